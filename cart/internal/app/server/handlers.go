@@ -3,8 +3,12 @@ package server
 import (
 	"bytes"
 	grpcV1 "cart/internal/controller/grpc/v1"
+	"cart/internal/domain"
 	"cart/internal/metrics"
 	"cart/internal/repository/postgres"
+	"cart/internal/service/fxrates"
+	"cart/internal/service/regions"
+	"cart/internal/service/shipping"
 	"cart/internal/service/stockms"
 	"cart/internal/usecase/carts"
 	pb "cart/pkg/api/cart"
@@ -31,8 +35,36 @@ func (s *Server) registerGRPCServices() error {
 		return fmt.Errorf("failed to create new gRPC stock service: %w", err)
 	}
 
+	fxRatesCfg := s.cfg.FXRatesConfig()
+
+	fxRateProvider, err := fxrates.NewFileRateProvider(fxRatesCfg.File, fxRatesCfg.RefreshInterval, fxRatesCfg.MaxAge)
+	if err != nil {
+		return fmt.Errorf("failed to create fx rate provider: %w", err)
+	}
+
+	sourcingCfg := s.cfg.SourcingConfig()
+
+	sourcingRules, err := domain.ParseSourcingRules(sourcingCfg.Rules)
+	if err != nil {
+		return fmt.Errorf("failed to parse sourcing rules: %w", err)
+	}
+
+	locationRegions, err := regions.LoadFile(sourcingCfg.RegionsFile)
+	if err != nil {
+		return fmt.Errorf("failed to load regions of locations: %w", err)
+	}
+
+	sourcing := domain.SourcingPolicy{Rules: sourcingRules, Regions: locationRegions}
+
+	shippingRates, err := shipping.LoadFile(s.cfg.ShippingConfig().RatesFile)
+	if err != nil {
+		return fmt.Errorf("failed to load shipping rates: %w", err)
+	}
+
 	// usecases.
-	cartUseCase := carts.NewCartServiceUseCase(stockService, cartRepo, s.kafkaProducer)
+	cartUseCase := carts.NewCartServiceUseCase(
+		stockService, cartRepo, fxRateProvider, s.kafkaProducer, sourcing, shippingRates,
+	)
 
 	cartGRPCHandler := grpcV1.NewCartGRPCHandler(cartUseCase, s.logger)
 
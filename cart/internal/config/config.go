@@ -22,6 +22,9 @@ type Config interface {
 	StockServiceURL() string
 	StockServiceGRPCAddress() string
 	GetKafkaBrokers() string
+	FXRatesConfig() FXRatesConfig
+	SourcingConfig() SourcingConfig
+	ShippingConfig() ShippingConfig
 }
 
 type CartServiceConfig struct {
@@ -30,6 +33,9 @@ type CartServiceConfig struct {
 	ExternalServices ExternalServicesConfig
 	Kafka            KafkaServiceConfig
 	Observality      ObservalityConfig
+	FXRates          FXRatesConfig
+	Sourcing         SourcingConfig
+	Shipping         ShippingConfig
 }
 
 type (
@@ -59,6 +65,26 @@ type (
 	KafkaServiceConfig struct {
 		Brokers string `env:"KAFKA_BROKERS,required"`
 	}
+	// FXRatesConfig holds configurations of exchange rates used for display currency of cart.
+	FXRatesConfig struct {
+		// File is json file with rates of currencies to its base currency.
+		File            string        `env:"FX_RATES_FILE" envDefault:"fx_rates.json"`
+		RefreshInterval time.Duration `env:"FX_RATES_REFRESH_INTERVAL" envDefault:"5m"`
+		// MaxAge is how long rates read last are used while file can not be read.
+		MaxAge time.Duration `env:"FX_RATES_MAX_AGE" envDefault:"24h"`
+	}
+	// SourcingConfig holds configurations of picking fulfillment locations of cart lines.
+	SourcingConfig struct {
+		// Rules are comma separated sourcing rules, the first one decides and next ones break its ties.
+		Rules string `env:"SOURCING_RULES" envDefault:"single_location,nearest_region,cheapest"`
+		// RegionsFile is json file with regions of fulfillment locations and nearby regions of every region.
+		RegionsFile string `env:"SOURCING_REGIONS_FILE" envDefault:"regions.json"`
+	}
+	// ShippingConfig holds configurations of shipping options of cart.
+	ShippingConfig struct {
+		// RatesFile is json file with zones of destination regions and rate tables of shipping methods.
+		RatesFile string `env:"SHIPPING_RATES_FILE" envDefault:"shipping_rates.json"`
+	}
 	// ObservalityConfig holds needed configurations for observality.
 	ObservalityConfig struct {
 		LogStashHost string `env:"LOGSTASH_HOST,required"`
@@ -122,6 +148,18 @@ func (c *CartServiceConfig) GetKafkaBrokers() string {
 	return c.Kafka.Brokers
 }
 
+func (c *CartServiceConfig) FXRatesConfig() FXRatesConfig {
+	return c.FXRates
+}
+
+func (c *CartServiceConfig) SourcingConfig() SourcingConfig {
+	return c.Sourcing
+}
+
+func (c *CartServiceConfig) ShippingConfig() ShippingConfig {
+	return c.Shipping
+}
+
 // GenerateDSN returns a psql url.
 func (p *PostgresConfig) GenerateDSN() string {
 	return fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable",
@@ -45,6 +45,10 @@ func (c *CartGRPCHandler) AddCartItem(ctx context.Context, req *pb.CreateCartIte
 			return nil, status.Error(codes.InvalidArgument, "insufficient stock count")
 		}
 
+		if errors.Is(err, domain.ErrQuantityOutOfRange) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -60,7 +64,7 @@ func (c *CartGRPCHandler) DeleteCartItem(ctx context.Context, req *pb.RemoveCart
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	err = c.cartUC.DeleteCartItem(ctx, deleteCartItemReq.UserID, deleteCartItemReq.SkuID)
+	err = c.cartUC.DeleteCartItem(ctx, deleteCartItemReq.UserID, deleteCartItemReq.SkuID, deleteCartItemReq.SellerID)
 	if err != nil {
 		if errors.Is(err, domain.ErrCartItemNotFound) {
 			return nil, status.Error(codes.NotFound, cartItemNotFound)
@@ -80,7 +84,7 @@ func (c *CartGRPCHandler) ClearCartItems(ctx context.Context, req *pb.ClearCartI
 	if userID == 0 {
 		return nil, status.Error(codes.InvalidArgument, "user ID cannot be zero")
 	}
-	
+
 	err := c.cartUC.ClearCartItems(ctx, userID)
 	if err != nil {
 		if errors.Is(err, domain.ErrCartItemNotFound) {
@@ -97,13 +101,20 @@ func (c *CartGRPCHandler) ClearCartItems(ctx context.Context, req *pb.ClearCartI
 }
 
 func (c *CartGRPCHandler) ListCartItems(ctx context.Context, req *pb.ListCartItemsRequest) (*pb.ListCartItemsResponse, error) {
-	userID := domain.UserID(req.UserId)
-	if userID == 0 {
-		return nil, status.Error(codes.InvalidArgument, "user ID cannot be zero")
+	query, err := fromGrpcListCartItemsReqToDomain(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	listCartItems, err := c.cartUC.ListCartItems(ctx, userID)
+	listCartItems, err := c.cartUC.ListCartItems(ctx, query)
 	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrAmountOutOfRange):
+			return nil, status.Error(codes.OutOfRange, err.Error())
+		case errors.Is(err, domain.ErrFXRateNotFound):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
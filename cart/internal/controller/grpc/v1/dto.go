@@ -3,22 +3,25 @@ package v1
 import "cart/internal/domain"
 
 type CreateCartItemRequest struct {
-	UserID int64  `json:"userID" validate:"required"`
-	SkuID  uint32 `json:"skuID" validate:"required"`
-	Count  uint16 `json:"count" validate:"required"`
+	UserID   int64  `json:"userID" validate:"required"`
+	SkuID    uint32 `json:"skuID" validate:"required"`
+	Count    int64  `json:"count" validate:"required,gte=1"`
+	SellerID int64  `json:"sellerID" validate:"gte=0"`
 }
 
 func (c *CreateCartItemRequest) ToDomain() domain.CartItem {
 	return domain.CartItem{
-		UserID: domain.UserID(c.UserID),
-		SkuID:  domain.SkuID(c.SkuID),
-		Count:  c.Count,
+		UserID:   domain.UserID(c.UserID),
+		SkuID:    domain.SkuID(c.SkuID),
+		Count:    c.Count,
+		SellerID: domain.UserID(c.SellerID),
 	}
 }
 
 type DeleteCartItemRequest struct {
-	UserID int64  `json:"userID" validate:"required"`
-	SkuID  uint32 `json:"skuID" validate:"required"`
+	UserID   int64  `json:"userID" validate:"required"`
+	SkuID    uint32 `json:"skuID" validate:"required"`
+	SellerID int64  `json:"sellerID" validate:"gte=0"`
 }
 
 type ClearCartItemRequest struct {
@@ -26,5 +29,17 @@ type ClearCartItemRequest struct {
 }
 
 type ListCartItemsRequest struct {
-	UserID int64 `json:"userID" validate:"required"`
+	UserID          int64  `json:"userID" validate:"required"`
+	DisplayCurrency string `json:"displayCurrency" validate:"omitempty,iso4217"`
+	CustomerGroup   string `json:"customerGroup" validate:"max=64"`
+	Region          string `json:"region" validate:"max=64"`
+}
+
+func (l *ListCartItemsRequest) ToDomain() domain.ListCartItemsQuery {
+	return domain.ListCartItemsQuery{
+		UserID:          domain.UserID(l.UserID),
+		DisplayCurrency: l.DisplayCurrency,
+		CustomerGroup:   l.CustomerGroup,
+		Region:          l.Region,
+	}
 }
@@ -4,13 +4,17 @@ import (
 	"cart/internal/domain"
 	"cart/pkg/api/cart"
 	helper "cart/pkg/httphelper"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func fromGrpcCreateCartItemReqToDomain(req *cart.CreateCartItemRequest) (domain.CartItem, error) {
 	createCartItemReq := CreateCartItemRequest{
-		UserID: req.UserId,
-		SkuID:  req.SkuId,
-		Count:  uint16(req.Count),
+		UserID:   req.UserId,
+		SkuID:    req.SkuId,
+		Count:    req.Count,
+		SellerID: req.SellerId,
 	}
 
 	if err := helper.ValidateRequest(&createCartItemReq); err != nil {
@@ -22,8 +26,9 @@ func fromGrpcCreateCartItemReqToDomain(req *cart.CreateCartItemRequest) (domain.
 
 func fromGrpcDeleteCartItemReqToDomain(req *cart.RemoveCartItemRequest) (domain.CartItem, error) {
 	deleteCartItemReq := DeleteCartItemRequest{
-		UserID: req.UserId,
-		SkuID:  req.SkuId,
+		UserID:   req.UserId,
+		SkuID:    req.SkuId,
+		SellerID: req.SellerId,
 	}
 
 	if err := helper.ValidateRequest(&deleteCartItemReq); err != nil {
@@ -31,25 +36,133 @@ func fromGrpcDeleteCartItemReqToDomain(req *cart.RemoveCartItemRequest) (domain.
 	}
 
 	return domain.CartItem{
-		UserID: domain.UserID(deleteCartItemReq.UserID),
-		SkuID:  domain.SkuID(deleteCartItemReq.SkuID),
+		UserID:   domain.UserID(deleteCartItemReq.UserID),
+		SkuID:    domain.SkuID(deleteCartItemReq.SkuID),
+		SellerID: domain.UserID(deleteCartItemReq.SellerID),
 	}, nil
 }
 
+func fromGrpcListCartItemsReqToDomain(req *cart.ListCartItemsRequest) (domain.ListCartItemsQuery, error) {
+	listCartItemsReq := ListCartItemsRequest{
+		UserID:          req.UserId,
+		DisplayCurrency: req.DisplayCurrency,
+		CustomerGroup:   req.CustomerGroup,
+		Region:          req.Region,
+	}
+
+	if err := helper.ValidateRequest(&listCartItemsReq); err != nil {
+		return domain.ListCartItemsQuery{}, err
+	}
+
+	return listCartItemsReq.ToDomain(), nil
+}
+
 func fromListStockItemsDomainToGrpc(cartItemsDomain domain.ListCartItems) *cart.ListCartItemsResponse {
 	cartItemsRes := make([]*cart.CartItemResponse, 0, len(cartItemsDomain.Items))
 
 	for _, cartItem := range cartItemsDomain.Items {
-		cartItemsRes = append(cartItemsRes, &cart.CartItemResponse{
-			SkuId: uint32(cartItem.SKuID),
-			Name:  cartItem.Name,
-			Count: uint32(cartItem.Count),
-			Price: cartItem.Price,
+		cartItemRes := &cart.CartItemResponse{
+			SkuId:           uint32(cartItem.SKuID),
+			Name:            cartItem.Name,
+			Count:           cartItem.Count,
+			Price:           fromMoneyDomainToGrpc(cartItem.Price),
+			ListPrice:       fromMoneyDomainToGrpc(cartItem.ListPrice),
+			TierMinQuantity: cartItem.TierMinQuantity,
+			Total:           fromMoneyDomainToGrpc(cartItem.Total),
+			SellerId:        int64(cartItem.SellerID),
+			VariantGroupId:  cartItem.VariantGroupID,
+			Attributes:      fromAttributesDomainToGrpc(cartItem.Attributes),
+			Unit:            cartItem.Unit,
+			Availability:    string(cartItem.Availability),
+			Location:        cartItem.Location,
+		}
+
+		if !cartItem.ExpectedAt.IsZero() {
+			cartItemRes.ExpectedAt = timestamppb.New(cartItem.ExpectedAt)
+		}
+
+		if cartItem.DisplayTotal.Currency != "" {
+			cartItemRes.DisplayPrice = fromMoneyDomainToGrpc(cartItem.DisplayPrice)
+			cartItemRes.DisplayTotal = fromMoneyDomainToGrpc(cartItem.DisplayTotal)
+		}
+
+		if !cartItem.RateUpdatedAt.IsZero() {
+			cartItemRes.RateUpdatedAt = timestamppb.New(cartItem.RateUpdatedAt)
+		}
+
+		cartItemsRes = append(cartItemsRes, cartItemRes)
+	}
+
+	totals := make([]*cart.Money, 0, len(cartItemsDomain.Totals))
+	for _, total := range cartItemsDomain.Totals {
+		totals = append(totals, fromMoneyDomainToGrpc(total))
+	}
+
+	shipments := make([]*cart.Shipment, 0, len(cartItemsDomain.Shipments))
+	for _, shipment := range cartItemsDomain.Shipments {
+		shipments = append(shipments, &cart.Shipment{
+			Location: shipment.Location,
+			Region:   shipment.Region,
+			Lines:    int64(shipment.Lines),
 		})
 	}
 
-	return &cart.ListCartItemsResponse{
-		Items:      cartItemsRes,
-		TotalPrice: cartItemsDomain.TotalPrice,
+	shippingOptions := make([]*cart.ShippingOption, 0, len(cartItemsDomain.ShippingOptions))
+	for _, option := range cartItemsDomain.ShippingOptions {
+		shippingOptions = append(shippingOptions, fromShippingOptionDomainToGrpc(option))
+	}
+
+	listCartItemsRes := &cart.ListCartItemsResponse{
+		Items:           cartItemsRes,
+		Totals:          totals,
+		Shipments:       shipments,
+		SplitShipment:   cartItemsDomain.SplitShipment,
+		ShippingOptions: shippingOptions,
+	}
+
+	if cartItemsDomain.DisplayTotal.Currency != "" {
+		listCartItemsRes.DisplayTotal = fromMoneyDomainToGrpc(cartItemsDomain.DisplayTotal)
 	}
+
+	if !cartItemsDomain.RatesUpdatedAt.IsZero() {
+		listCartItemsRes.RatesUpdatedAt = timestamppb.New(cartItemsDomain.RatesUpdatedAt)
+	}
+
+	return listCartItemsRes
+}
+
+func fromShippingOptionDomainToGrpc(option domain.ShippingOption) *cart.ShippingOption {
+	optionRes := &cart.ShippingOption{
+		Method:             option.Method,
+		Price:              fromMoneyDomainToGrpc(option.Price),
+		EarliestDeliveryAt: timestamppb.New(option.EarliestAt),
+		LatestDeliveryAt:   timestamppb.New(option.LatestAt),
+	}
+
+	if option.DisplayPrice.Currency != "" {
+		optionRes.DisplayPrice = fromMoneyDomainToGrpc(option.DisplayPrice)
+	}
+
+	return optionRes
+}
+
+func fromMoneyDomainToGrpc(money domain.Money) *cart.Money {
+	return &cart.Money{
+		Currency: money.Currency,
+		Amount:   money.Amount,
+	}
+}
+
+// fromAttributesDomainToGrpc converts sku attributes received from stocks service, they are always representable as struct.
+func fromAttributesDomainToGrpc(attributes map[string]any) *structpb.Struct {
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	attributesStruct, err := structpb.NewStruct(attributes)
+	if err != nil {
+		return nil
+	}
+
+	return attributesStruct
 }
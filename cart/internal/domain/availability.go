@@ -0,0 +1,56 @@
+package domain
+
+import "time"
+
+// Availability represent when cart line can be shipped.
+type Availability string
+
+const (
+	// AvailabilityInStock is used when offer has enough stock for the line.
+	AvailabilityInStock Availability = "in_stock"
+	// AvailabilityBackorder is used when line is partly or fully beyond stock and ships when offer is restocked.
+	AvailabilityBackorder Availability = "backorder"
+	// AvailabilityPreorder is used when offer is not released yet and line ships at its restock date.
+	AvailabilityPreorder Availability = "preorder"
+	// AvailabilityUnavailable is used when line exceeds what can be ordered from offer now.
+	AvailabilityUnavailable Availability = "unavailable"
+)
+
+const backorderModePreorder = "preorder"
+
+// BackorderPolicy represent whether offer may be ordered beyond its stock.
+type BackorderPolicy struct {
+	// Mode is none, backorder or preorder.
+	Mode string
+	// RestockAt is expected time stock of offer arrives, zero when it is unknown.
+	RestockAt time.Time
+}
+
+// AvailabilityOf returns availability of count of offer with expected time it ships at,
+// which is zero for stock in hand and for unknown restock date.
+func (s StockItemBySKU) AvailabilityOf(count int64) (Availability, time.Time) {
+	switch {
+	case count > s.AvailableToOrder:
+		return AvailabilityUnavailable, time.Time{}
+	case s.Backorder.Mode == backorderModePreorder:
+		return AvailabilityPreorder, s.Backorder.RestockAt
+	case count <= s.Count:
+		return AvailabilityInStock, time.Time{}
+	default:
+		return AvailabilityBackorder, s.Backorder.RestockAt
+	}
+}
+
+// MaxAvailableToOrder returns the most one line can order from seller of offer, line ships from one
+// location, so it is what the best location of seller among Offers can take.
+func (s StockItemBySKU) MaxAvailableToOrder() int64 {
+	available := s.AvailableToOrder
+
+	for _, offer := range s.Offers {
+		if offer.SellerID == s.SellerID {
+			available = max(available, offer.AvailableToOrder)
+		}
+	}
+
+	return available
+}
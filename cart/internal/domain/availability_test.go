@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStockItemBySKU_AvailabilityOf(t *testing.T) {
+	t.Parallel()
+
+	restockAt := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		offer    StockItemBySKU
+		count    int64
+		want     Availability
+		wantTime time.Time
+	}{
+		{
+			name:  "in stock",
+			offer: StockItemBySKU{Count: 5, AvailableToOrder: 5, Backorder: BackorderPolicy{Mode: "none"}},
+			count: 5,
+			want:  AvailabilityInStock,
+		},
+		{
+			name:     "beyond stock is backordered",
+			offer:    StockItemBySKU{Count: 5, AvailableToOrder: 15, Backorder: BackorderPolicy{Mode: "backorder", RestockAt: restockAt}},
+			count:    6,
+			want:     AvailabilityBackorder,
+			wantTime: restockAt,
+		},
+		{
+			name:     "preorder ships at restock date even with stock",
+			offer:    StockItemBySKU{Count: 5, AvailableToOrder: 15, Backorder: BackorderPolicy{Mode: "preorder", RestockAt: restockAt}},
+			count:    1,
+			want:     AvailabilityPreorder,
+			wantTime: restockAt,
+		},
+		{
+			name:  "beyond backorder limit",
+			offer: StockItemBySKU{Count: -2, AvailableToOrder: 8, Backorder: BackorderPolicy{Mode: "backorder"}},
+			count: 9,
+			want:  AvailabilityUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotTime := tt.offer.AvailabilityOf(tt.count)
+			if got != tt.want || !gotTime.Equal(tt.wantTime) {
+				t.Errorf("AvailabilityOf(%d) = %s, %v, want %s, %v", tt.count, got, gotTime, tt.want, tt.wantTime)
+			}
+		})
+	}
+}
@@ -1,12 +1,37 @@
 package domain
 
+import "time"
+
 type CartItem struct {
+	UserID   UserID
+	SkuID    SkuID
+	Count    int64
+	SellerID UserID
+}
+
+// ListCartItemsQuery represent request for items of cart.
+type ListCartItemsQuery struct {
 	UserID UserID
-	SkuID  SkuID
-	Count  uint16
+	// DisplayCurrency is currency amounts are also converted to, empty leaves them in original currencies.
+	DisplayCurrency string
+	// CustomerGroup selects price tiers of customer group besides tiers for every customer.
+	CustomerGroup string
+	// Region is destination region of cart, fulfillment locations and shipping options are picked for it,
+	// empty when it is unknown.
+	Region string
 }
 
 type ListCartItems struct {
-	Items      []StockItemBySKU
-	TotalPrice uint32
+	Items []StockItemBySKU
+	// Shipments group items by location they ship from, SplitShipment is set when there are several.
+	Shipments     []Shipment
+	SplitShipment bool
+	// ShippingOptions are methods cart can be delivered by to destination region with price and delivery dates.
+	ShippingOptions []ShippingOption
+	// Totals has total of cart in every currency of its items, ordered by currency code.
+	Totals []Money
+	// DisplayTotal is sum of display totals of items, its currency is empty when display currency is not requested.
+	DisplayTotal Money
+	// RatesUpdatedAt is publish time of the oldest exchange rate used for display amounts.
+	RatesUpdatedAt time.Time
 }
@@ -7,3 +7,21 @@ var ErrInSufficientStockCount = errors.New("insufficient stock count")
 
 // ErrCartItemNotFound is returned when no rows in result set for cartItem.
 var ErrCartItemNotFound = errors.New("cart item not found")
+
+// ErrQuantityOutOfRange is returned when count of cart item does not fit into 64 bits.
+var ErrQuantityOutOfRange = errors.New("quantity out of range")
+
+// ErrCurrencyMismatch is returned when amounts in different currencies are combined.
+var ErrCurrencyMismatch = errors.New("currency mismatch")
+
+// ErrAmountOutOfRange is returned when price or total of cart does not fit into 64 bits.
+var ErrAmountOutOfRange = errors.New("amount out of range")
+
+// ErrFXRateNotFound is returned when there is no exchange rate between currencies.
+var ErrFXRateNotFound = errors.New("exchange rate not found")
+
+// ErrInvalidSourcingRule is returned when sourcing rules of configuration are unknown or repeated.
+var ErrInvalidSourcingRule = errors.New("invalid sourcing rule")
+
+// ErrInvalidShippingRate is returned when row of shipping rate table of configuration is invalid.
+var ErrInvalidShippingRate = errors.New("invalid shipping rate")
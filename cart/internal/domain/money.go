@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// unitScaleKg is number of grams in kg, counts of skus measured in kg are kept in grams.
+const unitScaleKg = 1000
+
+// decimalBase is base of minor units of currencies.
+const decimalBase = 10
+
+// defaultMinorUnits is number of digits of minor unit of currencies which are not listed in minorUnits.
+const defaultMinorUnits = 2
+
+// minorUnits holds ISO 4217 currencies whose minor unit is not a hundredth of major one.
+var minorUnits = map[string]int{
+	"BHD": 3, "CLP": 0, "IQD": 3, "ISK": 0, "JOD": 3, "JPY": 0, "KRW": 0,
+	"KWD": 3, "LYD": 3, "OMR": 3, "PYG": 0, "TND": 3, "UGX": 0, "VND": 0,
+}
+
+// Money represent amount in minor units of currency, e.g. kopecks of RUB or cents of USD.
+type Money struct {
+	Currency string
+	Amount   int64
+}
+
+// FXRate represent exchange rate of currency From to currency To.
+type FXRate struct {
+	From string
+	To   string
+	// Rate is number of major units of To one major unit of From is worth.
+	Rate *big.Rat
+	// UpdatedAt is time rate was published at by its source.
+	UpdatedAt time.Time
+}
+
+// Add returns sum of amounts, both of them must be in the same currency.
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, other.Currency)
+	}
+
+	sum := m.Amount + other.Amount
+	if (other.Amount > 0 && sum < m.Amount) || (other.Amount < 0 && sum > m.Amount) {
+		return Money{}, ErrAmountOutOfRange
+	}
+
+	return Money{Currency: m.Currency, Amount: sum}, nil
+}
+
+// ForQuantity returns price of count minor units of unit when m is price of one unit, e.g. of 1250 grams
+// priced per kg. Fraction of minor unit of currency is rounded half away from zero.
+func (m Money) ForQuantity(count int64, unit string) (Money, error) {
+	scale := int64(1)
+	if unit == "kg" {
+		scale = unitScaleKg
+	}
+
+	amount := new(big.Rat).SetFrac(new(big.Int).Mul(big.NewInt(m.Amount), big.NewInt(count)), big.NewInt(scale))
+
+	return newMoney(m.Currency, amount)
+}
+
+// Convert returns m in currency To of rate, minor units of both currencies are taken into account,
+// so 100 JPY at 0.0067 USD is 67 cents. Fraction of minor unit is rounded half away from zero.
+func (m Money) Convert(rate FXRate) (Money, error) {
+	if m.Currency != rate.From {
+		return Money{}, fmt.Errorf("%w: %s and rate of %s", ErrCurrencyMismatch, m.Currency, rate.From)
+	}
+
+	amount := new(big.Rat).Mul(new(big.Rat).SetInt64(m.Amount), rate.Rate)
+
+	digits := currencyMinorUnits(rate.To) - currencyMinorUnits(rate.From)
+	if digits >= 0 {
+		amount.Mul(amount, new(big.Rat).SetInt(pow10(digits)))
+	} else {
+		amount.Quo(amount, new(big.Rat).SetInt(pow10(-digits)))
+	}
+
+	return newMoney(rate.To, amount)
+}
+
+// newMoney rounds amount half away from zero to minor unit of currency.
+func newMoney(currency string, amount *big.Rat) (Money, error) {
+	rounded, remainder := new(big.Int).QuoRem(amount.Num(), amount.Denom(), new(big.Int))
+	if remainder.Lsh(remainder.Abs(remainder), 1).Cmp(amount.Denom()) >= 0 {
+		rounded.Add(rounded, big.NewInt(int64(amount.Sign())))
+	}
+
+	if !rounded.IsInt64() {
+		return Money{}, ErrAmountOutOfRange
+	}
+
+	return Money{Currency: currency, Amount: rounded.Int64()}, nil
+}
+
+func currencyMinorUnits(currency string) int {
+	if digits, ok := minorUnits[currency]; ok {
+		return digits
+	}
+
+	return defaultMinorUnits
+}
+
+func pow10(exponent int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(decimalBase), big.NewInt(int64(exponent)), nil)
+}
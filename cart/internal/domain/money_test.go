@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestMoney_Convert(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		money   Money
+		rate    FXRate
+		want    Money
+		wantErr error
+	}{
+		{
+			name:  "currencies with cents",
+			money: Money{Currency: "USD", Amount: 1999},
+			rate:  FXRate{From: "USD", To: "RUB", Rate: big.NewRat(9250, 100)},
+			want:  Money{Currency: "RUB", Amount: 184908},
+		},
+		{
+			name:  "to currency without minor unit",
+			money: Money{Currency: "USD", Amount: 1999},
+			rate:  FXRate{From: "USD", To: "JPY", Rate: big.NewRat(150, 1)},
+			want:  Money{Currency: "JPY", Amount: 2999},
+		},
+		{
+			name:  "from currency without minor unit",
+			money: Money{Currency: "JPY", Amount: 100},
+			rate:  FXRate{From: "JPY", To: "USD", Rate: big.NewRat(67, 10000)},
+			want:  Money{Currency: "USD", Amount: 67},
+		},
+		{
+			name:  "to currency with thousandths",
+			money: Money{Currency: "USD", Amount: 100},
+			rate:  FXRate{From: "USD", To: "KWD", Rate: big.NewRat(3075, 10000)},
+			want:  Money{Currency: "KWD", Amount: 308},
+		},
+		{
+			name:    "rate of other currency",
+			money:   Money{Currency: "EUR", Amount: 100},
+			rate:    FXRate{From: "USD", To: "RUB", Rate: big.NewRat(1, 1)},
+			wantErr: ErrCurrencyMismatch,
+		},
+		{
+			name:    "overflow",
+			money:   Money{Currency: "USD", Amount: math.MaxInt64},
+			rate:    FXRate{From: "USD", To: "RUB", Rate: big.NewRat(2, 1)},
+			wantErr: ErrAmountOutOfRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.money.Convert(tt.rate)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Convert() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("Convert() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
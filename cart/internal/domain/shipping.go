@@ -0,0 +1,197 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// ShippingRate represent row of shipping rate table, empty Location and Zone match any of them
+// and zero limits are unlimited.
+type ShippingRate struct {
+	Method   string
+	Location string
+	Zone     string
+	// MaxWeight is the heaviest shipment rate applies to in grams, weight is count of skus measured in kg.
+	MaxWeight int64
+	// MaxQuantity is the most units of skus measured in pieces, each and pack, rate applies to.
+	MaxQuantity int64
+	Price       Money
+	// MinDays and MaxDays are range of days shipment is delivered in after it ships.
+	MinDays int
+	MaxDays int
+}
+
+// ShippingRates represent rate tables of shipping methods with zones of destination regions.
+type ShippingRates struct {
+	// Zones maps destination region to its shipping zone.
+	Zones map[string]string
+	// Rates are rows of rate tables, the first row matching shipment prices it for its method.
+	Rates []ShippingRate
+}
+
+// ShippingOption represent shipping method cart can be delivered by.
+type ShippingOption struct {
+	Method string
+	// Price is sum of prices of every shipment of cart.
+	Price Money
+	// DisplayPrice is Price in display currency of cart, when it is requested.
+	DisplayPrice Money
+	// EarliestAt and LatestAt are range of delivery dates of shipment of cart delivered last.
+	EarliestAt time.Time
+	LatestAt   time.Time
+}
+
+// shipmentLoad represent what shipment from location carries and when it can ship.
+type shipmentLoad struct {
+	weight   int64
+	quantity int64
+	shipsAt  time.Time
+}
+
+// Validate checks rows of rate tables, every method must be priced in one currency.
+func (r ShippingRates) Validate() error {
+	currencies := make(map[string]string)
+
+	for i, rate := range r.Rates {
+		switch {
+		case rate.Method == "":
+			return fmt.Errorf("%w: rate %d has no method", ErrInvalidShippingRate, i)
+		case rate.Price.Currency == "" || rate.Price.Amount < 0:
+			return fmt.Errorf("%w: rate %d has invalid price", ErrInvalidShippingRate, i)
+		case rate.MaxWeight < 0 || rate.MaxQuantity < 0:
+			return fmt.Errorf("%w: rate %d has negative limit", ErrInvalidShippingRate, i)
+		case rate.MinDays < 0 || rate.MaxDays < rate.MinDays:
+			return fmt.Errorf("%w: rate %d has invalid delivery days", ErrInvalidShippingRate, i)
+		}
+
+		if currency, ok := currencies[rate.Method]; ok && currency != rate.Price.Currency {
+			return fmt.Errorf("%w: method %s is priced in %s and %s", ErrCurrencyMismatch, rate.Method, currency, rate.Price.Currency)
+		}
+
+		currencies[rate.Method] = rate.Price.Currency
+	}
+
+	return nil
+}
+
+// ZoneOf returns shipping zone of destination region, empty when it is unknown.
+func (r ShippingRates) ZoneOf(region string) string {
+	return r.Zones[region]
+}
+
+// OptionsOf returns shipping options of cart items to destination region, methods are in order of rate tables.
+// Items of every location are one shipment, priced by the first rate of method matching its location, zone,
+// weight and quantity, and method which can not carry every shipment is not offered. Shipment ships when all
+// its lines can, backordered and preordered lines at their expected time when it is known, unavailable lines
+// do not ship.
+func (r ShippingRates) OptionsOf(items []StockItemBySKU, region string, now time.Time) ([]ShippingOption, error) {
+	loads := make(map[string]*shipmentLoad)
+	locations := make([]string, 0)
+
+	for _, item := range items {
+		if item.Availability == AvailabilityUnavailable {
+			continue
+		}
+
+		load, ok := loads[item.Location]
+		if !ok {
+			load = &shipmentLoad{shipsAt: now}
+			loads[item.Location] = load
+			locations = append(locations, item.Location)
+		}
+
+		if item.Unit == "kg" {
+			load.weight += item.Count
+		} else {
+			load.quantity += item.Count
+		}
+
+		if item.ExpectedAt.After(load.shipsAt) {
+			load.shipsAt = item.ExpectedAt
+		}
+	}
+
+	if len(locations) == 0 {
+		return nil, nil
+	}
+
+	slices.Sort(locations)
+
+	zone := r.ZoneOf(region)
+	options := make([]ShippingOption, 0)
+
+	for _, method := range r.methods() {
+		option, ok, err := r.optionOf(method, locations, loads, zone)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			options = append(options, option)
+		}
+	}
+
+	return options, nil
+}
+
+// optionOf prices every shipment by method, ok is false when method can not carry some of them.
+func (r ShippingRates) optionOf(
+	method string,
+	locations []string,
+	loads map[string]*shipmentLoad,
+	zone string,
+) (ShippingOption, bool, error) {
+	option := ShippingOption{Method: method}
+
+	for _, location := range locations {
+		load := loads[location]
+
+		i := slices.IndexFunc(r.Rates, func(rate ShippingRate) bool {
+			return rate.Method == method &&
+				(rate.Location == "" || rate.Location == location) &&
+				(rate.Zone == "" || rate.Zone == zone) &&
+				(rate.MaxWeight == 0 || load.weight <= rate.MaxWeight) &&
+				(rate.MaxQuantity == 0 || load.quantity <= rate.MaxQuantity)
+		})
+		if i < 0 {
+			return ShippingOption{}, false, nil
+		}
+
+		rate := r.Rates[i]
+
+		if option.Price.Currency == "" {
+			option.Price.Currency = rate.Price.Currency
+		}
+
+		price, err := option.Price.Add(rate.Price)
+		if err != nil {
+			return ShippingOption{}, false, err
+		}
+
+		option.Price = price
+
+		if earliestAt := load.shipsAt.AddDate(0, 0, rate.MinDays); earliestAt.After(option.EarliestAt) {
+			option.EarliestAt = earliestAt
+		}
+
+		if latestAt := load.shipsAt.AddDate(0, 0, rate.MaxDays); latestAt.After(option.LatestAt) {
+			option.LatestAt = latestAt
+		}
+	}
+
+	return option, true, nil
+}
+
+// methods returns shipping methods in order they first appear in rate tables.
+func (r ShippingRates) methods() []string {
+	methods := make([]string, 0)
+
+	for _, rate := range r.Rates {
+		if !slices.Contains(methods, rate.Method) {
+			methods = append(methods, rate.Method)
+		}
+	}
+
+	return methods
+}
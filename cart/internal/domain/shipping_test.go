@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestShippingRates_OptionsOf(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 9, 1, 12, 0, 0, 0, time.UTC)
+	rub := func(amount int64) Money { return Money{Currency: "RUB", Amount: amount} }
+
+	rates := ShippingRates{
+		Zones: map[string]string{"central": "near", "ural": "far"},
+		Rates: []ShippingRate{
+			{Method: "express", Location: "msk-1", Zone: "near", MaxQuantity: 10, Price: rub(500), MinDays: 0, MaxDays: 1},
+			{Method: "standard", Zone: "near", MaxWeight: 5000, MaxQuantity: 10, Price: rub(200), MinDays: 1, MaxDays: 3},
+			{Method: "standard", Zone: "near", Price: rub(400), MinDays: 2, MaxDays: 4},
+			{Method: "standard", Price: rub(900), MinDays: 5, MaxDays: 10},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		items  []StockItemBySKU
+		region string
+		want   []ShippingOption
+	}{
+		{
+			name:   "small shipment nearby",
+			items:  []StockItemBySKU{{Location: "msk-1", Unit: "each", Count: 2}},
+			region: "central",
+			want: []ShippingOption{
+				{Method: "express", Price: rub(500), EarliestAt: now, LatestAt: now.AddDate(0, 0, 1)},
+				{Method: "standard", Price: rub(200), EarliestAt: now.AddDate(0, 0, 1), LatestAt: now.AddDate(0, 0, 3)},
+			},
+		},
+		{
+			name: "heavy shipment takes next rate",
+			items: []StockItemBySKU{
+				{Location: "msk-1", Unit: "kg", Count: 4000},
+				{Location: "msk-1", Unit: "kg", Count: 2000},
+			},
+			region: "central",
+			want: []ShippingOption{
+				{Method: "express", Price: rub(500), EarliestAt: now, LatestAt: now.AddDate(0, 0, 1)},
+				{Method: "standard", Price: rub(400), EarliestAt: now.AddDate(0, 0, 2), LatestAt: now.AddDate(0, 0, 4)},
+			},
+		},
+		{
+			name: "split shipment is priced per location",
+			items: []StockItemBySKU{
+				{Location: "msk-1", Unit: "each", Count: 1},
+				{Location: "spb-1", Unit: "each", Count: 1},
+			},
+			region: "central",
+			want: []ShippingOption{
+				{Method: "standard", Price: rub(400), EarliestAt: now.AddDate(0, 0, 1), LatestAt: now.AddDate(0, 0, 3)},
+			},
+		},
+		{
+			name:   "other zone",
+			items:  []StockItemBySKU{{Location: "msk-1", Unit: "each", Count: 1}},
+			region: "ural",
+			want: []ShippingOption{
+				{Method: "standard", Price: rub(900), EarliestAt: now.AddDate(0, 0, 5), LatestAt: now.AddDate(0, 0, 10)},
+			},
+		},
+		{
+			name: "backordered line ships when it is restocked",
+			items: []StockItemBySKU{
+				{Location: "msk-1", Unit: "each", Count: 1, Availability: AvailabilityBackorder, ExpectedAt: now.AddDate(0, 0, 7)},
+			},
+			region: "ural",
+			want: []ShippingOption{
+				{Method: "standard", Price: rub(900), EarliestAt: now.AddDate(0, 0, 12), LatestAt: now.AddDate(0, 0, 17)},
+			},
+		},
+		{
+			name:   "unavailable lines do not ship",
+			items:  []StockItemBySKU{{Location: "msk-1", Unit: "each", Count: 1, Availability: AvailabilityUnavailable}},
+			region: "central",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := rates.OptionsOf(tt.items, tt.region, now)
+			if err != nil {
+				t.Fatalf("OptionsOf() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("OptionsOf() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShippingRates_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		rates   []ShippingRate
+		wantErr error
+	}{
+		{
+			name:  "valid rates",
+			rates: []ShippingRate{{Method: "standard", Price: Money{Currency: "RUB", Amount: 100}, MinDays: 1, MaxDays: 3}},
+		},
+		{
+			name:    "no method",
+			rates:   []ShippingRate{{Price: Money{Currency: "RUB", Amount: 100}}},
+			wantErr: ErrInvalidShippingRate,
+		},
+		{
+			name:    "delivery days out of order",
+			rates:   []ShippingRate{{Method: "standard", Price: Money{Currency: "RUB", Amount: 100}, MinDays: 3, MaxDays: 1}},
+			wantErr: ErrInvalidShippingRate,
+		},
+		{
+			name: "method in two currencies",
+			rates: []ShippingRate{
+				{Method: "standard", Price: Money{Currency: "RUB", Amount: 100}},
+				{Method: "standard", Price: Money{Currency: "USD", Amount: 1}},
+			},
+			wantErr: ErrCurrencyMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rates := ShippingRates{Rates: tt.rates}
+			if err := rates.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package domain
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// SourcingRule represent rule fulfillment location of cart line is picked by.
+type SourcingRule string
+
+const (
+	// SourcingRuleSingleLocation prefers location most lines of cart can ship from, so cart is not split.
+	SourcingRuleSingleLocation SourcingRule = "single_location"
+	// SourcingRuleNearestRegion prefers location in region nearest to destination of cart.
+	SourcingRuleNearestRegion SourcingRule = "nearest_region"
+	// SourcingRuleCheapest prefers location with the lowest price of offer.
+	SourcingRuleCheapest SourcingRule = "cheapest"
+)
+
+// DefaultSourcingRules returns rules used when configuration sets none.
+func DefaultSourcingRules() []SourcingRule {
+	return []SourcingRule{SourcingRuleSingleLocation, SourcingRuleNearestRegion, SourcingRuleCheapest}
+}
+
+// ParseSourcingRules parses comma separated rules, the first rule decides and next ones break its ties.
+func ParseSourcingRules(rules string) ([]SourcingRule, error) {
+	if strings.TrimSpace(rules) == "" {
+		return DefaultSourcingRules(), nil
+	}
+
+	parsed := make([]SourcingRule, 0, 3)
+
+	for _, name := range strings.Split(rules, ",") {
+		rule := SourcingRule(strings.TrimSpace(name))
+
+		switch rule {
+		case SourcingRuleSingleLocation, SourcingRuleNearestRegion, SourcingRuleCheapest:
+		default:
+			return nil, fmt.Errorf("%w: %q", ErrInvalidSourcingRule, name)
+		}
+
+		if slices.Contains(parsed, rule) {
+			return nil, fmt.Errorf("%w: %q is repeated", ErrInvalidSourcingRule, name)
+		}
+
+		parsed = append(parsed, rule)
+	}
+
+	return parsed, nil
+}
+
+// Regions represent regions of fulfillment locations and how near regions are to each other.
+type Regions struct {
+	// Locations maps fulfillment location to its region.
+	Locations map[string]string
+	// Nearby lists regions from the nearest one for destination region, region itself is always the nearest.
+	Nearby map[string][]string
+}
+
+// RegionOf returns region of location, empty when it is unknown.
+func (r Regions) RegionOf(location string) string {
+	return r.Locations[location]
+}
+
+// Distance returns rank of location for destination region: 0 in destination region, then position
+// in nearby regions of destination, locations in other or unknown regions are the farthest.
+func (r Regions) Distance(location, destination string) int {
+	region := r.RegionOf(location)
+	nearby := r.Nearby[destination]
+
+	switch {
+	case region == "" || destination == "":
+		return len(nearby) + 1
+	case region == destination:
+		return 0
+	}
+
+	if i := slices.Index(nearby, region); i >= 0 {
+		return i + 1
+	}
+
+	return len(nearby) + 1
+}
+
+// SourcingPolicy represent rules fulfillment locations of cart lines are picked by.
+type SourcingPolicy struct {
+	Rules   []SourcingRule
+	Regions Regions
+}
+
+// SourcingLine represent cart line with offers of its seller in every location it is stocked in.
+type SourcingLine struct {
+	Count  int64
+	Offers []StockItemBySKU
+}
+
+// Source returns index of offer every line ships from, -1 for line without offers. Locations which have
+// stock for line go first, then the ones line can be backordered or preordered from, rules of policy
+// decide between locations of the same availability and offers keep their order when rules do not.
+func (p SourcingPolicy) Source(lines []SourcingLine, destination string) []int {
+	// coverage is number of lines location can fill now or by backorder.
+	coverage := make(map[string]int)
+
+	for _, line := range lines {
+		locations := make(map[string]bool)
+
+		for _, offer := range line.Offers {
+			if availabilityRank(offer, line.Count) < rankUnavailable && !locations[offer.Location] {
+				locations[offer.Location] = true
+				coverage[offer.Location]++
+			}
+		}
+	}
+
+	chosen := make([]int, 0, len(lines))
+
+	for _, line := range lines {
+		if len(line.Offers) == 0 {
+			chosen = append(chosen, -1)
+			continue
+		}
+
+		order := make([]int, len(line.Offers))
+		for i := range order {
+			order[i] = i
+		}
+
+		slices.SortStableFunc(order, func(i, j int) int {
+			a, b := line.Offers[i], line.Offers[j]
+
+			if c := cmp.Compare(availabilityRank(a, line.Count), availabilityRank(b, line.Count)); c != 0 {
+				return c
+			}
+
+			for _, rule := range p.Rules {
+				var c int
+
+				switch rule {
+				case SourcingRuleSingleLocation:
+					c = cmp.Compare(coverage[b.Location], coverage[a.Location])
+				case SourcingRuleNearestRegion:
+					c = cmp.Compare(p.Regions.Distance(a.Location, destination), p.Regions.Distance(b.Location, destination))
+				case SourcingRuleCheapest:
+					// prices in different currencies are not compared.
+					if a.Price.Currency == b.Price.Currency {
+						c = cmp.Compare(a.Price.Amount, b.Price.Amount)
+					}
+				}
+
+				if c != 0 {
+					return c
+				}
+			}
+
+			return 0
+		})
+
+		chosen = append(chosen, order[0])
+	}
+
+	return chosen
+}
+
+// ranks of offers by availability of line, lower ships sooner.
+const (
+	rankInStock = iota
+	rankOrderable
+	rankUnavailable
+)
+
+// availabilityRank orders offers for count: in stock, backorder or preorder, unavailable.
+func availabilityRank(offer StockItemBySKU, count int64) int {
+	switch availability, _ := offer.AvailabilityOf(count); availability {
+	case AvailabilityInStock:
+		return rankInStock
+	case AvailabilityBackorder, AvailabilityPreorder:
+		return rankOrderable
+	default:
+		return rankUnavailable
+	}
+}
+
+// Shipment represent lines of cart shipped together from one fulfillment location.
+type Shipment struct {
+	Location string
+	// Region is region of location, empty when it is unknown.
+	Region string
+	Lines  int
+}
+
+// ShipmentsOf groups items of cart by their fulfillment location, shipments are ordered by location.
+func (r Regions) ShipmentsOf(items []StockItemBySKU) []Shipment {
+	lines := make(map[string]int)
+
+	for _, item := range items {
+		lines[item.Location]++
+	}
+
+	shipments := make([]Shipment, 0, len(lines))
+	for location, count := range lines {
+		shipments = append(shipments, Shipment{Location: location, Region: r.RegionOf(location), Lines: count})
+	}
+
+	slices.SortFunc(shipments, func(a, b Shipment) int {
+		return strings.Compare(a.Location, b.Location)
+	})
+
+	return shipments
+}
@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSourcingPolicy_Source(t *testing.T) {
+	t.Parallel()
+
+	regions := Regions{
+		Locations: map[string]string{"msk-1": "central", "spb-1": "northwest", "ekb-1": "ural"},
+		Nearby:    map[string][]string{"central": {"northwest", "ural"}},
+	}
+
+	offer := func(location string, count, price int64) StockItemBySKU {
+		return StockItemBySKU{
+			Location:         location,
+			Count:            count,
+			AvailableToOrder: count,
+			Price:            Money{Currency: "RUB", Amount: price},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		rules       []SourcingRule
+		lines       []SourcingLine
+		destination string
+		want        []int
+	}{
+		{
+			name:  "line ships from location which has stock for it",
+			rules: []SourcingRule{SourcingRuleCheapest},
+			lines: []SourcingLine{
+				{Count: 5, Offers: []StockItemBySKU{offer("msk-1", 2, 100), offer("spb-1", 10, 200)}},
+			},
+			want: []int{1},
+		},
+		{
+			name:  "cheapest location",
+			rules: []SourcingRule{SourcingRuleCheapest},
+			lines: []SourcingLine{
+				{Count: 1, Offers: []StockItemBySKU{offer("msk-1", 5, 200), offer("spb-1", 5, 100)}},
+			},
+			want: []int{1},
+		},
+		{
+			name:  "single location keeps cart together",
+			rules: []SourcingRule{SourcingRuleSingleLocation, SourcingRuleCheapest},
+			lines: []SourcingLine{
+				{Count: 1, Offers: []StockItemBySKU{offer("msk-1", 5, 200), offer("spb-1", 5, 100)}},
+				{Count: 1, Offers: []StockItemBySKU{offer("msk-1", 5, 100)}},
+			},
+			want: []int{0, 0},
+		},
+		{
+			name:  "cheapest first splits cart",
+			rules: []SourcingRule{SourcingRuleCheapest, SourcingRuleSingleLocation},
+			lines: []SourcingLine{
+				{Count: 1, Offers: []StockItemBySKU{offer("msk-1", 5, 200), offer("spb-1", 5, 100)}},
+				{Count: 1, Offers: []StockItemBySKU{offer("msk-1", 5, 100)}},
+			},
+			want: []int{1, 0},
+		},
+		{
+			name:  "nearest region to destination",
+			rules: []SourcingRule{SourcingRuleNearestRegion, SourcingRuleCheapest},
+			lines: []SourcingLine{
+				{Count: 1, Offers: []StockItemBySKU{offer("ekb-1", 5, 100), offer("spb-1", 5, 200), offer("msk-1", 5, 300)}},
+				{Count: 1, Offers: []StockItemBySKU{offer("ekb-1", 5, 100), offer("spb-1", 5, 200)}},
+			},
+			destination: "central",
+			want:        []int{2, 1},
+		},
+		{
+			name:  "unknown destination leaves offers in their order",
+			rules: []SourcingRule{SourcingRuleNearestRegion},
+			lines: []SourcingLine{
+				{Count: 1, Offers: []StockItemBySKU{offer("ekb-1", 5, 100), offer("msk-1", 5, 100)}},
+			},
+			want: []int{0},
+		},
+		{
+			name:  "line without offers",
+			rules: DefaultSourcingRules(),
+			lines: []SourcingLine{{Count: 1}},
+			want:  []int{-1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			policy := SourcingPolicy{Rules: tt.rules, Regions: regions}
+			if got := policy.Source(tt.lines, tt.destination); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Source() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSourcingRules(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		rules   string
+		want    []SourcingRule
+		wantErr error
+	}{
+		{name: "defaults", want: DefaultSourcingRules()},
+		{
+			name:  "rules in order",
+			rules: "cheapest, nearest_region",
+			want:  []SourcingRule{SourcingRuleCheapest, SourcingRuleNearestRegion},
+		},
+		{name: "unknown rule", rules: "fastest", wantErr: ErrInvalidSourcingRule},
+		{name: "repeated rule", rules: "cheapest,cheapest", wantErr: ErrInvalidSourcingRule},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseSourcingRules(tt.rules)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseSourcingRules() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseSourcingRules() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,8 +1,48 @@
 package domain
 
+import "time"
+
+// StockItemQuery represent offer looked up by sku, Quantity and CustomerGroup select price tier of the offer.
+type StockItemQuery struct {
+	SkuID SkuID
+	// SellerID is zero for the best offer of sku.
+	SellerID      UserID
+	Quantity      int64
+	CustomerGroup string
+	// AllOffers asks for offers of sku in every location, they are returned as Offers.
+	AllOffers bool
+}
+
 type StockItemBySKU struct {
-	SKuID SkuID
-	Name  string
-	Price uint32
-	Count uint16
+	SKuID    SkuID
+	Name     string
+	Count    int64
+	SellerID UserID
+	// Location is fulfillment location of offer, for item of cart the one its line ships from.
+	Location string
+	// Offers are offers of sku in every location, set when all offers are requested.
+	Offers []StockItemBySKU
+	// Price is price of one unit of measure resolved for quantity and customer group, ListPrice is price before tier.
+	Price     Money
+	ListPrice Money
+	// TierMinQuantity is minimum quantity of price tier Price comes from, zero when list price applies.
+	TierMinQuantity int64
+	// VariantGroupID is zero for skus which are not variants of a product.
+	VariantGroupID int64
+	Attributes     map[string]any
+	// Unit is unit of measure of sku, Count is kept in its minor units, grams for kg.
+	Unit string
+	// Backorder and AvailableToOrder tell how much of offer can be ordered beyond its Count.
+	Backorder        BackorderPolicy
+	AvailableToOrder int64
+	// Availability and ExpectedAt tell when item of cart ships, see AvailabilityOf.
+	Availability Availability
+	ExpectedAt   time.Time
+	// Total is price of Count, it is set for items of cart.
+	Total Money
+	// DisplayPrice and DisplayTotal are Price and Total in display currency of cart, when it is requested.
+	DisplayPrice Money
+	DisplayTotal Money
+	// RateUpdatedAt is publish time of exchange rate used for display amounts, zero when no conversion was needed.
+	RateUpdatedAt time.Time
 }
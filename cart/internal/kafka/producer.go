@@ -36,14 +36,14 @@ type (
 	CartItemAddedPayload struct {
 		CartID string `json:"cartId"`
 		SKU    uint32 `json:"sku"`
-		Count  uint16 `json:"count"`
+		Count  int64  `json:"count"`
 		Status string `json:"status"`
 	}
 
 	CartItemFailedPayload struct {
 		CartID string `json:"cartId"`
 		SKU    uint32 `json:"sku"`
-		Count  uint16 `json:"count"`
+		Count  int64  `json:"count"`
 		Status string `json:"status"`
 		Reason string `json:"reason"`
 	}
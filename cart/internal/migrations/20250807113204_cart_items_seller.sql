@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- lines added before marketplace offers have seller 0.
+ALTER TABLE cart_items ADD COLUMN IF NOT EXISTS seller_id BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE cart_items DROP CONSTRAINT IF EXISTS cart_items_user_id_sku_key;
+ALTER TABLE cart_items ADD CONSTRAINT cart_items_user_id_sku_seller_id_key UNIQUE (user_id, sku, seller_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE cart_items DROP CONSTRAINT IF EXISTS cart_items_user_id_sku_seller_id_key;
+ALTER TABLE cart_items ADD CONSTRAINT cart_items_user_id_sku_key UNIQUE (user_id, sku);
+ALTER TABLE cart_items DROP COLUMN IF EXISTS seller_id;
+-- +goose StatementEnd
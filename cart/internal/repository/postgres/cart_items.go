@@ -8,8 +8,12 @@ import (
 	"errors"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
+// numericValueOutOfRangeCode is postgres SQLSTATE of arithmetic overflow, e.g. count not fitting BIGINT.
+const numericValueOutOfRangeCode = "22003"
+
 type cartServiceRepo struct {
 	psqlDB connection.DB
 }
@@ -22,25 +26,31 @@ func NewCartItemRepository(psqlDB connection.DB) *cartServiceRepo {
 
 func (c *cartServiceRepo) SaveOrUpdateCartItem(ctx context.Context, cartItem domain.CartItem) error {
 	_, err := c.psqlDB.Exec(ctx, `
-		INSERT INTO cart_items (user_id, sku, count)
-		VALUES ($1, $2, $3)
-		ON CONFLICT (user_id, sku) DO UPDATE SET
+		INSERT INTO cart_items (user_id, sku, count, seller_id)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id, sku, seller_id) DO UPDATE SET
 			count = cart_items.count + EXCLUDED.count,
 			updated_at = NOW()`,
-		cartItem.UserID, cartItem.SkuID, cartItem.Count,
+		cartItem.UserID, cartItem.SkuID, cartItem.Count, cartItem.SellerID,
 	)
 	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == numericValueOutOfRangeCode {
+			return domain.ErrQuantityOutOfRange
+		}
+
 		return err
 	}
 
 	return nil
 }
 
-func (c *cartServiceRepo) RemoveCartItem(ctx context.Context, userID domain.UserID, skuID domain.SkuID) error {
+// RemoveCartItem removes line of seller or lines of sku from every seller when sellerID is zero.
+func (c *cartServiceRepo) RemoveCartItem(ctx context.Context, userID domain.UserID, skuID domain.SkuID, sellerID domain.UserID) error {
 	_, err := c.psqlDB.Exec(ctx, `
 		DELETE FROM cart_items
-		WHERE user_id = $1 AND sku = $2`,
-		userID, skuID,
+		WHERE user_id = $1 AND sku = $2 AND ($3::BIGINT = 0 OR seller_id = $3)`,
+		userID, skuID, sellerID,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -73,9 +83,11 @@ func (c *cartServiceRepo) GetCartItemByUserID(ctx context.Context, userID domain
 	var cartItemData CartItemData
 
 	err := c.psqlDB.Get(ctx, &cartItemData, `
-		SELECT user_id, sku, count, created_at, updated_at
+		SELECT user_id, sku, count, seller_id, created_at, updated_at
 		FROM cart_items
-		WHERE user_id = $1 AND sku = $2`,
+		WHERE user_id = $1 AND sku = $2
+		ORDER BY seller_id
+		LIMIT 1`,
 		userID, skuID,
 	)
 	if err != nil {
@@ -110,7 +122,7 @@ func (c *cartServiceRepo) ListCartItemsByUserID(ctx context.Context, userID doma
 	var listCartItemsData []CartItemData
 
 	err := c.psqlDB.Select(ctx, &listCartItemsData, `
-		SELECT user_id, sku, count, created_at, updated_at
+		SELECT user_id, sku, count, seller_id, created_at, updated_at
 		FROM cart_items
 		WHERE user_id = $1`,
 		userID,
@@ -8,15 +8,17 @@ import (
 type CartItemData struct {
 	UserID    int64     `db:"user_id"`
 	SkuID     uint32    `db:"sku"`
-	Count     uint16    `db:"count"`
+	Count     int64     `db:"count"`
+	SellerID  int64     `db:"seller_id"`
 	CreatedAt time.Time `db:"created_at"`
 	UpdatedAt time.Time `db:"updated_at"`
 }
 
 func (c *CartItemData) ToDomain() domain.CartItem {
 	return domain.CartItem{
-		UserID: domain.UserID(c.UserID),
-		SkuID:  domain.SkuID(c.SkuID),
-		Count:  c.Count,
+		UserID:   domain.UserID(c.UserID),
+		SkuID:    domain.SkuID(c.SkuID),
+		Count:    c.Count,
+		SellerID: domain.UserID(c.SellerID),
 	}
 }
@@ -0,0 +1,139 @@
+package fxrates
+
+import (
+	"cart/internal/domain"
+	"cart/internal/usecase/carts"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ratesFile is format of local rates file, rates are numbers of units of currency one unit of base is worth:
+//
+//	{"base": "USD", "updatedAt": "2025-08-18T09:00:00Z", "rates": {"RUB": "92.50", "EUR": "0.92"}}
+type ratesFile struct {
+	Base      string            `json:"base"`
+	UpdatedAt time.Time         `json:"updatedAt"`
+	Rates     map[string]string `json:"rates"`
+}
+
+// rates is parsed rates file, rate of base to itself is included.
+type rates struct {
+	updatedAt time.Time
+	base      map[string]*big.Rat
+}
+
+type fileRateProvider struct {
+	path            string
+	refreshInterval time.Duration
+	maxAge          time.Duration
+
+	mu       sync.Mutex
+	rates    rates
+	loadedAt time.Time
+	// readAt is time rates were last read successfully, loadErr is error of the last failed read.
+	readAt  time.Time
+	loadErr error
+}
+
+var _ carts.FXRateProvider = (*fileRateProvider)(nil)
+
+// NewFileRateProvider returns rate provider which reads rates from local file and reads it again
+// when rates are older than refreshInterval. Rates read last are used while file is unreadable,
+// but not longer than maxAge after they were read. File must be readable when provider is created.
+func NewFileRateProvider(path string, refreshInterval, maxAge time.Duration) (*fileRateProvider, error) {
+	provider := &fileRateProvider{
+		path:            path,
+		refreshInterval: refreshInterval,
+		maxAge:          maxAge,
+	}
+
+	if err := provider.load(); err != nil {
+		return nil, err
+	}
+
+	return provider, nil
+}
+
+// GetRate returns cross rate of currencies through base currency of rates file.
+func (p *fileRateProvider) GetRate(ctx context.Context, from, to string) (domain.FXRate, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// rates which were read last time are used until file is fixed or they are too old.
+	if time.Since(p.loadedAt) >= p.refreshInterval {
+		p.loadErr = p.load()
+		if p.loadErr != nil {
+			log.Printf("fxrates: failed to refresh rates, rates read at %s are used: %v", p.readAt, p.loadErr)
+		}
+	}
+
+	if p.loadErr != nil {
+		trace.SpanFromContext(ctx).SetAttributes(attribute.String("fx_rates.refresh_error", p.loadErr.Error()))
+
+		if time.Since(p.readAt) >= p.maxAge {
+			return domain.FXRate{}, fmt.Errorf("%w: rates read at %s are stale: %v", domain.ErrFXRateNotFound, p.readAt, p.loadErr)
+		}
+	}
+
+	fromRate, ok := p.rates.base[from]
+	if !ok {
+		return domain.FXRate{}, fmt.Errorf("%w: %s", domain.ErrFXRateNotFound, from)
+	}
+
+	toRate, ok := p.rates.base[to]
+	if !ok {
+		return domain.FXRate{}, fmt.Errorf("%w: %s", domain.ErrFXRateNotFound, to)
+	}
+
+	return domain.FXRate{
+		From:      from,
+		To:        to,
+		Rate:      new(big.Rat).Quo(toRate, fromRate),
+		UpdatedAt: p.rates.updatedAt,
+	}, nil
+}
+
+// load reads rates file, p.mu must be held by caller unless provider is being created.
+func (p *fileRateProvider) load() error {
+	p.loadedAt = time.Now()
+
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return fmt.Errorf("failed to read rates file: %w", err)
+	}
+
+	var file ratesFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("failed to unmarshal rates file: %w", err)
+	}
+
+	if file.Base == "" {
+		return fmt.Errorf("rates file %s has no base currency", p.path)
+	}
+
+	base := make(map[string]*big.Rat, len(file.Rates)+1)
+	base[file.Base] = big.NewRat(1, 1)
+
+	for currency, value := range file.Rates {
+		rate, ok := new(big.Rat).SetString(value)
+		if !ok || rate.Sign() <= 0 {
+			return fmt.Errorf("rates file %s has invalid rate %q of %s", p.path, value, currency)
+		}
+
+		base[currency] = rate
+	}
+
+	p.rates = rates{updatedAt: file.UpdatedAt, base: base}
+	p.readAt = p.loadedAt
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package fxrates
+
+import (
+	"cart/internal/domain"
+	"context"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeRates(t *testing.T, path, content string) {
+	t.Helper()
+
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write rates file: %v", err)
+	}
+}
+
+func TestFileRateProvider_GetRate(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "rates.json")
+	writeRates(t, path, `{"base": "RUB", "updatedAt": "2025-08-18T09:00:00Z", "rates": {"USD": "0.0125", "EUR": "0.01"}}`)
+
+	provider, err := NewFileRateProvider(path, time.Hour, 24*time.Hour)
+	if err != nil {
+		t.Fatalf("NewFileRateProvider() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		from, to string
+		want     *big.Rat
+		wantErr  error
+	}{
+		{name: "from base", from: "RUB", to: "USD", want: big.NewRat(1, 80)},
+		{name: "to base", from: "USD", to: "RUB", want: big.NewRat(80, 1)},
+		{name: "cross rate through base", from: "USD", to: "EUR", want: big.NewRat(4, 5)},
+		{name: "missing pair", from: "RUB", to: "GBP", wantErr: domain.ErrFXRateNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := provider.GetRate(context.Background(), tt.from, tt.to)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetRate() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.want != nil && got.Rate.Cmp(tt.want) != 0 {
+				t.Errorf("GetRate() rate = %s, want %s", got.Rate, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewFileRateProvider(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "malformed file", content: `{"base": `},
+		{name: "no base currency", content: `{"rates": {"USD": "0.0125"}}`},
+		{name: "invalid rate", content: `{"base": "RUB", "rates": {"USD": "-1"}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "rates.json")
+			writeRates(t, path, tt.content)
+
+			if _, err := NewFileRateProvider(path, time.Hour, 24*time.Hour); err == nil {
+				t.Error("NewFileRateProvider() error = nil, want error")
+			}
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := NewFileRateProvider(filepath.Join(t.TempDir(), "rates.json"), time.Hour, 24*time.Hour); err == nil {
+			t.Error("NewFileRateProvider() error = nil, want error")
+		}
+	})
+}
+
+func TestFileRateProvider_Refresh(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "rates.json")
+	writeRates(t, path, `{"base": "RUB", "rates": {"USD": "0.0125"}}`)
+
+	// rates are read again on every call.
+	provider, err := NewFileRateProvider(path, 0, time.Hour)
+	if err != nil {
+		t.Fatalf("NewFileRateProvider() error = %v", err)
+	}
+
+	writeRates(t, path, `{"base": "RUB", "rates": {"USD": "0.01"}}`)
+
+	got, err := provider.GetRate(context.Background(), "RUB", "USD")
+	if err != nil {
+		t.Fatalf("GetRate() error = %v", err)
+	}
+
+	if want := big.NewRat(1, 100); got.Rate.Cmp(want) != 0 {
+		t.Errorf("GetRate() rate after refresh = %s, want %s", got.Rate, want)
+	}
+
+	// rates read last are used while file is broken.
+	writeRates(t, path, `{"base": `)
+
+	got, err = provider.GetRate(context.Background(), "RUB", "USD")
+	if err != nil {
+		t.Fatalf("GetRate() with broken file error = %v", err)
+	}
+
+	if want := big.NewRat(1, 100); got.Rate.Cmp(want) != 0 {
+		t.Errorf("GetRate() rate with broken file = %s, want %s", got.Rate, want)
+	}
+
+	if provider.loadErr == nil {
+		t.Error("loadErr = nil, want error of failed refresh")
+	}
+
+	// rates older than max age are not used anymore.
+	provider.readAt = time.Now().Add(-2 * time.Hour)
+
+	if _, err := provider.GetRate(context.Background(), "RUB", "USD"); !errors.Is(err, domain.ErrFXRateNotFound) {
+		t.Errorf("GetRate() with stale rates error = %v, want %v", err, domain.ErrFXRateNotFound)
+	}
+
+	// fixed file is read again.
+	writeRates(t, path, `{"base": "RUB", "rates": {"USD": "0.02"}}`)
+
+	got, err = provider.GetRate(context.Background(), "RUB", "USD")
+	if err != nil {
+		t.Fatalf("GetRate() with fixed file error = %v", err)
+	}
+
+	if want := big.NewRat(1, 50); got.Rate.Cmp(want) != 0 {
+		t.Errorf("GetRate() rate with fixed file = %s, want %s", got.Rate, want)
+	}
+}
@@ -0,0 +1,42 @@
+package regions
+
+import (
+	"cart/internal/domain"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// regionsFile is format of local regions file, nearby regions are listed from the nearest one:
+//
+//	{"locations": {"msk-1": "central"}, "nearby": {"central": ["northwest", "volga"]}}
+type regionsFile struct {
+	Locations map[string]string   `json:"locations"`
+	Nearby    map[string][]string `json:"nearby"`
+}
+
+// LoadFile reads regions of fulfillment locations from local file, empty path is no regions,
+// so nearest_region sourcing rule prefers no location.
+func LoadFile(path string) (domain.Regions, error) {
+	if path == "" {
+		return domain.Regions{}, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return domain.Regions{}, fmt.Errorf("failed to read regions file: %w", err)
+	}
+
+	var file regionsFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return domain.Regions{}, fmt.Errorf("failed to unmarshal regions file: %w", err)
+	}
+
+	for location, region := range file.Locations {
+		if region == "" {
+			return domain.Regions{}, fmt.Errorf("regions file %s has no region of location %s", path, location)
+		}
+	}
+
+	return domain.Regions{Locations: file.Locations, Nearby: file.Nearby}, nil
+}
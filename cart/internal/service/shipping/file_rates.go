@@ -0,0 +1,75 @@
+package shipping
+
+import (
+	"cart/internal/domain"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// ratesFile is format of local shipping rates file, zones map destination regions and rows of rate tables
+// are matched in order, so specific rows go before general ones:
+//
+//	{"zones": {"central": "near"}, "rates": [{"method": "standard", "zone": "near", "maxWeight": 20000,
+//	"maxQuantity": 30, "price": {"currency": "RUB", "amount": 29900}, "minDays": 2, "maxDays": 4}]}
+type ratesFile struct {
+	Zones map[string]string `json:"zones"`
+	Rates []rateRow         `json:"rates"`
+}
+
+type rateRow struct {
+	Method      string   `json:"method"`
+	Location    string   `json:"location"`
+	Zone        string   `json:"zone"`
+	MaxWeight   int64    `json:"maxWeight"`
+	MaxQuantity int64    `json:"maxQuantity"`
+	Price       moneyRow `json:"price"`
+	MinDays     int      `json:"minDays"`
+	MaxDays     int      `json:"maxDays"`
+}
+
+type moneyRow struct {
+	Currency string `json:"currency"`
+	Amount   int64  `json:"amount"`
+}
+
+// LoadFile reads shipping rate tables from local file, empty path is no rates, so cart has no shipping options.
+func LoadFile(path string) (domain.ShippingRates, error) {
+	if path == "" {
+		return domain.ShippingRates{}, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return domain.ShippingRates{}, fmt.Errorf("failed to read shipping rates file: %w", err)
+	}
+
+	var file ratesFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return domain.ShippingRates{}, fmt.Errorf("failed to unmarshal shipping rates file: %w", err)
+	}
+
+	rates := domain.ShippingRates{
+		Zones: file.Zones,
+		Rates: make([]domain.ShippingRate, 0, len(file.Rates)),
+	}
+
+	for _, row := range file.Rates {
+		rates.Rates = append(rates.Rates, domain.ShippingRate{
+			Method:      row.Method,
+			Location:    row.Location,
+			Zone:        row.Zone,
+			MaxWeight:   row.MaxWeight,
+			MaxQuantity: row.MaxQuantity,
+			Price:       domain.Money{Currency: row.Price.Currency, Amount: row.Price.Amount},
+			MinDays:     row.MinDays,
+			MaxDays:     row.MaxDays,
+		})
+	}
+
+	if err := rates.Validate(); err != nil {
+		return domain.ShippingRates{}, fmt.Errorf("shipping rates file %s: %w", path, err)
+	}
+
+	return rates, nil
+}
@@ -40,9 +40,13 @@ func NewGRPCStockService(address string) (*grpcStockService, error) {
 	}, nil
 }
 
-func (s *grpcStockService) GetStockItemBySKU(ctx context.Context, skuID domain.SkuID) (domain.StockItemBySKU, error) {
+func (s *grpcStockService) GetStockItemBySKU(ctx context.Context, query domain.StockItemQuery) (domain.StockItemBySKU, error) {
 	req := &pb.GetStockItemRequest{
-		SkuId: uint32(skuID),
+		SkuId:         uint32(query.SkuID),
+		SellerId:      int64(query.SellerID),
+		Quantity:      query.Quantity,
+		CustomerGroup: query.CustomerGroup,
+		AllOffers:     query.AllOffers,
 	}
 
 	ctx, cancel := context.WithTimeout(ctx, grpcCallTimeOut)
@@ -54,10 +58,38 @@ func (s *grpcStockService) GetStockItemBySKU(ctx context.Context, skuID domain.S
 		return domain.StockItemBySKU{}, fmt.Errorf("failed to get stock item via GRPC: %w", err)
 	}
 
-	return domain.StockItemBySKU{
-		SKuID: domain.SkuID(req.SkuId),
-		Name:  resp.Name,
-		Price: resp.Price,
-		Count: uint16(resp.Count),
-	}, nil
+	return fromStockItemResponse(resp), nil
+}
+
+// fromStockItemResponse converts offer with offers of sku in other locations.
+func fromStockItemResponse(resp *pb.StockItemResponse) domain.StockItemBySKU {
+	var restockAt time.Time
+	if resp.GetBackorder().GetRestockAt() != nil {
+		restockAt = resp.GetBackorder().GetRestockAt().AsTime()
+	}
+
+	stockItem := domain.StockItemBySKU{
+		SKuID:           domain.SkuID(resp.SkuId),
+		Name:            resp.Name,
+		Price:           domain.Money{Currency: resp.GetPrice().GetCurrency(), Amount: resp.GetPrice().GetAmount()},
+		ListPrice:       domain.Money{Currency: resp.GetListPrice().GetCurrency(), Amount: resp.GetListPrice().GetAmount()},
+		TierMinQuantity: resp.GetPriceTier().GetMinQuantity(),
+		Count:           resp.Count,
+		SellerID:        domain.UserID(resp.SellerId),
+		Location:        resp.Location,
+		VariantGroupID:  resp.VariantGroupId,
+		Attributes:      resp.Attributes.AsMap(),
+		Unit:            resp.Unit,
+		Backorder: domain.BackorderPolicy{
+			Mode:      resp.GetBackorder().GetMode(),
+			RestockAt: restockAt,
+		},
+		AvailableToOrder: resp.AvailableToOrder,
+	}
+
+	for _, offer := range resp.Offers {
+		stockItem.Offers = append(stockItem.Offers, fromStockItemResponse(offer))
+	}
+
+	return stockItem
 }
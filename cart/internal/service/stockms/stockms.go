@@ -21,11 +21,44 @@ type stockService struct {
 
 var _ carts.StockService = (*stockService)(nil)
 
+// int64 fields are encoded as strings by gateway.
 type stockItemResponse struct {
-	SkuID uint32 `json:"sku"`
-	Name  string `json:"name"`
-	Price uint32 `json:"price"`
-	Count uint16 `json:"count"`
+	SkuID            uint32              `json:"sku"`
+	Name             string              `json:"name"`
+	Price            moneyResponse       `json:"price"`
+	ListPrice        moneyResponse       `json:"listPrice"`
+	PriceTier        priceTierResponse   `json:"priceTier"`
+	Count            int64               `json:"count,string"`
+	SellerID         int64               `json:"sellerId,string"`
+	Location         string              `json:"location"`
+	Offers           []stockItemResponse `json:"offers"`
+	VariantGroupID   int64               `json:"variantGroupId,string"`
+	Attributes       map[string]any      `json:"attributes"`
+	Unit             string              `json:"unit"`
+	Backorder        backorderResponse   `json:"backorder"`
+	AvailableToOrder int64               `json:"availableToOrder,string"`
+}
+
+type moneyResponse struct {
+	Currency string `json:"currency"`
+	Amount   int64  `json:"amount,string"`
+}
+
+type priceTierResponse struct {
+	MinQuantity int64 `json:"minQuantity,string"`
+}
+
+type backorderResponse struct {
+	Mode      string    `json:"mode"`
+	RestockAt time.Time `json:"restockAt"`
+}
+
+type getStockItemRequest struct {
+	SkuID         uint32 `json:"skuId"`
+	SellerID      int64  `json:"sellerId,string,omitempty"`
+	Quantity      int64  `json:"quantity,string,omitempty"`
+	CustomerGroup string `json:"customerGroup,omitempty"`
+	AllOffers     bool   `json:"allOffers,omitempty"`
 }
 
 func NewHTTPStockService(baseURL string) *stockService {
@@ -37,8 +70,14 @@ func NewHTTPStockService(baseURL string) *stockService {
 	}
 }
 
-func (s *stockService) GetStockItemBySKU(ctx context.Context, skuID domain.SkuID) (domain.StockItemBySKU, error) {
-	reqBody := map[string]uint32{"skuID": uint32(skuID)}
+func (s *stockService) GetStockItemBySKU(ctx context.Context, query domain.StockItemQuery) (domain.StockItemBySKU, error) {
+	reqBody := getStockItemRequest{
+		SkuID:         uint32(query.SkuID),
+		SellerID:      int64(query.SellerID),
+		Quantity:      query.Quantity,
+		CustomerGroup: query.CustomerGroup,
+		AllOffers:     query.AllOffers,
+	}
 
 	jsonBody, err := json.Marshal(reqBody)
 	if err != nil {
@@ -72,10 +111,33 @@ func (s *stockService) GetStockItemBySKU(ctx context.Context, skuID domain.SkuID
 		return domain.StockItemBySKU{}, fmt.Errorf("failed to unmarshal stockItem: %w", err)
 	}
 
-	return domain.StockItemBySKU{
-		SKuID: domain.SkuID(stockItem.SkuID),
-		Name:  stockItem.Name,
-		Price: stockItem.Price,
-		Count: stockItem.Count,
-	}, nil
+	return stockItem.ToDomain(), nil
+}
+
+// ToDomain converts offer with offers of sku in other locations.
+func (r stockItemResponse) ToDomain() domain.StockItemBySKU {
+	stockItem := domain.StockItemBySKU{
+		SKuID:           domain.SkuID(r.SkuID),
+		Name:            r.Name,
+		Price:           domain.Money{Currency: r.Price.Currency, Amount: r.Price.Amount},
+		ListPrice:       domain.Money{Currency: r.ListPrice.Currency, Amount: r.ListPrice.Amount},
+		TierMinQuantity: r.PriceTier.MinQuantity,
+		Count:           r.Count,
+		SellerID:        domain.UserID(r.SellerID),
+		Location:        r.Location,
+		VariantGroupID:  r.VariantGroupID,
+		Attributes:      r.Attributes,
+		Unit:            r.Unit,
+		Backorder: domain.BackorderPolicy{
+			Mode:      r.Backorder.Mode,
+			RestockAt: r.Backorder.RestockAt,
+		},
+		AvailableToOrder: r.AvailableToOrder,
+	}
+
+	for _, offer := range r.Offers {
+		stockItem.Offers = append(stockItem.Offers, offer.ToDomain())
+	}
+
+	return stockItem
 }
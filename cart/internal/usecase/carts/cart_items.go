@@ -7,6 +7,9 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
+	"strings"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -17,13 +20,20 @@ import (
 type (
 	// StockService interface represent stock service buisiness logic.
 	StockService interface {
-		GetStockItemBySKU(ctx context.Context, skuID domain.SkuID) (domain.StockItemBySKU, error)
+		// GetStockItemBySKU returns offer of seller, the best offer of sku when seller is zero,
+		// priced for quantity and customer group of query.
+		GetStockItemBySKU(ctx context.Context, query domain.StockItemQuery) (domain.StockItemBySKU, error)
+	}
+	// FXRateProvider interface represent source of exchange rates between currencies.
+	FXRateProvider interface {
+		// GetRate returns rate of currency from to currency to, domain.ErrFXRateNotFound when it is unknown.
+		GetRate(ctx context.Context, from, to string) (domain.FXRate, error)
 	}
 	// CartItemRepository interface represent cart items repository logic.
 	CartItemRepository interface {
 		SaveOrUpdateCartItem(ctx context.Context, cartItem domain.CartItem) error
 		//UpdateCartItem(ctx context.Context, cartItem domain.CartItem) error
-		RemoveCartItem(ctx context.Context, userID domain.UserID, skuID domain.SkuID) error
+		RemoveCartItem(ctx context.Context, userID domain.UserID, skuID domain.SkuID, sellerID domain.UserID) error
 		RemoveAllCartItems(ctx context.Context, userID domain.UserID) error
 		GetCartItemByUserID(ctx context.Context, userID domain.UserID, skuID domain.SkuID) (domain.CartItem, error)
 		ListCartItemsByUserID(ctx context.Context, userID domain.UserID) ([]domain.CartItem, error)
@@ -33,7 +43,10 @@ type (
 type cartServiceUseCase struct {
 	StockService
 	CartItemRepository
+	FXRateProvider
 	KafkaProducer kafka.CartEventProducer
+	sourcing      domain.SourcingPolicy
+	shippingRates domain.ShippingRates
 }
 
 var _ usecase.CartItemUseCase = (*cartServiceUseCase)(nil)
@@ -41,12 +54,18 @@ var _ usecase.CartItemUseCase = (*cartServiceUseCase)(nil)
 func NewCartServiceUseCase(
 	stockService StockService,
 	cartItemRepo CartItemRepository,
+	fxRateProvider FXRateProvider,
 	kafkaProducer kafka.CartEventProducer,
+	sourcing domain.SourcingPolicy,
+	shippingRates domain.ShippingRates,
 ) *cartServiceUseCase {
 	return &cartServiceUseCase{
 		StockService:       stockService,
 		CartItemRepository: cartItemRepo,
+		FXRateProvider:     fxRateProvider,
 		KafkaProducer:      kafkaProducer,
+		sourcing:           sourcing,
+		shippingRates:      shippingRates,
 	}
 }
 
@@ -57,15 +76,24 @@ func (u *cartServiceUseCase) AddCartItem(ctx context.Context, cartItem domain.Ca
 	span.SetAttributes(
 		attribute.String("user_id", fmt.Sprintf("%d", cartItem.UserID)),
 		attribute.String("sku_id", fmt.Sprintf("%d", cartItem.SkuID)),
-		attribute.Int64("count", int64(cartItem.Count)),
+		attribute.Int64("count", cartItem.Count),
+		attribute.String("seller_id", fmt.Sprintf("%d", cartItem.SellerID)),
 	)
 
-	stockItemBySKU, err := u.GetStockItemBySKU(ctx, cartItem.SkuID)
+	stockItemBySKU, err := u.GetStockItemBySKU(ctx, domain.StockItemQuery{
+		SkuID:     cartItem.SkuID,
+		SellerID:  cartItem.SellerID,
+		Quantity:  cartItem.Count,
+		AllOffers: true,
+	})
 	if err != nil {
 		span.SetAttributes(attribute.String("error.message", err.Error()))
 		return err
 	}
 
+	// line holds offer it was checked against, so the best offer is pinned to its seller.
+	cartItem.SellerID = stockItemBySKU.SellerID
+
 	// prepare cart item addedpayload for producing event.
 	payload := kafka.CartItemAddedPayload{
 		CartID: fmt.Sprintf("%d", cartItem.UserID), // assuming userID is cartID.
@@ -74,7 +102,9 @@ func (u *cartServiceUseCase) AddCartItem(ctx context.Context, cartItem domain.Ca
 		Status: "success",
 	}
 
-	if cartItem.Count > stockItemBySKU.Count {
+	// backordered and preordered lines are accepted within what offer allows to order,
+	// in any location of seller, location line ships from is picked when cart is listed.
+	if cartItem.Count > stockItemBySKU.MaxAvailableToOrder() {
 		u.KafkaProducer.ProduceCartItemFailed(ctx, kafka.CartItemFailedPayload{
 			CartID: fmt.Sprintf("%d", cartItem.UserID),
 			SKU:    uint32(cartItem.SkuID),
@@ -93,16 +123,17 @@ func (u *cartServiceUseCase) AddCartItem(ctx context.Context, cartItem domain.Ca
 	return u.SaveOrUpdateCartItem(ctx, cartItem)
 }
 
-func (u *cartServiceUseCase) DeleteCartItem(ctx context.Context, userID domain.UserID, skuID domain.SkuID) error {
+func (u *cartServiceUseCase) DeleteCartItem(ctx context.Context, userID domain.UserID, skuID domain.SkuID, sellerID domain.UserID) error {
 	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "CartServiceUseCase.DeleteCartItem")
 	defer span.End()
 
 	span.SetAttributes(
 		attribute.String("user_id", fmt.Sprintf("%d", userID)),
 		attribute.String("sku_id", fmt.Sprintf("%d", skuID)),
+		attribute.String("seller_id", fmt.Sprintf("%d", sellerID)),
 	)
 
-	err := u.RemoveCartItem(ctx, userID, skuID)
+	err := u.RemoveCartItem(ctx, userID, skuID, sellerID)
 	if err != nil {
 		span.SetAttributes(attribute.String("error.message", err.Error()))
 		return err
@@ -128,41 +159,200 @@ func (u *cartServiceUseCase) ClearCartItems(ctx context.Context, userID domain.U
 	return nil
 }
 
-func (u *cartServiceUseCase) ListCartItems(ctx context.Context, userID domain.UserID) (domain.ListCartItems, error) {
+// ListCartItems returns items of cart with totals per currency, amounts are also converted
+// to display currency of query unless it is empty. Every line ships from location sourcing policy
+// picks among offers of its seller and is priced at price tier of its count and customer group,
+// shipments are priced by shipping rate tables for destination region.
+func (u *cartServiceUseCase) ListCartItems(ctx context.Context, query domain.ListCartItemsQuery) (domain.ListCartItems, error) {
 	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "CartServiceUseCase.ListCartItems")
 	defer span.End()
 
 	span.SetAttributes(
-		attribute.String("user_id", fmt.Sprintf("%d", userID)),
+		attribute.String("user_id", fmt.Sprintf("%d", query.UserID)),
+		attribute.String("display_currency", query.DisplayCurrency),
+		attribute.String("customer_group", query.CustomerGroup),
+		attribute.String("region", query.Region),
 	)
 
 	var listCartItemsResponse domain.ListCartItems
-	var totalPrice uint32
 
-	listCartItems, err := u.ListCartItemsByUserID(ctx, userID)
+	listCartItems, err := u.ListCartItemsByUserID(ctx, query.UserID)
 	if err != nil {
 		span.SetAttributes(attribute.String("error.message", err.Error()))
 		return domain.ListCartItems{}, err
 	}
 	// call service...
-	stockItems := make([]domain.StockItemBySKU, 0, len(listCartItems))
+	lines := make([]domain.SourcingLine, 0, len(listCartItems))
 
 	for _, listCartItem := range listCartItems {
-		stockItem, err := u.GetStockItemBySKU(ctx, listCartItem.SkuID)
+		stockItem, err := u.GetStockItemBySKU(ctx, domain.StockItemQuery{
+			SkuID:         listCartItem.SkuID,
+			SellerID:      listCartItem.SellerID,
+			Quantity:      listCartItem.Count,
+			CustomerGroup: query.CustomerGroup,
+			AllOffers:     true,
+		})
 		if err != nil {
 			span.SetAttributes(attribute.String("error.message", err.Error()))
 			continue
 		}
 
-		stockItems = append(stockItems, stockItem)
+		offers := stockItem.Offers
+		if len(offers) == 0 {
+			offers = []domain.StockItemBySKU{stockItem}
+		}
+
+		lines = append(lines, domain.SourcingLine{Count: listCartItem.Count, Offers: offers})
 	}
 
-	for _, stockItem := range stockItems {
-		totalPrice += stockItem.Price * uint32(stockItem.Count)
+	stockItems := make([]domain.StockItemBySKU, 0, len(lines))
+	totals := make(map[string]domain.Money)
+
+	for i, offer := range u.sourcing.Source(lines, query.Region) {
+		stockItem := lines[i].Offers[offer]
+		stockItem.Offers = nil
+
+		stockItem.Availability, stockItem.ExpectedAt = stockItem.AvailabilityOf(lines[i].Count)
+		stockItem.Count = lines[i].Count
+
+		// every line is rounded to minor unit of its currency before it is added to total.
+		stockItem.Total, err = stockItem.Price.ForQuantity(stockItem.Count, stockItem.Unit)
+		if err != nil {
+			span.SetAttributes(attribute.String("error.message", err.Error()))
+			return domain.ListCartItems{}, err
+		}
+
+		total, ok := totals[stockItem.Total.Currency]
+		if !ok {
+			total = domain.Money{Currency: stockItem.Total.Currency}
+		}
+
+		totals[stockItem.Total.Currency], err = total.Add(stockItem.Total)
+		if err != nil {
+			span.SetAttributes(attribute.String("error.message", err.Error()))
+			return domain.ListCartItems{}, err
+		}
+
+		stockItems = append(stockItems, stockItem)
 	}
 
 	listCartItemsResponse.Items = stockItems
-	listCartItemsResponse.TotalPrice = totalPrice
+	listCartItemsResponse.Shipments = u.sourcing.Regions.ShipmentsOf(stockItems)
+	listCartItemsResponse.SplitShipment = len(listCartItemsResponse.Shipments) > 1
+
+	listCartItemsResponse.ShippingOptions, err = u.shippingRates.OptionsOf(stockItems, query.Region, time.Now())
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.ListCartItems{}, err
+	}
+	listCartItemsResponse.Totals = make([]domain.Money, 0, len(totals))
+
+	for _, total := range totals {
+		listCartItemsResponse.Totals = append(listCartItemsResponse.Totals, total)
+	}
+
+	slices.SortFunc(listCartItemsResponse.Totals, func(a, b domain.Money) int {
+		return strings.Compare(a.Currency, b.Currency)
+	})
+
+	if query.DisplayCurrency != "" {
+		err = u.convertCartItems(ctx, &listCartItemsResponse, query.DisplayCurrency)
+		if err != nil {
+			span.SetAttributes(attribute.String("error.message", err.Error()))
+			return domain.ListCartItems{}, err
+		}
+	}
 
 	return listCartItemsResponse, nil
 }
+
+// convertCartItems sets display amounts of cart items, display total of cart and display prices of its
+// shipping options. Every line total is converted from its rounded original total, so display total is
+// the sum of display totals shown.
+func (u *cartServiceUseCase) convertCartItems(
+	ctx context.Context,
+	listCartItems *domain.ListCartItems,
+	displayCurrency string,
+) error {
+	rates := make(map[string]domain.FXRate)
+	listCartItems.DisplayTotal = domain.Money{Currency: displayCurrency}
+
+	rateOf := func(currency string) (domain.FXRate, error) {
+		rate, ok := rates[currency]
+		if ok {
+			return rate, nil
+		}
+
+		rate, err := u.GetRate(ctx, currency, displayCurrency)
+		if err != nil {
+			return domain.FXRate{}, err
+		}
+
+		rates[currency] = rate
+
+		if listCartItems.RatesUpdatedAt.IsZero() || rate.UpdatedAt.Before(listCartItems.RatesUpdatedAt) {
+			listCartItems.RatesUpdatedAt = rate.UpdatedAt
+		}
+
+		return rate, nil
+	}
+
+	for i := range listCartItems.Items {
+		item := &listCartItems.Items[i]
+		item.DisplayPrice, item.DisplayTotal = item.Price, item.Total
+
+		if item.Price.Currency != displayCurrency {
+			rate, err := rateOf(item.Price.Currency)
+			if err != nil {
+				return err
+			}
+
+			if err := convertCartItem(item, rate); err != nil {
+				return err
+			}
+		}
+
+		displayTotal, err := listCartItems.DisplayTotal.Add(item.DisplayTotal)
+		if err != nil {
+			return err
+		}
+
+		listCartItems.DisplayTotal = displayTotal
+	}
+
+	// shipping is chosen at checkout, so prices of options are converted but not added to display total.
+	for i := range listCartItems.ShippingOptions {
+		option := &listCartItems.ShippingOptions[i]
+		option.DisplayPrice = option.Price
+
+		if option.Price.Currency != displayCurrency {
+			rate, err := rateOf(option.Price.Currency)
+			if err != nil {
+				return err
+			}
+
+			option.DisplayPrice, err = option.Price.Convert(rate)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func convertCartItem(item *domain.StockItemBySKU, rate domain.FXRate) error {
+	displayPrice, err := item.Price.Convert(rate)
+	if err != nil {
+		return err
+	}
+
+	displayTotal, err := item.Total.Convert(rate)
+	if err != nil {
+		return err
+	}
+
+	item.DisplayPrice, item.DisplayTotal, item.RateUpdatedAt = displayPrice, displayTotal, rate.UpdatedAt
+
+	return nil
+}
@@ -38,9 +38,9 @@ type CartItemRepositoryMock struct {
 	beforeRemoveAllCartItemsCounter uint64
 	RemoveAllCartItemsMock          mCartItemRepositoryMockRemoveAllCartItems
 
-	funcRemoveCartItem          func(ctx context.Context, userID domain.UserID, skuID domain.SkuID) (err error)
+	funcRemoveCartItem          func(ctx context.Context, userID domain.UserID, skuID domain.SkuID, sellerID domain.UserID) (err error)
 	funcRemoveCartItemOrigin    string
-	inspectFuncRemoveCartItem   func(ctx context.Context, userID domain.UserID, skuID domain.SkuID)
+	inspectFuncRemoveCartItem   func(ctx context.Context, userID domain.UserID, skuID domain.SkuID, sellerID domain.UserID)
 	afterRemoveCartItemCounter  uint64
 	beforeRemoveCartItemCounter uint64
 	RemoveCartItemMock          mCartItemRepositoryMockRemoveCartItem
@@ -1166,16 +1166,18 @@ type CartItemRepositoryMockRemoveCartItemExpectation struct {
 
 // CartItemRepositoryMockRemoveCartItemParams contains parameters of the CartItemRepository.RemoveCartItem
 type CartItemRepositoryMockRemoveCartItemParams struct {
-	ctx    context.Context
-	userID domain.UserID
-	skuID  domain.SkuID
+	ctx      context.Context
+	userID   domain.UserID
+	skuID    domain.SkuID
+	sellerID domain.UserID
 }
 
 // CartItemRepositoryMockRemoveCartItemParamPtrs contains pointers to parameters of the CartItemRepository.RemoveCartItem
 type CartItemRepositoryMockRemoveCartItemParamPtrs struct {
-	ctx    *context.Context
-	userID *domain.UserID
-	skuID  *domain.SkuID
+	ctx      *context.Context
+	userID   *domain.UserID
+	skuID    *domain.SkuID
+	sellerID *domain.UserID
 }
 
 // CartItemRepositoryMockRemoveCartItemResults contains results of the CartItemRepository.RemoveCartItem
@@ -1185,10 +1187,11 @@ type CartItemRepositoryMockRemoveCartItemResults struct {
 
 // CartItemRepositoryMockRemoveCartItemOrigins contains origins of expectations of the CartItemRepository.RemoveCartItem
 type CartItemRepositoryMockRemoveCartItemExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originUserID string
-	originSkuID  string
+	origin         string
+	originCtx      string
+	originUserID   string
+	originSkuID    string
+	originSellerID string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1202,7 +1205,7 @@ func (mmRemoveCartItem *mCartItemRepositoryMockRemoveCartItem) Optional() *mCart
 }
 
 // Expect sets up expected params for CartItemRepository.RemoveCartItem
-func (mmRemoveCartItem *mCartItemRepositoryMockRemoveCartItem) Expect(ctx context.Context, userID domain.UserID, skuID domain.SkuID) *mCartItemRepositoryMockRemoveCartItem {
+func (mmRemoveCartItem *mCartItemRepositoryMockRemoveCartItem) Expect(ctx context.Context, userID domain.UserID, skuID domain.SkuID, sellerID domain.UserID) *mCartItemRepositoryMockRemoveCartItem {
 	if mmRemoveCartItem.mock.funcRemoveCartItem != nil {
 		mmRemoveCartItem.mock.t.Fatalf("CartItemRepositoryMock.RemoveCartItem mock is already set by Set")
 	}
@@ -1215,7 +1218,7 @@ func (mmRemoveCartItem *mCartItemRepositoryMockRemoveCartItem) Expect(ctx contex
 		mmRemoveCartItem.mock.t.Fatalf("CartItemRepositoryMock.RemoveCartItem mock is already set by ExpectParams functions")
 	}
 
-	mmRemoveCartItem.defaultExpectation.params = &CartItemRepositoryMockRemoveCartItemParams{ctx, userID, skuID}
+	mmRemoveCartItem.defaultExpectation.params = &CartItemRepositoryMockRemoveCartItemParams{ctx, userID, skuID, sellerID}
 	mmRemoveCartItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmRemoveCartItem.expectations {
 		if minimock.Equal(e.params, mmRemoveCartItem.defaultExpectation.params) {
@@ -1295,8 +1298,31 @@ func (mmRemoveCartItem *mCartItemRepositoryMockRemoveCartItem) ExpectSkuIDParam3
 	return mmRemoveCartItem
 }
 
+// ExpectSellerIDParam4 sets up expected param sellerID for CartItemRepository.RemoveCartItem
+func (mmRemoveCartItem *mCartItemRepositoryMockRemoveCartItem) ExpectSellerIDParam4(sellerID domain.UserID) *mCartItemRepositoryMockRemoveCartItem {
+	if mmRemoveCartItem.mock.funcRemoveCartItem != nil {
+		mmRemoveCartItem.mock.t.Fatalf("CartItemRepositoryMock.RemoveCartItem mock is already set by Set")
+	}
+
+	if mmRemoveCartItem.defaultExpectation == nil {
+		mmRemoveCartItem.defaultExpectation = &CartItemRepositoryMockRemoveCartItemExpectation{}
+	}
+
+	if mmRemoveCartItem.defaultExpectation.params != nil {
+		mmRemoveCartItem.mock.t.Fatalf("CartItemRepositoryMock.RemoveCartItem mock is already set by Expect")
+	}
+
+	if mmRemoveCartItem.defaultExpectation.paramPtrs == nil {
+		mmRemoveCartItem.defaultExpectation.paramPtrs = &CartItemRepositoryMockRemoveCartItemParamPtrs{}
+	}
+	mmRemoveCartItem.defaultExpectation.paramPtrs.sellerID = &sellerID
+	mmRemoveCartItem.defaultExpectation.expectationOrigins.originSellerID = minimock.CallerInfo(1)
+
+	return mmRemoveCartItem
+}
+
 // Inspect accepts an inspector function that has same arguments as the CartItemRepository.RemoveCartItem
-func (mmRemoveCartItem *mCartItemRepositoryMockRemoveCartItem) Inspect(f func(ctx context.Context, userID domain.UserID, skuID domain.SkuID)) *mCartItemRepositoryMockRemoveCartItem {
+func (mmRemoveCartItem *mCartItemRepositoryMockRemoveCartItem) Inspect(f func(ctx context.Context, userID domain.UserID, skuID domain.SkuID, sellerID domain.UserID)) *mCartItemRepositoryMockRemoveCartItem {
 	if mmRemoveCartItem.mock.inspectFuncRemoveCartItem != nil {
 		mmRemoveCartItem.mock.t.Fatalf("Inspect function is already set for CartItemRepositoryMock.RemoveCartItem")
 	}
@@ -1321,7 +1347,7 @@ func (mmRemoveCartItem *mCartItemRepositoryMockRemoveCartItem) Return(err error)
 }
 
 // Set uses given function f to mock the CartItemRepository.RemoveCartItem method
-func (mmRemoveCartItem *mCartItemRepositoryMockRemoveCartItem) Set(f func(ctx context.Context, userID domain.UserID, skuID domain.SkuID) (err error)) *CartItemRepositoryMock {
+func (mmRemoveCartItem *mCartItemRepositoryMockRemoveCartItem) Set(f func(ctx context.Context, userID domain.UserID, skuID domain.SkuID, sellerID domain.UserID) (err error)) *CartItemRepositoryMock {
 	if mmRemoveCartItem.defaultExpectation != nil {
 		mmRemoveCartItem.mock.t.Fatalf("Default expectation is already set for the CartItemRepository.RemoveCartItem method")
 	}
@@ -1337,14 +1363,14 @@ func (mmRemoveCartItem *mCartItemRepositoryMockRemoveCartItem) Set(f func(ctx co
 
 // When sets expectation for the CartItemRepository.RemoveCartItem which will trigger the result defined by the following
 // Then helper
-func (mmRemoveCartItem *mCartItemRepositoryMockRemoveCartItem) When(ctx context.Context, userID domain.UserID, skuID domain.SkuID) *CartItemRepositoryMockRemoveCartItemExpectation {
+func (mmRemoveCartItem *mCartItemRepositoryMockRemoveCartItem) When(ctx context.Context, userID domain.UserID, skuID domain.SkuID, sellerID domain.UserID) *CartItemRepositoryMockRemoveCartItemExpectation {
 	if mmRemoveCartItem.mock.funcRemoveCartItem != nil {
 		mmRemoveCartItem.mock.t.Fatalf("CartItemRepositoryMock.RemoveCartItem mock is already set by Set")
 	}
 
 	expectation := &CartItemRepositoryMockRemoveCartItemExpectation{
 		mock:               mmRemoveCartItem.mock,
-		params:             &CartItemRepositoryMockRemoveCartItemParams{ctx, userID, skuID},
+		params:             &CartItemRepositoryMockRemoveCartItemParams{ctx, userID, skuID, sellerID},
 		expectationOrigins: CartItemRepositoryMockRemoveCartItemExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmRemoveCartItem.expectations = append(mmRemoveCartItem.expectations, expectation)
@@ -1379,17 +1405,17 @@ func (mmRemoveCartItem *mCartItemRepositoryMockRemoveCartItem) invocationsDone()
 }
 
 // RemoveCartItem implements mm_carts.CartItemRepository
-func (mmRemoveCartItem *CartItemRepositoryMock) RemoveCartItem(ctx context.Context, userID domain.UserID, skuID domain.SkuID) (err error) {
+func (mmRemoveCartItem *CartItemRepositoryMock) RemoveCartItem(ctx context.Context, userID domain.UserID, skuID domain.SkuID, sellerID domain.UserID) (err error) {
 	mm_atomic.AddUint64(&mmRemoveCartItem.beforeRemoveCartItemCounter, 1)
 	defer mm_atomic.AddUint64(&mmRemoveCartItem.afterRemoveCartItemCounter, 1)
 
 	mmRemoveCartItem.t.Helper()
 
 	if mmRemoveCartItem.inspectFuncRemoveCartItem != nil {
-		mmRemoveCartItem.inspectFuncRemoveCartItem(ctx, userID, skuID)
+		mmRemoveCartItem.inspectFuncRemoveCartItem(ctx, userID, skuID, sellerID)
 	}
 
-	mm_params := CartItemRepositoryMockRemoveCartItemParams{ctx, userID, skuID}
+	mm_params := CartItemRepositoryMockRemoveCartItemParams{ctx, userID, skuID, sellerID}
 
 	// Record call args
 	mmRemoveCartItem.RemoveCartItemMock.mutex.Lock()
@@ -1408,7 +1434,7 @@ func (mmRemoveCartItem *CartItemRepositoryMock) RemoveCartItem(ctx context.Conte
 		mm_want := mmRemoveCartItem.RemoveCartItemMock.defaultExpectation.params
 		mm_want_ptrs := mmRemoveCartItem.RemoveCartItemMock.defaultExpectation.paramPtrs
 
-		mm_got := CartItemRepositoryMockRemoveCartItemParams{ctx, userID, skuID}
+		mm_got := CartItemRepositoryMockRemoveCartItemParams{ctx, userID, skuID, sellerID}
 
 		if mm_want_ptrs != nil {
 
@@ -1427,6 +1453,11 @@ func (mmRemoveCartItem *CartItemRepositoryMock) RemoveCartItem(ctx context.Conte
 					mmRemoveCartItem.RemoveCartItemMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
 			}
 
+			if mm_want_ptrs.sellerID != nil && !minimock.Equal(*mm_want_ptrs.sellerID, mm_got.sellerID) {
+				mmRemoveCartItem.t.Errorf("CartItemRepositoryMock.RemoveCartItem got unexpected parameter sellerID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveCartItem.RemoveCartItemMock.defaultExpectation.expectationOrigins.originSellerID, *mm_want_ptrs.sellerID, mm_got.sellerID, minimock.Diff(*mm_want_ptrs.sellerID, mm_got.sellerID))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmRemoveCartItem.t.Errorf("CartItemRepositoryMock.RemoveCartItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmRemoveCartItem.RemoveCartItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -1439,9 +1470,9 @@ func (mmRemoveCartItem *CartItemRepositoryMock) RemoveCartItem(ctx context.Conte
 		return (*mm_results).err
 	}
 	if mmRemoveCartItem.funcRemoveCartItem != nil {
-		return mmRemoveCartItem.funcRemoveCartItem(ctx, userID, skuID)
+		return mmRemoveCartItem.funcRemoveCartItem(ctx, userID, skuID, sellerID)
 	}
-	mmRemoveCartItem.t.Fatalf("Unexpected call to CartItemRepositoryMock.RemoveCartItem. %v %v %v", ctx, userID, skuID)
+	mmRemoveCartItem.t.Fatalf("Unexpected call to CartItemRepositoryMock.RemoveCartItem. %v %v %v %v", ctx, userID, skuID, sellerID)
 	return
 }
 
@@ -0,0 +1,447 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mock
+
+import (
+	"cart/internal/domain"
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// FXRateProviderMock implements mm_carts.FXRateProvider
+type FXRateProviderMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetRate          func(ctx context.Context, from string, to string) (f1 domain.FXRate, err error)
+	funcGetRateOrigin    string
+	inspectFuncGetRate   func(ctx context.Context, from string, to string)
+	afterGetRateCounter  uint64
+	beforeGetRateCounter uint64
+	GetRateMock          mFXRateProviderMockGetRate
+}
+
+// NewFXRateProviderMock returns a mock for mm_carts.FXRateProvider
+func NewFXRateProviderMock(t minimock.Tester) *FXRateProviderMock {
+	m := &FXRateProviderMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetRateMock = mFXRateProviderMockGetRate{mock: m}
+	m.GetRateMock.callArgs = []*FXRateProviderMockGetRateParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mFXRateProviderMockGetRate struct {
+	optional           bool
+	mock               *FXRateProviderMock
+	defaultExpectation *FXRateProviderMockGetRateExpectation
+	expectations       []*FXRateProviderMockGetRateExpectation
+
+	callArgs []*FXRateProviderMockGetRateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// FXRateProviderMockGetRateExpectation specifies expectation struct of the FXRateProvider.GetRate
+type FXRateProviderMockGetRateExpectation struct {
+	mock               *FXRateProviderMock
+	params             *FXRateProviderMockGetRateParams
+	paramPtrs          *FXRateProviderMockGetRateParamPtrs
+	expectationOrigins FXRateProviderMockGetRateExpectationOrigins
+	results            *FXRateProviderMockGetRateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// FXRateProviderMockGetRateParams contains parameters of the FXRateProvider.GetRate
+type FXRateProviderMockGetRateParams struct {
+	ctx  context.Context
+	from string
+	to   string
+}
+
+// FXRateProviderMockGetRateParamPtrs contains pointers to parameters of the FXRateProvider.GetRate
+type FXRateProviderMockGetRateParamPtrs struct {
+	ctx  *context.Context
+	from *string
+	to   *string
+}
+
+// FXRateProviderMockGetRateResults contains results of the FXRateProvider.GetRate
+type FXRateProviderMockGetRateResults struct {
+	f1  domain.FXRate
+	err error
+}
+
+// FXRateProviderMockGetRateOrigins contains origins of expectations of the FXRateProvider.GetRate
+type FXRateProviderMockGetRateExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originFrom string
+	originTo   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetRate *mFXRateProviderMockGetRate) Optional() *mFXRateProviderMockGetRate {
+	mmGetRate.optional = true
+	return mmGetRate
+}
+
+// Expect sets up expected params for FXRateProvider.GetRate
+func (mmGetRate *mFXRateProviderMockGetRate) Expect(ctx context.Context, from string, to string) *mFXRateProviderMockGetRate {
+	if mmGetRate.mock.funcGetRate != nil {
+		mmGetRate.mock.t.Fatalf("FXRateProviderMock.GetRate mock is already set by Set")
+	}
+
+	if mmGetRate.defaultExpectation == nil {
+		mmGetRate.defaultExpectation = &FXRateProviderMockGetRateExpectation{}
+	}
+
+	if mmGetRate.defaultExpectation.paramPtrs != nil {
+		mmGetRate.mock.t.Fatalf("FXRateProviderMock.GetRate mock is already set by ExpectParams functions")
+	}
+
+	mmGetRate.defaultExpectation.params = &FXRateProviderMockGetRateParams{ctx, from, to}
+	mmGetRate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetRate.expectations {
+		if minimock.Equal(e.params, mmGetRate.defaultExpectation.params) {
+			mmGetRate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRate.defaultExpectation.params)
+		}
+	}
+
+	return mmGetRate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for FXRateProvider.GetRate
+func (mmGetRate *mFXRateProviderMockGetRate) ExpectCtxParam1(ctx context.Context) *mFXRateProviderMockGetRate {
+	if mmGetRate.mock.funcGetRate != nil {
+		mmGetRate.mock.t.Fatalf("FXRateProviderMock.GetRate mock is already set by Set")
+	}
+
+	if mmGetRate.defaultExpectation == nil {
+		mmGetRate.defaultExpectation = &FXRateProviderMockGetRateExpectation{}
+	}
+
+	if mmGetRate.defaultExpectation.params != nil {
+		mmGetRate.mock.t.Fatalf("FXRateProviderMock.GetRate mock is already set by Expect")
+	}
+
+	if mmGetRate.defaultExpectation.paramPtrs == nil {
+		mmGetRate.defaultExpectation.paramPtrs = &FXRateProviderMockGetRateParamPtrs{}
+	}
+	mmGetRate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetRate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetRate
+}
+
+// ExpectFromParam2 sets up expected param from for FXRateProvider.GetRate
+func (mmGetRate *mFXRateProviderMockGetRate) ExpectFromParam2(from string) *mFXRateProviderMockGetRate {
+	if mmGetRate.mock.funcGetRate != nil {
+		mmGetRate.mock.t.Fatalf("FXRateProviderMock.GetRate mock is already set by Set")
+	}
+
+	if mmGetRate.defaultExpectation == nil {
+		mmGetRate.defaultExpectation = &FXRateProviderMockGetRateExpectation{}
+	}
+
+	if mmGetRate.defaultExpectation.params != nil {
+		mmGetRate.mock.t.Fatalf("FXRateProviderMock.GetRate mock is already set by Expect")
+	}
+
+	if mmGetRate.defaultExpectation.paramPtrs == nil {
+		mmGetRate.defaultExpectation.paramPtrs = &FXRateProviderMockGetRateParamPtrs{}
+	}
+	mmGetRate.defaultExpectation.paramPtrs.from = &from
+	mmGetRate.defaultExpectation.expectationOrigins.originFrom = minimock.CallerInfo(1)
+
+	return mmGetRate
+}
+
+// ExpectToParam3 sets up expected param to for FXRateProvider.GetRate
+func (mmGetRate *mFXRateProviderMockGetRate) ExpectToParam3(to string) *mFXRateProviderMockGetRate {
+	if mmGetRate.mock.funcGetRate != nil {
+		mmGetRate.mock.t.Fatalf("FXRateProviderMock.GetRate mock is already set by Set")
+	}
+
+	if mmGetRate.defaultExpectation == nil {
+		mmGetRate.defaultExpectation = &FXRateProviderMockGetRateExpectation{}
+	}
+
+	if mmGetRate.defaultExpectation.params != nil {
+		mmGetRate.mock.t.Fatalf("FXRateProviderMock.GetRate mock is already set by Expect")
+	}
+
+	if mmGetRate.defaultExpectation.paramPtrs == nil {
+		mmGetRate.defaultExpectation.paramPtrs = &FXRateProviderMockGetRateParamPtrs{}
+	}
+	mmGetRate.defaultExpectation.paramPtrs.to = &to
+	mmGetRate.defaultExpectation.expectationOrigins.originTo = minimock.CallerInfo(1)
+
+	return mmGetRate
+}
+
+// Inspect accepts an inspector function that has same arguments as the FXRateProvider.GetRate
+func (mmGetRate *mFXRateProviderMockGetRate) Inspect(f func(ctx context.Context, from string, to string)) *mFXRateProviderMockGetRate {
+	if mmGetRate.mock.inspectFuncGetRate != nil {
+		mmGetRate.mock.t.Fatalf("Inspect function is already set for FXRateProviderMock.GetRate")
+	}
+
+	mmGetRate.mock.inspectFuncGetRate = f
+
+	return mmGetRate
+}
+
+// Return sets up results that will be returned by FXRateProvider.GetRate
+func (mmGetRate *mFXRateProviderMockGetRate) Return(f1 domain.FXRate, err error) *FXRateProviderMock {
+	if mmGetRate.mock.funcGetRate != nil {
+		mmGetRate.mock.t.Fatalf("FXRateProviderMock.GetRate mock is already set by Set")
+	}
+
+	if mmGetRate.defaultExpectation == nil {
+		mmGetRate.defaultExpectation = &FXRateProviderMockGetRateExpectation{mock: mmGetRate.mock}
+	}
+	mmGetRate.defaultExpectation.results = &FXRateProviderMockGetRateResults{f1, err}
+	mmGetRate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetRate.mock
+}
+
+// Set uses given function f to mock the FXRateProvider.GetRate method
+func (mmGetRate *mFXRateProviderMockGetRate) Set(f func(ctx context.Context, from string, to string) (f1 domain.FXRate, err error)) *FXRateProviderMock {
+	if mmGetRate.defaultExpectation != nil {
+		mmGetRate.mock.t.Fatalf("Default expectation is already set for the FXRateProvider.GetRate method")
+	}
+
+	if len(mmGetRate.expectations) > 0 {
+		mmGetRate.mock.t.Fatalf("Some expectations are already set for the FXRateProvider.GetRate method")
+	}
+
+	mmGetRate.mock.funcGetRate = f
+	mmGetRate.mock.funcGetRateOrigin = minimock.CallerInfo(1)
+	return mmGetRate.mock
+}
+
+// When sets expectation for the FXRateProvider.GetRate which will trigger the result defined by the following
+// Then helper
+func (mmGetRate *mFXRateProviderMockGetRate) When(ctx context.Context, from string, to string) *FXRateProviderMockGetRateExpectation {
+	if mmGetRate.mock.funcGetRate != nil {
+		mmGetRate.mock.t.Fatalf("FXRateProviderMock.GetRate mock is already set by Set")
+	}
+
+	expectation := &FXRateProviderMockGetRateExpectation{
+		mock:               mmGetRate.mock,
+		params:             &FXRateProviderMockGetRateParams{ctx, from, to},
+		expectationOrigins: FXRateProviderMockGetRateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetRate.expectations = append(mmGetRate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up FXRateProvider.GetRate return parameters for the expectation previously defined by the When method
+func (e *FXRateProviderMockGetRateExpectation) Then(f1 domain.FXRate, err error) *FXRateProviderMock {
+	e.results = &FXRateProviderMockGetRateResults{f1, err}
+	return e.mock
+}
+
+// Times sets number of times FXRateProvider.GetRate should be invoked
+func (mmGetRate *mFXRateProviderMockGetRate) Times(n uint64) *mFXRateProviderMockGetRate {
+	if n == 0 {
+		mmGetRate.mock.t.Fatalf("Times of FXRateProviderMock.GetRate mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetRate.expectedInvocations, n)
+	mmGetRate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetRate
+}
+
+func (mmGetRate *mFXRateProviderMockGetRate) invocationsDone() bool {
+	if len(mmGetRate.expectations) == 0 && mmGetRate.defaultExpectation == nil && mmGetRate.mock.funcGetRate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetRate.mock.afterGetRateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetRate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetRate implements mm_carts.FXRateProvider
+func (mmGetRate *FXRateProviderMock) GetRate(ctx context.Context, from string, to string) (f1 domain.FXRate, err error) {
+	mm_atomic.AddUint64(&mmGetRate.beforeGetRateCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRate.afterGetRateCounter, 1)
+
+	mmGetRate.t.Helper()
+
+	if mmGetRate.inspectFuncGetRate != nil {
+		mmGetRate.inspectFuncGetRate(ctx, from, to)
+	}
+
+	mm_params := FXRateProviderMockGetRateParams{ctx, from, to}
+
+	// Record call args
+	mmGetRate.GetRateMock.mutex.Lock()
+	mmGetRate.GetRateMock.callArgs = append(mmGetRate.GetRateMock.callArgs, &mm_params)
+	mmGetRate.GetRateMock.mutex.Unlock()
+
+	for _, e := range mmGetRate.GetRateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.f1, e.results.err
+		}
+	}
+
+	if mmGetRate.GetRateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRate.GetRateMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRate.GetRateMock.defaultExpectation.params
+		mm_want_ptrs := mmGetRate.GetRateMock.defaultExpectation.paramPtrs
+
+		mm_got := FXRateProviderMockGetRateParams{ctx, from, to}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetRate.t.Errorf("FXRateProviderMock.GetRate got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRate.GetRateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.from != nil && !minimock.Equal(*mm_want_ptrs.from, mm_got.from) {
+				mmGetRate.t.Errorf("FXRateProviderMock.GetRate got unexpected parameter from, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRate.GetRateMock.defaultExpectation.expectationOrigins.originFrom, *mm_want_ptrs.from, mm_got.from, minimock.Diff(*mm_want_ptrs.from, mm_got.from))
+			}
+
+			if mm_want_ptrs.to != nil && !minimock.Equal(*mm_want_ptrs.to, mm_got.to) {
+				mmGetRate.t.Errorf("FXRateProviderMock.GetRate got unexpected parameter to, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRate.GetRateMock.defaultExpectation.expectationOrigins.originTo, *mm_want_ptrs.to, mm_got.to, minimock.Diff(*mm_want_ptrs.to, mm_got.to))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetRate.t.Errorf("FXRateProviderMock.GetRate got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetRate.GetRateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetRate.GetRateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetRate.t.Fatal("No results are set for the FXRateProviderMock.GetRate")
+		}
+		return (*mm_results).f1, (*mm_results).err
+	}
+	if mmGetRate.funcGetRate != nil {
+		return mmGetRate.funcGetRate(ctx, from, to)
+	}
+	mmGetRate.t.Fatalf("Unexpected call to FXRateProviderMock.GetRate. %v %v %v", ctx, from, to)
+	return
+}
+
+// GetRateAfterCounter returns a count of finished FXRateProviderMock.GetRate invocations
+func (mmGetRate *FXRateProviderMock) GetRateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRate.afterGetRateCounter)
+}
+
+// GetRateBeforeCounter returns a count of FXRateProviderMock.GetRate invocations
+func (mmGetRate *FXRateProviderMock) GetRateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRate.beforeGetRateCounter)
+}
+
+// Calls returns a list of arguments used in each call to FXRateProviderMock.GetRate.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetRate *mFXRateProviderMockGetRate) Calls() []*FXRateProviderMockGetRateParams {
+	mmGetRate.mutex.RLock()
+
+	argCopy := make([]*FXRateProviderMockGetRateParams, len(mmGetRate.callArgs))
+	copy(argCopy, mmGetRate.callArgs)
+
+	mmGetRate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetRateDone returns true if the count of the GetRate invocations corresponds
+// the number of defined expectations
+func (m *FXRateProviderMock) MinimockGetRateDone() bool {
+	if m.GetRateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetRateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetRateMock.invocationsDone()
+}
+
+// MinimockGetRateInspect logs each unmet expectation
+func (m *FXRateProviderMock) MinimockGetRateInspect() {
+	for _, e := range m.GetRateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to FXRateProviderMock.GetRate at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetRateCounter := mm_atomic.LoadUint64(&m.afterGetRateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRateMock.defaultExpectation != nil && afterGetRateCounter < 1 {
+		if m.GetRateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to FXRateProviderMock.GetRate at\n%s", m.GetRateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to FXRateProviderMock.GetRate at\n%s with params: %#v", m.GetRateMock.defaultExpectation.expectationOrigins.origin, *m.GetRateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRate != nil && afterGetRateCounter < 1 {
+		m.t.Errorf("Expected call to FXRateProviderMock.GetRate at\n%s", m.funcGetRateOrigin)
+	}
+
+	if !m.GetRateMock.invocationsDone() && afterGetRateCounter > 0 {
+		m.t.Errorf("Expected %d calls to FXRateProviderMock.GetRate at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetRateMock.expectedInvocations), m.GetRateMock.expectedInvocationsOrigin, afterGetRateCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *FXRateProviderMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetRateInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *FXRateProviderMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *FXRateProviderMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetRateDone()
+}
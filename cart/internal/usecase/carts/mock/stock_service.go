@@ -17,9 +17,9 @@ type StockServiceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcGetStockItemBySKU          func(ctx context.Context, skuID domain.SkuID) (s1 domain.StockItemBySKU, err error)
+	funcGetStockItemBySKU          func(ctx context.Context, query domain.StockItemQuery) (s1 domain.StockItemBySKU, err error)
 	funcGetStockItemBySKUOrigin    string
-	inspectFuncGetStockItemBySKU   func(ctx context.Context, skuID domain.SkuID)
+	inspectFuncGetStockItemBySKU   func(ctx context.Context, query domain.StockItemQuery)
 	afterGetStockItemBySKUCounter  uint64
 	beforeGetStockItemBySKUCounter uint64
 	GetStockItemBySKUMock          mStockServiceMockGetStockItemBySKU
@@ -68,13 +68,13 @@ type StockServiceMockGetStockItemBySKUExpectation struct {
 // StockServiceMockGetStockItemBySKUParams contains parameters of the StockService.GetStockItemBySKU
 type StockServiceMockGetStockItemBySKUParams struct {
 	ctx   context.Context
-	skuID domain.SkuID
+	query domain.StockItemQuery
 }
 
 // StockServiceMockGetStockItemBySKUParamPtrs contains pointers to parameters of the StockService.GetStockItemBySKU
 type StockServiceMockGetStockItemBySKUParamPtrs struct {
 	ctx   *context.Context
-	skuID *domain.SkuID
+	query *domain.StockItemQuery
 }
 
 // StockServiceMockGetStockItemBySKUResults contains results of the StockService.GetStockItemBySKU
@@ -87,7 +87,7 @@ type StockServiceMockGetStockItemBySKUResults struct {
 type StockServiceMockGetStockItemBySKUExpectationOrigins struct {
 	origin      string
 	originCtx   string
-	originSkuID string
+	originQuery string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -101,7 +101,7 @@ func (mmGetStockItemBySKU *mStockServiceMockGetStockItemBySKU) Optional() *mStoc
 }
 
 // Expect sets up expected params for StockService.GetStockItemBySKU
-func (mmGetStockItemBySKU *mStockServiceMockGetStockItemBySKU) Expect(ctx context.Context, skuID domain.SkuID) *mStockServiceMockGetStockItemBySKU {
+func (mmGetStockItemBySKU *mStockServiceMockGetStockItemBySKU) Expect(ctx context.Context, query domain.StockItemQuery) *mStockServiceMockGetStockItemBySKU {
 	if mmGetStockItemBySKU.mock.funcGetStockItemBySKU != nil {
 		mmGetStockItemBySKU.mock.t.Fatalf("StockServiceMock.GetStockItemBySKU mock is already set by Set")
 	}
@@ -114,7 +114,7 @@ func (mmGetStockItemBySKU *mStockServiceMockGetStockItemBySKU) Expect(ctx contex
 		mmGetStockItemBySKU.mock.t.Fatalf("StockServiceMock.GetStockItemBySKU mock is already set by ExpectParams functions")
 	}
 
-	mmGetStockItemBySKU.defaultExpectation.params = &StockServiceMockGetStockItemBySKUParams{ctx, skuID}
+	mmGetStockItemBySKU.defaultExpectation.params = &StockServiceMockGetStockItemBySKUParams{ctx, query}
 	mmGetStockItemBySKU.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmGetStockItemBySKU.expectations {
 		if minimock.Equal(e.params, mmGetStockItemBySKU.defaultExpectation.params) {
@@ -148,8 +148,8 @@ func (mmGetStockItemBySKU *mStockServiceMockGetStockItemBySKU) ExpectCtxParam1(c
 	return mmGetStockItemBySKU
 }
 
-// ExpectSkuIDParam2 sets up expected param skuID for StockService.GetStockItemBySKU
-func (mmGetStockItemBySKU *mStockServiceMockGetStockItemBySKU) ExpectSkuIDParam2(skuID domain.SkuID) *mStockServiceMockGetStockItemBySKU {
+// ExpectQueryParam2 sets up expected param query for StockService.GetStockItemBySKU
+func (mmGetStockItemBySKU *mStockServiceMockGetStockItemBySKU) ExpectQueryParam2(query domain.StockItemQuery) *mStockServiceMockGetStockItemBySKU {
 	if mmGetStockItemBySKU.mock.funcGetStockItemBySKU != nil {
 		mmGetStockItemBySKU.mock.t.Fatalf("StockServiceMock.GetStockItemBySKU mock is already set by Set")
 	}
@@ -165,14 +165,14 @@ func (mmGetStockItemBySKU *mStockServiceMockGetStockItemBySKU) ExpectSkuIDParam2
 	if mmGetStockItemBySKU.defaultExpectation.paramPtrs == nil {
 		mmGetStockItemBySKU.defaultExpectation.paramPtrs = &StockServiceMockGetStockItemBySKUParamPtrs{}
 	}
-	mmGetStockItemBySKU.defaultExpectation.paramPtrs.skuID = &skuID
-	mmGetStockItemBySKU.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+	mmGetStockItemBySKU.defaultExpectation.paramPtrs.query = &query
+	mmGetStockItemBySKU.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
 
 	return mmGetStockItemBySKU
 }
 
 // Inspect accepts an inspector function that has same arguments as the StockService.GetStockItemBySKU
-func (mmGetStockItemBySKU *mStockServiceMockGetStockItemBySKU) Inspect(f func(ctx context.Context, skuID domain.SkuID)) *mStockServiceMockGetStockItemBySKU {
+func (mmGetStockItemBySKU *mStockServiceMockGetStockItemBySKU) Inspect(f func(ctx context.Context, query domain.StockItemQuery)) *mStockServiceMockGetStockItemBySKU {
 	if mmGetStockItemBySKU.mock.inspectFuncGetStockItemBySKU != nil {
 		mmGetStockItemBySKU.mock.t.Fatalf("Inspect function is already set for StockServiceMock.GetStockItemBySKU")
 	}
@@ -197,7 +197,7 @@ func (mmGetStockItemBySKU *mStockServiceMockGetStockItemBySKU) Return(s1 domain.
 }
 
 // Set uses given function f to mock the StockService.GetStockItemBySKU method
-func (mmGetStockItemBySKU *mStockServiceMockGetStockItemBySKU) Set(f func(ctx context.Context, skuID domain.SkuID) (s1 domain.StockItemBySKU, err error)) *StockServiceMock {
+func (mmGetStockItemBySKU *mStockServiceMockGetStockItemBySKU) Set(f func(ctx context.Context, query domain.StockItemQuery) (s1 domain.StockItemBySKU, err error)) *StockServiceMock {
 	if mmGetStockItemBySKU.defaultExpectation != nil {
 		mmGetStockItemBySKU.mock.t.Fatalf("Default expectation is already set for the StockService.GetStockItemBySKU method")
 	}
@@ -213,14 +213,14 @@ func (mmGetStockItemBySKU *mStockServiceMockGetStockItemBySKU) Set(f func(ctx co
 
 // When sets expectation for the StockService.GetStockItemBySKU which will trigger the result defined by the following
 // Then helper
-func (mmGetStockItemBySKU *mStockServiceMockGetStockItemBySKU) When(ctx context.Context, skuID domain.SkuID) *StockServiceMockGetStockItemBySKUExpectation {
+func (mmGetStockItemBySKU *mStockServiceMockGetStockItemBySKU) When(ctx context.Context, query domain.StockItemQuery) *StockServiceMockGetStockItemBySKUExpectation {
 	if mmGetStockItemBySKU.mock.funcGetStockItemBySKU != nil {
 		mmGetStockItemBySKU.mock.t.Fatalf("StockServiceMock.GetStockItemBySKU mock is already set by Set")
 	}
 
 	expectation := &StockServiceMockGetStockItemBySKUExpectation{
 		mock:               mmGetStockItemBySKU.mock,
-		params:             &StockServiceMockGetStockItemBySKUParams{ctx, skuID},
+		params:             &StockServiceMockGetStockItemBySKUParams{ctx, query},
 		expectationOrigins: StockServiceMockGetStockItemBySKUExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmGetStockItemBySKU.expectations = append(mmGetStockItemBySKU.expectations, expectation)
@@ -255,17 +255,17 @@ func (mmGetStockItemBySKU *mStockServiceMockGetStockItemBySKU) invocationsDone()
 }
 
 // GetStockItemBySKU implements mm_carts.StockService
-func (mmGetStockItemBySKU *StockServiceMock) GetStockItemBySKU(ctx context.Context, skuID domain.SkuID) (s1 domain.StockItemBySKU, err error) {
+func (mmGetStockItemBySKU *StockServiceMock) GetStockItemBySKU(ctx context.Context, query domain.StockItemQuery) (s1 domain.StockItemBySKU, err error) {
 	mm_atomic.AddUint64(&mmGetStockItemBySKU.beforeGetStockItemBySKUCounter, 1)
 	defer mm_atomic.AddUint64(&mmGetStockItemBySKU.afterGetStockItemBySKUCounter, 1)
 
 	mmGetStockItemBySKU.t.Helper()
 
 	if mmGetStockItemBySKU.inspectFuncGetStockItemBySKU != nil {
-		mmGetStockItemBySKU.inspectFuncGetStockItemBySKU(ctx, skuID)
+		mmGetStockItemBySKU.inspectFuncGetStockItemBySKU(ctx, query)
 	}
 
-	mm_params := StockServiceMockGetStockItemBySKUParams{ctx, skuID}
+	mm_params := StockServiceMockGetStockItemBySKUParams{ctx, query}
 
 	// Record call args
 	mmGetStockItemBySKU.GetStockItemBySKUMock.mutex.Lock()
@@ -284,7 +284,7 @@ func (mmGetStockItemBySKU *StockServiceMock) GetStockItemBySKU(ctx context.Conte
 		mm_want := mmGetStockItemBySKU.GetStockItemBySKUMock.defaultExpectation.params
 		mm_want_ptrs := mmGetStockItemBySKU.GetStockItemBySKUMock.defaultExpectation.paramPtrs
 
-		mm_got := StockServiceMockGetStockItemBySKUParams{ctx, skuID}
+		mm_got := StockServiceMockGetStockItemBySKUParams{ctx, query}
 
 		if mm_want_ptrs != nil {
 
@@ -293,9 +293,9 @@ func (mmGetStockItemBySKU *StockServiceMock) GetStockItemBySKU(ctx context.Conte
 					mmGetStockItemBySKU.GetStockItemBySKUMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
-				mmGetStockItemBySKU.t.Errorf("StockServiceMock.GetStockItemBySKU got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetStockItemBySKU.GetStockItemBySKUMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmGetStockItemBySKU.t.Errorf("StockServiceMock.GetStockItemBySKU got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetStockItemBySKU.GetStockItemBySKUMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
@@ -310,9 +310,9 @@ func (mmGetStockItemBySKU *StockServiceMock) GetStockItemBySKU(ctx context.Conte
 		return (*mm_results).s1, (*mm_results).err
 	}
 	if mmGetStockItemBySKU.funcGetStockItemBySKU != nil {
-		return mmGetStockItemBySKU.funcGetStockItemBySKU(ctx, skuID)
+		return mmGetStockItemBySKU.funcGetStockItemBySKU(ctx, query)
 	}
-	mmGetStockItemBySKU.t.Fatalf("Unexpected call to StockServiceMock.GetStockItemBySKU. %v %v", ctx, skuID)
+	mmGetStockItemBySKU.t.Fatalf("Unexpected call to StockServiceMock.GetStockItemBySKU. %v %v", ctx, query)
 	return
 }
 
@@ -31,16 +31,16 @@ type CartItemUseCaseMock struct {
 	beforeClearCartItemsCounter uint64
 	ClearCartItemsMock          mCartItemUseCaseMockClearCartItems
 
-	funcDeleteCartItem          func(ctx context.Context, userID domain.UserID, skuID domain.SkuID) (err error)
+	funcDeleteCartItem          func(ctx context.Context, userID domain.UserID, skuID domain.SkuID, sellerID domain.UserID) (err error)
 	funcDeleteCartItemOrigin    string
-	inspectFuncDeleteCartItem   func(ctx context.Context, userID domain.UserID, skuID domain.SkuID)
+	inspectFuncDeleteCartItem   func(ctx context.Context, userID domain.UserID, skuID domain.SkuID, sellerID domain.UserID)
 	afterDeleteCartItemCounter  uint64
 	beforeDeleteCartItemCounter uint64
 	DeleteCartItemMock          mCartItemUseCaseMockDeleteCartItem
 
-	funcListCartItems          func(ctx context.Context, userID domain.UserID) (l1 domain.ListCartItems, err error)
+	funcListCartItems          func(ctx context.Context, query domain.ListCartItemsQuery) (l1 domain.ListCartItems, err error)
 	funcListCartItemsOrigin    string
-	inspectFuncListCartItems   func(ctx context.Context, userID domain.UserID)
+	inspectFuncListCartItems   func(ctx context.Context, query domain.ListCartItemsQuery)
 	afterListCartItemsCounter  uint64
 	beforeListCartItemsCounter uint64
 	ListCartItemsMock          mCartItemUseCaseMockListCartItems
@@ -781,16 +781,18 @@ type CartItemUseCaseMockDeleteCartItemExpectation struct {
 
 // CartItemUseCaseMockDeleteCartItemParams contains parameters of the CartItemUseCase.DeleteCartItem
 type CartItemUseCaseMockDeleteCartItemParams struct {
-	ctx    context.Context
-	userID domain.UserID
-	skuID  domain.SkuID
+	ctx      context.Context
+	userID   domain.UserID
+	skuID    domain.SkuID
+	sellerID domain.UserID
 }
 
 // CartItemUseCaseMockDeleteCartItemParamPtrs contains pointers to parameters of the CartItemUseCase.DeleteCartItem
 type CartItemUseCaseMockDeleteCartItemParamPtrs struct {
-	ctx    *context.Context
-	userID *domain.UserID
-	skuID  *domain.SkuID
+	ctx      *context.Context
+	userID   *domain.UserID
+	skuID    *domain.SkuID
+	sellerID *domain.UserID
 }
 
 // CartItemUseCaseMockDeleteCartItemResults contains results of the CartItemUseCase.DeleteCartItem
@@ -800,10 +802,11 @@ type CartItemUseCaseMockDeleteCartItemResults struct {
 
 // CartItemUseCaseMockDeleteCartItemOrigins contains origins of expectations of the CartItemUseCase.DeleteCartItem
 type CartItemUseCaseMockDeleteCartItemExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originUserID string
-	originSkuID  string
+	origin         string
+	originCtx      string
+	originUserID   string
+	originSkuID    string
+	originSellerID string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -817,7 +820,7 @@ func (mmDeleteCartItem *mCartItemUseCaseMockDeleteCartItem) Optional() *mCartIte
 }
 
 // Expect sets up expected params for CartItemUseCase.DeleteCartItem
-func (mmDeleteCartItem *mCartItemUseCaseMockDeleteCartItem) Expect(ctx context.Context, userID domain.UserID, skuID domain.SkuID) *mCartItemUseCaseMockDeleteCartItem {
+func (mmDeleteCartItem *mCartItemUseCaseMockDeleteCartItem) Expect(ctx context.Context, userID domain.UserID, skuID domain.SkuID, sellerID domain.UserID) *mCartItemUseCaseMockDeleteCartItem {
 	if mmDeleteCartItem.mock.funcDeleteCartItem != nil {
 		mmDeleteCartItem.mock.t.Fatalf("CartItemUseCaseMock.DeleteCartItem mock is already set by Set")
 	}
@@ -830,7 +833,7 @@ func (mmDeleteCartItem *mCartItemUseCaseMockDeleteCartItem) Expect(ctx context.C
 		mmDeleteCartItem.mock.t.Fatalf("CartItemUseCaseMock.DeleteCartItem mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteCartItem.defaultExpectation.params = &CartItemUseCaseMockDeleteCartItemParams{ctx, userID, skuID}
+	mmDeleteCartItem.defaultExpectation.params = &CartItemUseCaseMockDeleteCartItemParams{ctx, userID, skuID, sellerID}
 	mmDeleteCartItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmDeleteCartItem.expectations {
 		if minimock.Equal(e.params, mmDeleteCartItem.defaultExpectation.params) {
@@ -910,8 +913,31 @@ func (mmDeleteCartItem *mCartItemUseCaseMockDeleteCartItem) ExpectSkuIDParam3(sk
 	return mmDeleteCartItem
 }
 
+// ExpectSellerIDParam4 sets up expected param sellerID for CartItemUseCase.DeleteCartItem
+func (mmDeleteCartItem *mCartItemUseCaseMockDeleteCartItem) ExpectSellerIDParam4(sellerID domain.UserID) *mCartItemUseCaseMockDeleteCartItem {
+	if mmDeleteCartItem.mock.funcDeleteCartItem != nil {
+		mmDeleteCartItem.mock.t.Fatalf("CartItemUseCaseMock.DeleteCartItem mock is already set by Set")
+	}
+
+	if mmDeleteCartItem.defaultExpectation == nil {
+		mmDeleteCartItem.defaultExpectation = &CartItemUseCaseMockDeleteCartItemExpectation{}
+	}
+
+	if mmDeleteCartItem.defaultExpectation.params != nil {
+		mmDeleteCartItem.mock.t.Fatalf("CartItemUseCaseMock.DeleteCartItem mock is already set by Expect")
+	}
+
+	if mmDeleteCartItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteCartItem.defaultExpectation.paramPtrs = &CartItemUseCaseMockDeleteCartItemParamPtrs{}
+	}
+	mmDeleteCartItem.defaultExpectation.paramPtrs.sellerID = &sellerID
+	mmDeleteCartItem.defaultExpectation.expectationOrigins.originSellerID = minimock.CallerInfo(1)
+
+	return mmDeleteCartItem
+}
+
 // Inspect accepts an inspector function that has same arguments as the CartItemUseCase.DeleteCartItem
-func (mmDeleteCartItem *mCartItemUseCaseMockDeleteCartItem) Inspect(f func(ctx context.Context, userID domain.UserID, skuID domain.SkuID)) *mCartItemUseCaseMockDeleteCartItem {
+func (mmDeleteCartItem *mCartItemUseCaseMockDeleteCartItem) Inspect(f func(ctx context.Context, userID domain.UserID, skuID domain.SkuID, sellerID domain.UserID)) *mCartItemUseCaseMockDeleteCartItem {
 	if mmDeleteCartItem.mock.inspectFuncDeleteCartItem != nil {
 		mmDeleteCartItem.mock.t.Fatalf("Inspect function is already set for CartItemUseCaseMock.DeleteCartItem")
 	}
@@ -936,7 +962,7 @@ func (mmDeleteCartItem *mCartItemUseCaseMockDeleteCartItem) Return(err error) *C
 }
 
 // Set uses given function f to mock the CartItemUseCase.DeleteCartItem method
-func (mmDeleteCartItem *mCartItemUseCaseMockDeleteCartItem) Set(f func(ctx context.Context, userID domain.UserID, skuID domain.SkuID) (err error)) *CartItemUseCaseMock {
+func (mmDeleteCartItem *mCartItemUseCaseMockDeleteCartItem) Set(f func(ctx context.Context, userID domain.UserID, skuID domain.SkuID, sellerID domain.UserID) (err error)) *CartItemUseCaseMock {
 	if mmDeleteCartItem.defaultExpectation != nil {
 		mmDeleteCartItem.mock.t.Fatalf("Default expectation is already set for the CartItemUseCase.DeleteCartItem method")
 	}
@@ -952,14 +978,14 @@ func (mmDeleteCartItem *mCartItemUseCaseMockDeleteCartItem) Set(f func(ctx conte
 
 // When sets expectation for the CartItemUseCase.DeleteCartItem which will trigger the result defined by the following
 // Then helper
-func (mmDeleteCartItem *mCartItemUseCaseMockDeleteCartItem) When(ctx context.Context, userID domain.UserID, skuID domain.SkuID) *CartItemUseCaseMockDeleteCartItemExpectation {
+func (mmDeleteCartItem *mCartItemUseCaseMockDeleteCartItem) When(ctx context.Context, userID domain.UserID, skuID domain.SkuID, sellerID domain.UserID) *CartItemUseCaseMockDeleteCartItemExpectation {
 	if mmDeleteCartItem.mock.funcDeleteCartItem != nil {
 		mmDeleteCartItem.mock.t.Fatalf("CartItemUseCaseMock.DeleteCartItem mock is already set by Set")
 	}
 
 	expectation := &CartItemUseCaseMockDeleteCartItemExpectation{
 		mock:               mmDeleteCartItem.mock,
-		params:             &CartItemUseCaseMockDeleteCartItemParams{ctx, userID, skuID},
+		params:             &CartItemUseCaseMockDeleteCartItemParams{ctx, userID, skuID, sellerID},
 		expectationOrigins: CartItemUseCaseMockDeleteCartItemExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmDeleteCartItem.expectations = append(mmDeleteCartItem.expectations, expectation)
@@ -994,17 +1020,17 @@ func (mmDeleteCartItem *mCartItemUseCaseMockDeleteCartItem) invocationsDone() bo
 }
 
 // DeleteCartItem implements mm_usecase.CartItemUseCase
-func (mmDeleteCartItem *CartItemUseCaseMock) DeleteCartItem(ctx context.Context, userID domain.UserID, skuID domain.SkuID) (err error) {
+func (mmDeleteCartItem *CartItemUseCaseMock) DeleteCartItem(ctx context.Context, userID domain.UserID, skuID domain.SkuID, sellerID domain.UserID) (err error) {
 	mm_atomic.AddUint64(&mmDeleteCartItem.beforeDeleteCartItemCounter, 1)
 	defer mm_atomic.AddUint64(&mmDeleteCartItem.afterDeleteCartItemCounter, 1)
 
 	mmDeleteCartItem.t.Helper()
 
 	if mmDeleteCartItem.inspectFuncDeleteCartItem != nil {
-		mmDeleteCartItem.inspectFuncDeleteCartItem(ctx, userID, skuID)
+		mmDeleteCartItem.inspectFuncDeleteCartItem(ctx, userID, skuID, sellerID)
 	}
 
-	mm_params := CartItemUseCaseMockDeleteCartItemParams{ctx, userID, skuID}
+	mm_params := CartItemUseCaseMockDeleteCartItemParams{ctx, userID, skuID, sellerID}
 
 	// Record call args
 	mmDeleteCartItem.DeleteCartItemMock.mutex.Lock()
@@ -1023,7 +1049,7 @@ func (mmDeleteCartItem *CartItemUseCaseMock) DeleteCartItem(ctx context.Context,
 		mm_want := mmDeleteCartItem.DeleteCartItemMock.defaultExpectation.params
 		mm_want_ptrs := mmDeleteCartItem.DeleteCartItemMock.defaultExpectation.paramPtrs
 
-		mm_got := CartItemUseCaseMockDeleteCartItemParams{ctx, userID, skuID}
+		mm_got := CartItemUseCaseMockDeleteCartItemParams{ctx, userID, skuID, sellerID}
 
 		if mm_want_ptrs != nil {
 
@@ -1042,6 +1068,11 @@ func (mmDeleteCartItem *CartItemUseCaseMock) DeleteCartItem(ctx context.Context,
 					mmDeleteCartItem.DeleteCartItemMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
 			}
 
+			if mm_want_ptrs.sellerID != nil && !minimock.Equal(*mm_want_ptrs.sellerID, mm_got.sellerID) {
+				mmDeleteCartItem.t.Errorf("CartItemUseCaseMock.DeleteCartItem got unexpected parameter sellerID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteCartItem.DeleteCartItemMock.defaultExpectation.expectationOrigins.originSellerID, *mm_want_ptrs.sellerID, mm_got.sellerID, minimock.Diff(*mm_want_ptrs.sellerID, mm_got.sellerID))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmDeleteCartItem.t.Errorf("CartItemUseCaseMock.DeleteCartItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmDeleteCartItem.DeleteCartItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -1054,9 +1085,9 @@ func (mmDeleteCartItem *CartItemUseCaseMock) DeleteCartItem(ctx context.Context,
 		return (*mm_results).err
 	}
 	if mmDeleteCartItem.funcDeleteCartItem != nil {
-		return mmDeleteCartItem.funcDeleteCartItem(ctx, userID, skuID)
+		return mmDeleteCartItem.funcDeleteCartItem(ctx, userID, skuID, sellerID)
 	}
-	mmDeleteCartItem.t.Fatalf("Unexpected call to CartItemUseCaseMock.DeleteCartItem. %v %v %v", ctx, userID, skuID)
+	mmDeleteCartItem.t.Fatalf("Unexpected call to CartItemUseCaseMock.DeleteCartItem. %v %v %v %v", ctx, userID, skuID, sellerID)
 	return
 }
 
@@ -1154,14 +1185,14 @@ type CartItemUseCaseMockListCartItemsExpectation struct {
 
 // CartItemUseCaseMockListCartItemsParams contains parameters of the CartItemUseCase.ListCartItems
 type CartItemUseCaseMockListCartItemsParams struct {
-	ctx    context.Context
-	userID domain.UserID
+	ctx   context.Context
+	query domain.ListCartItemsQuery
 }
 
 // CartItemUseCaseMockListCartItemsParamPtrs contains pointers to parameters of the CartItemUseCase.ListCartItems
 type CartItemUseCaseMockListCartItemsParamPtrs struct {
-	ctx    *context.Context
-	userID *domain.UserID
+	ctx   *context.Context
+	query *domain.ListCartItemsQuery
 }
 
 // CartItemUseCaseMockListCartItemsResults contains results of the CartItemUseCase.ListCartItems
@@ -1172,9 +1203,9 @@ type CartItemUseCaseMockListCartItemsResults struct {
 
 // CartItemUseCaseMockListCartItemsOrigins contains origins of expectations of the CartItemUseCase.ListCartItems
 type CartItemUseCaseMockListCartItemsExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originUserID string
+	origin      string
+	originCtx   string
+	originQuery string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1188,7 +1219,7 @@ func (mmListCartItems *mCartItemUseCaseMockListCartItems) Optional() *mCartItemU
 }
 
 // Expect sets up expected params for CartItemUseCase.ListCartItems
-func (mmListCartItems *mCartItemUseCaseMockListCartItems) Expect(ctx context.Context, userID domain.UserID) *mCartItemUseCaseMockListCartItems {
+func (mmListCartItems *mCartItemUseCaseMockListCartItems) Expect(ctx context.Context, query domain.ListCartItemsQuery) *mCartItemUseCaseMockListCartItems {
 	if mmListCartItems.mock.funcListCartItems != nil {
 		mmListCartItems.mock.t.Fatalf("CartItemUseCaseMock.ListCartItems mock is already set by Set")
 	}
@@ -1201,7 +1232,7 @@ func (mmListCartItems *mCartItemUseCaseMockListCartItems) Expect(ctx context.Con
 		mmListCartItems.mock.t.Fatalf("CartItemUseCaseMock.ListCartItems mock is already set by ExpectParams functions")
 	}
 
-	mmListCartItems.defaultExpectation.params = &CartItemUseCaseMockListCartItemsParams{ctx, userID}
+	mmListCartItems.defaultExpectation.params = &CartItemUseCaseMockListCartItemsParams{ctx, query}
 	mmListCartItems.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmListCartItems.expectations {
 		if minimock.Equal(e.params, mmListCartItems.defaultExpectation.params) {
@@ -1235,8 +1266,8 @@ func (mmListCartItems *mCartItemUseCaseMockListCartItems) ExpectCtxParam1(ctx co
 	return mmListCartItems
 }
 
-// ExpectUserIDParam2 sets up expected param userID for CartItemUseCase.ListCartItems
-func (mmListCartItems *mCartItemUseCaseMockListCartItems) ExpectUserIDParam2(userID domain.UserID) *mCartItemUseCaseMockListCartItems {
+// ExpectQueryParam2 sets up expected param query for CartItemUseCase.ListCartItems
+func (mmListCartItems *mCartItemUseCaseMockListCartItems) ExpectQueryParam2(query domain.ListCartItemsQuery) *mCartItemUseCaseMockListCartItems {
 	if mmListCartItems.mock.funcListCartItems != nil {
 		mmListCartItems.mock.t.Fatalf("CartItemUseCaseMock.ListCartItems mock is already set by Set")
 	}
@@ -1252,14 +1283,14 @@ func (mmListCartItems *mCartItemUseCaseMockListCartItems) ExpectUserIDParam2(use
 	if mmListCartItems.defaultExpectation.paramPtrs == nil {
 		mmListCartItems.defaultExpectation.paramPtrs = &CartItemUseCaseMockListCartItemsParamPtrs{}
 	}
-	mmListCartItems.defaultExpectation.paramPtrs.userID = &userID
-	mmListCartItems.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmListCartItems.defaultExpectation.paramPtrs.query = &query
+	mmListCartItems.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
 
 	return mmListCartItems
 }
 
 // Inspect accepts an inspector function that has same arguments as the CartItemUseCase.ListCartItems
-func (mmListCartItems *mCartItemUseCaseMockListCartItems) Inspect(f func(ctx context.Context, userID domain.UserID)) *mCartItemUseCaseMockListCartItems {
+func (mmListCartItems *mCartItemUseCaseMockListCartItems) Inspect(f func(ctx context.Context, query domain.ListCartItemsQuery)) *mCartItemUseCaseMockListCartItems {
 	if mmListCartItems.mock.inspectFuncListCartItems != nil {
 		mmListCartItems.mock.t.Fatalf("Inspect function is already set for CartItemUseCaseMock.ListCartItems")
 	}
@@ -1284,7 +1315,7 @@ func (mmListCartItems *mCartItemUseCaseMockListCartItems) Return(l1 domain.ListC
 }
 
 // Set uses given function f to mock the CartItemUseCase.ListCartItems method
-func (mmListCartItems *mCartItemUseCaseMockListCartItems) Set(f func(ctx context.Context, userID domain.UserID) (l1 domain.ListCartItems, err error)) *CartItemUseCaseMock {
+func (mmListCartItems *mCartItemUseCaseMockListCartItems) Set(f func(ctx context.Context, query domain.ListCartItemsQuery) (l1 domain.ListCartItems, err error)) *CartItemUseCaseMock {
 	if mmListCartItems.defaultExpectation != nil {
 		mmListCartItems.mock.t.Fatalf("Default expectation is already set for the CartItemUseCase.ListCartItems method")
 	}
@@ -1300,14 +1331,14 @@ func (mmListCartItems *mCartItemUseCaseMockListCartItems) Set(f func(ctx context
 
 // When sets expectation for the CartItemUseCase.ListCartItems which will trigger the result defined by the following
 // Then helper
-func (mmListCartItems *mCartItemUseCaseMockListCartItems) When(ctx context.Context, userID domain.UserID) *CartItemUseCaseMockListCartItemsExpectation {
+func (mmListCartItems *mCartItemUseCaseMockListCartItems) When(ctx context.Context, query domain.ListCartItemsQuery) *CartItemUseCaseMockListCartItemsExpectation {
 	if mmListCartItems.mock.funcListCartItems != nil {
 		mmListCartItems.mock.t.Fatalf("CartItemUseCaseMock.ListCartItems mock is already set by Set")
 	}
 
 	expectation := &CartItemUseCaseMockListCartItemsExpectation{
 		mock:               mmListCartItems.mock,
-		params:             &CartItemUseCaseMockListCartItemsParams{ctx, userID},
+		params:             &CartItemUseCaseMockListCartItemsParams{ctx, query},
 		expectationOrigins: CartItemUseCaseMockListCartItemsExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmListCartItems.expectations = append(mmListCartItems.expectations, expectation)
@@ -1342,17 +1373,17 @@ func (mmListCartItems *mCartItemUseCaseMockListCartItems) invocationsDone() bool
 }
 
 // ListCartItems implements mm_usecase.CartItemUseCase
-func (mmListCartItems *CartItemUseCaseMock) ListCartItems(ctx context.Context, userID domain.UserID) (l1 domain.ListCartItems, err error) {
+func (mmListCartItems *CartItemUseCaseMock) ListCartItems(ctx context.Context, query domain.ListCartItemsQuery) (l1 domain.ListCartItems, err error) {
 	mm_atomic.AddUint64(&mmListCartItems.beforeListCartItemsCounter, 1)
 	defer mm_atomic.AddUint64(&mmListCartItems.afterListCartItemsCounter, 1)
 
 	mmListCartItems.t.Helper()
 
 	if mmListCartItems.inspectFuncListCartItems != nil {
-		mmListCartItems.inspectFuncListCartItems(ctx, userID)
+		mmListCartItems.inspectFuncListCartItems(ctx, query)
 	}
 
-	mm_params := CartItemUseCaseMockListCartItemsParams{ctx, userID}
+	mm_params := CartItemUseCaseMockListCartItemsParams{ctx, query}
 
 	// Record call args
 	mmListCartItems.ListCartItemsMock.mutex.Lock()
@@ -1371,7 +1402,7 @@ func (mmListCartItems *CartItemUseCaseMock) ListCartItems(ctx context.Context, u
 		mm_want := mmListCartItems.ListCartItemsMock.defaultExpectation.params
 		mm_want_ptrs := mmListCartItems.ListCartItemsMock.defaultExpectation.paramPtrs
 
-		mm_got := CartItemUseCaseMockListCartItemsParams{ctx, userID}
+		mm_got := CartItemUseCaseMockListCartItemsParams{ctx, query}
 
 		if mm_want_ptrs != nil {
 
@@ -1380,9 +1411,9 @@ func (mmListCartItems *CartItemUseCaseMock) ListCartItems(ctx context.Context, u
 					mmListCartItems.ListCartItemsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmListCartItems.t.Errorf("CartItemUseCaseMock.ListCartItems got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmListCartItems.ListCartItemsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmListCartItems.t.Errorf("CartItemUseCaseMock.ListCartItems got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListCartItems.ListCartItemsMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
@@ -1397,9 +1428,9 @@ func (mmListCartItems *CartItemUseCaseMock) ListCartItems(ctx context.Context, u
 		return (*mm_results).l1, (*mm_results).err
 	}
 	if mmListCartItems.funcListCartItems != nil {
-		return mmListCartItems.funcListCartItems(ctx, userID)
+		return mmListCartItems.funcListCartItems(ctx, query)
 	}
-	mmListCartItems.t.Fatalf("Unexpected call to CartItemUseCaseMock.ListCartItems. %v %v", ctx, userID)
+	mmListCartItems.t.Fatalf("Unexpected call to CartItemUseCaseMock.ListCartItems. %v %v", ctx, query)
 	return
 }
 
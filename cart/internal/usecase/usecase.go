@@ -10,8 +10,8 @@ import (
 type (
 	CartItemUseCase interface {
 		AddCartItem(ctx context.Context, cartItem domain.CartItem) error
-		DeleteCartItem(ctx context.Context, userID domain.UserID, skuID domain.SkuID) error
+		DeleteCartItem(ctx context.Context, userID domain.UserID, skuID domain.SkuID, sellerID domain.UserID) error
 		ClearCartItems(ctx context.Context, userID domain.UserID) error
-		ListCartItems(ctx context.Context, userID domain.UserID) (domain.ListCartItems, error)
+		ListCartItems(ctx context.Context, query domain.ListCartItemsQuery) (domain.ListCartItems, error)
 	}
 )
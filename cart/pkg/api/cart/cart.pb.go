@@ -10,6 +10,8 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -74,18 +76,75 @@ func (x *GeneralResponse) GetMessage() string {
 	return ""
 }
 
+// Money is amount in minor units of currency, e.g. 1999 RUB is 19.99 rubles.
+type Money struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ISO 4217 currency code.
+	Currency      string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount        int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_cart_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Money) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
 type CreateCartItemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	SkuId         uint32                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
-	Count         uint32                 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuId  uint32                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	// count in minor units of sku unit of measure, grams for kg.
+	Count int64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// seller whose offer is added, zero takes the best offer of sku.
+	SellerId      int64 `protobuf:"varint,4,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateCartItemRequest) Reset() {
 	*x = CreateCartItemRequest{}
-	mi := &file_cart_proto_msgTypes[1]
+	mi := &file_cart_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -97,7 +156,7 @@ func (x *CreateCartItemRequest) String() string {
 func (*CreateCartItemRequest) ProtoMessage() {}
 
 func (x *CreateCartItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[1]
+	mi := &file_cart_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -110,7 +169,7 @@ func (x *CreateCartItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCartItemRequest.ProtoReflect.Descriptor instead.
 func (*CreateCartItemRequest) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{1}
+	return file_cart_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateCartItemRequest) GetUserId() int64 {
@@ -127,24 +186,33 @@ func (x *CreateCartItemRequest) GetSkuId() uint32 {
 	return 0
 }
 
-func (x *CreateCartItemRequest) GetCount() uint32 {
+func (x *CreateCartItemRequest) GetCount() int64 {
 	if x != nil {
 		return x.Count
 	}
 	return 0
 }
 
+func (x *CreateCartItemRequest) GetSellerId() int64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
 type RemoveCartItemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	SkuId         uint32                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuId  uint32                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	// removes line of this seller only, zero removes lines of sku from every seller.
+	SellerId      int64 `protobuf:"varint,3,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RemoveCartItemRequest) Reset() {
 	*x = RemoveCartItemRequest{}
-	mi := &file_cart_proto_msgTypes[2]
+	mi := &file_cart_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -156,7 +224,7 @@ func (x *RemoveCartItemRequest) String() string {
 func (*RemoveCartItemRequest) ProtoMessage() {}
 
 func (x *RemoveCartItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[2]
+	mi := &file_cart_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -169,7 +237,7 @@ func (x *RemoveCartItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveCartItemRequest.ProtoReflect.Descriptor instead.
 func (*RemoveCartItemRequest) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{2}
+	return file_cart_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *RemoveCartItemRequest) GetUserId() int64 {
@@ -186,6 +254,13 @@ func (x *RemoveCartItemRequest) GetSkuId() uint32 {
 	return 0
 }
 
+func (x *RemoveCartItemRequest) GetSellerId() int64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
 type ClearCartItemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -195,7 +270,7 @@ type ClearCartItemRequest struct {
 
 func (x *ClearCartItemRequest) Reset() {
 	*x = ClearCartItemRequest{}
-	mi := &file_cart_proto_msgTypes[3]
+	mi := &file_cart_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -207,7 +282,7 @@ func (x *ClearCartItemRequest) String() string {
 func (*ClearCartItemRequest) ProtoMessage() {}
 
 func (x *ClearCartItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[3]
+	mi := &file_cart_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -220,7 +295,7 @@ func (x *ClearCartItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClearCartItemRequest.ProtoReflect.Descriptor instead.
 func (*ClearCartItemRequest) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{3}
+	return file_cart_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ClearCartItemRequest) GetUserId() int64 {
@@ -231,15 +306,21 @@ func (x *ClearCartItemRequest) GetUserId() int64 {
 }
 
 type ListCartItemsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// ISO 4217 code of currency prices are also shown in, empty shows original prices only.
+	DisplayCurrency string `protobuf:"bytes,2,opt,name=display_currency,json=displayCurrency,proto3" json:"display_currency,omitempty"`
+	// customer group of user, its price tiers apply on top of quantity breaks, empty is every customer.
+	CustomerGroup string `protobuf:"bytes,3,opt,name=customer_group,json=customerGroup,proto3" json:"customer_group,omitempty"`
+	// destination region of cart, locations in or near it are preferred by nearest_region sourcing rule.
+	Region        string `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListCartItemsRequest) Reset() {
 	*x = ListCartItemsRequest{}
-	mi := &file_cart_proto_msgTypes[4]
+	mi := &file_cart_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -251,7 +332,7 @@ func (x *ListCartItemsRequest) String() string {
 func (*ListCartItemsRequest) ProtoMessage() {}
 
 func (x *ListCartItemsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[4]
+	mi := &file_cart_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -264,7 +345,7 @@ func (x *ListCartItemsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCartItemsRequest.ProtoReflect.Descriptor instead.
 func (*ListCartItemsRequest) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{4}
+	return file_cart_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListCartItemsRequest) GetUserId() int64 {
@@ -274,19 +355,64 @@ func (x *ListCartItemsRequest) GetUserId() int64 {
 	return 0
 }
 
+func (x *ListCartItemsRequest) GetDisplayCurrency() string {
+	if x != nil {
+		return x.DisplayCurrency
+	}
+	return ""
+}
+
+func (x *ListCartItemsRequest) GetCustomerGroup() string {
+	if x != nil {
+		return x.CustomerGroup
+	}
+	return ""
+}
+
+func (x *ListCartItemsRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
 type CartItemResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SkuId         uint32                 `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Count         uint32                 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
-	Price         uint32                 `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SkuId    uint32                 `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Count    int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	SellerId int64                  `protobuf:"varint,5,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	// variant group of sku, zero when sku is not a variant.
+	VariantGroupId int64            `protobuf:"varint,6,opt,name=variant_group_id,json=variantGroupId,proto3" json:"variant_group_id,omitempty"`
+	Attributes     *structpb.Struct `protobuf:"bytes,7,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// unit of measure of sku: each, kg or pack.
+	Unit string `protobuf:"bytes,8,opt,name=unit,proto3" json:"unit,omitempty"`
+	// price of one unit of measure.
+	Price *Money `protobuf:"bytes,9,opt,name=price,proto3" json:"price,omitempty"`
+	// price of count, rounded half up to minor unit of currency.
+	Total *Money `protobuf:"bytes,10,opt,name=total,proto3" json:"total,omitempty"`
+	// price and total converted to display currency, set when it is requested.
+	DisplayPrice *Money `protobuf:"bytes,11,opt,name=display_price,json=displayPrice,proto3" json:"display_price,omitempty"`
+	DisplayTotal *Money `protobuf:"bytes,12,opt,name=display_total,json=displayTotal,proto3" json:"display_total,omitempty"`
+	// time exchange rate used for conversion was published at.
+	RateUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=rate_updated_at,json=rateUpdatedAt,proto3" json:"rate_updated_at,omitempty"`
+	// in_stock, backorder, preorder or unavailable when offer can not cover count anymore.
+	Availability string `protobuf:"bytes,14,opt,name=availability,proto3" json:"availability,omitempty"`
+	// expected time backordered or preordered line ships, unset when it is unknown.
+	ExpectedAt *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=expected_at,json=expectedAt,proto3" json:"expected_at,omitempty"`
+	// price of one unit of measure before price tier, equal to price when no tier applies.
+	ListPrice *Money `protobuf:"bytes,16,opt,name=list_price,json=listPrice,proto3" json:"list_price,omitempty"`
+	// minimum quantity of price tier price comes from, zero when list price applies.
+	TierMinQuantity int64 `protobuf:"varint,17,opt,name=tier_min_quantity,json=tierMinQuantity,proto3" json:"tier_min_quantity,omitempty"`
+	// fulfillment location line ships from.
+	Location      string `protobuf:"bytes,18,opt,name=location,proto3" json:"location,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CartItemResponse) Reset() {
 	*x = CartItemResponse{}
-	mi := &file_cart_proto_msgTypes[5]
+	mi := &file_cart_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -298,7 +424,7 @@ func (x *CartItemResponse) String() string {
 func (*CartItemResponse) ProtoMessage() {}
 
 func (x *CartItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[5]
+	mi := &file_cart_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -311,7 +437,7 @@ func (x *CartItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CartItemResponse.ProtoReflect.Descriptor instead.
 func (*CartItemResponse) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{5}
+	return file_cart_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CartItemResponse) GetSkuId() uint32 {
@@ -328,31 +454,133 @@ func (x *CartItemResponse) GetName() string {
 	return ""
 }
 
-func (x *CartItemResponse) GetCount() uint32 {
+func (x *CartItemResponse) GetCount() int64 {
 	if x != nil {
 		return x.Count
 	}
 	return 0
 }
 
-func (x *CartItemResponse) GetPrice() uint32 {
+func (x *CartItemResponse) GetSellerId() int64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+func (x *CartItemResponse) GetVariantGroupId() int64 {
+	if x != nil {
+		return x.VariantGroupId
+	}
+	return 0
+}
+
+func (x *CartItemResponse) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *CartItemResponse) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *CartItemResponse) GetPrice() *Money {
 	if x != nil {
 		return x.Price
 	}
+	return nil
+}
+
+func (x *CartItemResponse) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *CartItemResponse) GetDisplayPrice() *Money {
+	if x != nil {
+		return x.DisplayPrice
+	}
+	return nil
+}
+
+func (x *CartItemResponse) GetDisplayTotal() *Money {
+	if x != nil {
+		return x.DisplayTotal
+	}
+	return nil
+}
+
+func (x *CartItemResponse) GetRateUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RateUpdatedAt
+	}
+	return nil
+}
+
+func (x *CartItemResponse) GetAvailability() string {
+	if x != nil {
+		return x.Availability
+	}
+	return ""
+}
+
+func (x *CartItemResponse) GetExpectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedAt
+	}
+	return nil
+}
+
+func (x *CartItemResponse) GetListPrice() *Money {
+	if x != nil {
+		return x.ListPrice
+	}
+	return nil
+}
+
+func (x *CartItemResponse) GetTierMinQuantity() int64 {
+	if x != nil {
+		return x.TierMinQuantity
+	}
 	return 0
 }
 
+func (x *CartItemResponse) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
 type ListCartItemsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*CartItemResponse    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalPrice    uint32                 `protobuf:"varint,2,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Items []*CartItemResponse    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// sum of item totals per currency, ordered by currency code.
+	Totals []*Money `protobuf:"bytes,3,rep,name=totals,proto3" json:"totals,omitempty"`
+	// sum of item display totals, set when display currency is requested.
+	DisplayTotal *Money `protobuf:"bytes,4,opt,name=display_total,json=displayTotal,proto3" json:"display_total,omitempty"`
+	// publish time of the oldest exchange rate used for conversion.
+	RatesUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=rates_updated_at,json=ratesUpdatedAt,proto3" json:"rates_updated_at,omitempty"`
+	// lines grouped by fulfillment location they ship from, ordered by location.
+	Shipments []*Shipment `protobuf:"bytes,6,rep,name=shipments,proto3" json:"shipments,omitempty"`
+	// cart ships from several locations.
+	SplitShipment bool `protobuf:"varint,7,opt,name=split_shipment,json=splitShipment,proto3" json:"split_shipment,omitempty"`
+	// shipping methods cart can be delivered by to region, in order of rate tables.
+	ShippingOptions []*ShippingOption `protobuf:"bytes,8,rep,name=shipping_options,json=shippingOptions,proto3" json:"shipping_options,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ListCartItemsResponse) Reset() {
 	*x = ListCartItemsResponse{}
-	mi := &file_cart_proto_msgTypes[6]
+	mi := &file_cart_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -364,7 +592,7 @@ func (x *ListCartItemsResponse) String() string {
 func (*ListCartItemsResponse) ProtoMessage() {}
 
 func (x *ListCartItemsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[6]
+	mi := &file_cart_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -377,7 +605,7 @@ func (x *ListCartItemsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCartItemsResponse.ProtoReflect.Descriptor instead.
 func (*ListCartItemsResponse) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{6}
+	return file_cart_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListCartItemsResponse) GetItems() []*CartItemResponse {
@@ -387,9 +615,186 @@ func (x *ListCartItemsResponse) GetItems() []*CartItemResponse {
 	return nil
 }
 
-func (x *ListCartItemsResponse) GetTotalPrice() uint32 {
+func (x *ListCartItemsResponse) GetTotals() []*Money {
 	if x != nil {
-		return x.TotalPrice
+		return x.Totals
+	}
+	return nil
+}
+
+func (x *ListCartItemsResponse) GetDisplayTotal() *Money {
+	if x != nil {
+		return x.DisplayTotal
+	}
+	return nil
+}
+
+func (x *ListCartItemsResponse) GetRatesUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RatesUpdatedAt
+	}
+	return nil
+}
+
+func (x *ListCartItemsResponse) GetShipments() []*Shipment {
+	if x != nil {
+		return x.Shipments
+	}
+	return nil
+}
+
+func (x *ListCartItemsResponse) GetSplitShipment() bool {
+	if x != nil {
+		return x.SplitShipment
+	}
+	return false
+}
+
+func (x *ListCartItemsResponse) GetShippingOptions() []*ShippingOption {
+	if x != nil {
+		return x.ShippingOptions
+	}
+	return nil
+}
+
+type ShippingOption struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// shipping method, e.g. standard or express.
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// sum of prices of every shipment of cart.
+	Price *Money `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	// price converted to display currency, set when it is requested.
+	DisplayPrice *Money `protobuf:"bytes,3,opt,name=display_price,json=displayPrice,proto3" json:"display_price,omitempty"`
+	// range of delivery dates of shipment delivered last.
+	EarliestDeliveryAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=earliest_delivery_at,json=earliestDeliveryAt,proto3" json:"earliest_delivery_at,omitempty"`
+	LatestDeliveryAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=latest_delivery_at,json=latestDeliveryAt,proto3" json:"latest_delivery_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ShippingOption) Reset() {
+	*x = ShippingOption{}
+	mi := &file_cart_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShippingOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShippingOption) ProtoMessage() {}
+
+func (x *ShippingOption) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShippingOption.ProtoReflect.Descriptor instead.
+func (*ShippingOption) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ShippingOption) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ShippingOption) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *ShippingOption) GetDisplayPrice() *Money {
+	if x != nil {
+		return x.DisplayPrice
+	}
+	return nil
+}
+
+func (x *ShippingOption) GetEarliestDeliveryAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EarliestDeliveryAt
+	}
+	return nil
+}
+
+func (x *ShippingOption) GetLatestDeliveryAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LatestDeliveryAt
+	}
+	return nil
+}
+
+type Shipment struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Location string                 `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	// region of location, empty when it is unknown.
+	Region string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	// number of lines shipped from location.
+	Lines         int64 `protobuf:"varint,3,opt,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Shipment) Reset() {
+	*x = Shipment{}
+	mi := &file_cart_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shipment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shipment) ProtoMessage() {}
+
+func (x *Shipment) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shipment.ProtoReflect.Descriptor instead.
+func (*Shipment) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Shipment) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Shipment) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Shipment) GetLines() int64 {
+	if x != nil {
+		return x.Lines
 	}
 	return 0
 }
@@ -399,30 +804,70 @@ var File_cart_proto protoreflect.FileDescriptor
 const file_cart_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"cart.proto\x1a\x1cgoogle/api/annotations.proto\"E\n" +
+	"cart.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"E\n" +
 	"\x0fGeneralResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"]\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\";\n" +
+	"\x05Money\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\"z\n" +
 	"\x15CreateCartItemRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x15\n" +
 	"\x06sku_id\x18\x02 \x01(\rR\x05skuId\x12\x14\n" +
-	"\x05count\x18\x03 \x01(\rR\x05count\"G\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\x12\x1b\n" +
+	"\tseller_id\x18\x04 \x01(\x03R\bsellerId\"d\n" +
 	"\x15RemoveCartItemRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x15\n" +
-	"\x06sku_id\x18\x02 \x01(\rR\x05skuId\"/\n" +
+	"\x06sku_id\x18\x02 \x01(\rR\x05skuId\x12\x1b\n" +
+	"\tseller_id\x18\x03 \x01(\x03R\bsellerId\"/\n" +
 	"\x14ClearCartItemRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"/\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\x99\x01\n" +
 	"\x14ListCartItemsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"i\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12)\n" +
+	"\x10display_currency\x18\x02 \x01(\tR\x0fdisplayCurrency\x12%\n" +
+	"\x0ecustomer_group\x18\x03 \x01(\tR\rcustomerGroup\x12\x16\n" +
+	"\x06region\x18\x04 \x01(\tR\x06region\"\x97\x05\n" +
 	"\x10CartItemResponse\x12\x15\n" +
 	"\x06sku_id\x18\x01 \x01(\rR\x05skuId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05count\x18\x03 \x01(\rR\x05count\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\rR\x05price\"a\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\x12\x1b\n" +
+	"\tseller_id\x18\x05 \x01(\x03R\bsellerId\x12(\n" +
+	"\x10variant_group_id\x18\x06 \x01(\x03R\x0evariantGroupId\x127\n" +
+	"\n" +
+	"attributes\x18\a \x01(\v2\x17.google.protobuf.StructR\n" +
+	"attributes\x12\x12\n" +
+	"\x04unit\x18\b \x01(\tR\x04unit\x12\x1c\n" +
+	"\x05price\x18\t \x01(\v2\x06.MoneyR\x05price\x12\x1c\n" +
+	"\x05total\x18\n" +
+	" \x01(\v2\x06.MoneyR\x05total\x12+\n" +
+	"\rdisplay_price\x18\v \x01(\v2\x06.MoneyR\fdisplayPrice\x12+\n" +
+	"\rdisplay_total\x18\f \x01(\v2\x06.MoneyR\fdisplayTotal\x12B\n" +
+	"\x0frate_updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\rrateUpdatedAt\x12\"\n" +
+	"\favailability\x18\x0e \x01(\tR\favailability\x12;\n" +
+	"\vexpected_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expectedAt\x12%\n" +
+	"\n" +
+	"list_price\x18\x10 \x01(\v2\x06.MoneyR\tlistPrice\x12*\n" +
+	"\x11tier_min_quantity\x18\x11 \x01(\x03R\x0ftierMinQuantity\x12\x1a\n" +
+	"\blocation\x18\x12 \x01(\tR\blocationJ\x04\b\x04\x10\x05\"\xe5\x02\n" +
 	"\x15ListCartItemsResponse\x12'\n" +
-	"\x05items\x18\x01 \x03(\v2\x11.CartItemResponseR\x05items\x12\x1f\n" +
-	"\vtotal_price\x18\x02 \x01(\rR\n" +
-	"totalPrice2\xe5\x02\n" +
+	"\x05items\x18\x01 \x03(\v2\x11.CartItemResponseR\x05items\x12\x1e\n" +
+	"\x06totals\x18\x03 \x03(\v2\x06.MoneyR\x06totals\x12+\n" +
+	"\rdisplay_total\x18\x04 \x01(\v2\x06.MoneyR\fdisplayTotal\x12D\n" +
+	"\x10rates_updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0eratesUpdatedAt\x12'\n" +
+	"\tshipments\x18\x06 \x03(\v2\t.ShipmentR\tshipments\x12%\n" +
+	"\x0esplit_shipment\x18\a \x01(\bR\rsplitShipment\x12:\n" +
+	"\x10shipping_options\x18\b \x03(\v2\x0f.ShippingOptionR\x0fshippingOptionsJ\x04\b\x02\x10\x03\"\x8b\x02\n" +
+	"\x0eShippingOption\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12\x1c\n" +
+	"\x05price\x18\x02 \x01(\v2\x06.MoneyR\x05price\x12+\n" +
+	"\rdisplay_price\x18\x03 \x01(\v2\x06.MoneyR\fdisplayPrice\x12L\n" +
+	"\x14earliest_delivery_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x12earliestDeliveryAt\x12H\n" +
+	"\x12latest_delivery_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x10latestDeliveryAt\"T\n" +
+	"\bShipment\x12\x1a\n" +
+	"\blocation\x18\x01 \x01(\tR\blocation\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x14\n" +
+	"\x05lines\x18\x03 \x01(\x03R\x05lines2\xe5\x02\n" +
 	"\vCartService\x12R\n" +
 	"\vAddCartItem\x12\x16.CreateCartItemRequest\x1a\x10.GeneralResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/cart/item/add\x12X\n" +
 	"\x0eDeleteCartItem\x12\x16.RemoveCartItemRequest\x1a\x10.GeneralResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/cart/item/delete\x12Q\n" +
@@ -442,31 +887,53 @@ func file_cart_proto_rawDescGZIP() []byte {
 	return file_cart_proto_rawDescData
 }
 
-var file_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_cart_proto_goTypes = []any{
 	(*GeneralResponse)(nil),       // 0: GeneralResponse
-	(*CreateCartItemRequest)(nil), // 1: CreateCartItemRequest
-	(*RemoveCartItemRequest)(nil), // 2: RemoveCartItemRequest
-	(*ClearCartItemRequest)(nil),  // 3: ClearCartItemRequest
-	(*ListCartItemsRequest)(nil),  // 4: ListCartItemsRequest
-	(*CartItemResponse)(nil),      // 5: CartItemResponse
-	(*ListCartItemsResponse)(nil), // 6: ListCartItemsResponse
+	(*Money)(nil),                 // 1: Money
+	(*CreateCartItemRequest)(nil), // 2: CreateCartItemRequest
+	(*RemoveCartItemRequest)(nil), // 3: RemoveCartItemRequest
+	(*ClearCartItemRequest)(nil),  // 4: ClearCartItemRequest
+	(*ListCartItemsRequest)(nil),  // 5: ListCartItemsRequest
+	(*CartItemResponse)(nil),      // 6: CartItemResponse
+	(*ListCartItemsResponse)(nil), // 7: ListCartItemsResponse
+	(*ShippingOption)(nil),        // 8: ShippingOption
+	(*Shipment)(nil),              // 9: Shipment
+	(*structpb.Struct)(nil),       // 10: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
 }
 var file_cart_proto_depIdxs = []int32{
-	5, // 0: ListCartItemsResponse.items:type_name -> CartItemResponse
-	1, // 1: CartService.AddCartItem:input_type -> CreateCartItemRequest
-	2, // 2: CartService.DeleteCartItem:input_type -> RemoveCartItemRequest
-	3, // 3: CartService.ClearCartItems:input_type -> ClearCartItemRequest
-	4, // 4: CartService.ListCartItems:input_type -> ListCartItemsRequest
-	0, // 5: CartService.AddCartItem:output_type -> GeneralResponse
-	0, // 6: CartService.DeleteCartItem:output_type -> GeneralResponse
-	0, // 7: CartService.ClearCartItems:output_type -> GeneralResponse
-	6, // 8: CartService.ListCartItems:output_type -> ListCartItemsResponse
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	10, // 0: CartItemResponse.attributes:type_name -> google.protobuf.Struct
+	1,  // 1: CartItemResponse.price:type_name -> Money
+	1,  // 2: CartItemResponse.total:type_name -> Money
+	1,  // 3: CartItemResponse.display_price:type_name -> Money
+	1,  // 4: CartItemResponse.display_total:type_name -> Money
+	11, // 5: CartItemResponse.rate_updated_at:type_name -> google.protobuf.Timestamp
+	11, // 6: CartItemResponse.expected_at:type_name -> google.protobuf.Timestamp
+	1,  // 7: CartItemResponse.list_price:type_name -> Money
+	6,  // 8: ListCartItemsResponse.items:type_name -> CartItemResponse
+	1,  // 9: ListCartItemsResponse.totals:type_name -> Money
+	1,  // 10: ListCartItemsResponse.display_total:type_name -> Money
+	11, // 11: ListCartItemsResponse.rates_updated_at:type_name -> google.protobuf.Timestamp
+	9,  // 12: ListCartItemsResponse.shipments:type_name -> Shipment
+	8,  // 13: ListCartItemsResponse.shipping_options:type_name -> ShippingOption
+	1,  // 14: ShippingOption.price:type_name -> Money
+	1,  // 15: ShippingOption.display_price:type_name -> Money
+	11, // 16: ShippingOption.earliest_delivery_at:type_name -> google.protobuf.Timestamp
+	11, // 17: ShippingOption.latest_delivery_at:type_name -> google.protobuf.Timestamp
+	2,  // 18: CartService.AddCartItem:input_type -> CreateCartItemRequest
+	3,  // 19: CartService.DeleteCartItem:input_type -> RemoveCartItemRequest
+	4,  // 20: CartService.ClearCartItems:input_type -> ClearCartItemRequest
+	5,  // 21: CartService.ListCartItems:input_type -> ListCartItemsRequest
+	0,  // 22: CartService.AddCartItem:output_type -> GeneralResponse
+	0,  // 23: CartService.DeleteCartItem:output_type -> GeneralResponse
+	0,  // 24: CartService.ClearCartItems:output_type -> GeneralResponse
+	7,  // 25: CartService.ListCartItems:output_type -> ListCartItemsResponse
+	22, // [22:26] is the sub-list for method output_type
+	18, // [18:22] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_cart_proto_init() }
@@ -480,7 +947,7 @@ func file_cart_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cart_proto_rawDesc), len(file_cart_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -3,7 +3,9 @@ package connection
 import (
 	"cart/internal/config"
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/jackc/pgx/v5"
@@ -26,9 +28,27 @@ type DB interface {
 	Querier
 	Get(ctx context.Context, dest interface{}, query string, args ...interface{}) error
 	Select(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	WithTx(ctx context.Context, opts TxOptions, fn func(ctx context.Context) error) error
 	Close()
 }
 
+// TxOptions configures transaction started by WithTx.
+type TxOptions struct {
+	// IsoLevel is isolation level of transaction, empty value uses database default.
+	IsoLevel pgx.TxIsoLevel
+	// MaxRetries is how many times fn is run again after serialization failure or deadlock.
+	MaxRetries int
+}
+
+const (
+	serializationFailureCode = "40001"
+	deadlockDetectedCode     = "40P01"
+	txRetryBackoff           = 20 * time.Millisecond
+)
+
+// txKey is context key of transaction started by WithTx.
+type txKey struct{}
+
 type Database struct {
 	pool *pgxpool.Pool
 }
@@ -47,15 +67,15 @@ func NewDB(ctx context.Context, cfg config.PostgresConfig) (*Database, error) {
 }
 
 func (d *Database) QueryRow(ctx context.Context, query string, args ...interface{}) pgx.Row {
-	return d.pool.QueryRow(ctx, query, args...)
+	return d.querier(ctx).QueryRow(ctx, query, args...)
 }
 
 func (d *Database) Query(ctx context.Context, query string, args ...interface{}) (pgx.Rows, error) {
-	return d.pool.Query(ctx, query, args...)
+	return d.querier(ctx).Query(ctx, query, args...)
 }
 
 func (d *Database) Exec(ctx context.Context, query string, args ...interface{}) (pgconn.CommandTag, error) {
-	result, err := d.pool.Exec(ctx, query, args...)
+	result, err := d.querier(ctx).Exec(ctx, query, args...)
 	if err != nil {
 		return pgconn.CommandTag{}, fmt.Errorf("executing query error: %w", err)
 	}
@@ -68,11 +88,79 @@ func (d *Database) Exec(ctx context.Context, query string, args ...interface{})
 }
 
 func (d *Database) Get(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
-	return pgxscan.Get(ctx, d.pool, dest, query, args...)
+	return pgxscan.Get(ctx, d.querier(ctx), dest, query, args...)
 }
 
 func (d *Database) Select(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
-	return pgxscan.Select(ctx, d.pool, dest, query, args...)
+	return pgxscan.Select(ctx, d.querier(ctx), dest, query, args...)
+}
+
+// WithTx runs fn inside a transaction which is put into ctx, so every DB call made with that ctx joins it.
+// It commits when fn succeeds and rolls back otherwise. On serialization failure or deadlock whole fn
+// is retried up to opts.MaxRetries times, so fn must not have side effects outside of database.
+// Nested calls join the outer transaction.
+func (d *Database) WithTx(ctx context.Context, opts TxOptions, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
+	var err error
+
+	for attempt := 0; attempt <= opts.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return errors.Join(err, ctx.Err())
+			case <-time.After(time.Duration(attempt) * txRetryBackoff):
+			}
+		}
+
+		err = d.runTx(ctx, opts, fn)
+		if !isRetryableTxError(err) {
+			return err
+		}
+	}
+
+	return fmt.Errorf("transaction failed after %d retries: %w", opts.MaxRetries, err)
+}
+
+func (d *Database) runTx(ctx context.Context, opts TxOptions, fn func(ctx context.Context) error) error {
+	tx, err := d.pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: opts.IsoLevel})
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+			return errors.Join(err, fmt.Errorf("error rolling back transaction: %w", rollbackErr))
+		}
+
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// querier returns transaction from ctx when there is one, pool otherwise.
+func (d *Database) querier(ctx context.Context) Querier {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx
+	}
+
+	return d.pool
+}
+
+func isRetryableTxError(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+
+	return pgErr.Code == serializationFailureCode || pgErr.Code == deadlockDetectedCode
 }
 
 func (d *Database) Close() {
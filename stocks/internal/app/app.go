@@ -69,7 +69,21 @@ func NewStockServiceApp() error {
 	}
 	defer kafkaProducer.Close()
 
-	srv := server.NewServer(cfg, psqlDB, kafkaProducer, logger)
+	// cart demand is optional input of reorder suggestions, service runs without it.
+	kafkaCfg := cfg.KafkaConfig()
+
+	demandConsumer, err := kafka.NewConsumer(strings.Split(kafkaCfg.Brokers, ","), kafkaCfg.DemandTopic, kafkaCfg.DemandConsumerGroup)
+	if err != nil {
+		logger.Errorf("failed to create stocks service cart demand consumer: %v\n", err)
+	} else {
+		defer func() {
+			if err := demandConsumer.Close(); err != nil {
+				logger.Errorf("failed to close stocks service cart demand consumer: %v\n", err)
+			}
+		}()
+	}
+
+	srv := server.NewServer(cfg, psqlDB, kafkaProducer, demandConsumer, logger)
 	if err := srv.RunServer(); err != nil {
 		logger.Errorf("%+v\n", err.Error())
 		return fmt.Errorf("failed to run http server: %w", err)
@@ -5,29 +5,55 @@ import (
 	"context"
 	"net/http"
 	"os"
+	"stocks/internal/authz"
 	grpcV1 "stocks/internal/controller/grpc/v1"
+	"stocks/internal/domain"
 	"stocks/internal/metrics"
 	"stocks/internal/repository/postgres"
 	stockUC "stocks/internal/usecase/stocks"
 	pb "stocks/pkg/api/stocks"
 	"stocks/pkg/log"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-func (s *Server) registerGRPCServices() {
+// initUseCases builds usecase once, it is shared by grpc handlers and background jobs.
+func (s *Server) initUseCases() {
 	// initialize repository.
 	skuRepo := postgres.NewSKURepository(s.psqlDB)
-	stockRepo := postgres.NewStockServiceRepository(s.psqlDB)
+	stockRepo := postgres.NewStockServiceRepository(s.psqlDB, s.changeBus)
+	thresholdRepo := postgres.NewStockThresholdRepository(s.psqlDB)
+	priceRepo := postgres.NewPriceRepository(s.psqlDB, s.changeBus)
+	reportRepo := postgres.NewReportRepository(s.psqlDB)
 
 	// initialize usecase.
-	stockUC := stockUC.NewStockServiceUseCase(skuRepo, stockRepo, s.kafkaProducer)
+	// rule is validated when config is loaded.
+	defaultOfferRule := domain.OfferRule(s.cfg.MarketplaceConfig().BestOfferRule)
+
+	reorderCfg := s.cfg.ReorderConfig()
+	reorderDefaults := domain.ReorderParams{
+		WindowDays:      reorderCfg.WindowDays,
+		LeadTimeDays:    reorderCfg.LeadTimeDays,
+		SafetyStockDays: reorderCfg.SafetyStockDays,
+		CoverDays:       reorderCfg.CoverDays,
+	}.WithDefaults(domain.DefaultReorderParams())
+
+	s.stockUC = stockUC.NewStockServiceUseCase(
+		skuRepo, stockRepo, thresholdRepo, priceRepo, reportRepo,
+		s.kafkaProducer, s.changeBus, defaultOfferRule, reorderDefaults,
+	)
+}
 
-	stockGRPCHandler := grpcV1.NewStockGRPCHandler(stockUC)
+func (s *Server) registerGRPCServices() {
+	stockGRPCHandler := grpcV1.NewStockGRPCHandler(s.stockUC, authz.NewPolicyAuthorizer())
 
 	pb.RegisterStocksServiceServer(s.grpcServer, stockGRPCHandler)
 }
@@ -76,6 +102,54 @@ func grpcMiddleware(logger log.Logger, metrics metrics.Metrics) grpc.UnaryServer
 	}
 }
 
+// authMiddleware puts caller identity from metadata into context, requests without identity
+// pass as anonymous and are rejected by handlers of protected operations.
+func authMiddleware() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		caller, err := authz.CallerFromIncomingContext(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		return handler(authz.ContextWithCaller(ctx, caller), req)
+	}
+}
+
+// authStreamMiddleware is authMiddleware for streaming rpcs.
+func authStreamMiddleware() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		caller, err := authz.CallerFromIncomingContext(stream.Context())
+		if err != nil {
+			return status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		return handler(srv, &callerServerStream{
+			ServerStream: stream,
+			ctx:          authz.ContextWithCaller(stream.Context(), caller),
+		})
+	}
+}
+
+// callerServerStream overrides context of grpc.ServerStream with the one carrying caller.
+type callerServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (c *callerServerStream) Context() context.Context {
+	return c.ctx
+}
+
+// authHeaderMatcher forwards caller identity http headers to grpc metadata.
+func authHeaderMatcher(key string) (string, bool) {
+	switch strings.ToLower(key) {
+	case authz.MetadataUserID, authz.MetadataRole, authz.MetadataLocations:
+		return strings.ToLower(key), true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
 func observalityMiddleware(logger log.Logger, metrics metrics.Metrics) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -163,7 +237,16 @@ func (rw *responseWriter) WriteHeader(code int) {
 	rw.ResponseWriter.WriteHeader(code)
 }
 
+// Write captures only error bodies, successful streamed responses and downloads are not kept in memory.
 func (rw *responseWriter) Write(b []byte) (int, error) {
-	rw.body.Write(b)
+	if rw.statusCode >= 400 {
+		rw.body.Write(b)
+	}
+
 	return rw.ResponseWriter.Write(b)
 }
+
+// Unwrap lets http.ResponseController reach Flush of underlying writer, streaming responses need it.
+func (rw *responseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
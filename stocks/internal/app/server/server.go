@@ -11,9 +11,13 @@ import (
 	"sync"
 	"time"
 
+	"stocks/internal/changebus"
 	"stocks/internal/config"
+	httpV1 "stocks/internal/controller/http/v1"
+	kafkaV1 "stocks/internal/controller/kafka/v1"
 	"stocks/internal/kafka"
 	"stocks/internal/metrics"
+	"stocks/internal/usecase"
 	pb "stocks/pkg/api/stocks"
 	"stocks/pkg/connection"
 	"stocks/pkg/constants"
@@ -35,8 +39,12 @@ type Server struct {
 	cfg           config.Config
 	psqlDB        connection.DB
 	kafkaProducer kafka.StocksEventProducer
-	logger        log.Logger
-	metrics       metrics.Metrics
+	// demandConsumer reads cart demand, it is nil when consumer could not be created.
+	demandConsumer *kafka.Consumer
+	logger         log.Logger
+	metrics        metrics.Metrics
+	stockUC        usecase.StockServiceUseCase
+	changeBus      *changebus.Bus
 }
 
 // NewServer creates and returns a new instance of Server.
@@ -44,17 +52,20 @@ func NewServer(
 	cfg config.Config,
 	psqlDB connection.DB,
 	kafkaProducer kafka.StocksEventProducer,
+	demandConsumer *kafka.Consumer,
 	logger log.Logger,
 ) *Server {
 	return &Server{
-		server:        nil,
-		grpcServer:    nil,
-		metricsServer: nil,
-		cfg:           cfg,
-		psqlDB:        psqlDB,
-		kafkaProducer: kafkaProducer,
-		logger:        logger,
-		metrics:       metrics.RegisterMetrics(),
+		server:         nil,
+		grpcServer:     nil,
+		metricsServer:  nil,
+		cfg:            cfg,
+		psqlDB:         psqlDB,
+		kafkaProducer:  kafkaProducer,
+		demandConsumer: demandConsumer,
+		logger:         logger,
+		metrics:        metrics.RegisterMetrics(),
+		changeBus:      changebus.New(),
 	}
 }
 
@@ -63,6 +74,54 @@ func (s *Server) RunServer() error {
 	var wg sync.WaitGroup
 	errChan := make(chan error, 3)
 
+	s.initUseCases()
+
+	// background jobs are stopped before servers are shut down.
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+
+	schedulerCfg := s.cfg.SchedulerConfig()
+
+	// start scheduled price changes job.
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		s.runJob(jobsCtx, "ApplyScheduledPriceChanges", schedulerCfg.PriceChangesInterval, s.stockUC.ApplyScheduledPriceChanges)
+	}()
+
+	// start expired stock lots sweeper job.
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		s.runJob(jobsCtx, "ExpireStockLots", schedulerCfg.LotExpiryInterval, s.stockUC.ExpireStockLots)
+	}()
+
+	// start deleted stock items purge job.
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		s.runJob(jobsCtx, "PurgeDeletedStockItems", schedulerCfg.PurgeInterval, func(ctx context.Context) error {
+			return s.stockUC.PurgeDeletedStockItems(ctx, schedulerCfg.DeletedRetention)
+		})
+	}()
+
+	// start cart demand consumer.
+	if s.demandConsumer != nil {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			s.demandConsumer.Start(jobsCtx, kafkaV1.NewCartEventsHandler(s.stockUC))
+		}()
+	}
+
 	// start grpc server.
 	wg.Add(1)
 
@@ -107,6 +166,10 @@ func (s *Server) RunServer() error {
 		s.logger.Errorf("Server error: %v", err.Error())
 	}
 
+	stopJobs()
+	// end watch streams, otherwise graceful shutdown waits for them forever.
+	s.changeBus.Close()
+
 	// Create context for shutdown
 	ctxTimeOut, cancel := context.WithTimeout(context.Background(), constants.SrvTimeOut*time.Second)
 	defer cancel()
@@ -145,7 +208,13 @@ func (s *Server) runGRPCServer() error {
 	defer lis.Close()
 	// create a grpc server.
 	s.grpcServer = grpc.NewServer(
-		grpc.UnaryInterceptor(grpcMiddleware(s.logger, s.metrics)),
+		grpc.ChainUnaryInterceptor(
+			grpcMiddleware(s.logger, s.metrics),
+			authMiddleware(),
+		),
+		grpc.ChainStreamInterceptor(
+			authStreamMiddleware(),
+		),
 	)
 	// enable reflection for grpcui.
 	s.registerGRPCServices()
@@ -168,7 +237,9 @@ func (s *Server) runGatewayServer() error {
 	defer cancel()
 
 	// create grpc-gateway mux.
-	gatewayMux := runtime.NewServeMux()
+	gatewayMux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(authHeaderMatcher),
+	)
 
 	handler := observalityMiddleware(s.logger, s.metrics)(gatewayMux)
 
@@ -187,6 +258,20 @@ func (s *Server) runGatewayServer() error {
 		return fmt.Errorf("failed to register gateway handler: %w", err)
 	}
 
+	// report downloads are read through grpc service as well.
+	conn, err := grpc.NewClient(grpcEndpoint, opts...)
+	if err != nil {
+		return fmt.Errorf("failed to create stocks service client: %w", err)
+	}
+	defer conn.Close()
+
+	reportsHTTPHandler := httpV1.NewReportsHTTPHandler(pb.NewStocksServiceClient(conn))
+
+	err = gatewayMux.HandlePath(http.MethodGet, "/stocks/reports/valuation/download", reportsHTTPHandler.DownloadInventoryValuation)
+	if err != nil {
+		return fmt.Errorf("failed to register report download handler: %w", err)
+	}
+
 	s.server = &http.Server{
 		Addr:         s.cfg.Address(),
 		Handler:      mux,
@@ -222,3 +307,22 @@ func (s *Server) runMetricsServer() error {
 
 	return nil
 }
+
+// runJob runs job every interval until ctx is cancelled, job errors are only logged.
+func (s *Server) runJob(ctx context.Context, name string, interval time.Duration, job func(ctx context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	s.logger.Infof("%s job starting with interval %s", name, interval)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := job(ctx); err != nil && !errors.Is(err, context.Canceled) {
+				s.logger.Errorf("%s job: %v", name, err.Error())
+			}
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"stocks/internal/domain"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// metadata keys caller identity is read from, gateway forwards them from http headers with the same name.
+const (
+	MetadataUserID    = "x-user-id"
+	MetadataRole      = "x-user-role"
+	MetadataLocations = "x-user-locations"
+)
+
+// Role represent role of caller.
+type Role string
+
+const (
+	RoleMerchant          Role = "merchant"
+	RoleWarehouseOperator Role = "warehouse_operator"
+	RoleAdmin             Role = "admin"
+)
+
+// Caller represent identity of request caller.
+type Caller struct {
+	UserID domain.UserID
+	Role   Role
+	// Locations caller is permitted to operate in, empty means no restriction for merchants
+	// and no locations at all for warehouse operators.
+	Locations []string
+}
+
+// IsAnonymous reports whether request came without caller identity.
+func (c Caller) IsAnonymous() bool {
+	return c.UserID == 0
+}
+
+type callerKey struct{}
+
+// ContextWithCaller returns copy of ctx carrying caller.
+func ContextWithCaller(ctx context.Context, caller Caller) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// CallerFromContext returns caller put by ContextWithCaller, anonymous caller otherwise.
+func CallerFromContext(ctx context.Context) Caller {
+	caller, _ := ctx.Value(callerKey{}).(Caller)
+	return caller
+}
+
+// CallerFromIncomingContext reads caller identity from incoming grpc metadata.
+// Request without identity gives anonymous caller, malformed identity is an error.
+func CallerFromIncomingContext(ctx context.Context) (Caller, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	userIDValue := firstMetadataValue(md, MetadataUserID)
+	if userIDValue == "" {
+		return Caller{}, nil
+	}
+
+	userID, err := strconv.ParseInt(userIDValue, 10, 64)
+	if err != nil || userID <= 0 {
+		return Caller{}, fmt.Errorf("invalid %s metadata: %q", MetadataUserID, userIDValue)
+	}
+
+	role := Role(firstMetadataValue(md, MetadataRole))
+
+	switch role {
+	case RoleMerchant, RoleWarehouseOperator, RoleAdmin:
+	case "":
+		return Caller{}, errors.New(MetadataRole + " metadata is required with " + MetadataUserID)
+	default:
+		return Caller{}, fmt.Errorf("unknown role %q", role)
+	}
+
+	var locations []string
+
+	for _, location := range strings.Split(firstMetadataValue(md, MetadataLocations), ",") {
+		if location = strings.TrimSpace(location); location != "" {
+			locations = append(locations, location)
+		}
+	}
+
+	return Caller{
+		UserID:    domain.UserID(userID),
+		Role:      role,
+		Locations: locations,
+	}, nil
+}
+
+func firstMetadataValue(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return strings.TrimSpace(values[0])
+}
@@ -0,0 +1,111 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"stocks/internal/domain"
+)
+
+// ErrUnauthenticated is used when protected action is called without caller identity.
+var ErrUnauthenticated = errors.New("caller identity is required")
+
+// ErrPermissionDenied is used when caller is not allowed to perform action on resource.
+var ErrPermissionDenied = errors.New("permission denied")
+
+// Action represent kind of stock operation which is authorized.
+type Action string
+
+const (
+	// ActionManageStock covers creating, editing, pricing and deleting stock items of merchant.
+	ActionManageStock Action = "manage_stock"
+	// ActionMoveStock covers physical stock movements: adjustments and transfers.
+	ActionMoveStock Action = "move_stock"
+	// ActionConfigureThresholds covers reorder thresholds of locations.
+	ActionConfigureThresholds Action = "configure_thresholds"
+	// ActionViewReports covers stock valuation reports.
+	ActionViewReports Action = "view_reports"
+	// ActionManageCatalog covers catalog definitions shared by every merchant, such as bundles.
+	ActionManageCatalog Action = "manage_catalog"
+	// ActionCountStock covers cycle counts of locations and reconciliation of counted stock.
+	ActionCountStock Action = "count_stock"
+	// ActionOrderStock covers suppliers of merchant and purchase orders placed with them.
+	ActionOrderStock Action = "order_stock"
+)
+
+var rolePermissions = map[Role][]Action{
+	RoleAdmin: {
+		ActionManageStock, ActionMoveStock, ActionConfigureThresholds, ActionViewReports, ActionManageCatalog,
+		ActionCountStock, ActionOrderStock,
+	},
+	RoleMerchant:          {ActionManageStock, ActionMoveStock, ActionViewReports, ActionCountStock, ActionOrderStock},
+	RoleWarehouseOperator: {ActionMoveStock, ActionConfigureThresholds, ActionCountStock},
+}
+
+// Resource represent stock which action is performed on.
+type Resource struct {
+	// OwnerID is merchant owning the stock, zero for resources without owner.
+	OwnerID   domain.UserID
+	Locations []string
+}
+
+// Authorizer checks whether caller from ctx can perform action on resource.
+type Authorizer interface {
+	Authorize(ctx context.Context, action Action, resource Resource) error
+}
+
+var _ Authorizer = (*policyAuthorizer)(nil)
+
+type policyAuthorizer struct{}
+
+// NewPolicyAuthorizer returns Authorizer with role, ownership and location policies.
+func NewPolicyAuthorizer() *policyAuthorizer {
+	return &policyAuthorizer{}
+}
+
+func (p *policyAuthorizer) Authorize(ctx context.Context, action Action, resource Resource) error {
+	caller := CallerFromContext(ctx)
+	if caller.IsAnonymous() {
+		return ErrUnauthenticated
+	}
+
+	if !slices.Contains(rolePermissions[caller.Role], action) {
+		return fmt.Errorf("%w: role %s can not %s", ErrPermissionDenied, caller.Role, action)
+	}
+
+	if caller.Role == RoleAdmin {
+		return nil
+	}
+
+	// merchants operate only on their own stock, operators work for every merchant of their locations.
+	if caller.Role == RoleMerchant && resource.OwnerID != 0 && resource.OwnerID != caller.UserID {
+		return fmt.Errorf("%w: stock belongs to another merchant", ErrPermissionDenied)
+	}
+
+	// report without owner covers stock of every merchant.
+	if caller.Role == RoleMerchant && action == ActionViewReports && resource.OwnerID != caller.UserID {
+		return fmt.Errorf("%w: merchants view reports of their own stock only", ErrPermissionDenied)
+	}
+
+	// so does cycle count without owner.
+	if caller.Role == RoleMerchant && action == ActionCountStock && resource.OwnerID != caller.UserID {
+		return fmt.Errorf("%w: merchants count their own stock only", ErrPermissionDenied)
+	}
+
+	for _, location := range resource.Locations {
+		if !caller.canAccessLocation(location) {
+			return fmt.Errorf("%w: no access to location %q", ErrPermissionDenied, location)
+		}
+	}
+
+	return nil
+}
+
+func (c Caller) canAccessLocation(location string) bool {
+	if len(c.Locations) == 0 {
+		return c.Role == RoleMerchant
+	}
+
+	return slices.Contains(c.Locations, location)
+}
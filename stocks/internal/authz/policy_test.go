@@ -0,0 +1,151 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestPolicyAuthorizer_Authorize(t *testing.T) {
+	t.Parallel()
+
+	merchant := Caller{UserID: 1, Role: RoleMerchant}
+	operator := Caller{UserID: 2, Role: RoleWarehouseOperator, Locations: []string{"Ashgabat"}}
+	admin := Caller{UserID: 3, Role: RoleAdmin}
+
+	tests := []struct {
+		name     string
+		caller   Caller
+		action   Action
+		resource Resource
+		wantErr  error
+	}{
+		{
+			name:     "anonymous caller is unauthenticated",
+			caller:   Caller{},
+			action:   ActionManageStock,
+			resource: Resource{OwnerID: 1},
+			wantErr:  ErrUnauthenticated,
+		},
+		{
+			name:     "merchant manages own stock",
+			caller:   merchant,
+			action:   ActionManageStock,
+			resource: Resource{OwnerID: 1, Locations: []string{"Mary"}},
+		},
+		{
+			name:     "merchant can not manage stock of another merchant",
+			caller:   merchant,
+			action:   ActionManageStock,
+			resource: Resource{OwnerID: 5},
+			wantErr:  ErrPermissionDenied,
+		},
+		{
+			name:     "merchant restricted to locations",
+			caller:   Caller{UserID: 1, Role: RoleMerchant, Locations: []string{"Ashgabat"}},
+			action:   ActionMoveStock,
+			resource: Resource{OwnerID: 1, Locations: []string{"Ashgabat", "Mary"}},
+			wantErr:  ErrPermissionDenied,
+		},
+		{
+			name:     "merchant can not configure thresholds",
+			caller:   merchant,
+			action:   ActionConfigureThresholds,
+			resource: Resource{Locations: []string{"Ashgabat"}},
+			wantErr:  ErrPermissionDenied,
+		},
+		{
+			name:     "operator moves stock of any merchant in own location",
+			caller:   operator,
+			action:   ActionMoveStock,
+			resource: Resource{OwnerID: 5, Locations: []string{"Ashgabat"}},
+		},
+		{
+			name:     "operator can not move stock in other location",
+			caller:   operator,
+			action:   ActionMoveStock,
+			resource: Resource{OwnerID: 5, Locations: []string{"Mary"}},
+			wantErr:  ErrPermissionDenied,
+		},
+		{
+			name:     "operator without locations has no access",
+			caller:   Caller{UserID: 2, Role: RoleWarehouseOperator},
+			action:   ActionConfigureThresholds,
+			resource: Resource{Locations: []string{"Ashgabat"}},
+			wantErr:  ErrPermissionDenied,
+		},
+		{
+			name:     "operator can not manage stock",
+			caller:   operator,
+			action:   ActionManageStock,
+			resource: Resource{OwnerID: 5, Locations: []string{"Ashgabat"}},
+			wantErr:  ErrPermissionDenied,
+		},
+		{
+			name:     "merchant views report of own stock",
+			caller:   merchant,
+			action:   ActionViewReports,
+			resource: Resource{OwnerID: 1},
+		},
+		{
+			name:     "merchant can not view report of every merchant",
+			caller:   merchant,
+			action:   ActionViewReports,
+			resource: Resource{},
+			wantErr:  ErrPermissionDenied,
+		},
+		{
+			name:     "operator can not view reports",
+			caller:   operator,
+			action:   ActionViewReports,
+			resource: Resource{Locations: []string{"Ashgabat"}},
+			wantErr:  ErrPermissionDenied,
+		},
+		{
+			name:     "merchant can not count stock of every merchant",
+			caller:   merchant,
+			action:   ActionCountStock,
+			resource: Resource{Locations: []string{"Ashgabat"}},
+			wantErr:  ErrPermissionDenied,
+		},
+		{
+			name:     "operator counts stock of every merchant in own location",
+			caller:   operator,
+			action:   ActionCountStock,
+			resource: Resource{Locations: []string{"Ashgabat"}},
+		},
+		{
+			name:     "operator can not order stock",
+			caller:   operator,
+			action:   ActionOrderStock,
+			resource: Resource{OwnerID: 7, Locations: []string{"Ashgabat"}},
+			wantErr:  ErrPermissionDenied,
+		},
+		{
+			name:     "merchant can not manage catalog",
+			caller:   merchant,
+			action:   ActionManageCatalog,
+			resource: Resource{},
+			wantErr:  ErrPermissionDenied,
+		},
+		{
+			name:     "admin is allowed everything",
+			caller:   admin,
+			action:   ActionManageStock,
+			resource: Resource{OwnerID: 5, Locations: []string{"Mary"}},
+		},
+	}
+
+	authorizer := NewPolicyAuthorizer()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := authorizer.Authorize(ContextWithCaller(context.Background(), tt.caller), tt.action, tt.resource)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Authorize() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+// Package changebus delivers stock changes from repositories to in-process watchers.
+package changebus
+
+import (
+	"stocks/internal/domain"
+	"sync"
+	"time"
+)
+
+// Publisher publishes committed stock changes.
+type Publisher interface {
+	Publish(changes ...domain.StockChange)
+}
+
+// Subscriber subscribes to stock changes of skus.
+type Subscriber interface {
+	Subscribe(skuIDs []domain.SKUID) *Subscription
+	Unsubscribe(subscription *Subscription)
+}
+
+var (
+	_ Publisher  = (*Bus)(nil)
+	_ Subscriber = (*Bus)(nil)
+)
+
+// Bus fans out stock changes to subscriptions. Publishing never blocks: every subscription
+// keeps only the latest change per stock item until it is drained, so slow subscribers skip
+// intermediate states instead of holding back repositories or growing without limit.
+// Repositories publish after their transactions commit, so concurrent transactions may publish
+// out of commit order, change older than the one already published for stock item is dropped.
+type Bus struct {
+	mu            sync.RWMutex
+	subscriptions map[*Subscription]struct{}
+	// published is the latest change published per stock item, kept for publishedRetention.
+	published map[stockItemKey]publishedChange
+	evictedAt time.Time
+	now       func() time.Time
+	closed    bool
+}
+
+// publishedRetention is how long the latest change of stock item is kept to drop older ones. Changes
+// are published right after their transactions commit, so they race each other only for a moment.
+const publishedRetention = time.Minute
+
+type publishedChange struct {
+	change      domain.StockChange
+	publishedAt time.Time
+}
+
+func New() *Bus {
+	return &Bus{
+		subscriptions: make(map[*Subscription]struct{}),
+		published:     make(map[stockItemKey]publishedChange),
+		now:           time.Now,
+	}
+}
+
+func (b *Bus) Publish(changes ...domain.StockChange) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	b.evictPublished(now)
+
+	latest := make([]domain.StockChange, 0, len(changes))
+
+	for _, change := range changes {
+		key := keyOf(change)
+		if published, ok := b.published[key]; ok && !change.Supersedes(published.change) {
+			continue
+		}
+
+		b.published[key] = publishedChange{change: change, publishedAt: now}
+		latest = append(latest, change)
+	}
+
+	if len(latest) == 0 {
+		return
+	}
+
+	for subscription := range b.subscriptions {
+		subscription.push(latest)
+	}
+}
+
+// evictPublished forgets stock items published longer than publishedRetention ago, so bus does not
+// keep every stock item ever changed. It scans published changes at most once per retention.
+func (b *Bus) evictPublished(now time.Time) {
+	if now.Sub(b.evictedAt) < publishedRetention {
+		return
+	}
+
+	for key, published := range b.published {
+		if now.Sub(published.publishedAt) >= publishedRetention {
+			delete(b.published, key)
+		}
+	}
+
+	b.evictedAt = now
+}
+
+func (b *Bus) Subscribe(skuIDs []domain.SKUID) *Subscription {
+	subscription := &Subscription{
+		skuIDs:  make(map[domain.SKUID]struct{}, len(skuIDs)),
+		pending: make(map[stockItemKey]domain.StockChange),
+		notify:  make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+
+	for _, skuID := range skuIDs {
+		subscription.skuIDs[skuID] = struct{}{}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		close(subscription.done)
+		return subscription
+	}
+
+	b.subscriptions[subscription] = struct{}{}
+
+	return subscription
+}
+
+func (b *Bus) Unsubscribe(subscription *Subscription) {
+	b.mu.Lock()
+	delete(b.subscriptions, subscription)
+	b.mu.Unlock()
+}
+
+// Close ends every subscription, it is called on shutdown so watchers do not hold servers open.
+func (b *Bus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+
+	b.closed = true
+
+	for subscription := range b.subscriptions {
+		close(subscription.done)
+		delete(b.subscriptions, subscription)
+	}
+}
+
+type stockItemKey struct {
+	userID   domain.UserID
+	skuID    domain.SKUID
+	location string
+}
+
+func keyOf(change domain.StockChange) stockItemKey {
+	return stockItemKey{userID: change.UserID, skuID: change.SkuID, location: change.Location}
+}
+
+// Subscription collects changes of watched skus until they are drained.
+type Subscription struct {
+	skuIDs map[domain.SKUID]struct{}
+
+	mu      sync.Mutex
+	pending map[stockItemKey]domain.StockChange
+	// order keeps stock items in order of their first pending change.
+	order  []stockItemKey
+	notify chan struct{}
+	done   chan struct{}
+}
+
+// Notify is signalled when there are pending changes to drain.
+func (s *Subscription) Notify() <-chan struct{} {
+	return s.notify
+}
+
+// Done is closed when bus is closed and no more changes will come.
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Drain returns pending changes, latest one per stock item, and clears them.
+func (s *Subscription) Drain() []domain.StockChange {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	changes := make([]domain.StockChange, 0, len(s.order))
+	for _, key := range s.order {
+		changes = append(changes, s.pending[key])
+	}
+
+	clear(s.pending)
+	s.order = s.order[:0]
+
+	return changes
+}
+
+func (s *Subscription) push(changes []domain.StockChange) {
+	s.mu.Lock()
+
+	pushed := false
+
+	for _, change := range changes {
+		if _, ok := s.skuIDs[change.SkuID]; !ok {
+			continue
+		}
+
+		key := keyOf(change)
+		if _, ok := s.pending[key]; !ok {
+			s.order = append(s.order, key)
+		}
+
+		s.pending[key] = change
+		pushed = true
+	}
+
+	s.mu.Unlock()
+
+	if !pushed {
+		return
+	}
+
+	select {
+	case s.notify <- struct{}{}:
+	default:
+		// subscriber is already notified and will drain this change too.
+	}
+}
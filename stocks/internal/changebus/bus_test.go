@@ -0,0 +1,123 @@
+package changebus
+
+import (
+	"stocks/internal/domain"
+	"testing"
+	"time"
+)
+
+func TestBus_PublishCoalescesPendingChanges(t *testing.T) {
+	t.Parallel()
+
+	bus := New()
+	subscription := bus.Subscribe([]domain.SKUID{1001})
+
+	bus.Publish(
+		domain.StockChange{UserID: 1, SkuID: 1001, Location: "Ashgabat", Count: 10},
+		domain.StockChange{UserID: 1, SkuID: 2002, Location: "Ashgabat", Count: 3},
+		domain.StockChange{UserID: 1, SkuID: 1001, Location: "Mary", Count: 4},
+	)
+	bus.Publish(domain.StockChange{UserID: 1, SkuID: 1001, Location: "Ashgabat", Count: 7})
+
+	select {
+	case <-subscription.Notify():
+	default:
+		t.Fatal("subscription was not notified")
+	}
+
+	changes := subscription.Drain()
+
+	want := []domain.StockChange{
+		{UserID: 1, SkuID: 1001, Location: "Ashgabat", Count: 7},
+		{UserID: 1, SkuID: 1001, Location: "Mary", Count: 4},
+	}
+
+	if len(changes) != len(want) {
+		t.Fatalf("Drain() returned %d changes, want %d", len(changes), len(want))
+	}
+
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Errorf("Drain()[%d] = %+v, want %+v", i, changes[i], want[i])
+		}
+	}
+
+	if changes := subscription.Drain(); len(changes) != 0 {
+		t.Errorf("second Drain() returned %d changes, want 0", len(changes))
+	}
+
+	bus.Unsubscribe(subscription)
+	bus.Publish(domain.StockChange{UserID: 1, SkuID: 1001, Location: "Ashgabat", Count: 1})
+
+	if changes := subscription.Drain(); len(changes) != 0 {
+		t.Errorf("Drain() after Unsubscribe returned %d changes, want 0", len(changes))
+	}
+}
+
+func TestBus_PublishDropsOlderVersions(t *testing.T) {
+	t.Parallel()
+
+	bus := New()
+	subscription := bus.Subscribe([]domain.SKUID{1001})
+
+	bus.Publish(domain.StockChange{UserID: 1, SkuID: 1001, Location: "Ashgabat", Count: 7, Version: 5})
+
+	if changes := subscription.Drain(); len(changes) != 1 {
+		t.Fatalf("Drain() returned %d changes, want 1", len(changes))
+	}
+
+	// transaction which committed earlier publishes after the later one was already drained.
+	bus.Publish(
+		domain.StockChange{UserID: 1, SkuID: 1001, Location: "Ashgabat", Count: 10, Version: 3},
+		domain.StockChange{UserID: 1, SkuID: 1001, Location: "Ashgabat", Count: 7, Version: 5},
+		domain.StockChange{UserID: 1, SkuID: 1001, Location: "Mary", Count: 4, Version: 4},
+	)
+
+	changes := subscription.Drain()
+	want := []domain.StockChange{{UserID: 1, SkuID: 1001, Location: "Mary", Count: 4, Version: 4}}
+
+	if len(changes) != len(want) {
+		t.Fatalf("Drain() returned %d changes, want %d", len(changes), len(want))
+	}
+
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Errorf("Drain()[%d] = %+v, want %+v", i, changes[i], want[i])
+		}
+	}
+
+	bus.Publish(domain.StockChange{UserID: 1, SkuID: 1001, Location: "Ashgabat", Count: 2, Version: 8})
+
+	if changes := subscription.Drain(); len(changes) != 1 || changes[0].Version != 8 {
+		t.Errorf("Drain() = %+v, want change of version 8", changes)
+	}
+}
+
+func TestBus_PublishEvictsOldStockItems(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 9, 2, 9, 0, 0, 0, time.UTC)
+
+	bus := New()
+	bus.now = func() time.Time { return now }
+
+	bus.Publish(domain.StockChange{UserID: 1, SkuID: 1001, Location: "Ashgabat", Count: 7, Version: 5})
+
+	now = now.Add(publishedRetention / 2)
+	bus.Publish(domain.StockChange{UserID: 1, SkuID: 1001, Location: "Mary", Count: 4, Version: 6})
+
+	now = now.Add(publishedRetention / 2)
+	bus.Publish(domain.StockChange{UserID: 1, SkuID: 2002, Location: "Ashgabat", Count: 1, Version: 7})
+
+	if _, ok := bus.published[stockItemKey{userID: 1, skuID: 1001, location: "Ashgabat"}]; ok {
+		t.Error("stock item published before retention is kept")
+	}
+
+	if _, ok := bus.published[stockItemKey{userID: 1, skuID: 1001, location: "Mary"}]; !ok {
+		t.Error("stock item published within retention is evicted")
+	}
+
+	if len(bus.published) != 2 {
+		t.Errorf("bus keeps %d stock items, want 2", len(bus.published))
+	}
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"net"
+	"stocks/internal/domain"
 	"time"
 
 	"github.com/caarlos0/env/v11"
@@ -20,6 +21,10 @@ type Config interface {
 	SrvConfig() ServerConfig
 	DbConfig() PostgresConfig
 	GetKafkaBrokers() string
+	SchedulerConfig() SchedulerConfig
+	MarketplaceConfig() MarketplaceConfig
+	KafkaConfig() KafkaServiceConfig
+	ReorderConfig() ReorderConfig
 }
 
 type StockServiceConfig struct {
@@ -27,6 +32,9 @@ type StockServiceConfig struct {
 	Postgres         PostgresConfig
 	ExternalServices ExternalServicesConfig
 	Kafka            KafkaServiceConfig
+	Scheduler        SchedulerConfig
+	Marketplace      MarketplaceConfig
+	Reorder          ReorderConfig
 }
 
 type (
@@ -53,9 +61,32 @@ type (
 			LogStashHost string `env:"LOGSTASH_HOST,required"`
 		}
 	}
-	// KafkaServiceConfig holds needed configurations for stock service event producer.
+	// KafkaServiceConfig holds needed configurations for stock service event producer and cart demand consumer.
 	KafkaServiceConfig struct {
 		Brokers string `env:"KAFKA_BROKERS,required"`
+		// DemandTopic is topic cart service publishes cart_item_added events to.
+		DemandTopic         string `env:"KAFKA_DEMAND_TOPIC" envDefault:"metrics"`
+		DemandConsumerGroup string `env:"KAFKA_DEMAND_CONSUMER_GROUP" envDefault:"stocks_demand"`
+	}
+	// SchedulerConfig holds intervals of background jobs in stock service.
+	SchedulerConfig struct {
+		PriceChangesInterval time.Duration `env:"PRICE_CHANGES_INTERVAL" envDefault:"1m"`
+		LotExpiryInterval    time.Duration `env:"LOT_EXPIRY_INTERVAL" envDefault:"15m"`
+		PurgeInterval        time.Duration `env:"STOCK_ITEMS_PURGE_INTERVAL" envDefault:"1h"`
+		// DeletedRetention is how long soft deleted stock items are kept before purge.
+		DeletedRetention time.Duration `env:"DELETED_STOCK_ITEMS_RETENTION" envDefault:"720h"`
+	}
+	// MarketplaceConfig holds configurations of offers of the same sku from different sellers.
+	MarketplaceConfig struct {
+		// BestOfferRule is lowest_price or most_stock.
+		BestOfferRule string `env:"BEST_OFFER_RULE" envDefault:"most_stock"`
+	}
+	// ReorderConfig holds default parameters of reorder suggestions.
+	ReorderConfig struct {
+		WindowDays      int32 `env:"REORDER_WINDOW_DAYS" envDefault:"28"`
+		LeadTimeDays    int32 `env:"REORDER_LEAD_TIME_DAYS" envDefault:"7"`
+		SafetyStockDays int32 `env:"REORDER_SAFETY_STOCK_DAYS" envDefault:"3"`
+		CoverDays       int32 `env:"REORDER_COVER_DAYS" envDefault:"14"`
 	}
 )
 
@@ -75,6 +106,10 @@ func NewStockServiceConfig() (*StockServiceConfig, error) {
 		return nil, fmt.Errorf("stockServiceConfig.Parse: %w", err)
 	}
 
+	if _, err := domain.ParseOfferRule(stockServiceConfig.Marketplace.BestOfferRule); err != nil {
+		return nil, fmt.Errorf("BEST_OFFER_RULE: %w", err)
+	}
+
 	return stockServiceConfig, nil
 }
 
@@ -108,6 +143,29 @@ func (c *StockServiceConfig) GetKafkaBrokers() string {
 	return c.Kafka.Brokers
 }
 
+// SchedulerConfig returns the background jobs configuration.
+func (c *StockServiceConfig) SchedulerConfig() SchedulerConfig {
+	return c.Scheduler
+}
+
+// MarketplaceConfig returns the marketplace offers configuration.
+func (c *StockServiceConfig) MarketplaceConfig() MarketplaceConfig {
+	return c.Marketplace
+}
+
+// KafkaConfig returns the kafka configuration.
+func (c *StockServiceConfig) KafkaConfig() KafkaServiceConfig {
+	kafkaConfig := c.Kafka
+	kafkaConfig.Brokers = c.GetKafkaBrokers()
+
+	return kafkaConfig
+}
+
+// ReorderConfig returns the default reorder suggestions configuration.
+func (c *StockServiceConfig) ReorderConfig() ReorderConfig {
+	return c.Reorder
+}
+
 // GenerateDSN returns a psql url.
 func (p *PostgresConfig) GenerateDSN() string {
 	return fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable",
@@ -1,26 +1,76 @@
 package v1
 
-import "stocks/internal/domain"
+import (
+	"stocks/internal/domain"
+	"time"
+)
 
 type CreateStockItemRequest struct {
 	UserID   int64  `json:"userID" validate:"required"`
 	SkuID    uint32 `json:"skuID" validate:"required"`
-	Count    uint16 `json:"count" validate:"required"`
-	Price    uint32 `json:"price" validate:"required"`
+	Count    int64  `json:"count" validate:"required,gte=1"`
+	Price    int64  `json:"price" validate:"required,gte=1"`
+	Currency string `json:"currency" validate:"required,iso4217"`
 	Location string `json:"location" validate:"required"`
+	// lot fields are optional, expiry and receipt dates make sense only with lot number.
+	LotNumber  string    `json:"lotNumber" validate:"max=64"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+	ReceivedAt time.Time `json:"receivedAt"`
 }
 
 // convert to domain model.
 func (r *CreateStockItemRequest) ToDomain() domain.StockItem {
-	return domain.StockItem{
+	stockItem := domain.StockItem{
 		UserID: domain.UserID(r.UserID),
 		Sku: domain.SKU{
 			ID: domain.SKUID(r.SkuID),
 		},
 		Count:    r.Count,
-		Price:    r.Price,
+		Price:    domain.Money{Currency: domain.Currency(r.Currency), Amount: r.Price},
 		Location: r.Location,
 	}
+
+	if r.LotNumber != "" || !r.ExpiresAt.IsZero() || !r.ReceivedAt.IsZero() {
+		stockItem.Lot = &domain.StockLot{
+			UserID:     stockItem.UserID,
+			Sku:        stockItem.Sku,
+			Location:   r.Location,
+			LotNumber:  r.LotNumber,
+			ReceivedAt: r.ReceivedAt,
+			ExpiresAt:  r.ExpiresAt,
+			Quantity:   r.Count,
+		}
+	}
+
+	return stockItem
+}
+
+type UpdateStockItemRequest struct {
+	UserID          int64    `json:"userID" validate:"required"`
+	SkuID           uint32   `json:"skuID" validate:"required"`
+	CurrentLocation string   `json:"currentLocation" validate:"required"`
+	Count           int64    `json:"count" validate:"gte=0"`
+	Price           int64    `json:"price" validate:"gte=0"`
+	Currency        string   `json:"currency" validate:"omitempty,iso4217"`
+	Location        string   `json:"location"`
+	UpdateMask      []string `json:"updateMask" validate:"required,unique,dive,oneof=count price location"`
+}
+
+func (u *UpdateStockItemRequest) ToDomain() domain.StockItemUpdate {
+	updateMask := make([]domain.StockItemField, 0, len(u.UpdateMask))
+	for _, path := range u.UpdateMask {
+		updateMask = append(updateMask, domain.StockItemField(path))
+	}
+
+	return domain.StockItemUpdate{
+		UserID:      domain.UserID(u.UserID),
+		SkuID:       domain.SKUID(u.SkuID),
+		Location:    u.CurrentLocation,
+		Count:       u.Count,
+		Price:       domain.Money{Currency: domain.Currency(u.Currency), Amount: u.Price},
+		NewLocation: u.Location,
+		UpdateMask:  updateMask,
+	}
 }
 
 type DeleteStockItemRequest struct {
@@ -28,15 +78,64 @@ type DeleteStockItemRequest struct {
 	SkuID  uint32 `json:"skuID" validate:"required"`
 }
 
+type WatchStockRequest struct {
+	SkuIDs []uint32 `json:"skuIDs" validate:"required,max=100,unique,dive,required"`
+}
+
+func (w *WatchStockRequest) ToDomain() []domain.SKUID {
+	skuIDs := make([]domain.SKUID, 0, len(w.SkuIDs))
+	for _, skuID := range w.SkuIDs {
+		skuIDs = append(skuIDs, domain.SKUID(skuID))
+	}
+
+	return skuIDs
+}
+
+type RestoreStockItemRequest struct {
+	UserID   int64  `json:"userID" validate:"required"`
+	SkuID    uint32 `json:"skuID" validate:"required"`
+	Location string `json:"location" validate:"required"`
+}
+
 type GetStockItemRequest struct {
-	SkuID uint32 `json:"skuID" validate:"required"`
+	SkuID         uint32           `json:"skuID" validate:"required"`
+	Rule          domain.OfferRule `json:"offerRule"`
+	SellerID      int64            `json:"sellerID" validate:"gte=0"`
+	AllOffers     bool             `json:"allOffers"`
+	Quantity      int64            `json:"quantity" validate:"gte=0"`
+	CustomerGroup string           `json:"customerGroup" validate:"max=64"`
+}
+
+func (g *GetStockItemRequest) ToDomain() domain.OfferFilter {
+	return domain.OfferFilter{
+		SkuID:         domain.SKUID(g.SkuID),
+		SellerID:      domain.UserID(g.SellerID),
+		Rule:          g.Rule,
+		AllOffers:     g.AllOffers,
+		Quantity:      g.Quantity,
+		CustomerGroup: g.CustomerGroup,
+	}
+}
+
+type GetStockItemsBySKUsRequest struct {
+	Items []GetStockItemRequest `json:"items" validate:"required,min=1,max=100,dive"`
+}
+
+func (g *GetStockItemsBySKUsRequest) ToDomain() []domain.OfferFilter {
+	filters := make([]domain.OfferFilter, 0, len(g.Items))
+	for _, item := range g.Items {
+		filters = append(filters, item.ToDomain())
+	}
+
+	return filters
 }
 
 type FilterRequest struct {
-	UserID      int64  `json:"userID" validate:"required"`
-	Location    string `json:"location" validate:"required"`
-	PageSize    int64  `json:"pageSize" validate:"required,gte=1"`
-	CurrentPage int64  `json:"currentPage" validate:"required,gte=1"`
+	UserID      int64             `json:"userID" validate:"required"`
+	Location    string            `json:"location" validate:"required"`
+	PageSize    int64             `json:"pageSize" validate:"required,gte=1"`
+	CurrentPage int64             `json:"currentPage" validate:"required,gte=1"`
+	Attributes  map[string]string `json:"attributes" validate:"dive,keys,required,endkeys"`
 }
 
 func (f *FilterRequest) ToDomain() domain.Filter {
@@ -45,5 +144,496 @@ func (f *FilterRequest) ToDomain() domain.Filter {
 		Location:    f.Location,
 		PageSize:    f.PageSize,
 		CurrentPage: f.CurrentPage,
+		Attributes:  f.Attributes,
+	}
+}
+
+type SearchSKUsRequest struct {
+	Query       string            `json:"query" validate:"required,min=2"`
+	Types       []string          `json:"types" validate:"dive,required"`
+	PageSize    int64             `json:"pageSize" validate:"required,gte=1"`
+	CurrentPage int64             `json:"currentPage" validate:"required,gte=1"`
+	Attributes  map[string]string `json:"attributes" validate:"dive,keys,required,endkeys"`
+}
+
+func (s *SearchSKUsRequest) ToDomain() domain.SKUSearchFilter {
+	return domain.SKUSearchFilter{
+		Query:       s.Query,
+		Types:       s.Types,
+		PageSize:    s.PageSize,
+		CurrentPage: s.CurrentPage,
+		Attributes:  s.Attributes,
+	}
+}
+
+type AttributeDefinitionRequest struct {
+	Name          string               `json:"name" validate:"required"`
+	Type          domain.AttributeType `json:"type" validate:"required"`
+	Required      bool                 `json:"required"`
+	AllowedValues []string             `json:"allowedValues" validate:"dive,required"`
+}
+
+type SetAttributeSchemaRequest struct {
+	Type       string                       `json:"type" validate:"required"`
+	Attributes []AttributeDefinitionRequest `json:"attributes" validate:"dive"`
+}
+
+func (s *SetAttributeSchemaRequest) ToDomain() domain.AttributeSchema {
+	definitions := make([]domain.AttributeDefinition, 0, len(s.Attributes))
+	for _, definition := range s.Attributes {
+		definitions = append(definitions, domain.AttributeDefinition{
+			Name:          definition.Name,
+			Type:          definition.Type,
+			Required:      definition.Required,
+			AllowedValues: definition.AllowedValues,
+		})
+	}
+
+	return domain.AttributeSchema{
+		SkuType:    s.Type,
+		Attributes: definitions,
+	}
+}
+
+type CreateVariantGroupRequest struct {
+	Name string   `json:"name" validate:"required"`
+	Type string   `json:"type" validate:"required"`
+	Axes []string `json:"axes" validate:"required,min=1,unique,dive,required"`
+}
+
+func (c *CreateVariantGroupRequest) ToDomain() domain.VariantGroup {
+	return domain.VariantGroup{
+		Name:    c.Name,
+		SkuType: c.Type,
+		Axes:    c.Axes,
+	}
+}
+
+type GetVariantGroupRequest struct {
+	VariantGroupID int64 `json:"variantGroupID" validate:"required,gte=1"`
+}
+
+type UpdateSKUAttributesRequest struct {
+	SkuID          uint32         `json:"skuID" validate:"required"`
+	VariantGroupID int64          `json:"variantGroupID" validate:"gte=0"`
+	Attributes     map[string]any `json:"attributes"`
+	Unit           string         `json:"unit" validate:"omitempty,oneof=each kg pack"`
+}
+
+func (u *UpdateSKUAttributesRequest) ToDomain() domain.SKUAttributesUpdate {
+	return domain.SKUAttributesUpdate{
+		SkuID:          domain.SKUID(u.SkuID),
+		VariantGroupID: domain.VariantGroupID(u.VariantGroupID),
+		Attributes:     u.Attributes,
+		Unit:           domain.UnitOfMeasure(u.Unit),
+	}
+}
+
+type SetStockThresholdRequest struct {
+	SkuID            uint32 `json:"skuID" validate:"required"`
+	Location         string `json:"location"`
+	ReorderThreshold uint32 `json:"reorderThreshold"`
+	Hysteresis       uint32 `json:"hysteresis"`
+}
+
+func (s *SetStockThresholdRequest) ToDomain() domain.StockThreshold {
+	return domain.StockThreshold{
+		SkuID:            domain.SKUID(s.SkuID),
+		Location:         s.Location,
+		ReorderThreshold: s.ReorderThreshold,
+		Hysteresis:       s.Hysteresis,
+	}
+}
+
+type BundleComponentRequest struct {
+	SkuID    uint32 `json:"skuID" validate:"required"`
+	Quantity uint32 `json:"quantity" validate:"required,gte=1"`
+}
+
+type SetBundleRequest struct {
+	BundleSkuID uint32                   `json:"bundleSkuID" validate:"required"`
+	Components  []BundleComponentRequest `json:"components" validate:"required,min=1,dive"`
+}
+
+func (s *SetBundleRequest) ToDomain() domain.Bundle {
+	components := make([]domain.BundleComponent, 0, len(s.Components))
+	for _, component := range s.Components {
+		components = append(components, domain.BundleComponent{
+			SkuID:    domain.SKUID(component.SkuID),
+			Quantity: component.Quantity,
+		})
+	}
+
+	return domain.Bundle{
+		Sku:        domain.SKU{ID: domain.SKUID(s.BundleSkuID)},
+		Components: components,
+	}
+}
+
+type GetBundleRequest struct {
+	BundleSkuID uint32 `json:"bundleSkuID" validate:"required"`
+}
+
+type ListLowStockRequest struct {
+	Location    string `json:"location"`
+	PageSize    int64  `json:"pageSize" validate:"required,gte=1"`
+	CurrentPage int64  `json:"currentPage" validate:"required,gte=1"`
+}
+
+func (l *ListLowStockRequest) ToDomain() domain.LowStockFilter {
+	return domain.LowStockFilter{
+		Location:    l.Location,
+		PageSize:    l.PageSize,
+		CurrentPage: l.CurrentPage,
+	}
+}
+
+type ListExpiringLotsRequest struct {
+	WithinDays  uint32 `json:"withinDays" validate:"lte=3650"`
+	UserID      int64  `json:"userID"`
+	Location    string `json:"location"`
+	PageSize    int64  `json:"pageSize" validate:"required,gte=1"`
+	CurrentPage int64  `json:"currentPage" validate:"required,gte=1"`
+}
+
+func (l *ListExpiringLotsRequest) ToDomain(now time.Time) domain.ExpiringLotsFilter {
+	return domain.ExpiringLotsFilter{
+		ExpiresBefore: now.AddDate(0, 0, int(l.WithinDays)),
+		UserID:        domain.UserID(l.UserID),
+		Location:      l.Location,
+		PageSize:      l.PageSize,
+		CurrentPage:   l.CurrentPage,
+	}
+}
+
+type AdjustStockRequest struct {
+	UserID   int64                   `json:"userID" validate:"required"`
+	SkuID    uint32                  `json:"skuID" validate:"required"`
+	Location string                  `json:"location" validate:"required"`
+	Delta    int64                   `json:"delta" validate:"required"`
+	Reason   domain.AdjustmentReason `json:"reason" validate:"required"`
+	Note     string                  `json:"note" validate:"max=255"`
+}
+
+func (a *AdjustStockRequest) ToDomain() domain.StockAdjustment {
+	return domain.StockAdjustment{
+		UserID:   domain.UserID(a.UserID),
+		SkuID:    domain.SKUID(a.SkuID),
+		Location: a.Location,
+		Delta:    a.Delta,
+		Reason:   a.Reason,
+		Note:     a.Note,
+	}
+}
+
+type BackorderSettingsRequest struct {
+	UserID      int64     `json:"userID" validate:"required"`
+	SkuID       uint32    `json:"skuID" validate:"required"`
+	Location    string    `json:"location" validate:"required"`
+	Mode        string    `json:"mode" validate:"required,oneof=none backorder preorder"`
+	MaxQuantity int64     `json:"maxQuantity" validate:"gte=0"`
+	RestockAt   time.Time `json:"restockAt"`
+}
+
+func (b *BackorderSettingsRequest) ToDomain() domain.BackorderSettings {
+	return domain.BackorderSettings{
+		UserID:   domain.UserID(b.UserID),
+		SkuID:    domain.SKUID(b.SkuID),
+		Location: b.Location,
+		BackorderPolicy: domain.BackorderPolicy{
+			Mode:        domain.BackorderMode(b.Mode),
+			MaxQuantity: b.MaxQuantity,
+			RestockAt:   b.RestockAt,
+		},
+	}
+}
+
+type TransferStockRequest struct {
+	UserID             int64  `json:"userID" validate:"required"`
+	SkuID              uint32 `json:"skuID" validate:"required"`
+	FromLocation       string `json:"fromLocation" validate:"required"`
+	ToLocation         string `json:"toLocation" validate:"required,nefield=FromLocation"`
+	Quantity           int64  `json:"quantity" validate:"required,gte=1"`
+	ReceiveImmediately bool   `json:"receiveImmediately"`
+}
+
+func (t *TransferStockRequest) ToDomain() domain.StockTransfer {
+	return domain.StockTransfer{
+		UserID:       domain.UserID(t.UserID),
+		SkuID:        domain.SKUID(t.SkuID),
+		FromLocation: t.FromLocation,
+		ToLocation:   t.ToLocation,
+		Quantity:     t.Quantity,
+	}
+}
+
+type ReceiveStockTransferRequest struct {
+	UserID     int64 `json:"userID" validate:"required"`
+	TransferID int64 `json:"transferID" validate:"required,gte=1"`
+}
+
+type OpenCycleCountRequest struct {
+	Location string `json:"location" validate:"required"`
+	UserID   int64  `json:"userID" validate:"gte=0"`
+	Note     string `json:"note" validate:"max=255"`
+}
+
+func (o *OpenCycleCountRequest) ToDomain(openedBy domain.UserID) domain.CycleCount {
+	return domain.CycleCount{
+		Location: o.Location,
+		UserID:   domain.UserID(o.UserID),
+		Note:     o.Note,
+		OpenedBy: openedBy,
+	}
+}
+
+type CountedQuantityRequest struct {
+	UserID          int64  `json:"userID" validate:"gte=0"`
+	SkuID           uint32 `json:"skuID" validate:"required"`
+	CountedQuantity int64  `json:"countedQuantity" validate:"gte=0"`
+}
+
+type SubmitCycleCountsRequest struct {
+	CycleCountID int64                    `json:"cycleCountID" validate:"required,gte=1"`
+	Counts       []CountedQuantityRequest `json:"counts" validate:"required,min=1,dive"`
+}
+
+func (s *SubmitCycleCountsRequest) ToDomain(countedBy domain.UserID) domain.CycleCountSubmission {
+	counts := make([]domain.CountedQuantity, 0, len(s.Counts))
+	for _, count := range s.Counts {
+		counts = append(counts, domain.CountedQuantity{
+			UserID:          domain.UserID(count.UserID),
+			SkuID:           domain.SKUID(count.SkuID),
+			CountedQuantity: count.CountedQuantity,
+		})
+	}
+
+	return domain.CycleCountSubmission{
+		CycleCountID: domain.CycleCountID(s.CycleCountID),
+		Counts:       counts,
+		CountedBy:    countedBy,
+	}
+}
+
+type CycleCountIDRequest struct {
+	CycleCountID int64 `json:"cycleCountID" validate:"required,gte=1"`
+}
+
+type CreateSupplierRequest struct {
+	UserID       int64  `json:"userID" validate:"required"`
+	Name         string `json:"name" validate:"required,max=255"`
+	Email        string `json:"email" validate:"omitempty,email"`
+	Phone        string `json:"phone" validate:"max=32"`
+	LeadTimeDays int32  `json:"leadTimeDays" validate:"gte=0,lte=365"`
+}
+
+func (c *CreateSupplierRequest) ToDomain() domain.Supplier {
+	return domain.Supplier{
+		UserID:       domain.UserID(c.UserID),
+		Name:         c.Name,
+		Email:        c.Email,
+		Phone:        c.Phone,
+		LeadTimeDays: c.LeadTimeDays,
+	}
+}
+
+type ListSuppliersRequest struct {
+	UserID int64 `json:"userID" validate:"required"`
+}
+
+type PurchaseOrderLineRequest struct {
+	SkuID    uint32 `json:"skuID" validate:"required"`
+	Quantity int64  `json:"quantity" validate:"required,gte=1"`
+	Price    int64  `json:"price" validate:"required,gte=1"`
+	Currency string `json:"currency" validate:"required,iso4217"`
+}
+
+type CreatePurchaseOrderRequest struct {
+	UserID     int64                      `json:"userID" validate:"required"`
+	SupplierID int64                      `json:"supplierID" validate:"required,gte=1"`
+	Location   string                     `json:"location" validate:"required"`
+	Note       string                     `json:"note" validate:"max=255"`
+	ExpectedAt time.Time                  `json:"expectedAt"`
+	Lines      []PurchaseOrderLineRequest `json:"lines" validate:"required,min=1,dive"`
+}
+
+func (c *CreatePurchaseOrderRequest) ToDomain(createdBy domain.UserID) domain.PurchaseOrder {
+	lines := make([]domain.PurchaseOrderLine, 0, len(c.Lines))
+	for _, line := range c.Lines {
+		lines = append(lines, domain.PurchaseOrderLine{
+			Sku:      domain.SKU{ID: domain.SKUID(line.SkuID)},
+			Quantity: line.Quantity,
+			Price:    domain.Money{Currency: domain.Currency(line.Currency), Amount: line.Price},
+		})
+	}
+
+	return domain.PurchaseOrder{
+		UserID:     domain.UserID(c.UserID),
+		SupplierID: domain.SupplierID(c.SupplierID),
+		Location:   c.Location,
+		Note:       c.Note,
+		ExpectedAt: c.ExpectedAt,
+		Lines:      lines,
+		CreatedBy:  createdBy,
+	}
+}
+
+type PurchaseOrderIDRequest struct {
+	PurchaseOrderID int64 `json:"purchaseOrderID" validate:"required,gte=1"`
+}
+
+type ReceivedLineRequest struct {
+	SkuID     uint32    `json:"skuID" validate:"required"`
+	Quantity  int64     `json:"quantity" validate:"required,gte=1"`
+	LotNumber string    `json:"lotNumber" validate:"max=64"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+type ReceivePurchaseOrderRequest struct {
+	PurchaseOrderID int64                 `json:"purchaseOrderID" validate:"required,gte=1"`
+	Lines           []ReceivedLineRequest `json:"lines" validate:"required,min=1,dive"`
+}
+
+func (r *ReceivePurchaseOrderRequest) ToDomain(receivedBy domain.UserID) domain.PurchaseOrderReceipt {
+	lines := make([]domain.ReceivedLine, 0, len(r.Lines))
+	for _, line := range r.Lines {
+		receivedLine := domain.ReceivedLine{
+			SkuID:    domain.SKUID(line.SkuID),
+			Quantity: line.Quantity,
+		}
+
+		if line.LotNumber != "" || !line.ExpiresAt.IsZero() {
+			receivedLine.Lot = &domain.StockLot{
+				LotNumber: line.LotNumber,
+				ExpiresAt: line.ExpiresAt,
+			}
+		}
+
+		lines = append(lines, receivedLine)
+	}
+
+	return domain.PurchaseOrderReceipt{
+		PurchaseOrderID: domain.PurchaseOrderID(r.PurchaseOrderID),
+		Lines:           lines,
+		ReceivedBy:      receivedBy,
+	}
+}
+
+type GetReorderSuggestionsRequest struct {
+	UserID          int64  `json:"userID" validate:"required"`
+	Location        string `json:"location"`
+	SkuID           uint32 `json:"skuID"`
+	WindowDays      int32  `json:"windowDays" validate:"gte=0,lte=365"`
+	LeadTimeDays    int32  `json:"leadTimeDays" validate:"gte=0,lte=365"`
+	SafetyStockDays int32  `json:"safetyStockDays" validate:"gte=0,lte=365"`
+	CoverDays       int32  `json:"coverDays" validate:"gte=0,lte=365"`
+}
+
+func (g *GetReorderSuggestionsRequest) ToDomain() domain.ReorderFilter {
+	return domain.ReorderFilter{
+		UserID:   domain.UserID(g.UserID),
+		Location: g.Location,
+		SkuID:    domain.SKUID(g.SkuID),
+		ReorderParams: domain.ReorderParams{
+			WindowDays:      g.WindowDays,
+			LeadTimeDays:    g.LeadTimeDays,
+			SafetyStockDays: g.SafetyStockDays,
+			CoverDays:       g.CoverDays,
+		},
+	}
+}
+
+type SchedulePriceChangeRequest struct {
+	UserID      int64     `json:"userID" validate:"required"`
+	SkuID       uint32    `json:"skuID" validate:"required"`
+	Location    string    `json:"location" validate:"required"`
+	NewPrice    int64     `json:"newPrice" validate:"required,gte=1"`
+	Currency    string    `json:"currency" validate:"omitempty,iso4217"`
+	EffectiveAt time.Time `json:"effectiveAt" validate:"required"`
+}
+
+func (s *SchedulePriceChangeRequest) ToDomain() domain.ScheduledPriceChange {
+	return domain.ScheduledPriceChange{
+		UserID:      domain.UserID(s.UserID),
+		SkuID:       domain.SKUID(s.SkuID),
+		Location:    s.Location,
+		NewPrice:    domain.Money{Currency: domain.Currency(s.Currency), Amount: s.NewPrice},
+		EffectiveAt: s.EffectiveAt,
+	}
+}
+
+type PriceTierRequest struct {
+	MinQuantity int64  `json:"minQuantity" validate:"required,gte=1"`
+	Price       int64  `json:"price" validate:"required,gte=1"`
+	Currency    string `json:"currency" validate:"omitempty,iso4217"`
+}
+
+type SetPriceTiersRequest struct {
+	UserID        int64              `json:"userID" validate:"required"`
+	SkuID         uint32             `json:"skuID" validate:"required"`
+	Location      string             `json:"location" validate:"required"`
+	CustomerGroup string             `json:"customerGroup" validate:"max=64"`
+	Tiers         []PriceTierRequest `json:"tiers" validate:"max=50,dive"`
+}
+
+func (s *SetPriceTiersRequest) ToDomain() domain.PriceList {
+	priceList := domain.PriceList{
+		UserID:        domain.UserID(s.UserID),
+		SkuID:         domain.SKUID(s.SkuID),
+		Location:      s.Location,
+		CustomerGroup: s.CustomerGroup,
+		Tiers:         make([]domain.PriceTier, 0, len(s.Tiers)),
+	}
+
+	for _, tier := range s.Tiers {
+		priceList.Tiers = append(priceList.Tiers, domain.PriceTier{
+			CustomerGroup: s.CustomerGroup,
+			MinQuantity:   tier.MinQuantity,
+			Price:         domain.Money{Currency: domain.Currency(tier.Currency), Amount: tier.Price},
+		})
+	}
+
+	return priceList
+}
+
+type GetPriceTiersRequest struct {
+	UserID   int64  `json:"userID" validate:"required"`
+	SkuID    uint32 `json:"skuID" validate:"required"`
+	Location string `json:"location" validate:"required"`
+}
+
+type GetPriceHistoryRequest struct {
+	SkuID       uint32 `json:"skuID" validate:"required"`
+	UserID      int64  `json:"userID"`
+	Location    string `json:"location"`
+	PageSize    int64  `json:"pageSize" validate:"required,gte=1"`
+	CurrentPage int64  `json:"currentPage" validate:"required,gte=1"`
+}
+
+func (g *GetPriceHistoryRequest) ToDomain() domain.PriceHistoryFilter {
+	return domain.PriceHistoryFilter{
+		SkuID:       domain.SKUID(g.SkuID),
+		UserID:      domain.UserID(g.UserID),
+		Location:    g.Location,
+		PageSize:    g.PageSize,
+		CurrentPage: g.CurrentPage,
+	}
+}
+
+type InventoryValuationRequest struct {
+	GroupBy  []domain.ValuationDimension `json:"groupBy" validate:"unique,dive,required"`
+	UserID   int64                       `json:"userID" validate:"gte=0"`
+	Location string                      `json:"location"`
+	Type     string                      `json:"type"`
+	AsOf     time.Time                   `json:"asOf"`
+}
+
+func (i *InventoryValuationRequest) ToDomain() domain.InventoryValuationFilter {
+	return domain.InventoryValuationFilter{
+		GroupBy:  i.GroupBy,
+		UserID:   domain.UserID(i.UserID),
+		Location: i.Location,
+		Type:     i.Type,
+		AsOf:     i.AsOf,
 	}
 }
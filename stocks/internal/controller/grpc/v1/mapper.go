@@ -4,15 +4,37 @@ import (
 	"stocks/internal/domain"
 	"stocks/pkg/api/stocks"
 	helper "stocks/pkg/httphelper"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+func fromMoneyDomainToGrpc(money domain.Money) *stocks.Money {
+	return &stocks.Money{
+		Currency: string(money.Currency),
+		Amount:   money.Amount,
+	}
+}
+
 func fromGrpcStockItemReqToDomain(req *stocks.CreateStockItemRequest) (domain.StockItem, error) {
 	createStockItemReq := CreateStockItemRequest{
-		SkuID:    req.SkuId,
-		UserID:   req.UserId,
-		Count:    uint16(req.Count),
-		Price:    req.Price,
-		Location: req.Location,
+		SkuID:     req.SkuId,
+		UserID:    req.UserId,
+		Count:     req.Count,
+		Price:     req.GetPrice().GetAmount(),
+		Currency:  req.GetPrice().GetCurrency(),
+		Location:  req.Location,
+		LotNumber: req.LotNumber,
+	}
+
+	if req.ExpiresAt != nil {
+		createStockItemReq.ExpiresAt = req.ExpiresAt.AsTime()
+	}
+
+	if req.ReceivedAt != nil {
+		createStockItemReq.ReceivedAt = req.ReceivedAt.AsTime()
 	}
 
 	if err := helper.ValidateRequest(&createStockItemReq); err != nil {
@@ -22,6 +44,25 @@ func fromGrpcStockItemReqToDomain(req *stocks.CreateStockItemRequest) (domain.St
 	return createStockItemReq.ToDomain(), nil
 }
 
+func fromGrpcUpdateStockItemReqToDomain(req *stocks.UpdateStockItemRequest) (domain.StockItemUpdate, error) {
+	updateStockItemReq := UpdateStockItemRequest{
+		UserID:          req.GetItem().GetUserId(),
+		SkuID:           req.GetItem().GetSkuId(),
+		CurrentLocation: req.CurrentLocation,
+		Count:           req.GetItem().GetCount(),
+		Price:           req.GetItem().GetPrice().GetAmount(),
+		Currency:        req.GetItem().GetPrice().GetCurrency(),
+		Location:        req.GetItem().GetLocation(),
+		UpdateMask:      req.GetUpdateMask().GetPaths(),
+	}
+
+	if err := helper.ValidateRequest(&updateStockItemReq); err != nil {
+		return domain.StockItemUpdate{}, err
+	}
+
+	return updateStockItemReq.ToDomain(), nil
+}
+
 func fromGrpcDeleteStockItemReqToDomain(req *stocks.DeleteStockItemRequest) (domain.StockItem, error) {
 	deleteStockItemReq := DeleteStockItemRequest{
 		UserID: req.UserId,
@@ -40,26 +81,156 @@ func fromGrpcDeleteStockItemReqToDomain(req *stocks.DeleteStockItemRequest) (dom
 	}, nil
 }
 
-func fromGrpcGetStockItemReqToDomain(req *stocks.GetStockItemRequest) (domain.SKUID, error) {
-	getStockItemReq := GetStockItemRequest{
-		SkuID: req.SkuId,
+func fromGrpcRestoreStockItemReqToDomain(req *stocks.RestoreStockItemRequest) (domain.StockItem, error) {
+	restoreStockItemReq := RestoreStockItemRequest{
+		UserID:   req.UserId,
+		SkuID:    req.SkuId,
+		Location: req.Location,
+	}
+
+	if err := helper.ValidateRequest(&restoreStockItemReq); err != nil {
+		return domain.StockItem{}, err
 	}
 
+	return domain.StockItem{
+		UserID: domain.UserID(restoreStockItemReq.UserID),
+		Sku: domain.SKU{
+			ID: domain.SKUID(restoreStockItemReq.SkuID),
+		},
+		Location: restoreStockItemReq.Location,
+	}, nil
+}
+
+var offerRules = map[stocks.OfferRule]domain.OfferRule{
+	stocks.OfferRule_OFFER_RULE_LOWEST_PRICE: domain.OfferRuleLowestPrice,
+	stocks.OfferRule_OFFER_RULE_MOST_STOCK:   domain.OfferRuleMostStock,
+}
+
+func fromGrpcGetStockItemReqToDomain(req *stocks.GetStockItemRequest) (domain.OfferFilter, error) {
+	getStockItemReq := fromGrpcGetStockItemReqToDTO(req)
+
 	if err := helper.ValidateRequest(&getStockItemReq); err != nil {
-		return domain.SKUID(0), err
+		return domain.OfferFilter{}, err
 	}
 
-	return domain.SKUID(getStockItemReq.SkuID), nil
+	return getStockItemReq.ToDomain(), nil
+}
+
+func fromGrpcGetStockItemReqToDTO(req *stocks.GetStockItemRequest) GetStockItemRequest {
+	return GetStockItemRequest{
+		SkuID:         req.GetSkuId(),
+		Rule:          offerRules[req.GetOfferRule()],
+		SellerID:      req.GetSellerId(),
+		AllOffers:     req.GetAllOffers(),
+		Quantity:      req.GetQuantity(),
+		CustomerGroup: req.GetCustomerGroup(),
+	}
+}
+
+func fromGrpcGetStockItemsBySKUsReqToDomain(req *stocks.GetStockItemsBySKUsRequest) ([]domain.OfferFilter, error) {
+	getStockItemsReq := GetStockItemsBySKUsRequest{
+		Items: make([]GetStockItemRequest, 0, len(req.Items)),
+	}
+
+	for _, item := range req.Items {
+		getStockItemsReq.Items = append(getStockItemsReq.Items, fromGrpcGetStockItemReqToDTO(item))
+	}
+
+	if err := helper.ValidateRequest(&getStockItemsReq); err != nil {
+		return nil, err
+	}
+
+	return getStockItemsReq.ToDomain(), nil
+}
+
+func fromStockOffersListDomainToGrpc(stockOffersList []domain.StockOffers) *stocks.GetStockItemsBySKUsResponse {
+	getStockItemsResponse := &stocks.GetStockItemsBySKUsResponse{
+		Items: make([]*stocks.StockItemResponse, 0, len(stockOffersList)),
+	}
+
+	for _, stockOffers := range stockOffersList {
+		getStockItemsResponse.Items = append(getStockItemsResponse.Items, fromStockOffersDomainToGrpc(stockOffers))
+	}
+
+	return getStockItemsResponse
 }
 
 func fromStockItemDomainToGrpc(stockItem domain.StockItem) *stocks.StockItemResponse {
 	return &stocks.StockItemResponse{
-		SkuId:    uint32(stockItem.Sku.ID),
-		Name:     stockItem.Sku.Name,
-		Type:     stockItem.Sku.Type,
-		Count:    uint32(stockItem.Count),
-		Price:    stockItem.Price,
-		Location: stockItem.Location,
+		SkuId:          uint32(stockItem.Sku.ID),
+		Name:           stockItem.Sku.Name,
+		Type:           stockItem.Sku.Type,
+		Count:          stockItem.Count,
+		Price:          fromMoneyDomainToGrpc(stockItem.Price),
+		Location:       stockItem.Location,
+		SellerId:       int64(stockItem.UserID),
+		Bundle:         stockItem.Sku.IsBundle,
+		VariantGroupId: int64(stockItem.Sku.VariantGroupID),
+		Attributes:     fromSKUAttributesDomainToGrpc(stockItem.Sku.Attributes),
+		Unit:           string(stockItem.Sku.Unit),
+		Quantity:       stockItem.Sku.Unit.FormatQuantity(stockItem.Count),
+	}
+}
+
+func fromStockOffersDomainToGrpc(stockOffers domain.StockOffers) *stocks.StockItemResponse {
+	stockItemResponse := fromStockOfferDomainToGrpc(stockOffers.Best)
+
+	for _, offer := range stockOffers.Offers {
+		stockItemResponse.Offers = append(stockItemResponse.Offers, fromStockOfferDomainToGrpc(offer))
+	}
+
+	return stockItemResponse
+}
+
+// fromStockOfferDomainToGrpc converts offer with its backorder policy, quantity which can be ordered from it,
+// quantity incoming from suppliers and price tier it is priced by.
+func fromStockOfferDomainToGrpc(offer domain.StockItem) *stocks.StockItemResponse {
+	stockItemResponse := fromStockItemDomainToGrpc(offer)
+	stockItemResponse.AvailableToOrder = offer.Backorder.AvailableToOrder(offer.Count)
+	stockItemResponse.IncomingQuantity = offer.IncomingQuantity
+	stockItemResponse.Backorder = &stocks.BackorderPolicy{
+		Mode:        string(offer.Backorder.Mode),
+		MaxQuantity: offer.Backorder.MaxQuantity,
+	}
+
+	if !offer.Backorder.RestockAt.IsZero() {
+		stockItemResponse.Backorder.RestockAt = timestamppb.New(offer.Backorder.RestockAt)
+	}
+
+	if !offer.IncomingExpectedAt.IsZero() {
+		stockItemResponse.IncomingExpectedAt = timestamppb.New(offer.IncomingExpectedAt)
+	}
+
+	stockItemResponse.ListPrice = fromMoneyDomainToGrpc(offer.ListPrice)
+	if offer.Tier != nil {
+		stockItemResponse.PriceTier = fromPriceTierDomainToGrpc(*offer.Tier)
+	}
+
+	return stockItemResponse
+}
+
+func fromGrpcWatchStockReqToDomain(req *stocks.WatchStockRequest) ([]domain.SKUID, error) {
+	watchStockReq := WatchStockRequest{
+		SkuIDs: req.SkuIds,
+	}
+
+	if err := helper.ValidateRequest(&watchStockReq); err != nil {
+		return nil, err
+	}
+
+	return watchStockReq.ToDomain(), nil
+}
+
+func fromStockChangeDomainToGrpc(change domain.StockChange) *stocks.StockChangeEvent {
+	return &stocks.StockChangeEvent{
+		UserId:    int64(change.UserID),
+		SkuId:     uint32(change.SkuID),
+		Location:  change.Location,
+		Count:     change.Count,
+		Price:     fromMoneyDomainToGrpc(change.Price),
+		Deleted:   change.Deleted,
+		Snapshot:  change.Snapshot,
+		ChangedAt: timestamppb.New(change.ChangedAt),
 	}
 }
 
@@ -69,6 +240,7 @@ func fromGrpcListStockItemsFilterToDomain(filter *stocks.FilterRequest) (domain.
 		Location:    filter.Location,
 		PageSize:    filter.PageSize,
 		CurrentPage: filter.CurrentPage,
+		Attributes:  filter.Attributes,
 	}
 
 	if err := helper.ValidateRequest(&filterRequest); err != nil {
@@ -78,7 +250,7 @@ func fromGrpcListStockItemsFilterToDomain(filter *stocks.FilterRequest) (domain.
 	return filterRequest.ToDomain(), nil
 }
 
-func fromListStockItemsDomainToGrpc(stockItems []domain.StockItem, totalCount uint16, pageNumber int64) *stocks.ListStockItemsResponse {
+func fromListStockItemsDomainToGrpc(stockItems []domain.StockItem, totalCount uint32, pageNumber int64) *stocks.ListStockItemsResponse {
 	stockItemResponses := make([]*stocks.StockItemResponse, 0, len(stockItems))
 
 	for _, stockItem := range stockItems {
@@ -87,7 +259,904 @@ func fromListStockItemsDomainToGrpc(stockItems []domain.StockItem, totalCount ui
 
 	return &stocks.ListStockItemsResponse{
 		Items:      stockItemResponses,
-		TotalCount: uint32(totalCount),
+		TotalCount: totalCount,
 		PageNumber: pageNumber,
 	}
 }
+
+func fromGrpcSearchSKUsReqToDomain(req *stocks.SearchSKUsRequest) (domain.SKUSearchFilter, error) {
+	searchSKUsReq := SearchSKUsRequest{
+		Query:       strings.TrimSpace(req.Query),
+		Types:       req.Types,
+		PageSize:    req.PageSize,
+		CurrentPage: req.CurrentPage,
+		Attributes:  req.Attributes,
+	}
+
+	if err := helper.ValidateRequest(&searchSKUsReq); err != nil {
+		return domain.SKUSearchFilter{}, err
+	}
+
+	return searchSKUsReq.ToDomain(), nil
+}
+
+func fromSKUSearchResponseDomainToGrpc(searchResponse domain.SKUSearchResponse) *stocks.SearchSKUsResponse {
+	searchResults := make([]*stocks.SKUSearchResult, 0, len(searchResponse.Items))
+
+	for _, searchResult := range searchResponse.Items {
+		searchResults = append(searchResults, &stocks.SKUSearchResult{
+			SkuId:          uint32(searchResult.Sku.ID),
+			Name:           searchResult.Sku.Name,
+			Type:           searchResult.Sku.Type,
+			VariantGroupId: int64(searchResult.Sku.VariantGroupID),
+			Attributes:     fromSKUAttributesDomainToGrpc(searchResult.Sku.Attributes),
+			Rank:           searchResult.Rank,
+			Highlight:      searchResult.Highlight,
+			AvailableCount: searchResult.AvailableCount,
+		})
+	}
+
+	typeFacets := make([]*stocks.TypeFacet, 0, len(searchResponse.Facets))
+
+	for _, typeFacet := range searchResponse.Facets {
+		typeFacets = append(typeFacets, &stocks.TypeFacet{
+			Type:  typeFacet.Type,
+			Count: typeFacet.Count,
+		})
+	}
+
+	return &stocks.SearchSKUsResponse{
+		Items:      searchResults,
+		Facets:     typeFacets,
+		TotalCount: searchResponse.TotalCount,
+		PageNumber: searchResponse.PageNumber,
+	}
+}
+
+func fromGrpcSetStockThresholdReqToDomain(req *stocks.SetStockThresholdRequest) (domain.StockThreshold, error) {
+	setStockThresholdReq := SetStockThresholdRequest{
+		SkuID:            req.SkuId,
+		Location:         req.Location,
+		ReorderThreshold: req.ReorderThreshold,
+		Hysteresis:       req.Hysteresis,
+	}
+
+	if err := helper.ValidateRequest(&setStockThresholdReq); err != nil {
+		return domain.StockThreshold{}, err
+	}
+
+	return setStockThresholdReq.ToDomain(), nil
+}
+
+func fromGrpcSetBundleReqToDomain(req *stocks.SetBundleRequest) (domain.Bundle, error) {
+	setBundleReq := SetBundleRequest{
+		BundleSkuID: req.BundleSkuId,
+		Components:  make([]BundleComponentRequest, 0, len(req.Components)),
+	}
+
+	for _, component := range req.Components {
+		setBundleReq.Components = append(setBundleReq.Components, BundleComponentRequest{
+			SkuID:    component.SkuId,
+			Quantity: component.Quantity,
+		})
+	}
+
+	if err := helper.ValidateRequest(&setBundleReq); err != nil {
+		return domain.Bundle{}, err
+	}
+
+	return setBundleReq.ToDomain(), nil
+}
+
+func fromGrpcGetBundleReqToDomain(req *stocks.GetBundleRequest) (domain.SKUID, error) {
+	getBundleReq := GetBundleRequest{BundleSkuID: req.BundleSkuId}
+
+	if err := helper.ValidateRequest(&getBundleReq); err != nil {
+		return 0, err
+	}
+
+	return domain.SKUID(getBundleReq.BundleSkuID), nil
+}
+
+func fromBundleDomainToGrpc(bundle domain.Bundle) *stocks.BundleResponse {
+	components := make([]*stocks.BundleComponent, 0, len(bundle.Components))
+	for _, component := range bundle.Components {
+		components = append(components, &stocks.BundleComponent{
+			SkuId:    uint32(component.SkuID),
+			Quantity: component.Quantity,
+		})
+	}
+
+	return &stocks.BundleResponse{
+		BundleSkuId: uint32(bundle.Sku.ID),
+		Name:        bundle.Sku.Name,
+		Type:        bundle.Sku.Type,
+		Components:  components,
+	}
+}
+
+var attributeTypes = map[stocks.AttributeType]domain.AttributeType{
+	stocks.AttributeType_ATTRIBUTE_TYPE_STRING:  domain.AttributeTypeString,
+	stocks.AttributeType_ATTRIBUTE_TYPE_NUMBER:  domain.AttributeTypeNumber,
+	stocks.AttributeType_ATTRIBUTE_TYPE_BOOLEAN: domain.AttributeTypeBoolean,
+	stocks.AttributeType_ATTRIBUTE_TYPE_ENUM:    domain.AttributeTypeEnum,
+}
+
+// fromSKUAttributesDomainToGrpc converts attributes decoded from json, they are always representable as struct.
+func fromSKUAttributesDomainToGrpc(attributes domain.SKUAttributes) *structpb.Struct {
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	attributesStruct, err := structpb.NewStruct(attributes)
+	if err != nil {
+		return nil
+	}
+
+	return attributesStruct
+}
+
+func fromGrpcSetAttributeSchemaReqToDomain(req *stocks.SetAttributeSchemaRequest) (domain.AttributeSchema, error) {
+	setAttributeSchemaReq := SetAttributeSchemaRequest{
+		Type:       req.Type,
+		Attributes: make([]AttributeDefinitionRequest, 0, len(req.Attributes)),
+	}
+
+	for _, definition := range req.Attributes {
+		setAttributeSchemaReq.Attributes = append(setAttributeSchemaReq.Attributes, AttributeDefinitionRequest{
+			Name:          definition.Name,
+			Type:          attributeTypes[definition.Type],
+			Required:      definition.Required,
+			AllowedValues: definition.AllowedValues,
+		})
+	}
+
+	if err := helper.ValidateRequest(&setAttributeSchemaReq); err != nil {
+		return domain.AttributeSchema{}, err
+	}
+
+	return setAttributeSchemaReq.ToDomain(), nil
+}
+
+func fromGrpcCreateVariantGroupReqToDomain(req *stocks.CreateVariantGroupRequest) (domain.VariantGroup, error) {
+	createVariantGroupReq := CreateVariantGroupRequest{
+		Name: req.Name,
+		Type: req.Type,
+		Axes: req.Axes,
+	}
+
+	if err := helper.ValidateRequest(&createVariantGroupReq); err != nil {
+		return domain.VariantGroup{}, err
+	}
+
+	return createVariantGroupReq.ToDomain(), nil
+}
+
+func fromGrpcGetVariantGroupReqToDomain(req *stocks.GetVariantGroupRequest) (domain.VariantGroupID, error) {
+	getVariantGroupReq := GetVariantGroupRequest{VariantGroupID: req.VariantGroupId}
+
+	if err := helper.ValidateRequest(&getVariantGroupReq); err != nil {
+		return 0, err
+	}
+
+	return domain.VariantGroupID(getVariantGroupReq.VariantGroupID), nil
+}
+
+func fromGrpcUpdateSKUAttributesReqToDomain(req *stocks.UpdateSKUAttributesRequest) (domain.SKUAttributesUpdate, error) {
+	updateSKUAttributesReq := UpdateSKUAttributesRequest{
+		SkuID:          req.SkuId,
+		VariantGroupID: req.VariantGroupId,
+		Attributes:     req.Attributes.AsMap(),
+		Unit:           req.Unit,
+	}
+
+	if err := helper.ValidateRequest(&updateSKUAttributesReq); err != nil {
+		return domain.SKUAttributesUpdate{}, err
+	}
+
+	return updateSKUAttributesReq.ToDomain(), nil
+}
+
+func fromSKUDomainToGrpc(sku domain.SKU) *stocks.SKUResponse {
+	return &stocks.SKUResponse{
+		SkuId:          uint32(sku.ID),
+		Name:           sku.Name,
+		Type:           sku.Type,
+		VariantGroupId: int64(sku.VariantGroupID),
+		Attributes:     fromSKUAttributesDomainToGrpc(sku.Attributes),
+		Unit:           string(sku.Unit),
+	}
+}
+
+func fromVariantGroupDomainToGrpc(group domain.VariantGroup) *stocks.VariantGroupResponse {
+	variants := make([]*stocks.SKUResponse, 0, len(group.Variants))
+	for _, variant := range group.Variants {
+		variants = append(variants, fromSKUDomainToGrpc(variant))
+	}
+
+	return &stocks.VariantGroupResponse{
+		VariantGroupId: int64(group.ID),
+		Name:           group.Name,
+		Type:           group.SkuType,
+		Axes:           group.Axes,
+		Variants:       variants,
+	}
+}
+
+func fromGrpcListLowStockReqToDomain(req *stocks.ListLowStockRequest) (domain.LowStockFilter, error) {
+	listLowStockReq := ListLowStockRequest{
+		Location:    req.Location,
+		PageSize:    req.PageSize,
+		CurrentPage: req.CurrentPage,
+	}
+
+	if err := helper.ValidateRequest(&listLowStockReq); err != nil {
+		return domain.LowStockFilter{}, err
+	}
+
+	return listLowStockReq.ToDomain(), nil
+}
+
+func fromListLowStockDomainToGrpc(lowStockItems domain.PaginatedResponse[domain.LowStockItem]) *stocks.ListLowStockResponse {
+	lowStockItemResponses := make([]*stocks.LowStockItemResponse, 0, len(lowStockItems.Items))
+
+	for _, lowStockItem := range lowStockItems.Items {
+		lowStockItemResponses = append(lowStockItemResponses, &stocks.LowStockItemResponse{
+			Item:             fromStockItemDomainToGrpc(lowStockItem.StockItem),
+			UserId:           int64(lowStockItem.UserID),
+			StockLevel:       string(lowStockItem.Level),
+			ReorderThreshold: lowStockItem.ReorderThreshold,
+		})
+	}
+
+	return &stocks.ListLowStockResponse{
+		Items:      lowStockItemResponses,
+		TotalCount: lowStockItems.TotalCount,
+		PageNumber: lowStockItems.PageNumber,
+	}
+}
+
+var adjustmentReasons = map[stocks.AdjustmentReason]domain.AdjustmentReason{
+	stocks.AdjustmentReason_ADJUSTMENT_REASON_RECEIVED:   domain.AdjustmentReasonReceived,
+	stocks.AdjustmentReason_ADJUSTMENT_REASON_SOLD:       domain.AdjustmentReasonSold,
+	stocks.AdjustmentReason_ADJUSTMENT_REASON_RETURNED:   domain.AdjustmentReasonReturned,
+	stocks.AdjustmentReason_ADJUSTMENT_REASON_DAMAGED:    domain.AdjustmentReasonDamaged,
+	stocks.AdjustmentReason_ADJUSTMENT_REASON_LOST:       domain.AdjustmentReasonLost,
+	stocks.AdjustmentReason_ADJUSTMENT_REASON_CORRECTION: domain.AdjustmentReasonCorrection,
+}
+
+func fromGrpcAdjustStockReqToDomain(req *stocks.AdjustStockRequest) (domain.StockAdjustment, error) {
+	adjustStockReq := AdjustStockRequest{
+		UserID:   req.UserId,
+		SkuID:    req.SkuId,
+		Location: req.Location,
+		Delta:    req.Delta,
+		Reason:   adjustmentReasons[req.Reason],
+		Note:     req.Note,
+	}
+
+	if err := helper.ValidateRequest(&adjustStockReq); err != nil {
+		return domain.StockAdjustment{}, err
+	}
+
+	return adjustStockReq.ToDomain(), nil
+}
+
+func fromStockAdjustmentResultDomainToGrpc(adjustmentResult domain.StockAdjustmentResult) *stocks.AdjustStockResponse {
+	lotAllocationResponses := make([]*stocks.LotAllocation, 0, len(adjustmentResult.Lots))
+
+	for _, lotAllocation := range adjustmentResult.Lots {
+		lotAllocationResponse := &stocks.LotAllocation{
+			LotId:     int64(lotAllocation.LotID),
+			LotNumber: lotAllocation.LotNumber,
+			Quantity:  lotAllocation.Quantity,
+		}
+
+		if !lotAllocation.ExpiresAt.IsZero() {
+			lotAllocationResponse.ExpiresAt = timestamppb.New(lotAllocation.ExpiresAt)
+		}
+
+		lotAllocationResponses = append(lotAllocationResponses, lotAllocationResponse)
+	}
+
+	return &stocks.AdjustStockResponse{
+		SkuId:    uint32(adjustmentResult.Sku.ID),
+		Location: adjustmentResult.Location,
+		Quantity: adjustmentResult.Quantity,
+		Lots:     lotAllocationResponses,
+	}
+}
+
+func fromGrpcListExpiringLotsReqToDomain(req *stocks.ListExpiringLotsRequest) (domain.ExpiringLotsFilter, error) {
+	listExpiringLotsReq := ListExpiringLotsRequest{
+		WithinDays:  req.WithinDays,
+		UserID:      req.UserId,
+		Location:    req.Location,
+		PageSize:    req.PageSize,
+		CurrentPage: req.CurrentPage,
+	}
+
+	if err := helper.ValidateRequest(&listExpiringLotsReq); err != nil {
+		return domain.ExpiringLotsFilter{}, err
+	}
+
+	return listExpiringLotsReq.ToDomain(time.Now()), nil
+}
+
+func fromListExpiringLotsDomainToGrpc(lots domain.PaginatedResponse[domain.StockLot]) *stocks.ListExpiringLotsResponse {
+	lotResponses := make([]*stocks.StockLotResponse, 0, len(lots.Items))
+
+	for _, lot := range lots.Items {
+		lotResponses = append(lotResponses, &stocks.StockLotResponse{
+			LotId:      int64(lot.ID),
+			UserId:     int64(lot.UserID),
+			SkuId:      uint32(lot.Sku.ID),
+			Name:       lot.Sku.Name,
+			Location:   lot.Location,
+			LotNumber:  lot.LotNumber,
+			ReceivedAt: timestamppb.New(lot.ReceivedAt),
+			ExpiresAt:  timestamppb.New(lot.ExpiresAt),
+			Quantity:   lot.Quantity,
+		})
+	}
+
+	return &stocks.ListExpiringLotsResponse{
+		Lots:       lotResponses,
+		TotalCount: lots.TotalCount,
+		PageNumber: lots.PageNumber,
+	}
+}
+
+func fromGrpcBackorderSettingsReqToDomain(req *stocks.BackorderSettingsRequest) (domain.BackorderSettings, error) {
+	backorderSettingsReq := BackorderSettingsRequest{
+		UserID:      req.UserId,
+		SkuID:       req.SkuId,
+		Location:    req.Location,
+		Mode:        req.GetPolicy().GetMode(),
+		MaxQuantity: req.GetPolicy().GetMaxQuantity(),
+	}
+
+	// requests of clients which know only backorders_enabled flag.
+	if req.Policy == nil {
+		backorderSettingsReq.Mode = string(domain.BackorderModeNone)
+		if req.BackordersEnabled {
+			backorderSettingsReq.Mode = string(domain.BackorderModeBackorder)
+		}
+	}
+
+	if req.GetPolicy().GetRestockAt() != nil {
+		backorderSettingsReq.RestockAt = req.GetPolicy().GetRestockAt().AsTime()
+	}
+
+	if err := helper.ValidateRequest(&backorderSettingsReq); err != nil {
+		return domain.BackorderSettings{}, err
+	}
+
+	return backorderSettingsReq.ToDomain(), nil
+}
+
+func fromGrpcTransferStockReqToDomain(req *stocks.TransferStockRequest) (domain.StockTransfer, bool, error) {
+	transferStockReq := TransferStockRequest{
+		UserID:             req.UserId,
+		SkuID:              req.SkuId,
+		FromLocation:       req.FromLocation,
+		ToLocation:         req.ToLocation,
+		Quantity:           req.Quantity,
+		ReceiveImmediately: req.ReceiveImmediately,
+	}
+
+	if err := helper.ValidateRequest(&transferStockReq); err != nil {
+		return domain.StockTransfer{}, false, err
+	}
+
+	return transferStockReq.ToDomain(), transferStockReq.ReceiveImmediately, nil
+}
+
+func fromGrpcReceiveStockTransferReqToDomain(req *stocks.ReceiveStockTransferRequest) (domain.UserID, domain.TransferID, error) {
+	receiveStockTransferReq := ReceiveStockTransferRequest{
+		UserID:     req.UserId,
+		TransferID: req.TransferId,
+	}
+
+	if err := helper.ValidateRequest(&receiveStockTransferReq); err != nil {
+		return 0, 0, err
+	}
+
+	return domain.UserID(receiveStockTransferReq.UserID), domain.TransferID(receiveStockTransferReq.TransferID), nil
+}
+
+func fromStockTransferDomainToGrpc(transfer domain.StockTransfer) *stocks.StockTransferResponse {
+	stockTransferResponse := &stocks.StockTransferResponse{
+		TransferId:   int64(transfer.ID),
+		SkuId:        uint32(transfer.SkuID),
+		FromLocation: transfer.FromLocation,
+		ToLocation:   transfer.ToLocation,
+		Quantity:     transfer.Quantity,
+		Status:       string(transfer.Status),
+		ShippedAt:    timestamppb.New(transfer.ShippedAt),
+	}
+
+	if !transfer.ReceivedAt.IsZero() {
+		stockTransferResponse.ReceivedAt = timestamppb.New(transfer.ReceivedAt)
+	}
+
+	return stockTransferResponse
+}
+
+func fromGrpcOpenCycleCountReqToDomain(req *stocks.OpenCycleCountRequest, openedBy domain.UserID) (domain.CycleCount, error) {
+	openCycleCountReq := OpenCycleCountRequest{
+		Location: req.Location,
+		UserID:   req.UserId,
+		Note:     req.Note,
+	}
+
+	if err := helper.ValidateRequest(&openCycleCountReq); err != nil {
+		return domain.CycleCount{}, err
+	}
+
+	return openCycleCountReq.ToDomain(openedBy), nil
+}
+
+func fromGrpcSubmitCycleCountsReqToDomain(
+	req *stocks.SubmitCycleCountsRequest,
+	countedBy domain.UserID,
+) (domain.CycleCountSubmission, error) {
+	submitCycleCountsReq := SubmitCycleCountsRequest{
+		CycleCountID: req.CycleCountId,
+		Counts:       make([]CountedQuantityRequest, 0, len(req.Counts)),
+	}
+
+	for _, count := range req.Counts {
+		submitCycleCountsReq.Counts = append(submitCycleCountsReq.Counts, CountedQuantityRequest{
+			UserID:          count.UserId,
+			SkuID:           count.SkuId,
+			CountedQuantity: count.CountedQuantity,
+		})
+	}
+
+	if err := helper.ValidateRequest(&submitCycleCountsReq); err != nil {
+		return domain.CycleCountSubmission{}, err
+	}
+
+	return submitCycleCountsReq.ToDomain(countedBy), nil
+}
+
+func fromGrpcCycleCountIDToDomain(cycleCountID int64) (domain.CycleCountID, error) {
+	cycleCountIDReq := CycleCountIDRequest{CycleCountID: cycleCountID}
+
+	if err := helper.ValidateRequest(&cycleCountIDReq); err != nil {
+		return 0, err
+	}
+
+	return domain.CycleCountID(cycleCountIDReq.CycleCountID), nil
+}
+
+func fromCycleCountDomainToGrpc(cycleCount domain.CycleCount) *stocks.CycleCountResponse {
+	cycleCountResponse := &stocks.CycleCountResponse{
+		CycleCountId: int64(cycleCount.ID),
+		Location:     cycleCount.Location,
+		UserId:       int64(cycleCount.UserID),
+		Status:       string(cycleCount.Status),
+		Note:         cycleCount.Note,
+		OpenedBy:     int64(cycleCount.OpenedBy),
+		OpenedAt:     timestamppb.New(cycleCount.OpenedAt),
+		ApprovedBy:   int64(cycleCount.ApprovedBy),
+		Lines:        make([]*stocks.CycleCountLine, 0, len(cycleCount.Lines)),
+		Events:       make([]*stocks.CycleCountEvent, 0, len(cycleCount.Events)),
+	}
+
+	if !cycleCount.ApprovedAt.IsZero() {
+		cycleCountResponse.ApprovedAt = timestamppb.New(cycleCount.ApprovedAt)
+	}
+
+	for _, line := range cycleCount.Lines {
+		cycleCountResponse.Lines = append(cycleCountResponse.Lines, &stocks.CycleCountLine{
+			UserId:          int64(line.UserID),
+			SkuId:           uint32(line.Sku.ID),
+			Name:            line.Sku.Name,
+			SystemQuantity:  line.SystemQuantity,
+			CountedQuantity: line.CountedQuantity,
+			Variance:        line.Variance(),
+			CountedBy:       int64(line.CountedBy),
+			CountedAt:       timestamppb.New(line.CountedAt),
+			QuantityAfter:   line.QuantityAfter,
+		})
+	}
+
+	for _, event := range cycleCount.Events {
+		cycleCountResponse.Events = append(cycleCountResponse.Events, &stocks.CycleCountEvent{
+			Action:          string(event.Action),
+			ActorId:         int64(event.ActorID),
+			UserId:          int64(event.UserID),
+			SkuId:           uint32(event.SkuID),
+			SystemQuantity:  event.SystemQuantity,
+			CountedQuantity: event.CountedQuantity,
+			CreatedAt:       timestamppb.New(event.CreatedAt),
+		})
+	}
+
+	return cycleCountResponse
+}
+
+func fromGrpcCreateSupplierReqToDomain(req *stocks.CreateSupplierRequest) (domain.Supplier, error) {
+	createSupplierReq := CreateSupplierRequest{
+		UserID:       req.UserId,
+		Name:         req.Name,
+		Email:        req.Email,
+		Phone:        req.Phone,
+		LeadTimeDays: req.LeadTimeDays,
+	}
+
+	if err := helper.ValidateRequest(&createSupplierReq); err != nil {
+		return domain.Supplier{}, err
+	}
+
+	return createSupplierReq.ToDomain(), nil
+}
+
+func fromGrpcListSuppliersReqToDomain(req *stocks.ListSuppliersRequest) (domain.UserID, error) {
+	listSuppliersReq := ListSuppliersRequest{UserID: req.UserId}
+
+	if err := helper.ValidateRequest(&listSuppliersReq); err != nil {
+		return 0, err
+	}
+
+	return domain.UserID(listSuppliersReq.UserID), nil
+}
+
+func fromSupplierDomainToGrpc(supplier domain.Supplier) *stocks.SupplierResponse {
+	return &stocks.SupplierResponse{
+		SupplierId:   int64(supplier.ID),
+		UserId:       int64(supplier.UserID),
+		Name:         supplier.Name,
+		Email:        supplier.Email,
+		Phone:        supplier.Phone,
+		LeadTimeDays: supplier.LeadTimeDays,
+		CreatedAt:    timestamppb.New(supplier.CreatedAt),
+	}
+}
+
+func fromSuppliersDomainToGrpc(suppliers []domain.Supplier) *stocks.ListSuppliersResponse {
+	listSuppliersResponse := &stocks.ListSuppliersResponse{
+		Suppliers: make([]*stocks.SupplierResponse, 0, len(suppliers)),
+	}
+
+	for _, supplier := range suppliers {
+		listSuppliersResponse.Suppliers = append(listSuppliersResponse.Suppliers, fromSupplierDomainToGrpc(supplier))
+	}
+
+	return listSuppliersResponse
+}
+
+func fromGrpcCreatePurchaseOrderReqToDomain(
+	req *stocks.CreatePurchaseOrderRequest,
+	createdBy domain.UserID,
+) (domain.PurchaseOrder, error) {
+	createPurchaseOrderReq := CreatePurchaseOrderRequest{
+		UserID:     req.UserId,
+		SupplierID: req.SupplierId,
+		Location:   req.Location,
+		Note:       req.Note,
+		Lines:      make([]PurchaseOrderLineRequest, 0, len(req.Lines)),
+	}
+
+	if req.ExpectedAt != nil {
+		createPurchaseOrderReq.ExpectedAt = req.ExpectedAt.AsTime()
+	}
+
+	for _, line := range req.Lines {
+		createPurchaseOrderReq.Lines = append(createPurchaseOrderReq.Lines, PurchaseOrderLineRequest{
+			SkuID:    line.SkuId,
+			Quantity: line.Quantity,
+			Price:    line.GetPrice().GetAmount(),
+			Currency: line.GetPrice().GetCurrency(),
+		})
+	}
+
+	if err := helper.ValidateRequest(&createPurchaseOrderReq); err != nil {
+		return domain.PurchaseOrder{}, err
+	}
+
+	return createPurchaseOrderReq.ToDomain(createdBy), nil
+}
+
+func fromGrpcPurchaseOrderIDToDomain(purchaseOrderID int64) (domain.PurchaseOrderID, error) {
+	purchaseOrderIDReq := PurchaseOrderIDRequest{PurchaseOrderID: purchaseOrderID}
+
+	if err := helper.ValidateRequest(&purchaseOrderIDReq); err != nil {
+		return 0, err
+	}
+
+	return domain.PurchaseOrderID(purchaseOrderIDReq.PurchaseOrderID), nil
+}
+
+func fromGrpcReceivePurchaseOrderReqToDomain(
+	req *stocks.ReceivePurchaseOrderRequest,
+	receivedBy domain.UserID,
+) (domain.PurchaseOrderReceipt, error) {
+	receivePurchaseOrderReq := ReceivePurchaseOrderRequest{
+		PurchaseOrderID: req.PurchaseOrderId,
+		Lines:           make([]ReceivedLineRequest, 0, len(req.Lines)),
+	}
+
+	for _, line := range req.Lines {
+		receivedLineReq := ReceivedLineRequest{
+			SkuID:     line.SkuId,
+			Quantity:  line.Quantity,
+			LotNumber: line.LotNumber,
+		}
+
+		if line.ExpiresAt != nil {
+			receivedLineReq.ExpiresAt = line.ExpiresAt.AsTime()
+		}
+
+		receivePurchaseOrderReq.Lines = append(receivePurchaseOrderReq.Lines, receivedLineReq)
+	}
+
+	if err := helper.ValidateRequest(&receivePurchaseOrderReq); err != nil {
+		return domain.PurchaseOrderReceipt{}, err
+	}
+
+	return receivePurchaseOrderReq.ToDomain(receivedBy), nil
+}
+
+func fromPurchaseOrderDomainToGrpc(purchaseOrder domain.PurchaseOrder) *stocks.PurchaseOrderResponse {
+	purchaseOrderResponse := &stocks.PurchaseOrderResponse{
+		PurchaseOrderId: int64(purchaseOrder.ID),
+		UserId:          int64(purchaseOrder.UserID),
+		SupplierId:      int64(purchaseOrder.SupplierID),
+		Location:        purchaseOrder.Location,
+		Status:          string(purchaseOrder.Status),
+		Note:            purchaseOrder.Note,
+		CreatedBy:       int64(purchaseOrder.CreatedBy),
+		CreatedAt:       timestamppb.New(purchaseOrder.CreatedAt),
+		Lines:           make([]*stocks.PurchaseOrderLine, 0, len(purchaseOrder.Lines)),
+	}
+
+	if !purchaseOrder.OrderedAt.IsZero() {
+		purchaseOrderResponse.OrderedAt = timestamppb.New(purchaseOrder.OrderedAt)
+	}
+
+	if !purchaseOrder.ExpectedAt.IsZero() {
+		purchaseOrderResponse.ExpectedAt = timestamppb.New(purchaseOrder.ExpectedAt)
+	}
+
+	if !purchaseOrder.ReceivedAt.IsZero() {
+		purchaseOrderResponse.ReceivedAt = timestamppb.New(purchaseOrder.ReceivedAt)
+	}
+
+	for _, line := range purchaseOrder.Lines {
+		purchaseOrderResponse.Lines = append(purchaseOrderResponse.Lines, &stocks.PurchaseOrderLine{
+			SkuId:               uint32(line.Sku.ID),
+			Name:                line.Sku.Name,
+			Quantity:            line.Quantity,
+			Price:               fromMoneyDomainToGrpc(line.Price),
+			ReceivedQuantity:    line.ReceivedQuantity,
+			OutstandingQuantity: line.Outstanding(),
+		})
+	}
+
+	return purchaseOrderResponse
+}
+
+func fromGrpcGetReorderSuggestionsReqToDomain(req *stocks.GetReorderSuggestionsRequest) (domain.ReorderFilter, error) {
+	getReorderSuggestionsReq := GetReorderSuggestionsRequest{
+		UserID:          req.UserId,
+		Location:        req.Location,
+		SkuID:           req.SkuId,
+		WindowDays:      req.WindowDays,
+		LeadTimeDays:    req.LeadTimeDays,
+		SafetyStockDays: req.SafetyStockDays,
+		CoverDays:       req.CoverDays,
+	}
+
+	if err := helper.ValidateRequest(&getReorderSuggestionsReq); err != nil {
+		return domain.ReorderFilter{}, err
+	}
+
+	return getReorderSuggestionsReq.ToDomain(), nil
+}
+
+func fromReorderSuggestionsDomainToGrpc(suggestions []domain.ReorderSuggestion) *stocks.GetReorderSuggestionsResponse {
+	getReorderSuggestionsResponse := &stocks.GetReorderSuggestionsResponse{
+		Suggestions: make([]*stocks.ReorderSuggestion, 0, len(suggestions)),
+	}
+
+	for _, suggestion := range suggestions {
+		getReorderSuggestionsResponse.Suggestions = append(getReorderSuggestionsResponse.Suggestions, &stocks.ReorderSuggestion{
+			SkuId:             uint32(suggestion.Sku.ID),
+			Name:              suggestion.Sku.Name,
+			Location:          suggestion.Location,
+			SellerId:          int64(suggestion.UserID),
+			Unit:              string(suggestion.Sku.Unit),
+			Count:             suggestion.Count,
+			IncomingQuantity:  suggestion.IncomingQuantity,
+			SoldQuantity:      suggestion.SoldQuantity,
+			CartAddedQuantity: suggestion.CartAddedQuantity,
+			SalesVelocity:     suggestion.SalesVelocity,
+			DaysOfCover:       suggestion.DaysOfCover,
+			LeadTimeDays:      suggestion.LeadTimeDays,
+			SafetyStock:       suggestion.SafetyStock,
+			ReorderPoint:      suggestion.ReorderPoint,
+			SuggestedQuantity: suggestion.SuggestedQuantity,
+		})
+	}
+
+	return getReorderSuggestionsResponse
+}
+
+func fromGrpcSchedulePriceChangeReqToDomain(req *stocks.SchedulePriceChangeRequest) (domain.ScheduledPriceChange, error) {
+	schedulePriceChangeReq := SchedulePriceChangeRequest{
+		UserID:   req.UserId,
+		SkuID:    req.SkuId,
+		Location: req.Location,
+		NewPrice: req.GetNewPrice().GetAmount(),
+		Currency: req.GetNewPrice().GetCurrency(),
+	}
+
+	if req.EffectiveAt != nil {
+		schedulePriceChangeReq.EffectiveAt = req.EffectiveAt.AsTime()
+	}
+
+	if err := helper.ValidateRequest(&schedulePriceChangeReq); err != nil {
+		return domain.ScheduledPriceChange{}, err
+	}
+
+	return schedulePriceChangeReq.ToDomain(), nil
+}
+
+func fromGrpcSetPriceTiersReqToDomain(req *stocks.SetPriceTiersRequest) (domain.PriceList, error) {
+	setPriceTiersReq := SetPriceTiersRequest{
+		UserID:        req.UserId,
+		SkuID:         req.SkuId,
+		Location:      req.Location,
+		CustomerGroup: req.CustomerGroup,
+		Tiers:         make([]PriceTierRequest, 0, len(req.Tiers)),
+	}
+
+	for _, tier := range req.Tiers {
+		setPriceTiersReq.Tiers = append(setPriceTiersReq.Tiers, PriceTierRequest{
+			MinQuantity: tier.MinQuantity,
+			Price:       tier.GetPrice().GetAmount(),
+			Currency:    tier.GetPrice().GetCurrency(),
+		})
+	}
+
+	if err := helper.ValidateRequest(&setPriceTiersReq); err != nil {
+		return domain.PriceList{}, err
+	}
+
+	return setPriceTiersReq.ToDomain(), nil
+}
+
+func fromGrpcGetPriceTiersReqToDomain(req *stocks.GetPriceTiersRequest) (domain.UserID, domain.SKUID, string, error) {
+	getPriceTiersReq := GetPriceTiersRequest{
+		UserID:   req.UserId,
+		SkuID:    req.SkuId,
+		Location: req.Location,
+	}
+
+	if err := helper.ValidateRequest(&getPriceTiersReq); err != nil {
+		return 0, 0, "", err
+	}
+
+	return domain.UserID(getPriceTiersReq.UserID), domain.SKUID(getPriceTiersReq.SkuID), getPriceTiersReq.Location, nil
+}
+
+func fromPriceTierDomainToGrpc(priceTier domain.PriceTier) *stocks.PriceTier {
+	return &stocks.PriceTier{
+		CustomerGroup: priceTier.CustomerGroup,
+		MinQuantity:   priceTier.MinQuantity,
+		Price:         fromMoneyDomainToGrpc(priceTier.Price),
+	}
+}
+
+func fromPriceTiersDomainToGrpc(priceTiers []domain.PriceTier) *stocks.PriceTiersResponse {
+	priceTiersResponse := &stocks.PriceTiersResponse{
+		Tiers: make([]*stocks.PriceTier, 0, len(priceTiers)),
+	}
+
+	for _, priceTier := range priceTiers {
+		priceTiersResponse.Tiers = append(priceTiersResponse.Tiers, fromPriceTierDomainToGrpc(priceTier))
+	}
+
+	return priceTiersResponse
+}
+
+func fromScheduledPriceChangeDomainToGrpc(priceChange domain.ScheduledPriceChange) *stocks.ScheduledPriceChangeResponse {
+	return &stocks.ScheduledPriceChangeResponse{
+		Id:          int64(priceChange.ID),
+		UserId:      int64(priceChange.UserID),
+		SkuId:       uint32(priceChange.SkuID),
+		Location:    priceChange.Location,
+		NewPrice:    fromMoneyDomainToGrpc(priceChange.NewPrice),
+		EffectiveAt: timestamppb.New(priceChange.EffectiveAt),
+		Status:      string(priceChange.Status),
+	}
+}
+
+func fromGrpcGetPriceHistoryReqToDomain(req *stocks.GetPriceHistoryRequest) (domain.PriceHistoryFilter, error) {
+	getPriceHistoryReq := GetPriceHistoryRequest{
+		SkuID:       req.SkuId,
+		UserID:      req.UserId,
+		Location:    req.Location,
+		PageSize:    req.PageSize,
+		CurrentPage: req.CurrentPage,
+	}
+
+	if err := helper.ValidateRequest(&getPriceHistoryReq); err != nil {
+		return domain.PriceHistoryFilter{}, err
+	}
+
+	return getPriceHistoryReq.ToDomain(), nil
+}
+
+func fromPriceHistoryDomainToGrpc(priceHistory domain.PriceHistory) *stocks.PriceHistoryResponse {
+	priceHistoryEntries := make([]*stocks.PriceHistoryEntry, 0, len(priceHistory.Entries))
+
+	for _, priceHistoryEntry := range priceHistory.Entries {
+		entry := &stocks.PriceHistoryEntry{
+			UserId:    int64(priceHistoryEntry.UserID),
+			Location:  priceHistoryEntry.Location,
+			NewPrice:  fromMoneyDomainToGrpc(priceHistoryEntry.NewPrice),
+			ChangedAt: timestamppb.New(priceHistoryEntry.ChangedAt),
+		}
+
+		if priceHistoryEntry.OldPrice != nil {
+			entry.OldPrice = fromMoneyDomainToGrpc(*priceHistoryEntry.OldPrice)
+		}
+
+		priceHistoryEntries = append(priceHistoryEntries, entry)
+	}
+
+	scheduledPriceChanges := make([]*stocks.ScheduledPriceChangeResponse, 0, len(priceHistory.Scheduled))
+
+	for _, priceChange := range priceHistory.Scheduled {
+		scheduledPriceChanges = append(scheduledPriceChanges, fromScheduledPriceChangeDomainToGrpc(priceChange))
+	}
+
+	return &stocks.PriceHistoryResponse{
+		Entries:   priceHistoryEntries,
+		Scheduled: scheduledPriceChanges,
+	}
+}
+
+var valuationDimensions = map[stocks.ValuationDimension]domain.ValuationDimension{
+	stocks.ValuationDimension_VALUATION_DIMENSION_LOCATION: domain.ValuationDimensionLocation,
+	stocks.ValuationDimension_VALUATION_DIMENSION_TYPE:     domain.ValuationDimensionType,
+	stocks.ValuationDimension_VALUATION_DIMENSION_OWNER:    domain.ValuationDimensionOwner,
+}
+
+func fromGrpcInventoryValuationReqToDomain(req *stocks.InventoryValuationRequest) (domain.InventoryValuationFilter, error) {
+	inventoryValuationReq := InventoryValuationRequest{
+		GroupBy:  make([]domain.ValuationDimension, 0, len(req.GroupBy)),
+		UserID:   req.UserId,
+		Location: req.Location,
+		Type:     req.Type,
+	}
+
+	for _, dimension := range req.GroupBy {
+		inventoryValuationReq.GroupBy = append(inventoryValuationReq.GroupBy, valuationDimensions[dimension])
+	}
+
+	if req.AsOf != nil {
+		inventoryValuationReq.AsOf = req.AsOf.AsTime()
+	}
+
+	if err := helper.ValidateRequest(&inventoryValuationReq); err != nil {
+		return domain.InventoryValuationFilter{}, err
+	}
+
+	return inventoryValuationReq.ToDomain(), nil
+}
+
+func fromInventoryValuationRowDomainToGrpc(row domain.InventoryValuationRow) *stocks.InventoryValuationRow {
+	return &stocks.InventoryValuationRow{
+		UserId:   int64(row.UserID),
+		Location: row.Location,
+		Type:     row.Type,
+		SkuCount: row.SkuCount,
+		Quantity: row.Quantity,
+		Value:    fromMoneyDomainToGrpc(row.Value),
+	}
+}
@@ -3,10 +3,13 @@ package v1
 import (
 	"context"
 	"errors"
+	"slices"
+	"stocks/internal/authz"
 	"stocks/internal/domain"
 	"stocks/internal/usecase"
 	pb "stocks/pkg/api/stocks"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -17,11 +20,29 @@ const (
 
 type StockGRPCHandler struct {
 	pb.UnimplementedStocksServiceServer
-	stockUC usecase.StockServiceUseCase
+	stockUC    usecase.StockServiceUseCase
+	authorizer authz.Authorizer
 }
 
-func NewStockGRPCHandler(stockUC usecase.StockServiceUseCase) *StockGRPCHandler {
-	return &StockGRPCHandler{stockUC: stockUC}
+func NewStockGRPCHandler(stockUC usecase.StockServiceUseCase, authorizer authz.Authorizer) *StockGRPCHandler {
+	return &StockGRPCHandler{stockUC: stockUC, authorizer: authorizer}
+}
+
+// authorize checks caller of request before usecase is called and maps denial to grpc status.
+func (s *StockGRPCHandler) authorize(ctx context.Context, action authz.Action, resource authz.Resource) error {
+	err := s.authorizer.Authorize(ctx, action, resource)
+	if err != nil {
+		switch {
+		case errors.Is(err, authz.ErrUnauthenticated):
+			return status.Error(codes.Unauthenticated, err.Error())
+		case errors.Is(err, authz.ErrPermissionDenied):
+			return status.Error(codes.PermissionDenied, err.Error())
+		}
+
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
 }
 
 func (s *StockGRPCHandler) AddStockItem(ctx context.Context, req *pb.CreateStockItemRequest) (*pb.GeneralResponse, error) {
@@ -30,10 +51,23 @@ func (s *StockGRPCHandler) AddStockItem(ctx context.Context, req *pb.CreateStock
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	err = s.authorize(ctx, authz.ActionManageStock, authz.Resource{
+		OwnerID:   stockItemReq.UserID,
+		Locations: []string{stockItemReq.Location},
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	err = s.stockUC.AddStockItem(ctx, stockItemReq)
 	if err != nil {
-		if errors.Is(err, domain.ErrSKUNotFound) {
+		switch {
+		case errors.Is(err, domain.ErrSKUNotFound):
 			return nil, status.Error(codes.NotFound, "SKU not found")
+		case errors.Is(err, domain.ErrSKUIsBundle), errors.Is(err, domain.ErrLotExpiryMismatch):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, domain.ErrQuantityOutOfRange), errors.Is(err, domain.ErrInvalidLot):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
 
 		return nil, status.Error(codes.Internal, err.Error())
@@ -45,13 +79,54 @@ func (s *StockGRPCHandler) AddStockItem(ctx context.Context, req *pb.CreateStock
 	}, nil
 }
 
+func (s *StockGRPCHandler) UpdateStockItem(ctx context.Context, req *pb.UpdateStockItemRequest) (*pb.StockItemResponse, error) {
+	stockItemUpdate, err := fromGrpcUpdateStockItemReqToDomain(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	locations := []string{stockItemUpdate.Location}
+	if slices.Contains(stockItemUpdate.UpdateMask, domain.StockItemFieldLocation) {
+		locations = append(locations, stockItemUpdate.NewLocation)
+	}
+
+	err = s.authorize(ctx, authz.ActionManageStock, authz.Resource{
+		OwnerID:   stockItemUpdate.UserID,
+		Locations: locations,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stockItem, err := s.stockUC.UpdateStockItem(ctx, stockItemUpdate)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrStockItemNotFound):
+			return nil, status.Error(codes.NotFound, stockItemNotFound)
+		case errors.Is(err, domain.ErrStockItemAlreadyExists):
+			return nil, status.Error(codes.AlreadyExists, "stock item already exists in location")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromStockItemDomainToGrpc(stockItem), nil
+}
+
 func (s *StockGRPCHandler) DeleteStockItem(ctx context.Context, req *pb.DeleteStockItemRequest) (*pb.GeneralResponse, error) {
 	deleteStockItemReq, err := fromGrpcDeleteStockItemReqToDomain(req)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	err = s.stockUC.DeleteStockItem(ctx, deleteStockItemReq.UserID, deleteStockItemReq.Sku.ID)
+	err = s.authorize(ctx, authz.ActionManageStock, authz.Resource{OwnerID: deleteStockItemReq.UserID})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.stockUC.DeleteStockItem(
+		ctx, deleteStockItemReq.UserID, deleteStockItemReq.Sku.ID, authz.CallerFromContext(ctx).UserID,
+	)
 	if err != nil {
 		if errors.Is(err, domain.ErrStockItemNotFound) {
 			return nil, status.Error(codes.NotFound, stockItemNotFound)
@@ -66,22 +141,97 @@ func (s *StockGRPCHandler) DeleteStockItem(ctx context.Context, req *pb.DeleteSt
 	}, nil
 }
 
+func (s *StockGRPCHandler) RestoreStockItem(ctx context.Context, req *pb.RestoreStockItemRequest) (*pb.StockItemResponse, error) {
+	restoreStockItemReq, err := fromGrpcRestoreStockItemReqToDomain(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.authorize(ctx, authz.ActionManageStock, authz.Resource{
+		OwnerID:   restoreStockItemReq.UserID,
+		Locations: []string{restoreStockItemReq.Location},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stockItem, err := s.stockUC.RestoreStockItem(
+		ctx, restoreStockItemReq.UserID, restoreStockItemReq.Sku.ID, restoreStockItemReq.Location,
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrStockItemNotFound):
+			return nil, status.Error(codes.NotFound, "deleted stock item not found")
+		case errors.Is(err, domain.ErrStockItemAlreadyExists):
+			return nil, status.Error(codes.AlreadyExists, "stock item already exists in location")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromStockItemDomainToGrpc(stockItem), nil
+}
+
 func (s *StockGRPCHandler) GetStockItemBySKU(ctx context.Context, req *pb.GetStockItemRequest) (*pb.StockItemResponse, error) {
-	skuID, err := fromGrpcGetStockItemReqToDomain(req)
+	offerFilter, err := fromGrpcGetStockItemReqToDomain(req)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	stockItem, err := s.stockUC.GetStockItemBySKU(ctx, skuID)
+	stockOffers, err := s.stockUC.GetStockItemBySKU(ctx, offerFilter)
 	if err != nil {
-		if errors.Is(err, domain.ErrStockItemNotFound) {
+		switch {
+		case errors.Is(err, domain.ErrStockItemNotFound):
 			return nil, status.Error(codes.NotFound, stockItemNotFound)
+		case errors.Is(err, domain.ErrAmountOutOfRange):
+			return nil, status.Error(codes.OutOfRange, err.Error())
 		}
 
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return fromStockItemDomainToGrpc(stockItem), nil
+	return fromStockOffersDomainToGrpc(stockOffers), nil
+}
+
+func (s *StockGRPCHandler) GetStockItemsBySKUs(
+	ctx context.Context,
+	req *pb.GetStockItemsBySKUsRequest,
+) (*pb.GetStockItemsBySKUsResponse, error) {
+	offerFilters, err := fromGrpcGetStockItemsBySKUsReqToDomain(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	stockOffersList, err := s.stockUC.GetStockItemsBySKUs(ctx, offerFilters)
+	if err != nil {
+		if errors.Is(err, domain.ErrAmountOutOfRange) {
+			return nil, status.Error(codes.OutOfRange, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromStockOffersListDomainToGrpc(stockOffersList), nil
+}
+
+func (s *StockGRPCHandler) WatchStock(req *pb.WatchStockRequest, stream grpc.ServerStreamingServer[pb.StockChangeEvent]) error {
+	skuIDs, err := fromGrpcWatchStockReqToDomain(req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.stockUC.WatchStock(stream.Context(), skuIDs, func(change domain.StockChange) error {
+		return stream.Send(fromStockChangeDomainToGrpc(change))
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
 }
 
 func (s *StockGRPCHandler) ListStockItemsByLocation(ctx context.Context, filter *pb.FilterRequest) (*pb.ListStockItemsResponse, error) {
@@ -97,3 +247,812 @@ func (s *StockGRPCHandler) ListStockItemsByLocation(ctx context.Context, filter
 
 	return fromListStockItemsDomainToGrpc(listStockItems.Items, listStockItems.TotalCount, listStockItems.PageNumber), nil
 }
+
+func (s *StockGRPCHandler) SearchSKUs(ctx context.Context, req *pb.SearchSKUsRequest) (*pb.SearchSKUsResponse, error) {
+	searchFilter, err := fromGrpcSearchSKUsReqToDomain(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	searchResponse, err := s.stockUC.SearchSKUs(ctx, searchFilter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromSKUSearchResponseDomainToGrpc(searchResponse), nil
+}
+
+func (s *StockGRPCHandler) SetStockThreshold(ctx context.Context, req *pb.SetStockThresholdRequest) (*pb.GeneralResponse, error) {
+	threshold, err := fromGrpcSetStockThresholdReqToDomain(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.authorize(ctx, authz.ActionConfigureThresholds, authz.Resource{Locations: []string{threshold.Location}})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.stockUC.SetStockThreshold(ctx, threshold)
+	if err != nil {
+		if errors.Is(err, domain.ErrSKUNotFound) {
+			return nil, status.Error(codes.NotFound, "SKU not found")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.GeneralResponse{
+		Success: true,
+		Message: "stock threshold saved successfully",
+	}, nil
+}
+
+func (s *StockGRPCHandler) ListLowStock(ctx context.Context, req *pb.ListLowStockRequest) (*pb.ListLowStockResponse, error) {
+	lowStockFilter, err := fromGrpcListLowStockReqToDomain(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	lowStockItems, err := s.stockUC.ListLowStock(ctx, lowStockFilter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromListLowStockDomainToGrpc(lowStockItems), nil
+}
+
+func (s *StockGRPCHandler) AdjustStock(ctx context.Context, req *pb.AdjustStockRequest) (*pb.AdjustStockResponse, error) {
+	adjustment, err := fromGrpcAdjustStockReqToDomain(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.authorize(ctx, authz.ActionMoveStock, authz.Resource{
+		OwnerID:   adjustment.UserID,
+		Locations: []string{adjustment.Location},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	adjustmentResult, err := s.stockUC.AdjustStock(ctx, adjustment)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrStockItemNotFound):
+			return nil, status.Error(codes.NotFound, stockItemNotFound)
+		case errors.Is(err, domain.ErrInsufficientStock):
+			return nil, status.Error(codes.FailedPrecondition, "insufficient stock beyond backorder limit")
+		case errors.Is(err, domain.ErrQuantityOutOfRange):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromStockAdjustmentResultDomainToGrpc(adjustmentResult), nil
+}
+
+func (s *StockGRPCHandler) ListExpiringLots(ctx context.Context, req *pb.ListExpiringLotsRequest) (*pb.ListExpiringLotsResponse, error) {
+	expiringLotsFilter, err := fromGrpcListExpiringLotsReqToDomain(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	expiringLots, err := s.stockUC.ListExpiringLots(ctx, expiringLotsFilter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromListExpiringLotsDomainToGrpc(expiringLots), nil
+}
+
+func (s *StockGRPCHandler) SetBundle(ctx context.Context, req *pb.SetBundleRequest) (*pb.GeneralResponse, error) {
+	bundle, err := fromGrpcSetBundleReqToDomain(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.authorize(ctx, authz.ActionManageCatalog, authz.Resource{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.stockUC.SetBundle(ctx, bundle)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrSKUNotFound):
+			return nil, status.Error(codes.NotFound, "SKU not found")
+		case errors.Is(err, domain.ErrInvalidBundle):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.GeneralResponse{
+		Success: true,
+		Message: "bundle saved successfully",
+	}, nil
+}
+
+func (s *StockGRPCHandler) GetBundle(ctx context.Context, req *pb.GetBundleRequest) (*pb.BundleResponse, error) {
+	skuID, err := fromGrpcGetBundleReqToDomain(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	bundle, err := s.stockUC.GetBundle(ctx, skuID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrSKUNotFound):
+			return nil, status.Error(codes.NotFound, "SKU not found")
+		case errors.Is(err, domain.ErrBundleNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromBundleDomainToGrpc(bundle), nil
+}
+
+func (s *StockGRPCHandler) SetAttributeSchema(ctx context.Context, req *pb.SetAttributeSchemaRequest) (*pb.GeneralResponse, error) {
+	schema, err := fromGrpcSetAttributeSchemaReqToDomain(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.authorize(ctx, authz.ActionManageCatalog, authz.Resource{})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.stockUC.SetAttributeSchema(ctx, schema)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidAttributeSchema) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.GeneralResponse{
+		Success: true,
+		Message: "attribute schema saved successfully",
+	}, nil
+}
+
+func (s *StockGRPCHandler) CreateVariantGroup(ctx context.Context, req *pb.CreateVariantGroupRequest) (*pb.VariantGroupResponse, error) {
+	group, err := fromGrpcCreateVariantGroupReqToDomain(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.authorize(ctx, authz.ActionManageCatalog, authz.Resource{})
+	if err != nil {
+		return nil, err
+	}
+
+	createdGroup, err := s.stockUC.CreateVariantGroup(ctx, group)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidAttributes) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromVariantGroupDomainToGrpc(createdGroup), nil
+}
+
+func (s *StockGRPCHandler) GetVariantGroup(ctx context.Context, req *pb.GetVariantGroupRequest) (*pb.VariantGroupResponse, error) {
+	groupID, err := fromGrpcGetVariantGroupReqToDomain(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	group, err := s.stockUC.GetVariantGroup(ctx, groupID)
+	if err != nil {
+		if errors.Is(err, domain.ErrVariantGroupNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromVariantGroupDomainToGrpc(group), nil
+}
+
+func (s *StockGRPCHandler) UpdateSKUAttributes(ctx context.Context, req *pb.UpdateSKUAttributesRequest) (*pb.SKUResponse, error) {
+	update, err := fromGrpcUpdateSKUAttributesReqToDomain(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.authorize(ctx, authz.ActionManageCatalog, authz.Resource{})
+	if err != nil {
+		return nil, err
+	}
+
+	sku, err := s.stockUC.UpdateSKUAttributes(ctx, update)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrSKUNotFound):
+			return nil, status.Error(codes.NotFound, "SKU not found")
+		case errors.Is(err, domain.ErrVariantGroupNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, domain.ErrInvalidAttributes):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, domain.ErrDuplicateVariant):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		case errors.Is(err, domain.ErrUnitChangeWithStock):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromSKUDomainToGrpc(sku), nil
+}
+
+func (s *StockGRPCHandler) UpdateBackorderSettings(ctx context.Context, req *pb.BackorderSettingsRequest) (*pb.GeneralResponse, error) {
+	settings, err := fromGrpcBackorderSettingsReqToDomain(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.authorize(ctx, authz.ActionManageStock, authz.Resource{
+		OwnerID:   settings.UserID,
+		Locations: []string{settings.Location},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.stockUC.SetBackorderSettings(ctx, settings)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrStockItemNotFound):
+			return nil, status.Error(codes.NotFound, stockItemNotFound)
+		case errors.Is(err, domain.ErrInvalidBackorderSettings):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.GeneralResponse{
+		Success: true,
+		Message: "backorder settings updated successfully",
+	}, nil
+}
+
+func (s *StockGRPCHandler) TransferStock(ctx context.Context, req *pb.TransferStockRequest) (*pb.StockTransferResponse, error) {
+	transfer, receiveImmediately, err := fromGrpcTransferStockReqToDomain(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.authorize(ctx, authz.ActionMoveStock, authz.Resource{
+		OwnerID:   transfer.UserID,
+		Locations: []string{transfer.FromLocation, transfer.ToLocation},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stockTransfer, err := s.stockUC.TransferStock(ctx, transfer, receiveImmediately)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrStockItemNotFound):
+			return nil, status.Error(codes.NotFound, stockItemNotFound)
+		case errors.Is(err, domain.ErrInsufficientStock):
+			return nil, status.Error(codes.FailedPrecondition, "insufficient stock in source location")
+		case errors.Is(err, domain.ErrQuantityOutOfRange):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromStockTransferDomainToGrpc(stockTransfer), nil
+}
+
+func (s *StockGRPCHandler) ReceiveStockTransfer(ctx context.Context, req *pb.ReceiveStockTransferRequest) (*pb.StockTransferResponse, error) {
+	userID, transferID, err := fromGrpcReceiveStockTransferReqToDomain(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// transfer is received at destination, so permission is checked against it.
+	stockTransfer, err := s.stockUC.GetTransfer(ctx, userID, transferID)
+	if err != nil {
+		if errors.Is(err, domain.ErrStockTransferNotFound) {
+			return nil, status.Error(codes.NotFound, "stock transfer not found")
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.authorize(ctx, authz.ActionMoveStock, authz.Resource{
+		OwnerID:   userID,
+		Locations: []string{stockTransfer.ToLocation},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stockTransfer, err = s.stockUC.ReceiveTransfer(ctx, userID, transferID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrStockTransferNotFound):
+			return nil, status.Error(codes.NotFound, "stock transfer not found")
+		case errors.Is(err, domain.ErrStockTransferAlreadyReceived):
+			return nil, status.Error(codes.FailedPrecondition, "stock transfer already received")
+		case errors.Is(err, domain.ErrQuantityOutOfRange):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromStockTransferDomainToGrpc(stockTransfer), nil
+}
+
+func (s *StockGRPCHandler) OpenCycleCount(ctx context.Context, req *pb.OpenCycleCountRequest) (*pb.CycleCountResponse, error) {
+	cycleCount, err := fromGrpcOpenCycleCountReqToDomain(req, authz.CallerFromContext(ctx).UserID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.authorize(ctx, authz.ActionCountStock, authz.Resource{
+		OwnerID:   cycleCount.UserID,
+		Locations: []string{cycleCount.Location},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cycleCount, err = s.stockUC.OpenCycleCount(ctx, cycleCount)
+	if err != nil {
+		if errors.Is(err, domain.ErrCycleCountAlreadyOpen) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromCycleCountDomainToGrpc(cycleCount), nil
+}
+
+func (s *StockGRPCHandler) SubmitCycleCounts(ctx context.Context, req *pb.SubmitCycleCountsRequest) (*pb.CycleCountResponse, error) {
+	submission, err := fromGrpcSubmitCycleCountsReqToDomain(req, authz.CallerFromContext(ctx).UserID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.authorizeCycleCount(ctx, submission.CycleCountID)
+	if err != nil {
+		return nil, err
+	}
+
+	cycleCount, err := s.stockUC.SubmitCycleCounts(ctx, submission)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrCycleCountNotFound), errors.Is(err, domain.ErrStockItemNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, domain.ErrCycleCountNotOpen):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, domain.ErrInvalidCycleCount):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromCycleCountDomainToGrpc(cycleCount), nil
+}
+
+func (s *StockGRPCHandler) GetCycleCountVariances(ctx context.Context, req *pb.GetCycleCountRequest) (*pb.CycleCountResponse, error) {
+	cycleCountID, err := fromGrpcCycleCountIDToDomain(req.CycleCountId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.authorizeCycleCount(ctx, cycleCountID)
+	if err != nil {
+		return nil, err
+	}
+
+	cycleCount, err := s.stockUC.ReviewCycleCount(ctx, cycleCountID)
+	if err != nil {
+		if errors.Is(err, domain.ErrCycleCountNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromCycleCountDomainToGrpc(cycleCount), nil
+}
+
+func (s *StockGRPCHandler) ApproveCycleCount(ctx context.Context, req *pb.ApproveCycleCountRequest) (*pb.CycleCountResponse, error) {
+	cycleCountID, err := fromGrpcCycleCountIDToDomain(req.CycleCountId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.authorizeCycleCount(ctx, cycleCountID)
+	if err != nil {
+		return nil, err
+	}
+
+	cycleCount, err := s.stockUC.ApproveCycleCount(ctx, cycleCountID, authz.CallerFromContext(ctx).UserID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrCycleCountNotFound), errors.Is(err, domain.ErrStockItemNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, domain.ErrCycleCountNotOpen), errors.Is(err, domain.ErrInvalidCycleCount):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, domain.ErrQuantityOutOfRange):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromCycleCountDomainToGrpc(cycleCount), nil
+}
+
+// authorizeCycleCount checks caller can count stock of count session, it is looked up for its owner and location.
+func (s *StockGRPCHandler) authorizeCycleCount(ctx context.Context, cycleCountID domain.CycleCountID) error {
+	cycleCount, err := s.stockUC.ReviewCycleCount(ctx, cycleCountID)
+	if err != nil {
+		if errors.Is(err, domain.ErrCycleCountNotFound) {
+			return status.Error(codes.NotFound, err.Error())
+		}
+
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return s.authorize(ctx, authz.ActionCountStock, authz.Resource{
+		OwnerID:   cycleCount.UserID,
+		Locations: []string{cycleCount.Location},
+	})
+}
+
+func (s *StockGRPCHandler) CreateSupplier(ctx context.Context, req *pb.CreateSupplierRequest) (*pb.SupplierResponse, error) {
+	supplier, err := fromGrpcCreateSupplierReqToDomain(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.authorize(ctx, authz.ActionOrderStock, authz.Resource{OwnerID: supplier.UserID})
+	if err != nil {
+		return nil, err
+	}
+
+	supplier, err = s.stockUC.CreateSupplier(ctx, supplier)
+	if err != nil {
+		if errors.Is(err, domain.ErrSupplierAlreadyExists) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromSupplierDomainToGrpc(supplier), nil
+}
+
+func (s *StockGRPCHandler) ListSuppliers(ctx context.Context, req *pb.ListSuppliersRequest) (*pb.ListSuppliersResponse, error) {
+	userID, err := fromGrpcListSuppliersReqToDomain(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.authorize(ctx, authz.ActionOrderStock, authz.Resource{OwnerID: userID})
+	if err != nil {
+		return nil, err
+	}
+
+	suppliers, err := s.stockUC.ListSuppliers(ctx, userID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromSuppliersDomainToGrpc(suppliers), nil
+}
+
+func (s *StockGRPCHandler) CreatePurchaseOrder(ctx context.Context, req *pb.CreatePurchaseOrderRequest) (*pb.PurchaseOrderResponse, error) {
+	purchaseOrder, err := fromGrpcCreatePurchaseOrderReqToDomain(req, authz.CallerFromContext(ctx).UserID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.authorize(ctx, authz.ActionOrderStock, authz.Resource{
+		OwnerID:   purchaseOrder.UserID,
+		Locations: []string{purchaseOrder.Location},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	purchaseOrder, err = s.stockUC.CreatePurchaseOrder(ctx, purchaseOrder)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrSKUNotFound), errors.Is(err, domain.ErrSupplierNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, domain.ErrSKUIsBundle):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, domain.ErrInvalidPurchaseOrder):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromPurchaseOrderDomainToGrpc(purchaseOrder), nil
+}
+
+func (s *StockGRPCHandler) PlacePurchaseOrder(ctx context.Context, req *pb.PurchaseOrderRequest) (*pb.PurchaseOrderResponse, error) {
+	purchaseOrderID, err := fromGrpcPurchaseOrderIDToDomain(req.PurchaseOrderId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.authorizePurchaseOrder(ctx, authz.ActionOrderStock, purchaseOrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	purchaseOrder, err := s.stockUC.PlacePurchaseOrder(ctx, purchaseOrderID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPurchaseOrderNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, domain.ErrPurchaseOrderStatus):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromPurchaseOrderDomainToGrpc(purchaseOrder), nil
+}
+
+func (s *StockGRPCHandler) ReceivePurchaseOrder(ctx context.Context, req *pb.ReceivePurchaseOrderRequest) (*pb.PurchaseOrderResponse, error) {
+	receipt, err := fromGrpcReceivePurchaseOrderReqToDomain(req, authz.CallerFromContext(ctx).UserID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// receiving is physical movement of stock, so operators of location receive deliveries too.
+	err = s.authorizePurchaseOrder(ctx, authz.ActionMoveStock, receipt.PurchaseOrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	purchaseOrder, err := s.stockUC.ReceivePurchaseOrder(ctx, receipt)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPurchaseOrderNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, domain.ErrPurchaseOrderStatus), errors.Is(err, domain.ErrLotExpiryMismatch):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, domain.ErrInvalidPurchaseOrderReceipt), errors.Is(err, domain.ErrInvalidLot),
+			errors.Is(err, domain.ErrQuantityOutOfRange):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromPurchaseOrderDomainToGrpc(purchaseOrder), nil
+}
+
+func (s *StockGRPCHandler) GetPurchaseOrder(ctx context.Context, req *pb.PurchaseOrderRequest) (*pb.PurchaseOrderResponse, error) {
+	purchaseOrderID, err := fromGrpcPurchaseOrderIDToDomain(req.PurchaseOrderId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	purchaseOrder, err := s.stockUC.GetPurchaseOrder(ctx, purchaseOrderID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPurchaseOrderNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// whoever receives the order can see what is expected.
+	err = s.authorize(ctx, authz.ActionMoveStock, authz.Resource{
+		OwnerID:   purchaseOrder.UserID,
+		Locations: []string{purchaseOrder.Location},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fromPurchaseOrderDomainToGrpc(purchaseOrder), nil
+}
+
+func (s *StockGRPCHandler) GetReorderSuggestions(
+	ctx context.Context,
+	req *pb.GetReorderSuggestionsRequest,
+) (*pb.GetReorderSuggestionsResponse, error) {
+	filter, err := fromGrpcGetReorderSuggestionsReqToDomain(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resource := authz.Resource{OwnerID: filter.UserID}
+	if filter.Location != "" {
+		resource.Locations = []string{filter.Location}
+	}
+
+	err = s.authorize(ctx, authz.ActionOrderStock, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions, err := s.stockUC.GetReorderSuggestions(ctx, filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromReorderSuggestionsDomainToGrpc(suggestions), nil
+}
+
+// authorizePurchaseOrder checks caller can perform action on purchase order, it is looked up for its owner and location.
+func (s *StockGRPCHandler) authorizePurchaseOrder(ctx context.Context, action authz.Action, purchaseOrderID domain.PurchaseOrderID) error {
+	purchaseOrder, err := s.stockUC.GetPurchaseOrder(ctx, purchaseOrderID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPurchaseOrderNotFound) {
+			return status.Error(codes.NotFound, err.Error())
+		}
+
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return s.authorize(ctx, action, authz.Resource{
+		OwnerID:   purchaseOrder.UserID,
+		Locations: []string{purchaseOrder.Location},
+	})
+}
+
+func (s *StockGRPCHandler) SchedulePriceChange(ctx context.Context, req *pb.SchedulePriceChangeRequest) (*pb.ScheduledPriceChangeResponse, error) {
+	priceChange, err := fromGrpcSchedulePriceChangeReqToDomain(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.authorize(ctx, authz.ActionManageStock, authz.Resource{
+		OwnerID:   priceChange.UserID,
+		Locations: []string{priceChange.Location},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	scheduledPriceChange, err := s.stockUC.SchedulePriceChange(ctx, priceChange)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPriceChangeInPast):
+			return nil, status.Error(codes.InvalidArgument, "effective time of price change must be in the future")
+		case errors.Is(err, domain.ErrStockItemNotFound):
+			return nil, status.Error(codes.NotFound, stockItemNotFound)
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromScheduledPriceChangeDomainToGrpc(scheduledPriceChange), nil
+}
+
+func (s *StockGRPCHandler) SetPriceTiers(ctx context.Context, req *pb.SetPriceTiersRequest) (*pb.PriceTiersResponse, error) {
+	priceList, err := fromGrpcSetPriceTiersReqToDomain(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.authorize(ctx, authz.ActionManageStock, authz.Resource{
+		OwnerID:   priceList.UserID,
+		Locations: []string{priceList.Location},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	priceTiers, err := s.stockUC.SetPriceTiers(ctx, priceList)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidPriceTiers), errors.Is(err, domain.ErrCurrencyMismatch):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, domain.ErrStockItemNotFound):
+			return nil, status.Error(codes.NotFound, stockItemNotFound)
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromPriceTiersDomainToGrpc(priceTiers), nil
+}
+
+func (s *StockGRPCHandler) GetPriceTiers(ctx context.Context, req *pb.GetPriceTiersRequest) (*pb.PriceTiersResponse, error) {
+	userID, skuID, location, err := fromGrpcGetPriceTiersReqToDomain(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.authorize(ctx, authz.ActionManageStock, authz.Resource{
+		OwnerID:   userID,
+		Locations: []string{location},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	priceTiers, err := s.stockUC.GetPriceTiers(ctx, userID, skuID, location)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromPriceTiersDomainToGrpc(priceTiers), nil
+}
+
+func (s *StockGRPCHandler) GetPriceHistory(ctx context.Context, req *pb.GetPriceHistoryRequest) (*pb.PriceHistoryResponse, error) {
+	filter, err := fromGrpcGetPriceHistoryReqToDomain(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	priceHistory, err := s.stockUC.GetPriceHistory(ctx, filter)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return fromPriceHistoryDomainToGrpc(priceHistory), nil
+}
+
+func (s *StockGRPCHandler) GetInventoryValuation(
+	req *pb.InventoryValuationRequest,
+	stream grpc.ServerStreamingServer[pb.InventoryValuationRow],
+) error {
+	filter, err := fromGrpcInventoryValuationReqToDomain(req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resource := authz.Resource{OwnerID: filter.UserID}
+	if filter.Location != "" {
+		resource.Locations = []string{filter.Location}
+	}
+
+	err = s.authorize(stream.Context(), authz.ActionViewReports, resource)
+	if err != nil {
+		return err
+	}
+
+	err = s.stockUC.GetInventoryValuation(stream.Context(), filter, func(row domain.InventoryValuationRow) error {
+		return stream.Send(fromInventoryValuationRowDomainToGrpc(row))
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrValuationAsOfInFuture) {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		if errors.Is(err, domain.ErrAmountOutOfRange) {
+			return status.Error(codes.OutOfRange, err.Error())
+		}
+
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
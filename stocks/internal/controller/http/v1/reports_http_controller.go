@@ -0,0 +1,278 @@
+package v1
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"stocks/internal/authz"
+	pb "stocks/pkg/api/stocks"
+	"stocks/pkg/httphelper"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	reportFormatCSV  = "csv"
+	reportFormatJSON = "json"
+)
+
+var valuationDimensions = map[string]pb.ValuationDimension{
+	"location": pb.ValuationDimension_VALUATION_DIMENSION_LOCATION,
+	"type":     pb.ValuationDimension_VALUATION_DIMENSION_TYPE,
+	"owner":    pb.ValuationDimension_VALUATION_DIMENSION_OWNER,
+}
+
+var valuationCSVHeader = []string{"user_id", "location", "type", "sku_count", "quantity", "currency", "value"}
+
+// ReportsHTTPHandler serves report downloads, reports are read through grpc service,
+// so authorization and validation are the same as for GetInventoryValuation rpc.
+type ReportsHTTPHandler struct {
+	stocksClient pb.StocksServiceClient
+}
+
+func NewReportsHTTPHandler(stocksClient pb.StocksServiceClient) *ReportsHTTPHandler {
+	return &ReportsHTTPHandler{stocksClient: stocksClient}
+}
+
+type InventoryValuationRowResponse struct {
+	UserID   int64  `json:"userID"`
+	Location string `json:"location"`
+	Type     string `json:"type"`
+	SkuCount int64  `json:"skuCount"`
+	Quantity int64  `json:"quantity"`
+	Currency string `json:"currency"`
+	Value    int64  `json:"value"`
+}
+
+// DownloadInventoryValuation streams inventory valuation report as csv or json attachment.
+func (h *ReportsHTTPHandler) DownloadInventoryValuation(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	query := r.URL.Query()
+
+	format := query.Get("format")
+	if format == "" {
+		format = reportFormatCSV
+	}
+
+	if format != reportFormatCSV && format != reportFormatJSON {
+		httphelper.ErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("unsupported format %q", format))
+		return
+	}
+
+	req, err := inventoryValuationReqFromQuery(query)
+	if err != nil {
+		httphelper.ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx := metadata.NewOutgoingContext(r.Context(), callerMetadataFromHeader(r.Header))
+
+	stream, err := h.stocksClient.GetInventoryValuation(ctx, req)
+	if err != nil {
+		respondGRPCError(w, err)
+		return
+	}
+
+	// errors of server stream come with the first message, so it is received before headers are written.
+	row, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		respondGRPCError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="inventory_valuation.%s"`, format))
+
+	var rowWriter reportRowWriter
+
+	if format == reportFormatJSON {
+		w.Header().Set("Content-Type", "application/json")
+		rowWriter = &jsonRowWriter{w: w}
+	} else {
+		w.Header().Set("Content-Type", "text/csv")
+		rowWriter = newCSVRowWriter(w)
+	}
+
+	rc := http.NewResponseController(w)
+
+	for ; err == nil; row, err = stream.Recv() {
+		if err = rowWriter.write(row); err != nil {
+			break
+		}
+
+		_ = rc.Flush()
+	}
+
+	// headers are already sent, so failure in the middle of report only cuts it short.
+	if !errors.Is(err, io.EOF) {
+		log.Printf("DownloadInventoryValuation: report is incomplete: %v", err)
+		return
+	}
+
+	if err := rowWriter.close(); err != nil {
+		log.Printf("DownloadInventoryValuation: %v", err)
+	}
+}
+
+func inventoryValuationReqFromQuery(query url.Values) (*pb.InventoryValuationRequest, error) {
+	req := &pb.InventoryValuationRequest{
+		Location: query.Get("location"),
+		Type:     query.Get("type"),
+	}
+
+	if groupBy := query.Get("group_by"); groupBy != "" {
+		for _, name := range strings.Split(groupBy, ",") {
+			dimension, ok := valuationDimensions[strings.TrimSpace(name)]
+			if !ok {
+				return nil, fmt.Errorf("unknown group_by dimension %q", name)
+			}
+
+			req.GroupBy = append(req.GroupBy, dimension)
+		}
+	}
+
+	if userID := query.Get("user_id"); userID != "" {
+		parsedUserID, err := strconv.ParseInt(userID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user_id %q", userID)
+		}
+
+		req.UserId = parsedUserID
+	}
+
+	if asOf := query.Get("as_of"); asOf != "" {
+		parsedAsOf, err := time.Parse(time.RFC3339, asOf)
+		if err != nil {
+			return nil, fmt.Errorf("invalid as_of %q, RFC3339 time is expected", asOf)
+		}
+
+		req.AsOf = timestamppb.New(parsedAsOf)
+	}
+
+	return req, nil
+}
+
+// callerMetadataFromHeader forwards caller identity headers, the same ones gateway forwards for rpcs.
+func callerMetadataFromHeader(header http.Header) metadata.MD {
+	md := metadata.MD{}
+
+	for _, key := range []string{authz.MetadataUserID, authz.MetadataRole, authz.MetadataLocations} {
+		if value := header.Get(key); value != "" {
+			md.Set(key, value)
+		}
+	}
+
+	return md
+}
+
+func respondGRPCError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	httphelper.ErrorResponse(w, runtime.HTTPStatusFromCode(st.Code()), st.Message())
+}
+
+// reportRowWriter encodes report rows as they are received.
+type reportRowWriter interface {
+	write(row *pb.InventoryValuationRow) error
+	close() error
+}
+
+type csvRowWriter struct {
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+func newCSVRowWriter(w io.Writer) *csvRowWriter {
+	return &csvRowWriter{writer: csv.NewWriter(w)}
+}
+
+func (c *csvRowWriter) write(row *pb.InventoryValuationRow) error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+
+	err := c.writer.Write([]string{
+		strconv.FormatInt(row.UserId, 10),
+		row.Location,
+		row.Type,
+		strconv.FormatInt(row.SkuCount, 10),
+		strconv.FormatInt(row.Quantity, 10),
+		row.GetValue().GetCurrency(),
+		strconv.FormatInt(row.GetValue().GetAmount(), 10),
+	})
+	if err != nil {
+		return err
+	}
+
+	c.writer.Flush()
+
+	return c.writer.Error()
+}
+
+func (c *csvRowWriter) writeHeader() error {
+	if c.headerWritten {
+		return nil
+	}
+
+	c.headerWritten = true
+
+	return c.writer.Write(valuationCSVHeader)
+}
+
+// close writes header of empty report.
+func (c *csvRowWriter) close() error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+
+	c.writer.Flush()
+
+	return c.writer.Error()
+}
+
+// jsonRowWriter writes rows as json array element by element.
+type jsonRowWriter struct {
+	w       io.Writer
+	started bool
+}
+
+func (j *jsonRowWriter) write(row *pb.InventoryValuationRow) error {
+	separator := ","
+	if !j.started {
+		separator = "["
+		j.started = true
+	}
+
+	if _, err := io.WriteString(j.w, separator); err != nil {
+		return err
+	}
+
+	return json.NewEncoder(j.w).Encode(InventoryValuationRowResponse{
+		UserID:   row.UserId,
+		Location: row.Location,
+		Type:     row.Type,
+		SkuCount: row.SkuCount,
+		Quantity: row.Quantity,
+		Currency: row.GetValue().GetCurrency(),
+		Value:    row.GetValue().GetAmount(),
+	})
+}
+
+func (j *jsonRowWriter) close() error {
+	if !j.started {
+		_, err := io.WriteString(j.w, "[]\n")
+		return err
+	}
+
+	_, err := io.WriteString(j.w, "]\n")
+
+	return err
+}
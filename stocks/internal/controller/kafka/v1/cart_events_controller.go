@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"stocks/internal/domain"
+	"stocks/internal/kafka"
+	"stocks/internal/usecase"
+	"time"
+)
+
+const (
+	cartItemAddedEvent = "cart_item_added"
+	cartItemAddedOK    = "success"
+)
+
+var _ kafka.MessageHandler = (*CartEventsHandler)(nil)
+
+// CartEventsHandler records demand of cart_item_added events of cart service, other events of topic are skipped.
+type CartEventsHandler struct {
+	stockUseCase usecase.StockServiceUseCase
+}
+
+func NewCartEventsHandler(stockUseCase usecase.StockServiceUseCase) *CartEventsHandler {
+	return &CartEventsHandler{
+		stockUseCase: stockUseCase,
+	}
+}
+
+type (
+	cartEvent struct {
+		Type      string          `json:"type"`
+		Timestamp time.Time       `json:"timestamp"`
+		Payload   json.RawMessage `json:"payload"`
+	}
+
+	cartItemAddedPayload struct {
+		SKU    uint32 `json:"sku"`
+		Count  int64  `json:"count"`
+		Status string `json:"status"`
+	}
+)
+
+func (h *CartEventsHandler) HandleMessage(ctx context.Context, message []byte, partition int32, offset int64) error {
+	var event cartEvent
+	// message which is not an event can never be handled, it is skipped instead of retried.
+	if err := json.Unmarshal(message, &event); err != nil || event.Type != cartItemAddedEvent {
+		return nil
+	}
+
+	var payload cartItemAddedPayload
+	if err := json.Unmarshal(event.Payload, &payload); err != nil || payload.Status != cartItemAddedOK {
+		return nil
+	}
+
+	addedAt := event.Timestamp
+	if addedAt.IsZero() {
+		addedAt = time.Now()
+	}
+
+	err := h.stockUseCase.RecordCartDemand(ctx, domain.CartDemand{
+		Partition: partition,
+		Offset:    offset,
+		SkuID:     domain.SKUID(payload.SKU),
+		Quantity:  payload.Count,
+		AddedAt:   addedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record cart demand: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// BackorderMode represent how stock item is sold when it runs out of stock.
+type BackorderMode string
+
+const (
+	// BackorderModeNone is used when stock item can not be sold beyond its count.
+	BackorderModeNone BackorderMode = "none"
+	// BackorderModeBackorder is used when stock item is sold beyond its count and shipped when it is restocked.
+	BackorderModeBackorder BackorderMode = "backorder"
+	// BackorderModePreorder is used when stock item is not released yet and every unit ships at restock date.
+	BackorderModePreorder BackorderMode = "preorder"
+)
+
+// BackorderPolicy represent how far below zero count of stock item may go and when it is restocked.
+type BackorderPolicy struct {
+	Mode BackorderMode
+	// MaxQuantity is the most count may go below zero, zero is unlimited.
+	MaxQuantity int64
+	// RestockAt is expected time stock arrives, zero when it is unknown. It is required for preorders.
+	RestockAt time.Time
+}
+
+// BackorderSettings represent backorder policy of stock item of seller in location.
+type BackorderSettings struct {
+	UserID   UserID
+	SkuID    SKUID
+	Location string
+	BackorderPolicy
+}
+
+// Validate checks limit and restock date are set only for modes which use them.
+func (p BackorderPolicy) Validate() error {
+	switch p.Mode {
+	case BackorderModeNone:
+		if p.MaxQuantity != 0 || !p.RestockAt.IsZero() {
+			return fmt.Errorf("%w: limit and restock date need backorder or preorder mode", ErrInvalidBackorderSettings)
+		}
+	case BackorderModeBackorder:
+	case BackorderModePreorder:
+		if p.RestockAt.IsZero() {
+			return fmt.Errorf("%w: preorder needs restock date", ErrInvalidBackorderSettings)
+		}
+	default:
+		return fmt.Errorf("%w: unknown mode %q", ErrInvalidBackorderSettings, p.Mode)
+	}
+
+	if p.MaxQuantity < 0 {
+		return fmt.Errorf("%w: negative backorder limit", ErrInvalidBackorderSettings)
+	}
+
+	return nil
+}
+
+// AvailableToOrder returns how much can be ordered when stock item has quantity, which is negative
+// when it is backordered already.
+func (p BackorderPolicy) AvailableToOrder(quantity int64) int64 {
+	if p.Mode == BackorderModeNone || p.Mode == "" {
+		return max(quantity, 0)
+	}
+
+	if p.MaxQuantity == 0 || quantity > math.MaxInt64-p.MaxQuantity {
+		return math.MaxInt64
+	}
+
+	return max(quantity+p.MaxQuantity, 0)
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBackorderPolicy_AvailableToOrder(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		policy   BackorderPolicy
+		quantity int64
+		want     int64
+	}{
+		{name: "no backorders", policy: BackorderPolicy{Mode: BackorderModeNone}, quantity: 5, want: 5},
+		{name: "no backorders when backordered", policy: BackorderPolicy{Mode: BackorderModeNone}, quantity: -3, want: 0},
+		{name: "limited backorders", policy: BackorderPolicy{Mode: BackorderModeBackorder, MaxQuantity: 10}, quantity: 5, want: 15},
+		{name: "limit partly used", policy: BackorderPolicy{Mode: BackorderModePreorder, MaxQuantity: 10}, quantity: -4, want: 6},
+		{name: "limit exceeded", policy: BackorderPolicy{Mode: BackorderModeBackorder, MaxQuantity: 10}, quantity: -12, want: 0},
+		{name: "unlimited backorders", policy: BackorderPolicy{Mode: BackorderModeBackorder}, quantity: -12, want: math.MaxInt64},
+		{name: "limit does not overflow", policy: BackorderPolicy{Mode: BackorderModeBackorder, MaxQuantity: 10}, quantity: math.MaxInt64, want: math.MaxInt64},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.policy.AvailableToOrder(tt.quantity); got != tt.want {
+				t.Errorf("AvailableToOrder(%d) = %d, want %d", tt.quantity, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package domain
+
+import "fmt"
+
+// BundleComponent represent sku and its quantity in one unit of bundle.
+type BundleComponent struct {
+	SkuID    SKUID
+	Quantity uint32
+}
+
+// Bundle represent sku composed of other skus (bill of materials), bundle has no stock of its own,
+// its availability is derived from stock of components of the same seller in the same location.
+type Bundle struct {
+	Sku        SKU
+	Components []BundleComponent
+}
+
+// Validate checks bundle does not contain itself and every component is listed once.
+func (b Bundle) Validate() error {
+	seen := make(map[SKUID]struct{}, len(b.Components))
+
+	for _, component := range b.Components {
+		if component.SkuID == b.Sku.ID {
+			return fmt.Errorf("%w: bundle can not contain itself", ErrInvalidBundle)
+		}
+
+		if _, ok := seen[component.SkuID]; ok {
+			return fmt.Errorf("%w: component %d is listed twice", ErrInvalidBundle, component.SkuID)
+		}
+
+		seen[component.SkuID] = struct{}{}
+	}
+
+	return nil
+}
+
+// BundleAvailability returns how many bundles can be assembled from component quantities,
+// quantities are keyed by component sku and missing components give zero.
+func BundleAvailability(components []BundleComponent, quantities map[SKUID]int64) int64 {
+	var availability int64
+
+	for i, component := range components {
+		available := quantities[component.SkuID] / int64(component.Quantity)
+		if available < 0 {
+			available = 0
+		}
+
+		if i == 0 || available < availability {
+			availability = available
+		}
+	}
+
+	return availability
+}
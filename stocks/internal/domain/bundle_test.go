@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBundleAvailability(t *testing.T) {
+	t.Parallel()
+
+	components := []BundleComponent{
+		{SkuID: 1001, Quantity: 1},
+		{SkuID: 2020, Quantity: 2},
+	}
+
+	tests := []struct {
+		name       string
+		quantities map[SKUID]int64
+		want       int64
+	}{
+		{name: "limited by scarcest component", quantities: map[SKUID]int64{1001: 10, 2020: 7}, want: 3},
+		{name: "partial bundle is not counted", quantities: map[SKUID]int64{1001: 1, 2020: 1}, want: 0},
+		{name: "missing component gives zero", quantities: map[SKUID]int64{1001: 10}, want: 0},
+		{name: "backordered component gives zero", quantities: map[SKUID]int64{1001: -4, 2020: 8}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := BundleAvailability(components, tt.quantities); got != tt.want {
+				t.Errorf("BundleAvailability() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBundleValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		bundle  Bundle
+		wantErr bool
+	}{
+		{
+			name:   "valid",
+			bundle: Bundle{Sku: SKU{ID: 3000}, Components: []BundleComponent{{SkuID: 1001, Quantity: 1}, {SkuID: 2020, Quantity: 2}}},
+		},
+		{
+			name:    "contains itself",
+			bundle:  Bundle{Sku: SKU{ID: 3000}, Components: []BundleComponent{{SkuID: 3000, Quantity: 1}}},
+			wantErr: true,
+		},
+		{
+			name:    "duplicate component",
+			bundle:  Bundle{Sku: SKU{ID: 3000}, Components: []BundleComponent{{SkuID: 1001, Quantity: 1}, {SkuID: 1001, Quantity: 2}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.bundle.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err != nil && !errors.Is(err, ErrInvalidBundle) {
+				t.Errorf("Validate() error = %v, want ErrInvalidBundle", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// CycleCountID represent cycle count session id.
+type CycleCountID int64
+
+// CycleCountStatus represent state of cycle count session.
+type CycleCountStatus string
+
+const (
+	// CycleCountStatusOpen is used while shelves are counted and counts can be submitted.
+	CycleCountStatusOpen CycleCountStatus = "open"
+	// CycleCountStatusApproved is used when variances of counts were posted as adjustments.
+	CycleCountStatusApproved CycleCountStatus = "approved"
+)
+
+// CycleCountAction represent kind of cycle count audit trail entry.
+type CycleCountAction string
+
+const (
+	CycleCountActionOpened   CycleCountAction = "opened"
+	CycleCountActionCounted  CycleCountAction = "counted"
+	CycleCountActionAdjusted CycleCountAction = "adjusted"
+	CycleCountActionApproved CycleCountAction = "approved"
+)
+
+// AdjustmentReasonCycleCount is ledger reason of variances posted by approved cycle count.
+const AdjustmentReasonCycleCount AdjustmentReason = "cycle_count"
+
+// CycleCount represent count session of location, it is open while counts are submitted
+// and approved once variances against system quantities are posted as adjustments.
+type CycleCount struct {
+	ID       CycleCountID
+	Location string
+	// UserID is merchant whose stock is counted, zero counts stock of every merchant in location.
+	UserID     UserID
+	Status     CycleCountStatus
+	Note       string
+	OpenedBy   UserID
+	OpenedAt   time.Time
+	ApprovedBy UserID
+	ApprovedAt time.Time
+	Lines      []CycleCountLine
+	Events     []CycleCountEvent
+}
+
+// CountedQuantity represent quantity of sku found on shelf.
+type CountedQuantity struct {
+	UserID          UserID
+	SkuID           SKUID
+	CountedQuantity int64
+}
+
+// ResolveCounts checks counts belong to merchant of count session and fills their merchant
+// when session has one, the same sku of merchant can be counted once per submission.
+func (c CycleCount) ResolveCounts(counts []CountedQuantity) ([]CountedQuantity, error) {
+	if len(counts) == 0 {
+		return nil, fmt.Errorf("%w: no counts submitted", ErrInvalidCycleCount)
+	}
+
+	type countKey struct {
+		userID UserID
+		skuID  SKUID
+	}
+
+	resolved := make([]CountedQuantity, 0, len(counts))
+	seen := make(map[countKey]struct{}, len(counts))
+
+	for _, count := range counts {
+		if count.UserID == 0 {
+			count.UserID = c.UserID
+		}
+
+		switch {
+		case count.UserID == 0:
+			return nil, fmt.Errorf("%w: merchant of sku %d is required", ErrInvalidCycleCount, count.SkuID)
+		case c.UserID != 0 && count.UserID != c.UserID:
+			return nil, fmt.Errorf("%w: sku %d belongs to another merchant", ErrInvalidCycleCount, count.SkuID)
+		case count.CountedQuantity < 0:
+			return nil, fmt.Errorf("%w: counted quantity of sku %d is negative", ErrInvalidCycleCount, count.SkuID)
+		}
+
+		key := countKey{userID: count.UserID, skuID: count.SkuID}
+		if _, ok := seen[key]; ok {
+			return nil, fmt.Errorf("%w: sku %d counted twice", ErrInvalidCycleCount, count.SkuID)
+		}
+
+		seen[key] = struct{}{}
+		resolved = append(resolved, count)
+	}
+
+	return resolved, nil
+}
+
+// CycleCountSubmission represent counts submitted to open cycle count.
+type CycleCountSubmission struct {
+	CycleCountID CycleCountID
+	Counts       []CountedQuantity
+	CountedBy    UserID
+}
+
+// CycleCountLine represent the latest count of stock item in cycle count session.
+type CycleCountLine struct {
+	UserID UserID
+	Sku    SKU
+	// SystemQuantity is quantity of stock item when it was counted.
+	SystemQuantity  int64
+	CountedQuantity int64
+	CountedBy       UserID
+	CountedAt       time.Time
+	// QuantityAfter is quantity of stock item after approval, zero before.
+	QuantityAfter int64
+}
+
+// Variance is difference between counted and system quantity, approval adjusts stock item by it,
+// so stock movements made after counting are kept.
+func (l CycleCountLine) Variance() int64 {
+	return l.CountedQuantity - l.SystemQuantity
+}
+
+// CycleCountEvent represent audit trail entry of cycle count session.
+type CycleCountEvent struct {
+	Action  CycleCountAction
+	ActorID UserID
+	// UserID, SkuID and quantities are set for entries of lines only.
+	UserID          UserID
+	SkuID           SKUID
+	SystemQuantity  int64
+	CountedQuantity int64
+	CreatedAt       time.Time
+}
+
+// CycleCountApproval represent approved cycle count with adjustments it posted.
+type CycleCountApproval struct {
+	CycleCount  CycleCount
+	Adjustments []StockAdjustmentResult
+}
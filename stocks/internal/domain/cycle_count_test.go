@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCycleCount_ResolveCounts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		cycleCount CycleCount
+		counts     []CountedQuantity
+		want       []CountedQuantity
+		wantErr    error
+	}{
+		{
+			name:       "merchant is taken from session",
+			cycleCount: CycleCount{UserID: 7},
+			counts:     []CountedQuantity{{SkuID: 1001, CountedQuantity: 3}},
+			want:       []CountedQuantity{{UserID: 7, SkuID: 1001, CountedQuantity: 3}},
+		},
+		{
+			name:   "session of every merchant keeps merchant of count",
+			counts: []CountedQuantity{{UserID: 7, SkuID: 1001}, {UserID: 8, SkuID: 1001, CountedQuantity: 2}},
+			want:   []CountedQuantity{{UserID: 7, SkuID: 1001}, {UserID: 8, SkuID: 1001, CountedQuantity: 2}},
+		},
+		{name: "no counts", cycleCount: CycleCount{UserID: 7}, wantErr: ErrInvalidCycleCount},
+		{
+			name:    "merchant is required in session of every merchant",
+			counts:  []CountedQuantity{{SkuID: 1001, CountedQuantity: 3}},
+			wantErr: ErrInvalidCycleCount,
+		},
+		{
+			name:       "sku of another merchant",
+			cycleCount: CycleCount{UserID: 7},
+			counts:     []CountedQuantity{{UserID: 8, SkuID: 1001, CountedQuantity: 3}},
+			wantErr:    ErrInvalidCycleCount,
+		},
+		{
+			name:       "negative count",
+			cycleCount: CycleCount{UserID: 7},
+			counts:     []CountedQuantity{{SkuID: 1001, CountedQuantity: -1}},
+			wantErr:    ErrInvalidCycleCount,
+		},
+		{
+			name:       "sku counted twice",
+			cycleCount: CycleCount{UserID: 7},
+			counts:     []CountedQuantity{{SkuID: 1001, CountedQuantity: 3}, {UserID: 7, SkuID: 1001, CountedQuantity: 4}},
+			wantErr:    ErrInvalidCycleCount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.cycleCount.ResolveCounts(tt.counts)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ResolveCounts() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ResolveCounts() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCycleCountLine_Variance(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		line CycleCountLine
+		want int64
+	}{
+		{name: "shortage", line: CycleCountLine{SystemQuantity: 10, CountedQuantity: 7}, want: -3},
+		{name: "surplus", line: CycleCountLine{SystemQuantity: 10, CountedQuantity: 12}, want: 2},
+		{name: "matches", line: CycleCountLine{SystemQuantity: 10, CountedQuantity: 10}, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.line.Variance(); got != tt.want {
+				t.Errorf("Variance() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -7,3 +7,102 @@ var ErrSKUNotFound = errors.New("sku not found")
 
 // ErrStockItemNotFound is used when stock item not found.
 var ErrStockItemNotFound = errors.New("stock item not found")
+
+// ErrStockThresholdNotFound is used when no reorder threshold configured for sku.
+var ErrStockThresholdNotFound = errors.New("stock threshold not found")
+
+// ErrInsufficientStock is used when adjustment would make stock negative beyond backorder limit of stock item.
+var ErrInsufficientStock = errors.New("insufficient stock")
+
+// ErrStockTransferNotFound is used when stock transfer not found.
+var ErrStockTransferNotFound = errors.New("stock transfer not found")
+
+// ErrStockTransferAlreadyReceived is used when receiving transfer which is not in transit anymore.
+var ErrStockTransferAlreadyReceived = errors.New("stock transfer already received")
+
+// ErrPriceChangeInPast is used when scheduled price change effective time already passed.
+var ErrPriceChangeInPast = errors.New("price change effective time is in the past")
+
+// ErrStockItemAlreadyExists is used when stock item of sku already exists in location.
+var ErrStockItemAlreadyExists = errors.New("stock item already exists")
+
+// ErrValuationAsOfInFuture is used when inventory valuation is requested for time which has not come yet.
+var ErrValuationAsOfInFuture = errors.New("valuation as of time is in the future")
+
+// ErrInvalidBundle is used when bundle components refer to the bundle itself or to another bundle.
+var ErrInvalidBundle = errors.New("invalid bundle components")
+
+// ErrBundleNotFound is used when sku has no bundle components.
+var ErrBundleNotFound = errors.New("bundle not found")
+
+// ErrSKUIsBundle is used when stock is added to bundle sku, bundle stock is derived from its components.
+var ErrSKUIsBundle = errors.New("sku is a bundle, its stock is derived from components")
+
+// ErrInvalidAttributeSchema is used when attribute schema of sku type is malformed.
+var ErrInvalidAttributeSchema = errors.New("invalid attribute schema")
+
+// ErrAttributeSchemaNotFound is used when sku type has no attribute schema.
+var ErrAttributeSchemaNotFound = errors.New("attribute schema not found")
+
+// ErrInvalidAttributes is used when sku attributes do not match attribute schema of sku type.
+var ErrInvalidAttributes = errors.New("invalid sku attributes")
+
+// ErrVariantGroupNotFound is used when variant group does not exist.
+var ErrVariantGroupNotFound = errors.New("variant group not found")
+
+// ErrDuplicateVariant is used when another sku of variant group already has the same axes values.
+var ErrDuplicateVariant = errors.New("variant with the same axes values already exists")
+
+// ErrQuantityOutOfRange is used when resulting stock or cart quantity does not fit into 64 bits.
+var ErrQuantityOutOfRange = errors.New("quantity out of range")
+
+// ErrUnitChangeWithStock is used when unit of measure of sku is changed while it has stock, counts would change meaning.
+var ErrUnitChangeWithStock = errors.New("unit of measure can not be changed while sku has stock")
+
+// ErrCurrencyMismatch is used when amounts in different currencies are combined.
+var ErrCurrencyMismatch = errors.New("currency mismatch")
+
+// ErrAmountOutOfRange is used when price or value does not fit into 64 bits.
+var ErrAmountOutOfRange = errors.New("amount out of range")
+
+// ErrInvalidBackorderSettings is used when backorder mode, limit and restock date do not fit each other.
+var ErrInvalidBackorderSettings = errors.New("invalid backorder settings")
+
+// ErrInvalidLot is used when lot of received stock has no lot number or expires before it is received.
+var ErrInvalidLot = errors.New("invalid stock lot")
+
+// ErrLotExpiryMismatch is used when units are received to existing lot with another expiry date.
+var ErrLotExpiryMismatch = errors.New("lot already exists with another expiry date")
+
+// ErrCycleCountNotFound is used when cycle count session does not exist.
+var ErrCycleCountNotFound = errors.New("cycle count not found")
+
+// ErrCycleCountNotOpen is used when counts are submitted to or approval is made of cycle count which is already approved.
+var ErrCycleCountNotOpen = errors.New("cycle count is not open")
+
+// ErrCycleCountAlreadyOpen is used when location already has open cycle count.
+var ErrCycleCountAlreadyOpen = errors.New("location already has open cycle count")
+
+// ErrInvalidCycleCount is used when submitted counts do not fit cycle count session or it has nothing to approve.
+var ErrInvalidCycleCount = errors.New("invalid cycle count")
+
+// ErrSupplierNotFound is used when supplier does not exist or belongs to another merchant.
+var ErrSupplierNotFound = errors.New("supplier not found")
+
+// ErrSupplierAlreadyExists is used when merchant already has supplier with the same name.
+var ErrSupplierAlreadyExists = errors.New("supplier already exists")
+
+// ErrPurchaseOrderNotFound is used when purchase order does not exist.
+var ErrPurchaseOrderNotFound = errors.New("purchase order not found")
+
+// ErrInvalidPurchaseOrder is used when purchase order has no lines or they order nothing.
+var ErrInvalidPurchaseOrder = errors.New("invalid purchase order")
+
+// ErrPurchaseOrderStatus is used when purchase order can not be ordered or received in its status.
+var ErrPurchaseOrderStatus = errors.New("purchase order status does not allow it")
+
+// ErrInvalidPurchaseOrderReceipt is used when receipt has sku which is not ordered or more units than outstanding.
+var ErrInvalidPurchaseOrderReceipt = errors.New("invalid purchase order receipt")
+
+// ErrInvalidPriceTiers is used when price tiers repeat minimum quantity or have no positive price.
+var ErrInvalidPriceTiers = errors.New("invalid price tiers")
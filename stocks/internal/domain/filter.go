@@ -5,10 +5,12 @@ type Filter struct {
 	Location    string
 	PageSize    int64
 	CurrentPage int64
+	// Attributes match stock items of skus whose attributes have these values, compared as text.
+	Attributes map[string]string
 }
 
 type PaginatedResponse[T any] struct {
 	Items      []T
-	TotalCount uint16
+	TotalCount uint32
 	PageNumber int64
 }
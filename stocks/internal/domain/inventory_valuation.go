@@ -0,0 +1,36 @@
+package domain
+
+import "time"
+
+// ValuationDimension represent attribute inventory value is grouped by.
+type ValuationDimension string
+
+const (
+	ValuationDimensionLocation ValuationDimension = "location"
+	ValuationDimensionType     ValuationDimension = "type"
+	ValuationDimensionOwner    ValuationDimension = "owner"
+)
+
+// InventoryValuationFilter represent parameters of inventory valuation report.
+type InventoryValuationFilter struct {
+	// GroupBy lists dimensions rows are aggregated by, empty means every dimension.
+	GroupBy []ValuationDimension
+	// optional filters, zero values match everything.
+	UserID   UserID
+	Location string
+	Type     string
+	// AsOf rebuilds stock from movement ledger and price history at that time, zero time means current stock.
+	AsOf time.Time
+}
+
+// InventoryValuationRow represent quantity and value of stock aggregated by dimensions of report,
+// dimensions which are not grouped by are left empty. Rows are always split by currency of offers.
+type InventoryValuationRow struct {
+	UserID   UserID
+	Location string
+	Type     string
+	SkuCount int64
+	Quantity int64
+	// Value is sum of quantity multiplied by price, backordered stock is not valued.
+	Value Money
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Currency represent ISO 4217 code of currency, e.g. RUB or USD.
+type Currency string
+
+// DefaultCurrency is currency of prices stored before offers had currency of their own.
+const DefaultCurrency Currency = "RUB"
+
+// Money represent amount in minor units of currency, e.g. kopecks of RUB or cents of USD.
+type Money struct {
+	Currency Currency
+	Amount   int64
+}
+
+// Add returns sum of amounts, both of them must be in the same currency.
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, other.Currency)
+	}
+
+	sum := m.Amount + other.Amount
+	if (other.Amount > 0 && sum < m.Amount) || (other.Amount < 0 && sum > m.Amount) {
+		return Money{}, ErrAmountOutOfRange
+	}
+
+	return Money{Currency: m.Currency, Amount: sum}, nil
+}
+
+// ForQuantity returns price of count minor units of unit when m is price of one unit, e.g. of 1250 grams
+// priced per kg. Fraction of minor unit of currency is rounded half away from zero.
+func (m Money) ForQuantity(count int64, unit UnitOfMeasure) (Money, error) {
+	scale := big.NewInt(unit.Scale())
+	product := new(big.Int).Mul(big.NewInt(m.Amount), big.NewInt(count))
+
+	amount, remainder := new(big.Int).QuoRem(product, scale, new(big.Int))
+	if remainder.Lsh(remainder.Abs(remainder), 1).Cmp(scale) >= 0 {
+		amount.Add(amount, big.NewInt(int64(product.Sign())))
+	}
+
+	if !amount.IsInt64() {
+		return Money{}, ErrAmountOutOfRange
+	}
+
+	return Money{Currency: m.Currency, Amount: amount.Int64()}, nil
+}
@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestMoney_ForQuantity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		price   Money
+		count   int64
+		unit    UnitOfMeasure
+		want    Money
+		wantErr error
+	}{
+		{
+			name:  "each is priced per piece",
+			price: Money{Currency: "USD", Amount: 250},
+			count: 3,
+			unit:  UnitOfMeasureEach,
+			want:  Money{Currency: "USD", Amount: 750},
+		},
+		{
+			name:  "kg is priced per kg",
+			price: Money{Currency: "RUB", Amount: 19900},
+			count: 1250,
+			unit:  UnitOfMeasureKg,
+			want:  Money{Currency: "RUB", Amount: 24875},
+		},
+		{
+			name:  "half is rounded up",
+			price: Money{Currency: "RUB", Amount: 1},
+			count: 500,
+			unit:  UnitOfMeasureKg,
+			want:  Money{Currency: "RUB", Amount: 1},
+		},
+		{
+			name:  "less than half is rounded down",
+			price: Money{Currency: "RUB", Amount: 1},
+			count: 499,
+			unit:  UnitOfMeasureKg,
+			want:  Money{Currency: "RUB", Amount: 0},
+		},
+		{
+			name:  "negative half is rounded away from zero",
+			price: Money{Currency: "RUB", Amount: 1},
+			count: -500,
+			unit:  UnitOfMeasureKg,
+			want:  Money{Currency: "RUB", Amount: -1},
+		},
+		{
+			name:    "overflow",
+			price:   Money{Currency: "RUB", Amount: math.MaxInt64},
+			count:   2,
+			unit:    UnitOfMeasureEach,
+			wantErr: ErrAmountOutOfRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.price.ForQuantity(tt.count, tt.unit)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ForQuantity() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("ForQuantity() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoney_Add(t *testing.T) {
+	t.Parallel()
+
+	sum, err := Money{Currency: "USD", Amount: 150}.Add(Money{Currency: "USD", Amount: 50})
+	if err != nil || sum != (Money{Currency: "USD", Amount: 200}) {
+		t.Errorf("Add() = %+v, %v, want 200 USD", sum, err)
+	}
+
+	if _, err := (Money{Currency: "USD", Amount: 1}).Add(Money{Currency: "EUR", Amount: 1}); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Add() of different currencies error = %v, want %v", err, ErrCurrencyMismatch)
+	}
+
+	if _, err := (Money{Currency: "USD", Amount: math.MaxInt64}).Add(Money{Currency: "USD", Amount: 1}); !errors.Is(err, ErrAmountOutOfRange) {
+		t.Errorf("Add() overflow error = %v, want %v", err, ErrAmountOutOfRange)
+	}
+}
@@ -0,0 +1,57 @@
+package domain
+
+import "time"
+
+// PriceChangeID represent scheduled price change id.
+type PriceChangeID int64
+
+// PriceChangeStatus represent state of scheduled price change.
+type PriceChangeStatus string
+
+const (
+	// PriceChangeStatusPending is used when price change waits for its effective time.
+	PriceChangeStatusPending PriceChangeStatus = "pending"
+	// PriceChangeStatusApplied is used when price change was written to stock item.
+	PriceChangeStatusApplied PriceChangeStatus = "applied"
+	// PriceChangeStatusFailed is used when stock item of price change does not exist anymore.
+	PriceChangeStatusFailed PriceChangeStatus = "failed"
+)
+
+// ScheduledPriceChange represent price of stock item which will be applied at effective time.
+type ScheduledPriceChange struct {
+	ID          PriceChangeID
+	UserID      UserID
+	SkuID       SKUID
+	Location    string
+	OldPrice    Money
+	NewPrice    Money
+	EffectiveAt time.Time
+	Status      PriceChangeStatus
+	AppliedAt   time.Time
+}
+
+// PriceHistoryEntry represent single change of stock item price.
+type PriceHistoryEntry struct {
+	UserID   UserID
+	SkuID    SKUID
+	Location string
+	// OldPrice is nil for the price stock item was created with.
+	OldPrice  *Money
+	NewPrice  Money
+	ChangedAt time.Time
+}
+
+// PriceHistoryFilter represent parameters for listing price history of sku.
+type PriceHistoryFilter struct {
+	SkuID       SKUID
+	UserID      UserID
+	Location    string
+	PageSize    int64
+	CurrentPage int64
+}
+
+// PriceHistory represent price history of sku with its pending price changes.
+type PriceHistory struct {
+	Entries   []PriceHistoryEntry
+	Scheduled []ScheduledPriceChange
+}
@@ -0,0 +1,48 @@
+package domain
+
+import "fmt"
+
+// PriceTier represent price of stock item for orders of at least MinQuantity, it is for customers
+// of CustomerGroup only unless group is empty. Offer is priced by the lowest of its list price and tiers
+// which apply to order.
+type PriceTier struct {
+	CustomerGroup string
+	// MinQuantity is in minor units of sku unit of measure, like count.
+	MinQuantity int64
+	// Price is price of one unit of measure in currency of the offer.
+	Price Money
+}
+
+// PriceList represent price tiers of stock item for one customer group, empty group is every customer.
+type PriceList struct {
+	UserID        UserID
+	SkuID         SKUID
+	Location      string
+	CustomerGroup string
+	Tiers         []PriceTier
+}
+
+// Validate checks every tier of price list has positive price in currency of stock item and its own minimum quantity.
+// Empty list is valid, it removes tiers of the group.
+func (l PriceList) Validate(currency Currency) error {
+	seen := make(map[int64]struct{}, len(l.Tiers))
+
+	for _, tier := range l.Tiers {
+		switch {
+		case tier.MinQuantity <= 0:
+			return fmt.Errorf("%w: minimum quantity must be positive", ErrInvalidPriceTiers)
+		case tier.Price.Amount <= 0:
+			return fmt.Errorf("%w: price of %d units must be positive", ErrInvalidPriceTiers, tier.MinQuantity)
+		case tier.Price.Currency != currency:
+			return fmt.Errorf("%w: tier is in %s, offer is in %s", ErrCurrencyMismatch, tier.Price.Currency, currency)
+		}
+
+		if _, ok := seen[tier.MinQuantity]; ok {
+			return fmt.Errorf("%w: %d units priced twice", ErrInvalidPriceTiers, tier.MinQuantity)
+		}
+
+		seen[tier.MinQuantity] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPriceList_Validate(t *testing.T) {
+	t.Parallel()
+
+	rub := func(amount int64) Money { return Money{Currency: "RUB", Amount: amount} }
+
+	tests := []struct {
+		name    string
+		tiers   []PriceTier
+		wantErr error
+	}{
+		{name: "no tiers removes them"},
+		{
+			name:  "quantity breaks",
+			tiers: []PriceTier{{MinQuantity: 10, Price: rub(90)}, {MinQuantity: 100, Price: rub(80)}},
+		},
+		{
+			name:    "minimum quantity must be positive",
+			tiers:   []PriceTier{{MinQuantity: 0, Price: rub(90)}},
+			wantErr: ErrInvalidPriceTiers,
+		},
+		{
+			name:    "price must be positive",
+			tiers:   []PriceTier{{MinQuantity: 10, Price: rub(0)}},
+			wantErr: ErrInvalidPriceTiers,
+		},
+		{
+			name:    "quantity priced twice",
+			tiers:   []PriceTier{{MinQuantity: 10, Price: rub(90)}, {MinQuantity: 10, Price: rub(80)}},
+			wantErr: ErrInvalidPriceTiers,
+		},
+		{
+			name:    "tier in other currency than offer",
+			tiers:   []PriceTier{{MinQuantity: 10, Price: Money{Currency: "USD", Amount: 1}}},
+			wantErr: ErrCurrencyMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			priceList := PriceList{CustomerGroup: "wholesale", Tiers: tt.tiers}
+			if err := priceList.Validate("RUB"); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
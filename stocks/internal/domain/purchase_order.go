@@ -0,0 +1,214 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// SupplierID represent supplier id.
+type SupplierID int64
+
+// Supplier represent company merchant restocks its stock from.
+type Supplier struct {
+	ID SupplierID
+	// UserID is merchant supplier belongs to.
+	UserID UserID
+	Name   string
+	Email  string
+	Phone  string
+	// LeadTimeDays is how many days pass from ordering to delivery, it gives expected date of orders without one.
+	LeadTimeDays int32
+	CreatedAt    time.Time
+}
+
+// PurchaseOrderID represent purchase order id.
+type PurchaseOrderID int64
+
+// PurchaseOrderStatus represent state of purchase order.
+type PurchaseOrderStatus string
+
+const (
+	// PurchaseOrderStatusDraft is used while purchase order is prepared and its lines can be changed.
+	PurchaseOrderStatusDraft PurchaseOrderStatus = "draft"
+	// PurchaseOrderStatusOrdered is used when purchase order was sent to supplier and nothing arrived yet.
+	PurchaseOrderStatusOrdered PurchaseOrderStatus = "ordered"
+	// PurchaseOrderStatusPartiallyReceived is used when some of ordered units arrived.
+	PurchaseOrderStatusPartiallyReceived PurchaseOrderStatus = "partially_received"
+	// PurchaseOrderStatusReceived is used when every ordered unit arrived.
+	PurchaseOrderStatusReceived PurchaseOrderStatus = "received"
+)
+
+// Incoming tells whether units of purchase order in status are on their way to location.
+func (s PurchaseOrderStatus) Incoming() bool {
+	return s == PurchaseOrderStatusOrdered || s == PurchaseOrderStatusPartiallyReceived
+}
+
+// PurchaseOrder represent order of sku units from supplier to location of merchant.
+type PurchaseOrder struct {
+	ID         PurchaseOrderID
+	UserID     UserID
+	SupplierID SupplierID
+	Location   string
+	Status     PurchaseOrderStatus
+	Note       string
+	Lines      []PurchaseOrderLine
+	CreatedBy  UserID
+	CreatedAt  time.Time
+	OrderedAt  time.Time
+	// ExpectedAt is when delivery is expected, zero makes it lead time of supplier after ordering.
+	ExpectedAt time.Time
+	ReceivedAt time.Time
+}
+
+// PurchaseOrderLine represent units of sku ordered by purchase order.
+type PurchaseOrderLine struct {
+	Sku      SKU
+	Quantity int64
+	// Price is price stock item is offered at once units are received, like price of added stock.
+	Price            Money
+	ReceivedQuantity int64
+}
+
+// Outstanding is quantity of line which did not arrive yet.
+func (l PurchaseOrderLine) Outstanding() int64 {
+	return l.Quantity - l.ReceivedQuantity
+}
+
+// Validate checks purchase order orders something and every sku once.
+func (o PurchaseOrder) Validate() error {
+	if len(o.Lines) == 0 {
+		return fmt.Errorf("%w: no lines", ErrInvalidPurchaseOrder)
+	}
+
+	seen := make(map[SKUID]struct{}, len(o.Lines))
+
+	for _, line := range o.Lines {
+		switch {
+		case line.Quantity <= 0:
+			return fmt.Errorf("%w: quantity of sku %d must be positive", ErrInvalidPurchaseOrder, line.Sku.ID)
+		case line.Price.Amount <= 0:
+			return fmt.Errorf("%w: price of sku %d must be positive", ErrInvalidPurchaseOrder, line.Sku.ID)
+		}
+
+		if _, ok := seen[line.Sku.ID]; ok {
+			return fmt.Errorf("%w: sku %d ordered twice", ErrInvalidPurchaseOrder, line.Sku.ID)
+		}
+
+		seen[line.Sku.ID] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateReceipt checks purchase order waits for units of receipt and none of its lines gets more than outstanding.
+func (o PurchaseOrder) ValidateReceipt(receipt PurchaseOrderReceipt) error {
+	if !o.Status.Incoming() {
+		return fmt.Errorf("%w: purchase order is %s", ErrPurchaseOrderStatus, o.Status)
+	}
+
+	if len(receipt.Lines) == 0 {
+		return fmt.Errorf("%w: nothing received", ErrInvalidPurchaseOrderReceipt)
+	}
+
+	outstanding := make(map[SKUID]int64, len(o.Lines))
+	for _, line := range o.Lines {
+		outstanding[line.Sku.ID] = line.Outstanding()
+	}
+
+	for _, line := range receipt.Lines {
+		left, ok := outstanding[line.SkuID]
+
+		switch {
+		case !ok:
+			return fmt.Errorf("%w: sku %d is not ordered", ErrInvalidPurchaseOrderReceipt, line.SkuID)
+		case line.Quantity <= 0:
+			return fmt.Errorf("%w: quantity of sku %d must be positive", ErrInvalidPurchaseOrderReceipt, line.SkuID)
+		case line.Quantity > left:
+			return fmt.Errorf("%w: %d of sku %d received, %d outstanding", ErrInvalidPurchaseOrderReceipt, line.Quantity, line.SkuID, left)
+		}
+
+		if line.Lot != nil {
+			if err := line.Lot.Validate(); err != nil {
+				return err
+			}
+		}
+
+		// the same sku may come in several lots of one receipt, they share outstanding quantity.
+		outstanding[line.SkuID] = left - line.Quantity
+	}
+
+	return nil
+}
+
+// ReceivedStatus returns status purchase order has after its lines received quantities they have.
+func (o PurchaseOrder) ReceivedStatus() PurchaseOrderStatus {
+	for _, line := range o.Lines {
+		if line.Outstanding() > 0 {
+			return PurchaseOrderStatusPartiallyReceived
+		}
+	}
+
+	return PurchaseOrderStatusReceived
+}
+
+// ReceiptStockItems returns stock items receipt adds to location of purchase order, priced by its lines.
+func (o PurchaseOrder) ReceiptStockItems(receipt PurchaseOrderReceipt) []StockItem {
+	lines := make(map[SKUID]PurchaseOrderLine, len(o.Lines))
+	for _, line := range o.Lines {
+		lines[line.Sku.ID] = line
+	}
+
+	stockItems := make([]StockItem, 0, len(receipt.Lines))
+
+	for _, receivedLine := range receipt.Lines {
+		orderLine := lines[receivedLine.SkuID]
+
+		stockItem := StockItem{
+			UserID:   o.UserID,
+			Sku:      orderLine.Sku,
+			Count:    receivedLine.Quantity,
+			Price:    orderLine.Price,
+			Location: o.Location,
+		}
+
+		if receivedLine.Lot != nil {
+			lot := *receivedLine.Lot
+			lot.UserID = o.UserID
+			lot.Sku = orderLine.Sku
+			lot.Location = o.Location
+			lot.Quantity = receivedLine.Quantity
+			stockItem.Lot = &lot
+		}
+
+		stockItems = append(stockItems, stockItem)
+	}
+
+	return stockItems
+}
+
+// PurchaseOrderReceipt represent units of purchase order which arrived to its location.
+type PurchaseOrderReceipt struct {
+	PurchaseOrderID PurchaseOrderID
+	Lines           []ReceivedLine
+	ReceivedBy      UserID
+}
+
+// ReceivedLine represent arrived units of sku, optionally of lot.
+type ReceivedLine struct {
+	SkuID    SKUID
+	Quantity int64
+	Lot      *StockLot
+}
+
+// ReceivedStockItem represent stock item units of purchase order were added to.
+type ReceivedStockItem struct {
+	StockItem StockItem
+	// Created is set when stock item did not exist before receipt.
+	Created bool
+}
+
+// PurchaseOrderReceiptResult represent purchase order after receipt with stock items it added units to.
+type PurchaseOrderReceiptResult struct {
+	PurchaseOrder PurchaseOrder
+	StockItems    []ReceivedStockItem
+}
@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPurchaseOrder_Validate(t *testing.T) {
+	t.Parallel()
+
+	price := Money{Currency: "USD", Amount: 1999}
+
+	tests := []struct {
+		name    string
+		lines   []PurchaseOrderLine
+		wantErr error
+	}{
+		{name: "valid", lines: []PurchaseOrderLine{{Sku: SKU{ID: 1001}, Quantity: 10, Price: price}}},
+		{name: "no lines", wantErr: ErrInvalidPurchaseOrder},
+		{name: "zero quantity", lines: []PurchaseOrderLine{{Sku: SKU{ID: 1001}, Price: price}}, wantErr: ErrInvalidPurchaseOrder},
+		{name: "zero price", lines: []PurchaseOrderLine{{Sku: SKU{ID: 1001}, Quantity: 10}}, wantErr: ErrInvalidPurchaseOrder},
+		{
+			name: "sku ordered twice",
+			lines: []PurchaseOrderLine{
+				{Sku: SKU{ID: 1001}, Quantity: 10, Price: price},
+				{Sku: SKU{ID: 1001}, Quantity: 5, Price: price},
+			},
+			wantErr: ErrInvalidPurchaseOrder,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := (PurchaseOrder{Lines: tt.lines}).Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPurchaseOrder_ValidateReceipt(t *testing.T) {
+	t.Parallel()
+
+	lines := []PurchaseOrderLine{
+		{Sku: SKU{ID: 1001}, Quantity: 10, ReceivedQuantity: 4},
+		{Sku: SKU{ID: 1002}, Quantity: 5},
+	}
+
+	tests := []struct {
+		name    string
+		status  PurchaseOrderStatus
+		lines   []ReceivedLine
+		wantErr error
+	}{
+		{name: "outstanding quantity", status: PurchaseOrderStatusPartiallyReceived, lines: []ReceivedLine{{SkuID: 1001, Quantity: 6}}},
+		{
+			name:   "sku split into lots",
+			status: PurchaseOrderStatusOrdered,
+			lines: []ReceivedLine{
+				{SkuID: 1002, Quantity: 2, Lot: &StockLot{LotNumber: "L1"}},
+				{SkuID: 1002, Quantity: 3, Lot: &StockLot{LotNumber: "L2"}},
+			},
+		},
+		{name: "draft is not ordered yet", status: PurchaseOrderStatusDraft, lines: []ReceivedLine{{SkuID: 1001, Quantity: 1}}, wantErr: ErrPurchaseOrderStatus},
+		{name: "already received", status: PurchaseOrderStatusReceived, lines: []ReceivedLine{{SkuID: 1001, Quantity: 1}}, wantErr: ErrPurchaseOrderStatus},
+		{name: "nothing received", status: PurchaseOrderStatusOrdered, wantErr: ErrInvalidPurchaseOrderReceipt},
+		{name: "sku not ordered", status: PurchaseOrderStatusOrdered, lines: []ReceivedLine{{SkuID: 1003, Quantity: 1}}, wantErr: ErrInvalidPurchaseOrderReceipt},
+		{name: "more than outstanding", status: PurchaseOrderStatusOrdered, lines: []ReceivedLine{{SkuID: 1001, Quantity: 7}}, wantErr: ErrInvalidPurchaseOrderReceipt},
+		{
+			name:   "lots exceed outstanding together",
+			status: PurchaseOrderStatusOrdered,
+			lines: []ReceivedLine{
+				{SkuID: 1002, Quantity: 3, Lot: &StockLot{LotNumber: "L1"}},
+				{SkuID: 1002, Quantity: 3, Lot: &StockLot{LotNumber: "L2"}},
+			},
+			wantErr: ErrInvalidPurchaseOrderReceipt,
+		},
+		{name: "lot without number", status: PurchaseOrderStatusOrdered, lines: []ReceivedLine{{SkuID: 1002, Quantity: 1, Lot: &StockLot{}}}, wantErr: ErrInvalidLot},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			purchaseOrder := PurchaseOrder{Status: tt.status, Lines: lines}
+			if err := purchaseOrder.ValidateReceipt(PurchaseOrderReceipt{Lines: tt.lines}); !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateReceipt() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPurchaseOrder_ReceivedStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		lines []PurchaseOrderLine
+		want  PurchaseOrderStatus
+	}{
+		{
+			name:  "line outstanding",
+			lines: []PurchaseOrderLine{{Quantity: 10, ReceivedQuantity: 10}, {Quantity: 5, ReceivedQuantity: 1}},
+			want:  PurchaseOrderStatusPartiallyReceived,
+		},
+		{
+			name:  "every line received",
+			lines: []PurchaseOrderLine{{Quantity: 10, ReceivedQuantity: 10}, {Quantity: 5, ReceivedQuantity: 5}},
+			want:  PurchaseOrderStatusReceived,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := (PurchaseOrder{Lines: tt.lines}).ReceivedStatus(); got != tt.want {
+				t.Errorf("ReceivedStatus() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPurchaseOrder_ReceiptStockItems(t *testing.T) {
+	t.Parallel()
+
+	sku := SKU{ID: 1001, Name: "t-shirt"}
+	price := Money{Currency: "USD", Amount: 1999}
+
+	purchaseOrder := PurchaseOrder{
+		UserID:   7,
+		Location: "Ashgabat",
+		Lines:    []PurchaseOrderLine{{Sku: sku, Quantity: 10, Price: price}},
+	}
+
+	got := purchaseOrder.ReceiptStockItems(PurchaseOrderReceipt{Lines: []ReceivedLine{
+		{SkuID: 1001, Quantity: 4},
+		{SkuID: 1001, Quantity: 6, Lot: &StockLot{LotNumber: "L1"}},
+	}})
+
+	want := []StockItem{
+		{UserID: 7, Sku: sku, Count: 4, Price: price, Location: "Ashgabat"},
+		{
+			UserID: 7, Sku: sku, Count: 6, Price: price, Location: "Ashgabat",
+			Lot: &StockLot{UserID: 7, Sku: sku, Location: "Ashgabat", LotNumber: "L1", Quantity: 6},
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ReceiptStockItems() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+// Defaults of reorder parameters, they are used when neither request nor configuration sets one.
+const (
+	DefaultReorderWindowDays      = 28
+	DefaultReorderLeadTimeDays    = 7
+	DefaultReorderSafetyStockDays = 3
+	DefaultReorderCoverDays       = 14
+)
+
+// ReorderParams represent parameters reorder suggestions are computed with, zero value of field takes its default.
+type ReorderParams struct {
+	// WindowDays is how many past days sales velocity is measured over.
+	WindowDays int32
+	// LeadTimeDays is how many days pass from ordering to delivery, requested one overrides lead time
+	// of supplier the stock item was last ordered from.
+	LeadTimeDays int32
+	// SafetyStockDays is how many days of sales are kept on top of lead time demand.
+	SafetyStockDays int32
+	// CoverDays is how many days of sales suggested order should last after it arrives.
+	CoverDays int32
+}
+
+// WithDefaults returns params where unset fields are taken from defaults.
+func (p ReorderParams) WithDefaults(defaults ReorderParams) ReorderParams {
+	if p.WindowDays <= 0 {
+		p.WindowDays = defaults.WindowDays
+	}
+
+	if p.LeadTimeDays <= 0 {
+		p.LeadTimeDays = defaults.LeadTimeDays
+	}
+
+	if p.SafetyStockDays <= 0 {
+		p.SafetyStockDays = defaults.SafetyStockDays
+	}
+
+	if p.CoverDays <= 0 {
+		p.CoverDays = defaults.CoverDays
+	}
+
+	return p
+}
+
+// DefaultReorderParams returns built in reorder parameters.
+func DefaultReorderParams() ReorderParams {
+	return ReorderParams{
+		WindowDays:      DefaultReorderWindowDays,
+		LeadTimeDays:    DefaultReorderLeadTimeDays,
+		SafetyStockDays: DefaultReorderSafetyStockDays,
+		CoverDays:       DefaultReorderCoverDays,
+	}
+}
+
+// ReorderFilter represent parameters for listing reorder suggestions of merchant.
+type ReorderFilter struct {
+	UserID UserID
+	// Location and SkuID narrow suggestions down, empty values list every stock item of merchant.
+	Location string
+	SkuID    SKUID
+	ReorderParams
+}
+
+// CartDemand represent units of sku added to a cart, read from cart_item_added events of cart service.
+type CartDemand struct {
+	// Partition and Offset identify event, redelivered event is recorded once.
+	Partition int32
+	Offset    int64
+	SkuID     SKUID
+	Quantity  int64
+	AddedAt   time.Time
+}
+
+// DemandHistory represent stock item with its demand over reorder window.
+type DemandHistory struct {
+	StockItem
+	// SoldQuantity is units sold from stock item net of returns.
+	SoldQuantity int64
+	// SkuSoldQuantity is units of the sku sold net of returns by every seller and location.
+	SkuSoldQuantity int64
+	// SkuOffers is number of stock items the sku is offered from.
+	SkuOffers int64
+	// CartAddedQuantity is units of the sku added to carts, carts do not tell seller nor location.
+	CartAddedQuantity int64
+	ReorderThreshold  uint32
+	// LeadTimeDays is lead time of supplier stock item was last ordered from, zero when it was never ordered.
+	LeadTimeDays int32
+}
+
+// ReorderSuggestion represent quantity proposed to order for stock item.
+type ReorderSuggestion struct {
+	StockItem
+	SoldQuantity      int64
+	CartAddedQuantity int64
+	// SalesVelocity is units sold per day.
+	SalesVelocity float64
+	// DaysOfCover is how many days count lasts at sales velocity, it is -1 when stock item does not sell.
+	DaysOfCover       float64
+	LeadTimeDays      int32
+	SafetyStock       int64
+	ReorderPoint      int64
+	SuggestedQuantity int64
+}
+
+// SalesVelocity returns units of stock item sold per day over window. Carts also count, because units
+// nobody could buy while stock was out never reach the ledger, cart demand of the sku is shared between
+// stock items by their part of sales, or evenly when the sku did not sell anywhere.
+func (h DemandHistory) SalesVelocity(windowDays int32) float64 {
+	if windowDays <= 0 {
+		return 0
+	}
+
+	var share float64
+
+	switch {
+	case h.SkuSoldQuantity > 0:
+		share = float64(max(h.SoldQuantity, 0)) / float64(h.SkuSoldQuantity)
+	case h.SkuOffers > 0:
+		share = 1 / float64(h.SkuOffers)
+	}
+
+	demand := max(float64(max(h.SoldQuantity, 0)), float64(h.CartAddedQuantity)*share)
+
+	return demand / float64(windowDays)
+}
+
+// ParamsOf returns requested params of stock item, unset fields are taken from defaults
+// except lead time, which is lead time of its supplier when it has one.
+func (h DemandHistory) ParamsOf(requested, defaults ReorderParams) ReorderParams {
+	if requested.LeadTimeDays <= 0 && h.LeadTimeDays > 0 {
+		requested.LeadTimeDays = h.LeadTimeDays
+	}
+
+	return requested.WithDefaults(defaults)
+}
+
+// SuggestReorder computes sales velocity, days of cover and quantity to order for stock item. Order is
+// suggested when stock on hand and incoming falls to reorder point, demand over lead time plus safety stock,
+// and it brings stock up to demand over lead time and cover days plus safety stock.
+func (h DemandHistory) SuggestReorder(params ReorderParams) ReorderSuggestion {
+	leadTimeDays := params.LeadTimeDays
+
+	velocity := h.SalesVelocity(params.WindowDays)
+
+	daysOfCover := float64(-1)
+	if velocity > 0 {
+		daysOfCover = float64(max(h.Count, 0)) / velocity
+	}
+
+	safetyStock := max(ceilUnits(velocity*float64(params.SafetyStockDays)), int64(h.ReorderThreshold))
+	reorderPoint := ceilUnits(velocity*float64(leadTimeDays)) + safetyStock
+	// backordered units are negative count, they are owed to customers and must be ordered too.
+	position := h.Count + h.IncomingQuantity
+
+	var suggested int64
+	if position <= reorderPoint {
+		suggested = max(ceilUnits(velocity*float64(leadTimeDays+params.CoverDays))+safetyStock-position, 0)
+	}
+
+	return ReorderSuggestion{
+		StockItem:         h.StockItem,
+		SoldQuantity:      h.SoldQuantity,
+		CartAddedQuantity: h.CartAddedQuantity,
+		SalesVelocity:     velocity,
+		DaysOfCover:       daysOfCover,
+		LeadTimeDays:      leadTimeDays,
+		SafetyStock:       safetyStock,
+		ReorderPoint:      reorderPoint,
+		SuggestedQuantity: suggested,
+	}
+}
+
+// ceilUnits rounds units up, error of float division must not add a unit, e.g. 10 / 28 * 28.
+func ceilUnits(units float64) int64 {
+	return int64(math.Ceil(units - 1e-9))
+}
@@ -0,0 +1,190 @@
+package domain
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDemandHistory_SuggestReorder(t *testing.T) {
+	t.Parallel()
+
+	params := ReorderParams{WindowDays: 28, LeadTimeDays: 7, SafetyStockDays: 3, CoverDays: 14}
+
+	tests := []struct {
+		name    string
+		history DemandHistory
+		want    ReorderSuggestion
+	}{
+		{
+			name: "stock below reorder point is ordered up to cover",
+			history: DemandHistory{
+				StockItem:       StockItem{Count: 10},
+				SoldQuantity:    56,
+				SkuSoldQuantity: 56,
+				SkuOffers:       1,
+			},
+			want: ReorderSuggestion{
+				StockItem:         StockItem{Count: 10},
+				SoldQuantity:      56,
+				SalesVelocity:     2,
+				DaysOfCover:       5,
+				LeadTimeDays:      7,
+				SafetyStock:       6,
+				ReorderPoint:      20,
+				SuggestedQuantity: 38,
+			},
+		},
+		{
+			name: "stock above reorder point is not ordered",
+			history: DemandHistory{
+				StockItem:       StockItem{Count: 100},
+				SoldQuantity:    56,
+				SkuSoldQuantity: 56,
+				SkuOffers:       1,
+			},
+			want: ReorderSuggestion{
+				StockItem:     StockItem{Count: 100},
+				SoldQuantity:  56,
+				SalesVelocity: 2,
+				DaysOfCover:   50,
+				LeadTimeDays:  7,
+				SafetyStock:   6,
+				ReorderPoint:  20,
+			},
+		},
+		{
+			name: "incoming quantity counts as stock",
+			history: DemandHistory{
+				StockItem:       StockItem{Count: 10, IncomingQuantity: 30},
+				SoldQuantity:    56,
+				SkuSoldQuantity: 56,
+				SkuOffers:       1,
+			},
+			want: ReorderSuggestion{
+				StockItem:     StockItem{Count: 10, IncomingQuantity: 30},
+				SoldQuantity:  56,
+				SalesVelocity: 2,
+				DaysOfCover:   5,
+				LeadTimeDays:  7,
+				SafetyStock:   6,
+				ReorderPoint:  20,
+			},
+		},
+		{
+			name: "carts reveal demand of sku out of stock everywhere",
+			history: DemandHistory{
+				SkuOffers:         2,
+				CartAddedQuantity: 112,
+			},
+			want: ReorderSuggestion{
+				CartAddedQuantity: 112,
+				SalesVelocity:     2,
+				DaysOfCover:       0,
+				LeadTimeDays:      7,
+				SafetyStock:       6,
+				ReorderPoint:      20,
+				SuggestedQuantity: 48,
+			},
+		},
+		{
+			name: "cart demand is shared by part of sales",
+			history: DemandHistory{
+				StockItem:         StockItem{Count: 10},
+				SoldQuantity:      14,
+				SkuSoldQuantity:   28,
+				SkuOffers:         2,
+				CartAddedQuantity: 112,
+			},
+			want: ReorderSuggestion{
+				StockItem:         StockItem{Count: 10},
+				SoldQuantity:      14,
+				CartAddedQuantity: 112,
+				SalesVelocity:     2,
+				DaysOfCover:       5,
+				LeadTimeDays:      7,
+				SafetyStock:       6,
+				ReorderPoint:      20,
+				SuggestedQuantity: 38,
+			},
+		},
+		{
+			name: "threshold is safety stock of item without sales",
+			history: DemandHistory{
+				StockItem:        StockItem{Count: 3},
+				SkuOffers:        1,
+				ReorderThreshold: 5,
+			},
+			want: ReorderSuggestion{
+				StockItem:         StockItem{Count: 3},
+				DaysOfCover:       -1,
+				LeadTimeDays:      7,
+				SafetyStock:       5,
+				ReorderPoint:      5,
+				SuggestedQuantity: 2,
+			},
+		},
+		{
+			name: "backordered units are ordered",
+			history: DemandHistory{
+				StockItem: StockItem{Count: -4},
+				SkuOffers: 1,
+			},
+			want: ReorderSuggestion{
+				StockItem:         StockItem{Count: -4},
+				DaysOfCover:       -1,
+				LeadTimeDays:      7,
+				SuggestedQuantity: 4,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.history.SuggestReorder(params); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SuggestReorder() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDemandHistory_ParamsOf(t *testing.T) {
+	t.Parallel()
+
+	defaults := DefaultReorderParams()
+
+	tests := []struct {
+		name             string
+		requested        ReorderParams
+		supplierLeadTime int32
+		want             ReorderParams
+	}{
+		{
+			name: "defaults",
+			want: defaults,
+		},
+		{
+			name:             "lead time of supplier",
+			supplierLeadTime: 10,
+			want:             ReorderParams{WindowDays: 28, LeadTimeDays: 10, SafetyStockDays: 3, CoverDays: 14},
+		},
+		{
+			name:             "requested lead time overrides supplier",
+			requested:        ReorderParams{WindowDays: 7, LeadTimeDays: 2},
+			supplierLeadTime: 10,
+			want:             ReorderParams{WindowDays: 7, LeadTimeDays: 2, SafetyStockDays: 3, CoverDays: 14},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			history := DemandHistory{LeadTimeDays: tt.supplierLeadTime}
+			if got := history.ParamsOf(tt.requested, defaults); got != tt.want {
+				t.Errorf("ParamsOf() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
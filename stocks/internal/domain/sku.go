@@ -5,4 +5,41 @@ type SKU struct {
 	ID   SKUID
 	Name string
 	Type string
+	// Unit is unit of measure counts of sku are kept in.
+	Unit UnitOfMeasure
+	// IsBundle is set for skus composed of other skus.
+	IsBundle bool
+	// VariantGroupID is zero for skus which are not variants of a product.
+	VariantGroupID VariantGroupID
+	Attributes     SKUAttributes
+}
+
+// SKUSearchFilter represent parameters of typo-tolerant sku search.
+type SKUSearchFilter struct {
+	Query       string
+	Types       []string
+	PageSize    int64
+	CurrentPage int64
+	// Attributes match skus whose attributes have these values, compared as text.
+	Attributes map[string]string
+}
+
+// SKUSearchResult represent single ranked sku matched by search query.
+type SKUSearchResult struct {
+	Sku            SKU
+	Rank           float64
+	Highlight      string
+	AvailableCount int64
+}
+
+// TypeFacet represent count of matched skus per sku type.
+type TypeFacet struct {
+	Type  string
+	Count uint32
+}
+
+// SKUSearchResponse represent paginated search results with type facets.
+type SKUSearchResponse struct {
+	PaginatedResponse[SKUSearchResult]
+	Facets []TypeFacet
 }
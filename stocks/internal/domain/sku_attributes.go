@@ -0,0 +1,141 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+)
+
+// AttributeType represent type of sku attribute value.
+type AttributeType string
+
+const (
+	AttributeTypeString  AttributeType = "string"
+	AttributeTypeNumber  AttributeType = "number"
+	AttributeTypeBoolean AttributeType = "boolean"
+	// AttributeTypeEnum is string value restricted to allowed values of attribute.
+	AttributeTypeEnum AttributeType = "enum"
+)
+
+// SKUAttributes represent typed attributes of sku keyed by attribute name,
+// values are string, float64 or bool as they are decoded from json.
+type SKUAttributes map[string]any
+
+// AttributeDefinition represent single attribute of sku type.
+type AttributeDefinition struct {
+	Name          string        `json:"name"`
+	Type          AttributeType `json:"type"`
+	Required      bool          `json:"required"`
+	AllowedValues []string      `json:"allowed_values,omitempty"`
+}
+
+// AttributeSchema represent attributes skus of type may have.
+type AttributeSchema struct {
+	SkuType    string
+	Attributes []AttributeDefinition
+}
+
+// Validate checks schema itself: attribute names are unique and enum attributes list their values.
+func (s AttributeSchema) Validate() error {
+	seen := make(map[string]struct{}, len(s.Attributes))
+
+	for _, definition := range s.Attributes {
+		if _, ok := seen[definition.Name]; ok {
+			return fmt.Errorf("%w: attribute %q is defined twice", ErrInvalidAttributeSchema, definition.Name)
+		}
+
+		seen[definition.Name] = struct{}{}
+
+		switch definition.Type {
+		case AttributeTypeString, AttributeTypeNumber, AttributeTypeBoolean:
+		case AttributeTypeEnum:
+			if len(definition.AllowedValues) == 0 {
+				return fmt.Errorf("%w: enum attribute %q has no allowed values", ErrInvalidAttributeSchema, definition.Name)
+			}
+		default:
+			return fmt.Errorf("%w: attribute %q has unknown type %q", ErrInvalidAttributeSchema, definition.Name, definition.Type)
+		}
+	}
+
+	return nil
+}
+
+// ValidateAttributes checks attributes of sku against schema of its type.
+func (s AttributeSchema) ValidateAttributes(attributes SKUAttributes) error {
+	definitions := make(map[string]AttributeDefinition, len(s.Attributes))
+
+	for _, definition := range s.Attributes {
+		definitions[definition.Name] = definition
+
+		if _, ok := attributes[definition.Name]; definition.Required && !ok {
+			return fmt.Errorf("%w: attribute %q is required", ErrInvalidAttributes, definition.Name)
+		}
+	}
+
+	for name, value := range attributes {
+		definition, ok := definitions[name]
+		if !ok {
+			return fmt.Errorf("%w: attribute %q is not defined for type %q", ErrInvalidAttributes, name, s.SkuType)
+		}
+
+		if !definition.accepts(value) {
+			return fmt.Errorf("%w: attribute %q expects %s value, got %v", ErrInvalidAttributes, name, definition.Type, value)
+		}
+	}
+
+	return nil
+}
+
+func (d AttributeDefinition) accepts(value any) bool {
+	switch d.Type {
+	case AttributeTypeString:
+		_, ok := value.(string)
+		return ok
+	case AttributeTypeNumber:
+		_, ok := value.(float64)
+		return ok
+	case AttributeTypeBoolean:
+		_, ok := value.(bool)
+		return ok
+	case AttributeTypeEnum:
+		str, ok := value.(string)
+		return ok && slices.Contains(d.AllowedValues, str)
+	}
+
+	return false
+}
+
+// VariantGroup represent skus which are the same product in different variants, like t-shirt in several sizes
+// and colors. Axes are attributes variants differ by, every variant has its own combination of axes values.
+type VariantGroup struct {
+	ID      VariantGroupID
+	Name    string
+	SkuType string
+	Axes    []string
+	// Variants are skus of group, they are set when group is read.
+	Variants []SKU
+}
+
+// AxesValues returns values of variant axes from attributes of sku, they must all be present.
+func (g VariantGroup) AxesValues(attributes SKUAttributes) (SKUAttributes, error) {
+	axesValues := make(SKUAttributes, len(g.Axes))
+
+	for _, axis := range g.Axes {
+		value, ok := attributes[axis]
+		if !ok {
+			return nil, fmt.Errorf("%w: variant axis %q is missing", ErrInvalidAttributes, axis)
+		}
+
+		axesValues[axis] = value
+	}
+
+	return axesValues, nil
+}
+
+// SKUAttributesUpdate represent new attributes and variant group of sku, zero variant group detaches sku from group.
+type SKUAttributesUpdate struct {
+	SkuID          SKUID
+	VariantGroupID VariantGroupID
+	Attributes     SKUAttributes
+	// Unit changes unit of measure of sku, empty unit keeps current one.
+	Unit UnitOfMeasure
+}
@@ -0,0 +1,164 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestAttributeSchema_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		attributes []AttributeDefinition
+		wantErr    error
+	}{
+		{
+			name: "every type",
+			attributes: []AttributeDefinition{
+				{Name: "brand", Type: AttributeTypeString, Required: true},
+				{Name: "weight", Type: AttributeTypeNumber},
+				{Name: "organic", Type: AttributeTypeBoolean},
+				{Name: "size", Type: AttributeTypeEnum, AllowedValues: []string{"S", "M", "L"}},
+			},
+		},
+		{
+			name: "attribute defined twice",
+			attributes: []AttributeDefinition{
+				{Name: "brand", Type: AttributeTypeString},
+				{Name: "brand", Type: AttributeTypeNumber},
+			},
+			wantErr: ErrInvalidAttributeSchema,
+		},
+		{
+			name:       "enum without allowed values",
+			attributes: []AttributeDefinition{{Name: "size", Type: AttributeTypeEnum}},
+			wantErr:    ErrInvalidAttributeSchema,
+		},
+		{
+			name:       "unknown type",
+			attributes: []AttributeDefinition{{Name: "size", Type: "date"}},
+			wantErr:    ErrInvalidAttributeSchema,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			schema := AttributeSchema{SkuType: "apparel", Attributes: tt.attributes}
+			if err := schema.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAttributeSchema_ValidateAttributes(t *testing.T) {
+	t.Parallel()
+
+	schema := AttributeSchema{
+		SkuType: "apparel",
+		Attributes: []AttributeDefinition{
+			{Name: "brand", Type: AttributeTypeString, Required: true},
+			{Name: "weight", Type: AttributeTypeNumber},
+			{Name: "organic", Type: AttributeTypeBoolean},
+			{Name: "size", Type: AttributeTypeEnum, AllowedValues: []string{"S", "M", "L"}},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		attributes SKUAttributes
+		wantErr    error
+	}{
+		{
+			name:       "every attribute",
+			attributes: SKUAttributes{"brand": "acme", "weight": 0.5, "organic": true, "size": "M"},
+		},
+		{
+			name:       "only required attribute",
+			attributes: SKUAttributes{"brand": "acme"},
+		},
+		{
+			name:       "required attribute is missing",
+			attributes: SKUAttributes{"size": "M"},
+			wantErr:    ErrInvalidAttributes,
+		},
+		{
+			name:       "unknown attribute",
+			attributes: SKUAttributes{"brand": "acme", "color": "red"},
+			wantErr:    ErrInvalidAttributes,
+		},
+		{
+			name:       "string instead of number",
+			attributes: SKUAttributes{"brand": "acme", "weight": "0.5"},
+			wantErr:    ErrInvalidAttributes,
+		},
+		{
+			name:       "number instead of boolean",
+			attributes: SKUAttributes{"brand": "acme", "organic": float64(1)},
+			wantErr:    ErrInvalidAttributes,
+		},
+		{
+			name:       "value not allowed by enum",
+			attributes: SKUAttributes{"brand": "acme", "size": "XXL"},
+			wantErr:    ErrInvalidAttributes,
+		},
+		{
+			name:       "number instead of enum value",
+			attributes: SKUAttributes{"brand": "acme", "size": float64(42)},
+			wantErr:    ErrInvalidAttributes,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := schema.ValidateAttributes(tt.attributes); !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateAttributes() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVariantGroup_AxesValues(t *testing.T) {
+	t.Parallel()
+
+	group := VariantGroup{Name: "t-shirt", SkuType: "apparel", Axes: []string{"size", "color"}}
+
+	tests := []struct {
+		name       string
+		attributes SKUAttributes
+		want       SKUAttributes
+		wantErr    error
+	}{
+		{
+			name:       "axes values without other attributes",
+			attributes: SKUAttributes{"size": "M", "color": "red", "brand": "acme"},
+			want:       SKUAttributes{"size": "M", "color": "red"},
+		},
+		{
+			name:       "axis is missing",
+			attributes: SKUAttributes{"size": "M"},
+			wantErr:    ErrInvalidAttributes,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := group.AxesValues(tt.attributes)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AxesValues() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AxesValues() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
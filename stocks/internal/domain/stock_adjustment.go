@@ -0,0 +1,37 @@
+package domain
+
+// AdjustmentReason represent why stock quantity was changed.
+type AdjustmentReason string
+
+const (
+	AdjustmentReasonReceived   AdjustmentReason = "received"
+	AdjustmentReasonSold       AdjustmentReason = "sold"
+	AdjustmentReasonReturned   AdjustmentReason = "returned"
+	AdjustmentReasonDamaged    AdjustmentReason = "damaged"
+	AdjustmentReasonLost       AdjustmentReason = "lost"
+	AdjustmentReasonCorrection AdjustmentReason = "correction"
+)
+
+// StockAdjustment represent signed change of stock item quantity.
+type StockAdjustment struct {
+	UserID   UserID
+	SkuID    SKUID
+	Location string
+	Delta    int64
+	Reason   AdjustmentReason
+	Note     string
+}
+
+// StockAdjustmentResult represent stock item state after adjustment was applied.
+type StockAdjustmentResult struct {
+	StockItem
+	// Quantity is the real quantity after adjustment, it is negative for backordered stock.
+	Quantity int64
+	// Lots are lots units were taken from first expired first out, empty for increases.
+	Lots []LotAllocation
+}
+
+// ClampCount converts quantity to stock item count, negative quantities are treated as no stock.
+func ClampCount(quantity int64) int64 {
+	return max(quantity, 0)
+}
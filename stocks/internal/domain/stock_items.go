@@ -1,10 +1,95 @@
 package domain
 
+import "time"
+
 // StockItem represent stock's items domain.
 type StockItem struct {
+	UserID UserID
+	Sku    SKU
+	// Count is quantity in minor units of sku unit of measure, e.g. grams of sku sold by kg.
+	Count int64
+	// Price is price of one unit of measure in currency of the offer.
+	Price    Money
+	Location string
+	Level    StockLevel
+	// Version grows with every committed write of stock item, later writes have greater versions.
+	Version int64
+	// Backorder tells whether stock item may be sold beyond its count.
+	Backorder BackorderPolicy
+	// Lot is lot added units belong to, it is set only when stock is received with lot number.
+	Lot *StockLot
+	// IncomingQuantity is quantity ordered from suppliers and not received yet, set for offers only.
+	IncomingQuantity int64
+	// IncomingExpectedAt is the earliest expected delivery of incoming quantity, zero when it is unknown.
+	IncomingExpectedAt time.Time
+	// ListPrice is price before price tiers, set for offers only, Price of offer is the one order gets.
+	ListPrice Money
+	// Tier is price tier Price of offer comes from, nil when list price applies.
+	Tier *PriceTier
+}
+
+// StockItemField represent field of stock item which can be changed by partial update.
+type StockItemField string
+
+const (
+	StockItemFieldCount    StockItemField = "count"
+	StockItemFieldPrice    StockItemField = "price"
+	StockItemFieldLocation StockItemField = "location"
+)
+
+const (
+	// AdjustmentReasonDeleted is ledger reason of units written off by deleting stock item.
+	AdjustmentReasonDeleted AdjustmentReason = "deleted"
+	// AdjustmentReasonRestored is ledger reason of units brought back by restoring deleted stock item.
+	AdjustmentReasonRestored AdjustmentReason = "restored"
+	// AdjustmentReasonOpening is ledger reason of quantity stock item had before ledger was kept.
+	AdjustmentReasonOpening AdjustmentReason = "opening"
+)
+
+// StockItemUpdate represent partial update of stock item, only fields listed in UpdateMask are written,
+// so zero values like price 0 or empty location can be set explicitly.
+type StockItemUpdate struct {
+	UserID UserID
+	SkuID  SKUID
+	// Location is current location of stock item which is updated.
+	Location    string
+	Count       int64
+	Price       Money
+	NewLocation string
+	UpdateMask  []StockItemField
+}
+
+// StockChange represent state of stock item after its quantity or price was changed.
+type StockChange struct {
 	UserID   UserID
-	Sku      SKU
-	Count    uint16
-	Price    uint32
+	SkuID    SKUID
 	Location string
+	Count    int64
+	Price    Money
+	// Deleted is set when stock item was deleted and is not available anymore.
+	Deleted bool
+	// Snapshot is set for current state sent to watcher before any change happened.
+	Snapshot  bool
+	ChangedAt time.Time
+	// Version is version of stock item change carries, changes of stock item are ordered by it.
+	Version int64
+}
+
+// NewStockChange returns change carrying current state of stock item.
+func NewStockChange(stockItem StockItem) StockChange {
+	return StockChange{
+		UserID:    stockItem.UserID,
+		SkuID:     stockItem.Sku.ID,
+		Location:  stockItem.Location,
+		Count:     stockItem.Count,
+		Price:     stockItem.Price,
+		ChangedAt: time.Now(),
+		Version:   stockItem.Version,
+	}
+}
+
+// Supersedes tells whether change is newer state of stock item than other one, changes without
+// version are not ordered and supersede any change.
+func (c StockChange) Supersedes(other StockChange) bool {
+	return c.Version == 0 || other.Version == 0 || c.Version > other.Version
 }
@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// LotID represent stock lot id.
+type LotID int64
+
+// AdjustmentReasonExpired is ledger reason of units moved out of available stock when their lot expired.
+const AdjustmentReasonExpired AdjustmentReason = "expired"
+
+// StockLot represent units of stock item received together, they share lot number and expiry date.
+// Sum of lot quantities never exceeds count of stock item, the rest is stock received without lot.
+type StockLot struct {
+	ID         LotID
+	UserID     UserID
+	Sku        SKU
+	Location   string
+	LotNumber  string
+	ReceivedAt time.Time
+	// ExpiresAt is zero for lots which do not expire.
+	ExpiresAt time.Time
+	Quantity  int64
+}
+
+// Validate checks lot receipt has lot number and does not expire before it is received.
+func (l StockLot) Validate() error {
+	if l.LotNumber == "" {
+		return fmt.Errorf("%w: lot number is required", ErrInvalidLot)
+	}
+
+	if !l.ExpiresAt.IsZero() && !l.ReceivedAt.IsZero() && l.ExpiresAt.Before(l.ReceivedAt) {
+		return fmt.Errorf("%w: lot expires before it is received", ErrInvalidLot)
+	}
+
+	return nil
+}
+
+// LotAllocation represent units taken from lot by stock decrement.
+type LotAllocation struct {
+	LotID     LotID
+	LotNumber string
+	ExpiresAt time.Time
+	Quantity  int64
+}
+
+// AllocateFEFO takes quantity from lots first expired first out: lots expiring earlier go first, lots which
+// do not expire go last and lots expiring at the same time are taken in order they were received.
+// When lots do not hold the whole quantity the rest is taken from stock without lot, so it is not allocated.
+func AllocateFEFO(lots []StockLot, quantity int64) []LotAllocation {
+	ordered := make([]StockLot, len(lots))
+	copy(ordered, lots)
+
+	sort.SliceStable(ordered, func(i, j int) bool {
+		left, right := ordered[i], ordered[j]
+		if !left.ExpiresAt.Equal(right.ExpiresAt) {
+			if left.ExpiresAt.IsZero() || right.ExpiresAt.IsZero() {
+				return right.ExpiresAt.IsZero()
+			}
+
+			return left.ExpiresAt.Before(right.ExpiresAt)
+		}
+
+		if !left.ReceivedAt.Equal(right.ReceivedAt) {
+			return left.ReceivedAt.Before(right.ReceivedAt)
+		}
+
+		return left.ID < right.ID
+	})
+
+	var allocations []LotAllocation
+
+	for _, lot := range ordered {
+		if quantity <= 0 {
+			break
+		}
+
+		if lot.Quantity <= 0 {
+			continue
+		}
+
+		taken := min(lot.Quantity, quantity)
+		quantity -= taken
+
+		allocations = append(allocations, LotAllocation{
+			LotID:     lot.ID,
+			LotNumber: lot.LotNumber,
+			ExpiresAt: lot.ExpiresAt,
+			Quantity:  taken,
+		})
+	}
+
+	return allocations
+}
+
+// ExpiredLot represent lot whose remaining units were moved out of available stock by expiry sweeper,
+// Lot.Quantity is the expired quantity.
+type ExpiredLot struct {
+	Lot StockLot
+	StockAdjustmentResult
+}
+
+// ExpiringLotsFilter represent filter of lots with stock expiring soon.
+type ExpiringLotsFilter struct {
+	// ExpiresBefore matches lots expiring before that time, expired lots which are not swept yet included.
+	ExpiresBefore time.Time
+	// UserID and Location are optional, zero values match all sellers and locations.
+	UserID      UserID
+	Location    string
+	PageSize    int64
+	CurrentPage int64
+}
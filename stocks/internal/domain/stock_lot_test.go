@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAllocateFEFO(t *testing.T) {
+	t.Parallel()
+
+	received := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	expiresSoon := received.AddDate(0, 1, 0)
+	expiresLater := received.AddDate(0, 6, 0)
+
+	lots := []StockLot{
+		{ID: 1, LotNumber: "no-expiry", ReceivedAt: received, Quantity: 10},
+		{ID: 2, LotNumber: "later", ReceivedAt: received, ExpiresAt: expiresLater, Quantity: 5},
+		{ID: 3, LotNumber: "soon-new", ReceivedAt: received.AddDate(0, 0, 1), ExpiresAt: expiresSoon, Quantity: 4},
+		{ID: 4, LotNumber: "soon-old", ReceivedAt: received, ExpiresAt: expiresSoon, Quantity: 2},
+		{ID: 5, LotNumber: "empty", ReceivedAt: received, ExpiresAt: received, Quantity: 0},
+	}
+
+	tests := []struct {
+		name     string
+		quantity int64
+		want     []LotAllocation
+	}{
+		{name: "nothing to take", quantity: 0, want: nil},
+		{
+			name:     "earliest expiry received first goes first",
+			quantity: 1,
+			want:     []LotAllocation{{LotID: 4, LotNumber: "soon-old", ExpiresAt: expiresSoon, Quantity: 1}},
+		},
+		{
+			name:     "spans lots in expiry order",
+			quantity: 8,
+			want: []LotAllocation{
+				{LotID: 4, LotNumber: "soon-old", ExpiresAt: expiresSoon, Quantity: 2},
+				{LotID: 3, LotNumber: "soon-new", ExpiresAt: expiresSoon, Quantity: 4},
+				{LotID: 2, LotNumber: "later", ExpiresAt: expiresLater, Quantity: 2},
+			},
+		},
+		{
+			name:     "rest is taken from stock without lot",
+			quantity: 30,
+			want: []LotAllocation{
+				{LotID: 4, LotNumber: "soon-old", ExpiresAt: expiresSoon, Quantity: 2},
+				{LotID: 3, LotNumber: "soon-new", ExpiresAt: expiresSoon, Quantity: 4},
+				{LotID: 2, LotNumber: "later", ExpiresAt: expiresLater, Quantity: 5},
+				{LotID: 1, LotNumber: "no-expiry", Quantity: 10},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := AllocateFEFO(lots, tt.quantity); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AllocateFEFO(%d) = %+v, want %+v", tt.quantity, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStockLot_Validate(t *testing.T) {
+	t.Parallel()
+
+	received := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		lot     StockLot
+		wantErr error
+	}{
+		{name: "lot without expiry", lot: StockLot{LotNumber: "L1"}},
+		{name: "lot with expiry", lot: StockLot{LotNumber: "L1", ReceivedAt: received, ExpiresAt: received.AddDate(0, 1, 0)}},
+		{name: "expiry without lot number", lot: StockLot{ExpiresAt: received}, wantErr: ErrInvalidLot},
+		{name: "expires before received", lot: StockLot{LotNumber: "L1", ReceivedAt: received, ExpiresAt: received.Add(-time.Hour)}, wantErr: ErrInvalidLot},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := tt.lot.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package domain
+
+import "fmt"
+
+// OfferRule represent how the best offer of sku is chosen among sellers.
+type OfferRule string
+
+const (
+	// OfferRuleLowestPrice prefers the cheapest offer, the one with more stock wins ties.
+	OfferRuleLowestPrice OfferRule = "lowest_price"
+	// OfferRuleMostStock prefers offer with the largest quantity, the cheaper one wins ties.
+	OfferRuleMostStock OfferRule = "most_stock"
+)
+
+// ParseOfferRule returns OfferRule of value or error when rule is unknown.
+func ParseOfferRule(value string) (OfferRule, error) {
+	switch rule := OfferRule(value); rule {
+	case OfferRuleLowestPrice, OfferRuleMostStock:
+		return rule, nil
+	}
+
+	return "", fmt.Errorf("unknown offer rule %q", value)
+}
+
+// OfferFilter represent parameters of looking up offers of sku. Every live stock item of sku is an offer
+// of its seller (user_id) in its location.
+type OfferFilter struct {
+	SkuID SKUID
+	// SellerID limits offers to one seller, zero means every seller.
+	SellerID UserID
+	// Rule orders offers, empty means default rule of service.
+	Rule OfferRule
+	// AllOffers requests every offer instead of the best one only.
+	AllOffers bool
+	// Quantity and CustomerGroup price offers by price tiers order of quantity by customer of group gets,
+	// zero quantity is one unit of measure.
+	Quantity      int64
+	CustomerGroup string
+}
+
+// StockOffers represent the best offer of sku and, when requested, all of its offers.
+type StockOffers struct {
+	Best StockItem
+	// Offers are ordered by rule, best first.
+	Offers []StockItem
+}
@@ -0,0 +1,54 @@
+package domain
+
+// StockLevel represent alert state of a stock item.
+type StockLevel string
+
+const (
+	// StockLevelOK is used when stock is above its reorder threshold.
+	StockLevelOK StockLevel = "ok"
+	// StockLevelLow is used when stock is at or below its reorder threshold.
+	StockLevelLow StockLevel = "low"
+	// StockLevelDepleted is used when there is no stock left.
+	StockLevelDepleted StockLevel = "depleted"
+)
+
+// StockThreshold represent reorder threshold of sku, optionally bound to a location.
+// Empty location means threshold applies to all locations of the sku.
+type StockThreshold struct {
+	SkuID            SKUID
+	Location         string
+	ReorderThreshold uint32
+	// Hysteresis is how far above the threshold stock must rise before a raised alert is cleared.
+	Hysteresis uint32
+}
+
+// Evaluate returns the stock level for given count, taking current level into account
+// so that alerts do not flap while count oscillates around the threshold.
+func (t StockThreshold) Evaluate(current StockLevel, count int64) StockLevel {
+	switch {
+	case count <= 0:
+		return StockLevelDepleted
+	case count <= int64(t.ReorderThreshold):
+		return StockLevelLow
+	case current == StockLevelOK || current == "":
+		return StockLevelOK
+	case count >= int64(t.ReorderThreshold)+int64(t.Hysteresis):
+		return StockLevelOK
+	default:
+		// still inside hysteresis band, depleted stock that got some units back is only low.
+		return StockLevelLow
+	}
+}
+
+// LowStockItem represent stock item which is low or depleted with its threshold.
+type LowStockItem struct {
+	StockItem
+	ReorderThreshold uint32
+}
+
+// LowStockFilter represent parameters for listing low stock items.
+type LowStockFilter struct {
+	Location    string
+	PageSize    int64
+	CurrentPage int64
+}
@@ -0,0 +1,36 @@
+package domain
+
+import "testing"
+
+func TestStockThreshold_Evaluate(t *testing.T) {
+	t.Parallel()
+
+	threshold := StockThreshold{SkuID: 1001, ReorderThreshold: 10, Hysteresis: 5}
+
+	tests := []struct {
+		name    string
+		current StockLevel
+		count   int64
+		want    StockLevel
+	}{
+		{name: "ok stays ok above threshold", current: StockLevelOK, count: 11, want: StockLevelOK},
+		{name: "ok becomes low at threshold", current: StockLevelOK, count: 10, want: StockLevelLow},
+		{name: "ok becomes depleted at zero", current: StockLevelOK, count: 0, want: StockLevelDepleted},
+		{name: "backordered stock is depleted", current: StockLevelLow, count: -3, want: StockLevelDepleted},
+		{name: "low stays low inside hysteresis band", current: StockLevelLow, count: 14, want: StockLevelLow},
+		{name: "low clears at threshold plus hysteresis", current: StockLevelLow, count: 15, want: StockLevelOK},
+		{name: "depleted becomes low when restocked inside band", current: StockLevelDepleted, count: 12, want: StockLevelLow},
+		{name: "depleted clears when fully restocked", current: StockLevelDepleted, count: 20, want: StockLevelOK},
+		{name: "unknown level treated as ok", current: "", count: 11, want: StockLevelOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := threshold.Evaluate(tt.current, tt.count); got != tt.want {
+				t.Errorf("Evaluate(%q, %d) = %q, want %q", tt.current, tt.count, got, tt.want)
+			}
+		})
+	}
+}
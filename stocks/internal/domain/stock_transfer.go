@@ -0,0 +1,42 @@
+package domain
+
+import "time"
+
+// TransferID represent stock transfer id.
+type TransferID int64
+
+// TransferStatus represent state of stock transfer between locations.
+type TransferStatus string
+
+const (
+	// TransferStatusInTransit is used when goods left source location but not arrived yet.
+	TransferStatusInTransit TransferStatus = "in_transit"
+	// TransferStatusReceived is used when goods arrived to destination location.
+	TransferStatusReceived TransferStatus = "received"
+)
+
+const (
+	// AdjustmentReasonTransferOut is ledger reason of units shipped from source location.
+	AdjustmentReasonTransferOut AdjustmentReason = "transfer_out"
+	// AdjustmentReasonTransferIn is ledger reason of units received to destination location.
+	AdjustmentReasonTransferIn AdjustmentReason = "transfer_in"
+)
+
+// StockTransfer represent movement of sku units from one location to another.
+type StockTransfer struct {
+	ID           TransferID
+	UserID       UserID
+	SkuID        SKUID
+	FromLocation string
+	ToLocation   string
+	Quantity     int64
+	Status       TransferStatus
+	ShippedAt    time.Time
+	ReceivedAt   time.Time
+}
+
+// StockTransferResult represent stock transfer with stock items changed by it.
+type StockTransferResult struct {
+	Transfer     StockTransfer
+	ChangedItems []StockItem
+}
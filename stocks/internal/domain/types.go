@@ -5,3 +5,6 @@ type UserID int64
 
 // SKUID represent sku id.
 type SKUID uint32
+
+// VariantGroupID represent variant group id.
+type VariantGroupID int64
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// UnitOfMeasure represent unit sku is counted in. Counts are integers in minor units of the unit,
+// so weighed goods can have fractional quantities without floating point.
+type UnitOfMeasure string
+
+const (
+	UnitOfMeasureEach UnitOfMeasure = "each"
+	// UnitOfMeasureKg counts are kept in grams.
+	UnitOfMeasureKg   UnitOfMeasure = "kg"
+	UnitOfMeasurePack UnitOfMeasure = "pack"
+)
+
+// Scale returns number of minor units in one unit.
+func (u UnitOfMeasure) Scale() int64 {
+	if u == UnitOfMeasureKg {
+		return 1000
+	}
+
+	return 1
+}
+
+// FormatQuantity formats count given in minor units as decimal quantity of unit, e.g. 1250 grams as "1.250".
+func (u UnitOfMeasure) FormatQuantity(count int64) string {
+	scale := u.Scale()
+	if scale == 1 {
+		return strconv.FormatInt(count, 10)
+	}
+
+	sign := ""
+	if count < 0 {
+		sign = "-"
+		count = -count
+	}
+
+	fractionDigits := len(strconv.FormatInt(scale, 10)) - 1
+
+	return fmt.Sprintf("%s%d.%0*d", sign, count/scale, fractionDigits, count%scale)
+}
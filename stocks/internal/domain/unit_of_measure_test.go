@@ -0,0 +1,29 @@
+package domain
+
+import "testing"
+
+func TestUnitOfMeasure_FormatQuantity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		unit  UnitOfMeasure
+		count int64
+		want  string
+	}{
+		{name: "each is whole number", unit: UnitOfMeasureEach, count: 70000, want: "70000"},
+		{name: "kg is counted in grams", unit: UnitOfMeasureKg, count: 1250, want: "1.250"},
+		{name: "less than kg", unit: UnitOfMeasureKg, count: 5, want: "0.005"},
+		{name: "backordered kg", unit: UnitOfMeasureKg, count: -1500, want: "-1.500"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.unit.FormatQuantity(tt.count); got != tt.want {
+				t.Errorf("FormatQuantity(%d) = %q, want %q", tt.count, got, tt.want)
+			}
+		})
+	}
+}
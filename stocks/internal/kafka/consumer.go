@@ -0,0 +1,94 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
+)
+
+const (
+	sessionTimeoutMs = 7000
+	readTimeout      = 2
+)
+
+// MessageHandler handles message consumed from kafka, message is stored as consumed only when it returns no error.
+type MessageHandler interface {
+	HandleMessage(ctx context.Context, message []byte, partition int32, offset int64) error
+}
+
+// Consumer reads messages of a topic and passes them to handler.
+type Consumer struct {
+	consumer *kafka.Consumer
+}
+
+func NewConsumer(address []string, topic, consumerGroup string) (*Consumer, error) {
+	conf := &kafka.ConfigMap{
+		"bootstrap.servers":        strings.Join(address, ","),
+		"group.id":                 consumerGroup,
+		"session.timeout.ms":       sessionTimeoutMs,
+		"enable.auto.offset.store": false,
+		"enable.auto.commit":       true,
+		"auto.commit.interval.ms":  5000,
+		"auto.offset.reset":        "earliest",
+	}
+
+	c, err := kafka.NewConsumer(conf)
+	if err != nil {
+		return nil, fmt.Errorf("error creating stocks service kafka consumer: %w", err)
+	}
+
+	if err := c.Subscribe(topic, nil); err != nil {
+		return nil, fmt.Errorf("[stocksService]: c.Subscribe: %w", err)
+	}
+
+	return &Consumer{
+		consumer: c,
+	}, nil
+}
+
+// Start reads messages until ctx is cancelled.
+func (c *Consumer) Start(ctx context.Context, handler MessageHandler) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("[stocksService]context cancelled, stopping consumer...")
+			return
+		default:
+			kafkaMsg, err := c.consumer.ReadMessage(readTimeout)
+			if err != nil {
+				if kafkaErr, ok := err.(kafka.Error); ok && kafkaErr.Code() != kafka.ErrTimedOut {
+					log.Printf("[stocksService]c.consumer.ReadMessage: %v\n", err.Error())
+				}
+
+				continue
+			}
+
+			if kafkaMsg == nil {
+				continue
+			}
+
+			err = handler.HandleMessage(ctx, kafkaMsg.Value, kafkaMsg.TopicPartition.Partition, int64(kafkaMsg.TopicPartition.Offset))
+			if err != nil {
+				log.Printf("[stocksService]handler.HandleMessage: %v\n", err.Error())
+				continue
+			}
+
+			if _, err := c.consumer.StoreMessage(kafkaMsg); err != nil {
+				log.Printf("[stocksService]c.consumer.StoreMessage: %v\n", err.Error())
+				continue
+			}
+		}
+	}
+}
+
+// Close commits stored offsets and leaves consumer group.
+func (c *Consumer) Close() error {
+	if _, err := c.consumer.Commit(); err != nil {
+		log.Printf("[stocksService]c.consumer.Commit: %v\n", err.Error())
+	}
+
+	return c.consumer.Close()
+}
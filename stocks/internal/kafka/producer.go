@@ -19,6 +19,13 @@ type (
 	StocksEventProducer interface {
 		ProduceSKUCreated(ctx context.Context, payload SKUCreatedAndStockChangedPayload)
 		ProduceStockChanged(ctx context.Context, payload SKUCreatedAndStockChangedPayload)
+		ProduceStockLow(ctx context.Context, payload StockLevelPayload)
+		ProduceStockDepleted(ctx context.Context, payload StockLevelPayload)
+		ProduceStockTransferShipped(ctx context.Context, payload StockTransferPayload)
+		ProduceStockTransferReceived(ctx context.Context, payload StockTransferPayload)
+		ProducePriceChanged(ctx context.Context, payload PriceChangedPayload)
+		ProduceStockDeleted(ctx context.Context, payload StockDeletedPayload)
+		ProduceStockReconciled(ctx context.Context, payload StockReconciledPayload)
 		Close()
 	}
 )
@@ -32,9 +39,61 @@ type (
 	}
 
 	SKUCreatedAndStockChangedPayload struct {
-		SKU   string `json:"sku"`
-		Price uint32 `json:"price"`
-		Count uint16 `json:"count"`
+		SKU      string `json:"sku"`
+		Price    int64  `json:"price"`
+		Currency string `json:"currency"`
+		Count    int64  `json:"count"`
+		Delta    int64  `json:"delta,omitempty"`
+		Reason   string `json:"reason,omitempty"`
+	}
+
+	StockLevelPayload struct {
+		SKU              string `json:"sku"`
+		UserID           int64  `json:"userId"`
+		Location         string `json:"location"`
+		Count            int64  `json:"count"`
+		ReorderThreshold uint32 `json:"reorderThreshold"`
+	}
+
+	StockTransferPayload struct {
+		TransferID   int64  `json:"transferId"`
+		SKU          string `json:"sku"`
+		UserID       int64  `json:"userId"`
+		FromLocation string `json:"fromLocation"`
+		ToLocation   string `json:"toLocation"`
+		Quantity     int64  `json:"quantity"`
+		Status       string `json:"status"`
+	}
+
+	PriceChangedPayload struct {
+		SKU               string `json:"sku"`
+		UserID            int64  `json:"userId"`
+		Location          string `json:"location"`
+		OldPrice          int64  `json:"oldPrice"`
+		OldCurrency       string `json:"oldCurrency"`
+		NewPrice          int64  `json:"newPrice"`
+		Currency          string `json:"currency"`
+		ScheduledChangeID int64  `json:"scheduledChangeId"`
+	}
+
+	StockDeletedPayload struct {
+		SKU       string `json:"sku"`
+		UserID    int64  `json:"userId"`
+		Location  string `json:"location"`
+		Count     int64  `json:"count"`
+		DeletedBy int64  `json:"deletedBy"`
+	}
+
+	StockReconciledPayload struct {
+		CycleCountID    int64  `json:"cycleCountId"`
+		SKU             string `json:"sku"`
+		UserID          int64  `json:"userId"`
+		Location        string `json:"location"`
+		SystemQuantity  int64  `json:"systemQuantity"`
+		CountedQuantity int64  `json:"countedQuantity"`
+		Variance        int64  `json:"variance"`
+		QuantityAfter   int64  `json:"quantityAfter"`
+		ApprovedBy      int64  `json:"approvedBy"`
 	}
 )
 
@@ -113,6 +172,118 @@ func (sp *stocksEventProducer) ProduceStockChanged(ctx context.Context, payload
 	sp.produce(ctx, eventBytes, "stock_changed_key", 1)
 }
 
+func (sp *stocksEventProducer) ProduceStockLow(ctx context.Context, payload StockLevelPayload) {
+	event := EventModel{
+		Type:      "stock_low",
+		Service:   "stock",
+		Timestamp: time.Now(),
+		Payload:   payload,
+	}
+
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("failed to marshal stock_low event: %v\n", err.Error())
+	}
+
+	sp.produce(ctx, eventBytes, "stock_low_key", 1)
+}
+
+func (sp *stocksEventProducer) ProduceStockDepleted(ctx context.Context, payload StockLevelPayload) {
+	event := EventModel{
+		Type:      "stock_depleted",
+		Service:   "stock",
+		Timestamp: time.Now(),
+		Payload:   payload,
+	}
+
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("failed to marshal stock_depleted event: %v\n", err.Error())
+	}
+
+	sp.produce(ctx, eventBytes, "stock_depleted_key", 1)
+}
+
+func (sp *stocksEventProducer) ProduceStockTransferShipped(ctx context.Context, payload StockTransferPayload) {
+	event := EventModel{
+		Type:      "stock_transfer_shipped",
+		Service:   "stock",
+		Timestamp: time.Now(),
+		Payload:   payload,
+	}
+
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("failed to marshal stock_transfer_shipped event: %v\n", err.Error())
+	}
+
+	sp.produce(ctx, eventBytes, "stock_transfer_shipped_key", 1)
+}
+
+func (sp *stocksEventProducer) ProduceStockTransferReceived(ctx context.Context, payload StockTransferPayload) {
+	event := EventModel{
+		Type:      "stock_transfer_received",
+		Service:   "stock",
+		Timestamp: time.Now(),
+		Payload:   payload,
+	}
+
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("failed to marshal stock_transfer_received event: %v\n", err.Error())
+	}
+
+	sp.produce(ctx, eventBytes, "stock_transfer_received_key", 1)
+}
+
+func (sp *stocksEventProducer) ProducePriceChanged(ctx context.Context, payload PriceChangedPayload) {
+	event := EventModel{
+		Type:      "price_changed",
+		Service:   "stock",
+		Timestamp: time.Now(),
+		Payload:   payload,
+	}
+
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("failed to marshal price_changed event: %v\n", err.Error())
+	}
+
+	sp.produce(ctx, eventBytes, "price_changed_key", 1)
+}
+
+func (sp *stocksEventProducer) ProduceStockDeleted(ctx context.Context, payload StockDeletedPayload) {
+	event := EventModel{
+		Type:      "stock_deleted",
+		Service:   "stock",
+		Timestamp: time.Now(),
+		Payload:   payload,
+	}
+
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("failed to marshal stock_deleted event: %v\n", err.Error())
+	}
+
+	sp.produce(ctx, eventBytes, "stock_deleted_key", 1)
+}
+
+func (sp *stocksEventProducer) ProduceStockReconciled(ctx context.Context, payload StockReconciledPayload) {
+	event := EventModel{
+		Type:      "stock_reconciled",
+		Service:   "stock",
+		Timestamp: time.Now(),
+		Payload:   payload,
+	}
+
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("failed to marshal stock_reconciled event: %v\n", err.Error())
+	}
+
+	sp.produce(ctx, eventBytes, "stock_reconciled_key", 1)
+}
+
 func (sp *stocksEventProducer) produce(ctx context.Context, message []byte, key string, partition int32) {
 	kafkaMessage := &kafka.Message{
 		TopicPartition: kafka.TopicPartition{
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE sku ADD COLUMN IF NOT EXISTS search_vector TSVECTOR
+    GENERATED ALWAYS AS (
+        to_tsvector('simple', replace(name, '-', ' ') || ' ' || COALESCE(type, ''))
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_sku_search_vector ON sku USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_sku_name_trgm ON sku USING GIN (name gin_trgm_ops);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_sku_name_trgm;
+DROP INDEX IF EXISTS idx_sku_search_vector;
+ALTER TABLE sku DROP COLUMN IF EXISTS search_vector;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS stock_thresholds (
+    id BIGSERIAL PRIMARY KEY,
+    sku_id BIGINT NOT NULL REFERENCES sku (sku_id),
+    -- empty location means the threshold applies to every location of the sku.
+    location TEXT NOT NULL DEFAULT '',
+    reorder_threshold BIGINT NOT NULL CHECK (reorder_threshold >= 0),
+    hysteresis BIGINT NOT NULL DEFAULT 0 CHECK (hysteresis >= 0),
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE(sku_id, location)
+);
+
+ALTER TABLE stock_items ADD COLUMN IF NOT EXISTS stock_level TEXT NOT NULL DEFAULT 'ok';
+
+CREATE INDEX IF NOT EXISTS idx_stock_items_stock_level ON stock_items (stock_level) WHERE stock_level <> 'ok';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_stock_items_stock_level;
+ALTER TABLE stock_items DROP COLUMN IF EXISTS stock_level;
+DROP TABLE IF EXISTS stock_thresholds;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE stock_items ADD COLUMN IF NOT EXISTS backorders_enabled BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS stock_movements (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    sku_id BIGINT NOT NULL REFERENCES sku (sku_id),
+    location TEXT NOT NULL,
+    delta BIGINT NOT NULL,
+    quantity_after BIGINT NOT NULL,
+    reason TEXT NOT NULL,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_movements_sku_created_at ON stock_movements (sku_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS stock_movements;
+ALTER TABLE stock_items DROP COLUMN IF EXISTS backorders_enabled;
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+UPDATE stock_items SET location = '' WHERE location IS NULL;
+ALTER TABLE stock_items ALTER COLUMN location SET NOT NULL;
+
+-- the same sku can now be stored in several locations.
+ALTER TABLE stock_items DROP CONSTRAINT IF EXISTS stock_items_sku_id_key;
+ALTER TABLE stock_items ADD CONSTRAINT stock_items_user_id_sku_id_location_key UNIQUE (user_id, sku_id, location);
+CREATE INDEX IF NOT EXISTS idx_stock_items_sku_id ON stock_items (sku_id);
+
+CREATE TABLE IF NOT EXISTS stock_transfers (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    sku_id BIGINT NOT NULL REFERENCES sku (sku_id),
+    from_location TEXT NOT NULL,
+    to_location TEXT NOT NULL,
+    quantity BIGINT NOT NULL CHECK (quantity > 0),
+    status TEXT NOT NULL DEFAULT 'in_transit',
+    shipped_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    received_at TIMESTAMPTZ,
+
+    CHECK (from_location <> to_location)
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_transfers_in_transit ON stock_transfers (user_id, sku_id) WHERE status = 'in_transit';
+
+ALTER TABLE stock_movements ADD COLUMN IF NOT EXISTS reference TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE stock_movements DROP COLUMN IF EXISTS reference;
+DROP TABLE IF EXISTS stock_transfers;
+DROP INDEX IF EXISTS idx_stock_items_sku_id;
+ALTER TABLE stock_items DROP CONSTRAINT IF EXISTS stock_items_user_id_sku_id_location_key;
+ALTER TABLE stock_items ADD CONSTRAINT stock_items_sku_id_key UNIQUE (sku_id);
+ALTER TABLE stock_items ALTER COLUMN location DROP NOT NULL;
+-- +goose StatementEnd
@@ -0,0 +1,57 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS price_history (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    sku_id BIGINT NOT NULL REFERENCES sku (sku_id),
+    location TEXT NOT NULL,
+    -- old_price is NULL for the price stock item was created with.
+    old_price BIGINT,
+    new_price BIGINT NOT NULL,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_price_history_sku_changed_at ON price_history (sku_id, changed_at);
+
+CREATE TABLE IF NOT EXISTS scheduled_price_changes (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    sku_id BIGINT NOT NULL REFERENCES sku (sku_id),
+    location TEXT NOT NULL,
+    new_price BIGINT NOT NULL,
+    effective_at TIMESTAMPTZ NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    applied_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_scheduled_price_changes_due ON scheduled_price_changes (effective_at) WHERE status = 'pending';
+
+-- every write path of stock_items.price is recorded, including plain UpdateStockItem calls.
+CREATE OR REPLACE FUNCTION record_price_history() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' OR OLD.price IS DISTINCT FROM NEW.price THEN
+        INSERT INTO price_history (user_id, sku_id, location, old_price, new_price)
+        VALUES (
+            NEW.user_id, NEW.sku_id, NEW.location,
+            CASE WHEN TG_OP = 'UPDATE' THEN OLD.price END,
+            NEW.price
+        );
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER stock_items_price_history
+    AFTER INSERT OR UPDATE OF price ON stock_items
+    FOR EACH ROW EXECUTE FUNCTION record_price_history();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS stock_items_price_history ON stock_items;
+DROP FUNCTION IF EXISTS record_price_history();
+DROP TABLE IF EXISTS scheduled_price_changes;
+DROP TABLE IF EXISTS price_history;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE stock_items ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE stock_items ADD COLUMN IF NOT EXISTS deleted_by BIGINT;
+
+-- deleted rows are kept, so only live stock items have to be unique.
+ALTER TABLE stock_items DROP CONSTRAINT IF EXISTS stock_items_user_id_sku_id_location_key;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_stock_items_user_id_sku_id_location_live
+    ON stock_items (user_id, sku_id, location) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_stock_items_deleted_at ON stock_items (deleted_at) WHERE deleted_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM stock_items WHERE deleted_at IS NOT NULL;
+DROP INDEX IF EXISTS idx_stock_items_deleted_at;
+DROP INDEX IF EXISTS idx_stock_items_user_id_sku_id_location_live;
+ALTER TABLE stock_items ADD CONSTRAINT stock_items_user_id_sku_id_location_key UNIQUE (user_id, sku_id, location);
+ALTER TABLE stock_items DROP COLUMN IF EXISTS deleted_by;
+ALTER TABLE stock_items DROP COLUMN IF EXISTS deleted_at;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- version orders changes of stock item in commit order: writes of a row wait for its lock, so every
+-- committed write takes the next value after the previous one. One sequence serves every row, so row
+-- created after stock item was deleted continues versions of its location instead of starting over.
+CREATE SEQUENCE IF NOT EXISTS stock_item_versions;
+
+ALTER TABLE stock_items ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT nextval('stock_item_versions');
+
+CREATE OR REPLACE FUNCTION next_stock_item_version() RETURNS TRIGGER AS $$
+BEGIN
+    NEW.version := nextval('stock_item_versions');
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER stock_items_version
+    BEFORE INSERT OR UPDATE ON stock_items
+    FOR EACH ROW EXECUTE FUNCTION next_stock_item_version();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS stock_items_version ON stock_items;
+DROP FUNCTION IF EXISTS next_stock_item_version();
+ALTER TABLE stock_items DROP COLUMN IF EXISTS version;
+DROP SEQUENCE IF EXISTS stock_item_versions;
+-- +goose StatementEnd
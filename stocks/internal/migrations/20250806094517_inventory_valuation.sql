@@ -0,0 +1,96 @@
+-- +goose Up
+-- +goose StatementBegin
+-- as-of valuation reads the latest ledger entry and price of every stock item before given time.
+CREATE INDEX IF NOT EXISTS idx_stock_movements_item_created_at ON stock_movements (user_id, sku_id, location, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_price_history_item_changed_at ON price_history (user_id, sku_id, location, changed_at DESC, id DESC);
+
+-- stock items which had stock before ledger was kept get opening entry with quantity they had then,
+-- just before their first movement, so as-of valuation finds them at any time since they were created.
+INSERT INTO stock_movements (user_id, sku_id, location, delta, quantity_after, reason, created_at)
+SELECT si.user_id, si.sku_id, si.location,
+    COALESCE(first.quantity_after - first.delta, si.count),
+    COALESCE(first.quantity_after - first.delta, si.count),
+    'opening',
+    COALESCE(LEAST(si.created_at, first.created_at - INTERVAL '1 microsecond'), NOW())
+FROM stock_items si
+LEFT JOIN LATERAL (
+    SELECT m.delta, m.quantity_after, m.created_at
+    FROM stock_movements m
+    WHERE m.user_id = si.user_id AND m.sku_id = si.sku_id AND m.location = si.location
+    ORDER BY m.created_at, m.id
+    LIMIT 1
+) first ON TRUE
+WHERE si.deleted_at IS NULL
+    AND (first.created_at IS NULL OR first.quantity_after - first.delta <> 0);
+
+-- price stock item had before price history was kept, or since it was moved to its location, opens its history.
+INSERT INTO price_history (user_id, sku_id, location, old_price, new_price, changed_at)
+SELECT si.user_id, si.sku_id, si.location, NULL,
+    COALESCE(first.old_price, si.price),
+    COALESCE(LEAST(si.created_at, first.changed_at - INTERVAL '1 microsecond'), NOW())
+FROM stock_items si
+LEFT JOIN LATERAL (
+    SELECT ph.old_price, ph.changed_at
+    FROM price_history ph
+    WHERE ph.user_id = si.user_id AND ph.sku_id = si.sku_id AND ph.location = si.location
+    ORDER BY ph.changed_at, ph.id
+    LIMIT 1
+) first ON TRUE
+WHERE si.deleted_at IS NULL
+    AND (first.changed_at IS NULL OR first.old_price IS NOT NULL);
+
+-- stock item moved to another location starts price history there with the price it has.
+CREATE OR REPLACE FUNCTION record_price_history() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' AND OLD.location IS DISTINCT FROM NEW.location THEN
+        INSERT INTO price_history (user_id, sku_id, location, old_price, new_price)
+        VALUES (NEW.user_id, NEW.sku_id, NEW.location, NULL, NEW.price);
+    ELSIF TG_OP = 'INSERT' OR OLD.price IS DISTINCT FROM NEW.price THEN
+        INSERT INTO price_history (user_id, sku_id, location, old_price, new_price)
+        VALUES (
+            NEW.user_id, NEW.sku_id, NEW.location,
+            CASE WHEN TG_OP = 'UPDATE' THEN OLD.price END,
+            NEW.price
+        );
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS stock_items_price_history ON stock_items;
+
+CREATE TRIGGER stock_items_price_history
+    AFTER INSERT OR UPDATE OF price, location ON stock_items
+    FOR EACH ROW EXECUTE FUNCTION record_price_history();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS stock_items_price_history ON stock_items;
+
+CREATE OR REPLACE FUNCTION record_price_history() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' OR OLD.price IS DISTINCT FROM NEW.price THEN
+        INSERT INTO price_history (user_id, sku_id, location, old_price, new_price)
+        VALUES (
+            NEW.user_id, NEW.sku_id, NEW.location,
+            CASE WHEN TG_OP = 'UPDATE' THEN OLD.price END,
+            NEW.price
+        );
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER stock_items_price_history
+    AFTER INSERT OR UPDATE OF price ON stock_items
+    FOR EACH ROW EXECUTE FUNCTION record_price_history();
+
+-- backfilled opening prices can not be told from recorded ones, they are kept.
+DELETE FROM stock_movements WHERE reason = 'opening';
+
+DROP INDEX IF EXISTS idx_price_history_item_changed_at;
+DROP INDEX IF EXISTS idx_stock_movements_item_created_at;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- bill of materials of bundle skus, bundle has no stock items of its own.
+CREATE TABLE IF NOT EXISTS bundle_components (
+    bundle_sku_id BIGINT NOT NULL REFERENCES sku (sku_id) ON DELETE CASCADE,
+    component_sku_id BIGINT NOT NULL REFERENCES sku (sku_id),
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+
+    PRIMARY KEY (bundle_sku_id, component_sku_id),
+    CHECK (bundle_sku_id <> component_sku_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bundle_components_component ON bundle_components (component_sku_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS bundle_components;
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+-- attributes skus of type may have, definitions are list of {name, type, required, allowed_values}.
+CREATE TABLE IF NOT EXISTS sku_attribute_schemas (
+    type TEXT PRIMARY KEY,
+    attributes JSONB NOT NULL DEFAULT '[]',
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- variant groups join skus which are the same product, axes are attributes variants differ by.
+CREATE TABLE IF NOT EXISTS sku_variant_groups (
+    id BIGSERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    type TEXT NOT NULL,
+    axes TEXT[] NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE sku
+    ADD COLUMN IF NOT EXISTS variant_group_id BIGINT REFERENCES sku_variant_groups (id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS attributes JSONB NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_sku_variant_group ON sku (variant_group_id) WHERE variant_group_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_sku_attributes ON sku USING GIN (attributes jsonb_path_ops);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_sku_attributes;
+DROP INDEX IF EXISTS idx_sku_variant_group;
+
+ALTER TABLE sku
+    DROP COLUMN IF EXISTS attributes,
+    DROP COLUMN IF EXISTS variant_group_id;
+
+DROP TABLE IF EXISTS sku_variant_groups;
+DROP TABLE IF EXISTS sku_attribute_schemas;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- counts of sku are kept in minor units of its unit of measure, grams for kg.
+ALTER TABLE sku
+    ADD COLUMN IF NOT EXISTS unit TEXT NOT NULL DEFAULT 'each' CHECK (unit IN ('each', 'kg', 'pack'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE sku DROP COLUMN IF EXISTS unit;
+-- +goose StatementEnd
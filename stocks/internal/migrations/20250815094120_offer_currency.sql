@@ -0,0 +1,72 @@
+-- +goose Up
+-- +goose StatementBegin
+-- prices are amounts in minor units of currency of the offer, prices stored before currencies were RUB.
+ALTER TABLE stock_items
+    ADD COLUMN IF NOT EXISTS currency TEXT NOT NULL DEFAULT 'RUB' CHECK (currency ~ '^[A-Z]{3}$');
+
+-- old_currency is NULL together with old_price.
+ALTER TABLE price_history
+    ADD COLUMN IF NOT EXISTS old_currency TEXT,
+    ADD COLUMN IF NOT EXISTS currency TEXT NOT NULL DEFAULT 'RUB';
+
+UPDATE price_history SET old_currency = currency WHERE old_price IS NOT NULL;
+
+ALTER TABLE scheduled_price_changes
+    ADD COLUMN IF NOT EXISTS currency TEXT NOT NULL DEFAULT 'RUB';
+
+CREATE OR REPLACE FUNCTION record_price_history() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' AND OLD.location IS DISTINCT FROM NEW.location THEN
+        INSERT INTO price_history (user_id, sku_id, location, old_price, old_currency, new_price, currency)
+        VALUES (NEW.user_id, NEW.sku_id, NEW.location, NULL, NULL, NEW.price, NEW.currency);
+    ELSIF TG_OP = 'INSERT' OR OLD.price IS DISTINCT FROM NEW.price OR OLD.currency IS DISTINCT FROM NEW.currency THEN
+        INSERT INTO price_history (user_id, sku_id, location, old_price, old_currency, new_price, currency)
+        VALUES (
+            NEW.user_id, NEW.sku_id, NEW.location,
+            CASE WHEN TG_OP = 'UPDATE' THEN OLD.price END,
+            CASE WHEN TG_OP = 'UPDATE' THEN OLD.currency END,
+            NEW.price, NEW.currency
+        );
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS stock_items_price_history ON stock_items;
+
+CREATE TRIGGER stock_items_price_history
+    AFTER INSERT OR UPDATE OF price, currency, location ON stock_items
+    FOR EACH ROW EXECUTE FUNCTION record_price_history();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS stock_items_price_history ON stock_items;
+
+CREATE OR REPLACE FUNCTION record_price_history() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' AND OLD.location IS DISTINCT FROM NEW.location THEN
+        INSERT INTO price_history (user_id, sku_id, location, old_price, new_price)
+        VALUES (NEW.user_id, NEW.sku_id, NEW.location, NULL, NEW.price);
+    ELSIF TG_OP = 'INSERT' OR OLD.price IS DISTINCT FROM NEW.price THEN
+        INSERT INTO price_history (user_id, sku_id, location, old_price, new_price)
+        VALUES (
+            NEW.user_id, NEW.sku_id, NEW.location,
+            CASE WHEN TG_OP = 'UPDATE' THEN OLD.price END,
+            NEW.price
+        );
+    END IF;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER stock_items_price_history
+    AFTER INSERT OR UPDATE OF price, location ON stock_items
+    FOR EACH ROW EXECUTE FUNCTION record_price_history();
+
+ALTER TABLE scheduled_price_changes DROP COLUMN IF EXISTS currency;
+ALTER TABLE price_history DROP COLUMN IF EXISTS currency, DROP COLUMN IF EXISTS old_currency;
+ALTER TABLE stock_items DROP COLUMN IF EXISTS currency;
+-- +goose StatementEnd
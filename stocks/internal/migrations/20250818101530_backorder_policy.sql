@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+-- backorder_mode replaces backorders_enabled: backorder sells beyond stock, preorder sells stock which is not released yet.
+-- max_backorder_quantity limits how far below zero count may go, NULL is unlimited like backorders_enabled was.
+ALTER TABLE stock_items
+    ADD COLUMN IF NOT EXISTS backorder_mode TEXT NOT NULL DEFAULT 'none'
+        CHECK (backorder_mode IN ('none', 'backorder', 'preorder')),
+    ADD COLUMN IF NOT EXISTS max_backorder_quantity BIGINT CHECK (max_backorder_quantity > 0),
+    ADD COLUMN IF NOT EXISTS restock_at TIMESTAMPTZ;
+
+UPDATE stock_items SET backorder_mode = 'backorder' WHERE backorders_enabled;
+
+ALTER TABLE stock_items DROP COLUMN IF EXISTS backorders_enabled;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE stock_items ADD COLUMN IF NOT EXISTS backorders_enabled BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE stock_items SET backorders_enabled = backorder_mode <> 'none';
+
+ALTER TABLE stock_items
+    DROP COLUMN IF EXISTS restock_at,
+    DROP COLUMN IF EXISTS max_backorder_quantity,
+    DROP COLUMN IF EXISTS backorder_mode;
+-- +goose StatementEnd
@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+-- lots split stock of stock item by receipt, sum of lot quantities never exceeds count of stock item
+-- and the rest is stock received without lot. lots follow stock item when it is moved or deleted.
+CREATE TABLE IF NOT EXISTS stock_lots (
+    id BIGSERIAL PRIMARY KEY,
+    stock_item_id BIGINT NOT NULL REFERENCES stock_items (id) ON DELETE CASCADE,
+    lot_number TEXT NOT NULL,
+    received_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMPTZ,
+    quantity BIGINT NOT NULL CHECK (quantity >= 0),
+    -- set by expiry sweeper when units left in lot are moved out of available stock.
+    expired_at TIMESTAMPTZ,
+    expired_quantity BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE (stock_item_id, lot_number)
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_lots_expires_at ON stock_lots (expires_at) WHERE quantity > 0;
+
+-- lot ledger, reference ties lot movements to stock transfer they were shipped by.
+CREATE TABLE IF NOT EXISTS stock_lot_movements (
+    id BIGSERIAL PRIMARY KEY,
+    lot_id BIGINT NOT NULL REFERENCES stock_lots (id) ON DELETE CASCADE,
+    delta BIGINT NOT NULL,
+    quantity_after BIGINT NOT NULL,
+    reason TEXT NOT NULL,
+    reference TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_lot_movements_lot_id ON stock_lot_movements (lot_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_stock_lot_movements_reference ON stock_lot_movements (reference);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS stock_lot_movements;
+DROP TABLE IF EXISTS stock_lots;
+-- +goose StatementEnd
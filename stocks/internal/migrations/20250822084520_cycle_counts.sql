@@ -0,0 +1,53 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS cycle_counts (
+    id BIGSERIAL PRIMARY KEY,
+    location TEXT NOT NULL,
+    -- merchant whose stock is counted, 0 counts stock of every merchant in location.
+    user_id BIGINT NOT NULL DEFAULT 0,
+    status TEXT NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'approved')),
+    note TEXT NOT NULL DEFAULT '',
+    opened_by BIGINT NOT NULL,
+    opened_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    approved_by BIGINT,
+    approved_at TIMESTAMPTZ
+);
+
+-- one open count per location, so the same shelf is not reconciled twice.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_cycle_counts_open_location ON cycle_counts (location) WHERE status = 'open';
+
+CREATE TABLE IF NOT EXISTS cycle_count_lines (
+    cycle_count_id BIGINT NOT NULL REFERENCES cycle_counts (id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    sku_id BIGINT NOT NULL,
+    system_quantity BIGINT NOT NULL,
+    counted_quantity BIGINT NOT NULL CHECK (counted_quantity >= 0),
+    counted_by BIGINT NOT NULL,
+    counted_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    quantity_after BIGINT,
+
+    PRIMARY KEY (cycle_count_id, user_id, sku_id)
+);
+
+-- audit trail of count sessions, every submitted count is kept even when sku is counted again.
+CREATE TABLE IF NOT EXISTS cycle_count_events (
+    id BIGSERIAL PRIMARY KEY,
+    cycle_count_id BIGINT NOT NULL REFERENCES cycle_counts (id) ON DELETE CASCADE,
+    action TEXT NOT NULL,
+    actor_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL DEFAULT 0,
+    sku_id BIGINT NOT NULL DEFAULT 0,
+    system_quantity BIGINT NOT NULL DEFAULT 0,
+    counted_quantity BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_cycle_count_events_cycle_count_id ON cycle_count_events (cycle_count_id, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS cycle_count_events;
+DROP TABLE IF EXISTS cycle_count_lines;
+DROP TABLE IF EXISTS cycle_counts;
+-- +goose StatementEnd
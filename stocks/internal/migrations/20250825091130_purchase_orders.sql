@@ -0,0 +1,66 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS suppliers (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name TEXT NOT NULL,
+    email TEXT NOT NULL DEFAULT '',
+    phone TEXT NOT NULL DEFAULT '',
+    lead_time_days INT NOT NULL DEFAULT 0 CHECK (lead_time_days >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE (user_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS purchase_orders (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    supplier_id BIGINT NOT NULL REFERENCES suppliers (id),
+    location TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'ordered', 'partially_received', 'received')),
+    note TEXT NOT NULL DEFAULT '',
+    created_by BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ordered_at TIMESTAMPTZ,
+    expected_at TIMESTAMPTZ,
+    received_at TIMESTAMPTZ
+);
+
+-- incoming quantity of offers sums lines of orders on their way.
+CREATE INDEX IF NOT EXISTS idx_purchase_orders_incoming ON purchase_orders (user_id, location)
+    WHERE status IN ('ordered', 'partially_received');
+
+CREATE TABLE IF NOT EXISTS purchase_order_lines (
+    purchase_order_id BIGINT NOT NULL REFERENCES purchase_orders (id) ON DELETE CASCADE,
+    sku_id BIGINT NOT NULL REFERENCES sku (sku_id),
+    quantity BIGINT NOT NULL CHECK (quantity > 0),
+    price BIGINT NOT NULL,
+    currency TEXT NOT NULL,
+    received_quantity BIGINT NOT NULL DEFAULT 0 CHECK (received_quantity >= 0 AND received_quantity <= quantity),
+
+    PRIMARY KEY (purchase_order_id, sku_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_purchase_order_lines_sku_id ON purchase_order_lines (sku_id);
+
+-- every arrival of units, purchase order may be received in several deliveries.
+CREATE TABLE IF NOT EXISTS purchase_order_receipts (
+    id BIGSERIAL PRIMARY KEY,
+    purchase_order_id BIGINT NOT NULL REFERENCES purchase_orders (id) ON DELETE CASCADE,
+    sku_id BIGINT NOT NULL,
+    quantity BIGINT NOT NULL CHECK (quantity > 0),
+    lot_number TEXT NOT NULL DEFAULT '',
+    received_by BIGINT NOT NULL,
+    received_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_purchase_order_receipts_purchase_order_id ON purchase_order_receipts (purchase_order_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS purchase_order_receipts;
+DROP TABLE IF EXISTS purchase_order_lines;
+DROP TABLE IF EXISTS purchase_orders;
+DROP TABLE IF EXISTS suppliers;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- units added to carts, read from cart_item_added events of cart service. Event position is the key,
+-- so redelivered event is recorded once.
+CREATE TABLE IF NOT EXISTS cart_demand (
+    kafka_partition INT NOT NULL,
+    kafka_offset BIGINT NOT NULL,
+    sku_id BIGINT NOT NULL,
+    quantity BIGINT NOT NULL CHECK (quantity > 0),
+    added_at TIMESTAMPTZ NOT NULL,
+
+    PRIMARY KEY (kafka_partition, kafka_offset)
+);
+
+CREATE INDEX IF NOT EXISTS idx_cart_demand_sku_added_at ON cart_demand (sku_id, added_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS cart_demand;
+-- +goose StatementEnd
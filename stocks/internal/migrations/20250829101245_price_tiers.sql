@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- quantity breaks and customer group prices of stock items, empty group is every customer.
+CREATE TABLE IF NOT EXISTS price_tiers (
+    user_id BIGINT NOT NULL,
+    sku_id BIGINT NOT NULL REFERENCES sku (sku_id),
+    location TEXT NOT NULL,
+    customer_group TEXT NOT NULL DEFAULT '',
+    min_quantity BIGINT NOT NULL CHECK (min_quantity > 0),
+    price BIGINT NOT NULL CHECK (price > 0),
+    currency TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (user_id, sku_id, location, customer_group, min_quantity)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS price_tiers;
+-- +goose StatementEnd
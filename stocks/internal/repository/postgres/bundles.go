@@ -0,0 +1,301 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"stocks/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// foreignKeyViolationCode is postgres SQLSTATE of foreign key constraint violation.
+const foreignKeyViolationCode = "23503"
+
+// SaveBundle replaces bill of materials of bundle sku. Bundles can not be nested and bundle sku
+// can not have stock items of its own, since its stock is derived from components.
+func (s *skuRepository) SaveBundle(ctx context.Context, bundle domain.Bundle) error {
+	componentSkuIDs := make([]int64, 0, len(bundle.Components))
+	quantities := make([]int64, 0, len(bundle.Components))
+
+	for _, component := range bundle.Components {
+		componentSkuIDs = append(componentSkuIDs, int64(component.SkuID))
+		quantities = append(quantities, int64(component.Quantity))
+	}
+
+	err := s.psqlDB.WithTx(ctx, defaultTxOptions, func(ctx context.Context) error {
+		// lock bundle sku, so concurrent definitions of the same bundle are serialized.
+		var skuID int64
+
+		err := s.psqlDB.Get(ctx, &skuID, `
+			SELECT sku_id FROM sku WHERE sku_id = $1 FOR UPDATE`,
+			bundle.Sku.ID,
+		)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrSKUNotFound
+			}
+
+			return err
+		}
+
+		var nested bool
+
+		err = s.psqlDB.Get(ctx, &nested, `
+			SELECT EXISTS (
+				SELECT 1 FROM bundle_components
+				WHERE component_sku_id = $1 OR bundle_sku_id = ANY($2)
+			)`,
+			bundle.Sku.ID, componentSkuIDs,
+		)
+		if err != nil {
+			return err
+		}
+
+		if nested {
+			return fmt.Errorf("%w: bundles can not be nested", domain.ErrInvalidBundle)
+		}
+
+		var hasStock bool
+
+		err = s.psqlDB.Get(ctx, &hasStock, `
+			SELECT EXISTS (
+				SELECT 1 FROM stock_items WHERE sku_id = $1 AND deleted_at IS NULL
+			)`,
+			bundle.Sku.ID,
+		)
+		if err != nil {
+			return err
+		}
+
+		if hasStock {
+			return fmt.Errorf("%w: bundle sku has stock items of its own", domain.ErrInvalidBundle)
+		}
+
+		_, err = s.psqlDB.Exec(ctx, `
+			DELETE FROM bundle_components WHERE bundle_sku_id = $1`,
+			bundle.Sku.ID,
+		)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+
+		_, err = s.psqlDB.Exec(ctx, `
+			INSERT INTO bundle_components (bundle_sku_id, component_sku_id, quantity)
+			SELECT $1, component_sku_id, quantity
+			FROM unnest($2::BIGINT[], $3::BIGINT[]) AS c (component_sku_id, quantity)`,
+			bundle.Sku.ID, componentSkuIDs, quantities,
+		)
+
+		return err
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode {
+			return domain.ErrSKUNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// GetBundleComponents returns bill of materials of sku, it is empty when sku is not a bundle.
+func (s *skuRepository) GetBundleComponents(ctx context.Context, skuID domain.SKUID) ([]domain.BundleComponent, error) {
+	var bundleComponentsData []BundleComponentData
+
+	err := s.psqlDB.Select(ctx, &bundleComponentsData, `
+		SELECT component_sku_id, quantity
+		FROM bundle_components
+		WHERE bundle_sku_id = $1
+		ORDER BY component_sku_id`,
+		skuID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	bundleComponents := make([]domain.BundleComponent, 0, len(bundleComponentsData))
+	for _, bundleComponentData := range bundleComponentsData {
+		bundleComponents = append(bundleComponents, bundleComponentData.ToDomain())
+	}
+
+	return bundleComponents, nil
+}
+
+// ListBundleOffers returns offers of bundle sku ordered by offer rule like ListStockItemOffers does.
+// Seller offers bundle in location where it has every component priced in the same currency, availability
+// is the number of complete bundles and price is the sum of component prices rounded to minor unit.
+func (s *stockServiceRepository) ListBundleOffers(ctx context.Context, filter domain.OfferFilter) ([]domain.StockItem, error) {
+	var stockItemsData []StockItemData
+
+	ordering, ok := offerOrderings[filter.Rule]
+	if !ok {
+		return nil, fmt.Errorf("unknown offer rule %q", filter.Rule)
+	}
+
+	limit := "LIMIT 1"
+	if filter.AllOffers {
+		limit = ""
+	}
+
+	err := s.psqlDB.Select(ctx, &stockItemsData, `
+		WITH components AS (
+			SELECT component_sku_id, quantity
+			FROM bundle_components
+			WHERE bundle_sku_id = $1
+		), offers AS (
+			SELECT ci.user_id, ci.location, ci.currency,
+				MIN(GREATEST(ci.count, 0) / c.quantity)::BIGINT AS count,
+				ROUND(SUM(ci.price * c.quantity::NUMERIC / `+unitScale("cs")+`))::BIGINT AS price
+			FROM stock_items ci
+			INNER JOIN components c ON c.component_sku_id = ci.sku_id
+			INNER JOIN sku cs ON cs.sku_id = ci.sku_id
+			WHERE ($2::BIGINT = 0 OR ci.user_id = $2) AND ci.deleted_at IS NULL
+			GROUP BY ci.user_id, ci.location, ci.currency
+			HAVING COUNT(*) = (SELECT COUNT(*) FROM components)
+		)
+		SELECT si.user_id, s.sku_id, s.name, s.type,
+			COALESCE(s.variant_group_id, 0) AS variant_group_id, s.attributes, s.unit, si.count, si.price, si.currency, si.location
+		FROM offers si
+		INNER JOIN sku s ON s.sku_id = $1
+		ORDER BY `+ordering+`
+		`+limit,
+		filter.SkuID, filter.SellerID,
+	)
+	if err != nil {
+		return nil, amountOutOfRange(err)
+	}
+
+	if len(stockItemsData) == 0 {
+		return nil, domain.ErrStockItemNotFound
+	}
+
+	stockItems := make([]domain.StockItem, 0, len(stockItemsData))
+
+	for _, stockItemData := range stockItemsData {
+		stockItem := stockItemData.ToDomain()
+		stockItem.Sku.IsBundle = true
+		// bundles have no price tiers of their own, they are priced by list prices of components.
+		stockItem.ListPrice = stockItem.Price
+		stockItems = append(stockItems, stockItem)
+	}
+
+	return stockItems, nil
+}
+
+// AdjustBundleComponents applies delta of bundle to every component of seller in location in one statement:
+// either all components are adjusted and their ledger entries written or none of them.
+// Units taken from components come out of their lots first expired first out.
+func (s *stockServiceRepository) AdjustBundleComponents(
+	ctx context.Context,
+	adjustment domain.StockAdjustment,
+	components []domain.BundleComponent,
+) ([]domain.StockAdjustmentResult, error) {
+	var (
+		adjustedStockItemsData []AdjustedStockItemData
+		lotAllocations         map[uint32][]domain.LotAllocation
+	)
+
+	err := s.psqlDB.WithTx(ctx, defaultTxOptions, func(ctx context.Context) error {
+		adjustedStockItemsData, lotAllocations = nil, nil
+
+		err := s.psqlDB.Select(ctx, &adjustedStockItemsData, `
+			WITH components AS (
+				SELECT component_sku_id, quantity
+				FROM bundle_components
+				WHERE bundle_sku_id = $2
+			), adjusted AS (
+				UPDATE stock_items si
+				SET count = si.count + $1 * c.quantity, updated_at = NOW()
+				FROM components c
+				WHERE si.sku_id = c.component_sku_id AND si.user_id = $3 AND si.location = $4 AND si.deleted_at IS NULL
+					AND (`+backorderGuard("si", "$1 * c.quantity")+`)
+				RETURNING si.user_id, si.sku_id, si.count, si.price, si.currency, si.location, si.stock_level, si.version, $1 * c.quantity AS delta
+			), movement AS (
+				INSERT INTO stock_movements (user_id, sku_id, location, delta, quantity_after, reason, note, reference)
+				SELECT user_id, sku_id, location, delta, count, $5, $6, $7
+				FROM adjusted
+			)
+			SELECT user_id, sku_id, count, price, currency, location, stock_level, version FROM adjusted`,
+			adjustment.Delta, adjustment.SkuID,
+			adjustment.UserID, adjustment.Location,
+			adjustment.Reason, adjustment.Note, fmt.Sprintf("bundle:%d", adjustment.SkuID),
+		)
+		if err != nil {
+			return err
+		}
+
+		// some component is missing or would go below zero, nothing is applied then.
+		if len(adjustedStockItemsData) != len(components) {
+			return s.bundleAdjustmentRejectedReason(ctx, adjustment, components)
+		}
+
+		if adjustment.Delta >= 0 {
+			return nil
+		}
+
+		lotAllocations = make(map[uint32][]domain.LotAllocation, len(components))
+
+		for _, component := range components {
+			allocations, err := s.allocateLots(ctx, adjustment.UserID, component.SkuID, adjustment.Location,
+				-adjustment.Delta*int64(component.Quantity), adjustment.Reason, fmt.Sprintf("bundle:%d", adjustment.SkuID),
+			)
+			if err != nil {
+				return err
+			}
+
+			lotAllocations[uint32(component.SkuID)] = allocations
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, quantityOutOfRange(err)
+	}
+
+	adjustmentResults := make([]domain.StockAdjustmentResult, 0, len(adjustedStockItemsData))
+	changes := make([]domain.StockChange, 0, len(adjustedStockItemsData))
+
+	for _, adjustedStockItemData := range adjustedStockItemsData {
+		adjustmentResult := adjustedStockItemData.ToDomain()
+		adjustmentResult.Lots = lotAllocations[adjustedStockItemData.SkuID]
+		adjustmentResults = append(adjustmentResults, adjustmentResult)
+		changes = append(changes, domain.NewStockChange(adjustmentResult.StockItem))
+	}
+
+	s.changes.Publish(changes...)
+
+	return adjustmentResults, nil
+}
+
+// bundleAdjustmentRejectedReason tells apart missing component stock item from component without enough stock.
+func (s *stockServiceRepository) bundleAdjustmentRejectedReason(
+	ctx context.Context,
+	adjustment domain.StockAdjustment,
+	components []domain.BundleComponent,
+) error {
+	var existingCount int
+
+	componentSkuIDs := make([]int64, 0, len(components))
+	for _, component := range components {
+		componentSkuIDs = append(componentSkuIDs, int64(component.SkuID))
+	}
+
+	err := s.psqlDB.Get(ctx, &existingCount, `
+		SELECT COUNT(*) FROM stock_items
+		WHERE user_id = $1 AND sku_id = ANY($2) AND location = $3 AND deleted_at IS NULL`,
+		adjustment.UserID, componentSkuIDs, adjustment.Location,
+	)
+	if err != nil {
+		return err
+	}
+
+	if existingCount != len(components) {
+		return domain.ErrStockItemNotFound
+	}
+
+	return domain.ErrInsufficientStock
+}
@@ -0,0 +1,322 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"stocks/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const cycleCountColumns = `id, location, user_id, status, note, opened_by, opened_at, approved_by, approved_at`
+
+// CreateCycleCount opens count session of location and writes its first audit trail entry.
+func (s *stockServiceRepository) CreateCycleCount(ctx context.Context, cycleCount domain.CycleCount) (domain.CycleCount, error) {
+	var cycleCountData CycleCountData
+
+	err := s.psqlDB.WithTx(ctx, defaultTxOptions, func(ctx context.Context) error {
+		err := s.psqlDB.Get(ctx, &cycleCountData, `
+			INSERT INTO cycle_counts (location, user_id, note, opened_by)
+			VALUES ($1, $2, $3, $4)
+			RETURNING `+cycleCountColumns,
+			cycleCount.Location, cycleCount.UserID, cycleCount.Note, cycleCount.OpenedBy,
+		)
+		if err != nil {
+			return err
+		}
+
+		return s.recordCycleCountEvent(ctx, cycleCountData.ID, domain.CycleCountEvent{
+			Action:  domain.CycleCountActionOpened,
+			ActorID: cycleCount.OpenedBy,
+		})
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return domain.CycleCount{}, domain.ErrCycleCountAlreadyOpen
+		}
+
+		return domain.CycleCount{}, err
+	}
+
+	return cycleCountData.ToDomain(), nil
+}
+
+// GetCycleCount returns count session with its lines and audit trail.
+func (s *stockServiceRepository) GetCycleCount(ctx context.Context, cycleCountID domain.CycleCountID) (domain.CycleCount, error) {
+	var cycleCountData CycleCountData
+
+	err := s.psqlDB.Get(ctx, &cycleCountData, `
+		SELECT `+cycleCountColumns+`
+		FROM cycle_counts
+		WHERE id = $1`,
+		cycleCountID,
+	)
+	if err != nil {
+		if pgxscan.NotFound(err) {
+			return domain.CycleCount{}, domain.ErrCycleCountNotFound
+		}
+
+		return domain.CycleCount{}, err
+	}
+
+	var linesData []CycleCountLineData
+
+	err = s.psqlDB.Select(ctx, &linesData, `
+		SELECT l.user_id, l.sku_id, COALESCE(s.name, '') AS name, l.system_quantity, l.counted_quantity,
+			l.counted_by, l.counted_at, l.quantity_after
+		FROM cycle_count_lines l
+		LEFT JOIN sku s ON s.sku_id = l.sku_id
+		WHERE l.cycle_count_id = $1
+		ORDER BY l.user_id, l.sku_id`,
+		cycleCountID,
+	)
+	if err != nil {
+		return domain.CycleCount{}, err
+	}
+
+	var eventsData []CycleCountEventData
+
+	err = s.psqlDB.Select(ctx, &eventsData, `
+		SELECT action, actor_id, user_id, sku_id, system_quantity, counted_quantity, created_at
+		FROM cycle_count_events
+		WHERE cycle_count_id = $1
+		ORDER BY id`,
+		cycleCountID,
+	)
+	if err != nil {
+		return domain.CycleCount{}, err
+	}
+
+	cycleCount := cycleCountData.ToDomain()
+
+	cycleCount.Lines = make([]domain.CycleCountLine, 0, len(linesData))
+	for _, lineData := range linesData {
+		cycleCount.Lines = append(cycleCount.Lines, lineData.ToDomain())
+	}
+
+	cycleCount.Events = make([]domain.CycleCountEvent, 0, len(eventsData))
+	for _, eventData := range eventsData {
+		cycleCount.Events = append(cycleCount.Events, eventData.ToDomain())
+	}
+
+	return cycleCount, nil
+}
+
+// SaveCycleCounts records counts with quantities system has at this moment, counting stock item
+// again replaces its line while audit trail keeps every count.
+func (s *stockServiceRepository) SaveCycleCounts(ctx context.Context, submission domain.CycleCountSubmission) error {
+	return s.psqlDB.WithTx(ctx, defaultTxOptions, func(ctx context.Context) error {
+		location, err := s.lockOpenCycleCount(ctx, submission.CycleCountID)
+		if err != nil {
+			return err
+		}
+
+		for _, count := range submission.Counts {
+			_, err = s.psqlDB.Exec(ctx, `
+				WITH line AS (
+					INSERT INTO cycle_count_lines (cycle_count_id, user_id, sku_id, system_quantity, counted_quantity, counted_by)
+					SELECT $1, user_id, sku_id, count, $5, $6
+					FROM stock_items
+					WHERE user_id = $2 AND sku_id = $3 AND location = $4 AND deleted_at IS NULL
+					ON CONFLICT (cycle_count_id, user_id, sku_id) DO UPDATE SET
+						system_quantity = EXCLUDED.system_quantity,
+						counted_quantity = EXCLUDED.counted_quantity,
+						counted_by = EXCLUDED.counted_by,
+						counted_at = NOW()
+					RETURNING cycle_count_id, user_id, sku_id, system_quantity, counted_quantity, counted_by
+				)
+				INSERT INTO cycle_count_events (cycle_count_id, action, actor_id, user_id, sku_id, system_quantity, counted_quantity)
+				SELECT cycle_count_id, $7, counted_by, user_id, sku_id, system_quantity, counted_quantity
+				FROM line`,
+				submission.CycleCountID, count.UserID, count.SkuID, location,
+				count.CountedQuantity, submission.CountedBy, domain.CycleCountActionCounted,
+			)
+			if err != nil {
+				if errors.Is(err, pgx.ErrNoRows) {
+					return fmt.Errorf("%w: sku %d of merchant %d in %s", domain.ErrStockItemNotFound, count.SkuID, count.UserID, location)
+				}
+
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ApplyCycleCountVariances posts variances of counted lines as adjustments of stock items, ledger entries
+// reference the count session, and approves it in one transaction.
+func (s *stockServiceRepository) ApplyCycleCountVariances(
+	ctx context.Context,
+	cycleCountID domain.CycleCountID,
+	approvedBy domain.UserID,
+) (domain.CycleCountApproval, error) {
+	var approval domain.CycleCountApproval
+
+	err := s.psqlDB.WithTx(ctx, defaultTxOptions, func(ctx context.Context) error {
+		// transaction can be retried, result of failed attempt must not leak.
+		approval = domain.CycleCountApproval{}
+
+		location, err := s.lockOpenCycleCount(ctx, cycleCountID)
+		if err != nil {
+			return err
+		}
+
+		cycleCount, err := s.GetCycleCount(ctx, cycleCountID)
+		if err != nil {
+			return err
+		}
+
+		if len(cycleCount.Lines) == 0 {
+			return fmt.Errorf("%w: nothing counted", domain.ErrInvalidCycleCount)
+		}
+
+		reference := fmt.Sprintf("cycle_count:%d", cycleCountID)
+
+		for _, line := range cycleCount.Lines {
+			var adjustedStockItemData AdjustedStockItemData
+
+			// counted stock item is locked even without variance, so its quantity after approval is exact.
+			err := s.psqlDB.Get(ctx, &adjustedStockItemData, `
+				WITH adjusted AS (
+					UPDATE stock_items
+					SET count = count + $1, updated_at = CASE WHEN $1 = 0 THEN updated_at ELSE NOW() END
+					WHERE user_id = $2 AND sku_id = $3 AND location = $4 AND deleted_at IS NULL
+					RETURNING user_id, sku_id, count, price, currency, location, stock_level, version
+				), movement AS (
+					INSERT INTO stock_movements (user_id, sku_id, location, delta, quantity_after, reason, reference)
+					SELECT user_id, sku_id, location, $1, count, $5, $6
+					FROM adjusted
+					WHERE $1 <> 0
+				)
+				SELECT user_id, sku_id, count, price, currency, location, stock_level, version FROM adjusted`,
+				line.Variance(), line.UserID, line.Sku.ID, location,
+				domain.AdjustmentReasonCycleCount, reference,
+			)
+			if err != nil {
+				if pgxscan.NotFound(err) {
+					return fmt.Errorf("%w: sku %d of merchant %d in %s", domain.ErrStockItemNotFound, line.Sku.ID, line.UserID, location)
+				}
+
+				return err
+			}
+
+			_, err = s.psqlDB.Exec(ctx, `
+				UPDATE cycle_count_lines
+				SET quantity_after = $4
+				WHERE cycle_count_id = $1 AND user_id = $2 AND sku_id = $3`,
+				cycleCountID, line.UserID, line.Sku.ID, adjustedStockItemData.Quantity,
+			)
+			if err != nil {
+				return err
+			}
+
+			if line.Variance() == 0 {
+				continue
+			}
+
+			adjustmentResult := adjustedStockItemData.ToDomain()
+
+			if line.Variance() < 0 {
+				adjustmentResult.Lots, err = s.allocateLots(ctx, line.UserID, line.Sku.ID, location,
+					-line.Variance(), domain.AdjustmentReasonCycleCount, reference,
+				)
+				if err != nil {
+					return err
+				}
+			}
+
+			err = s.recordCycleCountEvent(ctx, int64(cycleCountID), domain.CycleCountEvent{
+				Action:          domain.CycleCountActionAdjusted,
+				ActorID:         approvedBy,
+				UserID:          line.UserID,
+				SkuID:           line.Sku.ID,
+				SystemQuantity:  line.SystemQuantity,
+				CountedQuantity: line.CountedQuantity,
+			})
+			if err != nil {
+				return err
+			}
+
+			approval.Adjustments = append(approval.Adjustments, adjustmentResult)
+		}
+
+		_, err = s.psqlDB.Exec(ctx, `
+			UPDATE cycle_counts
+			SET status = $2, approved_by = $3, approved_at = NOW()
+			WHERE id = $1`,
+			cycleCountID, domain.CycleCountStatusApproved, approvedBy,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = s.recordCycleCountEvent(ctx, int64(cycleCountID), domain.CycleCountEvent{
+			Action:  domain.CycleCountActionApproved,
+			ActorID: approvedBy,
+		})
+		if err != nil {
+			return err
+		}
+
+		approval.CycleCount, err = s.GetCycleCount(ctx, cycleCountID)
+
+		return err
+	})
+	if err != nil {
+		return domain.CycleCountApproval{}, quantityOutOfRange(err)
+	}
+
+	changes := make([]domain.StockChange, 0, len(approval.Adjustments))
+	for _, adjustment := range approval.Adjustments {
+		changes = append(changes, domain.NewStockChange(adjustment.StockItem))
+	}
+
+	s.changes.Publish(changes...)
+
+	return approval, nil
+}
+
+// lockOpenCycleCount locks count session till the end of transaction and returns its location.
+func (s *stockServiceRepository) lockOpenCycleCount(ctx context.Context, cycleCountID domain.CycleCountID) (string, error) {
+	var (
+		location string
+		status   string
+	)
+
+	err := s.psqlDB.QueryRow(ctx, `
+		SELECT location, status
+		FROM cycle_counts
+		WHERE id = $1
+		FOR UPDATE`,
+		cycleCountID,
+	).Scan(&location, &status)
+	if err != nil {
+		if pgxscan.NotFound(err) {
+			return "", domain.ErrCycleCountNotFound
+		}
+
+		return "", err
+	}
+
+	if domain.CycleCountStatus(status) != domain.CycleCountStatusOpen {
+		return "", domain.ErrCycleCountNotOpen
+	}
+
+	return location, nil
+}
+
+func (s *stockServiceRepository) recordCycleCountEvent(ctx context.Context, cycleCountID int64, event domain.CycleCountEvent) error {
+	_, err := s.psqlDB.Exec(ctx, `
+		INSERT INTO cycle_count_events (cycle_count_id, action, actor_id, user_id, sku_id, system_quantity, counted_quantity)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		cycleCountID, event.Action, event.ActorID, event.UserID, event.SkuID,
+		event.SystemQuantity, event.CountedQuantity,
+	)
+
+	return err
+}
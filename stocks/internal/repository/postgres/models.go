@@ -6,41 +6,606 @@ import (
 )
 
 type SKU struct {
-	SkuID uint32 `db:"sku_id"`
-	Name  string `db:"name"`
-	Type  string `db:"type"`
+	SkuID          uint32         `db:"sku_id"`
+	Name           string         `db:"name"`
+	Type           string         `db:"type"`
+	Unit           string         `db:"unit"`
+	IsBundle       bool           `db:"is_bundle"`
+	VariantGroupID int64          `db:"variant_group_id"`
+	Attributes     map[string]any `db:"attributes"`
 }
 
 func (s *SKU) ToDomain() domain.SKU {
 	return domain.SKU{
-		ID:   domain.SKUID(s.SkuID),
-		Name: s.Name,
-		Type: s.Type,
+		ID:             domain.SKUID(s.SkuID),
+		Name:           s.Name,
+		Type:           s.Type,
+		Unit:           domain.UnitOfMeasure(s.Unit),
+		IsBundle:       s.IsBundle,
+		VariantGroupID: domain.VariantGroupID(s.VariantGroupID),
+		Attributes:     s.Attributes,
 	}
 }
 
 type StockItemData struct {
-	UserID    int64     `db:"user_id"`
-	SkuID     uint32    `db:"sku_id"`
-	Count     uint16    `db:"count"`
-	Name      string    `db:"name"`
-	Type      string    `db:"type"`
-	Price     uint32    `db:"price"`
-	Location  string    `db:"location"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	UserID         int64          `db:"user_id"`
+	SkuID          uint32         `db:"sku_id"`
+	Count          int64          `db:"count"`
+	Name           string         `db:"name"`
+	Type           string         `db:"type"`
+	Unit           string         `db:"unit"`
+	VariantGroupID int64          `db:"variant_group_id"`
+	Attributes     map[string]any `db:"attributes"`
+	Price          int64          `db:"price"`
+	Currency       string         `db:"currency"`
+	Location       string         `db:"location"`
+	Level          string         `db:"stock_level"`
+	Version        int64          `db:"version"`
+	CreatedAt      time.Time      `db:"created_at"`
+	UpdatedAt      time.Time      `db:"updated_at"`
+	// backorder policy is selected only by queries of offers, items of other queries have none.
+	BackorderMode        string     `db:"backorder_mode"`
+	MaxBackorderQuantity *int64     `db:"max_backorder_quantity"`
+	RestockAt            *time.Time `db:"restock_at"`
+	// incoming units of purchase orders are selected only by queries of offers too.
+	IncomingQuantity   int64      `db:"incoming_quantity"`
+	IncomingExpectedAt *time.Time `db:"incoming_expected_at"`
+	// list price and price tier are selected only by queries of offers priced by tiers.
+	ListPrice         *int64  `db:"list_price"`
+	TierCustomerGroup *string `db:"tier_customer_group"`
+	TierMinQuantity   *int64  `db:"tier_min_quantity"`
 }
 
 func (s *StockItemData) ToDomain() domain.StockItem {
-	return domain.StockItem{
+	stockItem := domain.StockItem{
 		UserID: domain.UserID(s.UserID),
 		Sku: domain.SKU{
-			ID:   domain.SKUID(s.SkuID),
-			Name: s.Name,
-			Type: s.Type,
+			ID:             domain.SKUID(s.SkuID),
+			Name:           s.Name,
+			Type:           s.Type,
+			Unit:           domain.UnitOfMeasure(s.Unit),
+			VariantGroupID: domain.VariantGroupID(s.VariantGroupID),
+			Attributes:     s.Attributes,
 		},
 		Count:    s.Count,
-		Price:    s.Price,
+		Price:    domain.Money{Currency: domain.Currency(s.Currency), Amount: s.Price},
 		Location: s.Location,
+		Level:    domain.StockLevel(s.Level),
+		Version:  s.Version,
+		Backorder: domain.BackorderPolicy{
+			Mode: domain.BackorderMode(s.BackorderMode),
+		},
+	}
+
+	if stockItem.Backorder.Mode == "" {
+		stockItem.Backorder.Mode = domain.BackorderModeNone
+	}
+
+	if s.MaxBackorderQuantity != nil {
+		stockItem.Backorder.MaxQuantity = *s.MaxBackorderQuantity
+	}
+
+	if s.RestockAt != nil {
+		stockItem.Backorder.RestockAt = *s.RestockAt
+	}
+
+	stockItem.IncomingQuantity = s.IncomingQuantity
+	if s.IncomingExpectedAt != nil {
+		stockItem.IncomingExpectedAt = *s.IncomingExpectedAt
+	}
+
+	if s.ListPrice != nil {
+		stockItem.ListPrice = domain.Money{Currency: stockItem.Price.Currency, Amount: *s.ListPrice}
+	}
+
+	if s.TierMinQuantity != nil {
+		stockItem.Tier = &domain.PriceTier{
+			MinQuantity: *s.TierMinQuantity,
+			Price:       stockItem.Price,
+		}
+
+		if s.TierCustomerGroup != nil {
+			stockItem.Tier.CustomerGroup = *s.TierCustomerGroup
+		}
+	}
+
+	return stockItem
+}
+
+// UpdatedStockItemData represent stock item after partial update with count it had before.
+type UpdatedStockItemData struct {
+	StockItemData
+	PreviousCount int64 `db:"previous_count"`
+}
+
+type BundleComponentData struct {
+	SkuID    uint32 `db:"component_sku_id"`
+	Quantity uint32 `db:"quantity"`
+}
+
+func (b *BundleComponentData) ToDomain() domain.BundleComponent {
+	return domain.BundleComponent{
+		SkuID:    domain.SKUID(b.SkuID),
+		Quantity: b.Quantity,
+	}
+}
+
+type SKUSearchResultData struct {
+	SkuID          uint32         `db:"sku_id"`
+	Name           string         `db:"name"`
+	Type           string         `db:"type"`
+	Unit           string         `db:"unit"`
+	VariantGroupID int64          `db:"variant_group_id"`
+	Attributes     map[string]any `db:"attributes"`
+	Rank           float64        `db:"rank"`
+	Highlight      string         `db:"highlight"`
+	AvailableCount int64          `db:"available_count"`
+}
+
+func (s *SKUSearchResultData) ToDomain() domain.SKUSearchResult {
+	return domain.SKUSearchResult{
+		Sku: domain.SKU{
+			ID:             domain.SKUID(s.SkuID),
+			Name:           s.Name,
+			Type:           s.Type,
+			Unit:           domain.UnitOfMeasure(s.Unit),
+			VariantGroupID: domain.VariantGroupID(s.VariantGroupID),
+			Attributes:     s.Attributes,
+		},
+		Rank:           s.Rank,
+		Highlight:      s.Highlight,
+		AvailableCount: s.AvailableCount,
+	}
+}
+
+type AttributeSchemaData struct {
+	Type       string                       `db:"type"`
+	Attributes []domain.AttributeDefinition `db:"attributes"`
+}
+
+func (a *AttributeSchemaData) ToDomain() domain.AttributeSchema {
+	return domain.AttributeSchema{
+		SkuType:    a.Type,
+		Attributes: a.Attributes,
+	}
+}
+
+type VariantGroupData struct {
+	ID   int64    `db:"id"`
+	Name string   `db:"name"`
+	Type string   `db:"type"`
+	Axes []string `db:"axes"`
+}
+
+func (v *VariantGroupData) ToDomain() domain.VariantGroup {
+	return domain.VariantGroup{
+		ID:      domain.VariantGroupID(v.ID),
+		Name:    v.Name,
+		SkuType: v.Type,
+		Axes:    v.Axes,
+	}
+}
+
+type TypeFacetData struct {
+	Type  string `db:"type"`
+	Count uint32 `db:"count"`
+}
+
+func (t *TypeFacetData) ToDomain() domain.TypeFacet {
+	return domain.TypeFacet{
+		Type:  t.Type,
+		Count: t.Count,
+	}
+}
+
+type StockThresholdData struct {
+	SkuID            uint32 `db:"sku_id"`
+	Location         string `db:"location"`
+	ReorderThreshold uint32 `db:"reorder_threshold"`
+	Hysteresis       uint32 `db:"hysteresis"`
+}
+
+func (s *StockThresholdData) ToDomain() domain.StockThreshold {
+	return domain.StockThreshold{
+		SkuID:            domain.SKUID(s.SkuID),
+		Location:         s.Location,
+		ReorderThreshold: s.ReorderThreshold,
+		Hysteresis:       s.Hysteresis,
+	}
+}
+
+type LowStockItemData struct {
+	StockItemData
+	ReorderThreshold uint32 `db:"reorder_threshold"`
+}
+
+func (l *LowStockItemData) ToDomain() domain.LowStockItem {
+	return domain.LowStockItem{
+		StockItem:        l.StockItemData.ToDomain(),
+		ReorderThreshold: l.ReorderThreshold,
+	}
+}
+
+type AdjustedStockItemData struct {
+	UserID   int64  `db:"user_id"`
+	SkuID    uint32 `db:"sku_id"`
+	Quantity int64  `db:"count"`
+	Price    int64  `db:"price"`
+	Currency string `db:"currency"`
+	Location string `db:"location"`
+	Level    string `db:"stock_level"`
+	Version  int64  `db:"version"`
+}
+
+func (a *AdjustedStockItemData) ToDomain() domain.StockAdjustmentResult {
+	return domain.StockAdjustmentResult{
+		StockItem: domain.StockItem{
+			UserID: domain.UserID(a.UserID),
+			Sku: domain.SKU{
+				ID: domain.SKUID(a.SkuID),
+			},
+			Count:    domain.ClampCount(a.Quantity),
+			Price:    domain.Money{Currency: domain.Currency(a.Currency), Amount: a.Price},
+			Location: a.Location,
+			Level:    domain.StockLevel(a.Level),
+			Version:  a.Version,
+		},
+		Quantity: a.Quantity,
+	}
+}
+
+type UpsertedStockItemData struct {
+	AdjustedStockItemData
+	Created bool `db:"created"`
+}
+
+type StockTransferData struct {
+	ID           int64      `db:"id"`
+	UserID       int64      `db:"user_id"`
+	SkuID        uint32     `db:"sku_id"`
+	FromLocation string     `db:"from_location"`
+	ToLocation   string     `db:"to_location"`
+	Quantity     int64      `db:"quantity"`
+	Status       string     `db:"status"`
+	ShippedAt    time.Time  `db:"shipped_at"`
+	ReceivedAt   *time.Time `db:"received_at"`
+}
+
+func (s *StockTransferData) ToDomain() domain.StockTransfer {
+	stockTransfer := domain.StockTransfer{
+		ID:           domain.TransferID(s.ID),
+		UserID:       domain.UserID(s.UserID),
+		SkuID:        domain.SKUID(s.SkuID),
+		FromLocation: s.FromLocation,
+		ToLocation:   s.ToLocation,
+		Quantity:     s.Quantity,
+		Status:       domain.TransferStatus(s.Status),
+		ShippedAt:    s.ShippedAt,
+	}
+
+	if s.ReceivedAt != nil {
+		stockTransfer.ReceivedAt = *s.ReceivedAt
+	}
+
+	return stockTransfer
+}
+
+type ScheduledPriceChangeData struct {
+	ID          int64      `db:"id"`
+	UserID      int64      `db:"user_id"`
+	SkuID       uint32     `db:"sku_id"`
+	Location    string     `db:"location"`
+	NewPrice    int64      `db:"new_price"`
+	Currency    string     `db:"currency"`
+	EffectiveAt time.Time  `db:"effective_at"`
+	Status      string     `db:"status"`
+	AppliedAt   *time.Time `db:"applied_at"`
+}
+
+func (s *ScheduledPriceChangeData) ToDomain() domain.ScheduledPriceChange {
+	priceChange := domain.ScheduledPriceChange{
+		ID:          domain.PriceChangeID(s.ID),
+		UserID:      domain.UserID(s.UserID),
+		SkuID:       domain.SKUID(s.SkuID),
+		Location:    s.Location,
+		NewPrice:    domain.Money{Currency: domain.Currency(s.Currency), Amount: s.NewPrice},
+		EffectiveAt: s.EffectiveAt,
+		Status:      domain.PriceChangeStatus(s.Status),
+	}
+
+	if s.AppliedAt != nil {
+		priceChange.AppliedAt = *s.AppliedAt
+	}
+
+	return priceChange
+}
+
+type PriceHistoryEntryData struct {
+	UserID      int64     `db:"user_id"`
+	SkuID       uint32    `db:"sku_id"`
+	Location    string    `db:"location"`
+	OldPrice    *int64    `db:"old_price"`
+	OldCurrency *string   `db:"old_currency"`
+	NewPrice    int64     `db:"new_price"`
+	Currency    string    `db:"currency"`
+	ChangedAt   time.Time `db:"changed_at"`
+}
+
+func (p *PriceHistoryEntryData) ToDomain() domain.PriceHistoryEntry {
+	priceHistoryEntry := domain.PriceHistoryEntry{
+		UserID:    domain.UserID(p.UserID),
+		SkuID:     domain.SKUID(p.SkuID),
+		Location:  p.Location,
+		NewPrice:  domain.Money{Currency: domain.Currency(p.Currency), Amount: p.NewPrice},
+		ChangedAt: p.ChangedAt,
+	}
+
+	if p.OldPrice != nil && p.OldCurrency != nil {
+		priceHistoryEntry.OldPrice = &domain.Money{Currency: domain.Currency(*p.OldCurrency), Amount: *p.OldPrice}
+	}
+
+	return priceHistoryEntry
+}
+
+type InventoryValuationRowData struct {
+	UserID   int64  `db:"user_id"`
+	Location string `db:"location"`
+	Type     string `db:"type"`
+	SkuCount int64  `db:"sku_count"`
+	Quantity int64  `db:"quantity"`
+	Currency string `db:"currency"`
+	Value    int64  `db:"value"`
+}
+
+func (i *InventoryValuationRowData) ToDomain() domain.InventoryValuationRow {
+	return domain.InventoryValuationRow{
+		UserID:   domain.UserID(i.UserID),
+		Location: i.Location,
+		Type:     i.Type,
+		SkuCount: i.SkuCount,
+		Quantity: i.Quantity,
+		Value:    domain.Money{Currency: domain.Currency(i.Currency), Amount: i.Value},
+	}
+}
+
+type StockLotData struct {
+	ID         int64      `db:"id"`
+	UserID     int64      `db:"user_id"`
+	SkuID      uint32     `db:"sku_id"`
+	Name       string     `db:"name"`
+	Location   string     `db:"location"`
+	LotNumber  string     `db:"lot_number"`
+	ReceivedAt time.Time  `db:"received_at"`
+	ExpiresAt  *time.Time `db:"expires_at"`
+	Quantity   int64      `db:"quantity"`
+}
+
+func (s *StockLotData) ToDomain() domain.StockLot {
+	stockLot := domain.StockLot{
+		ID:     domain.LotID(s.ID),
+		UserID: domain.UserID(s.UserID),
+		Sku: domain.SKU{
+			ID:   domain.SKUID(s.SkuID),
+			Name: s.Name,
+		},
+		Location:   s.Location,
+		LotNumber:  s.LotNumber,
+		ReceivedAt: s.ReceivedAt,
+		Quantity:   s.Quantity,
+	}
+
+	if s.ExpiresAt != nil {
+		stockLot.ExpiresAt = *s.ExpiresAt
+	}
+
+	return stockLot
+}
+
+type CycleCountData struct {
+	ID         int64      `db:"id"`
+	Location   string     `db:"location"`
+	UserID     int64      `db:"user_id"`
+	Status     string     `db:"status"`
+	Note       string     `db:"note"`
+	OpenedBy   int64      `db:"opened_by"`
+	OpenedAt   time.Time  `db:"opened_at"`
+	ApprovedBy *int64     `db:"approved_by"`
+	ApprovedAt *time.Time `db:"approved_at"`
+}
+
+func (c *CycleCountData) ToDomain() domain.CycleCount {
+	cycleCount := domain.CycleCount{
+		ID:       domain.CycleCountID(c.ID),
+		Location: c.Location,
+		UserID:   domain.UserID(c.UserID),
+		Status:   domain.CycleCountStatus(c.Status),
+		Note:     c.Note,
+		OpenedBy: domain.UserID(c.OpenedBy),
+		OpenedAt: c.OpenedAt,
+	}
+
+	if c.ApprovedBy != nil {
+		cycleCount.ApprovedBy = domain.UserID(*c.ApprovedBy)
+	}
+
+	if c.ApprovedAt != nil {
+		cycleCount.ApprovedAt = *c.ApprovedAt
+	}
+
+	return cycleCount
+}
+
+type CycleCountLineData struct {
+	UserID          int64     `db:"user_id"`
+	SkuID           uint32    `db:"sku_id"`
+	Name            string    `db:"name"`
+	SystemQuantity  int64     `db:"system_quantity"`
+	CountedQuantity int64     `db:"counted_quantity"`
+	CountedBy       int64     `db:"counted_by"`
+	CountedAt       time.Time `db:"counted_at"`
+	QuantityAfter   *int64    `db:"quantity_after"`
+}
+
+func (c *CycleCountLineData) ToDomain() domain.CycleCountLine {
+	cycleCountLine := domain.CycleCountLine{
+		UserID:          domain.UserID(c.UserID),
+		Sku:             domain.SKU{ID: domain.SKUID(c.SkuID), Name: c.Name},
+		SystemQuantity:  c.SystemQuantity,
+		CountedQuantity: c.CountedQuantity,
+		CountedBy:       domain.UserID(c.CountedBy),
+		CountedAt:       c.CountedAt,
+	}
+
+	if c.QuantityAfter != nil {
+		cycleCountLine.QuantityAfter = *c.QuantityAfter
+	}
+
+	return cycleCountLine
+}
+
+type CycleCountEventData struct {
+	Action          string    `db:"action"`
+	ActorID         int64     `db:"actor_id"`
+	UserID          int64     `db:"user_id"`
+	SkuID           uint32    `db:"sku_id"`
+	SystemQuantity  int64     `db:"system_quantity"`
+	CountedQuantity int64     `db:"counted_quantity"`
+	CreatedAt       time.Time `db:"created_at"`
+}
+
+func (c *CycleCountEventData) ToDomain() domain.CycleCountEvent {
+	return domain.CycleCountEvent{
+		Action:          domain.CycleCountAction(c.Action),
+		ActorID:         domain.UserID(c.ActorID),
+		UserID:          domain.UserID(c.UserID),
+		SkuID:           domain.SKUID(c.SkuID),
+		SystemQuantity:  c.SystemQuantity,
+		CountedQuantity: c.CountedQuantity,
+		CreatedAt:       c.CreatedAt,
+	}
+}
+
+type SupplierData struct {
+	ID           int64     `db:"id"`
+	UserID       int64     `db:"user_id"`
+	Name         string    `db:"name"`
+	Email        string    `db:"email"`
+	Phone        string    `db:"phone"`
+	LeadTimeDays int32     `db:"lead_time_days"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+func (s *SupplierData) ToDomain() domain.Supplier {
+	return domain.Supplier{
+		ID:           domain.SupplierID(s.ID),
+		UserID:       domain.UserID(s.UserID),
+		Name:         s.Name,
+		Email:        s.Email,
+		Phone:        s.Phone,
+		LeadTimeDays: s.LeadTimeDays,
+		CreatedAt:    s.CreatedAt,
+	}
+}
+
+type PurchaseOrderData struct {
+	ID         int64      `db:"id"`
+	UserID     int64      `db:"user_id"`
+	SupplierID int64      `db:"supplier_id"`
+	Location   string     `db:"location"`
+	Status     string     `db:"status"`
+	Note       string     `db:"note"`
+	CreatedBy  int64      `db:"created_by"`
+	CreatedAt  time.Time  `db:"created_at"`
+	OrderedAt  *time.Time `db:"ordered_at"`
+	ExpectedAt *time.Time `db:"expected_at"`
+	ReceivedAt *time.Time `db:"received_at"`
+}
+
+func (p *PurchaseOrderData) ToDomain() domain.PurchaseOrder {
+	purchaseOrder := domain.PurchaseOrder{
+		ID:         domain.PurchaseOrderID(p.ID),
+		UserID:     domain.UserID(p.UserID),
+		SupplierID: domain.SupplierID(p.SupplierID),
+		Location:   p.Location,
+		Status:     domain.PurchaseOrderStatus(p.Status),
+		Note:       p.Note,
+		CreatedBy:  domain.UserID(p.CreatedBy),
+		CreatedAt:  p.CreatedAt,
+	}
+
+	if p.OrderedAt != nil {
+		purchaseOrder.OrderedAt = *p.OrderedAt
+	}
+
+	if p.ExpectedAt != nil {
+		purchaseOrder.ExpectedAt = *p.ExpectedAt
+	}
+
+	if p.ReceivedAt != nil {
+		purchaseOrder.ReceivedAt = *p.ReceivedAt
+	}
+
+	return purchaseOrder
+}
+
+type PurchaseOrderLineData struct {
+	SkuID            uint32 `db:"sku_id"`
+	Name             string `db:"name"`
+	Type             string `db:"type"`
+	Unit             string `db:"unit"`
+	Quantity         int64  `db:"quantity"`
+	Price            int64  `db:"price"`
+	Currency         string `db:"currency"`
+	ReceivedQuantity int64  `db:"received_quantity"`
+}
+
+func (p *PurchaseOrderLineData) ToDomain() domain.PurchaseOrderLine {
+	return domain.PurchaseOrderLine{
+		Sku: domain.SKU{
+			ID:   domain.SKUID(p.SkuID),
+			Name: p.Name,
+			Type: p.Type,
+			Unit: domain.UnitOfMeasure(p.Unit),
+		},
+		Quantity:         p.Quantity,
+		Price:            domain.Money{Currency: domain.Currency(p.Currency), Amount: p.Price},
+		ReceivedQuantity: p.ReceivedQuantity,
+	}
+}
+
+type DemandHistoryData struct {
+	StockItemData
+	SoldQuantity      int64  `db:"sold_quantity"`
+	SkuSoldQuantity   int64  `db:"sku_sold_quantity"`
+	SkuOffers         int64  `db:"sku_offers"`
+	CartAddedQuantity int64  `db:"cart_added_quantity"`
+	ReorderThreshold  uint32 `db:"reorder_threshold"`
+	LeadTimeDays      int32  `db:"lead_time_days"`
+}
+
+func (d *DemandHistoryData) ToDomain() domain.DemandHistory {
+	return domain.DemandHistory{
+		StockItem:         d.StockItemData.ToDomain(),
+		SoldQuantity:      d.SoldQuantity,
+		SkuSoldQuantity:   d.SkuSoldQuantity,
+		SkuOffers:         d.SkuOffers,
+		CartAddedQuantity: d.CartAddedQuantity,
+		ReorderThreshold:  d.ReorderThreshold,
+		LeadTimeDays:      d.LeadTimeDays,
+	}
+}
+
+type PriceTierData struct {
+	CustomerGroup string `db:"customer_group"`
+	MinQuantity   int64  `db:"min_quantity"`
+	Price         int64  `db:"price"`
+	Currency      string `db:"currency"`
+}
+
+func (p *PriceTierData) ToDomain() domain.PriceTier {
+	return domain.PriceTier{
+		CustomerGroup: p.CustomerGroup,
+		MinQuantity:   p.MinQuantity,
+		Price:         domain.Money{Currency: domain.Currency(p.Currency), Amount: p.Price},
 	}
 }
@@ -0,0 +1,189 @@
+package postgres
+
+import (
+	"context"
+	"stocks/internal/changebus"
+	"stocks/internal/domain"
+	"stocks/internal/usecase/stocks"
+	"stocks/pkg/connection"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+const scheduledPriceChangeColumns = `id, user_id, sku_id, location, new_price, currency, effective_at, status, applied_at`
+
+var _ stocks.PriceRepository = (*priceRepository)(nil)
+
+type priceRepository struct {
+	psqlDB  connection.DB
+	changes changebus.Publisher
+}
+
+func NewPriceRepository(psqlDB connection.DB, changes changebus.Publisher) *priceRepository {
+	return &priceRepository{psqlDB: psqlDB, changes: changes}
+}
+
+func (p *priceRepository) SaveScheduledPriceChange(ctx context.Context, priceChange domain.ScheduledPriceChange) (domain.ScheduledPriceChange, error) {
+	var priceChangeData ScheduledPriceChangeData
+
+	err := p.psqlDB.Get(ctx, &priceChangeData, `
+		INSERT INTO scheduled_price_changes (user_id, sku_id, location, new_price, currency, effective_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING `+scheduledPriceChangeColumns,
+		priceChange.UserID, priceChange.SkuID, priceChange.Location,
+		priceChange.NewPrice.Amount, priceChange.NewPrice.Currency, priceChange.EffectiveAt,
+	)
+	if err != nil {
+		return domain.ScheduledPriceChange{}, err
+	}
+
+	return priceChangeData.ToDomain(), nil
+}
+
+// ApplyDuePriceChanges writes prices of due changes to stock items and returns applied ones.
+// Rows are locked with SKIP LOCKED, so several stocks replicas can run scheduler at the same time.
+func (p *priceRepository) ApplyDuePriceChanges(ctx context.Context, limit int) ([]domain.ScheduledPriceChange, error) {
+	var (
+		appliedPriceChanges []domain.ScheduledPriceChange
+		stockChanges        []domain.StockChange
+	)
+
+	err := p.psqlDB.WithTx(ctx, defaultTxOptions, func(ctx context.Context) error {
+		// transaction can be retried, result of failed attempt must not leak.
+		appliedPriceChanges, stockChanges = nil, nil
+
+		var duePriceChangesData []ScheduledPriceChangeData
+
+		err := p.psqlDB.Select(ctx, &duePriceChangesData, `
+			SELECT `+scheduledPriceChangeColumns+`
+			FROM scheduled_price_changes
+			WHERE status = $1 AND effective_at <= NOW()
+			ORDER BY effective_at, id
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED`,
+			domain.PriceChangeStatusPending, limit,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, duePriceChangeData := range duePriceChangesData {
+			priceChange := duePriceChangeData.ToDomain()
+
+			var (
+				oldPrice    int64
+				oldCurrency string
+				count       int64
+				version     int64
+			)
+
+			err := p.psqlDB.QueryRow(ctx, `
+				WITH old AS (
+					SELECT id, price, currency FROM stock_items
+					WHERE user_id = $3 AND sku_id = $4 AND location = $5 AND deleted_at IS NULL
+					FOR UPDATE
+				)
+				UPDATE stock_items si
+				SET price = $1, currency = $2, updated_at = NOW()
+				FROM old
+				WHERE si.id = old.id
+				RETURNING old.price, old.currency, si.count, si.version`,
+				priceChange.NewPrice.Amount, priceChange.NewPrice.Currency,
+				priceChange.UserID, priceChange.SkuID, priceChange.Location,
+			).Scan(&oldPrice, &oldCurrency, &count, &version)
+
+			status := domain.PriceChangeStatusApplied
+
+			switch {
+			case pgxscan.NotFound(err):
+				// stock item was deleted after change had been scheduled.
+				status = domain.PriceChangeStatusFailed
+			case err != nil:
+				return err
+			}
+
+			err = p.psqlDB.QueryRow(ctx, `
+				UPDATE scheduled_price_changes
+				SET status = $1, applied_at = NOW()
+				WHERE id = $2
+				RETURNING applied_at`,
+				status, priceChange.ID,
+			).Scan(&priceChange.AppliedAt)
+			if err != nil {
+				return err
+			}
+
+			if status == domain.PriceChangeStatusApplied {
+				priceChange.Status = status
+				priceChange.OldPrice = domain.Money{Currency: domain.Currency(oldCurrency), Amount: oldPrice}
+				appliedPriceChanges = append(appliedPriceChanges, priceChange)
+				stockChanges = append(stockChanges, domain.StockChange{
+					UserID:    priceChange.UserID,
+					SkuID:     priceChange.SkuID,
+					Location:  priceChange.Location,
+					Count:     domain.ClampCount(count),
+					Price:     priceChange.NewPrice,
+					ChangedAt: priceChange.AppliedAt,
+					Version:   version,
+				})
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	p.changes.Publish(stockChanges...)
+
+	return appliedPriceChanges, nil
+}
+
+func (p *priceRepository) ListPriceHistory(ctx context.Context, filter domain.PriceHistoryFilter) ([]domain.PriceHistoryEntry, error) {
+	var priceHistoryData []PriceHistoryEntryData
+
+	offset := (filter.CurrentPage - 1) * filter.PageSize
+
+	err := p.psqlDB.Select(ctx, &priceHistoryData, `
+		SELECT user_id, sku_id, location, old_price, old_currency, new_price, currency, changed_at
+		FROM price_history
+		WHERE sku_id = $1 AND ($2 = 0 OR user_id = $2) AND ($3 = '' OR location = $3)
+		ORDER BY changed_at DESC, id DESC
+		OFFSET $4 LIMIT $5`,
+		filter.SkuID, filter.UserID, filter.Location,
+		offset, filter.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	priceHistory := make([]domain.PriceHistoryEntry, 0, len(priceHistoryData))
+	for _, priceHistoryEntry := range priceHistoryData {
+		priceHistory = append(priceHistory, priceHistoryEntry.ToDomain())
+	}
+
+	return priceHistory, nil
+}
+
+func (p *priceRepository) ListPendingPriceChanges(ctx context.Context, filter domain.PriceHistoryFilter) ([]domain.ScheduledPriceChange, error) {
+	var priceChangesData []ScheduledPriceChangeData
+
+	err := p.psqlDB.Select(ctx, &priceChangesData, `
+		SELECT `+scheduledPriceChangeColumns+`
+		FROM scheduled_price_changes
+		WHERE status = $1 AND sku_id = $2 AND ($3 = 0 OR user_id = $3) AND ($4 = '' OR location = $4)
+		ORDER BY effective_at, id`,
+		domain.PriceChangeStatusPending, filter.SkuID, filter.UserID, filter.Location,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	priceChanges := make([]domain.ScheduledPriceChange, 0, len(priceChangesData))
+	for _, priceChange := range priceChangesData {
+		priceChanges = append(priceChanges, priceChange.ToDomain())
+	}
+
+	return priceChanges, nil
+}
@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"stocks/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// tierJoin joins the lowest tier price below list price of stock item si that order of quantity $4
+// by customer of group $3 gets, tiers in other currency than offer are ignored.
+const tierJoin = `LEFT JOIN LATERAL (
+			SELECT pt.customer_group, pt.min_quantity, pt.price
+			FROM price_tiers pt
+			WHERE pt.user_id = si.user_id AND pt.sku_id = si.sku_id AND pt.location = si.location
+				AND pt.currency = si.currency AND pt.customer_group IN ('', $3) AND pt.min_quantity <= GREATEST($4::BIGINT, 1)
+				AND pt.price < si.price
+			ORDER BY pt.price ASC, pt.min_quantity ASC
+			LIMIT 1
+		) tier ON TRUE`
+
+// ReplacePriceTiers replaces price tiers of customer group of stock item with tiers of price list,
+// they must be in currency stock item is offered in.
+func (p *priceRepository) ReplacePriceTiers(ctx context.Context, priceList domain.PriceList) error {
+	return p.psqlDB.WithTx(ctx, defaultTxOptions, func(ctx context.Context) error {
+		var currency string
+
+		err := p.psqlDB.QueryRow(ctx, `
+			SELECT currency
+			FROM stock_items
+			WHERE user_id = $1 AND sku_id = $2 AND location = $3 AND deleted_at IS NULL
+			FOR UPDATE`,
+			priceList.UserID, priceList.SkuID, priceList.Location,
+		).Scan(&currency)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrStockItemNotFound
+			}
+
+			return err
+		}
+
+		// tier without currency is in currency of offer.
+		for i := range priceList.Tiers {
+			if priceList.Tiers[i].Price.Currency == "" {
+				priceList.Tiers[i].Price.Currency = domain.Currency(currency)
+			}
+		}
+
+		// currency is checked under lock, offer could be repriced in other currency meanwhile.
+		if err := priceList.Validate(domain.Currency(currency)); err != nil {
+			return err
+		}
+
+		_, err = p.psqlDB.Exec(ctx, `
+			DELETE FROM price_tiers
+			WHERE user_id = $1 AND sku_id = $2 AND location = $3 AND customer_group = $4`,
+			priceList.UserID, priceList.SkuID, priceList.Location, priceList.CustomerGroup,
+		)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+
+		for _, tier := range priceList.Tiers {
+			_, err = p.psqlDB.Exec(ctx, `
+				INSERT INTO price_tiers (user_id, sku_id, location, customer_group, min_quantity, price, currency)
+				VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+				priceList.UserID, priceList.SkuID, priceList.Location, priceList.CustomerGroup,
+				tier.MinQuantity, tier.Price.Amount, tier.Price.Currency,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ListPriceTiersOfStockItem returns price tiers of every customer group of stock item,
+// tiers for every customer go first.
+func (p *priceRepository) ListPriceTiersOfStockItem(
+	ctx context.Context,
+	userID domain.UserID,
+	skuID domain.SKUID,
+	location string,
+) ([]domain.PriceTier, error) {
+	var priceTiersData []PriceTierData
+
+	err := p.psqlDB.Select(ctx, &priceTiersData, `
+		SELECT customer_group, min_quantity, price, currency
+		FROM price_tiers
+		WHERE user_id = $1 AND sku_id = $2 AND location = $3
+		ORDER BY customer_group, min_quantity`,
+		userID, skuID, location,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	priceTiers := make([]domain.PriceTier, 0, len(priceTiersData))
+	for _, priceTierData := range priceTiersData {
+		priceTiers = append(priceTiers, priceTierData.ToDomain())
+	}
+
+	return priceTiers, nil
+}
@@ -0,0 +1,281 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"stocks/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const purchaseOrderColumns = `id, user_id, supplier_id, location, status, note, created_by, created_at, ordered_at, expected_at, received_at`
+
+// incomingJoin joins outstanding units of purchase orders on their way to stock item si as incoming.
+const incomingJoin = `LEFT JOIN LATERAL (
+			SELECT SUM(l.quantity - l.received_quantity)::BIGINT AS quantity, MIN(po.expected_at) AS expected_at
+			FROM purchase_orders po
+			INNER JOIN purchase_order_lines l ON l.purchase_order_id = po.id
+			WHERE po.user_id = si.user_id AND po.location = si.location AND l.sku_id = si.sku_id
+				AND po.status IN ('ordered', 'partially_received') AND l.received_quantity < l.quantity
+		) incoming ON TRUE`
+
+func (s *stockServiceRepository) SaveSupplier(ctx context.Context, supplier domain.Supplier) (domain.Supplier, error) {
+	var supplierData SupplierData
+
+	err := s.psqlDB.Get(ctx, &supplierData, `
+		INSERT INTO suppliers (user_id, name, email, phone, lead_time_days)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, user_id, name, email, phone, lead_time_days, created_at`,
+		supplier.UserID, supplier.Name, supplier.Email, supplier.Phone, supplier.LeadTimeDays,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return domain.Supplier{}, domain.ErrSupplierAlreadyExists
+		}
+
+		return domain.Supplier{}, err
+	}
+
+	return supplierData.ToDomain(), nil
+}
+
+func (s *stockServiceRepository) ListSuppliersOfMerchant(ctx context.Context, userID domain.UserID) ([]domain.Supplier, error) {
+	var suppliersData []SupplierData
+
+	err := s.psqlDB.Select(ctx, &suppliersData, `
+		SELECT id, user_id, name, email, phone, lead_time_days, created_at
+		FROM suppliers
+		WHERE user_id = $1
+		ORDER BY name`,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	suppliers := make([]domain.Supplier, 0, len(suppliersData))
+	for _, supplierData := range suppliersData {
+		suppliers = append(suppliers, supplierData.ToDomain())
+	}
+
+	return suppliers, nil
+}
+
+// SavePurchaseOrder creates draft purchase order with its lines, supplier must belong to merchant of the order.
+func (s *stockServiceRepository) SavePurchaseOrder(ctx context.Context, purchaseOrder domain.PurchaseOrder) (domain.PurchaseOrder, error) {
+	var purchaseOrderID int64
+
+	err := s.psqlDB.WithTx(ctx, defaultTxOptions, func(ctx context.Context) error {
+		var expectedAt any
+		if !purchaseOrder.ExpectedAt.IsZero() {
+			expectedAt = purchaseOrder.ExpectedAt
+		}
+
+		err := s.psqlDB.QueryRow(ctx, `
+			INSERT INTO purchase_orders (user_id, supplier_id, location, note, created_by, expected_at)
+			SELECT user_id, id, $3, $4, $5, $6
+			FROM suppliers
+			WHERE id = $2 AND user_id = $1
+			RETURNING id`,
+			purchaseOrder.UserID, purchaseOrder.SupplierID, purchaseOrder.Location,
+			purchaseOrder.Note, purchaseOrder.CreatedBy, expectedAt,
+		).Scan(&purchaseOrderID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrSupplierNotFound
+			}
+
+			return err
+		}
+
+		for _, line := range purchaseOrder.Lines {
+			_, err = s.psqlDB.Exec(ctx, `
+				INSERT INTO purchase_order_lines (purchase_order_id, sku_id, quantity, price, currency)
+				VALUES ($1, $2, $3, $4, $5)`,
+				purchaseOrderID, line.Sku.ID, line.Quantity, line.Price.Amount, line.Price.Currency,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode {
+			return domain.PurchaseOrder{}, domain.ErrSKUNotFound
+		}
+
+		return domain.PurchaseOrder{}, err
+	}
+
+	return s.GetPurchaseOrderByID(ctx, domain.PurchaseOrderID(purchaseOrderID))
+}
+
+// GetPurchaseOrderByID returns purchase order with its lines.
+func (s *stockServiceRepository) GetPurchaseOrderByID(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) (domain.PurchaseOrder, error) {
+	var purchaseOrderData PurchaseOrderData
+
+	err := s.psqlDB.Get(ctx, &purchaseOrderData, `
+		SELECT `+purchaseOrderColumns+`
+		FROM purchase_orders
+		WHERE id = $1`,
+		purchaseOrderID,
+	)
+	if err != nil {
+		if pgxscan.NotFound(err) {
+			return domain.PurchaseOrder{}, domain.ErrPurchaseOrderNotFound
+		}
+
+		return domain.PurchaseOrder{}, err
+	}
+
+	var linesData []PurchaseOrderLineData
+
+	err = s.psqlDB.Select(ctx, &linesData, `
+		SELECT l.sku_id, s.name, s.type, s.unit, l.quantity, l.price, l.currency, l.received_quantity
+		FROM purchase_order_lines l
+		INNER JOIN sku s ON s.sku_id = l.sku_id
+		WHERE l.purchase_order_id = $1
+		ORDER BY l.sku_id`,
+		purchaseOrderID,
+	)
+	if err != nil {
+		return domain.PurchaseOrder{}, err
+	}
+
+	purchaseOrder := purchaseOrderData.ToDomain()
+
+	purchaseOrder.Lines = make([]domain.PurchaseOrderLine, 0, len(linesData))
+	for _, lineData := range linesData {
+		purchaseOrder.Lines = append(purchaseOrder.Lines, lineData.ToDomain())
+	}
+
+	return purchaseOrder, nil
+}
+
+// MarkPurchaseOrderOrdered moves draft purchase order to ordered, order without expected date
+// is expected after lead time of its supplier.
+func (s *stockServiceRepository) MarkPurchaseOrderOrdered(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) (domain.PurchaseOrder, error) {
+	_, err := s.psqlDB.Exec(ctx, `
+		UPDATE purchase_orders po
+		SET status = $2, ordered_at = NOW(),
+			expected_at = COALESCE(po.expected_at, NOW() + make_interval(days => su.lead_time_days))
+		FROM suppliers su
+		WHERE po.id = $1 AND su.id = po.supplier_id AND po.status = $3`,
+		purchaseOrderID, domain.PurchaseOrderStatusOrdered, domain.PurchaseOrderStatusDraft,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.PurchaseOrder{}, domain.ErrPurchaseOrderStatus
+		}
+
+		return domain.PurchaseOrder{}, err
+	}
+
+	return s.GetPurchaseOrderByID(ctx, purchaseOrderID)
+}
+
+// ReceivePurchaseOrderLines adds received units to stock the same way added stock is, records receipt
+// against lines of purchase order and moves it to partially received or received in one transaction.
+// Changes of received stock items are published after it commits.
+func (s *stockServiceRepository) ReceivePurchaseOrderLines(
+	ctx context.Context,
+	receipt domain.PurchaseOrderReceipt,
+) (domain.PurchaseOrderReceiptResult, error) {
+	var result domain.PurchaseOrderReceiptResult
+
+	err := s.psqlDB.WithTx(ctx, defaultTxOptions, func(ctx context.Context) error {
+		// transaction can be retried, result of failed attempt must not leak.
+		result = domain.PurchaseOrderReceiptResult{}
+
+		_, err := s.psqlDB.Exec(ctx, `SELECT id FROM purchase_orders WHERE id = $1 FOR UPDATE`, receipt.PurchaseOrderID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrPurchaseOrderNotFound
+			}
+
+			return err
+		}
+
+		purchaseOrder, err := s.GetPurchaseOrderByID(ctx, receipt.PurchaseOrderID)
+		if err != nil {
+			return err
+		}
+
+		// receipt is checked again under lock, concurrent receipt could take outstanding quantity.
+		err = purchaseOrder.ValidateReceipt(receipt)
+		if err != nil {
+			return err
+		}
+
+		for i, stockItem := range purchaseOrder.ReceiptStockItems(receipt) {
+			var lotNumber string
+			if stockItem.Lot != nil {
+				lotNumber = stockItem.Lot.LotNumber
+			}
+
+			_, err = s.psqlDB.Exec(ctx, `
+				WITH line AS (
+					UPDATE purchase_order_lines
+					SET received_quantity = received_quantity + $3
+					WHERE purchase_order_id = $1 AND sku_id = $2
+				)
+				INSERT INTO purchase_order_receipts (purchase_order_id, sku_id, quantity, lot_number, received_by)
+				VALUES ($1, $2, $3, $4, $5)`,
+				receipt.PurchaseOrderID, receipt.Lines[i].SkuID, stockItem.Count, lotNumber, receipt.ReceivedBy,
+			)
+			if err != nil {
+				return err
+			}
+
+			receivedStockItem, created, err := s.upsertStockItem(ctx, stockItem)
+			if err != nil {
+				return err
+			}
+
+			result.StockItems = append(result.StockItems, domain.ReceivedStockItem{
+				StockItem: receivedStockItem,
+				Created:   created,
+			})
+		}
+
+		purchaseOrder, err = s.GetPurchaseOrderByID(ctx, receipt.PurchaseOrderID)
+		if err != nil {
+			return err
+		}
+
+		status := purchaseOrder.ReceivedStatus()
+
+		_, err = s.psqlDB.Exec(ctx, `
+			UPDATE purchase_orders
+			SET status = $2, received_at = CASE WHEN $2 = $3 THEN NOW() END
+			WHERE id = $1`,
+			receipt.PurchaseOrderID, status, domain.PurchaseOrderStatusReceived,
+		)
+		if err != nil {
+			return err
+		}
+
+		result.PurchaseOrder, err = s.GetPurchaseOrderByID(ctx, receipt.PurchaseOrderID)
+
+		return err
+	})
+	if err != nil {
+		return domain.PurchaseOrderReceiptResult{}, err
+	}
+
+	// received stock is published once receipt commits, failed or retried attempts publish nothing.
+	changes := make([]domain.StockChange, 0, len(result.StockItems))
+	for _, received := range result.StockItems {
+		changes = append(changes, domain.NewStockChange(received.StockItem))
+	}
+
+	s.changes.Publish(changes...)
+
+	return result, nil
+}
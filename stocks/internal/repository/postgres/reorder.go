@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"stocks/internal/domain"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// SaveCartDemand records units added to a cart, event which was already recorded is skipped.
+func (s *stockServiceRepository) SaveCartDemand(ctx context.Context, demand domain.CartDemand) error {
+	_, err := s.psqlDB.Exec(ctx, `
+		INSERT INTO cart_demand (kafka_partition, kafka_offset, sku_id, quantity, added_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (kafka_partition, kafka_offset) DO NOTHING`,
+		demand.Partition, demand.Offset, demand.SkuID, demand.Quantity, demand.AddedAt,
+	)
+	// redelivered event affects no rows.
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+
+	return nil
+}
+
+// ListDemandHistory returns stock items of merchant with their sales, cart demand, threshold and
+// supplier lead time since given time. Sales are sold units of the ledger net of returns, units added
+// to carts of bundle count for its components.
+func (s *stockServiceRepository) ListDemandHistory(
+	ctx context.Context,
+	filter domain.ReorderFilter,
+	since time.Time,
+) ([]domain.DemandHistory, error) {
+	var demandHistoryData []DemandHistoryData
+
+	err := s.psqlDB.Select(ctx, &demandHistoryData, `
+		WITH skus AS (
+			SELECT DISTINCT sku_id
+			FROM stock_items
+			WHERE user_id = $1 AND ($2 = '' OR location = $2) AND ($3::BIGINT = 0 OR sku_id = $3) AND deleted_at IS NULL
+		), sales AS (
+			SELECT m.user_id, m.sku_id, m.location, -SUM(m.delta)::BIGINT AS quantity
+			FROM stock_movements m
+			INNER JOIN skus ON skus.sku_id = m.sku_id
+			WHERE m.reason IN ($5, $6) AND m.created_at >= $4
+			GROUP BY m.user_id, m.sku_id, m.location
+		), sku_sales AS (
+			SELECT sku_id, SUM(quantity)::BIGINT AS quantity
+			FROM sales
+			GROUP BY sku_id
+		), offers AS (
+			SELECT si.sku_id, COUNT(*) AS quantity
+			FROM stock_items si
+			INNER JOIN skus ON skus.sku_id = si.sku_id
+			WHERE si.deleted_at IS NULL
+			GROUP BY si.sku_id
+		), carts AS (
+			SELECT COALESCE(bc.component_sku_id, cd.sku_id) AS sku_id, SUM(cd.quantity * COALESCE(bc.quantity, 1))::BIGINT AS quantity
+			FROM cart_demand cd
+			LEFT JOIN bundle_components bc ON bc.bundle_sku_id = cd.sku_id
+			WHERE cd.added_at >= $4
+			GROUP BY 1
+		)
+		SELECT si.user_id, s.sku_id, s.name, s.type,
+			COALESCE(s.variant_group_id, 0) AS variant_group_id, s.attributes, s.unit, si.count, si.price, si.currency, si.location, si.stock_level,
+			si.created_at, si.updated_at,
+			COALESCE(incoming.quantity, 0) AS incoming_quantity, incoming.expected_at AS incoming_expected_at,
+			COALESCE(sa.quantity, 0) AS sold_quantity, COALESCE(ss.quantity, 0) AS sku_sold_quantity,
+			COALESCE(o.quantity, 0) AS sku_offers, COALESCE(c.quantity, 0) AS cart_added_quantity,
+			COALESCE(t.reorder_threshold, 0) AS reorder_threshold, COALESCE(lt.lead_time_days, 0) AS lead_time_days
+		FROM stock_items si
+		INNER JOIN sku s ON s.sku_id = si.sku_id
+		`+incomingJoin+`
+		LEFT JOIN sales sa ON sa.user_id = si.user_id AND sa.sku_id = si.sku_id AND sa.location = si.location
+		LEFT JOIN sku_sales ss ON ss.sku_id = si.sku_id
+		LEFT JOIN offers o ON o.sku_id = si.sku_id
+		LEFT JOIN carts c ON c.sku_id = si.sku_id
+		LEFT JOIN LATERAL (
+			SELECT st.reorder_threshold
+			FROM stock_thresholds st
+			WHERE st.sku_id = si.sku_id AND st.location IN (si.location, '')
+			ORDER BY st.location = '' ASC
+			LIMIT 1
+		) t ON TRUE
+		LEFT JOIN LATERAL (
+			SELECT su.lead_time_days
+			FROM purchase_orders po
+			INNER JOIN purchase_order_lines l ON l.purchase_order_id = po.id
+			INNER JOIN suppliers su ON su.id = po.supplier_id
+			WHERE po.user_id = si.user_id AND l.sku_id = si.sku_id AND po.status <> 'draft'
+			ORDER BY po.location = si.location DESC, po.ordered_at DESC
+			LIMIT 1
+		) lt ON TRUE
+		WHERE si.user_id = $1 AND ($2 = '' OR si.location = $2) AND ($3::BIGINT = 0 OR si.sku_id = $3) AND si.deleted_at IS NULL
+		ORDER BY si.location, s.sku_id`,
+		filter.UserID, filter.Location, filter.SkuID, since,
+		domain.AdjustmentReasonSold, domain.AdjustmentReasonReturned,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	demandHistory := make([]domain.DemandHistory, 0, len(demandHistoryData))
+	for _, data := range demandHistoryData {
+		demandHistory = append(demandHistory, data.ToDomain())
+	}
+
+	return demandHistory, nil
+}
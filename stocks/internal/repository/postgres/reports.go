@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"slices"
+	"stocks/internal/domain"
+	"stocks/internal/usecase/stocks"
+	"stocks/pkg/connection"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+var _ stocks.ReportRepository = (*reportRepository)(nil)
+
+type reportRepository struct {
+	psqlDB connection.DB
+}
+
+func NewReportRepository(psqlDB connection.DB) *reportRepository {
+	return &reportRepository{psqlDB: psqlDB}
+}
+
+// currentPositionsQuery selects quantity and price of every live stock item.
+const currentPositionsQuery = `
+	SELECT si.user_id, si.sku_id, si.location, GREATEST(si.count, 0)::BIGINT AS quantity,
+		si.price::BIGINT AS price, si.currency
+	FROM stock_items si
+	WHERE si.deleted_at IS NULL`
+
+// asOfPositionsQuery rebuilds quantity of stock items from the latest ledger entry at $7
+// and their price from the latest price change at $7. Stock items without recorded price then
+// are valued at price they have now, deleted ones without it at zero in currency $8.
+const asOfPositionsQuery = `
+	SELECT m.user_id, m.sku_id, m.location, GREATEST(m.quantity_after, 0) AS quantity,
+		COALESCE(ph.new_price, si.price, 0)::BIGINT AS price, COALESCE(ph.currency, si.currency, $8) AS currency
+	FROM (
+		SELECT DISTINCT ON (user_id, sku_id, location) user_id, sku_id, location, quantity_after
+		FROM stock_movements
+		WHERE created_at <= $7
+		ORDER BY user_id, sku_id, location, created_at DESC, id DESC
+	) m
+	LEFT JOIN LATERAL (
+		SELECT new_price, currency
+		FROM price_history
+		WHERE user_id = m.user_id AND sku_id = m.sku_id AND location = m.location AND changed_at <= $7
+		ORDER BY changed_at DESC, id DESC
+		LIMIT 1
+	) ph ON TRUE
+	LEFT JOIN stock_items si ON si.user_id = m.user_id AND si.sku_id = m.sku_id AND si.location = m.location
+		AND si.deleted_at IS NULL`
+
+// StreamInventoryValuation aggregates stock value by dimensions of filter and currency and passes rows
+// to fn one by one, so big reports are not loaded into memory at once. Value of weighed goods is rounded
+// to minor unit of currency once per row.
+func (r *reportRepository) StreamInventoryValuation(
+	ctx context.Context,
+	filter domain.InventoryValuationFilter,
+	fn func(row domain.InventoryValuationRow) error,
+) error {
+	args := []interface{}{
+		slices.Contains(filter.GroupBy, domain.ValuationDimensionLocation),
+		slices.Contains(filter.GroupBy, domain.ValuationDimensionType),
+		slices.Contains(filter.GroupBy, domain.ValuationDimensionOwner),
+		filter.UserID, filter.Location, filter.Type,
+	}
+
+	positionsQuery := currentPositionsQuery
+	if !filter.AsOf.IsZero() {
+		positionsQuery = asOfPositionsQuery
+		args = append(args, filter.AsOf, domain.DefaultCurrency)
+	}
+
+	rows, err := r.psqlDB.Query(ctx, `
+		WITH positions AS (`+positionsQuery+`
+		)
+		SELECT
+			CASE WHEN $1::BOOLEAN THEN p.location ELSE '' END AS location,
+			CASE WHEN $2::BOOLEAN THEN s.type ELSE '' END AS type,
+			CASE WHEN $3::BOOLEAN THEN p.user_id ELSE 0 END AS user_id,
+			p.currency,
+			COUNT(DISTINCT p.sku_id) AS sku_count,
+			COALESCE(SUM(p.quantity), 0)::BIGINT AS quantity,
+			COALESCE(ROUND(SUM(p.quantity * p.price::NUMERIC / `+unitScale("s")+`)), 0)::BIGINT AS value
+		FROM positions p
+		JOIN sku s ON s.sku_id = p.sku_id
+		WHERE ($4::BIGINT = 0 OR p.user_id = $4) AND ($5::TEXT = '' OR p.location = $5) AND ($6::TEXT = '' OR s.type = $6)
+		GROUP BY 1, 2, 3, 4
+		ORDER BY 1, 2, 3, 4`,
+		args...,
+	)
+	if err != nil {
+		return amountOutOfRange(err)
+	}
+	defer rows.Close()
+
+	rowScanner := pgxscan.NewRowScanner(rows)
+
+	for rows.Next() {
+		var valuationRowData InventoryValuationRowData
+		if err := rowScanner.Scan(&valuationRowData); err != nil {
+			return err
+		}
+
+		if err := fn(valuationRowData.ToDomain()); err != nil {
+			return err
+		}
+	}
+
+	return amountOutOfRange(rows.Err())
+}
@@ -24,7 +24,10 @@ func (s *skuRepository) GetSKUByID(ctx context.Context, skuID domain.SKUID) (dom
 	var sku SKU
 
 	err := s.psqlDB.Get(ctx, &sku, `
-		SELECT sku_id, name, type FROM sku WHERE sku_id = $1`,
+		SELECT sku_id, name, type, unit, COALESCE(variant_group_id, 0) AS variant_group_id, attributes,
+			EXISTS (SELECT 1 FROM bundle_components bc WHERE bc.bundle_sku_id = sku.sku_id) AS is_bundle
+		FROM sku
+		WHERE sku_id = $1`,
 		skuID,
 	)
 	if err != nil {
@@ -37,3 +40,101 @@ func (s *skuRepository) GetSKUByID(ctx context.Context, skuID domain.SKUID) (dom
 
 	return sku.ToDomain(), nil
 }
+
+// unitScale returns number of minor units in one unit of measure of sku aliased alias,
+// it must match domain.UnitOfMeasure.Scale.
+func unitScale(alias string) string {
+	return `CASE ` + alias + `.unit WHEN 'kg' THEN 1000 ELSE 1 END`
+}
+
+// skuSearchCondition matches skus either by full-text query or by trigram similarity,
+// so misspelled queries still find their products.
+const skuSearchCondition = `(
+	s.search_vector @@ websearch_to_tsquery('simple', $1)
+	OR s.name % $1
+	OR $1 <% s.name
+)`
+
+func (s *skuRepository) SearchSKUsByQuery(ctx context.Context, filter domain.SKUSearchFilter) ([]domain.SKUSearchResult, error) {
+	var searchResultsData []SKUSearchResultData
+
+	offset := (filter.CurrentPage - 1) * filter.PageSize
+
+	attributes, err := attributesFilterJSON(filter.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.psqlDB.Select(ctx, &searchResultsData, `
+		SELECT
+			s.sku_id,
+			s.name,
+			COALESCE(s.type, '') AS type,
+			s.unit,
+			COALESCE(s.variant_group_id, 0) AS variant_group_id,
+			s.attributes,
+			ts_rank(s.search_vector, websearch_to_tsquery('simple', $1)) + similarity(s.name, $1) AS rank,
+			ts_headline('simple', s.name, websearch_to_tsquery('simple', $1),
+				'StartSel=<mark>, StopSel=</mark>, HighlightAll=true') AS highlight,
+			COALESCE(a.available_count, 0) AS available_count
+		FROM sku s
+		LEFT JOIN (
+			SELECT sku_id, SUM(count)::BIGINT AS available_count
+			FROM stock_items
+			WHERE deleted_at IS NULL
+			GROUP BY sku_id
+		) a ON a.sku_id = s.sku_id
+		WHERE `+skuSearchCondition+`
+			AND (COALESCE(cardinality($2::TEXT[]), 0) = 0 OR s.type = ANY($2::TEXT[]))
+			AND `+attributesFilterCondition("$5")+`
+		ORDER BY rank DESC, s.sku_id
+		OFFSET $3 LIMIT $4`,
+		filter.Query,
+		filter.Types,
+		offset,
+		filter.PageSize,
+		attributes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	searchResults := make([]domain.SKUSearchResult, 0, len(searchResultsData))
+	for _, searchResult := range searchResultsData {
+		searchResults = append(searchResults, searchResult.ToDomain())
+	}
+
+	return searchResults, nil
+}
+
+// CountSKUsByType counts skus matched by query and attributes of filter per type, types of filter
+// are not applied, so facets show other types while searching in some of them.
+func (s *skuRepository) CountSKUsByType(ctx context.Context, filter domain.SKUSearchFilter) ([]domain.TypeFacet, error) {
+	var typeFacetsData []TypeFacetData
+
+	attributes, err := attributesFilterJSON(filter.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.psqlDB.Select(ctx, &typeFacetsData, `
+		SELECT COALESCE(s.type, '') AS type, COUNT(s.sku_id) AS count
+		FROM sku s
+		WHERE `+skuSearchCondition+`
+			AND `+attributesFilterCondition("$2")+`
+		GROUP BY COALESCE(s.type, '')
+		ORDER BY count DESC, type`,
+		filter.Query,
+		attributes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	typeFacets := make([]domain.TypeFacet, 0, len(typeFacetsData))
+	for _, typeFacet := range typeFacetsData {
+		typeFacets = append(typeFacets, typeFacet.ToDomain())
+	}
+
+	return typeFacets, nil
+}
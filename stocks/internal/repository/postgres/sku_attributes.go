@@ -0,0 +1,231 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"stocks/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// attributesFilterCondition matches skus aliased s whose attributes have every value of filter object param,
+// values are compared as text, so "42" matches number 42 and "true" matches boolean true.
+func attributesFilterCondition(param string) string {
+	return `NOT EXISTS (
+		SELECT 1 FROM jsonb_each_text(` + param + `::JSONB) f
+		WHERE s.attributes ->> f.key IS DISTINCT FROM f.value
+	)`
+}
+
+// attributesFilterJSON encodes attribute filter as json object, empty filter matches every sku.
+func attributesFilterJSON(attributes map[string]string) (string, error) {
+	if len(attributes) == 0 {
+		return "{}", nil
+	}
+
+	encoded, err := json.Marshal(attributes)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode attributes filter: %w", err)
+	}
+
+	return string(encoded), nil
+}
+
+// SaveAttributeSchema creates or replaces attribute schema of sku type.
+func (s *skuRepository) SaveAttributeSchema(ctx context.Context, schema domain.AttributeSchema) error {
+	attributes, err := json.Marshal(schema.Attributes)
+	if err != nil {
+		return fmt.Errorf("failed to encode attribute schema: %w", err)
+	}
+
+	_, err = s.psqlDB.Exec(ctx, `
+		INSERT INTO sku_attribute_schemas (type, attributes)
+		VALUES ($1, $2)
+		ON CONFLICT (type) DO UPDATE SET attributes = EXCLUDED.attributes, updated_at = NOW()`,
+		schema.SkuType, attributes,
+	)
+
+	return err
+}
+
+func (s *skuRepository) GetAttributeSchema(ctx context.Context, skuType string) (domain.AttributeSchema, error) {
+	var attributeSchemaData AttributeSchemaData
+
+	err := s.psqlDB.Get(ctx, &attributeSchemaData, `
+		SELECT type, attributes
+		FROM sku_attribute_schemas
+		WHERE type = $1`,
+		skuType,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.AttributeSchema{}, domain.ErrAttributeSchemaNotFound
+		}
+
+		return domain.AttributeSchema{}, err
+	}
+
+	return attributeSchemaData.ToDomain(), nil
+}
+
+func (s *skuRepository) CreateVariantGroup(ctx context.Context, group domain.VariantGroup) (domain.VariantGroup, error) {
+	var variantGroupData VariantGroupData
+
+	err := s.psqlDB.Get(ctx, &variantGroupData, `
+		INSERT INTO sku_variant_groups (name, type, axes)
+		VALUES ($1, $2, $3)
+		RETURNING id, name, type, axes`,
+		group.Name, group.SkuType, group.Axes,
+	)
+	if err != nil {
+		return domain.VariantGroup{}, err
+	}
+
+	return variantGroupData.ToDomain(), nil
+}
+
+// GetVariantGroup returns variant group with its variants ordered by sku id.
+func (s *skuRepository) GetVariantGroup(ctx context.Context, groupID domain.VariantGroupID) (domain.VariantGroup, error) {
+	var variantGroupData VariantGroupData
+
+	err := s.psqlDB.Get(ctx, &variantGroupData, `
+		SELECT id, name, type, axes
+		FROM sku_variant_groups
+		WHERE id = $1`,
+		groupID,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.VariantGroup{}, domain.ErrVariantGroupNotFound
+		}
+
+		return domain.VariantGroup{}, err
+	}
+
+	var variantsData []SKU
+
+	err = s.psqlDB.Select(ctx, &variantsData, `
+		SELECT sku_id, name, type, unit, variant_group_id, attributes
+		FROM sku
+		WHERE variant_group_id = $1
+		ORDER BY sku_id`,
+		groupID,
+	)
+	if err != nil {
+		return domain.VariantGroup{}, err
+	}
+
+	variantGroup := variantGroupData.ToDomain()
+	for _, variantData := range variantsData {
+		variantGroup.Variants = append(variantGroup.Variants, variantData.ToDomain())
+	}
+
+	return variantGroup, nil
+}
+
+// UpdateSKUAttributes sets attributes, variant group and unit of sku. Variant group is locked while
+// axes values are checked, so two skus of group can not get the same combination concurrently.
+// Unit can not be changed while sku has stock items, since their counts are kept in minor units of it.
+func (s *skuRepository) UpdateSKUAttributes(
+	ctx context.Context,
+	update domain.SKUAttributesUpdate,
+	axesValues domain.SKUAttributes,
+) error {
+	if update.Attributes == nil {
+		update.Attributes = domain.SKUAttributes{}
+	}
+
+	attributes, err := json.Marshal(update.Attributes)
+	if err != nil {
+		return fmt.Errorf("failed to encode sku attributes: %w", err)
+	}
+
+	err = s.psqlDB.WithTx(ctx, defaultTxOptions, func(ctx context.Context) error {
+		if update.VariantGroupID != 0 {
+			var groupID int64
+
+			err := s.psqlDB.Get(ctx, &groupID, `
+				SELECT id FROM sku_variant_groups WHERE id = $1 FOR UPDATE`,
+				update.VariantGroupID,
+			)
+			if err != nil {
+				if errors.Is(err, pgx.ErrNoRows) {
+					return domain.ErrVariantGroupNotFound
+				}
+
+				return err
+			}
+
+			axes, err := json.Marshal(axesValues)
+			if err != nil {
+				return fmt.Errorf("failed to encode variant axes: %w", err)
+			}
+
+			var duplicate bool
+
+			err = s.psqlDB.Get(ctx, &duplicate, `
+				SELECT EXISTS (
+					SELECT 1 FROM sku
+					WHERE variant_group_id = $1 AND sku_id <> $2 AND attributes @> $3::JSONB
+				)`,
+				update.VariantGroupID, update.SkuID, axes,
+			)
+			if err != nil {
+				return err
+			}
+
+			if duplicate {
+				return domain.ErrDuplicateVariant
+			}
+		}
+
+		if update.Unit != "" {
+			var unitChangeWithStock bool
+
+			err := s.psqlDB.Get(ctx, &unitChangeWithStock, `
+				SELECT EXISTS (
+					SELECT 1 FROM sku s
+					INNER JOIN stock_items si ON si.sku_id = s.sku_id AND si.deleted_at IS NULL
+					WHERE s.sku_id = $1 AND s.unit <> $2
+				)`,
+				update.SkuID, update.Unit,
+			)
+			if err != nil {
+				return err
+			}
+
+			if unitChangeWithStock {
+				return domain.ErrUnitChangeWithStock
+			}
+		}
+
+		_, err := s.psqlDB.Exec(ctx, `
+			UPDATE sku
+			SET attributes = $2, variant_group_id = NULLIF($3::BIGINT, 0), unit = COALESCE(NULLIF($4, ''), unit)
+			WHERE sku_id = $1`,
+			update.SkuID, attributes, update.VariantGroupID, update.Unit,
+		)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrSKUNotFound
+			}
+
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode {
+			return domain.ErrVariantGroupNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"stocks/internal/domain"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// backorderGuard is condition letting count of stock item aliased alias change by delta: stock can always
+// be received, but it is taken only down to zero or down to backorder limit of stock item.
+func backorderGuard(alias, delta string) string {
+	return delta + ` >= 0 OR ` + alias + `.count + ` + delta + ` >= CASE ` + alias + `.backorder_mode
+		WHEN 'none' THEN 0
+		ELSE -COALESCE(` + alias + `.max_backorder_quantity, 9223372036854775807)
+	END`
+}
+
+// AdjustStockCount applies signed delta and writes ledger entry in one statement,
+// so concurrent adjustments can not lose updates or push stock below zero.
+// Units taken from stock come out of lots first expired first out.
+func (s *stockServiceRepository) AdjustStockCount(ctx context.Context, adjustment domain.StockAdjustment) (domain.StockAdjustmentResult, error) {
+	var (
+		adjustedStockItemData AdjustedStockItemData
+		lotAllocations        []domain.LotAllocation
+	)
+
+	err := s.psqlDB.WithTx(ctx, defaultTxOptions, func(ctx context.Context) error {
+		err := s.psqlDB.Get(ctx, &adjustedStockItemData, `
+			WITH adjusted AS (
+				UPDATE stock_items
+				SET count = count + $1, updated_at = NOW()
+				WHERE user_id = $2 AND sku_id = $3 AND location = $4 AND deleted_at IS NULL
+					AND (`+backorderGuard("stock_items", "$1")+`)
+				RETURNING user_id, sku_id, count, price, currency, location, stock_level, version
+			), movement AS (
+				INSERT INTO stock_movements (user_id, sku_id, location, delta, quantity_after, reason, note)
+				SELECT user_id, sku_id, location, $1, count, $5, $6
+				FROM adjusted
+			)
+			SELECT user_id, sku_id, count, price, currency, location, stock_level, version FROM adjusted`,
+			adjustment.Delta,
+			adjustment.UserID, adjustment.SkuID, adjustment.Location,
+			adjustment.Reason, adjustment.Note,
+		)
+		if err != nil || adjustment.Delta >= 0 {
+			return err
+		}
+
+		lotAllocations, err = s.allocateLots(ctx, adjustment.UserID, adjustment.SkuID, adjustment.Location,
+			-adjustment.Delta, adjustment.Reason, "",
+		)
+
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.StockAdjustmentResult{}, s.adjustmentRejectedReason(ctx, adjustment)
+		}
+
+		return domain.StockAdjustmentResult{}, quantityOutOfRange(err)
+	}
+
+	adjustmentResult := adjustedStockItemData.ToDomain()
+	adjustmentResult.Lots = lotAllocations
+
+	s.changes.Publish(domain.NewStockChange(adjustmentResult.StockItem))
+
+	return adjustmentResult, nil
+}
+
+// adjustmentRejectedReason tells apart missing stock item from adjustment rejected by stock guard.
+func (s *stockServiceRepository) adjustmentRejectedReason(ctx context.Context, adjustment domain.StockAdjustment) error {
+	var exists bool
+
+	err := s.psqlDB.Get(ctx, &exists, `
+		SELECT EXISTS (
+			SELECT 1 FROM stock_items
+			WHERE user_id = $1 AND sku_id = $2 AND location = $3 AND deleted_at IS NULL
+		)`,
+		adjustment.UserID, adjustment.SkuID, adjustment.Location,
+	)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return domain.ErrStockItemNotFound
+	}
+
+	return domain.ErrInsufficientStock
+}
+
+// UpdateBackorderSettings sets backorder policy of stock item, zero limit and restock date are stored as NULL.
+func (s *stockServiceRepository) UpdateBackorderSettings(ctx context.Context, settings domain.BackorderSettings) error {
+	var restockAt *time.Time
+	if !settings.RestockAt.IsZero() {
+		restockAt = &settings.RestockAt
+	}
+
+	_, err := s.psqlDB.Exec(ctx, `
+		UPDATE stock_items
+		SET backorder_mode = $1, max_backorder_quantity = NULLIF($2::BIGINT, 0), restock_at = $3, updated_at = NOW()
+		WHERE user_id = $4 AND sku_id = $5 AND location = $6 AND deleted_at IS NULL`,
+		settings.Mode, settings.MaxQuantity, restockAt,
+		settings.UserID, settings.SkuID, settings.Location,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrStockItemNotFound
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"stocks/internal/domain"
+	"stocks/pkg/connection"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// execDB is database whose Exec returns err, like connection.Database does when no row is affected.
+type execDB struct {
+	connection.DB
+	err error
+}
+
+func (d execDB) Exec(context.Context, string, ...interface{}) (pgconn.CommandTag, error) {
+	return pgconn.NewCommandTag("UPDATE 1"), d.err
+}
+
+func TestStockServiceRepository_UpdateBackorderSettings(t *testing.T) {
+	t.Parallel()
+
+	execErr := fmt.Errorf("executing query error: %w", errors.New("connection reset"))
+
+	tests := []struct {
+		name    string
+		execErr error
+		wantErr error
+	}{
+		{name: "stock item is updated"},
+		{name: "stock item is missing or deleted", execErr: pgx.ErrNoRows, wantErr: domain.ErrStockItemNotFound},
+		{name: "database error", execErr: execErr, wantErr: execErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repository := NewStockServiceRepository(execDB{err: tt.execErr}, nil)
+
+			err := repository.UpdateBackorderSettings(context.Background(), domain.BackorderSettings{
+				UserID:          1,
+				SkuID:           1001,
+				Location:        "Ashgabat",
+				BackorderPolicy: domain.BackorderPolicy{Mode: domain.BackorderModeBackorder},
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("UpdateBackorderSettings() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
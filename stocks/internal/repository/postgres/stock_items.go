@@ -3,47 +3,119 @@ package postgres
 import (
 	"context"
 	"errors"
+	"fmt"
+	"stocks/internal/changebus"
 	"stocks/internal/domain"
 	"stocks/internal/usecase/stocks"
 	"stocks/pkg/connection"
+	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
+// uniqueViolationCode is postgres SQLSTATE of unique constraint violation.
+const uniqueViolationCode = "23505"
+
+// numericValueOutOfRangeCode is postgres SQLSTATE of arithmetic overflow, e.g. count not fitting BIGINT.
+const numericValueOutOfRangeCode = "22003"
+
+// defaultTxOptions is used by repository methods which run several statements in one transaction.
+var defaultTxOptions = connection.TxOptions{
+	IsoLevel:   pgx.ReadCommitted,
+	MaxRetries: 3,
+}
+
 var _ stocks.StockServiceRepository = (*stockServiceRepository)(nil)
 
 type stockServiceRepository struct {
-	psqlDB connection.DB
+	psqlDB  connection.DB
+	changes changebus.Publisher
 }
 
-func NewStockServiceRepository(psqlDB connection.DB) *stockServiceRepository {
-	return &stockServiceRepository{psqlDB: psqlDB}
+func NewStockServiceRepository(psqlDB connection.DB, changes changebus.Publisher) *stockServiceRepository {
+	return &stockServiceRepository{psqlDB: psqlDB, changes: changes}
 }
 
-func (s *stockServiceRepository) SaveStockItem(ctx context.Context, stockItem domain.StockItem) error {
-	_, err := s.psqlDB.Exec(ctx, `
-		INSERT INTO stock_items (user_id, sku_id, count, price, location)
-		VALUES ($1, $2, $3, $4, $5)`,
-		stockItem.UserID, stockItem.Sku.ID, stockItem.Count,
-		stockItem.Price, stockItem.Location,
-	)
+// UpsertStockItem adds count of stock item to existing one or creates it and records ledger entry
+// in the same transaction, units received with lot number are put into that lot.
+// Returned stock item has level stored before this change.
+func (s *stockServiceRepository) UpsertStockItem(ctx context.Context, stockItem domain.StockItem) (domain.StockItem, bool, error) {
+	upsertedStockItem, created, err := s.upsertStockItem(ctx, stockItem)
+	if err != nil {
+		return domain.StockItem{}, false, err
+	}
+
+	s.changes.Publish(domain.NewStockChange(upsertedStockItem))
+
+	return upsertedStockItem, created, nil
+}
+
+// upsertStockItem is UpsertStockItem without publishing change, it may run in transaction of caller,
+// which publishes change once its transaction commits.
+func (s *stockServiceRepository) upsertStockItem(ctx context.Context, stockItem domain.StockItem) (domain.StockItem, bool, error) {
+	var upsertedStockItemData UpsertedStockItemData
+
+	err := s.psqlDB.WithTx(ctx, defaultTxOptions, func(ctx context.Context) error {
+		err := s.psqlDB.Get(ctx, &upsertedStockItemData, `
+			INSERT INTO stock_items (user_id, sku_id, count, price, currency, location)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (user_id, sku_id, location) WHERE deleted_at IS NULL DO UPDATE SET
+				count = stock_items.count + EXCLUDED.count,
+				price = EXCLUDED.price,
+				currency = EXCLUDED.currency,
+				updated_at = NOW()
+			RETURNING user_id, sku_id, count, price, currency, location, stock_level, version, (xmax = 0) AS created`,
+			stockItem.UserID, stockItem.Sku.ID, stockItem.Count,
+			stockItem.Price.Amount, stockItem.Price.Currency, stockItem.Location,
+		)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.psqlDB.Exec(ctx, `
+			INSERT INTO stock_movements (user_id, sku_id, location, delta, quantity_after, reason)
+			VALUES ($1, $2, $3, $4, $5, $6)`,
+			stockItem.UserID, stockItem.Sku.ID, stockItem.Location,
+			stockItem.Count, upsertedStockItemData.Quantity, domain.AdjustmentReasonReceived,
+		)
+		if err != nil || stockItem.Lot == nil {
+			return err
+		}
+
+		err = s.receiveLot(ctx, stockItem)
+		if err != nil {
+			return err
+		}
+
+		// units which filled backorders are already gone, lots keep only what is left on hand.
+		return s.trimLots(ctx, stockItem.UserID, stockItem.Sku.ID, stockItem.Location,
+			upsertedStockItemData.Quantity, domain.AdjustmentReasonReceived,
+		)
+	})
 	if err != nil {
-		return err
+		return domain.StockItem{}, false, quantityOutOfRange(err)
 	}
 
-	return nil
+	upsertedStockItem := upsertedStockItemData.ToDomain().StockItem
+	upsertedStockItem.Sku = stockItem.Sku
+
+	return upsertedStockItem, upsertedStockItemData.Created, nil
 }
 
-func (s *stockServiceRepository) GetStockItem(ctx context.Context, userID domain.UserID, skuID domain.SKUID) (domain.StockItem, error) {
+func (s *stockServiceRepository) GetStockItem(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) (domain.StockItem, error) {
 	var stockItemData StockItemData
 
 	err := s.psqlDB.Get(ctx, &stockItemData, `
-		SELECT si.user_id, s.sku_id, si.count, s.name, s.type, si.price, si.location, si.created_at, si.updated_at
+		SELECT si.user_id, s.sku_id, si.count, s.name, s.type,
+			COALESCE(s.variant_group_id, 0) AS variant_group_id, s.attributes, s.unit, si.price, si.currency, si.location, si.stock_level, si.created_at, si.updated_at
 		FROM stock_items si 
 		LEFT JOIN sku s ON s.sku_id = si.sku_id
-		WHERE si.user_id = $1 AND si.sku_id = $2`,
+		WHERE si.user_id = $1 AND si.sku_id = $2 AND si.location = $3 AND si.deleted_at IS NULL`,
 		userID,
 		skuID,
+		location,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -56,71 +128,365 @@ func (s *stockServiceRepository) GetStockItem(ctx context.Context, userID domain
 	return stockItemData.ToDomain(), nil
 }
 
-func (s *stockServiceRepository) UpdateStockItem(ctx context.Context, stockItem domain.StockItem) error {
-	_, err := s.psqlDB.Exec(ctx, `
-		UPDATE stock_items
-		SET	
-			count = COALESCE(NULLIF($1, 0), count),
-			price = COALESCE(NULLIF($2, 0), price),
-			location = COALESCE(NULLIF($3, ''), location),
-			updated_at = NOW()
-		WHERE user_id = $4 AND sku_id = $5`,
-		stockItem.Count, stockItem.Price, stockItem.Location,
-		stockItem.UserID, stockItem.Sku.ID,
-	)
+// UpdateStockItemFields writes exactly fields from update mask and returns updated stock item.
+func (s *stockServiceRepository) UpdateStockItemFields(ctx context.Context, update domain.StockItemUpdate) (domain.StockItem, error) {
+	var stockItemData UpdatedStockItemData
+
+	args := []interface{}{update.UserID, update.SkuID, update.Location}
+	setClauses := make([]string, 0, len(update.UpdateMask)+2)
+
+	for _, field := range update.UpdateMask {
+		switch field {
+		case domain.StockItemFieldCount:
+			args = append(args, update.Count)
+		case domain.StockItemFieldPrice:
+			// empty currency keeps currency of the offer, only amount of price is changed then.
+			args = append(args, update.Price.Currency)
+			setClauses = append(setClauses, fmt.Sprintf("currency = COALESCE(NULLIF($%d, ''), currency)", len(args)))
+			args = append(args, update.Price.Amount)
+		case domain.StockItemFieldLocation:
+			args = append(args, update.NewLocation)
+		default:
+			return domain.StockItem{}, fmt.Errorf("unknown stock item field %q", field)
+		}
+
+		setClauses = append(setClauses, fmt.Sprintf("%s = $%d", field, len(args)))
+	}
+
+	setClauses = append(setClauses, "updated_at = NOW()")
+
+	err := s.psqlDB.WithTx(ctx, defaultTxOptions, func(ctx context.Context) error {
+		err := s.psqlDB.Get(ctx, &stockItemData, `
+			WITH previous AS (
+				SELECT id, count
+				FROM stock_items
+				WHERE user_id = $1 AND sku_id = $2 AND location = $3 AND deleted_at IS NULL
+				FOR UPDATE
+			), updated AS (
+				UPDATE stock_items si
+				SET `+strings.Join(setClauses, ", ")+`
+				FROM previous p
+				WHERE si.id = p.id
+				RETURNING si.user_id, si.sku_id, si.count, si.price, si.currency, si.location, si.stock_level, si.version, si.created_at, si.updated_at,
+					p.count AS previous_count
+			)
+			SELECT u.user_id, s.sku_id, u.count, s.name, s.type,
+				COALESCE(s.variant_group_id, 0) AS variant_group_id, s.attributes, s.unit, u.price, u.currency, u.location, u.stock_level, u.version, u.created_at, u.updated_at,
+				u.previous_count
+			FROM updated u
+			LEFT JOIN sku s ON s.sku_id = u.sku_id`,
+			args...,
+		)
+		if err != nil {
+			return err
+		}
+
+		// moved stock leaves old location and arrives to new one, so as-of reports see both sides.
+		if stockItemData.Location != update.Location {
+			err = s.recordMovement(ctx, stockItemData.StockItemData, update.Location,
+				-stockItemData.PreviousCount, 0, domain.AdjustmentReasonTransferOut,
+			)
+			if err != nil {
+				return err
+			}
+
+			err = s.recordMovement(ctx, stockItemData.StockItemData, stockItemData.Location,
+				stockItemData.Count, stockItemData.Count, domain.AdjustmentReasonTransferIn,
+			)
+		} else if delta := stockItemData.Count - stockItemData.PreviousCount; delta != 0 {
+			err = s.recordMovement(ctx, stockItemData.StockItemData, stockItemData.Location,
+				delta, stockItemData.Count, domain.AdjustmentReasonCorrection,
+			)
+		}
+
+		if err != nil || stockItemData.Count >= stockItemData.PreviousCount {
+			return err
+		}
+
+		// lots move with stock item, but they can not keep more than corrected count.
+		return s.trimLots(ctx, update.UserID, update.SkuID, stockItemData.Location,
+			stockItemData.Count, domain.AdjustmentReasonCorrection,
+		)
+	})
 	if err != nil {
-		return err
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.StockItem{}, domain.ErrStockItemNotFound
+		}
+
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return domain.StockItem{}, domain.ErrStockItemAlreadyExists
+		}
+
+		return domain.StockItem{}, err
 	}
 
-	return nil
+	updatedStockItem := stockItemData.ToDomain()
+	changes := []domain.StockChange{domain.NewStockChange(updatedStockItem)}
+
+	// stock item moved to another location, watchers of old one see it gone.
+	if updatedStockItem.Location != update.Location {
+		movedFrom := domain.NewStockChange(updatedStockItem)
+		movedFrom.Location = update.Location
+		movedFrom.Deleted = true
+		changes = append(changes, movedFrom)
+	}
+
+	s.changes.Publish(changes...)
+
+	return updatedStockItem, nil
 }
 
-func (s *stockServiceRepository) DeleteStockItemFromStorage(ctx context.Context, userID domain.UserID, skuID domain.SKUID) error {
+// DeleteStockItemFromStorage soft deletes stock items of sku in every location and returns deleted ones.
+func (s *stockServiceRepository) DeleteStockItemFromStorage(
+	ctx context.Context,
+	userID domain.UserID,
+	skuID domain.SKUID,
+	deletedBy domain.UserID,
+) ([]domain.StockItem, error) {
+	var deletedStockItemsData []AdjustedStockItemData
+
+	err := s.psqlDB.WithTx(ctx, defaultTxOptions, func(ctx context.Context) error {
+		err := s.psqlDB.Select(ctx, &deletedStockItemsData, `
+			UPDATE stock_items
+			SET deleted_at = NOW(), deleted_by = $3
+			WHERE user_id = $1 AND sku_id = $2 AND deleted_at IS NULL
+			RETURNING user_id, sku_id, count, price, currency, location, stock_level, version`,
+			userID, skuID, deletedBy,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, deletedStockItemData := range deletedStockItemsData {
+			_, err = s.psqlDB.Exec(ctx, `
+				INSERT INTO stock_movements (user_id, sku_id, location, delta, quantity_after, reason)
+				VALUES ($1, $2, $3, $4, 0, $5)`,
+				deletedStockItemData.UserID, deletedStockItemData.SkuID, deletedStockItemData.Location,
+				-deletedStockItemData.Quantity, domain.AdjustmentReasonDeleted,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(deletedStockItemsData) == 0 {
+		return nil, domain.ErrStockItemNotFound
+	}
+
+	deletedStockItems := make([]domain.StockItem, 0, len(deletedStockItemsData))
+	changes := make([]domain.StockChange, 0, len(deletedStockItemsData))
+
+	for _, deletedStockItemData := range deletedStockItemsData {
+		deletedStockItem := deletedStockItemData.ToDomain().StockItem
+		deletedStockItems = append(deletedStockItems, deletedStockItem)
+
+		change := domain.NewStockChange(deletedStockItem)
+		change.Deleted = true
+		changes = append(changes, change)
+	}
+
+	s.changes.Publish(changes...)
+
+	return deletedStockItems, nil
+}
+
+// RestoreDeletedStockItem brings back the latest deleted stock item of sku in location.
+func (s *stockServiceRepository) RestoreDeletedStockItem(
+	ctx context.Context,
+	userID domain.UserID,
+	skuID domain.SKUID,
+	location string,
+) (domain.StockItem, error) {
+	var stockItemData StockItemData
+
+	err := s.psqlDB.WithTx(ctx, defaultTxOptions, func(ctx context.Context) error {
+		err := s.psqlDB.Get(ctx, &stockItemData, `
+			WITH restored AS (
+				UPDATE stock_items
+				SET deleted_at = NULL, deleted_by = NULL, updated_at = NOW()
+				WHERE id = (
+					SELECT id FROM stock_items
+					WHERE user_id = $1 AND sku_id = $2 AND location = $3 AND deleted_at IS NOT NULL
+					ORDER BY deleted_at DESC
+					LIMIT 1
+				)
+				RETURNING user_id, sku_id, count, price, currency, location, stock_level, version, created_at, updated_at
+			)
+			SELECT r.user_id, s.sku_id, r.count, s.name, s.type,
+				COALESCE(s.variant_group_id, 0) AS variant_group_id, s.attributes, s.unit, r.price, r.currency, r.location, r.stock_level, r.version, r.created_at, r.updated_at
+			FROM restored r
+			LEFT JOIN sku s ON s.sku_id = r.sku_id`,
+			userID, skuID, location,
+		)
+		if err != nil {
+			return err
+		}
+
+		return s.recordMovement(ctx, stockItemData, stockItemData.Location,
+			stockItemData.Count, stockItemData.Count, domain.AdjustmentReasonRestored,
+		)
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.StockItem{}, domain.ErrStockItemNotFound
+		}
+
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return domain.StockItem{}, domain.ErrStockItemAlreadyExists
+		}
+
+		return domain.StockItem{}, err
+	}
+
+	restoredStockItem := stockItemData.ToDomain()
+
+	s.changes.Publish(domain.NewStockChange(restoredStockItem))
+
+	return restoredStockItem, nil
+}
+
+// recordMovement writes ledger entry of stock item quantity change made outside of adjustments and transfers.
+func (s *stockServiceRepository) recordMovement(
+	ctx context.Context,
+	stockItemData StockItemData,
+	location string,
+	delta int64,
+	quantityAfter int64,
+	reason domain.AdjustmentReason,
+) error {
 	_, err := s.psqlDB.Exec(ctx, `
+		INSERT INTO stock_movements (user_id, sku_id, location, delta, quantity_after, reason)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		stockItemData.UserID, stockItemData.SkuID, location, delta, quantityAfter, reason,
+	)
+
+	return err
+}
+
+// PurgeStockItemsDeletedBefore removes soft deleted stock items for good and returns how many were removed.
+func (s *stockServiceRepository) PurgeStockItemsDeletedBefore(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	result, err := s.psqlDB.Exec(ctx, `
 		DELETE FROM stock_items
-		WHERE user_id = $1 AND sku_id = $2`,
-		userID, skuID,
+		WHERE deleted_at IS NOT NULL AND deleted_at < $1`,
+		deletedBefore,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return domain.ErrStockItemNotFound
+			return 0, nil
 		}
 
-		return err
+		return 0, err
 	}
 
-	return nil
+	return result.RowsAffected(), nil
 }
 
-func (s *stockServiceRepository) GetStockItemBySku(ctx context.Context, skuID domain.SKUID) (domain.StockItem, error) {
-	var stockItemData StockItemData
+// offerOrderings are ORDER BY clauses of offer rules, offers in stock always go before sold out ones.
+// price is output column of offer queries, price order gets after price tiers.
+var offerOrderings = map[domain.OfferRule]string{
+	domain.OfferRuleLowestPrice: "si.count > 0 DESC, price ASC, si.count DESC, si.user_id ASC, si.location ASC",
+	domain.OfferRuleMostStock:   "si.count > 0 DESC, si.count DESC, price ASC, si.user_id ASC, si.location ASC",
+}
 
-	err := s.psqlDB.Get(ctx, &stockItemData, `
-		SELECT si.user_id, s.sku_id, s.name, s.type, si.count, si.price, si.location, si.created_at, si.updated_at
+// ListStockItemOffers returns live stock items of sku ordered by offer rule, best offer first,
+// only the best one is returned when all offers are not requested. Offers are priced by price tiers
+// of quantity and customer group of filter.
+func (s *stockServiceRepository) ListStockItemOffers(ctx context.Context, filter domain.OfferFilter) ([]domain.StockItem, error) {
+	var stockItemsData []StockItemData
+
+	ordering, ok := offerOrderings[filter.Rule]
+	if !ok {
+		return nil, fmt.Errorf("unknown offer rule %q", filter.Rule)
+	}
+
+	limit := "LIMIT 1"
+	if filter.AllOffers {
+		limit = ""
+	}
+
+	err := s.psqlDB.Select(ctx, &stockItemsData, `
+		SELECT si.user_id, s.sku_id, s.name, s.type,
+			COALESCE(s.variant_group_id, 0) AS variant_group_id, s.attributes, s.unit, si.count,
+			COALESCE(tier.price, si.price) AS price, si.price AS list_price, si.currency, si.location, si.created_at, si.updated_at,
+			si.backorder_mode, si.max_backorder_quantity, si.restock_at,
+			COALESCE(incoming.quantity, 0) AS incoming_quantity, incoming.expected_at AS incoming_expected_at,
+			tier.customer_group AS tier_customer_group, tier.min_quantity AS tier_min_quantity
 		FROM stock_items si
 		INNER JOIN sku s ON s.sku_id = si.sku_id
-		WHERE si.sku_id = $1`,
-		skuID,
+		`+incomingJoin+`
+		`+tierJoin+`
+		WHERE si.sku_id = $1 AND ($2::BIGINT = 0 OR si.user_id = $2) AND si.deleted_at IS NULL
+		ORDER BY `+ordering+`
+		`+limit,
+		filter.SkuID, filter.SellerID, filter.CustomerGroup, filter.Quantity,
 	)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return domain.StockItem{}, domain.ErrStockItemNotFound
-		}
+		return nil, err
+	}
 
-		return domain.StockItem{}, err
+	if len(stockItemsData) == 0 {
+		return nil, domain.ErrStockItemNotFound
 	}
 
-	return stockItemData.ToDomain(), nil
+	stockItems := make([]domain.StockItem, 0, len(stockItemsData))
+	for _, stockItemData := range stockItemsData {
+		stockItems = append(stockItems, stockItemData.ToDomain())
+	}
+
+	return stockItems, nil
+}
+
+func (s *stockServiceRepository) ListStockItemsBySkus(ctx context.Context, skuIDs []domain.SKUID) ([]domain.StockItem, error) {
+	var stockItemsData []StockItemData
+
+	skuIDValues := make([]int64, 0, len(skuIDs))
+	for _, skuID := range skuIDs {
+		skuIDValues = append(skuIDValues, int64(skuID))
+	}
+
+	err := s.psqlDB.Select(ctx, &stockItemsData, `
+		SELECT si.user_id, s.sku_id, s.name, s.type,
+			COALESCE(s.variant_group_id, 0) AS variant_group_id, s.attributes, s.unit, si.count, si.price, si.currency, si.location, si.stock_level, si.version, si.created_at, si.updated_at
+		FROM stock_items si
+		INNER JOIN sku s ON s.sku_id = si.sku_id
+		WHERE si.sku_id = ANY($1) AND si.deleted_at IS NULL
+		ORDER BY si.sku_id, si.user_id, si.location`,
+		skuIDValues,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	stockItems := make([]domain.StockItem, 0, len(stockItemsData))
+	for _, stockItem := range stockItemsData {
+		stockItems = append(stockItems, stockItem.ToDomain())
+	}
+
+	return stockItems, nil
 }
 
-func (s *stockServiceRepository) CountStockItems(ctx context.Context, userID domain.UserID, location string) (uint16, error) {
+func (s *stockServiceRepository) CountStockItems(ctx context.Context, filter domain.Filter) (uint16, error) {
 	var stockItemsCount uint16
 
-	err := s.psqlDB.Get(ctx, &stockItemsCount, `
-		SELECT COUNT(user_id) 
-		FROM stock_items
-		WHERE user_id = $1 AND location = $2`,
-		userID, location,
+	attributes, err := attributesFilterJSON(filter.Attributes)
+	if err != nil {
+		return 0, err
+	}
+
+	err = s.psqlDB.Get(ctx, &stockItemsCount, `
+		SELECT COUNT(si.user_id)
+		FROM stock_items si
+		LEFT JOIN sku s ON s.sku_id = si.sku_id
+		WHERE si.user_id = $1 AND si.location = $2 AND si.deleted_at IS NULL AND `+attributesFilterCondition("$3"),
+		filter.UserID, filter.Location, attributes,
 	)
 	if err != nil {
 		return 0, err
@@ -134,16 +500,23 @@ func (s *stockServiceRepository) ListStockItemsByLocation(ctx context.Context, f
 
 	offset := (filter.CurrentPage - 1) * filter.PageSize
 
-	err := s.psqlDB.Select(ctx, &stockItemsData, `
-		SELECT si.user_id, s.sku_id, s.name, s.type, si.count, si.price, si.location, si.created_at, si.updated_at
+	attributes, err := attributesFilterJSON(filter.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.psqlDB.Select(ctx, &stockItemsData, `
+		SELECT si.user_id, s.sku_id, s.name, s.type,
+			COALESCE(s.variant_group_id, 0) AS variant_group_id, s.attributes, s.unit, si.count, si.price, si.currency, si.location, si.created_at, si.updated_at
 		FROM stock_items si
 		LEFT JOIN sku s ON s.sku_id = si.sku_id
-		WHERE user_id = $1 AND location = $2
+		WHERE si.user_id = $1 AND si.location = $2 AND si.deleted_at IS NULL AND `+attributesFilterCondition("$5")+`
 		OFFSET $3 LIMIT $4`,
 		filter.UserID,
 		filter.Location,
 		offset,
 		filter.PageSize,
+		attributes,
 	)
 	if err != nil {
 		return nil, err
@@ -156,3 +529,23 @@ func (s *stockServiceRepository) ListStockItemsByLocation(ctx context.Context, f
 
 	return stockItems, nil
 }
+
+// quantityOutOfRange maps overflow of count arithmetic to domain error, other errors are returned as is.
+func quantityOutOfRange(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == numericValueOutOfRangeCode {
+		return domain.ErrQuantityOutOfRange
+	}
+
+	return err
+}
+
+// amountOutOfRange maps overflow of price arithmetic to domain error, other errors are returned as is.
+func amountOutOfRange(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == numericValueOutOfRangeCode {
+		return domain.ErrAmountOutOfRange
+	}
+
+	return err
+}
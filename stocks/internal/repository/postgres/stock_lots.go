@@ -0,0 +1,361 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"stocks/internal/domain"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+)
+
+const stockLotColumns = `sl.id, si.user_id, si.sku_id, si.location, sl.lot_number, sl.received_at, sl.expires_at, sl.quantity`
+
+// receiveLot puts received units of stock item into its lot, units of lot received again are added to it.
+// Stock item must be written by the same transaction, so its row is locked.
+func (s *stockServiceRepository) receiveLot(ctx context.Context, stockItem domain.StockItem) error {
+	var (
+		lotID                 int64
+		quantity              int64
+		receivedAt, expiresAt *time.Time
+	)
+
+	if !stockItem.Lot.ReceivedAt.IsZero() {
+		receivedAt = &stockItem.Lot.ReceivedAt
+	}
+
+	if !stockItem.Lot.ExpiresAt.IsZero() {
+		expiresAt = &stockItem.Lot.ExpiresAt
+	}
+
+	// lot number identifies one receipt, so the same lot can not come with another expiry date.
+	err := s.psqlDB.QueryRow(ctx, `
+		INSERT INTO stock_lots (stock_item_id, lot_number, received_at, expires_at, quantity)
+		SELECT id, $4, COALESCE($5, NOW()), $6, $7
+		FROM stock_items
+		WHERE user_id = $1 AND sku_id = $2 AND location = $3 AND deleted_at IS NULL
+		ON CONFLICT (stock_item_id, lot_number) DO UPDATE SET
+			quantity = stock_lots.quantity + EXCLUDED.quantity,
+			updated_at = NOW()
+		WHERE stock_lots.expires_at IS NOT DISTINCT FROM EXCLUDED.expires_at
+		RETURNING id, quantity`,
+		stockItem.UserID, stockItem.Sku.ID, stockItem.Location,
+		stockItem.Lot.LotNumber, receivedAt, expiresAt, stockItem.Count,
+	).Scan(&lotID, &quantity)
+	if err != nil {
+		if pgxscan.NotFound(err) {
+			return domain.ErrLotExpiryMismatch
+		}
+
+		return err
+	}
+
+	return s.recordLotMovement(ctx, lotID, stockItem.Count, quantity, domain.AdjustmentReasonReceived, "")
+}
+
+// allocateLots takes quantity from lots of stock item first expired first out and writes lot ledger entries,
+// the part lots do not hold is taken from stock received without lot.
+// Stock item must be updated by the same transaction before, so lots can not be changed concurrently.
+func (s *stockServiceRepository) allocateLots(
+	ctx context.Context,
+	userID domain.UserID,
+	skuID domain.SKUID,
+	location string,
+	quantity int64,
+	reason domain.AdjustmentReason,
+	reference string,
+) ([]domain.LotAllocation, error) {
+	lots, err := s.listStockItemLots(ctx, userID, skuID, location)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.takeFromLots(ctx, lots, quantity, reason, reference)
+}
+
+// trimLots takes units lots hold above count of stock item, e.g. after count was corrected
+// or received stock filled backorders, so lots never hold more than stock item has.
+func (s *stockServiceRepository) trimLots(
+	ctx context.Context,
+	userID domain.UserID,
+	skuID domain.SKUID,
+	location string,
+	count int64,
+	reason domain.AdjustmentReason,
+) error {
+	lots, err := s.listStockItemLots(ctx, userID, skuID, location)
+	if err != nil {
+		return err
+	}
+
+	excess := -max(count, 0)
+	for _, lot := range lots {
+		excess += lot.Quantity
+	}
+
+	if excess <= 0 {
+		return nil
+	}
+
+	_, err = s.takeFromLots(ctx, lots, excess, reason, "")
+
+	return err
+}
+
+// listStockItemLots returns lots of live stock item which still hold units.
+func (s *stockServiceRepository) listStockItemLots(
+	ctx context.Context,
+	userID domain.UserID,
+	skuID domain.SKUID,
+	location string,
+) ([]domain.StockLot, error) {
+	var stockLotsData []StockLotData
+
+	err := s.psqlDB.Select(ctx, &stockLotsData, `
+		SELECT `+stockLotColumns+`
+		FROM stock_lots sl
+		INNER JOIN stock_items si ON si.id = sl.stock_item_id
+		WHERE si.user_id = $1 AND si.sku_id = $2 AND si.location = $3 AND si.deleted_at IS NULL AND sl.quantity > 0
+		FOR UPDATE OF sl`,
+		userID, skuID, location,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	stockLots := make([]domain.StockLot, 0, len(stockLotsData))
+	for _, stockLotData := range stockLotsData {
+		stockLots = append(stockLots, stockLotData.ToDomain())
+	}
+
+	return stockLots, nil
+}
+
+func (s *stockServiceRepository) takeFromLots(
+	ctx context.Context,
+	lots []domain.StockLot,
+	quantity int64,
+	reason domain.AdjustmentReason,
+	reference string,
+) ([]domain.LotAllocation, error) {
+	allocations := domain.AllocateFEFO(lots, quantity)
+
+	for _, allocation := range allocations {
+		_, err := s.psqlDB.Exec(ctx, `
+			WITH taken AS (
+				UPDATE stock_lots
+				SET quantity = quantity - $2, updated_at = NOW()
+				WHERE id = $1
+				RETURNING id, quantity
+			)
+			INSERT INTO stock_lot_movements (lot_id, delta, quantity_after, reason, reference)
+			SELECT id, -$2, quantity, $3, $4
+			FROM taken`,
+			allocation.LotID, allocation.Quantity, reason, reference,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return allocations, nil
+}
+
+// receiveTransferLots recreates lots shipped by transfer at its destination, so units keep their expiry dates.
+// Lot which already is at destination with another expiry date keeps the earliest one.
+func (s *stockServiceRepository) receiveTransferLots(ctx context.Context, transferData StockTransferData) error {
+	reference := fmt.Sprintf("transfer:%d", transferData.ID)
+
+	_, err := s.psqlDB.Exec(ctx, `
+		WITH shipped AS (
+			SELECT sl.lot_number, sl.received_at, sl.expires_at, -m.delta AS quantity
+			FROM stock_lot_movements m
+			INNER JOIN stock_lots sl ON sl.id = m.lot_id
+			WHERE m.reference = $4 AND m.delta < 0
+		), received AS (
+			INSERT INTO stock_lots (stock_item_id, lot_number, received_at, expires_at, quantity)
+			SELECT si.id, sh.lot_number, sh.received_at, sh.expires_at, sh.quantity
+			FROM shipped sh
+			INNER JOIN stock_items si ON si.user_id = $1 AND si.sku_id = $2 AND si.location = $3 AND si.deleted_at IS NULL
+			ON CONFLICT (stock_item_id, lot_number) DO UPDATE SET
+				quantity = stock_lots.quantity + EXCLUDED.quantity,
+				expires_at = LEAST(stock_lots.expires_at, EXCLUDED.expires_at),
+				updated_at = NOW()
+			RETURNING id, lot_number, quantity
+		)
+		INSERT INTO stock_lot_movements (lot_id, delta, quantity_after, reason, reference)
+		SELECT r.id, sh.quantity, r.quantity, $5, $4
+		FROM received r
+		INNER JOIN shipped sh ON sh.lot_number = r.lot_number`,
+		transferData.UserID, transferData.SkuID, transferData.ToLocation,
+		reference, domain.AdjustmentReasonTransferIn,
+	)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *stockServiceRepository) recordLotMovement(
+	ctx context.Context,
+	lotID int64,
+	delta int64,
+	quantityAfter int64,
+	reason domain.AdjustmentReason,
+	reference string,
+) error {
+	_, err := s.psqlDB.Exec(ctx, `
+		INSERT INTO stock_lot_movements (lot_id, delta, quantity_after, reason, reference)
+		VALUES ($1, $2, $3, $4, $5)`,
+		lotID, delta, quantityAfter, reason, reference,
+	)
+
+	return err
+}
+
+// ExpireDueLots moves units left in expired lots out of available stock and returns expired lots.
+// Rows are locked with SKIP LOCKED, so several stocks replicas can run sweeper at the same time.
+func (s *stockServiceRepository) ExpireDueLots(ctx context.Context, limit int) ([]domain.ExpiredLot, error) {
+	var expiredLots []domain.ExpiredLot
+
+	err := s.psqlDB.WithTx(ctx, defaultTxOptions, func(ctx context.Context) error {
+		// transaction can be retried, result of failed attempt must not leak.
+		expiredLots = nil
+
+		var dueLotsData []StockLotData
+
+		err := s.psqlDB.Select(ctx, &dueLotsData, `
+			SELECT `+stockLotColumns+`
+			FROM stock_lots sl
+			INNER JOIN stock_items si ON si.id = sl.stock_item_id
+			WHERE sl.expires_at <= NOW() AND sl.quantity > 0 AND si.deleted_at IS NULL
+			ORDER BY sl.expires_at, sl.id
+			LIMIT $1
+			FOR UPDATE OF si, sl SKIP LOCKED`,
+			limit,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, dueLotData := range dueLotsData {
+			lot := dueLotData.ToDomain()
+
+			var adjustedStockItemData AdjustedStockItemData
+
+			err := s.psqlDB.Get(ctx, &adjustedStockItemData, `
+				WITH adjusted AS (
+					UPDATE stock_items
+					SET count = count - $1, updated_at = NOW()
+					WHERE user_id = $2 AND sku_id = $3 AND location = $4 AND deleted_at IS NULL
+					RETURNING user_id, sku_id, count, price, currency, location, stock_level, version
+				), movement AS (
+					INSERT INTO stock_movements (user_id, sku_id, location, delta, quantity_after, reason, reference)
+					SELECT user_id, sku_id, location, -$1, count, $5, $6
+					FROM adjusted
+				)
+				SELECT user_id, sku_id, count, price, currency, location, stock_level, version FROM adjusted`,
+				lot.Quantity, lot.UserID, lot.Sku.ID, lot.Location,
+				domain.AdjustmentReasonExpired, "lot:"+lot.LotNumber,
+			)
+			if err != nil {
+				return err
+			}
+
+			_, err = s.psqlDB.Exec(ctx, `
+				WITH expired AS (
+					UPDATE stock_lots
+					SET quantity = 0, expired_quantity = expired_quantity + quantity, expired_at = NOW(), updated_at = NOW()
+					WHERE id = $1
+					RETURNING id
+				)
+				INSERT INTO stock_lot_movements (lot_id, delta, quantity_after, reason)
+				SELECT id, -$2, 0, $3
+				FROM expired`,
+				lot.ID, lot.Quantity, domain.AdjustmentReasonExpired,
+			)
+			if err != nil {
+				return err
+			}
+
+			expiredLot := domain.ExpiredLot{
+				Lot:                   lot,
+				StockAdjustmentResult: adjustedStockItemData.ToDomain(),
+			}
+			expiredLot.Lots = []domain.LotAllocation{{
+				LotID:     lot.ID,
+				LotNumber: lot.LotNumber,
+				ExpiresAt: lot.ExpiresAt,
+				Quantity:  lot.Quantity,
+			}}
+
+			expiredLots = append(expiredLots, expiredLot)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]domain.StockChange, 0, len(expiredLots))
+	for _, expiredLot := range expiredLots {
+		changes = append(changes, domain.NewStockChange(expiredLot.StockItem))
+	}
+
+	s.changes.Publish(changes...)
+
+	return expiredLots, nil
+}
+
+func (s *stockServiceRepository) CountLotsExpiringBefore(ctx context.Context, filter domain.ExpiringLotsFilter) (uint32, error) {
+	var lotsCount uint32
+
+	err := s.psqlDB.Get(ctx, &lotsCount, `
+		SELECT COUNT(sl.id)
+		FROM stock_lots sl
+		INNER JOIN stock_items si ON si.id = sl.stock_item_id
+		WHERE sl.expires_at < $1 AND sl.quantity > 0 AND si.deleted_at IS NULL
+			AND ($2::BIGINT = 0 OR si.user_id = $2) AND ($3::TEXT = '' OR si.location = $3)`,
+		filter.ExpiresBefore, filter.UserID, filter.Location,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return lotsCount, nil
+}
+
+// ListLotsExpiringBefore returns lots of live stock items which hold units expiring before filter time,
+// the earliest expiring first.
+func (s *stockServiceRepository) ListLotsExpiringBefore(ctx context.Context, filter domain.ExpiringLotsFilter) ([]domain.StockLot, error) {
+	var stockLotsData []StockLotData
+
+	offset := (filter.CurrentPage - 1) * filter.PageSize
+
+	err := s.psqlDB.Select(ctx, &stockLotsData, `
+		SELECT `+stockLotColumns+`, COALESCE(s.name, '') AS name
+		FROM stock_lots sl
+		INNER JOIN stock_items si ON si.id = sl.stock_item_id
+		LEFT JOIN sku s ON s.sku_id = si.sku_id
+		WHERE sl.expires_at < $1 AND sl.quantity > 0 AND si.deleted_at IS NULL
+			AND ($2::BIGINT = 0 OR si.user_id = $2) AND ($3::TEXT = '' OR si.location = $3)
+		ORDER BY sl.expires_at, sl.id
+		OFFSET $4 LIMIT $5`,
+		filter.ExpiresBefore, filter.UserID, filter.Location,
+		offset, filter.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	stockLots := make([]domain.StockLot, 0, len(stockLotsData))
+	for _, stockLotData := range stockLotsData {
+		stockLots = append(stockLots, stockLotData.ToDomain())
+	}
+
+	return stockLots, nil
+}
@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"stocks/internal/domain"
+	"stocks/internal/usecase/stocks"
+	"stocks/pkg/connection"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var _ stocks.StockThresholdRepository = (*stockThresholdRepository)(nil)
+
+type stockThresholdRepository struct {
+	psqlDB connection.DB
+}
+
+func NewStockThresholdRepository(psqlDB connection.DB) *stockThresholdRepository {
+	return &stockThresholdRepository{psqlDB: psqlDB}
+}
+
+func (s *stockThresholdRepository) SaveStockThreshold(ctx context.Context, threshold domain.StockThreshold) error {
+	_, err := s.psqlDB.Exec(ctx, `
+		INSERT INTO stock_thresholds (sku_id, location, reorder_threshold, hysteresis)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (sku_id, location) DO UPDATE SET
+			reorder_threshold = EXCLUDED.reorder_threshold,
+			hysteresis = EXCLUDED.hysteresis,
+			updated_at = NOW()`,
+		threshold.SkuID, threshold.Location,
+		threshold.ReorderThreshold, threshold.Hysteresis,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetStockThreshold returns location specific threshold of sku, falling back to sku-wide one.
+func (s *stockThresholdRepository) GetStockThreshold(ctx context.Context, skuID domain.SKUID, location string) (domain.StockThreshold, error) {
+	var thresholdData StockThresholdData
+
+	err := s.psqlDB.Get(ctx, &thresholdData, `
+		SELECT sku_id, location, reorder_threshold, hysteresis
+		FROM stock_thresholds
+		WHERE sku_id = $1 AND location IN ($2, '')
+		ORDER BY location = '' ASC
+		LIMIT 1`,
+		skuID, location,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.StockThreshold{}, domain.ErrStockThresholdNotFound
+		}
+
+		return domain.StockThreshold{}, err
+	}
+
+	return thresholdData.ToDomain(), nil
+}
+
+// UpdateStockLevel moves stock item from one level to another, it reports false
+// when level was already changed by someone else.
+func (s *stockThresholdRepository) UpdateStockLevel(
+	ctx context.Context,
+	stockItem domain.StockItem,
+	from, to domain.StockLevel,
+) (bool, error) {
+	_, err := s.psqlDB.Exec(ctx, `
+		UPDATE stock_items
+		SET stock_level = $1
+		WHERE user_id = $2 AND sku_id = $3 AND location = $4 AND stock_level = $5 AND deleted_at IS NULL`,
+		to, stockItem.UserID, stockItem.Sku.ID, stockItem.Location, from,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *stockThresholdRepository) CountLowStockItems(ctx context.Context, location string) (uint32, error) {
+	var lowStockItemsCount uint32
+
+	err := s.psqlDB.Get(ctx, &lowStockItemsCount, `
+		SELECT COUNT(id)
+		FROM stock_items
+		WHERE stock_level <> 'ok' AND deleted_at IS NULL AND ($1 = '' OR location = $1)`,
+		location,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return lowStockItemsCount, nil
+}
+
+func (s *stockThresholdRepository) ListLowStockItems(ctx context.Context, filter domain.LowStockFilter) ([]domain.LowStockItem, error) {
+	var lowStockItemsData []LowStockItemData
+
+	offset := (filter.CurrentPage - 1) * filter.PageSize
+
+	err := s.psqlDB.Select(ctx, &lowStockItemsData, `
+		SELECT
+			si.user_id, s.sku_id, s.name, s.type,
+				COALESCE(s.variant_group_id, 0) AS variant_group_id, s.attributes, s.unit, si.count, si.price, si.currency, si.location, si.stock_level,
+			si.created_at, si.updated_at, COALESCE(t.reorder_threshold, 0) AS reorder_threshold
+		FROM stock_items si
+		INNER JOIN sku s ON s.sku_id = si.sku_id
+		LEFT JOIN LATERAL (
+			SELECT st.reorder_threshold
+			FROM stock_thresholds st
+			WHERE st.sku_id = si.sku_id AND st.location IN (si.location, '')
+			ORDER BY st.location = '' ASC
+			LIMIT 1
+		) t ON TRUE
+		WHERE si.stock_level <> 'ok' AND si.deleted_at IS NULL AND ($1 = '' OR si.location = $1)
+		ORDER BY si.stock_level = 'depleted' DESC, si.count, s.sku_id
+		OFFSET $2 LIMIT $3`,
+		filter.Location,
+		offset,
+		filter.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	lowStockItems := make([]domain.LowStockItem, 0, len(lowStockItemsData))
+	for _, lowStockItem := range lowStockItemsData {
+		lowStockItems = append(lowStockItems, lowStockItem.ToDomain())
+	}
+
+	return lowStockItems, nil
+}
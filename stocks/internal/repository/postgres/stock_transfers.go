@@ -0,0 +1,260 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"stocks/internal/domain"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+const stockTransferColumns = `id, user_id, sku_id, from_location, to_location, quantity, status, shipped_at, received_at`
+
+// ShipStockTransfer takes units from source location and records transfer in transit,
+// when receiveImmediately is set units are put to destination in the same transaction.
+func (s *stockServiceRepository) ShipStockTransfer(
+	ctx context.Context,
+	transfer domain.StockTransfer,
+	receiveImmediately bool,
+) (domain.StockTransferResult, error) {
+	var transferResult domain.StockTransferResult
+
+	err := s.psqlDB.WithTx(ctx, defaultTxOptions, func(ctx context.Context) error {
+		// transaction can be retried, result of failed attempt must not leak.
+		transferResult = domain.StockTransferResult{}
+
+		var source AdjustedStockItemData
+
+		err := s.psqlDB.Get(ctx, &source, `
+			UPDATE stock_items
+			SET count = count - $1, updated_at = NOW()
+			WHERE user_id = $2 AND sku_id = $3 AND location = $4 AND deleted_at IS NULL AND count >= $1
+			RETURNING user_id, sku_id, count, price, currency, location, stock_level, version`,
+			transfer.Quantity, transfer.UserID, transfer.SkuID, transfer.FromLocation,
+		)
+		if err != nil {
+			if pgxscan.NotFound(err) {
+				return s.adjustmentRejectedReason(ctx, domain.StockAdjustment{
+					UserID:   transfer.UserID,
+					SkuID:    transfer.SkuID,
+					Location: transfer.FromLocation,
+				})
+			}
+
+			return err
+		}
+
+		var transferData StockTransferData
+
+		err = s.psqlDB.Get(ctx, &transferData, `
+			INSERT INTO stock_transfers (user_id, sku_id, from_location, to_location, quantity, status)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			RETURNING `+stockTransferColumns,
+			transfer.UserID, transfer.SkuID, transfer.FromLocation, transfer.ToLocation,
+			transfer.Quantity, domain.TransferStatusInTransit,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = s.insertTransferMovement(ctx, transferData, source, domain.AdjustmentReasonTransferOut)
+		if err != nil {
+			return err
+		}
+
+		// lots shipped are recorded under transfer reference, destination recreates them on receive.
+		_, err = s.allocateLots(ctx, transfer.UserID, transfer.SkuID, transfer.FromLocation, transfer.Quantity,
+			domain.AdjustmentReasonTransferOut, fmt.Sprintf("transfer:%d", transferData.ID),
+		)
+		if err != nil {
+			return err
+		}
+
+		transferResult.Transfer = transferData.ToDomain()
+		transferResult.ChangedItems = append(transferResult.ChangedItems, source.ToDomain().StockItem)
+
+		if !receiveImmediately {
+			return nil
+		}
+
+		receivedResult, err := s.receiveStockTransfer(ctx, transfer.UserID, transferData.ID)
+		if err != nil {
+			return err
+		}
+
+		transferResult.Transfer = receivedResult.Transfer
+		transferResult.ChangedItems = append(transferResult.ChangedItems, receivedResult.ChangedItems...)
+
+		return nil
+	})
+	if err != nil {
+		return domain.StockTransferResult{}, quantityOutOfRange(err)
+	}
+
+	s.publishStockItemsChanged(transferResult.ChangedItems)
+
+	return transferResult, nil
+}
+
+func (s *stockServiceRepository) ReceiveStockTransfer(
+	ctx context.Context,
+	userID domain.UserID,
+	transferID domain.TransferID,
+) (domain.StockTransferResult, error) {
+	var transferResult domain.StockTransferResult
+
+	err := s.psqlDB.WithTx(ctx, defaultTxOptions, func(ctx context.Context) error {
+		var err error
+
+		transferResult, err = s.receiveStockTransfer(ctx, userID, int64(transferID))
+
+		return err
+	})
+	if err != nil {
+		return domain.StockTransferResult{}, quantityOutOfRange(err)
+	}
+
+	s.publishStockItemsChanged(transferResult.ChangedItems)
+
+	return transferResult, nil
+}
+
+func (s *stockServiceRepository) GetStockTransfer(
+	ctx context.Context,
+	userID domain.UserID,
+	transferID domain.TransferID,
+) (domain.StockTransfer, error) {
+	var transferData StockTransferData
+
+	err := s.psqlDB.Get(ctx, &transferData, `
+		SELECT `+stockTransferColumns+`
+		FROM stock_transfers
+		WHERE id = $1 AND user_id = $2`,
+		transferID, userID,
+	)
+	if err != nil {
+		if pgxscan.NotFound(err) {
+			return domain.StockTransfer{}, domain.ErrStockTransferNotFound
+		}
+
+		return domain.StockTransfer{}, err
+	}
+
+	return transferData.ToDomain(), nil
+}
+
+func (s *stockServiceRepository) receiveStockTransfer(ctx context.Context, userID domain.UserID, transferID int64) (domain.StockTransferResult, error) {
+	var transferData StockTransferData
+
+	err := s.psqlDB.Get(ctx, &transferData, `
+		UPDATE stock_transfers
+		SET status = $1, received_at = NOW()
+		WHERE id = $2 AND user_id = $3 AND status = $4
+		RETURNING `+stockTransferColumns,
+		domain.TransferStatusReceived, transferID, userID, domain.TransferStatusInTransit,
+	)
+	if err != nil {
+		if pgxscan.NotFound(err) {
+			return domain.StockTransferResult{}, s.transferRejectedReason(ctx, userID, transferID)
+		}
+
+		return domain.StockTransferResult{}, err
+	}
+
+	var destination AdjustedStockItemData
+
+	// destination inherits price of source location when it did not store this sku yet.
+	err = s.psqlDB.Get(ctx, &destination, `
+		INSERT INTO stock_items (user_id, sku_id, count, price, currency, location)
+		VALUES ($1, $2, $3, COALESCE((
+			SELECT price FROM stock_items
+			WHERE user_id = $1 AND sku_id = $2 AND location = $4 AND deleted_at IS NULL
+		), 0), COALESCE((
+			SELECT currency FROM stock_items
+			WHERE user_id = $1 AND sku_id = $2 AND location = $4 AND deleted_at IS NULL
+		), $6), $5)
+		ON CONFLICT (user_id, sku_id, location) WHERE deleted_at IS NULL DO UPDATE SET
+			count = stock_items.count + EXCLUDED.count,
+			updated_at = NOW()
+		RETURNING user_id, sku_id, count, price, currency, location, stock_level, version`,
+		transferData.UserID, transferData.SkuID, transferData.Quantity,
+		transferData.FromLocation, transferData.ToLocation, domain.DefaultCurrency,
+	)
+	if err != nil {
+		return domain.StockTransferResult{}, err
+	}
+
+	err = s.insertTransferMovement(ctx, transferData, destination, domain.AdjustmentReasonTransferIn)
+	if err != nil {
+		return domain.StockTransferResult{}, err
+	}
+
+	err = s.receiveTransferLots(ctx, transferData)
+	if err != nil {
+		return domain.StockTransferResult{}, err
+	}
+
+	err = s.trimLots(ctx, userID, domain.SKUID(transferData.SkuID), transferData.ToLocation,
+		destination.Quantity, domain.AdjustmentReasonTransferIn,
+	)
+	if err != nil {
+		return domain.StockTransferResult{}, err
+	}
+
+	return domain.StockTransferResult{
+		Transfer:     transferData.ToDomain(),
+		ChangedItems: []domain.StockItem{destination.ToDomain().StockItem},
+	}, nil
+}
+
+func (s *stockServiceRepository) insertTransferMovement(
+	ctx context.Context,
+	transferData StockTransferData,
+	stockItemData AdjustedStockItemData,
+	reason domain.AdjustmentReason,
+) error {
+	delta := transferData.Quantity
+	if reason == domain.AdjustmentReasonTransferOut {
+		delta = -delta
+	}
+
+	_, err := s.psqlDB.Exec(ctx, `
+		INSERT INTO stock_movements (user_id, sku_id, location, delta, quantity_after, reason, reference)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		stockItemData.UserID, stockItemData.SkuID, stockItemData.Location,
+		delta, stockItemData.Quantity, reason, fmt.Sprintf("transfer:%d", transferData.ID),
+	)
+
+	return err
+}
+
+// transferRejectedReason tells apart missing transfer from transfer that was already received.
+func (s *stockServiceRepository) transferRejectedReason(ctx context.Context, userID domain.UserID, transferID int64) error {
+	var exists bool
+
+	err := s.psqlDB.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM stock_transfers WHERE id = $1 AND user_id = $2
+		)`,
+		transferID, userID,
+	).Scan(&exists)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return domain.ErrStockTransferNotFound
+	}
+
+	return domain.ErrStockTransferAlreadyReceived
+}
+
+// publishStockItemsChanged publishes stock items changed by committed transaction.
+func (s *stockServiceRepository) publishStockItemsChanged(stockItems []domain.StockItem) {
+	changes := make([]domain.StockChange, 0, len(stockItems))
+	for _, stockItem := range stockItems {
+		changes = append(changes, domain.NewStockChange(stockItem))
+	}
+
+	s.changes.Publish(changes...)
+}
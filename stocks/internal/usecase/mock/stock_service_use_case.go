@@ -7,6 +7,7 @@ import (
 	"stocks/internal/domain"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
@@ -24,26 +25,299 @@ type StockServiceUseCaseMock struct {
 	beforeAddStockItemCounter uint64
 	AddStockItemMock          mStockServiceUseCaseMockAddStockItem
 
-	funcDeleteStockItem          func(ctx context.Context, userID domain.UserID, skuID domain.SKUID) (err error)
+	funcAdjustStock          func(ctx context.Context, adjustment domain.StockAdjustment) (s1 domain.StockAdjustmentResult, err error)
+	funcAdjustStockOrigin    string
+	inspectFuncAdjustStock   func(ctx context.Context, adjustment domain.StockAdjustment)
+	afterAdjustStockCounter  uint64
+	beforeAdjustStockCounter uint64
+	AdjustStockMock          mStockServiceUseCaseMockAdjustStock
+
+	funcApplyScheduledPriceChanges          func(ctx context.Context) (err error)
+	funcApplyScheduledPriceChangesOrigin    string
+	inspectFuncApplyScheduledPriceChanges   func(ctx context.Context)
+	afterApplyScheduledPriceChangesCounter  uint64
+	beforeApplyScheduledPriceChangesCounter uint64
+	ApplyScheduledPriceChangesMock          mStockServiceUseCaseMockApplyScheduledPriceChanges
+
+	funcApproveCycleCount          func(ctx context.Context, cycleCountID domain.CycleCountID, approvedBy domain.UserID) (c2 domain.CycleCount, err error)
+	funcApproveCycleCountOrigin    string
+	inspectFuncApproveCycleCount   func(ctx context.Context, cycleCountID domain.CycleCountID, approvedBy domain.UserID)
+	afterApproveCycleCountCounter  uint64
+	beforeApproveCycleCountCounter uint64
+	ApproveCycleCountMock          mStockServiceUseCaseMockApproveCycleCount
+
+	funcCreatePurchaseOrder          func(ctx context.Context, purchaseOrder domain.PurchaseOrder) (p1 domain.PurchaseOrder, err error)
+	funcCreatePurchaseOrderOrigin    string
+	inspectFuncCreatePurchaseOrder   func(ctx context.Context, purchaseOrder domain.PurchaseOrder)
+	afterCreatePurchaseOrderCounter  uint64
+	beforeCreatePurchaseOrderCounter uint64
+	CreatePurchaseOrderMock          mStockServiceUseCaseMockCreatePurchaseOrder
+
+	funcCreateSupplier          func(ctx context.Context, supplier domain.Supplier) (s1 domain.Supplier, err error)
+	funcCreateSupplierOrigin    string
+	inspectFuncCreateSupplier   func(ctx context.Context, supplier domain.Supplier)
+	afterCreateSupplierCounter  uint64
+	beforeCreateSupplierCounter uint64
+	CreateSupplierMock          mStockServiceUseCaseMockCreateSupplier
+
+	funcCreateVariantGroup          func(ctx context.Context, group domain.VariantGroup) (v1 domain.VariantGroup, err error)
+	funcCreateVariantGroupOrigin    string
+	inspectFuncCreateVariantGroup   func(ctx context.Context, group domain.VariantGroup)
+	afterCreateVariantGroupCounter  uint64
+	beforeCreateVariantGroupCounter uint64
+	CreateVariantGroupMock          mStockServiceUseCaseMockCreateVariantGroup
+
+	funcDeleteStockItem          func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, deletedBy domain.UserID) (err error)
 	funcDeleteStockItemOrigin    string
-	inspectFuncDeleteStockItem   func(ctx context.Context, userID domain.UserID, skuID domain.SKUID)
+	inspectFuncDeleteStockItem   func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, deletedBy domain.UserID)
 	afterDeleteStockItemCounter  uint64
 	beforeDeleteStockItemCounter uint64
 	DeleteStockItemMock          mStockServiceUseCaseMockDeleteStockItem
 
-	funcGetStockItemBySKU          func(ctx context.Context, skuID domain.SKUID) (s1 domain.StockItem, err error)
+	funcExpireStockLots          func(ctx context.Context) (err error)
+	funcExpireStockLotsOrigin    string
+	inspectFuncExpireStockLots   func(ctx context.Context)
+	afterExpireStockLotsCounter  uint64
+	beforeExpireStockLotsCounter uint64
+	ExpireStockLotsMock          mStockServiceUseCaseMockExpireStockLots
+
+	funcGetBundle          func(ctx context.Context, skuID domain.SKUID) (b1 domain.Bundle, err error)
+	funcGetBundleOrigin    string
+	inspectFuncGetBundle   func(ctx context.Context, skuID domain.SKUID)
+	afterGetBundleCounter  uint64
+	beforeGetBundleCounter uint64
+	GetBundleMock          mStockServiceUseCaseMockGetBundle
+
+	funcGetInventoryValuation          func(ctx context.Context, filter domain.InventoryValuationFilter, send func(row domain.InventoryValuationRow) error) (err error)
+	funcGetInventoryValuationOrigin    string
+	inspectFuncGetInventoryValuation   func(ctx context.Context, filter domain.InventoryValuationFilter, send func(row domain.InventoryValuationRow) error)
+	afterGetInventoryValuationCounter  uint64
+	beforeGetInventoryValuationCounter uint64
+	GetInventoryValuationMock          mStockServiceUseCaseMockGetInventoryValuation
+
+	funcGetPriceHistory          func(ctx context.Context, filter domain.PriceHistoryFilter) (p1 domain.PriceHistory, err error)
+	funcGetPriceHistoryOrigin    string
+	inspectFuncGetPriceHistory   func(ctx context.Context, filter domain.PriceHistoryFilter)
+	afterGetPriceHistoryCounter  uint64
+	beforeGetPriceHistoryCounter uint64
+	GetPriceHistoryMock          mStockServiceUseCaseMockGetPriceHistory
+
+	funcGetPriceTiers          func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) (pa1 []domain.PriceTier, err error)
+	funcGetPriceTiersOrigin    string
+	inspectFuncGetPriceTiers   func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string)
+	afterGetPriceTiersCounter  uint64
+	beforeGetPriceTiersCounter uint64
+	GetPriceTiersMock          mStockServiceUseCaseMockGetPriceTiers
+
+	funcGetPurchaseOrder          func(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) (p1 domain.PurchaseOrder, err error)
+	funcGetPurchaseOrderOrigin    string
+	inspectFuncGetPurchaseOrder   func(ctx context.Context, purchaseOrderID domain.PurchaseOrderID)
+	afterGetPurchaseOrderCounter  uint64
+	beforeGetPurchaseOrderCounter uint64
+	GetPurchaseOrderMock          mStockServiceUseCaseMockGetPurchaseOrder
+
+	funcGetReorderSuggestions          func(ctx context.Context, filter domain.ReorderFilter) (ra1 []domain.ReorderSuggestion, err error)
+	funcGetReorderSuggestionsOrigin    string
+	inspectFuncGetReorderSuggestions   func(ctx context.Context, filter domain.ReorderFilter)
+	afterGetReorderSuggestionsCounter  uint64
+	beforeGetReorderSuggestionsCounter uint64
+	GetReorderSuggestionsMock          mStockServiceUseCaseMockGetReorderSuggestions
+
+	funcGetStockItemBySKU          func(ctx context.Context, filter domain.OfferFilter) (s1 domain.StockOffers, err error)
 	funcGetStockItemBySKUOrigin    string
-	inspectFuncGetStockItemBySKU   func(ctx context.Context, skuID domain.SKUID)
+	inspectFuncGetStockItemBySKU   func(ctx context.Context, filter domain.OfferFilter)
 	afterGetStockItemBySKUCounter  uint64
 	beforeGetStockItemBySKUCounter uint64
 	GetStockItemBySKUMock          mStockServiceUseCaseMockGetStockItemBySKU
 
+	funcGetStockItemsBySKUs          func(ctx context.Context, filters []domain.OfferFilter) (sa1 []domain.StockOffers, err error)
+	funcGetStockItemsBySKUsOrigin    string
+	inspectFuncGetStockItemsBySKUs   func(ctx context.Context, filters []domain.OfferFilter)
+	afterGetStockItemsBySKUsCounter  uint64
+	beforeGetStockItemsBySKUsCounter uint64
+	GetStockItemsBySKUsMock          mStockServiceUseCaseMockGetStockItemsBySKUs
+
+	funcGetTransfer          func(ctx context.Context, userID domain.UserID, transferID domain.TransferID) (s1 domain.StockTransfer, err error)
+	funcGetTransferOrigin    string
+	inspectFuncGetTransfer   func(ctx context.Context, userID domain.UserID, transferID domain.TransferID)
+	afterGetTransferCounter  uint64
+	beforeGetTransferCounter uint64
+	GetTransferMock          mStockServiceUseCaseMockGetTransfer
+
+	funcGetVariantGroup          func(ctx context.Context, groupID domain.VariantGroupID) (v1 domain.VariantGroup, err error)
+	funcGetVariantGroupOrigin    string
+	inspectFuncGetVariantGroup   func(ctx context.Context, groupID domain.VariantGroupID)
+	afterGetVariantGroupCounter  uint64
+	beforeGetVariantGroupCounter uint64
+	GetVariantGroupMock          mStockServiceUseCaseMockGetVariantGroup
+
+	funcListExpiringLots          func(ctx context.Context, filter domain.ExpiringLotsFilter) (p1 domain.PaginatedResponse[domain.StockLot], err error)
+	funcListExpiringLotsOrigin    string
+	inspectFuncListExpiringLots   func(ctx context.Context, filter domain.ExpiringLotsFilter)
+	afterListExpiringLotsCounter  uint64
+	beforeListExpiringLotsCounter uint64
+	ListExpiringLotsMock          mStockServiceUseCaseMockListExpiringLots
+
+	funcListLowStock          func(ctx context.Context, filter domain.LowStockFilter) (p1 domain.PaginatedResponse[domain.LowStockItem], err error)
+	funcListLowStockOrigin    string
+	inspectFuncListLowStock   func(ctx context.Context, filter domain.LowStockFilter)
+	afterListLowStockCounter  uint64
+	beforeListLowStockCounter uint64
+	ListLowStockMock          mStockServiceUseCaseMockListLowStock
+
 	funcListStockItems          func(ctx context.Context, filter domain.Filter) (p1 domain.PaginatedResponse[domain.StockItem], err error)
 	funcListStockItemsOrigin    string
 	inspectFuncListStockItems   func(ctx context.Context, filter domain.Filter)
 	afterListStockItemsCounter  uint64
 	beforeListStockItemsCounter uint64
 	ListStockItemsMock          mStockServiceUseCaseMockListStockItems
+
+	funcListSuppliers          func(ctx context.Context, userID domain.UserID) (sa1 []domain.Supplier, err error)
+	funcListSuppliersOrigin    string
+	inspectFuncListSuppliers   func(ctx context.Context, userID domain.UserID)
+	afterListSuppliersCounter  uint64
+	beforeListSuppliersCounter uint64
+	ListSuppliersMock          mStockServiceUseCaseMockListSuppliers
+
+	funcOpenCycleCount          func(ctx context.Context, cycleCount domain.CycleCount) (c2 domain.CycleCount, err error)
+	funcOpenCycleCountOrigin    string
+	inspectFuncOpenCycleCount   func(ctx context.Context, cycleCount domain.CycleCount)
+	afterOpenCycleCountCounter  uint64
+	beforeOpenCycleCountCounter uint64
+	OpenCycleCountMock          mStockServiceUseCaseMockOpenCycleCount
+
+	funcPlacePurchaseOrder          func(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) (p1 domain.PurchaseOrder, err error)
+	funcPlacePurchaseOrderOrigin    string
+	inspectFuncPlacePurchaseOrder   func(ctx context.Context, purchaseOrderID domain.PurchaseOrderID)
+	afterPlacePurchaseOrderCounter  uint64
+	beforePlacePurchaseOrderCounter uint64
+	PlacePurchaseOrderMock          mStockServiceUseCaseMockPlacePurchaseOrder
+
+	funcPurgeDeletedStockItems          func(ctx context.Context, retention time.Duration) (err error)
+	funcPurgeDeletedStockItemsOrigin    string
+	inspectFuncPurgeDeletedStockItems   func(ctx context.Context, retention time.Duration)
+	afterPurgeDeletedStockItemsCounter  uint64
+	beforePurgeDeletedStockItemsCounter uint64
+	PurgeDeletedStockItemsMock          mStockServiceUseCaseMockPurgeDeletedStockItems
+
+	funcReceivePurchaseOrder          func(ctx context.Context, receipt domain.PurchaseOrderReceipt) (p1 domain.PurchaseOrder, err error)
+	funcReceivePurchaseOrderOrigin    string
+	inspectFuncReceivePurchaseOrder   func(ctx context.Context, receipt domain.PurchaseOrderReceipt)
+	afterReceivePurchaseOrderCounter  uint64
+	beforeReceivePurchaseOrderCounter uint64
+	ReceivePurchaseOrderMock          mStockServiceUseCaseMockReceivePurchaseOrder
+
+	funcReceiveTransfer          func(ctx context.Context, userID domain.UserID, transferID domain.TransferID) (s1 domain.StockTransfer, err error)
+	funcReceiveTransferOrigin    string
+	inspectFuncReceiveTransfer   func(ctx context.Context, userID domain.UserID, transferID domain.TransferID)
+	afterReceiveTransferCounter  uint64
+	beforeReceiveTransferCounter uint64
+	ReceiveTransferMock          mStockServiceUseCaseMockReceiveTransfer
+
+	funcRecordCartDemand          func(ctx context.Context, demand domain.CartDemand) (err error)
+	funcRecordCartDemandOrigin    string
+	inspectFuncRecordCartDemand   func(ctx context.Context, demand domain.CartDemand)
+	afterRecordCartDemandCounter  uint64
+	beforeRecordCartDemandCounter uint64
+	RecordCartDemandMock          mStockServiceUseCaseMockRecordCartDemand
+
+	funcRestoreStockItem          func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) (s1 domain.StockItem, err error)
+	funcRestoreStockItemOrigin    string
+	inspectFuncRestoreStockItem   func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string)
+	afterRestoreStockItemCounter  uint64
+	beforeRestoreStockItemCounter uint64
+	RestoreStockItemMock          mStockServiceUseCaseMockRestoreStockItem
+
+	funcReviewCycleCount          func(ctx context.Context, cycleCountID domain.CycleCountID) (c2 domain.CycleCount, err error)
+	funcReviewCycleCountOrigin    string
+	inspectFuncReviewCycleCount   func(ctx context.Context, cycleCountID domain.CycleCountID)
+	afterReviewCycleCountCounter  uint64
+	beforeReviewCycleCountCounter uint64
+	ReviewCycleCountMock          mStockServiceUseCaseMockReviewCycleCount
+
+	funcSchedulePriceChange          func(ctx context.Context, priceChange domain.ScheduledPriceChange) (s1 domain.ScheduledPriceChange, err error)
+	funcSchedulePriceChangeOrigin    string
+	inspectFuncSchedulePriceChange   func(ctx context.Context, priceChange domain.ScheduledPriceChange)
+	afterSchedulePriceChangeCounter  uint64
+	beforeSchedulePriceChangeCounter uint64
+	SchedulePriceChangeMock          mStockServiceUseCaseMockSchedulePriceChange
+
+	funcSearchSKUs          func(ctx context.Context, filter domain.SKUSearchFilter) (s1 domain.SKUSearchResponse, err error)
+	funcSearchSKUsOrigin    string
+	inspectFuncSearchSKUs   func(ctx context.Context, filter domain.SKUSearchFilter)
+	afterSearchSKUsCounter  uint64
+	beforeSearchSKUsCounter uint64
+	SearchSKUsMock          mStockServiceUseCaseMockSearchSKUs
+
+	funcSetAttributeSchema          func(ctx context.Context, schema domain.AttributeSchema) (err error)
+	funcSetAttributeSchemaOrigin    string
+	inspectFuncSetAttributeSchema   func(ctx context.Context, schema domain.AttributeSchema)
+	afterSetAttributeSchemaCounter  uint64
+	beforeSetAttributeSchemaCounter uint64
+	SetAttributeSchemaMock          mStockServiceUseCaseMockSetAttributeSchema
+
+	funcSetBackorderSettings          func(ctx context.Context, settings domain.BackorderSettings) (err error)
+	funcSetBackorderSettingsOrigin    string
+	inspectFuncSetBackorderSettings   func(ctx context.Context, settings domain.BackorderSettings)
+	afterSetBackorderSettingsCounter  uint64
+	beforeSetBackorderSettingsCounter uint64
+	SetBackorderSettingsMock          mStockServiceUseCaseMockSetBackorderSettings
+
+	funcSetBundle          func(ctx context.Context, bundle domain.Bundle) (err error)
+	funcSetBundleOrigin    string
+	inspectFuncSetBundle   func(ctx context.Context, bundle domain.Bundle)
+	afterSetBundleCounter  uint64
+	beforeSetBundleCounter uint64
+	SetBundleMock          mStockServiceUseCaseMockSetBundle
+
+	funcSetPriceTiers          func(ctx context.Context, priceList domain.PriceList) (pa1 []domain.PriceTier, err error)
+	funcSetPriceTiersOrigin    string
+	inspectFuncSetPriceTiers   func(ctx context.Context, priceList domain.PriceList)
+	afterSetPriceTiersCounter  uint64
+	beforeSetPriceTiersCounter uint64
+	SetPriceTiersMock          mStockServiceUseCaseMockSetPriceTiers
+
+	funcSetStockThreshold          func(ctx context.Context, threshold domain.StockThreshold) (err error)
+	funcSetStockThresholdOrigin    string
+	inspectFuncSetStockThreshold   func(ctx context.Context, threshold domain.StockThreshold)
+	afterSetStockThresholdCounter  uint64
+	beforeSetStockThresholdCounter uint64
+	SetStockThresholdMock          mStockServiceUseCaseMockSetStockThreshold
+
+	funcSubmitCycleCounts          func(ctx context.Context, submission domain.CycleCountSubmission) (c2 domain.CycleCount, err error)
+	funcSubmitCycleCountsOrigin    string
+	inspectFuncSubmitCycleCounts   func(ctx context.Context, submission domain.CycleCountSubmission)
+	afterSubmitCycleCountsCounter  uint64
+	beforeSubmitCycleCountsCounter uint64
+	SubmitCycleCountsMock          mStockServiceUseCaseMockSubmitCycleCounts
+
+	funcTransferStock          func(ctx context.Context, transfer domain.StockTransfer, receiveImmediately bool) (s1 domain.StockTransfer, err error)
+	funcTransferStockOrigin    string
+	inspectFuncTransferStock   func(ctx context.Context, transfer domain.StockTransfer, receiveImmediately bool)
+	afterTransferStockCounter  uint64
+	beforeTransferStockCounter uint64
+	TransferStockMock          mStockServiceUseCaseMockTransferStock
+
+	funcUpdateSKUAttributes          func(ctx context.Context, update domain.SKUAttributesUpdate) (s1 domain.SKU, err error)
+	funcUpdateSKUAttributesOrigin    string
+	inspectFuncUpdateSKUAttributes   func(ctx context.Context, update domain.SKUAttributesUpdate)
+	afterUpdateSKUAttributesCounter  uint64
+	beforeUpdateSKUAttributesCounter uint64
+	UpdateSKUAttributesMock          mStockServiceUseCaseMockUpdateSKUAttributes
+
+	funcUpdateStockItem          func(ctx context.Context, update domain.StockItemUpdate) (s1 domain.StockItem, err error)
+	funcUpdateStockItemOrigin    string
+	inspectFuncUpdateStockItem   func(ctx context.Context, update domain.StockItemUpdate)
+	afterUpdateStockItemCounter  uint64
+	beforeUpdateStockItemCounter uint64
+	UpdateStockItemMock          mStockServiceUseCaseMockUpdateStockItem
+
+	funcWatchStock          func(ctx context.Context, skuIDs []domain.SKUID, send func(change domain.StockChange) error) (err error)
+	funcWatchStockOrigin    string
+	inspectFuncWatchStock   func(ctx context.Context, skuIDs []domain.SKUID, send func(change domain.StockChange) error)
+	afterWatchStockCounter  uint64
+	beforeWatchStockCounter uint64
+	WatchStockMock          mStockServiceUseCaseMockWatchStock
 }
 
 // NewStockServiceUseCaseMock returns a mock for mm_usecase.StockServiceUseCase
@@ -57,15 +331,132 @@ func NewStockServiceUseCaseMock(t minimock.Tester) *StockServiceUseCaseMock {
 	m.AddStockItemMock = mStockServiceUseCaseMockAddStockItem{mock: m}
 	m.AddStockItemMock.callArgs = []*StockServiceUseCaseMockAddStockItemParams{}
 
+	m.AdjustStockMock = mStockServiceUseCaseMockAdjustStock{mock: m}
+	m.AdjustStockMock.callArgs = []*StockServiceUseCaseMockAdjustStockParams{}
+
+	m.ApplyScheduledPriceChangesMock = mStockServiceUseCaseMockApplyScheduledPriceChanges{mock: m}
+	m.ApplyScheduledPriceChangesMock.callArgs = []*StockServiceUseCaseMockApplyScheduledPriceChangesParams{}
+
+	m.ApproveCycleCountMock = mStockServiceUseCaseMockApproveCycleCount{mock: m}
+	m.ApproveCycleCountMock.callArgs = []*StockServiceUseCaseMockApproveCycleCountParams{}
+
+	m.CreatePurchaseOrderMock = mStockServiceUseCaseMockCreatePurchaseOrder{mock: m}
+	m.CreatePurchaseOrderMock.callArgs = []*StockServiceUseCaseMockCreatePurchaseOrderParams{}
+
+	m.CreateSupplierMock = mStockServiceUseCaseMockCreateSupplier{mock: m}
+	m.CreateSupplierMock.callArgs = []*StockServiceUseCaseMockCreateSupplierParams{}
+
+	m.CreateVariantGroupMock = mStockServiceUseCaseMockCreateVariantGroup{mock: m}
+	m.CreateVariantGroupMock.callArgs = []*StockServiceUseCaseMockCreateVariantGroupParams{}
+
 	m.DeleteStockItemMock = mStockServiceUseCaseMockDeleteStockItem{mock: m}
 	m.DeleteStockItemMock.callArgs = []*StockServiceUseCaseMockDeleteStockItemParams{}
 
+	m.ExpireStockLotsMock = mStockServiceUseCaseMockExpireStockLots{mock: m}
+	m.ExpireStockLotsMock.callArgs = []*StockServiceUseCaseMockExpireStockLotsParams{}
+
+	m.GetBundleMock = mStockServiceUseCaseMockGetBundle{mock: m}
+	m.GetBundleMock.callArgs = []*StockServiceUseCaseMockGetBundleParams{}
+
+	m.GetInventoryValuationMock = mStockServiceUseCaseMockGetInventoryValuation{mock: m}
+	m.GetInventoryValuationMock.callArgs = []*StockServiceUseCaseMockGetInventoryValuationParams{}
+
+	m.GetPriceHistoryMock = mStockServiceUseCaseMockGetPriceHistory{mock: m}
+	m.GetPriceHistoryMock.callArgs = []*StockServiceUseCaseMockGetPriceHistoryParams{}
+
+	m.GetPriceTiersMock = mStockServiceUseCaseMockGetPriceTiers{mock: m}
+	m.GetPriceTiersMock.callArgs = []*StockServiceUseCaseMockGetPriceTiersParams{}
+
+	m.GetPurchaseOrderMock = mStockServiceUseCaseMockGetPurchaseOrder{mock: m}
+	m.GetPurchaseOrderMock.callArgs = []*StockServiceUseCaseMockGetPurchaseOrderParams{}
+
+	m.GetReorderSuggestionsMock = mStockServiceUseCaseMockGetReorderSuggestions{mock: m}
+	m.GetReorderSuggestionsMock.callArgs = []*StockServiceUseCaseMockGetReorderSuggestionsParams{}
+
 	m.GetStockItemBySKUMock = mStockServiceUseCaseMockGetStockItemBySKU{mock: m}
 	m.GetStockItemBySKUMock.callArgs = []*StockServiceUseCaseMockGetStockItemBySKUParams{}
 
+	m.GetStockItemsBySKUsMock = mStockServiceUseCaseMockGetStockItemsBySKUs{mock: m}
+	m.GetStockItemsBySKUsMock.callArgs = []*StockServiceUseCaseMockGetStockItemsBySKUsParams{}
+
+	m.GetTransferMock = mStockServiceUseCaseMockGetTransfer{mock: m}
+	m.GetTransferMock.callArgs = []*StockServiceUseCaseMockGetTransferParams{}
+
+	m.GetVariantGroupMock = mStockServiceUseCaseMockGetVariantGroup{mock: m}
+	m.GetVariantGroupMock.callArgs = []*StockServiceUseCaseMockGetVariantGroupParams{}
+
+	m.ListExpiringLotsMock = mStockServiceUseCaseMockListExpiringLots{mock: m}
+	m.ListExpiringLotsMock.callArgs = []*StockServiceUseCaseMockListExpiringLotsParams{}
+
+	m.ListLowStockMock = mStockServiceUseCaseMockListLowStock{mock: m}
+	m.ListLowStockMock.callArgs = []*StockServiceUseCaseMockListLowStockParams{}
+
 	m.ListStockItemsMock = mStockServiceUseCaseMockListStockItems{mock: m}
 	m.ListStockItemsMock.callArgs = []*StockServiceUseCaseMockListStockItemsParams{}
 
+	m.ListSuppliersMock = mStockServiceUseCaseMockListSuppliers{mock: m}
+	m.ListSuppliersMock.callArgs = []*StockServiceUseCaseMockListSuppliersParams{}
+
+	m.OpenCycleCountMock = mStockServiceUseCaseMockOpenCycleCount{mock: m}
+	m.OpenCycleCountMock.callArgs = []*StockServiceUseCaseMockOpenCycleCountParams{}
+
+	m.PlacePurchaseOrderMock = mStockServiceUseCaseMockPlacePurchaseOrder{mock: m}
+	m.PlacePurchaseOrderMock.callArgs = []*StockServiceUseCaseMockPlacePurchaseOrderParams{}
+
+	m.PurgeDeletedStockItemsMock = mStockServiceUseCaseMockPurgeDeletedStockItems{mock: m}
+	m.PurgeDeletedStockItemsMock.callArgs = []*StockServiceUseCaseMockPurgeDeletedStockItemsParams{}
+
+	m.ReceivePurchaseOrderMock = mStockServiceUseCaseMockReceivePurchaseOrder{mock: m}
+	m.ReceivePurchaseOrderMock.callArgs = []*StockServiceUseCaseMockReceivePurchaseOrderParams{}
+
+	m.ReceiveTransferMock = mStockServiceUseCaseMockReceiveTransfer{mock: m}
+	m.ReceiveTransferMock.callArgs = []*StockServiceUseCaseMockReceiveTransferParams{}
+
+	m.RecordCartDemandMock = mStockServiceUseCaseMockRecordCartDemand{mock: m}
+	m.RecordCartDemandMock.callArgs = []*StockServiceUseCaseMockRecordCartDemandParams{}
+
+	m.RestoreStockItemMock = mStockServiceUseCaseMockRestoreStockItem{mock: m}
+	m.RestoreStockItemMock.callArgs = []*StockServiceUseCaseMockRestoreStockItemParams{}
+
+	m.ReviewCycleCountMock = mStockServiceUseCaseMockReviewCycleCount{mock: m}
+	m.ReviewCycleCountMock.callArgs = []*StockServiceUseCaseMockReviewCycleCountParams{}
+
+	m.SchedulePriceChangeMock = mStockServiceUseCaseMockSchedulePriceChange{mock: m}
+	m.SchedulePriceChangeMock.callArgs = []*StockServiceUseCaseMockSchedulePriceChangeParams{}
+
+	m.SearchSKUsMock = mStockServiceUseCaseMockSearchSKUs{mock: m}
+	m.SearchSKUsMock.callArgs = []*StockServiceUseCaseMockSearchSKUsParams{}
+
+	m.SetAttributeSchemaMock = mStockServiceUseCaseMockSetAttributeSchema{mock: m}
+	m.SetAttributeSchemaMock.callArgs = []*StockServiceUseCaseMockSetAttributeSchemaParams{}
+
+	m.SetBackorderSettingsMock = mStockServiceUseCaseMockSetBackorderSettings{mock: m}
+	m.SetBackorderSettingsMock.callArgs = []*StockServiceUseCaseMockSetBackorderSettingsParams{}
+
+	m.SetBundleMock = mStockServiceUseCaseMockSetBundle{mock: m}
+	m.SetBundleMock.callArgs = []*StockServiceUseCaseMockSetBundleParams{}
+
+	m.SetPriceTiersMock = mStockServiceUseCaseMockSetPriceTiers{mock: m}
+	m.SetPriceTiersMock.callArgs = []*StockServiceUseCaseMockSetPriceTiersParams{}
+
+	m.SetStockThresholdMock = mStockServiceUseCaseMockSetStockThreshold{mock: m}
+	m.SetStockThresholdMock.callArgs = []*StockServiceUseCaseMockSetStockThresholdParams{}
+
+	m.SubmitCycleCountsMock = mStockServiceUseCaseMockSubmitCycleCounts{mock: m}
+	m.SubmitCycleCountsMock.callArgs = []*StockServiceUseCaseMockSubmitCycleCountsParams{}
+
+	m.TransferStockMock = mStockServiceUseCaseMockTransferStock{mock: m}
+	m.TransferStockMock.callArgs = []*StockServiceUseCaseMockTransferStockParams{}
+
+	m.UpdateSKUAttributesMock = mStockServiceUseCaseMockUpdateSKUAttributes{mock: m}
+	m.UpdateSKUAttributesMock.callArgs = []*StockServiceUseCaseMockUpdateSKUAttributesParams{}
+
+	m.UpdateStockItemMock = mStockServiceUseCaseMockUpdateStockItem{mock: m}
+	m.UpdateStockItemMock.callArgs = []*StockServiceUseCaseMockUpdateStockItemParams{}
+
+	m.WatchStockMock = mStockServiceUseCaseMockWatchStock{mock: m}
+	m.WatchStockMock.callArgs = []*StockServiceUseCaseMockWatchStockParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -413,55 +804,53 @@ func (m *StockServiceUseCaseMock) MinimockAddStockItemInspect() {
 	}
 }
 
-type mStockServiceUseCaseMockDeleteStockItem struct {
+type mStockServiceUseCaseMockAdjustStock struct {
 	optional           bool
 	mock               *StockServiceUseCaseMock
-	defaultExpectation *StockServiceUseCaseMockDeleteStockItemExpectation
-	expectations       []*StockServiceUseCaseMockDeleteStockItemExpectation
+	defaultExpectation *StockServiceUseCaseMockAdjustStockExpectation
+	expectations       []*StockServiceUseCaseMockAdjustStockExpectation
 
-	callArgs []*StockServiceUseCaseMockDeleteStockItemParams
+	callArgs []*StockServiceUseCaseMockAdjustStockParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// StockServiceUseCaseMockDeleteStockItemExpectation specifies expectation struct of the StockServiceUseCase.DeleteStockItem
-type StockServiceUseCaseMockDeleteStockItemExpectation struct {
+// StockServiceUseCaseMockAdjustStockExpectation specifies expectation struct of the StockServiceUseCase.AdjustStock
+type StockServiceUseCaseMockAdjustStockExpectation struct {
 	mock               *StockServiceUseCaseMock
-	params             *StockServiceUseCaseMockDeleteStockItemParams
-	paramPtrs          *StockServiceUseCaseMockDeleteStockItemParamPtrs
-	expectationOrigins StockServiceUseCaseMockDeleteStockItemExpectationOrigins
-	results            *StockServiceUseCaseMockDeleteStockItemResults
+	params             *StockServiceUseCaseMockAdjustStockParams
+	paramPtrs          *StockServiceUseCaseMockAdjustStockParamPtrs
+	expectationOrigins StockServiceUseCaseMockAdjustStockExpectationOrigins
+	results            *StockServiceUseCaseMockAdjustStockResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// StockServiceUseCaseMockDeleteStockItemParams contains parameters of the StockServiceUseCase.DeleteStockItem
-type StockServiceUseCaseMockDeleteStockItemParams struct {
-	ctx    context.Context
-	userID domain.UserID
-	skuID  domain.SKUID
+// StockServiceUseCaseMockAdjustStockParams contains parameters of the StockServiceUseCase.AdjustStock
+type StockServiceUseCaseMockAdjustStockParams struct {
+	ctx        context.Context
+	adjustment domain.StockAdjustment
 }
 
-// StockServiceUseCaseMockDeleteStockItemParamPtrs contains pointers to parameters of the StockServiceUseCase.DeleteStockItem
-type StockServiceUseCaseMockDeleteStockItemParamPtrs struct {
-	ctx    *context.Context
-	userID *domain.UserID
-	skuID  *domain.SKUID
+// StockServiceUseCaseMockAdjustStockParamPtrs contains pointers to parameters of the StockServiceUseCase.AdjustStock
+type StockServiceUseCaseMockAdjustStockParamPtrs struct {
+	ctx        *context.Context
+	adjustment *domain.StockAdjustment
 }
 
-// StockServiceUseCaseMockDeleteStockItemResults contains results of the StockServiceUseCase.DeleteStockItem
-type StockServiceUseCaseMockDeleteStockItemResults struct {
+// StockServiceUseCaseMockAdjustStockResults contains results of the StockServiceUseCase.AdjustStock
+type StockServiceUseCaseMockAdjustStockResults struct {
+	s1  domain.StockAdjustmentResult
 	err error
 }
 
-// StockServiceUseCaseMockDeleteStockItemOrigins contains origins of expectations of the StockServiceUseCase.DeleteStockItem
-type StockServiceUseCaseMockDeleteStockItemExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originUserID string
-	originSkuID  string
+// StockServiceUseCaseMockAdjustStockOrigins contains origins of expectations of the StockServiceUseCase.AdjustStock
+type StockServiceUseCaseMockAdjustStockExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originAdjustment string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -469,370 +858,13988 @@ type StockServiceUseCaseMockDeleteStockItemExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteStockItem *mStockServiceUseCaseMockDeleteStockItem) Optional() *mStockServiceUseCaseMockDeleteStockItem {
-	mmDeleteStockItem.optional = true
-	return mmDeleteStockItem
+func (mmAdjustStock *mStockServiceUseCaseMockAdjustStock) Optional() *mStockServiceUseCaseMockAdjustStock {
+	mmAdjustStock.optional = true
+	return mmAdjustStock
 }
 
-// Expect sets up expected params for StockServiceUseCase.DeleteStockItem
-func (mmDeleteStockItem *mStockServiceUseCaseMockDeleteStockItem) Expect(ctx context.Context, userID domain.UserID, skuID domain.SKUID) *mStockServiceUseCaseMockDeleteStockItem {
-	if mmDeleteStockItem.mock.funcDeleteStockItem != nil {
-		mmDeleteStockItem.mock.t.Fatalf("StockServiceUseCaseMock.DeleteStockItem mock is already set by Set")
+// Expect sets up expected params for StockServiceUseCase.AdjustStock
+func (mmAdjustStock *mStockServiceUseCaseMockAdjustStock) Expect(ctx context.Context, adjustment domain.StockAdjustment) *mStockServiceUseCaseMockAdjustStock {
+	if mmAdjustStock.mock.funcAdjustStock != nil {
+		mmAdjustStock.mock.t.Fatalf("StockServiceUseCaseMock.AdjustStock mock is already set by Set")
 	}
 
-	if mmDeleteStockItem.defaultExpectation == nil {
-		mmDeleteStockItem.defaultExpectation = &StockServiceUseCaseMockDeleteStockItemExpectation{}
+	if mmAdjustStock.defaultExpectation == nil {
+		mmAdjustStock.defaultExpectation = &StockServiceUseCaseMockAdjustStockExpectation{}
 	}
 
-	if mmDeleteStockItem.defaultExpectation.paramPtrs != nil {
-		mmDeleteStockItem.mock.t.Fatalf("StockServiceUseCaseMock.DeleteStockItem mock is already set by ExpectParams functions")
+	if mmAdjustStock.defaultExpectation.paramPtrs != nil {
+		mmAdjustStock.mock.t.Fatalf("StockServiceUseCaseMock.AdjustStock mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteStockItem.defaultExpectation.params = &StockServiceUseCaseMockDeleteStockItemParams{ctx, userID, skuID}
-	mmDeleteStockItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteStockItem.expectations {
-		if minimock.Equal(e.params, mmDeleteStockItem.defaultExpectation.params) {
-			mmDeleteStockItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteStockItem.defaultExpectation.params)
+	mmAdjustStock.defaultExpectation.params = &StockServiceUseCaseMockAdjustStockParams{ctx, adjustment}
+	mmAdjustStock.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAdjustStock.expectations {
+		if minimock.Equal(e.params, mmAdjustStock.defaultExpectation.params) {
+			mmAdjustStock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAdjustStock.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteStockItem
-}
-
-// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.DeleteStockItem
-func (mmDeleteStockItem *mStockServiceUseCaseMockDeleteStockItem) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockDeleteStockItem {
-	if mmDeleteStockItem.mock.funcDeleteStockItem != nil {
-		mmDeleteStockItem.mock.t.Fatalf("StockServiceUseCaseMock.DeleteStockItem mock is already set by Set")
-	}
-
-	if mmDeleteStockItem.defaultExpectation == nil {
-		mmDeleteStockItem.defaultExpectation = &StockServiceUseCaseMockDeleteStockItemExpectation{}
-	}
-
-	if mmDeleteStockItem.defaultExpectation.params != nil {
-		mmDeleteStockItem.mock.t.Fatalf("StockServiceUseCaseMock.DeleteStockItem mock is already set by Expect")
-	}
-
-	if mmDeleteStockItem.defaultExpectation.paramPtrs == nil {
-		mmDeleteStockItem.defaultExpectation.paramPtrs = &StockServiceUseCaseMockDeleteStockItemParamPtrs{}
-	}
-	mmDeleteStockItem.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteStockItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
-
-	return mmDeleteStockItem
+	return mmAdjustStock
 }
 
-// ExpectUserIDParam2 sets up expected param userID for StockServiceUseCase.DeleteStockItem
-func (mmDeleteStockItem *mStockServiceUseCaseMockDeleteStockItem) ExpectUserIDParam2(userID domain.UserID) *mStockServiceUseCaseMockDeleteStockItem {
-	if mmDeleteStockItem.mock.funcDeleteStockItem != nil {
-		mmDeleteStockItem.mock.t.Fatalf("StockServiceUseCaseMock.DeleteStockItem mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.AdjustStock
+func (mmAdjustStock *mStockServiceUseCaseMockAdjustStock) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockAdjustStock {
+	if mmAdjustStock.mock.funcAdjustStock != nil {
+		mmAdjustStock.mock.t.Fatalf("StockServiceUseCaseMock.AdjustStock mock is already set by Set")
 	}
 
-	if mmDeleteStockItem.defaultExpectation == nil {
-		mmDeleteStockItem.defaultExpectation = &StockServiceUseCaseMockDeleteStockItemExpectation{}
+	if mmAdjustStock.defaultExpectation == nil {
+		mmAdjustStock.defaultExpectation = &StockServiceUseCaseMockAdjustStockExpectation{}
 	}
 
-	if mmDeleteStockItem.defaultExpectation.params != nil {
-		mmDeleteStockItem.mock.t.Fatalf("StockServiceUseCaseMock.DeleteStockItem mock is already set by Expect")
+	if mmAdjustStock.defaultExpectation.params != nil {
+		mmAdjustStock.mock.t.Fatalf("StockServiceUseCaseMock.AdjustStock mock is already set by Expect")
 	}
 
-	if mmDeleteStockItem.defaultExpectation.paramPtrs == nil {
-		mmDeleteStockItem.defaultExpectation.paramPtrs = &StockServiceUseCaseMockDeleteStockItemParamPtrs{}
+	if mmAdjustStock.defaultExpectation.paramPtrs == nil {
+		mmAdjustStock.defaultExpectation.paramPtrs = &StockServiceUseCaseMockAdjustStockParamPtrs{}
 	}
-	mmDeleteStockItem.defaultExpectation.paramPtrs.userID = &userID
-	mmDeleteStockItem.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmAdjustStock.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAdjustStock.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteStockItem
+	return mmAdjustStock
 }
 
-// ExpectSkuIDParam3 sets up expected param skuID for StockServiceUseCase.DeleteStockItem
-func (mmDeleteStockItem *mStockServiceUseCaseMockDeleteStockItem) ExpectSkuIDParam3(skuID domain.SKUID) *mStockServiceUseCaseMockDeleteStockItem {
-	if mmDeleteStockItem.mock.funcDeleteStockItem != nil {
-		mmDeleteStockItem.mock.t.Fatalf("StockServiceUseCaseMock.DeleteStockItem mock is already set by Set")
+// ExpectAdjustmentParam2 sets up expected param adjustment for StockServiceUseCase.AdjustStock
+func (mmAdjustStock *mStockServiceUseCaseMockAdjustStock) ExpectAdjustmentParam2(adjustment domain.StockAdjustment) *mStockServiceUseCaseMockAdjustStock {
+	if mmAdjustStock.mock.funcAdjustStock != nil {
+		mmAdjustStock.mock.t.Fatalf("StockServiceUseCaseMock.AdjustStock mock is already set by Set")
 	}
 
-	if mmDeleteStockItem.defaultExpectation == nil {
-		mmDeleteStockItem.defaultExpectation = &StockServiceUseCaseMockDeleteStockItemExpectation{}
+	if mmAdjustStock.defaultExpectation == nil {
+		mmAdjustStock.defaultExpectation = &StockServiceUseCaseMockAdjustStockExpectation{}
 	}
 
-	if mmDeleteStockItem.defaultExpectation.params != nil {
-		mmDeleteStockItem.mock.t.Fatalf("StockServiceUseCaseMock.DeleteStockItem mock is already set by Expect")
+	if mmAdjustStock.defaultExpectation.params != nil {
+		mmAdjustStock.mock.t.Fatalf("StockServiceUseCaseMock.AdjustStock mock is already set by Expect")
 	}
 
-	if mmDeleteStockItem.defaultExpectation.paramPtrs == nil {
-		mmDeleteStockItem.defaultExpectation.paramPtrs = &StockServiceUseCaseMockDeleteStockItemParamPtrs{}
+	if mmAdjustStock.defaultExpectation.paramPtrs == nil {
+		mmAdjustStock.defaultExpectation.paramPtrs = &StockServiceUseCaseMockAdjustStockParamPtrs{}
 	}
-	mmDeleteStockItem.defaultExpectation.paramPtrs.skuID = &skuID
-	mmDeleteStockItem.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+	mmAdjustStock.defaultExpectation.paramPtrs.adjustment = &adjustment
+	mmAdjustStock.defaultExpectation.expectationOrigins.originAdjustment = minimock.CallerInfo(1)
 
-	return mmDeleteStockItem
+	return mmAdjustStock
 }
 
-// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.DeleteStockItem
-func (mmDeleteStockItem *mStockServiceUseCaseMockDeleteStockItem) Inspect(f func(ctx context.Context, userID domain.UserID, skuID domain.SKUID)) *mStockServiceUseCaseMockDeleteStockItem {
-	if mmDeleteStockItem.mock.inspectFuncDeleteStockItem != nil {
-		mmDeleteStockItem.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.DeleteStockItem")
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.AdjustStock
+func (mmAdjustStock *mStockServiceUseCaseMockAdjustStock) Inspect(f func(ctx context.Context, adjustment domain.StockAdjustment)) *mStockServiceUseCaseMockAdjustStock {
+	if mmAdjustStock.mock.inspectFuncAdjustStock != nil {
+		mmAdjustStock.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.AdjustStock")
 	}
 
-	mmDeleteStockItem.mock.inspectFuncDeleteStockItem = f
+	mmAdjustStock.mock.inspectFuncAdjustStock = f
 
-	return mmDeleteStockItem
+	return mmAdjustStock
 }
 
-// Return sets up results that will be returned by StockServiceUseCase.DeleteStockItem
-func (mmDeleteStockItem *mStockServiceUseCaseMockDeleteStockItem) Return(err error) *StockServiceUseCaseMock {
-	if mmDeleteStockItem.mock.funcDeleteStockItem != nil {
-		mmDeleteStockItem.mock.t.Fatalf("StockServiceUseCaseMock.DeleteStockItem mock is already set by Set")
+// Return sets up results that will be returned by StockServiceUseCase.AdjustStock
+func (mmAdjustStock *mStockServiceUseCaseMockAdjustStock) Return(s1 domain.StockAdjustmentResult, err error) *StockServiceUseCaseMock {
+	if mmAdjustStock.mock.funcAdjustStock != nil {
+		mmAdjustStock.mock.t.Fatalf("StockServiceUseCaseMock.AdjustStock mock is already set by Set")
 	}
 
-	if mmDeleteStockItem.defaultExpectation == nil {
-		mmDeleteStockItem.defaultExpectation = &StockServiceUseCaseMockDeleteStockItemExpectation{mock: mmDeleteStockItem.mock}
+	if mmAdjustStock.defaultExpectation == nil {
+		mmAdjustStock.defaultExpectation = &StockServiceUseCaseMockAdjustStockExpectation{mock: mmAdjustStock.mock}
 	}
-	mmDeleteStockItem.defaultExpectation.results = &StockServiceUseCaseMockDeleteStockItemResults{err}
-	mmDeleteStockItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteStockItem.mock
+	mmAdjustStock.defaultExpectation.results = &StockServiceUseCaseMockAdjustStockResults{s1, err}
+	mmAdjustStock.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAdjustStock.mock
 }
 
-// Set uses given function f to mock the StockServiceUseCase.DeleteStockItem method
-func (mmDeleteStockItem *mStockServiceUseCaseMockDeleteStockItem) Set(f func(ctx context.Context, userID domain.UserID, skuID domain.SKUID) (err error)) *StockServiceUseCaseMock {
-	if mmDeleteStockItem.defaultExpectation != nil {
-		mmDeleteStockItem.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.DeleteStockItem method")
+// Set uses given function f to mock the StockServiceUseCase.AdjustStock method
+func (mmAdjustStock *mStockServiceUseCaseMockAdjustStock) Set(f func(ctx context.Context, adjustment domain.StockAdjustment) (s1 domain.StockAdjustmentResult, err error)) *StockServiceUseCaseMock {
+	if mmAdjustStock.defaultExpectation != nil {
+		mmAdjustStock.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.AdjustStock method")
 	}
 
-	if len(mmDeleteStockItem.expectations) > 0 {
-		mmDeleteStockItem.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.DeleteStockItem method")
+	if len(mmAdjustStock.expectations) > 0 {
+		mmAdjustStock.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.AdjustStock method")
 	}
 
-	mmDeleteStockItem.mock.funcDeleteStockItem = f
-	mmDeleteStockItem.mock.funcDeleteStockItemOrigin = minimock.CallerInfo(1)
-	return mmDeleteStockItem.mock
+	mmAdjustStock.mock.funcAdjustStock = f
+	mmAdjustStock.mock.funcAdjustStockOrigin = minimock.CallerInfo(1)
+	return mmAdjustStock.mock
 }
 
-// When sets expectation for the StockServiceUseCase.DeleteStockItem which will trigger the result defined by the following
+// When sets expectation for the StockServiceUseCase.AdjustStock which will trigger the result defined by the following
 // Then helper
-func (mmDeleteStockItem *mStockServiceUseCaseMockDeleteStockItem) When(ctx context.Context, userID domain.UserID, skuID domain.SKUID) *StockServiceUseCaseMockDeleteStockItemExpectation {
-	if mmDeleteStockItem.mock.funcDeleteStockItem != nil {
-		mmDeleteStockItem.mock.t.Fatalf("StockServiceUseCaseMock.DeleteStockItem mock is already set by Set")
+func (mmAdjustStock *mStockServiceUseCaseMockAdjustStock) When(ctx context.Context, adjustment domain.StockAdjustment) *StockServiceUseCaseMockAdjustStockExpectation {
+	if mmAdjustStock.mock.funcAdjustStock != nil {
+		mmAdjustStock.mock.t.Fatalf("StockServiceUseCaseMock.AdjustStock mock is already set by Set")
 	}
 
-	expectation := &StockServiceUseCaseMockDeleteStockItemExpectation{
-		mock:               mmDeleteStockItem.mock,
-		params:             &StockServiceUseCaseMockDeleteStockItemParams{ctx, userID, skuID},
-		expectationOrigins: StockServiceUseCaseMockDeleteStockItemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &StockServiceUseCaseMockAdjustStockExpectation{
+		mock:               mmAdjustStock.mock,
+		params:             &StockServiceUseCaseMockAdjustStockParams{ctx, adjustment},
+		expectationOrigins: StockServiceUseCaseMockAdjustStockExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteStockItem.expectations = append(mmDeleteStockItem.expectations, expectation)
+	mmAdjustStock.expectations = append(mmAdjustStock.expectations, expectation)
 	return expectation
 }
 
-// Then sets up StockServiceUseCase.DeleteStockItem return parameters for the expectation previously defined by the When method
-func (e *StockServiceUseCaseMockDeleteStockItemExpectation) Then(err error) *StockServiceUseCaseMock {
-	e.results = &StockServiceUseCaseMockDeleteStockItemResults{err}
+// Then sets up StockServiceUseCase.AdjustStock return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockAdjustStockExpectation) Then(s1 domain.StockAdjustmentResult, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockAdjustStockResults{s1, err}
 	return e.mock
 }
 
-// Times sets number of times StockServiceUseCase.DeleteStockItem should be invoked
-func (mmDeleteStockItem *mStockServiceUseCaseMockDeleteStockItem) Times(n uint64) *mStockServiceUseCaseMockDeleteStockItem {
+// Times sets number of times StockServiceUseCase.AdjustStock should be invoked
+func (mmAdjustStock *mStockServiceUseCaseMockAdjustStock) Times(n uint64) *mStockServiceUseCaseMockAdjustStock {
 	if n == 0 {
-		mmDeleteStockItem.mock.t.Fatalf("Times of StockServiceUseCaseMock.DeleteStockItem mock can not be zero")
+		mmAdjustStock.mock.t.Fatalf("Times of StockServiceUseCaseMock.AdjustStock mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteStockItem.expectedInvocations, n)
-	mmDeleteStockItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteStockItem
+	mm_atomic.StoreUint64(&mmAdjustStock.expectedInvocations, n)
+	mmAdjustStock.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAdjustStock
 }
 
-func (mmDeleteStockItem *mStockServiceUseCaseMockDeleteStockItem) invocationsDone() bool {
-	if len(mmDeleteStockItem.expectations) == 0 && mmDeleteStockItem.defaultExpectation == nil && mmDeleteStockItem.mock.funcDeleteStockItem == nil {
+func (mmAdjustStock *mStockServiceUseCaseMockAdjustStock) invocationsDone() bool {
+	if len(mmAdjustStock.expectations) == 0 && mmAdjustStock.defaultExpectation == nil && mmAdjustStock.mock.funcAdjustStock == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteStockItem.mock.afterDeleteStockItemCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteStockItem.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAdjustStock.mock.afterAdjustStockCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAdjustStock.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteStockItem implements mm_usecase.StockServiceUseCase
-func (mmDeleteStockItem *StockServiceUseCaseMock) DeleteStockItem(ctx context.Context, userID domain.UserID, skuID domain.SKUID) (err error) {
-	mm_atomic.AddUint64(&mmDeleteStockItem.beforeDeleteStockItemCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteStockItem.afterDeleteStockItemCounter, 1)
+// AdjustStock implements mm_usecase.StockServiceUseCase
+func (mmAdjustStock *StockServiceUseCaseMock) AdjustStock(ctx context.Context, adjustment domain.StockAdjustment) (s1 domain.StockAdjustmentResult, err error) {
+	mm_atomic.AddUint64(&mmAdjustStock.beforeAdjustStockCounter, 1)
+	defer mm_atomic.AddUint64(&mmAdjustStock.afterAdjustStockCounter, 1)
 
-	mmDeleteStockItem.t.Helper()
+	mmAdjustStock.t.Helper()
 
-	if mmDeleteStockItem.inspectFuncDeleteStockItem != nil {
-		mmDeleteStockItem.inspectFuncDeleteStockItem(ctx, userID, skuID)
+	if mmAdjustStock.inspectFuncAdjustStock != nil {
+		mmAdjustStock.inspectFuncAdjustStock(ctx, adjustment)
 	}
 
-	mm_params := StockServiceUseCaseMockDeleteStockItemParams{ctx, userID, skuID}
+	mm_params := StockServiceUseCaseMockAdjustStockParams{ctx, adjustment}
 
 	// Record call args
-	mmDeleteStockItem.DeleteStockItemMock.mutex.Lock()
-	mmDeleteStockItem.DeleteStockItemMock.callArgs = append(mmDeleteStockItem.DeleteStockItemMock.callArgs, &mm_params)
-	mmDeleteStockItem.DeleteStockItemMock.mutex.Unlock()
+	mmAdjustStock.AdjustStockMock.mutex.Lock()
+	mmAdjustStock.AdjustStockMock.callArgs = append(mmAdjustStock.AdjustStockMock.callArgs, &mm_params)
+	mmAdjustStock.AdjustStockMock.mutex.Unlock()
 
-	for _, e := range mmDeleteStockItem.DeleteStockItemMock.expectations {
+	for _, e := range mmAdjustStock.AdjustStockMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.s1, e.results.err
 		}
 	}
 
-	if mmDeleteStockItem.DeleteStockItemMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteStockItem.DeleteStockItemMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteStockItem.DeleteStockItemMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteStockItem.DeleteStockItemMock.defaultExpectation.paramPtrs
+	if mmAdjustStock.AdjustStockMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAdjustStock.AdjustStockMock.defaultExpectation.Counter, 1)
+		mm_want := mmAdjustStock.AdjustStockMock.defaultExpectation.params
+		mm_want_ptrs := mmAdjustStock.AdjustStockMock.defaultExpectation.paramPtrs
 
-		mm_got := StockServiceUseCaseMockDeleteStockItemParams{ctx, userID, skuID}
+		mm_got := StockServiceUseCaseMockAdjustStockParams{ctx, adjustment}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteStockItem.t.Errorf("StockServiceUseCaseMock.DeleteStockItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteStockItem.DeleteStockItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmDeleteStockItem.t.Errorf("StockServiceUseCaseMock.DeleteStockItem got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteStockItem.DeleteStockItemMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+				mmAdjustStock.t.Errorf("StockServiceUseCaseMock.AdjustStock got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdjustStock.AdjustStockMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
-				mmDeleteStockItem.t.Errorf("StockServiceUseCaseMock.DeleteStockItem got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteStockItem.DeleteStockItemMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			if mm_want_ptrs.adjustment != nil && !minimock.Equal(*mm_want_ptrs.adjustment, mm_got.adjustment) {
+				mmAdjustStock.t.Errorf("StockServiceUseCaseMock.AdjustStock got unexpected parameter adjustment, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdjustStock.AdjustStockMock.defaultExpectation.expectationOrigins.originAdjustment, *mm_want_ptrs.adjustment, mm_got.adjustment, minimock.Diff(*mm_want_ptrs.adjustment, mm_got.adjustment))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteStockItem.t.Errorf("StockServiceUseCaseMock.DeleteStockItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteStockItem.DeleteStockItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAdjustStock.t.Errorf("StockServiceUseCaseMock.AdjustStock got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAdjustStock.AdjustStockMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteStockItem.DeleteStockItemMock.defaultExpectation.results
+		mm_results := mmAdjustStock.AdjustStockMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteStockItem.t.Fatal("No results are set for the StockServiceUseCaseMock.DeleteStockItem")
+			mmAdjustStock.t.Fatal("No results are set for the StockServiceUseCaseMock.AdjustStock")
 		}
-		return (*mm_results).err
+		return (*mm_results).s1, (*mm_results).err
 	}
-	if mmDeleteStockItem.funcDeleteStockItem != nil {
-		return mmDeleteStockItem.funcDeleteStockItem(ctx, userID, skuID)
+	if mmAdjustStock.funcAdjustStock != nil {
+		return mmAdjustStock.funcAdjustStock(ctx, adjustment)
 	}
-	mmDeleteStockItem.t.Fatalf("Unexpected call to StockServiceUseCaseMock.DeleteStockItem. %v %v %v", ctx, userID, skuID)
+	mmAdjustStock.t.Fatalf("Unexpected call to StockServiceUseCaseMock.AdjustStock. %v %v", ctx, adjustment)
 	return
 }
 
-// DeleteStockItemAfterCounter returns a count of finished StockServiceUseCaseMock.DeleteStockItem invocations
-func (mmDeleteStockItem *StockServiceUseCaseMock) DeleteStockItemAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteStockItem.afterDeleteStockItemCounter)
+// AdjustStockAfterCounter returns a count of finished StockServiceUseCaseMock.AdjustStock invocations
+func (mmAdjustStock *StockServiceUseCaseMock) AdjustStockAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAdjustStock.afterAdjustStockCounter)
 }
 
-// DeleteStockItemBeforeCounter returns a count of StockServiceUseCaseMock.DeleteStockItem invocations
-func (mmDeleteStockItem *StockServiceUseCaseMock) DeleteStockItemBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteStockItem.beforeDeleteStockItemCounter)
+// AdjustStockBeforeCounter returns a count of StockServiceUseCaseMock.AdjustStock invocations
+func (mmAdjustStock *StockServiceUseCaseMock) AdjustStockBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAdjustStock.beforeAdjustStockCounter)
 }
 
-// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.DeleteStockItem.
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.AdjustStock.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteStockItem *mStockServiceUseCaseMockDeleteStockItem) Calls() []*StockServiceUseCaseMockDeleteStockItemParams {
-	mmDeleteStockItem.mutex.RLock()
+func (mmAdjustStock *mStockServiceUseCaseMockAdjustStock) Calls() []*StockServiceUseCaseMockAdjustStockParams {
+	mmAdjustStock.mutex.RLock()
 
-	argCopy := make([]*StockServiceUseCaseMockDeleteStockItemParams, len(mmDeleteStockItem.callArgs))
-	copy(argCopy, mmDeleteStockItem.callArgs)
+	argCopy := make([]*StockServiceUseCaseMockAdjustStockParams, len(mmAdjustStock.callArgs))
+	copy(argCopy, mmAdjustStock.callArgs)
 
-	mmDeleteStockItem.mutex.RUnlock()
+	mmAdjustStock.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteStockItemDone returns true if the count of the DeleteStockItem invocations corresponds
+// MinimockAdjustStockDone returns true if the count of the AdjustStock invocations corresponds
 // the number of defined expectations
-func (m *StockServiceUseCaseMock) MinimockDeleteStockItemDone() bool {
-	if m.DeleteStockItemMock.optional {
+func (m *StockServiceUseCaseMock) MinimockAdjustStockDone() bool {
+	if m.AdjustStockMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteStockItemMock.expectations {
+	for _, e := range m.AdjustStockMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteStockItemMock.invocationsDone()
+	return m.AdjustStockMock.invocationsDone()
 }
 
-// MinimockDeleteStockItemInspect logs each unmet expectation
-func (m *StockServiceUseCaseMock) MinimockDeleteStockItemInspect() {
-	for _, e := range m.DeleteStockItemMock.expectations {
+// MinimockAdjustStockInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockAdjustStockInspect() {
+	for _, e := range m.AdjustStockMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StockServiceUseCaseMock.DeleteStockItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.AdjustStock at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteStockItemCounter := mm_atomic.LoadUint64(&m.afterDeleteStockItemCounter)
+	afterAdjustStockCounter := mm_atomic.LoadUint64(&m.afterAdjustStockCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteStockItemMock.defaultExpectation != nil && afterDeleteStockItemCounter < 1 {
-		if m.DeleteStockItemMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to StockServiceUseCaseMock.DeleteStockItem at\n%s", m.DeleteStockItemMock.defaultExpectation.returnOrigin)
+	if m.AdjustStockMock.defaultExpectation != nil && afterAdjustStockCounter < 1 {
+		if m.AdjustStockMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.AdjustStock at\n%s", m.AdjustStockMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to StockServiceUseCaseMock.DeleteStockItem at\n%s with params: %#v", m.DeleteStockItemMock.defaultExpectation.expectationOrigins.origin, *m.DeleteStockItemMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.AdjustStock at\n%s with params: %#v", m.AdjustStockMock.defaultExpectation.expectationOrigins.origin, *m.AdjustStockMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteStockItem != nil && afterDeleteStockItemCounter < 1 {
-		m.t.Errorf("Expected call to StockServiceUseCaseMock.DeleteStockItem at\n%s", m.funcDeleteStockItemOrigin)
+	if m.funcAdjustStock != nil && afterAdjustStockCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.AdjustStock at\n%s", m.funcAdjustStockOrigin)
 	}
 
-	if !m.DeleteStockItemMock.invocationsDone() && afterDeleteStockItemCounter > 0 {
-		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.DeleteStockItem at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteStockItemMock.expectedInvocations), m.DeleteStockItemMock.expectedInvocationsOrigin, afterDeleteStockItemCounter)
+	if !m.AdjustStockMock.invocationsDone() && afterAdjustStockCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.AdjustStock at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AdjustStockMock.expectedInvocations), m.AdjustStockMock.expectedInvocationsOrigin, afterAdjustStockCounter)
 	}
 }
 
-type mStockServiceUseCaseMockGetStockItemBySKU struct {
+type mStockServiceUseCaseMockApplyScheduledPriceChanges struct {
 	optional           bool
 	mock               *StockServiceUseCaseMock
-	defaultExpectation *StockServiceUseCaseMockGetStockItemBySKUExpectation
-	expectations       []*StockServiceUseCaseMockGetStockItemBySKUExpectation
+	defaultExpectation *StockServiceUseCaseMockApplyScheduledPriceChangesExpectation
+	expectations       []*StockServiceUseCaseMockApplyScheduledPriceChangesExpectation
 
-	callArgs []*StockServiceUseCaseMockGetStockItemBySKUParams
+	callArgs []*StockServiceUseCaseMockApplyScheduledPriceChangesParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// StockServiceUseCaseMockGetStockItemBySKUExpectation specifies expectation struct of the StockServiceUseCase.GetStockItemBySKU
-type StockServiceUseCaseMockGetStockItemBySKUExpectation struct {
+// StockServiceUseCaseMockApplyScheduledPriceChangesExpectation specifies expectation struct of the StockServiceUseCase.ApplyScheduledPriceChanges
+type StockServiceUseCaseMockApplyScheduledPriceChangesExpectation struct {
 	mock               *StockServiceUseCaseMock
-	params             *StockServiceUseCaseMockGetStockItemBySKUParams
-	paramPtrs          *StockServiceUseCaseMockGetStockItemBySKUParamPtrs
-	expectationOrigins StockServiceUseCaseMockGetStockItemBySKUExpectationOrigins
-	results            *StockServiceUseCaseMockGetStockItemBySKUResults
+	params             *StockServiceUseCaseMockApplyScheduledPriceChangesParams
+	paramPtrs          *StockServiceUseCaseMockApplyScheduledPriceChangesParamPtrs
+	expectationOrigins StockServiceUseCaseMockApplyScheduledPriceChangesExpectationOrigins
+	results            *StockServiceUseCaseMockApplyScheduledPriceChangesResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// StockServiceUseCaseMockGetStockItemBySKUParams contains parameters of the StockServiceUseCase.GetStockItemBySKU
-type StockServiceUseCaseMockGetStockItemBySKUParams struct {
-	ctx   context.Context
-	skuID domain.SKUID
+// StockServiceUseCaseMockApplyScheduledPriceChangesParams contains parameters of the StockServiceUseCase.ApplyScheduledPriceChanges
+type StockServiceUseCaseMockApplyScheduledPriceChangesParams struct {
+	ctx context.Context
 }
 
-// StockServiceUseCaseMockGetStockItemBySKUParamPtrs contains pointers to parameters of the StockServiceUseCase.GetStockItemBySKU
-type StockServiceUseCaseMockGetStockItemBySKUParamPtrs struct {
-	ctx   *context.Context
-	skuID *domain.SKUID
+// StockServiceUseCaseMockApplyScheduledPriceChangesParamPtrs contains pointers to parameters of the StockServiceUseCase.ApplyScheduledPriceChanges
+type StockServiceUseCaseMockApplyScheduledPriceChangesParamPtrs struct {
+	ctx *context.Context
 }
 
-// StockServiceUseCaseMockGetStockItemBySKUResults contains results of the StockServiceUseCase.GetStockItemBySKU
-type StockServiceUseCaseMockGetStockItemBySKUResults struct {
-	s1  domain.StockItem
+// StockServiceUseCaseMockApplyScheduledPriceChangesResults contains results of the StockServiceUseCase.ApplyScheduledPriceChanges
+type StockServiceUseCaseMockApplyScheduledPriceChangesResults struct {
+	err error
+}
+
+// StockServiceUseCaseMockApplyScheduledPriceChangesOrigins contains origins of expectations of the StockServiceUseCase.ApplyScheduledPriceChanges
+type StockServiceUseCaseMockApplyScheduledPriceChangesExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmApplyScheduledPriceChanges *mStockServiceUseCaseMockApplyScheduledPriceChanges) Optional() *mStockServiceUseCaseMockApplyScheduledPriceChanges {
+	mmApplyScheduledPriceChanges.optional = true
+	return mmApplyScheduledPriceChanges
+}
+
+// Expect sets up expected params for StockServiceUseCase.ApplyScheduledPriceChanges
+func (mmApplyScheduledPriceChanges *mStockServiceUseCaseMockApplyScheduledPriceChanges) Expect(ctx context.Context) *mStockServiceUseCaseMockApplyScheduledPriceChanges {
+	if mmApplyScheduledPriceChanges.mock.funcApplyScheduledPriceChanges != nil {
+		mmApplyScheduledPriceChanges.mock.t.Fatalf("StockServiceUseCaseMock.ApplyScheduledPriceChanges mock is already set by Set")
+	}
+
+	if mmApplyScheduledPriceChanges.defaultExpectation == nil {
+		mmApplyScheduledPriceChanges.defaultExpectation = &StockServiceUseCaseMockApplyScheduledPriceChangesExpectation{}
+	}
+
+	if mmApplyScheduledPriceChanges.defaultExpectation.paramPtrs != nil {
+		mmApplyScheduledPriceChanges.mock.t.Fatalf("StockServiceUseCaseMock.ApplyScheduledPriceChanges mock is already set by ExpectParams functions")
+	}
+
+	mmApplyScheduledPriceChanges.defaultExpectation.params = &StockServiceUseCaseMockApplyScheduledPriceChangesParams{ctx}
+	mmApplyScheduledPriceChanges.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmApplyScheduledPriceChanges.expectations {
+		if minimock.Equal(e.params, mmApplyScheduledPriceChanges.defaultExpectation.params) {
+			mmApplyScheduledPriceChanges.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmApplyScheduledPriceChanges.defaultExpectation.params)
+		}
+	}
+
+	return mmApplyScheduledPriceChanges
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.ApplyScheduledPriceChanges
+func (mmApplyScheduledPriceChanges *mStockServiceUseCaseMockApplyScheduledPriceChanges) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockApplyScheduledPriceChanges {
+	if mmApplyScheduledPriceChanges.mock.funcApplyScheduledPriceChanges != nil {
+		mmApplyScheduledPriceChanges.mock.t.Fatalf("StockServiceUseCaseMock.ApplyScheduledPriceChanges mock is already set by Set")
+	}
+
+	if mmApplyScheduledPriceChanges.defaultExpectation == nil {
+		mmApplyScheduledPriceChanges.defaultExpectation = &StockServiceUseCaseMockApplyScheduledPriceChangesExpectation{}
+	}
+
+	if mmApplyScheduledPriceChanges.defaultExpectation.params != nil {
+		mmApplyScheduledPriceChanges.mock.t.Fatalf("StockServiceUseCaseMock.ApplyScheduledPriceChanges mock is already set by Expect")
+	}
+
+	if mmApplyScheduledPriceChanges.defaultExpectation.paramPtrs == nil {
+		mmApplyScheduledPriceChanges.defaultExpectation.paramPtrs = &StockServiceUseCaseMockApplyScheduledPriceChangesParamPtrs{}
+	}
+	mmApplyScheduledPriceChanges.defaultExpectation.paramPtrs.ctx = &ctx
+	mmApplyScheduledPriceChanges.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmApplyScheduledPriceChanges
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.ApplyScheduledPriceChanges
+func (mmApplyScheduledPriceChanges *mStockServiceUseCaseMockApplyScheduledPriceChanges) Inspect(f func(ctx context.Context)) *mStockServiceUseCaseMockApplyScheduledPriceChanges {
+	if mmApplyScheduledPriceChanges.mock.inspectFuncApplyScheduledPriceChanges != nil {
+		mmApplyScheduledPriceChanges.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.ApplyScheduledPriceChanges")
+	}
+
+	mmApplyScheduledPriceChanges.mock.inspectFuncApplyScheduledPriceChanges = f
+
+	return mmApplyScheduledPriceChanges
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.ApplyScheduledPriceChanges
+func (mmApplyScheduledPriceChanges *mStockServiceUseCaseMockApplyScheduledPriceChanges) Return(err error) *StockServiceUseCaseMock {
+	if mmApplyScheduledPriceChanges.mock.funcApplyScheduledPriceChanges != nil {
+		mmApplyScheduledPriceChanges.mock.t.Fatalf("StockServiceUseCaseMock.ApplyScheduledPriceChanges mock is already set by Set")
+	}
+
+	if mmApplyScheduledPriceChanges.defaultExpectation == nil {
+		mmApplyScheduledPriceChanges.defaultExpectation = &StockServiceUseCaseMockApplyScheduledPriceChangesExpectation{mock: mmApplyScheduledPriceChanges.mock}
+	}
+	mmApplyScheduledPriceChanges.defaultExpectation.results = &StockServiceUseCaseMockApplyScheduledPriceChangesResults{err}
+	mmApplyScheduledPriceChanges.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmApplyScheduledPriceChanges.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.ApplyScheduledPriceChanges method
+func (mmApplyScheduledPriceChanges *mStockServiceUseCaseMockApplyScheduledPriceChanges) Set(f func(ctx context.Context) (err error)) *StockServiceUseCaseMock {
+	if mmApplyScheduledPriceChanges.defaultExpectation != nil {
+		mmApplyScheduledPriceChanges.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.ApplyScheduledPriceChanges method")
+	}
+
+	if len(mmApplyScheduledPriceChanges.expectations) > 0 {
+		mmApplyScheduledPriceChanges.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.ApplyScheduledPriceChanges method")
+	}
+
+	mmApplyScheduledPriceChanges.mock.funcApplyScheduledPriceChanges = f
+	mmApplyScheduledPriceChanges.mock.funcApplyScheduledPriceChangesOrigin = minimock.CallerInfo(1)
+	return mmApplyScheduledPriceChanges.mock
+}
+
+// When sets expectation for the StockServiceUseCase.ApplyScheduledPriceChanges which will trigger the result defined by the following
+// Then helper
+func (mmApplyScheduledPriceChanges *mStockServiceUseCaseMockApplyScheduledPriceChanges) When(ctx context.Context) *StockServiceUseCaseMockApplyScheduledPriceChangesExpectation {
+	if mmApplyScheduledPriceChanges.mock.funcApplyScheduledPriceChanges != nil {
+		mmApplyScheduledPriceChanges.mock.t.Fatalf("StockServiceUseCaseMock.ApplyScheduledPriceChanges mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockApplyScheduledPriceChangesExpectation{
+		mock:               mmApplyScheduledPriceChanges.mock,
+		params:             &StockServiceUseCaseMockApplyScheduledPriceChangesParams{ctx},
+		expectationOrigins: StockServiceUseCaseMockApplyScheduledPriceChangesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmApplyScheduledPriceChanges.expectations = append(mmApplyScheduledPriceChanges.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.ApplyScheduledPriceChanges return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockApplyScheduledPriceChangesExpectation) Then(err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockApplyScheduledPriceChangesResults{err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.ApplyScheduledPriceChanges should be invoked
+func (mmApplyScheduledPriceChanges *mStockServiceUseCaseMockApplyScheduledPriceChanges) Times(n uint64) *mStockServiceUseCaseMockApplyScheduledPriceChanges {
+	if n == 0 {
+		mmApplyScheduledPriceChanges.mock.t.Fatalf("Times of StockServiceUseCaseMock.ApplyScheduledPriceChanges mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmApplyScheduledPriceChanges.expectedInvocations, n)
+	mmApplyScheduledPriceChanges.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmApplyScheduledPriceChanges
+}
+
+func (mmApplyScheduledPriceChanges *mStockServiceUseCaseMockApplyScheduledPriceChanges) invocationsDone() bool {
+	if len(mmApplyScheduledPriceChanges.expectations) == 0 && mmApplyScheduledPriceChanges.defaultExpectation == nil && mmApplyScheduledPriceChanges.mock.funcApplyScheduledPriceChanges == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmApplyScheduledPriceChanges.mock.afterApplyScheduledPriceChangesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmApplyScheduledPriceChanges.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ApplyScheduledPriceChanges implements mm_usecase.StockServiceUseCase
+func (mmApplyScheduledPriceChanges *StockServiceUseCaseMock) ApplyScheduledPriceChanges(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmApplyScheduledPriceChanges.beforeApplyScheduledPriceChangesCounter, 1)
+	defer mm_atomic.AddUint64(&mmApplyScheduledPriceChanges.afterApplyScheduledPriceChangesCounter, 1)
+
+	mmApplyScheduledPriceChanges.t.Helper()
+
+	if mmApplyScheduledPriceChanges.inspectFuncApplyScheduledPriceChanges != nil {
+		mmApplyScheduledPriceChanges.inspectFuncApplyScheduledPriceChanges(ctx)
+	}
+
+	mm_params := StockServiceUseCaseMockApplyScheduledPriceChangesParams{ctx}
+
+	// Record call args
+	mmApplyScheduledPriceChanges.ApplyScheduledPriceChangesMock.mutex.Lock()
+	mmApplyScheduledPriceChanges.ApplyScheduledPriceChangesMock.callArgs = append(mmApplyScheduledPriceChanges.ApplyScheduledPriceChangesMock.callArgs, &mm_params)
+	mmApplyScheduledPriceChanges.ApplyScheduledPriceChangesMock.mutex.Unlock()
+
+	for _, e := range mmApplyScheduledPriceChanges.ApplyScheduledPriceChangesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmApplyScheduledPriceChanges.ApplyScheduledPriceChangesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmApplyScheduledPriceChanges.ApplyScheduledPriceChangesMock.defaultExpectation.Counter, 1)
+		mm_want := mmApplyScheduledPriceChanges.ApplyScheduledPriceChangesMock.defaultExpectation.params
+		mm_want_ptrs := mmApplyScheduledPriceChanges.ApplyScheduledPriceChangesMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockApplyScheduledPriceChangesParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmApplyScheduledPriceChanges.t.Errorf("StockServiceUseCaseMock.ApplyScheduledPriceChanges got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyScheduledPriceChanges.ApplyScheduledPriceChangesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmApplyScheduledPriceChanges.t.Errorf("StockServiceUseCaseMock.ApplyScheduledPriceChanges got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmApplyScheduledPriceChanges.ApplyScheduledPriceChangesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmApplyScheduledPriceChanges.ApplyScheduledPriceChangesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmApplyScheduledPriceChanges.t.Fatal("No results are set for the StockServiceUseCaseMock.ApplyScheduledPriceChanges")
+		}
+		return (*mm_results).err
+	}
+	if mmApplyScheduledPriceChanges.funcApplyScheduledPriceChanges != nil {
+		return mmApplyScheduledPriceChanges.funcApplyScheduledPriceChanges(ctx)
+	}
+	mmApplyScheduledPriceChanges.t.Fatalf("Unexpected call to StockServiceUseCaseMock.ApplyScheduledPriceChanges. %v", ctx)
+	return
+}
+
+// ApplyScheduledPriceChangesAfterCounter returns a count of finished StockServiceUseCaseMock.ApplyScheduledPriceChanges invocations
+func (mmApplyScheduledPriceChanges *StockServiceUseCaseMock) ApplyScheduledPriceChangesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApplyScheduledPriceChanges.afterApplyScheduledPriceChangesCounter)
+}
+
+// ApplyScheduledPriceChangesBeforeCounter returns a count of StockServiceUseCaseMock.ApplyScheduledPriceChanges invocations
+func (mmApplyScheduledPriceChanges *StockServiceUseCaseMock) ApplyScheduledPriceChangesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApplyScheduledPriceChanges.beforeApplyScheduledPriceChangesCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.ApplyScheduledPriceChanges.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmApplyScheduledPriceChanges *mStockServiceUseCaseMockApplyScheduledPriceChanges) Calls() []*StockServiceUseCaseMockApplyScheduledPriceChangesParams {
+	mmApplyScheduledPriceChanges.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockApplyScheduledPriceChangesParams, len(mmApplyScheduledPriceChanges.callArgs))
+	copy(argCopy, mmApplyScheduledPriceChanges.callArgs)
+
+	mmApplyScheduledPriceChanges.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockApplyScheduledPriceChangesDone returns true if the count of the ApplyScheduledPriceChanges invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockApplyScheduledPriceChangesDone() bool {
+	if m.ApplyScheduledPriceChangesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ApplyScheduledPriceChangesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ApplyScheduledPriceChangesMock.invocationsDone()
+}
+
+// MinimockApplyScheduledPriceChangesInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockApplyScheduledPriceChangesInspect() {
+	for _, e := range m.ApplyScheduledPriceChangesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ApplyScheduledPriceChanges at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterApplyScheduledPriceChangesCounter := mm_atomic.LoadUint64(&m.afterApplyScheduledPriceChangesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ApplyScheduledPriceChangesMock.defaultExpectation != nil && afterApplyScheduledPriceChangesCounter < 1 {
+		if m.ApplyScheduledPriceChangesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ApplyScheduledPriceChanges at\n%s", m.ApplyScheduledPriceChangesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ApplyScheduledPriceChanges at\n%s with params: %#v", m.ApplyScheduledPriceChangesMock.defaultExpectation.expectationOrigins.origin, *m.ApplyScheduledPriceChangesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcApplyScheduledPriceChanges != nil && afterApplyScheduledPriceChangesCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.ApplyScheduledPriceChanges at\n%s", m.funcApplyScheduledPriceChangesOrigin)
+	}
+
+	if !m.ApplyScheduledPriceChangesMock.invocationsDone() && afterApplyScheduledPriceChangesCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.ApplyScheduledPriceChanges at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ApplyScheduledPriceChangesMock.expectedInvocations), m.ApplyScheduledPriceChangesMock.expectedInvocationsOrigin, afterApplyScheduledPriceChangesCounter)
+	}
+}
+
+type mStockServiceUseCaseMockApproveCycleCount struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockApproveCycleCountExpectation
+	expectations       []*StockServiceUseCaseMockApproveCycleCountExpectation
+
+	callArgs []*StockServiceUseCaseMockApproveCycleCountParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockApproveCycleCountExpectation specifies expectation struct of the StockServiceUseCase.ApproveCycleCount
+type StockServiceUseCaseMockApproveCycleCountExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockApproveCycleCountParams
+	paramPtrs          *StockServiceUseCaseMockApproveCycleCountParamPtrs
+	expectationOrigins StockServiceUseCaseMockApproveCycleCountExpectationOrigins
+	results            *StockServiceUseCaseMockApproveCycleCountResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockApproveCycleCountParams contains parameters of the StockServiceUseCase.ApproveCycleCount
+type StockServiceUseCaseMockApproveCycleCountParams struct {
+	ctx          context.Context
+	cycleCountID domain.CycleCountID
+	approvedBy   domain.UserID
+}
+
+// StockServiceUseCaseMockApproveCycleCountParamPtrs contains pointers to parameters of the StockServiceUseCase.ApproveCycleCount
+type StockServiceUseCaseMockApproveCycleCountParamPtrs struct {
+	ctx          *context.Context
+	cycleCountID *domain.CycleCountID
+	approvedBy   *domain.UserID
+}
+
+// StockServiceUseCaseMockApproveCycleCountResults contains results of the StockServiceUseCase.ApproveCycleCount
+type StockServiceUseCaseMockApproveCycleCountResults struct {
+	c2  domain.CycleCount
+	err error
+}
+
+// StockServiceUseCaseMockApproveCycleCountOrigins contains origins of expectations of the StockServiceUseCase.ApproveCycleCount
+type StockServiceUseCaseMockApproveCycleCountExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originCycleCountID string
+	originApprovedBy   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmApproveCycleCount *mStockServiceUseCaseMockApproveCycleCount) Optional() *mStockServiceUseCaseMockApproveCycleCount {
+	mmApproveCycleCount.optional = true
+	return mmApproveCycleCount
+}
+
+// Expect sets up expected params for StockServiceUseCase.ApproveCycleCount
+func (mmApproveCycleCount *mStockServiceUseCaseMockApproveCycleCount) Expect(ctx context.Context, cycleCountID domain.CycleCountID, approvedBy domain.UserID) *mStockServiceUseCaseMockApproveCycleCount {
+	if mmApproveCycleCount.mock.funcApproveCycleCount != nil {
+		mmApproveCycleCount.mock.t.Fatalf("StockServiceUseCaseMock.ApproveCycleCount mock is already set by Set")
+	}
+
+	if mmApproveCycleCount.defaultExpectation == nil {
+		mmApproveCycleCount.defaultExpectation = &StockServiceUseCaseMockApproveCycleCountExpectation{}
+	}
+
+	if mmApproveCycleCount.defaultExpectation.paramPtrs != nil {
+		mmApproveCycleCount.mock.t.Fatalf("StockServiceUseCaseMock.ApproveCycleCount mock is already set by ExpectParams functions")
+	}
+
+	mmApproveCycleCount.defaultExpectation.params = &StockServiceUseCaseMockApproveCycleCountParams{ctx, cycleCountID, approvedBy}
+	mmApproveCycleCount.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmApproveCycleCount.expectations {
+		if minimock.Equal(e.params, mmApproveCycleCount.defaultExpectation.params) {
+			mmApproveCycleCount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmApproveCycleCount.defaultExpectation.params)
+		}
+	}
+
+	return mmApproveCycleCount
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.ApproveCycleCount
+func (mmApproveCycleCount *mStockServiceUseCaseMockApproveCycleCount) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockApproveCycleCount {
+	if mmApproveCycleCount.mock.funcApproveCycleCount != nil {
+		mmApproveCycleCount.mock.t.Fatalf("StockServiceUseCaseMock.ApproveCycleCount mock is already set by Set")
+	}
+
+	if mmApproveCycleCount.defaultExpectation == nil {
+		mmApproveCycleCount.defaultExpectation = &StockServiceUseCaseMockApproveCycleCountExpectation{}
+	}
+
+	if mmApproveCycleCount.defaultExpectation.params != nil {
+		mmApproveCycleCount.mock.t.Fatalf("StockServiceUseCaseMock.ApproveCycleCount mock is already set by Expect")
+	}
+
+	if mmApproveCycleCount.defaultExpectation.paramPtrs == nil {
+		mmApproveCycleCount.defaultExpectation.paramPtrs = &StockServiceUseCaseMockApproveCycleCountParamPtrs{}
+	}
+	mmApproveCycleCount.defaultExpectation.paramPtrs.ctx = &ctx
+	mmApproveCycleCount.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmApproveCycleCount
+}
+
+// ExpectCycleCountIDParam2 sets up expected param cycleCountID for StockServiceUseCase.ApproveCycleCount
+func (mmApproveCycleCount *mStockServiceUseCaseMockApproveCycleCount) ExpectCycleCountIDParam2(cycleCountID domain.CycleCountID) *mStockServiceUseCaseMockApproveCycleCount {
+	if mmApproveCycleCount.mock.funcApproveCycleCount != nil {
+		mmApproveCycleCount.mock.t.Fatalf("StockServiceUseCaseMock.ApproveCycleCount mock is already set by Set")
+	}
+
+	if mmApproveCycleCount.defaultExpectation == nil {
+		mmApproveCycleCount.defaultExpectation = &StockServiceUseCaseMockApproveCycleCountExpectation{}
+	}
+
+	if mmApproveCycleCount.defaultExpectation.params != nil {
+		mmApproveCycleCount.mock.t.Fatalf("StockServiceUseCaseMock.ApproveCycleCount mock is already set by Expect")
+	}
+
+	if mmApproveCycleCount.defaultExpectation.paramPtrs == nil {
+		mmApproveCycleCount.defaultExpectation.paramPtrs = &StockServiceUseCaseMockApproveCycleCountParamPtrs{}
+	}
+	mmApproveCycleCount.defaultExpectation.paramPtrs.cycleCountID = &cycleCountID
+	mmApproveCycleCount.defaultExpectation.expectationOrigins.originCycleCountID = minimock.CallerInfo(1)
+
+	return mmApproveCycleCount
+}
+
+// ExpectApprovedByParam3 sets up expected param approvedBy for StockServiceUseCase.ApproveCycleCount
+func (mmApproveCycleCount *mStockServiceUseCaseMockApproveCycleCount) ExpectApprovedByParam3(approvedBy domain.UserID) *mStockServiceUseCaseMockApproveCycleCount {
+	if mmApproveCycleCount.mock.funcApproveCycleCount != nil {
+		mmApproveCycleCount.mock.t.Fatalf("StockServiceUseCaseMock.ApproveCycleCount mock is already set by Set")
+	}
+
+	if mmApproveCycleCount.defaultExpectation == nil {
+		mmApproveCycleCount.defaultExpectation = &StockServiceUseCaseMockApproveCycleCountExpectation{}
+	}
+
+	if mmApproveCycleCount.defaultExpectation.params != nil {
+		mmApproveCycleCount.mock.t.Fatalf("StockServiceUseCaseMock.ApproveCycleCount mock is already set by Expect")
+	}
+
+	if mmApproveCycleCount.defaultExpectation.paramPtrs == nil {
+		mmApproveCycleCount.defaultExpectation.paramPtrs = &StockServiceUseCaseMockApproveCycleCountParamPtrs{}
+	}
+	mmApproveCycleCount.defaultExpectation.paramPtrs.approvedBy = &approvedBy
+	mmApproveCycleCount.defaultExpectation.expectationOrigins.originApprovedBy = minimock.CallerInfo(1)
+
+	return mmApproveCycleCount
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.ApproveCycleCount
+func (mmApproveCycleCount *mStockServiceUseCaseMockApproveCycleCount) Inspect(f func(ctx context.Context, cycleCountID domain.CycleCountID, approvedBy domain.UserID)) *mStockServiceUseCaseMockApproveCycleCount {
+	if mmApproveCycleCount.mock.inspectFuncApproveCycleCount != nil {
+		mmApproveCycleCount.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.ApproveCycleCount")
+	}
+
+	mmApproveCycleCount.mock.inspectFuncApproveCycleCount = f
+
+	return mmApproveCycleCount
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.ApproveCycleCount
+func (mmApproveCycleCount *mStockServiceUseCaseMockApproveCycleCount) Return(c2 domain.CycleCount, err error) *StockServiceUseCaseMock {
+	if mmApproveCycleCount.mock.funcApproveCycleCount != nil {
+		mmApproveCycleCount.mock.t.Fatalf("StockServiceUseCaseMock.ApproveCycleCount mock is already set by Set")
+	}
+
+	if mmApproveCycleCount.defaultExpectation == nil {
+		mmApproveCycleCount.defaultExpectation = &StockServiceUseCaseMockApproveCycleCountExpectation{mock: mmApproveCycleCount.mock}
+	}
+	mmApproveCycleCount.defaultExpectation.results = &StockServiceUseCaseMockApproveCycleCountResults{c2, err}
+	mmApproveCycleCount.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmApproveCycleCount.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.ApproveCycleCount method
+func (mmApproveCycleCount *mStockServiceUseCaseMockApproveCycleCount) Set(f func(ctx context.Context, cycleCountID domain.CycleCountID, approvedBy domain.UserID) (c2 domain.CycleCount, err error)) *StockServiceUseCaseMock {
+	if mmApproveCycleCount.defaultExpectation != nil {
+		mmApproveCycleCount.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.ApproveCycleCount method")
+	}
+
+	if len(mmApproveCycleCount.expectations) > 0 {
+		mmApproveCycleCount.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.ApproveCycleCount method")
+	}
+
+	mmApproveCycleCount.mock.funcApproveCycleCount = f
+	mmApproveCycleCount.mock.funcApproveCycleCountOrigin = minimock.CallerInfo(1)
+	return mmApproveCycleCount.mock
+}
+
+// When sets expectation for the StockServiceUseCase.ApproveCycleCount which will trigger the result defined by the following
+// Then helper
+func (mmApproveCycleCount *mStockServiceUseCaseMockApproveCycleCount) When(ctx context.Context, cycleCountID domain.CycleCountID, approvedBy domain.UserID) *StockServiceUseCaseMockApproveCycleCountExpectation {
+	if mmApproveCycleCount.mock.funcApproveCycleCount != nil {
+		mmApproveCycleCount.mock.t.Fatalf("StockServiceUseCaseMock.ApproveCycleCount mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockApproveCycleCountExpectation{
+		mock:               mmApproveCycleCount.mock,
+		params:             &StockServiceUseCaseMockApproveCycleCountParams{ctx, cycleCountID, approvedBy},
+		expectationOrigins: StockServiceUseCaseMockApproveCycleCountExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmApproveCycleCount.expectations = append(mmApproveCycleCount.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.ApproveCycleCount return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockApproveCycleCountExpectation) Then(c2 domain.CycleCount, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockApproveCycleCountResults{c2, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.ApproveCycleCount should be invoked
+func (mmApproveCycleCount *mStockServiceUseCaseMockApproveCycleCount) Times(n uint64) *mStockServiceUseCaseMockApproveCycleCount {
+	if n == 0 {
+		mmApproveCycleCount.mock.t.Fatalf("Times of StockServiceUseCaseMock.ApproveCycleCount mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmApproveCycleCount.expectedInvocations, n)
+	mmApproveCycleCount.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmApproveCycleCount
+}
+
+func (mmApproveCycleCount *mStockServiceUseCaseMockApproveCycleCount) invocationsDone() bool {
+	if len(mmApproveCycleCount.expectations) == 0 && mmApproveCycleCount.defaultExpectation == nil && mmApproveCycleCount.mock.funcApproveCycleCount == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmApproveCycleCount.mock.afterApproveCycleCountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmApproveCycleCount.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ApproveCycleCount implements mm_usecase.StockServiceUseCase
+func (mmApproveCycleCount *StockServiceUseCaseMock) ApproveCycleCount(ctx context.Context, cycleCountID domain.CycleCountID, approvedBy domain.UserID) (c2 domain.CycleCount, err error) {
+	mm_atomic.AddUint64(&mmApproveCycleCount.beforeApproveCycleCountCounter, 1)
+	defer mm_atomic.AddUint64(&mmApproveCycleCount.afterApproveCycleCountCounter, 1)
+
+	mmApproveCycleCount.t.Helper()
+
+	if mmApproveCycleCount.inspectFuncApproveCycleCount != nil {
+		mmApproveCycleCount.inspectFuncApproveCycleCount(ctx, cycleCountID, approvedBy)
+	}
+
+	mm_params := StockServiceUseCaseMockApproveCycleCountParams{ctx, cycleCountID, approvedBy}
+
+	// Record call args
+	mmApproveCycleCount.ApproveCycleCountMock.mutex.Lock()
+	mmApproveCycleCount.ApproveCycleCountMock.callArgs = append(mmApproveCycleCount.ApproveCycleCountMock.callArgs, &mm_params)
+	mmApproveCycleCount.ApproveCycleCountMock.mutex.Unlock()
+
+	for _, e := range mmApproveCycleCount.ApproveCycleCountMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.c2, e.results.err
+		}
+	}
+
+	if mmApproveCycleCount.ApproveCycleCountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmApproveCycleCount.ApproveCycleCountMock.defaultExpectation.Counter, 1)
+		mm_want := mmApproveCycleCount.ApproveCycleCountMock.defaultExpectation.params
+		mm_want_ptrs := mmApproveCycleCount.ApproveCycleCountMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockApproveCycleCountParams{ctx, cycleCountID, approvedBy}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmApproveCycleCount.t.Errorf("StockServiceUseCaseMock.ApproveCycleCount got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApproveCycleCount.ApproveCycleCountMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.cycleCountID != nil && !minimock.Equal(*mm_want_ptrs.cycleCountID, mm_got.cycleCountID) {
+				mmApproveCycleCount.t.Errorf("StockServiceUseCaseMock.ApproveCycleCount got unexpected parameter cycleCountID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApproveCycleCount.ApproveCycleCountMock.defaultExpectation.expectationOrigins.originCycleCountID, *mm_want_ptrs.cycleCountID, mm_got.cycleCountID, minimock.Diff(*mm_want_ptrs.cycleCountID, mm_got.cycleCountID))
+			}
+
+			if mm_want_ptrs.approvedBy != nil && !minimock.Equal(*mm_want_ptrs.approvedBy, mm_got.approvedBy) {
+				mmApproveCycleCount.t.Errorf("StockServiceUseCaseMock.ApproveCycleCount got unexpected parameter approvedBy, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApproveCycleCount.ApproveCycleCountMock.defaultExpectation.expectationOrigins.originApprovedBy, *mm_want_ptrs.approvedBy, mm_got.approvedBy, minimock.Diff(*mm_want_ptrs.approvedBy, mm_got.approvedBy))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmApproveCycleCount.t.Errorf("StockServiceUseCaseMock.ApproveCycleCount got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmApproveCycleCount.ApproveCycleCountMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmApproveCycleCount.ApproveCycleCountMock.defaultExpectation.results
+		if mm_results == nil {
+			mmApproveCycleCount.t.Fatal("No results are set for the StockServiceUseCaseMock.ApproveCycleCount")
+		}
+		return (*mm_results).c2, (*mm_results).err
+	}
+	if mmApproveCycleCount.funcApproveCycleCount != nil {
+		return mmApproveCycleCount.funcApproveCycleCount(ctx, cycleCountID, approvedBy)
+	}
+	mmApproveCycleCount.t.Fatalf("Unexpected call to StockServiceUseCaseMock.ApproveCycleCount. %v %v %v", ctx, cycleCountID, approvedBy)
+	return
+}
+
+// ApproveCycleCountAfterCounter returns a count of finished StockServiceUseCaseMock.ApproveCycleCount invocations
+func (mmApproveCycleCount *StockServiceUseCaseMock) ApproveCycleCountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApproveCycleCount.afterApproveCycleCountCounter)
+}
+
+// ApproveCycleCountBeforeCounter returns a count of StockServiceUseCaseMock.ApproveCycleCount invocations
+func (mmApproveCycleCount *StockServiceUseCaseMock) ApproveCycleCountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApproveCycleCount.beforeApproveCycleCountCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.ApproveCycleCount.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmApproveCycleCount *mStockServiceUseCaseMockApproveCycleCount) Calls() []*StockServiceUseCaseMockApproveCycleCountParams {
+	mmApproveCycleCount.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockApproveCycleCountParams, len(mmApproveCycleCount.callArgs))
+	copy(argCopy, mmApproveCycleCount.callArgs)
+
+	mmApproveCycleCount.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockApproveCycleCountDone returns true if the count of the ApproveCycleCount invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockApproveCycleCountDone() bool {
+	if m.ApproveCycleCountMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ApproveCycleCountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ApproveCycleCountMock.invocationsDone()
+}
+
+// MinimockApproveCycleCountInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockApproveCycleCountInspect() {
+	for _, e := range m.ApproveCycleCountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ApproveCycleCount at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterApproveCycleCountCounter := mm_atomic.LoadUint64(&m.afterApproveCycleCountCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ApproveCycleCountMock.defaultExpectation != nil && afterApproveCycleCountCounter < 1 {
+		if m.ApproveCycleCountMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ApproveCycleCount at\n%s", m.ApproveCycleCountMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ApproveCycleCount at\n%s with params: %#v", m.ApproveCycleCountMock.defaultExpectation.expectationOrigins.origin, *m.ApproveCycleCountMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcApproveCycleCount != nil && afterApproveCycleCountCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.ApproveCycleCount at\n%s", m.funcApproveCycleCountOrigin)
+	}
+
+	if !m.ApproveCycleCountMock.invocationsDone() && afterApproveCycleCountCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.ApproveCycleCount at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ApproveCycleCountMock.expectedInvocations), m.ApproveCycleCountMock.expectedInvocationsOrigin, afterApproveCycleCountCounter)
+	}
+}
+
+type mStockServiceUseCaseMockCreatePurchaseOrder struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockCreatePurchaseOrderExpectation
+	expectations       []*StockServiceUseCaseMockCreatePurchaseOrderExpectation
+
+	callArgs []*StockServiceUseCaseMockCreatePurchaseOrderParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockCreatePurchaseOrderExpectation specifies expectation struct of the StockServiceUseCase.CreatePurchaseOrder
+type StockServiceUseCaseMockCreatePurchaseOrderExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockCreatePurchaseOrderParams
+	paramPtrs          *StockServiceUseCaseMockCreatePurchaseOrderParamPtrs
+	expectationOrigins StockServiceUseCaseMockCreatePurchaseOrderExpectationOrigins
+	results            *StockServiceUseCaseMockCreatePurchaseOrderResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockCreatePurchaseOrderParams contains parameters of the StockServiceUseCase.CreatePurchaseOrder
+type StockServiceUseCaseMockCreatePurchaseOrderParams struct {
+	ctx           context.Context
+	purchaseOrder domain.PurchaseOrder
+}
+
+// StockServiceUseCaseMockCreatePurchaseOrderParamPtrs contains pointers to parameters of the StockServiceUseCase.CreatePurchaseOrder
+type StockServiceUseCaseMockCreatePurchaseOrderParamPtrs struct {
+	ctx           *context.Context
+	purchaseOrder *domain.PurchaseOrder
+}
+
+// StockServiceUseCaseMockCreatePurchaseOrderResults contains results of the StockServiceUseCase.CreatePurchaseOrder
+type StockServiceUseCaseMockCreatePurchaseOrderResults struct {
+	p1  domain.PurchaseOrder
+	err error
+}
+
+// StockServiceUseCaseMockCreatePurchaseOrderOrigins contains origins of expectations of the StockServiceUseCase.CreatePurchaseOrder
+type StockServiceUseCaseMockCreatePurchaseOrderExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originPurchaseOrder string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreatePurchaseOrder *mStockServiceUseCaseMockCreatePurchaseOrder) Optional() *mStockServiceUseCaseMockCreatePurchaseOrder {
+	mmCreatePurchaseOrder.optional = true
+	return mmCreatePurchaseOrder
+}
+
+// Expect sets up expected params for StockServiceUseCase.CreatePurchaseOrder
+func (mmCreatePurchaseOrder *mStockServiceUseCaseMockCreatePurchaseOrder) Expect(ctx context.Context, purchaseOrder domain.PurchaseOrder) *mStockServiceUseCaseMockCreatePurchaseOrder {
+	if mmCreatePurchaseOrder.mock.funcCreatePurchaseOrder != nil {
+		mmCreatePurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.CreatePurchaseOrder mock is already set by Set")
+	}
+
+	if mmCreatePurchaseOrder.defaultExpectation == nil {
+		mmCreatePurchaseOrder.defaultExpectation = &StockServiceUseCaseMockCreatePurchaseOrderExpectation{}
+	}
+
+	if mmCreatePurchaseOrder.defaultExpectation.paramPtrs != nil {
+		mmCreatePurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.CreatePurchaseOrder mock is already set by ExpectParams functions")
+	}
+
+	mmCreatePurchaseOrder.defaultExpectation.params = &StockServiceUseCaseMockCreatePurchaseOrderParams{ctx, purchaseOrder}
+	mmCreatePurchaseOrder.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreatePurchaseOrder.expectations {
+		if minimock.Equal(e.params, mmCreatePurchaseOrder.defaultExpectation.params) {
+			mmCreatePurchaseOrder.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreatePurchaseOrder.defaultExpectation.params)
+		}
+	}
+
+	return mmCreatePurchaseOrder
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.CreatePurchaseOrder
+func (mmCreatePurchaseOrder *mStockServiceUseCaseMockCreatePurchaseOrder) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockCreatePurchaseOrder {
+	if mmCreatePurchaseOrder.mock.funcCreatePurchaseOrder != nil {
+		mmCreatePurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.CreatePurchaseOrder mock is already set by Set")
+	}
+
+	if mmCreatePurchaseOrder.defaultExpectation == nil {
+		mmCreatePurchaseOrder.defaultExpectation = &StockServiceUseCaseMockCreatePurchaseOrderExpectation{}
+	}
+
+	if mmCreatePurchaseOrder.defaultExpectation.params != nil {
+		mmCreatePurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.CreatePurchaseOrder mock is already set by Expect")
+	}
+
+	if mmCreatePurchaseOrder.defaultExpectation.paramPtrs == nil {
+		mmCreatePurchaseOrder.defaultExpectation.paramPtrs = &StockServiceUseCaseMockCreatePurchaseOrderParamPtrs{}
+	}
+	mmCreatePurchaseOrder.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreatePurchaseOrder.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreatePurchaseOrder
+}
+
+// ExpectPurchaseOrderParam2 sets up expected param purchaseOrder for StockServiceUseCase.CreatePurchaseOrder
+func (mmCreatePurchaseOrder *mStockServiceUseCaseMockCreatePurchaseOrder) ExpectPurchaseOrderParam2(purchaseOrder domain.PurchaseOrder) *mStockServiceUseCaseMockCreatePurchaseOrder {
+	if mmCreatePurchaseOrder.mock.funcCreatePurchaseOrder != nil {
+		mmCreatePurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.CreatePurchaseOrder mock is already set by Set")
+	}
+
+	if mmCreatePurchaseOrder.defaultExpectation == nil {
+		mmCreatePurchaseOrder.defaultExpectation = &StockServiceUseCaseMockCreatePurchaseOrderExpectation{}
+	}
+
+	if mmCreatePurchaseOrder.defaultExpectation.params != nil {
+		mmCreatePurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.CreatePurchaseOrder mock is already set by Expect")
+	}
+
+	if mmCreatePurchaseOrder.defaultExpectation.paramPtrs == nil {
+		mmCreatePurchaseOrder.defaultExpectation.paramPtrs = &StockServiceUseCaseMockCreatePurchaseOrderParamPtrs{}
+	}
+	mmCreatePurchaseOrder.defaultExpectation.paramPtrs.purchaseOrder = &purchaseOrder
+	mmCreatePurchaseOrder.defaultExpectation.expectationOrigins.originPurchaseOrder = minimock.CallerInfo(1)
+
+	return mmCreatePurchaseOrder
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.CreatePurchaseOrder
+func (mmCreatePurchaseOrder *mStockServiceUseCaseMockCreatePurchaseOrder) Inspect(f func(ctx context.Context, purchaseOrder domain.PurchaseOrder)) *mStockServiceUseCaseMockCreatePurchaseOrder {
+	if mmCreatePurchaseOrder.mock.inspectFuncCreatePurchaseOrder != nil {
+		mmCreatePurchaseOrder.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.CreatePurchaseOrder")
+	}
+
+	mmCreatePurchaseOrder.mock.inspectFuncCreatePurchaseOrder = f
+
+	return mmCreatePurchaseOrder
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.CreatePurchaseOrder
+func (mmCreatePurchaseOrder *mStockServiceUseCaseMockCreatePurchaseOrder) Return(p1 domain.PurchaseOrder, err error) *StockServiceUseCaseMock {
+	if mmCreatePurchaseOrder.mock.funcCreatePurchaseOrder != nil {
+		mmCreatePurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.CreatePurchaseOrder mock is already set by Set")
+	}
+
+	if mmCreatePurchaseOrder.defaultExpectation == nil {
+		mmCreatePurchaseOrder.defaultExpectation = &StockServiceUseCaseMockCreatePurchaseOrderExpectation{mock: mmCreatePurchaseOrder.mock}
+	}
+	mmCreatePurchaseOrder.defaultExpectation.results = &StockServiceUseCaseMockCreatePurchaseOrderResults{p1, err}
+	mmCreatePurchaseOrder.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreatePurchaseOrder.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.CreatePurchaseOrder method
+func (mmCreatePurchaseOrder *mStockServiceUseCaseMockCreatePurchaseOrder) Set(f func(ctx context.Context, purchaseOrder domain.PurchaseOrder) (p1 domain.PurchaseOrder, err error)) *StockServiceUseCaseMock {
+	if mmCreatePurchaseOrder.defaultExpectation != nil {
+		mmCreatePurchaseOrder.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.CreatePurchaseOrder method")
+	}
+
+	if len(mmCreatePurchaseOrder.expectations) > 0 {
+		mmCreatePurchaseOrder.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.CreatePurchaseOrder method")
+	}
+
+	mmCreatePurchaseOrder.mock.funcCreatePurchaseOrder = f
+	mmCreatePurchaseOrder.mock.funcCreatePurchaseOrderOrigin = minimock.CallerInfo(1)
+	return mmCreatePurchaseOrder.mock
+}
+
+// When sets expectation for the StockServiceUseCase.CreatePurchaseOrder which will trigger the result defined by the following
+// Then helper
+func (mmCreatePurchaseOrder *mStockServiceUseCaseMockCreatePurchaseOrder) When(ctx context.Context, purchaseOrder domain.PurchaseOrder) *StockServiceUseCaseMockCreatePurchaseOrderExpectation {
+	if mmCreatePurchaseOrder.mock.funcCreatePurchaseOrder != nil {
+		mmCreatePurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.CreatePurchaseOrder mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockCreatePurchaseOrderExpectation{
+		mock:               mmCreatePurchaseOrder.mock,
+		params:             &StockServiceUseCaseMockCreatePurchaseOrderParams{ctx, purchaseOrder},
+		expectationOrigins: StockServiceUseCaseMockCreatePurchaseOrderExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreatePurchaseOrder.expectations = append(mmCreatePurchaseOrder.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.CreatePurchaseOrder return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockCreatePurchaseOrderExpectation) Then(p1 domain.PurchaseOrder, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockCreatePurchaseOrderResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.CreatePurchaseOrder should be invoked
+func (mmCreatePurchaseOrder *mStockServiceUseCaseMockCreatePurchaseOrder) Times(n uint64) *mStockServiceUseCaseMockCreatePurchaseOrder {
+	if n == 0 {
+		mmCreatePurchaseOrder.mock.t.Fatalf("Times of StockServiceUseCaseMock.CreatePurchaseOrder mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreatePurchaseOrder.expectedInvocations, n)
+	mmCreatePurchaseOrder.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreatePurchaseOrder
+}
+
+func (mmCreatePurchaseOrder *mStockServiceUseCaseMockCreatePurchaseOrder) invocationsDone() bool {
+	if len(mmCreatePurchaseOrder.expectations) == 0 && mmCreatePurchaseOrder.defaultExpectation == nil && mmCreatePurchaseOrder.mock.funcCreatePurchaseOrder == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreatePurchaseOrder.mock.afterCreatePurchaseOrderCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreatePurchaseOrder.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreatePurchaseOrder implements mm_usecase.StockServiceUseCase
+func (mmCreatePurchaseOrder *StockServiceUseCaseMock) CreatePurchaseOrder(ctx context.Context, purchaseOrder domain.PurchaseOrder) (p1 domain.PurchaseOrder, err error) {
+	mm_atomic.AddUint64(&mmCreatePurchaseOrder.beforeCreatePurchaseOrderCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreatePurchaseOrder.afterCreatePurchaseOrderCounter, 1)
+
+	mmCreatePurchaseOrder.t.Helper()
+
+	if mmCreatePurchaseOrder.inspectFuncCreatePurchaseOrder != nil {
+		mmCreatePurchaseOrder.inspectFuncCreatePurchaseOrder(ctx, purchaseOrder)
+	}
+
+	mm_params := StockServiceUseCaseMockCreatePurchaseOrderParams{ctx, purchaseOrder}
+
+	// Record call args
+	mmCreatePurchaseOrder.CreatePurchaseOrderMock.mutex.Lock()
+	mmCreatePurchaseOrder.CreatePurchaseOrderMock.callArgs = append(mmCreatePurchaseOrder.CreatePurchaseOrderMock.callArgs, &mm_params)
+	mmCreatePurchaseOrder.CreatePurchaseOrderMock.mutex.Unlock()
+
+	for _, e := range mmCreatePurchaseOrder.CreatePurchaseOrderMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmCreatePurchaseOrder.CreatePurchaseOrderMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreatePurchaseOrder.CreatePurchaseOrderMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreatePurchaseOrder.CreatePurchaseOrderMock.defaultExpectation.params
+		mm_want_ptrs := mmCreatePurchaseOrder.CreatePurchaseOrderMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockCreatePurchaseOrderParams{ctx, purchaseOrder}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreatePurchaseOrder.t.Errorf("StockServiceUseCaseMock.CreatePurchaseOrder got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePurchaseOrder.CreatePurchaseOrderMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.purchaseOrder != nil && !minimock.Equal(*mm_want_ptrs.purchaseOrder, mm_got.purchaseOrder) {
+				mmCreatePurchaseOrder.t.Errorf("StockServiceUseCaseMock.CreatePurchaseOrder got unexpected parameter purchaseOrder, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreatePurchaseOrder.CreatePurchaseOrderMock.defaultExpectation.expectationOrigins.originPurchaseOrder, *mm_want_ptrs.purchaseOrder, mm_got.purchaseOrder, minimock.Diff(*mm_want_ptrs.purchaseOrder, mm_got.purchaseOrder))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreatePurchaseOrder.t.Errorf("StockServiceUseCaseMock.CreatePurchaseOrder got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreatePurchaseOrder.CreatePurchaseOrderMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreatePurchaseOrder.CreatePurchaseOrderMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreatePurchaseOrder.t.Fatal("No results are set for the StockServiceUseCaseMock.CreatePurchaseOrder")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmCreatePurchaseOrder.funcCreatePurchaseOrder != nil {
+		return mmCreatePurchaseOrder.funcCreatePurchaseOrder(ctx, purchaseOrder)
+	}
+	mmCreatePurchaseOrder.t.Fatalf("Unexpected call to StockServiceUseCaseMock.CreatePurchaseOrder. %v %v", ctx, purchaseOrder)
+	return
+}
+
+// CreatePurchaseOrderAfterCounter returns a count of finished StockServiceUseCaseMock.CreatePurchaseOrder invocations
+func (mmCreatePurchaseOrder *StockServiceUseCaseMock) CreatePurchaseOrderAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePurchaseOrder.afterCreatePurchaseOrderCounter)
+}
+
+// CreatePurchaseOrderBeforeCounter returns a count of StockServiceUseCaseMock.CreatePurchaseOrder invocations
+func (mmCreatePurchaseOrder *StockServiceUseCaseMock) CreatePurchaseOrderBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreatePurchaseOrder.beforeCreatePurchaseOrderCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.CreatePurchaseOrder.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreatePurchaseOrder *mStockServiceUseCaseMockCreatePurchaseOrder) Calls() []*StockServiceUseCaseMockCreatePurchaseOrderParams {
+	mmCreatePurchaseOrder.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockCreatePurchaseOrderParams, len(mmCreatePurchaseOrder.callArgs))
+	copy(argCopy, mmCreatePurchaseOrder.callArgs)
+
+	mmCreatePurchaseOrder.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreatePurchaseOrderDone returns true if the count of the CreatePurchaseOrder invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockCreatePurchaseOrderDone() bool {
+	if m.CreatePurchaseOrderMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreatePurchaseOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreatePurchaseOrderMock.invocationsDone()
+}
+
+// MinimockCreatePurchaseOrderInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockCreatePurchaseOrderInspect() {
+	for _, e := range m.CreatePurchaseOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.CreatePurchaseOrder at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreatePurchaseOrderCounter := mm_atomic.LoadUint64(&m.afterCreatePurchaseOrderCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreatePurchaseOrderMock.defaultExpectation != nil && afterCreatePurchaseOrderCounter < 1 {
+		if m.CreatePurchaseOrderMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.CreatePurchaseOrder at\n%s", m.CreatePurchaseOrderMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.CreatePurchaseOrder at\n%s with params: %#v", m.CreatePurchaseOrderMock.defaultExpectation.expectationOrigins.origin, *m.CreatePurchaseOrderMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreatePurchaseOrder != nil && afterCreatePurchaseOrderCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.CreatePurchaseOrder at\n%s", m.funcCreatePurchaseOrderOrigin)
+	}
+
+	if !m.CreatePurchaseOrderMock.invocationsDone() && afterCreatePurchaseOrderCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.CreatePurchaseOrder at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreatePurchaseOrderMock.expectedInvocations), m.CreatePurchaseOrderMock.expectedInvocationsOrigin, afterCreatePurchaseOrderCounter)
+	}
+}
+
+type mStockServiceUseCaseMockCreateSupplier struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockCreateSupplierExpectation
+	expectations       []*StockServiceUseCaseMockCreateSupplierExpectation
+
+	callArgs []*StockServiceUseCaseMockCreateSupplierParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockCreateSupplierExpectation specifies expectation struct of the StockServiceUseCase.CreateSupplier
+type StockServiceUseCaseMockCreateSupplierExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockCreateSupplierParams
+	paramPtrs          *StockServiceUseCaseMockCreateSupplierParamPtrs
+	expectationOrigins StockServiceUseCaseMockCreateSupplierExpectationOrigins
+	results            *StockServiceUseCaseMockCreateSupplierResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockCreateSupplierParams contains parameters of the StockServiceUseCase.CreateSupplier
+type StockServiceUseCaseMockCreateSupplierParams struct {
+	ctx      context.Context
+	supplier domain.Supplier
+}
+
+// StockServiceUseCaseMockCreateSupplierParamPtrs contains pointers to parameters of the StockServiceUseCase.CreateSupplier
+type StockServiceUseCaseMockCreateSupplierParamPtrs struct {
+	ctx      *context.Context
+	supplier *domain.Supplier
+}
+
+// StockServiceUseCaseMockCreateSupplierResults contains results of the StockServiceUseCase.CreateSupplier
+type StockServiceUseCaseMockCreateSupplierResults struct {
+	s1  domain.Supplier
+	err error
+}
+
+// StockServiceUseCaseMockCreateSupplierOrigins contains origins of expectations of the StockServiceUseCase.CreateSupplier
+type StockServiceUseCaseMockCreateSupplierExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originSupplier string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateSupplier *mStockServiceUseCaseMockCreateSupplier) Optional() *mStockServiceUseCaseMockCreateSupplier {
+	mmCreateSupplier.optional = true
+	return mmCreateSupplier
+}
+
+// Expect sets up expected params for StockServiceUseCase.CreateSupplier
+func (mmCreateSupplier *mStockServiceUseCaseMockCreateSupplier) Expect(ctx context.Context, supplier domain.Supplier) *mStockServiceUseCaseMockCreateSupplier {
+	if mmCreateSupplier.mock.funcCreateSupplier != nil {
+		mmCreateSupplier.mock.t.Fatalf("StockServiceUseCaseMock.CreateSupplier mock is already set by Set")
+	}
+
+	if mmCreateSupplier.defaultExpectation == nil {
+		mmCreateSupplier.defaultExpectation = &StockServiceUseCaseMockCreateSupplierExpectation{}
+	}
+
+	if mmCreateSupplier.defaultExpectation.paramPtrs != nil {
+		mmCreateSupplier.mock.t.Fatalf("StockServiceUseCaseMock.CreateSupplier mock is already set by ExpectParams functions")
+	}
+
+	mmCreateSupplier.defaultExpectation.params = &StockServiceUseCaseMockCreateSupplierParams{ctx, supplier}
+	mmCreateSupplier.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateSupplier.expectations {
+		if minimock.Equal(e.params, mmCreateSupplier.defaultExpectation.params) {
+			mmCreateSupplier.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateSupplier.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateSupplier
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.CreateSupplier
+func (mmCreateSupplier *mStockServiceUseCaseMockCreateSupplier) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockCreateSupplier {
+	if mmCreateSupplier.mock.funcCreateSupplier != nil {
+		mmCreateSupplier.mock.t.Fatalf("StockServiceUseCaseMock.CreateSupplier mock is already set by Set")
+	}
+
+	if mmCreateSupplier.defaultExpectation == nil {
+		mmCreateSupplier.defaultExpectation = &StockServiceUseCaseMockCreateSupplierExpectation{}
+	}
+
+	if mmCreateSupplier.defaultExpectation.params != nil {
+		mmCreateSupplier.mock.t.Fatalf("StockServiceUseCaseMock.CreateSupplier mock is already set by Expect")
+	}
+
+	if mmCreateSupplier.defaultExpectation.paramPtrs == nil {
+		mmCreateSupplier.defaultExpectation.paramPtrs = &StockServiceUseCaseMockCreateSupplierParamPtrs{}
+	}
+	mmCreateSupplier.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateSupplier.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateSupplier
+}
+
+// ExpectSupplierParam2 sets up expected param supplier for StockServiceUseCase.CreateSupplier
+func (mmCreateSupplier *mStockServiceUseCaseMockCreateSupplier) ExpectSupplierParam2(supplier domain.Supplier) *mStockServiceUseCaseMockCreateSupplier {
+	if mmCreateSupplier.mock.funcCreateSupplier != nil {
+		mmCreateSupplier.mock.t.Fatalf("StockServiceUseCaseMock.CreateSupplier mock is already set by Set")
+	}
+
+	if mmCreateSupplier.defaultExpectation == nil {
+		mmCreateSupplier.defaultExpectation = &StockServiceUseCaseMockCreateSupplierExpectation{}
+	}
+
+	if mmCreateSupplier.defaultExpectation.params != nil {
+		mmCreateSupplier.mock.t.Fatalf("StockServiceUseCaseMock.CreateSupplier mock is already set by Expect")
+	}
+
+	if mmCreateSupplier.defaultExpectation.paramPtrs == nil {
+		mmCreateSupplier.defaultExpectation.paramPtrs = &StockServiceUseCaseMockCreateSupplierParamPtrs{}
+	}
+	mmCreateSupplier.defaultExpectation.paramPtrs.supplier = &supplier
+	mmCreateSupplier.defaultExpectation.expectationOrigins.originSupplier = minimock.CallerInfo(1)
+
+	return mmCreateSupplier
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.CreateSupplier
+func (mmCreateSupplier *mStockServiceUseCaseMockCreateSupplier) Inspect(f func(ctx context.Context, supplier domain.Supplier)) *mStockServiceUseCaseMockCreateSupplier {
+	if mmCreateSupplier.mock.inspectFuncCreateSupplier != nil {
+		mmCreateSupplier.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.CreateSupplier")
+	}
+
+	mmCreateSupplier.mock.inspectFuncCreateSupplier = f
+
+	return mmCreateSupplier
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.CreateSupplier
+func (mmCreateSupplier *mStockServiceUseCaseMockCreateSupplier) Return(s1 domain.Supplier, err error) *StockServiceUseCaseMock {
+	if mmCreateSupplier.mock.funcCreateSupplier != nil {
+		mmCreateSupplier.mock.t.Fatalf("StockServiceUseCaseMock.CreateSupplier mock is already set by Set")
+	}
+
+	if mmCreateSupplier.defaultExpectation == nil {
+		mmCreateSupplier.defaultExpectation = &StockServiceUseCaseMockCreateSupplierExpectation{mock: mmCreateSupplier.mock}
+	}
+	mmCreateSupplier.defaultExpectation.results = &StockServiceUseCaseMockCreateSupplierResults{s1, err}
+	mmCreateSupplier.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateSupplier.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.CreateSupplier method
+func (mmCreateSupplier *mStockServiceUseCaseMockCreateSupplier) Set(f func(ctx context.Context, supplier domain.Supplier) (s1 domain.Supplier, err error)) *StockServiceUseCaseMock {
+	if mmCreateSupplier.defaultExpectation != nil {
+		mmCreateSupplier.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.CreateSupplier method")
+	}
+
+	if len(mmCreateSupplier.expectations) > 0 {
+		mmCreateSupplier.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.CreateSupplier method")
+	}
+
+	mmCreateSupplier.mock.funcCreateSupplier = f
+	mmCreateSupplier.mock.funcCreateSupplierOrigin = minimock.CallerInfo(1)
+	return mmCreateSupplier.mock
+}
+
+// When sets expectation for the StockServiceUseCase.CreateSupplier which will trigger the result defined by the following
+// Then helper
+func (mmCreateSupplier *mStockServiceUseCaseMockCreateSupplier) When(ctx context.Context, supplier domain.Supplier) *StockServiceUseCaseMockCreateSupplierExpectation {
+	if mmCreateSupplier.mock.funcCreateSupplier != nil {
+		mmCreateSupplier.mock.t.Fatalf("StockServiceUseCaseMock.CreateSupplier mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockCreateSupplierExpectation{
+		mock:               mmCreateSupplier.mock,
+		params:             &StockServiceUseCaseMockCreateSupplierParams{ctx, supplier},
+		expectationOrigins: StockServiceUseCaseMockCreateSupplierExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateSupplier.expectations = append(mmCreateSupplier.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.CreateSupplier return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockCreateSupplierExpectation) Then(s1 domain.Supplier, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockCreateSupplierResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.CreateSupplier should be invoked
+func (mmCreateSupplier *mStockServiceUseCaseMockCreateSupplier) Times(n uint64) *mStockServiceUseCaseMockCreateSupplier {
+	if n == 0 {
+		mmCreateSupplier.mock.t.Fatalf("Times of StockServiceUseCaseMock.CreateSupplier mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateSupplier.expectedInvocations, n)
+	mmCreateSupplier.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateSupplier
+}
+
+func (mmCreateSupplier *mStockServiceUseCaseMockCreateSupplier) invocationsDone() bool {
+	if len(mmCreateSupplier.expectations) == 0 && mmCreateSupplier.defaultExpectation == nil && mmCreateSupplier.mock.funcCreateSupplier == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateSupplier.mock.afterCreateSupplierCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateSupplier.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateSupplier implements mm_usecase.StockServiceUseCase
+func (mmCreateSupplier *StockServiceUseCaseMock) CreateSupplier(ctx context.Context, supplier domain.Supplier) (s1 domain.Supplier, err error) {
+	mm_atomic.AddUint64(&mmCreateSupplier.beforeCreateSupplierCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateSupplier.afterCreateSupplierCounter, 1)
+
+	mmCreateSupplier.t.Helper()
+
+	if mmCreateSupplier.inspectFuncCreateSupplier != nil {
+		mmCreateSupplier.inspectFuncCreateSupplier(ctx, supplier)
+	}
+
+	mm_params := StockServiceUseCaseMockCreateSupplierParams{ctx, supplier}
+
+	// Record call args
+	mmCreateSupplier.CreateSupplierMock.mutex.Lock()
+	mmCreateSupplier.CreateSupplierMock.callArgs = append(mmCreateSupplier.CreateSupplierMock.callArgs, &mm_params)
+	mmCreateSupplier.CreateSupplierMock.mutex.Unlock()
+
+	for _, e := range mmCreateSupplier.CreateSupplierMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmCreateSupplier.CreateSupplierMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateSupplier.CreateSupplierMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateSupplier.CreateSupplierMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateSupplier.CreateSupplierMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockCreateSupplierParams{ctx, supplier}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateSupplier.t.Errorf("StockServiceUseCaseMock.CreateSupplier got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateSupplier.CreateSupplierMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.supplier != nil && !minimock.Equal(*mm_want_ptrs.supplier, mm_got.supplier) {
+				mmCreateSupplier.t.Errorf("StockServiceUseCaseMock.CreateSupplier got unexpected parameter supplier, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateSupplier.CreateSupplierMock.defaultExpectation.expectationOrigins.originSupplier, *mm_want_ptrs.supplier, mm_got.supplier, minimock.Diff(*mm_want_ptrs.supplier, mm_got.supplier))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateSupplier.t.Errorf("StockServiceUseCaseMock.CreateSupplier got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateSupplier.CreateSupplierMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateSupplier.CreateSupplierMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateSupplier.t.Fatal("No results are set for the StockServiceUseCaseMock.CreateSupplier")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmCreateSupplier.funcCreateSupplier != nil {
+		return mmCreateSupplier.funcCreateSupplier(ctx, supplier)
+	}
+	mmCreateSupplier.t.Fatalf("Unexpected call to StockServiceUseCaseMock.CreateSupplier. %v %v", ctx, supplier)
+	return
+}
+
+// CreateSupplierAfterCounter returns a count of finished StockServiceUseCaseMock.CreateSupplier invocations
+func (mmCreateSupplier *StockServiceUseCaseMock) CreateSupplierAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateSupplier.afterCreateSupplierCounter)
+}
+
+// CreateSupplierBeforeCounter returns a count of StockServiceUseCaseMock.CreateSupplier invocations
+func (mmCreateSupplier *StockServiceUseCaseMock) CreateSupplierBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateSupplier.beforeCreateSupplierCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.CreateSupplier.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateSupplier *mStockServiceUseCaseMockCreateSupplier) Calls() []*StockServiceUseCaseMockCreateSupplierParams {
+	mmCreateSupplier.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockCreateSupplierParams, len(mmCreateSupplier.callArgs))
+	copy(argCopy, mmCreateSupplier.callArgs)
+
+	mmCreateSupplier.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateSupplierDone returns true if the count of the CreateSupplier invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockCreateSupplierDone() bool {
+	if m.CreateSupplierMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateSupplierMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateSupplierMock.invocationsDone()
+}
+
+// MinimockCreateSupplierInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockCreateSupplierInspect() {
+	for _, e := range m.CreateSupplierMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.CreateSupplier at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateSupplierCounter := mm_atomic.LoadUint64(&m.afterCreateSupplierCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateSupplierMock.defaultExpectation != nil && afterCreateSupplierCounter < 1 {
+		if m.CreateSupplierMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.CreateSupplier at\n%s", m.CreateSupplierMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.CreateSupplier at\n%s with params: %#v", m.CreateSupplierMock.defaultExpectation.expectationOrigins.origin, *m.CreateSupplierMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateSupplier != nil && afterCreateSupplierCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.CreateSupplier at\n%s", m.funcCreateSupplierOrigin)
+	}
+
+	if !m.CreateSupplierMock.invocationsDone() && afterCreateSupplierCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.CreateSupplier at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateSupplierMock.expectedInvocations), m.CreateSupplierMock.expectedInvocationsOrigin, afterCreateSupplierCounter)
+	}
+}
+
+type mStockServiceUseCaseMockCreateVariantGroup struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockCreateVariantGroupExpectation
+	expectations       []*StockServiceUseCaseMockCreateVariantGroupExpectation
+
+	callArgs []*StockServiceUseCaseMockCreateVariantGroupParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockCreateVariantGroupExpectation specifies expectation struct of the StockServiceUseCase.CreateVariantGroup
+type StockServiceUseCaseMockCreateVariantGroupExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockCreateVariantGroupParams
+	paramPtrs          *StockServiceUseCaseMockCreateVariantGroupParamPtrs
+	expectationOrigins StockServiceUseCaseMockCreateVariantGroupExpectationOrigins
+	results            *StockServiceUseCaseMockCreateVariantGroupResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockCreateVariantGroupParams contains parameters of the StockServiceUseCase.CreateVariantGroup
+type StockServiceUseCaseMockCreateVariantGroupParams struct {
+	ctx   context.Context
+	group domain.VariantGroup
+}
+
+// StockServiceUseCaseMockCreateVariantGroupParamPtrs contains pointers to parameters of the StockServiceUseCase.CreateVariantGroup
+type StockServiceUseCaseMockCreateVariantGroupParamPtrs struct {
+	ctx   *context.Context
+	group *domain.VariantGroup
+}
+
+// StockServiceUseCaseMockCreateVariantGroupResults contains results of the StockServiceUseCase.CreateVariantGroup
+type StockServiceUseCaseMockCreateVariantGroupResults struct {
+	v1  domain.VariantGroup
+	err error
+}
+
+// StockServiceUseCaseMockCreateVariantGroupOrigins contains origins of expectations of the StockServiceUseCase.CreateVariantGroup
+type StockServiceUseCaseMockCreateVariantGroupExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originGroup string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateVariantGroup *mStockServiceUseCaseMockCreateVariantGroup) Optional() *mStockServiceUseCaseMockCreateVariantGroup {
+	mmCreateVariantGroup.optional = true
+	return mmCreateVariantGroup
+}
+
+// Expect sets up expected params for StockServiceUseCase.CreateVariantGroup
+func (mmCreateVariantGroup *mStockServiceUseCaseMockCreateVariantGroup) Expect(ctx context.Context, group domain.VariantGroup) *mStockServiceUseCaseMockCreateVariantGroup {
+	if mmCreateVariantGroup.mock.funcCreateVariantGroup != nil {
+		mmCreateVariantGroup.mock.t.Fatalf("StockServiceUseCaseMock.CreateVariantGroup mock is already set by Set")
+	}
+
+	if mmCreateVariantGroup.defaultExpectation == nil {
+		mmCreateVariantGroup.defaultExpectation = &StockServiceUseCaseMockCreateVariantGroupExpectation{}
+	}
+
+	if mmCreateVariantGroup.defaultExpectation.paramPtrs != nil {
+		mmCreateVariantGroup.mock.t.Fatalf("StockServiceUseCaseMock.CreateVariantGroup mock is already set by ExpectParams functions")
+	}
+
+	mmCreateVariantGroup.defaultExpectation.params = &StockServiceUseCaseMockCreateVariantGroupParams{ctx, group}
+	mmCreateVariantGroup.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateVariantGroup.expectations {
+		if minimock.Equal(e.params, mmCreateVariantGroup.defaultExpectation.params) {
+			mmCreateVariantGroup.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateVariantGroup.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateVariantGroup
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.CreateVariantGroup
+func (mmCreateVariantGroup *mStockServiceUseCaseMockCreateVariantGroup) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockCreateVariantGroup {
+	if mmCreateVariantGroup.mock.funcCreateVariantGroup != nil {
+		mmCreateVariantGroup.mock.t.Fatalf("StockServiceUseCaseMock.CreateVariantGroup mock is already set by Set")
+	}
+
+	if mmCreateVariantGroup.defaultExpectation == nil {
+		mmCreateVariantGroup.defaultExpectation = &StockServiceUseCaseMockCreateVariantGroupExpectation{}
+	}
+
+	if mmCreateVariantGroup.defaultExpectation.params != nil {
+		mmCreateVariantGroup.mock.t.Fatalf("StockServiceUseCaseMock.CreateVariantGroup mock is already set by Expect")
+	}
+
+	if mmCreateVariantGroup.defaultExpectation.paramPtrs == nil {
+		mmCreateVariantGroup.defaultExpectation.paramPtrs = &StockServiceUseCaseMockCreateVariantGroupParamPtrs{}
+	}
+	mmCreateVariantGroup.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateVariantGroup.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateVariantGroup
+}
+
+// ExpectGroupParam2 sets up expected param group for StockServiceUseCase.CreateVariantGroup
+func (mmCreateVariantGroup *mStockServiceUseCaseMockCreateVariantGroup) ExpectGroupParam2(group domain.VariantGroup) *mStockServiceUseCaseMockCreateVariantGroup {
+	if mmCreateVariantGroup.mock.funcCreateVariantGroup != nil {
+		mmCreateVariantGroup.mock.t.Fatalf("StockServiceUseCaseMock.CreateVariantGroup mock is already set by Set")
+	}
+
+	if mmCreateVariantGroup.defaultExpectation == nil {
+		mmCreateVariantGroup.defaultExpectation = &StockServiceUseCaseMockCreateVariantGroupExpectation{}
+	}
+
+	if mmCreateVariantGroup.defaultExpectation.params != nil {
+		mmCreateVariantGroup.mock.t.Fatalf("StockServiceUseCaseMock.CreateVariantGroup mock is already set by Expect")
+	}
+
+	if mmCreateVariantGroup.defaultExpectation.paramPtrs == nil {
+		mmCreateVariantGroup.defaultExpectation.paramPtrs = &StockServiceUseCaseMockCreateVariantGroupParamPtrs{}
+	}
+	mmCreateVariantGroup.defaultExpectation.paramPtrs.group = &group
+	mmCreateVariantGroup.defaultExpectation.expectationOrigins.originGroup = minimock.CallerInfo(1)
+
+	return mmCreateVariantGroup
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.CreateVariantGroup
+func (mmCreateVariantGroup *mStockServiceUseCaseMockCreateVariantGroup) Inspect(f func(ctx context.Context, group domain.VariantGroup)) *mStockServiceUseCaseMockCreateVariantGroup {
+	if mmCreateVariantGroup.mock.inspectFuncCreateVariantGroup != nil {
+		mmCreateVariantGroup.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.CreateVariantGroup")
+	}
+
+	mmCreateVariantGroup.mock.inspectFuncCreateVariantGroup = f
+
+	return mmCreateVariantGroup
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.CreateVariantGroup
+func (mmCreateVariantGroup *mStockServiceUseCaseMockCreateVariantGroup) Return(v1 domain.VariantGroup, err error) *StockServiceUseCaseMock {
+	if mmCreateVariantGroup.mock.funcCreateVariantGroup != nil {
+		mmCreateVariantGroup.mock.t.Fatalf("StockServiceUseCaseMock.CreateVariantGroup mock is already set by Set")
+	}
+
+	if mmCreateVariantGroup.defaultExpectation == nil {
+		mmCreateVariantGroup.defaultExpectation = &StockServiceUseCaseMockCreateVariantGroupExpectation{mock: mmCreateVariantGroup.mock}
+	}
+	mmCreateVariantGroup.defaultExpectation.results = &StockServiceUseCaseMockCreateVariantGroupResults{v1, err}
+	mmCreateVariantGroup.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateVariantGroup.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.CreateVariantGroup method
+func (mmCreateVariantGroup *mStockServiceUseCaseMockCreateVariantGroup) Set(f func(ctx context.Context, group domain.VariantGroup) (v1 domain.VariantGroup, err error)) *StockServiceUseCaseMock {
+	if mmCreateVariantGroup.defaultExpectation != nil {
+		mmCreateVariantGroup.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.CreateVariantGroup method")
+	}
+
+	if len(mmCreateVariantGroup.expectations) > 0 {
+		mmCreateVariantGroup.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.CreateVariantGroup method")
+	}
+
+	mmCreateVariantGroup.mock.funcCreateVariantGroup = f
+	mmCreateVariantGroup.mock.funcCreateVariantGroupOrigin = minimock.CallerInfo(1)
+	return mmCreateVariantGroup.mock
+}
+
+// When sets expectation for the StockServiceUseCase.CreateVariantGroup which will trigger the result defined by the following
+// Then helper
+func (mmCreateVariantGroup *mStockServiceUseCaseMockCreateVariantGroup) When(ctx context.Context, group domain.VariantGroup) *StockServiceUseCaseMockCreateVariantGroupExpectation {
+	if mmCreateVariantGroup.mock.funcCreateVariantGroup != nil {
+		mmCreateVariantGroup.mock.t.Fatalf("StockServiceUseCaseMock.CreateVariantGroup mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockCreateVariantGroupExpectation{
+		mock:               mmCreateVariantGroup.mock,
+		params:             &StockServiceUseCaseMockCreateVariantGroupParams{ctx, group},
+		expectationOrigins: StockServiceUseCaseMockCreateVariantGroupExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateVariantGroup.expectations = append(mmCreateVariantGroup.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.CreateVariantGroup return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockCreateVariantGroupExpectation) Then(v1 domain.VariantGroup, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockCreateVariantGroupResults{v1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.CreateVariantGroup should be invoked
+func (mmCreateVariantGroup *mStockServiceUseCaseMockCreateVariantGroup) Times(n uint64) *mStockServiceUseCaseMockCreateVariantGroup {
+	if n == 0 {
+		mmCreateVariantGroup.mock.t.Fatalf("Times of StockServiceUseCaseMock.CreateVariantGroup mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateVariantGroup.expectedInvocations, n)
+	mmCreateVariantGroup.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateVariantGroup
+}
+
+func (mmCreateVariantGroup *mStockServiceUseCaseMockCreateVariantGroup) invocationsDone() bool {
+	if len(mmCreateVariantGroup.expectations) == 0 && mmCreateVariantGroup.defaultExpectation == nil && mmCreateVariantGroup.mock.funcCreateVariantGroup == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateVariantGroup.mock.afterCreateVariantGroupCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateVariantGroup.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateVariantGroup implements mm_usecase.StockServiceUseCase
+func (mmCreateVariantGroup *StockServiceUseCaseMock) CreateVariantGroup(ctx context.Context, group domain.VariantGroup) (v1 domain.VariantGroup, err error) {
+	mm_atomic.AddUint64(&mmCreateVariantGroup.beforeCreateVariantGroupCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateVariantGroup.afterCreateVariantGroupCounter, 1)
+
+	mmCreateVariantGroup.t.Helper()
+
+	if mmCreateVariantGroup.inspectFuncCreateVariantGroup != nil {
+		mmCreateVariantGroup.inspectFuncCreateVariantGroup(ctx, group)
+	}
+
+	mm_params := StockServiceUseCaseMockCreateVariantGroupParams{ctx, group}
+
+	// Record call args
+	mmCreateVariantGroup.CreateVariantGroupMock.mutex.Lock()
+	mmCreateVariantGroup.CreateVariantGroupMock.callArgs = append(mmCreateVariantGroup.CreateVariantGroupMock.callArgs, &mm_params)
+	mmCreateVariantGroup.CreateVariantGroupMock.mutex.Unlock()
+
+	for _, e := range mmCreateVariantGroup.CreateVariantGroupMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.v1, e.results.err
+		}
+	}
+
+	if mmCreateVariantGroup.CreateVariantGroupMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateVariantGroup.CreateVariantGroupMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateVariantGroup.CreateVariantGroupMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateVariantGroup.CreateVariantGroupMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockCreateVariantGroupParams{ctx, group}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateVariantGroup.t.Errorf("StockServiceUseCaseMock.CreateVariantGroup got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateVariantGroup.CreateVariantGroupMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.group != nil && !minimock.Equal(*mm_want_ptrs.group, mm_got.group) {
+				mmCreateVariantGroup.t.Errorf("StockServiceUseCaseMock.CreateVariantGroup got unexpected parameter group, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateVariantGroup.CreateVariantGroupMock.defaultExpectation.expectationOrigins.originGroup, *mm_want_ptrs.group, mm_got.group, minimock.Diff(*mm_want_ptrs.group, mm_got.group))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateVariantGroup.t.Errorf("StockServiceUseCaseMock.CreateVariantGroup got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateVariantGroup.CreateVariantGroupMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateVariantGroup.CreateVariantGroupMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateVariantGroup.t.Fatal("No results are set for the StockServiceUseCaseMock.CreateVariantGroup")
+		}
+		return (*mm_results).v1, (*mm_results).err
+	}
+	if mmCreateVariantGroup.funcCreateVariantGroup != nil {
+		return mmCreateVariantGroup.funcCreateVariantGroup(ctx, group)
+	}
+	mmCreateVariantGroup.t.Fatalf("Unexpected call to StockServiceUseCaseMock.CreateVariantGroup. %v %v", ctx, group)
+	return
+}
+
+// CreateVariantGroupAfterCounter returns a count of finished StockServiceUseCaseMock.CreateVariantGroup invocations
+func (mmCreateVariantGroup *StockServiceUseCaseMock) CreateVariantGroupAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateVariantGroup.afterCreateVariantGroupCounter)
+}
+
+// CreateVariantGroupBeforeCounter returns a count of StockServiceUseCaseMock.CreateVariantGroup invocations
+func (mmCreateVariantGroup *StockServiceUseCaseMock) CreateVariantGroupBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateVariantGroup.beforeCreateVariantGroupCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.CreateVariantGroup.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateVariantGroup *mStockServiceUseCaseMockCreateVariantGroup) Calls() []*StockServiceUseCaseMockCreateVariantGroupParams {
+	mmCreateVariantGroup.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockCreateVariantGroupParams, len(mmCreateVariantGroup.callArgs))
+	copy(argCopy, mmCreateVariantGroup.callArgs)
+
+	mmCreateVariantGroup.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateVariantGroupDone returns true if the count of the CreateVariantGroup invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockCreateVariantGroupDone() bool {
+	if m.CreateVariantGroupMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateVariantGroupMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateVariantGroupMock.invocationsDone()
+}
+
+// MinimockCreateVariantGroupInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockCreateVariantGroupInspect() {
+	for _, e := range m.CreateVariantGroupMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.CreateVariantGroup at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateVariantGroupCounter := mm_atomic.LoadUint64(&m.afterCreateVariantGroupCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateVariantGroupMock.defaultExpectation != nil && afterCreateVariantGroupCounter < 1 {
+		if m.CreateVariantGroupMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.CreateVariantGroup at\n%s", m.CreateVariantGroupMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.CreateVariantGroup at\n%s with params: %#v", m.CreateVariantGroupMock.defaultExpectation.expectationOrigins.origin, *m.CreateVariantGroupMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateVariantGroup != nil && afterCreateVariantGroupCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.CreateVariantGroup at\n%s", m.funcCreateVariantGroupOrigin)
+	}
+
+	if !m.CreateVariantGroupMock.invocationsDone() && afterCreateVariantGroupCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.CreateVariantGroup at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateVariantGroupMock.expectedInvocations), m.CreateVariantGroupMock.expectedInvocationsOrigin, afterCreateVariantGroupCounter)
+	}
+}
+
+type mStockServiceUseCaseMockDeleteStockItem struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockDeleteStockItemExpectation
+	expectations       []*StockServiceUseCaseMockDeleteStockItemExpectation
+
+	callArgs []*StockServiceUseCaseMockDeleteStockItemParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockDeleteStockItemExpectation specifies expectation struct of the StockServiceUseCase.DeleteStockItem
+type StockServiceUseCaseMockDeleteStockItemExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockDeleteStockItemParams
+	paramPtrs          *StockServiceUseCaseMockDeleteStockItemParamPtrs
+	expectationOrigins StockServiceUseCaseMockDeleteStockItemExpectationOrigins
+	results            *StockServiceUseCaseMockDeleteStockItemResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockDeleteStockItemParams contains parameters of the StockServiceUseCase.DeleteStockItem
+type StockServiceUseCaseMockDeleteStockItemParams struct {
+	ctx       context.Context
+	userID    domain.UserID
+	skuID     domain.SKUID
+	deletedBy domain.UserID
+}
+
+// StockServiceUseCaseMockDeleteStockItemParamPtrs contains pointers to parameters of the StockServiceUseCase.DeleteStockItem
+type StockServiceUseCaseMockDeleteStockItemParamPtrs struct {
+	ctx       *context.Context
+	userID    *domain.UserID
+	skuID     *domain.SKUID
+	deletedBy *domain.UserID
+}
+
+// StockServiceUseCaseMockDeleteStockItemResults contains results of the StockServiceUseCase.DeleteStockItem
+type StockServiceUseCaseMockDeleteStockItemResults struct {
+	err error
+}
+
+// StockServiceUseCaseMockDeleteStockItemOrigins contains origins of expectations of the StockServiceUseCase.DeleteStockItem
+type StockServiceUseCaseMockDeleteStockItemExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUserID    string
+	originSkuID     string
+	originDeletedBy string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteStockItem *mStockServiceUseCaseMockDeleteStockItem) Optional() *mStockServiceUseCaseMockDeleteStockItem {
+	mmDeleteStockItem.optional = true
+	return mmDeleteStockItem
+}
+
+// Expect sets up expected params for StockServiceUseCase.DeleteStockItem
+func (mmDeleteStockItem *mStockServiceUseCaseMockDeleteStockItem) Expect(ctx context.Context, userID domain.UserID, skuID domain.SKUID, deletedBy domain.UserID) *mStockServiceUseCaseMockDeleteStockItem {
+	if mmDeleteStockItem.mock.funcDeleteStockItem != nil {
+		mmDeleteStockItem.mock.t.Fatalf("StockServiceUseCaseMock.DeleteStockItem mock is already set by Set")
+	}
+
+	if mmDeleteStockItem.defaultExpectation == nil {
+		mmDeleteStockItem.defaultExpectation = &StockServiceUseCaseMockDeleteStockItemExpectation{}
+	}
+
+	if mmDeleteStockItem.defaultExpectation.paramPtrs != nil {
+		mmDeleteStockItem.mock.t.Fatalf("StockServiceUseCaseMock.DeleteStockItem mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteStockItem.defaultExpectation.params = &StockServiceUseCaseMockDeleteStockItemParams{ctx, userID, skuID, deletedBy}
+	mmDeleteStockItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteStockItem.expectations {
+		if minimock.Equal(e.params, mmDeleteStockItem.defaultExpectation.params) {
+			mmDeleteStockItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteStockItem.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteStockItem
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.DeleteStockItem
+func (mmDeleteStockItem *mStockServiceUseCaseMockDeleteStockItem) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockDeleteStockItem {
+	if mmDeleteStockItem.mock.funcDeleteStockItem != nil {
+		mmDeleteStockItem.mock.t.Fatalf("StockServiceUseCaseMock.DeleteStockItem mock is already set by Set")
+	}
+
+	if mmDeleteStockItem.defaultExpectation == nil {
+		mmDeleteStockItem.defaultExpectation = &StockServiceUseCaseMockDeleteStockItemExpectation{}
+	}
+
+	if mmDeleteStockItem.defaultExpectation.params != nil {
+		mmDeleteStockItem.mock.t.Fatalf("StockServiceUseCaseMock.DeleteStockItem mock is already set by Expect")
+	}
+
+	if mmDeleteStockItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteStockItem.defaultExpectation.paramPtrs = &StockServiceUseCaseMockDeleteStockItemParamPtrs{}
+	}
+	mmDeleteStockItem.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteStockItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteStockItem
+}
+
+// ExpectUserIDParam2 sets up expected param userID for StockServiceUseCase.DeleteStockItem
+func (mmDeleteStockItem *mStockServiceUseCaseMockDeleteStockItem) ExpectUserIDParam2(userID domain.UserID) *mStockServiceUseCaseMockDeleteStockItem {
+	if mmDeleteStockItem.mock.funcDeleteStockItem != nil {
+		mmDeleteStockItem.mock.t.Fatalf("StockServiceUseCaseMock.DeleteStockItem mock is already set by Set")
+	}
+
+	if mmDeleteStockItem.defaultExpectation == nil {
+		mmDeleteStockItem.defaultExpectation = &StockServiceUseCaseMockDeleteStockItemExpectation{}
+	}
+
+	if mmDeleteStockItem.defaultExpectation.params != nil {
+		mmDeleteStockItem.mock.t.Fatalf("StockServiceUseCaseMock.DeleteStockItem mock is already set by Expect")
+	}
+
+	if mmDeleteStockItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteStockItem.defaultExpectation.paramPtrs = &StockServiceUseCaseMockDeleteStockItemParamPtrs{}
+	}
+	mmDeleteStockItem.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteStockItem.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteStockItem
+}
+
+// ExpectSkuIDParam3 sets up expected param skuID for StockServiceUseCase.DeleteStockItem
+func (mmDeleteStockItem *mStockServiceUseCaseMockDeleteStockItem) ExpectSkuIDParam3(skuID domain.SKUID) *mStockServiceUseCaseMockDeleteStockItem {
+	if mmDeleteStockItem.mock.funcDeleteStockItem != nil {
+		mmDeleteStockItem.mock.t.Fatalf("StockServiceUseCaseMock.DeleteStockItem mock is already set by Set")
+	}
+
+	if mmDeleteStockItem.defaultExpectation == nil {
+		mmDeleteStockItem.defaultExpectation = &StockServiceUseCaseMockDeleteStockItemExpectation{}
+	}
+
+	if mmDeleteStockItem.defaultExpectation.params != nil {
+		mmDeleteStockItem.mock.t.Fatalf("StockServiceUseCaseMock.DeleteStockItem mock is already set by Expect")
+	}
+
+	if mmDeleteStockItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteStockItem.defaultExpectation.paramPtrs = &StockServiceUseCaseMockDeleteStockItemParamPtrs{}
+	}
+	mmDeleteStockItem.defaultExpectation.paramPtrs.skuID = &skuID
+	mmDeleteStockItem.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmDeleteStockItem
+}
+
+// ExpectDeletedByParam4 sets up expected param deletedBy for StockServiceUseCase.DeleteStockItem
+func (mmDeleteStockItem *mStockServiceUseCaseMockDeleteStockItem) ExpectDeletedByParam4(deletedBy domain.UserID) *mStockServiceUseCaseMockDeleteStockItem {
+	if mmDeleteStockItem.mock.funcDeleteStockItem != nil {
+		mmDeleteStockItem.mock.t.Fatalf("StockServiceUseCaseMock.DeleteStockItem mock is already set by Set")
+	}
+
+	if mmDeleteStockItem.defaultExpectation == nil {
+		mmDeleteStockItem.defaultExpectation = &StockServiceUseCaseMockDeleteStockItemExpectation{}
+	}
+
+	if mmDeleteStockItem.defaultExpectation.params != nil {
+		mmDeleteStockItem.mock.t.Fatalf("StockServiceUseCaseMock.DeleteStockItem mock is already set by Expect")
+	}
+
+	if mmDeleteStockItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteStockItem.defaultExpectation.paramPtrs = &StockServiceUseCaseMockDeleteStockItemParamPtrs{}
+	}
+	mmDeleteStockItem.defaultExpectation.paramPtrs.deletedBy = &deletedBy
+	mmDeleteStockItem.defaultExpectation.expectationOrigins.originDeletedBy = minimock.CallerInfo(1)
+
+	return mmDeleteStockItem
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.DeleteStockItem
+func (mmDeleteStockItem *mStockServiceUseCaseMockDeleteStockItem) Inspect(f func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, deletedBy domain.UserID)) *mStockServiceUseCaseMockDeleteStockItem {
+	if mmDeleteStockItem.mock.inspectFuncDeleteStockItem != nil {
+		mmDeleteStockItem.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.DeleteStockItem")
+	}
+
+	mmDeleteStockItem.mock.inspectFuncDeleteStockItem = f
+
+	return mmDeleteStockItem
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.DeleteStockItem
+func (mmDeleteStockItem *mStockServiceUseCaseMockDeleteStockItem) Return(err error) *StockServiceUseCaseMock {
+	if mmDeleteStockItem.mock.funcDeleteStockItem != nil {
+		mmDeleteStockItem.mock.t.Fatalf("StockServiceUseCaseMock.DeleteStockItem mock is already set by Set")
+	}
+
+	if mmDeleteStockItem.defaultExpectation == nil {
+		mmDeleteStockItem.defaultExpectation = &StockServiceUseCaseMockDeleteStockItemExpectation{mock: mmDeleteStockItem.mock}
+	}
+	mmDeleteStockItem.defaultExpectation.results = &StockServiceUseCaseMockDeleteStockItemResults{err}
+	mmDeleteStockItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteStockItem.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.DeleteStockItem method
+func (mmDeleteStockItem *mStockServiceUseCaseMockDeleteStockItem) Set(f func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, deletedBy domain.UserID) (err error)) *StockServiceUseCaseMock {
+	if mmDeleteStockItem.defaultExpectation != nil {
+		mmDeleteStockItem.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.DeleteStockItem method")
+	}
+
+	if len(mmDeleteStockItem.expectations) > 0 {
+		mmDeleteStockItem.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.DeleteStockItem method")
+	}
+
+	mmDeleteStockItem.mock.funcDeleteStockItem = f
+	mmDeleteStockItem.mock.funcDeleteStockItemOrigin = minimock.CallerInfo(1)
+	return mmDeleteStockItem.mock
+}
+
+// When sets expectation for the StockServiceUseCase.DeleteStockItem which will trigger the result defined by the following
+// Then helper
+func (mmDeleteStockItem *mStockServiceUseCaseMockDeleteStockItem) When(ctx context.Context, userID domain.UserID, skuID domain.SKUID, deletedBy domain.UserID) *StockServiceUseCaseMockDeleteStockItemExpectation {
+	if mmDeleteStockItem.mock.funcDeleteStockItem != nil {
+		mmDeleteStockItem.mock.t.Fatalf("StockServiceUseCaseMock.DeleteStockItem mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockDeleteStockItemExpectation{
+		mock:               mmDeleteStockItem.mock,
+		params:             &StockServiceUseCaseMockDeleteStockItemParams{ctx, userID, skuID, deletedBy},
+		expectationOrigins: StockServiceUseCaseMockDeleteStockItemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteStockItem.expectations = append(mmDeleteStockItem.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.DeleteStockItem return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockDeleteStockItemExpectation) Then(err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockDeleteStockItemResults{err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.DeleteStockItem should be invoked
+func (mmDeleteStockItem *mStockServiceUseCaseMockDeleteStockItem) Times(n uint64) *mStockServiceUseCaseMockDeleteStockItem {
+	if n == 0 {
+		mmDeleteStockItem.mock.t.Fatalf("Times of StockServiceUseCaseMock.DeleteStockItem mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteStockItem.expectedInvocations, n)
+	mmDeleteStockItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteStockItem
+}
+
+func (mmDeleteStockItem *mStockServiceUseCaseMockDeleteStockItem) invocationsDone() bool {
+	if len(mmDeleteStockItem.expectations) == 0 && mmDeleteStockItem.defaultExpectation == nil && mmDeleteStockItem.mock.funcDeleteStockItem == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteStockItem.mock.afterDeleteStockItemCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteStockItem.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteStockItem implements mm_usecase.StockServiceUseCase
+func (mmDeleteStockItem *StockServiceUseCaseMock) DeleteStockItem(ctx context.Context, userID domain.UserID, skuID domain.SKUID, deletedBy domain.UserID) (err error) {
+	mm_atomic.AddUint64(&mmDeleteStockItem.beforeDeleteStockItemCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteStockItem.afterDeleteStockItemCounter, 1)
+
+	mmDeleteStockItem.t.Helper()
+
+	if mmDeleteStockItem.inspectFuncDeleteStockItem != nil {
+		mmDeleteStockItem.inspectFuncDeleteStockItem(ctx, userID, skuID, deletedBy)
+	}
+
+	mm_params := StockServiceUseCaseMockDeleteStockItemParams{ctx, userID, skuID, deletedBy}
+
+	// Record call args
+	mmDeleteStockItem.DeleteStockItemMock.mutex.Lock()
+	mmDeleteStockItem.DeleteStockItemMock.callArgs = append(mmDeleteStockItem.DeleteStockItemMock.callArgs, &mm_params)
+	mmDeleteStockItem.DeleteStockItemMock.mutex.Unlock()
+
+	for _, e := range mmDeleteStockItem.DeleteStockItemMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteStockItem.DeleteStockItemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteStockItem.DeleteStockItemMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteStockItem.DeleteStockItemMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteStockItem.DeleteStockItemMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockDeleteStockItemParams{ctx, userID, skuID, deletedBy}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteStockItem.t.Errorf("StockServiceUseCaseMock.DeleteStockItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteStockItem.DeleteStockItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteStockItem.t.Errorf("StockServiceUseCaseMock.DeleteStockItem got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteStockItem.DeleteStockItemMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmDeleteStockItem.t.Errorf("StockServiceUseCaseMock.DeleteStockItem got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteStockItem.DeleteStockItemMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.deletedBy != nil && !minimock.Equal(*mm_want_ptrs.deletedBy, mm_got.deletedBy) {
+				mmDeleteStockItem.t.Errorf("StockServiceUseCaseMock.DeleteStockItem got unexpected parameter deletedBy, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteStockItem.DeleteStockItemMock.defaultExpectation.expectationOrigins.originDeletedBy, *mm_want_ptrs.deletedBy, mm_got.deletedBy, minimock.Diff(*mm_want_ptrs.deletedBy, mm_got.deletedBy))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteStockItem.t.Errorf("StockServiceUseCaseMock.DeleteStockItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteStockItem.DeleteStockItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteStockItem.DeleteStockItemMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteStockItem.t.Fatal("No results are set for the StockServiceUseCaseMock.DeleteStockItem")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteStockItem.funcDeleteStockItem != nil {
+		return mmDeleteStockItem.funcDeleteStockItem(ctx, userID, skuID, deletedBy)
+	}
+	mmDeleteStockItem.t.Fatalf("Unexpected call to StockServiceUseCaseMock.DeleteStockItem. %v %v %v %v", ctx, userID, skuID, deletedBy)
+	return
+}
+
+// DeleteStockItemAfterCounter returns a count of finished StockServiceUseCaseMock.DeleteStockItem invocations
+func (mmDeleteStockItem *StockServiceUseCaseMock) DeleteStockItemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteStockItem.afterDeleteStockItemCounter)
+}
+
+// DeleteStockItemBeforeCounter returns a count of StockServiceUseCaseMock.DeleteStockItem invocations
+func (mmDeleteStockItem *StockServiceUseCaseMock) DeleteStockItemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteStockItem.beforeDeleteStockItemCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.DeleteStockItem.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteStockItem *mStockServiceUseCaseMockDeleteStockItem) Calls() []*StockServiceUseCaseMockDeleteStockItemParams {
+	mmDeleteStockItem.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockDeleteStockItemParams, len(mmDeleteStockItem.callArgs))
+	copy(argCopy, mmDeleteStockItem.callArgs)
+
+	mmDeleteStockItem.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteStockItemDone returns true if the count of the DeleteStockItem invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockDeleteStockItemDone() bool {
+	if m.DeleteStockItemMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteStockItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteStockItemMock.invocationsDone()
+}
+
+// MinimockDeleteStockItemInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockDeleteStockItemInspect() {
+	for _, e := range m.DeleteStockItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.DeleteStockItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteStockItemCounter := mm_atomic.LoadUint64(&m.afterDeleteStockItemCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteStockItemMock.defaultExpectation != nil && afterDeleteStockItemCounter < 1 {
+		if m.DeleteStockItemMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.DeleteStockItem at\n%s", m.DeleteStockItemMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.DeleteStockItem at\n%s with params: %#v", m.DeleteStockItemMock.defaultExpectation.expectationOrigins.origin, *m.DeleteStockItemMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteStockItem != nil && afterDeleteStockItemCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.DeleteStockItem at\n%s", m.funcDeleteStockItemOrigin)
+	}
+
+	if !m.DeleteStockItemMock.invocationsDone() && afterDeleteStockItemCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.DeleteStockItem at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteStockItemMock.expectedInvocations), m.DeleteStockItemMock.expectedInvocationsOrigin, afterDeleteStockItemCounter)
+	}
+}
+
+type mStockServiceUseCaseMockExpireStockLots struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockExpireStockLotsExpectation
+	expectations       []*StockServiceUseCaseMockExpireStockLotsExpectation
+
+	callArgs []*StockServiceUseCaseMockExpireStockLotsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockExpireStockLotsExpectation specifies expectation struct of the StockServiceUseCase.ExpireStockLots
+type StockServiceUseCaseMockExpireStockLotsExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockExpireStockLotsParams
+	paramPtrs          *StockServiceUseCaseMockExpireStockLotsParamPtrs
+	expectationOrigins StockServiceUseCaseMockExpireStockLotsExpectationOrigins
+	results            *StockServiceUseCaseMockExpireStockLotsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockExpireStockLotsParams contains parameters of the StockServiceUseCase.ExpireStockLots
+type StockServiceUseCaseMockExpireStockLotsParams struct {
+	ctx context.Context
+}
+
+// StockServiceUseCaseMockExpireStockLotsParamPtrs contains pointers to parameters of the StockServiceUseCase.ExpireStockLots
+type StockServiceUseCaseMockExpireStockLotsParamPtrs struct {
+	ctx *context.Context
+}
+
+// StockServiceUseCaseMockExpireStockLotsResults contains results of the StockServiceUseCase.ExpireStockLots
+type StockServiceUseCaseMockExpireStockLotsResults struct {
+	err error
+}
+
+// StockServiceUseCaseMockExpireStockLotsOrigins contains origins of expectations of the StockServiceUseCase.ExpireStockLots
+type StockServiceUseCaseMockExpireStockLotsExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExpireStockLots *mStockServiceUseCaseMockExpireStockLots) Optional() *mStockServiceUseCaseMockExpireStockLots {
+	mmExpireStockLots.optional = true
+	return mmExpireStockLots
+}
+
+// Expect sets up expected params for StockServiceUseCase.ExpireStockLots
+func (mmExpireStockLots *mStockServiceUseCaseMockExpireStockLots) Expect(ctx context.Context) *mStockServiceUseCaseMockExpireStockLots {
+	if mmExpireStockLots.mock.funcExpireStockLots != nil {
+		mmExpireStockLots.mock.t.Fatalf("StockServiceUseCaseMock.ExpireStockLots mock is already set by Set")
+	}
+
+	if mmExpireStockLots.defaultExpectation == nil {
+		mmExpireStockLots.defaultExpectation = &StockServiceUseCaseMockExpireStockLotsExpectation{}
+	}
+
+	if mmExpireStockLots.defaultExpectation.paramPtrs != nil {
+		mmExpireStockLots.mock.t.Fatalf("StockServiceUseCaseMock.ExpireStockLots mock is already set by ExpectParams functions")
+	}
+
+	mmExpireStockLots.defaultExpectation.params = &StockServiceUseCaseMockExpireStockLotsParams{ctx}
+	mmExpireStockLots.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExpireStockLots.expectations {
+		if minimock.Equal(e.params, mmExpireStockLots.defaultExpectation.params) {
+			mmExpireStockLots.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExpireStockLots.defaultExpectation.params)
+		}
+	}
+
+	return mmExpireStockLots
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.ExpireStockLots
+func (mmExpireStockLots *mStockServiceUseCaseMockExpireStockLots) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockExpireStockLots {
+	if mmExpireStockLots.mock.funcExpireStockLots != nil {
+		mmExpireStockLots.mock.t.Fatalf("StockServiceUseCaseMock.ExpireStockLots mock is already set by Set")
+	}
+
+	if mmExpireStockLots.defaultExpectation == nil {
+		mmExpireStockLots.defaultExpectation = &StockServiceUseCaseMockExpireStockLotsExpectation{}
+	}
+
+	if mmExpireStockLots.defaultExpectation.params != nil {
+		mmExpireStockLots.mock.t.Fatalf("StockServiceUseCaseMock.ExpireStockLots mock is already set by Expect")
+	}
+
+	if mmExpireStockLots.defaultExpectation.paramPtrs == nil {
+		mmExpireStockLots.defaultExpectation.paramPtrs = &StockServiceUseCaseMockExpireStockLotsParamPtrs{}
+	}
+	mmExpireStockLots.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExpireStockLots.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExpireStockLots
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.ExpireStockLots
+func (mmExpireStockLots *mStockServiceUseCaseMockExpireStockLots) Inspect(f func(ctx context.Context)) *mStockServiceUseCaseMockExpireStockLots {
+	if mmExpireStockLots.mock.inspectFuncExpireStockLots != nil {
+		mmExpireStockLots.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.ExpireStockLots")
+	}
+
+	mmExpireStockLots.mock.inspectFuncExpireStockLots = f
+
+	return mmExpireStockLots
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.ExpireStockLots
+func (mmExpireStockLots *mStockServiceUseCaseMockExpireStockLots) Return(err error) *StockServiceUseCaseMock {
+	if mmExpireStockLots.mock.funcExpireStockLots != nil {
+		mmExpireStockLots.mock.t.Fatalf("StockServiceUseCaseMock.ExpireStockLots mock is already set by Set")
+	}
+
+	if mmExpireStockLots.defaultExpectation == nil {
+		mmExpireStockLots.defaultExpectation = &StockServiceUseCaseMockExpireStockLotsExpectation{mock: mmExpireStockLots.mock}
+	}
+	mmExpireStockLots.defaultExpectation.results = &StockServiceUseCaseMockExpireStockLotsResults{err}
+	mmExpireStockLots.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExpireStockLots.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.ExpireStockLots method
+func (mmExpireStockLots *mStockServiceUseCaseMockExpireStockLots) Set(f func(ctx context.Context) (err error)) *StockServiceUseCaseMock {
+	if mmExpireStockLots.defaultExpectation != nil {
+		mmExpireStockLots.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.ExpireStockLots method")
+	}
+
+	if len(mmExpireStockLots.expectations) > 0 {
+		mmExpireStockLots.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.ExpireStockLots method")
+	}
+
+	mmExpireStockLots.mock.funcExpireStockLots = f
+	mmExpireStockLots.mock.funcExpireStockLotsOrigin = minimock.CallerInfo(1)
+	return mmExpireStockLots.mock
+}
+
+// When sets expectation for the StockServiceUseCase.ExpireStockLots which will trigger the result defined by the following
+// Then helper
+func (mmExpireStockLots *mStockServiceUseCaseMockExpireStockLots) When(ctx context.Context) *StockServiceUseCaseMockExpireStockLotsExpectation {
+	if mmExpireStockLots.mock.funcExpireStockLots != nil {
+		mmExpireStockLots.mock.t.Fatalf("StockServiceUseCaseMock.ExpireStockLots mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockExpireStockLotsExpectation{
+		mock:               mmExpireStockLots.mock,
+		params:             &StockServiceUseCaseMockExpireStockLotsParams{ctx},
+		expectationOrigins: StockServiceUseCaseMockExpireStockLotsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExpireStockLots.expectations = append(mmExpireStockLots.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.ExpireStockLots return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockExpireStockLotsExpectation) Then(err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockExpireStockLotsResults{err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.ExpireStockLots should be invoked
+func (mmExpireStockLots *mStockServiceUseCaseMockExpireStockLots) Times(n uint64) *mStockServiceUseCaseMockExpireStockLots {
+	if n == 0 {
+		mmExpireStockLots.mock.t.Fatalf("Times of StockServiceUseCaseMock.ExpireStockLots mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExpireStockLots.expectedInvocations, n)
+	mmExpireStockLots.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExpireStockLots
+}
+
+func (mmExpireStockLots *mStockServiceUseCaseMockExpireStockLots) invocationsDone() bool {
+	if len(mmExpireStockLots.expectations) == 0 && mmExpireStockLots.defaultExpectation == nil && mmExpireStockLots.mock.funcExpireStockLots == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExpireStockLots.mock.afterExpireStockLotsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExpireStockLots.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ExpireStockLots implements mm_usecase.StockServiceUseCase
+func (mmExpireStockLots *StockServiceUseCaseMock) ExpireStockLots(ctx context.Context) (err error) {
+	mm_atomic.AddUint64(&mmExpireStockLots.beforeExpireStockLotsCounter, 1)
+	defer mm_atomic.AddUint64(&mmExpireStockLots.afterExpireStockLotsCounter, 1)
+
+	mmExpireStockLots.t.Helper()
+
+	if mmExpireStockLots.inspectFuncExpireStockLots != nil {
+		mmExpireStockLots.inspectFuncExpireStockLots(ctx)
+	}
+
+	mm_params := StockServiceUseCaseMockExpireStockLotsParams{ctx}
+
+	// Record call args
+	mmExpireStockLots.ExpireStockLotsMock.mutex.Lock()
+	mmExpireStockLots.ExpireStockLotsMock.callArgs = append(mmExpireStockLots.ExpireStockLotsMock.callArgs, &mm_params)
+	mmExpireStockLots.ExpireStockLotsMock.mutex.Unlock()
+
+	for _, e := range mmExpireStockLots.ExpireStockLotsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmExpireStockLots.ExpireStockLotsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExpireStockLots.ExpireStockLotsMock.defaultExpectation.Counter, 1)
+		mm_want := mmExpireStockLots.ExpireStockLotsMock.defaultExpectation.params
+		mm_want_ptrs := mmExpireStockLots.ExpireStockLotsMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockExpireStockLotsParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExpireStockLots.t.Errorf("StockServiceUseCaseMock.ExpireStockLots got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExpireStockLots.ExpireStockLotsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExpireStockLots.t.Errorf("StockServiceUseCaseMock.ExpireStockLots got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExpireStockLots.ExpireStockLotsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExpireStockLots.ExpireStockLotsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExpireStockLots.t.Fatal("No results are set for the StockServiceUseCaseMock.ExpireStockLots")
+		}
+		return (*mm_results).err
+	}
+	if mmExpireStockLots.funcExpireStockLots != nil {
+		return mmExpireStockLots.funcExpireStockLots(ctx)
+	}
+	mmExpireStockLots.t.Fatalf("Unexpected call to StockServiceUseCaseMock.ExpireStockLots. %v", ctx)
+	return
+}
+
+// ExpireStockLotsAfterCounter returns a count of finished StockServiceUseCaseMock.ExpireStockLots invocations
+func (mmExpireStockLots *StockServiceUseCaseMock) ExpireStockLotsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExpireStockLots.afterExpireStockLotsCounter)
+}
+
+// ExpireStockLotsBeforeCounter returns a count of StockServiceUseCaseMock.ExpireStockLots invocations
+func (mmExpireStockLots *StockServiceUseCaseMock) ExpireStockLotsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExpireStockLots.beforeExpireStockLotsCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.ExpireStockLots.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExpireStockLots *mStockServiceUseCaseMockExpireStockLots) Calls() []*StockServiceUseCaseMockExpireStockLotsParams {
+	mmExpireStockLots.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockExpireStockLotsParams, len(mmExpireStockLots.callArgs))
+	copy(argCopy, mmExpireStockLots.callArgs)
+
+	mmExpireStockLots.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExpireStockLotsDone returns true if the count of the ExpireStockLots invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockExpireStockLotsDone() bool {
+	if m.ExpireStockLotsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExpireStockLotsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExpireStockLotsMock.invocationsDone()
+}
+
+// MinimockExpireStockLotsInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockExpireStockLotsInspect() {
+	for _, e := range m.ExpireStockLotsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ExpireStockLots at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExpireStockLotsCounter := mm_atomic.LoadUint64(&m.afterExpireStockLotsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExpireStockLotsMock.defaultExpectation != nil && afterExpireStockLotsCounter < 1 {
+		if m.ExpireStockLotsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ExpireStockLots at\n%s", m.ExpireStockLotsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ExpireStockLots at\n%s with params: %#v", m.ExpireStockLotsMock.defaultExpectation.expectationOrigins.origin, *m.ExpireStockLotsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExpireStockLots != nil && afterExpireStockLotsCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.ExpireStockLots at\n%s", m.funcExpireStockLotsOrigin)
+	}
+
+	if !m.ExpireStockLotsMock.invocationsDone() && afterExpireStockLotsCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.ExpireStockLots at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExpireStockLotsMock.expectedInvocations), m.ExpireStockLotsMock.expectedInvocationsOrigin, afterExpireStockLotsCounter)
+	}
+}
+
+type mStockServiceUseCaseMockGetBundle struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockGetBundleExpectation
+	expectations       []*StockServiceUseCaseMockGetBundleExpectation
+
+	callArgs []*StockServiceUseCaseMockGetBundleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockGetBundleExpectation specifies expectation struct of the StockServiceUseCase.GetBundle
+type StockServiceUseCaseMockGetBundleExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockGetBundleParams
+	paramPtrs          *StockServiceUseCaseMockGetBundleParamPtrs
+	expectationOrigins StockServiceUseCaseMockGetBundleExpectationOrigins
+	results            *StockServiceUseCaseMockGetBundleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockGetBundleParams contains parameters of the StockServiceUseCase.GetBundle
+type StockServiceUseCaseMockGetBundleParams struct {
+	ctx   context.Context
+	skuID domain.SKUID
+}
+
+// StockServiceUseCaseMockGetBundleParamPtrs contains pointers to parameters of the StockServiceUseCase.GetBundle
+type StockServiceUseCaseMockGetBundleParamPtrs struct {
+	ctx   *context.Context
+	skuID *domain.SKUID
+}
+
+// StockServiceUseCaseMockGetBundleResults contains results of the StockServiceUseCase.GetBundle
+type StockServiceUseCaseMockGetBundleResults struct {
+	b1  domain.Bundle
+	err error
+}
+
+// StockServiceUseCaseMockGetBundleOrigins contains origins of expectations of the StockServiceUseCase.GetBundle
+type StockServiceUseCaseMockGetBundleExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originSkuID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetBundle *mStockServiceUseCaseMockGetBundle) Optional() *mStockServiceUseCaseMockGetBundle {
+	mmGetBundle.optional = true
+	return mmGetBundle
+}
+
+// Expect sets up expected params for StockServiceUseCase.GetBundle
+func (mmGetBundle *mStockServiceUseCaseMockGetBundle) Expect(ctx context.Context, skuID domain.SKUID) *mStockServiceUseCaseMockGetBundle {
+	if mmGetBundle.mock.funcGetBundle != nil {
+		mmGetBundle.mock.t.Fatalf("StockServiceUseCaseMock.GetBundle mock is already set by Set")
+	}
+
+	if mmGetBundle.defaultExpectation == nil {
+		mmGetBundle.defaultExpectation = &StockServiceUseCaseMockGetBundleExpectation{}
+	}
+
+	if mmGetBundle.defaultExpectation.paramPtrs != nil {
+		mmGetBundle.mock.t.Fatalf("StockServiceUseCaseMock.GetBundle mock is already set by ExpectParams functions")
+	}
+
+	mmGetBundle.defaultExpectation.params = &StockServiceUseCaseMockGetBundleParams{ctx, skuID}
+	mmGetBundle.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetBundle.expectations {
+		if minimock.Equal(e.params, mmGetBundle.defaultExpectation.params) {
+			mmGetBundle.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetBundle.defaultExpectation.params)
+		}
+	}
+
+	return mmGetBundle
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.GetBundle
+func (mmGetBundle *mStockServiceUseCaseMockGetBundle) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockGetBundle {
+	if mmGetBundle.mock.funcGetBundle != nil {
+		mmGetBundle.mock.t.Fatalf("StockServiceUseCaseMock.GetBundle mock is already set by Set")
+	}
+
+	if mmGetBundle.defaultExpectation == nil {
+		mmGetBundle.defaultExpectation = &StockServiceUseCaseMockGetBundleExpectation{}
+	}
+
+	if mmGetBundle.defaultExpectation.params != nil {
+		mmGetBundle.mock.t.Fatalf("StockServiceUseCaseMock.GetBundle mock is already set by Expect")
+	}
+
+	if mmGetBundle.defaultExpectation.paramPtrs == nil {
+		mmGetBundle.defaultExpectation.paramPtrs = &StockServiceUseCaseMockGetBundleParamPtrs{}
+	}
+	mmGetBundle.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetBundle.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetBundle
+}
+
+// ExpectSkuIDParam2 sets up expected param skuID for StockServiceUseCase.GetBundle
+func (mmGetBundle *mStockServiceUseCaseMockGetBundle) ExpectSkuIDParam2(skuID domain.SKUID) *mStockServiceUseCaseMockGetBundle {
+	if mmGetBundle.mock.funcGetBundle != nil {
+		mmGetBundle.mock.t.Fatalf("StockServiceUseCaseMock.GetBundle mock is already set by Set")
+	}
+
+	if mmGetBundle.defaultExpectation == nil {
+		mmGetBundle.defaultExpectation = &StockServiceUseCaseMockGetBundleExpectation{}
+	}
+
+	if mmGetBundle.defaultExpectation.params != nil {
+		mmGetBundle.mock.t.Fatalf("StockServiceUseCaseMock.GetBundle mock is already set by Expect")
+	}
+
+	if mmGetBundle.defaultExpectation.paramPtrs == nil {
+		mmGetBundle.defaultExpectation.paramPtrs = &StockServiceUseCaseMockGetBundleParamPtrs{}
+	}
+	mmGetBundle.defaultExpectation.paramPtrs.skuID = &skuID
+	mmGetBundle.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmGetBundle
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.GetBundle
+func (mmGetBundle *mStockServiceUseCaseMockGetBundle) Inspect(f func(ctx context.Context, skuID domain.SKUID)) *mStockServiceUseCaseMockGetBundle {
+	if mmGetBundle.mock.inspectFuncGetBundle != nil {
+		mmGetBundle.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.GetBundle")
+	}
+
+	mmGetBundle.mock.inspectFuncGetBundle = f
+
+	return mmGetBundle
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.GetBundle
+func (mmGetBundle *mStockServiceUseCaseMockGetBundle) Return(b1 domain.Bundle, err error) *StockServiceUseCaseMock {
+	if mmGetBundle.mock.funcGetBundle != nil {
+		mmGetBundle.mock.t.Fatalf("StockServiceUseCaseMock.GetBundle mock is already set by Set")
+	}
+
+	if mmGetBundle.defaultExpectation == nil {
+		mmGetBundle.defaultExpectation = &StockServiceUseCaseMockGetBundleExpectation{mock: mmGetBundle.mock}
+	}
+	mmGetBundle.defaultExpectation.results = &StockServiceUseCaseMockGetBundleResults{b1, err}
+	mmGetBundle.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetBundle.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.GetBundle method
+func (mmGetBundle *mStockServiceUseCaseMockGetBundle) Set(f func(ctx context.Context, skuID domain.SKUID) (b1 domain.Bundle, err error)) *StockServiceUseCaseMock {
+	if mmGetBundle.defaultExpectation != nil {
+		mmGetBundle.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.GetBundle method")
+	}
+
+	if len(mmGetBundle.expectations) > 0 {
+		mmGetBundle.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.GetBundle method")
+	}
+
+	mmGetBundle.mock.funcGetBundle = f
+	mmGetBundle.mock.funcGetBundleOrigin = minimock.CallerInfo(1)
+	return mmGetBundle.mock
+}
+
+// When sets expectation for the StockServiceUseCase.GetBundle which will trigger the result defined by the following
+// Then helper
+func (mmGetBundle *mStockServiceUseCaseMockGetBundle) When(ctx context.Context, skuID domain.SKUID) *StockServiceUseCaseMockGetBundleExpectation {
+	if mmGetBundle.mock.funcGetBundle != nil {
+		mmGetBundle.mock.t.Fatalf("StockServiceUseCaseMock.GetBundle mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockGetBundleExpectation{
+		mock:               mmGetBundle.mock,
+		params:             &StockServiceUseCaseMockGetBundleParams{ctx, skuID},
+		expectationOrigins: StockServiceUseCaseMockGetBundleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetBundle.expectations = append(mmGetBundle.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.GetBundle return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockGetBundleExpectation) Then(b1 domain.Bundle, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockGetBundleResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.GetBundle should be invoked
+func (mmGetBundle *mStockServiceUseCaseMockGetBundle) Times(n uint64) *mStockServiceUseCaseMockGetBundle {
+	if n == 0 {
+		mmGetBundle.mock.t.Fatalf("Times of StockServiceUseCaseMock.GetBundle mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetBundle.expectedInvocations, n)
+	mmGetBundle.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetBundle
+}
+
+func (mmGetBundle *mStockServiceUseCaseMockGetBundle) invocationsDone() bool {
+	if len(mmGetBundle.expectations) == 0 && mmGetBundle.defaultExpectation == nil && mmGetBundle.mock.funcGetBundle == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetBundle.mock.afterGetBundleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetBundle.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetBundle implements mm_usecase.StockServiceUseCase
+func (mmGetBundle *StockServiceUseCaseMock) GetBundle(ctx context.Context, skuID domain.SKUID) (b1 domain.Bundle, err error) {
+	mm_atomic.AddUint64(&mmGetBundle.beforeGetBundleCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetBundle.afterGetBundleCounter, 1)
+
+	mmGetBundle.t.Helper()
+
+	if mmGetBundle.inspectFuncGetBundle != nil {
+		mmGetBundle.inspectFuncGetBundle(ctx, skuID)
+	}
+
+	mm_params := StockServiceUseCaseMockGetBundleParams{ctx, skuID}
+
+	// Record call args
+	mmGetBundle.GetBundleMock.mutex.Lock()
+	mmGetBundle.GetBundleMock.callArgs = append(mmGetBundle.GetBundleMock.callArgs, &mm_params)
+	mmGetBundle.GetBundleMock.mutex.Unlock()
+
+	for _, e := range mmGetBundle.GetBundleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmGetBundle.GetBundleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetBundle.GetBundleMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetBundle.GetBundleMock.defaultExpectation.params
+		mm_want_ptrs := mmGetBundle.GetBundleMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockGetBundleParams{ctx, skuID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetBundle.t.Errorf("StockServiceUseCaseMock.GetBundle got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetBundle.GetBundleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmGetBundle.t.Errorf("StockServiceUseCaseMock.GetBundle got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetBundle.GetBundleMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetBundle.t.Errorf("StockServiceUseCaseMock.GetBundle got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetBundle.GetBundleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetBundle.GetBundleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetBundle.t.Fatal("No results are set for the StockServiceUseCaseMock.GetBundle")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmGetBundle.funcGetBundle != nil {
+		return mmGetBundle.funcGetBundle(ctx, skuID)
+	}
+	mmGetBundle.t.Fatalf("Unexpected call to StockServiceUseCaseMock.GetBundle. %v %v", ctx, skuID)
+	return
+}
+
+// GetBundleAfterCounter returns a count of finished StockServiceUseCaseMock.GetBundle invocations
+func (mmGetBundle *StockServiceUseCaseMock) GetBundleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBundle.afterGetBundleCounter)
+}
+
+// GetBundleBeforeCounter returns a count of StockServiceUseCaseMock.GetBundle invocations
+func (mmGetBundle *StockServiceUseCaseMock) GetBundleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBundle.beforeGetBundleCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.GetBundle.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetBundle *mStockServiceUseCaseMockGetBundle) Calls() []*StockServiceUseCaseMockGetBundleParams {
+	mmGetBundle.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockGetBundleParams, len(mmGetBundle.callArgs))
+	copy(argCopy, mmGetBundle.callArgs)
+
+	mmGetBundle.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetBundleDone returns true if the count of the GetBundle invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockGetBundleDone() bool {
+	if m.GetBundleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetBundleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetBundleMock.invocationsDone()
+}
+
+// MinimockGetBundleInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockGetBundleInspect() {
+	for _, e := range m.GetBundleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetBundle at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetBundleCounter := mm_atomic.LoadUint64(&m.afterGetBundleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetBundleMock.defaultExpectation != nil && afterGetBundleCounter < 1 {
+		if m.GetBundleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetBundle at\n%s", m.GetBundleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetBundle at\n%s with params: %#v", m.GetBundleMock.defaultExpectation.expectationOrigins.origin, *m.GetBundleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetBundle != nil && afterGetBundleCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.GetBundle at\n%s", m.funcGetBundleOrigin)
+	}
+
+	if !m.GetBundleMock.invocationsDone() && afterGetBundleCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.GetBundle at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetBundleMock.expectedInvocations), m.GetBundleMock.expectedInvocationsOrigin, afterGetBundleCounter)
+	}
+}
+
+type mStockServiceUseCaseMockGetInventoryValuation struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockGetInventoryValuationExpectation
+	expectations       []*StockServiceUseCaseMockGetInventoryValuationExpectation
+
+	callArgs []*StockServiceUseCaseMockGetInventoryValuationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockGetInventoryValuationExpectation specifies expectation struct of the StockServiceUseCase.GetInventoryValuation
+type StockServiceUseCaseMockGetInventoryValuationExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockGetInventoryValuationParams
+	paramPtrs          *StockServiceUseCaseMockGetInventoryValuationParamPtrs
+	expectationOrigins StockServiceUseCaseMockGetInventoryValuationExpectationOrigins
+	results            *StockServiceUseCaseMockGetInventoryValuationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockGetInventoryValuationParams contains parameters of the StockServiceUseCase.GetInventoryValuation
+type StockServiceUseCaseMockGetInventoryValuationParams struct {
+	ctx    context.Context
+	filter domain.InventoryValuationFilter
+	send   func(row domain.InventoryValuationRow) error
+}
+
+// StockServiceUseCaseMockGetInventoryValuationParamPtrs contains pointers to parameters of the StockServiceUseCase.GetInventoryValuation
+type StockServiceUseCaseMockGetInventoryValuationParamPtrs struct {
+	ctx    *context.Context
+	filter *domain.InventoryValuationFilter
+	send   *func(row domain.InventoryValuationRow) error
+}
+
+// StockServiceUseCaseMockGetInventoryValuationResults contains results of the StockServiceUseCase.GetInventoryValuation
+type StockServiceUseCaseMockGetInventoryValuationResults struct {
+	err error
+}
+
+// StockServiceUseCaseMockGetInventoryValuationOrigins contains origins of expectations of the StockServiceUseCase.GetInventoryValuation
+type StockServiceUseCaseMockGetInventoryValuationExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+	originSend   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetInventoryValuation *mStockServiceUseCaseMockGetInventoryValuation) Optional() *mStockServiceUseCaseMockGetInventoryValuation {
+	mmGetInventoryValuation.optional = true
+	return mmGetInventoryValuation
+}
+
+// Expect sets up expected params for StockServiceUseCase.GetInventoryValuation
+func (mmGetInventoryValuation *mStockServiceUseCaseMockGetInventoryValuation) Expect(ctx context.Context, filter domain.InventoryValuationFilter, send func(row domain.InventoryValuationRow) error) *mStockServiceUseCaseMockGetInventoryValuation {
+	if mmGetInventoryValuation.mock.funcGetInventoryValuation != nil {
+		mmGetInventoryValuation.mock.t.Fatalf("StockServiceUseCaseMock.GetInventoryValuation mock is already set by Set")
+	}
+
+	if mmGetInventoryValuation.defaultExpectation == nil {
+		mmGetInventoryValuation.defaultExpectation = &StockServiceUseCaseMockGetInventoryValuationExpectation{}
+	}
+
+	if mmGetInventoryValuation.defaultExpectation.paramPtrs != nil {
+		mmGetInventoryValuation.mock.t.Fatalf("StockServiceUseCaseMock.GetInventoryValuation mock is already set by ExpectParams functions")
+	}
+
+	mmGetInventoryValuation.defaultExpectation.params = &StockServiceUseCaseMockGetInventoryValuationParams{ctx, filter, send}
+	mmGetInventoryValuation.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetInventoryValuation.expectations {
+		if minimock.Equal(e.params, mmGetInventoryValuation.defaultExpectation.params) {
+			mmGetInventoryValuation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetInventoryValuation.defaultExpectation.params)
+		}
+	}
+
+	return mmGetInventoryValuation
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.GetInventoryValuation
+func (mmGetInventoryValuation *mStockServiceUseCaseMockGetInventoryValuation) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockGetInventoryValuation {
+	if mmGetInventoryValuation.mock.funcGetInventoryValuation != nil {
+		mmGetInventoryValuation.mock.t.Fatalf("StockServiceUseCaseMock.GetInventoryValuation mock is already set by Set")
+	}
+
+	if mmGetInventoryValuation.defaultExpectation == nil {
+		mmGetInventoryValuation.defaultExpectation = &StockServiceUseCaseMockGetInventoryValuationExpectation{}
+	}
+
+	if mmGetInventoryValuation.defaultExpectation.params != nil {
+		mmGetInventoryValuation.mock.t.Fatalf("StockServiceUseCaseMock.GetInventoryValuation mock is already set by Expect")
+	}
+
+	if mmGetInventoryValuation.defaultExpectation.paramPtrs == nil {
+		mmGetInventoryValuation.defaultExpectation.paramPtrs = &StockServiceUseCaseMockGetInventoryValuationParamPtrs{}
+	}
+	mmGetInventoryValuation.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetInventoryValuation.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetInventoryValuation
+}
+
+// ExpectFilterParam2 sets up expected param filter for StockServiceUseCase.GetInventoryValuation
+func (mmGetInventoryValuation *mStockServiceUseCaseMockGetInventoryValuation) ExpectFilterParam2(filter domain.InventoryValuationFilter) *mStockServiceUseCaseMockGetInventoryValuation {
+	if mmGetInventoryValuation.mock.funcGetInventoryValuation != nil {
+		mmGetInventoryValuation.mock.t.Fatalf("StockServiceUseCaseMock.GetInventoryValuation mock is already set by Set")
+	}
+
+	if mmGetInventoryValuation.defaultExpectation == nil {
+		mmGetInventoryValuation.defaultExpectation = &StockServiceUseCaseMockGetInventoryValuationExpectation{}
+	}
+
+	if mmGetInventoryValuation.defaultExpectation.params != nil {
+		mmGetInventoryValuation.mock.t.Fatalf("StockServiceUseCaseMock.GetInventoryValuation mock is already set by Expect")
+	}
+
+	if mmGetInventoryValuation.defaultExpectation.paramPtrs == nil {
+		mmGetInventoryValuation.defaultExpectation.paramPtrs = &StockServiceUseCaseMockGetInventoryValuationParamPtrs{}
+	}
+	mmGetInventoryValuation.defaultExpectation.paramPtrs.filter = &filter
+	mmGetInventoryValuation.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmGetInventoryValuation
+}
+
+// ExpectSendParam3 sets up expected param send for StockServiceUseCase.GetInventoryValuation
+func (mmGetInventoryValuation *mStockServiceUseCaseMockGetInventoryValuation) ExpectSendParam3(send func(row domain.InventoryValuationRow) error) *mStockServiceUseCaseMockGetInventoryValuation {
+	if mmGetInventoryValuation.mock.funcGetInventoryValuation != nil {
+		mmGetInventoryValuation.mock.t.Fatalf("StockServiceUseCaseMock.GetInventoryValuation mock is already set by Set")
+	}
+
+	if mmGetInventoryValuation.defaultExpectation == nil {
+		mmGetInventoryValuation.defaultExpectation = &StockServiceUseCaseMockGetInventoryValuationExpectation{}
+	}
+
+	if mmGetInventoryValuation.defaultExpectation.params != nil {
+		mmGetInventoryValuation.mock.t.Fatalf("StockServiceUseCaseMock.GetInventoryValuation mock is already set by Expect")
+	}
+
+	if mmGetInventoryValuation.defaultExpectation.paramPtrs == nil {
+		mmGetInventoryValuation.defaultExpectation.paramPtrs = &StockServiceUseCaseMockGetInventoryValuationParamPtrs{}
+	}
+	mmGetInventoryValuation.defaultExpectation.paramPtrs.send = &send
+	mmGetInventoryValuation.defaultExpectation.expectationOrigins.originSend = minimock.CallerInfo(1)
+
+	return mmGetInventoryValuation
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.GetInventoryValuation
+func (mmGetInventoryValuation *mStockServiceUseCaseMockGetInventoryValuation) Inspect(f func(ctx context.Context, filter domain.InventoryValuationFilter, send func(row domain.InventoryValuationRow) error)) *mStockServiceUseCaseMockGetInventoryValuation {
+	if mmGetInventoryValuation.mock.inspectFuncGetInventoryValuation != nil {
+		mmGetInventoryValuation.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.GetInventoryValuation")
+	}
+
+	mmGetInventoryValuation.mock.inspectFuncGetInventoryValuation = f
+
+	return mmGetInventoryValuation
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.GetInventoryValuation
+func (mmGetInventoryValuation *mStockServiceUseCaseMockGetInventoryValuation) Return(err error) *StockServiceUseCaseMock {
+	if mmGetInventoryValuation.mock.funcGetInventoryValuation != nil {
+		mmGetInventoryValuation.mock.t.Fatalf("StockServiceUseCaseMock.GetInventoryValuation mock is already set by Set")
+	}
+
+	if mmGetInventoryValuation.defaultExpectation == nil {
+		mmGetInventoryValuation.defaultExpectation = &StockServiceUseCaseMockGetInventoryValuationExpectation{mock: mmGetInventoryValuation.mock}
+	}
+	mmGetInventoryValuation.defaultExpectation.results = &StockServiceUseCaseMockGetInventoryValuationResults{err}
+	mmGetInventoryValuation.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetInventoryValuation.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.GetInventoryValuation method
+func (mmGetInventoryValuation *mStockServiceUseCaseMockGetInventoryValuation) Set(f func(ctx context.Context, filter domain.InventoryValuationFilter, send func(row domain.InventoryValuationRow) error) (err error)) *StockServiceUseCaseMock {
+	if mmGetInventoryValuation.defaultExpectation != nil {
+		mmGetInventoryValuation.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.GetInventoryValuation method")
+	}
+
+	if len(mmGetInventoryValuation.expectations) > 0 {
+		mmGetInventoryValuation.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.GetInventoryValuation method")
+	}
+
+	mmGetInventoryValuation.mock.funcGetInventoryValuation = f
+	mmGetInventoryValuation.mock.funcGetInventoryValuationOrigin = minimock.CallerInfo(1)
+	return mmGetInventoryValuation.mock
+}
+
+// When sets expectation for the StockServiceUseCase.GetInventoryValuation which will trigger the result defined by the following
+// Then helper
+func (mmGetInventoryValuation *mStockServiceUseCaseMockGetInventoryValuation) When(ctx context.Context, filter domain.InventoryValuationFilter, send func(row domain.InventoryValuationRow) error) *StockServiceUseCaseMockGetInventoryValuationExpectation {
+	if mmGetInventoryValuation.mock.funcGetInventoryValuation != nil {
+		mmGetInventoryValuation.mock.t.Fatalf("StockServiceUseCaseMock.GetInventoryValuation mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockGetInventoryValuationExpectation{
+		mock:               mmGetInventoryValuation.mock,
+		params:             &StockServiceUseCaseMockGetInventoryValuationParams{ctx, filter, send},
+		expectationOrigins: StockServiceUseCaseMockGetInventoryValuationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetInventoryValuation.expectations = append(mmGetInventoryValuation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.GetInventoryValuation return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockGetInventoryValuationExpectation) Then(err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockGetInventoryValuationResults{err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.GetInventoryValuation should be invoked
+func (mmGetInventoryValuation *mStockServiceUseCaseMockGetInventoryValuation) Times(n uint64) *mStockServiceUseCaseMockGetInventoryValuation {
+	if n == 0 {
+		mmGetInventoryValuation.mock.t.Fatalf("Times of StockServiceUseCaseMock.GetInventoryValuation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetInventoryValuation.expectedInvocations, n)
+	mmGetInventoryValuation.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetInventoryValuation
+}
+
+func (mmGetInventoryValuation *mStockServiceUseCaseMockGetInventoryValuation) invocationsDone() bool {
+	if len(mmGetInventoryValuation.expectations) == 0 && mmGetInventoryValuation.defaultExpectation == nil && mmGetInventoryValuation.mock.funcGetInventoryValuation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetInventoryValuation.mock.afterGetInventoryValuationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetInventoryValuation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetInventoryValuation implements mm_usecase.StockServiceUseCase
+func (mmGetInventoryValuation *StockServiceUseCaseMock) GetInventoryValuation(ctx context.Context, filter domain.InventoryValuationFilter, send func(row domain.InventoryValuationRow) error) (err error) {
+	mm_atomic.AddUint64(&mmGetInventoryValuation.beforeGetInventoryValuationCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetInventoryValuation.afterGetInventoryValuationCounter, 1)
+
+	mmGetInventoryValuation.t.Helper()
+
+	if mmGetInventoryValuation.inspectFuncGetInventoryValuation != nil {
+		mmGetInventoryValuation.inspectFuncGetInventoryValuation(ctx, filter, send)
+	}
+
+	mm_params := StockServiceUseCaseMockGetInventoryValuationParams{ctx, filter, send}
+
+	// Record call args
+	mmGetInventoryValuation.GetInventoryValuationMock.mutex.Lock()
+	mmGetInventoryValuation.GetInventoryValuationMock.callArgs = append(mmGetInventoryValuation.GetInventoryValuationMock.callArgs, &mm_params)
+	mmGetInventoryValuation.GetInventoryValuationMock.mutex.Unlock()
+
+	for _, e := range mmGetInventoryValuation.GetInventoryValuationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmGetInventoryValuation.GetInventoryValuationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetInventoryValuation.GetInventoryValuationMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetInventoryValuation.GetInventoryValuationMock.defaultExpectation.params
+		mm_want_ptrs := mmGetInventoryValuation.GetInventoryValuationMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockGetInventoryValuationParams{ctx, filter, send}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetInventoryValuation.t.Errorf("StockServiceUseCaseMock.GetInventoryValuation got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetInventoryValuation.GetInventoryValuationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmGetInventoryValuation.t.Errorf("StockServiceUseCaseMock.GetInventoryValuation got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetInventoryValuation.GetInventoryValuationMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+			if mm_want_ptrs.send != nil && !minimock.Equal(*mm_want_ptrs.send, mm_got.send) {
+				mmGetInventoryValuation.t.Errorf("StockServiceUseCaseMock.GetInventoryValuation got unexpected parameter send, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetInventoryValuation.GetInventoryValuationMock.defaultExpectation.expectationOrigins.originSend, *mm_want_ptrs.send, mm_got.send, minimock.Diff(*mm_want_ptrs.send, mm_got.send))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetInventoryValuation.t.Errorf("StockServiceUseCaseMock.GetInventoryValuation got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetInventoryValuation.GetInventoryValuationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetInventoryValuation.GetInventoryValuationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetInventoryValuation.t.Fatal("No results are set for the StockServiceUseCaseMock.GetInventoryValuation")
+		}
+		return (*mm_results).err
+	}
+	if mmGetInventoryValuation.funcGetInventoryValuation != nil {
+		return mmGetInventoryValuation.funcGetInventoryValuation(ctx, filter, send)
+	}
+	mmGetInventoryValuation.t.Fatalf("Unexpected call to StockServiceUseCaseMock.GetInventoryValuation. %v %v %v", ctx, filter, send)
+	return
+}
+
+// GetInventoryValuationAfterCounter returns a count of finished StockServiceUseCaseMock.GetInventoryValuation invocations
+func (mmGetInventoryValuation *StockServiceUseCaseMock) GetInventoryValuationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetInventoryValuation.afterGetInventoryValuationCounter)
+}
+
+// GetInventoryValuationBeforeCounter returns a count of StockServiceUseCaseMock.GetInventoryValuation invocations
+func (mmGetInventoryValuation *StockServiceUseCaseMock) GetInventoryValuationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetInventoryValuation.beforeGetInventoryValuationCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.GetInventoryValuation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetInventoryValuation *mStockServiceUseCaseMockGetInventoryValuation) Calls() []*StockServiceUseCaseMockGetInventoryValuationParams {
+	mmGetInventoryValuation.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockGetInventoryValuationParams, len(mmGetInventoryValuation.callArgs))
+	copy(argCopy, mmGetInventoryValuation.callArgs)
+
+	mmGetInventoryValuation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetInventoryValuationDone returns true if the count of the GetInventoryValuation invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockGetInventoryValuationDone() bool {
+	if m.GetInventoryValuationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetInventoryValuationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetInventoryValuationMock.invocationsDone()
+}
+
+// MinimockGetInventoryValuationInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockGetInventoryValuationInspect() {
+	for _, e := range m.GetInventoryValuationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetInventoryValuation at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetInventoryValuationCounter := mm_atomic.LoadUint64(&m.afterGetInventoryValuationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetInventoryValuationMock.defaultExpectation != nil && afterGetInventoryValuationCounter < 1 {
+		if m.GetInventoryValuationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetInventoryValuation at\n%s", m.GetInventoryValuationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetInventoryValuation at\n%s with params: %#v", m.GetInventoryValuationMock.defaultExpectation.expectationOrigins.origin, *m.GetInventoryValuationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetInventoryValuation != nil && afterGetInventoryValuationCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.GetInventoryValuation at\n%s", m.funcGetInventoryValuationOrigin)
+	}
+
+	if !m.GetInventoryValuationMock.invocationsDone() && afterGetInventoryValuationCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.GetInventoryValuation at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetInventoryValuationMock.expectedInvocations), m.GetInventoryValuationMock.expectedInvocationsOrigin, afterGetInventoryValuationCounter)
+	}
+}
+
+type mStockServiceUseCaseMockGetPriceHistory struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockGetPriceHistoryExpectation
+	expectations       []*StockServiceUseCaseMockGetPriceHistoryExpectation
+
+	callArgs []*StockServiceUseCaseMockGetPriceHistoryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockGetPriceHistoryExpectation specifies expectation struct of the StockServiceUseCase.GetPriceHistory
+type StockServiceUseCaseMockGetPriceHistoryExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockGetPriceHistoryParams
+	paramPtrs          *StockServiceUseCaseMockGetPriceHistoryParamPtrs
+	expectationOrigins StockServiceUseCaseMockGetPriceHistoryExpectationOrigins
+	results            *StockServiceUseCaseMockGetPriceHistoryResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockGetPriceHistoryParams contains parameters of the StockServiceUseCase.GetPriceHistory
+type StockServiceUseCaseMockGetPriceHistoryParams struct {
+	ctx    context.Context
+	filter domain.PriceHistoryFilter
+}
+
+// StockServiceUseCaseMockGetPriceHistoryParamPtrs contains pointers to parameters of the StockServiceUseCase.GetPriceHistory
+type StockServiceUseCaseMockGetPriceHistoryParamPtrs struct {
+	ctx    *context.Context
+	filter *domain.PriceHistoryFilter
+}
+
+// StockServiceUseCaseMockGetPriceHistoryResults contains results of the StockServiceUseCase.GetPriceHistory
+type StockServiceUseCaseMockGetPriceHistoryResults struct {
+	p1  domain.PriceHistory
+	err error
+}
+
+// StockServiceUseCaseMockGetPriceHistoryOrigins contains origins of expectations of the StockServiceUseCase.GetPriceHistory
+type StockServiceUseCaseMockGetPriceHistoryExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPriceHistory *mStockServiceUseCaseMockGetPriceHistory) Optional() *mStockServiceUseCaseMockGetPriceHistory {
+	mmGetPriceHistory.optional = true
+	return mmGetPriceHistory
+}
+
+// Expect sets up expected params for StockServiceUseCase.GetPriceHistory
+func (mmGetPriceHistory *mStockServiceUseCaseMockGetPriceHistory) Expect(ctx context.Context, filter domain.PriceHistoryFilter) *mStockServiceUseCaseMockGetPriceHistory {
+	if mmGetPriceHistory.mock.funcGetPriceHistory != nil {
+		mmGetPriceHistory.mock.t.Fatalf("StockServiceUseCaseMock.GetPriceHistory mock is already set by Set")
+	}
+
+	if mmGetPriceHistory.defaultExpectation == nil {
+		mmGetPriceHistory.defaultExpectation = &StockServiceUseCaseMockGetPriceHistoryExpectation{}
+	}
+
+	if mmGetPriceHistory.defaultExpectation.paramPtrs != nil {
+		mmGetPriceHistory.mock.t.Fatalf("StockServiceUseCaseMock.GetPriceHistory mock is already set by ExpectParams functions")
+	}
+
+	mmGetPriceHistory.defaultExpectation.params = &StockServiceUseCaseMockGetPriceHistoryParams{ctx, filter}
+	mmGetPriceHistory.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPriceHistory.expectations {
+		if minimock.Equal(e.params, mmGetPriceHistory.defaultExpectation.params) {
+			mmGetPriceHistory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPriceHistory.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPriceHistory
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.GetPriceHistory
+func (mmGetPriceHistory *mStockServiceUseCaseMockGetPriceHistory) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockGetPriceHistory {
+	if mmGetPriceHistory.mock.funcGetPriceHistory != nil {
+		mmGetPriceHistory.mock.t.Fatalf("StockServiceUseCaseMock.GetPriceHistory mock is already set by Set")
+	}
+
+	if mmGetPriceHistory.defaultExpectation == nil {
+		mmGetPriceHistory.defaultExpectation = &StockServiceUseCaseMockGetPriceHistoryExpectation{}
+	}
+
+	if mmGetPriceHistory.defaultExpectation.params != nil {
+		mmGetPriceHistory.mock.t.Fatalf("StockServiceUseCaseMock.GetPriceHistory mock is already set by Expect")
+	}
+
+	if mmGetPriceHistory.defaultExpectation.paramPtrs == nil {
+		mmGetPriceHistory.defaultExpectation.paramPtrs = &StockServiceUseCaseMockGetPriceHistoryParamPtrs{}
+	}
+	mmGetPriceHistory.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPriceHistory.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPriceHistory
+}
+
+// ExpectFilterParam2 sets up expected param filter for StockServiceUseCase.GetPriceHistory
+func (mmGetPriceHistory *mStockServiceUseCaseMockGetPriceHistory) ExpectFilterParam2(filter domain.PriceHistoryFilter) *mStockServiceUseCaseMockGetPriceHistory {
+	if mmGetPriceHistory.mock.funcGetPriceHistory != nil {
+		mmGetPriceHistory.mock.t.Fatalf("StockServiceUseCaseMock.GetPriceHistory mock is already set by Set")
+	}
+
+	if mmGetPriceHistory.defaultExpectation == nil {
+		mmGetPriceHistory.defaultExpectation = &StockServiceUseCaseMockGetPriceHistoryExpectation{}
+	}
+
+	if mmGetPriceHistory.defaultExpectation.params != nil {
+		mmGetPriceHistory.mock.t.Fatalf("StockServiceUseCaseMock.GetPriceHistory mock is already set by Expect")
+	}
+
+	if mmGetPriceHistory.defaultExpectation.paramPtrs == nil {
+		mmGetPriceHistory.defaultExpectation.paramPtrs = &StockServiceUseCaseMockGetPriceHistoryParamPtrs{}
+	}
+	mmGetPriceHistory.defaultExpectation.paramPtrs.filter = &filter
+	mmGetPriceHistory.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmGetPriceHistory
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.GetPriceHistory
+func (mmGetPriceHistory *mStockServiceUseCaseMockGetPriceHistory) Inspect(f func(ctx context.Context, filter domain.PriceHistoryFilter)) *mStockServiceUseCaseMockGetPriceHistory {
+	if mmGetPriceHistory.mock.inspectFuncGetPriceHistory != nil {
+		mmGetPriceHistory.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.GetPriceHistory")
+	}
+
+	mmGetPriceHistory.mock.inspectFuncGetPriceHistory = f
+
+	return mmGetPriceHistory
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.GetPriceHistory
+func (mmGetPriceHistory *mStockServiceUseCaseMockGetPriceHistory) Return(p1 domain.PriceHistory, err error) *StockServiceUseCaseMock {
+	if mmGetPriceHistory.mock.funcGetPriceHistory != nil {
+		mmGetPriceHistory.mock.t.Fatalf("StockServiceUseCaseMock.GetPriceHistory mock is already set by Set")
+	}
+
+	if mmGetPriceHistory.defaultExpectation == nil {
+		mmGetPriceHistory.defaultExpectation = &StockServiceUseCaseMockGetPriceHistoryExpectation{mock: mmGetPriceHistory.mock}
+	}
+	mmGetPriceHistory.defaultExpectation.results = &StockServiceUseCaseMockGetPriceHistoryResults{p1, err}
+	mmGetPriceHistory.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPriceHistory.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.GetPriceHistory method
+func (mmGetPriceHistory *mStockServiceUseCaseMockGetPriceHistory) Set(f func(ctx context.Context, filter domain.PriceHistoryFilter) (p1 domain.PriceHistory, err error)) *StockServiceUseCaseMock {
+	if mmGetPriceHistory.defaultExpectation != nil {
+		mmGetPriceHistory.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.GetPriceHistory method")
+	}
+
+	if len(mmGetPriceHistory.expectations) > 0 {
+		mmGetPriceHistory.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.GetPriceHistory method")
+	}
+
+	mmGetPriceHistory.mock.funcGetPriceHistory = f
+	mmGetPriceHistory.mock.funcGetPriceHistoryOrigin = minimock.CallerInfo(1)
+	return mmGetPriceHistory.mock
+}
+
+// When sets expectation for the StockServiceUseCase.GetPriceHistory which will trigger the result defined by the following
+// Then helper
+func (mmGetPriceHistory *mStockServiceUseCaseMockGetPriceHistory) When(ctx context.Context, filter domain.PriceHistoryFilter) *StockServiceUseCaseMockGetPriceHistoryExpectation {
+	if mmGetPriceHistory.mock.funcGetPriceHistory != nil {
+		mmGetPriceHistory.mock.t.Fatalf("StockServiceUseCaseMock.GetPriceHistory mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockGetPriceHistoryExpectation{
+		mock:               mmGetPriceHistory.mock,
+		params:             &StockServiceUseCaseMockGetPriceHistoryParams{ctx, filter},
+		expectationOrigins: StockServiceUseCaseMockGetPriceHistoryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPriceHistory.expectations = append(mmGetPriceHistory.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.GetPriceHistory return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockGetPriceHistoryExpectation) Then(p1 domain.PriceHistory, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockGetPriceHistoryResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.GetPriceHistory should be invoked
+func (mmGetPriceHistory *mStockServiceUseCaseMockGetPriceHistory) Times(n uint64) *mStockServiceUseCaseMockGetPriceHistory {
+	if n == 0 {
+		mmGetPriceHistory.mock.t.Fatalf("Times of StockServiceUseCaseMock.GetPriceHistory mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPriceHistory.expectedInvocations, n)
+	mmGetPriceHistory.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPriceHistory
+}
+
+func (mmGetPriceHistory *mStockServiceUseCaseMockGetPriceHistory) invocationsDone() bool {
+	if len(mmGetPriceHistory.expectations) == 0 && mmGetPriceHistory.defaultExpectation == nil && mmGetPriceHistory.mock.funcGetPriceHistory == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPriceHistory.mock.afterGetPriceHistoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPriceHistory.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPriceHistory implements mm_usecase.StockServiceUseCase
+func (mmGetPriceHistory *StockServiceUseCaseMock) GetPriceHistory(ctx context.Context, filter domain.PriceHistoryFilter) (p1 domain.PriceHistory, err error) {
+	mm_atomic.AddUint64(&mmGetPriceHistory.beforeGetPriceHistoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPriceHistory.afterGetPriceHistoryCounter, 1)
+
+	mmGetPriceHistory.t.Helper()
+
+	if mmGetPriceHistory.inspectFuncGetPriceHistory != nil {
+		mmGetPriceHistory.inspectFuncGetPriceHistory(ctx, filter)
+	}
+
+	mm_params := StockServiceUseCaseMockGetPriceHistoryParams{ctx, filter}
+
+	// Record call args
+	mmGetPriceHistory.GetPriceHistoryMock.mutex.Lock()
+	mmGetPriceHistory.GetPriceHistoryMock.callArgs = append(mmGetPriceHistory.GetPriceHistoryMock.callArgs, &mm_params)
+	mmGetPriceHistory.GetPriceHistoryMock.mutex.Unlock()
+
+	for _, e := range mmGetPriceHistory.GetPriceHistoryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmGetPriceHistory.GetPriceHistoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPriceHistory.GetPriceHistoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPriceHistory.GetPriceHistoryMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPriceHistory.GetPriceHistoryMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockGetPriceHistoryParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPriceHistory.t.Errorf("StockServiceUseCaseMock.GetPriceHistory got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPriceHistory.GetPriceHistoryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmGetPriceHistory.t.Errorf("StockServiceUseCaseMock.GetPriceHistory got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPriceHistory.GetPriceHistoryMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPriceHistory.t.Errorf("StockServiceUseCaseMock.GetPriceHistory got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPriceHistory.GetPriceHistoryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPriceHistory.GetPriceHistoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPriceHistory.t.Fatal("No results are set for the StockServiceUseCaseMock.GetPriceHistory")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmGetPriceHistory.funcGetPriceHistory != nil {
+		return mmGetPriceHistory.funcGetPriceHistory(ctx, filter)
+	}
+	mmGetPriceHistory.t.Fatalf("Unexpected call to StockServiceUseCaseMock.GetPriceHistory. %v %v", ctx, filter)
+	return
+}
+
+// GetPriceHistoryAfterCounter returns a count of finished StockServiceUseCaseMock.GetPriceHistory invocations
+func (mmGetPriceHistory *StockServiceUseCaseMock) GetPriceHistoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPriceHistory.afterGetPriceHistoryCounter)
+}
+
+// GetPriceHistoryBeforeCounter returns a count of StockServiceUseCaseMock.GetPriceHistory invocations
+func (mmGetPriceHistory *StockServiceUseCaseMock) GetPriceHistoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPriceHistory.beforeGetPriceHistoryCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.GetPriceHistory.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPriceHistory *mStockServiceUseCaseMockGetPriceHistory) Calls() []*StockServiceUseCaseMockGetPriceHistoryParams {
+	mmGetPriceHistory.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockGetPriceHistoryParams, len(mmGetPriceHistory.callArgs))
+	copy(argCopy, mmGetPriceHistory.callArgs)
+
+	mmGetPriceHistory.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPriceHistoryDone returns true if the count of the GetPriceHistory invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockGetPriceHistoryDone() bool {
+	if m.GetPriceHistoryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPriceHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPriceHistoryMock.invocationsDone()
+}
+
+// MinimockGetPriceHistoryInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockGetPriceHistoryInspect() {
+	for _, e := range m.GetPriceHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetPriceHistory at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPriceHistoryCounter := mm_atomic.LoadUint64(&m.afterGetPriceHistoryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPriceHistoryMock.defaultExpectation != nil && afterGetPriceHistoryCounter < 1 {
+		if m.GetPriceHistoryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetPriceHistory at\n%s", m.GetPriceHistoryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetPriceHistory at\n%s with params: %#v", m.GetPriceHistoryMock.defaultExpectation.expectationOrigins.origin, *m.GetPriceHistoryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPriceHistory != nil && afterGetPriceHistoryCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.GetPriceHistory at\n%s", m.funcGetPriceHistoryOrigin)
+	}
+
+	if !m.GetPriceHistoryMock.invocationsDone() && afterGetPriceHistoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.GetPriceHistory at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPriceHistoryMock.expectedInvocations), m.GetPriceHistoryMock.expectedInvocationsOrigin, afterGetPriceHistoryCounter)
+	}
+}
+
+type mStockServiceUseCaseMockGetPriceTiers struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockGetPriceTiersExpectation
+	expectations       []*StockServiceUseCaseMockGetPriceTiersExpectation
+
+	callArgs []*StockServiceUseCaseMockGetPriceTiersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockGetPriceTiersExpectation specifies expectation struct of the StockServiceUseCase.GetPriceTiers
+type StockServiceUseCaseMockGetPriceTiersExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockGetPriceTiersParams
+	paramPtrs          *StockServiceUseCaseMockGetPriceTiersParamPtrs
+	expectationOrigins StockServiceUseCaseMockGetPriceTiersExpectationOrigins
+	results            *StockServiceUseCaseMockGetPriceTiersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockGetPriceTiersParams contains parameters of the StockServiceUseCase.GetPriceTiers
+type StockServiceUseCaseMockGetPriceTiersParams struct {
+	ctx      context.Context
+	userID   domain.UserID
+	skuID    domain.SKUID
+	location string
+}
+
+// StockServiceUseCaseMockGetPriceTiersParamPtrs contains pointers to parameters of the StockServiceUseCase.GetPriceTiers
+type StockServiceUseCaseMockGetPriceTiersParamPtrs struct {
+	ctx      *context.Context
+	userID   *domain.UserID
+	skuID    *domain.SKUID
+	location *string
+}
+
+// StockServiceUseCaseMockGetPriceTiersResults contains results of the StockServiceUseCase.GetPriceTiers
+type StockServiceUseCaseMockGetPriceTiersResults struct {
+	pa1 []domain.PriceTier
+	err error
+}
+
+// StockServiceUseCaseMockGetPriceTiersOrigins contains origins of expectations of the StockServiceUseCase.GetPriceTiers
+type StockServiceUseCaseMockGetPriceTiersExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originSkuID    string
+	originLocation string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPriceTiers *mStockServiceUseCaseMockGetPriceTiers) Optional() *mStockServiceUseCaseMockGetPriceTiers {
+	mmGetPriceTiers.optional = true
+	return mmGetPriceTiers
+}
+
+// Expect sets up expected params for StockServiceUseCase.GetPriceTiers
+func (mmGetPriceTiers *mStockServiceUseCaseMockGetPriceTiers) Expect(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) *mStockServiceUseCaseMockGetPriceTiers {
+	if mmGetPriceTiers.mock.funcGetPriceTiers != nil {
+		mmGetPriceTiers.mock.t.Fatalf("StockServiceUseCaseMock.GetPriceTiers mock is already set by Set")
+	}
+
+	if mmGetPriceTiers.defaultExpectation == nil {
+		mmGetPriceTiers.defaultExpectation = &StockServiceUseCaseMockGetPriceTiersExpectation{}
+	}
+
+	if mmGetPriceTiers.defaultExpectation.paramPtrs != nil {
+		mmGetPriceTiers.mock.t.Fatalf("StockServiceUseCaseMock.GetPriceTiers mock is already set by ExpectParams functions")
+	}
+
+	mmGetPriceTiers.defaultExpectation.params = &StockServiceUseCaseMockGetPriceTiersParams{ctx, userID, skuID, location}
+	mmGetPriceTiers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPriceTiers.expectations {
+		if minimock.Equal(e.params, mmGetPriceTiers.defaultExpectation.params) {
+			mmGetPriceTiers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPriceTiers.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPriceTiers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.GetPriceTiers
+func (mmGetPriceTiers *mStockServiceUseCaseMockGetPriceTiers) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockGetPriceTiers {
+	if mmGetPriceTiers.mock.funcGetPriceTiers != nil {
+		mmGetPriceTiers.mock.t.Fatalf("StockServiceUseCaseMock.GetPriceTiers mock is already set by Set")
+	}
+
+	if mmGetPriceTiers.defaultExpectation == nil {
+		mmGetPriceTiers.defaultExpectation = &StockServiceUseCaseMockGetPriceTiersExpectation{}
+	}
+
+	if mmGetPriceTiers.defaultExpectation.params != nil {
+		mmGetPriceTiers.mock.t.Fatalf("StockServiceUseCaseMock.GetPriceTiers mock is already set by Expect")
+	}
+
+	if mmGetPriceTiers.defaultExpectation.paramPtrs == nil {
+		mmGetPriceTiers.defaultExpectation.paramPtrs = &StockServiceUseCaseMockGetPriceTiersParamPtrs{}
+	}
+	mmGetPriceTiers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPriceTiers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPriceTiers
+}
+
+// ExpectUserIDParam2 sets up expected param userID for StockServiceUseCase.GetPriceTiers
+func (mmGetPriceTiers *mStockServiceUseCaseMockGetPriceTiers) ExpectUserIDParam2(userID domain.UserID) *mStockServiceUseCaseMockGetPriceTiers {
+	if mmGetPriceTiers.mock.funcGetPriceTiers != nil {
+		mmGetPriceTiers.mock.t.Fatalf("StockServiceUseCaseMock.GetPriceTiers mock is already set by Set")
+	}
+
+	if mmGetPriceTiers.defaultExpectation == nil {
+		mmGetPriceTiers.defaultExpectation = &StockServiceUseCaseMockGetPriceTiersExpectation{}
+	}
+
+	if mmGetPriceTiers.defaultExpectation.params != nil {
+		mmGetPriceTiers.mock.t.Fatalf("StockServiceUseCaseMock.GetPriceTiers mock is already set by Expect")
+	}
+
+	if mmGetPriceTiers.defaultExpectation.paramPtrs == nil {
+		mmGetPriceTiers.defaultExpectation.paramPtrs = &StockServiceUseCaseMockGetPriceTiersParamPtrs{}
+	}
+	mmGetPriceTiers.defaultExpectation.paramPtrs.userID = &userID
+	mmGetPriceTiers.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetPriceTiers
+}
+
+// ExpectSkuIDParam3 sets up expected param skuID for StockServiceUseCase.GetPriceTiers
+func (mmGetPriceTiers *mStockServiceUseCaseMockGetPriceTiers) ExpectSkuIDParam3(skuID domain.SKUID) *mStockServiceUseCaseMockGetPriceTiers {
+	if mmGetPriceTiers.mock.funcGetPriceTiers != nil {
+		mmGetPriceTiers.mock.t.Fatalf("StockServiceUseCaseMock.GetPriceTiers mock is already set by Set")
+	}
+
+	if mmGetPriceTiers.defaultExpectation == nil {
+		mmGetPriceTiers.defaultExpectation = &StockServiceUseCaseMockGetPriceTiersExpectation{}
+	}
+
+	if mmGetPriceTiers.defaultExpectation.params != nil {
+		mmGetPriceTiers.mock.t.Fatalf("StockServiceUseCaseMock.GetPriceTiers mock is already set by Expect")
+	}
+
+	if mmGetPriceTiers.defaultExpectation.paramPtrs == nil {
+		mmGetPriceTiers.defaultExpectation.paramPtrs = &StockServiceUseCaseMockGetPriceTiersParamPtrs{}
+	}
+	mmGetPriceTiers.defaultExpectation.paramPtrs.skuID = &skuID
+	mmGetPriceTiers.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmGetPriceTiers
+}
+
+// ExpectLocationParam4 sets up expected param location for StockServiceUseCase.GetPriceTiers
+func (mmGetPriceTiers *mStockServiceUseCaseMockGetPriceTiers) ExpectLocationParam4(location string) *mStockServiceUseCaseMockGetPriceTiers {
+	if mmGetPriceTiers.mock.funcGetPriceTiers != nil {
+		mmGetPriceTiers.mock.t.Fatalf("StockServiceUseCaseMock.GetPriceTiers mock is already set by Set")
+	}
+
+	if mmGetPriceTiers.defaultExpectation == nil {
+		mmGetPriceTiers.defaultExpectation = &StockServiceUseCaseMockGetPriceTiersExpectation{}
+	}
+
+	if mmGetPriceTiers.defaultExpectation.params != nil {
+		mmGetPriceTiers.mock.t.Fatalf("StockServiceUseCaseMock.GetPriceTiers mock is already set by Expect")
+	}
+
+	if mmGetPriceTiers.defaultExpectation.paramPtrs == nil {
+		mmGetPriceTiers.defaultExpectation.paramPtrs = &StockServiceUseCaseMockGetPriceTiersParamPtrs{}
+	}
+	mmGetPriceTiers.defaultExpectation.paramPtrs.location = &location
+	mmGetPriceTiers.defaultExpectation.expectationOrigins.originLocation = minimock.CallerInfo(1)
+
+	return mmGetPriceTiers
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.GetPriceTiers
+func (mmGetPriceTiers *mStockServiceUseCaseMockGetPriceTiers) Inspect(f func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string)) *mStockServiceUseCaseMockGetPriceTiers {
+	if mmGetPriceTiers.mock.inspectFuncGetPriceTiers != nil {
+		mmGetPriceTiers.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.GetPriceTiers")
+	}
+
+	mmGetPriceTiers.mock.inspectFuncGetPriceTiers = f
+
+	return mmGetPriceTiers
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.GetPriceTiers
+func (mmGetPriceTiers *mStockServiceUseCaseMockGetPriceTiers) Return(pa1 []domain.PriceTier, err error) *StockServiceUseCaseMock {
+	if mmGetPriceTiers.mock.funcGetPriceTiers != nil {
+		mmGetPriceTiers.mock.t.Fatalf("StockServiceUseCaseMock.GetPriceTiers mock is already set by Set")
+	}
+
+	if mmGetPriceTiers.defaultExpectation == nil {
+		mmGetPriceTiers.defaultExpectation = &StockServiceUseCaseMockGetPriceTiersExpectation{mock: mmGetPriceTiers.mock}
+	}
+	mmGetPriceTiers.defaultExpectation.results = &StockServiceUseCaseMockGetPriceTiersResults{pa1, err}
+	mmGetPriceTiers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPriceTiers.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.GetPriceTiers method
+func (mmGetPriceTiers *mStockServiceUseCaseMockGetPriceTiers) Set(f func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) (pa1 []domain.PriceTier, err error)) *StockServiceUseCaseMock {
+	if mmGetPriceTiers.defaultExpectation != nil {
+		mmGetPriceTiers.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.GetPriceTiers method")
+	}
+
+	if len(mmGetPriceTiers.expectations) > 0 {
+		mmGetPriceTiers.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.GetPriceTiers method")
+	}
+
+	mmGetPriceTiers.mock.funcGetPriceTiers = f
+	mmGetPriceTiers.mock.funcGetPriceTiersOrigin = minimock.CallerInfo(1)
+	return mmGetPriceTiers.mock
+}
+
+// When sets expectation for the StockServiceUseCase.GetPriceTiers which will trigger the result defined by the following
+// Then helper
+func (mmGetPriceTiers *mStockServiceUseCaseMockGetPriceTiers) When(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) *StockServiceUseCaseMockGetPriceTiersExpectation {
+	if mmGetPriceTiers.mock.funcGetPriceTiers != nil {
+		mmGetPriceTiers.mock.t.Fatalf("StockServiceUseCaseMock.GetPriceTiers mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockGetPriceTiersExpectation{
+		mock:               mmGetPriceTiers.mock,
+		params:             &StockServiceUseCaseMockGetPriceTiersParams{ctx, userID, skuID, location},
+		expectationOrigins: StockServiceUseCaseMockGetPriceTiersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPriceTiers.expectations = append(mmGetPriceTiers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.GetPriceTiers return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockGetPriceTiersExpectation) Then(pa1 []domain.PriceTier, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockGetPriceTiersResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.GetPriceTiers should be invoked
+func (mmGetPriceTiers *mStockServiceUseCaseMockGetPriceTiers) Times(n uint64) *mStockServiceUseCaseMockGetPriceTiers {
+	if n == 0 {
+		mmGetPriceTiers.mock.t.Fatalf("Times of StockServiceUseCaseMock.GetPriceTiers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPriceTiers.expectedInvocations, n)
+	mmGetPriceTiers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPriceTiers
+}
+
+func (mmGetPriceTiers *mStockServiceUseCaseMockGetPriceTiers) invocationsDone() bool {
+	if len(mmGetPriceTiers.expectations) == 0 && mmGetPriceTiers.defaultExpectation == nil && mmGetPriceTiers.mock.funcGetPriceTiers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPriceTiers.mock.afterGetPriceTiersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPriceTiers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPriceTiers implements mm_usecase.StockServiceUseCase
+func (mmGetPriceTiers *StockServiceUseCaseMock) GetPriceTiers(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) (pa1 []domain.PriceTier, err error) {
+	mm_atomic.AddUint64(&mmGetPriceTiers.beforeGetPriceTiersCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPriceTiers.afterGetPriceTiersCounter, 1)
+
+	mmGetPriceTiers.t.Helper()
+
+	if mmGetPriceTiers.inspectFuncGetPriceTiers != nil {
+		mmGetPriceTiers.inspectFuncGetPriceTiers(ctx, userID, skuID, location)
+	}
+
+	mm_params := StockServiceUseCaseMockGetPriceTiersParams{ctx, userID, skuID, location}
+
+	// Record call args
+	mmGetPriceTiers.GetPriceTiersMock.mutex.Lock()
+	mmGetPriceTiers.GetPriceTiersMock.callArgs = append(mmGetPriceTiers.GetPriceTiersMock.callArgs, &mm_params)
+	mmGetPriceTiers.GetPriceTiersMock.mutex.Unlock()
+
+	for _, e := range mmGetPriceTiers.GetPriceTiersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmGetPriceTiers.GetPriceTiersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPriceTiers.GetPriceTiersMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPriceTiers.GetPriceTiersMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPriceTiers.GetPriceTiersMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockGetPriceTiersParams{ctx, userID, skuID, location}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPriceTiers.t.Errorf("StockServiceUseCaseMock.GetPriceTiers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPriceTiers.GetPriceTiersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetPriceTiers.t.Errorf("StockServiceUseCaseMock.GetPriceTiers got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPriceTiers.GetPriceTiersMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmGetPriceTiers.t.Errorf("StockServiceUseCaseMock.GetPriceTiers got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPriceTiers.GetPriceTiersMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.location != nil && !minimock.Equal(*mm_want_ptrs.location, mm_got.location) {
+				mmGetPriceTiers.t.Errorf("StockServiceUseCaseMock.GetPriceTiers got unexpected parameter location, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPriceTiers.GetPriceTiersMock.defaultExpectation.expectationOrigins.originLocation, *mm_want_ptrs.location, mm_got.location, minimock.Diff(*mm_want_ptrs.location, mm_got.location))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPriceTiers.t.Errorf("StockServiceUseCaseMock.GetPriceTiers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPriceTiers.GetPriceTiersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPriceTiers.GetPriceTiersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPriceTiers.t.Fatal("No results are set for the StockServiceUseCaseMock.GetPriceTiers")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmGetPriceTiers.funcGetPriceTiers != nil {
+		return mmGetPriceTiers.funcGetPriceTiers(ctx, userID, skuID, location)
+	}
+	mmGetPriceTiers.t.Fatalf("Unexpected call to StockServiceUseCaseMock.GetPriceTiers. %v %v %v %v", ctx, userID, skuID, location)
+	return
+}
+
+// GetPriceTiersAfterCounter returns a count of finished StockServiceUseCaseMock.GetPriceTiers invocations
+func (mmGetPriceTiers *StockServiceUseCaseMock) GetPriceTiersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPriceTiers.afterGetPriceTiersCounter)
+}
+
+// GetPriceTiersBeforeCounter returns a count of StockServiceUseCaseMock.GetPriceTiers invocations
+func (mmGetPriceTiers *StockServiceUseCaseMock) GetPriceTiersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPriceTiers.beforeGetPriceTiersCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.GetPriceTiers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPriceTiers *mStockServiceUseCaseMockGetPriceTiers) Calls() []*StockServiceUseCaseMockGetPriceTiersParams {
+	mmGetPriceTiers.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockGetPriceTiersParams, len(mmGetPriceTiers.callArgs))
+	copy(argCopy, mmGetPriceTiers.callArgs)
+
+	mmGetPriceTiers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPriceTiersDone returns true if the count of the GetPriceTiers invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockGetPriceTiersDone() bool {
+	if m.GetPriceTiersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPriceTiersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPriceTiersMock.invocationsDone()
+}
+
+// MinimockGetPriceTiersInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockGetPriceTiersInspect() {
+	for _, e := range m.GetPriceTiersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetPriceTiers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPriceTiersCounter := mm_atomic.LoadUint64(&m.afterGetPriceTiersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPriceTiersMock.defaultExpectation != nil && afterGetPriceTiersCounter < 1 {
+		if m.GetPriceTiersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetPriceTiers at\n%s", m.GetPriceTiersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetPriceTiers at\n%s with params: %#v", m.GetPriceTiersMock.defaultExpectation.expectationOrigins.origin, *m.GetPriceTiersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPriceTiers != nil && afterGetPriceTiersCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.GetPriceTiers at\n%s", m.funcGetPriceTiersOrigin)
+	}
+
+	if !m.GetPriceTiersMock.invocationsDone() && afterGetPriceTiersCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.GetPriceTiers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPriceTiersMock.expectedInvocations), m.GetPriceTiersMock.expectedInvocationsOrigin, afterGetPriceTiersCounter)
+	}
+}
+
+type mStockServiceUseCaseMockGetPurchaseOrder struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockGetPurchaseOrderExpectation
+	expectations       []*StockServiceUseCaseMockGetPurchaseOrderExpectation
+
+	callArgs []*StockServiceUseCaseMockGetPurchaseOrderParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockGetPurchaseOrderExpectation specifies expectation struct of the StockServiceUseCase.GetPurchaseOrder
+type StockServiceUseCaseMockGetPurchaseOrderExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockGetPurchaseOrderParams
+	paramPtrs          *StockServiceUseCaseMockGetPurchaseOrderParamPtrs
+	expectationOrigins StockServiceUseCaseMockGetPurchaseOrderExpectationOrigins
+	results            *StockServiceUseCaseMockGetPurchaseOrderResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockGetPurchaseOrderParams contains parameters of the StockServiceUseCase.GetPurchaseOrder
+type StockServiceUseCaseMockGetPurchaseOrderParams struct {
+	ctx             context.Context
+	purchaseOrderID domain.PurchaseOrderID
+}
+
+// StockServiceUseCaseMockGetPurchaseOrderParamPtrs contains pointers to parameters of the StockServiceUseCase.GetPurchaseOrder
+type StockServiceUseCaseMockGetPurchaseOrderParamPtrs struct {
+	ctx             *context.Context
+	purchaseOrderID *domain.PurchaseOrderID
+}
+
+// StockServiceUseCaseMockGetPurchaseOrderResults contains results of the StockServiceUseCase.GetPurchaseOrder
+type StockServiceUseCaseMockGetPurchaseOrderResults struct {
+	p1  domain.PurchaseOrder
+	err error
+}
+
+// StockServiceUseCaseMockGetPurchaseOrderOrigins contains origins of expectations of the StockServiceUseCase.GetPurchaseOrder
+type StockServiceUseCaseMockGetPurchaseOrderExpectationOrigins struct {
+	origin                string
+	originCtx             string
+	originPurchaseOrderID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPurchaseOrder *mStockServiceUseCaseMockGetPurchaseOrder) Optional() *mStockServiceUseCaseMockGetPurchaseOrder {
+	mmGetPurchaseOrder.optional = true
+	return mmGetPurchaseOrder
+}
+
+// Expect sets up expected params for StockServiceUseCase.GetPurchaseOrder
+func (mmGetPurchaseOrder *mStockServiceUseCaseMockGetPurchaseOrder) Expect(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) *mStockServiceUseCaseMockGetPurchaseOrder {
+	if mmGetPurchaseOrder.mock.funcGetPurchaseOrder != nil {
+		mmGetPurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.GetPurchaseOrder mock is already set by Set")
+	}
+
+	if mmGetPurchaseOrder.defaultExpectation == nil {
+		mmGetPurchaseOrder.defaultExpectation = &StockServiceUseCaseMockGetPurchaseOrderExpectation{}
+	}
+
+	if mmGetPurchaseOrder.defaultExpectation.paramPtrs != nil {
+		mmGetPurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.GetPurchaseOrder mock is already set by ExpectParams functions")
+	}
+
+	mmGetPurchaseOrder.defaultExpectation.params = &StockServiceUseCaseMockGetPurchaseOrderParams{ctx, purchaseOrderID}
+	mmGetPurchaseOrder.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPurchaseOrder.expectations {
+		if minimock.Equal(e.params, mmGetPurchaseOrder.defaultExpectation.params) {
+			mmGetPurchaseOrder.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPurchaseOrder.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPurchaseOrder
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.GetPurchaseOrder
+func (mmGetPurchaseOrder *mStockServiceUseCaseMockGetPurchaseOrder) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockGetPurchaseOrder {
+	if mmGetPurchaseOrder.mock.funcGetPurchaseOrder != nil {
+		mmGetPurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.GetPurchaseOrder mock is already set by Set")
+	}
+
+	if mmGetPurchaseOrder.defaultExpectation == nil {
+		mmGetPurchaseOrder.defaultExpectation = &StockServiceUseCaseMockGetPurchaseOrderExpectation{}
+	}
+
+	if mmGetPurchaseOrder.defaultExpectation.params != nil {
+		mmGetPurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.GetPurchaseOrder mock is already set by Expect")
+	}
+
+	if mmGetPurchaseOrder.defaultExpectation.paramPtrs == nil {
+		mmGetPurchaseOrder.defaultExpectation.paramPtrs = &StockServiceUseCaseMockGetPurchaseOrderParamPtrs{}
+	}
+	mmGetPurchaseOrder.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPurchaseOrder.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPurchaseOrder
+}
+
+// ExpectPurchaseOrderIDParam2 sets up expected param purchaseOrderID for StockServiceUseCase.GetPurchaseOrder
+func (mmGetPurchaseOrder *mStockServiceUseCaseMockGetPurchaseOrder) ExpectPurchaseOrderIDParam2(purchaseOrderID domain.PurchaseOrderID) *mStockServiceUseCaseMockGetPurchaseOrder {
+	if mmGetPurchaseOrder.mock.funcGetPurchaseOrder != nil {
+		mmGetPurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.GetPurchaseOrder mock is already set by Set")
+	}
+
+	if mmGetPurchaseOrder.defaultExpectation == nil {
+		mmGetPurchaseOrder.defaultExpectation = &StockServiceUseCaseMockGetPurchaseOrderExpectation{}
+	}
+
+	if mmGetPurchaseOrder.defaultExpectation.params != nil {
+		mmGetPurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.GetPurchaseOrder mock is already set by Expect")
+	}
+
+	if mmGetPurchaseOrder.defaultExpectation.paramPtrs == nil {
+		mmGetPurchaseOrder.defaultExpectation.paramPtrs = &StockServiceUseCaseMockGetPurchaseOrderParamPtrs{}
+	}
+	mmGetPurchaseOrder.defaultExpectation.paramPtrs.purchaseOrderID = &purchaseOrderID
+	mmGetPurchaseOrder.defaultExpectation.expectationOrigins.originPurchaseOrderID = minimock.CallerInfo(1)
+
+	return mmGetPurchaseOrder
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.GetPurchaseOrder
+func (mmGetPurchaseOrder *mStockServiceUseCaseMockGetPurchaseOrder) Inspect(f func(ctx context.Context, purchaseOrderID domain.PurchaseOrderID)) *mStockServiceUseCaseMockGetPurchaseOrder {
+	if mmGetPurchaseOrder.mock.inspectFuncGetPurchaseOrder != nil {
+		mmGetPurchaseOrder.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.GetPurchaseOrder")
+	}
+
+	mmGetPurchaseOrder.mock.inspectFuncGetPurchaseOrder = f
+
+	return mmGetPurchaseOrder
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.GetPurchaseOrder
+func (mmGetPurchaseOrder *mStockServiceUseCaseMockGetPurchaseOrder) Return(p1 domain.PurchaseOrder, err error) *StockServiceUseCaseMock {
+	if mmGetPurchaseOrder.mock.funcGetPurchaseOrder != nil {
+		mmGetPurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.GetPurchaseOrder mock is already set by Set")
+	}
+
+	if mmGetPurchaseOrder.defaultExpectation == nil {
+		mmGetPurchaseOrder.defaultExpectation = &StockServiceUseCaseMockGetPurchaseOrderExpectation{mock: mmGetPurchaseOrder.mock}
+	}
+	mmGetPurchaseOrder.defaultExpectation.results = &StockServiceUseCaseMockGetPurchaseOrderResults{p1, err}
+	mmGetPurchaseOrder.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPurchaseOrder.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.GetPurchaseOrder method
+func (mmGetPurchaseOrder *mStockServiceUseCaseMockGetPurchaseOrder) Set(f func(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) (p1 domain.PurchaseOrder, err error)) *StockServiceUseCaseMock {
+	if mmGetPurchaseOrder.defaultExpectation != nil {
+		mmGetPurchaseOrder.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.GetPurchaseOrder method")
+	}
+
+	if len(mmGetPurchaseOrder.expectations) > 0 {
+		mmGetPurchaseOrder.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.GetPurchaseOrder method")
+	}
+
+	mmGetPurchaseOrder.mock.funcGetPurchaseOrder = f
+	mmGetPurchaseOrder.mock.funcGetPurchaseOrderOrigin = minimock.CallerInfo(1)
+	return mmGetPurchaseOrder.mock
+}
+
+// When sets expectation for the StockServiceUseCase.GetPurchaseOrder which will trigger the result defined by the following
+// Then helper
+func (mmGetPurchaseOrder *mStockServiceUseCaseMockGetPurchaseOrder) When(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) *StockServiceUseCaseMockGetPurchaseOrderExpectation {
+	if mmGetPurchaseOrder.mock.funcGetPurchaseOrder != nil {
+		mmGetPurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.GetPurchaseOrder mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockGetPurchaseOrderExpectation{
+		mock:               mmGetPurchaseOrder.mock,
+		params:             &StockServiceUseCaseMockGetPurchaseOrderParams{ctx, purchaseOrderID},
+		expectationOrigins: StockServiceUseCaseMockGetPurchaseOrderExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPurchaseOrder.expectations = append(mmGetPurchaseOrder.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.GetPurchaseOrder return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockGetPurchaseOrderExpectation) Then(p1 domain.PurchaseOrder, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockGetPurchaseOrderResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.GetPurchaseOrder should be invoked
+func (mmGetPurchaseOrder *mStockServiceUseCaseMockGetPurchaseOrder) Times(n uint64) *mStockServiceUseCaseMockGetPurchaseOrder {
+	if n == 0 {
+		mmGetPurchaseOrder.mock.t.Fatalf("Times of StockServiceUseCaseMock.GetPurchaseOrder mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPurchaseOrder.expectedInvocations, n)
+	mmGetPurchaseOrder.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPurchaseOrder
+}
+
+func (mmGetPurchaseOrder *mStockServiceUseCaseMockGetPurchaseOrder) invocationsDone() bool {
+	if len(mmGetPurchaseOrder.expectations) == 0 && mmGetPurchaseOrder.defaultExpectation == nil && mmGetPurchaseOrder.mock.funcGetPurchaseOrder == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPurchaseOrder.mock.afterGetPurchaseOrderCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPurchaseOrder.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPurchaseOrder implements mm_usecase.StockServiceUseCase
+func (mmGetPurchaseOrder *StockServiceUseCaseMock) GetPurchaseOrder(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) (p1 domain.PurchaseOrder, err error) {
+	mm_atomic.AddUint64(&mmGetPurchaseOrder.beforeGetPurchaseOrderCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPurchaseOrder.afterGetPurchaseOrderCounter, 1)
+
+	mmGetPurchaseOrder.t.Helper()
+
+	if mmGetPurchaseOrder.inspectFuncGetPurchaseOrder != nil {
+		mmGetPurchaseOrder.inspectFuncGetPurchaseOrder(ctx, purchaseOrderID)
+	}
+
+	mm_params := StockServiceUseCaseMockGetPurchaseOrderParams{ctx, purchaseOrderID}
+
+	// Record call args
+	mmGetPurchaseOrder.GetPurchaseOrderMock.mutex.Lock()
+	mmGetPurchaseOrder.GetPurchaseOrderMock.callArgs = append(mmGetPurchaseOrder.GetPurchaseOrderMock.callArgs, &mm_params)
+	mmGetPurchaseOrder.GetPurchaseOrderMock.mutex.Unlock()
+
+	for _, e := range mmGetPurchaseOrder.GetPurchaseOrderMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmGetPurchaseOrder.GetPurchaseOrderMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPurchaseOrder.GetPurchaseOrderMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPurchaseOrder.GetPurchaseOrderMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPurchaseOrder.GetPurchaseOrderMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockGetPurchaseOrderParams{ctx, purchaseOrderID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPurchaseOrder.t.Errorf("StockServiceUseCaseMock.GetPurchaseOrder got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPurchaseOrder.GetPurchaseOrderMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.purchaseOrderID != nil && !minimock.Equal(*mm_want_ptrs.purchaseOrderID, mm_got.purchaseOrderID) {
+				mmGetPurchaseOrder.t.Errorf("StockServiceUseCaseMock.GetPurchaseOrder got unexpected parameter purchaseOrderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPurchaseOrder.GetPurchaseOrderMock.defaultExpectation.expectationOrigins.originPurchaseOrderID, *mm_want_ptrs.purchaseOrderID, mm_got.purchaseOrderID, minimock.Diff(*mm_want_ptrs.purchaseOrderID, mm_got.purchaseOrderID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPurchaseOrder.t.Errorf("StockServiceUseCaseMock.GetPurchaseOrder got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPurchaseOrder.GetPurchaseOrderMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPurchaseOrder.GetPurchaseOrderMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPurchaseOrder.t.Fatal("No results are set for the StockServiceUseCaseMock.GetPurchaseOrder")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmGetPurchaseOrder.funcGetPurchaseOrder != nil {
+		return mmGetPurchaseOrder.funcGetPurchaseOrder(ctx, purchaseOrderID)
+	}
+	mmGetPurchaseOrder.t.Fatalf("Unexpected call to StockServiceUseCaseMock.GetPurchaseOrder. %v %v", ctx, purchaseOrderID)
+	return
+}
+
+// GetPurchaseOrderAfterCounter returns a count of finished StockServiceUseCaseMock.GetPurchaseOrder invocations
+func (mmGetPurchaseOrder *StockServiceUseCaseMock) GetPurchaseOrderAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPurchaseOrder.afterGetPurchaseOrderCounter)
+}
+
+// GetPurchaseOrderBeforeCounter returns a count of StockServiceUseCaseMock.GetPurchaseOrder invocations
+func (mmGetPurchaseOrder *StockServiceUseCaseMock) GetPurchaseOrderBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPurchaseOrder.beforeGetPurchaseOrderCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.GetPurchaseOrder.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPurchaseOrder *mStockServiceUseCaseMockGetPurchaseOrder) Calls() []*StockServiceUseCaseMockGetPurchaseOrderParams {
+	mmGetPurchaseOrder.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockGetPurchaseOrderParams, len(mmGetPurchaseOrder.callArgs))
+	copy(argCopy, mmGetPurchaseOrder.callArgs)
+
+	mmGetPurchaseOrder.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPurchaseOrderDone returns true if the count of the GetPurchaseOrder invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockGetPurchaseOrderDone() bool {
+	if m.GetPurchaseOrderMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPurchaseOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPurchaseOrderMock.invocationsDone()
+}
+
+// MinimockGetPurchaseOrderInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockGetPurchaseOrderInspect() {
+	for _, e := range m.GetPurchaseOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetPurchaseOrder at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPurchaseOrderCounter := mm_atomic.LoadUint64(&m.afterGetPurchaseOrderCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPurchaseOrderMock.defaultExpectation != nil && afterGetPurchaseOrderCounter < 1 {
+		if m.GetPurchaseOrderMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetPurchaseOrder at\n%s", m.GetPurchaseOrderMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetPurchaseOrder at\n%s with params: %#v", m.GetPurchaseOrderMock.defaultExpectation.expectationOrigins.origin, *m.GetPurchaseOrderMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPurchaseOrder != nil && afterGetPurchaseOrderCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.GetPurchaseOrder at\n%s", m.funcGetPurchaseOrderOrigin)
+	}
+
+	if !m.GetPurchaseOrderMock.invocationsDone() && afterGetPurchaseOrderCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.GetPurchaseOrder at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPurchaseOrderMock.expectedInvocations), m.GetPurchaseOrderMock.expectedInvocationsOrigin, afterGetPurchaseOrderCounter)
+	}
+}
+
+type mStockServiceUseCaseMockGetReorderSuggestions struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockGetReorderSuggestionsExpectation
+	expectations       []*StockServiceUseCaseMockGetReorderSuggestionsExpectation
+
+	callArgs []*StockServiceUseCaseMockGetReorderSuggestionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockGetReorderSuggestionsExpectation specifies expectation struct of the StockServiceUseCase.GetReorderSuggestions
+type StockServiceUseCaseMockGetReorderSuggestionsExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockGetReorderSuggestionsParams
+	paramPtrs          *StockServiceUseCaseMockGetReorderSuggestionsParamPtrs
+	expectationOrigins StockServiceUseCaseMockGetReorderSuggestionsExpectationOrigins
+	results            *StockServiceUseCaseMockGetReorderSuggestionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockGetReorderSuggestionsParams contains parameters of the StockServiceUseCase.GetReorderSuggestions
+type StockServiceUseCaseMockGetReorderSuggestionsParams struct {
+	ctx    context.Context
+	filter domain.ReorderFilter
+}
+
+// StockServiceUseCaseMockGetReorderSuggestionsParamPtrs contains pointers to parameters of the StockServiceUseCase.GetReorderSuggestions
+type StockServiceUseCaseMockGetReorderSuggestionsParamPtrs struct {
+	ctx    *context.Context
+	filter *domain.ReorderFilter
+}
+
+// StockServiceUseCaseMockGetReorderSuggestionsResults contains results of the StockServiceUseCase.GetReorderSuggestions
+type StockServiceUseCaseMockGetReorderSuggestionsResults struct {
+	ra1 []domain.ReorderSuggestion
+	err error
+}
+
+// StockServiceUseCaseMockGetReorderSuggestionsOrigins contains origins of expectations of the StockServiceUseCase.GetReorderSuggestions
+type StockServiceUseCaseMockGetReorderSuggestionsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetReorderSuggestions *mStockServiceUseCaseMockGetReorderSuggestions) Optional() *mStockServiceUseCaseMockGetReorderSuggestions {
+	mmGetReorderSuggestions.optional = true
+	return mmGetReorderSuggestions
+}
+
+// Expect sets up expected params for StockServiceUseCase.GetReorderSuggestions
+func (mmGetReorderSuggestions *mStockServiceUseCaseMockGetReorderSuggestions) Expect(ctx context.Context, filter domain.ReorderFilter) *mStockServiceUseCaseMockGetReorderSuggestions {
+	if mmGetReorderSuggestions.mock.funcGetReorderSuggestions != nil {
+		mmGetReorderSuggestions.mock.t.Fatalf("StockServiceUseCaseMock.GetReorderSuggestions mock is already set by Set")
+	}
+
+	if mmGetReorderSuggestions.defaultExpectation == nil {
+		mmGetReorderSuggestions.defaultExpectation = &StockServiceUseCaseMockGetReorderSuggestionsExpectation{}
+	}
+
+	if mmGetReorderSuggestions.defaultExpectation.paramPtrs != nil {
+		mmGetReorderSuggestions.mock.t.Fatalf("StockServiceUseCaseMock.GetReorderSuggestions mock is already set by ExpectParams functions")
+	}
+
+	mmGetReorderSuggestions.defaultExpectation.params = &StockServiceUseCaseMockGetReorderSuggestionsParams{ctx, filter}
+	mmGetReorderSuggestions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetReorderSuggestions.expectations {
+		if minimock.Equal(e.params, mmGetReorderSuggestions.defaultExpectation.params) {
+			mmGetReorderSuggestions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetReorderSuggestions.defaultExpectation.params)
+		}
+	}
+
+	return mmGetReorderSuggestions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.GetReorderSuggestions
+func (mmGetReorderSuggestions *mStockServiceUseCaseMockGetReorderSuggestions) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockGetReorderSuggestions {
+	if mmGetReorderSuggestions.mock.funcGetReorderSuggestions != nil {
+		mmGetReorderSuggestions.mock.t.Fatalf("StockServiceUseCaseMock.GetReorderSuggestions mock is already set by Set")
+	}
+
+	if mmGetReorderSuggestions.defaultExpectation == nil {
+		mmGetReorderSuggestions.defaultExpectation = &StockServiceUseCaseMockGetReorderSuggestionsExpectation{}
+	}
+
+	if mmGetReorderSuggestions.defaultExpectation.params != nil {
+		mmGetReorderSuggestions.mock.t.Fatalf("StockServiceUseCaseMock.GetReorderSuggestions mock is already set by Expect")
+	}
+
+	if mmGetReorderSuggestions.defaultExpectation.paramPtrs == nil {
+		mmGetReorderSuggestions.defaultExpectation.paramPtrs = &StockServiceUseCaseMockGetReorderSuggestionsParamPtrs{}
+	}
+	mmGetReorderSuggestions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetReorderSuggestions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetReorderSuggestions
+}
+
+// ExpectFilterParam2 sets up expected param filter for StockServiceUseCase.GetReorderSuggestions
+func (mmGetReorderSuggestions *mStockServiceUseCaseMockGetReorderSuggestions) ExpectFilterParam2(filter domain.ReorderFilter) *mStockServiceUseCaseMockGetReorderSuggestions {
+	if mmGetReorderSuggestions.mock.funcGetReorderSuggestions != nil {
+		mmGetReorderSuggestions.mock.t.Fatalf("StockServiceUseCaseMock.GetReorderSuggestions mock is already set by Set")
+	}
+
+	if mmGetReorderSuggestions.defaultExpectation == nil {
+		mmGetReorderSuggestions.defaultExpectation = &StockServiceUseCaseMockGetReorderSuggestionsExpectation{}
+	}
+
+	if mmGetReorderSuggestions.defaultExpectation.params != nil {
+		mmGetReorderSuggestions.mock.t.Fatalf("StockServiceUseCaseMock.GetReorderSuggestions mock is already set by Expect")
+	}
+
+	if mmGetReorderSuggestions.defaultExpectation.paramPtrs == nil {
+		mmGetReorderSuggestions.defaultExpectation.paramPtrs = &StockServiceUseCaseMockGetReorderSuggestionsParamPtrs{}
+	}
+	mmGetReorderSuggestions.defaultExpectation.paramPtrs.filter = &filter
+	mmGetReorderSuggestions.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmGetReorderSuggestions
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.GetReorderSuggestions
+func (mmGetReorderSuggestions *mStockServiceUseCaseMockGetReorderSuggestions) Inspect(f func(ctx context.Context, filter domain.ReorderFilter)) *mStockServiceUseCaseMockGetReorderSuggestions {
+	if mmGetReorderSuggestions.mock.inspectFuncGetReorderSuggestions != nil {
+		mmGetReorderSuggestions.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.GetReorderSuggestions")
+	}
+
+	mmGetReorderSuggestions.mock.inspectFuncGetReorderSuggestions = f
+
+	return mmGetReorderSuggestions
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.GetReorderSuggestions
+func (mmGetReorderSuggestions *mStockServiceUseCaseMockGetReorderSuggestions) Return(ra1 []domain.ReorderSuggestion, err error) *StockServiceUseCaseMock {
+	if mmGetReorderSuggestions.mock.funcGetReorderSuggestions != nil {
+		mmGetReorderSuggestions.mock.t.Fatalf("StockServiceUseCaseMock.GetReorderSuggestions mock is already set by Set")
+	}
+
+	if mmGetReorderSuggestions.defaultExpectation == nil {
+		mmGetReorderSuggestions.defaultExpectation = &StockServiceUseCaseMockGetReorderSuggestionsExpectation{mock: mmGetReorderSuggestions.mock}
+	}
+	mmGetReorderSuggestions.defaultExpectation.results = &StockServiceUseCaseMockGetReorderSuggestionsResults{ra1, err}
+	mmGetReorderSuggestions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetReorderSuggestions.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.GetReorderSuggestions method
+func (mmGetReorderSuggestions *mStockServiceUseCaseMockGetReorderSuggestions) Set(f func(ctx context.Context, filter domain.ReorderFilter) (ra1 []domain.ReorderSuggestion, err error)) *StockServiceUseCaseMock {
+	if mmGetReorderSuggestions.defaultExpectation != nil {
+		mmGetReorderSuggestions.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.GetReorderSuggestions method")
+	}
+
+	if len(mmGetReorderSuggestions.expectations) > 0 {
+		mmGetReorderSuggestions.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.GetReorderSuggestions method")
+	}
+
+	mmGetReorderSuggestions.mock.funcGetReorderSuggestions = f
+	mmGetReorderSuggestions.mock.funcGetReorderSuggestionsOrigin = minimock.CallerInfo(1)
+	return mmGetReorderSuggestions.mock
+}
+
+// When sets expectation for the StockServiceUseCase.GetReorderSuggestions which will trigger the result defined by the following
+// Then helper
+func (mmGetReorderSuggestions *mStockServiceUseCaseMockGetReorderSuggestions) When(ctx context.Context, filter domain.ReorderFilter) *StockServiceUseCaseMockGetReorderSuggestionsExpectation {
+	if mmGetReorderSuggestions.mock.funcGetReorderSuggestions != nil {
+		mmGetReorderSuggestions.mock.t.Fatalf("StockServiceUseCaseMock.GetReorderSuggestions mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockGetReorderSuggestionsExpectation{
+		mock:               mmGetReorderSuggestions.mock,
+		params:             &StockServiceUseCaseMockGetReorderSuggestionsParams{ctx, filter},
+		expectationOrigins: StockServiceUseCaseMockGetReorderSuggestionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetReorderSuggestions.expectations = append(mmGetReorderSuggestions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.GetReorderSuggestions return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockGetReorderSuggestionsExpectation) Then(ra1 []domain.ReorderSuggestion, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockGetReorderSuggestionsResults{ra1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.GetReorderSuggestions should be invoked
+func (mmGetReorderSuggestions *mStockServiceUseCaseMockGetReorderSuggestions) Times(n uint64) *mStockServiceUseCaseMockGetReorderSuggestions {
+	if n == 0 {
+		mmGetReorderSuggestions.mock.t.Fatalf("Times of StockServiceUseCaseMock.GetReorderSuggestions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetReorderSuggestions.expectedInvocations, n)
+	mmGetReorderSuggestions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetReorderSuggestions
+}
+
+func (mmGetReorderSuggestions *mStockServiceUseCaseMockGetReorderSuggestions) invocationsDone() bool {
+	if len(mmGetReorderSuggestions.expectations) == 0 && mmGetReorderSuggestions.defaultExpectation == nil && mmGetReorderSuggestions.mock.funcGetReorderSuggestions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetReorderSuggestions.mock.afterGetReorderSuggestionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetReorderSuggestions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetReorderSuggestions implements mm_usecase.StockServiceUseCase
+func (mmGetReorderSuggestions *StockServiceUseCaseMock) GetReorderSuggestions(ctx context.Context, filter domain.ReorderFilter) (ra1 []domain.ReorderSuggestion, err error) {
+	mm_atomic.AddUint64(&mmGetReorderSuggestions.beforeGetReorderSuggestionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetReorderSuggestions.afterGetReorderSuggestionsCounter, 1)
+
+	mmGetReorderSuggestions.t.Helper()
+
+	if mmGetReorderSuggestions.inspectFuncGetReorderSuggestions != nil {
+		mmGetReorderSuggestions.inspectFuncGetReorderSuggestions(ctx, filter)
+	}
+
+	mm_params := StockServiceUseCaseMockGetReorderSuggestionsParams{ctx, filter}
+
+	// Record call args
+	mmGetReorderSuggestions.GetReorderSuggestionsMock.mutex.Lock()
+	mmGetReorderSuggestions.GetReorderSuggestionsMock.callArgs = append(mmGetReorderSuggestions.GetReorderSuggestionsMock.callArgs, &mm_params)
+	mmGetReorderSuggestions.GetReorderSuggestionsMock.mutex.Unlock()
+
+	for _, e := range mmGetReorderSuggestions.GetReorderSuggestionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ra1, e.results.err
+		}
+	}
+
+	if mmGetReorderSuggestions.GetReorderSuggestionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetReorderSuggestions.GetReorderSuggestionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetReorderSuggestions.GetReorderSuggestionsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetReorderSuggestions.GetReorderSuggestionsMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockGetReorderSuggestionsParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetReorderSuggestions.t.Errorf("StockServiceUseCaseMock.GetReorderSuggestions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReorderSuggestions.GetReorderSuggestionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmGetReorderSuggestions.t.Errorf("StockServiceUseCaseMock.GetReorderSuggestions got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReorderSuggestions.GetReorderSuggestionsMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetReorderSuggestions.t.Errorf("StockServiceUseCaseMock.GetReorderSuggestions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetReorderSuggestions.GetReorderSuggestionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetReorderSuggestions.GetReorderSuggestionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetReorderSuggestions.t.Fatal("No results are set for the StockServiceUseCaseMock.GetReorderSuggestions")
+		}
+		return (*mm_results).ra1, (*mm_results).err
+	}
+	if mmGetReorderSuggestions.funcGetReorderSuggestions != nil {
+		return mmGetReorderSuggestions.funcGetReorderSuggestions(ctx, filter)
+	}
+	mmGetReorderSuggestions.t.Fatalf("Unexpected call to StockServiceUseCaseMock.GetReorderSuggestions. %v %v", ctx, filter)
+	return
+}
+
+// GetReorderSuggestionsAfterCounter returns a count of finished StockServiceUseCaseMock.GetReorderSuggestions invocations
+func (mmGetReorderSuggestions *StockServiceUseCaseMock) GetReorderSuggestionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReorderSuggestions.afterGetReorderSuggestionsCounter)
+}
+
+// GetReorderSuggestionsBeforeCounter returns a count of StockServiceUseCaseMock.GetReorderSuggestions invocations
+func (mmGetReorderSuggestions *StockServiceUseCaseMock) GetReorderSuggestionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReorderSuggestions.beforeGetReorderSuggestionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.GetReorderSuggestions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetReorderSuggestions *mStockServiceUseCaseMockGetReorderSuggestions) Calls() []*StockServiceUseCaseMockGetReorderSuggestionsParams {
+	mmGetReorderSuggestions.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockGetReorderSuggestionsParams, len(mmGetReorderSuggestions.callArgs))
+	copy(argCopy, mmGetReorderSuggestions.callArgs)
+
+	mmGetReorderSuggestions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetReorderSuggestionsDone returns true if the count of the GetReorderSuggestions invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockGetReorderSuggestionsDone() bool {
+	if m.GetReorderSuggestionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetReorderSuggestionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetReorderSuggestionsMock.invocationsDone()
+}
+
+// MinimockGetReorderSuggestionsInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockGetReorderSuggestionsInspect() {
+	for _, e := range m.GetReorderSuggestionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetReorderSuggestions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetReorderSuggestionsCounter := mm_atomic.LoadUint64(&m.afterGetReorderSuggestionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetReorderSuggestionsMock.defaultExpectation != nil && afterGetReorderSuggestionsCounter < 1 {
+		if m.GetReorderSuggestionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetReorderSuggestions at\n%s", m.GetReorderSuggestionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetReorderSuggestions at\n%s with params: %#v", m.GetReorderSuggestionsMock.defaultExpectation.expectationOrigins.origin, *m.GetReorderSuggestionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetReorderSuggestions != nil && afterGetReorderSuggestionsCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.GetReorderSuggestions at\n%s", m.funcGetReorderSuggestionsOrigin)
+	}
+
+	if !m.GetReorderSuggestionsMock.invocationsDone() && afterGetReorderSuggestionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.GetReorderSuggestions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetReorderSuggestionsMock.expectedInvocations), m.GetReorderSuggestionsMock.expectedInvocationsOrigin, afterGetReorderSuggestionsCounter)
+	}
+}
+
+type mStockServiceUseCaseMockGetStockItemBySKU struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockGetStockItemBySKUExpectation
+	expectations       []*StockServiceUseCaseMockGetStockItemBySKUExpectation
+
+	callArgs []*StockServiceUseCaseMockGetStockItemBySKUParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockGetStockItemBySKUExpectation specifies expectation struct of the StockServiceUseCase.GetStockItemBySKU
+type StockServiceUseCaseMockGetStockItemBySKUExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockGetStockItemBySKUParams
+	paramPtrs          *StockServiceUseCaseMockGetStockItemBySKUParamPtrs
+	expectationOrigins StockServiceUseCaseMockGetStockItemBySKUExpectationOrigins
+	results            *StockServiceUseCaseMockGetStockItemBySKUResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockGetStockItemBySKUParams contains parameters of the StockServiceUseCase.GetStockItemBySKU
+type StockServiceUseCaseMockGetStockItemBySKUParams struct {
+	ctx    context.Context
+	filter domain.OfferFilter
+}
+
+// StockServiceUseCaseMockGetStockItemBySKUParamPtrs contains pointers to parameters of the StockServiceUseCase.GetStockItemBySKU
+type StockServiceUseCaseMockGetStockItemBySKUParamPtrs struct {
+	ctx    *context.Context
+	filter *domain.OfferFilter
+}
+
+// StockServiceUseCaseMockGetStockItemBySKUResults contains results of the StockServiceUseCase.GetStockItemBySKU
+type StockServiceUseCaseMockGetStockItemBySKUResults struct {
+	s1  domain.StockOffers
 	err error
 }
 
 // StockServiceUseCaseMockGetStockItemBySKUOrigins contains origins of expectations of the StockServiceUseCase.GetStockItemBySKU
 type StockServiceUseCaseMockGetStockItemBySKUExpectationOrigins struct {
-	origin      string
-	originCtx   string
-	originSkuID string
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetStockItemBySKU *mStockServiceUseCaseMockGetStockItemBySKU) Optional() *mStockServiceUseCaseMockGetStockItemBySKU {
+	mmGetStockItemBySKU.optional = true
+	return mmGetStockItemBySKU
+}
+
+// Expect sets up expected params for StockServiceUseCase.GetStockItemBySKU
+func (mmGetStockItemBySKU *mStockServiceUseCaseMockGetStockItemBySKU) Expect(ctx context.Context, filter domain.OfferFilter) *mStockServiceUseCaseMockGetStockItemBySKU {
+	if mmGetStockItemBySKU.mock.funcGetStockItemBySKU != nil {
+		mmGetStockItemBySKU.mock.t.Fatalf("StockServiceUseCaseMock.GetStockItemBySKU mock is already set by Set")
+	}
+
+	if mmGetStockItemBySKU.defaultExpectation == nil {
+		mmGetStockItemBySKU.defaultExpectation = &StockServiceUseCaseMockGetStockItemBySKUExpectation{}
+	}
+
+	if mmGetStockItemBySKU.defaultExpectation.paramPtrs != nil {
+		mmGetStockItemBySKU.mock.t.Fatalf("StockServiceUseCaseMock.GetStockItemBySKU mock is already set by ExpectParams functions")
+	}
+
+	mmGetStockItemBySKU.defaultExpectation.params = &StockServiceUseCaseMockGetStockItemBySKUParams{ctx, filter}
+	mmGetStockItemBySKU.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetStockItemBySKU.expectations {
+		if minimock.Equal(e.params, mmGetStockItemBySKU.defaultExpectation.params) {
+			mmGetStockItemBySKU.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetStockItemBySKU.defaultExpectation.params)
+		}
+	}
+
+	return mmGetStockItemBySKU
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.GetStockItemBySKU
+func (mmGetStockItemBySKU *mStockServiceUseCaseMockGetStockItemBySKU) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockGetStockItemBySKU {
+	if mmGetStockItemBySKU.mock.funcGetStockItemBySKU != nil {
+		mmGetStockItemBySKU.mock.t.Fatalf("StockServiceUseCaseMock.GetStockItemBySKU mock is already set by Set")
+	}
+
+	if mmGetStockItemBySKU.defaultExpectation == nil {
+		mmGetStockItemBySKU.defaultExpectation = &StockServiceUseCaseMockGetStockItemBySKUExpectation{}
+	}
+
+	if mmGetStockItemBySKU.defaultExpectation.params != nil {
+		mmGetStockItemBySKU.mock.t.Fatalf("StockServiceUseCaseMock.GetStockItemBySKU mock is already set by Expect")
+	}
+
+	if mmGetStockItemBySKU.defaultExpectation.paramPtrs == nil {
+		mmGetStockItemBySKU.defaultExpectation.paramPtrs = &StockServiceUseCaseMockGetStockItemBySKUParamPtrs{}
+	}
+	mmGetStockItemBySKU.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetStockItemBySKU.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetStockItemBySKU
+}
+
+// ExpectFilterParam2 sets up expected param filter for StockServiceUseCase.GetStockItemBySKU
+func (mmGetStockItemBySKU *mStockServiceUseCaseMockGetStockItemBySKU) ExpectFilterParam2(filter domain.OfferFilter) *mStockServiceUseCaseMockGetStockItemBySKU {
+	if mmGetStockItemBySKU.mock.funcGetStockItemBySKU != nil {
+		mmGetStockItemBySKU.mock.t.Fatalf("StockServiceUseCaseMock.GetStockItemBySKU mock is already set by Set")
+	}
+
+	if mmGetStockItemBySKU.defaultExpectation == nil {
+		mmGetStockItemBySKU.defaultExpectation = &StockServiceUseCaseMockGetStockItemBySKUExpectation{}
+	}
+
+	if mmGetStockItemBySKU.defaultExpectation.params != nil {
+		mmGetStockItemBySKU.mock.t.Fatalf("StockServiceUseCaseMock.GetStockItemBySKU mock is already set by Expect")
+	}
+
+	if mmGetStockItemBySKU.defaultExpectation.paramPtrs == nil {
+		mmGetStockItemBySKU.defaultExpectation.paramPtrs = &StockServiceUseCaseMockGetStockItemBySKUParamPtrs{}
+	}
+	mmGetStockItemBySKU.defaultExpectation.paramPtrs.filter = &filter
+	mmGetStockItemBySKU.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmGetStockItemBySKU
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.GetStockItemBySKU
+func (mmGetStockItemBySKU *mStockServiceUseCaseMockGetStockItemBySKU) Inspect(f func(ctx context.Context, filter domain.OfferFilter)) *mStockServiceUseCaseMockGetStockItemBySKU {
+	if mmGetStockItemBySKU.mock.inspectFuncGetStockItemBySKU != nil {
+		mmGetStockItemBySKU.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.GetStockItemBySKU")
+	}
+
+	mmGetStockItemBySKU.mock.inspectFuncGetStockItemBySKU = f
+
+	return mmGetStockItemBySKU
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.GetStockItemBySKU
+func (mmGetStockItemBySKU *mStockServiceUseCaseMockGetStockItemBySKU) Return(s1 domain.StockOffers, err error) *StockServiceUseCaseMock {
+	if mmGetStockItemBySKU.mock.funcGetStockItemBySKU != nil {
+		mmGetStockItemBySKU.mock.t.Fatalf("StockServiceUseCaseMock.GetStockItemBySKU mock is already set by Set")
+	}
+
+	if mmGetStockItemBySKU.defaultExpectation == nil {
+		mmGetStockItemBySKU.defaultExpectation = &StockServiceUseCaseMockGetStockItemBySKUExpectation{mock: mmGetStockItemBySKU.mock}
+	}
+	mmGetStockItemBySKU.defaultExpectation.results = &StockServiceUseCaseMockGetStockItemBySKUResults{s1, err}
+	mmGetStockItemBySKU.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetStockItemBySKU.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.GetStockItemBySKU method
+func (mmGetStockItemBySKU *mStockServiceUseCaseMockGetStockItemBySKU) Set(f func(ctx context.Context, filter domain.OfferFilter) (s1 domain.StockOffers, err error)) *StockServiceUseCaseMock {
+	if mmGetStockItemBySKU.defaultExpectation != nil {
+		mmGetStockItemBySKU.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.GetStockItemBySKU method")
+	}
+
+	if len(mmGetStockItemBySKU.expectations) > 0 {
+		mmGetStockItemBySKU.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.GetStockItemBySKU method")
+	}
+
+	mmGetStockItemBySKU.mock.funcGetStockItemBySKU = f
+	mmGetStockItemBySKU.mock.funcGetStockItemBySKUOrigin = minimock.CallerInfo(1)
+	return mmGetStockItemBySKU.mock
+}
+
+// When sets expectation for the StockServiceUseCase.GetStockItemBySKU which will trigger the result defined by the following
+// Then helper
+func (mmGetStockItemBySKU *mStockServiceUseCaseMockGetStockItemBySKU) When(ctx context.Context, filter domain.OfferFilter) *StockServiceUseCaseMockGetStockItemBySKUExpectation {
+	if mmGetStockItemBySKU.mock.funcGetStockItemBySKU != nil {
+		mmGetStockItemBySKU.mock.t.Fatalf("StockServiceUseCaseMock.GetStockItemBySKU mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockGetStockItemBySKUExpectation{
+		mock:               mmGetStockItemBySKU.mock,
+		params:             &StockServiceUseCaseMockGetStockItemBySKUParams{ctx, filter},
+		expectationOrigins: StockServiceUseCaseMockGetStockItemBySKUExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetStockItemBySKU.expectations = append(mmGetStockItemBySKU.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.GetStockItemBySKU return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockGetStockItemBySKUExpectation) Then(s1 domain.StockOffers, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockGetStockItemBySKUResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.GetStockItemBySKU should be invoked
+func (mmGetStockItemBySKU *mStockServiceUseCaseMockGetStockItemBySKU) Times(n uint64) *mStockServiceUseCaseMockGetStockItemBySKU {
+	if n == 0 {
+		mmGetStockItemBySKU.mock.t.Fatalf("Times of StockServiceUseCaseMock.GetStockItemBySKU mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetStockItemBySKU.expectedInvocations, n)
+	mmGetStockItemBySKU.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetStockItemBySKU
+}
+
+func (mmGetStockItemBySKU *mStockServiceUseCaseMockGetStockItemBySKU) invocationsDone() bool {
+	if len(mmGetStockItemBySKU.expectations) == 0 && mmGetStockItemBySKU.defaultExpectation == nil && mmGetStockItemBySKU.mock.funcGetStockItemBySKU == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetStockItemBySKU.mock.afterGetStockItemBySKUCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetStockItemBySKU.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetStockItemBySKU implements mm_usecase.StockServiceUseCase
+func (mmGetStockItemBySKU *StockServiceUseCaseMock) GetStockItemBySKU(ctx context.Context, filter domain.OfferFilter) (s1 domain.StockOffers, err error) {
+	mm_atomic.AddUint64(&mmGetStockItemBySKU.beforeGetStockItemBySKUCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetStockItemBySKU.afterGetStockItemBySKUCounter, 1)
+
+	mmGetStockItemBySKU.t.Helper()
+
+	if mmGetStockItemBySKU.inspectFuncGetStockItemBySKU != nil {
+		mmGetStockItemBySKU.inspectFuncGetStockItemBySKU(ctx, filter)
+	}
+
+	mm_params := StockServiceUseCaseMockGetStockItemBySKUParams{ctx, filter}
+
+	// Record call args
+	mmGetStockItemBySKU.GetStockItemBySKUMock.mutex.Lock()
+	mmGetStockItemBySKU.GetStockItemBySKUMock.callArgs = append(mmGetStockItemBySKU.GetStockItemBySKUMock.callArgs, &mm_params)
+	mmGetStockItemBySKU.GetStockItemBySKUMock.mutex.Unlock()
+
+	for _, e := range mmGetStockItemBySKU.GetStockItemBySKUMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetStockItemBySKU.GetStockItemBySKUMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetStockItemBySKU.GetStockItemBySKUMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetStockItemBySKU.GetStockItemBySKUMock.defaultExpectation.params
+		mm_want_ptrs := mmGetStockItemBySKU.GetStockItemBySKUMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockGetStockItemBySKUParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetStockItemBySKU.t.Errorf("StockServiceUseCaseMock.GetStockItemBySKU got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetStockItemBySKU.GetStockItemBySKUMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmGetStockItemBySKU.t.Errorf("StockServiceUseCaseMock.GetStockItemBySKU got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetStockItemBySKU.GetStockItemBySKUMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetStockItemBySKU.t.Errorf("StockServiceUseCaseMock.GetStockItemBySKU got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetStockItemBySKU.GetStockItemBySKUMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetStockItemBySKU.GetStockItemBySKUMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetStockItemBySKU.t.Fatal("No results are set for the StockServiceUseCaseMock.GetStockItemBySKU")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetStockItemBySKU.funcGetStockItemBySKU != nil {
+		return mmGetStockItemBySKU.funcGetStockItemBySKU(ctx, filter)
+	}
+	mmGetStockItemBySKU.t.Fatalf("Unexpected call to StockServiceUseCaseMock.GetStockItemBySKU. %v %v", ctx, filter)
+	return
+}
+
+// GetStockItemBySKUAfterCounter returns a count of finished StockServiceUseCaseMock.GetStockItemBySKU invocations
+func (mmGetStockItemBySKU *StockServiceUseCaseMock) GetStockItemBySKUAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetStockItemBySKU.afterGetStockItemBySKUCounter)
+}
+
+// GetStockItemBySKUBeforeCounter returns a count of StockServiceUseCaseMock.GetStockItemBySKU invocations
+func (mmGetStockItemBySKU *StockServiceUseCaseMock) GetStockItemBySKUBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetStockItemBySKU.beforeGetStockItemBySKUCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.GetStockItemBySKU.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetStockItemBySKU *mStockServiceUseCaseMockGetStockItemBySKU) Calls() []*StockServiceUseCaseMockGetStockItemBySKUParams {
+	mmGetStockItemBySKU.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockGetStockItemBySKUParams, len(mmGetStockItemBySKU.callArgs))
+	copy(argCopy, mmGetStockItemBySKU.callArgs)
+
+	mmGetStockItemBySKU.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetStockItemBySKUDone returns true if the count of the GetStockItemBySKU invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockGetStockItemBySKUDone() bool {
+	if m.GetStockItemBySKUMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetStockItemBySKUMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetStockItemBySKUMock.invocationsDone()
+}
+
+// MinimockGetStockItemBySKUInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockGetStockItemBySKUInspect() {
+	for _, e := range m.GetStockItemBySKUMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetStockItemBySKU at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetStockItemBySKUCounter := mm_atomic.LoadUint64(&m.afterGetStockItemBySKUCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetStockItemBySKUMock.defaultExpectation != nil && afterGetStockItemBySKUCounter < 1 {
+		if m.GetStockItemBySKUMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetStockItemBySKU at\n%s", m.GetStockItemBySKUMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetStockItemBySKU at\n%s with params: %#v", m.GetStockItemBySKUMock.defaultExpectation.expectationOrigins.origin, *m.GetStockItemBySKUMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetStockItemBySKU != nil && afterGetStockItemBySKUCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.GetStockItemBySKU at\n%s", m.funcGetStockItemBySKUOrigin)
+	}
+
+	if !m.GetStockItemBySKUMock.invocationsDone() && afterGetStockItemBySKUCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.GetStockItemBySKU at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetStockItemBySKUMock.expectedInvocations), m.GetStockItemBySKUMock.expectedInvocationsOrigin, afterGetStockItemBySKUCounter)
+	}
+}
+
+type mStockServiceUseCaseMockGetStockItemsBySKUs struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockGetStockItemsBySKUsExpectation
+	expectations       []*StockServiceUseCaseMockGetStockItemsBySKUsExpectation
+
+	callArgs []*StockServiceUseCaseMockGetStockItemsBySKUsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockGetStockItemsBySKUsExpectation specifies expectation struct of the StockServiceUseCase.GetStockItemsBySKUs
+type StockServiceUseCaseMockGetStockItemsBySKUsExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockGetStockItemsBySKUsParams
+	paramPtrs          *StockServiceUseCaseMockGetStockItemsBySKUsParamPtrs
+	expectationOrigins StockServiceUseCaseMockGetStockItemsBySKUsExpectationOrigins
+	results            *StockServiceUseCaseMockGetStockItemsBySKUsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockGetStockItemsBySKUsParams contains parameters of the StockServiceUseCase.GetStockItemsBySKUs
+type StockServiceUseCaseMockGetStockItemsBySKUsParams struct {
+	ctx     context.Context
+	filters []domain.OfferFilter
+}
+
+// StockServiceUseCaseMockGetStockItemsBySKUsParamPtrs contains pointers to parameters of the StockServiceUseCase.GetStockItemsBySKUs
+type StockServiceUseCaseMockGetStockItemsBySKUsParamPtrs struct {
+	ctx     *context.Context
+	filters *[]domain.OfferFilter
+}
+
+// StockServiceUseCaseMockGetStockItemsBySKUsResults contains results of the StockServiceUseCase.GetStockItemsBySKUs
+type StockServiceUseCaseMockGetStockItemsBySKUsResults struct {
+	sa1 []domain.StockOffers
+	err error
+}
+
+// StockServiceUseCaseMockGetStockItemsBySKUsOrigins contains origins of expectations of the StockServiceUseCase.GetStockItemsBySKUs
+type StockServiceUseCaseMockGetStockItemsBySKUsExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originFilters string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetStockItemsBySKUs *mStockServiceUseCaseMockGetStockItemsBySKUs) Optional() *mStockServiceUseCaseMockGetStockItemsBySKUs {
+	mmGetStockItemsBySKUs.optional = true
+	return mmGetStockItemsBySKUs
+}
+
+// Expect sets up expected params for StockServiceUseCase.GetStockItemsBySKUs
+func (mmGetStockItemsBySKUs *mStockServiceUseCaseMockGetStockItemsBySKUs) Expect(ctx context.Context, filters []domain.OfferFilter) *mStockServiceUseCaseMockGetStockItemsBySKUs {
+	if mmGetStockItemsBySKUs.mock.funcGetStockItemsBySKUs != nil {
+		mmGetStockItemsBySKUs.mock.t.Fatalf("StockServiceUseCaseMock.GetStockItemsBySKUs mock is already set by Set")
+	}
+
+	if mmGetStockItemsBySKUs.defaultExpectation == nil {
+		mmGetStockItemsBySKUs.defaultExpectation = &StockServiceUseCaseMockGetStockItemsBySKUsExpectation{}
+	}
+
+	if mmGetStockItemsBySKUs.defaultExpectation.paramPtrs != nil {
+		mmGetStockItemsBySKUs.mock.t.Fatalf("StockServiceUseCaseMock.GetStockItemsBySKUs mock is already set by ExpectParams functions")
+	}
+
+	mmGetStockItemsBySKUs.defaultExpectation.params = &StockServiceUseCaseMockGetStockItemsBySKUsParams{ctx, filters}
+	mmGetStockItemsBySKUs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetStockItemsBySKUs.expectations {
+		if minimock.Equal(e.params, mmGetStockItemsBySKUs.defaultExpectation.params) {
+			mmGetStockItemsBySKUs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetStockItemsBySKUs.defaultExpectation.params)
+		}
+	}
+
+	return mmGetStockItemsBySKUs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.GetStockItemsBySKUs
+func (mmGetStockItemsBySKUs *mStockServiceUseCaseMockGetStockItemsBySKUs) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockGetStockItemsBySKUs {
+	if mmGetStockItemsBySKUs.mock.funcGetStockItemsBySKUs != nil {
+		mmGetStockItemsBySKUs.mock.t.Fatalf("StockServiceUseCaseMock.GetStockItemsBySKUs mock is already set by Set")
+	}
+
+	if mmGetStockItemsBySKUs.defaultExpectation == nil {
+		mmGetStockItemsBySKUs.defaultExpectation = &StockServiceUseCaseMockGetStockItemsBySKUsExpectation{}
+	}
+
+	if mmGetStockItemsBySKUs.defaultExpectation.params != nil {
+		mmGetStockItemsBySKUs.mock.t.Fatalf("StockServiceUseCaseMock.GetStockItemsBySKUs mock is already set by Expect")
+	}
+
+	if mmGetStockItemsBySKUs.defaultExpectation.paramPtrs == nil {
+		mmGetStockItemsBySKUs.defaultExpectation.paramPtrs = &StockServiceUseCaseMockGetStockItemsBySKUsParamPtrs{}
+	}
+	mmGetStockItemsBySKUs.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetStockItemsBySKUs.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetStockItemsBySKUs
+}
+
+// ExpectFiltersParam2 sets up expected param filters for StockServiceUseCase.GetStockItemsBySKUs
+func (mmGetStockItemsBySKUs *mStockServiceUseCaseMockGetStockItemsBySKUs) ExpectFiltersParam2(filters []domain.OfferFilter) *mStockServiceUseCaseMockGetStockItemsBySKUs {
+	if mmGetStockItemsBySKUs.mock.funcGetStockItemsBySKUs != nil {
+		mmGetStockItemsBySKUs.mock.t.Fatalf("StockServiceUseCaseMock.GetStockItemsBySKUs mock is already set by Set")
+	}
+
+	if mmGetStockItemsBySKUs.defaultExpectation == nil {
+		mmGetStockItemsBySKUs.defaultExpectation = &StockServiceUseCaseMockGetStockItemsBySKUsExpectation{}
+	}
+
+	if mmGetStockItemsBySKUs.defaultExpectation.params != nil {
+		mmGetStockItemsBySKUs.mock.t.Fatalf("StockServiceUseCaseMock.GetStockItemsBySKUs mock is already set by Expect")
+	}
+
+	if mmGetStockItemsBySKUs.defaultExpectation.paramPtrs == nil {
+		mmGetStockItemsBySKUs.defaultExpectation.paramPtrs = &StockServiceUseCaseMockGetStockItemsBySKUsParamPtrs{}
+	}
+	mmGetStockItemsBySKUs.defaultExpectation.paramPtrs.filters = &filters
+	mmGetStockItemsBySKUs.defaultExpectation.expectationOrigins.originFilters = minimock.CallerInfo(1)
+
+	return mmGetStockItemsBySKUs
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.GetStockItemsBySKUs
+func (mmGetStockItemsBySKUs *mStockServiceUseCaseMockGetStockItemsBySKUs) Inspect(f func(ctx context.Context, filters []domain.OfferFilter)) *mStockServiceUseCaseMockGetStockItemsBySKUs {
+	if mmGetStockItemsBySKUs.mock.inspectFuncGetStockItemsBySKUs != nil {
+		mmGetStockItemsBySKUs.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.GetStockItemsBySKUs")
+	}
+
+	mmGetStockItemsBySKUs.mock.inspectFuncGetStockItemsBySKUs = f
+
+	return mmGetStockItemsBySKUs
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.GetStockItemsBySKUs
+func (mmGetStockItemsBySKUs *mStockServiceUseCaseMockGetStockItemsBySKUs) Return(sa1 []domain.StockOffers, err error) *StockServiceUseCaseMock {
+	if mmGetStockItemsBySKUs.mock.funcGetStockItemsBySKUs != nil {
+		mmGetStockItemsBySKUs.mock.t.Fatalf("StockServiceUseCaseMock.GetStockItemsBySKUs mock is already set by Set")
+	}
+
+	if mmGetStockItemsBySKUs.defaultExpectation == nil {
+		mmGetStockItemsBySKUs.defaultExpectation = &StockServiceUseCaseMockGetStockItemsBySKUsExpectation{mock: mmGetStockItemsBySKUs.mock}
+	}
+	mmGetStockItemsBySKUs.defaultExpectation.results = &StockServiceUseCaseMockGetStockItemsBySKUsResults{sa1, err}
+	mmGetStockItemsBySKUs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetStockItemsBySKUs.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.GetStockItemsBySKUs method
+func (mmGetStockItemsBySKUs *mStockServiceUseCaseMockGetStockItemsBySKUs) Set(f func(ctx context.Context, filters []domain.OfferFilter) (sa1 []domain.StockOffers, err error)) *StockServiceUseCaseMock {
+	if mmGetStockItemsBySKUs.defaultExpectation != nil {
+		mmGetStockItemsBySKUs.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.GetStockItemsBySKUs method")
+	}
+
+	if len(mmGetStockItemsBySKUs.expectations) > 0 {
+		mmGetStockItemsBySKUs.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.GetStockItemsBySKUs method")
+	}
+
+	mmGetStockItemsBySKUs.mock.funcGetStockItemsBySKUs = f
+	mmGetStockItemsBySKUs.mock.funcGetStockItemsBySKUsOrigin = minimock.CallerInfo(1)
+	return mmGetStockItemsBySKUs.mock
+}
+
+// When sets expectation for the StockServiceUseCase.GetStockItemsBySKUs which will trigger the result defined by the following
+// Then helper
+func (mmGetStockItemsBySKUs *mStockServiceUseCaseMockGetStockItemsBySKUs) When(ctx context.Context, filters []domain.OfferFilter) *StockServiceUseCaseMockGetStockItemsBySKUsExpectation {
+	if mmGetStockItemsBySKUs.mock.funcGetStockItemsBySKUs != nil {
+		mmGetStockItemsBySKUs.mock.t.Fatalf("StockServiceUseCaseMock.GetStockItemsBySKUs mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockGetStockItemsBySKUsExpectation{
+		mock:               mmGetStockItemsBySKUs.mock,
+		params:             &StockServiceUseCaseMockGetStockItemsBySKUsParams{ctx, filters},
+		expectationOrigins: StockServiceUseCaseMockGetStockItemsBySKUsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetStockItemsBySKUs.expectations = append(mmGetStockItemsBySKUs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.GetStockItemsBySKUs return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockGetStockItemsBySKUsExpectation) Then(sa1 []domain.StockOffers, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockGetStockItemsBySKUsResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.GetStockItemsBySKUs should be invoked
+func (mmGetStockItemsBySKUs *mStockServiceUseCaseMockGetStockItemsBySKUs) Times(n uint64) *mStockServiceUseCaseMockGetStockItemsBySKUs {
+	if n == 0 {
+		mmGetStockItemsBySKUs.mock.t.Fatalf("Times of StockServiceUseCaseMock.GetStockItemsBySKUs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetStockItemsBySKUs.expectedInvocations, n)
+	mmGetStockItemsBySKUs.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetStockItemsBySKUs
+}
+
+func (mmGetStockItemsBySKUs *mStockServiceUseCaseMockGetStockItemsBySKUs) invocationsDone() bool {
+	if len(mmGetStockItemsBySKUs.expectations) == 0 && mmGetStockItemsBySKUs.defaultExpectation == nil && mmGetStockItemsBySKUs.mock.funcGetStockItemsBySKUs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetStockItemsBySKUs.mock.afterGetStockItemsBySKUsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetStockItemsBySKUs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetStockItemsBySKUs implements mm_usecase.StockServiceUseCase
+func (mmGetStockItemsBySKUs *StockServiceUseCaseMock) GetStockItemsBySKUs(ctx context.Context, filters []domain.OfferFilter) (sa1 []domain.StockOffers, err error) {
+	mm_atomic.AddUint64(&mmGetStockItemsBySKUs.beforeGetStockItemsBySKUsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetStockItemsBySKUs.afterGetStockItemsBySKUsCounter, 1)
+
+	mmGetStockItemsBySKUs.t.Helper()
+
+	if mmGetStockItemsBySKUs.inspectFuncGetStockItemsBySKUs != nil {
+		mmGetStockItemsBySKUs.inspectFuncGetStockItemsBySKUs(ctx, filters)
+	}
+
+	mm_params := StockServiceUseCaseMockGetStockItemsBySKUsParams{ctx, filters}
+
+	// Record call args
+	mmGetStockItemsBySKUs.GetStockItemsBySKUsMock.mutex.Lock()
+	mmGetStockItemsBySKUs.GetStockItemsBySKUsMock.callArgs = append(mmGetStockItemsBySKUs.GetStockItemsBySKUsMock.callArgs, &mm_params)
+	mmGetStockItemsBySKUs.GetStockItemsBySKUsMock.mutex.Unlock()
+
+	for _, e := range mmGetStockItemsBySKUs.GetStockItemsBySKUsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmGetStockItemsBySKUs.GetStockItemsBySKUsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetStockItemsBySKUs.GetStockItemsBySKUsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetStockItemsBySKUs.GetStockItemsBySKUsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetStockItemsBySKUs.GetStockItemsBySKUsMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockGetStockItemsBySKUsParams{ctx, filters}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetStockItemsBySKUs.t.Errorf("StockServiceUseCaseMock.GetStockItemsBySKUs got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetStockItemsBySKUs.GetStockItemsBySKUsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filters != nil && !minimock.Equal(*mm_want_ptrs.filters, mm_got.filters) {
+				mmGetStockItemsBySKUs.t.Errorf("StockServiceUseCaseMock.GetStockItemsBySKUs got unexpected parameter filters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetStockItemsBySKUs.GetStockItemsBySKUsMock.defaultExpectation.expectationOrigins.originFilters, *mm_want_ptrs.filters, mm_got.filters, minimock.Diff(*mm_want_ptrs.filters, mm_got.filters))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetStockItemsBySKUs.t.Errorf("StockServiceUseCaseMock.GetStockItemsBySKUs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetStockItemsBySKUs.GetStockItemsBySKUsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetStockItemsBySKUs.GetStockItemsBySKUsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetStockItemsBySKUs.t.Fatal("No results are set for the StockServiceUseCaseMock.GetStockItemsBySKUs")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmGetStockItemsBySKUs.funcGetStockItemsBySKUs != nil {
+		return mmGetStockItemsBySKUs.funcGetStockItemsBySKUs(ctx, filters)
+	}
+	mmGetStockItemsBySKUs.t.Fatalf("Unexpected call to StockServiceUseCaseMock.GetStockItemsBySKUs. %v %v", ctx, filters)
+	return
+}
+
+// GetStockItemsBySKUsAfterCounter returns a count of finished StockServiceUseCaseMock.GetStockItemsBySKUs invocations
+func (mmGetStockItemsBySKUs *StockServiceUseCaseMock) GetStockItemsBySKUsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetStockItemsBySKUs.afterGetStockItemsBySKUsCounter)
+}
+
+// GetStockItemsBySKUsBeforeCounter returns a count of StockServiceUseCaseMock.GetStockItemsBySKUs invocations
+func (mmGetStockItemsBySKUs *StockServiceUseCaseMock) GetStockItemsBySKUsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetStockItemsBySKUs.beforeGetStockItemsBySKUsCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.GetStockItemsBySKUs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetStockItemsBySKUs *mStockServiceUseCaseMockGetStockItemsBySKUs) Calls() []*StockServiceUseCaseMockGetStockItemsBySKUsParams {
+	mmGetStockItemsBySKUs.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockGetStockItemsBySKUsParams, len(mmGetStockItemsBySKUs.callArgs))
+	copy(argCopy, mmGetStockItemsBySKUs.callArgs)
+
+	mmGetStockItemsBySKUs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetStockItemsBySKUsDone returns true if the count of the GetStockItemsBySKUs invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockGetStockItemsBySKUsDone() bool {
+	if m.GetStockItemsBySKUsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetStockItemsBySKUsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetStockItemsBySKUsMock.invocationsDone()
+}
+
+// MinimockGetStockItemsBySKUsInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockGetStockItemsBySKUsInspect() {
+	for _, e := range m.GetStockItemsBySKUsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetStockItemsBySKUs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetStockItemsBySKUsCounter := mm_atomic.LoadUint64(&m.afterGetStockItemsBySKUsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetStockItemsBySKUsMock.defaultExpectation != nil && afterGetStockItemsBySKUsCounter < 1 {
+		if m.GetStockItemsBySKUsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetStockItemsBySKUs at\n%s", m.GetStockItemsBySKUsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetStockItemsBySKUs at\n%s with params: %#v", m.GetStockItemsBySKUsMock.defaultExpectation.expectationOrigins.origin, *m.GetStockItemsBySKUsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetStockItemsBySKUs != nil && afterGetStockItemsBySKUsCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.GetStockItemsBySKUs at\n%s", m.funcGetStockItemsBySKUsOrigin)
+	}
+
+	if !m.GetStockItemsBySKUsMock.invocationsDone() && afterGetStockItemsBySKUsCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.GetStockItemsBySKUs at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetStockItemsBySKUsMock.expectedInvocations), m.GetStockItemsBySKUsMock.expectedInvocationsOrigin, afterGetStockItemsBySKUsCounter)
+	}
+}
+
+type mStockServiceUseCaseMockGetTransfer struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockGetTransferExpectation
+	expectations       []*StockServiceUseCaseMockGetTransferExpectation
+
+	callArgs []*StockServiceUseCaseMockGetTransferParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockGetTransferExpectation specifies expectation struct of the StockServiceUseCase.GetTransfer
+type StockServiceUseCaseMockGetTransferExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockGetTransferParams
+	paramPtrs          *StockServiceUseCaseMockGetTransferParamPtrs
+	expectationOrigins StockServiceUseCaseMockGetTransferExpectationOrigins
+	results            *StockServiceUseCaseMockGetTransferResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockGetTransferParams contains parameters of the StockServiceUseCase.GetTransfer
+type StockServiceUseCaseMockGetTransferParams struct {
+	ctx        context.Context
+	userID     domain.UserID
+	transferID domain.TransferID
+}
+
+// StockServiceUseCaseMockGetTransferParamPtrs contains pointers to parameters of the StockServiceUseCase.GetTransfer
+type StockServiceUseCaseMockGetTransferParamPtrs struct {
+	ctx        *context.Context
+	userID     *domain.UserID
+	transferID *domain.TransferID
+}
+
+// StockServiceUseCaseMockGetTransferResults contains results of the StockServiceUseCase.GetTransfer
+type StockServiceUseCaseMockGetTransferResults struct {
+	s1  domain.StockTransfer
+	err error
+}
+
+// StockServiceUseCaseMockGetTransferOrigins contains origins of expectations of the StockServiceUseCase.GetTransfer
+type StockServiceUseCaseMockGetTransferExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originUserID     string
+	originTransferID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetTransfer *mStockServiceUseCaseMockGetTransfer) Optional() *mStockServiceUseCaseMockGetTransfer {
+	mmGetTransfer.optional = true
+	return mmGetTransfer
+}
+
+// Expect sets up expected params for StockServiceUseCase.GetTransfer
+func (mmGetTransfer *mStockServiceUseCaseMockGetTransfer) Expect(ctx context.Context, userID domain.UserID, transferID domain.TransferID) *mStockServiceUseCaseMockGetTransfer {
+	if mmGetTransfer.mock.funcGetTransfer != nil {
+		mmGetTransfer.mock.t.Fatalf("StockServiceUseCaseMock.GetTransfer mock is already set by Set")
+	}
+
+	if mmGetTransfer.defaultExpectation == nil {
+		mmGetTransfer.defaultExpectation = &StockServiceUseCaseMockGetTransferExpectation{}
+	}
+
+	if mmGetTransfer.defaultExpectation.paramPtrs != nil {
+		mmGetTransfer.mock.t.Fatalf("StockServiceUseCaseMock.GetTransfer mock is already set by ExpectParams functions")
+	}
+
+	mmGetTransfer.defaultExpectation.params = &StockServiceUseCaseMockGetTransferParams{ctx, userID, transferID}
+	mmGetTransfer.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetTransfer.expectations {
+		if minimock.Equal(e.params, mmGetTransfer.defaultExpectation.params) {
+			mmGetTransfer.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetTransfer.defaultExpectation.params)
+		}
+	}
+
+	return mmGetTransfer
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.GetTransfer
+func (mmGetTransfer *mStockServiceUseCaseMockGetTransfer) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockGetTransfer {
+	if mmGetTransfer.mock.funcGetTransfer != nil {
+		mmGetTransfer.mock.t.Fatalf("StockServiceUseCaseMock.GetTransfer mock is already set by Set")
+	}
+
+	if mmGetTransfer.defaultExpectation == nil {
+		mmGetTransfer.defaultExpectation = &StockServiceUseCaseMockGetTransferExpectation{}
+	}
+
+	if mmGetTransfer.defaultExpectation.params != nil {
+		mmGetTransfer.mock.t.Fatalf("StockServiceUseCaseMock.GetTransfer mock is already set by Expect")
+	}
+
+	if mmGetTransfer.defaultExpectation.paramPtrs == nil {
+		mmGetTransfer.defaultExpectation.paramPtrs = &StockServiceUseCaseMockGetTransferParamPtrs{}
+	}
+	mmGetTransfer.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetTransfer.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetTransfer
+}
+
+// ExpectUserIDParam2 sets up expected param userID for StockServiceUseCase.GetTransfer
+func (mmGetTransfer *mStockServiceUseCaseMockGetTransfer) ExpectUserIDParam2(userID domain.UserID) *mStockServiceUseCaseMockGetTransfer {
+	if mmGetTransfer.mock.funcGetTransfer != nil {
+		mmGetTransfer.mock.t.Fatalf("StockServiceUseCaseMock.GetTransfer mock is already set by Set")
+	}
+
+	if mmGetTransfer.defaultExpectation == nil {
+		mmGetTransfer.defaultExpectation = &StockServiceUseCaseMockGetTransferExpectation{}
+	}
+
+	if mmGetTransfer.defaultExpectation.params != nil {
+		mmGetTransfer.mock.t.Fatalf("StockServiceUseCaseMock.GetTransfer mock is already set by Expect")
+	}
+
+	if mmGetTransfer.defaultExpectation.paramPtrs == nil {
+		mmGetTransfer.defaultExpectation.paramPtrs = &StockServiceUseCaseMockGetTransferParamPtrs{}
+	}
+	mmGetTransfer.defaultExpectation.paramPtrs.userID = &userID
+	mmGetTransfer.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetTransfer
+}
+
+// ExpectTransferIDParam3 sets up expected param transferID for StockServiceUseCase.GetTransfer
+func (mmGetTransfer *mStockServiceUseCaseMockGetTransfer) ExpectTransferIDParam3(transferID domain.TransferID) *mStockServiceUseCaseMockGetTransfer {
+	if mmGetTransfer.mock.funcGetTransfer != nil {
+		mmGetTransfer.mock.t.Fatalf("StockServiceUseCaseMock.GetTransfer mock is already set by Set")
+	}
+
+	if mmGetTransfer.defaultExpectation == nil {
+		mmGetTransfer.defaultExpectation = &StockServiceUseCaseMockGetTransferExpectation{}
+	}
+
+	if mmGetTransfer.defaultExpectation.params != nil {
+		mmGetTransfer.mock.t.Fatalf("StockServiceUseCaseMock.GetTransfer mock is already set by Expect")
+	}
+
+	if mmGetTransfer.defaultExpectation.paramPtrs == nil {
+		mmGetTransfer.defaultExpectation.paramPtrs = &StockServiceUseCaseMockGetTransferParamPtrs{}
+	}
+	mmGetTransfer.defaultExpectation.paramPtrs.transferID = &transferID
+	mmGetTransfer.defaultExpectation.expectationOrigins.originTransferID = minimock.CallerInfo(1)
+
+	return mmGetTransfer
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.GetTransfer
+func (mmGetTransfer *mStockServiceUseCaseMockGetTransfer) Inspect(f func(ctx context.Context, userID domain.UserID, transferID domain.TransferID)) *mStockServiceUseCaseMockGetTransfer {
+	if mmGetTransfer.mock.inspectFuncGetTransfer != nil {
+		mmGetTransfer.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.GetTransfer")
+	}
+
+	mmGetTransfer.mock.inspectFuncGetTransfer = f
+
+	return mmGetTransfer
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.GetTransfer
+func (mmGetTransfer *mStockServiceUseCaseMockGetTransfer) Return(s1 domain.StockTransfer, err error) *StockServiceUseCaseMock {
+	if mmGetTransfer.mock.funcGetTransfer != nil {
+		mmGetTransfer.mock.t.Fatalf("StockServiceUseCaseMock.GetTransfer mock is already set by Set")
+	}
+
+	if mmGetTransfer.defaultExpectation == nil {
+		mmGetTransfer.defaultExpectation = &StockServiceUseCaseMockGetTransferExpectation{mock: mmGetTransfer.mock}
+	}
+	mmGetTransfer.defaultExpectation.results = &StockServiceUseCaseMockGetTransferResults{s1, err}
+	mmGetTransfer.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetTransfer.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.GetTransfer method
+func (mmGetTransfer *mStockServiceUseCaseMockGetTransfer) Set(f func(ctx context.Context, userID domain.UserID, transferID domain.TransferID) (s1 domain.StockTransfer, err error)) *StockServiceUseCaseMock {
+	if mmGetTransfer.defaultExpectation != nil {
+		mmGetTransfer.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.GetTransfer method")
+	}
+
+	if len(mmGetTransfer.expectations) > 0 {
+		mmGetTransfer.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.GetTransfer method")
+	}
+
+	mmGetTransfer.mock.funcGetTransfer = f
+	mmGetTransfer.mock.funcGetTransferOrigin = minimock.CallerInfo(1)
+	return mmGetTransfer.mock
+}
+
+// When sets expectation for the StockServiceUseCase.GetTransfer which will trigger the result defined by the following
+// Then helper
+func (mmGetTransfer *mStockServiceUseCaseMockGetTransfer) When(ctx context.Context, userID domain.UserID, transferID domain.TransferID) *StockServiceUseCaseMockGetTransferExpectation {
+	if mmGetTransfer.mock.funcGetTransfer != nil {
+		mmGetTransfer.mock.t.Fatalf("StockServiceUseCaseMock.GetTransfer mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockGetTransferExpectation{
+		mock:               mmGetTransfer.mock,
+		params:             &StockServiceUseCaseMockGetTransferParams{ctx, userID, transferID},
+		expectationOrigins: StockServiceUseCaseMockGetTransferExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetTransfer.expectations = append(mmGetTransfer.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.GetTransfer return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockGetTransferExpectation) Then(s1 domain.StockTransfer, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockGetTransferResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.GetTransfer should be invoked
+func (mmGetTransfer *mStockServiceUseCaseMockGetTransfer) Times(n uint64) *mStockServiceUseCaseMockGetTransfer {
+	if n == 0 {
+		mmGetTransfer.mock.t.Fatalf("Times of StockServiceUseCaseMock.GetTransfer mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetTransfer.expectedInvocations, n)
+	mmGetTransfer.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetTransfer
+}
+
+func (mmGetTransfer *mStockServiceUseCaseMockGetTransfer) invocationsDone() bool {
+	if len(mmGetTransfer.expectations) == 0 && mmGetTransfer.defaultExpectation == nil && mmGetTransfer.mock.funcGetTransfer == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetTransfer.mock.afterGetTransferCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetTransfer.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetTransfer implements mm_usecase.StockServiceUseCase
+func (mmGetTransfer *StockServiceUseCaseMock) GetTransfer(ctx context.Context, userID domain.UserID, transferID domain.TransferID) (s1 domain.StockTransfer, err error) {
+	mm_atomic.AddUint64(&mmGetTransfer.beforeGetTransferCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetTransfer.afterGetTransferCounter, 1)
+
+	mmGetTransfer.t.Helper()
+
+	if mmGetTransfer.inspectFuncGetTransfer != nil {
+		mmGetTransfer.inspectFuncGetTransfer(ctx, userID, transferID)
+	}
+
+	mm_params := StockServiceUseCaseMockGetTransferParams{ctx, userID, transferID}
+
+	// Record call args
+	mmGetTransfer.GetTransferMock.mutex.Lock()
+	mmGetTransfer.GetTransferMock.callArgs = append(mmGetTransfer.GetTransferMock.callArgs, &mm_params)
+	mmGetTransfer.GetTransferMock.mutex.Unlock()
+
+	for _, e := range mmGetTransfer.GetTransferMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetTransfer.GetTransferMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetTransfer.GetTransferMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetTransfer.GetTransferMock.defaultExpectation.params
+		mm_want_ptrs := mmGetTransfer.GetTransferMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockGetTransferParams{ctx, userID, transferID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetTransfer.t.Errorf("StockServiceUseCaseMock.GetTransfer got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTransfer.GetTransferMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetTransfer.t.Errorf("StockServiceUseCaseMock.GetTransfer got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTransfer.GetTransferMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.transferID != nil && !minimock.Equal(*mm_want_ptrs.transferID, mm_got.transferID) {
+				mmGetTransfer.t.Errorf("StockServiceUseCaseMock.GetTransfer got unexpected parameter transferID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTransfer.GetTransferMock.defaultExpectation.expectationOrigins.originTransferID, *mm_want_ptrs.transferID, mm_got.transferID, minimock.Diff(*mm_want_ptrs.transferID, mm_got.transferID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetTransfer.t.Errorf("StockServiceUseCaseMock.GetTransfer got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetTransfer.GetTransferMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetTransfer.GetTransferMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetTransfer.t.Fatal("No results are set for the StockServiceUseCaseMock.GetTransfer")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetTransfer.funcGetTransfer != nil {
+		return mmGetTransfer.funcGetTransfer(ctx, userID, transferID)
+	}
+	mmGetTransfer.t.Fatalf("Unexpected call to StockServiceUseCaseMock.GetTransfer. %v %v %v", ctx, userID, transferID)
+	return
+}
+
+// GetTransferAfterCounter returns a count of finished StockServiceUseCaseMock.GetTransfer invocations
+func (mmGetTransfer *StockServiceUseCaseMock) GetTransferAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTransfer.afterGetTransferCounter)
+}
+
+// GetTransferBeforeCounter returns a count of StockServiceUseCaseMock.GetTransfer invocations
+func (mmGetTransfer *StockServiceUseCaseMock) GetTransferBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTransfer.beforeGetTransferCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.GetTransfer.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetTransfer *mStockServiceUseCaseMockGetTransfer) Calls() []*StockServiceUseCaseMockGetTransferParams {
+	mmGetTransfer.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockGetTransferParams, len(mmGetTransfer.callArgs))
+	copy(argCopy, mmGetTransfer.callArgs)
+
+	mmGetTransfer.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetTransferDone returns true if the count of the GetTransfer invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockGetTransferDone() bool {
+	if m.GetTransferMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetTransferMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetTransferMock.invocationsDone()
+}
+
+// MinimockGetTransferInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockGetTransferInspect() {
+	for _, e := range m.GetTransferMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetTransfer at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetTransferCounter := mm_atomic.LoadUint64(&m.afterGetTransferCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetTransferMock.defaultExpectation != nil && afterGetTransferCounter < 1 {
+		if m.GetTransferMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetTransfer at\n%s", m.GetTransferMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetTransfer at\n%s with params: %#v", m.GetTransferMock.defaultExpectation.expectationOrigins.origin, *m.GetTransferMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetTransfer != nil && afterGetTransferCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.GetTransfer at\n%s", m.funcGetTransferOrigin)
+	}
+
+	if !m.GetTransferMock.invocationsDone() && afterGetTransferCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.GetTransfer at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetTransferMock.expectedInvocations), m.GetTransferMock.expectedInvocationsOrigin, afterGetTransferCounter)
+	}
+}
+
+type mStockServiceUseCaseMockGetVariantGroup struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockGetVariantGroupExpectation
+	expectations       []*StockServiceUseCaseMockGetVariantGroupExpectation
+
+	callArgs []*StockServiceUseCaseMockGetVariantGroupParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockGetVariantGroupExpectation specifies expectation struct of the StockServiceUseCase.GetVariantGroup
+type StockServiceUseCaseMockGetVariantGroupExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockGetVariantGroupParams
+	paramPtrs          *StockServiceUseCaseMockGetVariantGroupParamPtrs
+	expectationOrigins StockServiceUseCaseMockGetVariantGroupExpectationOrigins
+	results            *StockServiceUseCaseMockGetVariantGroupResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockGetVariantGroupParams contains parameters of the StockServiceUseCase.GetVariantGroup
+type StockServiceUseCaseMockGetVariantGroupParams struct {
+	ctx     context.Context
+	groupID domain.VariantGroupID
+}
+
+// StockServiceUseCaseMockGetVariantGroupParamPtrs contains pointers to parameters of the StockServiceUseCase.GetVariantGroup
+type StockServiceUseCaseMockGetVariantGroupParamPtrs struct {
+	ctx     *context.Context
+	groupID *domain.VariantGroupID
+}
+
+// StockServiceUseCaseMockGetVariantGroupResults contains results of the StockServiceUseCase.GetVariantGroup
+type StockServiceUseCaseMockGetVariantGroupResults struct {
+	v1  domain.VariantGroup
+	err error
+}
+
+// StockServiceUseCaseMockGetVariantGroupOrigins contains origins of expectations of the StockServiceUseCase.GetVariantGroup
+type StockServiceUseCaseMockGetVariantGroupExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originGroupID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetVariantGroup *mStockServiceUseCaseMockGetVariantGroup) Optional() *mStockServiceUseCaseMockGetVariantGroup {
+	mmGetVariantGroup.optional = true
+	return mmGetVariantGroup
+}
+
+// Expect sets up expected params for StockServiceUseCase.GetVariantGroup
+func (mmGetVariantGroup *mStockServiceUseCaseMockGetVariantGroup) Expect(ctx context.Context, groupID domain.VariantGroupID) *mStockServiceUseCaseMockGetVariantGroup {
+	if mmGetVariantGroup.mock.funcGetVariantGroup != nil {
+		mmGetVariantGroup.mock.t.Fatalf("StockServiceUseCaseMock.GetVariantGroup mock is already set by Set")
+	}
+
+	if mmGetVariantGroup.defaultExpectation == nil {
+		mmGetVariantGroup.defaultExpectation = &StockServiceUseCaseMockGetVariantGroupExpectation{}
+	}
+
+	if mmGetVariantGroup.defaultExpectation.paramPtrs != nil {
+		mmGetVariantGroup.mock.t.Fatalf("StockServiceUseCaseMock.GetVariantGroup mock is already set by ExpectParams functions")
+	}
+
+	mmGetVariantGroup.defaultExpectation.params = &StockServiceUseCaseMockGetVariantGroupParams{ctx, groupID}
+	mmGetVariantGroup.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetVariantGroup.expectations {
+		if minimock.Equal(e.params, mmGetVariantGroup.defaultExpectation.params) {
+			mmGetVariantGroup.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetVariantGroup.defaultExpectation.params)
+		}
+	}
+
+	return mmGetVariantGroup
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.GetVariantGroup
+func (mmGetVariantGroup *mStockServiceUseCaseMockGetVariantGroup) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockGetVariantGroup {
+	if mmGetVariantGroup.mock.funcGetVariantGroup != nil {
+		mmGetVariantGroup.mock.t.Fatalf("StockServiceUseCaseMock.GetVariantGroup mock is already set by Set")
+	}
+
+	if mmGetVariantGroup.defaultExpectation == nil {
+		mmGetVariantGroup.defaultExpectation = &StockServiceUseCaseMockGetVariantGroupExpectation{}
+	}
+
+	if mmGetVariantGroup.defaultExpectation.params != nil {
+		mmGetVariantGroup.mock.t.Fatalf("StockServiceUseCaseMock.GetVariantGroup mock is already set by Expect")
+	}
+
+	if mmGetVariantGroup.defaultExpectation.paramPtrs == nil {
+		mmGetVariantGroup.defaultExpectation.paramPtrs = &StockServiceUseCaseMockGetVariantGroupParamPtrs{}
+	}
+	mmGetVariantGroup.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetVariantGroup.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetVariantGroup
+}
+
+// ExpectGroupIDParam2 sets up expected param groupID for StockServiceUseCase.GetVariantGroup
+func (mmGetVariantGroup *mStockServiceUseCaseMockGetVariantGroup) ExpectGroupIDParam2(groupID domain.VariantGroupID) *mStockServiceUseCaseMockGetVariantGroup {
+	if mmGetVariantGroup.mock.funcGetVariantGroup != nil {
+		mmGetVariantGroup.mock.t.Fatalf("StockServiceUseCaseMock.GetVariantGroup mock is already set by Set")
+	}
+
+	if mmGetVariantGroup.defaultExpectation == nil {
+		mmGetVariantGroup.defaultExpectation = &StockServiceUseCaseMockGetVariantGroupExpectation{}
+	}
+
+	if mmGetVariantGroup.defaultExpectation.params != nil {
+		mmGetVariantGroup.mock.t.Fatalf("StockServiceUseCaseMock.GetVariantGroup mock is already set by Expect")
+	}
+
+	if mmGetVariantGroup.defaultExpectation.paramPtrs == nil {
+		mmGetVariantGroup.defaultExpectation.paramPtrs = &StockServiceUseCaseMockGetVariantGroupParamPtrs{}
+	}
+	mmGetVariantGroup.defaultExpectation.paramPtrs.groupID = &groupID
+	mmGetVariantGroup.defaultExpectation.expectationOrigins.originGroupID = minimock.CallerInfo(1)
+
+	return mmGetVariantGroup
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.GetVariantGroup
+func (mmGetVariantGroup *mStockServiceUseCaseMockGetVariantGroup) Inspect(f func(ctx context.Context, groupID domain.VariantGroupID)) *mStockServiceUseCaseMockGetVariantGroup {
+	if mmGetVariantGroup.mock.inspectFuncGetVariantGroup != nil {
+		mmGetVariantGroup.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.GetVariantGroup")
+	}
+
+	mmGetVariantGroup.mock.inspectFuncGetVariantGroup = f
+
+	return mmGetVariantGroup
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.GetVariantGroup
+func (mmGetVariantGroup *mStockServiceUseCaseMockGetVariantGroup) Return(v1 domain.VariantGroup, err error) *StockServiceUseCaseMock {
+	if mmGetVariantGroup.mock.funcGetVariantGroup != nil {
+		mmGetVariantGroup.mock.t.Fatalf("StockServiceUseCaseMock.GetVariantGroup mock is already set by Set")
+	}
+
+	if mmGetVariantGroup.defaultExpectation == nil {
+		mmGetVariantGroup.defaultExpectation = &StockServiceUseCaseMockGetVariantGroupExpectation{mock: mmGetVariantGroup.mock}
+	}
+	mmGetVariantGroup.defaultExpectation.results = &StockServiceUseCaseMockGetVariantGroupResults{v1, err}
+	mmGetVariantGroup.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetVariantGroup.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.GetVariantGroup method
+func (mmGetVariantGroup *mStockServiceUseCaseMockGetVariantGroup) Set(f func(ctx context.Context, groupID domain.VariantGroupID) (v1 domain.VariantGroup, err error)) *StockServiceUseCaseMock {
+	if mmGetVariantGroup.defaultExpectation != nil {
+		mmGetVariantGroup.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.GetVariantGroup method")
+	}
+
+	if len(mmGetVariantGroup.expectations) > 0 {
+		mmGetVariantGroup.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.GetVariantGroup method")
+	}
+
+	mmGetVariantGroup.mock.funcGetVariantGroup = f
+	mmGetVariantGroup.mock.funcGetVariantGroupOrigin = minimock.CallerInfo(1)
+	return mmGetVariantGroup.mock
+}
+
+// When sets expectation for the StockServiceUseCase.GetVariantGroup which will trigger the result defined by the following
+// Then helper
+func (mmGetVariantGroup *mStockServiceUseCaseMockGetVariantGroup) When(ctx context.Context, groupID domain.VariantGroupID) *StockServiceUseCaseMockGetVariantGroupExpectation {
+	if mmGetVariantGroup.mock.funcGetVariantGroup != nil {
+		mmGetVariantGroup.mock.t.Fatalf("StockServiceUseCaseMock.GetVariantGroup mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockGetVariantGroupExpectation{
+		mock:               mmGetVariantGroup.mock,
+		params:             &StockServiceUseCaseMockGetVariantGroupParams{ctx, groupID},
+		expectationOrigins: StockServiceUseCaseMockGetVariantGroupExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetVariantGroup.expectations = append(mmGetVariantGroup.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.GetVariantGroup return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockGetVariantGroupExpectation) Then(v1 domain.VariantGroup, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockGetVariantGroupResults{v1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.GetVariantGroup should be invoked
+func (mmGetVariantGroup *mStockServiceUseCaseMockGetVariantGroup) Times(n uint64) *mStockServiceUseCaseMockGetVariantGroup {
+	if n == 0 {
+		mmGetVariantGroup.mock.t.Fatalf("Times of StockServiceUseCaseMock.GetVariantGroup mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetVariantGroup.expectedInvocations, n)
+	mmGetVariantGroup.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetVariantGroup
+}
+
+func (mmGetVariantGroup *mStockServiceUseCaseMockGetVariantGroup) invocationsDone() bool {
+	if len(mmGetVariantGroup.expectations) == 0 && mmGetVariantGroup.defaultExpectation == nil && mmGetVariantGroup.mock.funcGetVariantGroup == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetVariantGroup.mock.afterGetVariantGroupCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetVariantGroup.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetVariantGroup implements mm_usecase.StockServiceUseCase
+func (mmGetVariantGroup *StockServiceUseCaseMock) GetVariantGroup(ctx context.Context, groupID domain.VariantGroupID) (v1 domain.VariantGroup, err error) {
+	mm_atomic.AddUint64(&mmGetVariantGroup.beforeGetVariantGroupCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetVariantGroup.afterGetVariantGroupCounter, 1)
+
+	mmGetVariantGroup.t.Helper()
+
+	if mmGetVariantGroup.inspectFuncGetVariantGroup != nil {
+		mmGetVariantGroup.inspectFuncGetVariantGroup(ctx, groupID)
+	}
+
+	mm_params := StockServiceUseCaseMockGetVariantGroupParams{ctx, groupID}
+
+	// Record call args
+	mmGetVariantGroup.GetVariantGroupMock.mutex.Lock()
+	mmGetVariantGroup.GetVariantGroupMock.callArgs = append(mmGetVariantGroup.GetVariantGroupMock.callArgs, &mm_params)
+	mmGetVariantGroup.GetVariantGroupMock.mutex.Unlock()
+
+	for _, e := range mmGetVariantGroup.GetVariantGroupMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.v1, e.results.err
+		}
+	}
+
+	if mmGetVariantGroup.GetVariantGroupMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetVariantGroup.GetVariantGroupMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetVariantGroup.GetVariantGroupMock.defaultExpectation.params
+		mm_want_ptrs := mmGetVariantGroup.GetVariantGroupMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockGetVariantGroupParams{ctx, groupID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetVariantGroup.t.Errorf("StockServiceUseCaseMock.GetVariantGroup got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetVariantGroup.GetVariantGroupMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.groupID != nil && !minimock.Equal(*mm_want_ptrs.groupID, mm_got.groupID) {
+				mmGetVariantGroup.t.Errorf("StockServiceUseCaseMock.GetVariantGroup got unexpected parameter groupID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetVariantGroup.GetVariantGroupMock.defaultExpectation.expectationOrigins.originGroupID, *mm_want_ptrs.groupID, mm_got.groupID, minimock.Diff(*mm_want_ptrs.groupID, mm_got.groupID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetVariantGroup.t.Errorf("StockServiceUseCaseMock.GetVariantGroup got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetVariantGroup.GetVariantGroupMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetVariantGroup.GetVariantGroupMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetVariantGroup.t.Fatal("No results are set for the StockServiceUseCaseMock.GetVariantGroup")
+		}
+		return (*mm_results).v1, (*mm_results).err
+	}
+	if mmGetVariantGroup.funcGetVariantGroup != nil {
+		return mmGetVariantGroup.funcGetVariantGroup(ctx, groupID)
+	}
+	mmGetVariantGroup.t.Fatalf("Unexpected call to StockServiceUseCaseMock.GetVariantGroup. %v %v", ctx, groupID)
+	return
+}
+
+// GetVariantGroupAfterCounter returns a count of finished StockServiceUseCaseMock.GetVariantGroup invocations
+func (mmGetVariantGroup *StockServiceUseCaseMock) GetVariantGroupAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetVariantGroup.afterGetVariantGroupCounter)
+}
+
+// GetVariantGroupBeforeCounter returns a count of StockServiceUseCaseMock.GetVariantGroup invocations
+func (mmGetVariantGroup *StockServiceUseCaseMock) GetVariantGroupBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetVariantGroup.beforeGetVariantGroupCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.GetVariantGroup.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetVariantGroup *mStockServiceUseCaseMockGetVariantGroup) Calls() []*StockServiceUseCaseMockGetVariantGroupParams {
+	mmGetVariantGroup.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockGetVariantGroupParams, len(mmGetVariantGroup.callArgs))
+	copy(argCopy, mmGetVariantGroup.callArgs)
+
+	mmGetVariantGroup.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetVariantGroupDone returns true if the count of the GetVariantGroup invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockGetVariantGroupDone() bool {
+	if m.GetVariantGroupMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetVariantGroupMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetVariantGroupMock.invocationsDone()
+}
+
+// MinimockGetVariantGroupInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockGetVariantGroupInspect() {
+	for _, e := range m.GetVariantGroupMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetVariantGroup at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetVariantGroupCounter := mm_atomic.LoadUint64(&m.afterGetVariantGroupCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetVariantGroupMock.defaultExpectation != nil && afterGetVariantGroupCounter < 1 {
+		if m.GetVariantGroupMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetVariantGroup at\n%s", m.GetVariantGroupMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetVariantGroup at\n%s with params: %#v", m.GetVariantGroupMock.defaultExpectation.expectationOrigins.origin, *m.GetVariantGroupMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetVariantGroup != nil && afterGetVariantGroupCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.GetVariantGroup at\n%s", m.funcGetVariantGroupOrigin)
+	}
+
+	if !m.GetVariantGroupMock.invocationsDone() && afterGetVariantGroupCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.GetVariantGroup at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetVariantGroupMock.expectedInvocations), m.GetVariantGroupMock.expectedInvocationsOrigin, afterGetVariantGroupCounter)
+	}
+}
+
+type mStockServiceUseCaseMockListExpiringLots struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockListExpiringLotsExpectation
+	expectations       []*StockServiceUseCaseMockListExpiringLotsExpectation
+
+	callArgs []*StockServiceUseCaseMockListExpiringLotsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockListExpiringLotsExpectation specifies expectation struct of the StockServiceUseCase.ListExpiringLots
+type StockServiceUseCaseMockListExpiringLotsExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockListExpiringLotsParams
+	paramPtrs          *StockServiceUseCaseMockListExpiringLotsParamPtrs
+	expectationOrigins StockServiceUseCaseMockListExpiringLotsExpectationOrigins
+	results            *StockServiceUseCaseMockListExpiringLotsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockListExpiringLotsParams contains parameters of the StockServiceUseCase.ListExpiringLots
+type StockServiceUseCaseMockListExpiringLotsParams struct {
+	ctx    context.Context
+	filter domain.ExpiringLotsFilter
+}
+
+// StockServiceUseCaseMockListExpiringLotsParamPtrs contains pointers to parameters of the StockServiceUseCase.ListExpiringLots
+type StockServiceUseCaseMockListExpiringLotsParamPtrs struct {
+	ctx    *context.Context
+	filter *domain.ExpiringLotsFilter
+}
+
+// StockServiceUseCaseMockListExpiringLotsResults contains results of the StockServiceUseCase.ListExpiringLots
+type StockServiceUseCaseMockListExpiringLotsResults struct {
+	p1  domain.PaginatedResponse[domain.StockLot]
+	err error
+}
+
+// StockServiceUseCaseMockListExpiringLotsOrigins contains origins of expectations of the StockServiceUseCase.ListExpiringLots
+type StockServiceUseCaseMockListExpiringLotsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListExpiringLots *mStockServiceUseCaseMockListExpiringLots) Optional() *mStockServiceUseCaseMockListExpiringLots {
+	mmListExpiringLots.optional = true
+	return mmListExpiringLots
+}
+
+// Expect sets up expected params for StockServiceUseCase.ListExpiringLots
+func (mmListExpiringLots *mStockServiceUseCaseMockListExpiringLots) Expect(ctx context.Context, filter domain.ExpiringLotsFilter) *mStockServiceUseCaseMockListExpiringLots {
+	if mmListExpiringLots.mock.funcListExpiringLots != nil {
+		mmListExpiringLots.mock.t.Fatalf("StockServiceUseCaseMock.ListExpiringLots mock is already set by Set")
+	}
+
+	if mmListExpiringLots.defaultExpectation == nil {
+		mmListExpiringLots.defaultExpectation = &StockServiceUseCaseMockListExpiringLotsExpectation{}
+	}
+
+	if mmListExpiringLots.defaultExpectation.paramPtrs != nil {
+		mmListExpiringLots.mock.t.Fatalf("StockServiceUseCaseMock.ListExpiringLots mock is already set by ExpectParams functions")
+	}
+
+	mmListExpiringLots.defaultExpectation.params = &StockServiceUseCaseMockListExpiringLotsParams{ctx, filter}
+	mmListExpiringLots.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListExpiringLots.expectations {
+		if minimock.Equal(e.params, mmListExpiringLots.defaultExpectation.params) {
+			mmListExpiringLots.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListExpiringLots.defaultExpectation.params)
+		}
+	}
+
+	return mmListExpiringLots
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.ListExpiringLots
+func (mmListExpiringLots *mStockServiceUseCaseMockListExpiringLots) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockListExpiringLots {
+	if mmListExpiringLots.mock.funcListExpiringLots != nil {
+		mmListExpiringLots.mock.t.Fatalf("StockServiceUseCaseMock.ListExpiringLots mock is already set by Set")
+	}
+
+	if mmListExpiringLots.defaultExpectation == nil {
+		mmListExpiringLots.defaultExpectation = &StockServiceUseCaseMockListExpiringLotsExpectation{}
+	}
+
+	if mmListExpiringLots.defaultExpectation.params != nil {
+		mmListExpiringLots.mock.t.Fatalf("StockServiceUseCaseMock.ListExpiringLots mock is already set by Expect")
+	}
+
+	if mmListExpiringLots.defaultExpectation.paramPtrs == nil {
+		mmListExpiringLots.defaultExpectation.paramPtrs = &StockServiceUseCaseMockListExpiringLotsParamPtrs{}
+	}
+	mmListExpiringLots.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListExpiringLots.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListExpiringLots
+}
+
+// ExpectFilterParam2 sets up expected param filter for StockServiceUseCase.ListExpiringLots
+func (mmListExpiringLots *mStockServiceUseCaseMockListExpiringLots) ExpectFilterParam2(filter domain.ExpiringLotsFilter) *mStockServiceUseCaseMockListExpiringLots {
+	if mmListExpiringLots.mock.funcListExpiringLots != nil {
+		mmListExpiringLots.mock.t.Fatalf("StockServiceUseCaseMock.ListExpiringLots mock is already set by Set")
+	}
+
+	if mmListExpiringLots.defaultExpectation == nil {
+		mmListExpiringLots.defaultExpectation = &StockServiceUseCaseMockListExpiringLotsExpectation{}
+	}
+
+	if mmListExpiringLots.defaultExpectation.params != nil {
+		mmListExpiringLots.mock.t.Fatalf("StockServiceUseCaseMock.ListExpiringLots mock is already set by Expect")
+	}
+
+	if mmListExpiringLots.defaultExpectation.paramPtrs == nil {
+		mmListExpiringLots.defaultExpectation.paramPtrs = &StockServiceUseCaseMockListExpiringLotsParamPtrs{}
+	}
+	mmListExpiringLots.defaultExpectation.paramPtrs.filter = &filter
+	mmListExpiringLots.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListExpiringLots
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.ListExpiringLots
+func (mmListExpiringLots *mStockServiceUseCaseMockListExpiringLots) Inspect(f func(ctx context.Context, filter domain.ExpiringLotsFilter)) *mStockServiceUseCaseMockListExpiringLots {
+	if mmListExpiringLots.mock.inspectFuncListExpiringLots != nil {
+		mmListExpiringLots.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.ListExpiringLots")
+	}
+
+	mmListExpiringLots.mock.inspectFuncListExpiringLots = f
+
+	return mmListExpiringLots
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.ListExpiringLots
+func (mmListExpiringLots *mStockServiceUseCaseMockListExpiringLots) Return(p1 domain.PaginatedResponse[domain.StockLot], err error) *StockServiceUseCaseMock {
+	if mmListExpiringLots.mock.funcListExpiringLots != nil {
+		mmListExpiringLots.mock.t.Fatalf("StockServiceUseCaseMock.ListExpiringLots mock is already set by Set")
+	}
+
+	if mmListExpiringLots.defaultExpectation == nil {
+		mmListExpiringLots.defaultExpectation = &StockServiceUseCaseMockListExpiringLotsExpectation{mock: mmListExpiringLots.mock}
+	}
+	mmListExpiringLots.defaultExpectation.results = &StockServiceUseCaseMockListExpiringLotsResults{p1, err}
+	mmListExpiringLots.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListExpiringLots.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.ListExpiringLots method
+func (mmListExpiringLots *mStockServiceUseCaseMockListExpiringLots) Set(f func(ctx context.Context, filter domain.ExpiringLotsFilter) (p1 domain.PaginatedResponse[domain.StockLot], err error)) *StockServiceUseCaseMock {
+	if mmListExpiringLots.defaultExpectation != nil {
+		mmListExpiringLots.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.ListExpiringLots method")
+	}
+
+	if len(mmListExpiringLots.expectations) > 0 {
+		mmListExpiringLots.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.ListExpiringLots method")
+	}
+
+	mmListExpiringLots.mock.funcListExpiringLots = f
+	mmListExpiringLots.mock.funcListExpiringLotsOrigin = minimock.CallerInfo(1)
+	return mmListExpiringLots.mock
+}
+
+// When sets expectation for the StockServiceUseCase.ListExpiringLots which will trigger the result defined by the following
+// Then helper
+func (mmListExpiringLots *mStockServiceUseCaseMockListExpiringLots) When(ctx context.Context, filter domain.ExpiringLotsFilter) *StockServiceUseCaseMockListExpiringLotsExpectation {
+	if mmListExpiringLots.mock.funcListExpiringLots != nil {
+		mmListExpiringLots.mock.t.Fatalf("StockServiceUseCaseMock.ListExpiringLots mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockListExpiringLotsExpectation{
+		mock:               mmListExpiringLots.mock,
+		params:             &StockServiceUseCaseMockListExpiringLotsParams{ctx, filter},
+		expectationOrigins: StockServiceUseCaseMockListExpiringLotsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListExpiringLots.expectations = append(mmListExpiringLots.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.ListExpiringLots return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockListExpiringLotsExpectation) Then(p1 domain.PaginatedResponse[domain.StockLot], err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockListExpiringLotsResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.ListExpiringLots should be invoked
+func (mmListExpiringLots *mStockServiceUseCaseMockListExpiringLots) Times(n uint64) *mStockServiceUseCaseMockListExpiringLots {
+	if n == 0 {
+		mmListExpiringLots.mock.t.Fatalf("Times of StockServiceUseCaseMock.ListExpiringLots mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListExpiringLots.expectedInvocations, n)
+	mmListExpiringLots.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListExpiringLots
+}
+
+func (mmListExpiringLots *mStockServiceUseCaseMockListExpiringLots) invocationsDone() bool {
+	if len(mmListExpiringLots.expectations) == 0 && mmListExpiringLots.defaultExpectation == nil && mmListExpiringLots.mock.funcListExpiringLots == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListExpiringLots.mock.afterListExpiringLotsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListExpiringLots.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListExpiringLots implements mm_usecase.StockServiceUseCase
+func (mmListExpiringLots *StockServiceUseCaseMock) ListExpiringLots(ctx context.Context, filter domain.ExpiringLotsFilter) (p1 domain.PaginatedResponse[domain.StockLot], err error) {
+	mm_atomic.AddUint64(&mmListExpiringLots.beforeListExpiringLotsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListExpiringLots.afterListExpiringLotsCounter, 1)
+
+	mmListExpiringLots.t.Helper()
+
+	if mmListExpiringLots.inspectFuncListExpiringLots != nil {
+		mmListExpiringLots.inspectFuncListExpiringLots(ctx, filter)
+	}
+
+	mm_params := StockServiceUseCaseMockListExpiringLotsParams{ctx, filter}
+
+	// Record call args
+	mmListExpiringLots.ListExpiringLotsMock.mutex.Lock()
+	mmListExpiringLots.ListExpiringLotsMock.callArgs = append(mmListExpiringLots.ListExpiringLotsMock.callArgs, &mm_params)
+	mmListExpiringLots.ListExpiringLotsMock.mutex.Unlock()
+
+	for _, e := range mmListExpiringLots.ListExpiringLotsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmListExpiringLots.ListExpiringLotsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListExpiringLots.ListExpiringLotsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListExpiringLots.ListExpiringLotsMock.defaultExpectation.params
+		mm_want_ptrs := mmListExpiringLots.ListExpiringLotsMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockListExpiringLotsParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListExpiringLots.t.Errorf("StockServiceUseCaseMock.ListExpiringLots got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListExpiringLots.ListExpiringLotsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListExpiringLots.t.Errorf("StockServiceUseCaseMock.ListExpiringLots got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListExpiringLots.ListExpiringLotsMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListExpiringLots.t.Errorf("StockServiceUseCaseMock.ListExpiringLots got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListExpiringLots.ListExpiringLotsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListExpiringLots.ListExpiringLotsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListExpiringLots.t.Fatal("No results are set for the StockServiceUseCaseMock.ListExpiringLots")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmListExpiringLots.funcListExpiringLots != nil {
+		return mmListExpiringLots.funcListExpiringLots(ctx, filter)
+	}
+	mmListExpiringLots.t.Fatalf("Unexpected call to StockServiceUseCaseMock.ListExpiringLots. %v %v", ctx, filter)
+	return
+}
+
+// ListExpiringLotsAfterCounter returns a count of finished StockServiceUseCaseMock.ListExpiringLots invocations
+func (mmListExpiringLots *StockServiceUseCaseMock) ListExpiringLotsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListExpiringLots.afterListExpiringLotsCounter)
+}
+
+// ListExpiringLotsBeforeCounter returns a count of StockServiceUseCaseMock.ListExpiringLots invocations
+func (mmListExpiringLots *StockServiceUseCaseMock) ListExpiringLotsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListExpiringLots.beforeListExpiringLotsCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.ListExpiringLots.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListExpiringLots *mStockServiceUseCaseMockListExpiringLots) Calls() []*StockServiceUseCaseMockListExpiringLotsParams {
+	mmListExpiringLots.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockListExpiringLotsParams, len(mmListExpiringLots.callArgs))
+	copy(argCopy, mmListExpiringLots.callArgs)
+
+	mmListExpiringLots.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListExpiringLotsDone returns true if the count of the ListExpiringLots invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockListExpiringLotsDone() bool {
+	if m.ListExpiringLotsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListExpiringLotsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListExpiringLotsMock.invocationsDone()
+}
+
+// MinimockListExpiringLotsInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockListExpiringLotsInspect() {
+	for _, e := range m.ListExpiringLotsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ListExpiringLots at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListExpiringLotsCounter := mm_atomic.LoadUint64(&m.afterListExpiringLotsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListExpiringLotsMock.defaultExpectation != nil && afterListExpiringLotsCounter < 1 {
+		if m.ListExpiringLotsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ListExpiringLots at\n%s", m.ListExpiringLotsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ListExpiringLots at\n%s with params: %#v", m.ListExpiringLotsMock.defaultExpectation.expectationOrigins.origin, *m.ListExpiringLotsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListExpiringLots != nil && afterListExpiringLotsCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.ListExpiringLots at\n%s", m.funcListExpiringLotsOrigin)
+	}
+
+	if !m.ListExpiringLotsMock.invocationsDone() && afterListExpiringLotsCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.ListExpiringLots at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListExpiringLotsMock.expectedInvocations), m.ListExpiringLotsMock.expectedInvocationsOrigin, afterListExpiringLotsCounter)
+	}
+}
+
+type mStockServiceUseCaseMockListLowStock struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockListLowStockExpectation
+	expectations       []*StockServiceUseCaseMockListLowStockExpectation
+
+	callArgs []*StockServiceUseCaseMockListLowStockParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockListLowStockExpectation specifies expectation struct of the StockServiceUseCase.ListLowStock
+type StockServiceUseCaseMockListLowStockExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockListLowStockParams
+	paramPtrs          *StockServiceUseCaseMockListLowStockParamPtrs
+	expectationOrigins StockServiceUseCaseMockListLowStockExpectationOrigins
+	results            *StockServiceUseCaseMockListLowStockResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockListLowStockParams contains parameters of the StockServiceUseCase.ListLowStock
+type StockServiceUseCaseMockListLowStockParams struct {
+	ctx    context.Context
+	filter domain.LowStockFilter
+}
+
+// StockServiceUseCaseMockListLowStockParamPtrs contains pointers to parameters of the StockServiceUseCase.ListLowStock
+type StockServiceUseCaseMockListLowStockParamPtrs struct {
+	ctx    *context.Context
+	filter *domain.LowStockFilter
+}
+
+// StockServiceUseCaseMockListLowStockResults contains results of the StockServiceUseCase.ListLowStock
+type StockServiceUseCaseMockListLowStockResults struct {
+	p1  domain.PaginatedResponse[domain.LowStockItem]
+	err error
+}
+
+// StockServiceUseCaseMockListLowStockOrigins contains origins of expectations of the StockServiceUseCase.ListLowStock
+type StockServiceUseCaseMockListLowStockExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListLowStock *mStockServiceUseCaseMockListLowStock) Optional() *mStockServiceUseCaseMockListLowStock {
+	mmListLowStock.optional = true
+	return mmListLowStock
+}
+
+// Expect sets up expected params for StockServiceUseCase.ListLowStock
+func (mmListLowStock *mStockServiceUseCaseMockListLowStock) Expect(ctx context.Context, filter domain.LowStockFilter) *mStockServiceUseCaseMockListLowStock {
+	if mmListLowStock.mock.funcListLowStock != nil {
+		mmListLowStock.mock.t.Fatalf("StockServiceUseCaseMock.ListLowStock mock is already set by Set")
+	}
+
+	if mmListLowStock.defaultExpectation == nil {
+		mmListLowStock.defaultExpectation = &StockServiceUseCaseMockListLowStockExpectation{}
+	}
+
+	if mmListLowStock.defaultExpectation.paramPtrs != nil {
+		mmListLowStock.mock.t.Fatalf("StockServiceUseCaseMock.ListLowStock mock is already set by ExpectParams functions")
+	}
+
+	mmListLowStock.defaultExpectation.params = &StockServiceUseCaseMockListLowStockParams{ctx, filter}
+	mmListLowStock.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListLowStock.expectations {
+		if minimock.Equal(e.params, mmListLowStock.defaultExpectation.params) {
+			mmListLowStock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListLowStock.defaultExpectation.params)
+		}
+	}
+
+	return mmListLowStock
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.ListLowStock
+func (mmListLowStock *mStockServiceUseCaseMockListLowStock) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockListLowStock {
+	if mmListLowStock.mock.funcListLowStock != nil {
+		mmListLowStock.mock.t.Fatalf("StockServiceUseCaseMock.ListLowStock mock is already set by Set")
+	}
+
+	if mmListLowStock.defaultExpectation == nil {
+		mmListLowStock.defaultExpectation = &StockServiceUseCaseMockListLowStockExpectation{}
+	}
+
+	if mmListLowStock.defaultExpectation.params != nil {
+		mmListLowStock.mock.t.Fatalf("StockServiceUseCaseMock.ListLowStock mock is already set by Expect")
+	}
+
+	if mmListLowStock.defaultExpectation.paramPtrs == nil {
+		mmListLowStock.defaultExpectation.paramPtrs = &StockServiceUseCaseMockListLowStockParamPtrs{}
+	}
+	mmListLowStock.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListLowStock.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListLowStock
+}
+
+// ExpectFilterParam2 sets up expected param filter for StockServiceUseCase.ListLowStock
+func (mmListLowStock *mStockServiceUseCaseMockListLowStock) ExpectFilterParam2(filter domain.LowStockFilter) *mStockServiceUseCaseMockListLowStock {
+	if mmListLowStock.mock.funcListLowStock != nil {
+		mmListLowStock.mock.t.Fatalf("StockServiceUseCaseMock.ListLowStock mock is already set by Set")
+	}
+
+	if mmListLowStock.defaultExpectation == nil {
+		mmListLowStock.defaultExpectation = &StockServiceUseCaseMockListLowStockExpectation{}
+	}
+
+	if mmListLowStock.defaultExpectation.params != nil {
+		mmListLowStock.mock.t.Fatalf("StockServiceUseCaseMock.ListLowStock mock is already set by Expect")
+	}
+
+	if mmListLowStock.defaultExpectation.paramPtrs == nil {
+		mmListLowStock.defaultExpectation.paramPtrs = &StockServiceUseCaseMockListLowStockParamPtrs{}
+	}
+	mmListLowStock.defaultExpectation.paramPtrs.filter = &filter
+	mmListLowStock.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListLowStock
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.ListLowStock
+func (mmListLowStock *mStockServiceUseCaseMockListLowStock) Inspect(f func(ctx context.Context, filter domain.LowStockFilter)) *mStockServiceUseCaseMockListLowStock {
+	if mmListLowStock.mock.inspectFuncListLowStock != nil {
+		mmListLowStock.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.ListLowStock")
+	}
+
+	mmListLowStock.mock.inspectFuncListLowStock = f
+
+	return mmListLowStock
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.ListLowStock
+func (mmListLowStock *mStockServiceUseCaseMockListLowStock) Return(p1 domain.PaginatedResponse[domain.LowStockItem], err error) *StockServiceUseCaseMock {
+	if mmListLowStock.mock.funcListLowStock != nil {
+		mmListLowStock.mock.t.Fatalf("StockServiceUseCaseMock.ListLowStock mock is already set by Set")
+	}
+
+	if mmListLowStock.defaultExpectation == nil {
+		mmListLowStock.defaultExpectation = &StockServiceUseCaseMockListLowStockExpectation{mock: mmListLowStock.mock}
+	}
+	mmListLowStock.defaultExpectation.results = &StockServiceUseCaseMockListLowStockResults{p1, err}
+	mmListLowStock.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListLowStock.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.ListLowStock method
+func (mmListLowStock *mStockServiceUseCaseMockListLowStock) Set(f func(ctx context.Context, filter domain.LowStockFilter) (p1 domain.PaginatedResponse[domain.LowStockItem], err error)) *StockServiceUseCaseMock {
+	if mmListLowStock.defaultExpectation != nil {
+		mmListLowStock.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.ListLowStock method")
+	}
+
+	if len(mmListLowStock.expectations) > 0 {
+		mmListLowStock.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.ListLowStock method")
+	}
+
+	mmListLowStock.mock.funcListLowStock = f
+	mmListLowStock.mock.funcListLowStockOrigin = minimock.CallerInfo(1)
+	return mmListLowStock.mock
+}
+
+// When sets expectation for the StockServiceUseCase.ListLowStock which will trigger the result defined by the following
+// Then helper
+func (mmListLowStock *mStockServiceUseCaseMockListLowStock) When(ctx context.Context, filter domain.LowStockFilter) *StockServiceUseCaseMockListLowStockExpectation {
+	if mmListLowStock.mock.funcListLowStock != nil {
+		mmListLowStock.mock.t.Fatalf("StockServiceUseCaseMock.ListLowStock mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockListLowStockExpectation{
+		mock:               mmListLowStock.mock,
+		params:             &StockServiceUseCaseMockListLowStockParams{ctx, filter},
+		expectationOrigins: StockServiceUseCaseMockListLowStockExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListLowStock.expectations = append(mmListLowStock.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.ListLowStock return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockListLowStockExpectation) Then(p1 domain.PaginatedResponse[domain.LowStockItem], err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockListLowStockResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.ListLowStock should be invoked
+func (mmListLowStock *mStockServiceUseCaseMockListLowStock) Times(n uint64) *mStockServiceUseCaseMockListLowStock {
+	if n == 0 {
+		mmListLowStock.mock.t.Fatalf("Times of StockServiceUseCaseMock.ListLowStock mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListLowStock.expectedInvocations, n)
+	mmListLowStock.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListLowStock
+}
+
+func (mmListLowStock *mStockServiceUseCaseMockListLowStock) invocationsDone() bool {
+	if len(mmListLowStock.expectations) == 0 && mmListLowStock.defaultExpectation == nil && mmListLowStock.mock.funcListLowStock == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListLowStock.mock.afterListLowStockCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListLowStock.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListLowStock implements mm_usecase.StockServiceUseCase
+func (mmListLowStock *StockServiceUseCaseMock) ListLowStock(ctx context.Context, filter domain.LowStockFilter) (p1 domain.PaginatedResponse[domain.LowStockItem], err error) {
+	mm_atomic.AddUint64(&mmListLowStock.beforeListLowStockCounter, 1)
+	defer mm_atomic.AddUint64(&mmListLowStock.afterListLowStockCounter, 1)
+
+	mmListLowStock.t.Helper()
+
+	if mmListLowStock.inspectFuncListLowStock != nil {
+		mmListLowStock.inspectFuncListLowStock(ctx, filter)
+	}
+
+	mm_params := StockServiceUseCaseMockListLowStockParams{ctx, filter}
+
+	// Record call args
+	mmListLowStock.ListLowStockMock.mutex.Lock()
+	mmListLowStock.ListLowStockMock.callArgs = append(mmListLowStock.ListLowStockMock.callArgs, &mm_params)
+	mmListLowStock.ListLowStockMock.mutex.Unlock()
+
+	for _, e := range mmListLowStock.ListLowStockMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmListLowStock.ListLowStockMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListLowStock.ListLowStockMock.defaultExpectation.Counter, 1)
+		mm_want := mmListLowStock.ListLowStockMock.defaultExpectation.params
+		mm_want_ptrs := mmListLowStock.ListLowStockMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockListLowStockParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListLowStock.t.Errorf("StockServiceUseCaseMock.ListLowStock got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListLowStock.ListLowStockMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListLowStock.t.Errorf("StockServiceUseCaseMock.ListLowStock got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListLowStock.ListLowStockMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListLowStock.t.Errorf("StockServiceUseCaseMock.ListLowStock got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListLowStock.ListLowStockMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListLowStock.ListLowStockMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListLowStock.t.Fatal("No results are set for the StockServiceUseCaseMock.ListLowStock")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmListLowStock.funcListLowStock != nil {
+		return mmListLowStock.funcListLowStock(ctx, filter)
+	}
+	mmListLowStock.t.Fatalf("Unexpected call to StockServiceUseCaseMock.ListLowStock. %v %v", ctx, filter)
+	return
+}
+
+// ListLowStockAfterCounter returns a count of finished StockServiceUseCaseMock.ListLowStock invocations
+func (mmListLowStock *StockServiceUseCaseMock) ListLowStockAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListLowStock.afterListLowStockCounter)
+}
+
+// ListLowStockBeforeCounter returns a count of StockServiceUseCaseMock.ListLowStock invocations
+func (mmListLowStock *StockServiceUseCaseMock) ListLowStockBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListLowStock.beforeListLowStockCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.ListLowStock.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListLowStock *mStockServiceUseCaseMockListLowStock) Calls() []*StockServiceUseCaseMockListLowStockParams {
+	mmListLowStock.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockListLowStockParams, len(mmListLowStock.callArgs))
+	copy(argCopy, mmListLowStock.callArgs)
+
+	mmListLowStock.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListLowStockDone returns true if the count of the ListLowStock invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockListLowStockDone() bool {
+	if m.ListLowStockMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListLowStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListLowStockMock.invocationsDone()
+}
+
+// MinimockListLowStockInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockListLowStockInspect() {
+	for _, e := range m.ListLowStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ListLowStock at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListLowStockCounter := mm_atomic.LoadUint64(&m.afterListLowStockCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListLowStockMock.defaultExpectation != nil && afterListLowStockCounter < 1 {
+		if m.ListLowStockMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ListLowStock at\n%s", m.ListLowStockMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ListLowStock at\n%s with params: %#v", m.ListLowStockMock.defaultExpectation.expectationOrigins.origin, *m.ListLowStockMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListLowStock != nil && afterListLowStockCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.ListLowStock at\n%s", m.funcListLowStockOrigin)
+	}
+
+	if !m.ListLowStockMock.invocationsDone() && afterListLowStockCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.ListLowStock at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListLowStockMock.expectedInvocations), m.ListLowStockMock.expectedInvocationsOrigin, afterListLowStockCounter)
+	}
+}
+
+type mStockServiceUseCaseMockListStockItems struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockListStockItemsExpectation
+	expectations       []*StockServiceUseCaseMockListStockItemsExpectation
+
+	callArgs []*StockServiceUseCaseMockListStockItemsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockListStockItemsExpectation specifies expectation struct of the StockServiceUseCase.ListStockItems
+type StockServiceUseCaseMockListStockItemsExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockListStockItemsParams
+	paramPtrs          *StockServiceUseCaseMockListStockItemsParamPtrs
+	expectationOrigins StockServiceUseCaseMockListStockItemsExpectationOrigins
+	results            *StockServiceUseCaseMockListStockItemsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockListStockItemsParams contains parameters of the StockServiceUseCase.ListStockItems
+type StockServiceUseCaseMockListStockItemsParams struct {
+	ctx    context.Context
+	filter domain.Filter
+}
+
+// StockServiceUseCaseMockListStockItemsParamPtrs contains pointers to parameters of the StockServiceUseCase.ListStockItems
+type StockServiceUseCaseMockListStockItemsParamPtrs struct {
+	ctx    *context.Context
+	filter *domain.Filter
+}
+
+// StockServiceUseCaseMockListStockItemsResults contains results of the StockServiceUseCase.ListStockItems
+type StockServiceUseCaseMockListStockItemsResults struct {
+	p1  domain.PaginatedResponse[domain.StockItem]
+	err error
+}
+
+// StockServiceUseCaseMockListStockItemsOrigins contains origins of expectations of the StockServiceUseCase.ListStockItems
+type StockServiceUseCaseMockListStockItemsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListStockItems *mStockServiceUseCaseMockListStockItems) Optional() *mStockServiceUseCaseMockListStockItems {
+	mmListStockItems.optional = true
+	return mmListStockItems
+}
+
+// Expect sets up expected params for StockServiceUseCase.ListStockItems
+func (mmListStockItems *mStockServiceUseCaseMockListStockItems) Expect(ctx context.Context, filter domain.Filter) *mStockServiceUseCaseMockListStockItems {
+	if mmListStockItems.mock.funcListStockItems != nil {
+		mmListStockItems.mock.t.Fatalf("StockServiceUseCaseMock.ListStockItems mock is already set by Set")
+	}
+
+	if mmListStockItems.defaultExpectation == nil {
+		mmListStockItems.defaultExpectation = &StockServiceUseCaseMockListStockItemsExpectation{}
+	}
+
+	if mmListStockItems.defaultExpectation.paramPtrs != nil {
+		mmListStockItems.mock.t.Fatalf("StockServiceUseCaseMock.ListStockItems mock is already set by ExpectParams functions")
+	}
+
+	mmListStockItems.defaultExpectation.params = &StockServiceUseCaseMockListStockItemsParams{ctx, filter}
+	mmListStockItems.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListStockItems.expectations {
+		if minimock.Equal(e.params, mmListStockItems.defaultExpectation.params) {
+			mmListStockItems.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListStockItems.defaultExpectation.params)
+		}
+	}
+
+	return mmListStockItems
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.ListStockItems
+func (mmListStockItems *mStockServiceUseCaseMockListStockItems) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockListStockItems {
+	if mmListStockItems.mock.funcListStockItems != nil {
+		mmListStockItems.mock.t.Fatalf("StockServiceUseCaseMock.ListStockItems mock is already set by Set")
+	}
+
+	if mmListStockItems.defaultExpectation == nil {
+		mmListStockItems.defaultExpectation = &StockServiceUseCaseMockListStockItemsExpectation{}
+	}
+
+	if mmListStockItems.defaultExpectation.params != nil {
+		mmListStockItems.mock.t.Fatalf("StockServiceUseCaseMock.ListStockItems mock is already set by Expect")
+	}
+
+	if mmListStockItems.defaultExpectation.paramPtrs == nil {
+		mmListStockItems.defaultExpectation.paramPtrs = &StockServiceUseCaseMockListStockItemsParamPtrs{}
+	}
+	mmListStockItems.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListStockItems.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListStockItems
+}
+
+// ExpectFilterParam2 sets up expected param filter for StockServiceUseCase.ListStockItems
+func (mmListStockItems *mStockServiceUseCaseMockListStockItems) ExpectFilterParam2(filter domain.Filter) *mStockServiceUseCaseMockListStockItems {
+	if mmListStockItems.mock.funcListStockItems != nil {
+		mmListStockItems.mock.t.Fatalf("StockServiceUseCaseMock.ListStockItems mock is already set by Set")
+	}
+
+	if mmListStockItems.defaultExpectation == nil {
+		mmListStockItems.defaultExpectation = &StockServiceUseCaseMockListStockItemsExpectation{}
+	}
+
+	if mmListStockItems.defaultExpectation.params != nil {
+		mmListStockItems.mock.t.Fatalf("StockServiceUseCaseMock.ListStockItems mock is already set by Expect")
+	}
+
+	if mmListStockItems.defaultExpectation.paramPtrs == nil {
+		mmListStockItems.defaultExpectation.paramPtrs = &StockServiceUseCaseMockListStockItemsParamPtrs{}
+	}
+	mmListStockItems.defaultExpectation.paramPtrs.filter = &filter
+	mmListStockItems.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListStockItems
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.ListStockItems
+func (mmListStockItems *mStockServiceUseCaseMockListStockItems) Inspect(f func(ctx context.Context, filter domain.Filter)) *mStockServiceUseCaseMockListStockItems {
+	if mmListStockItems.mock.inspectFuncListStockItems != nil {
+		mmListStockItems.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.ListStockItems")
+	}
+
+	mmListStockItems.mock.inspectFuncListStockItems = f
+
+	return mmListStockItems
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.ListStockItems
+func (mmListStockItems *mStockServiceUseCaseMockListStockItems) Return(p1 domain.PaginatedResponse[domain.StockItem], err error) *StockServiceUseCaseMock {
+	if mmListStockItems.mock.funcListStockItems != nil {
+		mmListStockItems.mock.t.Fatalf("StockServiceUseCaseMock.ListStockItems mock is already set by Set")
+	}
+
+	if mmListStockItems.defaultExpectation == nil {
+		mmListStockItems.defaultExpectation = &StockServiceUseCaseMockListStockItemsExpectation{mock: mmListStockItems.mock}
+	}
+	mmListStockItems.defaultExpectation.results = &StockServiceUseCaseMockListStockItemsResults{p1, err}
+	mmListStockItems.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListStockItems.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.ListStockItems method
+func (mmListStockItems *mStockServiceUseCaseMockListStockItems) Set(f func(ctx context.Context, filter domain.Filter) (p1 domain.PaginatedResponse[domain.StockItem], err error)) *StockServiceUseCaseMock {
+	if mmListStockItems.defaultExpectation != nil {
+		mmListStockItems.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.ListStockItems method")
+	}
+
+	if len(mmListStockItems.expectations) > 0 {
+		mmListStockItems.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.ListStockItems method")
+	}
+
+	mmListStockItems.mock.funcListStockItems = f
+	mmListStockItems.mock.funcListStockItemsOrigin = minimock.CallerInfo(1)
+	return mmListStockItems.mock
+}
+
+// When sets expectation for the StockServiceUseCase.ListStockItems which will trigger the result defined by the following
+// Then helper
+func (mmListStockItems *mStockServiceUseCaseMockListStockItems) When(ctx context.Context, filter domain.Filter) *StockServiceUseCaseMockListStockItemsExpectation {
+	if mmListStockItems.mock.funcListStockItems != nil {
+		mmListStockItems.mock.t.Fatalf("StockServiceUseCaseMock.ListStockItems mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockListStockItemsExpectation{
+		mock:               mmListStockItems.mock,
+		params:             &StockServiceUseCaseMockListStockItemsParams{ctx, filter},
+		expectationOrigins: StockServiceUseCaseMockListStockItemsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListStockItems.expectations = append(mmListStockItems.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.ListStockItems return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockListStockItemsExpectation) Then(p1 domain.PaginatedResponse[domain.StockItem], err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockListStockItemsResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.ListStockItems should be invoked
+func (mmListStockItems *mStockServiceUseCaseMockListStockItems) Times(n uint64) *mStockServiceUseCaseMockListStockItems {
+	if n == 0 {
+		mmListStockItems.mock.t.Fatalf("Times of StockServiceUseCaseMock.ListStockItems mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListStockItems.expectedInvocations, n)
+	mmListStockItems.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListStockItems
+}
+
+func (mmListStockItems *mStockServiceUseCaseMockListStockItems) invocationsDone() bool {
+	if len(mmListStockItems.expectations) == 0 && mmListStockItems.defaultExpectation == nil && mmListStockItems.mock.funcListStockItems == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListStockItems.mock.afterListStockItemsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListStockItems.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListStockItems implements mm_usecase.StockServiceUseCase
+func (mmListStockItems *StockServiceUseCaseMock) ListStockItems(ctx context.Context, filter domain.Filter) (p1 domain.PaginatedResponse[domain.StockItem], err error) {
+	mm_atomic.AddUint64(&mmListStockItems.beforeListStockItemsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListStockItems.afterListStockItemsCounter, 1)
+
+	mmListStockItems.t.Helper()
+
+	if mmListStockItems.inspectFuncListStockItems != nil {
+		mmListStockItems.inspectFuncListStockItems(ctx, filter)
+	}
+
+	mm_params := StockServiceUseCaseMockListStockItemsParams{ctx, filter}
+
+	// Record call args
+	mmListStockItems.ListStockItemsMock.mutex.Lock()
+	mmListStockItems.ListStockItemsMock.callArgs = append(mmListStockItems.ListStockItemsMock.callArgs, &mm_params)
+	mmListStockItems.ListStockItemsMock.mutex.Unlock()
+
+	for _, e := range mmListStockItems.ListStockItemsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmListStockItems.ListStockItemsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListStockItems.ListStockItemsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListStockItems.ListStockItemsMock.defaultExpectation.params
+		mm_want_ptrs := mmListStockItems.ListStockItemsMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockListStockItemsParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListStockItems.t.Errorf("StockServiceUseCaseMock.ListStockItems got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListStockItems.ListStockItemsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListStockItems.t.Errorf("StockServiceUseCaseMock.ListStockItems got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListStockItems.ListStockItemsMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListStockItems.t.Errorf("StockServiceUseCaseMock.ListStockItems got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListStockItems.ListStockItemsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListStockItems.ListStockItemsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListStockItems.t.Fatal("No results are set for the StockServiceUseCaseMock.ListStockItems")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmListStockItems.funcListStockItems != nil {
+		return mmListStockItems.funcListStockItems(ctx, filter)
+	}
+	mmListStockItems.t.Fatalf("Unexpected call to StockServiceUseCaseMock.ListStockItems. %v %v", ctx, filter)
+	return
+}
+
+// ListStockItemsAfterCounter returns a count of finished StockServiceUseCaseMock.ListStockItems invocations
+func (mmListStockItems *StockServiceUseCaseMock) ListStockItemsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListStockItems.afterListStockItemsCounter)
+}
+
+// ListStockItemsBeforeCounter returns a count of StockServiceUseCaseMock.ListStockItems invocations
+func (mmListStockItems *StockServiceUseCaseMock) ListStockItemsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListStockItems.beforeListStockItemsCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.ListStockItems.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListStockItems *mStockServiceUseCaseMockListStockItems) Calls() []*StockServiceUseCaseMockListStockItemsParams {
+	mmListStockItems.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockListStockItemsParams, len(mmListStockItems.callArgs))
+	copy(argCopy, mmListStockItems.callArgs)
+
+	mmListStockItems.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListStockItemsDone returns true if the count of the ListStockItems invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockListStockItemsDone() bool {
+	if m.ListStockItemsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListStockItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListStockItemsMock.invocationsDone()
+}
+
+// MinimockListStockItemsInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockListStockItemsInspect() {
+	for _, e := range m.ListStockItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ListStockItems at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListStockItemsCounter := mm_atomic.LoadUint64(&m.afterListStockItemsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListStockItemsMock.defaultExpectation != nil && afterListStockItemsCounter < 1 {
+		if m.ListStockItemsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ListStockItems at\n%s", m.ListStockItemsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ListStockItems at\n%s with params: %#v", m.ListStockItemsMock.defaultExpectation.expectationOrigins.origin, *m.ListStockItemsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListStockItems != nil && afterListStockItemsCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.ListStockItems at\n%s", m.funcListStockItemsOrigin)
+	}
+
+	if !m.ListStockItemsMock.invocationsDone() && afterListStockItemsCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.ListStockItems at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListStockItemsMock.expectedInvocations), m.ListStockItemsMock.expectedInvocationsOrigin, afterListStockItemsCounter)
+	}
+}
+
+type mStockServiceUseCaseMockListSuppliers struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockListSuppliersExpectation
+	expectations       []*StockServiceUseCaseMockListSuppliersExpectation
+
+	callArgs []*StockServiceUseCaseMockListSuppliersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockListSuppliersExpectation specifies expectation struct of the StockServiceUseCase.ListSuppliers
+type StockServiceUseCaseMockListSuppliersExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockListSuppliersParams
+	paramPtrs          *StockServiceUseCaseMockListSuppliersParamPtrs
+	expectationOrigins StockServiceUseCaseMockListSuppliersExpectationOrigins
+	results            *StockServiceUseCaseMockListSuppliersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockListSuppliersParams contains parameters of the StockServiceUseCase.ListSuppliers
+type StockServiceUseCaseMockListSuppliersParams struct {
+	ctx    context.Context
+	userID domain.UserID
+}
+
+// StockServiceUseCaseMockListSuppliersParamPtrs contains pointers to parameters of the StockServiceUseCase.ListSuppliers
+type StockServiceUseCaseMockListSuppliersParamPtrs struct {
+	ctx    *context.Context
+	userID *domain.UserID
+}
+
+// StockServiceUseCaseMockListSuppliersResults contains results of the StockServiceUseCase.ListSuppliers
+type StockServiceUseCaseMockListSuppliersResults struct {
+	sa1 []domain.Supplier
+	err error
+}
+
+// StockServiceUseCaseMockListSuppliersOrigins contains origins of expectations of the StockServiceUseCase.ListSuppliers
+type StockServiceUseCaseMockListSuppliersExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListSuppliers *mStockServiceUseCaseMockListSuppliers) Optional() *mStockServiceUseCaseMockListSuppliers {
+	mmListSuppliers.optional = true
+	return mmListSuppliers
+}
+
+// Expect sets up expected params for StockServiceUseCase.ListSuppliers
+func (mmListSuppliers *mStockServiceUseCaseMockListSuppliers) Expect(ctx context.Context, userID domain.UserID) *mStockServiceUseCaseMockListSuppliers {
+	if mmListSuppliers.mock.funcListSuppliers != nil {
+		mmListSuppliers.mock.t.Fatalf("StockServiceUseCaseMock.ListSuppliers mock is already set by Set")
+	}
+
+	if mmListSuppliers.defaultExpectation == nil {
+		mmListSuppliers.defaultExpectation = &StockServiceUseCaseMockListSuppliersExpectation{}
+	}
+
+	if mmListSuppliers.defaultExpectation.paramPtrs != nil {
+		mmListSuppliers.mock.t.Fatalf("StockServiceUseCaseMock.ListSuppliers mock is already set by ExpectParams functions")
+	}
+
+	mmListSuppliers.defaultExpectation.params = &StockServiceUseCaseMockListSuppliersParams{ctx, userID}
+	mmListSuppliers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListSuppliers.expectations {
+		if minimock.Equal(e.params, mmListSuppliers.defaultExpectation.params) {
+			mmListSuppliers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListSuppliers.defaultExpectation.params)
+		}
+	}
+
+	return mmListSuppliers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.ListSuppliers
+func (mmListSuppliers *mStockServiceUseCaseMockListSuppliers) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockListSuppliers {
+	if mmListSuppliers.mock.funcListSuppliers != nil {
+		mmListSuppliers.mock.t.Fatalf("StockServiceUseCaseMock.ListSuppliers mock is already set by Set")
+	}
+
+	if mmListSuppliers.defaultExpectation == nil {
+		mmListSuppliers.defaultExpectation = &StockServiceUseCaseMockListSuppliersExpectation{}
+	}
+
+	if mmListSuppliers.defaultExpectation.params != nil {
+		mmListSuppliers.mock.t.Fatalf("StockServiceUseCaseMock.ListSuppliers mock is already set by Expect")
+	}
+
+	if mmListSuppliers.defaultExpectation.paramPtrs == nil {
+		mmListSuppliers.defaultExpectation.paramPtrs = &StockServiceUseCaseMockListSuppliersParamPtrs{}
+	}
+	mmListSuppliers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListSuppliers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListSuppliers
+}
+
+// ExpectUserIDParam2 sets up expected param userID for StockServiceUseCase.ListSuppliers
+func (mmListSuppliers *mStockServiceUseCaseMockListSuppliers) ExpectUserIDParam2(userID domain.UserID) *mStockServiceUseCaseMockListSuppliers {
+	if mmListSuppliers.mock.funcListSuppliers != nil {
+		mmListSuppliers.mock.t.Fatalf("StockServiceUseCaseMock.ListSuppliers mock is already set by Set")
+	}
+
+	if mmListSuppliers.defaultExpectation == nil {
+		mmListSuppliers.defaultExpectation = &StockServiceUseCaseMockListSuppliersExpectation{}
+	}
+
+	if mmListSuppliers.defaultExpectation.params != nil {
+		mmListSuppliers.mock.t.Fatalf("StockServiceUseCaseMock.ListSuppliers mock is already set by Expect")
+	}
+
+	if mmListSuppliers.defaultExpectation.paramPtrs == nil {
+		mmListSuppliers.defaultExpectation.paramPtrs = &StockServiceUseCaseMockListSuppliersParamPtrs{}
+	}
+	mmListSuppliers.defaultExpectation.paramPtrs.userID = &userID
+	mmListSuppliers.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListSuppliers
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.ListSuppliers
+func (mmListSuppliers *mStockServiceUseCaseMockListSuppliers) Inspect(f func(ctx context.Context, userID domain.UserID)) *mStockServiceUseCaseMockListSuppliers {
+	if mmListSuppliers.mock.inspectFuncListSuppliers != nil {
+		mmListSuppliers.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.ListSuppliers")
+	}
+
+	mmListSuppliers.mock.inspectFuncListSuppliers = f
+
+	return mmListSuppliers
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.ListSuppliers
+func (mmListSuppliers *mStockServiceUseCaseMockListSuppliers) Return(sa1 []domain.Supplier, err error) *StockServiceUseCaseMock {
+	if mmListSuppliers.mock.funcListSuppliers != nil {
+		mmListSuppliers.mock.t.Fatalf("StockServiceUseCaseMock.ListSuppliers mock is already set by Set")
+	}
+
+	if mmListSuppliers.defaultExpectation == nil {
+		mmListSuppliers.defaultExpectation = &StockServiceUseCaseMockListSuppliersExpectation{mock: mmListSuppliers.mock}
+	}
+	mmListSuppliers.defaultExpectation.results = &StockServiceUseCaseMockListSuppliersResults{sa1, err}
+	mmListSuppliers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListSuppliers.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.ListSuppliers method
+func (mmListSuppliers *mStockServiceUseCaseMockListSuppliers) Set(f func(ctx context.Context, userID domain.UserID) (sa1 []domain.Supplier, err error)) *StockServiceUseCaseMock {
+	if mmListSuppliers.defaultExpectation != nil {
+		mmListSuppliers.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.ListSuppliers method")
+	}
+
+	if len(mmListSuppliers.expectations) > 0 {
+		mmListSuppliers.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.ListSuppliers method")
+	}
+
+	mmListSuppliers.mock.funcListSuppliers = f
+	mmListSuppliers.mock.funcListSuppliersOrigin = minimock.CallerInfo(1)
+	return mmListSuppliers.mock
+}
+
+// When sets expectation for the StockServiceUseCase.ListSuppliers which will trigger the result defined by the following
+// Then helper
+func (mmListSuppliers *mStockServiceUseCaseMockListSuppliers) When(ctx context.Context, userID domain.UserID) *StockServiceUseCaseMockListSuppliersExpectation {
+	if mmListSuppliers.mock.funcListSuppliers != nil {
+		mmListSuppliers.mock.t.Fatalf("StockServiceUseCaseMock.ListSuppliers mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockListSuppliersExpectation{
+		mock:               mmListSuppliers.mock,
+		params:             &StockServiceUseCaseMockListSuppliersParams{ctx, userID},
+		expectationOrigins: StockServiceUseCaseMockListSuppliersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListSuppliers.expectations = append(mmListSuppliers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.ListSuppliers return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockListSuppliersExpectation) Then(sa1 []domain.Supplier, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockListSuppliersResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.ListSuppliers should be invoked
+func (mmListSuppliers *mStockServiceUseCaseMockListSuppliers) Times(n uint64) *mStockServiceUseCaseMockListSuppliers {
+	if n == 0 {
+		mmListSuppliers.mock.t.Fatalf("Times of StockServiceUseCaseMock.ListSuppliers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListSuppliers.expectedInvocations, n)
+	mmListSuppliers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListSuppliers
+}
+
+func (mmListSuppliers *mStockServiceUseCaseMockListSuppliers) invocationsDone() bool {
+	if len(mmListSuppliers.expectations) == 0 && mmListSuppliers.defaultExpectation == nil && mmListSuppliers.mock.funcListSuppliers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListSuppliers.mock.afterListSuppliersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListSuppliers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListSuppliers implements mm_usecase.StockServiceUseCase
+func (mmListSuppliers *StockServiceUseCaseMock) ListSuppliers(ctx context.Context, userID domain.UserID) (sa1 []domain.Supplier, err error) {
+	mm_atomic.AddUint64(&mmListSuppliers.beforeListSuppliersCounter, 1)
+	defer mm_atomic.AddUint64(&mmListSuppliers.afterListSuppliersCounter, 1)
+
+	mmListSuppliers.t.Helper()
+
+	if mmListSuppliers.inspectFuncListSuppliers != nil {
+		mmListSuppliers.inspectFuncListSuppliers(ctx, userID)
+	}
+
+	mm_params := StockServiceUseCaseMockListSuppliersParams{ctx, userID}
+
+	// Record call args
+	mmListSuppliers.ListSuppliersMock.mutex.Lock()
+	mmListSuppliers.ListSuppliersMock.callArgs = append(mmListSuppliers.ListSuppliersMock.callArgs, &mm_params)
+	mmListSuppliers.ListSuppliersMock.mutex.Unlock()
+
+	for _, e := range mmListSuppliers.ListSuppliersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListSuppliers.ListSuppliersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListSuppliers.ListSuppliersMock.defaultExpectation.Counter, 1)
+		mm_want := mmListSuppliers.ListSuppliersMock.defaultExpectation.params
+		mm_want_ptrs := mmListSuppliers.ListSuppliersMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockListSuppliersParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListSuppliers.t.Errorf("StockServiceUseCaseMock.ListSuppliers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSuppliers.ListSuppliersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListSuppliers.t.Errorf("StockServiceUseCaseMock.ListSuppliers got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSuppliers.ListSuppliersMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListSuppliers.t.Errorf("StockServiceUseCaseMock.ListSuppliers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListSuppliers.ListSuppliersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListSuppliers.ListSuppliersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListSuppliers.t.Fatal("No results are set for the StockServiceUseCaseMock.ListSuppliers")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListSuppliers.funcListSuppliers != nil {
+		return mmListSuppliers.funcListSuppliers(ctx, userID)
+	}
+	mmListSuppliers.t.Fatalf("Unexpected call to StockServiceUseCaseMock.ListSuppliers. %v %v", ctx, userID)
+	return
+}
+
+// ListSuppliersAfterCounter returns a count of finished StockServiceUseCaseMock.ListSuppliers invocations
+func (mmListSuppliers *StockServiceUseCaseMock) ListSuppliersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSuppliers.afterListSuppliersCounter)
+}
+
+// ListSuppliersBeforeCounter returns a count of StockServiceUseCaseMock.ListSuppliers invocations
+func (mmListSuppliers *StockServiceUseCaseMock) ListSuppliersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSuppliers.beforeListSuppliersCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.ListSuppliers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListSuppliers *mStockServiceUseCaseMockListSuppliers) Calls() []*StockServiceUseCaseMockListSuppliersParams {
+	mmListSuppliers.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockListSuppliersParams, len(mmListSuppliers.callArgs))
+	copy(argCopy, mmListSuppliers.callArgs)
+
+	mmListSuppliers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListSuppliersDone returns true if the count of the ListSuppliers invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockListSuppliersDone() bool {
+	if m.ListSuppliersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListSuppliersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListSuppliersMock.invocationsDone()
+}
+
+// MinimockListSuppliersInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockListSuppliersInspect() {
+	for _, e := range m.ListSuppliersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ListSuppliers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListSuppliersCounter := mm_atomic.LoadUint64(&m.afterListSuppliersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListSuppliersMock.defaultExpectation != nil && afterListSuppliersCounter < 1 {
+		if m.ListSuppliersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ListSuppliers at\n%s", m.ListSuppliersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ListSuppliers at\n%s with params: %#v", m.ListSuppliersMock.defaultExpectation.expectationOrigins.origin, *m.ListSuppliersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListSuppliers != nil && afterListSuppliersCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.ListSuppliers at\n%s", m.funcListSuppliersOrigin)
+	}
+
+	if !m.ListSuppliersMock.invocationsDone() && afterListSuppliersCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.ListSuppliers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListSuppliersMock.expectedInvocations), m.ListSuppliersMock.expectedInvocationsOrigin, afterListSuppliersCounter)
+	}
+}
+
+type mStockServiceUseCaseMockOpenCycleCount struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockOpenCycleCountExpectation
+	expectations       []*StockServiceUseCaseMockOpenCycleCountExpectation
+
+	callArgs []*StockServiceUseCaseMockOpenCycleCountParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockOpenCycleCountExpectation specifies expectation struct of the StockServiceUseCase.OpenCycleCount
+type StockServiceUseCaseMockOpenCycleCountExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockOpenCycleCountParams
+	paramPtrs          *StockServiceUseCaseMockOpenCycleCountParamPtrs
+	expectationOrigins StockServiceUseCaseMockOpenCycleCountExpectationOrigins
+	results            *StockServiceUseCaseMockOpenCycleCountResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockOpenCycleCountParams contains parameters of the StockServiceUseCase.OpenCycleCount
+type StockServiceUseCaseMockOpenCycleCountParams struct {
+	ctx        context.Context
+	cycleCount domain.CycleCount
+}
+
+// StockServiceUseCaseMockOpenCycleCountParamPtrs contains pointers to parameters of the StockServiceUseCase.OpenCycleCount
+type StockServiceUseCaseMockOpenCycleCountParamPtrs struct {
+	ctx        *context.Context
+	cycleCount *domain.CycleCount
+}
+
+// StockServiceUseCaseMockOpenCycleCountResults contains results of the StockServiceUseCase.OpenCycleCount
+type StockServiceUseCaseMockOpenCycleCountResults struct {
+	c2  domain.CycleCount
+	err error
+}
+
+// StockServiceUseCaseMockOpenCycleCountOrigins contains origins of expectations of the StockServiceUseCase.OpenCycleCount
+type StockServiceUseCaseMockOpenCycleCountExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originCycleCount string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmOpenCycleCount *mStockServiceUseCaseMockOpenCycleCount) Optional() *mStockServiceUseCaseMockOpenCycleCount {
+	mmOpenCycleCount.optional = true
+	return mmOpenCycleCount
+}
+
+// Expect sets up expected params for StockServiceUseCase.OpenCycleCount
+func (mmOpenCycleCount *mStockServiceUseCaseMockOpenCycleCount) Expect(ctx context.Context, cycleCount domain.CycleCount) *mStockServiceUseCaseMockOpenCycleCount {
+	if mmOpenCycleCount.mock.funcOpenCycleCount != nil {
+		mmOpenCycleCount.mock.t.Fatalf("StockServiceUseCaseMock.OpenCycleCount mock is already set by Set")
+	}
+
+	if mmOpenCycleCount.defaultExpectation == nil {
+		mmOpenCycleCount.defaultExpectation = &StockServiceUseCaseMockOpenCycleCountExpectation{}
+	}
+
+	if mmOpenCycleCount.defaultExpectation.paramPtrs != nil {
+		mmOpenCycleCount.mock.t.Fatalf("StockServiceUseCaseMock.OpenCycleCount mock is already set by ExpectParams functions")
+	}
+
+	mmOpenCycleCount.defaultExpectation.params = &StockServiceUseCaseMockOpenCycleCountParams{ctx, cycleCount}
+	mmOpenCycleCount.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmOpenCycleCount.expectations {
+		if minimock.Equal(e.params, mmOpenCycleCount.defaultExpectation.params) {
+			mmOpenCycleCount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmOpenCycleCount.defaultExpectation.params)
+		}
+	}
+
+	return mmOpenCycleCount
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.OpenCycleCount
+func (mmOpenCycleCount *mStockServiceUseCaseMockOpenCycleCount) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockOpenCycleCount {
+	if mmOpenCycleCount.mock.funcOpenCycleCount != nil {
+		mmOpenCycleCount.mock.t.Fatalf("StockServiceUseCaseMock.OpenCycleCount mock is already set by Set")
+	}
+
+	if mmOpenCycleCount.defaultExpectation == nil {
+		mmOpenCycleCount.defaultExpectation = &StockServiceUseCaseMockOpenCycleCountExpectation{}
+	}
+
+	if mmOpenCycleCount.defaultExpectation.params != nil {
+		mmOpenCycleCount.mock.t.Fatalf("StockServiceUseCaseMock.OpenCycleCount mock is already set by Expect")
+	}
+
+	if mmOpenCycleCount.defaultExpectation.paramPtrs == nil {
+		mmOpenCycleCount.defaultExpectation.paramPtrs = &StockServiceUseCaseMockOpenCycleCountParamPtrs{}
+	}
+	mmOpenCycleCount.defaultExpectation.paramPtrs.ctx = &ctx
+	mmOpenCycleCount.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmOpenCycleCount
+}
+
+// ExpectCycleCountParam2 sets up expected param cycleCount for StockServiceUseCase.OpenCycleCount
+func (mmOpenCycleCount *mStockServiceUseCaseMockOpenCycleCount) ExpectCycleCountParam2(cycleCount domain.CycleCount) *mStockServiceUseCaseMockOpenCycleCount {
+	if mmOpenCycleCount.mock.funcOpenCycleCount != nil {
+		mmOpenCycleCount.mock.t.Fatalf("StockServiceUseCaseMock.OpenCycleCount mock is already set by Set")
+	}
+
+	if mmOpenCycleCount.defaultExpectation == nil {
+		mmOpenCycleCount.defaultExpectation = &StockServiceUseCaseMockOpenCycleCountExpectation{}
+	}
+
+	if mmOpenCycleCount.defaultExpectation.params != nil {
+		mmOpenCycleCount.mock.t.Fatalf("StockServiceUseCaseMock.OpenCycleCount mock is already set by Expect")
+	}
+
+	if mmOpenCycleCount.defaultExpectation.paramPtrs == nil {
+		mmOpenCycleCount.defaultExpectation.paramPtrs = &StockServiceUseCaseMockOpenCycleCountParamPtrs{}
+	}
+	mmOpenCycleCount.defaultExpectation.paramPtrs.cycleCount = &cycleCount
+	mmOpenCycleCount.defaultExpectation.expectationOrigins.originCycleCount = minimock.CallerInfo(1)
+
+	return mmOpenCycleCount
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.OpenCycleCount
+func (mmOpenCycleCount *mStockServiceUseCaseMockOpenCycleCount) Inspect(f func(ctx context.Context, cycleCount domain.CycleCount)) *mStockServiceUseCaseMockOpenCycleCount {
+	if mmOpenCycleCount.mock.inspectFuncOpenCycleCount != nil {
+		mmOpenCycleCount.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.OpenCycleCount")
+	}
+
+	mmOpenCycleCount.mock.inspectFuncOpenCycleCount = f
+
+	return mmOpenCycleCount
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.OpenCycleCount
+func (mmOpenCycleCount *mStockServiceUseCaseMockOpenCycleCount) Return(c2 domain.CycleCount, err error) *StockServiceUseCaseMock {
+	if mmOpenCycleCount.mock.funcOpenCycleCount != nil {
+		mmOpenCycleCount.mock.t.Fatalf("StockServiceUseCaseMock.OpenCycleCount mock is already set by Set")
+	}
+
+	if mmOpenCycleCount.defaultExpectation == nil {
+		mmOpenCycleCount.defaultExpectation = &StockServiceUseCaseMockOpenCycleCountExpectation{mock: mmOpenCycleCount.mock}
+	}
+	mmOpenCycleCount.defaultExpectation.results = &StockServiceUseCaseMockOpenCycleCountResults{c2, err}
+	mmOpenCycleCount.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmOpenCycleCount.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.OpenCycleCount method
+func (mmOpenCycleCount *mStockServiceUseCaseMockOpenCycleCount) Set(f func(ctx context.Context, cycleCount domain.CycleCount) (c2 domain.CycleCount, err error)) *StockServiceUseCaseMock {
+	if mmOpenCycleCount.defaultExpectation != nil {
+		mmOpenCycleCount.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.OpenCycleCount method")
+	}
+
+	if len(mmOpenCycleCount.expectations) > 0 {
+		mmOpenCycleCount.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.OpenCycleCount method")
+	}
+
+	mmOpenCycleCount.mock.funcOpenCycleCount = f
+	mmOpenCycleCount.mock.funcOpenCycleCountOrigin = minimock.CallerInfo(1)
+	return mmOpenCycleCount.mock
+}
+
+// When sets expectation for the StockServiceUseCase.OpenCycleCount which will trigger the result defined by the following
+// Then helper
+func (mmOpenCycleCount *mStockServiceUseCaseMockOpenCycleCount) When(ctx context.Context, cycleCount domain.CycleCount) *StockServiceUseCaseMockOpenCycleCountExpectation {
+	if mmOpenCycleCount.mock.funcOpenCycleCount != nil {
+		mmOpenCycleCount.mock.t.Fatalf("StockServiceUseCaseMock.OpenCycleCount mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockOpenCycleCountExpectation{
+		mock:               mmOpenCycleCount.mock,
+		params:             &StockServiceUseCaseMockOpenCycleCountParams{ctx, cycleCount},
+		expectationOrigins: StockServiceUseCaseMockOpenCycleCountExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmOpenCycleCount.expectations = append(mmOpenCycleCount.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.OpenCycleCount return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockOpenCycleCountExpectation) Then(c2 domain.CycleCount, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockOpenCycleCountResults{c2, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.OpenCycleCount should be invoked
+func (mmOpenCycleCount *mStockServiceUseCaseMockOpenCycleCount) Times(n uint64) *mStockServiceUseCaseMockOpenCycleCount {
+	if n == 0 {
+		mmOpenCycleCount.mock.t.Fatalf("Times of StockServiceUseCaseMock.OpenCycleCount mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmOpenCycleCount.expectedInvocations, n)
+	mmOpenCycleCount.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmOpenCycleCount
+}
+
+func (mmOpenCycleCount *mStockServiceUseCaseMockOpenCycleCount) invocationsDone() bool {
+	if len(mmOpenCycleCount.expectations) == 0 && mmOpenCycleCount.defaultExpectation == nil && mmOpenCycleCount.mock.funcOpenCycleCount == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmOpenCycleCount.mock.afterOpenCycleCountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmOpenCycleCount.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// OpenCycleCount implements mm_usecase.StockServiceUseCase
+func (mmOpenCycleCount *StockServiceUseCaseMock) OpenCycleCount(ctx context.Context, cycleCount domain.CycleCount) (c2 domain.CycleCount, err error) {
+	mm_atomic.AddUint64(&mmOpenCycleCount.beforeOpenCycleCountCounter, 1)
+	defer mm_atomic.AddUint64(&mmOpenCycleCount.afterOpenCycleCountCounter, 1)
+
+	mmOpenCycleCount.t.Helper()
+
+	if mmOpenCycleCount.inspectFuncOpenCycleCount != nil {
+		mmOpenCycleCount.inspectFuncOpenCycleCount(ctx, cycleCount)
+	}
+
+	mm_params := StockServiceUseCaseMockOpenCycleCountParams{ctx, cycleCount}
+
+	// Record call args
+	mmOpenCycleCount.OpenCycleCountMock.mutex.Lock()
+	mmOpenCycleCount.OpenCycleCountMock.callArgs = append(mmOpenCycleCount.OpenCycleCountMock.callArgs, &mm_params)
+	mmOpenCycleCount.OpenCycleCountMock.mutex.Unlock()
+
+	for _, e := range mmOpenCycleCount.OpenCycleCountMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.c2, e.results.err
+		}
+	}
+
+	if mmOpenCycleCount.OpenCycleCountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmOpenCycleCount.OpenCycleCountMock.defaultExpectation.Counter, 1)
+		mm_want := mmOpenCycleCount.OpenCycleCountMock.defaultExpectation.params
+		mm_want_ptrs := mmOpenCycleCount.OpenCycleCountMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockOpenCycleCountParams{ctx, cycleCount}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmOpenCycleCount.t.Errorf("StockServiceUseCaseMock.OpenCycleCount got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOpenCycleCount.OpenCycleCountMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.cycleCount != nil && !minimock.Equal(*mm_want_ptrs.cycleCount, mm_got.cycleCount) {
+				mmOpenCycleCount.t.Errorf("StockServiceUseCaseMock.OpenCycleCount got unexpected parameter cycleCount, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOpenCycleCount.OpenCycleCountMock.defaultExpectation.expectationOrigins.originCycleCount, *mm_want_ptrs.cycleCount, mm_got.cycleCount, minimock.Diff(*mm_want_ptrs.cycleCount, mm_got.cycleCount))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmOpenCycleCount.t.Errorf("StockServiceUseCaseMock.OpenCycleCount got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmOpenCycleCount.OpenCycleCountMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmOpenCycleCount.OpenCycleCountMock.defaultExpectation.results
+		if mm_results == nil {
+			mmOpenCycleCount.t.Fatal("No results are set for the StockServiceUseCaseMock.OpenCycleCount")
+		}
+		return (*mm_results).c2, (*mm_results).err
+	}
+	if mmOpenCycleCount.funcOpenCycleCount != nil {
+		return mmOpenCycleCount.funcOpenCycleCount(ctx, cycleCount)
+	}
+	mmOpenCycleCount.t.Fatalf("Unexpected call to StockServiceUseCaseMock.OpenCycleCount. %v %v", ctx, cycleCount)
+	return
+}
+
+// OpenCycleCountAfterCounter returns a count of finished StockServiceUseCaseMock.OpenCycleCount invocations
+func (mmOpenCycleCount *StockServiceUseCaseMock) OpenCycleCountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOpenCycleCount.afterOpenCycleCountCounter)
+}
+
+// OpenCycleCountBeforeCounter returns a count of StockServiceUseCaseMock.OpenCycleCount invocations
+func (mmOpenCycleCount *StockServiceUseCaseMock) OpenCycleCountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOpenCycleCount.beforeOpenCycleCountCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.OpenCycleCount.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmOpenCycleCount *mStockServiceUseCaseMockOpenCycleCount) Calls() []*StockServiceUseCaseMockOpenCycleCountParams {
+	mmOpenCycleCount.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockOpenCycleCountParams, len(mmOpenCycleCount.callArgs))
+	copy(argCopy, mmOpenCycleCount.callArgs)
+
+	mmOpenCycleCount.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockOpenCycleCountDone returns true if the count of the OpenCycleCount invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockOpenCycleCountDone() bool {
+	if m.OpenCycleCountMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.OpenCycleCountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.OpenCycleCountMock.invocationsDone()
+}
+
+// MinimockOpenCycleCountInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockOpenCycleCountInspect() {
+	for _, e := range m.OpenCycleCountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.OpenCycleCount at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterOpenCycleCountCounter := mm_atomic.LoadUint64(&m.afterOpenCycleCountCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.OpenCycleCountMock.defaultExpectation != nil && afterOpenCycleCountCounter < 1 {
+		if m.OpenCycleCountMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.OpenCycleCount at\n%s", m.OpenCycleCountMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.OpenCycleCount at\n%s with params: %#v", m.OpenCycleCountMock.defaultExpectation.expectationOrigins.origin, *m.OpenCycleCountMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcOpenCycleCount != nil && afterOpenCycleCountCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.OpenCycleCount at\n%s", m.funcOpenCycleCountOrigin)
+	}
+
+	if !m.OpenCycleCountMock.invocationsDone() && afterOpenCycleCountCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.OpenCycleCount at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.OpenCycleCountMock.expectedInvocations), m.OpenCycleCountMock.expectedInvocationsOrigin, afterOpenCycleCountCounter)
+	}
+}
+
+type mStockServiceUseCaseMockPlacePurchaseOrder struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockPlacePurchaseOrderExpectation
+	expectations       []*StockServiceUseCaseMockPlacePurchaseOrderExpectation
+
+	callArgs []*StockServiceUseCaseMockPlacePurchaseOrderParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockPlacePurchaseOrderExpectation specifies expectation struct of the StockServiceUseCase.PlacePurchaseOrder
+type StockServiceUseCaseMockPlacePurchaseOrderExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockPlacePurchaseOrderParams
+	paramPtrs          *StockServiceUseCaseMockPlacePurchaseOrderParamPtrs
+	expectationOrigins StockServiceUseCaseMockPlacePurchaseOrderExpectationOrigins
+	results            *StockServiceUseCaseMockPlacePurchaseOrderResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockPlacePurchaseOrderParams contains parameters of the StockServiceUseCase.PlacePurchaseOrder
+type StockServiceUseCaseMockPlacePurchaseOrderParams struct {
+	ctx             context.Context
+	purchaseOrderID domain.PurchaseOrderID
+}
+
+// StockServiceUseCaseMockPlacePurchaseOrderParamPtrs contains pointers to parameters of the StockServiceUseCase.PlacePurchaseOrder
+type StockServiceUseCaseMockPlacePurchaseOrderParamPtrs struct {
+	ctx             *context.Context
+	purchaseOrderID *domain.PurchaseOrderID
+}
+
+// StockServiceUseCaseMockPlacePurchaseOrderResults contains results of the StockServiceUseCase.PlacePurchaseOrder
+type StockServiceUseCaseMockPlacePurchaseOrderResults struct {
+	p1  domain.PurchaseOrder
+	err error
+}
+
+// StockServiceUseCaseMockPlacePurchaseOrderOrigins contains origins of expectations of the StockServiceUseCase.PlacePurchaseOrder
+type StockServiceUseCaseMockPlacePurchaseOrderExpectationOrigins struct {
+	origin                string
+	originCtx             string
+	originPurchaseOrderID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPlacePurchaseOrder *mStockServiceUseCaseMockPlacePurchaseOrder) Optional() *mStockServiceUseCaseMockPlacePurchaseOrder {
+	mmPlacePurchaseOrder.optional = true
+	return mmPlacePurchaseOrder
+}
+
+// Expect sets up expected params for StockServiceUseCase.PlacePurchaseOrder
+func (mmPlacePurchaseOrder *mStockServiceUseCaseMockPlacePurchaseOrder) Expect(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) *mStockServiceUseCaseMockPlacePurchaseOrder {
+	if mmPlacePurchaseOrder.mock.funcPlacePurchaseOrder != nil {
+		mmPlacePurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.PlacePurchaseOrder mock is already set by Set")
+	}
+
+	if mmPlacePurchaseOrder.defaultExpectation == nil {
+		mmPlacePurchaseOrder.defaultExpectation = &StockServiceUseCaseMockPlacePurchaseOrderExpectation{}
+	}
+
+	if mmPlacePurchaseOrder.defaultExpectation.paramPtrs != nil {
+		mmPlacePurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.PlacePurchaseOrder mock is already set by ExpectParams functions")
+	}
+
+	mmPlacePurchaseOrder.defaultExpectation.params = &StockServiceUseCaseMockPlacePurchaseOrderParams{ctx, purchaseOrderID}
+	mmPlacePurchaseOrder.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPlacePurchaseOrder.expectations {
+		if minimock.Equal(e.params, mmPlacePurchaseOrder.defaultExpectation.params) {
+			mmPlacePurchaseOrder.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPlacePurchaseOrder.defaultExpectation.params)
+		}
+	}
+
+	return mmPlacePurchaseOrder
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.PlacePurchaseOrder
+func (mmPlacePurchaseOrder *mStockServiceUseCaseMockPlacePurchaseOrder) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockPlacePurchaseOrder {
+	if mmPlacePurchaseOrder.mock.funcPlacePurchaseOrder != nil {
+		mmPlacePurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.PlacePurchaseOrder mock is already set by Set")
+	}
+
+	if mmPlacePurchaseOrder.defaultExpectation == nil {
+		mmPlacePurchaseOrder.defaultExpectation = &StockServiceUseCaseMockPlacePurchaseOrderExpectation{}
+	}
+
+	if mmPlacePurchaseOrder.defaultExpectation.params != nil {
+		mmPlacePurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.PlacePurchaseOrder mock is already set by Expect")
+	}
+
+	if mmPlacePurchaseOrder.defaultExpectation.paramPtrs == nil {
+		mmPlacePurchaseOrder.defaultExpectation.paramPtrs = &StockServiceUseCaseMockPlacePurchaseOrderParamPtrs{}
+	}
+	mmPlacePurchaseOrder.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPlacePurchaseOrder.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPlacePurchaseOrder
+}
+
+// ExpectPurchaseOrderIDParam2 sets up expected param purchaseOrderID for StockServiceUseCase.PlacePurchaseOrder
+func (mmPlacePurchaseOrder *mStockServiceUseCaseMockPlacePurchaseOrder) ExpectPurchaseOrderIDParam2(purchaseOrderID domain.PurchaseOrderID) *mStockServiceUseCaseMockPlacePurchaseOrder {
+	if mmPlacePurchaseOrder.mock.funcPlacePurchaseOrder != nil {
+		mmPlacePurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.PlacePurchaseOrder mock is already set by Set")
+	}
+
+	if mmPlacePurchaseOrder.defaultExpectation == nil {
+		mmPlacePurchaseOrder.defaultExpectation = &StockServiceUseCaseMockPlacePurchaseOrderExpectation{}
+	}
+
+	if mmPlacePurchaseOrder.defaultExpectation.params != nil {
+		mmPlacePurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.PlacePurchaseOrder mock is already set by Expect")
+	}
+
+	if mmPlacePurchaseOrder.defaultExpectation.paramPtrs == nil {
+		mmPlacePurchaseOrder.defaultExpectation.paramPtrs = &StockServiceUseCaseMockPlacePurchaseOrderParamPtrs{}
+	}
+	mmPlacePurchaseOrder.defaultExpectation.paramPtrs.purchaseOrderID = &purchaseOrderID
+	mmPlacePurchaseOrder.defaultExpectation.expectationOrigins.originPurchaseOrderID = minimock.CallerInfo(1)
+
+	return mmPlacePurchaseOrder
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.PlacePurchaseOrder
+func (mmPlacePurchaseOrder *mStockServiceUseCaseMockPlacePurchaseOrder) Inspect(f func(ctx context.Context, purchaseOrderID domain.PurchaseOrderID)) *mStockServiceUseCaseMockPlacePurchaseOrder {
+	if mmPlacePurchaseOrder.mock.inspectFuncPlacePurchaseOrder != nil {
+		mmPlacePurchaseOrder.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.PlacePurchaseOrder")
+	}
+
+	mmPlacePurchaseOrder.mock.inspectFuncPlacePurchaseOrder = f
+
+	return mmPlacePurchaseOrder
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.PlacePurchaseOrder
+func (mmPlacePurchaseOrder *mStockServiceUseCaseMockPlacePurchaseOrder) Return(p1 domain.PurchaseOrder, err error) *StockServiceUseCaseMock {
+	if mmPlacePurchaseOrder.mock.funcPlacePurchaseOrder != nil {
+		mmPlacePurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.PlacePurchaseOrder mock is already set by Set")
+	}
+
+	if mmPlacePurchaseOrder.defaultExpectation == nil {
+		mmPlacePurchaseOrder.defaultExpectation = &StockServiceUseCaseMockPlacePurchaseOrderExpectation{mock: mmPlacePurchaseOrder.mock}
+	}
+	mmPlacePurchaseOrder.defaultExpectation.results = &StockServiceUseCaseMockPlacePurchaseOrderResults{p1, err}
+	mmPlacePurchaseOrder.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPlacePurchaseOrder.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.PlacePurchaseOrder method
+func (mmPlacePurchaseOrder *mStockServiceUseCaseMockPlacePurchaseOrder) Set(f func(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) (p1 domain.PurchaseOrder, err error)) *StockServiceUseCaseMock {
+	if mmPlacePurchaseOrder.defaultExpectation != nil {
+		mmPlacePurchaseOrder.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.PlacePurchaseOrder method")
+	}
+
+	if len(mmPlacePurchaseOrder.expectations) > 0 {
+		mmPlacePurchaseOrder.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.PlacePurchaseOrder method")
+	}
+
+	mmPlacePurchaseOrder.mock.funcPlacePurchaseOrder = f
+	mmPlacePurchaseOrder.mock.funcPlacePurchaseOrderOrigin = minimock.CallerInfo(1)
+	return mmPlacePurchaseOrder.mock
+}
+
+// When sets expectation for the StockServiceUseCase.PlacePurchaseOrder which will trigger the result defined by the following
+// Then helper
+func (mmPlacePurchaseOrder *mStockServiceUseCaseMockPlacePurchaseOrder) When(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) *StockServiceUseCaseMockPlacePurchaseOrderExpectation {
+	if mmPlacePurchaseOrder.mock.funcPlacePurchaseOrder != nil {
+		mmPlacePurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.PlacePurchaseOrder mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockPlacePurchaseOrderExpectation{
+		mock:               mmPlacePurchaseOrder.mock,
+		params:             &StockServiceUseCaseMockPlacePurchaseOrderParams{ctx, purchaseOrderID},
+		expectationOrigins: StockServiceUseCaseMockPlacePurchaseOrderExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPlacePurchaseOrder.expectations = append(mmPlacePurchaseOrder.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.PlacePurchaseOrder return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockPlacePurchaseOrderExpectation) Then(p1 domain.PurchaseOrder, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockPlacePurchaseOrderResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.PlacePurchaseOrder should be invoked
+func (mmPlacePurchaseOrder *mStockServiceUseCaseMockPlacePurchaseOrder) Times(n uint64) *mStockServiceUseCaseMockPlacePurchaseOrder {
+	if n == 0 {
+		mmPlacePurchaseOrder.mock.t.Fatalf("Times of StockServiceUseCaseMock.PlacePurchaseOrder mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPlacePurchaseOrder.expectedInvocations, n)
+	mmPlacePurchaseOrder.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPlacePurchaseOrder
+}
+
+func (mmPlacePurchaseOrder *mStockServiceUseCaseMockPlacePurchaseOrder) invocationsDone() bool {
+	if len(mmPlacePurchaseOrder.expectations) == 0 && mmPlacePurchaseOrder.defaultExpectation == nil && mmPlacePurchaseOrder.mock.funcPlacePurchaseOrder == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPlacePurchaseOrder.mock.afterPlacePurchaseOrderCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPlacePurchaseOrder.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PlacePurchaseOrder implements mm_usecase.StockServiceUseCase
+func (mmPlacePurchaseOrder *StockServiceUseCaseMock) PlacePurchaseOrder(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) (p1 domain.PurchaseOrder, err error) {
+	mm_atomic.AddUint64(&mmPlacePurchaseOrder.beforePlacePurchaseOrderCounter, 1)
+	defer mm_atomic.AddUint64(&mmPlacePurchaseOrder.afterPlacePurchaseOrderCounter, 1)
+
+	mmPlacePurchaseOrder.t.Helper()
+
+	if mmPlacePurchaseOrder.inspectFuncPlacePurchaseOrder != nil {
+		mmPlacePurchaseOrder.inspectFuncPlacePurchaseOrder(ctx, purchaseOrderID)
+	}
+
+	mm_params := StockServiceUseCaseMockPlacePurchaseOrderParams{ctx, purchaseOrderID}
+
+	// Record call args
+	mmPlacePurchaseOrder.PlacePurchaseOrderMock.mutex.Lock()
+	mmPlacePurchaseOrder.PlacePurchaseOrderMock.callArgs = append(mmPlacePurchaseOrder.PlacePurchaseOrderMock.callArgs, &mm_params)
+	mmPlacePurchaseOrder.PlacePurchaseOrderMock.mutex.Unlock()
+
+	for _, e := range mmPlacePurchaseOrder.PlacePurchaseOrderMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmPlacePurchaseOrder.PlacePurchaseOrderMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPlacePurchaseOrder.PlacePurchaseOrderMock.defaultExpectation.Counter, 1)
+		mm_want := mmPlacePurchaseOrder.PlacePurchaseOrderMock.defaultExpectation.params
+		mm_want_ptrs := mmPlacePurchaseOrder.PlacePurchaseOrderMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockPlacePurchaseOrderParams{ctx, purchaseOrderID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPlacePurchaseOrder.t.Errorf("StockServiceUseCaseMock.PlacePurchaseOrder got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPlacePurchaseOrder.PlacePurchaseOrderMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.purchaseOrderID != nil && !minimock.Equal(*mm_want_ptrs.purchaseOrderID, mm_got.purchaseOrderID) {
+				mmPlacePurchaseOrder.t.Errorf("StockServiceUseCaseMock.PlacePurchaseOrder got unexpected parameter purchaseOrderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPlacePurchaseOrder.PlacePurchaseOrderMock.defaultExpectation.expectationOrigins.originPurchaseOrderID, *mm_want_ptrs.purchaseOrderID, mm_got.purchaseOrderID, minimock.Diff(*mm_want_ptrs.purchaseOrderID, mm_got.purchaseOrderID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPlacePurchaseOrder.t.Errorf("StockServiceUseCaseMock.PlacePurchaseOrder got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPlacePurchaseOrder.PlacePurchaseOrderMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPlacePurchaseOrder.PlacePurchaseOrderMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPlacePurchaseOrder.t.Fatal("No results are set for the StockServiceUseCaseMock.PlacePurchaseOrder")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmPlacePurchaseOrder.funcPlacePurchaseOrder != nil {
+		return mmPlacePurchaseOrder.funcPlacePurchaseOrder(ctx, purchaseOrderID)
+	}
+	mmPlacePurchaseOrder.t.Fatalf("Unexpected call to StockServiceUseCaseMock.PlacePurchaseOrder. %v %v", ctx, purchaseOrderID)
+	return
+}
+
+// PlacePurchaseOrderAfterCounter returns a count of finished StockServiceUseCaseMock.PlacePurchaseOrder invocations
+func (mmPlacePurchaseOrder *StockServiceUseCaseMock) PlacePurchaseOrderAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPlacePurchaseOrder.afterPlacePurchaseOrderCounter)
+}
+
+// PlacePurchaseOrderBeforeCounter returns a count of StockServiceUseCaseMock.PlacePurchaseOrder invocations
+func (mmPlacePurchaseOrder *StockServiceUseCaseMock) PlacePurchaseOrderBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPlacePurchaseOrder.beforePlacePurchaseOrderCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.PlacePurchaseOrder.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPlacePurchaseOrder *mStockServiceUseCaseMockPlacePurchaseOrder) Calls() []*StockServiceUseCaseMockPlacePurchaseOrderParams {
+	mmPlacePurchaseOrder.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockPlacePurchaseOrderParams, len(mmPlacePurchaseOrder.callArgs))
+	copy(argCopy, mmPlacePurchaseOrder.callArgs)
+
+	mmPlacePurchaseOrder.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPlacePurchaseOrderDone returns true if the count of the PlacePurchaseOrder invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockPlacePurchaseOrderDone() bool {
+	if m.PlacePurchaseOrderMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PlacePurchaseOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PlacePurchaseOrderMock.invocationsDone()
+}
+
+// MinimockPlacePurchaseOrderInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockPlacePurchaseOrderInspect() {
+	for _, e := range m.PlacePurchaseOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.PlacePurchaseOrder at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPlacePurchaseOrderCounter := mm_atomic.LoadUint64(&m.afterPlacePurchaseOrderCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PlacePurchaseOrderMock.defaultExpectation != nil && afterPlacePurchaseOrderCounter < 1 {
+		if m.PlacePurchaseOrderMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.PlacePurchaseOrder at\n%s", m.PlacePurchaseOrderMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.PlacePurchaseOrder at\n%s with params: %#v", m.PlacePurchaseOrderMock.defaultExpectation.expectationOrigins.origin, *m.PlacePurchaseOrderMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPlacePurchaseOrder != nil && afterPlacePurchaseOrderCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.PlacePurchaseOrder at\n%s", m.funcPlacePurchaseOrderOrigin)
+	}
+
+	if !m.PlacePurchaseOrderMock.invocationsDone() && afterPlacePurchaseOrderCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.PlacePurchaseOrder at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PlacePurchaseOrderMock.expectedInvocations), m.PlacePurchaseOrderMock.expectedInvocationsOrigin, afterPlacePurchaseOrderCounter)
+	}
+}
+
+type mStockServiceUseCaseMockPurgeDeletedStockItems struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockPurgeDeletedStockItemsExpectation
+	expectations       []*StockServiceUseCaseMockPurgeDeletedStockItemsExpectation
+
+	callArgs []*StockServiceUseCaseMockPurgeDeletedStockItemsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockPurgeDeletedStockItemsExpectation specifies expectation struct of the StockServiceUseCase.PurgeDeletedStockItems
+type StockServiceUseCaseMockPurgeDeletedStockItemsExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockPurgeDeletedStockItemsParams
+	paramPtrs          *StockServiceUseCaseMockPurgeDeletedStockItemsParamPtrs
+	expectationOrigins StockServiceUseCaseMockPurgeDeletedStockItemsExpectationOrigins
+	results            *StockServiceUseCaseMockPurgeDeletedStockItemsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockPurgeDeletedStockItemsParams contains parameters of the StockServiceUseCase.PurgeDeletedStockItems
+type StockServiceUseCaseMockPurgeDeletedStockItemsParams struct {
+	ctx       context.Context
+	retention time.Duration
+}
+
+// StockServiceUseCaseMockPurgeDeletedStockItemsParamPtrs contains pointers to parameters of the StockServiceUseCase.PurgeDeletedStockItems
+type StockServiceUseCaseMockPurgeDeletedStockItemsParamPtrs struct {
+	ctx       *context.Context
+	retention *time.Duration
+}
+
+// StockServiceUseCaseMockPurgeDeletedStockItemsResults contains results of the StockServiceUseCase.PurgeDeletedStockItems
+type StockServiceUseCaseMockPurgeDeletedStockItemsResults struct {
+	err error
+}
+
+// StockServiceUseCaseMockPurgeDeletedStockItemsOrigins contains origins of expectations of the StockServiceUseCase.PurgeDeletedStockItems
+type StockServiceUseCaseMockPurgeDeletedStockItemsExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originRetention string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeDeletedStockItems *mStockServiceUseCaseMockPurgeDeletedStockItems) Optional() *mStockServiceUseCaseMockPurgeDeletedStockItems {
+	mmPurgeDeletedStockItems.optional = true
+	return mmPurgeDeletedStockItems
+}
+
+// Expect sets up expected params for StockServiceUseCase.PurgeDeletedStockItems
+func (mmPurgeDeletedStockItems *mStockServiceUseCaseMockPurgeDeletedStockItems) Expect(ctx context.Context, retention time.Duration) *mStockServiceUseCaseMockPurgeDeletedStockItems {
+	if mmPurgeDeletedStockItems.mock.funcPurgeDeletedStockItems != nil {
+		mmPurgeDeletedStockItems.mock.t.Fatalf("StockServiceUseCaseMock.PurgeDeletedStockItems mock is already set by Set")
+	}
+
+	if mmPurgeDeletedStockItems.defaultExpectation == nil {
+		mmPurgeDeletedStockItems.defaultExpectation = &StockServiceUseCaseMockPurgeDeletedStockItemsExpectation{}
+	}
+
+	if mmPurgeDeletedStockItems.defaultExpectation.paramPtrs != nil {
+		mmPurgeDeletedStockItems.mock.t.Fatalf("StockServiceUseCaseMock.PurgeDeletedStockItems mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeDeletedStockItems.defaultExpectation.params = &StockServiceUseCaseMockPurgeDeletedStockItemsParams{ctx, retention}
+	mmPurgeDeletedStockItems.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeDeletedStockItems.expectations {
+		if minimock.Equal(e.params, mmPurgeDeletedStockItems.defaultExpectation.params) {
+			mmPurgeDeletedStockItems.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeDeletedStockItems.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeDeletedStockItems
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.PurgeDeletedStockItems
+func (mmPurgeDeletedStockItems *mStockServiceUseCaseMockPurgeDeletedStockItems) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockPurgeDeletedStockItems {
+	if mmPurgeDeletedStockItems.mock.funcPurgeDeletedStockItems != nil {
+		mmPurgeDeletedStockItems.mock.t.Fatalf("StockServiceUseCaseMock.PurgeDeletedStockItems mock is already set by Set")
+	}
+
+	if mmPurgeDeletedStockItems.defaultExpectation == nil {
+		mmPurgeDeletedStockItems.defaultExpectation = &StockServiceUseCaseMockPurgeDeletedStockItemsExpectation{}
+	}
+
+	if mmPurgeDeletedStockItems.defaultExpectation.params != nil {
+		mmPurgeDeletedStockItems.mock.t.Fatalf("StockServiceUseCaseMock.PurgeDeletedStockItems mock is already set by Expect")
+	}
+
+	if mmPurgeDeletedStockItems.defaultExpectation.paramPtrs == nil {
+		mmPurgeDeletedStockItems.defaultExpectation.paramPtrs = &StockServiceUseCaseMockPurgeDeletedStockItemsParamPtrs{}
+	}
+	mmPurgeDeletedStockItems.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeDeletedStockItems.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeDeletedStockItems
+}
+
+// ExpectRetentionParam2 sets up expected param retention for StockServiceUseCase.PurgeDeletedStockItems
+func (mmPurgeDeletedStockItems *mStockServiceUseCaseMockPurgeDeletedStockItems) ExpectRetentionParam2(retention time.Duration) *mStockServiceUseCaseMockPurgeDeletedStockItems {
+	if mmPurgeDeletedStockItems.mock.funcPurgeDeletedStockItems != nil {
+		mmPurgeDeletedStockItems.mock.t.Fatalf("StockServiceUseCaseMock.PurgeDeletedStockItems mock is already set by Set")
+	}
+
+	if mmPurgeDeletedStockItems.defaultExpectation == nil {
+		mmPurgeDeletedStockItems.defaultExpectation = &StockServiceUseCaseMockPurgeDeletedStockItemsExpectation{}
+	}
+
+	if mmPurgeDeletedStockItems.defaultExpectation.params != nil {
+		mmPurgeDeletedStockItems.mock.t.Fatalf("StockServiceUseCaseMock.PurgeDeletedStockItems mock is already set by Expect")
+	}
+
+	if mmPurgeDeletedStockItems.defaultExpectation.paramPtrs == nil {
+		mmPurgeDeletedStockItems.defaultExpectation.paramPtrs = &StockServiceUseCaseMockPurgeDeletedStockItemsParamPtrs{}
+	}
+	mmPurgeDeletedStockItems.defaultExpectation.paramPtrs.retention = &retention
+	mmPurgeDeletedStockItems.defaultExpectation.expectationOrigins.originRetention = minimock.CallerInfo(1)
+
+	return mmPurgeDeletedStockItems
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.PurgeDeletedStockItems
+func (mmPurgeDeletedStockItems *mStockServiceUseCaseMockPurgeDeletedStockItems) Inspect(f func(ctx context.Context, retention time.Duration)) *mStockServiceUseCaseMockPurgeDeletedStockItems {
+	if mmPurgeDeletedStockItems.mock.inspectFuncPurgeDeletedStockItems != nil {
+		mmPurgeDeletedStockItems.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.PurgeDeletedStockItems")
+	}
+
+	mmPurgeDeletedStockItems.mock.inspectFuncPurgeDeletedStockItems = f
+
+	return mmPurgeDeletedStockItems
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.PurgeDeletedStockItems
+func (mmPurgeDeletedStockItems *mStockServiceUseCaseMockPurgeDeletedStockItems) Return(err error) *StockServiceUseCaseMock {
+	if mmPurgeDeletedStockItems.mock.funcPurgeDeletedStockItems != nil {
+		mmPurgeDeletedStockItems.mock.t.Fatalf("StockServiceUseCaseMock.PurgeDeletedStockItems mock is already set by Set")
+	}
+
+	if mmPurgeDeletedStockItems.defaultExpectation == nil {
+		mmPurgeDeletedStockItems.defaultExpectation = &StockServiceUseCaseMockPurgeDeletedStockItemsExpectation{mock: mmPurgeDeletedStockItems.mock}
+	}
+	mmPurgeDeletedStockItems.defaultExpectation.results = &StockServiceUseCaseMockPurgeDeletedStockItemsResults{err}
+	mmPurgeDeletedStockItems.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeletedStockItems.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.PurgeDeletedStockItems method
+func (mmPurgeDeletedStockItems *mStockServiceUseCaseMockPurgeDeletedStockItems) Set(f func(ctx context.Context, retention time.Duration) (err error)) *StockServiceUseCaseMock {
+	if mmPurgeDeletedStockItems.defaultExpectation != nil {
+		mmPurgeDeletedStockItems.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.PurgeDeletedStockItems method")
+	}
+
+	if len(mmPurgeDeletedStockItems.expectations) > 0 {
+		mmPurgeDeletedStockItems.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.PurgeDeletedStockItems method")
+	}
+
+	mmPurgeDeletedStockItems.mock.funcPurgeDeletedStockItems = f
+	mmPurgeDeletedStockItems.mock.funcPurgeDeletedStockItemsOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeletedStockItems.mock
+}
+
+// When sets expectation for the StockServiceUseCase.PurgeDeletedStockItems which will trigger the result defined by the following
+// Then helper
+func (mmPurgeDeletedStockItems *mStockServiceUseCaseMockPurgeDeletedStockItems) When(ctx context.Context, retention time.Duration) *StockServiceUseCaseMockPurgeDeletedStockItemsExpectation {
+	if mmPurgeDeletedStockItems.mock.funcPurgeDeletedStockItems != nil {
+		mmPurgeDeletedStockItems.mock.t.Fatalf("StockServiceUseCaseMock.PurgeDeletedStockItems mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockPurgeDeletedStockItemsExpectation{
+		mock:               mmPurgeDeletedStockItems.mock,
+		params:             &StockServiceUseCaseMockPurgeDeletedStockItemsParams{ctx, retention},
+		expectationOrigins: StockServiceUseCaseMockPurgeDeletedStockItemsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeDeletedStockItems.expectations = append(mmPurgeDeletedStockItems.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.PurgeDeletedStockItems return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockPurgeDeletedStockItemsExpectation) Then(err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockPurgeDeletedStockItemsResults{err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.PurgeDeletedStockItems should be invoked
+func (mmPurgeDeletedStockItems *mStockServiceUseCaseMockPurgeDeletedStockItems) Times(n uint64) *mStockServiceUseCaseMockPurgeDeletedStockItems {
+	if n == 0 {
+		mmPurgeDeletedStockItems.mock.t.Fatalf("Times of StockServiceUseCaseMock.PurgeDeletedStockItems mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeDeletedStockItems.expectedInvocations, n)
+	mmPurgeDeletedStockItems.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeDeletedStockItems
+}
+
+func (mmPurgeDeletedStockItems *mStockServiceUseCaseMockPurgeDeletedStockItems) invocationsDone() bool {
+	if len(mmPurgeDeletedStockItems.expectations) == 0 && mmPurgeDeletedStockItems.defaultExpectation == nil && mmPurgeDeletedStockItems.mock.funcPurgeDeletedStockItems == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeDeletedStockItems.mock.afterPurgeDeletedStockItemsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeDeletedStockItems.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeDeletedStockItems implements mm_usecase.StockServiceUseCase
+func (mmPurgeDeletedStockItems *StockServiceUseCaseMock) PurgeDeletedStockItems(ctx context.Context, retention time.Duration) (err error) {
+	mm_atomic.AddUint64(&mmPurgeDeletedStockItems.beforePurgeDeletedStockItemsCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeDeletedStockItems.afterPurgeDeletedStockItemsCounter, 1)
+
+	mmPurgeDeletedStockItems.t.Helper()
+
+	if mmPurgeDeletedStockItems.inspectFuncPurgeDeletedStockItems != nil {
+		mmPurgeDeletedStockItems.inspectFuncPurgeDeletedStockItems(ctx, retention)
+	}
+
+	mm_params := StockServiceUseCaseMockPurgeDeletedStockItemsParams{ctx, retention}
+
+	// Record call args
+	mmPurgeDeletedStockItems.PurgeDeletedStockItemsMock.mutex.Lock()
+	mmPurgeDeletedStockItems.PurgeDeletedStockItemsMock.callArgs = append(mmPurgeDeletedStockItems.PurgeDeletedStockItemsMock.callArgs, &mm_params)
+	mmPurgeDeletedStockItems.PurgeDeletedStockItemsMock.mutex.Unlock()
+
+	for _, e := range mmPurgeDeletedStockItems.PurgeDeletedStockItemsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPurgeDeletedStockItems.PurgeDeletedStockItemsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeDeletedStockItems.PurgeDeletedStockItemsMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeDeletedStockItems.PurgeDeletedStockItemsMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeDeletedStockItems.PurgeDeletedStockItemsMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockPurgeDeletedStockItemsParams{ctx, retention}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeDeletedStockItems.t.Errorf("StockServiceUseCaseMock.PurgeDeletedStockItems got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeDeletedStockItems.PurgeDeletedStockItemsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.retention != nil && !minimock.Equal(*mm_want_ptrs.retention, mm_got.retention) {
+				mmPurgeDeletedStockItems.t.Errorf("StockServiceUseCaseMock.PurgeDeletedStockItems got unexpected parameter retention, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeDeletedStockItems.PurgeDeletedStockItemsMock.defaultExpectation.expectationOrigins.originRetention, *mm_want_ptrs.retention, mm_got.retention, minimock.Diff(*mm_want_ptrs.retention, mm_got.retention))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeDeletedStockItems.t.Errorf("StockServiceUseCaseMock.PurgeDeletedStockItems got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeDeletedStockItems.PurgeDeletedStockItemsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeDeletedStockItems.PurgeDeletedStockItemsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeDeletedStockItems.t.Fatal("No results are set for the StockServiceUseCaseMock.PurgeDeletedStockItems")
+		}
+		return (*mm_results).err
+	}
+	if mmPurgeDeletedStockItems.funcPurgeDeletedStockItems != nil {
+		return mmPurgeDeletedStockItems.funcPurgeDeletedStockItems(ctx, retention)
+	}
+	mmPurgeDeletedStockItems.t.Fatalf("Unexpected call to StockServiceUseCaseMock.PurgeDeletedStockItems. %v %v", ctx, retention)
+	return
+}
+
+// PurgeDeletedStockItemsAfterCounter returns a count of finished StockServiceUseCaseMock.PurgeDeletedStockItems invocations
+func (mmPurgeDeletedStockItems *StockServiceUseCaseMock) PurgeDeletedStockItemsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeDeletedStockItems.afterPurgeDeletedStockItemsCounter)
+}
+
+// PurgeDeletedStockItemsBeforeCounter returns a count of StockServiceUseCaseMock.PurgeDeletedStockItems invocations
+func (mmPurgeDeletedStockItems *StockServiceUseCaseMock) PurgeDeletedStockItemsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeDeletedStockItems.beforePurgeDeletedStockItemsCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.PurgeDeletedStockItems.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeDeletedStockItems *mStockServiceUseCaseMockPurgeDeletedStockItems) Calls() []*StockServiceUseCaseMockPurgeDeletedStockItemsParams {
+	mmPurgeDeletedStockItems.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockPurgeDeletedStockItemsParams, len(mmPurgeDeletedStockItems.callArgs))
+	copy(argCopy, mmPurgeDeletedStockItems.callArgs)
+
+	mmPurgeDeletedStockItems.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeDeletedStockItemsDone returns true if the count of the PurgeDeletedStockItems invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockPurgeDeletedStockItemsDone() bool {
+	if m.PurgeDeletedStockItemsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeDeletedStockItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeDeletedStockItemsMock.invocationsDone()
+}
+
+// MinimockPurgeDeletedStockItemsInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockPurgeDeletedStockItemsInspect() {
+	for _, e := range m.PurgeDeletedStockItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.PurgeDeletedStockItems at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeDeletedStockItemsCounter := mm_atomic.LoadUint64(&m.afterPurgeDeletedStockItemsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeDeletedStockItemsMock.defaultExpectation != nil && afterPurgeDeletedStockItemsCounter < 1 {
+		if m.PurgeDeletedStockItemsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.PurgeDeletedStockItems at\n%s", m.PurgeDeletedStockItemsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.PurgeDeletedStockItems at\n%s with params: %#v", m.PurgeDeletedStockItemsMock.defaultExpectation.expectationOrigins.origin, *m.PurgeDeletedStockItemsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeDeletedStockItems != nil && afterPurgeDeletedStockItemsCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.PurgeDeletedStockItems at\n%s", m.funcPurgeDeletedStockItemsOrigin)
+	}
+
+	if !m.PurgeDeletedStockItemsMock.invocationsDone() && afterPurgeDeletedStockItemsCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.PurgeDeletedStockItems at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeDeletedStockItemsMock.expectedInvocations), m.PurgeDeletedStockItemsMock.expectedInvocationsOrigin, afterPurgeDeletedStockItemsCounter)
+	}
+}
+
+type mStockServiceUseCaseMockReceivePurchaseOrder struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockReceivePurchaseOrderExpectation
+	expectations       []*StockServiceUseCaseMockReceivePurchaseOrderExpectation
+
+	callArgs []*StockServiceUseCaseMockReceivePurchaseOrderParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockReceivePurchaseOrderExpectation specifies expectation struct of the StockServiceUseCase.ReceivePurchaseOrder
+type StockServiceUseCaseMockReceivePurchaseOrderExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockReceivePurchaseOrderParams
+	paramPtrs          *StockServiceUseCaseMockReceivePurchaseOrderParamPtrs
+	expectationOrigins StockServiceUseCaseMockReceivePurchaseOrderExpectationOrigins
+	results            *StockServiceUseCaseMockReceivePurchaseOrderResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockReceivePurchaseOrderParams contains parameters of the StockServiceUseCase.ReceivePurchaseOrder
+type StockServiceUseCaseMockReceivePurchaseOrderParams struct {
+	ctx     context.Context
+	receipt domain.PurchaseOrderReceipt
+}
+
+// StockServiceUseCaseMockReceivePurchaseOrderParamPtrs contains pointers to parameters of the StockServiceUseCase.ReceivePurchaseOrder
+type StockServiceUseCaseMockReceivePurchaseOrderParamPtrs struct {
+	ctx     *context.Context
+	receipt *domain.PurchaseOrderReceipt
+}
+
+// StockServiceUseCaseMockReceivePurchaseOrderResults contains results of the StockServiceUseCase.ReceivePurchaseOrder
+type StockServiceUseCaseMockReceivePurchaseOrderResults struct {
+	p1  domain.PurchaseOrder
+	err error
+}
+
+// StockServiceUseCaseMockReceivePurchaseOrderOrigins contains origins of expectations of the StockServiceUseCase.ReceivePurchaseOrder
+type StockServiceUseCaseMockReceivePurchaseOrderExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originReceipt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReceivePurchaseOrder *mStockServiceUseCaseMockReceivePurchaseOrder) Optional() *mStockServiceUseCaseMockReceivePurchaseOrder {
+	mmReceivePurchaseOrder.optional = true
+	return mmReceivePurchaseOrder
+}
+
+// Expect sets up expected params for StockServiceUseCase.ReceivePurchaseOrder
+func (mmReceivePurchaseOrder *mStockServiceUseCaseMockReceivePurchaseOrder) Expect(ctx context.Context, receipt domain.PurchaseOrderReceipt) *mStockServiceUseCaseMockReceivePurchaseOrder {
+	if mmReceivePurchaseOrder.mock.funcReceivePurchaseOrder != nil {
+		mmReceivePurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.ReceivePurchaseOrder mock is already set by Set")
+	}
+
+	if mmReceivePurchaseOrder.defaultExpectation == nil {
+		mmReceivePurchaseOrder.defaultExpectation = &StockServiceUseCaseMockReceivePurchaseOrderExpectation{}
+	}
+
+	if mmReceivePurchaseOrder.defaultExpectation.paramPtrs != nil {
+		mmReceivePurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.ReceivePurchaseOrder mock is already set by ExpectParams functions")
+	}
+
+	mmReceivePurchaseOrder.defaultExpectation.params = &StockServiceUseCaseMockReceivePurchaseOrderParams{ctx, receipt}
+	mmReceivePurchaseOrder.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReceivePurchaseOrder.expectations {
+		if minimock.Equal(e.params, mmReceivePurchaseOrder.defaultExpectation.params) {
+			mmReceivePurchaseOrder.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReceivePurchaseOrder.defaultExpectation.params)
+		}
+	}
+
+	return mmReceivePurchaseOrder
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.ReceivePurchaseOrder
+func (mmReceivePurchaseOrder *mStockServiceUseCaseMockReceivePurchaseOrder) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockReceivePurchaseOrder {
+	if mmReceivePurchaseOrder.mock.funcReceivePurchaseOrder != nil {
+		mmReceivePurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.ReceivePurchaseOrder mock is already set by Set")
+	}
+
+	if mmReceivePurchaseOrder.defaultExpectation == nil {
+		mmReceivePurchaseOrder.defaultExpectation = &StockServiceUseCaseMockReceivePurchaseOrderExpectation{}
+	}
+
+	if mmReceivePurchaseOrder.defaultExpectation.params != nil {
+		mmReceivePurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.ReceivePurchaseOrder mock is already set by Expect")
+	}
+
+	if mmReceivePurchaseOrder.defaultExpectation.paramPtrs == nil {
+		mmReceivePurchaseOrder.defaultExpectation.paramPtrs = &StockServiceUseCaseMockReceivePurchaseOrderParamPtrs{}
+	}
+	mmReceivePurchaseOrder.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReceivePurchaseOrder.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReceivePurchaseOrder
+}
+
+// ExpectReceiptParam2 sets up expected param receipt for StockServiceUseCase.ReceivePurchaseOrder
+func (mmReceivePurchaseOrder *mStockServiceUseCaseMockReceivePurchaseOrder) ExpectReceiptParam2(receipt domain.PurchaseOrderReceipt) *mStockServiceUseCaseMockReceivePurchaseOrder {
+	if mmReceivePurchaseOrder.mock.funcReceivePurchaseOrder != nil {
+		mmReceivePurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.ReceivePurchaseOrder mock is already set by Set")
+	}
+
+	if mmReceivePurchaseOrder.defaultExpectation == nil {
+		mmReceivePurchaseOrder.defaultExpectation = &StockServiceUseCaseMockReceivePurchaseOrderExpectation{}
+	}
+
+	if mmReceivePurchaseOrder.defaultExpectation.params != nil {
+		mmReceivePurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.ReceivePurchaseOrder mock is already set by Expect")
+	}
+
+	if mmReceivePurchaseOrder.defaultExpectation.paramPtrs == nil {
+		mmReceivePurchaseOrder.defaultExpectation.paramPtrs = &StockServiceUseCaseMockReceivePurchaseOrderParamPtrs{}
+	}
+	mmReceivePurchaseOrder.defaultExpectation.paramPtrs.receipt = &receipt
+	mmReceivePurchaseOrder.defaultExpectation.expectationOrigins.originReceipt = minimock.CallerInfo(1)
+
+	return mmReceivePurchaseOrder
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.ReceivePurchaseOrder
+func (mmReceivePurchaseOrder *mStockServiceUseCaseMockReceivePurchaseOrder) Inspect(f func(ctx context.Context, receipt domain.PurchaseOrderReceipt)) *mStockServiceUseCaseMockReceivePurchaseOrder {
+	if mmReceivePurchaseOrder.mock.inspectFuncReceivePurchaseOrder != nil {
+		mmReceivePurchaseOrder.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.ReceivePurchaseOrder")
+	}
+
+	mmReceivePurchaseOrder.mock.inspectFuncReceivePurchaseOrder = f
+
+	return mmReceivePurchaseOrder
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.ReceivePurchaseOrder
+func (mmReceivePurchaseOrder *mStockServiceUseCaseMockReceivePurchaseOrder) Return(p1 domain.PurchaseOrder, err error) *StockServiceUseCaseMock {
+	if mmReceivePurchaseOrder.mock.funcReceivePurchaseOrder != nil {
+		mmReceivePurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.ReceivePurchaseOrder mock is already set by Set")
+	}
+
+	if mmReceivePurchaseOrder.defaultExpectation == nil {
+		mmReceivePurchaseOrder.defaultExpectation = &StockServiceUseCaseMockReceivePurchaseOrderExpectation{mock: mmReceivePurchaseOrder.mock}
+	}
+	mmReceivePurchaseOrder.defaultExpectation.results = &StockServiceUseCaseMockReceivePurchaseOrderResults{p1, err}
+	mmReceivePurchaseOrder.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReceivePurchaseOrder.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.ReceivePurchaseOrder method
+func (mmReceivePurchaseOrder *mStockServiceUseCaseMockReceivePurchaseOrder) Set(f func(ctx context.Context, receipt domain.PurchaseOrderReceipt) (p1 domain.PurchaseOrder, err error)) *StockServiceUseCaseMock {
+	if mmReceivePurchaseOrder.defaultExpectation != nil {
+		mmReceivePurchaseOrder.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.ReceivePurchaseOrder method")
+	}
+
+	if len(mmReceivePurchaseOrder.expectations) > 0 {
+		mmReceivePurchaseOrder.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.ReceivePurchaseOrder method")
+	}
+
+	mmReceivePurchaseOrder.mock.funcReceivePurchaseOrder = f
+	mmReceivePurchaseOrder.mock.funcReceivePurchaseOrderOrigin = minimock.CallerInfo(1)
+	return mmReceivePurchaseOrder.mock
+}
+
+// When sets expectation for the StockServiceUseCase.ReceivePurchaseOrder which will trigger the result defined by the following
+// Then helper
+func (mmReceivePurchaseOrder *mStockServiceUseCaseMockReceivePurchaseOrder) When(ctx context.Context, receipt domain.PurchaseOrderReceipt) *StockServiceUseCaseMockReceivePurchaseOrderExpectation {
+	if mmReceivePurchaseOrder.mock.funcReceivePurchaseOrder != nil {
+		mmReceivePurchaseOrder.mock.t.Fatalf("StockServiceUseCaseMock.ReceivePurchaseOrder mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockReceivePurchaseOrderExpectation{
+		mock:               mmReceivePurchaseOrder.mock,
+		params:             &StockServiceUseCaseMockReceivePurchaseOrderParams{ctx, receipt},
+		expectationOrigins: StockServiceUseCaseMockReceivePurchaseOrderExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReceivePurchaseOrder.expectations = append(mmReceivePurchaseOrder.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.ReceivePurchaseOrder return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockReceivePurchaseOrderExpectation) Then(p1 domain.PurchaseOrder, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockReceivePurchaseOrderResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.ReceivePurchaseOrder should be invoked
+func (mmReceivePurchaseOrder *mStockServiceUseCaseMockReceivePurchaseOrder) Times(n uint64) *mStockServiceUseCaseMockReceivePurchaseOrder {
+	if n == 0 {
+		mmReceivePurchaseOrder.mock.t.Fatalf("Times of StockServiceUseCaseMock.ReceivePurchaseOrder mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReceivePurchaseOrder.expectedInvocations, n)
+	mmReceivePurchaseOrder.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReceivePurchaseOrder
+}
+
+func (mmReceivePurchaseOrder *mStockServiceUseCaseMockReceivePurchaseOrder) invocationsDone() bool {
+	if len(mmReceivePurchaseOrder.expectations) == 0 && mmReceivePurchaseOrder.defaultExpectation == nil && mmReceivePurchaseOrder.mock.funcReceivePurchaseOrder == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReceivePurchaseOrder.mock.afterReceivePurchaseOrderCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReceivePurchaseOrder.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReceivePurchaseOrder implements mm_usecase.StockServiceUseCase
+func (mmReceivePurchaseOrder *StockServiceUseCaseMock) ReceivePurchaseOrder(ctx context.Context, receipt domain.PurchaseOrderReceipt) (p1 domain.PurchaseOrder, err error) {
+	mm_atomic.AddUint64(&mmReceivePurchaseOrder.beforeReceivePurchaseOrderCounter, 1)
+	defer mm_atomic.AddUint64(&mmReceivePurchaseOrder.afterReceivePurchaseOrderCounter, 1)
+
+	mmReceivePurchaseOrder.t.Helper()
+
+	if mmReceivePurchaseOrder.inspectFuncReceivePurchaseOrder != nil {
+		mmReceivePurchaseOrder.inspectFuncReceivePurchaseOrder(ctx, receipt)
+	}
+
+	mm_params := StockServiceUseCaseMockReceivePurchaseOrderParams{ctx, receipt}
+
+	// Record call args
+	mmReceivePurchaseOrder.ReceivePurchaseOrderMock.mutex.Lock()
+	mmReceivePurchaseOrder.ReceivePurchaseOrderMock.callArgs = append(mmReceivePurchaseOrder.ReceivePurchaseOrderMock.callArgs, &mm_params)
+	mmReceivePurchaseOrder.ReceivePurchaseOrderMock.mutex.Unlock()
+
+	for _, e := range mmReceivePurchaseOrder.ReceivePurchaseOrderMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmReceivePurchaseOrder.ReceivePurchaseOrderMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReceivePurchaseOrder.ReceivePurchaseOrderMock.defaultExpectation.Counter, 1)
+		mm_want := mmReceivePurchaseOrder.ReceivePurchaseOrderMock.defaultExpectation.params
+		mm_want_ptrs := mmReceivePurchaseOrder.ReceivePurchaseOrderMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockReceivePurchaseOrderParams{ctx, receipt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReceivePurchaseOrder.t.Errorf("StockServiceUseCaseMock.ReceivePurchaseOrder got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReceivePurchaseOrder.ReceivePurchaseOrderMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.receipt != nil && !minimock.Equal(*mm_want_ptrs.receipt, mm_got.receipt) {
+				mmReceivePurchaseOrder.t.Errorf("StockServiceUseCaseMock.ReceivePurchaseOrder got unexpected parameter receipt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReceivePurchaseOrder.ReceivePurchaseOrderMock.defaultExpectation.expectationOrigins.originReceipt, *mm_want_ptrs.receipt, mm_got.receipt, minimock.Diff(*mm_want_ptrs.receipt, mm_got.receipt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReceivePurchaseOrder.t.Errorf("StockServiceUseCaseMock.ReceivePurchaseOrder got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReceivePurchaseOrder.ReceivePurchaseOrderMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReceivePurchaseOrder.ReceivePurchaseOrderMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReceivePurchaseOrder.t.Fatal("No results are set for the StockServiceUseCaseMock.ReceivePurchaseOrder")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmReceivePurchaseOrder.funcReceivePurchaseOrder != nil {
+		return mmReceivePurchaseOrder.funcReceivePurchaseOrder(ctx, receipt)
+	}
+	mmReceivePurchaseOrder.t.Fatalf("Unexpected call to StockServiceUseCaseMock.ReceivePurchaseOrder. %v %v", ctx, receipt)
+	return
+}
+
+// ReceivePurchaseOrderAfterCounter returns a count of finished StockServiceUseCaseMock.ReceivePurchaseOrder invocations
+func (mmReceivePurchaseOrder *StockServiceUseCaseMock) ReceivePurchaseOrderAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReceivePurchaseOrder.afterReceivePurchaseOrderCounter)
+}
+
+// ReceivePurchaseOrderBeforeCounter returns a count of StockServiceUseCaseMock.ReceivePurchaseOrder invocations
+func (mmReceivePurchaseOrder *StockServiceUseCaseMock) ReceivePurchaseOrderBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReceivePurchaseOrder.beforeReceivePurchaseOrderCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.ReceivePurchaseOrder.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReceivePurchaseOrder *mStockServiceUseCaseMockReceivePurchaseOrder) Calls() []*StockServiceUseCaseMockReceivePurchaseOrderParams {
+	mmReceivePurchaseOrder.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockReceivePurchaseOrderParams, len(mmReceivePurchaseOrder.callArgs))
+	copy(argCopy, mmReceivePurchaseOrder.callArgs)
+
+	mmReceivePurchaseOrder.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReceivePurchaseOrderDone returns true if the count of the ReceivePurchaseOrder invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockReceivePurchaseOrderDone() bool {
+	if m.ReceivePurchaseOrderMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReceivePurchaseOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReceivePurchaseOrderMock.invocationsDone()
+}
+
+// MinimockReceivePurchaseOrderInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockReceivePurchaseOrderInspect() {
+	for _, e := range m.ReceivePurchaseOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ReceivePurchaseOrder at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReceivePurchaseOrderCounter := mm_atomic.LoadUint64(&m.afterReceivePurchaseOrderCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReceivePurchaseOrderMock.defaultExpectation != nil && afterReceivePurchaseOrderCounter < 1 {
+		if m.ReceivePurchaseOrderMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ReceivePurchaseOrder at\n%s", m.ReceivePurchaseOrderMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ReceivePurchaseOrder at\n%s with params: %#v", m.ReceivePurchaseOrderMock.defaultExpectation.expectationOrigins.origin, *m.ReceivePurchaseOrderMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReceivePurchaseOrder != nil && afterReceivePurchaseOrderCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.ReceivePurchaseOrder at\n%s", m.funcReceivePurchaseOrderOrigin)
+	}
+
+	if !m.ReceivePurchaseOrderMock.invocationsDone() && afterReceivePurchaseOrderCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.ReceivePurchaseOrder at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReceivePurchaseOrderMock.expectedInvocations), m.ReceivePurchaseOrderMock.expectedInvocationsOrigin, afterReceivePurchaseOrderCounter)
+	}
+}
+
+type mStockServiceUseCaseMockReceiveTransfer struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockReceiveTransferExpectation
+	expectations       []*StockServiceUseCaseMockReceiveTransferExpectation
+
+	callArgs []*StockServiceUseCaseMockReceiveTransferParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockReceiveTransferExpectation specifies expectation struct of the StockServiceUseCase.ReceiveTransfer
+type StockServiceUseCaseMockReceiveTransferExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockReceiveTransferParams
+	paramPtrs          *StockServiceUseCaseMockReceiveTransferParamPtrs
+	expectationOrigins StockServiceUseCaseMockReceiveTransferExpectationOrigins
+	results            *StockServiceUseCaseMockReceiveTransferResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockReceiveTransferParams contains parameters of the StockServiceUseCase.ReceiveTransfer
+type StockServiceUseCaseMockReceiveTransferParams struct {
+	ctx        context.Context
+	userID     domain.UserID
+	transferID domain.TransferID
+}
+
+// StockServiceUseCaseMockReceiveTransferParamPtrs contains pointers to parameters of the StockServiceUseCase.ReceiveTransfer
+type StockServiceUseCaseMockReceiveTransferParamPtrs struct {
+	ctx        *context.Context
+	userID     *domain.UserID
+	transferID *domain.TransferID
+}
+
+// StockServiceUseCaseMockReceiveTransferResults contains results of the StockServiceUseCase.ReceiveTransfer
+type StockServiceUseCaseMockReceiveTransferResults struct {
+	s1  domain.StockTransfer
+	err error
+}
+
+// StockServiceUseCaseMockReceiveTransferOrigins contains origins of expectations of the StockServiceUseCase.ReceiveTransfer
+type StockServiceUseCaseMockReceiveTransferExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originUserID     string
+	originTransferID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReceiveTransfer *mStockServiceUseCaseMockReceiveTransfer) Optional() *mStockServiceUseCaseMockReceiveTransfer {
+	mmReceiveTransfer.optional = true
+	return mmReceiveTransfer
+}
+
+// Expect sets up expected params for StockServiceUseCase.ReceiveTransfer
+func (mmReceiveTransfer *mStockServiceUseCaseMockReceiveTransfer) Expect(ctx context.Context, userID domain.UserID, transferID domain.TransferID) *mStockServiceUseCaseMockReceiveTransfer {
+	if mmReceiveTransfer.mock.funcReceiveTransfer != nil {
+		mmReceiveTransfer.mock.t.Fatalf("StockServiceUseCaseMock.ReceiveTransfer mock is already set by Set")
+	}
+
+	if mmReceiveTransfer.defaultExpectation == nil {
+		mmReceiveTransfer.defaultExpectation = &StockServiceUseCaseMockReceiveTransferExpectation{}
+	}
+
+	if mmReceiveTransfer.defaultExpectation.paramPtrs != nil {
+		mmReceiveTransfer.mock.t.Fatalf("StockServiceUseCaseMock.ReceiveTransfer mock is already set by ExpectParams functions")
+	}
+
+	mmReceiveTransfer.defaultExpectation.params = &StockServiceUseCaseMockReceiveTransferParams{ctx, userID, transferID}
+	mmReceiveTransfer.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReceiveTransfer.expectations {
+		if minimock.Equal(e.params, mmReceiveTransfer.defaultExpectation.params) {
+			mmReceiveTransfer.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReceiveTransfer.defaultExpectation.params)
+		}
+	}
+
+	return mmReceiveTransfer
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.ReceiveTransfer
+func (mmReceiveTransfer *mStockServiceUseCaseMockReceiveTransfer) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockReceiveTransfer {
+	if mmReceiveTransfer.mock.funcReceiveTransfer != nil {
+		mmReceiveTransfer.mock.t.Fatalf("StockServiceUseCaseMock.ReceiveTransfer mock is already set by Set")
+	}
+
+	if mmReceiveTransfer.defaultExpectation == nil {
+		mmReceiveTransfer.defaultExpectation = &StockServiceUseCaseMockReceiveTransferExpectation{}
+	}
+
+	if mmReceiveTransfer.defaultExpectation.params != nil {
+		mmReceiveTransfer.mock.t.Fatalf("StockServiceUseCaseMock.ReceiveTransfer mock is already set by Expect")
+	}
+
+	if mmReceiveTransfer.defaultExpectation.paramPtrs == nil {
+		mmReceiveTransfer.defaultExpectation.paramPtrs = &StockServiceUseCaseMockReceiveTransferParamPtrs{}
+	}
+	mmReceiveTransfer.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReceiveTransfer.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReceiveTransfer
+}
+
+// ExpectUserIDParam2 sets up expected param userID for StockServiceUseCase.ReceiveTransfer
+func (mmReceiveTransfer *mStockServiceUseCaseMockReceiveTransfer) ExpectUserIDParam2(userID domain.UserID) *mStockServiceUseCaseMockReceiveTransfer {
+	if mmReceiveTransfer.mock.funcReceiveTransfer != nil {
+		mmReceiveTransfer.mock.t.Fatalf("StockServiceUseCaseMock.ReceiveTransfer mock is already set by Set")
+	}
+
+	if mmReceiveTransfer.defaultExpectation == nil {
+		mmReceiveTransfer.defaultExpectation = &StockServiceUseCaseMockReceiveTransferExpectation{}
+	}
+
+	if mmReceiveTransfer.defaultExpectation.params != nil {
+		mmReceiveTransfer.mock.t.Fatalf("StockServiceUseCaseMock.ReceiveTransfer mock is already set by Expect")
+	}
+
+	if mmReceiveTransfer.defaultExpectation.paramPtrs == nil {
+		mmReceiveTransfer.defaultExpectation.paramPtrs = &StockServiceUseCaseMockReceiveTransferParamPtrs{}
+	}
+	mmReceiveTransfer.defaultExpectation.paramPtrs.userID = &userID
+	mmReceiveTransfer.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmReceiveTransfer
+}
+
+// ExpectTransferIDParam3 sets up expected param transferID for StockServiceUseCase.ReceiveTransfer
+func (mmReceiveTransfer *mStockServiceUseCaseMockReceiveTransfer) ExpectTransferIDParam3(transferID domain.TransferID) *mStockServiceUseCaseMockReceiveTransfer {
+	if mmReceiveTransfer.mock.funcReceiveTransfer != nil {
+		mmReceiveTransfer.mock.t.Fatalf("StockServiceUseCaseMock.ReceiveTransfer mock is already set by Set")
+	}
+
+	if mmReceiveTransfer.defaultExpectation == nil {
+		mmReceiveTransfer.defaultExpectation = &StockServiceUseCaseMockReceiveTransferExpectation{}
+	}
+
+	if mmReceiveTransfer.defaultExpectation.params != nil {
+		mmReceiveTransfer.mock.t.Fatalf("StockServiceUseCaseMock.ReceiveTransfer mock is already set by Expect")
+	}
+
+	if mmReceiveTransfer.defaultExpectation.paramPtrs == nil {
+		mmReceiveTransfer.defaultExpectation.paramPtrs = &StockServiceUseCaseMockReceiveTransferParamPtrs{}
+	}
+	mmReceiveTransfer.defaultExpectation.paramPtrs.transferID = &transferID
+	mmReceiveTransfer.defaultExpectation.expectationOrigins.originTransferID = minimock.CallerInfo(1)
+
+	return mmReceiveTransfer
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.ReceiveTransfer
+func (mmReceiveTransfer *mStockServiceUseCaseMockReceiveTransfer) Inspect(f func(ctx context.Context, userID domain.UserID, transferID domain.TransferID)) *mStockServiceUseCaseMockReceiveTransfer {
+	if mmReceiveTransfer.mock.inspectFuncReceiveTransfer != nil {
+		mmReceiveTransfer.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.ReceiveTransfer")
+	}
+
+	mmReceiveTransfer.mock.inspectFuncReceiveTransfer = f
+
+	return mmReceiveTransfer
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.ReceiveTransfer
+func (mmReceiveTransfer *mStockServiceUseCaseMockReceiveTransfer) Return(s1 domain.StockTransfer, err error) *StockServiceUseCaseMock {
+	if mmReceiveTransfer.mock.funcReceiveTransfer != nil {
+		mmReceiveTransfer.mock.t.Fatalf("StockServiceUseCaseMock.ReceiveTransfer mock is already set by Set")
+	}
+
+	if mmReceiveTransfer.defaultExpectation == nil {
+		mmReceiveTransfer.defaultExpectation = &StockServiceUseCaseMockReceiveTransferExpectation{mock: mmReceiveTransfer.mock}
+	}
+	mmReceiveTransfer.defaultExpectation.results = &StockServiceUseCaseMockReceiveTransferResults{s1, err}
+	mmReceiveTransfer.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReceiveTransfer.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.ReceiveTransfer method
+func (mmReceiveTransfer *mStockServiceUseCaseMockReceiveTransfer) Set(f func(ctx context.Context, userID domain.UserID, transferID domain.TransferID) (s1 domain.StockTransfer, err error)) *StockServiceUseCaseMock {
+	if mmReceiveTransfer.defaultExpectation != nil {
+		mmReceiveTransfer.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.ReceiveTransfer method")
+	}
+
+	if len(mmReceiveTransfer.expectations) > 0 {
+		mmReceiveTransfer.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.ReceiveTransfer method")
+	}
+
+	mmReceiveTransfer.mock.funcReceiveTransfer = f
+	mmReceiveTransfer.mock.funcReceiveTransferOrigin = minimock.CallerInfo(1)
+	return mmReceiveTransfer.mock
+}
+
+// When sets expectation for the StockServiceUseCase.ReceiveTransfer which will trigger the result defined by the following
+// Then helper
+func (mmReceiveTransfer *mStockServiceUseCaseMockReceiveTransfer) When(ctx context.Context, userID domain.UserID, transferID domain.TransferID) *StockServiceUseCaseMockReceiveTransferExpectation {
+	if mmReceiveTransfer.mock.funcReceiveTransfer != nil {
+		mmReceiveTransfer.mock.t.Fatalf("StockServiceUseCaseMock.ReceiveTransfer mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockReceiveTransferExpectation{
+		mock:               mmReceiveTransfer.mock,
+		params:             &StockServiceUseCaseMockReceiveTransferParams{ctx, userID, transferID},
+		expectationOrigins: StockServiceUseCaseMockReceiveTransferExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReceiveTransfer.expectations = append(mmReceiveTransfer.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.ReceiveTransfer return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockReceiveTransferExpectation) Then(s1 domain.StockTransfer, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockReceiveTransferResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.ReceiveTransfer should be invoked
+func (mmReceiveTransfer *mStockServiceUseCaseMockReceiveTransfer) Times(n uint64) *mStockServiceUseCaseMockReceiveTransfer {
+	if n == 0 {
+		mmReceiveTransfer.mock.t.Fatalf("Times of StockServiceUseCaseMock.ReceiveTransfer mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReceiveTransfer.expectedInvocations, n)
+	mmReceiveTransfer.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReceiveTransfer
+}
+
+func (mmReceiveTransfer *mStockServiceUseCaseMockReceiveTransfer) invocationsDone() bool {
+	if len(mmReceiveTransfer.expectations) == 0 && mmReceiveTransfer.defaultExpectation == nil && mmReceiveTransfer.mock.funcReceiveTransfer == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReceiveTransfer.mock.afterReceiveTransferCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReceiveTransfer.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReceiveTransfer implements mm_usecase.StockServiceUseCase
+func (mmReceiveTransfer *StockServiceUseCaseMock) ReceiveTransfer(ctx context.Context, userID domain.UserID, transferID domain.TransferID) (s1 domain.StockTransfer, err error) {
+	mm_atomic.AddUint64(&mmReceiveTransfer.beforeReceiveTransferCounter, 1)
+	defer mm_atomic.AddUint64(&mmReceiveTransfer.afterReceiveTransferCounter, 1)
+
+	mmReceiveTransfer.t.Helper()
+
+	if mmReceiveTransfer.inspectFuncReceiveTransfer != nil {
+		mmReceiveTransfer.inspectFuncReceiveTransfer(ctx, userID, transferID)
+	}
+
+	mm_params := StockServiceUseCaseMockReceiveTransferParams{ctx, userID, transferID}
+
+	// Record call args
+	mmReceiveTransfer.ReceiveTransferMock.mutex.Lock()
+	mmReceiveTransfer.ReceiveTransferMock.callArgs = append(mmReceiveTransfer.ReceiveTransferMock.callArgs, &mm_params)
+	mmReceiveTransfer.ReceiveTransferMock.mutex.Unlock()
+
+	for _, e := range mmReceiveTransfer.ReceiveTransferMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmReceiveTransfer.ReceiveTransferMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReceiveTransfer.ReceiveTransferMock.defaultExpectation.Counter, 1)
+		mm_want := mmReceiveTransfer.ReceiveTransferMock.defaultExpectation.params
+		mm_want_ptrs := mmReceiveTransfer.ReceiveTransferMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockReceiveTransferParams{ctx, userID, transferID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReceiveTransfer.t.Errorf("StockServiceUseCaseMock.ReceiveTransfer got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReceiveTransfer.ReceiveTransferMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmReceiveTransfer.t.Errorf("StockServiceUseCaseMock.ReceiveTransfer got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReceiveTransfer.ReceiveTransferMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.transferID != nil && !minimock.Equal(*mm_want_ptrs.transferID, mm_got.transferID) {
+				mmReceiveTransfer.t.Errorf("StockServiceUseCaseMock.ReceiveTransfer got unexpected parameter transferID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReceiveTransfer.ReceiveTransferMock.defaultExpectation.expectationOrigins.originTransferID, *mm_want_ptrs.transferID, mm_got.transferID, minimock.Diff(*mm_want_ptrs.transferID, mm_got.transferID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReceiveTransfer.t.Errorf("StockServiceUseCaseMock.ReceiveTransfer got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReceiveTransfer.ReceiveTransferMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReceiveTransfer.ReceiveTransferMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReceiveTransfer.t.Fatal("No results are set for the StockServiceUseCaseMock.ReceiveTransfer")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmReceiveTransfer.funcReceiveTransfer != nil {
+		return mmReceiveTransfer.funcReceiveTransfer(ctx, userID, transferID)
+	}
+	mmReceiveTransfer.t.Fatalf("Unexpected call to StockServiceUseCaseMock.ReceiveTransfer. %v %v %v", ctx, userID, transferID)
+	return
+}
+
+// ReceiveTransferAfterCounter returns a count of finished StockServiceUseCaseMock.ReceiveTransfer invocations
+func (mmReceiveTransfer *StockServiceUseCaseMock) ReceiveTransferAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReceiveTransfer.afterReceiveTransferCounter)
+}
+
+// ReceiveTransferBeforeCounter returns a count of StockServiceUseCaseMock.ReceiveTransfer invocations
+func (mmReceiveTransfer *StockServiceUseCaseMock) ReceiveTransferBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReceiveTransfer.beforeReceiveTransferCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.ReceiveTransfer.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReceiveTransfer *mStockServiceUseCaseMockReceiveTransfer) Calls() []*StockServiceUseCaseMockReceiveTransferParams {
+	mmReceiveTransfer.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockReceiveTransferParams, len(mmReceiveTransfer.callArgs))
+	copy(argCopy, mmReceiveTransfer.callArgs)
+
+	mmReceiveTransfer.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReceiveTransferDone returns true if the count of the ReceiveTransfer invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockReceiveTransferDone() bool {
+	if m.ReceiveTransferMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReceiveTransferMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReceiveTransferMock.invocationsDone()
+}
+
+// MinimockReceiveTransferInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockReceiveTransferInspect() {
+	for _, e := range m.ReceiveTransferMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ReceiveTransfer at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReceiveTransferCounter := mm_atomic.LoadUint64(&m.afterReceiveTransferCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReceiveTransferMock.defaultExpectation != nil && afterReceiveTransferCounter < 1 {
+		if m.ReceiveTransferMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ReceiveTransfer at\n%s", m.ReceiveTransferMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ReceiveTransfer at\n%s with params: %#v", m.ReceiveTransferMock.defaultExpectation.expectationOrigins.origin, *m.ReceiveTransferMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReceiveTransfer != nil && afterReceiveTransferCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.ReceiveTransfer at\n%s", m.funcReceiveTransferOrigin)
+	}
+
+	if !m.ReceiveTransferMock.invocationsDone() && afterReceiveTransferCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.ReceiveTransfer at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReceiveTransferMock.expectedInvocations), m.ReceiveTransferMock.expectedInvocationsOrigin, afterReceiveTransferCounter)
+	}
+}
+
+type mStockServiceUseCaseMockRecordCartDemand struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockRecordCartDemandExpectation
+	expectations       []*StockServiceUseCaseMockRecordCartDemandExpectation
+
+	callArgs []*StockServiceUseCaseMockRecordCartDemandParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockRecordCartDemandExpectation specifies expectation struct of the StockServiceUseCase.RecordCartDemand
+type StockServiceUseCaseMockRecordCartDemandExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockRecordCartDemandParams
+	paramPtrs          *StockServiceUseCaseMockRecordCartDemandParamPtrs
+	expectationOrigins StockServiceUseCaseMockRecordCartDemandExpectationOrigins
+	results            *StockServiceUseCaseMockRecordCartDemandResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockRecordCartDemandParams contains parameters of the StockServiceUseCase.RecordCartDemand
+type StockServiceUseCaseMockRecordCartDemandParams struct {
+	ctx    context.Context
+	demand domain.CartDemand
+}
+
+// StockServiceUseCaseMockRecordCartDemandParamPtrs contains pointers to parameters of the StockServiceUseCase.RecordCartDemand
+type StockServiceUseCaseMockRecordCartDemandParamPtrs struct {
+	ctx    *context.Context
+	demand *domain.CartDemand
+}
+
+// StockServiceUseCaseMockRecordCartDemandResults contains results of the StockServiceUseCase.RecordCartDemand
+type StockServiceUseCaseMockRecordCartDemandResults struct {
+	err error
+}
+
+// StockServiceUseCaseMockRecordCartDemandOrigins contains origins of expectations of the StockServiceUseCase.RecordCartDemand
+type StockServiceUseCaseMockRecordCartDemandExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originDemand string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRecordCartDemand *mStockServiceUseCaseMockRecordCartDemand) Optional() *mStockServiceUseCaseMockRecordCartDemand {
+	mmRecordCartDemand.optional = true
+	return mmRecordCartDemand
+}
+
+// Expect sets up expected params for StockServiceUseCase.RecordCartDemand
+func (mmRecordCartDemand *mStockServiceUseCaseMockRecordCartDemand) Expect(ctx context.Context, demand domain.CartDemand) *mStockServiceUseCaseMockRecordCartDemand {
+	if mmRecordCartDemand.mock.funcRecordCartDemand != nil {
+		mmRecordCartDemand.mock.t.Fatalf("StockServiceUseCaseMock.RecordCartDemand mock is already set by Set")
+	}
+
+	if mmRecordCartDemand.defaultExpectation == nil {
+		mmRecordCartDemand.defaultExpectation = &StockServiceUseCaseMockRecordCartDemandExpectation{}
+	}
+
+	if mmRecordCartDemand.defaultExpectation.paramPtrs != nil {
+		mmRecordCartDemand.mock.t.Fatalf("StockServiceUseCaseMock.RecordCartDemand mock is already set by ExpectParams functions")
+	}
+
+	mmRecordCartDemand.defaultExpectation.params = &StockServiceUseCaseMockRecordCartDemandParams{ctx, demand}
+	mmRecordCartDemand.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRecordCartDemand.expectations {
+		if minimock.Equal(e.params, mmRecordCartDemand.defaultExpectation.params) {
+			mmRecordCartDemand.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRecordCartDemand.defaultExpectation.params)
+		}
+	}
+
+	return mmRecordCartDemand
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.RecordCartDemand
+func (mmRecordCartDemand *mStockServiceUseCaseMockRecordCartDemand) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockRecordCartDemand {
+	if mmRecordCartDemand.mock.funcRecordCartDemand != nil {
+		mmRecordCartDemand.mock.t.Fatalf("StockServiceUseCaseMock.RecordCartDemand mock is already set by Set")
+	}
+
+	if mmRecordCartDemand.defaultExpectation == nil {
+		mmRecordCartDemand.defaultExpectation = &StockServiceUseCaseMockRecordCartDemandExpectation{}
+	}
+
+	if mmRecordCartDemand.defaultExpectation.params != nil {
+		mmRecordCartDemand.mock.t.Fatalf("StockServiceUseCaseMock.RecordCartDemand mock is already set by Expect")
+	}
+
+	if mmRecordCartDemand.defaultExpectation.paramPtrs == nil {
+		mmRecordCartDemand.defaultExpectation.paramPtrs = &StockServiceUseCaseMockRecordCartDemandParamPtrs{}
+	}
+	mmRecordCartDemand.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRecordCartDemand.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRecordCartDemand
+}
+
+// ExpectDemandParam2 sets up expected param demand for StockServiceUseCase.RecordCartDemand
+func (mmRecordCartDemand *mStockServiceUseCaseMockRecordCartDemand) ExpectDemandParam2(demand domain.CartDemand) *mStockServiceUseCaseMockRecordCartDemand {
+	if mmRecordCartDemand.mock.funcRecordCartDemand != nil {
+		mmRecordCartDemand.mock.t.Fatalf("StockServiceUseCaseMock.RecordCartDemand mock is already set by Set")
+	}
+
+	if mmRecordCartDemand.defaultExpectation == nil {
+		mmRecordCartDemand.defaultExpectation = &StockServiceUseCaseMockRecordCartDemandExpectation{}
+	}
+
+	if mmRecordCartDemand.defaultExpectation.params != nil {
+		mmRecordCartDemand.mock.t.Fatalf("StockServiceUseCaseMock.RecordCartDemand mock is already set by Expect")
+	}
+
+	if mmRecordCartDemand.defaultExpectation.paramPtrs == nil {
+		mmRecordCartDemand.defaultExpectation.paramPtrs = &StockServiceUseCaseMockRecordCartDemandParamPtrs{}
+	}
+	mmRecordCartDemand.defaultExpectation.paramPtrs.demand = &demand
+	mmRecordCartDemand.defaultExpectation.expectationOrigins.originDemand = minimock.CallerInfo(1)
+
+	return mmRecordCartDemand
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.RecordCartDemand
+func (mmRecordCartDemand *mStockServiceUseCaseMockRecordCartDemand) Inspect(f func(ctx context.Context, demand domain.CartDemand)) *mStockServiceUseCaseMockRecordCartDemand {
+	if mmRecordCartDemand.mock.inspectFuncRecordCartDemand != nil {
+		mmRecordCartDemand.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.RecordCartDemand")
+	}
+
+	mmRecordCartDemand.mock.inspectFuncRecordCartDemand = f
+
+	return mmRecordCartDemand
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.RecordCartDemand
+func (mmRecordCartDemand *mStockServiceUseCaseMockRecordCartDemand) Return(err error) *StockServiceUseCaseMock {
+	if mmRecordCartDemand.mock.funcRecordCartDemand != nil {
+		mmRecordCartDemand.mock.t.Fatalf("StockServiceUseCaseMock.RecordCartDemand mock is already set by Set")
+	}
+
+	if mmRecordCartDemand.defaultExpectation == nil {
+		mmRecordCartDemand.defaultExpectation = &StockServiceUseCaseMockRecordCartDemandExpectation{mock: mmRecordCartDemand.mock}
+	}
+	mmRecordCartDemand.defaultExpectation.results = &StockServiceUseCaseMockRecordCartDemandResults{err}
+	mmRecordCartDemand.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRecordCartDemand.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.RecordCartDemand method
+func (mmRecordCartDemand *mStockServiceUseCaseMockRecordCartDemand) Set(f func(ctx context.Context, demand domain.CartDemand) (err error)) *StockServiceUseCaseMock {
+	if mmRecordCartDemand.defaultExpectation != nil {
+		mmRecordCartDemand.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.RecordCartDemand method")
+	}
+
+	if len(mmRecordCartDemand.expectations) > 0 {
+		mmRecordCartDemand.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.RecordCartDemand method")
+	}
+
+	mmRecordCartDemand.mock.funcRecordCartDemand = f
+	mmRecordCartDemand.mock.funcRecordCartDemandOrigin = minimock.CallerInfo(1)
+	return mmRecordCartDemand.mock
+}
+
+// When sets expectation for the StockServiceUseCase.RecordCartDemand which will trigger the result defined by the following
+// Then helper
+func (mmRecordCartDemand *mStockServiceUseCaseMockRecordCartDemand) When(ctx context.Context, demand domain.CartDemand) *StockServiceUseCaseMockRecordCartDemandExpectation {
+	if mmRecordCartDemand.mock.funcRecordCartDemand != nil {
+		mmRecordCartDemand.mock.t.Fatalf("StockServiceUseCaseMock.RecordCartDemand mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockRecordCartDemandExpectation{
+		mock:               mmRecordCartDemand.mock,
+		params:             &StockServiceUseCaseMockRecordCartDemandParams{ctx, demand},
+		expectationOrigins: StockServiceUseCaseMockRecordCartDemandExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRecordCartDemand.expectations = append(mmRecordCartDemand.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.RecordCartDemand return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockRecordCartDemandExpectation) Then(err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockRecordCartDemandResults{err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.RecordCartDemand should be invoked
+func (mmRecordCartDemand *mStockServiceUseCaseMockRecordCartDemand) Times(n uint64) *mStockServiceUseCaseMockRecordCartDemand {
+	if n == 0 {
+		mmRecordCartDemand.mock.t.Fatalf("Times of StockServiceUseCaseMock.RecordCartDemand mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRecordCartDemand.expectedInvocations, n)
+	mmRecordCartDemand.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRecordCartDemand
+}
+
+func (mmRecordCartDemand *mStockServiceUseCaseMockRecordCartDemand) invocationsDone() bool {
+	if len(mmRecordCartDemand.expectations) == 0 && mmRecordCartDemand.defaultExpectation == nil && mmRecordCartDemand.mock.funcRecordCartDemand == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRecordCartDemand.mock.afterRecordCartDemandCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRecordCartDemand.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RecordCartDemand implements mm_usecase.StockServiceUseCase
+func (mmRecordCartDemand *StockServiceUseCaseMock) RecordCartDemand(ctx context.Context, demand domain.CartDemand) (err error) {
+	mm_atomic.AddUint64(&mmRecordCartDemand.beforeRecordCartDemandCounter, 1)
+	defer mm_atomic.AddUint64(&mmRecordCartDemand.afterRecordCartDemandCounter, 1)
+
+	mmRecordCartDemand.t.Helper()
+
+	if mmRecordCartDemand.inspectFuncRecordCartDemand != nil {
+		mmRecordCartDemand.inspectFuncRecordCartDemand(ctx, demand)
+	}
+
+	mm_params := StockServiceUseCaseMockRecordCartDemandParams{ctx, demand}
+
+	// Record call args
+	mmRecordCartDemand.RecordCartDemandMock.mutex.Lock()
+	mmRecordCartDemand.RecordCartDemandMock.callArgs = append(mmRecordCartDemand.RecordCartDemandMock.callArgs, &mm_params)
+	mmRecordCartDemand.RecordCartDemandMock.mutex.Unlock()
+
+	for _, e := range mmRecordCartDemand.RecordCartDemandMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRecordCartDemand.RecordCartDemandMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRecordCartDemand.RecordCartDemandMock.defaultExpectation.Counter, 1)
+		mm_want := mmRecordCartDemand.RecordCartDemandMock.defaultExpectation.params
+		mm_want_ptrs := mmRecordCartDemand.RecordCartDemandMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockRecordCartDemandParams{ctx, demand}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRecordCartDemand.t.Errorf("StockServiceUseCaseMock.RecordCartDemand got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecordCartDemand.RecordCartDemandMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.demand != nil && !minimock.Equal(*mm_want_ptrs.demand, mm_got.demand) {
+				mmRecordCartDemand.t.Errorf("StockServiceUseCaseMock.RecordCartDemand got unexpected parameter demand, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecordCartDemand.RecordCartDemandMock.defaultExpectation.expectationOrigins.originDemand, *mm_want_ptrs.demand, mm_got.demand, minimock.Diff(*mm_want_ptrs.demand, mm_got.demand))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRecordCartDemand.t.Errorf("StockServiceUseCaseMock.RecordCartDemand got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRecordCartDemand.RecordCartDemandMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRecordCartDemand.RecordCartDemandMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRecordCartDemand.t.Fatal("No results are set for the StockServiceUseCaseMock.RecordCartDemand")
+		}
+		return (*mm_results).err
+	}
+	if mmRecordCartDemand.funcRecordCartDemand != nil {
+		return mmRecordCartDemand.funcRecordCartDemand(ctx, demand)
+	}
+	mmRecordCartDemand.t.Fatalf("Unexpected call to StockServiceUseCaseMock.RecordCartDemand. %v %v", ctx, demand)
+	return
+}
+
+// RecordCartDemandAfterCounter returns a count of finished StockServiceUseCaseMock.RecordCartDemand invocations
+func (mmRecordCartDemand *StockServiceUseCaseMock) RecordCartDemandAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordCartDemand.afterRecordCartDemandCounter)
+}
+
+// RecordCartDemandBeforeCounter returns a count of StockServiceUseCaseMock.RecordCartDemand invocations
+func (mmRecordCartDemand *StockServiceUseCaseMock) RecordCartDemandBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecordCartDemand.beforeRecordCartDemandCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.RecordCartDemand.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRecordCartDemand *mStockServiceUseCaseMockRecordCartDemand) Calls() []*StockServiceUseCaseMockRecordCartDemandParams {
+	mmRecordCartDemand.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockRecordCartDemandParams, len(mmRecordCartDemand.callArgs))
+	copy(argCopy, mmRecordCartDemand.callArgs)
+
+	mmRecordCartDemand.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRecordCartDemandDone returns true if the count of the RecordCartDemand invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockRecordCartDemandDone() bool {
+	if m.RecordCartDemandMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RecordCartDemandMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RecordCartDemandMock.invocationsDone()
+}
+
+// MinimockRecordCartDemandInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockRecordCartDemandInspect() {
+	for _, e := range m.RecordCartDemandMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.RecordCartDemand at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRecordCartDemandCounter := mm_atomic.LoadUint64(&m.afterRecordCartDemandCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RecordCartDemandMock.defaultExpectation != nil && afterRecordCartDemandCounter < 1 {
+		if m.RecordCartDemandMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.RecordCartDemand at\n%s", m.RecordCartDemandMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.RecordCartDemand at\n%s with params: %#v", m.RecordCartDemandMock.defaultExpectation.expectationOrigins.origin, *m.RecordCartDemandMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRecordCartDemand != nil && afterRecordCartDemandCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.RecordCartDemand at\n%s", m.funcRecordCartDemandOrigin)
+	}
+
+	if !m.RecordCartDemandMock.invocationsDone() && afterRecordCartDemandCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.RecordCartDemand at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RecordCartDemandMock.expectedInvocations), m.RecordCartDemandMock.expectedInvocationsOrigin, afterRecordCartDemandCounter)
+	}
+}
+
+type mStockServiceUseCaseMockRestoreStockItem struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockRestoreStockItemExpectation
+	expectations       []*StockServiceUseCaseMockRestoreStockItemExpectation
+
+	callArgs []*StockServiceUseCaseMockRestoreStockItemParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockRestoreStockItemExpectation specifies expectation struct of the StockServiceUseCase.RestoreStockItem
+type StockServiceUseCaseMockRestoreStockItemExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockRestoreStockItemParams
+	paramPtrs          *StockServiceUseCaseMockRestoreStockItemParamPtrs
+	expectationOrigins StockServiceUseCaseMockRestoreStockItemExpectationOrigins
+	results            *StockServiceUseCaseMockRestoreStockItemResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockRestoreStockItemParams contains parameters of the StockServiceUseCase.RestoreStockItem
+type StockServiceUseCaseMockRestoreStockItemParams struct {
+	ctx      context.Context
+	userID   domain.UserID
+	skuID    domain.SKUID
+	location string
+}
+
+// StockServiceUseCaseMockRestoreStockItemParamPtrs contains pointers to parameters of the StockServiceUseCase.RestoreStockItem
+type StockServiceUseCaseMockRestoreStockItemParamPtrs struct {
+	ctx      *context.Context
+	userID   *domain.UserID
+	skuID    *domain.SKUID
+	location *string
+}
+
+// StockServiceUseCaseMockRestoreStockItemResults contains results of the StockServiceUseCase.RestoreStockItem
+type StockServiceUseCaseMockRestoreStockItemResults struct {
+	s1  domain.StockItem
+	err error
+}
+
+// StockServiceUseCaseMockRestoreStockItemOrigins contains origins of expectations of the StockServiceUseCase.RestoreStockItem
+type StockServiceUseCaseMockRestoreStockItemExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originSkuID    string
+	originLocation string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRestoreStockItem *mStockServiceUseCaseMockRestoreStockItem) Optional() *mStockServiceUseCaseMockRestoreStockItem {
+	mmRestoreStockItem.optional = true
+	return mmRestoreStockItem
+}
+
+// Expect sets up expected params for StockServiceUseCase.RestoreStockItem
+func (mmRestoreStockItem *mStockServiceUseCaseMockRestoreStockItem) Expect(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) *mStockServiceUseCaseMockRestoreStockItem {
+	if mmRestoreStockItem.mock.funcRestoreStockItem != nil {
+		mmRestoreStockItem.mock.t.Fatalf("StockServiceUseCaseMock.RestoreStockItem mock is already set by Set")
+	}
+
+	if mmRestoreStockItem.defaultExpectation == nil {
+		mmRestoreStockItem.defaultExpectation = &StockServiceUseCaseMockRestoreStockItemExpectation{}
+	}
+
+	if mmRestoreStockItem.defaultExpectation.paramPtrs != nil {
+		mmRestoreStockItem.mock.t.Fatalf("StockServiceUseCaseMock.RestoreStockItem mock is already set by ExpectParams functions")
+	}
+
+	mmRestoreStockItem.defaultExpectation.params = &StockServiceUseCaseMockRestoreStockItemParams{ctx, userID, skuID, location}
+	mmRestoreStockItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRestoreStockItem.expectations {
+		if minimock.Equal(e.params, mmRestoreStockItem.defaultExpectation.params) {
+			mmRestoreStockItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRestoreStockItem.defaultExpectation.params)
+		}
+	}
+
+	return mmRestoreStockItem
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.RestoreStockItem
+func (mmRestoreStockItem *mStockServiceUseCaseMockRestoreStockItem) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockRestoreStockItem {
+	if mmRestoreStockItem.mock.funcRestoreStockItem != nil {
+		mmRestoreStockItem.mock.t.Fatalf("StockServiceUseCaseMock.RestoreStockItem mock is already set by Set")
+	}
+
+	if mmRestoreStockItem.defaultExpectation == nil {
+		mmRestoreStockItem.defaultExpectation = &StockServiceUseCaseMockRestoreStockItemExpectation{}
+	}
+
+	if mmRestoreStockItem.defaultExpectation.params != nil {
+		mmRestoreStockItem.mock.t.Fatalf("StockServiceUseCaseMock.RestoreStockItem mock is already set by Expect")
+	}
+
+	if mmRestoreStockItem.defaultExpectation.paramPtrs == nil {
+		mmRestoreStockItem.defaultExpectation.paramPtrs = &StockServiceUseCaseMockRestoreStockItemParamPtrs{}
+	}
+	mmRestoreStockItem.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRestoreStockItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRestoreStockItem
+}
+
+// ExpectUserIDParam2 sets up expected param userID for StockServiceUseCase.RestoreStockItem
+func (mmRestoreStockItem *mStockServiceUseCaseMockRestoreStockItem) ExpectUserIDParam2(userID domain.UserID) *mStockServiceUseCaseMockRestoreStockItem {
+	if mmRestoreStockItem.mock.funcRestoreStockItem != nil {
+		mmRestoreStockItem.mock.t.Fatalf("StockServiceUseCaseMock.RestoreStockItem mock is already set by Set")
+	}
+
+	if mmRestoreStockItem.defaultExpectation == nil {
+		mmRestoreStockItem.defaultExpectation = &StockServiceUseCaseMockRestoreStockItemExpectation{}
+	}
+
+	if mmRestoreStockItem.defaultExpectation.params != nil {
+		mmRestoreStockItem.mock.t.Fatalf("StockServiceUseCaseMock.RestoreStockItem mock is already set by Expect")
+	}
+
+	if mmRestoreStockItem.defaultExpectation.paramPtrs == nil {
+		mmRestoreStockItem.defaultExpectation.paramPtrs = &StockServiceUseCaseMockRestoreStockItemParamPtrs{}
+	}
+	mmRestoreStockItem.defaultExpectation.paramPtrs.userID = &userID
+	mmRestoreStockItem.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRestoreStockItem
+}
+
+// ExpectSkuIDParam3 sets up expected param skuID for StockServiceUseCase.RestoreStockItem
+func (mmRestoreStockItem *mStockServiceUseCaseMockRestoreStockItem) ExpectSkuIDParam3(skuID domain.SKUID) *mStockServiceUseCaseMockRestoreStockItem {
+	if mmRestoreStockItem.mock.funcRestoreStockItem != nil {
+		mmRestoreStockItem.mock.t.Fatalf("StockServiceUseCaseMock.RestoreStockItem mock is already set by Set")
+	}
+
+	if mmRestoreStockItem.defaultExpectation == nil {
+		mmRestoreStockItem.defaultExpectation = &StockServiceUseCaseMockRestoreStockItemExpectation{}
+	}
+
+	if mmRestoreStockItem.defaultExpectation.params != nil {
+		mmRestoreStockItem.mock.t.Fatalf("StockServiceUseCaseMock.RestoreStockItem mock is already set by Expect")
+	}
+
+	if mmRestoreStockItem.defaultExpectation.paramPtrs == nil {
+		mmRestoreStockItem.defaultExpectation.paramPtrs = &StockServiceUseCaseMockRestoreStockItemParamPtrs{}
+	}
+	mmRestoreStockItem.defaultExpectation.paramPtrs.skuID = &skuID
+	mmRestoreStockItem.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmRestoreStockItem
+}
+
+// ExpectLocationParam4 sets up expected param location for StockServiceUseCase.RestoreStockItem
+func (mmRestoreStockItem *mStockServiceUseCaseMockRestoreStockItem) ExpectLocationParam4(location string) *mStockServiceUseCaseMockRestoreStockItem {
+	if mmRestoreStockItem.mock.funcRestoreStockItem != nil {
+		mmRestoreStockItem.mock.t.Fatalf("StockServiceUseCaseMock.RestoreStockItem mock is already set by Set")
+	}
+
+	if mmRestoreStockItem.defaultExpectation == nil {
+		mmRestoreStockItem.defaultExpectation = &StockServiceUseCaseMockRestoreStockItemExpectation{}
+	}
+
+	if mmRestoreStockItem.defaultExpectation.params != nil {
+		mmRestoreStockItem.mock.t.Fatalf("StockServiceUseCaseMock.RestoreStockItem mock is already set by Expect")
+	}
+
+	if mmRestoreStockItem.defaultExpectation.paramPtrs == nil {
+		mmRestoreStockItem.defaultExpectation.paramPtrs = &StockServiceUseCaseMockRestoreStockItemParamPtrs{}
+	}
+	mmRestoreStockItem.defaultExpectation.paramPtrs.location = &location
+	mmRestoreStockItem.defaultExpectation.expectationOrigins.originLocation = minimock.CallerInfo(1)
+
+	return mmRestoreStockItem
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.RestoreStockItem
+func (mmRestoreStockItem *mStockServiceUseCaseMockRestoreStockItem) Inspect(f func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string)) *mStockServiceUseCaseMockRestoreStockItem {
+	if mmRestoreStockItem.mock.inspectFuncRestoreStockItem != nil {
+		mmRestoreStockItem.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.RestoreStockItem")
+	}
+
+	mmRestoreStockItem.mock.inspectFuncRestoreStockItem = f
+
+	return mmRestoreStockItem
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.RestoreStockItem
+func (mmRestoreStockItem *mStockServiceUseCaseMockRestoreStockItem) Return(s1 domain.StockItem, err error) *StockServiceUseCaseMock {
+	if mmRestoreStockItem.mock.funcRestoreStockItem != nil {
+		mmRestoreStockItem.mock.t.Fatalf("StockServiceUseCaseMock.RestoreStockItem mock is already set by Set")
+	}
+
+	if mmRestoreStockItem.defaultExpectation == nil {
+		mmRestoreStockItem.defaultExpectation = &StockServiceUseCaseMockRestoreStockItemExpectation{mock: mmRestoreStockItem.mock}
+	}
+	mmRestoreStockItem.defaultExpectation.results = &StockServiceUseCaseMockRestoreStockItemResults{s1, err}
+	mmRestoreStockItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRestoreStockItem.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.RestoreStockItem method
+func (mmRestoreStockItem *mStockServiceUseCaseMockRestoreStockItem) Set(f func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) (s1 domain.StockItem, err error)) *StockServiceUseCaseMock {
+	if mmRestoreStockItem.defaultExpectation != nil {
+		mmRestoreStockItem.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.RestoreStockItem method")
+	}
+
+	if len(mmRestoreStockItem.expectations) > 0 {
+		mmRestoreStockItem.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.RestoreStockItem method")
+	}
+
+	mmRestoreStockItem.mock.funcRestoreStockItem = f
+	mmRestoreStockItem.mock.funcRestoreStockItemOrigin = minimock.CallerInfo(1)
+	return mmRestoreStockItem.mock
+}
+
+// When sets expectation for the StockServiceUseCase.RestoreStockItem which will trigger the result defined by the following
+// Then helper
+func (mmRestoreStockItem *mStockServiceUseCaseMockRestoreStockItem) When(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) *StockServiceUseCaseMockRestoreStockItemExpectation {
+	if mmRestoreStockItem.mock.funcRestoreStockItem != nil {
+		mmRestoreStockItem.mock.t.Fatalf("StockServiceUseCaseMock.RestoreStockItem mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockRestoreStockItemExpectation{
+		mock:               mmRestoreStockItem.mock,
+		params:             &StockServiceUseCaseMockRestoreStockItemParams{ctx, userID, skuID, location},
+		expectationOrigins: StockServiceUseCaseMockRestoreStockItemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRestoreStockItem.expectations = append(mmRestoreStockItem.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.RestoreStockItem return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockRestoreStockItemExpectation) Then(s1 domain.StockItem, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockRestoreStockItemResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.RestoreStockItem should be invoked
+func (mmRestoreStockItem *mStockServiceUseCaseMockRestoreStockItem) Times(n uint64) *mStockServiceUseCaseMockRestoreStockItem {
+	if n == 0 {
+		mmRestoreStockItem.mock.t.Fatalf("Times of StockServiceUseCaseMock.RestoreStockItem mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRestoreStockItem.expectedInvocations, n)
+	mmRestoreStockItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRestoreStockItem
+}
+
+func (mmRestoreStockItem *mStockServiceUseCaseMockRestoreStockItem) invocationsDone() bool {
+	if len(mmRestoreStockItem.expectations) == 0 && mmRestoreStockItem.defaultExpectation == nil && mmRestoreStockItem.mock.funcRestoreStockItem == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRestoreStockItem.mock.afterRestoreStockItemCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRestoreStockItem.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RestoreStockItem implements mm_usecase.StockServiceUseCase
+func (mmRestoreStockItem *StockServiceUseCaseMock) RestoreStockItem(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) (s1 domain.StockItem, err error) {
+	mm_atomic.AddUint64(&mmRestoreStockItem.beforeRestoreStockItemCounter, 1)
+	defer mm_atomic.AddUint64(&mmRestoreStockItem.afterRestoreStockItemCounter, 1)
+
+	mmRestoreStockItem.t.Helper()
+
+	if mmRestoreStockItem.inspectFuncRestoreStockItem != nil {
+		mmRestoreStockItem.inspectFuncRestoreStockItem(ctx, userID, skuID, location)
+	}
+
+	mm_params := StockServiceUseCaseMockRestoreStockItemParams{ctx, userID, skuID, location}
+
+	// Record call args
+	mmRestoreStockItem.RestoreStockItemMock.mutex.Lock()
+	mmRestoreStockItem.RestoreStockItemMock.callArgs = append(mmRestoreStockItem.RestoreStockItemMock.callArgs, &mm_params)
+	mmRestoreStockItem.RestoreStockItemMock.mutex.Unlock()
+
+	for _, e := range mmRestoreStockItem.RestoreStockItemMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmRestoreStockItem.RestoreStockItemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRestoreStockItem.RestoreStockItemMock.defaultExpectation.Counter, 1)
+		mm_want := mmRestoreStockItem.RestoreStockItemMock.defaultExpectation.params
+		mm_want_ptrs := mmRestoreStockItem.RestoreStockItemMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockRestoreStockItemParams{ctx, userID, skuID, location}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRestoreStockItem.t.Errorf("StockServiceUseCaseMock.RestoreStockItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreStockItem.RestoreStockItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRestoreStockItem.t.Errorf("StockServiceUseCaseMock.RestoreStockItem got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreStockItem.RestoreStockItemMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmRestoreStockItem.t.Errorf("StockServiceUseCaseMock.RestoreStockItem got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreStockItem.RestoreStockItemMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.location != nil && !minimock.Equal(*mm_want_ptrs.location, mm_got.location) {
+				mmRestoreStockItem.t.Errorf("StockServiceUseCaseMock.RestoreStockItem got unexpected parameter location, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreStockItem.RestoreStockItemMock.defaultExpectation.expectationOrigins.originLocation, *mm_want_ptrs.location, mm_got.location, minimock.Diff(*mm_want_ptrs.location, mm_got.location))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRestoreStockItem.t.Errorf("StockServiceUseCaseMock.RestoreStockItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRestoreStockItem.RestoreStockItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRestoreStockItem.RestoreStockItemMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRestoreStockItem.t.Fatal("No results are set for the StockServiceUseCaseMock.RestoreStockItem")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmRestoreStockItem.funcRestoreStockItem != nil {
+		return mmRestoreStockItem.funcRestoreStockItem(ctx, userID, skuID, location)
+	}
+	mmRestoreStockItem.t.Fatalf("Unexpected call to StockServiceUseCaseMock.RestoreStockItem. %v %v %v %v", ctx, userID, skuID, location)
+	return
+}
+
+// RestoreStockItemAfterCounter returns a count of finished StockServiceUseCaseMock.RestoreStockItem invocations
+func (mmRestoreStockItem *StockServiceUseCaseMock) RestoreStockItemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestoreStockItem.afterRestoreStockItemCounter)
+}
+
+// RestoreStockItemBeforeCounter returns a count of StockServiceUseCaseMock.RestoreStockItem invocations
+func (mmRestoreStockItem *StockServiceUseCaseMock) RestoreStockItemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestoreStockItem.beforeRestoreStockItemCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.RestoreStockItem.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRestoreStockItem *mStockServiceUseCaseMockRestoreStockItem) Calls() []*StockServiceUseCaseMockRestoreStockItemParams {
+	mmRestoreStockItem.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockRestoreStockItemParams, len(mmRestoreStockItem.callArgs))
+	copy(argCopy, mmRestoreStockItem.callArgs)
+
+	mmRestoreStockItem.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRestoreStockItemDone returns true if the count of the RestoreStockItem invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockRestoreStockItemDone() bool {
+	if m.RestoreStockItemMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RestoreStockItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RestoreStockItemMock.invocationsDone()
+}
+
+// MinimockRestoreStockItemInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockRestoreStockItemInspect() {
+	for _, e := range m.RestoreStockItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.RestoreStockItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRestoreStockItemCounter := mm_atomic.LoadUint64(&m.afterRestoreStockItemCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RestoreStockItemMock.defaultExpectation != nil && afterRestoreStockItemCounter < 1 {
+		if m.RestoreStockItemMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.RestoreStockItem at\n%s", m.RestoreStockItemMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.RestoreStockItem at\n%s with params: %#v", m.RestoreStockItemMock.defaultExpectation.expectationOrigins.origin, *m.RestoreStockItemMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRestoreStockItem != nil && afterRestoreStockItemCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.RestoreStockItem at\n%s", m.funcRestoreStockItemOrigin)
+	}
+
+	if !m.RestoreStockItemMock.invocationsDone() && afterRestoreStockItemCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.RestoreStockItem at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RestoreStockItemMock.expectedInvocations), m.RestoreStockItemMock.expectedInvocationsOrigin, afterRestoreStockItemCounter)
+	}
+}
+
+type mStockServiceUseCaseMockReviewCycleCount struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockReviewCycleCountExpectation
+	expectations       []*StockServiceUseCaseMockReviewCycleCountExpectation
+
+	callArgs []*StockServiceUseCaseMockReviewCycleCountParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockReviewCycleCountExpectation specifies expectation struct of the StockServiceUseCase.ReviewCycleCount
+type StockServiceUseCaseMockReviewCycleCountExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockReviewCycleCountParams
+	paramPtrs          *StockServiceUseCaseMockReviewCycleCountParamPtrs
+	expectationOrigins StockServiceUseCaseMockReviewCycleCountExpectationOrigins
+	results            *StockServiceUseCaseMockReviewCycleCountResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockReviewCycleCountParams contains parameters of the StockServiceUseCase.ReviewCycleCount
+type StockServiceUseCaseMockReviewCycleCountParams struct {
+	ctx          context.Context
+	cycleCountID domain.CycleCountID
+}
+
+// StockServiceUseCaseMockReviewCycleCountParamPtrs contains pointers to parameters of the StockServiceUseCase.ReviewCycleCount
+type StockServiceUseCaseMockReviewCycleCountParamPtrs struct {
+	ctx          *context.Context
+	cycleCountID *domain.CycleCountID
+}
+
+// StockServiceUseCaseMockReviewCycleCountResults contains results of the StockServiceUseCase.ReviewCycleCount
+type StockServiceUseCaseMockReviewCycleCountResults struct {
+	c2  domain.CycleCount
+	err error
+}
+
+// StockServiceUseCaseMockReviewCycleCountOrigins contains origins of expectations of the StockServiceUseCase.ReviewCycleCount
+type StockServiceUseCaseMockReviewCycleCountExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originCycleCountID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReviewCycleCount *mStockServiceUseCaseMockReviewCycleCount) Optional() *mStockServiceUseCaseMockReviewCycleCount {
+	mmReviewCycleCount.optional = true
+	return mmReviewCycleCount
+}
+
+// Expect sets up expected params for StockServiceUseCase.ReviewCycleCount
+func (mmReviewCycleCount *mStockServiceUseCaseMockReviewCycleCount) Expect(ctx context.Context, cycleCountID domain.CycleCountID) *mStockServiceUseCaseMockReviewCycleCount {
+	if mmReviewCycleCount.mock.funcReviewCycleCount != nil {
+		mmReviewCycleCount.mock.t.Fatalf("StockServiceUseCaseMock.ReviewCycleCount mock is already set by Set")
+	}
+
+	if mmReviewCycleCount.defaultExpectation == nil {
+		mmReviewCycleCount.defaultExpectation = &StockServiceUseCaseMockReviewCycleCountExpectation{}
+	}
+
+	if mmReviewCycleCount.defaultExpectation.paramPtrs != nil {
+		mmReviewCycleCount.mock.t.Fatalf("StockServiceUseCaseMock.ReviewCycleCount mock is already set by ExpectParams functions")
+	}
+
+	mmReviewCycleCount.defaultExpectation.params = &StockServiceUseCaseMockReviewCycleCountParams{ctx, cycleCountID}
+	mmReviewCycleCount.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReviewCycleCount.expectations {
+		if minimock.Equal(e.params, mmReviewCycleCount.defaultExpectation.params) {
+			mmReviewCycleCount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReviewCycleCount.defaultExpectation.params)
+		}
+	}
+
+	return mmReviewCycleCount
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.ReviewCycleCount
+func (mmReviewCycleCount *mStockServiceUseCaseMockReviewCycleCount) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockReviewCycleCount {
+	if mmReviewCycleCount.mock.funcReviewCycleCount != nil {
+		mmReviewCycleCount.mock.t.Fatalf("StockServiceUseCaseMock.ReviewCycleCount mock is already set by Set")
+	}
+
+	if mmReviewCycleCount.defaultExpectation == nil {
+		mmReviewCycleCount.defaultExpectation = &StockServiceUseCaseMockReviewCycleCountExpectation{}
+	}
+
+	if mmReviewCycleCount.defaultExpectation.params != nil {
+		mmReviewCycleCount.mock.t.Fatalf("StockServiceUseCaseMock.ReviewCycleCount mock is already set by Expect")
+	}
+
+	if mmReviewCycleCount.defaultExpectation.paramPtrs == nil {
+		mmReviewCycleCount.defaultExpectation.paramPtrs = &StockServiceUseCaseMockReviewCycleCountParamPtrs{}
+	}
+	mmReviewCycleCount.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReviewCycleCount.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReviewCycleCount
+}
+
+// ExpectCycleCountIDParam2 sets up expected param cycleCountID for StockServiceUseCase.ReviewCycleCount
+func (mmReviewCycleCount *mStockServiceUseCaseMockReviewCycleCount) ExpectCycleCountIDParam2(cycleCountID domain.CycleCountID) *mStockServiceUseCaseMockReviewCycleCount {
+	if mmReviewCycleCount.mock.funcReviewCycleCount != nil {
+		mmReviewCycleCount.mock.t.Fatalf("StockServiceUseCaseMock.ReviewCycleCount mock is already set by Set")
+	}
+
+	if mmReviewCycleCount.defaultExpectation == nil {
+		mmReviewCycleCount.defaultExpectation = &StockServiceUseCaseMockReviewCycleCountExpectation{}
+	}
+
+	if mmReviewCycleCount.defaultExpectation.params != nil {
+		mmReviewCycleCount.mock.t.Fatalf("StockServiceUseCaseMock.ReviewCycleCount mock is already set by Expect")
+	}
+
+	if mmReviewCycleCount.defaultExpectation.paramPtrs == nil {
+		mmReviewCycleCount.defaultExpectation.paramPtrs = &StockServiceUseCaseMockReviewCycleCountParamPtrs{}
+	}
+	mmReviewCycleCount.defaultExpectation.paramPtrs.cycleCountID = &cycleCountID
+	mmReviewCycleCount.defaultExpectation.expectationOrigins.originCycleCountID = minimock.CallerInfo(1)
+
+	return mmReviewCycleCount
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.ReviewCycleCount
+func (mmReviewCycleCount *mStockServiceUseCaseMockReviewCycleCount) Inspect(f func(ctx context.Context, cycleCountID domain.CycleCountID)) *mStockServiceUseCaseMockReviewCycleCount {
+	if mmReviewCycleCount.mock.inspectFuncReviewCycleCount != nil {
+		mmReviewCycleCount.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.ReviewCycleCount")
+	}
+
+	mmReviewCycleCount.mock.inspectFuncReviewCycleCount = f
+
+	return mmReviewCycleCount
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.ReviewCycleCount
+func (mmReviewCycleCount *mStockServiceUseCaseMockReviewCycleCount) Return(c2 domain.CycleCount, err error) *StockServiceUseCaseMock {
+	if mmReviewCycleCount.mock.funcReviewCycleCount != nil {
+		mmReviewCycleCount.mock.t.Fatalf("StockServiceUseCaseMock.ReviewCycleCount mock is already set by Set")
+	}
+
+	if mmReviewCycleCount.defaultExpectation == nil {
+		mmReviewCycleCount.defaultExpectation = &StockServiceUseCaseMockReviewCycleCountExpectation{mock: mmReviewCycleCount.mock}
+	}
+	mmReviewCycleCount.defaultExpectation.results = &StockServiceUseCaseMockReviewCycleCountResults{c2, err}
+	mmReviewCycleCount.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReviewCycleCount.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.ReviewCycleCount method
+func (mmReviewCycleCount *mStockServiceUseCaseMockReviewCycleCount) Set(f func(ctx context.Context, cycleCountID domain.CycleCountID) (c2 domain.CycleCount, err error)) *StockServiceUseCaseMock {
+	if mmReviewCycleCount.defaultExpectation != nil {
+		mmReviewCycleCount.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.ReviewCycleCount method")
+	}
+
+	if len(mmReviewCycleCount.expectations) > 0 {
+		mmReviewCycleCount.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.ReviewCycleCount method")
+	}
+
+	mmReviewCycleCount.mock.funcReviewCycleCount = f
+	mmReviewCycleCount.mock.funcReviewCycleCountOrigin = minimock.CallerInfo(1)
+	return mmReviewCycleCount.mock
+}
+
+// When sets expectation for the StockServiceUseCase.ReviewCycleCount which will trigger the result defined by the following
+// Then helper
+func (mmReviewCycleCount *mStockServiceUseCaseMockReviewCycleCount) When(ctx context.Context, cycleCountID domain.CycleCountID) *StockServiceUseCaseMockReviewCycleCountExpectation {
+	if mmReviewCycleCount.mock.funcReviewCycleCount != nil {
+		mmReviewCycleCount.mock.t.Fatalf("StockServiceUseCaseMock.ReviewCycleCount mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockReviewCycleCountExpectation{
+		mock:               mmReviewCycleCount.mock,
+		params:             &StockServiceUseCaseMockReviewCycleCountParams{ctx, cycleCountID},
+		expectationOrigins: StockServiceUseCaseMockReviewCycleCountExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReviewCycleCount.expectations = append(mmReviewCycleCount.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.ReviewCycleCount return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockReviewCycleCountExpectation) Then(c2 domain.CycleCount, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockReviewCycleCountResults{c2, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.ReviewCycleCount should be invoked
+func (mmReviewCycleCount *mStockServiceUseCaseMockReviewCycleCount) Times(n uint64) *mStockServiceUseCaseMockReviewCycleCount {
+	if n == 0 {
+		mmReviewCycleCount.mock.t.Fatalf("Times of StockServiceUseCaseMock.ReviewCycleCount mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReviewCycleCount.expectedInvocations, n)
+	mmReviewCycleCount.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReviewCycleCount
+}
+
+func (mmReviewCycleCount *mStockServiceUseCaseMockReviewCycleCount) invocationsDone() bool {
+	if len(mmReviewCycleCount.expectations) == 0 && mmReviewCycleCount.defaultExpectation == nil && mmReviewCycleCount.mock.funcReviewCycleCount == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReviewCycleCount.mock.afterReviewCycleCountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReviewCycleCount.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReviewCycleCount implements mm_usecase.StockServiceUseCase
+func (mmReviewCycleCount *StockServiceUseCaseMock) ReviewCycleCount(ctx context.Context, cycleCountID domain.CycleCountID) (c2 domain.CycleCount, err error) {
+	mm_atomic.AddUint64(&mmReviewCycleCount.beforeReviewCycleCountCounter, 1)
+	defer mm_atomic.AddUint64(&mmReviewCycleCount.afterReviewCycleCountCounter, 1)
+
+	mmReviewCycleCount.t.Helper()
+
+	if mmReviewCycleCount.inspectFuncReviewCycleCount != nil {
+		mmReviewCycleCount.inspectFuncReviewCycleCount(ctx, cycleCountID)
+	}
+
+	mm_params := StockServiceUseCaseMockReviewCycleCountParams{ctx, cycleCountID}
+
+	// Record call args
+	mmReviewCycleCount.ReviewCycleCountMock.mutex.Lock()
+	mmReviewCycleCount.ReviewCycleCountMock.callArgs = append(mmReviewCycleCount.ReviewCycleCountMock.callArgs, &mm_params)
+	mmReviewCycleCount.ReviewCycleCountMock.mutex.Unlock()
+
+	for _, e := range mmReviewCycleCount.ReviewCycleCountMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.c2, e.results.err
+		}
+	}
+
+	if mmReviewCycleCount.ReviewCycleCountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReviewCycleCount.ReviewCycleCountMock.defaultExpectation.Counter, 1)
+		mm_want := mmReviewCycleCount.ReviewCycleCountMock.defaultExpectation.params
+		mm_want_ptrs := mmReviewCycleCount.ReviewCycleCountMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockReviewCycleCountParams{ctx, cycleCountID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReviewCycleCount.t.Errorf("StockServiceUseCaseMock.ReviewCycleCount got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReviewCycleCount.ReviewCycleCountMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.cycleCountID != nil && !minimock.Equal(*mm_want_ptrs.cycleCountID, mm_got.cycleCountID) {
+				mmReviewCycleCount.t.Errorf("StockServiceUseCaseMock.ReviewCycleCount got unexpected parameter cycleCountID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReviewCycleCount.ReviewCycleCountMock.defaultExpectation.expectationOrigins.originCycleCountID, *mm_want_ptrs.cycleCountID, mm_got.cycleCountID, minimock.Diff(*mm_want_ptrs.cycleCountID, mm_got.cycleCountID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReviewCycleCount.t.Errorf("StockServiceUseCaseMock.ReviewCycleCount got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReviewCycleCount.ReviewCycleCountMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReviewCycleCount.ReviewCycleCountMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReviewCycleCount.t.Fatal("No results are set for the StockServiceUseCaseMock.ReviewCycleCount")
+		}
+		return (*mm_results).c2, (*mm_results).err
+	}
+	if mmReviewCycleCount.funcReviewCycleCount != nil {
+		return mmReviewCycleCount.funcReviewCycleCount(ctx, cycleCountID)
+	}
+	mmReviewCycleCount.t.Fatalf("Unexpected call to StockServiceUseCaseMock.ReviewCycleCount. %v %v", ctx, cycleCountID)
+	return
+}
+
+// ReviewCycleCountAfterCounter returns a count of finished StockServiceUseCaseMock.ReviewCycleCount invocations
+func (mmReviewCycleCount *StockServiceUseCaseMock) ReviewCycleCountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReviewCycleCount.afterReviewCycleCountCounter)
+}
+
+// ReviewCycleCountBeforeCounter returns a count of StockServiceUseCaseMock.ReviewCycleCount invocations
+func (mmReviewCycleCount *StockServiceUseCaseMock) ReviewCycleCountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReviewCycleCount.beforeReviewCycleCountCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.ReviewCycleCount.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReviewCycleCount *mStockServiceUseCaseMockReviewCycleCount) Calls() []*StockServiceUseCaseMockReviewCycleCountParams {
+	mmReviewCycleCount.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockReviewCycleCountParams, len(mmReviewCycleCount.callArgs))
+	copy(argCopy, mmReviewCycleCount.callArgs)
+
+	mmReviewCycleCount.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReviewCycleCountDone returns true if the count of the ReviewCycleCount invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockReviewCycleCountDone() bool {
+	if m.ReviewCycleCountMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReviewCycleCountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReviewCycleCountMock.invocationsDone()
+}
+
+// MinimockReviewCycleCountInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockReviewCycleCountInspect() {
+	for _, e := range m.ReviewCycleCountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ReviewCycleCount at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReviewCycleCountCounter := mm_atomic.LoadUint64(&m.afterReviewCycleCountCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReviewCycleCountMock.defaultExpectation != nil && afterReviewCycleCountCounter < 1 {
+		if m.ReviewCycleCountMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ReviewCycleCount at\n%s", m.ReviewCycleCountMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.ReviewCycleCount at\n%s with params: %#v", m.ReviewCycleCountMock.defaultExpectation.expectationOrigins.origin, *m.ReviewCycleCountMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReviewCycleCount != nil && afterReviewCycleCountCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.ReviewCycleCount at\n%s", m.funcReviewCycleCountOrigin)
+	}
+
+	if !m.ReviewCycleCountMock.invocationsDone() && afterReviewCycleCountCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.ReviewCycleCount at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReviewCycleCountMock.expectedInvocations), m.ReviewCycleCountMock.expectedInvocationsOrigin, afterReviewCycleCountCounter)
+	}
+}
+
+type mStockServiceUseCaseMockSchedulePriceChange struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockSchedulePriceChangeExpectation
+	expectations       []*StockServiceUseCaseMockSchedulePriceChangeExpectation
+
+	callArgs []*StockServiceUseCaseMockSchedulePriceChangeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockSchedulePriceChangeExpectation specifies expectation struct of the StockServiceUseCase.SchedulePriceChange
+type StockServiceUseCaseMockSchedulePriceChangeExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockSchedulePriceChangeParams
+	paramPtrs          *StockServiceUseCaseMockSchedulePriceChangeParamPtrs
+	expectationOrigins StockServiceUseCaseMockSchedulePriceChangeExpectationOrigins
+	results            *StockServiceUseCaseMockSchedulePriceChangeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockSchedulePriceChangeParams contains parameters of the StockServiceUseCase.SchedulePriceChange
+type StockServiceUseCaseMockSchedulePriceChangeParams struct {
+	ctx         context.Context
+	priceChange domain.ScheduledPriceChange
+}
+
+// StockServiceUseCaseMockSchedulePriceChangeParamPtrs contains pointers to parameters of the StockServiceUseCase.SchedulePriceChange
+type StockServiceUseCaseMockSchedulePriceChangeParamPtrs struct {
+	ctx         *context.Context
+	priceChange *domain.ScheduledPriceChange
+}
+
+// StockServiceUseCaseMockSchedulePriceChangeResults contains results of the StockServiceUseCase.SchedulePriceChange
+type StockServiceUseCaseMockSchedulePriceChangeResults struct {
+	s1  domain.ScheduledPriceChange
+	err error
+}
+
+// StockServiceUseCaseMockSchedulePriceChangeOrigins contains origins of expectations of the StockServiceUseCase.SchedulePriceChange
+type StockServiceUseCaseMockSchedulePriceChangeExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originPriceChange string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSchedulePriceChange *mStockServiceUseCaseMockSchedulePriceChange) Optional() *mStockServiceUseCaseMockSchedulePriceChange {
+	mmSchedulePriceChange.optional = true
+	return mmSchedulePriceChange
+}
+
+// Expect sets up expected params for StockServiceUseCase.SchedulePriceChange
+func (mmSchedulePriceChange *mStockServiceUseCaseMockSchedulePriceChange) Expect(ctx context.Context, priceChange domain.ScheduledPriceChange) *mStockServiceUseCaseMockSchedulePriceChange {
+	if mmSchedulePriceChange.mock.funcSchedulePriceChange != nil {
+		mmSchedulePriceChange.mock.t.Fatalf("StockServiceUseCaseMock.SchedulePriceChange mock is already set by Set")
+	}
+
+	if mmSchedulePriceChange.defaultExpectation == nil {
+		mmSchedulePriceChange.defaultExpectation = &StockServiceUseCaseMockSchedulePriceChangeExpectation{}
+	}
+
+	if mmSchedulePriceChange.defaultExpectation.paramPtrs != nil {
+		mmSchedulePriceChange.mock.t.Fatalf("StockServiceUseCaseMock.SchedulePriceChange mock is already set by ExpectParams functions")
+	}
+
+	mmSchedulePriceChange.defaultExpectation.params = &StockServiceUseCaseMockSchedulePriceChangeParams{ctx, priceChange}
+	mmSchedulePriceChange.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSchedulePriceChange.expectations {
+		if minimock.Equal(e.params, mmSchedulePriceChange.defaultExpectation.params) {
+			mmSchedulePriceChange.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSchedulePriceChange.defaultExpectation.params)
+		}
+	}
+
+	return mmSchedulePriceChange
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.SchedulePriceChange
+func (mmSchedulePriceChange *mStockServiceUseCaseMockSchedulePriceChange) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockSchedulePriceChange {
+	if mmSchedulePriceChange.mock.funcSchedulePriceChange != nil {
+		mmSchedulePriceChange.mock.t.Fatalf("StockServiceUseCaseMock.SchedulePriceChange mock is already set by Set")
+	}
+
+	if mmSchedulePriceChange.defaultExpectation == nil {
+		mmSchedulePriceChange.defaultExpectation = &StockServiceUseCaseMockSchedulePriceChangeExpectation{}
+	}
+
+	if mmSchedulePriceChange.defaultExpectation.params != nil {
+		mmSchedulePriceChange.mock.t.Fatalf("StockServiceUseCaseMock.SchedulePriceChange mock is already set by Expect")
+	}
+
+	if mmSchedulePriceChange.defaultExpectation.paramPtrs == nil {
+		mmSchedulePriceChange.defaultExpectation.paramPtrs = &StockServiceUseCaseMockSchedulePriceChangeParamPtrs{}
+	}
+	mmSchedulePriceChange.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSchedulePriceChange.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSchedulePriceChange
+}
+
+// ExpectPriceChangeParam2 sets up expected param priceChange for StockServiceUseCase.SchedulePriceChange
+func (mmSchedulePriceChange *mStockServiceUseCaseMockSchedulePriceChange) ExpectPriceChangeParam2(priceChange domain.ScheduledPriceChange) *mStockServiceUseCaseMockSchedulePriceChange {
+	if mmSchedulePriceChange.mock.funcSchedulePriceChange != nil {
+		mmSchedulePriceChange.mock.t.Fatalf("StockServiceUseCaseMock.SchedulePriceChange mock is already set by Set")
+	}
+
+	if mmSchedulePriceChange.defaultExpectation == nil {
+		mmSchedulePriceChange.defaultExpectation = &StockServiceUseCaseMockSchedulePriceChangeExpectation{}
+	}
+
+	if mmSchedulePriceChange.defaultExpectation.params != nil {
+		mmSchedulePriceChange.mock.t.Fatalf("StockServiceUseCaseMock.SchedulePriceChange mock is already set by Expect")
+	}
+
+	if mmSchedulePriceChange.defaultExpectation.paramPtrs == nil {
+		mmSchedulePriceChange.defaultExpectation.paramPtrs = &StockServiceUseCaseMockSchedulePriceChangeParamPtrs{}
+	}
+	mmSchedulePriceChange.defaultExpectation.paramPtrs.priceChange = &priceChange
+	mmSchedulePriceChange.defaultExpectation.expectationOrigins.originPriceChange = minimock.CallerInfo(1)
+
+	return mmSchedulePriceChange
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.SchedulePriceChange
+func (mmSchedulePriceChange *mStockServiceUseCaseMockSchedulePriceChange) Inspect(f func(ctx context.Context, priceChange domain.ScheduledPriceChange)) *mStockServiceUseCaseMockSchedulePriceChange {
+	if mmSchedulePriceChange.mock.inspectFuncSchedulePriceChange != nil {
+		mmSchedulePriceChange.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.SchedulePriceChange")
+	}
+
+	mmSchedulePriceChange.mock.inspectFuncSchedulePriceChange = f
+
+	return mmSchedulePriceChange
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.SchedulePriceChange
+func (mmSchedulePriceChange *mStockServiceUseCaseMockSchedulePriceChange) Return(s1 domain.ScheduledPriceChange, err error) *StockServiceUseCaseMock {
+	if mmSchedulePriceChange.mock.funcSchedulePriceChange != nil {
+		mmSchedulePriceChange.mock.t.Fatalf("StockServiceUseCaseMock.SchedulePriceChange mock is already set by Set")
+	}
+
+	if mmSchedulePriceChange.defaultExpectation == nil {
+		mmSchedulePriceChange.defaultExpectation = &StockServiceUseCaseMockSchedulePriceChangeExpectation{mock: mmSchedulePriceChange.mock}
+	}
+	mmSchedulePriceChange.defaultExpectation.results = &StockServiceUseCaseMockSchedulePriceChangeResults{s1, err}
+	mmSchedulePriceChange.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSchedulePriceChange.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.SchedulePriceChange method
+func (mmSchedulePriceChange *mStockServiceUseCaseMockSchedulePriceChange) Set(f func(ctx context.Context, priceChange domain.ScheduledPriceChange) (s1 domain.ScheduledPriceChange, err error)) *StockServiceUseCaseMock {
+	if mmSchedulePriceChange.defaultExpectation != nil {
+		mmSchedulePriceChange.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.SchedulePriceChange method")
+	}
+
+	if len(mmSchedulePriceChange.expectations) > 0 {
+		mmSchedulePriceChange.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.SchedulePriceChange method")
+	}
+
+	mmSchedulePriceChange.mock.funcSchedulePriceChange = f
+	mmSchedulePriceChange.mock.funcSchedulePriceChangeOrigin = minimock.CallerInfo(1)
+	return mmSchedulePriceChange.mock
+}
+
+// When sets expectation for the StockServiceUseCase.SchedulePriceChange which will trigger the result defined by the following
+// Then helper
+func (mmSchedulePriceChange *mStockServiceUseCaseMockSchedulePriceChange) When(ctx context.Context, priceChange domain.ScheduledPriceChange) *StockServiceUseCaseMockSchedulePriceChangeExpectation {
+	if mmSchedulePriceChange.mock.funcSchedulePriceChange != nil {
+		mmSchedulePriceChange.mock.t.Fatalf("StockServiceUseCaseMock.SchedulePriceChange mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockSchedulePriceChangeExpectation{
+		mock:               mmSchedulePriceChange.mock,
+		params:             &StockServiceUseCaseMockSchedulePriceChangeParams{ctx, priceChange},
+		expectationOrigins: StockServiceUseCaseMockSchedulePriceChangeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSchedulePriceChange.expectations = append(mmSchedulePriceChange.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.SchedulePriceChange return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockSchedulePriceChangeExpectation) Then(s1 domain.ScheduledPriceChange, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockSchedulePriceChangeResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.SchedulePriceChange should be invoked
+func (mmSchedulePriceChange *mStockServiceUseCaseMockSchedulePriceChange) Times(n uint64) *mStockServiceUseCaseMockSchedulePriceChange {
+	if n == 0 {
+		mmSchedulePriceChange.mock.t.Fatalf("Times of StockServiceUseCaseMock.SchedulePriceChange mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSchedulePriceChange.expectedInvocations, n)
+	mmSchedulePriceChange.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSchedulePriceChange
+}
+
+func (mmSchedulePriceChange *mStockServiceUseCaseMockSchedulePriceChange) invocationsDone() bool {
+	if len(mmSchedulePriceChange.expectations) == 0 && mmSchedulePriceChange.defaultExpectation == nil && mmSchedulePriceChange.mock.funcSchedulePriceChange == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSchedulePriceChange.mock.afterSchedulePriceChangeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSchedulePriceChange.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SchedulePriceChange implements mm_usecase.StockServiceUseCase
+func (mmSchedulePriceChange *StockServiceUseCaseMock) SchedulePriceChange(ctx context.Context, priceChange domain.ScheduledPriceChange) (s1 domain.ScheduledPriceChange, err error) {
+	mm_atomic.AddUint64(&mmSchedulePriceChange.beforeSchedulePriceChangeCounter, 1)
+	defer mm_atomic.AddUint64(&mmSchedulePriceChange.afterSchedulePriceChangeCounter, 1)
+
+	mmSchedulePriceChange.t.Helper()
+
+	if mmSchedulePriceChange.inspectFuncSchedulePriceChange != nil {
+		mmSchedulePriceChange.inspectFuncSchedulePriceChange(ctx, priceChange)
+	}
+
+	mm_params := StockServiceUseCaseMockSchedulePriceChangeParams{ctx, priceChange}
+
+	// Record call args
+	mmSchedulePriceChange.SchedulePriceChangeMock.mutex.Lock()
+	mmSchedulePriceChange.SchedulePriceChangeMock.callArgs = append(mmSchedulePriceChange.SchedulePriceChangeMock.callArgs, &mm_params)
+	mmSchedulePriceChange.SchedulePriceChangeMock.mutex.Unlock()
+
+	for _, e := range mmSchedulePriceChange.SchedulePriceChangeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmSchedulePriceChange.SchedulePriceChangeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSchedulePriceChange.SchedulePriceChangeMock.defaultExpectation.Counter, 1)
+		mm_want := mmSchedulePriceChange.SchedulePriceChangeMock.defaultExpectation.params
+		mm_want_ptrs := mmSchedulePriceChange.SchedulePriceChangeMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockSchedulePriceChangeParams{ctx, priceChange}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSchedulePriceChange.t.Errorf("StockServiceUseCaseMock.SchedulePriceChange got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSchedulePriceChange.SchedulePriceChangeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.priceChange != nil && !minimock.Equal(*mm_want_ptrs.priceChange, mm_got.priceChange) {
+				mmSchedulePriceChange.t.Errorf("StockServiceUseCaseMock.SchedulePriceChange got unexpected parameter priceChange, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSchedulePriceChange.SchedulePriceChangeMock.defaultExpectation.expectationOrigins.originPriceChange, *mm_want_ptrs.priceChange, mm_got.priceChange, minimock.Diff(*mm_want_ptrs.priceChange, mm_got.priceChange))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSchedulePriceChange.t.Errorf("StockServiceUseCaseMock.SchedulePriceChange got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSchedulePriceChange.SchedulePriceChangeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSchedulePriceChange.SchedulePriceChangeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSchedulePriceChange.t.Fatal("No results are set for the StockServiceUseCaseMock.SchedulePriceChange")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmSchedulePriceChange.funcSchedulePriceChange != nil {
+		return mmSchedulePriceChange.funcSchedulePriceChange(ctx, priceChange)
+	}
+	mmSchedulePriceChange.t.Fatalf("Unexpected call to StockServiceUseCaseMock.SchedulePriceChange. %v %v", ctx, priceChange)
+	return
+}
+
+// SchedulePriceChangeAfterCounter returns a count of finished StockServiceUseCaseMock.SchedulePriceChange invocations
+func (mmSchedulePriceChange *StockServiceUseCaseMock) SchedulePriceChangeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSchedulePriceChange.afterSchedulePriceChangeCounter)
+}
+
+// SchedulePriceChangeBeforeCounter returns a count of StockServiceUseCaseMock.SchedulePriceChange invocations
+func (mmSchedulePriceChange *StockServiceUseCaseMock) SchedulePriceChangeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSchedulePriceChange.beforeSchedulePriceChangeCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.SchedulePriceChange.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSchedulePriceChange *mStockServiceUseCaseMockSchedulePriceChange) Calls() []*StockServiceUseCaseMockSchedulePriceChangeParams {
+	mmSchedulePriceChange.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockSchedulePriceChangeParams, len(mmSchedulePriceChange.callArgs))
+	copy(argCopy, mmSchedulePriceChange.callArgs)
+
+	mmSchedulePriceChange.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSchedulePriceChangeDone returns true if the count of the SchedulePriceChange invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockSchedulePriceChangeDone() bool {
+	if m.SchedulePriceChangeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SchedulePriceChangeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SchedulePriceChangeMock.invocationsDone()
+}
+
+// MinimockSchedulePriceChangeInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockSchedulePriceChangeInspect() {
+	for _, e := range m.SchedulePriceChangeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.SchedulePriceChange at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSchedulePriceChangeCounter := mm_atomic.LoadUint64(&m.afterSchedulePriceChangeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SchedulePriceChangeMock.defaultExpectation != nil && afterSchedulePriceChangeCounter < 1 {
+		if m.SchedulePriceChangeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.SchedulePriceChange at\n%s", m.SchedulePriceChangeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.SchedulePriceChange at\n%s with params: %#v", m.SchedulePriceChangeMock.defaultExpectation.expectationOrigins.origin, *m.SchedulePriceChangeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSchedulePriceChange != nil && afterSchedulePriceChangeCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.SchedulePriceChange at\n%s", m.funcSchedulePriceChangeOrigin)
+	}
+
+	if !m.SchedulePriceChangeMock.invocationsDone() && afterSchedulePriceChangeCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.SchedulePriceChange at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SchedulePriceChangeMock.expectedInvocations), m.SchedulePriceChangeMock.expectedInvocationsOrigin, afterSchedulePriceChangeCounter)
+	}
+}
+
+type mStockServiceUseCaseMockSearchSKUs struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockSearchSKUsExpectation
+	expectations       []*StockServiceUseCaseMockSearchSKUsExpectation
+
+	callArgs []*StockServiceUseCaseMockSearchSKUsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockSearchSKUsExpectation specifies expectation struct of the StockServiceUseCase.SearchSKUs
+type StockServiceUseCaseMockSearchSKUsExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockSearchSKUsParams
+	paramPtrs          *StockServiceUseCaseMockSearchSKUsParamPtrs
+	expectationOrigins StockServiceUseCaseMockSearchSKUsExpectationOrigins
+	results            *StockServiceUseCaseMockSearchSKUsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockSearchSKUsParams contains parameters of the StockServiceUseCase.SearchSKUs
+type StockServiceUseCaseMockSearchSKUsParams struct {
+	ctx    context.Context
+	filter domain.SKUSearchFilter
+}
+
+// StockServiceUseCaseMockSearchSKUsParamPtrs contains pointers to parameters of the StockServiceUseCase.SearchSKUs
+type StockServiceUseCaseMockSearchSKUsParamPtrs struct {
+	ctx    *context.Context
+	filter *domain.SKUSearchFilter
+}
+
+// StockServiceUseCaseMockSearchSKUsResults contains results of the StockServiceUseCase.SearchSKUs
+type StockServiceUseCaseMockSearchSKUsResults struct {
+	s1  domain.SKUSearchResponse
+	err error
+}
+
+// StockServiceUseCaseMockSearchSKUsOrigins contains origins of expectations of the StockServiceUseCase.SearchSKUs
+type StockServiceUseCaseMockSearchSKUsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSearchSKUs *mStockServiceUseCaseMockSearchSKUs) Optional() *mStockServiceUseCaseMockSearchSKUs {
+	mmSearchSKUs.optional = true
+	return mmSearchSKUs
+}
+
+// Expect sets up expected params for StockServiceUseCase.SearchSKUs
+func (mmSearchSKUs *mStockServiceUseCaseMockSearchSKUs) Expect(ctx context.Context, filter domain.SKUSearchFilter) *mStockServiceUseCaseMockSearchSKUs {
+	if mmSearchSKUs.mock.funcSearchSKUs != nil {
+		mmSearchSKUs.mock.t.Fatalf("StockServiceUseCaseMock.SearchSKUs mock is already set by Set")
+	}
+
+	if mmSearchSKUs.defaultExpectation == nil {
+		mmSearchSKUs.defaultExpectation = &StockServiceUseCaseMockSearchSKUsExpectation{}
+	}
+
+	if mmSearchSKUs.defaultExpectation.paramPtrs != nil {
+		mmSearchSKUs.mock.t.Fatalf("StockServiceUseCaseMock.SearchSKUs mock is already set by ExpectParams functions")
+	}
+
+	mmSearchSKUs.defaultExpectation.params = &StockServiceUseCaseMockSearchSKUsParams{ctx, filter}
+	mmSearchSKUs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSearchSKUs.expectations {
+		if minimock.Equal(e.params, mmSearchSKUs.defaultExpectation.params) {
+			mmSearchSKUs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSearchSKUs.defaultExpectation.params)
+		}
+	}
+
+	return mmSearchSKUs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.SearchSKUs
+func (mmSearchSKUs *mStockServiceUseCaseMockSearchSKUs) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockSearchSKUs {
+	if mmSearchSKUs.mock.funcSearchSKUs != nil {
+		mmSearchSKUs.mock.t.Fatalf("StockServiceUseCaseMock.SearchSKUs mock is already set by Set")
+	}
+
+	if mmSearchSKUs.defaultExpectation == nil {
+		mmSearchSKUs.defaultExpectation = &StockServiceUseCaseMockSearchSKUsExpectation{}
+	}
+
+	if mmSearchSKUs.defaultExpectation.params != nil {
+		mmSearchSKUs.mock.t.Fatalf("StockServiceUseCaseMock.SearchSKUs mock is already set by Expect")
+	}
+
+	if mmSearchSKUs.defaultExpectation.paramPtrs == nil {
+		mmSearchSKUs.defaultExpectation.paramPtrs = &StockServiceUseCaseMockSearchSKUsParamPtrs{}
+	}
+	mmSearchSKUs.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSearchSKUs.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSearchSKUs
+}
+
+// ExpectFilterParam2 sets up expected param filter for StockServiceUseCase.SearchSKUs
+func (mmSearchSKUs *mStockServiceUseCaseMockSearchSKUs) ExpectFilterParam2(filter domain.SKUSearchFilter) *mStockServiceUseCaseMockSearchSKUs {
+	if mmSearchSKUs.mock.funcSearchSKUs != nil {
+		mmSearchSKUs.mock.t.Fatalf("StockServiceUseCaseMock.SearchSKUs mock is already set by Set")
+	}
+
+	if mmSearchSKUs.defaultExpectation == nil {
+		mmSearchSKUs.defaultExpectation = &StockServiceUseCaseMockSearchSKUsExpectation{}
+	}
+
+	if mmSearchSKUs.defaultExpectation.params != nil {
+		mmSearchSKUs.mock.t.Fatalf("StockServiceUseCaseMock.SearchSKUs mock is already set by Expect")
+	}
+
+	if mmSearchSKUs.defaultExpectation.paramPtrs == nil {
+		mmSearchSKUs.defaultExpectation.paramPtrs = &StockServiceUseCaseMockSearchSKUsParamPtrs{}
+	}
+	mmSearchSKUs.defaultExpectation.paramPtrs.filter = &filter
+	mmSearchSKUs.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmSearchSKUs
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.SearchSKUs
+func (mmSearchSKUs *mStockServiceUseCaseMockSearchSKUs) Inspect(f func(ctx context.Context, filter domain.SKUSearchFilter)) *mStockServiceUseCaseMockSearchSKUs {
+	if mmSearchSKUs.mock.inspectFuncSearchSKUs != nil {
+		mmSearchSKUs.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.SearchSKUs")
+	}
+
+	mmSearchSKUs.mock.inspectFuncSearchSKUs = f
+
+	return mmSearchSKUs
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.SearchSKUs
+func (mmSearchSKUs *mStockServiceUseCaseMockSearchSKUs) Return(s1 domain.SKUSearchResponse, err error) *StockServiceUseCaseMock {
+	if mmSearchSKUs.mock.funcSearchSKUs != nil {
+		mmSearchSKUs.mock.t.Fatalf("StockServiceUseCaseMock.SearchSKUs mock is already set by Set")
+	}
+
+	if mmSearchSKUs.defaultExpectation == nil {
+		mmSearchSKUs.defaultExpectation = &StockServiceUseCaseMockSearchSKUsExpectation{mock: mmSearchSKUs.mock}
+	}
+	mmSearchSKUs.defaultExpectation.results = &StockServiceUseCaseMockSearchSKUsResults{s1, err}
+	mmSearchSKUs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSearchSKUs.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.SearchSKUs method
+func (mmSearchSKUs *mStockServiceUseCaseMockSearchSKUs) Set(f func(ctx context.Context, filter domain.SKUSearchFilter) (s1 domain.SKUSearchResponse, err error)) *StockServiceUseCaseMock {
+	if mmSearchSKUs.defaultExpectation != nil {
+		mmSearchSKUs.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.SearchSKUs method")
+	}
+
+	if len(mmSearchSKUs.expectations) > 0 {
+		mmSearchSKUs.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.SearchSKUs method")
+	}
+
+	mmSearchSKUs.mock.funcSearchSKUs = f
+	mmSearchSKUs.mock.funcSearchSKUsOrigin = minimock.CallerInfo(1)
+	return mmSearchSKUs.mock
+}
+
+// When sets expectation for the StockServiceUseCase.SearchSKUs which will trigger the result defined by the following
+// Then helper
+func (mmSearchSKUs *mStockServiceUseCaseMockSearchSKUs) When(ctx context.Context, filter domain.SKUSearchFilter) *StockServiceUseCaseMockSearchSKUsExpectation {
+	if mmSearchSKUs.mock.funcSearchSKUs != nil {
+		mmSearchSKUs.mock.t.Fatalf("StockServiceUseCaseMock.SearchSKUs mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockSearchSKUsExpectation{
+		mock:               mmSearchSKUs.mock,
+		params:             &StockServiceUseCaseMockSearchSKUsParams{ctx, filter},
+		expectationOrigins: StockServiceUseCaseMockSearchSKUsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSearchSKUs.expectations = append(mmSearchSKUs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.SearchSKUs return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockSearchSKUsExpectation) Then(s1 domain.SKUSearchResponse, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockSearchSKUsResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.SearchSKUs should be invoked
+func (mmSearchSKUs *mStockServiceUseCaseMockSearchSKUs) Times(n uint64) *mStockServiceUseCaseMockSearchSKUs {
+	if n == 0 {
+		mmSearchSKUs.mock.t.Fatalf("Times of StockServiceUseCaseMock.SearchSKUs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSearchSKUs.expectedInvocations, n)
+	mmSearchSKUs.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSearchSKUs
+}
+
+func (mmSearchSKUs *mStockServiceUseCaseMockSearchSKUs) invocationsDone() bool {
+	if len(mmSearchSKUs.expectations) == 0 && mmSearchSKUs.defaultExpectation == nil && mmSearchSKUs.mock.funcSearchSKUs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSearchSKUs.mock.afterSearchSKUsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSearchSKUs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SearchSKUs implements mm_usecase.StockServiceUseCase
+func (mmSearchSKUs *StockServiceUseCaseMock) SearchSKUs(ctx context.Context, filter domain.SKUSearchFilter) (s1 domain.SKUSearchResponse, err error) {
+	mm_atomic.AddUint64(&mmSearchSKUs.beforeSearchSKUsCounter, 1)
+	defer mm_atomic.AddUint64(&mmSearchSKUs.afterSearchSKUsCounter, 1)
+
+	mmSearchSKUs.t.Helper()
+
+	if mmSearchSKUs.inspectFuncSearchSKUs != nil {
+		mmSearchSKUs.inspectFuncSearchSKUs(ctx, filter)
+	}
+
+	mm_params := StockServiceUseCaseMockSearchSKUsParams{ctx, filter}
+
+	// Record call args
+	mmSearchSKUs.SearchSKUsMock.mutex.Lock()
+	mmSearchSKUs.SearchSKUsMock.callArgs = append(mmSearchSKUs.SearchSKUsMock.callArgs, &mm_params)
+	mmSearchSKUs.SearchSKUsMock.mutex.Unlock()
+
+	for _, e := range mmSearchSKUs.SearchSKUsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmSearchSKUs.SearchSKUsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSearchSKUs.SearchSKUsMock.defaultExpectation.Counter, 1)
+		mm_want := mmSearchSKUs.SearchSKUsMock.defaultExpectation.params
+		mm_want_ptrs := mmSearchSKUs.SearchSKUsMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockSearchSKUsParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSearchSKUs.t.Errorf("StockServiceUseCaseMock.SearchSKUs got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchSKUs.SearchSKUsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmSearchSKUs.t.Errorf("StockServiceUseCaseMock.SearchSKUs got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchSKUs.SearchSKUsMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSearchSKUs.t.Errorf("StockServiceUseCaseMock.SearchSKUs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSearchSKUs.SearchSKUsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSearchSKUs.SearchSKUsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSearchSKUs.t.Fatal("No results are set for the StockServiceUseCaseMock.SearchSKUs")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmSearchSKUs.funcSearchSKUs != nil {
+		return mmSearchSKUs.funcSearchSKUs(ctx, filter)
+	}
+	mmSearchSKUs.t.Fatalf("Unexpected call to StockServiceUseCaseMock.SearchSKUs. %v %v", ctx, filter)
+	return
+}
+
+// SearchSKUsAfterCounter returns a count of finished StockServiceUseCaseMock.SearchSKUs invocations
+func (mmSearchSKUs *StockServiceUseCaseMock) SearchSKUsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchSKUs.afterSearchSKUsCounter)
+}
+
+// SearchSKUsBeforeCounter returns a count of StockServiceUseCaseMock.SearchSKUs invocations
+func (mmSearchSKUs *StockServiceUseCaseMock) SearchSKUsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchSKUs.beforeSearchSKUsCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.SearchSKUs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSearchSKUs *mStockServiceUseCaseMockSearchSKUs) Calls() []*StockServiceUseCaseMockSearchSKUsParams {
+	mmSearchSKUs.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockSearchSKUsParams, len(mmSearchSKUs.callArgs))
+	copy(argCopy, mmSearchSKUs.callArgs)
+
+	mmSearchSKUs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSearchSKUsDone returns true if the count of the SearchSKUs invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockSearchSKUsDone() bool {
+	if m.SearchSKUsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SearchSKUsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SearchSKUsMock.invocationsDone()
+}
+
+// MinimockSearchSKUsInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockSearchSKUsInspect() {
+	for _, e := range m.SearchSKUsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.SearchSKUs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSearchSKUsCounter := mm_atomic.LoadUint64(&m.afterSearchSKUsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SearchSKUsMock.defaultExpectation != nil && afterSearchSKUsCounter < 1 {
+		if m.SearchSKUsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.SearchSKUs at\n%s", m.SearchSKUsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.SearchSKUs at\n%s with params: %#v", m.SearchSKUsMock.defaultExpectation.expectationOrigins.origin, *m.SearchSKUsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSearchSKUs != nil && afterSearchSKUsCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.SearchSKUs at\n%s", m.funcSearchSKUsOrigin)
+	}
+
+	if !m.SearchSKUsMock.invocationsDone() && afterSearchSKUsCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.SearchSKUs at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SearchSKUsMock.expectedInvocations), m.SearchSKUsMock.expectedInvocationsOrigin, afterSearchSKUsCounter)
+	}
+}
+
+type mStockServiceUseCaseMockSetAttributeSchema struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockSetAttributeSchemaExpectation
+	expectations       []*StockServiceUseCaseMockSetAttributeSchemaExpectation
+
+	callArgs []*StockServiceUseCaseMockSetAttributeSchemaParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockSetAttributeSchemaExpectation specifies expectation struct of the StockServiceUseCase.SetAttributeSchema
+type StockServiceUseCaseMockSetAttributeSchemaExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockSetAttributeSchemaParams
+	paramPtrs          *StockServiceUseCaseMockSetAttributeSchemaParamPtrs
+	expectationOrigins StockServiceUseCaseMockSetAttributeSchemaExpectationOrigins
+	results            *StockServiceUseCaseMockSetAttributeSchemaResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockSetAttributeSchemaParams contains parameters of the StockServiceUseCase.SetAttributeSchema
+type StockServiceUseCaseMockSetAttributeSchemaParams struct {
+	ctx    context.Context
+	schema domain.AttributeSchema
+}
+
+// StockServiceUseCaseMockSetAttributeSchemaParamPtrs contains pointers to parameters of the StockServiceUseCase.SetAttributeSchema
+type StockServiceUseCaseMockSetAttributeSchemaParamPtrs struct {
+	ctx    *context.Context
+	schema *domain.AttributeSchema
+}
+
+// StockServiceUseCaseMockSetAttributeSchemaResults contains results of the StockServiceUseCase.SetAttributeSchema
+type StockServiceUseCaseMockSetAttributeSchemaResults struct {
+	err error
+}
+
+// StockServiceUseCaseMockSetAttributeSchemaOrigins contains origins of expectations of the StockServiceUseCase.SetAttributeSchema
+type StockServiceUseCaseMockSetAttributeSchemaExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originSchema string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetAttributeSchema *mStockServiceUseCaseMockSetAttributeSchema) Optional() *mStockServiceUseCaseMockSetAttributeSchema {
+	mmSetAttributeSchema.optional = true
+	return mmSetAttributeSchema
+}
+
+// Expect sets up expected params for StockServiceUseCase.SetAttributeSchema
+func (mmSetAttributeSchema *mStockServiceUseCaseMockSetAttributeSchema) Expect(ctx context.Context, schema domain.AttributeSchema) *mStockServiceUseCaseMockSetAttributeSchema {
+	if mmSetAttributeSchema.mock.funcSetAttributeSchema != nil {
+		mmSetAttributeSchema.mock.t.Fatalf("StockServiceUseCaseMock.SetAttributeSchema mock is already set by Set")
+	}
+
+	if mmSetAttributeSchema.defaultExpectation == nil {
+		mmSetAttributeSchema.defaultExpectation = &StockServiceUseCaseMockSetAttributeSchemaExpectation{}
+	}
+
+	if mmSetAttributeSchema.defaultExpectation.paramPtrs != nil {
+		mmSetAttributeSchema.mock.t.Fatalf("StockServiceUseCaseMock.SetAttributeSchema mock is already set by ExpectParams functions")
+	}
+
+	mmSetAttributeSchema.defaultExpectation.params = &StockServiceUseCaseMockSetAttributeSchemaParams{ctx, schema}
+	mmSetAttributeSchema.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetAttributeSchema.expectations {
+		if minimock.Equal(e.params, mmSetAttributeSchema.defaultExpectation.params) {
+			mmSetAttributeSchema.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetAttributeSchema.defaultExpectation.params)
+		}
+	}
+
+	return mmSetAttributeSchema
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.SetAttributeSchema
+func (mmSetAttributeSchema *mStockServiceUseCaseMockSetAttributeSchema) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockSetAttributeSchema {
+	if mmSetAttributeSchema.mock.funcSetAttributeSchema != nil {
+		mmSetAttributeSchema.mock.t.Fatalf("StockServiceUseCaseMock.SetAttributeSchema mock is already set by Set")
+	}
+
+	if mmSetAttributeSchema.defaultExpectation == nil {
+		mmSetAttributeSchema.defaultExpectation = &StockServiceUseCaseMockSetAttributeSchemaExpectation{}
+	}
+
+	if mmSetAttributeSchema.defaultExpectation.params != nil {
+		mmSetAttributeSchema.mock.t.Fatalf("StockServiceUseCaseMock.SetAttributeSchema mock is already set by Expect")
+	}
+
+	if mmSetAttributeSchema.defaultExpectation.paramPtrs == nil {
+		mmSetAttributeSchema.defaultExpectation.paramPtrs = &StockServiceUseCaseMockSetAttributeSchemaParamPtrs{}
+	}
+	mmSetAttributeSchema.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetAttributeSchema.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetAttributeSchema
+}
+
+// ExpectSchemaParam2 sets up expected param schema for StockServiceUseCase.SetAttributeSchema
+func (mmSetAttributeSchema *mStockServiceUseCaseMockSetAttributeSchema) ExpectSchemaParam2(schema domain.AttributeSchema) *mStockServiceUseCaseMockSetAttributeSchema {
+	if mmSetAttributeSchema.mock.funcSetAttributeSchema != nil {
+		mmSetAttributeSchema.mock.t.Fatalf("StockServiceUseCaseMock.SetAttributeSchema mock is already set by Set")
+	}
+
+	if mmSetAttributeSchema.defaultExpectation == nil {
+		mmSetAttributeSchema.defaultExpectation = &StockServiceUseCaseMockSetAttributeSchemaExpectation{}
+	}
+
+	if mmSetAttributeSchema.defaultExpectation.params != nil {
+		mmSetAttributeSchema.mock.t.Fatalf("StockServiceUseCaseMock.SetAttributeSchema mock is already set by Expect")
+	}
+
+	if mmSetAttributeSchema.defaultExpectation.paramPtrs == nil {
+		mmSetAttributeSchema.defaultExpectation.paramPtrs = &StockServiceUseCaseMockSetAttributeSchemaParamPtrs{}
+	}
+	mmSetAttributeSchema.defaultExpectation.paramPtrs.schema = &schema
+	mmSetAttributeSchema.defaultExpectation.expectationOrigins.originSchema = minimock.CallerInfo(1)
+
+	return mmSetAttributeSchema
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.SetAttributeSchema
+func (mmSetAttributeSchema *mStockServiceUseCaseMockSetAttributeSchema) Inspect(f func(ctx context.Context, schema domain.AttributeSchema)) *mStockServiceUseCaseMockSetAttributeSchema {
+	if mmSetAttributeSchema.mock.inspectFuncSetAttributeSchema != nil {
+		mmSetAttributeSchema.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.SetAttributeSchema")
+	}
+
+	mmSetAttributeSchema.mock.inspectFuncSetAttributeSchema = f
+
+	return mmSetAttributeSchema
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.SetAttributeSchema
+func (mmSetAttributeSchema *mStockServiceUseCaseMockSetAttributeSchema) Return(err error) *StockServiceUseCaseMock {
+	if mmSetAttributeSchema.mock.funcSetAttributeSchema != nil {
+		mmSetAttributeSchema.mock.t.Fatalf("StockServiceUseCaseMock.SetAttributeSchema mock is already set by Set")
+	}
+
+	if mmSetAttributeSchema.defaultExpectation == nil {
+		mmSetAttributeSchema.defaultExpectation = &StockServiceUseCaseMockSetAttributeSchemaExpectation{mock: mmSetAttributeSchema.mock}
+	}
+	mmSetAttributeSchema.defaultExpectation.results = &StockServiceUseCaseMockSetAttributeSchemaResults{err}
+	mmSetAttributeSchema.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetAttributeSchema.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.SetAttributeSchema method
+func (mmSetAttributeSchema *mStockServiceUseCaseMockSetAttributeSchema) Set(f func(ctx context.Context, schema domain.AttributeSchema) (err error)) *StockServiceUseCaseMock {
+	if mmSetAttributeSchema.defaultExpectation != nil {
+		mmSetAttributeSchema.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.SetAttributeSchema method")
+	}
+
+	if len(mmSetAttributeSchema.expectations) > 0 {
+		mmSetAttributeSchema.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.SetAttributeSchema method")
+	}
+
+	mmSetAttributeSchema.mock.funcSetAttributeSchema = f
+	mmSetAttributeSchema.mock.funcSetAttributeSchemaOrigin = minimock.CallerInfo(1)
+	return mmSetAttributeSchema.mock
+}
+
+// When sets expectation for the StockServiceUseCase.SetAttributeSchema which will trigger the result defined by the following
+// Then helper
+func (mmSetAttributeSchema *mStockServiceUseCaseMockSetAttributeSchema) When(ctx context.Context, schema domain.AttributeSchema) *StockServiceUseCaseMockSetAttributeSchemaExpectation {
+	if mmSetAttributeSchema.mock.funcSetAttributeSchema != nil {
+		mmSetAttributeSchema.mock.t.Fatalf("StockServiceUseCaseMock.SetAttributeSchema mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockSetAttributeSchemaExpectation{
+		mock:               mmSetAttributeSchema.mock,
+		params:             &StockServiceUseCaseMockSetAttributeSchemaParams{ctx, schema},
+		expectationOrigins: StockServiceUseCaseMockSetAttributeSchemaExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetAttributeSchema.expectations = append(mmSetAttributeSchema.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.SetAttributeSchema return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockSetAttributeSchemaExpectation) Then(err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockSetAttributeSchemaResults{err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.SetAttributeSchema should be invoked
+func (mmSetAttributeSchema *mStockServiceUseCaseMockSetAttributeSchema) Times(n uint64) *mStockServiceUseCaseMockSetAttributeSchema {
+	if n == 0 {
+		mmSetAttributeSchema.mock.t.Fatalf("Times of StockServiceUseCaseMock.SetAttributeSchema mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetAttributeSchema.expectedInvocations, n)
+	mmSetAttributeSchema.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetAttributeSchema
+}
+
+func (mmSetAttributeSchema *mStockServiceUseCaseMockSetAttributeSchema) invocationsDone() bool {
+	if len(mmSetAttributeSchema.expectations) == 0 && mmSetAttributeSchema.defaultExpectation == nil && mmSetAttributeSchema.mock.funcSetAttributeSchema == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetAttributeSchema.mock.afterSetAttributeSchemaCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetAttributeSchema.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetAttributeSchema implements mm_usecase.StockServiceUseCase
+func (mmSetAttributeSchema *StockServiceUseCaseMock) SetAttributeSchema(ctx context.Context, schema domain.AttributeSchema) (err error) {
+	mm_atomic.AddUint64(&mmSetAttributeSchema.beforeSetAttributeSchemaCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetAttributeSchema.afterSetAttributeSchemaCounter, 1)
+
+	mmSetAttributeSchema.t.Helper()
+
+	if mmSetAttributeSchema.inspectFuncSetAttributeSchema != nil {
+		mmSetAttributeSchema.inspectFuncSetAttributeSchema(ctx, schema)
+	}
+
+	mm_params := StockServiceUseCaseMockSetAttributeSchemaParams{ctx, schema}
+
+	// Record call args
+	mmSetAttributeSchema.SetAttributeSchemaMock.mutex.Lock()
+	mmSetAttributeSchema.SetAttributeSchemaMock.callArgs = append(mmSetAttributeSchema.SetAttributeSchemaMock.callArgs, &mm_params)
+	mmSetAttributeSchema.SetAttributeSchemaMock.mutex.Unlock()
+
+	for _, e := range mmSetAttributeSchema.SetAttributeSchemaMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetAttributeSchema.SetAttributeSchemaMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetAttributeSchema.SetAttributeSchemaMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetAttributeSchema.SetAttributeSchemaMock.defaultExpectation.params
+		mm_want_ptrs := mmSetAttributeSchema.SetAttributeSchemaMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockSetAttributeSchemaParams{ctx, schema}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetAttributeSchema.t.Errorf("StockServiceUseCaseMock.SetAttributeSchema got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetAttributeSchema.SetAttributeSchemaMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.schema != nil && !minimock.Equal(*mm_want_ptrs.schema, mm_got.schema) {
+				mmSetAttributeSchema.t.Errorf("StockServiceUseCaseMock.SetAttributeSchema got unexpected parameter schema, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetAttributeSchema.SetAttributeSchemaMock.defaultExpectation.expectationOrigins.originSchema, *mm_want_ptrs.schema, mm_got.schema, minimock.Diff(*mm_want_ptrs.schema, mm_got.schema))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetAttributeSchema.t.Errorf("StockServiceUseCaseMock.SetAttributeSchema got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetAttributeSchema.SetAttributeSchemaMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetAttributeSchema.SetAttributeSchemaMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetAttributeSchema.t.Fatal("No results are set for the StockServiceUseCaseMock.SetAttributeSchema")
+		}
+		return (*mm_results).err
+	}
+	if mmSetAttributeSchema.funcSetAttributeSchema != nil {
+		return mmSetAttributeSchema.funcSetAttributeSchema(ctx, schema)
+	}
+	mmSetAttributeSchema.t.Fatalf("Unexpected call to StockServiceUseCaseMock.SetAttributeSchema. %v %v", ctx, schema)
+	return
+}
+
+// SetAttributeSchemaAfterCounter returns a count of finished StockServiceUseCaseMock.SetAttributeSchema invocations
+func (mmSetAttributeSchema *StockServiceUseCaseMock) SetAttributeSchemaAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetAttributeSchema.afterSetAttributeSchemaCounter)
+}
+
+// SetAttributeSchemaBeforeCounter returns a count of StockServiceUseCaseMock.SetAttributeSchema invocations
+func (mmSetAttributeSchema *StockServiceUseCaseMock) SetAttributeSchemaBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetAttributeSchema.beforeSetAttributeSchemaCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.SetAttributeSchema.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetAttributeSchema *mStockServiceUseCaseMockSetAttributeSchema) Calls() []*StockServiceUseCaseMockSetAttributeSchemaParams {
+	mmSetAttributeSchema.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockSetAttributeSchemaParams, len(mmSetAttributeSchema.callArgs))
+	copy(argCopy, mmSetAttributeSchema.callArgs)
+
+	mmSetAttributeSchema.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetAttributeSchemaDone returns true if the count of the SetAttributeSchema invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockSetAttributeSchemaDone() bool {
+	if m.SetAttributeSchemaMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetAttributeSchemaMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetAttributeSchemaMock.invocationsDone()
+}
+
+// MinimockSetAttributeSchemaInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockSetAttributeSchemaInspect() {
+	for _, e := range m.SetAttributeSchemaMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.SetAttributeSchema at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetAttributeSchemaCounter := mm_atomic.LoadUint64(&m.afterSetAttributeSchemaCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetAttributeSchemaMock.defaultExpectation != nil && afterSetAttributeSchemaCounter < 1 {
+		if m.SetAttributeSchemaMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.SetAttributeSchema at\n%s", m.SetAttributeSchemaMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.SetAttributeSchema at\n%s with params: %#v", m.SetAttributeSchemaMock.defaultExpectation.expectationOrigins.origin, *m.SetAttributeSchemaMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetAttributeSchema != nil && afterSetAttributeSchemaCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.SetAttributeSchema at\n%s", m.funcSetAttributeSchemaOrigin)
+	}
+
+	if !m.SetAttributeSchemaMock.invocationsDone() && afterSetAttributeSchemaCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.SetAttributeSchema at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetAttributeSchemaMock.expectedInvocations), m.SetAttributeSchemaMock.expectedInvocationsOrigin, afterSetAttributeSchemaCounter)
+	}
+}
+
+type mStockServiceUseCaseMockSetBackorderSettings struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockSetBackorderSettingsExpectation
+	expectations       []*StockServiceUseCaseMockSetBackorderSettingsExpectation
+
+	callArgs []*StockServiceUseCaseMockSetBackorderSettingsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockSetBackorderSettingsExpectation specifies expectation struct of the StockServiceUseCase.SetBackorderSettings
+type StockServiceUseCaseMockSetBackorderSettingsExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockSetBackorderSettingsParams
+	paramPtrs          *StockServiceUseCaseMockSetBackorderSettingsParamPtrs
+	expectationOrigins StockServiceUseCaseMockSetBackorderSettingsExpectationOrigins
+	results            *StockServiceUseCaseMockSetBackorderSettingsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockSetBackorderSettingsParams contains parameters of the StockServiceUseCase.SetBackorderSettings
+type StockServiceUseCaseMockSetBackorderSettingsParams struct {
+	ctx      context.Context
+	settings domain.BackorderSettings
+}
+
+// StockServiceUseCaseMockSetBackorderSettingsParamPtrs contains pointers to parameters of the StockServiceUseCase.SetBackorderSettings
+type StockServiceUseCaseMockSetBackorderSettingsParamPtrs struct {
+	ctx      *context.Context
+	settings *domain.BackorderSettings
+}
+
+// StockServiceUseCaseMockSetBackorderSettingsResults contains results of the StockServiceUseCase.SetBackorderSettings
+type StockServiceUseCaseMockSetBackorderSettingsResults struct {
+	err error
+}
+
+// StockServiceUseCaseMockSetBackorderSettingsOrigins contains origins of expectations of the StockServiceUseCase.SetBackorderSettings
+type StockServiceUseCaseMockSetBackorderSettingsExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originSettings string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetBackorderSettings *mStockServiceUseCaseMockSetBackorderSettings) Optional() *mStockServiceUseCaseMockSetBackorderSettings {
+	mmSetBackorderSettings.optional = true
+	return mmSetBackorderSettings
+}
+
+// Expect sets up expected params for StockServiceUseCase.SetBackorderSettings
+func (mmSetBackorderSettings *mStockServiceUseCaseMockSetBackorderSettings) Expect(ctx context.Context, settings domain.BackorderSettings) *mStockServiceUseCaseMockSetBackorderSettings {
+	if mmSetBackorderSettings.mock.funcSetBackorderSettings != nil {
+		mmSetBackorderSettings.mock.t.Fatalf("StockServiceUseCaseMock.SetBackorderSettings mock is already set by Set")
+	}
+
+	if mmSetBackorderSettings.defaultExpectation == nil {
+		mmSetBackorderSettings.defaultExpectation = &StockServiceUseCaseMockSetBackorderSettingsExpectation{}
+	}
+
+	if mmSetBackorderSettings.defaultExpectation.paramPtrs != nil {
+		mmSetBackorderSettings.mock.t.Fatalf("StockServiceUseCaseMock.SetBackorderSettings mock is already set by ExpectParams functions")
+	}
+
+	mmSetBackorderSettings.defaultExpectation.params = &StockServiceUseCaseMockSetBackorderSettingsParams{ctx, settings}
+	mmSetBackorderSettings.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetBackorderSettings.expectations {
+		if minimock.Equal(e.params, mmSetBackorderSettings.defaultExpectation.params) {
+			mmSetBackorderSettings.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetBackorderSettings.defaultExpectation.params)
+		}
+	}
+
+	return mmSetBackorderSettings
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.SetBackorderSettings
+func (mmSetBackorderSettings *mStockServiceUseCaseMockSetBackorderSettings) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockSetBackorderSettings {
+	if mmSetBackorderSettings.mock.funcSetBackorderSettings != nil {
+		mmSetBackorderSettings.mock.t.Fatalf("StockServiceUseCaseMock.SetBackorderSettings mock is already set by Set")
+	}
+
+	if mmSetBackorderSettings.defaultExpectation == nil {
+		mmSetBackorderSettings.defaultExpectation = &StockServiceUseCaseMockSetBackorderSettingsExpectation{}
+	}
+
+	if mmSetBackorderSettings.defaultExpectation.params != nil {
+		mmSetBackorderSettings.mock.t.Fatalf("StockServiceUseCaseMock.SetBackorderSettings mock is already set by Expect")
+	}
+
+	if mmSetBackorderSettings.defaultExpectation.paramPtrs == nil {
+		mmSetBackorderSettings.defaultExpectation.paramPtrs = &StockServiceUseCaseMockSetBackorderSettingsParamPtrs{}
+	}
+	mmSetBackorderSettings.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetBackorderSettings.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetBackorderSettings
+}
+
+// ExpectSettingsParam2 sets up expected param settings for StockServiceUseCase.SetBackorderSettings
+func (mmSetBackorderSettings *mStockServiceUseCaseMockSetBackorderSettings) ExpectSettingsParam2(settings domain.BackorderSettings) *mStockServiceUseCaseMockSetBackorderSettings {
+	if mmSetBackorderSettings.mock.funcSetBackorderSettings != nil {
+		mmSetBackorderSettings.mock.t.Fatalf("StockServiceUseCaseMock.SetBackorderSettings mock is already set by Set")
+	}
+
+	if mmSetBackorderSettings.defaultExpectation == nil {
+		mmSetBackorderSettings.defaultExpectation = &StockServiceUseCaseMockSetBackorderSettingsExpectation{}
+	}
+
+	if mmSetBackorderSettings.defaultExpectation.params != nil {
+		mmSetBackorderSettings.mock.t.Fatalf("StockServiceUseCaseMock.SetBackorderSettings mock is already set by Expect")
+	}
+
+	if mmSetBackorderSettings.defaultExpectation.paramPtrs == nil {
+		mmSetBackorderSettings.defaultExpectation.paramPtrs = &StockServiceUseCaseMockSetBackorderSettingsParamPtrs{}
+	}
+	mmSetBackorderSettings.defaultExpectation.paramPtrs.settings = &settings
+	mmSetBackorderSettings.defaultExpectation.expectationOrigins.originSettings = minimock.CallerInfo(1)
+
+	return mmSetBackorderSettings
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.SetBackorderSettings
+func (mmSetBackorderSettings *mStockServiceUseCaseMockSetBackorderSettings) Inspect(f func(ctx context.Context, settings domain.BackorderSettings)) *mStockServiceUseCaseMockSetBackorderSettings {
+	if mmSetBackorderSettings.mock.inspectFuncSetBackorderSettings != nil {
+		mmSetBackorderSettings.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.SetBackorderSettings")
+	}
+
+	mmSetBackorderSettings.mock.inspectFuncSetBackorderSettings = f
+
+	return mmSetBackorderSettings
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.SetBackorderSettings
+func (mmSetBackorderSettings *mStockServiceUseCaseMockSetBackorderSettings) Return(err error) *StockServiceUseCaseMock {
+	if mmSetBackorderSettings.mock.funcSetBackorderSettings != nil {
+		mmSetBackorderSettings.mock.t.Fatalf("StockServiceUseCaseMock.SetBackorderSettings mock is already set by Set")
+	}
+
+	if mmSetBackorderSettings.defaultExpectation == nil {
+		mmSetBackorderSettings.defaultExpectation = &StockServiceUseCaseMockSetBackorderSettingsExpectation{mock: mmSetBackorderSettings.mock}
+	}
+	mmSetBackorderSettings.defaultExpectation.results = &StockServiceUseCaseMockSetBackorderSettingsResults{err}
+	mmSetBackorderSettings.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetBackorderSettings.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.SetBackorderSettings method
+func (mmSetBackorderSettings *mStockServiceUseCaseMockSetBackorderSettings) Set(f func(ctx context.Context, settings domain.BackorderSettings) (err error)) *StockServiceUseCaseMock {
+	if mmSetBackorderSettings.defaultExpectation != nil {
+		mmSetBackorderSettings.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.SetBackorderSettings method")
+	}
+
+	if len(mmSetBackorderSettings.expectations) > 0 {
+		mmSetBackorderSettings.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.SetBackorderSettings method")
+	}
+
+	mmSetBackorderSettings.mock.funcSetBackorderSettings = f
+	mmSetBackorderSettings.mock.funcSetBackorderSettingsOrigin = minimock.CallerInfo(1)
+	return mmSetBackorderSettings.mock
+}
+
+// When sets expectation for the StockServiceUseCase.SetBackorderSettings which will trigger the result defined by the following
+// Then helper
+func (mmSetBackorderSettings *mStockServiceUseCaseMockSetBackorderSettings) When(ctx context.Context, settings domain.BackorderSettings) *StockServiceUseCaseMockSetBackorderSettingsExpectation {
+	if mmSetBackorderSettings.mock.funcSetBackorderSettings != nil {
+		mmSetBackorderSettings.mock.t.Fatalf("StockServiceUseCaseMock.SetBackorderSettings mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockSetBackorderSettingsExpectation{
+		mock:               mmSetBackorderSettings.mock,
+		params:             &StockServiceUseCaseMockSetBackorderSettingsParams{ctx, settings},
+		expectationOrigins: StockServiceUseCaseMockSetBackorderSettingsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetBackorderSettings.expectations = append(mmSetBackorderSettings.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.SetBackorderSettings return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockSetBackorderSettingsExpectation) Then(err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockSetBackorderSettingsResults{err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.SetBackorderSettings should be invoked
+func (mmSetBackorderSettings *mStockServiceUseCaseMockSetBackorderSettings) Times(n uint64) *mStockServiceUseCaseMockSetBackorderSettings {
+	if n == 0 {
+		mmSetBackorderSettings.mock.t.Fatalf("Times of StockServiceUseCaseMock.SetBackorderSettings mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetBackorderSettings.expectedInvocations, n)
+	mmSetBackorderSettings.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetBackorderSettings
+}
+
+func (mmSetBackorderSettings *mStockServiceUseCaseMockSetBackorderSettings) invocationsDone() bool {
+	if len(mmSetBackorderSettings.expectations) == 0 && mmSetBackorderSettings.defaultExpectation == nil && mmSetBackorderSettings.mock.funcSetBackorderSettings == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetBackorderSettings.mock.afterSetBackorderSettingsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetBackorderSettings.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetBackorderSettings implements mm_usecase.StockServiceUseCase
+func (mmSetBackorderSettings *StockServiceUseCaseMock) SetBackorderSettings(ctx context.Context, settings domain.BackorderSettings) (err error) {
+	mm_atomic.AddUint64(&mmSetBackorderSettings.beforeSetBackorderSettingsCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetBackorderSettings.afterSetBackorderSettingsCounter, 1)
+
+	mmSetBackorderSettings.t.Helper()
+
+	if mmSetBackorderSettings.inspectFuncSetBackorderSettings != nil {
+		mmSetBackorderSettings.inspectFuncSetBackorderSettings(ctx, settings)
+	}
+
+	mm_params := StockServiceUseCaseMockSetBackorderSettingsParams{ctx, settings}
+
+	// Record call args
+	mmSetBackorderSettings.SetBackorderSettingsMock.mutex.Lock()
+	mmSetBackorderSettings.SetBackorderSettingsMock.callArgs = append(mmSetBackorderSettings.SetBackorderSettingsMock.callArgs, &mm_params)
+	mmSetBackorderSettings.SetBackorderSettingsMock.mutex.Unlock()
+
+	for _, e := range mmSetBackorderSettings.SetBackorderSettingsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetBackorderSettings.SetBackorderSettingsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetBackorderSettings.SetBackorderSettingsMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetBackorderSettings.SetBackorderSettingsMock.defaultExpectation.params
+		mm_want_ptrs := mmSetBackorderSettings.SetBackorderSettingsMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockSetBackorderSettingsParams{ctx, settings}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetBackorderSettings.t.Errorf("StockServiceUseCaseMock.SetBackorderSettings got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetBackorderSettings.SetBackorderSettingsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.settings != nil && !minimock.Equal(*mm_want_ptrs.settings, mm_got.settings) {
+				mmSetBackorderSettings.t.Errorf("StockServiceUseCaseMock.SetBackorderSettings got unexpected parameter settings, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetBackorderSettings.SetBackorderSettingsMock.defaultExpectation.expectationOrigins.originSettings, *mm_want_ptrs.settings, mm_got.settings, minimock.Diff(*mm_want_ptrs.settings, mm_got.settings))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetBackorderSettings.t.Errorf("StockServiceUseCaseMock.SetBackorderSettings got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetBackorderSettings.SetBackorderSettingsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetBackorderSettings.SetBackorderSettingsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetBackorderSettings.t.Fatal("No results are set for the StockServiceUseCaseMock.SetBackorderSettings")
+		}
+		return (*mm_results).err
+	}
+	if mmSetBackorderSettings.funcSetBackorderSettings != nil {
+		return mmSetBackorderSettings.funcSetBackorderSettings(ctx, settings)
+	}
+	mmSetBackorderSettings.t.Fatalf("Unexpected call to StockServiceUseCaseMock.SetBackorderSettings. %v %v", ctx, settings)
+	return
+}
+
+// SetBackorderSettingsAfterCounter returns a count of finished StockServiceUseCaseMock.SetBackorderSettings invocations
+func (mmSetBackorderSettings *StockServiceUseCaseMock) SetBackorderSettingsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetBackorderSettings.afterSetBackorderSettingsCounter)
+}
+
+// SetBackorderSettingsBeforeCounter returns a count of StockServiceUseCaseMock.SetBackorderSettings invocations
+func (mmSetBackorderSettings *StockServiceUseCaseMock) SetBackorderSettingsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetBackorderSettings.beforeSetBackorderSettingsCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.SetBackorderSettings.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetBackorderSettings *mStockServiceUseCaseMockSetBackorderSettings) Calls() []*StockServiceUseCaseMockSetBackorderSettingsParams {
+	mmSetBackorderSettings.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockSetBackorderSettingsParams, len(mmSetBackorderSettings.callArgs))
+	copy(argCopy, mmSetBackorderSettings.callArgs)
+
+	mmSetBackorderSettings.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetBackorderSettingsDone returns true if the count of the SetBackorderSettings invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockSetBackorderSettingsDone() bool {
+	if m.SetBackorderSettingsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetBackorderSettingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetBackorderSettingsMock.invocationsDone()
+}
+
+// MinimockSetBackorderSettingsInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockSetBackorderSettingsInspect() {
+	for _, e := range m.SetBackorderSettingsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.SetBackorderSettings at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetBackorderSettingsCounter := mm_atomic.LoadUint64(&m.afterSetBackorderSettingsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetBackorderSettingsMock.defaultExpectation != nil && afterSetBackorderSettingsCounter < 1 {
+		if m.SetBackorderSettingsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.SetBackorderSettings at\n%s", m.SetBackorderSettingsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.SetBackorderSettings at\n%s with params: %#v", m.SetBackorderSettingsMock.defaultExpectation.expectationOrigins.origin, *m.SetBackorderSettingsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetBackorderSettings != nil && afterSetBackorderSettingsCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.SetBackorderSettings at\n%s", m.funcSetBackorderSettingsOrigin)
+	}
+
+	if !m.SetBackorderSettingsMock.invocationsDone() && afterSetBackorderSettingsCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.SetBackorderSettings at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetBackorderSettingsMock.expectedInvocations), m.SetBackorderSettingsMock.expectedInvocationsOrigin, afterSetBackorderSettingsCounter)
+	}
+}
+
+type mStockServiceUseCaseMockSetBundle struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockSetBundleExpectation
+	expectations       []*StockServiceUseCaseMockSetBundleExpectation
+
+	callArgs []*StockServiceUseCaseMockSetBundleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockSetBundleExpectation specifies expectation struct of the StockServiceUseCase.SetBundle
+type StockServiceUseCaseMockSetBundleExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockSetBundleParams
+	paramPtrs          *StockServiceUseCaseMockSetBundleParamPtrs
+	expectationOrigins StockServiceUseCaseMockSetBundleExpectationOrigins
+	results            *StockServiceUseCaseMockSetBundleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockSetBundleParams contains parameters of the StockServiceUseCase.SetBundle
+type StockServiceUseCaseMockSetBundleParams struct {
+	ctx    context.Context
+	bundle domain.Bundle
+}
+
+// StockServiceUseCaseMockSetBundleParamPtrs contains pointers to parameters of the StockServiceUseCase.SetBundle
+type StockServiceUseCaseMockSetBundleParamPtrs struct {
+	ctx    *context.Context
+	bundle *domain.Bundle
+}
+
+// StockServiceUseCaseMockSetBundleResults contains results of the StockServiceUseCase.SetBundle
+type StockServiceUseCaseMockSetBundleResults struct {
+	err error
+}
+
+// StockServiceUseCaseMockSetBundleOrigins contains origins of expectations of the StockServiceUseCase.SetBundle
+type StockServiceUseCaseMockSetBundleExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originBundle string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetBundle *mStockServiceUseCaseMockSetBundle) Optional() *mStockServiceUseCaseMockSetBundle {
+	mmSetBundle.optional = true
+	return mmSetBundle
+}
+
+// Expect sets up expected params for StockServiceUseCase.SetBundle
+func (mmSetBundle *mStockServiceUseCaseMockSetBundle) Expect(ctx context.Context, bundle domain.Bundle) *mStockServiceUseCaseMockSetBundle {
+	if mmSetBundle.mock.funcSetBundle != nil {
+		mmSetBundle.mock.t.Fatalf("StockServiceUseCaseMock.SetBundle mock is already set by Set")
+	}
+
+	if mmSetBundle.defaultExpectation == nil {
+		mmSetBundle.defaultExpectation = &StockServiceUseCaseMockSetBundleExpectation{}
+	}
+
+	if mmSetBundle.defaultExpectation.paramPtrs != nil {
+		mmSetBundle.mock.t.Fatalf("StockServiceUseCaseMock.SetBundle mock is already set by ExpectParams functions")
+	}
+
+	mmSetBundle.defaultExpectation.params = &StockServiceUseCaseMockSetBundleParams{ctx, bundle}
+	mmSetBundle.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetBundle.expectations {
+		if minimock.Equal(e.params, mmSetBundle.defaultExpectation.params) {
+			mmSetBundle.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetBundle.defaultExpectation.params)
+		}
+	}
+
+	return mmSetBundle
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.SetBundle
+func (mmSetBundle *mStockServiceUseCaseMockSetBundle) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockSetBundle {
+	if mmSetBundle.mock.funcSetBundle != nil {
+		mmSetBundle.mock.t.Fatalf("StockServiceUseCaseMock.SetBundle mock is already set by Set")
+	}
+
+	if mmSetBundle.defaultExpectation == nil {
+		mmSetBundle.defaultExpectation = &StockServiceUseCaseMockSetBundleExpectation{}
+	}
+
+	if mmSetBundle.defaultExpectation.params != nil {
+		mmSetBundle.mock.t.Fatalf("StockServiceUseCaseMock.SetBundle mock is already set by Expect")
+	}
+
+	if mmSetBundle.defaultExpectation.paramPtrs == nil {
+		mmSetBundle.defaultExpectation.paramPtrs = &StockServiceUseCaseMockSetBundleParamPtrs{}
+	}
+	mmSetBundle.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetBundle.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetBundle
+}
+
+// ExpectBundleParam2 sets up expected param bundle for StockServiceUseCase.SetBundle
+func (mmSetBundle *mStockServiceUseCaseMockSetBundle) ExpectBundleParam2(bundle domain.Bundle) *mStockServiceUseCaseMockSetBundle {
+	if mmSetBundle.mock.funcSetBundle != nil {
+		mmSetBundle.mock.t.Fatalf("StockServiceUseCaseMock.SetBundle mock is already set by Set")
+	}
+
+	if mmSetBundle.defaultExpectation == nil {
+		mmSetBundle.defaultExpectation = &StockServiceUseCaseMockSetBundleExpectation{}
+	}
+
+	if mmSetBundle.defaultExpectation.params != nil {
+		mmSetBundle.mock.t.Fatalf("StockServiceUseCaseMock.SetBundle mock is already set by Expect")
+	}
+
+	if mmSetBundle.defaultExpectation.paramPtrs == nil {
+		mmSetBundle.defaultExpectation.paramPtrs = &StockServiceUseCaseMockSetBundleParamPtrs{}
+	}
+	mmSetBundle.defaultExpectation.paramPtrs.bundle = &bundle
+	mmSetBundle.defaultExpectation.expectationOrigins.originBundle = minimock.CallerInfo(1)
+
+	return mmSetBundle
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.SetBundle
+func (mmSetBundle *mStockServiceUseCaseMockSetBundle) Inspect(f func(ctx context.Context, bundle domain.Bundle)) *mStockServiceUseCaseMockSetBundle {
+	if mmSetBundle.mock.inspectFuncSetBundle != nil {
+		mmSetBundle.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.SetBundle")
+	}
+
+	mmSetBundle.mock.inspectFuncSetBundle = f
+
+	return mmSetBundle
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.SetBundle
+func (mmSetBundle *mStockServiceUseCaseMockSetBundle) Return(err error) *StockServiceUseCaseMock {
+	if mmSetBundle.mock.funcSetBundle != nil {
+		mmSetBundle.mock.t.Fatalf("StockServiceUseCaseMock.SetBundle mock is already set by Set")
+	}
+
+	if mmSetBundle.defaultExpectation == nil {
+		mmSetBundle.defaultExpectation = &StockServiceUseCaseMockSetBundleExpectation{mock: mmSetBundle.mock}
+	}
+	mmSetBundle.defaultExpectation.results = &StockServiceUseCaseMockSetBundleResults{err}
+	mmSetBundle.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetBundle.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.SetBundle method
+func (mmSetBundle *mStockServiceUseCaseMockSetBundle) Set(f func(ctx context.Context, bundle domain.Bundle) (err error)) *StockServiceUseCaseMock {
+	if mmSetBundle.defaultExpectation != nil {
+		mmSetBundle.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.SetBundle method")
+	}
+
+	if len(mmSetBundle.expectations) > 0 {
+		mmSetBundle.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.SetBundle method")
+	}
+
+	mmSetBundle.mock.funcSetBundle = f
+	mmSetBundle.mock.funcSetBundleOrigin = minimock.CallerInfo(1)
+	return mmSetBundle.mock
+}
+
+// When sets expectation for the StockServiceUseCase.SetBundle which will trigger the result defined by the following
+// Then helper
+func (mmSetBundle *mStockServiceUseCaseMockSetBundle) When(ctx context.Context, bundle domain.Bundle) *StockServiceUseCaseMockSetBundleExpectation {
+	if mmSetBundle.mock.funcSetBundle != nil {
+		mmSetBundle.mock.t.Fatalf("StockServiceUseCaseMock.SetBundle mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockSetBundleExpectation{
+		mock:               mmSetBundle.mock,
+		params:             &StockServiceUseCaseMockSetBundleParams{ctx, bundle},
+		expectationOrigins: StockServiceUseCaseMockSetBundleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetBundle.expectations = append(mmSetBundle.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.SetBundle return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockSetBundleExpectation) Then(err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockSetBundleResults{err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.SetBundle should be invoked
+func (mmSetBundle *mStockServiceUseCaseMockSetBundle) Times(n uint64) *mStockServiceUseCaseMockSetBundle {
+	if n == 0 {
+		mmSetBundle.mock.t.Fatalf("Times of StockServiceUseCaseMock.SetBundle mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetBundle.expectedInvocations, n)
+	mmSetBundle.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetBundle
+}
+
+func (mmSetBundle *mStockServiceUseCaseMockSetBundle) invocationsDone() bool {
+	if len(mmSetBundle.expectations) == 0 && mmSetBundle.defaultExpectation == nil && mmSetBundle.mock.funcSetBundle == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetBundle.mock.afterSetBundleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetBundle.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetBundle implements mm_usecase.StockServiceUseCase
+func (mmSetBundle *StockServiceUseCaseMock) SetBundle(ctx context.Context, bundle domain.Bundle) (err error) {
+	mm_atomic.AddUint64(&mmSetBundle.beforeSetBundleCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetBundle.afterSetBundleCounter, 1)
+
+	mmSetBundle.t.Helper()
+
+	if mmSetBundle.inspectFuncSetBundle != nil {
+		mmSetBundle.inspectFuncSetBundle(ctx, bundle)
+	}
+
+	mm_params := StockServiceUseCaseMockSetBundleParams{ctx, bundle}
+
+	// Record call args
+	mmSetBundle.SetBundleMock.mutex.Lock()
+	mmSetBundle.SetBundleMock.callArgs = append(mmSetBundle.SetBundleMock.callArgs, &mm_params)
+	mmSetBundle.SetBundleMock.mutex.Unlock()
+
+	for _, e := range mmSetBundle.SetBundleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetBundle.SetBundleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetBundle.SetBundleMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetBundle.SetBundleMock.defaultExpectation.params
+		mm_want_ptrs := mmSetBundle.SetBundleMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockSetBundleParams{ctx, bundle}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetBundle.t.Errorf("StockServiceUseCaseMock.SetBundle got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetBundle.SetBundleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.bundle != nil && !minimock.Equal(*mm_want_ptrs.bundle, mm_got.bundle) {
+				mmSetBundle.t.Errorf("StockServiceUseCaseMock.SetBundle got unexpected parameter bundle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetBundle.SetBundleMock.defaultExpectation.expectationOrigins.originBundle, *mm_want_ptrs.bundle, mm_got.bundle, minimock.Diff(*mm_want_ptrs.bundle, mm_got.bundle))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetBundle.t.Errorf("StockServiceUseCaseMock.SetBundle got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetBundle.SetBundleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetBundle.SetBundleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetBundle.t.Fatal("No results are set for the StockServiceUseCaseMock.SetBundle")
+		}
+		return (*mm_results).err
+	}
+	if mmSetBundle.funcSetBundle != nil {
+		return mmSetBundle.funcSetBundle(ctx, bundle)
+	}
+	mmSetBundle.t.Fatalf("Unexpected call to StockServiceUseCaseMock.SetBundle. %v %v", ctx, bundle)
+	return
+}
+
+// SetBundleAfterCounter returns a count of finished StockServiceUseCaseMock.SetBundle invocations
+func (mmSetBundle *StockServiceUseCaseMock) SetBundleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetBundle.afterSetBundleCounter)
+}
+
+// SetBundleBeforeCounter returns a count of StockServiceUseCaseMock.SetBundle invocations
+func (mmSetBundle *StockServiceUseCaseMock) SetBundleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetBundle.beforeSetBundleCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.SetBundle.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetBundle *mStockServiceUseCaseMockSetBundle) Calls() []*StockServiceUseCaseMockSetBundleParams {
+	mmSetBundle.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockSetBundleParams, len(mmSetBundle.callArgs))
+	copy(argCopy, mmSetBundle.callArgs)
+
+	mmSetBundle.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetBundleDone returns true if the count of the SetBundle invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockSetBundleDone() bool {
+	if m.SetBundleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetBundleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetBundleMock.invocationsDone()
+}
+
+// MinimockSetBundleInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockSetBundleInspect() {
+	for _, e := range m.SetBundleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.SetBundle at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetBundleCounter := mm_atomic.LoadUint64(&m.afterSetBundleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetBundleMock.defaultExpectation != nil && afterSetBundleCounter < 1 {
+		if m.SetBundleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.SetBundle at\n%s", m.SetBundleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.SetBundle at\n%s with params: %#v", m.SetBundleMock.defaultExpectation.expectationOrigins.origin, *m.SetBundleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetBundle != nil && afterSetBundleCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.SetBundle at\n%s", m.funcSetBundleOrigin)
+	}
+
+	if !m.SetBundleMock.invocationsDone() && afterSetBundleCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.SetBundle at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetBundleMock.expectedInvocations), m.SetBundleMock.expectedInvocationsOrigin, afterSetBundleCounter)
+	}
+}
+
+type mStockServiceUseCaseMockSetPriceTiers struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockSetPriceTiersExpectation
+	expectations       []*StockServiceUseCaseMockSetPriceTiersExpectation
+
+	callArgs []*StockServiceUseCaseMockSetPriceTiersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockSetPriceTiersExpectation specifies expectation struct of the StockServiceUseCase.SetPriceTiers
+type StockServiceUseCaseMockSetPriceTiersExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockSetPriceTiersParams
+	paramPtrs          *StockServiceUseCaseMockSetPriceTiersParamPtrs
+	expectationOrigins StockServiceUseCaseMockSetPriceTiersExpectationOrigins
+	results            *StockServiceUseCaseMockSetPriceTiersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockSetPriceTiersParams contains parameters of the StockServiceUseCase.SetPriceTiers
+type StockServiceUseCaseMockSetPriceTiersParams struct {
+	ctx       context.Context
+	priceList domain.PriceList
+}
+
+// StockServiceUseCaseMockSetPriceTiersParamPtrs contains pointers to parameters of the StockServiceUseCase.SetPriceTiers
+type StockServiceUseCaseMockSetPriceTiersParamPtrs struct {
+	ctx       *context.Context
+	priceList *domain.PriceList
+}
+
+// StockServiceUseCaseMockSetPriceTiersResults contains results of the StockServiceUseCase.SetPriceTiers
+type StockServiceUseCaseMockSetPriceTiersResults struct {
+	pa1 []domain.PriceTier
+	err error
+}
+
+// StockServiceUseCaseMockSetPriceTiersOrigins contains origins of expectations of the StockServiceUseCase.SetPriceTiers
+type StockServiceUseCaseMockSetPriceTiersExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originPriceList string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetPriceTiers *mStockServiceUseCaseMockSetPriceTiers) Optional() *mStockServiceUseCaseMockSetPriceTiers {
+	mmSetPriceTiers.optional = true
+	return mmSetPriceTiers
+}
+
+// Expect sets up expected params for StockServiceUseCase.SetPriceTiers
+func (mmSetPriceTiers *mStockServiceUseCaseMockSetPriceTiers) Expect(ctx context.Context, priceList domain.PriceList) *mStockServiceUseCaseMockSetPriceTiers {
+	if mmSetPriceTiers.mock.funcSetPriceTiers != nil {
+		mmSetPriceTiers.mock.t.Fatalf("StockServiceUseCaseMock.SetPriceTiers mock is already set by Set")
+	}
+
+	if mmSetPriceTiers.defaultExpectation == nil {
+		mmSetPriceTiers.defaultExpectation = &StockServiceUseCaseMockSetPriceTiersExpectation{}
+	}
+
+	if mmSetPriceTiers.defaultExpectation.paramPtrs != nil {
+		mmSetPriceTiers.mock.t.Fatalf("StockServiceUseCaseMock.SetPriceTiers mock is already set by ExpectParams functions")
+	}
+
+	mmSetPriceTiers.defaultExpectation.params = &StockServiceUseCaseMockSetPriceTiersParams{ctx, priceList}
+	mmSetPriceTiers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetPriceTiers.expectations {
+		if minimock.Equal(e.params, mmSetPriceTiers.defaultExpectation.params) {
+			mmSetPriceTiers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetPriceTiers.defaultExpectation.params)
+		}
+	}
+
+	return mmSetPriceTiers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.SetPriceTiers
+func (mmSetPriceTiers *mStockServiceUseCaseMockSetPriceTiers) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockSetPriceTiers {
+	if mmSetPriceTiers.mock.funcSetPriceTiers != nil {
+		mmSetPriceTiers.mock.t.Fatalf("StockServiceUseCaseMock.SetPriceTiers mock is already set by Set")
+	}
+
+	if mmSetPriceTiers.defaultExpectation == nil {
+		mmSetPriceTiers.defaultExpectation = &StockServiceUseCaseMockSetPriceTiersExpectation{}
+	}
+
+	if mmSetPriceTiers.defaultExpectation.params != nil {
+		mmSetPriceTiers.mock.t.Fatalf("StockServiceUseCaseMock.SetPriceTiers mock is already set by Expect")
+	}
+
+	if mmSetPriceTiers.defaultExpectation.paramPtrs == nil {
+		mmSetPriceTiers.defaultExpectation.paramPtrs = &StockServiceUseCaseMockSetPriceTiersParamPtrs{}
+	}
+	mmSetPriceTiers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetPriceTiers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetPriceTiers
+}
+
+// ExpectPriceListParam2 sets up expected param priceList for StockServiceUseCase.SetPriceTiers
+func (mmSetPriceTiers *mStockServiceUseCaseMockSetPriceTiers) ExpectPriceListParam2(priceList domain.PriceList) *mStockServiceUseCaseMockSetPriceTiers {
+	if mmSetPriceTiers.mock.funcSetPriceTiers != nil {
+		mmSetPriceTiers.mock.t.Fatalf("StockServiceUseCaseMock.SetPriceTiers mock is already set by Set")
+	}
+
+	if mmSetPriceTiers.defaultExpectation == nil {
+		mmSetPriceTiers.defaultExpectation = &StockServiceUseCaseMockSetPriceTiersExpectation{}
+	}
+
+	if mmSetPriceTiers.defaultExpectation.params != nil {
+		mmSetPriceTiers.mock.t.Fatalf("StockServiceUseCaseMock.SetPriceTiers mock is already set by Expect")
+	}
+
+	if mmSetPriceTiers.defaultExpectation.paramPtrs == nil {
+		mmSetPriceTiers.defaultExpectation.paramPtrs = &StockServiceUseCaseMockSetPriceTiersParamPtrs{}
+	}
+	mmSetPriceTiers.defaultExpectation.paramPtrs.priceList = &priceList
+	mmSetPriceTiers.defaultExpectation.expectationOrigins.originPriceList = minimock.CallerInfo(1)
+
+	return mmSetPriceTiers
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.SetPriceTiers
+func (mmSetPriceTiers *mStockServiceUseCaseMockSetPriceTiers) Inspect(f func(ctx context.Context, priceList domain.PriceList)) *mStockServiceUseCaseMockSetPriceTiers {
+	if mmSetPriceTiers.mock.inspectFuncSetPriceTiers != nil {
+		mmSetPriceTiers.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.SetPriceTiers")
+	}
+
+	mmSetPriceTiers.mock.inspectFuncSetPriceTiers = f
+
+	return mmSetPriceTiers
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.SetPriceTiers
+func (mmSetPriceTiers *mStockServiceUseCaseMockSetPriceTiers) Return(pa1 []domain.PriceTier, err error) *StockServiceUseCaseMock {
+	if mmSetPriceTiers.mock.funcSetPriceTiers != nil {
+		mmSetPriceTiers.mock.t.Fatalf("StockServiceUseCaseMock.SetPriceTiers mock is already set by Set")
+	}
+
+	if mmSetPriceTiers.defaultExpectation == nil {
+		mmSetPriceTiers.defaultExpectation = &StockServiceUseCaseMockSetPriceTiersExpectation{mock: mmSetPriceTiers.mock}
+	}
+	mmSetPriceTiers.defaultExpectation.results = &StockServiceUseCaseMockSetPriceTiersResults{pa1, err}
+	mmSetPriceTiers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetPriceTiers.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.SetPriceTiers method
+func (mmSetPriceTiers *mStockServiceUseCaseMockSetPriceTiers) Set(f func(ctx context.Context, priceList domain.PriceList) (pa1 []domain.PriceTier, err error)) *StockServiceUseCaseMock {
+	if mmSetPriceTiers.defaultExpectation != nil {
+		mmSetPriceTiers.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.SetPriceTiers method")
+	}
+
+	if len(mmSetPriceTiers.expectations) > 0 {
+		mmSetPriceTiers.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.SetPriceTiers method")
+	}
+
+	mmSetPriceTiers.mock.funcSetPriceTiers = f
+	mmSetPriceTiers.mock.funcSetPriceTiersOrigin = minimock.CallerInfo(1)
+	return mmSetPriceTiers.mock
+}
+
+// When sets expectation for the StockServiceUseCase.SetPriceTiers which will trigger the result defined by the following
+// Then helper
+func (mmSetPriceTiers *mStockServiceUseCaseMockSetPriceTiers) When(ctx context.Context, priceList domain.PriceList) *StockServiceUseCaseMockSetPriceTiersExpectation {
+	if mmSetPriceTiers.mock.funcSetPriceTiers != nil {
+		mmSetPriceTiers.mock.t.Fatalf("StockServiceUseCaseMock.SetPriceTiers mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockSetPriceTiersExpectation{
+		mock:               mmSetPriceTiers.mock,
+		params:             &StockServiceUseCaseMockSetPriceTiersParams{ctx, priceList},
+		expectationOrigins: StockServiceUseCaseMockSetPriceTiersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetPriceTiers.expectations = append(mmSetPriceTiers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.SetPriceTiers return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockSetPriceTiersExpectation) Then(pa1 []domain.PriceTier, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockSetPriceTiersResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.SetPriceTiers should be invoked
+func (mmSetPriceTiers *mStockServiceUseCaseMockSetPriceTiers) Times(n uint64) *mStockServiceUseCaseMockSetPriceTiers {
+	if n == 0 {
+		mmSetPriceTiers.mock.t.Fatalf("Times of StockServiceUseCaseMock.SetPriceTiers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetPriceTiers.expectedInvocations, n)
+	mmSetPriceTiers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetPriceTiers
+}
+
+func (mmSetPriceTiers *mStockServiceUseCaseMockSetPriceTiers) invocationsDone() bool {
+	if len(mmSetPriceTiers.expectations) == 0 && mmSetPriceTiers.defaultExpectation == nil && mmSetPriceTiers.mock.funcSetPriceTiers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetPriceTiers.mock.afterSetPriceTiersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetPriceTiers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetPriceTiers implements mm_usecase.StockServiceUseCase
+func (mmSetPriceTiers *StockServiceUseCaseMock) SetPriceTiers(ctx context.Context, priceList domain.PriceList) (pa1 []domain.PriceTier, err error) {
+	mm_atomic.AddUint64(&mmSetPriceTiers.beforeSetPriceTiersCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetPriceTiers.afterSetPriceTiersCounter, 1)
+
+	mmSetPriceTiers.t.Helper()
+
+	if mmSetPriceTiers.inspectFuncSetPriceTiers != nil {
+		mmSetPriceTiers.inspectFuncSetPriceTiers(ctx, priceList)
+	}
+
+	mm_params := StockServiceUseCaseMockSetPriceTiersParams{ctx, priceList}
+
+	// Record call args
+	mmSetPriceTiers.SetPriceTiersMock.mutex.Lock()
+	mmSetPriceTiers.SetPriceTiersMock.callArgs = append(mmSetPriceTiers.SetPriceTiersMock.callArgs, &mm_params)
+	mmSetPriceTiers.SetPriceTiersMock.mutex.Unlock()
+
+	for _, e := range mmSetPriceTiers.SetPriceTiersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmSetPriceTiers.SetPriceTiersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetPriceTiers.SetPriceTiersMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetPriceTiers.SetPriceTiersMock.defaultExpectation.params
+		mm_want_ptrs := mmSetPriceTiers.SetPriceTiersMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockSetPriceTiersParams{ctx, priceList}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetPriceTiers.t.Errorf("StockServiceUseCaseMock.SetPriceTiers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetPriceTiers.SetPriceTiersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.priceList != nil && !minimock.Equal(*mm_want_ptrs.priceList, mm_got.priceList) {
+				mmSetPriceTiers.t.Errorf("StockServiceUseCaseMock.SetPriceTiers got unexpected parameter priceList, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetPriceTiers.SetPriceTiersMock.defaultExpectation.expectationOrigins.originPriceList, *mm_want_ptrs.priceList, mm_got.priceList, minimock.Diff(*mm_want_ptrs.priceList, mm_got.priceList))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetPriceTiers.t.Errorf("StockServiceUseCaseMock.SetPriceTiers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetPriceTiers.SetPriceTiersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetPriceTiers.SetPriceTiersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetPriceTiers.t.Fatal("No results are set for the StockServiceUseCaseMock.SetPriceTiers")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmSetPriceTiers.funcSetPriceTiers != nil {
+		return mmSetPriceTiers.funcSetPriceTiers(ctx, priceList)
+	}
+	mmSetPriceTiers.t.Fatalf("Unexpected call to StockServiceUseCaseMock.SetPriceTiers. %v %v", ctx, priceList)
+	return
+}
+
+// SetPriceTiersAfterCounter returns a count of finished StockServiceUseCaseMock.SetPriceTiers invocations
+func (mmSetPriceTiers *StockServiceUseCaseMock) SetPriceTiersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetPriceTiers.afterSetPriceTiersCounter)
+}
+
+// SetPriceTiersBeforeCounter returns a count of StockServiceUseCaseMock.SetPriceTiers invocations
+func (mmSetPriceTiers *StockServiceUseCaseMock) SetPriceTiersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetPriceTiers.beforeSetPriceTiersCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.SetPriceTiers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetPriceTiers *mStockServiceUseCaseMockSetPriceTiers) Calls() []*StockServiceUseCaseMockSetPriceTiersParams {
+	mmSetPriceTiers.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockSetPriceTiersParams, len(mmSetPriceTiers.callArgs))
+	copy(argCopy, mmSetPriceTiers.callArgs)
+
+	mmSetPriceTiers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetPriceTiersDone returns true if the count of the SetPriceTiers invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockSetPriceTiersDone() bool {
+	if m.SetPriceTiersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetPriceTiersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetPriceTiersMock.invocationsDone()
+}
+
+// MinimockSetPriceTiersInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockSetPriceTiersInspect() {
+	for _, e := range m.SetPriceTiersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.SetPriceTiers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetPriceTiersCounter := mm_atomic.LoadUint64(&m.afterSetPriceTiersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetPriceTiersMock.defaultExpectation != nil && afterSetPriceTiersCounter < 1 {
+		if m.SetPriceTiersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.SetPriceTiers at\n%s", m.SetPriceTiersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.SetPriceTiers at\n%s with params: %#v", m.SetPriceTiersMock.defaultExpectation.expectationOrigins.origin, *m.SetPriceTiersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetPriceTiers != nil && afterSetPriceTiersCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.SetPriceTiers at\n%s", m.funcSetPriceTiersOrigin)
+	}
+
+	if !m.SetPriceTiersMock.invocationsDone() && afterSetPriceTiersCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.SetPriceTiers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetPriceTiersMock.expectedInvocations), m.SetPriceTiersMock.expectedInvocationsOrigin, afterSetPriceTiersCounter)
+	}
+}
+
+type mStockServiceUseCaseMockSetStockThreshold struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockSetStockThresholdExpectation
+	expectations       []*StockServiceUseCaseMockSetStockThresholdExpectation
+
+	callArgs []*StockServiceUseCaseMockSetStockThresholdParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockSetStockThresholdExpectation specifies expectation struct of the StockServiceUseCase.SetStockThreshold
+type StockServiceUseCaseMockSetStockThresholdExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockSetStockThresholdParams
+	paramPtrs          *StockServiceUseCaseMockSetStockThresholdParamPtrs
+	expectationOrigins StockServiceUseCaseMockSetStockThresholdExpectationOrigins
+	results            *StockServiceUseCaseMockSetStockThresholdResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockSetStockThresholdParams contains parameters of the StockServiceUseCase.SetStockThreshold
+type StockServiceUseCaseMockSetStockThresholdParams struct {
+	ctx       context.Context
+	threshold domain.StockThreshold
+}
+
+// StockServiceUseCaseMockSetStockThresholdParamPtrs contains pointers to parameters of the StockServiceUseCase.SetStockThreshold
+type StockServiceUseCaseMockSetStockThresholdParamPtrs struct {
+	ctx       *context.Context
+	threshold *domain.StockThreshold
+}
+
+// StockServiceUseCaseMockSetStockThresholdResults contains results of the StockServiceUseCase.SetStockThreshold
+type StockServiceUseCaseMockSetStockThresholdResults struct {
+	err error
+}
+
+// StockServiceUseCaseMockSetStockThresholdOrigins contains origins of expectations of the StockServiceUseCase.SetStockThreshold
+type StockServiceUseCaseMockSetStockThresholdExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originThreshold string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetStockThreshold *mStockServiceUseCaseMockSetStockThreshold) Optional() *mStockServiceUseCaseMockSetStockThreshold {
+	mmSetStockThreshold.optional = true
+	return mmSetStockThreshold
+}
+
+// Expect sets up expected params for StockServiceUseCase.SetStockThreshold
+func (mmSetStockThreshold *mStockServiceUseCaseMockSetStockThreshold) Expect(ctx context.Context, threshold domain.StockThreshold) *mStockServiceUseCaseMockSetStockThreshold {
+	if mmSetStockThreshold.mock.funcSetStockThreshold != nil {
+		mmSetStockThreshold.mock.t.Fatalf("StockServiceUseCaseMock.SetStockThreshold mock is already set by Set")
+	}
+
+	if mmSetStockThreshold.defaultExpectation == nil {
+		mmSetStockThreshold.defaultExpectation = &StockServiceUseCaseMockSetStockThresholdExpectation{}
+	}
+
+	if mmSetStockThreshold.defaultExpectation.paramPtrs != nil {
+		mmSetStockThreshold.mock.t.Fatalf("StockServiceUseCaseMock.SetStockThreshold mock is already set by ExpectParams functions")
+	}
+
+	mmSetStockThreshold.defaultExpectation.params = &StockServiceUseCaseMockSetStockThresholdParams{ctx, threshold}
+	mmSetStockThreshold.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetStockThreshold.expectations {
+		if minimock.Equal(e.params, mmSetStockThreshold.defaultExpectation.params) {
+			mmSetStockThreshold.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetStockThreshold.defaultExpectation.params)
+		}
+	}
+
+	return mmSetStockThreshold
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.SetStockThreshold
+func (mmSetStockThreshold *mStockServiceUseCaseMockSetStockThreshold) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockSetStockThreshold {
+	if mmSetStockThreshold.mock.funcSetStockThreshold != nil {
+		mmSetStockThreshold.mock.t.Fatalf("StockServiceUseCaseMock.SetStockThreshold mock is already set by Set")
+	}
+
+	if mmSetStockThreshold.defaultExpectation == nil {
+		mmSetStockThreshold.defaultExpectation = &StockServiceUseCaseMockSetStockThresholdExpectation{}
+	}
+
+	if mmSetStockThreshold.defaultExpectation.params != nil {
+		mmSetStockThreshold.mock.t.Fatalf("StockServiceUseCaseMock.SetStockThreshold mock is already set by Expect")
+	}
+
+	if mmSetStockThreshold.defaultExpectation.paramPtrs == nil {
+		mmSetStockThreshold.defaultExpectation.paramPtrs = &StockServiceUseCaseMockSetStockThresholdParamPtrs{}
+	}
+	mmSetStockThreshold.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetStockThreshold.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetStockThreshold
+}
+
+// ExpectThresholdParam2 sets up expected param threshold for StockServiceUseCase.SetStockThreshold
+func (mmSetStockThreshold *mStockServiceUseCaseMockSetStockThreshold) ExpectThresholdParam2(threshold domain.StockThreshold) *mStockServiceUseCaseMockSetStockThreshold {
+	if mmSetStockThreshold.mock.funcSetStockThreshold != nil {
+		mmSetStockThreshold.mock.t.Fatalf("StockServiceUseCaseMock.SetStockThreshold mock is already set by Set")
+	}
+
+	if mmSetStockThreshold.defaultExpectation == nil {
+		mmSetStockThreshold.defaultExpectation = &StockServiceUseCaseMockSetStockThresholdExpectation{}
+	}
+
+	if mmSetStockThreshold.defaultExpectation.params != nil {
+		mmSetStockThreshold.mock.t.Fatalf("StockServiceUseCaseMock.SetStockThreshold mock is already set by Expect")
+	}
+
+	if mmSetStockThreshold.defaultExpectation.paramPtrs == nil {
+		mmSetStockThreshold.defaultExpectation.paramPtrs = &StockServiceUseCaseMockSetStockThresholdParamPtrs{}
+	}
+	mmSetStockThreshold.defaultExpectation.paramPtrs.threshold = &threshold
+	mmSetStockThreshold.defaultExpectation.expectationOrigins.originThreshold = minimock.CallerInfo(1)
+
+	return mmSetStockThreshold
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.SetStockThreshold
+func (mmSetStockThreshold *mStockServiceUseCaseMockSetStockThreshold) Inspect(f func(ctx context.Context, threshold domain.StockThreshold)) *mStockServiceUseCaseMockSetStockThreshold {
+	if mmSetStockThreshold.mock.inspectFuncSetStockThreshold != nil {
+		mmSetStockThreshold.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.SetStockThreshold")
+	}
+
+	mmSetStockThreshold.mock.inspectFuncSetStockThreshold = f
+
+	return mmSetStockThreshold
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.SetStockThreshold
+func (mmSetStockThreshold *mStockServiceUseCaseMockSetStockThreshold) Return(err error) *StockServiceUseCaseMock {
+	if mmSetStockThreshold.mock.funcSetStockThreshold != nil {
+		mmSetStockThreshold.mock.t.Fatalf("StockServiceUseCaseMock.SetStockThreshold mock is already set by Set")
+	}
+
+	if mmSetStockThreshold.defaultExpectation == nil {
+		mmSetStockThreshold.defaultExpectation = &StockServiceUseCaseMockSetStockThresholdExpectation{mock: mmSetStockThreshold.mock}
+	}
+	mmSetStockThreshold.defaultExpectation.results = &StockServiceUseCaseMockSetStockThresholdResults{err}
+	mmSetStockThreshold.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetStockThreshold.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.SetStockThreshold method
+func (mmSetStockThreshold *mStockServiceUseCaseMockSetStockThreshold) Set(f func(ctx context.Context, threshold domain.StockThreshold) (err error)) *StockServiceUseCaseMock {
+	if mmSetStockThreshold.defaultExpectation != nil {
+		mmSetStockThreshold.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.SetStockThreshold method")
+	}
+
+	if len(mmSetStockThreshold.expectations) > 0 {
+		mmSetStockThreshold.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.SetStockThreshold method")
+	}
+
+	mmSetStockThreshold.mock.funcSetStockThreshold = f
+	mmSetStockThreshold.mock.funcSetStockThresholdOrigin = minimock.CallerInfo(1)
+	return mmSetStockThreshold.mock
+}
+
+// When sets expectation for the StockServiceUseCase.SetStockThreshold which will trigger the result defined by the following
+// Then helper
+func (mmSetStockThreshold *mStockServiceUseCaseMockSetStockThreshold) When(ctx context.Context, threshold domain.StockThreshold) *StockServiceUseCaseMockSetStockThresholdExpectation {
+	if mmSetStockThreshold.mock.funcSetStockThreshold != nil {
+		mmSetStockThreshold.mock.t.Fatalf("StockServiceUseCaseMock.SetStockThreshold mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockSetStockThresholdExpectation{
+		mock:               mmSetStockThreshold.mock,
+		params:             &StockServiceUseCaseMockSetStockThresholdParams{ctx, threshold},
+		expectationOrigins: StockServiceUseCaseMockSetStockThresholdExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetStockThreshold.expectations = append(mmSetStockThreshold.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.SetStockThreshold return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockSetStockThresholdExpectation) Then(err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockSetStockThresholdResults{err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.SetStockThreshold should be invoked
+func (mmSetStockThreshold *mStockServiceUseCaseMockSetStockThreshold) Times(n uint64) *mStockServiceUseCaseMockSetStockThreshold {
+	if n == 0 {
+		mmSetStockThreshold.mock.t.Fatalf("Times of StockServiceUseCaseMock.SetStockThreshold mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetStockThreshold.expectedInvocations, n)
+	mmSetStockThreshold.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetStockThreshold
+}
+
+func (mmSetStockThreshold *mStockServiceUseCaseMockSetStockThreshold) invocationsDone() bool {
+	if len(mmSetStockThreshold.expectations) == 0 && mmSetStockThreshold.defaultExpectation == nil && mmSetStockThreshold.mock.funcSetStockThreshold == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetStockThreshold.mock.afterSetStockThresholdCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetStockThreshold.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetStockThreshold implements mm_usecase.StockServiceUseCase
+func (mmSetStockThreshold *StockServiceUseCaseMock) SetStockThreshold(ctx context.Context, threshold domain.StockThreshold) (err error) {
+	mm_atomic.AddUint64(&mmSetStockThreshold.beforeSetStockThresholdCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetStockThreshold.afterSetStockThresholdCounter, 1)
+
+	mmSetStockThreshold.t.Helper()
+
+	if mmSetStockThreshold.inspectFuncSetStockThreshold != nil {
+		mmSetStockThreshold.inspectFuncSetStockThreshold(ctx, threshold)
+	}
+
+	mm_params := StockServiceUseCaseMockSetStockThresholdParams{ctx, threshold}
+
+	// Record call args
+	mmSetStockThreshold.SetStockThresholdMock.mutex.Lock()
+	mmSetStockThreshold.SetStockThresholdMock.callArgs = append(mmSetStockThreshold.SetStockThresholdMock.callArgs, &mm_params)
+	mmSetStockThreshold.SetStockThresholdMock.mutex.Unlock()
+
+	for _, e := range mmSetStockThreshold.SetStockThresholdMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetStockThreshold.SetStockThresholdMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetStockThreshold.SetStockThresholdMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetStockThreshold.SetStockThresholdMock.defaultExpectation.params
+		mm_want_ptrs := mmSetStockThreshold.SetStockThresholdMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockSetStockThresholdParams{ctx, threshold}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetStockThreshold.t.Errorf("StockServiceUseCaseMock.SetStockThreshold got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetStockThreshold.SetStockThresholdMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.threshold != nil && !minimock.Equal(*mm_want_ptrs.threshold, mm_got.threshold) {
+				mmSetStockThreshold.t.Errorf("StockServiceUseCaseMock.SetStockThreshold got unexpected parameter threshold, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetStockThreshold.SetStockThresholdMock.defaultExpectation.expectationOrigins.originThreshold, *mm_want_ptrs.threshold, mm_got.threshold, minimock.Diff(*mm_want_ptrs.threshold, mm_got.threshold))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetStockThreshold.t.Errorf("StockServiceUseCaseMock.SetStockThreshold got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetStockThreshold.SetStockThresholdMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetStockThreshold.SetStockThresholdMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetStockThreshold.t.Fatal("No results are set for the StockServiceUseCaseMock.SetStockThreshold")
+		}
+		return (*mm_results).err
+	}
+	if mmSetStockThreshold.funcSetStockThreshold != nil {
+		return mmSetStockThreshold.funcSetStockThreshold(ctx, threshold)
+	}
+	mmSetStockThreshold.t.Fatalf("Unexpected call to StockServiceUseCaseMock.SetStockThreshold. %v %v", ctx, threshold)
+	return
+}
+
+// SetStockThresholdAfterCounter returns a count of finished StockServiceUseCaseMock.SetStockThreshold invocations
+func (mmSetStockThreshold *StockServiceUseCaseMock) SetStockThresholdAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetStockThreshold.afterSetStockThresholdCounter)
+}
+
+// SetStockThresholdBeforeCounter returns a count of StockServiceUseCaseMock.SetStockThreshold invocations
+func (mmSetStockThreshold *StockServiceUseCaseMock) SetStockThresholdBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetStockThreshold.beforeSetStockThresholdCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.SetStockThreshold.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetStockThreshold *mStockServiceUseCaseMockSetStockThreshold) Calls() []*StockServiceUseCaseMockSetStockThresholdParams {
+	mmSetStockThreshold.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockSetStockThresholdParams, len(mmSetStockThreshold.callArgs))
+	copy(argCopy, mmSetStockThreshold.callArgs)
+
+	mmSetStockThreshold.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetStockThresholdDone returns true if the count of the SetStockThreshold invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockSetStockThresholdDone() bool {
+	if m.SetStockThresholdMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetStockThresholdMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetStockThresholdMock.invocationsDone()
+}
+
+// MinimockSetStockThresholdInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockSetStockThresholdInspect() {
+	for _, e := range m.SetStockThresholdMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.SetStockThreshold at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetStockThresholdCounter := mm_atomic.LoadUint64(&m.afterSetStockThresholdCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetStockThresholdMock.defaultExpectation != nil && afterSetStockThresholdCounter < 1 {
+		if m.SetStockThresholdMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.SetStockThreshold at\n%s", m.SetStockThresholdMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.SetStockThreshold at\n%s with params: %#v", m.SetStockThresholdMock.defaultExpectation.expectationOrigins.origin, *m.SetStockThresholdMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetStockThreshold != nil && afterSetStockThresholdCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.SetStockThreshold at\n%s", m.funcSetStockThresholdOrigin)
+	}
+
+	if !m.SetStockThresholdMock.invocationsDone() && afterSetStockThresholdCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.SetStockThreshold at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetStockThresholdMock.expectedInvocations), m.SetStockThresholdMock.expectedInvocationsOrigin, afterSetStockThresholdCounter)
+	}
+}
+
+type mStockServiceUseCaseMockSubmitCycleCounts struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockSubmitCycleCountsExpectation
+	expectations       []*StockServiceUseCaseMockSubmitCycleCountsExpectation
+
+	callArgs []*StockServiceUseCaseMockSubmitCycleCountsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockSubmitCycleCountsExpectation specifies expectation struct of the StockServiceUseCase.SubmitCycleCounts
+type StockServiceUseCaseMockSubmitCycleCountsExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockSubmitCycleCountsParams
+	paramPtrs          *StockServiceUseCaseMockSubmitCycleCountsParamPtrs
+	expectationOrigins StockServiceUseCaseMockSubmitCycleCountsExpectationOrigins
+	results            *StockServiceUseCaseMockSubmitCycleCountsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockSubmitCycleCountsParams contains parameters of the StockServiceUseCase.SubmitCycleCounts
+type StockServiceUseCaseMockSubmitCycleCountsParams struct {
+	ctx        context.Context
+	submission domain.CycleCountSubmission
+}
+
+// StockServiceUseCaseMockSubmitCycleCountsParamPtrs contains pointers to parameters of the StockServiceUseCase.SubmitCycleCounts
+type StockServiceUseCaseMockSubmitCycleCountsParamPtrs struct {
+	ctx        *context.Context
+	submission *domain.CycleCountSubmission
+}
+
+// StockServiceUseCaseMockSubmitCycleCountsResults contains results of the StockServiceUseCase.SubmitCycleCounts
+type StockServiceUseCaseMockSubmitCycleCountsResults struct {
+	c2  domain.CycleCount
+	err error
+}
+
+// StockServiceUseCaseMockSubmitCycleCountsOrigins contains origins of expectations of the StockServiceUseCase.SubmitCycleCounts
+type StockServiceUseCaseMockSubmitCycleCountsExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originSubmission string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSubmitCycleCounts *mStockServiceUseCaseMockSubmitCycleCounts) Optional() *mStockServiceUseCaseMockSubmitCycleCounts {
+	mmSubmitCycleCounts.optional = true
+	return mmSubmitCycleCounts
+}
+
+// Expect sets up expected params for StockServiceUseCase.SubmitCycleCounts
+func (mmSubmitCycleCounts *mStockServiceUseCaseMockSubmitCycleCounts) Expect(ctx context.Context, submission domain.CycleCountSubmission) *mStockServiceUseCaseMockSubmitCycleCounts {
+	if mmSubmitCycleCounts.mock.funcSubmitCycleCounts != nil {
+		mmSubmitCycleCounts.mock.t.Fatalf("StockServiceUseCaseMock.SubmitCycleCounts mock is already set by Set")
+	}
+
+	if mmSubmitCycleCounts.defaultExpectation == nil {
+		mmSubmitCycleCounts.defaultExpectation = &StockServiceUseCaseMockSubmitCycleCountsExpectation{}
+	}
+
+	if mmSubmitCycleCounts.defaultExpectation.paramPtrs != nil {
+		mmSubmitCycleCounts.mock.t.Fatalf("StockServiceUseCaseMock.SubmitCycleCounts mock is already set by ExpectParams functions")
+	}
+
+	mmSubmitCycleCounts.defaultExpectation.params = &StockServiceUseCaseMockSubmitCycleCountsParams{ctx, submission}
+	mmSubmitCycleCounts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSubmitCycleCounts.expectations {
+		if minimock.Equal(e.params, mmSubmitCycleCounts.defaultExpectation.params) {
+			mmSubmitCycleCounts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSubmitCycleCounts.defaultExpectation.params)
+		}
+	}
+
+	return mmSubmitCycleCounts
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.SubmitCycleCounts
+func (mmSubmitCycleCounts *mStockServiceUseCaseMockSubmitCycleCounts) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockSubmitCycleCounts {
+	if mmSubmitCycleCounts.mock.funcSubmitCycleCounts != nil {
+		mmSubmitCycleCounts.mock.t.Fatalf("StockServiceUseCaseMock.SubmitCycleCounts mock is already set by Set")
+	}
+
+	if mmSubmitCycleCounts.defaultExpectation == nil {
+		mmSubmitCycleCounts.defaultExpectation = &StockServiceUseCaseMockSubmitCycleCountsExpectation{}
+	}
+
+	if mmSubmitCycleCounts.defaultExpectation.params != nil {
+		mmSubmitCycleCounts.mock.t.Fatalf("StockServiceUseCaseMock.SubmitCycleCounts mock is already set by Expect")
+	}
+
+	if mmSubmitCycleCounts.defaultExpectation.paramPtrs == nil {
+		mmSubmitCycleCounts.defaultExpectation.paramPtrs = &StockServiceUseCaseMockSubmitCycleCountsParamPtrs{}
+	}
+	mmSubmitCycleCounts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSubmitCycleCounts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSubmitCycleCounts
+}
+
+// ExpectSubmissionParam2 sets up expected param submission for StockServiceUseCase.SubmitCycleCounts
+func (mmSubmitCycleCounts *mStockServiceUseCaseMockSubmitCycleCounts) ExpectSubmissionParam2(submission domain.CycleCountSubmission) *mStockServiceUseCaseMockSubmitCycleCounts {
+	if mmSubmitCycleCounts.mock.funcSubmitCycleCounts != nil {
+		mmSubmitCycleCounts.mock.t.Fatalf("StockServiceUseCaseMock.SubmitCycleCounts mock is already set by Set")
+	}
+
+	if mmSubmitCycleCounts.defaultExpectation == nil {
+		mmSubmitCycleCounts.defaultExpectation = &StockServiceUseCaseMockSubmitCycleCountsExpectation{}
+	}
+
+	if mmSubmitCycleCounts.defaultExpectation.params != nil {
+		mmSubmitCycleCounts.mock.t.Fatalf("StockServiceUseCaseMock.SubmitCycleCounts mock is already set by Expect")
+	}
+
+	if mmSubmitCycleCounts.defaultExpectation.paramPtrs == nil {
+		mmSubmitCycleCounts.defaultExpectation.paramPtrs = &StockServiceUseCaseMockSubmitCycleCountsParamPtrs{}
+	}
+	mmSubmitCycleCounts.defaultExpectation.paramPtrs.submission = &submission
+	mmSubmitCycleCounts.defaultExpectation.expectationOrigins.originSubmission = minimock.CallerInfo(1)
+
+	return mmSubmitCycleCounts
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.SubmitCycleCounts
+func (mmSubmitCycleCounts *mStockServiceUseCaseMockSubmitCycleCounts) Inspect(f func(ctx context.Context, submission domain.CycleCountSubmission)) *mStockServiceUseCaseMockSubmitCycleCounts {
+	if mmSubmitCycleCounts.mock.inspectFuncSubmitCycleCounts != nil {
+		mmSubmitCycleCounts.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.SubmitCycleCounts")
+	}
+
+	mmSubmitCycleCounts.mock.inspectFuncSubmitCycleCounts = f
+
+	return mmSubmitCycleCounts
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.SubmitCycleCounts
+func (mmSubmitCycleCounts *mStockServiceUseCaseMockSubmitCycleCounts) Return(c2 domain.CycleCount, err error) *StockServiceUseCaseMock {
+	if mmSubmitCycleCounts.mock.funcSubmitCycleCounts != nil {
+		mmSubmitCycleCounts.mock.t.Fatalf("StockServiceUseCaseMock.SubmitCycleCounts mock is already set by Set")
+	}
+
+	if mmSubmitCycleCounts.defaultExpectation == nil {
+		mmSubmitCycleCounts.defaultExpectation = &StockServiceUseCaseMockSubmitCycleCountsExpectation{mock: mmSubmitCycleCounts.mock}
+	}
+	mmSubmitCycleCounts.defaultExpectation.results = &StockServiceUseCaseMockSubmitCycleCountsResults{c2, err}
+	mmSubmitCycleCounts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSubmitCycleCounts.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.SubmitCycleCounts method
+func (mmSubmitCycleCounts *mStockServiceUseCaseMockSubmitCycleCounts) Set(f func(ctx context.Context, submission domain.CycleCountSubmission) (c2 domain.CycleCount, err error)) *StockServiceUseCaseMock {
+	if mmSubmitCycleCounts.defaultExpectation != nil {
+		mmSubmitCycleCounts.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.SubmitCycleCounts method")
+	}
+
+	if len(mmSubmitCycleCounts.expectations) > 0 {
+		mmSubmitCycleCounts.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.SubmitCycleCounts method")
+	}
+
+	mmSubmitCycleCounts.mock.funcSubmitCycleCounts = f
+	mmSubmitCycleCounts.mock.funcSubmitCycleCountsOrigin = minimock.CallerInfo(1)
+	return mmSubmitCycleCounts.mock
+}
+
+// When sets expectation for the StockServiceUseCase.SubmitCycleCounts which will trigger the result defined by the following
+// Then helper
+func (mmSubmitCycleCounts *mStockServiceUseCaseMockSubmitCycleCounts) When(ctx context.Context, submission domain.CycleCountSubmission) *StockServiceUseCaseMockSubmitCycleCountsExpectation {
+	if mmSubmitCycleCounts.mock.funcSubmitCycleCounts != nil {
+		mmSubmitCycleCounts.mock.t.Fatalf("StockServiceUseCaseMock.SubmitCycleCounts mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockSubmitCycleCountsExpectation{
+		mock:               mmSubmitCycleCounts.mock,
+		params:             &StockServiceUseCaseMockSubmitCycleCountsParams{ctx, submission},
+		expectationOrigins: StockServiceUseCaseMockSubmitCycleCountsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSubmitCycleCounts.expectations = append(mmSubmitCycleCounts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.SubmitCycleCounts return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockSubmitCycleCountsExpectation) Then(c2 domain.CycleCount, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockSubmitCycleCountsResults{c2, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.SubmitCycleCounts should be invoked
+func (mmSubmitCycleCounts *mStockServiceUseCaseMockSubmitCycleCounts) Times(n uint64) *mStockServiceUseCaseMockSubmitCycleCounts {
+	if n == 0 {
+		mmSubmitCycleCounts.mock.t.Fatalf("Times of StockServiceUseCaseMock.SubmitCycleCounts mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSubmitCycleCounts.expectedInvocations, n)
+	mmSubmitCycleCounts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSubmitCycleCounts
+}
+
+func (mmSubmitCycleCounts *mStockServiceUseCaseMockSubmitCycleCounts) invocationsDone() bool {
+	if len(mmSubmitCycleCounts.expectations) == 0 && mmSubmitCycleCounts.defaultExpectation == nil && mmSubmitCycleCounts.mock.funcSubmitCycleCounts == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSubmitCycleCounts.mock.afterSubmitCycleCountsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSubmitCycleCounts.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SubmitCycleCounts implements mm_usecase.StockServiceUseCase
+func (mmSubmitCycleCounts *StockServiceUseCaseMock) SubmitCycleCounts(ctx context.Context, submission domain.CycleCountSubmission) (c2 domain.CycleCount, err error) {
+	mm_atomic.AddUint64(&mmSubmitCycleCounts.beforeSubmitCycleCountsCounter, 1)
+	defer mm_atomic.AddUint64(&mmSubmitCycleCounts.afterSubmitCycleCountsCounter, 1)
+
+	mmSubmitCycleCounts.t.Helper()
+
+	if mmSubmitCycleCounts.inspectFuncSubmitCycleCounts != nil {
+		mmSubmitCycleCounts.inspectFuncSubmitCycleCounts(ctx, submission)
+	}
+
+	mm_params := StockServiceUseCaseMockSubmitCycleCountsParams{ctx, submission}
+
+	// Record call args
+	mmSubmitCycleCounts.SubmitCycleCountsMock.mutex.Lock()
+	mmSubmitCycleCounts.SubmitCycleCountsMock.callArgs = append(mmSubmitCycleCounts.SubmitCycleCountsMock.callArgs, &mm_params)
+	mmSubmitCycleCounts.SubmitCycleCountsMock.mutex.Unlock()
+
+	for _, e := range mmSubmitCycleCounts.SubmitCycleCountsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.c2, e.results.err
+		}
+	}
+
+	if mmSubmitCycleCounts.SubmitCycleCountsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSubmitCycleCounts.SubmitCycleCountsMock.defaultExpectation.Counter, 1)
+		mm_want := mmSubmitCycleCounts.SubmitCycleCountsMock.defaultExpectation.params
+		mm_want_ptrs := mmSubmitCycleCounts.SubmitCycleCountsMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockSubmitCycleCountsParams{ctx, submission}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSubmitCycleCounts.t.Errorf("StockServiceUseCaseMock.SubmitCycleCounts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSubmitCycleCounts.SubmitCycleCountsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.submission != nil && !minimock.Equal(*mm_want_ptrs.submission, mm_got.submission) {
+				mmSubmitCycleCounts.t.Errorf("StockServiceUseCaseMock.SubmitCycleCounts got unexpected parameter submission, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSubmitCycleCounts.SubmitCycleCountsMock.defaultExpectation.expectationOrigins.originSubmission, *mm_want_ptrs.submission, mm_got.submission, minimock.Diff(*mm_want_ptrs.submission, mm_got.submission))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSubmitCycleCounts.t.Errorf("StockServiceUseCaseMock.SubmitCycleCounts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSubmitCycleCounts.SubmitCycleCountsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSubmitCycleCounts.SubmitCycleCountsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSubmitCycleCounts.t.Fatal("No results are set for the StockServiceUseCaseMock.SubmitCycleCounts")
+		}
+		return (*mm_results).c2, (*mm_results).err
+	}
+	if mmSubmitCycleCounts.funcSubmitCycleCounts != nil {
+		return mmSubmitCycleCounts.funcSubmitCycleCounts(ctx, submission)
+	}
+	mmSubmitCycleCounts.t.Fatalf("Unexpected call to StockServiceUseCaseMock.SubmitCycleCounts. %v %v", ctx, submission)
+	return
+}
+
+// SubmitCycleCountsAfterCounter returns a count of finished StockServiceUseCaseMock.SubmitCycleCounts invocations
+func (mmSubmitCycleCounts *StockServiceUseCaseMock) SubmitCycleCountsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSubmitCycleCounts.afterSubmitCycleCountsCounter)
+}
+
+// SubmitCycleCountsBeforeCounter returns a count of StockServiceUseCaseMock.SubmitCycleCounts invocations
+func (mmSubmitCycleCounts *StockServiceUseCaseMock) SubmitCycleCountsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSubmitCycleCounts.beforeSubmitCycleCountsCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.SubmitCycleCounts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSubmitCycleCounts *mStockServiceUseCaseMockSubmitCycleCounts) Calls() []*StockServiceUseCaseMockSubmitCycleCountsParams {
+	mmSubmitCycleCounts.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockSubmitCycleCountsParams, len(mmSubmitCycleCounts.callArgs))
+	copy(argCopy, mmSubmitCycleCounts.callArgs)
+
+	mmSubmitCycleCounts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSubmitCycleCountsDone returns true if the count of the SubmitCycleCounts invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockSubmitCycleCountsDone() bool {
+	if m.SubmitCycleCountsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SubmitCycleCountsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SubmitCycleCountsMock.invocationsDone()
+}
+
+// MinimockSubmitCycleCountsInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockSubmitCycleCountsInspect() {
+	for _, e := range m.SubmitCycleCountsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.SubmitCycleCounts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSubmitCycleCountsCounter := mm_atomic.LoadUint64(&m.afterSubmitCycleCountsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SubmitCycleCountsMock.defaultExpectation != nil && afterSubmitCycleCountsCounter < 1 {
+		if m.SubmitCycleCountsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.SubmitCycleCounts at\n%s", m.SubmitCycleCountsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.SubmitCycleCounts at\n%s with params: %#v", m.SubmitCycleCountsMock.defaultExpectation.expectationOrigins.origin, *m.SubmitCycleCountsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSubmitCycleCounts != nil && afterSubmitCycleCountsCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.SubmitCycleCounts at\n%s", m.funcSubmitCycleCountsOrigin)
+	}
+
+	if !m.SubmitCycleCountsMock.invocationsDone() && afterSubmitCycleCountsCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.SubmitCycleCounts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SubmitCycleCountsMock.expectedInvocations), m.SubmitCycleCountsMock.expectedInvocationsOrigin, afterSubmitCycleCountsCounter)
+	}
+}
+
+type mStockServiceUseCaseMockTransferStock struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockTransferStockExpectation
+	expectations       []*StockServiceUseCaseMockTransferStockExpectation
+
+	callArgs []*StockServiceUseCaseMockTransferStockParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockTransferStockExpectation specifies expectation struct of the StockServiceUseCase.TransferStock
+type StockServiceUseCaseMockTransferStockExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockTransferStockParams
+	paramPtrs          *StockServiceUseCaseMockTransferStockParamPtrs
+	expectationOrigins StockServiceUseCaseMockTransferStockExpectationOrigins
+	results            *StockServiceUseCaseMockTransferStockResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockTransferStockParams contains parameters of the StockServiceUseCase.TransferStock
+type StockServiceUseCaseMockTransferStockParams struct {
+	ctx                context.Context
+	transfer           domain.StockTransfer
+	receiveImmediately bool
+}
+
+// StockServiceUseCaseMockTransferStockParamPtrs contains pointers to parameters of the StockServiceUseCase.TransferStock
+type StockServiceUseCaseMockTransferStockParamPtrs struct {
+	ctx                *context.Context
+	transfer           *domain.StockTransfer
+	receiveImmediately *bool
+}
+
+// StockServiceUseCaseMockTransferStockResults contains results of the StockServiceUseCase.TransferStock
+type StockServiceUseCaseMockTransferStockResults struct {
+	s1  domain.StockTransfer
+	err error
+}
+
+// StockServiceUseCaseMockTransferStockOrigins contains origins of expectations of the StockServiceUseCase.TransferStock
+type StockServiceUseCaseMockTransferStockExpectationOrigins struct {
+	origin                   string
+	originCtx                string
+	originTransfer           string
+	originReceiveImmediately string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmTransferStock *mStockServiceUseCaseMockTransferStock) Optional() *mStockServiceUseCaseMockTransferStock {
+	mmTransferStock.optional = true
+	return mmTransferStock
+}
+
+// Expect sets up expected params for StockServiceUseCase.TransferStock
+func (mmTransferStock *mStockServiceUseCaseMockTransferStock) Expect(ctx context.Context, transfer domain.StockTransfer, receiveImmediately bool) *mStockServiceUseCaseMockTransferStock {
+	if mmTransferStock.mock.funcTransferStock != nil {
+		mmTransferStock.mock.t.Fatalf("StockServiceUseCaseMock.TransferStock mock is already set by Set")
+	}
+
+	if mmTransferStock.defaultExpectation == nil {
+		mmTransferStock.defaultExpectation = &StockServiceUseCaseMockTransferStockExpectation{}
+	}
+
+	if mmTransferStock.defaultExpectation.paramPtrs != nil {
+		mmTransferStock.mock.t.Fatalf("StockServiceUseCaseMock.TransferStock mock is already set by ExpectParams functions")
+	}
+
+	mmTransferStock.defaultExpectation.params = &StockServiceUseCaseMockTransferStockParams{ctx, transfer, receiveImmediately}
+	mmTransferStock.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmTransferStock.expectations {
+		if minimock.Equal(e.params, mmTransferStock.defaultExpectation.params) {
+			mmTransferStock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmTransferStock.defaultExpectation.params)
+		}
+	}
+
+	return mmTransferStock
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.TransferStock
+func (mmTransferStock *mStockServiceUseCaseMockTransferStock) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockTransferStock {
+	if mmTransferStock.mock.funcTransferStock != nil {
+		mmTransferStock.mock.t.Fatalf("StockServiceUseCaseMock.TransferStock mock is already set by Set")
+	}
+
+	if mmTransferStock.defaultExpectation == nil {
+		mmTransferStock.defaultExpectation = &StockServiceUseCaseMockTransferStockExpectation{}
+	}
+
+	if mmTransferStock.defaultExpectation.params != nil {
+		mmTransferStock.mock.t.Fatalf("StockServiceUseCaseMock.TransferStock mock is already set by Expect")
+	}
+
+	if mmTransferStock.defaultExpectation.paramPtrs == nil {
+		mmTransferStock.defaultExpectation.paramPtrs = &StockServiceUseCaseMockTransferStockParamPtrs{}
+	}
+	mmTransferStock.defaultExpectation.paramPtrs.ctx = &ctx
+	mmTransferStock.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmTransferStock
+}
+
+// ExpectTransferParam2 sets up expected param transfer for StockServiceUseCase.TransferStock
+func (mmTransferStock *mStockServiceUseCaseMockTransferStock) ExpectTransferParam2(transfer domain.StockTransfer) *mStockServiceUseCaseMockTransferStock {
+	if mmTransferStock.mock.funcTransferStock != nil {
+		mmTransferStock.mock.t.Fatalf("StockServiceUseCaseMock.TransferStock mock is already set by Set")
+	}
+
+	if mmTransferStock.defaultExpectation == nil {
+		mmTransferStock.defaultExpectation = &StockServiceUseCaseMockTransferStockExpectation{}
+	}
+
+	if mmTransferStock.defaultExpectation.params != nil {
+		mmTransferStock.mock.t.Fatalf("StockServiceUseCaseMock.TransferStock mock is already set by Expect")
+	}
+
+	if mmTransferStock.defaultExpectation.paramPtrs == nil {
+		mmTransferStock.defaultExpectation.paramPtrs = &StockServiceUseCaseMockTransferStockParamPtrs{}
+	}
+	mmTransferStock.defaultExpectation.paramPtrs.transfer = &transfer
+	mmTransferStock.defaultExpectation.expectationOrigins.originTransfer = minimock.CallerInfo(1)
+
+	return mmTransferStock
+}
+
+// ExpectReceiveImmediatelyParam3 sets up expected param receiveImmediately for StockServiceUseCase.TransferStock
+func (mmTransferStock *mStockServiceUseCaseMockTransferStock) ExpectReceiveImmediatelyParam3(receiveImmediately bool) *mStockServiceUseCaseMockTransferStock {
+	if mmTransferStock.mock.funcTransferStock != nil {
+		mmTransferStock.mock.t.Fatalf("StockServiceUseCaseMock.TransferStock mock is already set by Set")
+	}
+
+	if mmTransferStock.defaultExpectation == nil {
+		mmTransferStock.defaultExpectation = &StockServiceUseCaseMockTransferStockExpectation{}
+	}
+
+	if mmTransferStock.defaultExpectation.params != nil {
+		mmTransferStock.mock.t.Fatalf("StockServiceUseCaseMock.TransferStock mock is already set by Expect")
+	}
+
+	if mmTransferStock.defaultExpectation.paramPtrs == nil {
+		mmTransferStock.defaultExpectation.paramPtrs = &StockServiceUseCaseMockTransferStockParamPtrs{}
+	}
+	mmTransferStock.defaultExpectation.paramPtrs.receiveImmediately = &receiveImmediately
+	mmTransferStock.defaultExpectation.expectationOrigins.originReceiveImmediately = minimock.CallerInfo(1)
+
+	return mmTransferStock
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.TransferStock
+func (mmTransferStock *mStockServiceUseCaseMockTransferStock) Inspect(f func(ctx context.Context, transfer domain.StockTransfer, receiveImmediately bool)) *mStockServiceUseCaseMockTransferStock {
+	if mmTransferStock.mock.inspectFuncTransferStock != nil {
+		mmTransferStock.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.TransferStock")
+	}
+
+	mmTransferStock.mock.inspectFuncTransferStock = f
+
+	return mmTransferStock
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.TransferStock
+func (mmTransferStock *mStockServiceUseCaseMockTransferStock) Return(s1 domain.StockTransfer, err error) *StockServiceUseCaseMock {
+	if mmTransferStock.mock.funcTransferStock != nil {
+		mmTransferStock.mock.t.Fatalf("StockServiceUseCaseMock.TransferStock mock is already set by Set")
+	}
+
+	if mmTransferStock.defaultExpectation == nil {
+		mmTransferStock.defaultExpectation = &StockServiceUseCaseMockTransferStockExpectation{mock: mmTransferStock.mock}
+	}
+	mmTransferStock.defaultExpectation.results = &StockServiceUseCaseMockTransferStockResults{s1, err}
+	mmTransferStock.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmTransferStock.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.TransferStock method
+func (mmTransferStock *mStockServiceUseCaseMockTransferStock) Set(f func(ctx context.Context, transfer domain.StockTransfer, receiveImmediately bool) (s1 domain.StockTransfer, err error)) *StockServiceUseCaseMock {
+	if mmTransferStock.defaultExpectation != nil {
+		mmTransferStock.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.TransferStock method")
+	}
+
+	if len(mmTransferStock.expectations) > 0 {
+		mmTransferStock.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.TransferStock method")
+	}
+
+	mmTransferStock.mock.funcTransferStock = f
+	mmTransferStock.mock.funcTransferStockOrigin = minimock.CallerInfo(1)
+	return mmTransferStock.mock
+}
+
+// When sets expectation for the StockServiceUseCase.TransferStock which will trigger the result defined by the following
+// Then helper
+func (mmTransferStock *mStockServiceUseCaseMockTransferStock) When(ctx context.Context, transfer domain.StockTransfer, receiveImmediately bool) *StockServiceUseCaseMockTransferStockExpectation {
+	if mmTransferStock.mock.funcTransferStock != nil {
+		mmTransferStock.mock.t.Fatalf("StockServiceUseCaseMock.TransferStock mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockTransferStockExpectation{
+		mock:               mmTransferStock.mock,
+		params:             &StockServiceUseCaseMockTransferStockParams{ctx, transfer, receiveImmediately},
+		expectationOrigins: StockServiceUseCaseMockTransferStockExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmTransferStock.expectations = append(mmTransferStock.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.TransferStock return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockTransferStockExpectation) Then(s1 domain.StockTransfer, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockTransferStockResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.TransferStock should be invoked
+func (mmTransferStock *mStockServiceUseCaseMockTransferStock) Times(n uint64) *mStockServiceUseCaseMockTransferStock {
+	if n == 0 {
+		mmTransferStock.mock.t.Fatalf("Times of StockServiceUseCaseMock.TransferStock mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmTransferStock.expectedInvocations, n)
+	mmTransferStock.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmTransferStock
+}
+
+func (mmTransferStock *mStockServiceUseCaseMockTransferStock) invocationsDone() bool {
+	if len(mmTransferStock.expectations) == 0 && mmTransferStock.defaultExpectation == nil && mmTransferStock.mock.funcTransferStock == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmTransferStock.mock.afterTransferStockCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmTransferStock.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// TransferStock implements mm_usecase.StockServiceUseCase
+func (mmTransferStock *StockServiceUseCaseMock) TransferStock(ctx context.Context, transfer domain.StockTransfer, receiveImmediately bool) (s1 domain.StockTransfer, err error) {
+	mm_atomic.AddUint64(&mmTransferStock.beforeTransferStockCounter, 1)
+	defer mm_atomic.AddUint64(&mmTransferStock.afterTransferStockCounter, 1)
+
+	mmTransferStock.t.Helper()
+
+	if mmTransferStock.inspectFuncTransferStock != nil {
+		mmTransferStock.inspectFuncTransferStock(ctx, transfer, receiveImmediately)
+	}
+
+	mm_params := StockServiceUseCaseMockTransferStockParams{ctx, transfer, receiveImmediately}
+
+	// Record call args
+	mmTransferStock.TransferStockMock.mutex.Lock()
+	mmTransferStock.TransferStockMock.callArgs = append(mmTransferStock.TransferStockMock.callArgs, &mm_params)
+	mmTransferStock.TransferStockMock.mutex.Unlock()
+
+	for _, e := range mmTransferStock.TransferStockMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmTransferStock.TransferStockMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmTransferStock.TransferStockMock.defaultExpectation.Counter, 1)
+		mm_want := mmTransferStock.TransferStockMock.defaultExpectation.params
+		mm_want_ptrs := mmTransferStock.TransferStockMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockTransferStockParams{ctx, transfer, receiveImmediately}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmTransferStock.t.Errorf("StockServiceUseCaseMock.TransferStock got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransferStock.TransferStockMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.transfer != nil && !minimock.Equal(*mm_want_ptrs.transfer, mm_got.transfer) {
+				mmTransferStock.t.Errorf("StockServiceUseCaseMock.TransferStock got unexpected parameter transfer, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransferStock.TransferStockMock.defaultExpectation.expectationOrigins.originTransfer, *mm_want_ptrs.transfer, mm_got.transfer, minimock.Diff(*mm_want_ptrs.transfer, mm_got.transfer))
+			}
+
+			if mm_want_ptrs.receiveImmediately != nil && !minimock.Equal(*mm_want_ptrs.receiveImmediately, mm_got.receiveImmediately) {
+				mmTransferStock.t.Errorf("StockServiceUseCaseMock.TransferStock got unexpected parameter receiveImmediately, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmTransferStock.TransferStockMock.defaultExpectation.expectationOrigins.originReceiveImmediately, *mm_want_ptrs.receiveImmediately, mm_got.receiveImmediately, minimock.Diff(*mm_want_ptrs.receiveImmediately, mm_got.receiveImmediately))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmTransferStock.t.Errorf("StockServiceUseCaseMock.TransferStock got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmTransferStock.TransferStockMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmTransferStock.TransferStockMock.defaultExpectation.results
+		if mm_results == nil {
+			mmTransferStock.t.Fatal("No results are set for the StockServiceUseCaseMock.TransferStock")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmTransferStock.funcTransferStock != nil {
+		return mmTransferStock.funcTransferStock(ctx, transfer, receiveImmediately)
+	}
+	mmTransferStock.t.Fatalf("Unexpected call to StockServiceUseCaseMock.TransferStock. %v %v %v", ctx, transfer, receiveImmediately)
+	return
+}
+
+// TransferStockAfterCounter returns a count of finished StockServiceUseCaseMock.TransferStock invocations
+func (mmTransferStock *StockServiceUseCaseMock) TransferStockAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTransferStock.afterTransferStockCounter)
+}
+
+// TransferStockBeforeCounter returns a count of StockServiceUseCaseMock.TransferStock invocations
+func (mmTransferStock *StockServiceUseCaseMock) TransferStockBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmTransferStock.beforeTransferStockCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.TransferStock.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmTransferStock *mStockServiceUseCaseMockTransferStock) Calls() []*StockServiceUseCaseMockTransferStockParams {
+	mmTransferStock.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockTransferStockParams, len(mmTransferStock.callArgs))
+	copy(argCopy, mmTransferStock.callArgs)
+
+	mmTransferStock.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockTransferStockDone returns true if the count of the TransferStock invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockTransferStockDone() bool {
+	if m.TransferStockMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.TransferStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.TransferStockMock.invocationsDone()
+}
+
+// MinimockTransferStockInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockTransferStockInspect() {
+	for _, e := range m.TransferStockMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.TransferStock at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterTransferStockCounter := mm_atomic.LoadUint64(&m.afterTransferStockCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.TransferStockMock.defaultExpectation != nil && afterTransferStockCounter < 1 {
+		if m.TransferStockMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.TransferStock at\n%s", m.TransferStockMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.TransferStock at\n%s with params: %#v", m.TransferStockMock.defaultExpectation.expectationOrigins.origin, *m.TransferStockMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcTransferStock != nil && afterTransferStockCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.TransferStock at\n%s", m.funcTransferStockOrigin)
+	}
+
+	if !m.TransferStockMock.invocationsDone() && afterTransferStockCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.TransferStock at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.TransferStockMock.expectedInvocations), m.TransferStockMock.expectedInvocationsOrigin, afterTransferStockCounter)
+	}
+}
+
+type mStockServiceUseCaseMockUpdateSKUAttributes struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockUpdateSKUAttributesExpectation
+	expectations       []*StockServiceUseCaseMockUpdateSKUAttributesExpectation
+
+	callArgs []*StockServiceUseCaseMockUpdateSKUAttributesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockUpdateSKUAttributesExpectation specifies expectation struct of the StockServiceUseCase.UpdateSKUAttributes
+type StockServiceUseCaseMockUpdateSKUAttributesExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockUpdateSKUAttributesParams
+	paramPtrs          *StockServiceUseCaseMockUpdateSKUAttributesParamPtrs
+	expectationOrigins StockServiceUseCaseMockUpdateSKUAttributesExpectationOrigins
+	results            *StockServiceUseCaseMockUpdateSKUAttributesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockUpdateSKUAttributesParams contains parameters of the StockServiceUseCase.UpdateSKUAttributes
+type StockServiceUseCaseMockUpdateSKUAttributesParams struct {
+	ctx    context.Context
+	update domain.SKUAttributesUpdate
+}
+
+// StockServiceUseCaseMockUpdateSKUAttributesParamPtrs contains pointers to parameters of the StockServiceUseCase.UpdateSKUAttributes
+type StockServiceUseCaseMockUpdateSKUAttributesParamPtrs struct {
+	ctx    *context.Context
+	update *domain.SKUAttributesUpdate
+}
+
+// StockServiceUseCaseMockUpdateSKUAttributesResults contains results of the StockServiceUseCase.UpdateSKUAttributes
+type StockServiceUseCaseMockUpdateSKUAttributesResults struct {
+	s1  domain.SKU
+	err error
+}
+
+// StockServiceUseCaseMockUpdateSKUAttributesOrigins contains origins of expectations of the StockServiceUseCase.UpdateSKUAttributes
+type StockServiceUseCaseMockUpdateSKUAttributesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUpdate string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateSKUAttributes *mStockServiceUseCaseMockUpdateSKUAttributes) Optional() *mStockServiceUseCaseMockUpdateSKUAttributes {
+	mmUpdateSKUAttributes.optional = true
+	return mmUpdateSKUAttributes
+}
+
+// Expect sets up expected params for StockServiceUseCase.UpdateSKUAttributes
+func (mmUpdateSKUAttributes *mStockServiceUseCaseMockUpdateSKUAttributes) Expect(ctx context.Context, update domain.SKUAttributesUpdate) *mStockServiceUseCaseMockUpdateSKUAttributes {
+	if mmUpdateSKUAttributes.mock.funcUpdateSKUAttributes != nil {
+		mmUpdateSKUAttributes.mock.t.Fatalf("StockServiceUseCaseMock.UpdateSKUAttributes mock is already set by Set")
+	}
+
+	if mmUpdateSKUAttributes.defaultExpectation == nil {
+		mmUpdateSKUAttributes.defaultExpectation = &StockServiceUseCaseMockUpdateSKUAttributesExpectation{}
+	}
+
+	if mmUpdateSKUAttributes.defaultExpectation.paramPtrs != nil {
+		mmUpdateSKUAttributes.mock.t.Fatalf("StockServiceUseCaseMock.UpdateSKUAttributes mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateSKUAttributes.defaultExpectation.params = &StockServiceUseCaseMockUpdateSKUAttributesParams{ctx, update}
+	mmUpdateSKUAttributes.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateSKUAttributes.expectations {
+		if minimock.Equal(e.params, mmUpdateSKUAttributes.defaultExpectation.params) {
+			mmUpdateSKUAttributes.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateSKUAttributes.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateSKUAttributes
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.UpdateSKUAttributes
+func (mmUpdateSKUAttributes *mStockServiceUseCaseMockUpdateSKUAttributes) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockUpdateSKUAttributes {
+	if mmUpdateSKUAttributes.mock.funcUpdateSKUAttributes != nil {
+		mmUpdateSKUAttributes.mock.t.Fatalf("StockServiceUseCaseMock.UpdateSKUAttributes mock is already set by Set")
+	}
+
+	if mmUpdateSKUAttributes.defaultExpectation == nil {
+		mmUpdateSKUAttributes.defaultExpectation = &StockServiceUseCaseMockUpdateSKUAttributesExpectation{}
+	}
+
+	if mmUpdateSKUAttributes.defaultExpectation.params != nil {
+		mmUpdateSKUAttributes.mock.t.Fatalf("StockServiceUseCaseMock.UpdateSKUAttributes mock is already set by Expect")
+	}
+
+	if mmUpdateSKUAttributes.defaultExpectation.paramPtrs == nil {
+		mmUpdateSKUAttributes.defaultExpectation.paramPtrs = &StockServiceUseCaseMockUpdateSKUAttributesParamPtrs{}
+	}
+	mmUpdateSKUAttributes.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateSKUAttributes.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateSKUAttributes
+}
+
+// ExpectUpdateParam2 sets up expected param update for StockServiceUseCase.UpdateSKUAttributes
+func (mmUpdateSKUAttributes *mStockServiceUseCaseMockUpdateSKUAttributes) ExpectUpdateParam2(update domain.SKUAttributesUpdate) *mStockServiceUseCaseMockUpdateSKUAttributes {
+	if mmUpdateSKUAttributes.mock.funcUpdateSKUAttributes != nil {
+		mmUpdateSKUAttributes.mock.t.Fatalf("StockServiceUseCaseMock.UpdateSKUAttributes mock is already set by Set")
+	}
+
+	if mmUpdateSKUAttributes.defaultExpectation == nil {
+		mmUpdateSKUAttributes.defaultExpectation = &StockServiceUseCaseMockUpdateSKUAttributesExpectation{}
+	}
+
+	if mmUpdateSKUAttributes.defaultExpectation.params != nil {
+		mmUpdateSKUAttributes.mock.t.Fatalf("StockServiceUseCaseMock.UpdateSKUAttributes mock is already set by Expect")
+	}
+
+	if mmUpdateSKUAttributes.defaultExpectation.paramPtrs == nil {
+		mmUpdateSKUAttributes.defaultExpectation.paramPtrs = &StockServiceUseCaseMockUpdateSKUAttributesParamPtrs{}
+	}
+	mmUpdateSKUAttributes.defaultExpectation.paramPtrs.update = &update
+	mmUpdateSKUAttributes.defaultExpectation.expectationOrigins.originUpdate = minimock.CallerInfo(1)
+
+	return mmUpdateSKUAttributes
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.UpdateSKUAttributes
+func (mmUpdateSKUAttributes *mStockServiceUseCaseMockUpdateSKUAttributes) Inspect(f func(ctx context.Context, update domain.SKUAttributesUpdate)) *mStockServiceUseCaseMockUpdateSKUAttributes {
+	if mmUpdateSKUAttributes.mock.inspectFuncUpdateSKUAttributes != nil {
+		mmUpdateSKUAttributes.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.UpdateSKUAttributes")
+	}
+
+	mmUpdateSKUAttributes.mock.inspectFuncUpdateSKUAttributes = f
+
+	return mmUpdateSKUAttributes
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.UpdateSKUAttributes
+func (mmUpdateSKUAttributes *mStockServiceUseCaseMockUpdateSKUAttributes) Return(s1 domain.SKU, err error) *StockServiceUseCaseMock {
+	if mmUpdateSKUAttributes.mock.funcUpdateSKUAttributes != nil {
+		mmUpdateSKUAttributes.mock.t.Fatalf("StockServiceUseCaseMock.UpdateSKUAttributes mock is already set by Set")
+	}
+
+	if mmUpdateSKUAttributes.defaultExpectation == nil {
+		mmUpdateSKUAttributes.defaultExpectation = &StockServiceUseCaseMockUpdateSKUAttributesExpectation{mock: mmUpdateSKUAttributes.mock}
+	}
+	mmUpdateSKUAttributes.defaultExpectation.results = &StockServiceUseCaseMockUpdateSKUAttributesResults{s1, err}
+	mmUpdateSKUAttributes.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateSKUAttributes.mock
+}
+
+// Set uses given function f to mock the StockServiceUseCase.UpdateSKUAttributes method
+func (mmUpdateSKUAttributes *mStockServiceUseCaseMockUpdateSKUAttributes) Set(f func(ctx context.Context, update domain.SKUAttributesUpdate) (s1 domain.SKU, err error)) *StockServiceUseCaseMock {
+	if mmUpdateSKUAttributes.defaultExpectation != nil {
+		mmUpdateSKUAttributes.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.UpdateSKUAttributes method")
+	}
+
+	if len(mmUpdateSKUAttributes.expectations) > 0 {
+		mmUpdateSKUAttributes.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.UpdateSKUAttributes method")
+	}
+
+	mmUpdateSKUAttributes.mock.funcUpdateSKUAttributes = f
+	mmUpdateSKUAttributes.mock.funcUpdateSKUAttributesOrigin = minimock.CallerInfo(1)
+	return mmUpdateSKUAttributes.mock
+}
+
+// When sets expectation for the StockServiceUseCase.UpdateSKUAttributes which will trigger the result defined by the following
+// Then helper
+func (mmUpdateSKUAttributes *mStockServiceUseCaseMockUpdateSKUAttributes) When(ctx context.Context, update domain.SKUAttributesUpdate) *StockServiceUseCaseMockUpdateSKUAttributesExpectation {
+	if mmUpdateSKUAttributes.mock.funcUpdateSKUAttributes != nil {
+		mmUpdateSKUAttributes.mock.t.Fatalf("StockServiceUseCaseMock.UpdateSKUAttributes mock is already set by Set")
+	}
+
+	expectation := &StockServiceUseCaseMockUpdateSKUAttributesExpectation{
+		mock:               mmUpdateSKUAttributes.mock,
+		params:             &StockServiceUseCaseMockUpdateSKUAttributesParams{ctx, update},
+		expectationOrigins: StockServiceUseCaseMockUpdateSKUAttributesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateSKUAttributes.expectations = append(mmUpdateSKUAttributes.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceUseCase.UpdateSKUAttributes return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockUpdateSKUAttributesExpectation) Then(s1 domain.SKU, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockUpdateSKUAttributesResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceUseCase.UpdateSKUAttributes should be invoked
+func (mmUpdateSKUAttributes *mStockServiceUseCaseMockUpdateSKUAttributes) Times(n uint64) *mStockServiceUseCaseMockUpdateSKUAttributes {
+	if n == 0 {
+		mmUpdateSKUAttributes.mock.t.Fatalf("Times of StockServiceUseCaseMock.UpdateSKUAttributes mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateSKUAttributes.expectedInvocations, n)
+	mmUpdateSKUAttributes.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateSKUAttributes
+}
+
+func (mmUpdateSKUAttributes *mStockServiceUseCaseMockUpdateSKUAttributes) invocationsDone() bool {
+	if len(mmUpdateSKUAttributes.expectations) == 0 && mmUpdateSKUAttributes.defaultExpectation == nil && mmUpdateSKUAttributes.mock.funcUpdateSKUAttributes == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateSKUAttributes.mock.afterUpdateSKUAttributesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateSKUAttributes.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateSKUAttributes implements mm_usecase.StockServiceUseCase
+func (mmUpdateSKUAttributes *StockServiceUseCaseMock) UpdateSKUAttributes(ctx context.Context, update domain.SKUAttributesUpdate) (s1 domain.SKU, err error) {
+	mm_atomic.AddUint64(&mmUpdateSKUAttributes.beforeUpdateSKUAttributesCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateSKUAttributes.afterUpdateSKUAttributesCounter, 1)
+
+	mmUpdateSKUAttributes.t.Helper()
+
+	if mmUpdateSKUAttributes.inspectFuncUpdateSKUAttributes != nil {
+		mmUpdateSKUAttributes.inspectFuncUpdateSKUAttributes(ctx, update)
+	}
+
+	mm_params := StockServiceUseCaseMockUpdateSKUAttributesParams{ctx, update}
+
+	// Record call args
+	mmUpdateSKUAttributes.UpdateSKUAttributesMock.mutex.Lock()
+	mmUpdateSKUAttributes.UpdateSKUAttributesMock.callArgs = append(mmUpdateSKUAttributes.UpdateSKUAttributesMock.callArgs, &mm_params)
+	mmUpdateSKUAttributes.UpdateSKUAttributesMock.mutex.Unlock()
+
+	for _, e := range mmUpdateSKUAttributes.UpdateSKUAttributesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmUpdateSKUAttributes.UpdateSKUAttributesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateSKUAttributes.UpdateSKUAttributesMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateSKUAttributes.UpdateSKUAttributesMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateSKUAttributes.UpdateSKUAttributesMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceUseCaseMockUpdateSKUAttributesParams{ctx, update}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateSKUAttributes.t.Errorf("StockServiceUseCaseMock.UpdateSKUAttributes got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateSKUAttributes.UpdateSKUAttributesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.update != nil && !minimock.Equal(*mm_want_ptrs.update, mm_got.update) {
+				mmUpdateSKUAttributes.t.Errorf("StockServiceUseCaseMock.UpdateSKUAttributes got unexpected parameter update, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateSKUAttributes.UpdateSKUAttributesMock.defaultExpectation.expectationOrigins.originUpdate, *mm_want_ptrs.update, mm_got.update, minimock.Diff(*mm_want_ptrs.update, mm_got.update))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateSKUAttributes.t.Errorf("StockServiceUseCaseMock.UpdateSKUAttributes got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateSKUAttributes.UpdateSKUAttributesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateSKUAttributes.UpdateSKUAttributesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateSKUAttributes.t.Fatal("No results are set for the StockServiceUseCaseMock.UpdateSKUAttributes")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmUpdateSKUAttributes.funcUpdateSKUAttributes != nil {
+		return mmUpdateSKUAttributes.funcUpdateSKUAttributes(ctx, update)
+	}
+	mmUpdateSKUAttributes.t.Fatalf("Unexpected call to StockServiceUseCaseMock.UpdateSKUAttributes. %v %v", ctx, update)
+	return
+}
+
+// UpdateSKUAttributesAfterCounter returns a count of finished StockServiceUseCaseMock.UpdateSKUAttributes invocations
+func (mmUpdateSKUAttributes *StockServiceUseCaseMock) UpdateSKUAttributesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateSKUAttributes.afterUpdateSKUAttributesCounter)
+}
+
+// UpdateSKUAttributesBeforeCounter returns a count of StockServiceUseCaseMock.UpdateSKUAttributes invocations
+func (mmUpdateSKUAttributes *StockServiceUseCaseMock) UpdateSKUAttributesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateSKUAttributes.beforeUpdateSKUAttributesCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.UpdateSKUAttributes.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateSKUAttributes *mStockServiceUseCaseMockUpdateSKUAttributes) Calls() []*StockServiceUseCaseMockUpdateSKUAttributesParams {
+	mmUpdateSKUAttributes.mutex.RLock()
+
+	argCopy := make([]*StockServiceUseCaseMockUpdateSKUAttributesParams, len(mmUpdateSKUAttributes.callArgs))
+	copy(argCopy, mmUpdateSKUAttributes.callArgs)
+
+	mmUpdateSKUAttributes.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateSKUAttributesDone returns true if the count of the UpdateSKUAttributes invocations corresponds
+// the number of defined expectations
+func (m *StockServiceUseCaseMock) MinimockUpdateSKUAttributesDone() bool {
+	if m.UpdateSKUAttributesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateSKUAttributesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateSKUAttributesMock.invocationsDone()
+}
+
+// MinimockUpdateSKUAttributesInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockUpdateSKUAttributesInspect() {
+	for _, e := range m.UpdateSKUAttributesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.UpdateSKUAttributes at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateSKUAttributesCounter := mm_atomic.LoadUint64(&m.afterUpdateSKUAttributesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateSKUAttributesMock.defaultExpectation != nil && afterUpdateSKUAttributesCounter < 1 {
+		if m.UpdateSKUAttributesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.UpdateSKUAttributes at\n%s", m.UpdateSKUAttributesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.UpdateSKUAttributes at\n%s with params: %#v", m.UpdateSKUAttributesMock.defaultExpectation.expectationOrigins.origin, *m.UpdateSKUAttributesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateSKUAttributes != nil && afterUpdateSKUAttributesCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.UpdateSKUAttributes at\n%s", m.funcUpdateSKUAttributesOrigin)
+	}
+
+	if !m.UpdateSKUAttributesMock.invocationsDone() && afterUpdateSKUAttributesCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.UpdateSKUAttributes at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateSKUAttributesMock.expectedInvocations), m.UpdateSKUAttributesMock.expectedInvocationsOrigin, afterUpdateSKUAttributesCounter)
+	}
+}
+
+type mStockServiceUseCaseMockUpdateStockItem struct {
+	optional           bool
+	mock               *StockServiceUseCaseMock
+	defaultExpectation *StockServiceUseCaseMockUpdateStockItemExpectation
+	expectations       []*StockServiceUseCaseMockUpdateStockItemExpectation
+
+	callArgs []*StockServiceUseCaseMockUpdateStockItemParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceUseCaseMockUpdateStockItemExpectation specifies expectation struct of the StockServiceUseCase.UpdateStockItem
+type StockServiceUseCaseMockUpdateStockItemExpectation struct {
+	mock               *StockServiceUseCaseMock
+	params             *StockServiceUseCaseMockUpdateStockItemParams
+	paramPtrs          *StockServiceUseCaseMockUpdateStockItemParamPtrs
+	expectationOrigins StockServiceUseCaseMockUpdateStockItemExpectationOrigins
+	results            *StockServiceUseCaseMockUpdateStockItemResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceUseCaseMockUpdateStockItemParams contains parameters of the StockServiceUseCase.UpdateStockItem
+type StockServiceUseCaseMockUpdateStockItemParams struct {
+	ctx    context.Context
+	update domain.StockItemUpdate
+}
+
+// StockServiceUseCaseMockUpdateStockItemParamPtrs contains pointers to parameters of the StockServiceUseCase.UpdateStockItem
+type StockServiceUseCaseMockUpdateStockItemParamPtrs struct {
+	ctx    *context.Context
+	update *domain.StockItemUpdate
+}
+
+// StockServiceUseCaseMockUpdateStockItemResults contains results of the StockServiceUseCase.UpdateStockItem
+type StockServiceUseCaseMockUpdateStockItemResults struct {
+	s1  domain.StockItem
+	err error
+}
+
+// StockServiceUseCaseMockUpdateStockItemOrigins contains origins of expectations of the StockServiceUseCase.UpdateStockItem
+type StockServiceUseCaseMockUpdateStockItemExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUpdate string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -840,342 +14847,344 @@ type StockServiceUseCaseMockGetStockItemBySKUExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetStockItemBySKU *mStockServiceUseCaseMockGetStockItemBySKU) Optional() *mStockServiceUseCaseMockGetStockItemBySKU {
-	mmGetStockItemBySKU.optional = true
-	return mmGetStockItemBySKU
+func (mmUpdateStockItem *mStockServiceUseCaseMockUpdateStockItem) Optional() *mStockServiceUseCaseMockUpdateStockItem {
+	mmUpdateStockItem.optional = true
+	return mmUpdateStockItem
 }
 
-// Expect sets up expected params for StockServiceUseCase.GetStockItemBySKU
-func (mmGetStockItemBySKU *mStockServiceUseCaseMockGetStockItemBySKU) Expect(ctx context.Context, skuID domain.SKUID) *mStockServiceUseCaseMockGetStockItemBySKU {
-	if mmGetStockItemBySKU.mock.funcGetStockItemBySKU != nil {
-		mmGetStockItemBySKU.mock.t.Fatalf("StockServiceUseCaseMock.GetStockItemBySKU mock is already set by Set")
+// Expect sets up expected params for StockServiceUseCase.UpdateStockItem
+func (mmUpdateStockItem *mStockServiceUseCaseMockUpdateStockItem) Expect(ctx context.Context, update domain.StockItemUpdate) *mStockServiceUseCaseMockUpdateStockItem {
+	if mmUpdateStockItem.mock.funcUpdateStockItem != nil {
+		mmUpdateStockItem.mock.t.Fatalf("StockServiceUseCaseMock.UpdateStockItem mock is already set by Set")
 	}
 
-	if mmGetStockItemBySKU.defaultExpectation == nil {
-		mmGetStockItemBySKU.defaultExpectation = &StockServiceUseCaseMockGetStockItemBySKUExpectation{}
+	if mmUpdateStockItem.defaultExpectation == nil {
+		mmUpdateStockItem.defaultExpectation = &StockServiceUseCaseMockUpdateStockItemExpectation{}
 	}
 
-	if mmGetStockItemBySKU.defaultExpectation.paramPtrs != nil {
-		mmGetStockItemBySKU.mock.t.Fatalf("StockServiceUseCaseMock.GetStockItemBySKU mock is already set by ExpectParams functions")
+	if mmUpdateStockItem.defaultExpectation.paramPtrs != nil {
+		mmUpdateStockItem.mock.t.Fatalf("StockServiceUseCaseMock.UpdateStockItem mock is already set by ExpectParams functions")
 	}
 
-	mmGetStockItemBySKU.defaultExpectation.params = &StockServiceUseCaseMockGetStockItemBySKUParams{ctx, skuID}
-	mmGetStockItemBySKU.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetStockItemBySKU.expectations {
-		if minimock.Equal(e.params, mmGetStockItemBySKU.defaultExpectation.params) {
-			mmGetStockItemBySKU.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetStockItemBySKU.defaultExpectation.params)
+	mmUpdateStockItem.defaultExpectation.params = &StockServiceUseCaseMockUpdateStockItemParams{ctx, update}
+	mmUpdateStockItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateStockItem.expectations {
+		if minimock.Equal(e.params, mmUpdateStockItem.defaultExpectation.params) {
+			mmUpdateStockItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateStockItem.defaultExpectation.params)
 		}
 	}
 
-	return mmGetStockItemBySKU
+	return mmUpdateStockItem
 }
 
-// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.GetStockItemBySKU
-func (mmGetStockItemBySKU *mStockServiceUseCaseMockGetStockItemBySKU) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockGetStockItemBySKU {
-	if mmGetStockItemBySKU.mock.funcGetStockItemBySKU != nil {
-		mmGetStockItemBySKU.mock.t.Fatalf("StockServiceUseCaseMock.GetStockItemBySKU mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.UpdateStockItem
+func (mmUpdateStockItem *mStockServiceUseCaseMockUpdateStockItem) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockUpdateStockItem {
+	if mmUpdateStockItem.mock.funcUpdateStockItem != nil {
+		mmUpdateStockItem.mock.t.Fatalf("StockServiceUseCaseMock.UpdateStockItem mock is already set by Set")
 	}
 
-	if mmGetStockItemBySKU.defaultExpectation == nil {
-		mmGetStockItemBySKU.defaultExpectation = &StockServiceUseCaseMockGetStockItemBySKUExpectation{}
+	if mmUpdateStockItem.defaultExpectation == nil {
+		mmUpdateStockItem.defaultExpectation = &StockServiceUseCaseMockUpdateStockItemExpectation{}
 	}
 
-	if mmGetStockItemBySKU.defaultExpectation.params != nil {
-		mmGetStockItemBySKU.mock.t.Fatalf("StockServiceUseCaseMock.GetStockItemBySKU mock is already set by Expect")
+	if mmUpdateStockItem.defaultExpectation.params != nil {
+		mmUpdateStockItem.mock.t.Fatalf("StockServiceUseCaseMock.UpdateStockItem mock is already set by Expect")
 	}
 
-	if mmGetStockItemBySKU.defaultExpectation.paramPtrs == nil {
-		mmGetStockItemBySKU.defaultExpectation.paramPtrs = &StockServiceUseCaseMockGetStockItemBySKUParamPtrs{}
+	if mmUpdateStockItem.defaultExpectation.paramPtrs == nil {
+		mmUpdateStockItem.defaultExpectation.paramPtrs = &StockServiceUseCaseMockUpdateStockItemParamPtrs{}
 	}
-	mmGetStockItemBySKU.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetStockItemBySKU.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmUpdateStockItem.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateStockItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetStockItemBySKU
+	return mmUpdateStockItem
 }
 
-// ExpectSkuIDParam2 sets up expected param skuID for StockServiceUseCase.GetStockItemBySKU
-func (mmGetStockItemBySKU *mStockServiceUseCaseMockGetStockItemBySKU) ExpectSkuIDParam2(skuID domain.SKUID) *mStockServiceUseCaseMockGetStockItemBySKU {
-	if mmGetStockItemBySKU.mock.funcGetStockItemBySKU != nil {
-		mmGetStockItemBySKU.mock.t.Fatalf("StockServiceUseCaseMock.GetStockItemBySKU mock is already set by Set")
+// ExpectUpdateParam2 sets up expected param update for StockServiceUseCase.UpdateStockItem
+func (mmUpdateStockItem *mStockServiceUseCaseMockUpdateStockItem) ExpectUpdateParam2(update domain.StockItemUpdate) *mStockServiceUseCaseMockUpdateStockItem {
+	if mmUpdateStockItem.mock.funcUpdateStockItem != nil {
+		mmUpdateStockItem.mock.t.Fatalf("StockServiceUseCaseMock.UpdateStockItem mock is already set by Set")
 	}
 
-	if mmGetStockItemBySKU.defaultExpectation == nil {
-		mmGetStockItemBySKU.defaultExpectation = &StockServiceUseCaseMockGetStockItemBySKUExpectation{}
+	if mmUpdateStockItem.defaultExpectation == nil {
+		mmUpdateStockItem.defaultExpectation = &StockServiceUseCaseMockUpdateStockItemExpectation{}
 	}
 
-	if mmGetStockItemBySKU.defaultExpectation.params != nil {
-		mmGetStockItemBySKU.mock.t.Fatalf("StockServiceUseCaseMock.GetStockItemBySKU mock is already set by Expect")
+	if mmUpdateStockItem.defaultExpectation.params != nil {
+		mmUpdateStockItem.mock.t.Fatalf("StockServiceUseCaseMock.UpdateStockItem mock is already set by Expect")
 	}
 
-	if mmGetStockItemBySKU.defaultExpectation.paramPtrs == nil {
-		mmGetStockItemBySKU.defaultExpectation.paramPtrs = &StockServiceUseCaseMockGetStockItemBySKUParamPtrs{}
+	if mmUpdateStockItem.defaultExpectation.paramPtrs == nil {
+		mmUpdateStockItem.defaultExpectation.paramPtrs = &StockServiceUseCaseMockUpdateStockItemParamPtrs{}
 	}
-	mmGetStockItemBySKU.defaultExpectation.paramPtrs.skuID = &skuID
-	mmGetStockItemBySKU.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+	mmUpdateStockItem.defaultExpectation.paramPtrs.update = &update
+	mmUpdateStockItem.defaultExpectation.expectationOrigins.originUpdate = minimock.CallerInfo(1)
 
-	return mmGetStockItemBySKU
+	return mmUpdateStockItem
 }
 
-// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.GetStockItemBySKU
-func (mmGetStockItemBySKU *mStockServiceUseCaseMockGetStockItemBySKU) Inspect(f func(ctx context.Context, skuID domain.SKUID)) *mStockServiceUseCaseMockGetStockItemBySKU {
-	if mmGetStockItemBySKU.mock.inspectFuncGetStockItemBySKU != nil {
-		mmGetStockItemBySKU.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.GetStockItemBySKU")
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.UpdateStockItem
+func (mmUpdateStockItem *mStockServiceUseCaseMockUpdateStockItem) Inspect(f func(ctx context.Context, update domain.StockItemUpdate)) *mStockServiceUseCaseMockUpdateStockItem {
+	if mmUpdateStockItem.mock.inspectFuncUpdateStockItem != nil {
+		mmUpdateStockItem.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.UpdateStockItem")
 	}
 
-	mmGetStockItemBySKU.mock.inspectFuncGetStockItemBySKU = f
+	mmUpdateStockItem.mock.inspectFuncUpdateStockItem = f
 
-	return mmGetStockItemBySKU
+	return mmUpdateStockItem
 }
 
-// Return sets up results that will be returned by StockServiceUseCase.GetStockItemBySKU
-func (mmGetStockItemBySKU *mStockServiceUseCaseMockGetStockItemBySKU) Return(s1 domain.StockItem, err error) *StockServiceUseCaseMock {
-	if mmGetStockItemBySKU.mock.funcGetStockItemBySKU != nil {
-		mmGetStockItemBySKU.mock.t.Fatalf("StockServiceUseCaseMock.GetStockItemBySKU mock is already set by Set")
+// Return sets up results that will be returned by StockServiceUseCase.UpdateStockItem
+func (mmUpdateStockItem *mStockServiceUseCaseMockUpdateStockItem) Return(s1 domain.StockItem, err error) *StockServiceUseCaseMock {
+	if mmUpdateStockItem.mock.funcUpdateStockItem != nil {
+		mmUpdateStockItem.mock.t.Fatalf("StockServiceUseCaseMock.UpdateStockItem mock is already set by Set")
 	}
 
-	if mmGetStockItemBySKU.defaultExpectation == nil {
-		mmGetStockItemBySKU.defaultExpectation = &StockServiceUseCaseMockGetStockItemBySKUExpectation{mock: mmGetStockItemBySKU.mock}
+	if mmUpdateStockItem.defaultExpectation == nil {
+		mmUpdateStockItem.defaultExpectation = &StockServiceUseCaseMockUpdateStockItemExpectation{mock: mmUpdateStockItem.mock}
 	}
-	mmGetStockItemBySKU.defaultExpectation.results = &StockServiceUseCaseMockGetStockItemBySKUResults{s1, err}
-	mmGetStockItemBySKU.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetStockItemBySKU.mock
+	mmUpdateStockItem.defaultExpectation.results = &StockServiceUseCaseMockUpdateStockItemResults{s1, err}
+	mmUpdateStockItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateStockItem.mock
 }
 
-// Set uses given function f to mock the StockServiceUseCase.GetStockItemBySKU method
-func (mmGetStockItemBySKU *mStockServiceUseCaseMockGetStockItemBySKU) Set(f func(ctx context.Context, skuID domain.SKUID) (s1 domain.StockItem, err error)) *StockServiceUseCaseMock {
-	if mmGetStockItemBySKU.defaultExpectation != nil {
-		mmGetStockItemBySKU.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.GetStockItemBySKU method")
+// Set uses given function f to mock the StockServiceUseCase.UpdateStockItem method
+func (mmUpdateStockItem *mStockServiceUseCaseMockUpdateStockItem) Set(f func(ctx context.Context, update domain.StockItemUpdate) (s1 domain.StockItem, err error)) *StockServiceUseCaseMock {
+	if mmUpdateStockItem.defaultExpectation != nil {
+		mmUpdateStockItem.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.UpdateStockItem method")
 	}
 
-	if len(mmGetStockItemBySKU.expectations) > 0 {
-		mmGetStockItemBySKU.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.GetStockItemBySKU method")
+	if len(mmUpdateStockItem.expectations) > 0 {
+		mmUpdateStockItem.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.UpdateStockItem method")
 	}
 
-	mmGetStockItemBySKU.mock.funcGetStockItemBySKU = f
-	mmGetStockItemBySKU.mock.funcGetStockItemBySKUOrigin = minimock.CallerInfo(1)
-	return mmGetStockItemBySKU.mock
+	mmUpdateStockItem.mock.funcUpdateStockItem = f
+	mmUpdateStockItem.mock.funcUpdateStockItemOrigin = minimock.CallerInfo(1)
+	return mmUpdateStockItem.mock
 }
 
-// When sets expectation for the StockServiceUseCase.GetStockItemBySKU which will trigger the result defined by the following
+// When sets expectation for the StockServiceUseCase.UpdateStockItem which will trigger the result defined by the following
 // Then helper
-func (mmGetStockItemBySKU *mStockServiceUseCaseMockGetStockItemBySKU) When(ctx context.Context, skuID domain.SKUID) *StockServiceUseCaseMockGetStockItemBySKUExpectation {
-	if mmGetStockItemBySKU.mock.funcGetStockItemBySKU != nil {
-		mmGetStockItemBySKU.mock.t.Fatalf("StockServiceUseCaseMock.GetStockItemBySKU mock is already set by Set")
+func (mmUpdateStockItem *mStockServiceUseCaseMockUpdateStockItem) When(ctx context.Context, update domain.StockItemUpdate) *StockServiceUseCaseMockUpdateStockItemExpectation {
+	if mmUpdateStockItem.mock.funcUpdateStockItem != nil {
+		mmUpdateStockItem.mock.t.Fatalf("StockServiceUseCaseMock.UpdateStockItem mock is already set by Set")
 	}
 
-	expectation := &StockServiceUseCaseMockGetStockItemBySKUExpectation{
-		mock:               mmGetStockItemBySKU.mock,
-		params:             &StockServiceUseCaseMockGetStockItemBySKUParams{ctx, skuID},
-		expectationOrigins: StockServiceUseCaseMockGetStockItemBySKUExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &StockServiceUseCaseMockUpdateStockItemExpectation{
+		mock:               mmUpdateStockItem.mock,
+		params:             &StockServiceUseCaseMockUpdateStockItemParams{ctx, update},
+		expectationOrigins: StockServiceUseCaseMockUpdateStockItemExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetStockItemBySKU.expectations = append(mmGetStockItemBySKU.expectations, expectation)
+	mmUpdateStockItem.expectations = append(mmUpdateStockItem.expectations, expectation)
 	return expectation
 }
 
-// Then sets up StockServiceUseCase.GetStockItemBySKU return parameters for the expectation previously defined by the When method
-func (e *StockServiceUseCaseMockGetStockItemBySKUExpectation) Then(s1 domain.StockItem, err error) *StockServiceUseCaseMock {
-	e.results = &StockServiceUseCaseMockGetStockItemBySKUResults{s1, err}
+// Then sets up StockServiceUseCase.UpdateStockItem return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockUpdateStockItemExpectation) Then(s1 domain.StockItem, err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockUpdateStockItemResults{s1, err}
 	return e.mock
 }
 
-// Times sets number of times StockServiceUseCase.GetStockItemBySKU should be invoked
-func (mmGetStockItemBySKU *mStockServiceUseCaseMockGetStockItemBySKU) Times(n uint64) *mStockServiceUseCaseMockGetStockItemBySKU {
+// Times sets number of times StockServiceUseCase.UpdateStockItem should be invoked
+func (mmUpdateStockItem *mStockServiceUseCaseMockUpdateStockItem) Times(n uint64) *mStockServiceUseCaseMockUpdateStockItem {
 	if n == 0 {
-		mmGetStockItemBySKU.mock.t.Fatalf("Times of StockServiceUseCaseMock.GetStockItemBySKU mock can not be zero")
+		mmUpdateStockItem.mock.t.Fatalf("Times of StockServiceUseCaseMock.UpdateStockItem mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetStockItemBySKU.expectedInvocations, n)
-	mmGetStockItemBySKU.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetStockItemBySKU
+	mm_atomic.StoreUint64(&mmUpdateStockItem.expectedInvocations, n)
+	mmUpdateStockItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateStockItem
 }
 
-func (mmGetStockItemBySKU *mStockServiceUseCaseMockGetStockItemBySKU) invocationsDone() bool {
-	if len(mmGetStockItemBySKU.expectations) == 0 && mmGetStockItemBySKU.defaultExpectation == nil && mmGetStockItemBySKU.mock.funcGetStockItemBySKU == nil {
+func (mmUpdateStockItem *mStockServiceUseCaseMockUpdateStockItem) invocationsDone() bool {
+	if len(mmUpdateStockItem.expectations) == 0 && mmUpdateStockItem.defaultExpectation == nil && mmUpdateStockItem.mock.funcUpdateStockItem == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetStockItemBySKU.mock.afterGetStockItemBySKUCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetStockItemBySKU.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateStockItem.mock.afterUpdateStockItemCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateStockItem.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetStockItemBySKU implements mm_usecase.StockServiceUseCase
-func (mmGetStockItemBySKU *StockServiceUseCaseMock) GetStockItemBySKU(ctx context.Context, skuID domain.SKUID) (s1 domain.StockItem, err error) {
-	mm_atomic.AddUint64(&mmGetStockItemBySKU.beforeGetStockItemBySKUCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetStockItemBySKU.afterGetStockItemBySKUCounter, 1)
+// UpdateStockItem implements mm_usecase.StockServiceUseCase
+func (mmUpdateStockItem *StockServiceUseCaseMock) UpdateStockItem(ctx context.Context, update domain.StockItemUpdate) (s1 domain.StockItem, err error) {
+	mm_atomic.AddUint64(&mmUpdateStockItem.beforeUpdateStockItemCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateStockItem.afterUpdateStockItemCounter, 1)
 
-	mmGetStockItemBySKU.t.Helper()
+	mmUpdateStockItem.t.Helper()
 
-	if mmGetStockItemBySKU.inspectFuncGetStockItemBySKU != nil {
-		mmGetStockItemBySKU.inspectFuncGetStockItemBySKU(ctx, skuID)
+	if mmUpdateStockItem.inspectFuncUpdateStockItem != nil {
+		mmUpdateStockItem.inspectFuncUpdateStockItem(ctx, update)
 	}
 
-	mm_params := StockServiceUseCaseMockGetStockItemBySKUParams{ctx, skuID}
+	mm_params := StockServiceUseCaseMockUpdateStockItemParams{ctx, update}
 
 	// Record call args
-	mmGetStockItemBySKU.GetStockItemBySKUMock.mutex.Lock()
-	mmGetStockItemBySKU.GetStockItemBySKUMock.callArgs = append(mmGetStockItemBySKU.GetStockItemBySKUMock.callArgs, &mm_params)
-	mmGetStockItemBySKU.GetStockItemBySKUMock.mutex.Unlock()
+	mmUpdateStockItem.UpdateStockItemMock.mutex.Lock()
+	mmUpdateStockItem.UpdateStockItemMock.callArgs = append(mmUpdateStockItem.UpdateStockItemMock.callArgs, &mm_params)
+	mmUpdateStockItem.UpdateStockItemMock.mutex.Unlock()
 
-	for _, e := range mmGetStockItemBySKU.GetStockItemBySKUMock.expectations {
+	for _, e := range mmUpdateStockItem.UpdateStockItemMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.s1, e.results.err
 		}
 	}
 
-	if mmGetStockItemBySKU.GetStockItemBySKUMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetStockItemBySKU.GetStockItemBySKUMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetStockItemBySKU.GetStockItemBySKUMock.defaultExpectation.params
-		mm_want_ptrs := mmGetStockItemBySKU.GetStockItemBySKUMock.defaultExpectation.paramPtrs
+	if mmUpdateStockItem.UpdateStockItemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateStockItem.UpdateStockItemMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateStockItem.UpdateStockItemMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateStockItem.UpdateStockItemMock.defaultExpectation.paramPtrs
 
-		mm_got := StockServiceUseCaseMockGetStockItemBySKUParams{ctx, skuID}
+		mm_got := StockServiceUseCaseMockUpdateStockItemParams{ctx, update}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetStockItemBySKU.t.Errorf("StockServiceUseCaseMock.GetStockItemBySKU got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetStockItemBySKU.GetStockItemBySKUMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpdateStockItem.t.Errorf("StockServiceUseCaseMock.UpdateStockItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateStockItem.UpdateStockItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
-				mmGetStockItemBySKU.t.Errorf("StockServiceUseCaseMock.GetStockItemBySKU got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetStockItemBySKU.GetStockItemBySKUMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			if mm_want_ptrs.update != nil && !minimock.Equal(*mm_want_ptrs.update, mm_got.update) {
+				mmUpdateStockItem.t.Errorf("StockServiceUseCaseMock.UpdateStockItem got unexpected parameter update, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateStockItem.UpdateStockItemMock.defaultExpectation.expectationOrigins.originUpdate, *mm_want_ptrs.update, mm_got.update, minimock.Diff(*mm_want_ptrs.update, mm_got.update))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetStockItemBySKU.t.Errorf("StockServiceUseCaseMock.GetStockItemBySKU got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetStockItemBySKU.GetStockItemBySKUMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateStockItem.t.Errorf("StockServiceUseCaseMock.UpdateStockItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateStockItem.UpdateStockItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetStockItemBySKU.GetStockItemBySKUMock.defaultExpectation.results
+		mm_results := mmUpdateStockItem.UpdateStockItemMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetStockItemBySKU.t.Fatal("No results are set for the StockServiceUseCaseMock.GetStockItemBySKU")
+			mmUpdateStockItem.t.Fatal("No results are set for the StockServiceUseCaseMock.UpdateStockItem")
 		}
 		return (*mm_results).s1, (*mm_results).err
 	}
-	if mmGetStockItemBySKU.funcGetStockItemBySKU != nil {
-		return mmGetStockItemBySKU.funcGetStockItemBySKU(ctx, skuID)
+	if mmUpdateStockItem.funcUpdateStockItem != nil {
+		return mmUpdateStockItem.funcUpdateStockItem(ctx, update)
 	}
-	mmGetStockItemBySKU.t.Fatalf("Unexpected call to StockServiceUseCaseMock.GetStockItemBySKU. %v %v", ctx, skuID)
+	mmUpdateStockItem.t.Fatalf("Unexpected call to StockServiceUseCaseMock.UpdateStockItem. %v %v", ctx, update)
 	return
 }
 
-// GetStockItemBySKUAfterCounter returns a count of finished StockServiceUseCaseMock.GetStockItemBySKU invocations
-func (mmGetStockItemBySKU *StockServiceUseCaseMock) GetStockItemBySKUAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetStockItemBySKU.afterGetStockItemBySKUCounter)
-}
-
-// GetStockItemBySKUBeforeCounter returns a count of StockServiceUseCaseMock.GetStockItemBySKU invocations
-func (mmGetStockItemBySKU *StockServiceUseCaseMock) GetStockItemBySKUBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetStockItemBySKU.beforeGetStockItemBySKUCounter)
+// UpdateStockItemAfterCounter returns a count of finished StockServiceUseCaseMock.UpdateStockItem invocations
+func (mmUpdateStockItem *StockServiceUseCaseMock) UpdateStockItemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateStockItem.afterUpdateStockItemCounter)
 }
 
-// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.GetStockItemBySKU.
+// UpdateStockItemBeforeCounter returns a count of StockServiceUseCaseMock.UpdateStockItem invocations
+func (mmUpdateStockItem *StockServiceUseCaseMock) UpdateStockItemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateStockItem.beforeUpdateStockItemCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.UpdateStockItem.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetStockItemBySKU *mStockServiceUseCaseMockGetStockItemBySKU) Calls() []*StockServiceUseCaseMockGetStockItemBySKUParams {
-	mmGetStockItemBySKU.mutex.RLock()
+func (mmUpdateStockItem *mStockServiceUseCaseMockUpdateStockItem) Calls() []*StockServiceUseCaseMockUpdateStockItemParams {
+	mmUpdateStockItem.mutex.RLock()
 
-	argCopy := make([]*StockServiceUseCaseMockGetStockItemBySKUParams, len(mmGetStockItemBySKU.callArgs))
-	copy(argCopy, mmGetStockItemBySKU.callArgs)
+	argCopy := make([]*StockServiceUseCaseMockUpdateStockItemParams, len(mmUpdateStockItem.callArgs))
+	copy(argCopy, mmUpdateStockItem.callArgs)
 
-	mmGetStockItemBySKU.mutex.RUnlock()
+	mmUpdateStockItem.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetStockItemBySKUDone returns true if the count of the GetStockItemBySKU invocations corresponds
+// MinimockUpdateStockItemDone returns true if the count of the UpdateStockItem invocations corresponds
 // the number of defined expectations
-func (m *StockServiceUseCaseMock) MinimockGetStockItemBySKUDone() bool {
-	if m.GetStockItemBySKUMock.optional {
+func (m *StockServiceUseCaseMock) MinimockUpdateStockItemDone() bool {
+	if m.UpdateStockItemMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetStockItemBySKUMock.expectations {
+	for _, e := range m.UpdateStockItemMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetStockItemBySKUMock.invocationsDone()
+	return m.UpdateStockItemMock.invocationsDone()
 }
 
-// MinimockGetStockItemBySKUInspect logs each unmet expectation
-func (m *StockServiceUseCaseMock) MinimockGetStockItemBySKUInspect() {
-	for _, e := range m.GetStockItemBySKUMock.expectations {
+// MinimockUpdateStockItemInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockUpdateStockItemInspect() {
+	for _, e := range m.UpdateStockItemMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetStockItemBySKU at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.UpdateStockItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetStockItemBySKUCounter := mm_atomic.LoadUint64(&m.afterGetStockItemBySKUCounter)
+	afterUpdateStockItemCounter := mm_atomic.LoadUint64(&m.afterUpdateStockItemCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetStockItemBySKUMock.defaultExpectation != nil && afterGetStockItemBySKUCounter < 1 {
-		if m.GetStockItemBySKUMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetStockItemBySKU at\n%s", m.GetStockItemBySKUMock.defaultExpectation.returnOrigin)
+	if m.UpdateStockItemMock.defaultExpectation != nil && afterUpdateStockItemCounter < 1 {
+		if m.UpdateStockItemMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.UpdateStockItem at\n%s", m.UpdateStockItemMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to StockServiceUseCaseMock.GetStockItemBySKU at\n%s with params: %#v", m.GetStockItemBySKUMock.defaultExpectation.expectationOrigins.origin, *m.GetStockItemBySKUMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.UpdateStockItem at\n%s with params: %#v", m.UpdateStockItemMock.defaultExpectation.expectationOrigins.origin, *m.UpdateStockItemMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetStockItemBySKU != nil && afterGetStockItemBySKUCounter < 1 {
-		m.t.Errorf("Expected call to StockServiceUseCaseMock.GetStockItemBySKU at\n%s", m.funcGetStockItemBySKUOrigin)
+	if m.funcUpdateStockItem != nil && afterUpdateStockItemCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.UpdateStockItem at\n%s", m.funcUpdateStockItemOrigin)
 	}
 
-	if !m.GetStockItemBySKUMock.invocationsDone() && afterGetStockItemBySKUCounter > 0 {
-		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.GetStockItemBySKU at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetStockItemBySKUMock.expectedInvocations), m.GetStockItemBySKUMock.expectedInvocationsOrigin, afterGetStockItemBySKUCounter)
+	if !m.UpdateStockItemMock.invocationsDone() && afterUpdateStockItemCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.UpdateStockItem at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateStockItemMock.expectedInvocations), m.UpdateStockItemMock.expectedInvocationsOrigin, afterUpdateStockItemCounter)
 	}
 }
 
-type mStockServiceUseCaseMockListStockItems struct {
+type mStockServiceUseCaseMockWatchStock struct {
 	optional           bool
 	mock               *StockServiceUseCaseMock
-	defaultExpectation *StockServiceUseCaseMockListStockItemsExpectation
-	expectations       []*StockServiceUseCaseMockListStockItemsExpectation
+	defaultExpectation *StockServiceUseCaseMockWatchStockExpectation
+	expectations       []*StockServiceUseCaseMockWatchStockExpectation
 
-	callArgs []*StockServiceUseCaseMockListStockItemsParams
+	callArgs []*StockServiceUseCaseMockWatchStockParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// StockServiceUseCaseMockListStockItemsExpectation specifies expectation struct of the StockServiceUseCase.ListStockItems
-type StockServiceUseCaseMockListStockItemsExpectation struct {
+// StockServiceUseCaseMockWatchStockExpectation specifies expectation struct of the StockServiceUseCase.WatchStock
+type StockServiceUseCaseMockWatchStockExpectation struct {
 	mock               *StockServiceUseCaseMock
-	params             *StockServiceUseCaseMockListStockItemsParams
-	paramPtrs          *StockServiceUseCaseMockListStockItemsParamPtrs
-	expectationOrigins StockServiceUseCaseMockListStockItemsExpectationOrigins
-	results            *StockServiceUseCaseMockListStockItemsResults
+	params             *StockServiceUseCaseMockWatchStockParams
+	paramPtrs          *StockServiceUseCaseMockWatchStockParamPtrs
+	expectationOrigins StockServiceUseCaseMockWatchStockExpectationOrigins
+	results            *StockServiceUseCaseMockWatchStockResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// StockServiceUseCaseMockListStockItemsParams contains parameters of the StockServiceUseCase.ListStockItems
-type StockServiceUseCaseMockListStockItemsParams struct {
+// StockServiceUseCaseMockWatchStockParams contains parameters of the StockServiceUseCase.WatchStock
+type StockServiceUseCaseMockWatchStockParams struct {
 	ctx    context.Context
-	filter domain.Filter
+	skuIDs []domain.SKUID
+	send   func(change domain.StockChange) error
 }
 
-// StockServiceUseCaseMockListStockItemsParamPtrs contains pointers to parameters of the StockServiceUseCase.ListStockItems
-type StockServiceUseCaseMockListStockItemsParamPtrs struct {
+// StockServiceUseCaseMockWatchStockParamPtrs contains pointers to parameters of the StockServiceUseCase.WatchStock
+type StockServiceUseCaseMockWatchStockParamPtrs struct {
 	ctx    *context.Context
-	filter *domain.Filter
+	skuIDs *[]domain.SKUID
+	send   *func(change domain.StockChange) error
 }
 
-// StockServiceUseCaseMockListStockItemsResults contains results of the StockServiceUseCase.ListStockItems
-type StockServiceUseCaseMockListStockItemsResults struct {
-	p1  domain.PaginatedResponse[domain.StockItem]
+// StockServiceUseCaseMockWatchStockResults contains results of the StockServiceUseCase.WatchStock
+type StockServiceUseCaseMockWatchStockResults struct {
 	err error
 }
 
-// StockServiceUseCaseMockListStockItemsOrigins contains origins of expectations of the StockServiceUseCase.ListStockItems
-type StockServiceUseCaseMockListStockItemsExpectationOrigins struct {
+// StockServiceUseCaseMockWatchStockOrigins contains origins of expectations of the StockServiceUseCase.WatchStock
+type StockServiceUseCaseMockWatchStockExpectationOrigins struct {
 	origin       string
 	originCtx    string
-	originFilter string
+	originSkuIDs string
+	originSend   string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1183,292 +15192,320 @@ type StockServiceUseCaseMockListStockItemsExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmListStockItems *mStockServiceUseCaseMockListStockItems) Optional() *mStockServiceUseCaseMockListStockItems {
-	mmListStockItems.optional = true
-	return mmListStockItems
+func (mmWatchStock *mStockServiceUseCaseMockWatchStock) Optional() *mStockServiceUseCaseMockWatchStock {
+	mmWatchStock.optional = true
+	return mmWatchStock
 }
 
-// Expect sets up expected params for StockServiceUseCase.ListStockItems
-func (mmListStockItems *mStockServiceUseCaseMockListStockItems) Expect(ctx context.Context, filter domain.Filter) *mStockServiceUseCaseMockListStockItems {
-	if mmListStockItems.mock.funcListStockItems != nil {
-		mmListStockItems.mock.t.Fatalf("StockServiceUseCaseMock.ListStockItems mock is already set by Set")
+// Expect sets up expected params for StockServiceUseCase.WatchStock
+func (mmWatchStock *mStockServiceUseCaseMockWatchStock) Expect(ctx context.Context, skuIDs []domain.SKUID, send func(change domain.StockChange) error) *mStockServiceUseCaseMockWatchStock {
+	if mmWatchStock.mock.funcWatchStock != nil {
+		mmWatchStock.mock.t.Fatalf("StockServiceUseCaseMock.WatchStock mock is already set by Set")
 	}
 
-	if mmListStockItems.defaultExpectation == nil {
-		mmListStockItems.defaultExpectation = &StockServiceUseCaseMockListStockItemsExpectation{}
+	if mmWatchStock.defaultExpectation == nil {
+		mmWatchStock.defaultExpectation = &StockServiceUseCaseMockWatchStockExpectation{}
 	}
 
-	if mmListStockItems.defaultExpectation.paramPtrs != nil {
-		mmListStockItems.mock.t.Fatalf("StockServiceUseCaseMock.ListStockItems mock is already set by ExpectParams functions")
+	if mmWatchStock.defaultExpectation.paramPtrs != nil {
+		mmWatchStock.mock.t.Fatalf("StockServiceUseCaseMock.WatchStock mock is already set by ExpectParams functions")
 	}
 
-	mmListStockItems.defaultExpectation.params = &StockServiceUseCaseMockListStockItemsParams{ctx, filter}
-	mmListStockItems.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmListStockItems.expectations {
-		if minimock.Equal(e.params, mmListStockItems.defaultExpectation.params) {
-			mmListStockItems.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListStockItems.defaultExpectation.params)
+	mmWatchStock.defaultExpectation.params = &StockServiceUseCaseMockWatchStockParams{ctx, skuIDs, send}
+	mmWatchStock.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmWatchStock.expectations {
+		if minimock.Equal(e.params, mmWatchStock.defaultExpectation.params) {
+			mmWatchStock.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmWatchStock.defaultExpectation.params)
 		}
 	}
 
-	return mmListStockItems
+	return mmWatchStock
 }
 
-// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.ListStockItems
-func (mmListStockItems *mStockServiceUseCaseMockListStockItems) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockListStockItems {
-	if mmListStockItems.mock.funcListStockItems != nil {
-		mmListStockItems.mock.t.Fatalf("StockServiceUseCaseMock.ListStockItems mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for StockServiceUseCase.WatchStock
+func (mmWatchStock *mStockServiceUseCaseMockWatchStock) ExpectCtxParam1(ctx context.Context) *mStockServiceUseCaseMockWatchStock {
+	if mmWatchStock.mock.funcWatchStock != nil {
+		mmWatchStock.mock.t.Fatalf("StockServiceUseCaseMock.WatchStock mock is already set by Set")
 	}
 
-	if mmListStockItems.defaultExpectation == nil {
-		mmListStockItems.defaultExpectation = &StockServiceUseCaseMockListStockItemsExpectation{}
+	if mmWatchStock.defaultExpectation == nil {
+		mmWatchStock.defaultExpectation = &StockServiceUseCaseMockWatchStockExpectation{}
 	}
 
-	if mmListStockItems.defaultExpectation.params != nil {
-		mmListStockItems.mock.t.Fatalf("StockServiceUseCaseMock.ListStockItems mock is already set by Expect")
+	if mmWatchStock.defaultExpectation.params != nil {
+		mmWatchStock.mock.t.Fatalf("StockServiceUseCaseMock.WatchStock mock is already set by Expect")
 	}
 
-	if mmListStockItems.defaultExpectation.paramPtrs == nil {
-		mmListStockItems.defaultExpectation.paramPtrs = &StockServiceUseCaseMockListStockItemsParamPtrs{}
+	if mmWatchStock.defaultExpectation.paramPtrs == nil {
+		mmWatchStock.defaultExpectation.paramPtrs = &StockServiceUseCaseMockWatchStockParamPtrs{}
 	}
-	mmListStockItems.defaultExpectation.paramPtrs.ctx = &ctx
-	mmListStockItems.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmWatchStock.defaultExpectation.paramPtrs.ctx = &ctx
+	mmWatchStock.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmListStockItems
+	return mmWatchStock
 }
 
-// ExpectFilterParam2 sets up expected param filter for StockServiceUseCase.ListStockItems
-func (mmListStockItems *mStockServiceUseCaseMockListStockItems) ExpectFilterParam2(filter domain.Filter) *mStockServiceUseCaseMockListStockItems {
-	if mmListStockItems.mock.funcListStockItems != nil {
-		mmListStockItems.mock.t.Fatalf("StockServiceUseCaseMock.ListStockItems mock is already set by Set")
+// ExpectSkuIDsParam2 sets up expected param skuIDs for StockServiceUseCase.WatchStock
+func (mmWatchStock *mStockServiceUseCaseMockWatchStock) ExpectSkuIDsParam2(skuIDs []domain.SKUID) *mStockServiceUseCaseMockWatchStock {
+	if mmWatchStock.mock.funcWatchStock != nil {
+		mmWatchStock.mock.t.Fatalf("StockServiceUseCaseMock.WatchStock mock is already set by Set")
 	}
 
-	if mmListStockItems.defaultExpectation == nil {
-		mmListStockItems.defaultExpectation = &StockServiceUseCaseMockListStockItemsExpectation{}
+	if mmWatchStock.defaultExpectation == nil {
+		mmWatchStock.defaultExpectation = &StockServiceUseCaseMockWatchStockExpectation{}
 	}
 
-	if mmListStockItems.defaultExpectation.params != nil {
-		mmListStockItems.mock.t.Fatalf("StockServiceUseCaseMock.ListStockItems mock is already set by Expect")
+	if mmWatchStock.defaultExpectation.params != nil {
+		mmWatchStock.mock.t.Fatalf("StockServiceUseCaseMock.WatchStock mock is already set by Expect")
 	}
 
-	if mmListStockItems.defaultExpectation.paramPtrs == nil {
-		mmListStockItems.defaultExpectation.paramPtrs = &StockServiceUseCaseMockListStockItemsParamPtrs{}
+	if mmWatchStock.defaultExpectation.paramPtrs == nil {
+		mmWatchStock.defaultExpectation.paramPtrs = &StockServiceUseCaseMockWatchStockParamPtrs{}
 	}
-	mmListStockItems.defaultExpectation.paramPtrs.filter = &filter
-	mmListStockItems.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+	mmWatchStock.defaultExpectation.paramPtrs.skuIDs = &skuIDs
+	mmWatchStock.defaultExpectation.expectationOrigins.originSkuIDs = minimock.CallerInfo(1)
 
-	return mmListStockItems
+	return mmWatchStock
 }
 
-// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.ListStockItems
-func (mmListStockItems *mStockServiceUseCaseMockListStockItems) Inspect(f func(ctx context.Context, filter domain.Filter)) *mStockServiceUseCaseMockListStockItems {
-	if mmListStockItems.mock.inspectFuncListStockItems != nil {
-		mmListStockItems.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.ListStockItems")
+// ExpectSendParam3 sets up expected param send for StockServiceUseCase.WatchStock
+func (mmWatchStock *mStockServiceUseCaseMockWatchStock) ExpectSendParam3(send func(change domain.StockChange) error) *mStockServiceUseCaseMockWatchStock {
+	if mmWatchStock.mock.funcWatchStock != nil {
+		mmWatchStock.mock.t.Fatalf("StockServiceUseCaseMock.WatchStock mock is already set by Set")
 	}
 
-	mmListStockItems.mock.inspectFuncListStockItems = f
+	if mmWatchStock.defaultExpectation == nil {
+		mmWatchStock.defaultExpectation = &StockServiceUseCaseMockWatchStockExpectation{}
+	}
 
-	return mmListStockItems
+	if mmWatchStock.defaultExpectation.params != nil {
+		mmWatchStock.mock.t.Fatalf("StockServiceUseCaseMock.WatchStock mock is already set by Expect")
+	}
+
+	if mmWatchStock.defaultExpectation.paramPtrs == nil {
+		mmWatchStock.defaultExpectation.paramPtrs = &StockServiceUseCaseMockWatchStockParamPtrs{}
+	}
+	mmWatchStock.defaultExpectation.paramPtrs.send = &send
+	mmWatchStock.defaultExpectation.expectationOrigins.originSend = minimock.CallerInfo(1)
+
+	return mmWatchStock
 }
 
-// Return sets up results that will be returned by StockServiceUseCase.ListStockItems
-func (mmListStockItems *mStockServiceUseCaseMockListStockItems) Return(p1 domain.PaginatedResponse[domain.StockItem], err error) *StockServiceUseCaseMock {
-	if mmListStockItems.mock.funcListStockItems != nil {
-		mmListStockItems.mock.t.Fatalf("StockServiceUseCaseMock.ListStockItems mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the StockServiceUseCase.WatchStock
+func (mmWatchStock *mStockServiceUseCaseMockWatchStock) Inspect(f func(ctx context.Context, skuIDs []domain.SKUID, send func(change domain.StockChange) error)) *mStockServiceUseCaseMockWatchStock {
+	if mmWatchStock.mock.inspectFuncWatchStock != nil {
+		mmWatchStock.mock.t.Fatalf("Inspect function is already set for StockServiceUseCaseMock.WatchStock")
 	}
 
-	if mmListStockItems.defaultExpectation == nil {
-		mmListStockItems.defaultExpectation = &StockServiceUseCaseMockListStockItemsExpectation{mock: mmListStockItems.mock}
+	mmWatchStock.mock.inspectFuncWatchStock = f
+
+	return mmWatchStock
+}
+
+// Return sets up results that will be returned by StockServiceUseCase.WatchStock
+func (mmWatchStock *mStockServiceUseCaseMockWatchStock) Return(err error) *StockServiceUseCaseMock {
+	if mmWatchStock.mock.funcWatchStock != nil {
+		mmWatchStock.mock.t.Fatalf("StockServiceUseCaseMock.WatchStock mock is already set by Set")
 	}
-	mmListStockItems.defaultExpectation.results = &StockServiceUseCaseMockListStockItemsResults{p1, err}
-	mmListStockItems.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmListStockItems.mock
+
+	if mmWatchStock.defaultExpectation == nil {
+		mmWatchStock.defaultExpectation = &StockServiceUseCaseMockWatchStockExpectation{mock: mmWatchStock.mock}
+	}
+	mmWatchStock.defaultExpectation.results = &StockServiceUseCaseMockWatchStockResults{err}
+	mmWatchStock.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmWatchStock.mock
 }
 
-// Set uses given function f to mock the StockServiceUseCase.ListStockItems method
-func (mmListStockItems *mStockServiceUseCaseMockListStockItems) Set(f func(ctx context.Context, filter domain.Filter) (p1 domain.PaginatedResponse[domain.StockItem], err error)) *StockServiceUseCaseMock {
-	if mmListStockItems.defaultExpectation != nil {
-		mmListStockItems.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.ListStockItems method")
+// Set uses given function f to mock the StockServiceUseCase.WatchStock method
+func (mmWatchStock *mStockServiceUseCaseMockWatchStock) Set(f func(ctx context.Context, skuIDs []domain.SKUID, send func(change domain.StockChange) error) (err error)) *StockServiceUseCaseMock {
+	if mmWatchStock.defaultExpectation != nil {
+		mmWatchStock.mock.t.Fatalf("Default expectation is already set for the StockServiceUseCase.WatchStock method")
 	}
 
-	if len(mmListStockItems.expectations) > 0 {
-		mmListStockItems.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.ListStockItems method")
+	if len(mmWatchStock.expectations) > 0 {
+		mmWatchStock.mock.t.Fatalf("Some expectations are already set for the StockServiceUseCase.WatchStock method")
 	}
 
-	mmListStockItems.mock.funcListStockItems = f
-	mmListStockItems.mock.funcListStockItemsOrigin = minimock.CallerInfo(1)
-	return mmListStockItems.mock
+	mmWatchStock.mock.funcWatchStock = f
+	mmWatchStock.mock.funcWatchStockOrigin = minimock.CallerInfo(1)
+	return mmWatchStock.mock
 }
 
-// When sets expectation for the StockServiceUseCase.ListStockItems which will trigger the result defined by the following
+// When sets expectation for the StockServiceUseCase.WatchStock which will trigger the result defined by the following
 // Then helper
-func (mmListStockItems *mStockServiceUseCaseMockListStockItems) When(ctx context.Context, filter domain.Filter) *StockServiceUseCaseMockListStockItemsExpectation {
-	if mmListStockItems.mock.funcListStockItems != nil {
-		mmListStockItems.mock.t.Fatalf("StockServiceUseCaseMock.ListStockItems mock is already set by Set")
+func (mmWatchStock *mStockServiceUseCaseMockWatchStock) When(ctx context.Context, skuIDs []domain.SKUID, send func(change domain.StockChange) error) *StockServiceUseCaseMockWatchStockExpectation {
+	if mmWatchStock.mock.funcWatchStock != nil {
+		mmWatchStock.mock.t.Fatalf("StockServiceUseCaseMock.WatchStock mock is already set by Set")
 	}
 
-	expectation := &StockServiceUseCaseMockListStockItemsExpectation{
-		mock:               mmListStockItems.mock,
-		params:             &StockServiceUseCaseMockListStockItemsParams{ctx, filter},
-		expectationOrigins: StockServiceUseCaseMockListStockItemsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &StockServiceUseCaseMockWatchStockExpectation{
+		mock:               mmWatchStock.mock,
+		params:             &StockServiceUseCaseMockWatchStockParams{ctx, skuIDs, send},
+		expectationOrigins: StockServiceUseCaseMockWatchStockExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmListStockItems.expectations = append(mmListStockItems.expectations, expectation)
+	mmWatchStock.expectations = append(mmWatchStock.expectations, expectation)
 	return expectation
 }
 
-// Then sets up StockServiceUseCase.ListStockItems return parameters for the expectation previously defined by the When method
-func (e *StockServiceUseCaseMockListStockItemsExpectation) Then(p1 domain.PaginatedResponse[domain.StockItem], err error) *StockServiceUseCaseMock {
-	e.results = &StockServiceUseCaseMockListStockItemsResults{p1, err}
+// Then sets up StockServiceUseCase.WatchStock return parameters for the expectation previously defined by the When method
+func (e *StockServiceUseCaseMockWatchStockExpectation) Then(err error) *StockServiceUseCaseMock {
+	e.results = &StockServiceUseCaseMockWatchStockResults{err}
 	return e.mock
 }
 
-// Times sets number of times StockServiceUseCase.ListStockItems should be invoked
-func (mmListStockItems *mStockServiceUseCaseMockListStockItems) Times(n uint64) *mStockServiceUseCaseMockListStockItems {
+// Times sets number of times StockServiceUseCase.WatchStock should be invoked
+func (mmWatchStock *mStockServiceUseCaseMockWatchStock) Times(n uint64) *mStockServiceUseCaseMockWatchStock {
 	if n == 0 {
-		mmListStockItems.mock.t.Fatalf("Times of StockServiceUseCaseMock.ListStockItems mock can not be zero")
+		mmWatchStock.mock.t.Fatalf("Times of StockServiceUseCaseMock.WatchStock mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmListStockItems.expectedInvocations, n)
-	mmListStockItems.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmListStockItems
+	mm_atomic.StoreUint64(&mmWatchStock.expectedInvocations, n)
+	mmWatchStock.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmWatchStock
 }
 
-func (mmListStockItems *mStockServiceUseCaseMockListStockItems) invocationsDone() bool {
-	if len(mmListStockItems.expectations) == 0 && mmListStockItems.defaultExpectation == nil && mmListStockItems.mock.funcListStockItems == nil {
+func (mmWatchStock *mStockServiceUseCaseMockWatchStock) invocationsDone() bool {
+	if len(mmWatchStock.expectations) == 0 && mmWatchStock.defaultExpectation == nil && mmWatchStock.mock.funcWatchStock == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmListStockItems.mock.afterListStockItemsCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmListStockItems.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmWatchStock.mock.afterWatchStockCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmWatchStock.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// ListStockItems implements mm_usecase.StockServiceUseCase
-func (mmListStockItems *StockServiceUseCaseMock) ListStockItems(ctx context.Context, filter domain.Filter) (p1 domain.PaginatedResponse[domain.StockItem], err error) {
-	mm_atomic.AddUint64(&mmListStockItems.beforeListStockItemsCounter, 1)
-	defer mm_atomic.AddUint64(&mmListStockItems.afterListStockItemsCounter, 1)
+// WatchStock implements mm_usecase.StockServiceUseCase
+func (mmWatchStock *StockServiceUseCaseMock) WatchStock(ctx context.Context, skuIDs []domain.SKUID, send func(change domain.StockChange) error) (err error) {
+	mm_atomic.AddUint64(&mmWatchStock.beforeWatchStockCounter, 1)
+	defer mm_atomic.AddUint64(&mmWatchStock.afterWatchStockCounter, 1)
 
-	mmListStockItems.t.Helper()
+	mmWatchStock.t.Helper()
 
-	if mmListStockItems.inspectFuncListStockItems != nil {
-		mmListStockItems.inspectFuncListStockItems(ctx, filter)
+	if mmWatchStock.inspectFuncWatchStock != nil {
+		mmWatchStock.inspectFuncWatchStock(ctx, skuIDs, send)
 	}
 
-	mm_params := StockServiceUseCaseMockListStockItemsParams{ctx, filter}
+	mm_params := StockServiceUseCaseMockWatchStockParams{ctx, skuIDs, send}
 
 	// Record call args
-	mmListStockItems.ListStockItemsMock.mutex.Lock()
-	mmListStockItems.ListStockItemsMock.callArgs = append(mmListStockItems.ListStockItemsMock.callArgs, &mm_params)
-	mmListStockItems.ListStockItemsMock.mutex.Unlock()
+	mmWatchStock.WatchStockMock.mutex.Lock()
+	mmWatchStock.WatchStockMock.callArgs = append(mmWatchStock.WatchStockMock.callArgs, &mm_params)
+	mmWatchStock.WatchStockMock.mutex.Unlock()
 
-	for _, e := range mmListStockItems.ListStockItemsMock.expectations {
+	for _, e := range mmWatchStock.WatchStockMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.p1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmListStockItems.ListStockItemsMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmListStockItems.ListStockItemsMock.defaultExpectation.Counter, 1)
-		mm_want := mmListStockItems.ListStockItemsMock.defaultExpectation.params
-		mm_want_ptrs := mmListStockItems.ListStockItemsMock.defaultExpectation.paramPtrs
+	if mmWatchStock.WatchStockMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmWatchStock.WatchStockMock.defaultExpectation.Counter, 1)
+		mm_want := mmWatchStock.WatchStockMock.defaultExpectation.params
+		mm_want_ptrs := mmWatchStock.WatchStockMock.defaultExpectation.paramPtrs
 
-		mm_got := StockServiceUseCaseMockListStockItemsParams{ctx, filter}
+		mm_got := StockServiceUseCaseMockWatchStockParams{ctx, skuIDs, send}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmListStockItems.t.Errorf("StockServiceUseCaseMock.ListStockItems got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmListStockItems.ListStockItemsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmWatchStock.t.Errorf("StockServiceUseCaseMock.WatchStock got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWatchStock.WatchStockMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
-				mmListStockItems.t.Errorf("StockServiceUseCaseMock.ListStockItems got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmListStockItems.ListStockItemsMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			if mm_want_ptrs.skuIDs != nil && !minimock.Equal(*mm_want_ptrs.skuIDs, mm_got.skuIDs) {
+				mmWatchStock.t.Errorf("StockServiceUseCaseMock.WatchStock got unexpected parameter skuIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWatchStock.WatchStockMock.defaultExpectation.expectationOrigins.originSkuIDs, *mm_want_ptrs.skuIDs, mm_got.skuIDs, minimock.Diff(*mm_want_ptrs.skuIDs, mm_got.skuIDs))
+			}
+
+			if mm_want_ptrs.send != nil && !minimock.Equal(*mm_want_ptrs.send, mm_got.send) {
+				mmWatchStock.t.Errorf("StockServiceUseCaseMock.WatchStock got unexpected parameter send, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWatchStock.WatchStockMock.defaultExpectation.expectationOrigins.originSend, *mm_want_ptrs.send, mm_got.send, minimock.Diff(*mm_want_ptrs.send, mm_got.send))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmListStockItems.t.Errorf("StockServiceUseCaseMock.ListStockItems got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmListStockItems.ListStockItemsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmWatchStock.t.Errorf("StockServiceUseCaseMock.WatchStock got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmWatchStock.WatchStockMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmListStockItems.ListStockItemsMock.defaultExpectation.results
+		mm_results := mmWatchStock.WatchStockMock.defaultExpectation.results
 		if mm_results == nil {
-			mmListStockItems.t.Fatal("No results are set for the StockServiceUseCaseMock.ListStockItems")
+			mmWatchStock.t.Fatal("No results are set for the StockServiceUseCaseMock.WatchStock")
 		}
-		return (*mm_results).p1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmListStockItems.funcListStockItems != nil {
-		return mmListStockItems.funcListStockItems(ctx, filter)
+	if mmWatchStock.funcWatchStock != nil {
+		return mmWatchStock.funcWatchStock(ctx, skuIDs, send)
 	}
-	mmListStockItems.t.Fatalf("Unexpected call to StockServiceUseCaseMock.ListStockItems. %v %v", ctx, filter)
+	mmWatchStock.t.Fatalf("Unexpected call to StockServiceUseCaseMock.WatchStock. %v %v %v", ctx, skuIDs, send)
 	return
 }
 
-// ListStockItemsAfterCounter returns a count of finished StockServiceUseCaseMock.ListStockItems invocations
-func (mmListStockItems *StockServiceUseCaseMock) ListStockItemsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmListStockItems.afterListStockItemsCounter)
+// WatchStockAfterCounter returns a count of finished StockServiceUseCaseMock.WatchStock invocations
+func (mmWatchStock *StockServiceUseCaseMock) WatchStockAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWatchStock.afterWatchStockCounter)
 }
 
-// ListStockItemsBeforeCounter returns a count of StockServiceUseCaseMock.ListStockItems invocations
-func (mmListStockItems *StockServiceUseCaseMock) ListStockItemsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmListStockItems.beforeListStockItemsCounter)
+// WatchStockBeforeCounter returns a count of StockServiceUseCaseMock.WatchStock invocations
+func (mmWatchStock *StockServiceUseCaseMock) WatchStockBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWatchStock.beforeWatchStockCounter)
 }
 
-// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.ListStockItems.
+// Calls returns a list of arguments used in each call to StockServiceUseCaseMock.WatchStock.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmListStockItems *mStockServiceUseCaseMockListStockItems) Calls() []*StockServiceUseCaseMockListStockItemsParams {
-	mmListStockItems.mutex.RLock()
+func (mmWatchStock *mStockServiceUseCaseMockWatchStock) Calls() []*StockServiceUseCaseMockWatchStockParams {
+	mmWatchStock.mutex.RLock()
 
-	argCopy := make([]*StockServiceUseCaseMockListStockItemsParams, len(mmListStockItems.callArgs))
-	copy(argCopy, mmListStockItems.callArgs)
+	argCopy := make([]*StockServiceUseCaseMockWatchStockParams, len(mmWatchStock.callArgs))
+	copy(argCopy, mmWatchStock.callArgs)
 
-	mmListStockItems.mutex.RUnlock()
+	mmWatchStock.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockListStockItemsDone returns true if the count of the ListStockItems invocations corresponds
+// MinimockWatchStockDone returns true if the count of the WatchStock invocations corresponds
 // the number of defined expectations
-func (m *StockServiceUseCaseMock) MinimockListStockItemsDone() bool {
-	if m.ListStockItemsMock.optional {
+func (m *StockServiceUseCaseMock) MinimockWatchStockDone() bool {
+	if m.WatchStockMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ListStockItemsMock.expectations {
+	for _, e := range m.WatchStockMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ListStockItemsMock.invocationsDone()
+	return m.WatchStockMock.invocationsDone()
 }
 
-// MinimockListStockItemsInspect logs each unmet expectation
-func (m *StockServiceUseCaseMock) MinimockListStockItemsInspect() {
-	for _, e := range m.ListStockItemsMock.expectations {
+// MinimockWatchStockInspect logs each unmet expectation
+func (m *StockServiceUseCaseMock) MinimockWatchStockInspect() {
+	for _, e := range m.WatchStockMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StockServiceUseCaseMock.ListStockItems at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.WatchStock at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterListStockItemsCounter := mm_atomic.LoadUint64(&m.afterListStockItemsCounter)
+	afterWatchStockCounter := mm_atomic.LoadUint64(&m.afterWatchStockCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ListStockItemsMock.defaultExpectation != nil && afterListStockItemsCounter < 1 {
-		if m.ListStockItemsMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to StockServiceUseCaseMock.ListStockItems at\n%s", m.ListStockItemsMock.defaultExpectation.returnOrigin)
+	if m.WatchStockMock.defaultExpectation != nil && afterWatchStockCounter < 1 {
+		if m.WatchStockMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.WatchStock at\n%s", m.WatchStockMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to StockServiceUseCaseMock.ListStockItems at\n%s with params: %#v", m.ListStockItemsMock.defaultExpectation.expectationOrigins.origin, *m.ListStockItemsMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StockServiceUseCaseMock.WatchStock at\n%s with params: %#v", m.WatchStockMock.defaultExpectation.expectationOrigins.origin, *m.WatchStockMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcListStockItems != nil && afterListStockItemsCounter < 1 {
-		m.t.Errorf("Expected call to StockServiceUseCaseMock.ListStockItems at\n%s", m.funcListStockItemsOrigin)
+	if m.funcWatchStock != nil && afterWatchStockCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceUseCaseMock.WatchStock at\n%s", m.funcWatchStockOrigin)
 	}
 
-	if !m.ListStockItemsMock.invocationsDone() && afterListStockItemsCounter > 0 {
-		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.ListStockItems at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.ListStockItemsMock.expectedInvocations), m.ListStockItemsMock.expectedInvocationsOrigin, afterListStockItemsCounter)
+	if !m.WatchStockMock.invocationsDone() && afterWatchStockCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceUseCaseMock.WatchStock at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.WatchStockMock.expectedInvocations), m.WatchStockMock.expectedInvocationsOrigin, afterWatchStockCounter)
 	}
 }
 
@@ -1478,11 +15515,89 @@ func (m *StockServiceUseCaseMock) MinimockFinish() {
 		if !m.minimockDone() {
 			m.MinimockAddStockItemInspect()
 
+			m.MinimockAdjustStockInspect()
+
+			m.MinimockApplyScheduledPriceChangesInspect()
+
+			m.MinimockApproveCycleCountInspect()
+
+			m.MinimockCreatePurchaseOrderInspect()
+
+			m.MinimockCreateSupplierInspect()
+
+			m.MinimockCreateVariantGroupInspect()
+
 			m.MinimockDeleteStockItemInspect()
 
+			m.MinimockExpireStockLotsInspect()
+
+			m.MinimockGetBundleInspect()
+
+			m.MinimockGetInventoryValuationInspect()
+
+			m.MinimockGetPriceHistoryInspect()
+
+			m.MinimockGetPriceTiersInspect()
+
+			m.MinimockGetPurchaseOrderInspect()
+
+			m.MinimockGetReorderSuggestionsInspect()
+
 			m.MinimockGetStockItemBySKUInspect()
 
+			m.MinimockGetStockItemsBySKUsInspect()
+
+			m.MinimockGetTransferInspect()
+
+			m.MinimockGetVariantGroupInspect()
+
+			m.MinimockListExpiringLotsInspect()
+
+			m.MinimockListLowStockInspect()
+
 			m.MinimockListStockItemsInspect()
+
+			m.MinimockListSuppliersInspect()
+
+			m.MinimockOpenCycleCountInspect()
+
+			m.MinimockPlacePurchaseOrderInspect()
+
+			m.MinimockPurgeDeletedStockItemsInspect()
+
+			m.MinimockReceivePurchaseOrderInspect()
+
+			m.MinimockReceiveTransferInspect()
+
+			m.MinimockRecordCartDemandInspect()
+
+			m.MinimockRestoreStockItemInspect()
+
+			m.MinimockReviewCycleCountInspect()
+
+			m.MinimockSchedulePriceChangeInspect()
+
+			m.MinimockSearchSKUsInspect()
+
+			m.MinimockSetAttributeSchemaInspect()
+
+			m.MinimockSetBackorderSettingsInspect()
+
+			m.MinimockSetBundleInspect()
+
+			m.MinimockSetPriceTiersInspect()
+
+			m.MinimockSetStockThresholdInspect()
+
+			m.MinimockSubmitCycleCountsInspect()
+
+			m.MinimockTransferStockInspect()
+
+			m.MinimockUpdateSKUAttributesInspect()
+
+			m.MinimockUpdateStockItemInspect()
+
+			m.MinimockWatchStockInspect()
 		}
 	})
 }
@@ -1507,7 +15622,46 @@ func (m *StockServiceUseCaseMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockAddStockItemDone() &&
+		m.MinimockAdjustStockDone() &&
+		m.MinimockApplyScheduledPriceChangesDone() &&
+		m.MinimockApproveCycleCountDone() &&
+		m.MinimockCreatePurchaseOrderDone() &&
+		m.MinimockCreateSupplierDone() &&
+		m.MinimockCreateVariantGroupDone() &&
 		m.MinimockDeleteStockItemDone() &&
+		m.MinimockExpireStockLotsDone() &&
+		m.MinimockGetBundleDone() &&
+		m.MinimockGetInventoryValuationDone() &&
+		m.MinimockGetPriceHistoryDone() &&
+		m.MinimockGetPriceTiersDone() &&
+		m.MinimockGetPurchaseOrderDone() &&
+		m.MinimockGetReorderSuggestionsDone() &&
 		m.MinimockGetStockItemBySKUDone() &&
-		m.MinimockListStockItemsDone()
+		m.MinimockGetStockItemsBySKUsDone() &&
+		m.MinimockGetTransferDone() &&
+		m.MinimockGetVariantGroupDone() &&
+		m.MinimockListExpiringLotsDone() &&
+		m.MinimockListLowStockDone() &&
+		m.MinimockListStockItemsDone() &&
+		m.MinimockListSuppliersDone() &&
+		m.MinimockOpenCycleCountDone() &&
+		m.MinimockPlacePurchaseOrderDone() &&
+		m.MinimockPurgeDeletedStockItemsDone() &&
+		m.MinimockReceivePurchaseOrderDone() &&
+		m.MinimockReceiveTransferDone() &&
+		m.MinimockRecordCartDemandDone() &&
+		m.MinimockRestoreStockItemDone() &&
+		m.MinimockReviewCycleCountDone() &&
+		m.MinimockSchedulePriceChangeDone() &&
+		m.MinimockSearchSKUsDone() &&
+		m.MinimockSetAttributeSchemaDone() &&
+		m.MinimockSetBackorderSettingsDone() &&
+		m.MinimockSetBundleDone() &&
+		m.MinimockSetPriceTiersDone() &&
+		m.MinimockSetStockThresholdDone() &&
+		m.MinimockSubmitCycleCountsDone() &&
+		m.MinimockTransferStockDone() &&
+		m.MinimockUpdateSKUAttributesDone() &&
+		m.MinimockUpdateStockItemDone() &&
+		m.MinimockWatchStockDone()
 }
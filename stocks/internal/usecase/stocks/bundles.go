@@ -0,0 +1,110 @@
+package stocks
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"stocks/internal/domain"
+	"stocks/internal/kafka"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// SetBundle defines sku as bundle of components, previous components of bundle are replaced.
+func (s *stockServiceUseCase) SetBundle(ctx context.Context, bundle domain.Bundle) error {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.SetBundle")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("sku_id", fmt.Sprintf("%d", bundle.Sku.ID)),
+		attribute.Int("components", len(bundle.Components)),
+	)
+
+	if err := bundle.Validate(); err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return err
+	}
+
+	err := s.SaveBundle(ctx, bundle)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+// GetBundle returns bundle sku with its components.
+func (s *stockServiceUseCase) GetBundle(ctx context.Context, skuID domain.SKUID) (domain.Bundle, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.GetBundle")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("sku_id", fmt.Sprintf("%d", skuID)))
+
+	sku, err := s.GetSKUByID(ctx, skuID)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.Bundle{}, err
+	}
+
+	if !sku.IsBundle {
+		span.SetAttributes(attribute.String("error.message", domain.ErrBundleNotFound.Error()))
+		return domain.Bundle{}, domain.ErrBundleNotFound
+	}
+
+	components, err := s.GetBundleComponents(ctx, skuID)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.Bundle{}, err
+	}
+
+	return domain.Bundle{Sku: sku, Components: components}, nil
+}
+
+// adjustBundle applies bundle adjustment to its components of the same seller and location
+// and returns resulting availability of bundle, price of bundle offer is read by ListBundleOffers.
+func (s *stockServiceUseCase) adjustBundle(
+	ctx context.Context,
+	adjustment domain.StockAdjustment,
+	components []domain.BundleComponent,
+) (domain.StockAdjustmentResult, error) {
+	componentResults, err := s.AdjustBundleComponents(ctx, adjustment, components)
+	if err != nil {
+		return domain.StockAdjustmentResult{}, err
+	}
+
+	quantities := make(map[domain.SKUID]int64, len(componentResults))
+	bundleQuantities := make(map[domain.SKUID]uint32, len(components))
+
+	for _, component := range components {
+		bundleQuantities[component.SkuID] = component.Quantity
+	}
+
+	for _, componentResult := range componentResults {
+		quantities[componentResult.Sku.ID] = componentResult.Quantity
+
+		s.KafkaProducer.ProduceStockChanged(ctx, kafka.SKUCreatedAndStockChangedPayload{
+			SKU:      fmt.Sprintf("%d", componentResult.Sku.ID),
+			Count:    componentResult.Count,
+			Price:    componentResult.Price.Amount,
+			Currency: string(componentResult.Price.Currency),
+			Delta:    adjustment.Delta * int64(bundleQuantities[componentResult.Sku.ID]),
+			Reason:   string(adjustment.Reason),
+		})
+
+		s.checkStockLevel(ctx, componentResult.StockItem, componentResult.Level)
+	}
+
+	availability := domain.BundleAvailability(components, quantities)
+
+	return domain.StockAdjustmentResult{
+		StockItem: domain.StockItem{
+			UserID:   adjustment.UserID,
+			Sku:      domain.SKU{ID: adjustment.SkuID, IsBundle: true},
+			Count:    domain.ClampCount(availability),
+			Location: adjustment.Location,
+		},
+		Quantity: availability,
+	}, nil
+}
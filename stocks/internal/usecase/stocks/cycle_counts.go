@@ -0,0 +1,150 @@
+package stocks
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"stocks/internal/domain"
+	"stocks/internal/kafka"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (s *stockServiceUseCase) OpenCycleCount(ctx context.Context, cycleCount domain.CycleCount) (domain.CycleCount, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.OpenCycleCount")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("location", cycleCount.Location),
+		attribute.String("user_id", fmt.Sprintf("%d", cycleCount.UserID)),
+		attribute.String("opened_by", fmt.Sprintf("%d", cycleCount.OpenedBy)),
+	)
+
+	openedCycleCount, err := s.CreateCycleCount(ctx, cycleCount)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.CycleCount{}, err
+	}
+
+	return openedCycleCount, nil
+}
+
+func (s *stockServiceUseCase) SubmitCycleCounts(ctx context.Context, submission domain.CycleCountSubmission) (domain.CycleCount, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.SubmitCycleCounts")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int64("cycle_count_id", int64(submission.CycleCountID)),
+		attribute.Int("counts", len(submission.Counts)),
+		attribute.String("counted_by", fmt.Sprintf("%d", submission.CountedBy)),
+	)
+
+	cycleCount, err := s.GetCycleCount(ctx, submission.CycleCountID)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.CycleCount{}, err
+	}
+
+	submission.Counts, err = cycleCount.ResolveCounts(submission.Counts)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.CycleCount{}, err
+	}
+
+	err = s.SaveCycleCounts(ctx, submission)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.CycleCount{}, err
+	}
+
+	cycleCount, err = s.GetCycleCount(ctx, submission.CycleCountID)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.CycleCount{}, err
+	}
+
+	return cycleCount, nil
+}
+
+// ReviewCycleCount returns count session with variances of its lines against system quantities and audit trail.
+func (s *stockServiceUseCase) ReviewCycleCount(ctx context.Context, cycleCountID domain.CycleCountID) (domain.CycleCount, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.ReviewCycleCount")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int64("cycle_count_id", int64(cycleCountID)))
+
+	cycleCount, err := s.GetCycleCount(ctx, cycleCountID)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.CycleCount{}, err
+	}
+
+	return cycleCount, nil
+}
+
+// ApproveCycleCount posts variances of count session as adjustments, emits stock_reconciled for every
+// counted stock item and stock_changed for those whose quantity was changed.
+func (s *stockServiceUseCase) ApproveCycleCount(
+	ctx context.Context,
+	cycleCountID domain.CycleCountID,
+	approvedBy domain.UserID,
+) (domain.CycleCount, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.ApproveCycleCount")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int64("cycle_count_id", int64(cycleCountID)),
+		attribute.String("approved_by", fmt.Sprintf("%d", approvedBy)),
+	)
+
+	approval, err := s.ApplyCycleCountVariances(ctx, cycleCountID, approvedBy)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.CycleCount{}, err
+	}
+
+	cycleCount := approval.CycleCount
+
+	for _, line := range cycleCount.Lines {
+		s.KafkaProducer.ProduceStockReconciled(ctx, kafka.StockReconciledPayload{
+			CycleCountID:    int64(cycleCount.ID),
+			SKU:             fmt.Sprintf("%d", line.Sku.ID),
+			UserID:          int64(line.UserID),
+			Location:        cycleCount.Location,
+			SystemQuantity:  line.SystemQuantity,
+			CountedQuantity: line.CountedQuantity,
+			Variance:        line.Variance(),
+			QuantityAfter:   line.QuantityAfter,
+			ApprovedBy:      int64(approvedBy),
+		})
+	}
+
+	for _, adjustment := range approval.Adjustments {
+		s.KafkaProducer.ProduceStockChanged(ctx, kafka.SKUCreatedAndStockChangedPayload{
+			SKU:      fmt.Sprintf("%d", adjustment.Sku.ID),
+			Count:    adjustment.Count,
+			Price:    adjustment.Price.Amount,
+			Currency: string(adjustment.Price.Currency),
+			Delta:    adjustmentDelta(cycleCount.Lines, adjustment),
+			Reason:   string(domain.AdjustmentReasonCycleCount),
+		})
+
+		s.checkStockLevel(ctx, adjustment.StockItem, adjustment.Level)
+	}
+
+	span.SetAttributes(attribute.Int("adjusted_count", len(approval.Adjustments)))
+
+	return cycleCount, nil
+}
+
+// adjustmentDelta finds variance of line adjustment was posted for.
+func adjustmentDelta(lines []domain.CycleCountLine, adjustment domain.StockAdjustmentResult) int64 {
+	for _, line := range lines {
+		if line.UserID == adjustment.UserID && line.Sku.ID == adjustment.Sku.ID {
+			return line.Variance()
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,2257 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mock
+
+import (
+	"context"
+	"stocks/internal/domain"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// PriceRepositoryMock implements mm_stocks.PriceRepository
+type PriceRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcApplyDuePriceChanges          func(ctx context.Context, limit int) (sa1 []domain.ScheduledPriceChange, err error)
+	funcApplyDuePriceChangesOrigin    string
+	inspectFuncApplyDuePriceChanges   func(ctx context.Context, limit int)
+	afterApplyDuePriceChangesCounter  uint64
+	beforeApplyDuePriceChangesCounter uint64
+	ApplyDuePriceChangesMock          mPriceRepositoryMockApplyDuePriceChanges
+
+	funcListPendingPriceChanges          func(ctx context.Context, filter domain.PriceHistoryFilter) (sa1 []domain.ScheduledPriceChange, err error)
+	funcListPendingPriceChangesOrigin    string
+	inspectFuncListPendingPriceChanges   func(ctx context.Context, filter domain.PriceHistoryFilter)
+	afterListPendingPriceChangesCounter  uint64
+	beforeListPendingPriceChangesCounter uint64
+	ListPendingPriceChangesMock          mPriceRepositoryMockListPendingPriceChanges
+
+	funcListPriceHistory          func(ctx context.Context, filter domain.PriceHistoryFilter) (pa1 []domain.PriceHistoryEntry, err error)
+	funcListPriceHistoryOrigin    string
+	inspectFuncListPriceHistory   func(ctx context.Context, filter domain.PriceHistoryFilter)
+	afterListPriceHistoryCounter  uint64
+	beforeListPriceHistoryCounter uint64
+	ListPriceHistoryMock          mPriceRepositoryMockListPriceHistory
+
+	funcListPriceTiersOfStockItem          func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) (pa1 []domain.PriceTier, err error)
+	funcListPriceTiersOfStockItemOrigin    string
+	inspectFuncListPriceTiersOfStockItem   func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string)
+	afterListPriceTiersOfStockItemCounter  uint64
+	beforeListPriceTiersOfStockItemCounter uint64
+	ListPriceTiersOfStockItemMock          mPriceRepositoryMockListPriceTiersOfStockItem
+
+	funcReplacePriceTiers          func(ctx context.Context, priceList domain.PriceList) (err error)
+	funcReplacePriceTiersOrigin    string
+	inspectFuncReplacePriceTiers   func(ctx context.Context, priceList domain.PriceList)
+	afterReplacePriceTiersCounter  uint64
+	beforeReplacePriceTiersCounter uint64
+	ReplacePriceTiersMock          mPriceRepositoryMockReplacePriceTiers
+
+	funcSaveScheduledPriceChange          func(ctx context.Context, priceChange domain.ScheduledPriceChange) (s1 domain.ScheduledPriceChange, err error)
+	funcSaveScheduledPriceChangeOrigin    string
+	inspectFuncSaveScheduledPriceChange   func(ctx context.Context, priceChange domain.ScheduledPriceChange)
+	afterSaveScheduledPriceChangeCounter  uint64
+	beforeSaveScheduledPriceChangeCounter uint64
+	SaveScheduledPriceChangeMock          mPriceRepositoryMockSaveScheduledPriceChange
+}
+
+// NewPriceRepositoryMock returns a mock for mm_stocks.PriceRepository
+func NewPriceRepositoryMock(t minimock.Tester) *PriceRepositoryMock {
+	m := &PriceRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ApplyDuePriceChangesMock = mPriceRepositoryMockApplyDuePriceChanges{mock: m}
+	m.ApplyDuePriceChangesMock.callArgs = []*PriceRepositoryMockApplyDuePriceChangesParams{}
+
+	m.ListPendingPriceChangesMock = mPriceRepositoryMockListPendingPriceChanges{mock: m}
+	m.ListPendingPriceChangesMock.callArgs = []*PriceRepositoryMockListPendingPriceChangesParams{}
+
+	m.ListPriceHistoryMock = mPriceRepositoryMockListPriceHistory{mock: m}
+	m.ListPriceHistoryMock.callArgs = []*PriceRepositoryMockListPriceHistoryParams{}
+
+	m.ListPriceTiersOfStockItemMock = mPriceRepositoryMockListPriceTiersOfStockItem{mock: m}
+	m.ListPriceTiersOfStockItemMock.callArgs = []*PriceRepositoryMockListPriceTiersOfStockItemParams{}
+
+	m.ReplacePriceTiersMock = mPriceRepositoryMockReplacePriceTiers{mock: m}
+	m.ReplacePriceTiersMock.callArgs = []*PriceRepositoryMockReplacePriceTiersParams{}
+
+	m.SaveScheduledPriceChangeMock = mPriceRepositoryMockSaveScheduledPriceChange{mock: m}
+	m.SaveScheduledPriceChangeMock.callArgs = []*PriceRepositoryMockSaveScheduledPriceChangeParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPriceRepositoryMockApplyDuePriceChanges struct {
+	optional           bool
+	mock               *PriceRepositoryMock
+	defaultExpectation *PriceRepositoryMockApplyDuePriceChangesExpectation
+	expectations       []*PriceRepositoryMockApplyDuePriceChangesExpectation
+
+	callArgs []*PriceRepositoryMockApplyDuePriceChangesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PriceRepositoryMockApplyDuePriceChangesExpectation specifies expectation struct of the PriceRepository.ApplyDuePriceChanges
+type PriceRepositoryMockApplyDuePriceChangesExpectation struct {
+	mock               *PriceRepositoryMock
+	params             *PriceRepositoryMockApplyDuePriceChangesParams
+	paramPtrs          *PriceRepositoryMockApplyDuePriceChangesParamPtrs
+	expectationOrigins PriceRepositoryMockApplyDuePriceChangesExpectationOrigins
+	results            *PriceRepositoryMockApplyDuePriceChangesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PriceRepositoryMockApplyDuePriceChangesParams contains parameters of the PriceRepository.ApplyDuePriceChanges
+type PriceRepositoryMockApplyDuePriceChangesParams struct {
+	ctx   context.Context
+	limit int
+}
+
+// PriceRepositoryMockApplyDuePriceChangesParamPtrs contains pointers to parameters of the PriceRepository.ApplyDuePriceChanges
+type PriceRepositoryMockApplyDuePriceChangesParamPtrs struct {
+	ctx   *context.Context
+	limit *int
+}
+
+// PriceRepositoryMockApplyDuePriceChangesResults contains results of the PriceRepository.ApplyDuePriceChanges
+type PriceRepositoryMockApplyDuePriceChangesResults struct {
+	sa1 []domain.ScheduledPriceChange
+	err error
+}
+
+// PriceRepositoryMockApplyDuePriceChangesOrigins contains origins of expectations of the PriceRepository.ApplyDuePriceChanges
+type PriceRepositoryMockApplyDuePriceChangesExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originLimit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmApplyDuePriceChanges *mPriceRepositoryMockApplyDuePriceChanges) Optional() *mPriceRepositoryMockApplyDuePriceChanges {
+	mmApplyDuePriceChanges.optional = true
+	return mmApplyDuePriceChanges
+}
+
+// Expect sets up expected params for PriceRepository.ApplyDuePriceChanges
+func (mmApplyDuePriceChanges *mPriceRepositoryMockApplyDuePriceChanges) Expect(ctx context.Context, limit int) *mPriceRepositoryMockApplyDuePriceChanges {
+	if mmApplyDuePriceChanges.mock.funcApplyDuePriceChanges != nil {
+		mmApplyDuePriceChanges.mock.t.Fatalf("PriceRepositoryMock.ApplyDuePriceChanges mock is already set by Set")
+	}
+
+	if mmApplyDuePriceChanges.defaultExpectation == nil {
+		mmApplyDuePriceChanges.defaultExpectation = &PriceRepositoryMockApplyDuePriceChangesExpectation{}
+	}
+
+	if mmApplyDuePriceChanges.defaultExpectation.paramPtrs != nil {
+		mmApplyDuePriceChanges.mock.t.Fatalf("PriceRepositoryMock.ApplyDuePriceChanges mock is already set by ExpectParams functions")
+	}
+
+	mmApplyDuePriceChanges.defaultExpectation.params = &PriceRepositoryMockApplyDuePriceChangesParams{ctx, limit}
+	mmApplyDuePriceChanges.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmApplyDuePriceChanges.expectations {
+		if minimock.Equal(e.params, mmApplyDuePriceChanges.defaultExpectation.params) {
+			mmApplyDuePriceChanges.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmApplyDuePriceChanges.defaultExpectation.params)
+		}
+	}
+
+	return mmApplyDuePriceChanges
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PriceRepository.ApplyDuePriceChanges
+func (mmApplyDuePriceChanges *mPriceRepositoryMockApplyDuePriceChanges) ExpectCtxParam1(ctx context.Context) *mPriceRepositoryMockApplyDuePriceChanges {
+	if mmApplyDuePriceChanges.mock.funcApplyDuePriceChanges != nil {
+		mmApplyDuePriceChanges.mock.t.Fatalf("PriceRepositoryMock.ApplyDuePriceChanges mock is already set by Set")
+	}
+
+	if mmApplyDuePriceChanges.defaultExpectation == nil {
+		mmApplyDuePriceChanges.defaultExpectation = &PriceRepositoryMockApplyDuePriceChangesExpectation{}
+	}
+
+	if mmApplyDuePriceChanges.defaultExpectation.params != nil {
+		mmApplyDuePriceChanges.mock.t.Fatalf("PriceRepositoryMock.ApplyDuePriceChanges mock is already set by Expect")
+	}
+
+	if mmApplyDuePriceChanges.defaultExpectation.paramPtrs == nil {
+		mmApplyDuePriceChanges.defaultExpectation.paramPtrs = &PriceRepositoryMockApplyDuePriceChangesParamPtrs{}
+	}
+	mmApplyDuePriceChanges.defaultExpectation.paramPtrs.ctx = &ctx
+	mmApplyDuePriceChanges.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmApplyDuePriceChanges
+}
+
+// ExpectLimitParam2 sets up expected param limit for PriceRepository.ApplyDuePriceChanges
+func (mmApplyDuePriceChanges *mPriceRepositoryMockApplyDuePriceChanges) ExpectLimitParam2(limit int) *mPriceRepositoryMockApplyDuePriceChanges {
+	if mmApplyDuePriceChanges.mock.funcApplyDuePriceChanges != nil {
+		mmApplyDuePriceChanges.mock.t.Fatalf("PriceRepositoryMock.ApplyDuePriceChanges mock is already set by Set")
+	}
+
+	if mmApplyDuePriceChanges.defaultExpectation == nil {
+		mmApplyDuePriceChanges.defaultExpectation = &PriceRepositoryMockApplyDuePriceChangesExpectation{}
+	}
+
+	if mmApplyDuePriceChanges.defaultExpectation.params != nil {
+		mmApplyDuePriceChanges.mock.t.Fatalf("PriceRepositoryMock.ApplyDuePriceChanges mock is already set by Expect")
+	}
+
+	if mmApplyDuePriceChanges.defaultExpectation.paramPtrs == nil {
+		mmApplyDuePriceChanges.defaultExpectation.paramPtrs = &PriceRepositoryMockApplyDuePriceChangesParamPtrs{}
+	}
+	mmApplyDuePriceChanges.defaultExpectation.paramPtrs.limit = &limit
+	mmApplyDuePriceChanges.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmApplyDuePriceChanges
+}
+
+// Inspect accepts an inspector function that has same arguments as the PriceRepository.ApplyDuePriceChanges
+func (mmApplyDuePriceChanges *mPriceRepositoryMockApplyDuePriceChanges) Inspect(f func(ctx context.Context, limit int)) *mPriceRepositoryMockApplyDuePriceChanges {
+	if mmApplyDuePriceChanges.mock.inspectFuncApplyDuePriceChanges != nil {
+		mmApplyDuePriceChanges.mock.t.Fatalf("Inspect function is already set for PriceRepositoryMock.ApplyDuePriceChanges")
+	}
+
+	mmApplyDuePriceChanges.mock.inspectFuncApplyDuePriceChanges = f
+
+	return mmApplyDuePriceChanges
+}
+
+// Return sets up results that will be returned by PriceRepository.ApplyDuePriceChanges
+func (mmApplyDuePriceChanges *mPriceRepositoryMockApplyDuePriceChanges) Return(sa1 []domain.ScheduledPriceChange, err error) *PriceRepositoryMock {
+	if mmApplyDuePriceChanges.mock.funcApplyDuePriceChanges != nil {
+		mmApplyDuePriceChanges.mock.t.Fatalf("PriceRepositoryMock.ApplyDuePriceChanges mock is already set by Set")
+	}
+
+	if mmApplyDuePriceChanges.defaultExpectation == nil {
+		mmApplyDuePriceChanges.defaultExpectation = &PriceRepositoryMockApplyDuePriceChangesExpectation{mock: mmApplyDuePriceChanges.mock}
+	}
+	mmApplyDuePriceChanges.defaultExpectation.results = &PriceRepositoryMockApplyDuePriceChangesResults{sa1, err}
+	mmApplyDuePriceChanges.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmApplyDuePriceChanges.mock
+}
+
+// Set uses given function f to mock the PriceRepository.ApplyDuePriceChanges method
+func (mmApplyDuePriceChanges *mPriceRepositoryMockApplyDuePriceChanges) Set(f func(ctx context.Context, limit int) (sa1 []domain.ScheduledPriceChange, err error)) *PriceRepositoryMock {
+	if mmApplyDuePriceChanges.defaultExpectation != nil {
+		mmApplyDuePriceChanges.mock.t.Fatalf("Default expectation is already set for the PriceRepository.ApplyDuePriceChanges method")
+	}
+
+	if len(mmApplyDuePriceChanges.expectations) > 0 {
+		mmApplyDuePriceChanges.mock.t.Fatalf("Some expectations are already set for the PriceRepository.ApplyDuePriceChanges method")
+	}
+
+	mmApplyDuePriceChanges.mock.funcApplyDuePriceChanges = f
+	mmApplyDuePriceChanges.mock.funcApplyDuePriceChangesOrigin = minimock.CallerInfo(1)
+	return mmApplyDuePriceChanges.mock
+}
+
+// When sets expectation for the PriceRepository.ApplyDuePriceChanges which will trigger the result defined by the following
+// Then helper
+func (mmApplyDuePriceChanges *mPriceRepositoryMockApplyDuePriceChanges) When(ctx context.Context, limit int) *PriceRepositoryMockApplyDuePriceChangesExpectation {
+	if mmApplyDuePriceChanges.mock.funcApplyDuePriceChanges != nil {
+		mmApplyDuePriceChanges.mock.t.Fatalf("PriceRepositoryMock.ApplyDuePriceChanges mock is already set by Set")
+	}
+
+	expectation := &PriceRepositoryMockApplyDuePriceChangesExpectation{
+		mock:               mmApplyDuePriceChanges.mock,
+		params:             &PriceRepositoryMockApplyDuePriceChangesParams{ctx, limit},
+		expectationOrigins: PriceRepositoryMockApplyDuePriceChangesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmApplyDuePriceChanges.expectations = append(mmApplyDuePriceChanges.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PriceRepository.ApplyDuePriceChanges return parameters for the expectation previously defined by the When method
+func (e *PriceRepositoryMockApplyDuePriceChangesExpectation) Then(sa1 []domain.ScheduledPriceChange, err error) *PriceRepositoryMock {
+	e.results = &PriceRepositoryMockApplyDuePriceChangesResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times PriceRepository.ApplyDuePriceChanges should be invoked
+func (mmApplyDuePriceChanges *mPriceRepositoryMockApplyDuePriceChanges) Times(n uint64) *mPriceRepositoryMockApplyDuePriceChanges {
+	if n == 0 {
+		mmApplyDuePriceChanges.mock.t.Fatalf("Times of PriceRepositoryMock.ApplyDuePriceChanges mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmApplyDuePriceChanges.expectedInvocations, n)
+	mmApplyDuePriceChanges.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmApplyDuePriceChanges
+}
+
+func (mmApplyDuePriceChanges *mPriceRepositoryMockApplyDuePriceChanges) invocationsDone() bool {
+	if len(mmApplyDuePriceChanges.expectations) == 0 && mmApplyDuePriceChanges.defaultExpectation == nil && mmApplyDuePriceChanges.mock.funcApplyDuePriceChanges == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmApplyDuePriceChanges.mock.afterApplyDuePriceChangesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmApplyDuePriceChanges.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ApplyDuePriceChanges implements mm_stocks.PriceRepository
+func (mmApplyDuePriceChanges *PriceRepositoryMock) ApplyDuePriceChanges(ctx context.Context, limit int) (sa1 []domain.ScheduledPriceChange, err error) {
+	mm_atomic.AddUint64(&mmApplyDuePriceChanges.beforeApplyDuePriceChangesCounter, 1)
+	defer mm_atomic.AddUint64(&mmApplyDuePriceChanges.afterApplyDuePriceChangesCounter, 1)
+
+	mmApplyDuePriceChanges.t.Helper()
+
+	if mmApplyDuePriceChanges.inspectFuncApplyDuePriceChanges != nil {
+		mmApplyDuePriceChanges.inspectFuncApplyDuePriceChanges(ctx, limit)
+	}
+
+	mm_params := PriceRepositoryMockApplyDuePriceChangesParams{ctx, limit}
+
+	// Record call args
+	mmApplyDuePriceChanges.ApplyDuePriceChangesMock.mutex.Lock()
+	mmApplyDuePriceChanges.ApplyDuePriceChangesMock.callArgs = append(mmApplyDuePriceChanges.ApplyDuePriceChangesMock.callArgs, &mm_params)
+	mmApplyDuePriceChanges.ApplyDuePriceChangesMock.mutex.Unlock()
+
+	for _, e := range mmApplyDuePriceChanges.ApplyDuePriceChangesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmApplyDuePriceChanges.ApplyDuePriceChangesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmApplyDuePriceChanges.ApplyDuePriceChangesMock.defaultExpectation.Counter, 1)
+		mm_want := mmApplyDuePriceChanges.ApplyDuePriceChangesMock.defaultExpectation.params
+		mm_want_ptrs := mmApplyDuePriceChanges.ApplyDuePriceChangesMock.defaultExpectation.paramPtrs
+
+		mm_got := PriceRepositoryMockApplyDuePriceChangesParams{ctx, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmApplyDuePriceChanges.t.Errorf("PriceRepositoryMock.ApplyDuePriceChanges got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyDuePriceChanges.ApplyDuePriceChangesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmApplyDuePriceChanges.t.Errorf("PriceRepositoryMock.ApplyDuePriceChanges got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyDuePriceChanges.ApplyDuePriceChangesMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmApplyDuePriceChanges.t.Errorf("PriceRepositoryMock.ApplyDuePriceChanges got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmApplyDuePriceChanges.ApplyDuePriceChangesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmApplyDuePriceChanges.ApplyDuePriceChangesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmApplyDuePriceChanges.t.Fatal("No results are set for the PriceRepositoryMock.ApplyDuePriceChanges")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmApplyDuePriceChanges.funcApplyDuePriceChanges != nil {
+		return mmApplyDuePriceChanges.funcApplyDuePriceChanges(ctx, limit)
+	}
+	mmApplyDuePriceChanges.t.Fatalf("Unexpected call to PriceRepositoryMock.ApplyDuePriceChanges. %v %v", ctx, limit)
+	return
+}
+
+// ApplyDuePriceChangesAfterCounter returns a count of finished PriceRepositoryMock.ApplyDuePriceChanges invocations
+func (mmApplyDuePriceChanges *PriceRepositoryMock) ApplyDuePriceChangesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApplyDuePriceChanges.afterApplyDuePriceChangesCounter)
+}
+
+// ApplyDuePriceChangesBeforeCounter returns a count of PriceRepositoryMock.ApplyDuePriceChanges invocations
+func (mmApplyDuePriceChanges *PriceRepositoryMock) ApplyDuePriceChangesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApplyDuePriceChanges.beforeApplyDuePriceChangesCounter)
+}
+
+// Calls returns a list of arguments used in each call to PriceRepositoryMock.ApplyDuePriceChanges.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmApplyDuePriceChanges *mPriceRepositoryMockApplyDuePriceChanges) Calls() []*PriceRepositoryMockApplyDuePriceChangesParams {
+	mmApplyDuePriceChanges.mutex.RLock()
+
+	argCopy := make([]*PriceRepositoryMockApplyDuePriceChangesParams, len(mmApplyDuePriceChanges.callArgs))
+	copy(argCopy, mmApplyDuePriceChanges.callArgs)
+
+	mmApplyDuePriceChanges.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockApplyDuePriceChangesDone returns true if the count of the ApplyDuePriceChanges invocations corresponds
+// the number of defined expectations
+func (m *PriceRepositoryMock) MinimockApplyDuePriceChangesDone() bool {
+	if m.ApplyDuePriceChangesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ApplyDuePriceChangesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ApplyDuePriceChangesMock.invocationsDone()
+}
+
+// MinimockApplyDuePriceChangesInspect logs each unmet expectation
+func (m *PriceRepositoryMock) MinimockApplyDuePriceChangesInspect() {
+	for _, e := range m.ApplyDuePriceChangesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PriceRepositoryMock.ApplyDuePriceChanges at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterApplyDuePriceChangesCounter := mm_atomic.LoadUint64(&m.afterApplyDuePriceChangesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ApplyDuePriceChangesMock.defaultExpectation != nil && afterApplyDuePriceChangesCounter < 1 {
+		if m.ApplyDuePriceChangesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PriceRepositoryMock.ApplyDuePriceChanges at\n%s", m.ApplyDuePriceChangesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PriceRepositoryMock.ApplyDuePriceChanges at\n%s with params: %#v", m.ApplyDuePriceChangesMock.defaultExpectation.expectationOrigins.origin, *m.ApplyDuePriceChangesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcApplyDuePriceChanges != nil && afterApplyDuePriceChangesCounter < 1 {
+		m.t.Errorf("Expected call to PriceRepositoryMock.ApplyDuePriceChanges at\n%s", m.funcApplyDuePriceChangesOrigin)
+	}
+
+	if !m.ApplyDuePriceChangesMock.invocationsDone() && afterApplyDuePriceChangesCounter > 0 {
+		m.t.Errorf("Expected %d calls to PriceRepositoryMock.ApplyDuePriceChanges at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ApplyDuePriceChangesMock.expectedInvocations), m.ApplyDuePriceChangesMock.expectedInvocationsOrigin, afterApplyDuePriceChangesCounter)
+	}
+}
+
+type mPriceRepositoryMockListPendingPriceChanges struct {
+	optional           bool
+	mock               *PriceRepositoryMock
+	defaultExpectation *PriceRepositoryMockListPendingPriceChangesExpectation
+	expectations       []*PriceRepositoryMockListPendingPriceChangesExpectation
+
+	callArgs []*PriceRepositoryMockListPendingPriceChangesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PriceRepositoryMockListPendingPriceChangesExpectation specifies expectation struct of the PriceRepository.ListPendingPriceChanges
+type PriceRepositoryMockListPendingPriceChangesExpectation struct {
+	mock               *PriceRepositoryMock
+	params             *PriceRepositoryMockListPendingPriceChangesParams
+	paramPtrs          *PriceRepositoryMockListPendingPriceChangesParamPtrs
+	expectationOrigins PriceRepositoryMockListPendingPriceChangesExpectationOrigins
+	results            *PriceRepositoryMockListPendingPriceChangesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PriceRepositoryMockListPendingPriceChangesParams contains parameters of the PriceRepository.ListPendingPriceChanges
+type PriceRepositoryMockListPendingPriceChangesParams struct {
+	ctx    context.Context
+	filter domain.PriceHistoryFilter
+}
+
+// PriceRepositoryMockListPendingPriceChangesParamPtrs contains pointers to parameters of the PriceRepository.ListPendingPriceChanges
+type PriceRepositoryMockListPendingPriceChangesParamPtrs struct {
+	ctx    *context.Context
+	filter *domain.PriceHistoryFilter
+}
+
+// PriceRepositoryMockListPendingPriceChangesResults contains results of the PriceRepository.ListPendingPriceChanges
+type PriceRepositoryMockListPendingPriceChangesResults struct {
+	sa1 []domain.ScheduledPriceChange
+	err error
+}
+
+// PriceRepositoryMockListPendingPriceChangesOrigins contains origins of expectations of the PriceRepository.ListPendingPriceChanges
+type PriceRepositoryMockListPendingPriceChangesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPendingPriceChanges *mPriceRepositoryMockListPendingPriceChanges) Optional() *mPriceRepositoryMockListPendingPriceChanges {
+	mmListPendingPriceChanges.optional = true
+	return mmListPendingPriceChanges
+}
+
+// Expect sets up expected params for PriceRepository.ListPendingPriceChanges
+func (mmListPendingPriceChanges *mPriceRepositoryMockListPendingPriceChanges) Expect(ctx context.Context, filter domain.PriceHistoryFilter) *mPriceRepositoryMockListPendingPriceChanges {
+	if mmListPendingPriceChanges.mock.funcListPendingPriceChanges != nil {
+		mmListPendingPriceChanges.mock.t.Fatalf("PriceRepositoryMock.ListPendingPriceChanges mock is already set by Set")
+	}
+
+	if mmListPendingPriceChanges.defaultExpectation == nil {
+		mmListPendingPriceChanges.defaultExpectation = &PriceRepositoryMockListPendingPriceChangesExpectation{}
+	}
+
+	if mmListPendingPriceChanges.defaultExpectation.paramPtrs != nil {
+		mmListPendingPriceChanges.mock.t.Fatalf("PriceRepositoryMock.ListPendingPriceChanges mock is already set by ExpectParams functions")
+	}
+
+	mmListPendingPriceChanges.defaultExpectation.params = &PriceRepositoryMockListPendingPriceChangesParams{ctx, filter}
+	mmListPendingPriceChanges.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListPendingPriceChanges.expectations {
+		if minimock.Equal(e.params, mmListPendingPriceChanges.defaultExpectation.params) {
+			mmListPendingPriceChanges.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPendingPriceChanges.defaultExpectation.params)
+		}
+	}
+
+	return mmListPendingPriceChanges
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PriceRepository.ListPendingPriceChanges
+func (mmListPendingPriceChanges *mPriceRepositoryMockListPendingPriceChanges) ExpectCtxParam1(ctx context.Context) *mPriceRepositoryMockListPendingPriceChanges {
+	if mmListPendingPriceChanges.mock.funcListPendingPriceChanges != nil {
+		mmListPendingPriceChanges.mock.t.Fatalf("PriceRepositoryMock.ListPendingPriceChanges mock is already set by Set")
+	}
+
+	if mmListPendingPriceChanges.defaultExpectation == nil {
+		mmListPendingPriceChanges.defaultExpectation = &PriceRepositoryMockListPendingPriceChangesExpectation{}
+	}
+
+	if mmListPendingPriceChanges.defaultExpectation.params != nil {
+		mmListPendingPriceChanges.mock.t.Fatalf("PriceRepositoryMock.ListPendingPriceChanges mock is already set by Expect")
+	}
+
+	if mmListPendingPriceChanges.defaultExpectation.paramPtrs == nil {
+		mmListPendingPriceChanges.defaultExpectation.paramPtrs = &PriceRepositoryMockListPendingPriceChangesParamPtrs{}
+	}
+	mmListPendingPriceChanges.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListPendingPriceChanges.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListPendingPriceChanges
+}
+
+// ExpectFilterParam2 sets up expected param filter for PriceRepository.ListPendingPriceChanges
+func (mmListPendingPriceChanges *mPriceRepositoryMockListPendingPriceChanges) ExpectFilterParam2(filter domain.PriceHistoryFilter) *mPriceRepositoryMockListPendingPriceChanges {
+	if mmListPendingPriceChanges.mock.funcListPendingPriceChanges != nil {
+		mmListPendingPriceChanges.mock.t.Fatalf("PriceRepositoryMock.ListPendingPriceChanges mock is already set by Set")
+	}
+
+	if mmListPendingPriceChanges.defaultExpectation == nil {
+		mmListPendingPriceChanges.defaultExpectation = &PriceRepositoryMockListPendingPriceChangesExpectation{}
+	}
+
+	if mmListPendingPriceChanges.defaultExpectation.params != nil {
+		mmListPendingPriceChanges.mock.t.Fatalf("PriceRepositoryMock.ListPendingPriceChanges mock is already set by Expect")
+	}
+
+	if mmListPendingPriceChanges.defaultExpectation.paramPtrs == nil {
+		mmListPendingPriceChanges.defaultExpectation.paramPtrs = &PriceRepositoryMockListPendingPriceChangesParamPtrs{}
+	}
+	mmListPendingPriceChanges.defaultExpectation.paramPtrs.filter = &filter
+	mmListPendingPriceChanges.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListPendingPriceChanges
+}
+
+// Inspect accepts an inspector function that has same arguments as the PriceRepository.ListPendingPriceChanges
+func (mmListPendingPriceChanges *mPriceRepositoryMockListPendingPriceChanges) Inspect(f func(ctx context.Context, filter domain.PriceHistoryFilter)) *mPriceRepositoryMockListPendingPriceChanges {
+	if mmListPendingPriceChanges.mock.inspectFuncListPendingPriceChanges != nil {
+		mmListPendingPriceChanges.mock.t.Fatalf("Inspect function is already set for PriceRepositoryMock.ListPendingPriceChanges")
+	}
+
+	mmListPendingPriceChanges.mock.inspectFuncListPendingPriceChanges = f
+
+	return mmListPendingPriceChanges
+}
+
+// Return sets up results that will be returned by PriceRepository.ListPendingPriceChanges
+func (mmListPendingPriceChanges *mPriceRepositoryMockListPendingPriceChanges) Return(sa1 []domain.ScheduledPriceChange, err error) *PriceRepositoryMock {
+	if mmListPendingPriceChanges.mock.funcListPendingPriceChanges != nil {
+		mmListPendingPriceChanges.mock.t.Fatalf("PriceRepositoryMock.ListPendingPriceChanges mock is already set by Set")
+	}
+
+	if mmListPendingPriceChanges.defaultExpectation == nil {
+		mmListPendingPriceChanges.defaultExpectation = &PriceRepositoryMockListPendingPriceChangesExpectation{mock: mmListPendingPriceChanges.mock}
+	}
+	mmListPendingPriceChanges.defaultExpectation.results = &PriceRepositoryMockListPendingPriceChangesResults{sa1, err}
+	mmListPendingPriceChanges.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListPendingPriceChanges.mock
+}
+
+// Set uses given function f to mock the PriceRepository.ListPendingPriceChanges method
+func (mmListPendingPriceChanges *mPriceRepositoryMockListPendingPriceChanges) Set(f func(ctx context.Context, filter domain.PriceHistoryFilter) (sa1 []domain.ScheduledPriceChange, err error)) *PriceRepositoryMock {
+	if mmListPendingPriceChanges.defaultExpectation != nil {
+		mmListPendingPriceChanges.mock.t.Fatalf("Default expectation is already set for the PriceRepository.ListPendingPriceChanges method")
+	}
+
+	if len(mmListPendingPriceChanges.expectations) > 0 {
+		mmListPendingPriceChanges.mock.t.Fatalf("Some expectations are already set for the PriceRepository.ListPendingPriceChanges method")
+	}
+
+	mmListPendingPriceChanges.mock.funcListPendingPriceChanges = f
+	mmListPendingPriceChanges.mock.funcListPendingPriceChangesOrigin = minimock.CallerInfo(1)
+	return mmListPendingPriceChanges.mock
+}
+
+// When sets expectation for the PriceRepository.ListPendingPriceChanges which will trigger the result defined by the following
+// Then helper
+func (mmListPendingPriceChanges *mPriceRepositoryMockListPendingPriceChanges) When(ctx context.Context, filter domain.PriceHistoryFilter) *PriceRepositoryMockListPendingPriceChangesExpectation {
+	if mmListPendingPriceChanges.mock.funcListPendingPriceChanges != nil {
+		mmListPendingPriceChanges.mock.t.Fatalf("PriceRepositoryMock.ListPendingPriceChanges mock is already set by Set")
+	}
+
+	expectation := &PriceRepositoryMockListPendingPriceChangesExpectation{
+		mock:               mmListPendingPriceChanges.mock,
+		params:             &PriceRepositoryMockListPendingPriceChangesParams{ctx, filter},
+		expectationOrigins: PriceRepositoryMockListPendingPriceChangesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListPendingPriceChanges.expectations = append(mmListPendingPriceChanges.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PriceRepository.ListPendingPriceChanges return parameters for the expectation previously defined by the When method
+func (e *PriceRepositoryMockListPendingPriceChangesExpectation) Then(sa1 []domain.ScheduledPriceChange, err error) *PriceRepositoryMock {
+	e.results = &PriceRepositoryMockListPendingPriceChangesResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times PriceRepository.ListPendingPriceChanges should be invoked
+func (mmListPendingPriceChanges *mPriceRepositoryMockListPendingPriceChanges) Times(n uint64) *mPriceRepositoryMockListPendingPriceChanges {
+	if n == 0 {
+		mmListPendingPriceChanges.mock.t.Fatalf("Times of PriceRepositoryMock.ListPendingPriceChanges mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPendingPriceChanges.expectedInvocations, n)
+	mmListPendingPriceChanges.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListPendingPriceChanges
+}
+
+func (mmListPendingPriceChanges *mPriceRepositoryMockListPendingPriceChanges) invocationsDone() bool {
+	if len(mmListPendingPriceChanges.expectations) == 0 && mmListPendingPriceChanges.defaultExpectation == nil && mmListPendingPriceChanges.mock.funcListPendingPriceChanges == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPendingPriceChanges.mock.afterListPendingPriceChangesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPendingPriceChanges.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPendingPriceChanges implements mm_stocks.PriceRepository
+func (mmListPendingPriceChanges *PriceRepositoryMock) ListPendingPriceChanges(ctx context.Context, filter domain.PriceHistoryFilter) (sa1 []domain.ScheduledPriceChange, err error) {
+	mm_atomic.AddUint64(&mmListPendingPriceChanges.beforeListPendingPriceChangesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPendingPriceChanges.afterListPendingPriceChangesCounter, 1)
+
+	mmListPendingPriceChanges.t.Helper()
+
+	if mmListPendingPriceChanges.inspectFuncListPendingPriceChanges != nil {
+		mmListPendingPriceChanges.inspectFuncListPendingPriceChanges(ctx, filter)
+	}
+
+	mm_params := PriceRepositoryMockListPendingPriceChangesParams{ctx, filter}
+
+	// Record call args
+	mmListPendingPriceChanges.ListPendingPriceChangesMock.mutex.Lock()
+	mmListPendingPriceChanges.ListPendingPriceChangesMock.callArgs = append(mmListPendingPriceChanges.ListPendingPriceChangesMock.callArgs, &mm_params)
+	mmListPendingPriceChanges.ListPendingPriceChangesMock.mutex.Unlock()
+
+	for _, e := range mmListPendingPriceChanges.ListPendingPriceChangesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListPendingPriceChanges.ListPendingPriceChangesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPendingPriceChanges.ListPendingPriceChangesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPendingPriceChanges.ListPendingPriceChangesMock.defaultExpectation.params
+		mm_want_ptrs := mmListPendingPriceChanges.ListPendingPriceChangesMock.defaultExpectation.paramPtrs
+
+		mm_got := PriceRepositoryMockListPendingPriceChangesParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPendingPriceChanges.t.Errorf("PriceRepositoryMock.ListPendingPriceChanges got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPendingPriceChanges.ListPendingPriceChangesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListPendingPriceChanges.t.Errorf("PriceRepositoryMock.ListPendingPriceChanges got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPendingPriceChanges.ListPendingPriceChangesMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPendingPriceChanges.t.Errorf("PriceRepositoryMock.ListPendingPriceChanges got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListPendingPriceChanges.ListPendingPriceChangesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPendingPriceChanges.ListPendingPriceChangesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPendingPriceChanges.t.Fatal("No results are set for the PriceRepositoryMock.ListPendingPriceChanges")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListPendingPriceChanges.funcListPendingPriceChanges != nil {
+		return mmListPendingPriceChanges.funcListPendingPriceChanges(ctx, filter)
+	}
+	mmListPendingPriceChanges.t.Fatalf("Unexpected call to PriceRepositoryMock.ListPendingPriceChanges. %v %v", ctx, filter)
+	return
+}
+
+// ListPendingPriceChangesAfterCounter returns a count of finished PriceRepositoryMock.ListPendingPriceChanges invocations
+func (mmListPendingPriceChanges *PriceRepositoryMock) ListPendingPriceChangesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPendingPriceChanges.afterListPendingPriceChangesCounter)
+}
+
+// ListPendingPriceChangesBeforeCounter returns a count of PriceRepositoryMock.ListPendingPriceChanges invocations
+func (mmListPendingPriceChanges *PriceRepositoryMock) ListPendingPriceChangesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPendingPriceChanges.beforeListPendingPriceChangesCounter)
+}
+
+// Calls returns a list of arguments used in each call to PriceRepositoryMock.ListPendingPriceChanges.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPendingPriceChanges *mPriceRepositoryMockListPendingPriceChanges) Calls() []*PriceRepositoryMockListPendingPriceChangesParams {
+	mmListPendingPriceChanges.mutex.RLock()
+
+	argCopy := make([]*PriceRepositoryMockListPendingPriceChangesParams, len(mmListPendingPriceChanges.callArgs))
+	copy(argCopy, mmListPendingPriceChanges.callArgs)
+
+	mmListPendingPriceChanges.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPendingPriceChangesDone returns true if the count of the ListPendingPriceChanges invocations corresponds
+// the number of defined expectations
+func (m *PriceRepositoryMock) MinimockListPendingPriceChangesDone() bool {
+	if m.ListPendingPriceChangesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPendingPriceChangesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPendingPriceChangesMock.invocationsDone()
+}
+
+// MinimockListPendingPriceChangesInspect logs each unmet expectation
+func (m *PriceRepositoryMock) MinimockListPendingPriceChangesInspect() {
+	for _, e := range m.ListPendingPriceChangesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PriceRepositoryMock.ListPendingPriceChanges at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListPendingPriceChangesCounter := mm_atomic.LoadUint64(&m.afterListPendingPriceChangesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPendingPriceChangesMock.defaultExpectation != nil && afterListPendingPriceChangesCounter < 1 {
+		if m.ListPendingPriceChangesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PriceRepositoryMock.ListPendingPriceChanges at\n%s", m.ListPendingPriceChangesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PriceRepositoryMock.ListPendingPriceChanges at\n%s with params: %#v", m.ListPendingPriceChangesMock.defaultExpectation.expectationOrigins.origin, *m.ListPendingPriceChangesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPendingPriceChanges != nil && afterListPendingPriceChangesCounter < 1 {
+		m.t.Errorf("Expected call to PriceRepositoryMock.ListPendingPriceChanges at\n%s", m.funcListPendingPriceChangesOrigin)
+	}
+
+	if !m.ListPendingPriceChangesMock.invocationsDone() && afterListPendingPriceChangesCounter > 0 {
+		m.t.Errorf("Expected %d calls to PriceRepositoryMock.ListPendingPriceChanges at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPendingPriceChangesMock.expectedInvocations), m.ListPendingPriceChangesMock.expectedInvocationsOrigin, afterListPendingPriceChangesCounter)
+	}
+}
+
+type mPriceRepositoryMockListPriceHistory struct {
+	optional           bool
+	mock               *PriceRepositoryMock
+	defaultExpectation *PriceRepositoryMockListPriceHistoryExpectation
+	expectations       []*PriceRepositoryMockListPriceHistoryExpectation
+
+	callArgs []*PriceRepositoryMockListPriceHistoryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PriceRepositoryMockListPriceHistoryExpectation specifies expectation struct of the PriceRepository.ListPriceHistory
+type PriceRepositoryMockListPriceHistoryExpectation struct {
+	mock               *PriceRepositoryMock
+	params             *PriceRepositoryMockListPriceHistoryParams
+	paramPtrs          *PriceRepositoryMockListPriceHistoryParamPtrs
+	expectationOrigins PriceRepositoryMockListPriceHistoryExpectationOrigins
+	results            *PriceRepositoryMockListPriceHistoryResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PriceRepositoryMockListPriceHistoryParams contains parameters of the PriceRepository.ListPriceHistory
+type PriceRepositoryMockListPriceHistoryParams struct {
+	ctx    context.Context
+	filter domain.PriceHistoryFilter
+}
+
+// PriceRepositoryMockListPriceHistoryParamPtrs contains pointers to parameters of the PriceRepository.ListPriceHistory
+type PriceRepositoryMockListPriceHistoryParamPtrs struct {
+	ctx    *context.Context
+	filter *domain.PriceHistoryFilter
+}
+
+// PriceRepositoryMockListPriceHistoryResults contains results of the PriceRepository.ListPriceHistory
+type PriceRepositoryMockListPriceHistoryResults struct {
+	pa1 []domain.PriceHistoryEntry
+	err error
+}
+
+// PriceRepositoryMockListPriceHistoryOrigins contains origins of expectations of the PriceRepository.ListPriceHistory
+type PriceRepositoryMockListPriceHistoryExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPriceHistory *mPriceRepositoryMockListPriceHistory) Optional() *mPriceRepositoryMockListPriceHistory {
+	mmListPriceHistory.optional = true
+	return mmListPriceHistory
+}
+
+// Expect sets up expected params for PriceRepository.ListPriceHistory
+func (mmListPriceHistory *mPriceRepositoryMockListPriceHistory) Expect(ctx context.Context, filter domain.PriceHistoryFilter) *mPriceRepositoryMockListPriceHistory {
+	if mmListPriceHistory.mock.funcListPriceHistory != nil {
+		mmListPriceHistory.mock.t.Fatalf("PriceRepositoryMock.ListPriceHistory mock is already set by Set")
+	}
+
+	if mmListPriceHistory.defaultExpectation == nil {
+		mmListPriceHistory.defaultExpectation = &PriceRepositoryMockListPriceHistoryExpectation{}
+	}
+
+	if mmListPriceHistory.defaultExpectation.paramPtrs != nil {
+		mmListPriceHistory.mock.t.Fatalf("PriceRepositoryMock.ListPriceHistory mock is already set by ExpectParams functions")
+	}
+
+	mmListPriceHistory.defaultExpectation.params = &PriceRepositoryMockListPriceHistoryParams{ctx, filter}
+	mmListPriceHistory.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListPriceHistory.expectations {
+		if minimock.Equal(e.params, mmListPriceHistory.defaultExpectation.params) {
+			mmListPriceHistory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPriceHistory.defaultExpectation.params)
+		}
+	}
+
+	return mmListPriceHistory
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PriceRepository.ListPriceHistory
+func (mmListPriceHistory *mPriceRepositoryMockListPriceHistory) ExpectCtxParam1(ctx context.Context) *mPriceRepositoryMockListPriceHistory {
+	if mmListPriceHistory.mock.funcListPriceHistory != nil {
+		mmListPriceHistory.mock.t.Fatalf("PriceRepositoryMock.ListPriceHistory mock is already set by Set")
+	}
+
+	if mmListPriceHistory.defaultExpectation == nil {
+		mmListPriceHistory.defaultExpectation = &PriceRepositoryMockListPriceHistoryExpectation{}
+	}
+
+	if mmListPriceHistory.defaultExpectation.params != nil {
+		mmListPriceHistory.mock.t.Fatalf("PriceRepositoryMock.ListPriceHistory mock is already set by Expect")
+	}
+
+	if mmListPriceHistory.defaultExpectation.paramPtrs == nil {
+		mmListPriceHistory.defaultExpectation.paramPtrs = &PriceRepositoryMockListPriceHistoryParamPtrs{}
+	}
+	mmListPriceHistory.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListPriceHistory.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListPriceHistory
+}
+
+// ExpectFilterParam2 sets up expected param filter for PriceRepository.ListPriceHistory
+func (mmListPriceHistory *mPriceRepositoryMockListPriceHistory) ExpectFilterParam2(filter domain.PriceHistoryFilter) *mPriceRepositoryMockListPriceHistory {
+	if mmListPriceHistory.mock.funcListPriceHistory != nil {
+		mmListPriceHistory.mock.t.Fatalf("PriceRepositoryMock.ListPriceHistory mock is already set by Set")
+	}
+
+	if mmListPriceHistory.defaultExpectation == nil {
+		mmListPriceHistory.defaultExpectation = &PriceRepositoryMockListPriceHistoryExpectation{}
+	}
+
+	if mmListPriceHistory.defaultExpectation.params != nil {
+		mmListPriceHistory.mock.t.Fatalf("PriceRepositoryMock.ListPriceHistory mock is already set by Expect")
+	}
+
+	if mmListPriceHistory.defaultExpectation.paramPtrs == nil {
+		mmListPriceHistory.defaultExpectation.paramPtrs = &PriceRepositoryMockListPriceHistoryParamPtrs{}
+	}
+	mmListPriceHistory.defaultExpectation.paramPtrs.filter = &filter
+	mmListPriceHistory.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListPriceHistory
+}
+
+// Inspect accepts an inspector function that has same arguments as the PriceRepository.ListPriceHistory
+func (mmListPriceHistory *mPriceRepositoryMockListPriceHistory) Inspect(f func(ctx context.Context, filter domain.PriceHistoryFilter)) *mPriceRepositoryMockListPriceHistory {
+	if mmListPriceHistory.mock.inspectFuncListPriceHistory != nil {
+		mmListPriceHistory.mock.t.Fatalf("Inspect function is already set for PriceRepositoryMock.ListPriceHistory")
+	}
+
+	mmListPriceHistory.mock.inspectFuncListPriceHistory = f
+
+	return mmListPriceHistory
+}
+
+// Return sets up results that will be returned by PriceRepository.ListPriceHistory
+func (mmListPriceHistory *mPriceRepositoryMockListPriceHistory) Return(pa1 []domain.PriceHistoryEntry, err error) *PriceRepositoryMock {
+	if mmListPriceHistory.mock.funcListPriceHistory != nil {
+		mmListPriceHistory.mock.t.Fatalf("PriceRepositoryMock.ListPriceHistory mock is already set by Set")
+	}
+
+	if mmListPriceHistory.defaultExpectation == nil {
+		mmListPriceHistory.defaultExpectation = &PriceRepositoryMockListPriceHistoryExpectation{mock: mmListPriceHistory.mock}
+	}
+	mmListPriceHistory.defaultExpectation.results = &PriceRepositoryMockListPriceHistoryResults{pa1, err}
+	mmListPriceHistory.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListPriceHistory.mock
+}
+
+// Set uses given function f to mock the PriceRepository.ListPriceHistory method
+func (mmListPriceHistory *mPriceRepositoryMockListPriceHistory) Set(f func(ctx context.Context, filter domain.PriceHistoryFilter) (pa1 []domain.PriceHistoryEntry, err error)) *PriceRepositoryMock {
+	if mmListPriceHistory.defaultExpectation != nil {
+		mmListPriceHistory.mock.t.Fatalf("Default expectation is already set for the PriceRepository.ListPriceHistory method")
+	}
+
+	if len(mmListPriceHistory.expectations) > 0 {
+		mmListPriceHistory.mock.t.Fatalf("Some expectations are already set for the PriceRepository.ListPriceHistory method")
+	}
+
+	mmListPriceHistory.mock.funcListPriceHistory = f
+	mmListPriceHistory.mock.funcListPriceHistoryOrigin = minimock.CallerInfo(1)
+	return mmListPriceHistory.mock
+}
+
+// When sets expectation for the PriceRepository.ListPriceHistory which will trigger the result defined by the following
+// Then helper
+func (mmListPriceHistory *mPriceRepositoryMockListPriceHistory) When(ctx context.Context, filter domain.PriceHistoryFilter) *PriceRepositoryMockListPriceHistoryExpectation {
+	if mmListPriceHistory.mock.funcListPriceHistory != nil {
+		mmListPriceHistory.mock.t.Fatalf("PriceRepositoryMock.ListPriceHistory mock is already set by Set")
+	}
+
+	expectation := &PriceRepositoryMockListPriceHistoryExpectation{
+		mock:               mmListPriceHistory.mock,
+		params:             &PriceRepositoryMockListPriceHistoryParams{ctx, filter},
+		expectationOrigins: PriceRepositoryMockListPriceHistoryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListPriceHistory.expectations = append(mmListPriceHistory.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PriceRepository.ListPriceHistory return parameters for the expectation previously defined by the When method
+func (e *PriceRepositoryMockListPriceHistoryExpectation) Then(pa1 []domain.PriceHistoryEntry, err error) *PriceRepositoryMock {
+	e.results = &PriceRepositoryMockListPriceHistoryResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times PriceRepository.ListPriceHistory should be invoked
+func (mmListPriceHistory *mPriceRepositoryMockListPriceHistory) Times(n uint64) *mPriceRepositoryMockListPriceHistory {
+	if n == 0 {
+		mmListPriceHistory.mock.t.Fatalf("Times of PriceRepositoryMock.ListPriceHistory mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPriceHistory.expectedInvocations, n)
+	mmListPriceHistory.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListPriceHistory
+}
+
+func (mmListPriceHistory *mPriceRepositoryMockListPriceHistory) invocationsDone() bool {
+	if len(mmListPriceHistory.expectations) == 0 && mmListPriceHistory.defaultExpectation == nil && mmListPriceHistory.mock.funcListPriceHistory == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPriceHistory.mock.afterListPriceHistoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPriceHistory.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPriceHistory implements mm_stocks.PriceRepository
+func (mmListPriceHistory *PriceRepositoryMock) ListPriceHistory(ctx context.Context, filter domain.PriceHistoryFilter) (pa1 []domain.PriceHistoryEntry, err error) {
+	mm_atomic.AddUint64(&mmListPriceHistory.beforeListPriceHistoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPriceHistory.afterListPriceHistoryCounter, 1)
+
+	mmListPriceHistory.t.Helper()
+
+	if mmListPriceHistory.inspectFuncListPriceHistory != nil {
+		mmListPriceHistory.inspectFuncListPriceHistory(ctx, filter)
+	}
+
+	mm_params := PriceRepositoryMockListPriceHistoryParams{ctx, filter}
+
+	// Record call args
+	mmListPriceHistory.ListPriceHistoryMock.mutex.Lock()
+	mmListPriceHistory.ListPriceHistoryMock.callArgs = append(mmListPriceHistory.ListPriceHistoryMock.callArgs, &mm_params)
+	mmListPriceHistory.ListPriceHistoryMock.mutex.Unlock()
+
+	for _, e := range mmListPriceHistory.ListPriceHistoryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmListPriceHistory.ListPriceHistoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPriceHistory.ListPriceHistoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPriceHistory.ListPriceHistoryMock.defaultExpectation.params
+		mm_want_ptrs := mmListPriceHistory.ListPriceHistoryMock.defaultExpectation.paramPtrs
+
+		mm_got := PriceRepositoryMockListPriceHistoryParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPriceHistory.t.Errorf("PriceRepositoryMock.ListPriceHistory got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPriceHistory.ListPriceHistoryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListPriceHistory.t.Errorf("PriceRepositoryMock.ListPriceHistory got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPriceHistory.ListPriceHistoryMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPriceHistory.t.Errorf("PriceRepositoryMock.ListPriceHistory got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListPriceHistory.ListPriceHistoryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPriceHistory.ListPriceHistoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPriceHistory.t.Fatal("No results are set for the PriceRepositoryMock.ListPriceHistory")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmListPriceHistory.funcListPriceHistory != nil {
+		return mmListPriceHistory.funcListPriceHistory(ctx, filter)
+	}
+	mmListPriceHistory.t.Fatalf("Unexpected call to PriceRepositoryMock.ListPriceHistory. %v %v", ctx, filter)
+	return
+}
+
+// ListPriceHistoryAfterCounter returns a count of finished PriceRepositoryMock.ListPriceHistory invocations
+func (mmListPriceHistory *PriceRepositoryMock) ListPriceHistoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPriceHistory.afterListPriceHistoryCounter)
+}
+
+// ListPriceHistoryBeforeCounter returns a count of PriceRepositoryMock.ListPriceHistory invocations
+func (mmListPriceHistory *PriceRepositoryMock) ListPriceHistoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPriceHistory.beforeListPriceHistoryCounter)
+}
+
+// Calls returns a list of arguments used in each call to PriceRepositoryMock.ListPriceHistory.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPriceHistory *mPriceRepositoryMockListPriceHistory) Calls() []*PriceRepositoryMockListPriceHistoryParams {
+	mmListPriceHistory.mutex.RLock()
+
+	argCopy := make([]*PriceRepositoryMockListPriceHistoryParams, len(mmListPriceHistory.callArgs))
+	copy(argCopy, mmListPriceHistory.callArgs)
+
+	mmListPriceHistory.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPriceHistoryDone returns true if the count of the ListPriceHistory invocations corresponds
+// the number of defined expectations
+func (m *PriceRepositoryMock) MinimockListPriceHistoryDone() bool {
+	if m.ListPriceHistoryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPriceHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPriceHistoryMock.invocationsDone()
+}
+
+// MinimockListPriceHistoryInspect logs each unmet expectation
+func (m *PriceRepositoryMock) MinimockListPriceHistoryInspect() {
+	for _, e := range m.ListPriceHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PriceRepositoryMock.ListPriceHistory at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListPriceHistoryCounter := mm_atomic.LoadUint64(&m.afterListPriceHistoryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPriceHistoryMock.defaultExpectation != nil && afterListPriceHistoryCounter < 1 {
+		if m.ListPriceHistoryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PriceRepositoryMock.ListPriceHistory at\n%s", m.ListPriceHistoryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PriceRepositoryMock.ListPriceHistory at\n%s with params: %#v", m.ListPriceHistoryMock.defaultExpectation.expectationOrigins.origin, *m.ListPriceHistoryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPriceHistory != nil && afterListPriceHistoryCounter < 1 {
+		m.t.Errorf("Expected call to PriceRepositoryMock.ListPriceHistory at\n%s", m.funcListPriceHistoryOrigin)
+	}
+
+	if !m.ListPriceHistoryMock.invocationsDone() && afterListPriceHistoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to PriceRepositoryMock.ListPriceHistory at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPriceHistoryMock.expectedInvocations), m.ListPriceHistoryMock.expectedInvocationsOrigin, afterListPriceHistoryCounter)
+	}
+}
+
+type mPriceRepositoryMockListPriceTiersOfStockItem struct {
+	optional           bool
+	mock               *PriceRepositoryMock
+	defaultExpectation *PriceRepositoryMockListPriceTiersOfStockItemExpectation
+	expectations       []*PriceRepositoryMockListPriceTiersOfStockItemExpectation
+
+	callArgs []*PriceRepositoryMockListPriceTiersOfStockItemParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PriceRepositoryMockListPriceTiersOfStockItemExpectation specifies expectation struct of the PriceRepository.ListPriceTiersOfStockItem
+type PriceRepositoryMockListPriceTiersOfStockItemExpectation struct {
+	mock               *PriceRepositoryMock
+	params             *PriceRepositoryMockListPriceTiersOfStockItemParams
+	paramPtrs          *PriceRepositoryMockListPriceTiersOfStockItemParamPtrs
+	expectationOrigins PriceRepositoryMockListPriceTiersOfStockItemExpectationOrigins
+	results            *PriceRepositoryMockListPriceTiersOfStockItemResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PriceRepositoryMockListPriceTiersOfStockItemParams contains parameters of the PriceRepository.ListPriceTiersOfStockItem
+type PriceRepositoryMockListPriceTiersOfStockItemParams struct {
+	ctx      context.Context
+	userID   domain.UserID
+	skuID    domain.SKUID
+	location string
+}
+
+// PriceRepositoryMockListPriceTiersOfStockItemParamPtrs contains pointers to parameters of the PriceRepository.ListPriceTiersOfStockItem
+type PriceRepositoryMockListPriceTiersOfStockItemParamPtrs struct {
+	ctx      *context.Context
+	userID   *domain.UserID
+	skuID    *domain.SKUID
+	location *string
+}
+
+// PriceRepositoryMockListPriceTiersOfStockItemResults contains results of the PriceRepository.ListPriceTiersOfStockItem
+type PriceRepositoryMockListPriceTiersOfStockItemResults struct {
+	pa1 []domain.PriceTier
+	err error
+}
+
+// PriceRepositoryMockListPriceTiersOfStockItemOrigins contains origins of expectations of the PriceRepository.ListPriceTiersOfStockItem
+type PriceRepositoryMockListPriceTiersOfStockItemExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originSkuID    string
+	originLocation string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPriceTiersOfStockItem *mPriceRepositoryMockListPriceTiersOfStockItem) Optional() *mPriceRepositoryMockListPriceTiersOfStockItem {
+	mmListPriceTiersOfStockItem.optional = true
+	return mmListPriceTiersOfStockItem
+}
+
+// Expect sets up expected params for PriceRepository.ListPriceTiersOfStockItem
+func (mmListPriceTiersOfStockItem *mPriceRepositoryMockListPriceTiersOfStockItem) Expect(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) *mPriceRepositoryMockListPriceTiersOfStockItem {
+	if mmListPriceTiersOfStockItem.mock.funcListPriceTiersOfStockItem != nil {
+		mmListPriceTiersOfStockItem.mock.t.Fatalf("PriceRepositoryMock.ListPriceTiersOfStockItem mock is already set by Set")
+	}
+
+	if mmListPriceTiersOfStockItem.defaultExpectation == nil {
+		mmListPriceTiersOfStockItem.defaultExpectation = &PriceRepositoryMockListPriceTiersOfStockItemExpectation{}
+	}
+
+	if mmListPriceTiersOfStockItem.defaultExpectation.paramPtrs != nil {
+		mmListPriceTiersOfStockItem.mock.t.Fatalf("PriceRepositoryMock.ListPriceTiersOfStockItem mock is already set by ExpectParams functions")
+	}
+
+	mmListPriceTiersOfStockItem.defaultExpectation.params = &PriceRepositoryMockListPriceTiersOfStockItemParams{ctx, userID, skuID, location}
+	mmListPriceTiersOfStockItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListPriceTiersOfStockItem.expectations {
+		if minimock.Equal(e.params, mmListPriceTiersOfStockItem.defaultExpectation.params) {
+			mmListPriceTiersOfStockItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPriceTiersOfStockItem.defaultExpectation.params)
+		}
+	}
+
+	return mmListPriceTiersOfStockItem
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PriceRepository.ListPriceTiersOfStockItem
+func (mmListPriceTiersOfStockItem *mPriceRepositoryMockListPriceTiersOfStockItem) ExpectCtxParam1(ctx context.Context) *mPriceRepositoryMockListPriceTiersOfStockItem {
+	if mmListPriceTiersOfStockItem.mock.funcListPriceTiersOfStockItem != nil {
+		mmListPriceTiersOfStockItem.mock.t.Fatalf("PriceRepositoryMock.ListPriceTiersOfStockItem mock is already set by Set")
+	}
+
+	if mmListPriceTiersOfStockItem.defaultExpectation == nil {
+		mmListPriceTiersOfStockItem.defaultExpectation = &PriceRepositoryMockListPriceTiersOfStockItemExpectation{}
+	}
+
+	if mmListPriceTiersOfStockItem.defaultExpectation.params != nil {
+		mmListPriceTiersOfStockItem.mock.t.Fatalf("PriceRepositoryMock.ListPriceTiersOfStockItem mock is already set by Expect")
+	}
+
+	if mmListPriceTiersOfStockItem.defaultExpectation.paramPtrs == nil {
+		mmListPriceTiersOfStockItem.defaultExpectation.paramPtrs = &PriceRepositoryMockListPriceTiersOfStockItemParamPtrs{}
+	}
+	mmListPriceTiersOfStockItem.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListPriceTiersOfStockItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListPriceTiersOfStockItem
+}
+
+// ExpectUserIDParam2 sets up expected param userID for PriceRepository.ListPriceTiersOfStockItem
+func (mmListPriceTiersOfStockItem *mPriceRepositoryMockListPriceTiersOfStockItem) ExpectUserIDParam2(userID domain.UserID) *mPriceRepositoryMockListPriceTiersOfStockItem {
+	if mmListPriceTiersOfStockItem.mock.funcListPriceTiersOfStockItem != nil {
+		mmListPriceTiersOfStockItem.mock.t.Fatalf("PriceRepositoryMock.ListPriceTiersOfStockItem mock is already set by Set")
+	}
+
+	if mmListPriceTiersOfStockItem.defaultExpectation == nil {
+		mmListPriceTiersOfStockItem.defaultExpectation = &PriceRepositoryMockListPriceTiersOfStockItemExpectation{}
+	}
+
+	if mmListPriceTiersOfStockItem.defaultExpectation.params != nil {
+		mmListPriceTiersOfStockItem.mock.t.Fatalf("PriceRepositoryMock.ListPriceTiersOfStockItem mock is already set by Expect")
+	}
+
+	if mmListPriceTiersOfStockItem.defaultExpectation.paramPtrs == nil {
+		mmListPriceTiersOfStockItem.defaultExpectation.paramPtrs = &PriceRepositoryMockListPriceTiersOfStockItemParamPtrs{}
+	}
+	mmListPriceTiersOfStockItem.defaultExpectation.paramPtrs.userID = &userID
+	mmListPriceTiersOfStockItem.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListPriceTiersOfStockItem
+}
+
+// ExpectSkuIDParam3 sets up expected param skuID for PriceRepository.ListPriceTiersOfStockItem
+func (mmListPriceTiersOfStockItem *mPriceRepositoryMockListPriceTiersOfStockItem) ExpectSkuIDParam3(skuID domain.SKUID) *mPriceRepositoryMockListPriceTiersOfStockItem {
+	if mmListPriceTiersOfStockItem.mock.funcListPriceTiersOfStockItem != nil {
+		mmListPriceTiersOfStockItem.mock.t.Fatalf("PriceRepositoryMock.ListPriceTiersOfStockItem mock is already set by Set")
+	}
+
+	if mmListPriceTiersOfStockItem.defaultExpectation == nil {
+		mmListPriceTiersOfStockItem.defaultExpectation = &PriceRepositoryMockListPriceTiersOfStockItemExpectation{}
+	}
+
+	if mmListPriceTiersOfStockItem.defaultExpectation.params != nil {
+		mmListPriceTiersOfStockItem.mock.t.Fatalf("PriceRepositoryMock.ListPriceTiersOfStockItem mock is already set by Expect")
+	}
+
+	if mmListPriceTiersOfStockItem.defaultExpectation.paramPtrs == nil {
+		mmListPriceTiersOfStockItem.defaultExpectation.paramPtrs = &PriceRepositoryMockListPriceTiersOfStockItemParamPtrs{}
+	}
+	mmListPriceTiersOfStockItem.defaultExpectation.paramPtrs.skuID = &skuID
+	mmListPriceTiersOfStockItem.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmListPriceTiersOfStockItem
+}
+
+// ExpectLocationParam4 sets up expected param location for PriceRepository.ListPriceTiersOfStockItem
+func (mmListPriceTiersOfStockItem *mPriceRepositoryMockListPriceTiersOfStockItem) ExpectLocationParam4(location string) *mPriceRepositoryMockListPriceTiersOfStockItem {
+	if mmListPriceTiersOfStockItem.mock.funcListPriceTiersOfStockItem != nil {
+		mmListPriceTiersOfStockItem.mock.t.Fatalf("PriceRepositoryMock.ListPriceTiersOfStockItem mock is already set by Set")
+	}
+
+	if mmListPriceTiersOfStockItem.defaultExpectation == nil {
+		mmListPriceTiersOfStockItem.defaultExpectation = &PriceRepositoryMockListPriceTiersOfStockItemExpectation{}
+	}
+
+	if mmListPriceTiersOfStockItem.defaultExpectation.params != nil {
+		mmListPriceTiersOfStockItem.mock.t.Fatalf("PriceRepositoryMock.ListPriceTiersOfStockItem mock is already set by Expect")
+	}
+
+	if mmListPriceTiersOfStockItem.defaultExpectation.paramPtrs == nil {
+		mmListPriceTiersOfStockItem.defaultExpectation.paramPtrs = &PriceRepositoryMockListPriceTiersOfStockItemParamPtrs{}
+	}
+	mmListPriceTiersOfStockItem.defaultExpectation.paramPtrs.location = &location
+	mmListPriceTiersOfStockItem.defaultExpectation.expectationOrigins.originLocation = minimock.CallerInfo(1)
+
+	return mmListPriceTiersOfStockItem
+}
+
+// Inspect accepts an inspector function that has same arguments as the PriceRepository.ListPriceTiersOfStockItem
+func (mmListPriceTiersOfStockItem *mPriceRepositoryMockListPriceTiersOfStockItem) Inspect(f func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string)) *mPriceRepositoryMockListPriceTiersOfStockItem {
+	if mmListPriceTiersOfStockItem.mock.inspectFuncListPriceTiersOfStockItem != nil {
+		mmListPriceTiersOfStockItem.mock.t.Fatalf("Inspect function is already set for PriceRepositoryMock.ListPriceTiersOfStockItem")
+	}
+
+	mmListPriceTiersOfStockItem.mock.inspectFuncListPriceTiersOfStockItem = f
+
+	return mmListPriceTiersOfStockItem
+}
+
+// Return sets up results that will be returned by PriceRepository.ListPriceTiersOfStockItem
+func (mmListPriceTiersOfStockItem *mPriceRepositoryMockListPriceTiersOfStockItem) Return(pa1 []domain.PriceTier, err error) *PriceRepositoryMock {
+	if mmListPriceTiersOfStockItem.mock.funcListPriceTiersOfStockItem != nil {
+		mmListPriceTiersOfStockItem.mock.t.Fatalf("PriceRepositoryMock.ListPriceTiersOfStockItem mock is already set by Set")
+	}
+
+	if mmListPriceTiersOfStockItem.defaultExpectation == nil {
+		mmListPriceTiersOfStockItem.defaultExpectation = &PriceRepositoryMockListPriceTiersOfStockItemExpectation{mock: mmListPriceTiersOfStockItem.mock}
+	}
+	mmListPriceTiersOfStockItem.defaultExpectation.results = &PriceRepositoryMockListPriceTiersOfStockItemResults{pa1, err}
+	mmListPriceTiersOfStockItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListPriceTiersOfStockItem.mock
+}
+
+// Set uses given function f to mock the PriceRepository.ListPriceTiersOfStockItem method
+func (mmListPriceTiersOfStockItem *mPriceRepositoryMockListPriceTiersOfStockItem) Set(f func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) (pa1 []domain.PriceTier, err error)) *PriceRepositoryMock {
+	if mmListPriceTiersOfStockItem.defaultExpectation != nil {
+		mmListPriceTiersOfStockItem.mock.t.Fatalf("Default expectation is already set for the PriceRepository.ListPriceTiersOfStockItem method")
+	}
+
+	if len(mmListPriceTiersOfStockItem.expectations) > 0 {
+		mmListPriceTiersOfStockItem.mock.t.Fatalf("Some expectations are already set for the PriceRepository.ListPriceTiersOfStockItem method")
+	}
+
+	mmListPriceTiersOfStockItem.mock.funcListPriceTiersOfStockItem = f
+	mmListPriceTiersOfStockItem.mock.funcListPriceTiersOfStockItemOrigin = minimock.CallerInfo(1)
+	return mmListPriceTiersOfStockItem.mock
+}
+
+// When sets expectation for the PriceRepository.ListPriceTiersOfStockItem which will trigger the result defined by the following
+// Then helper
+func (mmListPriceTiersOfStockItem *mPriceRepositoryMockListPriceTiersOfStockItem) When(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) *PriceRepositoryMockListPriceTiersOfStockItemExpectation {
+	if mmListPriceTiersOfStockItem.mock.funcListPriceTiersOfStockItem != nil {
+		mmListPriceTiersOfStockItem.mock.t.Fatalf("PriceRepositoryMock.ListPriceTiersOfStockItem mock is already set by Set")
+	}
+
+	expectation := &PriceRepositoryMockListPriceTiersOfStockItemExpectation{
+		mock:               mmListPriceTiersOfStockItem.mock,
+		params:             &PriceRepositoryMockListPriceTiersOfStockItemParams{ctx, userID, skuID, location},
+		expectationOrigins: PriceRepositoryMockListPriceTiersOfStockItemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListPriceTiersOfStockItem.expectations = append(mmListPriceTiersOfStockItem.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PriceRepository.ListPriceTiersOfStockItem return parameters for the expectation previously defined by the When method
+func (e *PriceRepositoryMockListPriceTiersOfStockItemExpectation) Then(pa1 []domain.PriceTier, err error) *PriceRepositoryMock {
+	e.results = &PriceRepositoryMockListPriceTiersOfStockItemResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times PriceRepository.ListPriceTiersOfStockItem should be invoked
+func (mmListPriceTiersOfStockItem *mPriceRepositoryMockListPriceTiersOfStockItem) Times(n uint64) *mPriceRepositoryMockListPriceTiersOfStockItem {
+	if n == 0 {
+		mmListPriceTiersOfStockItem.mock.t.Fatalf("Times of PriceRepositoryMock.ListPriceTiersOfStockItem mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPriceTiersOfStockItem.expectedInvocations, n)
+	mmListPriceTiersOfStockItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListPriceTiersOfStockItem
+}
+
+func (mmListPriceTiersOfStockItem *mPriceRepositoryMockListPriceTiersOfStockItem) invocationsDone() bool {
+	if len(mmListPriceTiersOfStockItem.expectations) == 0 && mmListPriceTiersOfStockItem.defaultExpectation == nil && mmListPriceTiersOfStockItem.mock.funcListPriceTiersOfStockItem == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPriceTiersOfStockItem.mock.afterListPriceTiersOfStockItemCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPriceTiersOfStockItem.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPriceTiersOfStockItem implements mm_stocks.PriceRepository
+func (mmListPriceTiersOfStockItem *PriceRepositoryMock) ListPriceTiersOfStockItem(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) (pa1 []domain.PriceTier, err error) {
+	mm_atomic.AddUint64(&mmListPriceTiersOfStockItem.beforeListPriceTiersOfStockItemCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPriceTiersOfStockItem.afterListPriceTiersOfStockItemCounter, 1)
+
+	mmListPriceTiersOfStockItem.t.Helper()
+
+	if mmListPriceTiersOfStockItem.inspectFuncListPriceTiersOfStockItem != nil {
+		mmListPriceTiersOfStockItem.inspectFuncListPriceTiersOfStockItem(ctx, userID, skuID, location)
+	}
+
+	mm_params := PriceRepositoryMockListPriceTiersOfStockItemParams{ctx, userID, skuID, location}
+
+	// Record call args
+	mmListPriceTiersOfStockItem.ListPriceTiersOfStockItemMock.mutex.Lock()
+	mmListPriceTiersOfStockItem.ListPriceTiersOfStockItemMock.callArgs = append(mmListPriceTiersOfStockItem.ListPriceTiersOfStockItemMock.callArgs, &mm_params)
+	mmListPriceTiersOfStockItem.ListPriceTiersOfStockItemMock.mutex.Unlock()
+
+	for _, e := range mmListPriceTiersOfStockItem.ListPriceTiersOfStockItemMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmListPriceTiersOfStockItem.ListPriceTiersOfStockItemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPriceTiersOfStockItem.ListPriceTiersOfStockItemMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPriceTiersOfStockItem.ListPriceTiersOfStockItemMock.defaultExpectation.params
+		mm_want_ptrs := mmListPriceTiersOfStockItem.ListPriceTiersOfStockItemMock.defaultExpectation.paramPtrs
+
+		mm_got := PriceRepositoryMockListPriceTiersOfStockItemParams{ctx, userID, skuID, location}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPriceTiersOfStockItem.t.Errorf("PriceRepositoryMock.ListPriceTiersOfStockItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPriceTiersOfStockItem.ListPriceTiersOfStockItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListPriceTiersOfStockItem.t.Errorf("PriceRepositoryMock.ListPriceTiersOfStockItem got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPriceTiersOfStockItem.ListPriceTiersOfStockItemMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmListPriceTiersOfStockItem.t.Errorf("PriceRepositoryMock.ListPriceTiersOfStockItem got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPriceTiersOfStockItem.ListPriceTiersOfStockItemMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.location != nil && !minimock.Equal(*mm_want_ptrs.location, mm_got.location) {
+				mmListPriceTiersOfStockItem.t.Errorf("PriceRepositoryMock.ListPriceTiersOfStockItem got unexpected parameter location, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPriceTiersOfStockItem.ListPriceTiersOfStockItemMock.defaultExpectation.expectationOrigins.originLocation, *mm_want_ptrs.location, mm_got.location, minimock.Diff(*mm_want_ptrs.location, mm_got.location))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPriceTiersOfStockItem.t.Errorf("PriceRepositoryMock.ListPriceTiersOfStockItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListPriceTiersOfStockItem.ListPriceTiersOfStockItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPriceTiersOfStockItem.ListPriceTiersOfStockItemMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPriceTiersOfStockItem.t.Fatal("No results are set for the PriceRepositoryMock.ListPriceTiersOfStockItem")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmListPriceTiersOfStockItem.funcListPriceTiersOfStockItem != nil {
+		return mmListPriceTiersOfStockItem.funcListPriceTiersOfStockItem(ctx, userID, skuID, location)
+	}
+	mmListPriceTiersOfStockItem.t.Fatalf("Unexpected call to PriceRepositoryMock.ListPriceTiersOfStockItem. %v %v %v %v", ctx, userID, skuID, location)
+	return
+}
+
+// ListPriceTiersOfStockItemAfterCounter returns a count of finished PriceRepositoryMock.ListPriceTiersOfStockItem invocations
+func (mmListPriceTiersOfStockItem *PriceRepositoryMock) ListPriceTiersOfStockItemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPriceTiersOfStockItem.afterListPriceTiersOfStockItemCounter)
+}
+
+// ListPriceTiersOfStockItemBeforeCounter returns a count of PriceRepositoryMock.ListPriceTiersOfStockItem invocations
+func (mmListPriceTiersOfStockItem *PriceRepositoryMock) ListPriceTiersOfStockItemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPriceTiersOfStockItem.beforeListPriceTiersOfStockItemCounter)
+}
+
+// Calls returns a list of arguments used in each call to PriceRepositoryMock.ListPriceTiersOfStockItem.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPriceTiersOfStockItem *mPriceRepositoryMockListPriceTiersOfStockItem) Calls() []*PriceRepositoryMockListPriceTiersOfStockItemParams {
+	mmListPriceTiersOfStockItem.mutex.RLock()
+
+	argCopy := make([]*PriceRepositoryMockListPriceTiersOfStockItemParams, len(mmListPriceTiersOfStockItem.callArgs))
+	copy(argCopy, mmListPriceTiersOfStockItem.callArgs)
+
+	mmListPriceTiersOfStockItem.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPriceTiersOfStockItemDone returns true if the count of the ListPriceTiersOfStockItem invocations corresponds
+// the number of defined expectations
+func (m *PriceRepositoryMock) MinimockListPriceTiersOfStockItemDone() bool {
+	if m.ListPriceTiersOfStockItemMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPriceTiersOfStockItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPriceTiersOfStockItemMock.invocationsDone()
+}
+
+// MinimockListPriceTiersOfStockItemInspect logs each unmet expectation
+func (m *PriceRepositoryMock) MinimockListPriceTiersOfStockItemInspect() {
+	for _, e := range m.ListPriceTiersOfStockItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PriceRepositoryMock.ListPriceTiersOfStockItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListPriceTiersOfStockItemCounter := mm_atomic.LoadUint64(&m.afterListPriceTiersOfStockItemCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPriceTiersOfStockItemMock.defaultExpectation != nil && afterListPriceTiersOfStockItemCounter < 1 {
+		if m.ListPriceTiersOfStockItemMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PriceRepositoryMock.ListPriceTiersOfStockItem at\n%s", m.ListPriceTiersOfStockItemMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PriceRepositoryMock.ListPriceTiersOfStockItem at\n%s with params: %#v", m.ListPriceTiersOfStockItemMock.defaultExpectation.expectationOrigins.origin, *m.ListPriceTiersOfStockItemMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPriceTiersOfStockItem != nil && afterListPriceTiersOfStockItemCounter < 1 {
+		m.t.Errorf("Expected call to PriceRepositoryMock.ListPriceTiersOfStockItem at\n%s", m.funcListPriceTiersOfStockItemOrigin)
+	}
+
+	if !m.ListPriceTiersOfStockItemMock.invocationsDone() && afterListPriceTiersOfStockItemCounter > 0 {
+		m.t.Errorf("Expected %d calls to PriceRepositoryMock.ListPriceTiersOfStockItem at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPriceTiersOfStockItemMock.expectedInvocations), m.ListPriceTiersOfStockItemMock.expectedInvocationsOrigin, afterListPriceTiersOfStockItemCounter)
+	}
+}
+
+type mPriceRepositoryMockReplacePriceTiers struct {
+	optional           bool
+	mock               *PriceRepositoryMock
+	defaultExpectation *PriceRepositoryMockReplacePriceTiersExpectation
+	expectations       []*PriceRepositoryMockReplacePriceTiersExpectation
+
+	callArgs []*PriceRepositoryMockReplacePriceTiersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PriceRepositoryMockReplacePriceTiersExpectation specifies expectation struct of the PriceRepository.ReplacePriceTiers
+type PriceRepositoryMockReplacePriceTiersExpectation struct {
+	mock               *PriceRepositoryMock
+	params             *PriceRepositoryMockReplacePriceTiersParams
+	paramPtrs          *PriceRepositoryMockReplacePriceTiersParamPtrs
+	expectationOrigins PriceRepositoryMockReplacePriceTiersExpectationOrigins
+	results            *PriceRepositoryMockReplacePriceTiersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PriceRepositoryMockReplacePriceTiersParams contains parameters of the PriceRepository.ReplacePriceTiers
+type PriceRepositoryMockReplacePriceTiersParams struct {
+	ctx       context.Context
+	priceList domain.PriceList
+}
+
+// PriceRepositoryMockReplacePriceTiersParamPtrs contains pointers to parameters of the PriceRepository.ReplacePriceTiers
+type PriceRepositoryMockReplacePriceTiersParamPtrs struct {
+	ctx       *context.Context
+	priceList *domain.PriceList
+}
+
+// PriceRepositoryMockReplacePriceTiersResults contains results of the PriceRepository.ReplacePriceTiers
+type PriceRepositoryMockReplacePriceTiersResults struct {
+	err error
+}
+
+// PriceRepositoryMockReplacePriceTiersOrigins contains origins of expectations of the PriceRepository.ReplacePriceTiers
+type PriceRepositoryMockReplacePriceTiersExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originPriceList string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReplacePriceTiers *mPriceRepositoryMockReplacePriceTiers) Optional() *mPriceRepositoryMockReplacePriceTiers {
+	mmReplacePriceTiers.optional = true
+	return mmReplacePriceTiers
+}
+
+// Expect sets up expected params for PriceRepository.ReplacePriceTiers
+func (mmReplacePriceTiers *mPriceRepositoryMockReplacePriceTiers) Expect(ctx context.Context, priceList domain.PriceList) *mPriceRepositoryMockReplacePriceTiers {
+	if mmReplacePriceTiers.mock.funcReplacePriceTiers != nil {
+		mmReplacePriceTiers.mock.t.Fatalf("PriceRepositoryMock.ReplacePriceTiers mock is already set by Set")
+	}
+
+	if mmReplacePriceTiers.defaultExpectation == nil {
+		mmReplacePriceTiers.defaultExpectation = &PriceRepositoryMockReplacePriceTiersExpectation{}
+	}
+
+	if mmReplacePriceTiers.defaultExpectation.paramPtrs != nil {
+		mmReplacePriceTiers.mock.t.Fatalf("PriceRepositoryMock.ReplacePriceTiers mock is already set by ExpectParams functions")
+	}
+
+	mmReplacePriceTiers.defaultExpectation.params = &PriceRepositoryMockReplacePriceTiersParams{ctx, priceList}
+	mmReplacePriceTiers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReplacePriceTiers.expectations {
+		if minimock.Equal(e.params, mmReplacePriceTiers.defaultExpectation.params) {
+			mmReplacePriceTiers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReplacePriceTiers.defaultExpectation.params)
+		}
+	}
+
+	return mmReplacePriceTiers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PriceRepository.ReplacePriceTiers
+func (mmReplacePriceTiers *mPriceRepositoryMockReplacePriceTiers) ExpectCtxParam1(ctx context.Context) *mPriceRepositoryMockReplacePriceTiers {
+	if mmReplacePriceTiers.mock.funcReplacePriceTiers != nil {
+		mmReplacePriceTiers.mock.t.Fatalf("PriceRepositoryMock.ReplacePriceTiers mock is already set by Set")
+	}
+
+	if mmReplacePriceTiers.defaultExpectation == nil {
+		mmReplacePriceTiers.defaultExpectation = &PriceRepositoryMockReplacePriceTiersExpectation{}
+	}
+
+	if mmReplacePriceTiers.defaultExpectation.params != nil {
+		mmReplacePriceTiers.mock.t.Fatalf("PriceRepositoryMock.ReplacePriceTiers mock is already set by Expect")
+	}
+
+	if mmReplacePriceTiers.defaultExpectation.paramPtrs == nil {
+		mmReplacePriceTiers.defaultExpectation.paramPtrs = &PriceRepositoryMockReplacePriceTiersParamPtrs{}
+	}
+	mmReplacePriceTiers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReplacePriceTiers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReplacePriceTiers
+}
+
+// ExpectPriceListParam2 sets up expected param priceList for PriceRepository.ReplacePriceTiers
+func (mmReplacePriceTiers *mPriceRepositoryMockReplacePriceTiers) ExpectPriceListParam2(priceList domain.PriceList) *mPriceRepositoryMockReplacePriceTiers {
+	if mmReplacePriceTiers.mock.funcReplacePriceTiers != nil {
+		mmReplacePriceTiers.mock.t.Fatalf("PriceRepositoryMock.ReplacePriceTiers mock is already set by Set")
+	}
+
+	if mmReplacePriceTiers.defaultExpectation == nil {
+		mmReplacePriceTiers.defaultExpectation = &PriceRepositoryMockReplacePriceTiersExpectation{}
+	}
+
+	if mmReplacePriceTiers.defaultExpectation.params != nil {
+		mmReplacePriceTiers.mock.t.Fatalf("PriceRepositoryMock.ReplacePriceTiers mock is already set by Expect")
+	}
+
+	if mmReplacePriceTiers.defaultExpectation.paramPtrs == nil {
+		mmReplacePriceTiers.defaultExpectation.paramPtrs = &PriceRepositoryMockReplacePriceTiersParamPtrs{}
+	}
+	mmReplacePriceTiers.defaultExpectation.paramPtrs.priceList = &priceList
+	mmReplacePriceTiers.defaultExpectation.expectationOrigins.originPriceList = minimock.CallerInfo(1)
+
+	return mmReplacePriceTiers
+}
+
+// Inspect accepts an inspector function that has same arguments as the PriceRepository.ReplacePriceTiers
+func (mmReplacePriceTiers *mPriceRepositoryMockReplacePriceTiers) Inspect(f func(ctx context.Context, priceList domain.PriceList)) *mPriceRepositoryMockReplacePriceTiers {
+	if mmReplacePriceTiers.mock.inspectFuncReplacePriceTiers != nil {
+		mmReplacePriceTiers.mock.t.Fatalf("Inspect function is already set for PriceRepositoryMock.ReplacePriceTiers")
+	}
+
+	mmReplacePriceTiers.mock.inspectFuncReplacePriceTiers = f
+
+	return mmReplacePriceTiers
+}
+
+// Return sets up results that will be returned by PriceRepository.ReplacePriceTiers
+func (mmReplacePriceTiers *mPriceRepositoryMockReplacePriceTiers) Return(err error) *PriceRepositoryMock {
+	if mmReplacePriceTiers.mock.funcReplacePriceTiers != nil {
+		mmReplacePriceTiers.mock.t.Fatalf("PriceRepositoryMock.ReplacePriceTiers mock is already set by Set")
+	}
+
+	if mmReplacePriceTiers.defaultExpectation == nil {
+		mmReplacePriceTiers.defaultExpectation = &PriceRepositoryMockReplacePriceTiersExpectation{mock: mmReplacePriceTiers.mock}
+	}
+	mmReplacePriceTiers.defaultExpectation.results = &PriceRepositoryMockReplacePriceTiersResults{err}
+	mmReplacePriceTiers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReplacePriceTiers.mock
+}
+
+// Set uses given function f to mock the PriceRepository.ReplacePriceTiers method
+func (mmReplacePriceTiers *mPriceRepositoryMockReplacePriceTiers) Set(f func(ctx context.Context, priceList domain.PriceList) (err error)) *PriceRepositoryMock {
+	if mmReplacePriceTiers.defaultExpectation != nil {
+		mmReplacePriceTiers.mock.t.Fatalf("Default expectation is already set for the PriceRepository.ReplacePriceTiers method")
+	}
+
+	if len(mmReplacePriceTiers.expectations) > 0 {
+		mmReplacePriceTiers.mock.t.Fatalf("Some expectations are already set for the PriceRepository.ReplacePriceTiers method")
+	}
+
+	mmReplacePriceTiers.mock.funcReplacePriceTiers = f
+	mmReplacePriceTiers.mock.funcReplacePriceTiersOrigin = minimock.CallerInfo(1)
+	return mmReplacePriceTiers.mock
+}
+
+// When sets expectation for the PriceRepository.ReplacePriceTiers which will trigger the result defined by the following
+// Then helper
+func (mmReplacePriceTiers *mPriceRepositoryMockReplacePriceTiers) When(ctx context.Context, priceList domain.PriceList) *PriceRepositoryMockReplacePriceTiersExpectation {
+	if mmReplacePriceTiers.mock.funcReplacePriceTiers != nil {
+		mmReplacePriceTiers.mock.t.Fatalf("PriceRepositoryMock.ReplacePriceTiers mock is already set by Set")
+	}
+
+	expectation := &PriceRepositoryMockReplacePriceTiersExpectation{
+		mock:               mmReplacePriceTiers.mock,
+		params:             &PriceRepositoryMockReplacePriceTiersParams{ctx, priceList},
+		expectationOrigins: PriceRepositoryMockReplacePriceTiersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReplacePriceTiers.expectations = append(mmReplacePriceTiers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PriceRepository.ReplacePriceTiers return parameters for the expectation previously defined by the When method
+func (e *PriceRepositoryMockReplacePriceTiersExpectation) Then(err error) *PriceRepositoryMock {
+	e.results = &PriceRepositoryMockReplacePriceTiersResults{err}
+	return e.mock
+}
+
+// Times sets number of times PriceRepository.ReplacePriceTiers should be invoked
+func (mmReplacePriceTiers *mPriceRepositoryMockReplacePriceTiers) Times(n uint64) *mPriceRepositoryMockReplacePriceTiers {
+	if n == 0 {
+		mmReplacePriceTiers.mock.t.Fatalf("Times of PriceRepositoryMock.ReplacePriceTiers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReplacePriceTiers.expectedInvocations, n)
+	mmReplacePriceTiers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReplacePriceTiers
+}
+
+func (mmReplacePriceTiers *mPriceRepositoryMockReplacePriceTiers) invocationsDone() bool {
+	if len(mmReplacePriceTiers.expectations) == 0 && mmReplacePriceTiers.defaultExpectation == nil && mmReplacePriceTiers.mock.funcReplacePriceTiers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReplacePriceTiers.mock.afterReplacePriceTiersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReplacePriceTiers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReplacePriceTiers implements mm_stocks.PriceRepository
+func (mmReplacePriceTiers *PriceRepositoryMock) ReplacePriceTiers(ctx context.Context, priceList domain.PriceList) (err error) {
+	mm_atomic.AddUint64(&mmReplacePriceTiers.beforeReplacePriceTiersCounter, 1)
+	defer mm_atomic.AddUint64(&mmReplacePriceTiers.afterReplacePriceTiersCounter, 1)
+
+	mmReplacePriceTiers.t.Helper()
+
+	if mmReplacePriceTiers.inspectFuncReplacePriceTiers != nil {
+		mmReplacePriceTiers.inspectFuncReplacePriceTiers(ctx, priceList)
+	}
+
+	mm_params := PriceRepositoryMockReplacePriceTiersParams{ctx, priceList}
+
+	// Record call args
+	mmReplacePriceTiers.ReplacePriceTiersMock.mutex.Lock()
+	mmReplacePriceTiers.ReplacePriceTiersMock.callArgs = append(mmReplacePriceTiers.ReplacePriceTiersMock.callArgs, &mm_params)
+	mmReplacePriceTiers.ReplacePriceTiersMock.mutex.Unlock()
+
+	for _, e := range mmReplacePriceTiers.ReplacePriceTiersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReplacePriceTiers.ReplacePriceTiersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReplacePriceTiers.ReplacePriceTiersMock.defaultExpectation.Counter, 1)
+		mm_want := mmReplacePriceTiers.ReplacePriceTiersMock.defaultExpectation.params
+		mm_want_ptrs := mmReplacePriceTiers.ReplacePriceTiersMock.defaultExpectation.paramPtrs
+
+		mm_got := PriceRepositoryMockReplacePriceTiersParams{ctx, priceList}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReplacePriceTiers.t.Errorf("PriceRepositoryMock.ReplacePriceTiers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReplacePriceTiers.ReplacePriceTiersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.priceList != nil && !minimock.Equal(*mm_want_ptrs.priceList, mm_got.priceList) {
+				mmReplacePriceTiers.t.Errorf("PriceRepositoryMock.ReplacePriceTiers got unexpected parameter priceList, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReplacePriceTiers.ReplacePriceTiersMock.defaultExpectation.expectationOrigins.originPriceList, *mm_want_ptrs.priceList, mm_got.priceList, minimock.Diff(*mm_want_ptrs.priceList, mm_got.priceList))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReplacePriceTiers.t.Errorf("PriceRepositoryMock.ReplacePriceTiers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReplacePriceTiers.ReplacePriceTiersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReplacePriceTiers.ReplacePriceTiersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReplacePriceTiers.t.Fatal("No results are set for the PriceRepositoryMock.ReplacePriceTiers")
+		}
+		return (*mm_results).err
+	}
+	if mmReplacePriceTiers.funcReplacePriceTiers != nil {
+		return mmReplacePriceTiers.funcReplacePriceTiers(ctx, priceList)
+	}
+	mmReplacePriceTiers.t.Fatalf("Unexpected call to PriceRepositoryMock.ReplacePriceTiers. %v %v", ctx, priceList)
+	return
+}
+
+// ReplacePriceTiersAfterCounter returns a count of finished PriceRepositoryMock.ReplacePriceTiers invocations
+func (mmReplacePriceTiers *PriceRepositoryMock) ReplacePriceTiersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReplacePriceTiers.afterReplacePriceTiersCounter)
+}
+
+// ReplacePriceTiersBeforeCounter returns a count of PriceRepositoryMock.ReplacePriceTiers invocations
+func (mmReplacePriceTiers *PriceRepositoryMock) ReplacePriceTiersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReplacePriceTiers.beforeReplacePriceTiersCounter)
+}
+
+// Calls returns a list of arguments used in each call to PriceRepositoryMock.ReplacePriceTiers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReplacePriceTiers *mPriceRepositoryMockReplacePriceTiers) Calls() []*PriceRepositoryMockReplacePriceTiersParams {
+	mmReplacePriceTiers.mutex.RLock()
+
+	argCopy := make([]*PriceRepositoryMockReplacePriceTiersParams, len(mmReplacePriceTiers.callArgs))
+	copy(argCopy, mmReplacePriceTiers.callArgs)
+
+	mmReplacePriceTiers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReplacePriceTiersDone returns true if the count of the ReplacePriceTiers invocations corresponds
+// the number of defined expectations
+func (m *PriceRepositoryMock) MinimockReplacePriceTiersDone() bool {
+	if m.ReplacePriceTiersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReplacePriceTiersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReplacePriceTiersMock.invocationsDone()
+}
+
+// MinimockReplacePriceTiersInspect logs each unmet expectation
+func (m *PriceRepositoryMock) MinimockReplacePriceTiersInspect() {
+	for _, e := range m.ReplacePriceTiersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PriceRepositoryMock.ReplacePriceTiers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReplacePriceTiersCounter := mm_atomic.LoadUint64(&m.afterReplacePriceTiersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReplacePriceTiersMock.defaultExpectation != nil && afterReplacePriceTiersCounter < 1 {
+		if m.ReplacePriceTiersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PriceRepositoryMock.ReplacePriceTiers at\n%s", m.ReplacePriceTiersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PriceRepositoryMock.ReplacePriceTiers at\n%s with params: %#v", m.ReplacePriceTiersMock.defaultExpectation.expectationOrigins.origin, *m.ReplacePriceTiersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReplacePriceTiers != nil && afterReplacePriceTiersCounter < 1 {
+		m.t.Errorf("Expected call to PriceRepositoryMock.ReplacePriceTiers at\n%s", m.funcReplacePriceTiersOrigin)
+	}
+
+	if !m.ReplacePriceTiersMock.invocationsDone() && afterReplacePriceTiersCounter > 0 {
+		m.t.Errorf("Expected %d calls to PriceRepositoryMock.ReplacePriceTiers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReplacePriceTiersMock.expectedInvocations), m.ReplacePriceTiersMock.expectedInvocationsOrigin, afterReplacePriceTiersCounter)
+	}
+}
+
+type mPriceRepositoryMockSaveScheduledPriceChange struct {
+	optional           bool
+	mock               *PriceRepositoryMock
+	defaultExpectation *PriceRepositoryMockSaveScheduledPriceChangeExpectation
+	expectations       []*PriceRepositoryMockSaveScheduledPriceChangeExpectation
+
+	callArgs []*PriceRepositoryMockSaveScheduledPriceChangeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PriceRepositoryMockSaveScheduledPriceChangeExpectation specifies expectation struct of the PriceRepository.SaveScheduledPriceChange
+type PriceRepositoryMockSaveScheduledPriceChangeExpectation struct {
+	mock               *PriceRepositoryMock
+	params             *PriceRepositoryMockSaveScheduledPriceChangeParams
+	paramPtrs          *PriceRepositoryMockSaveScheduledPriceChangeParamPtrs
+	expectationOrigins PriceRepositoryMockSaveScheduledPriceChangeExpectationOrigins
+	results            *PriceRepositoryMockSaveScheduledPriceChangeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PriceRepositoryMockSaveScheduledPriceChangeParams contains parameters of the PriceRepository.SaveScheduledPriceChange
+type PriceRepositoryMockSaveScheduledPriceChangeParams struct {
+	ctx         context.Context
+	priceChange domain.ScheduledPriceChange
+}
+
+// PriceRepositoryMockSaveScheduledPriceChangeParamPtrs contains pointers to parameters of the PriceRepository.SaveScheduledPriceChange
+type PriceRepositoryMockSaveScheduledPriceChangeParamPtrs struct {
+	ctx         *context.Context
+	priceChange *domain.ScheduledPriceChange
+}
+
+// PriceRepositoryMockSaveScheduledPriceChangeResults contains results of the PriceRepository.SaveScheduledPriceChange
+type PriceRepositoryMockSaveScheduledPriceChangeResults struct {
+	s1  domain.ScheduledPriceChange
+	err error
+}
+
+// PriceRepositoryMockSaveScheduledPriceChangeOrigins contains origins of expectations of the PriceRepository.SaveScheduledPriceChange
+type PriceRepositoryMockSaveScheduledPriceChangeExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originPriceChange string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSaveScheduledPriceChange *mPriceRepositoryMockSaveScheduledPriceChange) Optional() *mPriceRepositoryMockSaveScheduledPriceChange {
+	mmSaveScheduledPriceChange.optional = true
+	return mmSaveScheduledPriceChange
+}
+
+// Expect sets up expected params for PriceRepository.SaveScheduledPriceChange
+func (mmSaveScheduledPriceChange *mPriceRepositoryMockSaveScheduledPriceChange) Expect(ctx context.Context, priceChange domain.ScheduledPriceChange) *mPriceRepositoryMockSaveScheduledPriceChange {
+	if mmSaveScheduledPriceChange.mock.funcSaveScheduledPriceChange != nil {
+		mmSaveScheduledPriceChange.mock.t.Fatalf("PriceRepositoryMock.SaveScheduledPriceChange mock is already set by Set")
+	}
+
+	if mmSaveScheduledPriceChange.defaultExpectation == nil {
+		mmSaveScheduledPriceChange.defaultExpectation = &PriceRepositoryMockSaveScheduledPriceChangeExpectation{}
+	}
+
+	if mmSaveScheduledPriceChange.defaultExpectation.paramPtrs != nil {
+		mmSaveScheduledPriceChange.mock.t.Fatalf("PriceRepositoryMock.SaveScheduledPriceChange mock is already set by ExpectParams functions")
+	}
+
+	mmSaveScheduledPriceChange.defaultExpectation.params = &PriceRepositoryMockSaveScheduledPriceChangeParams{ctx, priceChange}
+	mmSaveScheduledPriceChange.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveScheduledPriceChange.expectations {
+		if minimock.Equal(e.params, mmSaveScheduledPriceChange.defaultExpectation.params) {
+			mmSaveScheduledPriceChange.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveScheduledPriceChange.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveScheduledPriceChange
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PriceRepository.SaveScheduledPriceChange
+func (mmSaveScheduledPriceChange *mPriceRepositoryMockSaveScheduledPriceChange) ExpectCtxParam1(ctx context.Context) *mPriceRepositoryMockSaveScheduledPriceChange {
+	if mmSaveScheduledPriceChange.mock.funcSaveScheduledPriceChange != nil {
+		mmSaveScheduledPriceChange.mock.t.Fatalf("PriceRepositoryMock.SaveScheduledPriceChange mock is already set by Set")
+	}
+
+	if mmSaveScheduledPriceChange.defaultExpectation == nil {
+		mmSaveScheduledPriceChange.defaultExpectation = &PriceRepositoryMockSaveScheduledPriceChangeExpectation{}
+	}
+
+	if mmSaveScheduledPriceChange.defaultExpectation.params != nil {
+		mmSaveScheduledPriceChange.mock.t.Fatalf("PriceRepositoryMock.SaveScheduledPriceChange mock is already set by Expect")
+	}
+
+	if mmSaveScheduledPriceChange.defaultExpectation.paramPtrs == nil {
+		mmSaveScheduledPriceChange.defaultExpectation.paramPtrs = &PriceRepositoryMockSaveScheduledPriceChangeParamPtrs{}
+	}
+	mmSaveScheduledPriceChange.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveScheduledPriceChange.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSaveScheduledPriceChange
+}
+
+// ExpectPriceChangeParam2 sets up expected param priceChange for PriceRepository.SaveScheduledPriceChange
+func (mmSaveScheduledPriceChange *mPriceRepositoryMockSaveScheduledPriceChange) ExpectPriceChangeParam2(priceChange domain.ScheduledPriceChange) *mPriceRepositoryMockSaveScheduledPriceChange {
+	if mmSaveScheduledPriceChange.mock.funcSaveScheduledPriceChange != nil {
+		mmSaveScheduledPriceChange.mock.t.Fatalf("PriceRepositoryMock.SaveScheduledPriceChange mock is already set by Set")
+	}
+
+	if mmSaveScheduledPriceChange.defaultExpectation == nil {
+		mmSaveScheduledPriceChange.defaultExpectation = &PriceRepositoryMockSaveScheduledPriceChangeExpectation{}
+	}
+
+	if mmSaveScheduledPriceChange.defaultExpectation.params != nil {
+		mmSaveScheduledPriceChange.mock.t.Fatalf("PriceRepositoryMock.SaveScheduledPriceChange mock is already set by Expect")
+	}
+
+	if mmSaveScheduledPriceChange.defaultExpectation.paramPtrs == nil {
+		mmSaveScheduledPriceChange.defaultExpectation.paramPtrs = &PriceRepositoryMockSaveScheduledPriceChangeParamPtrs{}
+	}
+	mmSaveScheduledPriceChange.defaultExpectation.paramPtrs.priceChange = &priceChange
+	mmSaveScheduledPriceChange.defaultExpectation.expectationOrigins.originPriceChange = minimock.CallerInfo(1)
+
+	return mmSaveScheduledPriceChange
+}
+
+// Inspect accepts an inspector function that has same arguments as the PriceRepository.SaveScheduledPriceChange
+func (mmSaveScheduledPriceChange *mPriceRepositoryMockSaveScheduledPriceChange) Inspect(f func(ctx context.Context, priceChange domain.ScheduledPriceChange)) *mPriceRepositoryMockSaveScheduledPriceChange {
+	if mmSaveScheduledPriceChange.mock.inspectFuncSaveScheduledPriceChange != nil {
+		mmSaveScheduledPriceChange.mock.t.Fatalf("Inspect function is already set for PriceRepositoryMock.SaveScheduledPriceChange")
+	}
+
+	mmSaveScheduledPriceChange.mock.inspectFuncSaveScheduledPriceChange = f
+
+	return mmSaveScheduledPriceChange
+}
+
+// Return sets up results that will be returned by PriceRepository.SaveScheduledPriceChange
+func (mmSaveScheduledPriceChange *mPriceRepositoryMockSaveScheduledPriceChange) Return(s1 domain.ScheduledPriceChange, err error) *PriceRepositoryMock {
+	if mmSaveScheduledPriceChange.mock.funcSaveScheduledPriceChange != nil {
+		mmSaveScheduledPriceChange.mock.t.Fatalf("PriceRepositoryMock.SaveScheduledPriceChange mock is already set by Set")
+	}
+
+	if mmSaveScheduledPriceChange.defaultExpectation == nil {
+		mmSaveScheduledPriceChange.defaultExpectation = &PriceRepositoryMockSaveScheduledPriceChangeExpectation{mock: mmSaveScheduledPriceChange.mock}
+	}
+	mmSaveScheduledPriceChange.defaultExpectation.results = &PriceRepositoryMockSaveScheduledPriceChangeResults{s1, err}
+	mmSaveScheduledPriceChange.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveScheduledPriceChange.mock
+}
+
+// Set uses given function f to mock the PriceRepository.SaveScheduledPriceChange method
+func (mmSaveScheduledPriceChange *mPriceRepositoryMockSaveScheduledPriceChange) Set(f func(ctx context.Context, priceChange domain.ScheduledPriceChange) (s1 domain.ScheduledPriceChange, err error)) *PriceRepositoryMock {
+	if mmSaveScheduledPriceChange.defaultExpectation != nil {
+		mmSaveScheduledPriceChange.mock.t.Fatalf("Default expectation is already set for the PriceRepository.SaveScheduledPriceChange method")
+	}
+
+	if len(mmSaveScheduledPriceChange.expectations) > 0 {
+		mmSaveScheduledPriceChange.mock.t.Fatalf("Some expectations are already set for the PriceRepository.SaveScheduledPriceChange method")
+	}
+
+	mmSaveScheduledPriceChange.mock.funcSaveScheduledPriceChange = f
+	mmSaveScheduledPriceChange.mock.funcSaveScheduledPriceChangeOrigin = minimock.CallerInfo(1)
+	return mmSaveScheduledPriceChange.mock
+}
+
+// When sets expectation for the PriceRepository.SaveScheduledPriceChange which will trigger the result defined by the following
+// Then helper
+func (mmSaveScheduledPriceChange *mPriceRepositoryMockSaveScheduledPriceChange) When(ctx context.Context, priceChange domain.ScheduledPriceChange) *PriceRepositoryMockSaveScheduledPriceChangeExpectation {
+	if mmSaveScheduledPriceChange.mock.funcSaveScheduledPriceChange != nil {
+		mmSaveScheduledPriceChange.mock.t.Fatalf("PriceRepositoryMock.SaveScheduledPriceChange mock is already set by Set")
+	}
+
+	expectation := &PriceRepositoryMockSaveScheduledPriceChangeExpectation{
+		mock:               mmSaveScheduledPriceChange.mock,
+		params:             &PriceRepositoryMockSaveScheduledPriceChangeParams{ctx, priceChange},
+		expectationOrigins: PriceRepositoryMockSaveScheduledPriceChangeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSaveScheduledPriceChange.expectations = append(mmSaveScheduledPriceChange.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PriceRepository.SaveScheduledPriceChange return parameters for the expectation previously defined by the When method
+func (e *PriceRepositoryMockSaveScheduledPriceChangeExpectation) Then(s1 domain.ScheduledPriceChange, err error) *PriceRepositoryMock {
+	e.results = &PriceRepositoryMockSaveScheduledPriceChangeResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times PriceRepository.SaveScheduledPriceChange should be invoked
+func (mmSaveScheduledPriceChange *mPriceRepositoryMockSaveScheduledPriceChange) Times(n uint64) *mPriceRepositoryMockSaveScheduledPriceChange {
+	if n == 0 {
+		mmSaveScheduledPriceChange.mock.t.Fatalf("Times of PriceRepositoryMock.SaveScheduledPriceChange mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSaveScheduledPriceChange.expectedInvocations, n)
+	mmSaveScheduledPriceChange.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveScheduledPriceChange
+}
+
+func (mmSaveScheduledPriceChange *mPriceRepositoryMockSaveScheduledPriceChange) invocationsDone() bool {
+	if len(mmSaveScheduledPriceChange.expectations) == 0 && mmSaveScheduledPriceChange.defaultExpectation == nil && mmSaveScheduledPriceChange.mock.funcSaveScheduledPriceChange == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveScheduledPriceChange.mock.afterSaveScheduledPriceChangeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveScheduledPriceChange.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SaveScheduledPriceChange implements mm_stocks.PriceRepository
+func (mmSaveScheduledPriceChange *PriceRepositoryMock) SaveScheduledPriceChange(ctx context.Context, priceChange domain.ScheduledPriceChange) (s1 domain.ScheduledPriceChange, err error) {
+	mm_atomic.AddUint64(&mmSaveScheduledPriceChange.beforeSaveScheduledPriceChangeCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveScheduledPriceChange.afterSaveScheduledPriceChangeCounter, 1)
+
+	mmSaveScheduledPriceChange.t.Helper()
+
+	if mmSaveScheduledPriceChange.inspectFuncSaveScheduledPriceChange != nil {
+		mmSaveScheduledPriceChange.inspectFuncSaveScheduledPriceChange(ctx, priceChange)
+	}
+
+	mm_params := PriceRepositoryMockSaveScheduledPriceChangeParams{ctx, priceChange}
+
+	// Record call args
+	mmSaveScheduledPriceChange.SaveScheduledPriceChangeMock.mutex.Lock()
+	mmSaveScheduledPriceChange.SaveScheduledPriceChangeMock.callArgs = append(mmSaveScheduledPriceChange.SaveScheduledPriceChangeMock.callArgs, &mm_params)
+	mmSaveScheduledPriceChange.SaveScheduledPriceChangeMock.mutex.Unlock()
+
+	for _, e := range mmSaveScheduledPriceChange.SaveScheduledPriceChangeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmSaveScheduledPriceChange.SaveScheduledPriceChangeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveScheduledPriceChange.SaveScheduledPriceChangeMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveScheduledPriceChange.SaveScheduledPriceChangeMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveScheduledPriceChange.SaveScheduledPriceChangeMock.defaultExpectation.paramPtrs
+
+		mm_got := PriceRepositoryMockSaveScheduledPriceChangeParams{ctx, priceChange}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSaveScheduledPriceChange.t.Errorf("PriceRepositoryMock.SaveScheduledPriceChange got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveScheduledPriceChange.SaveScheduledPriceChangeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.priceChange != nil && !minimock.Equal(*mm_want_ptrs.priceChange, mm_got.priceChange) {
+				mmSaveScheduledPriceChange.t.Errorf("PriceRepositoryMock.SaveScheduledPriceChange got unexpected parameter priceChange, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveScheduledPriceChange.SaveScheduledPriceChangeMock.defaultExpectation.expectationOrigins.originPriceChange, *mm_want_ptrs.priceChange, mm_got.priceChange, minimock.Diff(*mm_want_ptrs.priceChange, mm_got.priceChange))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveScheduledPriceChange.t.Errorf("PriceRepositoryMock.SaveScheduledPriceChange got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveScheduledPriceChange.SaveScheduledPriceChangeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveScheduledPriceChange.SaveScheduledPriceChangeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveScheduledPriceChange.t.Fatal("No results are set for the PriceRepositoryMock.SaveScheduledPriceChange")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmSaveScheduledPriceChange.funcSaveScheduledPriceChange != nil {
+		return mmSaveScheduledPriceChange.funcSaveScheduledPriceChange(ctx, priceChange)
+	}
+	mmSaveScheduledPriceChange.t.Fatalf("Unexpected call to PriceRepositoryMock.SaveScheduledPriceChange. %v %v", ctx, priceChange)
+	return
+}
+
+// SaveScheduledPriceChangeAfterCounter returns a count of finished PriceRepositoryMock.SaveScheduledPriceChange invocations
+func (mmSaveScheduledPriceChange *PriceRepositoryMock) SaveScheduledPriceChangeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveScheduledPriceChange.afterSaveScheduledPriceChangeCounter)
+}
+
+// SaveScheduledPriceChangeBeforeCounter returns a count of PriceRepositoryMock.SaveScheduledPriceChange invocations
+func (mmSaveScheduledPriceChange *PriceRepositoryMock) SaveScheduledPriceChangeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveScheduledPriceChange.beforeSaveScheduledPriceChangeCounter)
+}
+
+// Calls returns a list of arguments used in each call to PriceRepositoryMock.SaveScheduledPriceChange.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveScheduledPriceChange *mPriceRepositoryMockSaveScheduledPriceChange) Calls() []*PriceRepositoryMockSaveScheduledPriceChangeParams {
+	mmSaveScheduledPriceChange.mutex.RLock()
+
+	argCopy := make([]*PriceRepositoryMockSaveScheduledPriceChangeParams, len(mmSaveScheduledPriceChange.callArgs))
+	copy(argCopy, mmSaveScheduledPriceChange.callArgs)
+
+	mmSaveScheduledPriceChange.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveScheduledPriceChangeDone returns true if the count of the SaveScheduledPriceChange invocations corresponds
+// the number of defined expectations
+func (m *PriceRepositoryMock) MinimockSaveScheduledPriceChangeDone() bool {
+	if m.SaveScheduledPriceChangeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveScheduledPriceChangeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveScheduledPriceChangeMock.invocationsDone()
+}
+
+// MinimockSaveScheduledPriceChangeInspect logs each unmet expectation
+func (m *PriceRepositoryMock) MinimockSaveScheduledPriceChangeInspect() {
+	for _, e := range m.SaveScheduledPriceChangeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PriceRepositoryMock.SaveScheduledPriceChange at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveScheduledPriceChangeCounter := mm_atomic.LoadUint64(&m.afterSaveScheduledPriceChangeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveScheduledPriceChangeMock.defaultExpectation != nil && afterSaveScheduledPriceChangeCounter < 1 {
+		if m.SaveScheduledPriceChangeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PriceRepositoryMock.SaveScheduledPriceChange at\n%s", m.SaveScheduledPriceChangeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PriceRepositoryMock.SaveScheduledPriceChange at\n%s with params: %#v", m.SaveScheduledPriceChangeMock.defaultExpectation.expectationOrigins.origin, *m.SaveScheduledPriceChangeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveScheduledPriceChange != nil && afterSaveScheduledPriceChangeCounter < 1 {
+		m.t.Errorf("Expected call to PriceRepositoryMock.SaveScheduledPriceChange at\n%s", m.funcSaveScheduledPriceChangeOrigin)
+	}
+
+	if !m.SaveScheduledPriceChangeMock.invocationsDone() && afterSaveScheduledPriceChangeCounter > 0 {
+		m.t.Errorf("Expected %d calls to PriceRepositoryMock.SaveScheduledPriceChange at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveScheduledPriceChangeMock.expectedInvocations), m.SaveScheduledPriceChangeMock.expectedInvocationsOrigin, afterSaveScheduledPriceChangeCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PriceRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockApplyDuePriceChangesInspect()
+
+			m.MinimockListPendingPriceChangesInspect()
+
+			m.MinimockListPriceHistoryInspect()
+
+			m.MinimockListPriceTiersOfStockItemInspect()
+
+			m.MinimockReplacePriceTiersInspect()
+
+			m.MinimockSaveScheduledPriceChangeInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PriceRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PriceRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockApplyDuePriceChangesDone() &&
+		m.MinimockListPendingPriceChangesDone() &&
+		m.MinimockListPriceHistoryDone() &&
+		m.MinimockListPriceTiersOfStockItemDone() &&
+		m.MinimockReplacePriceTiersDone() &&
+		m.MinimockSaveScheduledPriceChangeDone()
+}
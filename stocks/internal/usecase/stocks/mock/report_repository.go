@@ -0,0 +1,446 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mock
+
+import (
+	"context"
+	"stocks/internal/domain"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// ReportRepositoryMock implements mm_stocks.ReportRepository
+type ReportRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcStreamInventoryValuation          func(ctx context.Context, filter domain.InventoryValuationFilter, fn func(row domain.InventoryValuationRow) error) (err error)
+	funcStreamInventoryValuationOrigin    string
+	inspectFuncStreamInventoryValuation   func(ctx context.Context, filter domain.InventoryValuationFilter, fn func(row domain.InventoryValuationRow) error)
+	afterStreamInventoryValuationCounter  uint64
+	beforeStreamInventoryValuationCounter uint64
+	StreamInventoryValuationMock          mReportRepositoryMockStreamInventoryValuation
+}
+
+// NewReportRepositoryMock returns a mock for mm_stocks.ReportRepository
+func NewReportRepositoryMock(t minimock.Tester) *ReportRepositoryMock {
+	m := &ReportRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.StreamInventoryValuationMock = mReportRepositoryMockStreamInventoryValuation{mock: m}
+	m.StreamInventoryValuationMock.callArgs = []*ReportRepositoryMockStreamInventoryValuationParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mReportRepositoryMockStreamInventoryValuation struct {
+	optional           bool
+	mock               *ReportRepositoryMock
+	defaultExpectation *ReportRepositoryMockStreamInventoryValuationExpectation
+	expectations       []*ReportRepositoryMockStreamInventoryValuationExpectation
+
+	callArgs []*ReportRepositoryMockStreamInventoryValuationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ReportRepositoryMockStreamInventoryValuationExpectation specifies expectation struct of the ReportRepository.StreamInventoryValuation
+type ReportRepositoryMockStreamInventoryValuationExpectation struct {
+	mock               *ReportRepositoryMock
+	params             *ReportRepositoryMockStreamInventoryValuationParams
+	paramPtrs          *ReportRepositoryMockStreamInventoryValuationParamPtrs
+	expectationOrigins ReportRepositoryMockStreamInventoryValuationExpectationOrigins
+	results            *ReportRepositoryMockStreamInventoryValuationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ReportRepositoryMockStreamInventoryValuationParams contains parameters of the ReportRepository.StreamInventoryValuation
+type ReportRepositoryMockStreamInventoryValuationParams struct {
+	ctx    context.Context
+	filter domain.InventoryValuationFilter
+	fn     func(row domain.InventoryValuationRow) error
+}
+
+// ReportRepositoryMockStreamInventoryValuationParamPtrs contains pointers to parameters of the ReportRepository.StreamInventoryValuation
+type ReportRepositoryMockStreamInventoryValuationParamPtrs struct {
+	ctx    *context.Context
+	filter *domain.InventoryValuationFilter
+	fn     *func(row domain.InventoryValuationRow) error
+}
+
+// ReportRepositoryMockStreamInventoryValuationResults contains results of the ReportRepository.StreamInventoryValuation
+type ReportRepositoryMockStreamInventoryValuationResults struct {
+	err error
+}
+
+// ReportRepositoryMockStreamInventoryValuationOrigins contains origins of expectations of the ReportRepository.StreamInventoryValuation
+type ReportRepositoryMockStreamInventoryValuationExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+	originFn     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmStreamInventoryValuation *mReportRepositoryMockStreamInventoryValuation) Optional() *mReportRepositoryMockStreamInventoryValuation {
+	mmStreamInventoryValuation.optional = true
+	return mmStreamInventoryValuation
+}
+
+// Expect sets up expected params for ReportRepository.StreamInventoryValuation
+func (mmStreamInventoryValuation *mReportRepositoryMockStreamInventoryValuation) Expect(ctx context.Context, filter domain.InventoryValuationFilter, fn func(row domain.InventoryValuationRow) error) *mReportRepositoryMockStreamInventoryValuation {
+	if mmStreamInventoryValuation.mock.funcStreamInventoryValuation != nil {
+		mmStreamInventoryValuation.mock.t.Fatalf("ReportRepositoryMock.StreamInventoryValuation mock is already set by Set")
+	}
+
+	if mmStreamInventoryValuation.defaultExpectation == nil {
+		mmStreamInventoryValuation.defaultExpectation = &ReportRepositoryMockStreamInventoryValuationExpectation{}
+	}
+
+	if mmStreamInventoryValuation.defaultExpectation.paramPtrs != nil {
+		mmStreamInventoryValuation.mock.t.Fatalf("ReportRepositoryMock.StreamInventoryValuation mock is already set by ExpectParams functions")
+	}
+
+	mmStreamInventoryValuation.defaultExpectation.params = &ReportRepositoryMockStreamInventoryValuationParams{ctx, filter, fn}
+	mmStreamInventoryValuation.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmStreamInventoryValuation.expectations {
+		if minimock.Equal(e.params, mmStreamInventoryValuation.defaultExpectation.params) {
+			mmStreamInventoryValuation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmStreamInventoryValuation.defaultExpectation.params)
+		}
+	}
+
+	return mmStreamInventoryValuation
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReportRepository.StreamInventoryValuation
+func (mmStreamInventoryValuation *mReportRepositoryMockStreamInventoryValuation) ExpectCtxParam1(ctx context.Context) *mReportRepositoryMockStreamInventoryValuation {
+	if mmStreamInventoryValuation.mock.funcStreamInventoryValuation != nil {
+		mmStreamInventoryValuation.mock.t.Fatalf("ReportRepositoryMock.StreamInventoryValuation mock is already set by Set")
+	}
+
+	if mmStreamInventoryValuation.defaultExpectation == nil {
+		mmStreamInventoryValuation.defaultExpectation = &ReportRepositoryMockStreamInventoryValuationExpectation{}
+	}
+
+	if mmStreamInventoryValuation.defaultExpectation.params != nil {
+		mmStreamInventoryValuation.mock.t.Fatalf("ReportRepositoryMock.StreamInventoryValuation mock is already set by Expect")
+	}
+
+	if mmStreamInventoryValuation.defaultExpectation.paramPtrs == nil {
+		mmStreamInventoryValuation.defaultExpectation.paramPtrs = &ReportRepositoryMockStreamInventoryValuationParamPtrs{}
+	}
+	mmStreamInventoryValuation.defaultExpectation.paramPtrs.ctx = &ctx
+	mmStreamInventoryValuation.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmStreamInventoryValuation
+}
+
+// ExpectFilterParam2 sets up expected param filter for ReportRepository.StreamInventoryValuation
+func (mmStreamInventoryValuation *mReportRepositoryMockStreamInventoryValuation) ExpectFilterParam2(filter domain.InventoryValuationFilter) *mReportRepositoryMockStreamInventoryValuation {
+	if mmStreamInventoryValuation.mock.funcStreamInventoryValuation != nil {
+		mmStreamInventoryValuation.mock.t.Fatalf("ReportRepositoryMock.StreamInventoryValuation mock is already set by Set")
+	}
+
+	if mmStreamInventoryValuation.defaultExpectation == nil {
+		mmStreamInventoryValuation.defaultExpectation = &ReportRepositoryMockStreamInventoryValuationExpectation{}
+	}
+
+	if mmStreamInventoryValuation.defaultExpectation.params != nil {
+		mmStreamInventoryValuation.mock.t.Fatalf("ReportRepositoryMock.StreamInventoryValuation mock is already set by Expect")
+	}
+
+	if mmStreamInventoryValuation.defaultExpectation.paramPtrs == nil {
+		mmStreamInventoryValuation.defaultExpectation.paramPtrs = &ReportRepositoryMockStreamInventoryValuationParamPtrs{}
+	}
+	mmStreamInventoryValuation.defaultExpectation.paramPtrs.filter = &filter
+	mmStreamInventoryValuation.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmStreamInventoryValuation
+}
+
+// ExpectFnParam3 sets up expected param fn for ReportRepository.StreamInventoryValuation
+func (mmStreamInventoryValuation *mReportRepositoryMockStreamInventoryValuation) ExpectFnParam3(fn func(row domain.InventoryValuationRow) error) *mReportRepositoryMockStreamInventoryValuation {
+	if mmStreamInventoryValuation.mock.funcStreamInventoryValuation != nil {
+		mmStreamInventoryValuation.mock.t.Fatalf("ReportRepositoryMock.StreamInventoryValuation mock is already set by Set")
+	}
+
+	if mmStreamInventoryValuation.defaultExpectation == nil {
+		mmStreamInventoryValuation.defaultExpectation = &ReportRepositoryMockStreamInventoryValuationExpectation{}
+	}
+
+	if mmStreamInventoryValuation.defaultExpectation.params != nil {
+		mmStreamInventoryValuation.mock.t.Fatalf("ReportRepositoryMock.StreamInventoryValuation mock is already set by Expect")
+	}
+
+	if mmStreamInventoryValuation.defaultExpectation.paramPtrs == nil {
+		mmStreamInventoryValuation.defaultExpectation.paramPtrs = &ReportRepositoryMockStreamInventoryValuationParamPtrs{}
+	}
+	mmStreamInventoryValuation.defaultExpectation.paramPtrs.fn = &fn
+	mmStreamInventoryValuation.defaultExpectation.expectationOrigins.originFn = minimock.CallerInfo(1)
+
+	return mmStreamInventoryValuation
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReportRepository.StreamInventoryValuation
+func (mmStreamInventoryValuation *mReportRepositoryMockStreamInventoryValuation) Inspect(f func(ctx context.Context, filter domain.InventoryValuationFilter, fn func(row domain.InventoryValuationRow) error)) *mReportRepositoryMockStreamInventoryValuation {
+	if mmStreamInventoryValuation.mock.inspectFuncStreamInventoryValuation != nil {
+		mmStreamInventoryValuation.mock.t.Fatalf("Inspect function is already set for ReportRepositoryMock.StreamInventoryValuation")
+	}
+
+	mmStreamInventoryValuation.mock.inspectFuncStreamInventoryValuation = f
+
+	return mmStreamInventoryValuation
+}
+
+// Return sets up results that will be returned by ReportRepository.StreamInventoryValuation
+func (mmStreamInventoryValuation *mReportRepositoryMockStreamInventoryValuation) Return(err error) *ReportRepositoryMock {
+	if mmStreamInventoryValuation.mock.funcStreamInventoryValuation != nil {
+		mmStreamInventoryValuation.mock.t.Fatalf("ReportRepositoryMock.StreamInventoryValuation mock is already set by Set")
+	}
+
+	if mmStreamInventoryValuation.defaultExpectation == nil {
+		mmStreamInventoryValuation.defaultExpectation = &ReportRepositoryMockStreamInventoryValuationExpectation{mock: mmStreamInventoryValuation.mock}
+	}
+	mmStreamInventoryValuation.defaultExpectation.results = &ReportRepositoryMockStreamInventoryValuationResults{err}
+	mmStreamInventoryValuation.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmStreamInventoryValuation.mock
+}
+
+// Set uses given function f to mock the ReportRepository.StreamInventoryValuation method
+func (mmStreamInventoryValuation *mReportRepositoryMockStreamInventoryValuation) Set(f func(ctx context.Context, filter domain.InventoryValuationFilter, fn func(row domain.InventoryValuationRow) error) (err error)) *ReportRepositoryMock {
+	if mmStreamInventoryValuation.defaultExpectation != nil {
+		mmStreamInventoryValuation.mock.t.Fatalf("Default expectation is already set for the ReportRepository.StreamInventoryValuation method")
+	}
+
+	if len(mmStreamInventoryValuation.expectations) > 0 {
+		mmStreamInventoryValuation.mock.t.Fatalf("Some expectations are already set for the ReportRepository.StreamInventoryValuation method")
+	}
+
+	mmStreamInventoryValuation.mock.funcStreamInventoryValuation = f
+	mmStreamInventoryValuation.mock.funcStreamInventoryValuationOrigin = minimock.CallerInfo(1)
+	return mmStreamInventoryValuation.mock
+}
+
+// When sets expectation for the ReportRepository.StreamInventoryValuation which will trigger the result defined by the following
+// Then helper
+func (mmStreamInventoryValuation *mReportRepositoryMockStreamInventoryValuation) When(ctx context.Context, filter domain.InventoryValuationFilter, fn func(row domain.InventoryValuationRow) error) *ReportRepositoryMockStreamInventoryValuationExpectation {
+	if mmStreamInventoryValuation.mock.funcStreamInventoryValuation != nil {
+		mmStreamInventoryValuation.mock.t.Fatalf("ReportRepositoryMock.StreamInventoryValuation mock is already set by Set")
+	}
+
+	expectation := &ReportRepositoryMockStreamInventoryValuationExpectation{
+		mock:               mmStreamInventoryValuation.mock,
+		params:             &ReportRepositoryMockStreamInventoryValuationParams{ctx, filter, fn},
+		expectationOrigins: ReportRepositoryMockStreamInventoryValuationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmStreamInventoryValuation.expectations = append(mmStreamInventoryValuation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReportRepository.StreamInventoryValuation return parameters for the expectation previously defined by the When method
+func (e *ReportRepositoryMockStreamInventoryValuationExpectation) Then(err error) *ReportRepositoryMock {
+	e.results = &ReportRepositoryMockStreamInventoryValuationResults{err}
+	return e.mock
+}
+
+// Times sets number of times ReportRepository.StreamInventoryValuation should be invoked
+func (mmStreamInventoryValuation *mReportRepositoryMockStreamInventoryValuation) Times(n uint64) *mReportRepositoryMockStreamInventoryValuation {
+	if n == 0 {
+		mmStreamInventoryValuation.mock.t.Fatalf("Times of ReportRepositoryMock.StreamInventoryValuation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmStreamInventoryValuation.expectedInvocations, n)
+	mmStreamInventoryValuation.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmStreamInventoryValuation
+}
+
+func (mmStreamInventoryValuation *mReportRepositoryMockStreamInventoryValuation) invocationsDone() bool {
+	if len(mmStreamInventoryValuation.expectations) == 0 && mmStreamInventoryValuation.defaultExpectation == nil && mmStreamInventoryValuation.mock.funcStreamInventoryValuation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmStreamInventoryValuation.mock.afterStreamInventoryValuationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmStreamInventoryValuation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// StreamInventoryValuation implements mm_stocks.ReportRepository
+func (mmStreamInventoryValuation *ReportRepositoryMock) StreamInventoryValuation(ctx context.Context, filter domain.InventoryValuationFilter, fn func(row domain.InventoryValuationRow) error) (err error) {
+	mm_atomic.AddUint64(&mmStreamInventoryValuation.beforeStreamInventoryValuationCounter, 1)
+	defer mm_atomic.AddUint64(&mmStreamInventoryValuation.afterStreamInventoryValuationCounter, 1)
+
+	mmStreamInventoryValuation.t.Helper()
+
+	if mmStreamInventoryValuation.inspectFuncStreamInventoryValuation != nil {
+		mmStreamInventoryValuation.inspectFuncStreamInventoryValuation(ctx, filter, fn)
+	}
+
+	mm_params := ReportRepositoryMockStreamInventoryValuationParams{ctx, filter, fn}
+
+	// Record call args
+	mmStreamInventoryValuation.StreamInventoryValuationMock.mutex.Lock()
+	mmStreamInventoryValuation.StreamInventoryValuationMock.callArgs = append(mmStreamInventoryValuation.StreamInventoryValuationMock.callArgs, &mm_params)
+	mmStreamInventoryValuation.StreamInventoryValuationMock.mutex.Unlock()
+
+	for _, e := range mmStreamInventoryValuation.StreamInventoryValuationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmStreamInventoryValuation.StreamInventoryValuationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmStreamInventoryValuation.StreamInventoryValuationMock.defaultExpectation.Counter, 1)
+		mm_want := mmStreamInventoryValuation.StreamInventoryValuationMock.defaultExpectation.params
+		mm_want_ptrs := mmStreamInventoryValuation.StreamInventoryValuationMock.defaultExpectation.paramPtrs
+
+		mm_got := ReportRepositoryMockStreamInventoryValuationParams{ctx, filter, fn}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmStreamInventoryValuation.t.Errorf("ReportRepositoryMock.StreamInventoryValuation got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStreamInventoryValuation.StreamInventoryValuationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmStreamInventoryValuation.t.Errorf("ReportRepositoryMock.StreamInventoryValuation got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStreamInventoryValuation.StreamInventoryValuationMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+			if mm_want_ptrs.fn != nil && !minimock.Equal(*mm_want_ptrs.fn, mm_got.fn) {
+				mmStreamInventoryValuation.t.Errorf("ReportRepositoryMock.StreamInventoryValuation got unexpected parameter fn, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmStreamInventoryValuation.StreamInventoryValuationMock.defaultExpectation.expectationOrigins.originFn, *mm_want_ptrs.fn, mm_got.fn, minimock.Diff(*mm_want_ptrs.fn, mm_got.fn))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmStreamInventoryValuation.t.Errorf("ReportRepositoryMock.StreamInventoryValuation got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmStreamInventoryValuation.StreamInventoryValuationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmStreamInventoryValuation.StreamInventoryValuationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmStreamInventoryValuation.t.Fatal("No results are set for the ReportRepositoryMock.StreamInventoryValuation")
+		}
+		return (*mm_results).err
+	}
+	if mmStreamInventoryValuation.funcStreamInventoryValuation != nil {
+		return mmStreamInventoryValuation.funcStreamInventoryValuation(ctx, filter, fn)
+	}
+	mmStreamInventoryValuation.t.Fatalf("Unexpected call to ReportRepositoryMock.StreamInventoryValuation. %v %v %v", ctx, filter, fn)
+	return
+}
+
+// StreamInventoryValuationAfterCounter returns a count of finished ReportRepositoryMock.StreamInventoryValuation invocations
+func (mmStreamInventoryValuation *ReportRepositoryMock) StreamInventoryValuationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStreamInventoryValuation.afterStreamInventoryValuationCounter)
+}
+
+// StreamInventoryValuationBeforeCounter returns a count of ReportRepositoryMock.StreamInventoryValuation invocations
+func (mmStreamInventoryValuation *ReportRepositoryMock) StreamInventoryValuationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmStreamInventoryValuation.beforeStreamInventoryValuationCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReportRepositoryMock.StreamInventoryValuation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmStreamInventoryValuation *mReportRepositoryMockStreamInventoryValuation) Calls() []*ReportRepositoryMockStreamInventoryValuationParams {
+	mmStreamInventoryValuation.mutex.RLock()
+
+	argCopy := make([]*ReportRepositoryMockStreamInventoryValuationParams, len(mmStreamInventoryValuation.callArgs))
+	copy(argCopy, mmStreamInventoryValuation.callArgs)
+
+	mmStreamInventoryValuation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockStreamInventoryValuationDone returns true if the count of the StreamInventoryValuation invocations corresponds
+// the number of defined expectations
+func (m *ReportRepositoryMock) MinimockStreamInventoryValuationDone() bool {
+	if m.StreamInventoryValuationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.StreamInventoryValuationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.StreamInventoryValuationMock.invocationsDone()
+}
+
+// MinimockStreamInventoryValuationInspect logs each unmet expectation
+func (m *ReportRepositoryMock) MinimockStreamInventoryValuationInspect() {
+	for _, e := range m.StreamInventoryValuationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReportRepositoryMock.StreamInventoryValuation at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterStreamInventoryValuationCounter := mm_atomic.LoadUint64(&m.afterStreamInventoryValuationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.StreamInventoryValuationMock.defaultExpectation != nil && afterStreamInventoryValuationCounter < 1 {
+		if m.StreamInventoryValuationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ReportRepositoryMock.StreamInventoryValuation at\n%s", m.StreamInventoryValuationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ReportRepositoryMock.StreamInventoryValuation at\n%s with params: %#v", m.StreamInventoryValuationMock.defaultExpectation.expectationOrigins.origin, *m.StreamInventoryValuationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcStreamInventoryValuation != nil && afterStreamInventoryValuationCounter < 1 {
+		m.t.Errorf("Expected call to ReportRepositoryMock.StreamInventoryValuation at\n%s", m.funcStreamInventoryValuationOrigin)
+	}
+
+	if !m.StreamInventoryValuationMock.invocationsDone() && afterStreamInventoryValuationCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReportRepositoryMock.StreamInventoryValuation at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.StreamInventoryValuationMock.expectedInvocations), m.StreamInventoryValuationMock.expectedInvocationsOrigin, afterStreamInventoryValuationCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ReportRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockStreamInventoryValuationInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ReportRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ReportRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockStreamInventoryValuationDone()
+}
@@ -17,12 +17,75 @@ type SKURepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcCountSKUsByType          func(ctx context.Context, filter domain.SKUSearchFilter) (ta1 []domain.TypeFacet, err error)
+	funcCountSKUsByTypeOrigin    string
+	inspectFuncCountSKUsByType   func(ctx context.Context, filter domain.SKUSearchFilter)
+	afterCountSKUsByTypeCounter  uint64
+	beforeCountSKUsByTypeCounter uint64
+	CountSKUsByTypeMock          mSKURepositoryMockCountSKUsByType
+
+	funcCreateVariantGroup          func(ctx context.Context, group domain.VariantGroup) (v1 domain.VariantGroup, err error)
+	funcCreateVariantGroupOrigin    string
+	inspectFuncCreateVariantGroup   func(ctx context.Context, group domain.VariantGroup)
+	afterCreateVariantGroupCounter  uint64
+	beforeCreateVariantGroupCounter uint64
+	CreateVariantGroupMock          mSKURepositoryMockCreateVariantGroup
+
+	funcGetAttributeSchema          func(ctx context.Context, skuType string) (a1 domain.AttributeSchema, err error)
+	funcGetAttributeSchemaOrigin    string
+	inspectFuncGetAttributeSchema   func(ctx context.Context, skuType string)
+	afterGetAttributeSchemaCounter  uint64
+	beforeGetAttributeSchemaCounter uint64
+	GetAttributeSchemaMock          mSKURepositoryMockGetAttributeSchema
+
+	funcGetBundleComponents          func(ctx context.Context, skuID domain.SKUID) (ba1 []domain.BundleComponent, err error)
+	funcGetBundleComponentsOrigin    string
+	inspectFuncGetBundleComponents   func(ctx context.Context, skuID domain.SKUID)
+	afterGetBundleComponentsCounter  uint64
+	beforeGetBundleComponentsCounter uint64
+	GetBundleComponentsMock          mSKURepositoryMockGetBundleComponents
+
 	funcGetSKUByID          func(ctx context.Context, skuID domain.SKUID) (s1 domain.SKU, err error)
 	funcGetSKUByIDOrigin    string
 	inspectFuncGetSKUByID   func(ctx context.Context, skuID domain.SKUID)
 	afterGetSKUByIDCounter  uint64
 	beforeGetSKUByIDCounter uint64
 	GetSKUByIDMock          mSKURepositoryMockGetSKUByID
+
+	funcGetVariantGroup          func(ctx context.Context, groupID domain.VariantGroupID) (v1 domain.VariantGroup, err error)
+	funcGetVariantGroupOrigin    string
+	inspectFuncGetVariantGroup   func(ctx context.Context, groupID domain.VariantGroupID)
+	afterGetVariantGroupCounter  uint64
+	beforeGetVariantGroupCounter uint64
+	GetVariantGroupMock          mSKURepositoryMockGetVariantGroup
+
+	funcSaveAttributeSchema          func(ctx context.Context, schema domain.AttributeSchema) (err error)
+	funcSaveAttributeSchemaOrigin    string
+	inspectFuncSaveAttributeSchema   func(ctx context.Context, schema domain.AttributeSchema)
+	afterSaveAttributeSchemaCounter  uint64
+	beforeSaveAttributeSchemaCounter uint64
+	SaveAttributeSchemaMock          mSKURepositoryMockSaveAttributeSchema
+
+	funcSaveBundle          func(ctx context.Context, bundle domain.Bundle) (err error)
+	funcSaveBundleOrigin    string
+	inspectFuncSaveBundle   func(ctx context.Context, bundle domain.Bundle)
+	afterSaveBundleCounter  uint64
+	beforeSaveBundleCounter uint64
+	SaveBundleMock          mSKURepositoryMockSaveBundle
+
+	funcSearchSKUsByQuery          func(ctx context.Context, filter domain.SKUSearchFilter) (sa1 []domain.SKUSearchResult, err error)
+	funcSearchSKUsByQueryOrigin    string
+	inspectFuncSearchSKUsByQuery   func(ctx context.Context, filter domain.SKUSearchFilter)
+	afterSearchSKUsByQueryCounter  uint64
+	beforeSearchSKUsByQueryCounter uint64
+	SearchSKUsByQueryMock          mSKURepositoryMockSearchSKUsByQuery
+
+	funcUpdateSKUAttributes          func(ctx context.Context, update domain.SKUAttributesUpdate, axesValues domain.SKUAttributes) (err error)
+	funcUpdateSKUAttributesOrigin    string
+	inspectFuncUpdateSKUAttributes   func(ctx context.Context, update domain.SKUAttributesUpdate, axesValues domain.SKUAttributes)
+	afterUpdateSKUAttributesCounter  uint64
+	beforeUpdateSKUAttributesCounter uint64
+	UpdateSKUAttributesMock          mSKURepositoryMockUpdateSKUAttributes
 }
 
 // NewSKURepositoryMock returns a mock for mm_stocks.SKURepository
@@ -33,61 +96,88 @@ func NewSKURepositoryMock(t minimock.Tester) *SKURepositoryMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.CountSKUsByTypeMock = mSKURepositoryMockCountSKUsByType{mock: m}
+	m.CountSKUsByTypeMock.callArgs = []*SKURepositoryMockCountSKUsByTypeParams{}
+
+	m.CreateVariantGroupMock = mSKURepositoryMockCreateVariantGroup{mock: m}
+	m.CreateVariantGroupMock.callArgs = []*SKURepositoryMockCreateVariantGroupParams{}
+
+	m.GetAttributeSchemaMock = mSKURepositoryMockGetAttributeSchema{mock: m}
+	m.GetAttributeSchemaMock.callArgs = []*SKURepositoryMockGetAttributeSchemaParams{}
+
+	m.GetBundleComponentsMock = mSKURepositoryMockGetBundleComponents{mock: m}
+	m.GetBundleComponentsMock.callArgs = []*SKURepositoryMockGetBundleComponentsParams{}
+
 	m.GetSKUByIDMock = mSKURepositoryMockGetSKUByID{mock: m}
 	m.GetSKUByIDMock.callArgs = []*SKURepositoryMockGetSKUByIDParams{}
 
+	m.GetVariantGroupMock = mSKURepositoryMockGetVariantGroup{mock: m}
+	m.GetVariantGroupMock.callArgs = []*SKURepositoryMockGetVariantGroupParams{}
+
+	m.SaveAttributeSchemaMock = mSKURepositoryMockSaveAttributeSchema{mock: m}
+	m.SaveAttributeSchemaMock.callArgs = []*SKURepositoryMockSaveAttributeSchemaParams{}
+
+	m.SaveBundleMock = mSKURepositoryMockSaveBundle{mock: m}
+	m.SaveBundleMock.callArgs = []*SKURepositoryMockSaveBundleParams{}
+
+	m.SearchSKUsByQueryMock = mSKURepositoryMockSearchSKUsByQuery{mock: m}
+	m.SearchSKUsByQueryMock.callArgs = []*SKURepositoryMockSearchSKUsByQueryParams{}
+
+	m.UpdateSKUAttributesMock = mSKURepositoryMockUpdateSKUAttributes{mock: m}
+	m.UpdateSKUAttributesMock.callArgs = []*SKURepositoryMockUpdateSKUAttributesParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
-type mSKURepositoryMockGetSKUByID struct {
+type mSKURepositoryMockCountSKUsByType struct {
 	optional           bool
 	mock               *SKURepositoryMock
-	defaultExpectation *SKURepositoryMockGetSKUByIDExpectation
-	expectations       []*SKURepositoryMockGetSKUByIDExpectation
+	defaultExpectation *SKURepositoryMockCountSKUsByTypeExpectation
+	expectations       []*SKURepositoryMockCountSKUsByTypeExpectation
 
-	callArgs []*SKURepositoryMockGetSKUByIDParams
+	callArgs []*SKURepositoryMockCountSKUsByTypeParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// SKURepositoryMockGetSKUByIDExpectation specifies expectation struct of the SKURepository.GetSKUByID
-type SKURepositoryMockGetSKUByIDExpectation struct {
+// SKURepositoryMockCountSKUsByTypeExpectation specifies expectation struct of the SKURepository.CountSKUsByType
+type SKURepositoryMockCountSKUsByTypeExpectation struct {
 	mock               *SKURepositoryMock
-	params             *SKURepositoryMockGetSKUByIDParams
-	paramPtrs          *SKURepositoryMockGetSKUByIDParamPtrs
-	expectationOrigins SKURepositoryMockGetSKUByIDExpectationOrigins
-	results            *SKURepositoryMockGetSKUByIDResults
+	params             *SKURepositoryMockCountSKUsByTypeParams
+	paramPtrs          *SKURepositoryMockCountSKUsByTypeParamPtrs
+	expectationOrigins SKURepositoryMockCountSKUsByTypeExpectationOrigins
+	results            *SKURepositoryMockCountSKUsByTypeResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// SKURepositoryMockGetSKUByIDParams contains parameters of the SKURepository.GetSKUByID
-type SKURepositoryMockGetSKUByIDParams struct {
-	ctx   context.Context
-	skuID domain.SKUID
+// SKURepositoryMockCountSKUsByTypeParams contains parameters of the SKURepository.CountSKUsByType
+type SKURepositoryMockCountSKUsByTypeParams struct {
+	ctx    context.Context
+	filter domain.SKUSearchFilter
 }
 
-// SKURepositoryMockGetSKUByIDParamPtrs contains pointers to parameters of the SKURepository.GetSKUByID
-type SKURepositoryMockGetSKUByIDParamPtrs struct {
-	ctx   *context.Context
-	skuID *domain.SKUID
+// SKURepositoryMockCountSKUsByTypeParamPtrs contains pointers to parameters of the SKURepository.CountSKUsByType
+type SKURepositoryMockCountSKUsByTypeParamPtrs struct {
+	ctx    *context.Context
+	filter *domain.SKUSearchFilter
 }
 
-// SKURepositoryMockGetSKUByIDResults contains results of the SKURepository.GetSKUByID
-type SKURepositoryMockGetSKUByIDResults struct {
-	s1  domain.SKU
+// SKURepositoryMockCountSKUsByTypeResults contains results of the SKURepository.CountSKUsByType
+type SKURepositoryMockCountSKUsByTypeResults struct {
+	ta1 []domain.TypeFacet
 	err error
 }
 
-// SKURepositoryMockGetSKUByIDOrigins contains origins of expectations of the SKURepository.GetSKUByID
-type SKURepositoryMockGetSKUByIDExpectationOrigins struct {
-	origin      string
-	originCtx   string
-	originSkuID string
+// SKURepositoryMockCountSKUsByTypeOrigins contains origins of expectations of the SKURepository.CountSKUsByType
+type SKURepositoryMockCountSKUsByTypeExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -95,300 +185,3433 @@ type SKURepositoryMockGetSKUByIDExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetSKUByID *mSKURepositoryMockGetSKUByID) Optional() *mSKURepositoryMockGetSKUByID {
-	mmGetSKUByID.optional = true
-	return mmGetSKUByID
+func (mmCountSKUsByType *mSKURepositoryMockCountSKUsByType) Optional() *mSKURepositoryMockCountSKUsByType {
+	mmCountSKUsByType.optional = true
+	return mmCountSKUsByType
 }
 
-// Expect sets up expected params for SKURepository.GetSKUByID
-func (mmGetSKUByID *mSKURepositoryMockGetSKUByID) Expect(ctx context.Context, skuID domain.SKUID) *mSKURepositoryMockGetSKUByID {
-	if mmGetSKUByID.mock.funcGetSKUByID != nil {
-		mmGetSKUByID.mock.t.Fatalf("SKURepositoryMock.GetSKUByID mock is already set by Set")
+// Expect sets up expected params for SKURepository.CountSKUsByType
+func (mmCountSKUsByType *mSKURepositoryMockCountSKUsByType) Expect(ctx context.Context, filter domain.SKUSearchFilter) *mSKURepositoryMockCountSKUsByType {
+	if mmCountSKUsByType.mock.funcCountSKUsByType != nil {
+		mmCountSKUsByType.mock.t.Fatalf("SKURepositoryMock.CountSKUsByType mock is already set by Set")
 	}
 
-	if mmGetSKUByID.defaultExpectation == nil {
-		mmGetSKUByID.defaultExpectation = &SKURepositoryMockGetSKUByIDExpectation{}
+	if mmCountSKUsByType.defaultExpectation == nil {
+		mmCountSKUsByType.defaultExpectation = &SKURepositoryMockCountSKUsByTypeExpectation{}
 	}
 
-	if mmGetSKUByID.defaultExpectation.paramPtrs != nil {
-		mmGetSKUByID.mock.t.Fatalf("SKURepositoryMock.GetSKUByID mock is already set by ExpectParams functions")
+	if mmCountSKUsByType.defaultExpectation.paramPtrs != nil {
+		mmCountSKUsByType.mock.t.Fatalf("SKURepositoryMock.CountSKUsByType mock is already set by ExpectParams functions")
 	}
 
-	mmGetSKUByID.defaultExpectation.params = &SKURepositoryMockGetSKUByIDParams{ctx, skuID}
-	mmGetSKUByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetSKUByID.expectations {
-		if minimock.Equal(e.params, mmGetSKUByID.defaultExpectation.params) {
-			mmGetSKUByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetSKUByID.defaultExpectation.params)
+	mmCountSKUsByType.defaultExpectation.params = &SKURepositoryMockCountSKUsByTypeParams{ctx, filter}
+	mmCountSKUsByType.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCountSKUsByType.expectations {
+		if minimock.Equal(e.params, mmCountSKUsByType.defaultExpectation.params) {
+			mmCountSKUsByType.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCountSKUsByType.defaultExpectation.params)
 		}
 	}
 
-	return mmGetSKUByID
+	return mmCountSKUsByType
 }
 
-// ExpectCtxParam1 sets up expected param ctx for SKURepository.GetSKUByID
-func (mmGetSKUByID *mSKURepositoryMockGetSKUByID) ExpectCtxParam1(ctx context.Context) *mSKURepositoryMockGetSKUByID {
-	if mmGetSKUByID.mock.funcGetSKUByID != nil {
-		mmGetSKUByID.mock.t.Fatalf("SKURepositoryMock.GetSKUByID mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for SKURepository.CountSKUsByType
+func (mmCountSKUsByType *mSKURepositoryMockCountSKUsByType) ExpectCtxParam1(ctx context.Context) *mSKURepositoryMockCountSKUsByType {
+	if mmCountSKUsByType.mock.funcCountSKUsByType != nil {
+		mmCountSKUsByType.mock.t.Fatalf("SKURepositoryMock.CountSKUsByType mock is already set by Set")
 	}
 
-	if mmGetSKUByID.defaultExpectation == nil {
-		mmGetSKUByID.defaultExpectation = &SKURepositoryMockGetSKUByIDExpectation{}
+	if mmCountSKUsByType.defaultExpectation == nil {
+		mmCountSKUsByType.defaultExpectation = &SKURepositoryMockCountSKUsByTypeExpectation{}
 	}
 
-	if mmGetSKUByID.defaultExpectation.params != nil {
-		mmGetSKUByID.mock.t.Fatalf("SKURepositoryMock.GetSKUByID mock is already set by Expect")
+	if mmCountSKUsByType.defaultExpectation.params != nil {
+		mmCountSKUsByType.mock.t.Fatalf("SKURepositoryMock.CountSKUsByType mock is already set by Expect")
 	}
 
-	if mmGetSKUByID.defaultExpectation.paramPtrs == nil {
-		mmGetSKUByID.defaultExpectation.paramPtrs = &SKURepositoryMockGetSKUByIDParamPtrs{}
+	if mmCountSKUsByType.defaultExpectation.paramPtrs == nil {
+		mmCountSKUsByType.defaultExpectation.paramPtrs = &SKURepositoryMockCountSKUsByTypeParamPtrs{}
 	}
-	mmGetSKUByID.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetSKUByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmCountSKUsByType.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCountSKUsByType.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetSKUByID
+	return mmCountSKUsByType
 }
 
-// ExpectSkuIDParam2 sets up expected param skuID for SKURepository.GetSKUByID
-func (mmGetSKUByID *mSKURepositoryMockGetSKUByID) ExpectSkuIDParam2(skuID domain.SKUID) *mSKURepositoryMockGetSKUByID {
-	if mmGetSKUByID.mock.funcGetSKUByID != nil {
-		mmGetSKUByID.mock.t.Fatalf("SKURepositoryMock.GetSKUByID mock is already set by Set")
+// ExpectFilterParam2 sets up expected param filter for SKURepository.CountSKUsByType
+func (mmCountSKUsByType *mSKURepositoryMockCountSKUsByType) ExpectFilterParam2(filter domain.SKUSearchFilter) *mSKURepositoryMockCountSKUsByType {
+	if mmCountSKUsByType.mock.funcCountSKUsByType != nil {
+		mmCountSKUsByType.mock.t.Fatalf("SKURepositoryMock.CountSKUsByType mock is already set by Set")
 	}
 
-	if mmGetSKUByID.defaultExpectation == nil {
-		mmGetSKUByID.defaultExpectation = &SKURepositoryMockGetSKUByIDExpectation{}
+	if mmCountSKUsByType.defaultExpectation == nil {
+		mmCountSKUsByType.defaultExpectation = &SKURepositoryMockCountSKUsByTypeExpectation{}
 	}
 
-	if mmGetSKUByID.defaultExpectation.params != nil {
-		mmGetSKUByID.mock.t.Fatalf("SKURepositoryMock.GetSKUByID mock is already set by Expect")
+	if mmCountSKUsByType.defaultExpectation.params != nil {
+		mmCountSKUsByType.mock.t.Fatalf("SKURepositoryMock.CountSKUsByType mock is already set by Expect")
 	}
 
-	if mmGetSKUByID.defaultExpectation.paramPtrs == nil {
-		mmGetSKUByID.defaultExpectation.paramPtrs = &SKURepositoryMockGetSKUByIDParamPtrs{}
+	if mmCountSKUsByType.defaultExpectation.paramPtrs == nil {
+		mmCountSKUsByType.defaultExpectation.paramPtrs = &SKURepositoryMockCountSKUsByTypeParamPtrs{}
 	}
-	mmGetSKUByID.defaultExpectation.paramPtrs.skuID = &skuID
-	mmGetSKUByID.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+	mmCountSKUsByType.defaultExpectation.paramPtrs.filter = &filter
+	mmCountSKUsByType.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
 
-	return mmGetSKUByID
+	return mmCountSKUsByType
 }
 
-// Inspect accepts an inspector function that has same arguments as the SKURepository.GetSKUByID
-func (mmGetSKUByID *mSKURepositoryMockGetSKUByID) Inspect(f func(ctx context.Context, skuID domain.SKUID)) *mSKURepositoryMockGetSKUByID {
-	if mmGetSKUByID.mock.inspectFuncGetSKUByID != nil {
-		mmGetSKUByID.mock.t.Fatalf("Inspect function is already set for SKURepositoryMock.GetSKUByID")
+// Inspect accepts an inspector function that has same arguments as the SKURepository.CountSKUsByType
+func (mmCountSKUsByType *mSKURepositoryMockCountSKUsByType) Inspect(f func(ctx context.Context, filter domain.SKUSearchFilter)) *mSKURepositoryMockCountSKUsByType {
+	if mmCountSKUsByType.mock.inspectFuncCountSKUsByType != nil {
+		mmCountSKUsByType.mock.t.Fatalf("Inspect function is already set for SKURepositoryMock.CountSKUsByType")
 	}
 
-	mmGetSKUByID.mock.inspectFuncGetSKUByID = f
+	mmCountSKUsByType.mock.inspectFuncCountSKUsByType = f
 
-	return mmGetSKUByID
+	return mmCountSKUsByType
 }
 
-// Return sets up results that will be returned by SKURepository.GetSKUByID
-func (mmGetSKUByID *mSKURepositoryMockGetSKUByID) Return(s1 domain.SKU, err error) *SKURepositoryMock {
-	if mmGetSKUByID.mock.funcGetSKUByID != nil {
-		mmGetSKUByID.mock.t.Fatalf("SKURepositoryMock.GetSKUByID mock is already set by Set")
+// Return sets up results that will be returned by SKURepository.CountSKUsByType
+func (mmCountSKUsByType *mSKURepositoryMockCountSKUsByType) Return(ta1 []domain.TypeFacet, err error) *SKURepositoryMock {
+	if mmCountSKUsByType.mock.funcCountSKUsByType != nil {
+		mmCountSKUsByType.mock.t.Fatalf("SKURepositoryMock.CountSKUsByType mock is already set by Set")
 	}
 
-	if mmGetSKUByID.defaultExpectation == nil {
-		mmGetSKUByID.defaultExpectation = &SKURepositoryMockGetSKUByIDExpectation{mock: mmGetSKUByID.mock}
+	if mmCountSKUsByType.defaultExpectation == nil {
+		mmCountSKUsByType.defaultExpectation = &SKURepositoryMockCountSKUsByTypeExpectation{mock: mmCountSKUsByType.mock}
 	}
-	mmGetSKUByID.defaultExpectation.results = &SKURepositoryMockGetSKUByIDResults{s1, err}
-	mmGetSKUByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetSKUByID.mock
+	mmCountSKUsByType.defaultExpectation.results = &SKURepositoryMockCountSKUsByTypeResults{ta1, err}
+	mmCountSKUsByType.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCountSKUsByType.mock
 }
 
-// Set uses given function f to mock the SKURepository.GetSKUByID method
-func (mmGetSKUByID *mSKURepositoryMockGetSKUByID) Set(f func(ctx context.Context, skuID domain.SKUID) (s1 domain.SKU, err error)) *SKURepositoryMock {
-	if mmGetSKUByID.defaultExpectation != nil {
-		mmGetSKUByID.mock.t.Fatalf("Default expectation is already set for the SKURepository.GetSKUByID method")
+// Set uses given function f to mock the SKURepository.CountSKUsByType method
+func (mmCountSKUsByType *mSKURepositoryMockCountSKUsByType) Set(f func(ctx context.Context, filter domain.SKUSearchFilter) (ta1 []domain.TypeFacet, err error)) *SKURepositoryMock {
+	if mmCountSKUsByType.defaultExpectation != nil {
+		mmCountSKUsByType.mock.t.Fatalf("Default expectation is already set for the SKURepository.CountSKUsByType method")
 	}
 
-	if len(mmGetSKUByID.expectations) > 0 {
-		mmGetSKUByID.mock.t.Fatalf("Some expectations are already set for the SKURepository.GetSKUByID method")
+	if len(mmCountSKUsByType.expectations) > 0 {
+		mmCountSKUsByType.mock.t.Fatalf("Some expectations are already set for the SKURepository.CountSKUsByType method")
 	}
 
-	mmGetSKUByID.mock.funcGetSKUByID = f
-	mmGetSKUByID.mock.funcGetSKUByIDOrigin = minimock.CallerInfo(1)
-	return mmGetSKUByID.mock
+	mmCountSKUsByType.mock.funcCountSKUsByType = f
+	mmCountSKUsByType.mock.funcCountSKUsByTypeOrigin = minimock.CallerInfo(1)
+	return mmCountSKUsByType.mock
 }
 
-// When sets expectation for the SKURepository.GetSKUByID which will trigger the result defined by the following
+// When sets expectation for the SKURepository.CountSKUsByType which will trigger the result defined by the following
 // Then helper
-func (mmGetSKUByID *mSKURepositoryMockGetSKUByID) When(ctx context.Context, skuID domain.SKUID) *SKURepositoryMockGetSKUByIDExpectation {
-	if mmGetSKUByID.mock.funcGetSKUByID != nil {
-		mmGetSKUByID.mock.t.Fatalf("SKURepositoryMock.GetSKUByID mock is already set by Set")
+func (mmCountSKUsByType *mSKURepositoryMockCountSKUsByType) When(ctx context.Context, filter domain.SKUSearchFilter) *SKURepositoryMockCountSKUsByTypeExpectation {
+	if mmCountSKUsByType.mock.funcCountSKUsByType != nil {
+		mmCountSKUsByType.mock.t.Fatalf("SKURepositoryMock.CountSKUsByType mock is already set by Set")
 	}
 
-	expectation := &SKURepositoryMockGetSKUByIDExpectation{
-		mock:               mmGetSKUByID.mock,
-		params:             &SKURepositoryMockGetSKUByIDParams{ctx, skuID},
-		expectationOrigins: SKURepositoryMockGetSKUByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &SKURepositoryMockCountSKUsByTypeExpectation{
+		mock:               mmCountSKUsByType.mock,
+		params:             &SKURepositoryMockCountSKUsByTypeParams{ctx, filter},
+		expectationOrigins: SKURepositoryMockCountSKUsByTypeExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetSKUByID.expectations = append(mmGetSKUByID.expectations, expectation)
+	mmCountSKUsByType.expectations = append(mmCountSKUsByType.expectations, expectation)
 	return expectation
 }
 
-// Then sets up SKURepository.GetSKUByID return parameters for the expectation previously defined by the When method
-func (e *SKURepositoryMockGetSKUByIDExpectation) Then(s1 domain.SKU, err error) *SKURepositoryMock {
-	e.results = &SKURepositoryMockGetSKUByIDResults{s1, err}
+// Then sets up SKURepository.CountSKUsByType return parameters for the expectation previously defined by the When method
+func (e *SKURepositoryMockCountSKUsByTypeExpectation) Then(ta1 []domain.TypeFacet, err error) *SKURepositoryMock {
+	e.results = &SKURepositoryMockCountSKUsByTypeResults{ta1, err}
 	return e.mock
 }
 
-// Times sets number of times SKURepository.GetSKUByID should be invoked
-func (mmGetSKUByID *mSKURepositoryMockGetSKUByID) Times(n uint64) *mSKURepositoryMockGetSKUByID {
+// Times sets number of times SKURepository.CountSKUsByType should be invoked
+func (mmCountSKUsByType *mSKURepositoryMockCountSKUsByType) Times(n uint64) *mSKURepositoryMockCountSKUsByType {
 	if n == 0 {
-		mmGetSKUByID.mock.t.Fatalf("Times of SKURepositoryMock.GetSKUByID mock can not be zero")
+		mmCountSKUsByType.mock.t.Fatalf("Times of SKURepositoryMock.CountSKUsByType mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetSKUByID.expectedInvocations, n)
-	mmGetSKUByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetSKUByID
+	mm_atomic.StoreUint64(&mmCountSKUsByType.expectedInvocations, n)
+	mmCountSKUsByType.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCountSKUsByType
 }
 
-func (mmGetSKUByID *mSKURepositoryMockGetSKUByID) invocationsDone() bool {
-	if len(mmGetSKUByID.expectations) == 0 && mmGetSKUByID.defaultExpectation == nil && mmGetSKUByID.mock.funcGetSKUByID == nil {
+func (mmCountSKUsByType *mSKURepositoryMockCountSKUsByType) invocationsDone() bool {
+	if len(mmCountSKUsByType.expectations) == 0 && mmCountSKUsByType.defaultExpectation == nil && mmCountSKUsByType.mock.funcCountSKUsByType == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetSKUByID.mock.afterGetSKUByIDCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetSKUByID.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmCountSKUsByType.mock.afterCountSKUsByTypeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCountSKUsByType.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetSKUByID implements mm_stocks.SKURepository
-func (mmGetSKUByID *SKURepositoryMock) GetSKUByID(ctx context.Context, skuID domain.SKUID) (s1 domain.SKU, err error) {
-	mm_atomic.AddUint64(&mmGetSKUByID.beforeGetSKUByIDCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetSKUByID.afterGetSKUByIDCounter, 1)
+// CountSKUsByType implements mm_stocks.SKURepository
+func (mmCountSKUsByType *SKURepositoryMock) CountSKUsByType(ctx context.Context, filter domain.SKUSearchFilter) (ta1 []domain.TypeFacet, err error) {
+	mm_atomic.AddUint64(&mmCountSKUsByType.beforeCountSKUsByTypeCounter, 1)
+	defer mm_atomic.AddUint64(&mmCountSKUsByType.afterCountSKUsByTypeCounter, 1)
 
-	mmGetSKUByID.t.Helper()
+	mmCountSKUsByType.t.Helper()
 
-	if mmGetSKUByID.inspectFuncGetSKUByID != nil {
-		mmGetSKUByID.inspectFuncGetSKUByID(ctx, skuID)
+	if mmCountSKUsByType.inspectFuncCountSKUsByType != nil {
+		mmCountSKUsByType.inspectFuncCountSKUsByType(ctx, filter)
 	}
 
-	mm_params := SKURepositoryMockGetSKUByIDParams{ctx, skuID}
+	mm_params := SKURepositoryMockCountSKUsByTypeParams{ctx, filter}
 
 	// Record call args
-	mmGetSKUByID.GetSKUByIDMock.mutex.Lock()
-	mmGetSKUByID.GetSKUByIDMock.callArgs = append(mmGetSKUByID.GetSKUByIDMock.callArgs, &mm_params)
-	mmGetSKUByID.GetSKUByIDMock.mutex.Unlock()
+	mmCountSKUsByType.CountSKUsByTypeMock.mutex.Lock()
+	mmCountSKUsByType.CountSKUsByTypeMock.callArgs = append(mmCountSKUsByType.CountSKUsByTypeMock.callArgs, &mm_params)
+	mmCountSKUsByType.CountSKUsByTypeMock.mutex.Unlock()
 
-	for _, e := range mmGetSKUByID.GetSKUByIDMock.expectations {
+	for _, e := range mmCountSKUsByType.CountSKUsByTypeMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.s1, e.results.err
+			return e.results.ta1, e.results.err
 		}
 	}
 
-	if mmGetSKUByID.GetSKUByIDMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetSKUByID.GetSKUByIDMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetSKUByID.GetSKUByIDMock.defaultExpectation.params
-		mm_want_ptrs := mmGetSKUByID.GetSKUByIDMock.defaultExpectation.paramPtrs
+	if mmCountSKUsByType.CountSKUsByTypeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCountSKUsByType.CountSKUsByTypeMock.defaultExpectation.Counter, 1)
+		mm_want := mmCountSKUsByType.CountSKUsByTypeMock.defaultExpectation.params
+		mm_want_ptrs := mmCountSKUsByType.CountSKUsByTypeMock.defaultExpectation.paramPtrs
 
-		mm_got := SKURepositoryMockGetSKUByIDParams{ctx, skuID}
+		mm_got := SKURepositoryMockCountSKUsByTypeParams{ctx, filter}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetSKUByID.t.Errorf("SKURepositoryMock.GetSKUByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetSKUByID.GetSKUByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmCountSKUsByType.t.Errorf("SKURepositoryMock.CountSKUsByType got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountSKUsByType.CountSKUsByTypeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
-				mmGetSKUByID.t.Errorf("SKURepositoryMock.GetSKUByID got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetSKUByID.GetSKUByIDMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmCountSKUsByType.t.Errorf("SKURepositoryMock.CountSKUsByType got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountSKUsByType.CountSKUsByTypeMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetSKUByID.t.Errorf("SKURepositoryMock.GetSKUByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetSKUByID.GetSKUByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmCountSKUsByType.t.Errorf("SKURepositoryMock.CountSKUsByType got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCountSKUsByType.CountSKUsByTypeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetSKUByID.GetSKUByIDMock.defaultExpectation.results
+		mm_results := mmCountSKUsByType.CountSKUsByTypeMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetSKUByID.t.Fatal("No results are set for the SKURepositoryMock.GetSKUByID")
+			mmCountSKUsByType.t.Fatal("No results are set for the SKURepositoryMock.CountSKUsByType")
 		}
-		return (*mm_results).s1, (*mm_results).err
+		return (*mm_results).ta1, (*mm_results).err
 	}
-	if mmGetSKUByID.funcGetSKUByID != nil {
-		return mmGetSKUByID.funcGetSKUByID(ctx, skuID)
+	if mmCountSKUsByType.funcCountSKUsByType != nil {
+		return mmCountSKUsByType.funcCountSKUsByType(ctx, filter)
 	}
-	mmGetSKUByID.t.Fatalf("Unexpected call to SKURepositoryMock.GetSKUByID. %v %v", ctx, skuID)
+	mmCountSKUsByType.t.Fatalf("Unexpected call to SKURepositoryMock.CountSKUsByType. %v %v", ctx, filter)
 	return
 }
 
-// GetSKUByIDAfterCounter returns a count of finished SKURepositoryMock.GetSKUByID invocations
-func (mmGetSKUByID *SKURepositoryMock) GetSKUByIDAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetSKUByID.afterGetSKUByIDCounter)
+// CountSKUsByTypeAfterCounter returns a count of finished SKURepositoryMock.CountSKUsByType invocations
+func (mmCountSKUsByType *SKURepositoryMock) CountSKUsByTypeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountSKUsByType.afterCountSKUsByTypeCounter)
 }
 
-// GetSKUByIDBeforeCounter returns a count of SKURepositoryMock.GetSKUByID invocations
-func (mmGetSKUByID *SKURepositoryMock) GetSKUByIDBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetSKUByID.beforeGetSKUByIDCounter)
+// CountSKUsByTypeBeforeCounter returns a count of SKURepositoryMock.CountSKUsByType invocations
+func (mmCountSKUsByType *SKURepositoryMock) CountSKUsByTypeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountSKUsByType.beforeCountSKUsByTypeCounter)
 }
 
-// Calls returns a list of arguments used in each call to SKURepositoryMock.GetSKUByID.
+// Calls returns a list of arguments used in each call to SKURepositoryMock.CountSKUsByType.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetSKUByID *mSKURepositoryMockGetSKUByID) Calls() []*SKURepositoryMockGetSKUByIDParams {
-	mmGetSKUByID.mutex.RLock()
+func (mmCountSKUsByType *mSKURepositoryMockCountSKUsByType) Calls() []*SKURepositoryMockCountSKUsByTypeParams {
+	mmCountSKUsByType.mutex.RLock()
 
-	argCopy := make([]*SKURepositoryMockGetSKUByIDParams, len(mmGetSKUByID.callArgs))
-	copy(argCopy, mmGetSKUByID.callArgs)
+	argCopy := make([]*SKURepositoryMockCountSKUsByTypeParams, len(mmCountSKUsByType.callArgs))
+	copy(argCopy, mmCountSKUsByType.callArgs)
 
-	mmGetSKUByID.mutex.RUnlock()
+	mmCountSKUsByType.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetSKUByIDDone returns true if the count of the GetSKUByID invocations corresponds
+// MinimockCountSKUsByTypeDone returns true if the count of the CountSKUsByType invocations corresponds
 // the number of defined expectations
-func (m *SKURepositoryMock) MinimockGetSKUByIDDone() bool {
-	if m.GetSKUByIDMock.optional {
+func (m *SKURepositoryMock) MinimockCountSKUsByTypeDone() bool {
+	if m.CountSKUsByTypeMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetSKUByIDMock.expectations {
+	for _, e := range m.CountSKUsByTypeMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetSKUByIDMock.invocationsDone()
+	return m.CountSKUsByTypeMock.invocationsDone()
 }
 
-// MinimockGetSKUByIDInspect logs each unmet expectation
-func (m *SKURepositoryMock) MinimockGetSKUByIDInspect() {
-	for _, e := range m.GetSKUByIDMock.expectations {
+// MinimockCountSKUsByTypeInspect logs each unmet expectation
+func (m *SKURepositoryMock) MinimockCountSKUsByTypeInspect() {
+	for _, e := range m.CountSKUsByTypeMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to SKURepositoryMock.GetSKUByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to SKURepositoryMock.CountSKUsByType at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetSKUByIDCounter := mm_atomic.LoadUint64(&m.afterGetSKUByIDCounter)
+	afterCountSKUsByTypeCounter := mm_atomic.LoadUint64(&m.afterCountSKUsByTypeCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetSKUByIDMock.defaultExpectation != nil && afterGetSKUByIDCounter < 1 {
-		if m.GetSKUByIDMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to SKURepositoryMock.GetSKUByID at\n%s", m.GetSKUByIDMock.defaultExpectation.returnOrigin)
+	if m.CountSKUsByTypeMock.defaultExpectation != nil && afterCountSKUsByTypeCounter < 1 {
+		if m.CountSKUsByTypeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SKURepositoryMock.CountSKUsByType at\n%s", m.CountSKUsByTypeMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to SKURepositoryMock.GetSKUByID at\n%s with params: %#v", m.GetSKUByIDMock.defaultExpectation.expectationOrigins.origin, *m.GetSKUByIDMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to SKURepositoryMock.CountSKUsByType at\n%s with params: %#v", m.CountSKUsByTypeMock.defaultExpectation.expectationOrigins.origin, *m.CountSKUsByTypeMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetSKUByID != nil && afterGetSKUByIDCounter < 1 {
-		m.t.Errorf("Expected call to SKURepositoryMock.GetSKUByID at\n%s", m.funcGetSKUByIDOrigin)
+	if m.funcCountSKUsByType != nil && afterCountSKUsByTypeCounter < 1 {
+		m.t.Errorf("Expected call to SKURepositoryMock.CountSKUsByType at\n%s", m.funcCountSKUsByTypeOrigin)
 	}
 
-	if !m.GetSKUByIDMock.invocationsDone() && afterGetSKUByIDCounter > 0 {
-		m.t.Errorf("Expected %d calls to SKURepositoryMock.GetSKUByID at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetSKUByIDMock.expectedInvocations), m.GetSKUByIDMock.expectedInvocationsOrigin, afterGetSKUByIDCounter)
+	if !m.CountSKUsByTypeMock.invocationsDone() && afterCountSKUsByTypeCounter > 0 {
+		m.t.Errorf("Expected %d calls to SKURepositoryMock.CountSKUsByType at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CountSKUsByTypeMock.expectedInvocations), m.CountSKUsByTypeMock.expectedInvocationsOrigin, afterCountSKUsByTypeCounter)
 	}
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *SKURepositoryMock) MinimockFinish() {
-	m.finishOnce.Do(func() {
-		if !m.minimockDone() {
-			m.MinimockGetSKUByIDInspect()
+type mSKURepositoryMockCreateVariantGroup struct {
+	optional           bool
+	mock               *SKURepositoryMock
+	defaultExpectation *SKURepositoryMockCreateVariantGroupExpectation
+	expectations       []*SKURepositoryMockCreateVariantGroupExpectation
+
+	callArgs []*SKURepositoryMockCreateVariantGroupParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SKURepositoryMockCreateVariantGroupExpectation specifies expectation struct of the SKURepository.CreateVariantGroup
+type SKURepositoryMockCreateVariantGroupExpectation struct {
+	mock               *SKURepositoryMock
+	params             *SKURepositoryMockCreateVariantGroupParams
+	paramPtrs          *SKURepositoryMockCreateVariantGroupParamPtrs
+	expectationOrigins SKURepositoryMockCreateVariantGroupExpectationOrigins
+	results            *SKURepositoryMockCreateVariantGroupResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SKURepositoryMockCreateVariantGroupParams contains parameters of the SKURepository.CreateVariantGroup
+type SKURepositoryMockCreateVariantGroupParams struct {
+	ctx   context.Context
+	group domain.VariantGroup
+}
+
+// SKURepositoryMockCreateVariantGroupParamPtrs contains pointers to parameters of the SKURepository.CreateVariantGroup
+type SKURepositoryMockCreateVariantGroupParamPtrs struct {
+	ctx   *context.Context
+	group *domain.VariantGroup
+}
+
+// SKURepositoryMockCreateVariantGroupResults contains results of the SKURepository.CreateVariantGroup
+type SKURepositoryMockCreateVariantGroupResults struct {
+	v1  domain.VariantGroup
+	err error
+}
+
+// SKURepositoryMockCreateVariantGroupOrigins contains origins of expectations of the SKURepository.CreateVariantGroup
+type SKURepositoryMockCreateVariantGroupExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originGroup string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateVariantGroup *mSKURepositoryMockCreateVariantGroup) Optional() *mSKURepositoryMockCreateVariantGroup {
+	mmCreateVariantGroup.optional = true
+	return mmCreateVariantGroup
+}
+
+// Expect sets up expected params for SKURepository.CreateVariantGroup
+func (mmCreateVariantGroup *mSKURepositoryMockCreateVariantGroup) Expect(ctx context.Context, group domain.VariantGroup) *mSKURepositoryMockCreateVariantGroup {
+	if mmCreateVariantGroup.mock.funcCreateVariantGroup != nil {
+		mmCreateVariantGroup.mock.t.Fatalf("SKURepositoryMock.CreateVariantGroup mock is already set by Set")
+	}
+
+	if mmCreateVariantGroup.defaultExpectation == nil {
+		mmCreateVariantGroup.defaultExpectation = &SKURepositoryMockCreateVariantGroupExpectation{}
+	}
+
+	if mmCreateVariantGroup.defaultExpectation.paramPtrs != nil {
+		mmCreateVariantGroup.mock.t.Fatalf("SKURepositoryMock.CreateVariantGroup mock is already set by ExpectParams functions")
+	}
+
+	mmCreateVariantGroup.defaultExpectation.params = &SKURepositoryMockCreateVariantGroupParams{ctx, group}
+	mmCreateVariantGroup.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateVariantGroup.expectations {
+		if minimock.Equal(e.params, mmCreateVariantGroup.defaultExpectation.params) {
+			mmCreateVariantGroup.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateVariantGroup.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateVariantGroup
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SKURepository.CreateVariantGroup
+func (mmCreateVariantGroup *mSKURepositoryMockCreateVariantGroup) ExpectCtxParam1(ctx context.Context) *mSKURepositoryMockCreateVariantGroup {
+	if mmCreateVariantGroup.mock.funcCreateVariantGroup != nil {
+		mmCreateVariantGroup.mock.t.Fatalf("SKURepositoryMock.CreateVariantGroup mock is already set by Set")
+	}
+
+	if mmCreateVariantGroup.defaultExpectation == nil {
+		mmCreateVariantGroup.defaultExpectation = &SKURepositoryMockCreateVariantGroupExpectation{}
+	}
+
+	if mmCreateVariantGroup.defaultExpectation.params != nil {
+		mmCreateVariantGroup.mock.t.Fatalf("SKURepositoryMock.CreateVariantGroup mock is already set by Expect")
+	}
+
+	if mmCreateVariantGroup.defaultExpectation.paramPtrs == nil {
+		mmCreateVariantGroup.defaultExpectation.paramPtrs = &SKURepositoryMockCreateVariantGroupParamPtrs{}
+	}
+	mmCreateVariantGroup.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateVariantGroup.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateVariantGroup
+}
+
+// ExpectGroupParam2 sets up expected param group for SKURepository.CreateVariantGroup
+func (mmCreateVariantGroup *mSKURepositoryMockCreateVariantGroup) ExpectGroupParam2(group domain.VariantGroup) *mSKURepositoryMockCreateVariantGroup {
+	if mmCreateVariantGroup.mock.funcCreateVariantGroup != nil {
+		mmCreateVariantGroup.mock.t.Fatalf("SKURepositoryMock.CreateVariantGroup mock is already set by Set")
+	}
+
+	if mmCreateVariantGroup.defaultExpectation == nil {
+		mmCreateVariantGroup.defaultExpectation = &SKURepositoryMockCreateVariantGroupExpectation{}
+	}
+
+	if mmCreateVariantGroup.defaultExpectation.params != nil {
+		mmCreateVariantGroup.mock.t.Fatalf("SKURepositoryMock.CreateVariantGroup mock is already set by Expect")
+	}
+
+	if mmCreateVariantGroup.defaultExpectation.paramPtrs == nil {
+		mmCreateVariantGroup.defaultExpectation.paramPtrs = &SKURepositoryMockCreateVariantGroupParamPtrs{}
+	}
+	mmCreateVariantGroup.defaultExpectation.paramPtrs.group = &group
+	mmCreateVariantGroup.defaultExpectation.expectationOrigins.originGroup = minimock.CallerInfo(1)
+
+	return mmCreateVariantGroup
+}
+
+// Inspect accepts an inspector function that has same arguments as the SKURepository.CreateVariantGroup
+func (mmCreateVariantGroup *mSKURepositoryMockCreateVariantGroup) Inspect(f func(ctx context.Context, group domain.VariantGroup)) *mSKURepositoryMockCreateVariantGroup {
+	if mmCreateVariantGroup.mock.inspectFuncCreateVariantGroup != nil {
+		mmCreateVariantGroup.mock.t.Fatalf("Inspect function is already set for SKURepositoryMock.CreateVariantGroup")
+	}
+
+	mmCreateVariantGroup.mock.inspectFuncCreateVariantGroup = f
+
+	return mmCreateVariantGroup
+}
+
+// Return sets up results that will be returned by SKURepository.CreateVariantGroup
+func (mmCreateVariantGroup *mSKURepositoryMockCreateVariantGroup) Return(v1 domain.VariantGroup, err error) *SKURepositoryMock {
+	if mmCreateVariantGroup.mock.funcCreateVariantGroup != nil {
+		mmCreateVariantGroup.mock.t.Fatalf("SKURepositoryMock.CreateVariantGroup mock is already set by Set")
+	}
+
+	if mmCreateVariantGroup.defaultExpectation == nil {
+		mmCreateVariantGroup.defaultExpectation = &SKURepositoryMockCreateVariantGroupExpectation{mock: mmCreateVariantGroup.mock}
+	}
+	mmCreateVariantGroup.defaultExpectation.results = &SKURepositoryMockCreateVariantGroupResults{v1, err}
+	mmCreateVariantGroup.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateVariantGroup.mock
+}
+
+// Set uses given function f to mock the SKURepository.CreateVariantGroup method
+func (mmCreateVariantGroup *mSKURepositoryMockCreateVariantGroup) Set(f func(ctx context.Context, group domain.VariantGroup) (v1 domain.VariantGroup, err error)) *SKURepositoryMock {
+	if mmCreateVariantGroup.defaultExpectation != nil {
+		mmCreateVariantGroup.mock.t.Fatalf("Default expectation is already set for the SKURepository.CreateVariantGroup method")
+	}
+
+	if len(mmCreateVariantGroup.expectations) > 0 {
+		mmCreateVariantGroup.mock.t.Fatalf("Some expectations are already set for the SKURepository.CreateVariantGroup method")
+	}
+
+	mmCreateVariantGroup.mock.funcCreateVariantGroup = f
+	mmCreateVariantGroup.mock.funcCreateVariantGroupOrigin = minimock.CallerInfo(1)
+	return mmCreateVariantGroup.mock
+}
+
+// When sets expectation for the SKURepository.CreateVariantGroup which will trigger the result defined by the following
+// Then helper
+func (mmCreateVariantGroup *mSKURepositoryMockCreateVariantGroup) When(ctx context.Context, group domain.VariantGroup) *SKURepositoryMockCreateVariantGroupExpectation {
+	if mmCreateVariantGroup.mock.funcCreateVariantGroup != nil {
+		mmCreateVariantGroup.mock.t.Fatalf("SKURepositoryMock.CreateVariantGroup mock is already set by Set")
+	}
+
+	expectation := &SKURepositoryMockCreateVariantGroupExpectation{
+		mock:               mmCreateVariantGroup.mock,
+		params:             &SKURepositoryMockCreateVariantGroupParams{ctx, group},
+		expectationOrigins: SKURepositoryMockCreateVariantGroupExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateVariantGroup.expectations = append(mmCreateVariantGroup.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SKURepository.CreateVariantGroup return parameters for the expectation previously defined by the When method
+func (e *SKURepositoryMockCreateVariantGroupExpectation) Then(v1 domain.VariantGroup, err error) *SKURepositoryMock {
+	e.results = &SKURepositoryMockCreateVariantGroupResults{v1, err}
+	return e.mock
+}
+
+// Times sets number of times SKURepository.CreateVariantGroup should be invoked
+func (mmCreateVariantGroup *mSKURepositoryMockCreateVariantGroup) Times(n uint64) *mSKURepositoryMockCreateVariantGroup {
+	if n == 0 {
+		mmCreateVariantGroup.mock.t.Fatalf("Times of SKURepositoryMock.CreateVariantGroup mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateVariantGroup.expectedInvocations, n)
+	mmCreateVariantGroup.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateVariantGroup
+}
+
+func (mmCreateVariantGroup *mSKURepositoryMockCreateVariantGroup) invocationsDone() bool {
+	if len(mmCreateVariantGroup.expectations) == 0 && mmCreateVariantGroup.defaultExpectation == nil && mmCreateVariantGroup.mock.funcCreateVariantGroup == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateVariantGroup.mock.afterCreateVariantGroupCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateVariantGroup.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateVariantGroup implements mm_stocks.SKURepository
+func (mmCreateVariantGroup *SKURepositoryMock) CreateVariantGroup(ctx context.Context, group domain.VariantGroup) (v1 domain.VariantGroup, err error) {
+	mm_atomic.AddUint64(&mmCreateVariantGroup.beforeCreateVariantGroupCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateVariantGroup.afterCreateVariantGroupCounter, 1)
+
+	mmCreateVariantGroup.t.Helper()
+
+	if mmCreateVariantGroup.inspectFuncCreateVariantGroup != nil {
+		mmCreateVariantGroup.inspectFuncCreateVariantGroup(ctx, group)
+	}
+
+	mm_params := SKURepositoryMockCreateVariantGroupParams{ctx, group}
+
+	// Record call args
+	mmCreateVariantGroup.CreateVariantGroupMock.mutex.Lock()
+	mmCreateVariantGroup.CreateVariantGroupMock.callArgs = append(mmCreateVariantGroup.CreateVariantGroupMock.callArgs, &mm_params)
+	mmCreateVariantGroup.CreateVariantGroupMock.mutex.Unlock()
+
+	for _, e := range mmCreateVariantGroup.CreateVariantGroupMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.v1, e.results.err
+		}
+	}
+
+	if mmCreateVariantGroup.CreateVariantGroupMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateVariantGroup.CreateVariantGroupMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateVariantGroup.CreateVariantGroupMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateVariantGroup.CreateVariantGroupMock.defaultExpectation.paramPtrs
+
+		mm_got := SKURepositoryMockCreateVariantGroupParams{ctx, group}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateVariantGroup.t.Errorf("SKURepositoryMock.CreateVariantGroup got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateVariantGroup.CreateVariantGroupMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.group != nil && !minimock.Equal(*mm_want_ptrs.group, mm_got.group) {
+				mmCreateVariantGroup.t.Errorf("SKURepositoryMock.CreateVariantGroup got unexpected parameter group, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateVariantGroup.CreateVariantGroupMock.defaultExpectation.expectationOrigins.originGroup, *mm_want_ptrs.group, mm_got.group, minimock.Diff(*mm_want_ptrs.group, mm_got.group))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateVariantGroup.t.Errorf("SKURepositoryMock.CreateVariantGroup got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateVariantGroup.CreateVariantGroupMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateVariantGroup.CreateVariantGroupMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateVariantGroup.t.Fatal("No results are set for the SKURepositoryMock.CreateVariantGroup")
+		}
+		return (*mm_results).v1, (*mm_results).err
+	}
+	if mmCreateVariantGroup.funcCreateVariantGroup != nil {
+		return mmCreateVariantGroup.funcCreateVariantGroup(ctx, group)
+	}
+	mmCreateVariantGroup.t.Fatalf("Unexpected call to SKURepositoryMock.CreateVariantGroup. %v %v", ctx, group)
+	return
+}
+
+// CreateVariantGroupAfterCounter returns a count of finished SKURepositoryMock.CreateVariantGroup invocations
+func (mmCreateVariantGroup *SKURepositoryMock) CreateVariantGroupAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateVariantGroup.afterCreateVariantGroupCounter)
+}
+
+// CreateVariantGroupBeforeCounter returns a count of SKURepositoryMock.CreateVariantGroup invocations
+func (mmCreateVariantGroup *SKURepositoryMock) CreateVariantGroupBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateVariantGroup.beforeCreateVariantGroupCounter)
+}
+
+// Calls returns a list of arguments used in each call to SKURepositoryMock.CreateVariantGroup.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateVariantGroup *mSKURepositoryMockCreateVariantGroup) Calls() []*SKURepositoryMockCreateVariantGroupParams {
+	mmCreateVariantGroup.mutex.RLock()
+
+	argCopy := make([]*SKURepositoryMockCreateVariantGroupParams, len(mmCreateVariantGroup.callArgs))
+	copy(argCopy, mmCreateVariantGroup.callArgs)
+
+	mmCreateVariantGroup.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateVariantGroupDone returns true if the count of the CreateVariantGroup invocations corresponds
+// the number of defined expectations
+func (m *SKURepositoryMock) MinimockCreateVariantGroupDone() bool {
+	if m.CreateVariantGroupMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateVariantGroupMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateVariantGroupMock.invocationsDone()
+}
+
+// MinimockCreateVariantGroupInspect logs each unmet expectation
+func (m *SKURepositoryMock) MinimockCreateVariantGroupInspect() {
+	for _, e := range m.CreateVariantGroupMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SKURepositoryMock.CreateVariantGroup at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateVariantGroupCounter := mm_atomic.LoadUint64(&m.afterCreateVariantGroupCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateVariantGroupMock.defaultExpectation != nil && afterCreateVariantGroupCounter < 1 {
+		if m.CreateVariantGroupMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SKURepositoryMock.CreateVariantGroup at\n%s", m.CreateVariantGroupMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SKURepositoryMock.CreateVariantGroup at\n%s with params: %#v", m.CreateVariantGroupMock.defaultExpectation.expectationOrigins.origin, *m.CreateVariantGroupMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateVariantGroup != nil && afterCreateVariantGroupCounter < 1 {
+		m.t.Errorf("Expected call to SKURepositoryMock.CreateVariantGroup at\n%s", m.funcCreateVariantGroupOrigin)
+	}
+
+	if !m.CreateVariantGroupMock.invocationsDone() && afterCreateVariantGroupCounter > 0 {
+		m.t.Errorf("Expected %d calls to SKURepositoryMock.CreateVariantGroup at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateVariantGroupMock.expectedInvocations), m.CreateVariantGroupMock.expectedInvocationsOrigin, afterCreateVariantGroupCounter)
+	}
+}
+
+type mSKURepositoryMockGetAttributeSchema struct {
+	optional           bool
+	mock               *SKURepositoryMock
+	defaultExpectation *SKURepositoryMockGetAttributeSchemaExpectation
+	expectations       []*SKURepositoryMockGetAttributeSchemaExpectation
+
+	callArgs []*SKURepositoryMockGetAttributeSchemaParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SKURepositoryMockGetAttributeSchemaExpectation specifies expectation struct of the SKURepository.GetAttributeSchema
+type SKURepositoryMockGetAttributeSchemaExpectation struct {
+	mock               *SKURepositoryMock
+	params             *SKURepositoryMockGetAttributeSchemaParams
+	paramPtrs          *SKURepositoryMockGetAttributeSchemaParamPtrs
+	expectationOrigins SKURepositoryMockGetAttributeSchemaExpectationOrigins
+	results            *SKURepositoryMockGetAttributeSchemaResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SKURepositoryMockGetAttributeSchemaParams contains parameters of the SKURepository.GetAttributeSchema
+type SKURepositoryMockGetAttributeSchemaParams struct {
+	ctx     context.Context
+	skuType string
+}
+
+// SKURepositoryMockGetAttributeSchemaParamPtrs contains pointers to parameters of the SKURepository.GetAttributeSchema
+type SKURepositoryMockGetAttributeSchemaParamPtrs struct {
+	ctx     *context.Context
+	skuType *string
+}
+
+// SKURepositoryMockGetAttributeSchemaResults contains results of the SKURepository.GetAttributeSchema
+type SKURepositoryMockGetAttributeSchemaResults struct {
+	a1  domain.AttributeSchema
+	err error
+}
+
+// SKURepositoryMockGetAttributeSchemaOrigins contains origins of expectations of the SKURepository.GetAttributeSchema
+type SKURepositoryMockGetAttributeSchemaExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originSkuType string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAttributeSchema *mSKURepositoryMockGetAttributeSchema) Optional() *mSKURepositoryMockGetAttributeSchema {
+	mmGetAttributeSchema.optional = true
+	return mmGetAttributeSchema
+}
+
+// Expect sets up expected params for SKURepository.GetAttributeSchema
+func (mmGetAttributeSchema *mSKURepositoryMockGetAttributeSchema) Expect(ctx context.Context, skuType string) *mSKURepositoryMockGetAttributeSchema {
+	if mmGetAttributeSchema.mock.funcGetAttributeSchema != nil {
+		mmGetAttributeSchema.mock.t.Fatalf("SKURepositoryMock.GetAttributeSchema mock is already set by Set")
+	}
+
+	if mmGetAttributeSchema.defaultExpectation == nil {
+		mmGetAttributeSchema.defaultExpectation = &SKURepositoryMockGetAttributeSchemaExpectation{}
+	}
+
+	if mmGetAttributeSchema.defaultExpectation.paramPtrs != nil {
+		mmGetAttributeSchema.mock.t.Fatalf("SKURepositoryMock.GetAttributeSchema mock is already set by ExpectParams functions")
+	}
+
+	mmGetAttributeSchema.defaultExpectation.params = &SKURepositoryMockGetAttributeSchemaParams{ctx, skuType}
+	mmGetAttributeSchema.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetAttributeSchema.expectations {
+		if minimock.Equal(e.params, mmGetAttributeSchema.defaultExpectation.params) {
+			mmGetAttributeSchema.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAttributeSchema.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAttributeSchema
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SKURepository.GetAttributeSchema
+func (mmGetAttributeSchema *mSKURepositoryMockGetAttributeSchema) ExpectCtxParam1(ctx context.Context) *mSKURepositoryMockGetAttributeSchema {
+	if mmGetAttributeSchema.mock.funcGetAttributeSchema != nil {
+		mmGetAttributeSchema.mock.t.Fatalf("SKURepositoryMock.GetAttributeSchema mock is already set by Set")
+	}
+
+	if mmGetAttributeSchema.defaultExpectation == nil {
+		mmGetAttributeSchema.defaultExpectation = &SKURepositoryMockGetAttributeSchemaExpectation{}
+	}
+
+	if mmGetAttributeSchema.defaultExpectation.params != nil {
+		mmGetAttributeSchema.mock.t.Fatalf("SKURepositoryMock.GetAttributeSchema mock is already set by Expect")
+	}
+
+	if mmGetAttributeSchema.defaultExpectation.paramPtrs == nil {
+		mmGetAttributeSchema.defaultExpectation.paramPtrs = &SKURepositoryMockGetAttributeSchemaParamPtrs{}
+	}
+	mmGetAttributeSchema.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetAttributeSchema.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetAttributeSchema
+}
+
+// ExpectSkuTypeParam2 sets up expected param skuType for SKURepository.GetAttributeSchema
+func (mmGetAttributeSchema *mSKURepositoryMockGetAttributeSchema) ExpectSkuTypeParam2(skuType string) *mSKURepositoryMockGetAttributeSchema {
+	if mmGetAttributeSchema.mock.funcGetAttributeSchema != nil {
+		mmGetAttributeSchema.mock.t.Fatalf("SKURepositoryMock.GetAttributeSchema mock is already set by Set")
+	}
+
+	if mmGetAttributeSchema.defaultExpectation == nil {
+		mmGetAttributeSchema.defaultExpectation = &SKURepositoryMockGetAttributeSchemaExpectation{}
+	}
+
+	if mmGetAttributeSchema.defaultExpectation.params != nil {
+		mmGetAttributeSchema.mock.t.Fatalf("SKURepositoryMock.GetAttributeSchema mock is already set by Expect")
+	}
+
+	if mmGetAttributeSchema.defaultExpectation.paramPtrs == nil {
+		mmGetAttributeSchema.defaultExpectation.paramPtrs = &SKURepositoryMockGetAttributeSchemaParamPtrs{}
+	}
+	mmGetAttributeSchema.defaultExpectation.paramPtrs.skuType = &skuType
+	mmGetAttributeSchema.defaultExpectation.expectationOrigins.originSkuType = minimock.CallerInfo(1)
+
+	return mmGetAttributeSchema
+}
+
+// Inspect accepts an inspector function that has same arguments as the SKURepository.GetAttributeSchema
+func (mmGetAttributeSchema *mSKURepositoryMockGetAttributeSchema) Inspect(f func(ctx context.Context, skuType string)) *mSKURepositoryMockGetAttributeSchema {
+	if mmGetAttributeSchema.mock.inspectFuncGetAttributeSchema != nil {
+		mmGetAttributeSchema.mock.t.Fatalf("Inspect function is already set for SKURepositoryMock.GetAttributeSchema")
+	}
+
+	mmGetAttributeSchema.mock.inspectFuncGetAttributeSchema = f
+
+	return mmGetAttributeSchema
+}
+
+// Return sets up results that will be returned by SKURepository.GetAttributeSchema
+func (mmGetAttributeSchema *mSKURepositoryMockGetAttributeSchema) Return(a1 domain.AttributeSchema, err error) *SKURepositoryMock {
+	if mmGetAttributeSchema.mock.funcGetAttributeSchema != nil {
+		mmGetAttributeSchema.mock.t.Fatalf("SKURepositoryMock.GetAttributeSchema mock is already set by Set")
+	}
+
+	if mmGetAttributeSchema.defaultExpectation == nil {
+		mmGetAttributeSchema.defaultExpectation = &SKURepositoryMockGetAttributeSchemaExpectation{mock: mmGetAttributeSchema.mock}
+	}
+	mmGetAttributeSchema.defaultExpectation.results = &SKURepositoryMockGetAttributeSchemaResults{a1, err}
+	mmGetAttributeSchema.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetAttributeSchema.mock
+}
+
+// Set uses given function f to mock the SKURepository.GetAttributeSchema method
+func (mmGetAttributeSchema *mSKURepositoryMockGetAttributeSchema) Set(f func(ctx context.Context, skuType string) (a1 domain.AttributeSchema, err error)) *SKURepositoryMock {
+	if mmGetAttributeSchema.defaultExpectation != nil {
+		mmGetAttributeSchema.mock.t.Fatalf("Default expectation is already set for the SKURepository.GetAttributeSchema method")
+	}
+
+	if len(mmGetAttributeSchema.expectations) > 0 {
+		mmGetAttributeSchema.mock.t.Fatalf("Some expectations are already set for the SKURepository.GetAttributeSchema method")
+	}
+
+	mmGetAttributeSchema.mock.funcGetAttributeSchema = f
+	mmGetAttributeSchema.mock.funcGetAttributeSchemaOrigin = minimock.CallerInfo(1)
+	return mmGetAttributeSchema.mock
+}
+
+// When sets expectation for the SKURepository.GetAttributeSchema which will trigger the result defined by the following
+// Then helper
+func (mmGetAttributeSchema *mSKURepositoryMockGetAttributeSchema) When(ctx context.Context, skuType string) *SKURepositoryMockGetAttributeSchemaExpectation {
+	if mmGetAttributeSchema.mock.funcGetAttributeSchema != nil {
+		mmGetAttributeSchema.mock.t.Fatalf("SKURepositoryMock.GetAttributeSchema mock is already set by Set")
+	}
+
+	expectation := &SKURepositoryMockGetAttributeSchemaExpectation{
+		mock:               mmGetAttributeSchema.mock,
+		params:             &SKURepositoryMockGetAttributeSchemaParams{ctx, skuType},
+		expectationOrigins: SKURepositoryMockGetAttributeSchemaExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetAttributeSchema.expectations = append(mmGetAttributeSchema.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SKURepository.GetAttributeSchema return parameters for the expectation previously defined by the When method
+func (e *SKURepositoryMockGetAttributeSchemaExpectation) Then(a1 domain.AttributeSchema, err error) *SKURepositoryMock {
+	e.results = &SKURepositoryMockGetAttributeSchemaResults{a1, err}
+	return e.mock
+}
+
+// Times sets number of times SKURepository.GetAttributeSchema should be invoked
+func (mmGetAttributeSchema *mSKURepositoryMockGetAttributeSchema) Times(n uint64) *mSKURepositoryMockGetAttributeSchema {
+	if n == 0 {
+		mmGetAttributeSchema.mock.t.Fatalf("Times of SKURepositoryMock.GetAttributeSchema mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAttributeSchema.expectedInvocations, n)
+	mmGetAttributeSchema.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetAttributeSchema
+}
+
+func (mmGetAttributeSchema *mSKURepositoryMockGetAttributeSchema) invocationsDone() bool {
+	if len(mmGetAttributeSchema.expectations) == 0 && mmGetAttributeSchema.defaultExpectation == nil && mmGetAttributeSchema.mock.funcGetAttributeSchema == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAttributeSchema.mock.afterGetAttributeSchemaCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAttributeSchema.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAttributeSchema implements mm_stocks.SKURepository
+func (mmGetAttributeSchema *SKURepositoryMock) GetAttributeSchema(ctx context.Context, skuType string) (a1 domain.AttributeSchema, err error) {
+	mm_atomic.AddUint64(&mmGetAttributeSchema.beforeGetAttributeSchemaCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAttributeSchema.afterGetAttributeSchemaCounter, 1)
+
+	mmGetAttributeSchema.t.Helper()
+
+	if mmGetAttributeSchema.inspectFuncGetAttributeSchema != nil {
+		mmGetAttributeSchema.inspectFuncGetAttributeSchema(ctx, skuType)
+	}
+
+	mm_params := SKURepositoryMockGetAttributeSchemaParams{ctx, skuType}
+
+	// Record call args
+	mmGetAttributeSchema.GetAttributeSchemaMock.mutex.Lock()
+	mmGetAttributeSchema.GetAttributeSchemaMock.callArgs = append(mmGetAttributeSchema.GetAttributeSchemaMock.callArgs, &mm_params)
+	mmGetAttributeSchema.GetAttributeSchemaMock.mutex.Unlock()
+
+	for _, e := range mmGetAttributeSchema.GetAttributeSchemaMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.a1, e.results.err
+		}
+	}
+
+	if mmGetAttributeSchema.GetAttributeSchemaMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAttributeSchema.GetAttributeSchemaMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAttributeSchema.GetAttributeSchemaMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAttributeSchema.GetAttributeSchemaMock.defaultExpectation.paramPtrs
+
+		mm_got := SKURepositoryMockGetAttributeSchemaParams{ctx, skuType}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAttributeSchema.t.Errorf("SKURepositoryMock.GetAttributeSchema got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAttributeSchema.GetAttributeSchemaMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.skuType != nil && !minimock.Equal(*mm_want_ptrs.skuType, mm_got.skuType) {
+				mmGetAttributeSchema.t.Errorf("SKURepositoryMock.GetAttributeSchema got unexpected parameter skuType, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAttributeSchema.GetAttributeSchemaMock.defaultExpectation.expectationOrigins.originSkuType, *mm_want_ptrs.skuType, mm_got.skuType, minimock.Diff(*mm_want_ptrs.skuType, mm_got.skuType))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAttributeSchema.t.Errorf("SKURepositoryMock.GetAttributeSchema got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetAttributeSchema.GetAttributeSchemaMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAttributeSchema.GetAttributeSchemaMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAttributeSchema.t.Fatal("No results are set for the SKURepositoryMock.GetAttributeSchema")
+		}
+		return (*mm_results).a1, (*mm_results).err
+	}
+	if mmGetAttributeSchema.funcGetAttributeSchema != nil {
+		return mmGetAttributeSchema.funcGetAttributeSchema(ctx, skuType)
+	}
+	mmGetAttributeSchema.t.Fatalf("Unexpected call to SKURepositoryMock.GetAttributeSchema. %v %v", ctx, skuType)
+	return
+}
+
+// GetAttributeSchemaAfterCounter returns a count of finished SKURepositoryMock.GetAttributeSchema invocations
+func (mmGetAttributeSchema *SKURepositoryMock) GetAttributeSchemaAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAttributeSchema.afterGetAttributeSchemaCounter)
+}
+
+// GetAttributeSchemaBeforeCounter returns a count of SKURepositoryMock.GetAttributeSchema invocations
+func (mmGetAttributeSchema *SKURepositoryMock) GetAttributeSchemaBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAttributeSchema.beforeGetAttributeSchemaCounter)
+}
+
+// Calls returns a list of arguments used in each call to SKURepositoryMock.GetAttributeSchema.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAttributeSchema *mSKURepositoryMockGetAttributeSchema) Calls() []*SKURepositoryMockGetAttributeSchemaParams {
+	mmGetAttributeSchema.mutex.RLock()
+
+	argCopy := make([]*SKURepositoryMockGetAttributeSchemaParams, len(mmGetAttributeSchema.callArgs))
+	copy(argCopy, mmGetAttributeSchema.callArgs)
+
+	mmGetAttributeSchema.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAttributeSchemaDone returns true if the count of the GetAttributeSchema invocations corresponds
+// the number of defined expectations
+func (m *SKURepositoryMock) MinimockGetAttributeSchemaDone() bool {
+	if m.GetAttributeSchemaMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAttributeSchemaMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAttributeSchemaMock.invocationsDone()
+}
+
+// MinimockGetAttributeSchemaInspect logs each unmet expectation
+func (m *SKURepositoryMock) MinimockGetAttributeSchemaInspect() {
+	for _, e := range m.GetAttributeSchemaMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SKURepositoryMock.GetAttributeSchema at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetAttributeSchemaCounter := mm_atomic.LoadUint64(&m.afterGetAttributeSchemaCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAttributeSchemaMock.defaultExpectation != nil && afterGetAttributeSchemaCounter < 1 {
+		if m.GetAttributeSchemaMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SKURepositoryMock.GetAttributeSchema at\n%s", m.GetAttributeSchemaMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SKURepositoryMock.GetAttributeSchema at\n%s with params: %#v", m.GetAttributeSchemaMock.defaultExpectation.expectationOrigins.origin, *m.GetAttributeSchemaMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAttributeSchema != nil && afterGetAttributeSchemaCounter < 1 {
+		m.t.Errorf("Expected call to SKURepositoryMock.GetAttributeSchema at\n%s", m.funcGetAttributeSchemaOrigin)
+	}
+
+	if !m.GetAttributeSchemaMock.invocationsDone() && afterGetAttributeSchemaCounter > 0 {
+		m.t.Errorf("Expected %d calls to SKURepositoryMock.GetAttributeSchema at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAttributeSchemaMock.expectedInvocations), m.GetAttributeSchemaMock.expectedInvocationsOrigin, afterGetAttributeSchemaCounter)
+	}
+}
+
+type mSKURepositoryMockGetBundleComponents struct {
+	optional           bool
+	mock               *SKURepositoryMock
+	defaultExpectation *SKURepositoryMockGetBundleComponentsExpectation
+	expectations       []*SKURepositoryMockGetBundleComponentsExpectation
+
+	callArgs []*SKURepositoryMockGetBundleComponentsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SKURepositoryMockGetBundleComponentsExpectation specifies expectation struct of the SKURepository.GetBundleComponents
+type SKURepositoryMockGetBundleComponentsExpectation struct {
+	mock               *SKURepositoryMock
+	params             *SKURepositoryMockGetBundleComponentsParams
+	paramPtrs          *SKURepositoryMockGetBundleComponentsParamPtrs
+	expectationOrigins SKURepositoryMockGetBundleComponentsExpectationOrigins
+	results            *SKURepositoryMockGetBundleComponentsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SKURepositoryMockGetBundleComponentsParams contains parameters of the SKURepository.GetBundleComponents
+type SKURepositoryMockGetBundleComponentsParams struct {
+	ctx   context.Context
+	skuID domain.SKUID
+}
+
+// SKURepositoryMockGetBundleComponentsParamPtrs contains pointers to parameters of the SKURepository.GetBundleComponents
+type SKURepositoryMockGetBundleComponentsParamPtrs struct {
+	ctx   *context.Context
+	skuID *domain.SKUID
+}
+
+// SKURepositoryMockGetBundleComponentsResults contains results of the SKURepository.GetBundleComponents
+type SKURepositoryMockGetBundleComponentsResults struct {
+	ba1 []domain.BundleComponent
+	err error
+}
+
+// SKURepositoryMockGetBundleComponentsOrigins contains origins of expectations of the SKURepository.GetBundleComponents
+type SKURepositoryMockGetBundleComponentsExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originSkuID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetBundleComponents *mSKURepositoryMockGetBundleComponents) Optional() *mSKURepositoryMockGetBundleComponents {
+	mmGetBundleComponents.optional = true
+	return mmGetBundleComponents
+}
+
+// Expect sets up expected params for SKURepository.GetBundleComponents
+func (mmGetBundleComponents *mSKURepositoryMockGetBundleComponents) Expect(ctx context.Context, skuID domain.SKUID) *mSKURepositoryMockGetBundleComponents {
+	if mmGetBundleComponents.mock.funcGetBundleComponents != nil {
+		mmGetBundleComponents.mock.t.Fatalf("SKURepositoryMock.GetBundleComponents mock is already set by Set")
+	}
+
+	if mmGetBundleComponents.defaultExpectation == nil {
+		mmGetBundleComponents.defaultExpectation = &SKURepositoryMockGetBundleComponentsExpectation{}
+	}
+
+	if mmGetBundleComponents.defaultExpectation.paramPtrs != nil {
+		mmGetBundleComponents.mock.t.Fatalf("SKURepositoryMock.GetBundleComponents mock is already set by ExpectParams functions")
+	}
+
+	mmGetBundleComponents.defaultExpectation.params = &SKURepositoryMockGetBundleComponentsParams{ctx, skuID}
+	mmGetBundleComponents.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetBundleComponents.expectations {
+		if minimock.Equal(e.params, mmGetBundleComponents.defaultExpectation.params) {
+			mmGetBundleComponents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetBundleComponents.defaultExpectation.params)
+		}
+	}
+
+	return mmGetBundleComponents
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SKURepository.GetBundleComponents
+func (mmGetBundleComponents *mSKURepositoryMockGetBundleComponents) ExpectCtxParam1(ctx context.Context) *mSKURepositoryMockGetBundleComponents {
+	if mmGetBundleComponents.mock.funcGetBundleComponents != nil {
+		mmGetBundleComponents.mock.t.Fatalf("SKURepositoryMock.GetBundleComponents mock is already set by Set")
+	}
+
+	if mmGetBundleComponents.defaultExpectation == nil {
+		mmGetBundleComponents.defaultExpectation = &SKURepositoryMockGetBundleComponentsExpectation{}
+	}
+
+	if mmGetBundleComponents.defaultExpectation.params != nil {
+		mmGetBundleComponents.mock.t.Fatalf("SKURepositoryMock.GetBundleComponents mock is already set by Expect")
+	}
+
+	if mmGetBundleComponents.defaultExpectation.paramPtrs == nil {
+		mmGetBundleComponents.defaultExpectation.paramPtrs = &SKURepositoryMockGetBundleComponentsParamPtrs{}
+	}
+	mmGetBundleComponents.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetBundleComponents.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetBundleComponents
+}
+
+// ExpectSkuIDParam2 sets up expected param skuID for SKURepository.GetBundleComponents
+func (mmGetBundleComponents *mSKURepositoryMockGetBundleComponents) ExpectSkuIDParam2(skuID domain.SKUID) *mSKURepositoryMockGetBundleComponents {
+	if mmGetBundleComponents.mock.funcGetBundleComponents != nil {
+		mmGetBundleComponents.mock.t.Fatalf("SKURepositoryMock.GetBundleComponents mock is already set by Set")
+	}
+
+	if mmGetBundleComponents.defaultExpectation == nil {
+		mmGetBundleComponents.defaultExpectation = &SKURepositoryMockGetBundleComponentsExpectation{}
+	}
+
+	if mmGetBundleComponents.defaultExpectation.params != nil {
+		mmGetBundleComponents.mock.t.Fatalf("SKURepositoryMock.GetBundleComponents mock is already set by Expect")
+	}
+
+	if mmGetBundleComponents.defaultExpectation.paramPtrs == nil {
+		mmGetBundleComponents.defaultExpectation.paramPtrs = &SKURepositoryMockGetBundleComponentsParamPtrs{}
+	}
+	mmGetBundleComponents.defaultExpectation.paramPtrs.skuID = &skuID
+	mmGetBundleComponents.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmGetBundleComponents
+}
+
+// Inspect accepts an inspector function that has same arguments as the SKURepository.GetBundleComponents
+func (mmGetBundleComponents *mSKURepositoryMockGetBundleComponents) Inspect(f func(ctx context.Context, skuID domain.SKUID)) *mSKURepositoryMockGetBundleComponents {
+	if mmGetBundleComponents.mock.inspectFuncGetBundleComponents != nil {
+		mmGetBundleComponents.mock.t.Fatalf("Inspect function is already set for SKURepositoryMock.GetBundleComponents")
+	}
+
+	mmGetBundleComponents.mock.inspectFuncGetBundleComponents = f
+
+	return mmGetBundleComponents
+}
+
+// Return sets up results that will be returned by SKURepository.GetBundleComponents
+func (mmGetBundleComponents *mSKURepositoryMockGetBundleComponents) Return(ba1 []domain.BundleComponent, err error) *SKURepositoryMock {
+	if mmGetBundleComponents.mock.funcGetBundleComponents != nil {
+		mmGetBundleComponents.mock.t.Fatalf("SKURepositoryMock.GetBundleComponents mock is already set by Set")
+	}
+
+	if mmGetBundleComponents.defaultExpectation == nil {
+		mmGetBundleComponents.defaultExpectation = &SKURepositoryMockGetBundleComponentsExpectation{mock: mmGetBundleComponents.mock}
+	}
+	mmGetBundleComponents.defaultExpectation.results = &SKURepositoryMockGetBundleComponentsResults{ba1, err}
+	mmGetBundleComponents.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetBundleComponents.mock
+}
+
+// Set uses given function f to mock the SKURepository.GetBundleComponents method
+func (mmGetBundleComponents *mSKURepositoryMockGetBundleComponents) Set(f func(ctx context.Context, skuID domain.SKUID) (ba1 []domain.BundleComponent, err error)) *SKURepositoryMock {
+	if mmGetBundleComponents.defaultExpectation != nil {
+		mmGetBundleComponents.mock.t.Fatalf("Default expectation is already set for the SKURepository.GetBundleComponents method")
+	}
+
+	if len(mmGetBundleComponents.expectations) > 0 {
+		mmGetBundleComponents.mock.t.Fatalf("Some expectations are already set for the SKURepository.GetBundleComponents method")
+	}
+
+	mmGetBundleComponents.mock.funcGetBundleComponents = f
+	mmGetBundleComponents.mock.funcGetBundleComponentsOrigin = minimock.CallerInfo(1)
+	return mmGetBundleComponents.mock
+}
+
+// When sets expectation for the SKURepository.GetBundleComponents which will trigger the result defined by the following
+// Then helper
+func (mmGetBundleComponents *mSKURepositoryMockGetBundleComponents) When(ctx context.Context, skuID domain.SKUID) *SKURepositoryMockGetBundleComponentsExpectation {
+	if mmGetBundleComponents.mock.funcGetBundleComponents != nil {
+		mmGetBundleComponents.mock.t.Fatalf("SKURepositoryMock.GetBundleComponents mock is already set by Set")
+	}
+
+	expectation := &SKURepositoryMockGetBundleComponentsExpectation{
+		mock:               mmGetBundleComponents.mock,
+		params:             &SKURepositoryMockGetBundleComponentsParams{ctx, skuID},
+		expectationOrigins: SKURepositoryMockGetBundleComponentsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetBundleComponents.expectations = append(mmGetBundleComponents.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SKURepository.GetBundleComponents return parameters for the expectation previously defined by the When method
+func (e *SKURepositoryMockGetBundleComponentsExpectation) Then(ba1 []domain.BundleComponent, err error) *SKURepositoryMock {
+	e.results = &SKURepositoryMockGetBundleComponentsResults{ba1, err}
+	return e.mock
+}
+
+// Times sets number of times SKURepository.GetBundleComponents should be invoked
+func (mmGetBundleComponents *mSKURepositoryMockGetBundleComponents) Times(n uint64) *mSKURepositoryMockGetBundleComponents {
+	if n == 0 {
+		mmGetBundleComponents.mock.t.Fatalf("Times of SKURepositoryMock.GetBundleComponents mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetBundleComponents.expectedInvocations, n)
+	mmGetBundleComponents.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetBundleComponents
+}
+
+func (mmGetBundleComponents *mSKURepositoryMockGetBundleComponents) invocationsDone() bool {
+	if len(mmGetBundleComponents.expectations) == 0 && mmGetBundleComponents.defaultExpectation == nil && mmGetBundleComponents.mock.funcGetBundleComponents == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetBundleComponents.mock.afterGetBundleComponentsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetBundleComponents.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetBundleComponents implements mm_stocks.SKURepository
+func (mmGetBundleComponents *SKURepositoryMock) GetBundleComponents(ctx context.Context, skuID domain.SKUID) (ba1 []domain.BundleComponent, err error) {
+	mm_atomic.AddUint64(&mmGetBundleComponents.beforeGetBundleComponentsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetBundleComponents.afterGetBundleComponentsCounter, 1)
+
+	mmGetBundleComponents.t.Helper()
+
+	if mmGetBundleComponents.inspectFuncGetBundleComponents != nil {
+		mmGetBundleComponents.inspectFuncGetBundleComponents(ctx, skuID)
+	}
+
+	mm_params := SKURepositoryMockGetBundleComponentsParams{ctx, skuID}
+
+	// Record call args
+	mmGetBundleComponents.GetBundleComponentsMock.mutex.Lock()
+	mmGetBundleComponents.GetBundleComponentsMock.callArgs = append(mmGetBundleComponents.GetBundleComponentsMock.callArgs, &mm_params)
+	mmGetBundleComponents.GetBundleComponentsMock.mutex.Unlock()
+
+	for _, e := range mmGetBundleComponents.GetBundleComponentsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ba1, e.results.err
+		}
+	}
+
+	if mmGetBundleComponents.GetBundleComponentsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetBundleComponents.GetBundleComponentsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetBundleComponents.GetBundleComponentsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetBundleComponents.GetBundleComponentsMock.defaultExpectation.paramPtrs
+
+		mm_got := SKURepositoryMockGetBundleComponentsParams{ctx, skuID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetBundleComponents.t.Errorf("SKURepositoryMock.GetBundleComponents got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetBundleComponents.GetBundleComponentsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmGetBundleComponents.t.Errorf("SKURepositoryMock.GetBundleComponents got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetBundleComponents.GetBundleComponentsMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetBundleComponents.t.Errorf("SKURepositoryMock.GetBundleComponents got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetBundleComponents.GetBundleComponentsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetBundleComponents.GetBundleComponentsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetBundleComponents.t.Fatal("No results are set for the SKURepositoryMock.GetBundleComponents")
+		}
+		return (*mm_results).ba1, (*mm_results).err
+	}
+	if mmGetBundleComponents.funcGetBundleComponents != nil {
+		return mmGetBundleComponents.funcGetBundleComponents(ctx, skuID)
+	}
+	mmGetBundleComponents.t.Fatalf("Unexpected call to SKURepositoryMock.GetBundleComponents. %v %v", ctx, skuID)
+	return
+}
+
+// GetBundleComponentsAfterCounter returns a count of finished SKURepositoryMock.GetBundleComponents invocations
+func (mmGetBundleComponents *SKURepositoryMock) GetBundleComponentsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBundleComponents.afterGetBundleComponentsCounter)
+}
+
+// GetBundleComponentsBeforeCounter returns a count of SKURepositoryMock.GetBundleComponents invocations
+func (mmGetBundleComponents *SKURepositoryMock) GetBundleComponentsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetBundleComponents.beforeGetBundleComponentsCounter)
+}
+
+// Calls returns a list of arguments used in each call to SKURepositoryMock.GetBundleComponents.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetBundleComponents *mSKURepositoryMockGetBundleComponents) Calls() []*SKURepositoryMockGetBundleComponentsParams {
+	mmGetBundleComponents.mutex.RLock()
+
+	argCopy := make([]*SKURepositoryMockGetBundleComponentsParams, len(mmGetBundleComponents.callArgs))
+	copy(argCopy, mmGetBundleComponents.callArgs)
+
+	mmGetBundleComponents.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetBundleComponentsDone returns true if the count of the GetBundleComponents invocations corresponds
+// the number of defined expectations
+func (m *SKURepositoryMock) MinimockGetBundleComponentsDone() bool {
+	if m.GetBundleComponentsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetBundleComponentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetBundleComponentsMock.invocationsDone()
+}
+
+// MinimockGetBundleComponentsInspect logs each unmet expectation
+func (m *SKURepositoryMock) MinimockGetBundleComponentsInspect() {
+	for _, e := range m.GetBundleComponentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SKURepositoryMock.GetBundleComponents at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetBundleComponentsCounter := mm_atomic.LoadUint64(&m.afterGetBundleComponentsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetBundleComponentsMock.defaultExpectation != nil && afterGetBundleComponentsCounter < 1 {
+		if m.GetBundleComponentsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SKURepositoryMock.GetBundleComponents at\n%s", m.GetBundleComponentsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SKURepositoryMock.GetBundleComponents at\n%s with params: %#v", m.GetBundleComponentsMock.defaultExpectation.expectationOrigins.origin, *m.GetBundleComponentsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetBundleComponents != nil && afterGetBundleComponentsCounter < 1 {
+		m.t.Errorf("Expected call to SKURepositoryMock.GetBundleComponents at\n%s", m.funcGetBundleComponentsOrigin)
+	}
+
+	if !m.GetBundleComponentsMock.invocationsDone() && afterGetBundleComponentsCounter > 0 {
+		m.t.Errorf("Expected %d calls to SKURepositoryMock.GetBundleComponents at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetBundleComponentsMock.expectedInvocations), m.GetBundleComponentsMock.expectedInvocationsOrigin, afterGetBundleComponentsCounter)
+	}
+}
+
+type mSKURepositoryMockGetSKUByID struct {
+	optional           bool
+	mock               *SKURepositoryMock
+	defaultExpectation *SKURepositoryMockGetSKUByIDExpectation
+	expectations       []*SKURepositoryMockGetSKUByIDExpectation
+
+	callArgs []*SKURepositoryMockGetSKUByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SKURepositoryMockGetSKUByIDExpectation specifies expectation struct of the SKURepository.GetSKUByID
+type SKURepositoryMockGetSKUByIDExpectation struct {
+	mock               *SKURepositoryMock
+	params             *SKURepositoryMockGetSKUByIDParams
+	paramPtrs          *SKURepositoryMockGetSKUByIDParamPtrs
+	expectationOrigins SKURepositoryMockGetSKUByIDExpectationOrigins
+	results            *SKURepositoryMockGetSKUByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SKURepositoryMockGetSKUByIDParams contains parameters of the SKURepository.GetSKUByID
+type SKURepositoryMockGetSKUByIDParams struct {
+	ctx   context.Context
+	skuID domain.SKUID
+}
+
+// SKURepositoryMockGetSKUByIDParamPtrs contains pointers to parameters of the SKURepository.GetSKUByID
+type SKURepositoryMockGetSKUByIDParamPtrs struct {
+	ctx   *context.Context
+	skuID *domain.SKUID
+}
+
+// SKURepositoryMockGetSKUByIDResults contains results of the SKURepository.GetSKUByID
+type SKURepositoryMockGetSKUByIDResults struct {
+	s1  domain.SKU
+	err error
+}
+
+// SKURepositoryMockGetSKUByIDOrigins contains origins of expectations of the SKURepository.GetSKUByID
+type SKURepositoryMockGetSKUByIDExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originSkuID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetSKUByID *mSKURepositoryMockGetSKUByID) Optional() *mSKURepositoryMockGetSKUByID {
+	mmGetSKUByID.optional = true
+	return mmGetSKUByID
+}
+
+// Expect sets up expected params for SKURepository.GetSKUByID
+func (mmGetSKUByID *mSKURepositoryMockGetSKUByID) Expect(ctx context.Context, skuID domain.SKUID) *mSKURepositoryMockGetSKUByID {
+	if mmGetSKUByID.mock.funcGetSKUByID != nil {
+		mmGetSKUByID.mock.t.Fatalf("SKURepositoryMock.GetSKUByID mock is already set by Set")
+	}
+
+	if mmGetSKUByID.defaultExpectation == nil {
+		mmGetSKUByID.defaultExpectation = &SKURepositoryMockGetSKUByIDExpectation{}
+	}
+
+	if mmGetSKUByID.defaultExpectation.paramPtrs != nil {
+		mmGetSKUByID.mock.t.Fatalf("SKURepositoryMock.GetSKUByID mock is already set by ExpectParams functions")
+	}
+
+	mmGetSKUByID.defaultExpectation.params = &SKURepositoryMockGetSKUByIDParams{ctx, skuID}
+	mmGetSKUByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetSKUByID.expectations {
+		if minimock.Equal(e.params, mmGetSKUByID.defaultExpectation.params) {
+			mmGetSKUByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetSKUByID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetSKUByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SKURepository.GetSKUByID
+func (mmGetSKUByID *mSKURepositoryMockGetSKUByID) ExpectCtxParam1(ctx context.Context) *mSKURepositoryMockGetSKUByID {
+	if mmGetSKUByID.mock.funcGetSKUByID != nil {
+		mmGetSKUByID.mock.t.Fatalf("SKURepositoryMock.GetSKUByID mock is already set by Set")
+	}
+
+	if mmGetSKUByID.defaultExpectation == nil {
+		mmGetSKUByID.defaultExpectation = &SKURepositoryMockGetSKUByIDExpectation{}
+	}
+
+	if mmGetSKUByID.defaultExpectation.params != nil {
+		mmGetSKUByID.mock.t.Fatalf("SKURepositoryMock.GetSKUByID mock is already set by Expect")
+	}
+
+	if mmGetSKUByID.defaultExpectation.paramPtrs == nil {
+		mmGetSKUByID.defaultExpectation.paramPtrs = &SKURepositoryMockGetSKUByIDParamPtrs{}
+	}
+	mmGetSKUByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetSKUByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetSKUByID
+}
+
+// ExpectSkuIDParam2 sets up expected param skuID for SKURepository.GetSKUByID
+func (mmGetSKUByID *mSKURepositoryMockGetSKUByID) ExpectSkuIDParam2(skuID domain.SKUID) *mSKURepositoryMockGetSKUByID {
+	if mmGetSKUByID.mock.funcGetSKUByID != nil {
+		mmGetSKUByID.mock.t.Fatalf("SKURepositoryMock.GetSKUByID mock is already set by Set")
+	}
+
+	if mmGetSKUByID.defaultExpectation == nil {
+		mmGetSKUByID.defaultExpectation = &SKURepositoryMockGetSKUByIDExpectation{}
+	}
+
+	if mmGetSKUByID.defaultExpectation.params != nil {
+		mmGetSKUByID.mock.t.Fatalf("SKURepositoryMock.GetSKUByID mock is already set by Expect")
+	}
+
+	if mmGetSKUByID.defaultExpectation.paramPtrs == nil {
+		mmGetSKUByID.defaultExpectation.paramPtrs = &SKURepositoryMockGetSKUByIDParamPtrs{}
+	}
+	mmGetSKUByID.defaultExpectation.paramPtrs.skuID = &skuID
+	mmGetSKUByID.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmGetSKUByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the SKURepository.GetSKUByID
+func (mmGetSKUByID *mSKURepositoryMockGetSKUByID) Inspect(f func(ctx context.Context, skuID domain.SKUID)) *mSKURepositoryMockGetSKUByID {
+	if mmGetSKUByID.mock.inspectFuncGetSKUByID != nil {
+		mmGetSKUByID.mock.t.Fatalf("Inspect function is already set for SKURepositoryMock.GetSKUByID")
+	}
+
+	mmGetSKUByID.mock.inspectFuncGetSKUByID = f
+
+	return mmGetSKUByID
+}
+
+// Return sets up results that will be returned by SKURepository.GetSKUByID
+func (mmGetSKUByID *mSKURepositoryMockGetSKUByID) Return(s1 domain.SKU, err error) *SKURepositoryMock {
+	if mmGetSKUByID.mock.funcGetSKUByID != nil {
+		mmGetSKUByID.mock.t.Fatalf("SKURepositoryMock.GetSKUByID mock is already set by Set")
+	}
+
+	if mmGetSKUByID.defaultExpectation == nil {
+		mmGetSKUByID.defaultExpectation = &SKURepositoryMockGetSKUByIDExpectation{mock: mmGetSKUByID.mock}
+	}
+	mmGetSKUByID.defaultExpectation.results = &SKURepositoryMockGetSKUByIDResults{s1, err}
+	mmGetSKUByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetSKUByID.mock
+}
+
+// Set uses given function f to mock the SKURepository.GetSKUByID method
+func (mmGetSKUByID *mSKURepositoryMockGetSKUByID) Set(f func(ctx context.Context, skuID domain.SKUID) (s1 domain.SKU, err error)) *SKURepositoryMock {
+	if mmGetSKUByID.defaultExpectation != nil {
+		mmGetSKUByID.mock.t.Fatalf("Default expectation is already set for the SKURepository.GetSKUByID method")
+	}
+
+	if len(mmGetSKUByID.expectations) > 0 {
+		mmGetSKUByID.mock.t.Fatalf("Some expectations are already set for the SKURepository.GetSKUByID method")
+	}
+
+	mmGetSKUByID.mock.funcGetSKUByID = f
+	mmGetSKUByID.mock.funcGetSKUByIDOrigin = minimock.CallerInfo(1)
+	return mmGetSKUByID.mock
+}
+
+// When sets expectation for the SKURepository.GetSKUByID which will trigger the result defined by the following
+// Then helper
+func (mmGetSKUByID *mSKURepositoryMockGetSKUByID) When(ctx context.Context, skuID domain.SKUID) *SKURepositoryMockGetSKUByIDExpectation {
+	if mmGetSKUByID.mock.funcGetSKUByID != nil {
+		mmGetSKUByID.mock.t.Fatalf("SKURepositoryMock.GetSKUByID mock is already set by Set")
+	}
+
+	expectation := &SKURepositoryMockGetSKUByIDExpectation{
+		mock:               mmGetSKUByID.mock,
+		params:             &SKURepositoryMockGetSKUByIDParams{ctx, skuID},
+		expectationOrigins: SKURepositoryMockGetSKUByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetSKUByID.expectations = append(mmGetSKUByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SKURepository.GetSKUByID return parameters for the expectation previously defined by the When method
+func (e *SKURepositoryMockGetSKUByIDExpectation) Then(s1 domain.SKU, err error) *SKURepositoryMock {
+	e.results = &SKURepositoryMockGetSKUByIDResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times SKURepository.GetSKUByID should be invoked
+func (mmGetSKUByID *mSKURepositoryMockGetSKUByID) Times(n uint64) *mSKURepositoryMockGetSKUByID {
+	if n == 0 {
+		mmGetSKUByID.mock.t.Fatalf("Times of SKURepositoryMock.GetSKUByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetSKUByID.expectedInvocations, n)
+	mmGetSKUByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetSKUByID
+}
+
+func (mmGetSKUByID *mSKURepositoryMockGetSKUByID) invocationsDone() bool {
+	if len(mmGetSKUByID.expectations) == 0 && mmGetSKUByID.defaultExpectation == nil && mmGetSKUByID.mock.funcGetSKUByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetSKUByID.mock.afterGetSKUByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetSKUByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetSKUByID implements mm_stocks.SKURepository
+func (mmGetSKUByID *SKURepositoryMock) GetSKUByID(ctx context.Context, skuID domain.SKUID) (s1 domain.SKU, err error) {
+	mm_atomic.AddUint64(&mmGetSKUByID.beforeGetSKUByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetSKUByID.afterGetSKUByIDCounter, 1)
+
+	mmGetSKUByID.t.Helper()
+
+	if mmGetSKUByID.inspectFuncGetSKUByID != nil {
+		mmGetSKUByID.inspectFuncGetSKUByID(ctx, skuID)
+	}
+
+	mm_params := SKURepositoryMockGetSKUByIDParams{ctx, skuID}
+
+	// Record call args
+	mmGetSKUByID.GetSKUByIDMock.mutex.Lock()
+	mmGetSKUByID.GetSKUByIDMock.callArgs = append(mmGetSKUByID.GetSKUByIDMock.callArgs, &mm_params)
+	mmGetSKUByID.GetSKUByIDMock.mutex.Unlock()
+
+	for _, e := range mmGetSKUByID.GetSKUByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetSKUByID.GetSKUByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetSKUByID.GetSKUByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetSKUByID.GetSKUByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetSKUByID.GetSKUByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := SKURepositoryMockGetSKUByIDParams{ctx, skuID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetSKUByID.t.Errorf("SKURepositoryMock.GetSKUByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSKUByID.GetSKUByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmGetSKUByID.t.Errorf("SKURepositoryMock.GetSKUByID got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSKUByID.GetSKUByIDMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetSKUByID.t.Errorf("SKURepositoryMock.GetSKUByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetSKUByID.GetSKUByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetSKUByID.GetSKUByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetSKUByID.t.Fatal("No results are set for the SKURepositoryMock.GetSKUByID")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetSKUByID.funcGetSKUByID != nil {
+		return mmGetSKUByID.funcGetSKUByID(ctx, skuID)
+	}
+	mmGetSKUByID.t.Fatalf("Unexpected call to SKURepositoryMock.GetSKUByID. %v %v", ctx, skuID)
+	return
+}
+
+// GetSKUByIDAfterCounter returns a count of finished SKURepositoryMock.GetSKUByID invocations
+func (mmGetSKUByID *SKURepositoryMock) GetSKUByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSKUByID.afterGetSKUByIDCounter)
+}
+
+// GetSKUByIDBeforeCounter returns a count of SKURepositoryMock.GetSKUByID invocations
+func (mmGetSKUByID *SKURepositoryMock) GetSKUByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSKUByID.beforeGetSKUByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to SKURepositoryMock.GetSKUByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetSKUByID *mSKURepositoryMockGetSKUByID) Calls() []*SKURepositoryMockGetSKUByIDParams {
+	mmGetSKUByID.mutex.RLock()
+
+	argCopy := make([]*SKURepositoryMockGetSKUByIDParams, len(mmGetSKUByID.callArgs))
+	copy(argCopy, mmGetSKUByID.callArgs)
+
+	mmGetSKUByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetSKUByIDDone returns true if the count of the GetSKUByID invocations corresponds
+// the number of defined expectations
+func (m *SKURepositoryMock) MinimockGetSKUByIDDone() bool {
+	if m.GetSKUByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetSKUByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetSKUByIDMock.invocationsDone()
+}
+
+// MinimockGetSKUByIDInspect logs each unmet expectation
+func (m *SKURepositoryMock) MinimockGetSKUByIDInspect() {
+	for _, e := range m.GetSKUByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SKURepositoryMock.GetSKUByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetSKUByIDCounter := mm_atomic.LoadUint64(&m.afterGetSKUByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSKUByIDMock.defaultExpectation != nil && afterGetSKUByIDCounter < 1 {
+		if m.GetSKUByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SKURepositoryMock.GetSKUByID at\n%s", m.GetSKUByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SKURepositoryMock.GetSKUByID at\n%s with params: %#v", m.GetSKUByIDMock.defaultExpectation.expectationOrigins.origin, *m.GetSKUByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSKUByID != nil && afterGetSKUByIDCounter < 1 {
+		m.t.Errorf("Expected call to SKURepositoryMock.GetSKUByID at\n%s", m.funcGetSKUByIDOrigin)
+	}
+
+	if !m.GetSKUByIDMock.invocationsDone() && afterGetSKUByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to SKURepositoryMock.GetSKUByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetSKUByIDMock.expectedInvocations), m.GetSKUByIDMock.expectedInvocationsOrigin, afterGetSKUByIDCounter)
+	}
+}
+
+type mSKURepositoryMockGetVariantGroup struct {
+	optional           bool
+	mock               *SKURepositoryMock
+	defaultExpectation *SKURepositoryMockGetVariantGroupExpectation
+	expectations       []*SKURepositoryMockGetVariantGroupExpectation
+
+	callArgs []*SKURepositoryMockGetVariantGroupParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SKURepositoryMockGetVariantGroupExpectation specifies expectation struct of the SKURepository.GetVariantGroup
+type SKURepositoryMockGetVariantGroupExpectation struct {
+	mock               *SKURepositoryMock
+	params             *SKURepositoryMockGetVariantGroupParams
+	paramPtrs          *SKURepositoryMockGetVariantGroupParamPtrs
+	expectationOrigins SKURepositoryMockGetVariantGroupExpectationOrigins
+	results            *SKURepositoryMockGetVariantGroupResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SKURepositoryMockGetVariantGroupParams contains parameters of the SKURepository.GetVariantGroup
+type SKURepositoryMockGetVariantGroupParams struct {
+	ctx     context.Context
+	groupID domain.VariantGroupID
+}
+
+// SKURepositoryMockGetVariantGroupParamPtrs contains pointers to parameters of the SKURepository.GetVariantGroup
+type SKURepositoryMockGetVariantGroupParamPtrs struct {
+	ctx     *context.Context
+	groupID *domain.VariantGroupID
+}
+
+// SKURepositoryMockGetVariantGroupResults contains results of the SKURepository.GetVariantGroup
+type SKURepositoryMockGetVariantGroupResults struct {
+	v1  domain.VariantGroup
+	err error
+}
+
+// SKURepositoryMockGetVariantGroupOrigins contains origins of expectations of the SKURepository.GetVariantGroup
+type SKURepositoryMockGetVariantGroupExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originGroupID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetVariantGroup *mSKURepositoryMockGetVariantGroup) Optional() *mSKURepositoryMockGetVariantGroup {
+	mmGetVariantGroup.optional = true
+	return mmGetVariantGroup
+}
+
+// Expect sets up expected params for SKURepository.GetVariantGroup
+func (mmGetVariantGroup *mSKURepositoryMockGetVariantGroup) Expect(ctx context.Context, groupID domain.VariantGroupID) *mSKURepositoryMockGetVariantGroup {
+	if mmGetVariantGroup.mock.funcGetVariantGroup != nil {
+		mmGetVariantGroup.mock.t.Fatalf("SKURepositoryMock.GetVariantGroup mock is already set by Set")
+	}
+
+	if mmGetVariantGroup.defaultExpectation == nil {
+		mmGetVariantGroup.defaultExpectation = &SKURepositoryMockGetVariantGroupExpectation{}
+	}
+
+	if mmGetVariantGroup.defaultExpectation.paramPtrs != nil {
+		mmGetVariantGroup.mock.t.Fatalf("SKURepositoryMock.GetVariantGroup mock is already set by ExpectParams functions")
+	}
+
+	mmGetVariantGroup.defaultExpectation.params = &SKURepositoryMockGetVariantGroupParams{ctx, groupID}
+	mmGetVariantGroup.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetVariantGroup.expectations {
+		if minimock.Equal(e.params, mmGetVariantGroup.defaultExpectation.params) {
+			mmGetVariantGroup.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetVariantGroup.defaultExpectation.params)
+		}
+	}
+
+	return mmGetVariantGroup
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SKURepository.GetVariantGroup
+func (mmGetVariantGroup *mSKURepositoryMockGetVariantGroup) ExpectCtxParam1(ctx context.Context) *mSKURepositoryMockGetVariantGroup {
+	if mmGetVariantGroup.mock.funcGetVariantGroup != nil {
+		mmGetVariantGroup.mock.t.Fatalf("SKURepositoryMock.GetVariantGroup mock is already set by Set")
+	}
+
+	if mmGetVariantGroup.defaultExpectation == nil {
+		mmGetVariantGroup.defaultExpectation = &SKURepositoryMockGetVariantGroupExpectation{}
+	}
+
+	if mmGetVariantGroup.defaultExpectation.params != nil {
+		mmGetVariantGroup.mock.t.Fatalf("SKURepositoryMock.GetVariantGroup mock is already set by Expect")
+	}
+
+	if mmGetVariantGroup.defaultExpectation.paramPtrs == nil {
+		mmGetVariantGroup.defaultExpectation.paramPtrs = &SKURepositoryMockGetVariantGroupParamPtrs{}
+	}
+	mmGetVariantGroup.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetVariantGroup.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetVariantGroup
+}
+
+// ExpectGroupIDParam2 sets up expected param groupID for SKURepository.GetVariantGroup
+func (mmGetVariantGroup *mSKURepositoryMockGetVariantGroup) ExpectGroupIDParam2(groupID domain.VariantGroupID) *mSKURepositoryMockGetVariantGroup {
+	if mmGetVariantGroup.mock.funcGetVariantGroup != nil {
+		mmGetVariantGroup.mock.t.Fatalf("SKURepositoryMock.GetVariantGroup mock is already set by Set")
+	}
+
+	if mmGetVariantGroup.defaultExpectation == nil {
+		mmGetVariantGroup.defaultExpectation = &SKURepositoryMockGetVariantGroupExpectation{}
+	}
+
+	if mmGetVariantGroup.defaultExpectation.params != nil {
+		mmGetVariantGroup.mock.t.Fatalf("SKURepositoryMock.GetVariantGroup mock is already set by Expect")
+	}
+
+	if mmGetVariantGroup.defaultExpectation.paramPtrs == nil {
+		mmGetVariantGroup.defaultExpectation.paramPtrs = &SKURepositoryMockGetVariantGroupParamPtrs{}
+	}
+	mmGetVariantGroup.defaultExpectation.paramPtrs.groupID = &groupID
+	mmGetVariantGroup.defaultExpectation.expectationOrigins.originGroupID = minimock.CallerInfo(1)
+
+	return mmGetVariantGroup
+}
+
+// Inspect accepts an inspector function that has same arguments as the SKURepository.GetVariantGroup
+func (mmGetVariantGroup *mSKURepositoryMockGetVariantGroup) Inspect(f func(ctx context.Context, groupID domain.VariantGroupID)) *mSKURepositoryMockGetVariantGroup {
+	if mmGetVariantGroup.mock.inspectFuncGetVariantGroup != nil {
+		mmGetVariantGroup.mock.t.Fatalf("Inspect function is already set for SKURepositoryMock.GetVariantGroup")
+	}
+
+	mmGetVariantGroup.mock.inspectFuncGetVariantGroup = f
+
+	return mmGetVariantGroup
+}
+
+// Return sets up results that will be returned by SKURepository.GetVariantGroup
+func (mmGetVariantGroup *mSKURepositoryMockGetVariantGroup) Return(v1 domain.VariantGroup, err error) *SKURepositoryMock {
+	if mmGetVariantGroup.mock.funcGetVariantGroup != nil {
+		mmGetVariantGroup.mock.t.Fatalf("SKURepositoryMock.GetVariantGroup mock is already set by Set")
+	}
+
+	if mmGetVariantGroup.defaultExpectation == nil {
+		mmGetVariantGroup.defaultExpectation = &SKURepositoryMockGetVariantGroupExpectation{mock: mmGetVariantGroup.mock}
+	}
+	mmGetVariantGroup.defaultExpectation.results = &SKURepositoryMockGetVariantGroupResults{v1, err}
+	mmGetVariantGroup.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetVariantGroup.mock
+}
+
+// Set uses given function f to mock the SKURepository.GetVariantGroup method
+func (mmGetVariantGroup *mSKURepositoryMockGetVariantGroup) Set(f func(ctx context.Context, groupID domain.VariantGroupID) (v1 domain.VariantGroup, err error)) *SKURepositoryMock {
+	if mmGetVariantGroup.defaultExpectation != nil {
+		mmGetVariantGroup.mock.t.Fatalf("Default expectation is already set for the SKURepository.GetVariantGroup method")
+	}
+
+	if len(mmGetVariantGroup.expectations) > 0 {
+		mmGetVariantGroup.mock.t.Fatalf("Some expectations are already set for the SKURepository.GetVariantGroup method")
+	}
+
+	mmGetVariantGroup.mock.funcGetVariantGroup = f
+	mmGetVariantGroup.mock.funcGetVariantGroupOrigin = minimock.CallerInfo(1)
+	return mmGetVariantGroup.mock
+}
+
+// When sets expectation for the SKURepository.GetVariantGroup which will trigger the result defined by the following
+// Then helper
+func (mmGetVariantGroup *mSKURepositoryMockGetVariantGroup) When(ctx context.Context, groupID domain.VariantGroupID) *SKURepositoryMockGetVariantGroupExpectation {
+	if mmGetVariantGroup.mock.funcGetVariantGroup != nil {
+		mmGetVariantGroup.mock.t.Fatalf("SKURepositoryMock.GetVariantGroup mock is already set by Set")
+	}
+
+	expectation := &SKURepositoryMockGetVariantGroupExpectation{
+		mock:               mmGetVariantGroup.mock,
+		params:             &SKURepositoryMockGetVariantGroupParams{ctx, groupID},
+		expectationOrigins: SKURepositoryMockGetVariantGroupExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetVariantGroup.expectations = append(mmGetVariantGroup.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SKURepository.GetVariantGroup return parameters for the expectation previously defined by the When method
+func (e *SKURepositoryMockGetVariantGroupExpectation) Then(v1 domain.VariantGroup, err error) *SKURepositoryMock {
+	e.results = &SKURepositoryMockGetVariantGroupResults{v1, err}
+	return e.mock
+}
+
+// Times sets number of times SKURepository.GetVariantGroup should be invoked
+func (mmGetVariantGroup *mSKURepositoryMockGetVariantGroup) Times(n uint64) *mSKURepositoryMockGetVariantGroup {
+	if n == 0 {
+		mmGetVariantGroup.mock.t.Fatalf("Times of SKURepositoryMock.GetVariantGroup mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetVariantGroup.expectedInvocations, n)
+	mmGetVariantGroup.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetVariantGroup
+}
+
+func (mmGetVariantGroup *mSKURepositoryMockGetVariantGroup) invocationsDone() bool {
+	if len(mmGetVariantGroup.expectations) == 0 && mmGetVariantGroup.defaultExpectation == nil && mmGetVariantGroup.mock.funcGetVariantGroup == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetVariantGroup.mock.afterGetVariantGroupCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetVariantGroup.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetVariantGroup implements mm_stocks.SKURepository
+func (mmGetVariantGroup *SKURepositoryMock) GetVariantGroup(ctx context.Context, groupID domain.VariantGroupID) (v1 domain.VariantGroup, err error) {
+	mm_atomic.AddUint64(&mmGetVariantGroup.beforeGetVariantGroupCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetVariantGroup.afterGetVariantGroupCounter, 1)
+
+	mmGetVariantGroup.t.Helper()
+
+	if mmGetVariantGroup.inspectFuncGetVariantGroup != nil {
+		mmGetVariantGroup.inspectFuncGetVariantGroup(ctx, groupID)
+	}
+
+	mm_params := SKURepositoryMockGetVariantGroupParams{ctx, groupID}
+
+	// Record call args
+	mmGetVariantGroup.GetVariantGroupMock.mutex.Lock()
+	mmGetVariantGroup.GetVariantGroupMock.callArgs = append(mmGetVariantGroup.GetVariantGroupMock.callArgs, &mm_params)
+	mmGetVariantGroup.GetVariantGroupMock.mutex.Unlock()
+
+	for _, e := range mmGetVariantGroup.GetVariantGroupMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.v1, e.results.err
+		}
+	}
+
+	if mmGetVariantGroup.GetVariantGroupMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetVariantGroup.GetVariantGroupMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetVariantGroup.GetVariantGroupMock.defaultExpectation.params
+		mm_want_ptrs := mmGetVariantGroup.GetVariantGroupMock.defaultExpectation.paramPtrs
+
+		mm_got := SKURepositoryMockGetVariantGroupParams{ctx, groupID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetVariantGroup.t.Errorf("SKURepositoryMock.GetVariantGroup got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetVariantGroup.GetVariantGroupMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.groupID != nil && !minimock.Equal(*mm_want_ptrs.groupID, mm_got.groupID) {
+				mmGetVariantGroup.t.Errorf("SKURepositoryMock.GetVariantGroup got unexpected parameter groupID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetVariantGroup.GetVariantGroupMock.defaultExpectation.expectationOrigins.originGroupID, *mm_want_ptrs.groupID, mm_got.groupID, minimock.Diff(*mm_want_ptrs.groupID, mm_got.groupID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetVariantGroup.t.Errorf("SKURepositoryMock.GetVariantGroup got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetVariantGroup.GetVariantGroupMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetVariantGroup.GetVariantGroupMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetVariantGroup.t.Fatal("No results are set for the SKURepositoryMock.GetVariantGroup")
+		}
+		return (*mm_results).v1, (*mm_results).err
+	}
+	if mmGetVariantGroup.funcGetVariantGroup != nil {
+		return mmGetVariantGroup.funcGetVariantGroup(ctx, groupID)
+	}
+	mmGetVariantGroup.t.Fatalf("Unexpected call to SKURepositoryMock.GetVariantGroup. %v %v", ctx, groupID)
+	return
+}
+
+// GetVariantGroupAfterCounter returns a count of finished SKURepositoryMock.GetVariantGroup invocations
+func (mmGetVariantGroup *SKURepositoryMock) GetVariantGroupAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetVariantGroup.afterGetVariantGroupCounter)
+}
+
+// GetVariantGroupBeforeCounter returns a count of SKURepositoryMock.GetVariantGroup invocations
+func (mmGetVariantGroup *SKURepositoryMock) GetVariantGroupBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetVariantGroup.beforeGetVariantGroupCounter)
+}
+
+// Calls returns a list of arguments used in each call to SKURepositoryMock.GetVariantGroup.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetVariantGroup *mSKURepositoryMockGetVariantGroup) Calls() []*SKURepositoryMockGetVariantGroupParams {
+	mmGetVariantGroup.mutex.RLock()
+
+	argCopy := make([]*SKURepositoryMockGetVariantGroupParams, len(mmGetVariantGroup.callArgs))
+	copy(argCopy, mmGetVariantGroup.callArgs)
+
+	mmGetVariantGroup.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetVariantGroupDone returns true if the count of the GetVariantGroup invocations corresponds
+// the number of defined expectations
+func (m *SKURepositoryMock) MinimockGetVariantGroupDone() bool {
+	if m.GetVariantGroupMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetVariantGroupMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetVariantGroupMock.invocationsDone()
+}
+
+// MinimockGetVariantGroupInspect logs each unmet expectation
+func (m *SKURepositoryMock) MinimockGetVariantGroupInspect() {
+	for _, e := range m.GetVariantGroupMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SKURepositoryMock.GetVariantGroup at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetVariantGroupCounter := mm_atomic.LoadUint64(&m.afterGetVariantGroupCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetVariantGroupMock.defaultExpectation != nil && afterGetVariantGroupCounter < 1 {
+		if m.GetVariantGroupMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SKURepositoryMock.GetVariantGroup at\n%s", m.GetVariantGroupMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SKURepositoryMock.GetVariantGroup at\n%s with params: %#v", m.GetVariantGroupMock.defaultExpectation.expectationOrigins.origin, *m.GetVariantGroupMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetVariantGroup != nil && afterGetVariantGroupCounter < 1 {
+		m.t.Errorf("Expected call to SKURepositoryMock.GetVariantGroup at\n%s", m.funcGetVariantGroupOrigin)
+	}
+
+	if !m.GetVariantGroupMock.invocationsDone() && afterGetVariantGroupCounter > 0 {
+		m.t.Errorf("Expected %d calls to SKURepositoryMock.GetVariantGroup at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetVariantGroupMock.expectedInvocations), m.GetVariantGroupMock.expectedInvocationsOrigin, afterGetVariantGroupCounter)
+	}
+}
+
+type mSKURepositoryMockSaveAttributeSchema struct {
+	optional           bool
+	mock               *SKURepositoryMock
+	defaultExpectation *SKURepositoryMockSaveAttributeSchemaExpectation
+	expectations       []*SKURepositoryMockSaveAttributeSchemaExpectation
+
+	callArgs []*SKURepositoryMockSaveAttributeSchemaParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SKURepositoryMockSaveAttributeSchemaExpectation specifies expectation struct of the SKURepository.SaveAttributeSchema
+type SKURepositoryMockSaveAttributeSchemaExpectation struct {
+	mock               *SKURepositoryMock
+	params             *SKURepositoryMockSaveAttributeSchemaParams
+	paramPtrs          *SKURepositoryMockSaveAttributeSchemaParamPtrs
+	expectationOrigins SKURepositoryMockSaveAttributeSchemaExpectationOrigins
+	results            *SKURepositoryMockSaveAttributeSchemaResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SKURepositoryMockSaveAttributeSchemaParams contains parameters of the SKURepository.SaveAttributeSchema
+type SKURepositoryMockSaveAttributeSchemaParams struct {
+	ctx    context.Context
+	schema domain.AttributeSchema
+}
+
+// SKURepositoryMockSaveAttributeSchemaParamPtrs contains pointers to parameters of the SKURepository.SaveAttributeSchema
+type SKURepositoryMockSaveAttributeSchemaParamPtrs struct {
+	ctx    *context.Context
+	schema *domain.AttributeSchema
+}
+
+// SKURepositoryMockSaveAttributeSchemaResults contains results of the SKURepository.SaveAttributeSchema
+type SKURepositoryMockSaveAttributeSchemaResults struct {
+	err error
+}
+
+// SKURepositoryMockSaveAttributeSchemaOrigins contains origins of expectations of the SKURepository.SaveAttributeSchema
+type SKURepositoryMockSaveAttributeSchemaExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originSchema string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSaveAttributeSchema *mSKURepositoryMockSaveAttributeSchema) Optional() *mSKURepositoryMockSaveAttributeSchema {
+	mmSaveAttributeSchema.optional = true
+	return mmSaveAttributeSchema
+}
+
+// Expect sets up expected params for SKURepository.SaveAttributeSchema
+func (mmSaveAttributeSchema *mSKURepositoryMockSaveAttributeSchema) Expect(ctx context.Context, schema domain.AttributeSchema) *mSKURepositoryMockSaveAttributeSchema {
+	if mmSaveAttributeSchema.mock.funcSaveAttributeSchema != nil {
+		mmSaveAttributeSchema.mock.t.Fatalf("SKURepositoryMock.SaveAttributeSchema mock is already set by Set")
+	}
+
+	if mmSaveAttributeSchema.defaultExpectation == nil {
+		mmSaveAttributeSchema.defaultExpectation = &SKURepositoryMockSaveAttributeSchemaExpectation{}
+	}
+
+	if mmSaveAttributeSchema.defaultExpectation.paramPtrs != nil {
+		mmSaveAttributeSchema.mock.t.Fatalf("SKURepositoryMock.SaveAttributeSchema mock is already set by ExpectParams functions")
+	}
+
+	mmSaveAttributeSchema.defaultExpectation.params = &SKURepositoryMockSaveAttributeSchemaParams{ctx, schema}
+	mmSaveAttributeSchema.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveAttributeSchema.expectations {
+		if minimock.Equal(e.params, mmSaveAttributeSchema.defaultExpectation.params) {
+			mmSaveAttributeSchema.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveAttributeSchema.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveAttributeSchema
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SKURepository.SaveAttributeSchema
+func (mmSaveAttributeSchema *mSKURepositoryMockSaveAttributeSchema) ExpectCtxParam1(ctx context.Context) *mSKURepositoryMockSaveAttributeSchema {
+	if mmSaveAttributeSchema.mock.funcSaveAttributeSchema != nil {
+		mmSaveAttributeSchema.mock.t.Fatalf("SKURepositoryMock.SaveAttributeSchema mock is already set by Set")
+	}
+
+	if mmSaveAttributeSchema.defaultExpectation == nil {
+		mmSaveAttributeSchema.defaultExpectation = &SKURepositoryMockSaveAttributeSchemaExpectation{}
+	}
+
+	if mmSaveAttributeSchema.defaultExpectation.params != nil {
+		mmSaveAttributeSchema.mock.t.Fatalf("SKURepositoryMock.SaveAttributeSchema mock is already set by Expect")
+	}
+
+	if mmSaveAttributeSchema.defaultExpectation.paramPtrs == nil {
+		mmSaveAttributeSchema.defaultExpectation.paramPtrs = &SKURepositoryMockSaveAttributeSchemaParamPtrs{}
+	}
+	mmSaveAttributeSchema.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveAttributeSchema.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSaveAttributeSchema
+}
+
+// ExpectSchemaParam2 sets up expected param schema for SKURepository.SaveAttributeSchema
+func (mmSaveAttributeSchema *mSKURepositoryMockSaveAttributeSchema) ExpectSchemaParam2(schema domain.AttributeSchema) *mSKURepositoryMockSaveAttributeSchema {
+	if mmSaveAttributeSchema.mock.funcSaveAttributeSchema != nil {
+		mmSaveAttributeSchema.mock.t.Fatalf("SKURepositoryMock.SaveAttributeSchema mock is already set by Set")
+	}
+
+	if mmSaveAttributeSchema.defaultExpectation == nil {
+		mmSaveAttributeSchema.defaultExpectation = &SKURepositoryMockSaveAttributeSchemaExpectation{}
+	}
+
+	if mmSaveAttributeSchema.defaultExpectation.params != nil {
+		mmSaveAttributeSchema.mock.t.Fatalf("SKURepositoryMock.SaveAttributeSchema mock is already set by Expect")
+	}
+
+	if mmSaveAttributeSchema.defaultExpectation.paramPtrs == nil {
+		mmSaveAttributeSchema.defaultExpectation.paramPtrs = &SKURepositoryMockSaveAttributeSchemaParamPtrs{}
+	}
+	mmSaveAttributeSchema.defaultExpectation.paramPtrs.schema = &schema
+	mmSaveAttributeSchema.defaultExpectation.expectationOrigins.originSchema = minimock.CallerInfo(1)
+
+	return mmSaveAttributeSchema
+}
+
+// Inspect accepts an inspector function that has same arguments as the SKURepository.SaveAttributeSchema
+func (mmSaveAttributeSchema *mSKURepositoryMockSaveAttributeSchema) Inspect(f func(ctx context.Context, schema domain.AttributeSchema)) *mSKURepositoryMockSaveAttributeSchema {
+	if mmSaveAttributeSchema.mock.inspectFuncSaveAttributeSchema != nil {
+		mmSaveAttributeSchema.mock.t.Fatalf("Inspect function is already set for SKURepositoryMock.SaveAttributeSchema")
+	}
+
+	mmSaveAttributeSchema.mock.inspectFuncSaveAttributeSchema = f
+
+	return mmSaveAttributeSchema
+}
+
+// Return sets up results that will be returned by SKURepository.SaveAttributeSchema
+func (mmSaveAttributeSchema *mSKURepositoryMockSaveAttributeSchema) Return(err error) *SKURepositoryMock {
+	if mmSaveAttributeSchema.mock.funcSaveAttributeSchema != nil {
+		mmSaveAttributeSchema.mock.t.Fatalf("SKURepositoryMock.SaveAttributeSchema mock is already set by Set")
+	}
+
+	if mmSaveAttributeSchema.defaultExpectation == nil {
+		mmSaveAttributeSchema.defaultExpectation = &SKURepositoryMockSaveAttributeSchemaExpectation{mock: mmSaveAttributeSchema.mock}
+	}
+	mmSaveAttributeSchema.defaultExpectation.results = &SKURepositoryMockSaveAttributeSchemaResults{err}
+	mmSaveAttributeSchema.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveAttributeSchema.mock
+}
+
+// Set uses given function f to mock the SKURepository.SaveAttributeSchema method
+func (mmSaveAttributeSchema *mSKURepositoryMockSaveAttributeSchema) Set(f func(ctx context.Context, schema domain.AttributeSchema) (err error)) *SKURepositoryMock {
+	if mmSaveAttributeSchema.defaultExpectation != nil {
+		mmSaveAttributeSchema.mock.t.Fatalf("Default expectation is already set for the SKURepository.SaveAttributeSchema method")
+	}
+
+	if len(mmSaveAttributeSchema.expectations) > 0 {
+		mmSaveAttributeSchema.mock.t.Fatalf("Some expectations are already set for the SKURepository.SaveAttributeSchema method")
+	}
+
+	mmSaveAttributeSchema.mock.funcSaveAttributeSchema = f
+	mmSaveAttributeSchema.mock.funcSaveAttributeSchemaOrigin = minimock.CallerInfo(1)
+	return mmSaveAttributeSchema.mock
+}
+
+// When sets expectation for the SKURepository.SaveAttributeSchema which will trigger the result defined by the following
+// Then helper
+func (mmSaveAttributeSchema *mSKURepositoryMockSaveAttributeSchema) When(ctx context.Context, schema domain.AttributeSchema) *SKURepositoryMockSaveAttributeSchemaExpectation {
+	if mmSaveAttributeSchema.mock.funcSaveAttributeSchema != nil {
+		mmSaveAttributeSchema.mock.t.Fatalf("SKURepositoryMock.SaveAttributeSchema mock is already set by Set")
+	}
+
+	expectation := &SKURepositoryMockSaveAttributeSchemaExpectation{
+		mock:               mmSaveAttributeSchema.mock,
+		params:             &SKURepositoryMockSaveAttributeSchemaParams{ctx, schema},
+		expectationOrigins: SKURepositoryMockSaveAttributeSchemaExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSaveAttributeSchema.expectations = append(mmSaveAttributeSchema.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SKURepository.SaveAttributeSchema return parameters for the expectation previously defined by the When method
+func (e *SKURepositoryMockSaveAttributeSchemaExpectation) Then(err error) *SKURepositoryMock {
+	e.results = &SKURepositoryMockSaveAttributeSchemaResults{err}
+	return e.mock
+}
+
+// Times sets number of times SKURepository.SaveAttributeSchema should be invoked
+func (mmSaveAttributeSchema *mSKURepositoryMockSaveAttributeSchema) Times(n uint64) *mSKURepositoryMockSaveAttributeSchema {
+	if n == 0 {
+		mmSaveAttributeSchema.mock.t.Fatalf("Times of SKURepositoryMock.SaveAttributeSchema mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSaveAttributeSchema.expectedInvocations, n)
+	mmSaveAttributeSchema.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveAttributeSchema
+}
+
+func (mmSaveAttributeSchema *mSKURepositoryMockSaveAttributeSchema) invocationsDone() bool {
+	if len(mmSaveAttributeSchema.expectations) == 0 && mmSaveAttributeSchema.defaultExpectation == nil && mmSaveAttributeSchema.mock.funcSaveAttributeSchema == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveAttributeSchema.mock.afterSaveAttributeSchemaCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveAttributeSchema.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SaveAttributeSchema implements mm_stocks.SKURepository
+func (mmSaveAttributeSchema *SKURepositoryMock) SaveAttributeSchema(ctx context.Context, schema domain.AttributeSchema) (err error) {
+	mm_atomic.AddUint64(&mmSaveAttributeSchema.beforeSaveAttributeSchemaCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveAttributeSchema.afterSaveAttributeSchemaCounter, 1)
+
+	mmSaveAttributeSchema.t.Helper()
+
+	if mmSaveAttributeSchema.inspectFuncSaveAttributeSchema != nil {
+		mmSaveAttributeSchema.inspectFuncSaveAttributeSchema(ctx, schema)
+	}
+
+	mm_params := SKURepositoryMockSaveAttributeSchemaParams{ctx, schema}
+
+	// Record call args
+	mmSaveAttributeSchema.SaveAttributeSchemaMock.mutex.Lock()
+	mmSaveAttributeSchema.SaveAttributeSchemaMock.callArgs = append(mmSaveAttributeSchema.SaveAttributeSchemaMock.callArgs, &mm_params)
+	mmSaveAttributeSchema.SaveAttributeSchemaMock.mutex.Unlock()
+
+	for _, e := range mmSaveAttributeSchema.SaveAttributeSchemaMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveAttributeSchema.SaveAttributeSchemaMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveAttributeSchema.SaveAttributeSchemaMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveAttributeSchema.SaveAttributeSchemaMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveAttributeSchema.SaveAttributeSchemaMock.defaultExpectation.paramPtrs
+
+		mm_got := SKURepositoryMockSaveAttributeSchemaParams{ctx, schema}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSaveAttributeSchema.t.Errorf("SKURepositoryMock.SaveAttributeSchema got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveAttributeSchema.SaveAttributeSchemaMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.schema != nil && !minimock.Equal(*mm_want_ptrs.schema, mm_got.schema) {
+				mmSaveAttributeSchema.t.Errorf("SKURepositoryMock.SaveAttributeSchema got unexpected parameter schema, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveAttributeSchema.SaveAttributeSchemaMock.defaultExpectation.expectationOrigins.originSchema, *mm_want_ptrs.schema, mm_got.schema, minimock.Diff(*mm_want_ptrs.schema, mm_got.schema))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveAttributeSchema.t.Errorf("SKURepositoryMock.SaveAttributeSchema got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveAttributeSchema.SaveAttributeSchemaMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveAttributeSchema.SaveAttributeSchemaMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveAttributeSchema.t.Fatal("No results are set for the SKURepositoryMock.SaveAttributeSchema")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveAttributeSchema.funcSaveAttributeSchema != nil {
+		return mmSaveAttributeSchema.funcSaveAttributeSchema(ctx, schema)
+	}
+	mmSaveAttributeSchema.t.Fatalf("Unexpected call to SKURepositoryMock.SaveAttributeSchema. %v %v", ctx, schema)
+	return
+}
+
+// SaveAttributeSchemaAfterCounter returns a count of finished SKURepositoryMock.SaveAttributeSchema invocations
+func (mmSaveAttributeSchema *SKURepositoryMock) SaveAttributeSchemaAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveAttributeSchema.afterSaveAttributeSchemaCounter)
+}
+
+// SaveAttributeSchemaBeforeCounter returns a count of SKURepositoryMock.SaveAttributeSchema invocations
+func (mmSaveAttributeSchema *SKURepositoryMock) SaveAttributeSchemaBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveAttributeSchema.beforeSaveAttributeSchemaCounter)
+}
+
+// Calls returns a list of arguments used in each call to SKURepositoryMock.SaveAttributeSchema.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveAttributeSchema *mSKURepositoryMockSaveAttributeSchema) Calls() []*SKURepositoryMockSaveAttributeSchemaParams {
+	mmSaveAttributeSchema.mutex.RLock()
+
+	argCopy := make([]*SKURepositoryMockSaveAttributeSchemaParams, len(mmSaveAttributeSchema.callArgs))
+	copy(argCopy, mmSaveAttributeSchema.callArgs)
+
+	mmSaveAttributeSchema.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveAttributeSchemaDone returns true if the count of the SaveAttributeSchema invocations corresponds
+// the number of defined expectations
+func (m *SKURepositoryMock) MinimockSaveAttributeSchemaDone() bool {
+	if m.SaveAttributeSchemaMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveAttributeSchemaMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveAttributeSchemaMock.invocationsDone()
+}
+
+// MinimockSaveAttributeSchemaInspect logs each unmet expectation
+func (m *SKURepositoryMock) MinimockSaveAttributeSchemaInspect() {
+	for _, e := range m.SaveAttributeSchemaMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SKURepositoryMock.SaveAttributeSchema at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveAttributeSchemaCounter := mm_atomic.LoadUint64(&m.afterSaveAttributeSchemaCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveAttributeSchemaMock.defaultExpectation != nil && afterSaveAttributeSchemaCounter < 1 {
+		if m.SaveAttributeSchemaMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SKURepositoryMock.SaveAttributeSchema at\n%s", m.SaveAttributeSchemaMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SKURepositoryMock.SaveAttributeSchema at\n%s with params: %#v", m.SaveAttributeSchemaMock.defaultExpectation.expectationOrigins.origin, *m.SaveAttributeSchemaMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveAttributeSchema != nil && afterSaveAttributeSchemaCounter < 1 {
+		m.t.Errorf("Expected call to SKURepositoryMock.SaveAttributeSchema at\n%s", m.funcSaveAttributeSchemaOrigin)
+	}
+
+	if !m.SaveAttributeSchemaMock.invocationsDone() && afterSaveAttributeSchemaCounter > 0 {
+		m.t.Errorf("Expected %d calls to SKURepositoryMock.SaveAttributeSchema at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveAttributeSchemaMock.expectedInvocations), m.SaveAttributeSchemaMock.expectedInvocationsOrigin, afterSaveAttributeSchemaCounter)
+	}
+}
+
+type mSKURepositoryMockSaveBundle struct {
+	optional           bool
+	mock               *SKURepositoryMock
+	defaultExpectation *SKURepositoryMockSaveBundleExpectation
+	expectations       []*SKURepositoryMockSaveBundleExpectation
+
+	callArgs []*SKURepositoryMockSaveBundleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SKURepositoryMockSaveBundleExpectation specifies expectation struct of the SKURepository.SaveBundle
+type SKURepositoryMockSaveBundleExpectation struct {
+	mock               *SKURepositoryMock
+	params             *SKURepositoryMockSaveBundleParams
+	paramPtrs          *SKURepositoryMockSaveBundleParamPtrs
+	expectationOrigins SKURepositoryMockSaveBundleExpectationOrigins
+	results            *SKURepositoryMockSaveBundleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SKURepositoryMockSaveBundleParams contains parameters of the SKURepository.SaveBundle
+type SKURepositoryMockSaveBundleParams struct {
+	ctx    context.Context
+	bundle domain.Bundle
+}
+
+// SKURepositoryMockSaveBundleParamPtrs contains pointers to parameters of the SKURepository.SaveBundle
+type SKURepositoryMockSaveBundleParamPtrs struct {
+	ctx    *context.Context
+	bundle *domain.Bundle
+}
+
+// SKURepositoryMockSaveBundleResults contains results of the SKURepository.SaveBundle
+type SKURepositoryMockSaveBundleResults struct {
+	err error
+}
+
+// SKURepositoryMockSaveBundleOrigins contains origins of expectations of the SKURepository.SaveBundle
+type SKURepositoryMockSaveBundleExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originBundle string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSaveBundle *mSKURepositoryMockSaveBundle) Optional() *mSKURepositoryMockSaveBundle {
+	mmSaveBundle.optional = true
+	return mmSaveBundle
+}
+
+// Expect sets up expected params for SKURepository.SaveBundle
+func (mmSaveBundle *mSKURepositoryMockSaveBundle) Expect(ctx context.Context, bundle domain.Bundle) *mSKURepositoryMockSaveBundle {
+	if mmSaveBundle.mock.funcSaveBundle != nil {
+		mmSaveBundle.mock.t.Fatalf("SKURepositoryMock.SaveBundle mock is already set by Set")
+	}
+
+	if mmSaveBundle.defaultExpectation == nil {
+		mmSaveBundle.defaultExpectation = &SKURepositoryMockSaveBundleExpectation{}
+	}
+
+	if mmSaveBundle.defaultExpectation.paramPtrs != nil {
+		mmSaveBundle.mock.t.Fatalf("SKURepositoryMock.SaveBundle mock is already set by ExpectParams functions")
+	}
+
+	mmSaveBundle.defaultExpectation.params = &SKURepositoryMockSaveBundleParams{ctx, bundle}
+	mmSaveBundle.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveBundle.expectations {
+		if minimock.Equal(e.params, mmSaveBundle.defaultExpectation.params) {
+			mmSaveBundle.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveBundle.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveBundle
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SKURepository.SaveBundle
+func (mmSaveBundle *mSKURepositoryMockSaveBundle) ExpectCtxParam1(ctx context.Context) *mSKURepositoryMockSaveBundle {
+	if mmSaveBundle.mock.funcSaveBundle != nil {
+		mmSaveBundle.mock.t.Fatalf("SKURepositoryMock.SaveBundle mock is already set by Set")
+	}
+
+	if mmSaveBundle.defaultExpectation == nil {
+		mmSaveBundle.defaultExpectation = &SKURepositoryMockSaveBundleExpectation{}
+	}
+
+	if mmSaveBundle.defaultExpectation.params != nil {
+		mmSaveBundle.mock.t.Fatalf("SKURepositoryMock.SaveBundle mock is already set by Expect")
+	}
+
+	if mmSaveBundle.defaultExpectation.paramPtrs == nil {
+		mmSaveBundle.defaultExpectation.paramPtrs = &SKURepositoryMockSaveBundleParamPtrs{}
+	}
+	mmSaveBundle.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveBundle.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSaveBundle
+}
+
+// ExpectBundleParam2 sets up expected param bundle for SKURepository.SaveBundle
+func (mmSaveBundle *mSKURepositoryMockSaveBundle) ExpectBundleParam2(bundle domain.Bundle) *mSKURepositoryMockSaveBundle {
+	if mmSaveBundle.mock.funcSaveBundle != nil {
+		mmSaveBundle.mock.t.Fatalf("SKURepositoryMock.SaveBundle mock is already set by Set")
+	}
+
+	if mmSaveBundle.defaultExpectation == nil {
+		mmSaveBundle.defaultExpectation = &SKURepositoryMockSaveBundleExpectation{}
+	}
+
+	if mmSaveBundle.defaultExpectation.params != nil {
+		mmSaveBundle.mock.t.Fatalf("SKURepositoryMock.SaveBundle mock is already set by Expect")
+	}
+
+	if mmSaveBundle.defaultExpectation.paramPtrs == nil {
+		mmSaveBundle.defaultExpectation.paramPtrs = &SKURepositoryMockSaveBundleParamPtrs{}
+	}
+	mmSaveBundle.defaultExpectation.paramPtrs.bundle = &bundle
+	mmSaveBundle.defaultExpectation.expectationOrigins.originBundle = minimock.CallerInfo(1)
+
+	return mmSaveBundle
+}
+
+// Inspect accepts an inspector function that has same arguments as the SKURepository.SaveBundle
+func (mmSaveBundle *mSKURepositoryMockSaveBundle) Inspect(f func(ctx context.Context, bundle domain.Bundle)) *mSKURepositoryMockSaveBundle {
+	if mmSaveBundle.mock.inspectFuncSaveBundle != nil {
+		mmSaveBundle.mock.t.Fatalf("Inspect function is already set for SKURepositoryMock.SaveBundle")
+	}
+
+	mmSaveBundle.mock.inspectFuncSaveBundle = f
+
+	return mmSaveBundle
+}
+
+// Return sets up results that will be returned by SKURepository.SaveBundle
+func (mmSaveBundle *mSKURepositoryMockSaveBundle) Return(err error) *SKURepositoryMock {
+	if mmSaveBundle.mock.funcSaveBundle != nil {
+		mmSaveBundle.mock.t.Fatalf("SKURepositoryMock.SaveBundle mock is already set by Set")
+	}
+
+	if mmSaveBundle.defaultExpectation == nil {
+		mmSaveBundle.defaultExpectation = &SKURepositoryMockSaveBundleExpectation{mock: mmSaveBundle.mock}
+	}
+	mmSaveBundle.defaultExpectation.results = &SKURepositoryMockSaveBundleResults{err}
+	mmSaveBundle.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveBundle.mock
+}
+
+// Set uses given function f to mock the SKURepository.SaveBundle method
+func (mmSaveBundle *mSKURepositoryMockSaveBundle) Set(f func(ctx context.Context, bundle domain.Bundle) (err error)) *SKURepositoryMock {
+	if mmSaveBundle.defaultExpectation != nil {
+		mmSaveBundle.mock.t.Fatalf("Default expectation is already set for the SKURepository.SaveBundle method")
+	}
+
+	if len(mmSaveBundle.expectations) > 0 {
+		mmSaveBundle.mock.t.Fatalf("Some expectations are already set for the SKURepository.SaveBundle method")
+	}
+
+	mmSaveBundle.mock.funcSaveBundle = f
+	mmSaveBundle.mock.funcSaveBundleOrigin = minimock.CallerInfo(1)
+	return mmSaveBundle.mock
+}
+
+// When sets expectation for the SKURepository.SaveBundle which will trigger the result defined by the following
+// Then helper
+func (mmSaveBundle *mSKURepositoryMockSaveBundle) When(ctx context.Context, bundle domain.Bundle) *SKURepositoryMockSaveBundleExpectation {
+	if mmSaveBundle.mock.funcSaveBundle != nil {
+		mmSaveBundle.mock.t.Fatalf("SKURepositoryMock.SaveBundle mock is already set by Set")
+	}
+
+	expectation := &SKURepositoryMockSaveBundleExpectation{
+		mock:               mmSaveBundle.mock,
+		params:             &SKURepositoryMockSaveBundleParams{ctx, bundle},
+		expectationOrigins: SKURepositoryMockSaveBundleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSaveBundle.expectations = append(mmSaveBundle.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SKURepository.SaveBundle return parameters for the expectation previously defined by the When method
+func (e *SKURepositoryMockSaveBundleExpectation) Then(err error) *SKURepositoryMock {
+	e.results = &SKURepositoryMockSaveBundleResults{err}
+	return e.mock
+}
+
+// Times sets number of times SKURepository.SaveBundle should be invoked
+func (mmSaveBundle *mSKURepositoryMockSaveBundle) Times(n uint64) *mSKURepositoryMockSaveBundle {
+	if n == 0 {
+		mmSaveBundle.mock.t.Fatalf("Times of SKURepositoryMock.SaveBundle mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSaveBundle.expectedInvocations, n)
+	mmSaveBundle.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveBundle
+}
+
+func (mmSaveBundle *mSKURepositoryMockSaveBundle) invocationsDone() bool {
+	if len(mmSaveBundle.expectations) == 0 && mmSaveBundle.defaultExpectation == nil && mmSaveBundle.mock.funcSaveBundle == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveBundle.mock.afterSaveBundleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveBundle.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SaveBundle implements mm_stocks.SKURepository
+func (mmSaveBundle *SKURepositoryMock) SaveBundle(ctx context.Context, bundle domain.Bundle) (err error) {
+	mm_atomic.AddUint64(&mmSaveBundle.beforeSaveBundleCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveBundle.afterSaveBundleCounter, 1)
+
+	mmSaveBundle.t.Helper()
+
+	if mmSaveBundle.inspectFuncSaveBundle != nil {
+		mmSaveBundle.inspectFuncSaveBundle(ctx, bundle)
+	}
+
+	mm_params := SKURepositoryMockSaveBundleParams{ctx, bundle}
+
+	// Record call args
+	mmSaveBundle.SaveBundleMock.mutex.Lock()
+	mmSaveBundle.SaveBundleMock.callArgs = append(mmSaveBundle.SaveBundleMock.callArgs, &mm_params)
+	mmSaveBundle.SaveBundleMock.mutex.Unlock()
+
+	for _, e := range mmSaveBundle.SaveBundleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveBundle.SaveBundleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveBundle.SaveBundleMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveBundle.SaveBundleMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveBundle.SaveBundleMock.defaultExpectation.paramPtrs
+
+		mm_got := SKURepositoryMockSaveBundleParams{ctx, bundle}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSaveBundle.t.Errorf("SKURepositoryMock.SaveBundle got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveBundle.SaveBundleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.bundle != nil && !minimock.Equal(*mm_want_ptrs.bundle, mm_got.bundle) {
+				mmSaveBundle.t.Errorf("SKURepositoryMock.SaveBundle got unexpected parameter bundle, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveBundle.SaveBundleMock.defaultExpectation.expectationOrigins.originBundle, *mm_want_ptrs.bundle, mm_got.bundle, minimock.Diff(*mm_want_ptrs.bundle, mm_got.bundle))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveBundle.t.Errorf("SKURepositoryMock.SaveBundle got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveBundle.SaveBundleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveBundle.SaveBundleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveBundle.t.Fatal("No results are set for the SKURepositoryMock.SaveBundle")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveBundle.funcSaveBundle != nil {
+		return mmSaveBundle.funcSaveBundle(ctx, bundle)
+	}
+	mmSaveBundle.t.Fatalf("Unexpected call to SKURepositoryMock.SaveBundle. %v %v", ctx, bundle)
+	return
+}
+
+// SaveBundleAfterCounter returns a count of finished SKURepositoryMock.SaveBundle invocations
+func (mmSaveBundle *SKURepositoryMock) SaveBundleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveBundle.afterSaveBundleCounter)
+}
+
+// SaveBundleBeforeCounter returns a count of SKURepositoryMock.SaveBundle invocations
+func (mmSaveBundle *SKURepositoryMock) SaveBundleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveBundle.beforeSaveBundleCounter)
+}
+
+// Calls returns a list of arguments used in each call to SKURepositoryMock.SaveBundle.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveBundle *mSKURepositoryMockSaveBundle) Calls() []*SKURepositoryMockSaveBundleParams {
+	mmSaveBundle.mutex.RLock()
+
+	argCopy := make([]*SKURepositoryMockSaveBundleParams, len(mmSaveBundle.callArgs))
+	copy(argCopy, mmSaveBundle.callArgs)
+
+	mmSaveBundle.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveBundleDone returns true if the count of the SaveBundle invocations corresponds
+// the number of defined expectations
+func (m *SKURepositoryMock) MinimockSaveBundleDone() bool {
+	if m.SaveBundleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveBundleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveBundleMock.invocationsDone()
+}
+
+// MinimockSaveBundleInspect logs each unmet expectation
+func (m *SKURepositoryMock) MinimockSaveBundleInspect() {
+	for _, e := range m.SaveBundleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SKURepositoryMock.SaveBundle at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveBundleCounter := mm_atomic.LoadUint64(&m.afterSaveBundleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveBundleMock.defaultExpectation != nil && afterSaveBundleCounter < 1 {
+		if m.SaveBundleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SKURepositoryMock.SaveBundle at\n%s", m.SaveBundleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SKURepositoryMock.SaveBundle at\n%s with params: %#v", m.SaveBundleMock.defaultExpectation.expectationOrigins.origin, *m.SaveBundleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveBundle != nil && afterSaveBundleCounter < 1 {
+		m.t.Errorf("Expected call to SKURepositoryMock.SaveBundle at\n%s", m.funcSaveBundleOrigin)
+	}
+
+	if !m.SaveBundleMock.invocationsDone() && afterSaveBundleCounter > 0 {
+		m.t.Errorf("Expected %d calls to SKURepositoryMock.SaveBundle at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveBundleMock.expectedInvocations), m.SaveBundleMock.expectedInvocationsOrigin, afterSaveBundleCounter)
+	}
+}
+
+type mSKURepositoryMockSearchSKUsByQuery struct {
+	optional           bool
+	mock               *SKURepositoryMock
+	defaultExpectation *SKURepositoryMockSearchSKUsByQueryExpectation
+	expectations       []*SKURepositoryMockSearchSKUsByQueryExpectation
+
+	callArgs []*SKURepositoryMockSearchSKUsByQueryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SKURepositoryMockSearchSKUsByQueryExpectation specifies expectation struct of the SKURepository.SearchSKUsByQuery
+type SKURepositoryMockSearchSKUsByQueryExpectation struct {
+	mock               *SKURepositoryMock
+	params             *SKURepositoryMockSearchSKUsByQueryParams
+	paramPtrs          *SKURepositoryMockSearchSKUsByQueryParamPtrs
+	expectationOrigins SKURepositoryMockSearchSKUsByQueryExpectationOrigins
+	results            *SKURepositoryMockSearchSKUsByQueryResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SKURepositoryMockSearchSKUsByQueryParams contains parameters of the SKURepository.SearchSKUsByQuery
+type SKURepositoryMockSearchSKUsByQueryParams struct {
+	ctx    context.Context
+	filter domain.SKUSearchFilter
+}
+
+// SKURepositoryMockSearchSKUsByQueryParamPtrs contains pointers to parameters of the SKURepository.SearchSKUsByQuery
+type SKURepositoryMockSearchSKUsByQueryParamPtrs struct {
+	ctx    *context.Context
+	filter *domain.SKUSearchFilter
+}
+
+// SKURepositoryMockSearchSKUsByQueryResults contains results of the SKURepository.SearchSKUsByQuery
+type SKURepositoryMockSearchSKUsByQueryResults struct {
+	sa1 []domain.SKUSearchResult
+	err error
+}
+
+// SKURepositoryMockSearchSKUsByQueryOrigins contains origins of expectations of the SKURepository.SearchSKUsByQuery
+type SKURepositoryMockSearchSKUsByQueryExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSearchSKUsByQuery *mSKURepositoryMockSearchSKUsByQuery) Optional() *mSKURepositoryMockSearchSKUsByQuery {
+	mmSearchSKUsByQuery.optional = true
+	return mmSearchSKUsByQuery
+}
+
+// Expect sets up expected params for SKURepository.SearchSKUsByQuery
+func (mmSearchSKUsByQuery *mSKURepositoryMockSearchSKUsByQuery) Expect(ctx context.Context, filter domain.SKUSearchFilter) *mSKURepositoryMockSearchSKUsByQuery {
+	if mmSearchSKUsByQuery.mock.funcSearchSKUsByQuery != nil {
+		mmSearchSKUsByQuery.mock.t.Fatalf("SKURepositoryMock.SearchSKUsByQuery mock is already set by Set")
+	}
+
+	if mmSearchSKUsByQuery.defaultExpectation == nil {
+		mmSearchSKUsByQuery.defaultExpectation = &SKURepositoryMockSearchSKUsByQueryExpectation{}
+	}
+
+	if mmSearchSKUsByQuery.defaultExpectation.paramPtrs != nil {
+		mmSearchSKUsByQuery.mock.t.Fatalf("SKURepositoryMock.SearchSKUsByQuery mock is already set by ExpectParams functions")
+	}
+
+	mmSearchSKUsByQuery.defaultExpectation.params = &SKURepositoryMockSearchSKUsByQueryParams{ctx, filter}
+	mmSearchSKUsByQuery.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSearchSKUsByQuery.expectations {
+		if minimock.Equal(e.params, mmSearchSKUsByQuery.defaultExpectation.params) {
+			mmSearchSKUsByQuery.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSearchSKUsByQuery.defaultExpectation.params)
+		}
+	}
+
+	return mmSearchSKUsByQuery
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SKURepository.SearchSKUsByQuery
+func (mmSearchSKUsByQuery *mSKURepositoryMockSearchSKUsByQuery) ExpectCtxParam1(ctx context.Context) *mSKURepositoryMockSearchSKUsByQuery {
+	if mmSearchSKUsByQuery.mock.funcSearchSKUsByQuery != nil {
+		mmSearchSKUsByQuery.mock.t.Fatalf("SKURepositoryMock.SearchSKUsByQuery mock is already set by Set")
+	}
+
+	if mmSearchSKUsByQuery.defaultExpectation == nil {
+		mmSearchSKUsByQuery.defaultExpectation = &SKURepositoryMockSearchSKUsByQueryExpectation{}
+	}
+
+	if mmSearchSKUsByQuery.defaultExpectation.params != nil {
+		mmSearchSKUsByQuery.mock.t.Fatalf("SKURepositoryMock.SearchSKUsByQuery mock is already set by Expect")
+	}
+
+	if mmSearchSKUsByQuery.defaultExpectation.paramPtrs == nil {
+		mmSearchSKUsByQuery.defaultExpectation.paramPtrs = &SKURepositoryMockSearchSKUsByQueryParamPtrs{}
+	}
+	mmSearchSKUsByQuery.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSearchSKUsByQuery.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSearchSKUsByQuery
+}
+
+// ExpectFilterParam2 sets up expected param filter for SKURepository.SearchSKUsByQuery
+func (mmSearchSKUsByQuery *mSKURepositoryMockSearchSKUsByQuery) ExpectFilterParam2(filter domain.SKUSearchFilter) *mSKURepositoryMockSearchSKUsByQuery {
+	if mmSearchSKUsByQuery.mock.funcSearchSKUsByQuery != nil {
+		mmSearchSKUsByQuery.mock.t.Fatalf("SKURepositoryMock.SearchSKUsByQuery mock is already set by Set")
+	}
+
+	if mmSearchSKUsByQuery.defaultExpectation == nil {
+		mmSearchSKUsByQuery.defaultExpectation = &SKURepositoryMockSearchSKUsByQueryExpectation{}
+	}
+
+	if mmSearchSKUsByQuery.defaultExpectation.params != nil {
+		mmSearchSKUsByQuery.mock.t.Fatalf("SKURepositoryMock.SearchSKUsByQuery mock is already set by Expect")
+	}
+
+	if mmSearchSKUsByQuery.defaultExpectation.paramPtrs == nil {
+		mmSearchSKUsByQuery.defaultExpectation.paramPtrs = &SKURepositoryMockSearchSKUsByQueryParamPtrs{}
+	}
+	mmSearchSKUsByQuery.defaultExpectation.paramPtrs.filter = &filter
+	mmSearchSKUsByQuery.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmSearchSKUsByQuery
+}
+
+// Inspect accepts an inspector function that has same arguments as the SKURepository.SearchSKUsByQuery
+func (mmSearchSKUsByQuery *mSKURepositoryMockSearchSKUsByQuery) Inspect(f func(ctx context.Context, filter domain.SKUSearchFilter)) *mSKURepositoryMockSearchSKUsByQuery {
+	if mmSearchSKUsByQuery.mock.inspectFuncSearchSKUsByQuery != nil {
+		mmSearchSKUsByQuery.mock.t.Fatalf("Inspect function is already set for SKURepositoryMock.SearchSKUsByQuery")
+	}
+
+	mmSearchSKUsByQuery.mock.inspectFuncSearchSKUsByQuery = f
+
+	return mmSearchSKUsByQuery
+}
+
+// Return sets up results that will be returned by SKURepository.SearchSKUsByQuery
+func (mmSearchSKUsByQuery *mSKURepositoryMockSearchSKUsByQuery) Return(sa1 []domain.SKUSearchResult, err error) *SKURepositoryMock {
+	if mmSearchSKUsByQuery.mock.funcSearchSKUsByQuery != nil {
+		mmSearchSKUsByQuery.mock.t.Fatalf("SKURepositoryMock.SearchSKUsByQuery mock is already set by Set")
+	}
+
+	if mmSearchSKUsByQuery.defaultExpectation == nil {
+		mmSearchSKUsByQuery.defaultExpectation = &SKURepositoryMockSearchSKUsByQueryExpectation{mock: mmSearchSKUsByQuery.mock}
+	}
+	mmSearchSKUsByQuery.defaultExpectation.results = &SKURepositoryMockSearchSKUsByQueryResults{sa1, err}
+	mmSearchSKUsByQuery.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSearchSKUsByQuery.mock
+}
+
+// Set uses given function f to mock the SKURepository.SearchSKUsByQuery method
+func (mmSearchSKUsByQuery *mSKURepositoryMockSearchSKUsByQuery) Set(f func(ctx context.Context, filter domain.SKUSearchFilter) (sa1 []domain.SKUSearchResult, err error)) *SKURepositoryMock {
+	if mmSearchSKUsByQuery.defaultExpectation != nil {
+		mmSearchSKUsByQuery.mock.t.Fatalf("Default expectation is already set for the SKURepository.SearchSKUsByQuery method")
+	}
+
+	if len(mmSearchSKUsByQuery.expectations) > 0 {
+		mmSearchSKUsByQuery.mock.t.Fatalf("Some expectations are already set for the SKURepository.SearchSKUsByQuery method")
+	}
+
+	mmSearchSKUsByQuery.mock.funcSearchSKUsByQuery = f
+	mmSearchSKUsByQuery.mock.funcSearchSKUsByQueryOrigin = minimock.CallerInfo(1)
+	return mmSearchSKUsByQuery.mock
+}
+
+// When sets expectation for the SKURepository.SearchSKUsByQuery which will trigger the result defined by the following
+// Then helper
+func (mmSearchSKUsByQuery *mSKURepositoryMockSearchSKUsByQuery) When(ctx context.Context, filter domain.SKUSearchFilter) *SKURepositoryMockSearchSKUsByQueryExpectation {
+	if mmSearchSKUsByQuery.mock.funcSearchSKUsByQuery != nil {
+		mmSearchSKUsByQuery.mock.t.Fatalf("SKURepositoryMock.SearchSKUsByQuery mock is already set by Set")
+	}
+
+	expectation := &SKURepositoryMockSearchSKUsByQueryExpectation{
+		mock:               mmSearchSKUsByQuery.mock,
+		params:             &SKURepositoryMockSearchSKUsByQueryParams{ctx, filter},
+		expectationOrigins: SKURepositoryMockSearchSKUsByQueryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSearchSKUsByQuery.expectations = append(mmSearchSKUsByQuery.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SKURepository.SearchSKUsByQuery return parameters for the expectation previously defined by the When method
+func (e *SKURepositoryMockSearchSKUsByQueryExpectation) Then(sa1 []domain.SKUSearchResult, err error) *SKURepositoryMock {
+	e.results = &SKURepositoryMockSearchSKUsByQueryResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times SKURepository.SearchSKUsByQuery should be invoked
+func (mmSearchSKUsByQuery *mSKURepositoryMockSearchSKUsByQuery) Times(n uint64) *mSKURepositoryMockSearchSKUsByQuery {
+	if n == 0 {
+		mmSearchSKUsByQuery.mock.t.Fatalf("Times of SKURepositoryMock.SearchSKUsByQuery mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSearchSKUsByQuery.expectedInvocations, n)
+	mmSearchSKUsByQuery.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSearchSKUsByQuery
+}
+
+func (mmSearchSKUsByQuery *mSKURepositoryMockSearchSKUsByQuery) invocationsDone() bool {
+	if len(mmSearchSKUsByQuery.expectations) == 0 && mmSearchSKUsByQuery.defaultExpectation == nil && mmSearchSKUsByQuery.mock.funcSearchSKUsByQuery == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSearchSKUsByQuery.mock.afterSearchSKUsByQueryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSearchSKUsByQuery.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SearchSKUsByQuery implements mm_stocks.SKURepository
+func (mmSearchSKUsByQuery *SKURepositoryMock) SearchSKUsByQuery(ctx context.Context, filter domain.SKUSearchFilter) (sa1 []domain.SKUSearchResult, err error) {
+	mm_atomic.AddUint64(&mmSearchSKUsByQuery.beforeSearchSKUsByQueryCounter, 1)
+	defer mm_atomic.AddUint64(&mmSearchSKUsByQuery.afterSearchSKUsByQueryCounter, 1)
+
+	mmSearchSKUsByQuery.t.Helper()
+
+	if mmSearchSKUsByQuery.inspectFuncSearchSKUsByQuery != nil {
+		mmSearchSKUsByQuery.inspectFuncSearchSKUsByQuery(ctx, filter)
+	}
+
+	mm_params := SKURepositoryMockSearchSKUsByQueryParams{ctx, filter}
+
+	// Record call args
+	mmSearchSKUsByQuery.SearchSKUsByQueryMock.mutex.Lock()
+	mmSearchSKUsByQuery.SearchSKUsByQueryMock.callArgs = append(mmSearchSKUsByQuery.SearchSKUsByQueryMock.callArgs, &mm_params)
+	mmSearchSKUsByQuery.SearchSKUsByQueryMock.mutex.Unlock()
+
+	for _, e := range mmSearchSKUsByQuery.SearchSKUsByQueryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmSearchSKUsByQuery.SearchSKUsByQueryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSearchSKUsByQuery.SearchSKUsByQueryMock.defaultExpectation.Counter, 1)
+		mm_want := mmSearchSKUsByQuery.SearchSKUsByQueryMock.defaultExpectation.params
+		mm_want_ptrs := mmSearchSKUsByQuery.SearchSKUsByQueryMock.defaultExpectation.paramPtrs
+
+		mm_got := SKURepositoryMockSearchSKUsByQueryParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSearchSKUsByQuery.t.Errorf("SKURepositoryMock.SearchSKUsByQuery got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchSKUsByQuery.SearchSKUsByQueryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmSearchSKUsByQuery.t.Errorf("SKURepositoryMock.SearchSKUsByQuery got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchSKUsByQuery.SearchSKUsByQueryMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSearchSKUsByQuery.t.Errorf("SKURepositoryMock.SearchSKUsByQuery got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSearchSKUsByQuery.SearchSKUsByQueryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSearchSKUsByQuery.SearchSKUsByQueryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSearchSKUsByQuery.t.Fatal("No results are set for the SKURepositoryMock.SearchSKUsByQuery")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmSearchSKUsByQuery.funcSearchSKUsByQuery != nil {
+		return mmSearchSKUsByQuery.funcSearchSKUsByQuery(ctx, filter)
+	}
+	mmSearchSKUsByQuery.t.Fatalf("Unexpected call to SKURepositoryMock.SearchSKUsByQuery. %v %v", ctx, filter)
+	return
+}
+
+// SearchSKUsByQueryAfterCounter returns a count of finished SKURepositoryMock.SearchSKUsByQuery invocations
+func (mmSearchSKUsByQuery *SKURepositoryMock) SearchSKUsByQueryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchSKUsByQuery.afterSearchSKUsByQueryCounter)
+}
+
+// SearchSKUsByQueryBeforeCounter returns a count of SKURepositoryMock.SearchSKUsByQuery invocations
+func (mmSearchSKUsByQuery *SKURepositoryMock) SearchSKUsByQueryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchSKUsByQuery.beforeSearchSKUsByQueryCounter)
+}
+
+// Calls returns a list of arguments used in each call to SKURepositoryMock.SearchSKUsByQuery.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSearchSKUsByQuery *mSKURepositoryMockSearchSKUsByQuery) Calls() []*SKURepositoryMockSearchSKUsByQueryParams {
+	mmSearchSKUsByQuery.mutex.RLock()
+
+	argCopy := make([]*SKURepositoryMockSearchSKUsByQueryParams, len(mmSearchSKUsByQuery.callArgs))
+	copy(argCopy, mmSearchSKUsByQuery.callArgs)
+
+	mmSearchSKUsByQuery.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSearchSKUsByQueryDone returns true if the count of the SearchSKUsByQuery invocations corresponds
+// the number of defined expectations
+func (m *SKURepositoryMock) MinimockSearchSKUsByQueryDone() bool {
+	if m.SearchSKUsByQueryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SearchSKUsByQueryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SearchSKUsByQueryMock.invocationsDone()
+}
+
+// MinimockSearchSKUsByQueryInspect logs each unmet expectation
+func (m *SKURepositoryMock) MinimockSearchSKUsByQueryInspect() {
+	for _, e := range m.SearchSKUsByQueryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SKURepositoryMock.SearchSKUsByQuery at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSearchSKUsByQueryCounter := mm_atomic.LoadUint64(&m.afterSearchSKUsByQueryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SearchSKUsByQueryMock.defaultExpectation != nil && afterSearchSKUsByQueryCounter < 1 {
+		if m.SearchSKUsByQueryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SKURepositoryMock.SearchSKUsByQuery at\n%s", m.SearchSKUsByQueryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SKURepositoryMock.SearchSKUsByQuery at\n%s with params: %#v", m.SearchSKUsByQueryMock.defaultExpectation.expectationOrigins.origin, *m.SearchSKUsByQueryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSearchSKUsByQuery != nil && afterSearchSKUsByQueryCounter < 1 {
+		m.t.Errorf("Expected call to SKURepositoryMock.SearchSKUsByQuery at\n%s", m.funcSearchSKUsByQueryOrigin)
+	}
+
+	if !m.SearchSKUsByQueryMock.invocationsDone() && afterSearchSKUsByQueryCounter > 0 {
+		m.t.Errorf("Expected %d calls to SKURepositoryMock.SearchSKUsByQuery at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SearchSKUsByQueryMock.expectedInvocations), m.SearchSKUsByQueryMock.expectedInvocationsOrigin, afterSearchSKUsByQueryCounter)
+	}
+}
+
+type mSKURepositoryMockUpdateSKUAttributes struct {
+	optional           bool
+	mock               *SKURepositoryMock
+	defaultExpectation *SKURepositoryMockUpdateSKUAttributesExpectation
+	expectations       []*SKURepositoryMockUpdateSKUAttributesExpectation
+
+	callArgs []*SKURepositoryMockUpdateSKUAttributesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SKURepositoryMockUpdateSKUAttributesExpectation specifies expectation struct of the SKURepository.UpdateSKUAttributes
+type SKURepositoryMockUpdateSKUAttributesExpectation struct {
+	mock               *SKURepositoryMock
+	params             *SKURepositoryMockUpdateSKUAttributesParams
+	paramPtrs          *SKURepositoryMockUpdateSKUAttributesParamPtrs
+	expectationOrigins SKURepositoryMockUpdateSKUAttributesExpectationOrigins
+	results            *SKURepositoryMockUpdateSKUAttributesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SKURepositoryMockUpdateSKUAttributesParams contains parameters of the SKURepository.UpdateSKUAttributes
+type SKURepositoryMockUpdateSKUAttributesParams struct {
+	ctx        context.Context
+	update     domain.SKUAttributesUpdate
+	axesValues domain.SKUAttributes
+}
+
+// SKURepositoryMockUpdateSKUAttributesParamPtrs contains pointers to parameters of the SKURepository.UpdateSKUAttributes
+type SKURepositoryMockUpdateSKUAttributesParamPtrs struct {
+	ctx        *context.Context
+	update     *domain.SKUAttributesUpdate
+	axesValues *domain.SKUAttributes
+}
+
+// SKURepositoryMockUpdateSKUAttributesResults contains results of the SKURepository.UpdateSKUAttributes
+type SKURepositoryMockUpdateSKUAttributesResults struct {
+	err error
+}
+
+// SKURepositoryMockUpdateSKUAttributesOrigins contains origins of expectations of the SKURepository.UpdateSKUAttributes
+type SKURepositoryMockUpdateSKUAttributesExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originUpdate     string
+	originAxesValues string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateSKUAttributes *mSKURepositoryMockUpdateSKUAttributes) Optional() *mSKURepositoryMockUpdateSKUAttributes {
+	mmUpdateSKUAttributes.optional = true
+	return mmUpdateSKUAttributes
+}
+
+// Expect sets up expected params for SKURepository.UpdateSKUAttributes
+func (mmUpdateSKUAttributes *mSKURepositoryMockUpdateSKUAttributes) Expect(ctx context.Context, update domain.SKUAttributesUpdate, axesValues domain.SKUAttributes) *mSKURepositoryMockUpdateSKUAttributes {
+	if mmUpdateSKUAttributes.mock.funcUpdateSKUAttributes != nil {
+		mmUpdateSKUAttributes.mock.t.Fatalf("SKURepositoryMock.UpdateSKUAttributes mock is already set by Set")
+	}
+
+	if mmUpdateSKUAttributes.defaultExpectation == nil {
+		mmUpdateSKUAttributes.defaultExpectation = &SKURepositoryMockUpdateSKUAttributesExpectation{}
+	}
+
+	if mmUpdateSKUAttributes.defaultExpectation.paramPtrs != nil {
+		mmUpdateSKUAttributes.mock.t.Fatalf("SKURepositoryMock.UpdateSKUAttributes mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateSKUAttributes.defaultExpectation.params = &SKURepositoryMockUpdateSKUAttributesParams{ctx, update, axesValues}
+	mmUpdateSKUAttributes.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateSKUAttributes.expectations {
+		if minimock.Equal(e.params, mmUpdateSKUAttributes.defaultExpectation.params) {
+			mmUpdateSKUAttributes.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateSKUAttributes.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateSKUAttributes
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SKURepository.UpdateSKUAttributes
+func (mmUpdateSKUAttributes *mSKURepositoryMockUpdateSKUAttributes) ExpectCtxParam1(ctx context.Context) *mSKURepositoryMockUpdateSKUAttributes {
+	if mmUpdateSKUAttributes.mock.funcUpdateSKUAttributes != nil {
+		mmUpdateSKUAttributes.mock.t.Fatalf("SKURepositoryMock.UpdateSKUAttributes mock is already set by Set")
+	}
+
+	if mmUpdateSKUAttributes.defaultExpectation == nil {
+		mmUpdateSKUAttributes.defaultExpectation = &SKURepositoryMockUpdateSKUAttributesExpectation{}
+	}
+
+	if mmUpdateSKUAttributes.defaultExpectation.params != nil {
+		mmUpdateSKUAttributes.mock.t.Fatalf("SKURepositoryMock.UpdateSKUAttributes mock is already set by Expect")
+	}
+
+	if mmUpdateSKUAttributes.defaultExpectation.paramPtrs == nil {
+		mmUpdateSKUAttributes.defaultExpectation.paramPtrs = &SKURepositoryMockUpdateSKUAttributesParamPtrs{}
+	}
+	mmUpdateSKUAttributes.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateSKUAttributes.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateSKUAttributes
+}
+
+// ExpectUpdateParam2 sets up expected param update for SKURepository.UpdateSKUAttributes
+func (mmUpdateSKUAttributes *mSKURepositoryMockUpdateSKUAttributes) ExpectUpdateParam2(update domain.SKUAttributesUpdate) *mSKURepositoryMockUpdateSKUAttributes {
+	if mmUpdateSKUAttributes.mock.funcUpdateSKUAttributes != nil {
+		mmUpdateSKUAttributes.mock.t.Fatalf("SKURepositoryMock.UpdateSKUAttributes mock is already set by Set")
+	}
+
+	if mmUpdateSKUAttributes.defaultExpectation == nil {
+		mmUpdateSKUAttributes.defaultExpectation = &SKURepositoryMockUpdateSKUAttributesExpectation{}
+	}
+
+	if mmUpdateSKUAttributes.defaultExpectation.params != nil {
+		mmUpdateSKUAttributes.mock.t.Fatalf("SKURepositoryMock.UpdateSKUAttributes mock is already set by Expect")
+	}
+
+	if mmUpdateSKUAttributes.defaultExpectation.paramPtrs == nil {
+		mmUpdateSKUAttributes.defaultExpectation.paramPtrs = &SKURepositoryMockUpdateSKUAttributesParamPtrs{}
+	}
+	mmUpdateSKUAttributes.defaultExpectation.paramPtrs.update = &update
+	mmUpdateSKUAttributes.defaultExpectation.expectationOrigins.originUpdate = minimock.CallerInfo(1)
+
+	return mmUpdateSKUAttributes
+}
+
+// ExpectAxesValuesParam3 sets up expected param axesValues for SKURepository.UpdateSKUAttributes
+func (mmUpdateSKUAttributes *mSKURepositoryMockUpdateSKUAttributes) ExpectAxesValuesParam3(axesValues domain.SKUAttributes) *mSKURepositoryMockUpdateSKUAttributes {
+	if mmUpdateSKUAttributes.mock.funcUpdateSKUAttributes != nil {
+		mmUpdateSKUAttributes.mock.t.Fatalf("SKURepositoryMock.UpdateSKUAttributes mock is already set by Set")
+	}
+
+	if mmUpdateSKUAttributes.defaultExpectation == nil {
+		mmUpdateSKUAttributes.defaultExpectation = &SKURepositoryMockUpdateSKUAttributesExpectation{}
+	}
+
+	if mmUpdateSKUAttributes.defaultExpectation.params != nil {
+		mmUpdateSKUAttributes.mock.t.Fatalf("SKURepositoryMock.UpdateSKUAttributes mock is already set by Expect")
+	}
+
+	if mmUpdateSKUAttributes.defaultExpectation.paramPtrs == nil {
+		mmUpdateSKUAttributes.defaultExpectation.paramPtrs = &SKURepositoryMockUpdateSKUAttributesParamPtrs{}
+	}
+	mmUpdateSKUAttributes.defaultExpectation.paramPtrs.axesValues = &axesValues
+	mmUpdateSKUAttributes.defaultExpectation.expectationOrigins.originAxesValues = minimock.CallerInfo(1)
+
+	return mmUpdateSKUAttributes
+}
+
+// Inspect accepts an inspector function that has same arguments as the SKURepository.UpdateSKUAttributes
+func (mmUpdateSKUAttributes *mSKURepositoryMockUpdateSKUAttributes) Inspect(f func(ctx context.Context, update domain.SKUAttributesUpdate, axesValues domain.SKUAttributes)) *mSKURepositoryMockUpdateSKUAttributes {
+	if mmUpdateSKUAttributes.mock.inspectFuncUpdateSKUAttributes != nil {
+		mmUpdateSKUAttributes.mock.t.Fatalf("Inspect function is already set for SKURepositoryMock.UpdateSKUAttributes")
+	}
+
+	mmUpdateSKUAttributes.mock.inspectFuncUpdateSKUAttributes = f
+
+	return mmUpdateSKUAttributes
+}
+
+// Return sets up results that will be returned by SKURepository.UpdateSKUAttributes
+func (mmUpdateSKUAttributes *mSKURepositoryMockUpdateSKUAttributes) Return(err error) *SKURepositoryMock {
+	if mmUpdateSKUAttributes.mock.funcUpdateSKUAttributes != nil {
+		mmUpdateSKUAttributes.mock.t.Fatalf("SKURepositoryMock.UpdateSKUAttributes mock is already set by Set")
+	}
+
+	if mmUpdateSKUAttributes.defaultExpectation == nil {
+		mmUpdateSKUAttributes.defaultExpectation = &SKURepositoryMockUpdateSKUAttributesExpectation{mock: mmUpdateSKUAttributes.mock}
+	}
+	mmUpdateSKUAttributes.defaultExpectation.results = &SKURepositoryMockUpdateSKUAttributesResults{err}
+	mmUpdateSKUAttributes.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateSKUAttributes.mock
+}
+
+// Set uses given function f to mock the SKURepository.UpdateSKUAttributes method
+func (mmUpdateSKUAttributes *mSKURepositoryMockUpdateSKUAttributes) Set(f func(ctx context.Context, update domain.SKUAttributesUpdate, axesValues domain.SKUAttributes) (err error)) *SKURepositoryMock {
+	if mmUpdateSKUAttributes.defaultExpectation != nil {
+		mmUpdateSKUAttributes.mock.t.Fatalf("Default expectation is already set for the SKURepository.UpdateSKUAttributes method")
+	}
+
+	if len(mmUpdateSKUAttributes.expectations) > 0 {
+		mmUpdateSKUAttributes.mock.t.Fatalf("Some expectations are already set for the SKURepository.UpdateSKUAttributes method")
+	}
+
+	mmUpdateSKUAttributes.mock.funcUpdateSKUAttributes = f
+	mmUpdateSKUAttributes.mock.funcUpdateSKUAttributesOrigin = minimock.CallerInfo(1)
+	return mmUpdateSKUAttributes.mock
+}
+
+// When sets expectation for the SKURepository.UpdateSKUAttributes which will trigger the result defined by the following
+// Then helper
+func (mmUpdateSKUAttributes *mSKURepositoryMockUpdateSKUAttributes) When(ctx context.Context, update domain.SKUAttributesUpdate, axesValues domain.SKUAttributes) *SKURepositoryMockUpdateSKUAttributesExpectation {
+	if mmUpdateSKUAttributes.mock.funcUpdateSKUAttributes != nil {
+		mmUpdateSKUAttributes.mock.t.Fatalf("SKURepositoryMock.UpdateSKUAttributes mock is already set by Set")
+	}
+
+	expectation := &SKURepositoryMockUpdateSKUAttributesExpectation{
+		mock:               mmUpdateSKUAttributes.mock,
+		params:             &SKURepositoryMockUpdateSKUAttributesParams{ctx, update, axesValues},
+		expectationOrigins: SKURepositoryMockUpdateSKUAttributesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateSKUAttributes.expectations = append(mmUpdateSKUAttributes.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SKURepository.UpdateSKUAttributes return parameters for the expectation previously defined by the When method
+func (e *SKURepositoryMockUpdateSKUAttributesExpectation) Then(err error) *SKURepositoryMock {
+	e.results = &SKURepositoryMockUpdateSKUAttributesResults{err}
+	return e.mock
+}
+
+// Times sets number of times SKURepository.UpdateSKUAttributes should be invoked
+func (mmUpdateSKUAttributes *mSKURepositoryMockUpdateSKUAttributes) Times(n uint64) *mSKURepositoryMockUpdateSKUAttributes {
+	if n == 0 {
+		mmUpdateSKUAttributes.mock.t.Fatalf("Times of SKURepositoryMock.UpdateSKUAttributes mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateSKUAttributes.expectedInvocations, n)
+	mmUpdateSKUAttributes.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateSKUAttributes
+}
+
+func (mmUpdateSKUAttributes *mSKURepositoryMockUpdateSKUAttributes) invocationsDone() bool {
+	if len(mmUpdateSKUAttributes.expectations) == 0 && mmUpdateSKUAttributes.defaultExpectation == nil && mmUpdateSKUAttributes.mock.funcUpdateSKUAttributes == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateSKUAttributes.mock.afterUpdateSKUAttributesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateSKUAttributes.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateSKUAttributes implements mm_stocks.SKURepository
+func (mmUpdateSKUAttributes *SKURepositoryMock) UpdateSKUAttributes(ctx context.Context, update domain.SKUAttributesUpdate, axesValues domain.SKUAttributes) (err error) {
+	mm_atomic.AddUint64(&mmUpdateSKUAttributes.beforeUpdateSKUAttributesCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateSKUAttributes.afterUpdateSKUAttributesCounter, 1)
+
+	mmUpdateSKUAttributes.t.Helper()
+
+	if mmUpdateSKUAttributes.inspectFuncUpdateSKUAttributes != nil {
+		mmUpdateSKUAttributes.inspectFuncUpdateSKUAttributes(ctx, update, axesValues)
+	}
+
+	mm_params := SKURepositoryMockUpdateSKUAttributesParams{ctx, update, axesValues}
+
+	// Record call args
+	mmUpdateSKUAttributes.UpdateSKUAttributesMock.mutex.Lock()
+	mmUpdateSKUAttributes.UpdateSKUAttributesMock.callArgs = append(mmUpdateSKUAttributes.UpdateSKUAttributesMock.callArgs, &mm_params)
+	mmUpdateSKUAttributes.UpdateSKUAttributesMock.mutex.Unlock()
+
+	for _, e := range mmUpdateSKUAttributes.UpdateSKUAttributesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateSKUAttributes.UpdateSKUAttributesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateSKUAttributes.UpdateSKUAttributesMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateSKUAttributes.UpdateSKUAttributesMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateSKUAttributes.UpdateSKUAttributesMock.defaultExpectation.paramPtrs
+
+		mm_got := SKURepositoryMockUpdateSKUAttributesParams{ctx, update, axesValues}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateSKUAttributes.t.Errorf("SKURepositoryMock.UpdateSKUAttributes got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateSKUAttributes.UpdateSKUAttributesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.update != nil && !minimock.Equal(*mm_want_ptrs.update, mm_got.update) {
+				mmUpdateSKUAttributes.t.Errorf("SKURepositoryMock.UpdateSKUAttributes got unexpected parameter update, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateSKUAttributes.UpdateSKUAttributesMock.defaultExpectation.expectationOrigins.originUpdate, *mm_want_ptrs.update, mm_got.update, minimock.Diff(*mm_want_ptrs.update, mm_got.update))
+			}
+
+			if mm_want_ptrs.axesValues != nil && !minimock.Equal(*mm_want_ptrs.axesValues, mm_got.axesValues) {
+				mmUpdateSKUAttributes.t.Errorf("SKURepositoryMock.UpdateSKUAttributes got unexpected parameter axesValues, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateSKUAttributes.UpdateSKUAttributesMock.defaultExpectation.expectationOrigins.originAxesValues, *mm_want_ptrs.axesValues, mm_got.axesValues, minimock.Diff(*mm_want_ptrs.axesValues, mm_got.axesValues))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateSKUAttributes.t.Errorf("SKURepositoryMock.UpdateSKUAttributes got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateSKUAttributes.UpdateSKUAttributesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateSKUAttributes.UpdateSKUAttributesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateSKUAttributes.t.Fatal("No results are set for the SKURepositoryMock.UpdateSKUAttributes")
+		}
+		return (*mm_results).err
+	}
+	if mmUpdateSKUAttributes.funcUpdateSKUAttributes != nil {
+		return mmUpdateSKUAttributes.funcUpdateSKUAttributes(ctx, update, axesValues)
+	}
+	mmUpdateSKUAttributes.t.Fatalf("Unexpected call to SKURepositoryMock.UpdateSKUAttributes. %v %v %v", ctx, update, axesValues)
+	return
+}
+
+// UpdateSKUAttributesAfterCounter returns a count of finished SKURepositoryMock.UpdateSKUAttributes invocations
+func (mmUpdateSKUAttributes *SKURepositoryMock) UpdateSKUAttributesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateSKUAttributes.afterUpdateSKUAttributesCounter)
+}
+
+// UpdateSKUAttributesBeforeCounter returns a count of SKURepositoryMock.UpdateSKUAttributes invocations
+func (mmUpdateSKUAttributes *SKURepositoryMock) UpdateSKUAttributesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateSKUAttributes.beforeUpdateSKUAttributesCounter)
+}
+
+// Calls returns a list of arguments used in each call to SKURepositoryMock.UpdateSKUAttributes.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateSKUAttributes *mSKURepositoryMockUpdateSKUAttributes) Calls() []*SKURepositoryMockUpdateSKUAttributesParams {
+	mmUpdateSKUAttributes.mutex.RLock()
+
+	argCopy := make([]*SKURepositoryMockUpdateSKUAttributesParams, len(mmUpdateSKUAttributes.callArgs))
+	copy(argCopy, mmUpdateSKUAttributes.callArgs)
+
+	mmUpdateSKUAttributes.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateSKUAttributesDone returns true if the count of the UpdateSKUAttributes invocations corresponds
+// the number of defined expectations
+func (m *SKURepositoryMock) MinimockUpdateSKUAttributesDone() bool {
+	if m.UpdateSKUAttributesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateSKUAttributesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateSKUAttributesMock.invocationsDone()
+}
+
+// MinimockUpdateSKUAttributesInspect logs each unmet expectation
+func (m *SKURepositoryMock) MinimockUpdateSKUAttributesInspect() {
+	for _, e := range m.UpdateSKUAttributesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SKURepositoryMock.UpdateSKUAttributes at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateSKUAttributesCounter := mm_atomic.LoadUint64(&m.afterUpdateSKUAttributesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateSKUAttributesMock.defaultExpectation != nil && afterUpdateSKUAttributesCounter < 1 {
+		if m.UpdateSKUAttributesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SKURepositoryMock.UpdateSKUAttributes at\n%s", m.UpdateSKUAttributesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SKURepositoryMock.UpdateSKUAttributes at\n%s with params: %#v", m.UpdateSKUAttributesMock.defaultExpectation.expectationOrigins.origin, *m.UpdateSKUAttributesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateSKUAttributes != nil && afterUpdateSKUAttributesCounter < 1 {
+		m.t.Errorf("Expected call to SKURepositoryMock.UpdateSKUAttributes at\n%s", m.funcUpdateSKUAttributesOrigin)
+	}
+
+	if !m.UpdateSKUAttributesMock.invocationsDone() && afterUpdateSKUAttributesCounter > 0 {
+		m.t.Errorf("Expected %d calls to SKURepositoryMock.UpdateSKUAttributes at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateSKUAttributesMock.expectedInvocations), m.UpdateSKUAttributesMock.expectedInvocationsOrigin, afterUpdateSKUAttributesCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *SKURepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCountSKUsByTypeInspect()
+
+			m.MinimockCreateVariantGroupInspect()
+
+			m.MinimockGetAttributeSchemaInspect()
+
+			m.MinimockGetBundleComponentsInspect()
+
+			m.MinimockGetSKUByIDInspect()
+
+			m.MinimockGetVariantGroupInspect()
+
+			m.MinimockSaveAttributeSchemaInspect()
+
+			m.MinimockSaveBundleInspect()
+
+			m.MinimockSearchSKUsByQueryInspect()
+
+			m.MinimockUpdateSKUAttributesInspect()
 		}
 	})
 }
@@ -412,5 +3635,14 @@ func (m *SKURepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *SKURepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockGetSKUByIDDone()
+		m.MinimockCountSKUsByTypeDone() &&
+		m.MinimockCreateVariantGroupDone() &&
+		m.MinimockGetAttributeSchemaDone() &&
+		m.MinimockGetBundleComponentsDone() &&
+		m.MinimockGetSKUByIDDone() &&
+		m.MinimockGetVariantGroupDone() &&
+		m.MinimockSaveAttributeSchemaDone() &&
+		m.MinimockSaveBundleDone() &&
+		m.MinimockSearchSKUsByQueryDone() &&
+		m.MinimockUpdateSKUAttributesDone()
 }
@@ -7,6 +7,7 @@ import (
 	"stocks/internal/domain"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
@@ -17,33 +18,117 @@ type StockServiceRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcCountStockItems          func(ctx context.Context, userID domain.UserID, location string) (u1 uint16, err error)
+	funcAdjustBundleComponents          func(ctx context.Context, adjustment domain.StockAdjustment, components []domain.BundleComponent) (sa1 []domain.StockAdjustmentResult, err error)
+	funcAdjustBundleComponentsOrigin    string
+	inspectFuncAdjustBundleComponents   func(ctx context.Context, adjustment domain.StockAdjustment, components []domain.BundleComponent)
+	afterAdjustBundleComponentsCounter  uint64
+	beforeAdjustBundleComponentsCounter uint64
+	AdjustBundleComponentsMock          mStockServiceRepositoryMockAdjustBundleComponents
+
+	funcAdjustStockCount          func(ctx context.Context, adjustment domain.StockAdjustment) (s1 domain.StockAdjustmentResult, err error)
+	funcAdjustStockCountOrigin    string
+	inspectFuncAdjustStockCount   func(ctx context.Context, adjustment domain.StockAdjustment)
+	afterAdjustStockCountCounter  uint64
+	beforeAdjustStockCountCounter uint64
+	AdjustStockCountMock          mStockServiceRepositoryMockAdjustStockCount
+
+	funcApplyCycleCountVariances          func(ctx context.Context, cycleCountID domain.CycleCountID, approvedBy domain.UserID) (c2 domain.CycleCountApproval, err error)
+	funcApplyCycleCountVariancesOrigin    string
+	inspectFuncApplyCycleCountVariances   func(ctx context.Context, cycleCountID domain.CycleCountID, approvedBy domain.UserID)
+	afterApplyCycleCountVariancesCounter  uint64
+	beforeApplyCycleCountVariancesCounter uint64
+	ApplyCycleCountVariancesMock          mStockServiceRepositoryMockApplyCycleCountVariances
+
+	funcCountLotsExpiringBefore          func(ctx context.Context, filter domain.ExpiringLotsFilter) (u1 uint32, err error)
+	funcCountLotsExpiringBeforeOrigin    string
+	inspectFuncCountLotsExpiringBefore   func(ctx context.Context, filter domain.ExpiringLotsFilter)
+	afterCountLotsExpiringBeforeCounter  uint64
+	beforeCountLotsExpiringBeforeCounter uint64
+	CountLotsExpiringBeforeMock          mStockServiceRepositoryMockCountLotsExpiringBefore
+
+	funcCountStockItems          func(ctx context.Context, filter domain.Filter) (u1 uint16, err error)
 	funcCountStockItemsOrigin    string
-	inspectFuncCountStockItems   func(ctx context.Context, userID domain.UserID, location string)
+	inspectFuncCountStockItems   func(ctx context.Context, filter domain.Filter)
 	afterCountStockItemsCounter  uint64
 	beforeCountStockItemsCounter uint64
 	CountStockItemsMock          mStockServiceRepositoryMockCountStockItems
 
-	funcDeleteStockItemFromStorage          func(ctx context.Context, userID domain.UserID, skuID domain.SKUID) (err error)
+	funcCreateCycleCount          func(ctx context.Context, cycleCount domain.CycleCount) (c2 domain.CycleCount, err error)
+	funcCreateCycleCountOrigin    string
+	inspectFuncCreateCycleCount   func(ctx context.Context, cycleCount domain.CycleCount)
+	afterCreateCycleCountCounter  uint64
+	beforeCreateCycleCountCounter uint64
+	CreateCycleCountMock          mStockServiceRepositoryMockCreateCycleCount
+
+	funcDeleteStockItemFromStorage          func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, deletedBy domain.UserID) (sa1 []domain.StockItem, err error)
 	funcDeleteStockItemFromStorageOrigin    string
-	inspectFuncDeleteStockItemFromStorage   func(ctx context.Context, userID domain.UserID, skuID domain.SKUID)
+	inspectFuncDeleteStockItemFromStorage   func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, deletedBy domain.UserID)
 	afterDeleteStockItemFromStorageCounter  uint64
 	beforeDeleteStockItemFromStorageCounter uint64
 	DeleteStockItemFromStorageMock          mStockServiceRepositoryMockDeleteStockItemFromStorage
 
-	funcGetStockItem          func(ctx context.Context, userID domain.UserID, skuID domain.SKUID) (s1 domain.StockItem, err error)
+	funcExpireDueLots          func(ctx context.Context, limit int) (ea1 []domain.ExpiredLot, err error)
+	funcExpireDueLotsOrigin    string
+	inspectFuncExpireDueLots   func(ctx context.Context, limit int)
+	afterExpireDueLotsCounter  uint64
+	beforeExpireDueLotsCounter uint64
+	ExpireDueLotsMock          mStockServiceRepositoryMockExpireDueLots
+
+	funcGetCycleCount          func(ctx context.Context, cycleCountID domain.CycleCountID) (c2 domain.CycleCount, err error)
+	funcGetCycleCountOrigin    string
+	inspectFuncGetCycleCount   func(ctx context.Context, cycleCountID domain.CycleCountID)
+	afterGetCycleCountCounter  uint64
+	beforeGetCycleCountCounter uint64
+	GetCycleCountMock          mStockServiceRepositoryMockGetCycleCount
+
+	funcGetPurchaseOrderByID          func(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) (p1 domain.PurchaseOrder, err error)
+	funcGetPurchaseOrderByIDOrigin    string
+	inspectFuncGetPurchaseOrderByID   func(ctx context.Context, purchaseOrderID domain.PurchaseOrderID)
+	afterGetPurchaseOrderByIDCounter  uint64
+	beforeGetPurchaseOrderByIDCounter uint64
+	GetPurchaseOrderByIDMock          mStockServiceRepositoryMockGetPurchaseOrderByID
+
+	funcGetStockItem          func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) (s1 domain.StockItem, err error)
 	funcGetStockItemOrigin    string
-	inspectFuncGetStockItem   func(ctx context.Context, userID domain.UserID, skuID domain.SKUID)
+	inspectFuncGetStockItem   func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string)
 	afterGetStockItemCounter  uint64
 	beforeGetStockItemCounter uint64
 	GetStockItemMock          mStockServiceRepositoryMockGetStockItem
 
-	funcGetStockItemBySku          func(ctx context.Context, skuID domain.SKUID) (s1 domain.StockItem, err error)
-	funcGetStockItemBySkuOrigin    string
-	inspectFuncGetStockItemBySku   func(ctx context.Context, skuID domain.SKUID)
-	afterGetStockItemBySkuCounter  uint64
-	beforeGetStockItemBySkuCounter uint64
-	GetStockItemBySkuMock          mStockServiceRepositoryMockGetStockItemBySku
+	funcGetStockTransfer          func(ctx context.Context, userID domain.UserID, transferID domain.TransferID) (s1 domain.StockTransfer, err error)
+	funcGetStockTransferOrigin    string
+	inspectFuncGetStockTransfer   func(ctx context.Context, userID domain.UserID, transferID domain.TransferID)
+	afterGetStockTransferCounter  uint64
+	beforeGetStockTransferCounter uint64
+	GetStockTransferMock          mStockServiceRepositoryMockGetStockTransfer
+
+	funcListBundleOffers          func(ctx context.Context, filter domain.OfferFilter) (sa1 []domain.StockItem, err error)
+	funcListBundleOffersOrigin    string
+	inspectFuncListBundleOffers   func(ctx context.Context, filter domain.OfferFilter)
+	afterListBundleOffersCounter  uint64
+	beforeListBundleOffersCounter uint64
+	ListBundleOffersMock          mStockServiceRepositoryMockListBundleOffers
+
+	funcListDemandHistory          func(ctx context.Context, filter domain.ReorderFilter, since time.Time) (da1 []domain.DemandHistory, err error)
+	funcListDemandHistoryOrigin    string
+	inspectFuncListDemandHistory   func(ctx context.Context, filter domain.ReorderFilter, since time.Time)
+	afterListDemandHistoryCounter  uint64
+	beforeListDemandHistoryCounter uint64
+	ListDemandHistoryMock          mStockServiceRepositoryMockListDemandHistory
+
+	funcListLotsExpiringBefore          func(ctx context.Context, filter domain.ExpiringLotsFilter) (sa1 []domain.StockLot, err error)
+	funcListLotsExpiringBeforeOrigin    string
+	inspectFuncListLotsExpiringBefore   func(ctx context.Context, filter domain.ExpiringLotsFilter)
+	afterListLotsExpiringBeforeCounter  uint64
+	beforeListLotsExpiringBeforeCounter uint64
+	ListLotsExpiringBeforeMock          mStockServiceRepositoryMockListLotsExpiringBefore
+
+	funcListStockItemOffers          func(ctx context.Context, filter domain.OfferFilter) (sa1 []domain.StockItem, err error)
+	funcListStockItemOffersOrigin    string
+	inspectFuncListStockItemOffers   func(ctx context.Context, filter domain.OfferFilter)
+	afterListStockItemOffersCounter  uint64
+	beforeListStockItemOffersCounter uint64
+	ListStockItemOffersMock          mStockServiceRepositoryMockListStockItemOffers
 
 	funcListStockItemsByLocation          func(ctx context.Context, filter domain.Filter) (sa1 []domain.StockItem, err error)
 	funcListStockItemsByLocationOrigin    string
@@ -52,19 +137,110 @@ type StockServiceRepositoryMock struct {
 	beforeListStockItemsByLocationCounter uint64
 	ListStockItemsByLocationMock          mStockServiceRepositoryMockListStockItemsByLocation
 
-	funcSaveStockItem          func(ctx context.Context, stockItem domain.StockItem) (err error)
-	funcSaveStockItemOrigin    string
-	inspectFuncSaveStockItem   func(ctx context.Context, stockItem domain.StockItem)
-	afterSaveStockItemCounter  uint64
-	beforeSaveStockItemCounter uint64
-	SaveStockItemMock          mStockServiceRepositoryMockSaveStockItem
-
-	funcUpdateStockItem          func(ctx context.Context, stockItem domain.StockItem) (err error)
-	funcUpdateStockItemOrigin    string
-	inspectFuncUpdateStockItem   func(ctx context.Context, stockItem domain.StockItem)
-	afterUpdateStockItemCounter  uint64
-	beforeUpdateStockItemCounter uint64
-	UpdateStockItemMock          mStockServiceRepositoryMockUpdateStockItem
+	funcListStockItemsBySkus          func(ctx context.Context, skuIDs []domain.SKUID) (sa1 []domain.StockItem, err error)
+	funcListStockItemsBySkusOrigin    string
+	inspectFuncListStockItemsBySkus   func(ctx context.Context, skuIDs []domain.SKUID)
+	afterListStockItemsBySkusCounter  uint64
+	beforeListStockItemsBySkusCounter uint64
+	ListStockItemsBySkusMock          mStockServiceRepositoryMockListStockItemsBySkus
+
+	funcListSuppliersOfMerchant          func(ctx context.Context, userID domain.UserID) (sa1 []domain.Supplier, err error)
+	funcListSuppliersOfMerchantOrigin    string
+	inspectFuncListSuppliersOfMerchant   func(ctx context.Context, userID domain.UserID)
+	afterListSuppliersOfMerchantCounter  uint64
+	beforeListSuppliersOfMerchantCounter uint64
+	ListSuppliersOfMerchantMock          mStockServiceRepositoryMockListSuppliersOfMerchant
+
+	funcMarkPurchaseOrderOrdered          func(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) (p1 domain.PurchaseOrder, err error)
+	funcMarkPurchaseOrderOrderedOrigin    string
+	inspectFuncMarkPurchaseOrderOrdered   func(ctx context.Context, purchaseOrderID domain.PurchaseOrderID)
+	afterMarkPurchaseOrderOrderedCounter  uint64
+	beforeMarkPurchaseOrderOrderedCounter uint64
+	MarkPurchaseOrderOrderedMock          mStockServiceRepositoryMockMarkPurchaseOrderOrdered
+
+	funcPurgeStockItemsDeletedBefore          func(ctx context.Context, deletedBefore time.Time) (i1 int64, err error)
+	funcPurgeStockItemsDeletedBeforeOrigin    string
+	inspectFuncPurgeStockItemsDeletedBefore   func(ctx context.Context, deletedBefore time.Time)
+	afterPurgeStockItemsDeletedBeforeCounter  uint64
+	beforePurgeStockItemsDeletedBeforeCounter uint64
+	PurgeStockItemsDeletedBeforeMock          mStockServiceRepositoryMockPurgeStockItemsDeletedBefore
+
+	funcReceivePurchaseOrderLines          func(ctx context.Context, receipt domain.PurchaseOrderReceipt) (p1 domain.PurchaseOrderReceiptResult, err error)
+	funcReceivePurchaseOrderLinesOrigin    string
+	inspectFuncReceivePurchaseOrderLines   func(ctx context.Context, receipt domain.PurchaseOrderReceipt)
+	afterReceivePurchaseOrderLinesCounter  uint64
+	beforeReceivePurchaseOrderLinesCounter uint64
+	ReceivePurchaseOrderLinesMock          mStockServiceRepositoryMockReceivePurchaseOrderLines
+
+	funcReceiveStockTransfer          func(ctx context.Context, userID domain.UserID, transferID domain.TransferID) (s1 domain.StockTransferResult, err error)
+	funcReceiveStockTransferOrigin    string
+	inspectFuncReceiveStockTransfer   func(ctx context.Context, userID domain.UserID, transferID domain.TransferID)
+	afterReceiveStockTransferCounter  uint64
+	beforeReceiveStockTransferCounter uint64
+	ReceiveStockTransferMock          mStockServiceRepositoryMockReceiveStockTransfer
+
+	funcRestoreDeletedStockItem          func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) (s1 domain.StockItem, err error)
+	funcRestoreDeletedStockItemOrigin    string
+	inspectFuncRestoreDeletedStockItem   func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string)
+	afterRestoreDeletedStockItemCounter  uint64
+	beforeRestoreDeletedStockItemCounter uint64
+	RestoreDeletedStockItemMock          mStockServiceRepositoryMockRestoreDeletedStockItem
+
+	funcSaveCartDemand          func(ctx context.Context, demand domain.CartDemand) (err error)
+	funcSaveCartDemandOrigin    string
+	inspectFuncSaveCartDemand   func(ctx context.Context, demand domain.CartDemand)
+	afterSaveCartDemandCounter  uint64
+	beforeSaveCartDemandCounter uint64
+	SaveCartDemandMock          mStockServiceRepositoryMockSaveCartDemand
+
+	funcSaveCycleCounts          func(ctx context.Context, submission domain.CycleCountSubmission) (err error)
+	funcSaveCycleCountsOrigin    string
+	inspectFuncSaveCycleCounts   func(ctx context.Context, submission domain.CycleCountSubmission)
+	afterSaveCycleCountsCounter  uint64
+	beforeSaveCycleCountsCounter uint64
+	SaveCycleCountsMock          mStockServiceRepositoryMockSaveCycleCounts
+
+	funcSavePurchaseOrder          func(ctx context.Context, purchaseOrder domain.PurchaseOrder) (p1 domain.PurchaseOrder, err error)
+	funcSavePurchaseOrderOrigin    string
+	inspectFuncSavePurchaseOrder   func(ctx context.Context, purchaseOrder domain.PurchaseOrder)
+	afterSavePurchaseOrderCounter  uint64
+	beforeSavePurchaseOrderCounter uint64
+	SavePurchaseOrderMock          mStockServiceRepositoryMockSavePurchaseOrder
+
+	funcSaveSupplier          func(ctx context.Context, supplier domain.Supplier) (s1 domain.Supplier, err error)
+	funcSaveSupplierOrigin    string
+	inspectFuncSaveSupplier   func(ctx context.Context, supplier domain.Supplier)
+	afterSaveSupplierCounter  uint64
+	beforeSaveSupplierCounter uint64
+	SaveSupplierMock          mStockServiceRepositoryMockSaveSupplier
+
+	funcShipStockTransfer          func(ctx context.Context, transfer domain.StockTransfer, receiveImmediately bool) (s1 domain.StockTransferResult, err error)
+	funcShipStockTransferOrigin    string
+	inspectFuncShipStockTransfer   func(ctx context.Context, transfer domain.StockTransfer, receiveImmediately bool)
+	afterShipStockTransferCounter  uint64
+	beforeShipStockTransferCounter uint64
+	ShipStockTransferMock          mStockServiceRepositoryMockShipStockTransfer
+
+	funcUpdateBackorderSettings          func(ctx context.Context, settings domain.BackorderSettings) (err error)
+	funcUpdateBackorderSettingsOrigin    string
+	inspectFuncUpdateBackorderSettings   func(ctx context.Context, settings domain.BackorderSettings)
+	afterUpdateBackorderSettingsCounter  uint64
+	beforeUpdateBackorderSettingsCounter uint64
+	UpdateBackorderSettingsMock          mStockServiceRepositoryMockUpdateBackorderSettings
+
+	funcUpdateStockItemFields          func(ctx context.Context, update domain.StockItemUpdate) (s1 domain.StockItem, err error)
+	funcUpdateStockItemFieldsOrigin    string
+	inspectFuncUpdateStockItemFields   func(ctx context.Context, update domain.StockItemUpdate)
+	afterUpdateStockItemFieldsCounter  uint64
+	beforeUpdateStockItemFieldsCounter uint64
+	UpdateStockItemFieldsMock          mStockServiceRepositoryMockUpdateStockItemFields
+
+	funcUpsertStockItem          func(ctx context.Context, stockItem domain.StockItem) (s1 domain.StockItem, b1 bool, err error)
+	funcUpsertStockItemOrigin    string
+	inspectFuncUpsertStockItem   func(ctx context.Context, stockItem domain.StockItem)
+	afterUpsertStockItemCounter  uint64
+	beforeUpsertStockItemCounter uint64
+	UpsertStockItemMock          mStockServiceRepositoryMockUpsertStockItem
 }
 
 // NewStockServiceRepositoryMock returns a mock for mm_stocks.StockServiceRepository
@@ -75,82 +251,157 @@ func NewStockServiceRepositoryMock(t minimock.Tester) *StockServiceRepositoryMoc
 		controller.RegisterMocker(m)
 	}
 
+	m.AdjustBundleComponentsMock = mStockServiceRepositoryMockAdjustBundleComponents{mock: m}
+	m.AdjustBundleComponentsMock.callArgs = []*StockServiceRepositoryMockAdjustBundleComponentsParams{}
+
+	m.AdjustStockCountMock = mStockServiceRepositoryMockAdjustStockCount{mock: m}
+	m.AdjustStockCountMock.callArgs = []*StockServiceRepositoryMockAdjustStockCountParams{}
+
+	m.ApplyCycleCountVariancesMock = mStockServiceRepositoryMockApplyCycleCountVariances{mock: m}
+	m.ApplyCycleCountVariancesMock.callArgs = []*StockServiceRepositoryMockApplyCycleCountVariancesParams{}
+
+	m.CountLotsExpiringBeforeMock = mStockServiceRepositoryMockCountLotsExpiringBefore{mock: m}
+	m.CountLotsExpiringBeforeMock.callArgs = []*StockServiceRepositoryMockCountLotsExpiringBeforeParams{}
+
 	m.CountStockItemsMock = mStockServiceRepositoryMockCountStockItems{mock: m}
 	m.CountStockItemsMock.callArgs = []*StockServiceRepositoryMockCountStockItemsParams{}
 
+	m.CreateCycleCountMock = mStockServiceRepositoryMockCreateCycleCount{mock: m}
+	m.CreateCycleCountMock.callArgs = []*StockServiceRepositoryMockCreateCycleCountParams{}
+
 	m.DeleteStockItemFromStorageMock = mStockServiceRepositoryMockDeleteStockItemFromStorage{mock: m}
 	m.DeleteStockItemFromStorageMock.callArgs = []*StockServiceRepositoryMockDeleteStockItemFromStorageParams{}
 
+	m.ExpireDueLotsMock = mStockServiceRepositoryMockExpireDueLots{mock: m}
+	m.ExpireDueLotsMock.callArgs = []*StockServiceRepositoryMockExpireDueLotsParams{}
+
+	m.GetCycleCountMock = mStockServiceRepositoryMockGetCycleCount{mock: m}
+	m.GetCycleCountMock.callArgs = []*StockServiceRepositoryMockGetCycleCountParams{}
+
+	m.GetPurchaseOrderByIDMock = mStockServiceRepositoryMockGetPurchaseOrderByID{mock: m}
+	m.GetPurchaseOrderByIDMock.callArgs = []*StockServiceRepositoryMockGetPurchaseOrderByIDParams{}
+
 	m.GetStockItemMock = mStockServiceRepositoryMockGetStockItem{mock: m}
 	m.GetStockItemMock.callArgs = []*StockServiceRepositoryMockGetStockItemParams{}
 
-	m.GetStockItemBySkuMock = mStockServiceRepositoryMockGetStockItemBySku{mock: m}
-	m.GetStockItemBySkuMock.callArgs = []*StockServiceRepositoryMockGetStockItemBySkuParams{}
+	m.GetStockTransferMock = mStockServiceRepositoryMockGetStockTransfer{mock: m}
+	m.GetStockTransferMock.callArgs = []*StockServiceRepositoryMockGetStockTransferParams{}
+
+	m.ListBundleOffersMock = mStockServiceRepositoryMockListBundleOffers{mock: m}
+	m.ListBundleOffersMock.callArgs = []*StockServiceRepositoryMockListBundleOffersParams{}
+
+	m.ListDemandHistoryMock = mStockServiceRepositoryMockListDemandHistory{mock: m}
+	m.ListDemandHistoryMock.callArgs = []*StockServiceRepositoryMockListDemandHistoryParams{}
+
+	m.ListLotsExpiringBeforeMock = mStockServiceRepositoryMockListLotsExpiringBefore{mock: m}
+	m.ListLotsExpiringBeforeMock.callArgs = []*StockServiceRepositoryMockListLotsExpiringBeforeParams{}
+
+	m.ListStockItemOffersMock = mStockServiceRepositoryMockListStockItemOffers{mock: m}
+	m.ListStockItemOffersMock.callArgs = []*StockServiceRepositoryMockListStockItemOffersParams{}
 
 	m.ListStockItemsByLocationMock = mStockServiceRepositoryMockListStockItemsByLocation{mock: m}
 	m.ListStockItemsByLocationMock.callArgs = []*StockServiceRepositoryMockListStockItemsByLocationParams{}
 
-	m.SaveStockItemMock = mStockServiceRepositoryMockSaveStockItem{mock: m}
-	m.SaveStockItemMock.callArgs = []*StockServiceRepositoryMockSaveStockItemParams{}
+	m.ListStockItemsBySkusMock = mStockServiceRepositoryMockListStockItemsBySkus{mock: m}
+	m.ListStockItemsBySkusMock.callArgs = []*StockServiceRepositoryMockListStockItemsBySkusParams{}
+
+	m.ListSuppliersOfMerchantMock = mStockServiceRepositoryMockListSuppliersOfMerchant{mock: m}
+	m.ListSuppliersOfMerchantMock.callArgs = []*StockServiceRepositoryMockListSuppliersOfMerchantParams{}
+
+	m.MarkPurchaseOrderOrderedMock = mStockServiceRepositoryMockMarkPurchaseOrderOrdered{mock: m}
+	m.MarkPurchaseOrderOrderedMock.callArgs = []*StockServiceRepositoryMockMarkPurchaseOrderOrderedParams{}
 
-	m.UpdateStockItemMock = mStockServiceRepositoryMockUpdateStockItem{mock: m}
-	m.UpdateStockItemMock.callArgs = []*StockServiceRepositoryMockUpdateStockItemParams{}
+	m.PurgeStockItemsDeletedBeforeMock = mStockServiceRepositoryMockPurgeStockItemsDeletedBefore{mock: m}
+	m.PurgeStockItemsDeletedBeforeMock.callArgs = []*StockServiceRepositoryMockPurgeStockItemsDeletedBeforeParams{}
+
+	m.ReceivePurchaseOrderLinesMock = mStockServiceRepositoryMockReceivePurchaseOrderLines{mock: m}
+	m.ReceivePurchaseOrderLinesMock.callArgs = []*StockServiceRepositoryMockReceivePurchaseOrderLinesParams{}
+
+	m.ReceiveStockTransferMock = mStockServiceRepositoryMockReceiveStockTransfer{mock: m}
+	m.ReceiveStockTransferMock.callArgs = []*StockServiceRepositoryMockReceiveStockTransferParams{}
+
+	m.RestoreDeletedStockItemMock = mStockServiceRepositoryMockRestoreDeletedStockItem{mock: m}
+	m.RestoreDeletedStockItemMock.callArgs = []*StockServiceRepositoryMockRestoreDeletedStockItemParams{}
+
+	m.SaveCartDemandMock = mStockServiceRepositoryMockSaveCartDemand{mock: m}
+	m.SaveCartDemandMock.callArgs = []*StockServiceRepositoryMockSaveCartDemandParams{}
+
+	m.SaveCycleCountsMock = mStockServiceRepositoryMockSaveCycleCounts{mock: m}
+	m.SaveCycleCountsMock.callArgs = []*StockServiceRepositoryMockSaveCycleCountsParams{}
+
+	m.SavePurchaseOrderMock = mStockServiceRepositoryMockSavePurchaseOrder{mock: m}
+	m.SavePurchaseOrderMock.callArgs = []*StockServiceRepositoryMockSavePurchaseOrderParams{}
+
+	m.SaveSupplierMock = mStockServiceRepositoryMockSaveSupplier{mock: m}
+	m.SaveSupplierMock.callArgs = []*StockServiceRepositoryMockSaveSupplierParams{}
+
+	m.ShipStockTransferMock = mStockServiceRepositoryMockShipStockTransfer{mock: m}
+	m.ShipStockTransferMock.callArgs = []*StockServiceRepositoryMockShipStockTransferParams{}
+
+	m.UpdateBackorderSettingsMock = mStockServiceRepositoryMockUpdateBackorderSettings{mock: m}
+	m.UpdateBackorderSettingsMock.callArgs = []*StockServiceRepositoryMockUpdateBackorderSettingsParams{}
+
+	m.UpdateStockItemFieldsMock = mStockServiceRepositoryMockUpdateStockItemFields{mock: m}
+	m.UpdateStockItemFieldsMock.callArgs = []*StockServiceRepositoryMockUpdateStockItemFieldsParams{}
+
+	m.UpsertStockItemMock = mStockServiceRepositoryMockUpsertStockItem{mock: m}
+	m.UpsertStockItemMock.callArgs = []*StockServiceRepositoryMockUpsertStockItemParams{}
 
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
-type mStockServiceRepositoryMockCountStockItems struct {
+type mStockServiceRepositoryMockAdjustBundleComponents struct {
 	optional           bool
 	mock               *StockServiceRepositoryMock
-	defaultExpectation *StockServiceRepositoryMockCountStockItemsExpectation
-	expectations       []*StockServiceRepositoryMockCountStockItemsExpectation
+	defaultExpectation *StockServiceRepositoryMockAdjustBundleComponentsExpectation
+	expectations       []*StockServiceRepositoryMockAdjustBundleComponentsExpectation
 
-	callArgs []*StockServiceRepositoryMockCountStockItemsParams
+	callArgs []*StockServiceRepositoryMockAdjustBundleComponentsParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// StockServiceRepositoryMockCountStockItemsExpectation specifies expectation struct of the StockServiceRepository.CountStockItems
-type StockServiceRepositoryMockCountStockItemsExpectation struct {
+// StockServiceRepositoryMockAdjustBundleComponentsExpectation specifies expectation struct of the StockServiceRepository.AdjustBundleComponents
+type StockServiceRepositoryMockAdjustBundleComponentsExpectation struct {
 	mock               *StockServiceRepositoryMock
-	params             *StockServiceRepositoryMockCountStockItemsParams
-	paramPtrs          *StockServiceRepositoryMockCountStockItemsParamPtrs
-	expectationOrigins StockServiceRepositoryMockCountStockItemsExpectationOrigins
-	results            *StockServiceRepositoryMockCountStockItemsResults
+	params             *StockServiceRepositoryMockAdjustBundleComponentsParams
+	paramPtrs          *StockServiceRepositoryMockAdjustBundleComponentsParamPtrs
+	expectationOrigins StockServiceRepositoryMockAdjustBundleComponentsExpectationOrigins
+	results            *StockServiceRepositoryMockAdjustBundleComponentsResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// StockServiceRepositoryMockCountStockItemsParams contains parameters of the StockServiceRepository.CountStockItems
-type StockServiceRepositoryMockCountStockItemsParams struct {
-	ctx      context.Context
-	userID   domain.UserID
-	location string
+// StockServiceRepositoryMockAdjustBundleComponentsParams contains parameters of the StockServiceRepository.AdjustBundleComponents
+type StockServiceRepositoryMockAdjustBundleComponentsParams struct {
+	ctx        context.Context
+	adjustment domain.StockAdjustment
+	components []domain.BundleComponent
 }
 
-// StockServiceRepositoryMockCountStockItemsParamPtrs contains pointers to parameters of the StockServiceRepository.CountStockItems
-type StockServiceRepositoryMockCountStockItemsParamPtrs struct {
-	ctx      *context.Context
-	userID   *domain.UserID
-	location *string
+// StockServiceRepositoryMockAdjustBundleComponentsParamPtrs contains pointers to parameters of the StockServiceRepository.AdjustBundleComponents
+type StockServiceRepositoryMockAdjustBundleComponentsParamPtrs struct {
+	ctx        *context.Context
+	adjustment *domain.StockAdjustment
+	components *[]domain.BundleComponent
 }
 
-// StockServiceRepositoryMockCountStockItemsResults contains results of the StockServiceRepository.CountStockItems
-type StockServiceRepositoryMockCountStockItemsResults struct {
-	u1  uint16
+// StockServiceRepositoryMockAdjustBundleComponentsResults contains results of the StockServiceRepository.AdjustBundleComponents
+type StockServiceRepositoryMockAdjustBundleComponentsResults struct {
+	sa1 []domain.StockAdjustmentResult
 	err error
 }
 
-// StockServiceRepositoryMockCountStockItemsOrigins contains origins of expectations of the StockServiceRepository.CountStockItems
-type StockServiceRepositoryMockCountStockItemsExpectationOrigins struct {
-	origin         string
-	originCtx      string
-	originUserID   string
-	originLocation string
+// StockServiceRepositoryMockAdjustBundleComponentsOrigins contains origins of expectations of the StockServiceRepository.AdjustBundleComponents
+type StockServiceRepositoryMockAdjustBundleComponentsExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originAdjustment string
+	originComponents string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -158,372 +409,370 @@ type StockServiceRepositoryMockCountStockItemsExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCountStockItems *mStockServiceRepositoryMockCountStockItems) Optional() *mStockServiceRepositoryMockCountStockItems {
-	mmCountStockItems.optional = true
-	return mmCountStockItems
+func (mmAdjustBundleComponents *mStockServiceRepositoryMockAdjustBundleComponents) Optional() *mStockServiceRepositoryMockAdjustBundleComponents {
+	mmAdjustBundleComponents.optional = true
+	return mmAdjustBundleComponents
 }
 
-// Expect sets up expected params for StockServiceRepository.CountStockItems
-func (mmCountStockItems *mStockServiceRepositoryMockCountStockItems) Expect(ctx context.Context, userID domain.UserID, location string) *mStockServiceRepositoryMockCountStockItems {
-	if mmCountStockItems.mock.funcCountStockItems != nil {
-		mmCountStockItems.mock.t.Fatalf("StockServiceRepositoryMock.CountStockItems mock is already set by Set")
+// Expect sets up expected params for StockServiceRepository.AdjustBundleComponents
+func (mmAdjustBundleComponents *mStockServiceRepositoryMockAdjustBundleComponents) Expect(ctx context.Context, adjustment domain.StockAdjustment, components []domain.BundleComponent) *mStockServiceRepositoryMockAdjustBundleComponents {
+	if mmAdjustBundleComponents.mock.funcAdjustBundleComponents != nil {
+		mmAdjustBundleComponents.mock.t.Fatalf("StockServiceRepositoryMock.AdjustBundleComponents mock is already set by Set")
 	}
 
-	if mmCountStockItems.defaultExpectation == nil {
-		mmCountStockItems.defaultExpectation = &StockServiceRepositoryMockCountStockItemsExpectation{}
+	if mmAdjustBundleComponents.defaultExpectation == nil {
+		mmAdjustBundleComponents.defaultExpectation = &StockServiceRepositoryMockAdjustBundleComponentsExpectation{}
 	}
 
-	if mmCountStockItems.defaultExpectation.paramPtrs != nil {
-		mmCountStockItems.mock.t.Fatalf("StockServiceRepositoryMock.CountStockItems mock is already set by ExpectParams functions")
+	if mmAdjustBundleComponents.defaultExpectation.paramPtrs != nil {
+		mmAdjustBundleComponents.mock.t.Fatalf("StockServiceRepositoryMock.AdjustBundleComponents mock is already set by ExpectParams functions")
 	}
 
-	mmCountStockItems.defaultExpectation.params = &StockServiceRepositoryMockCountStockItemsParams{ctx, userID, location}
-	mmCountStockItems.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCountStockItems.expectations {
-		if minimock.Equal(e.params, mmCountStockItems.defaultExpectation.params) {
-			mmCountStockItems.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCountStockItems.defaultExpectation.params)
+	mmAdjustBundleComponents.defaultExpectation.params = &StockServiceRepositoryMockAdjustBundleComponentsParams{ctx, adjustment, components}
+	mmAdjustBundleComponents.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAdjustBundleComponents.expectations {
+		if minimock.Equal(e.params, mmAdjustBundleComponents.defaultExpectation.params) {
+			mmAdjustBundleComponents.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAdjustBundleComponents.defaultExpectation.params)
 		}
 	}
 
-	return mmCountStockItems
+	return mmAdjustBundleComponents
 }
 
-// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.CountStockItems
-func (mmCountStockItems *mStockServiceRepositoryMockCountStockItems) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockCountStockItems {
-	if mmCountStockItems.mock.funcCountStockItems != nil {
-		mmCountStockItems.mock.t.Fatalf("StockServiceRepositoryMock.CountStockItems mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.AdjustBundleComponents
+func (mmAdjustBundleComponents *mStockServiceRepositoryMockAdjustBundleComponents) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockAdjustBundleComponents {
+	if mmAdjustBundleComponents.mock.funcAdjustBundleComponents != nil {
+		mmAdjustBundleComponents.mock.t.Fatalf("StockServiceRepositoryMock.AdjustBundleComponents mock is already set by Set")
 	}
 
-	if mmCountStockItems.defaultExpectation == nil {
-		mmCountStockItems.defaultExpectation = &StockServiceRepositoryMockCountStockItemsExpectation{}
+	if mmAdjustBundleComponents.defaultExpectation == nil {
+		mmAdjustBundleComponents.defaultExpectation = &StockServiceRepositoryMockAdjustBundleComponentsExpectation{}
 	}
 
-	if mmCountStockItems.defaultExpectation.params != nil {
-		mmCountStockItems.mock.t.Fatalf("StockServiceRepositoryMock.CountStockItems mock is already set by Expect")
+	if mmAdjustBundleComponents.defaultExpectation.params != nil {
+		mmAdjustBundleComponents.mock.t.Fatalf("StockServiceRepositoryMock.AdjustBundleComponents mock is already set by Expect")
 	}
 
-	if mmCountStockItems.defaultExpectation.paramPtrs == nil {
-		mmCountStockItems.defaultExpectation.paramPtrs = &StockServiceRepositoryMockCountStockItemsParamPtrs{}
+	if mmAdjustBundleComponents.defaultExpectation.paramPtrs == nil {
+		mmAdjustBundleComponents.defaultExpectation.paramPtrs = &StockServiceRepositoryMockAdjustBundleComponentsParamPtrs{}
 	}
-	mmCountStockItems.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCountStockItems.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAdjustBundleComponents.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAdjustBundleComponents.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmCountStockItems
+	return mmAdjustBundleComponents
 }
 
-// ExpectUserIDParam2 sets up expected param userID for StockServiceRepository.CountStockItems
-func (mmCountStockItems *mStockServiceRepositoryMockCountStockItems) ExpectUserIDParam2(userID domain.UserID) *mStockServiceRepositoryMockCountStockItems {
-	if mmCountStockItems.mock.funcCountStockItems != nil {
-		mmCountStockItems.mock.t.Fatalf("StockServiceRepositoryMock.CountStockItems mock is already set by Set")
+// ExpectAdjustmentParam2 sets up expected param adjustment for StockServiceRepository.AdjustBundleComponents
+func (mmAdjustBundleComponents *mStockServiceRepositoryMockAdjustBundleComponents) ExpectAdjustmentParam2(adjustment domain.StockAdjustment) *mStockServiceRepositoryMockAdjustBundleComponents {
+	if mmAdjustBundleComponents.mock.funcAdjustBundleComponents != nil {
+		mmAdjustBundleComponents.mock.t.Fatalf("StockServiceRepositoryMock.AdjustBundleComponents mock is already set by Set")
 	}
 
-	if mmCountStockItems.defaultExpectation == nil {
-		mmCountStockItems.defaultExpectation = &StockServiceRepositoryMockCountStockItemsExpectation{}
+	if mmAdjustBundleComponents.defaultExpectation == nil {
+		mmAdjustBundleComponents.defaultExpectation = &StockServiceRepositoryMockAdjustBundleComponentsExpectation{}
 	}
 
-	if mmCountStockItems.defaultExpectation.params != nil {
-		mmCountStockItems.mock.t.Fatalf("StockServiceRepositoryMock.CountStockItems mock is already set by Expect")
+	if mmAdjustBundleComponents.defaultExpectation.params != nil {
+		mmAdjustBundleComponents.mock.t.Fatalf("StockServiceRepositoryMock.AdjustBundleComponents mock is already set by Expect")
 	}
 
-	if mmCountStockItems.defaultExpectation.paramPtrs == nil {
-		mmCountStockItems.defaultExpectation.paramPtrs = &StockServiceRepositoryMockCountStockItemsParamPtrs{}
+	if mmAdjustBundleComponents.defaultExpectation.paramPtrs == nil {
+		mmAdjustBundleComponents.defaultExpectation.paramPtrs = &StockServiceRepositoryMockAdjustBundleComponentsParamPtrs{}
 	}
-	mmCountStockItems.defaultExpectation.paramPtrs.userID = &userID
-	mmCountStockItems.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmAdjustBundleComponents.defaultExpectation.paramPtrs.adjustment = &adjustment
+	mmAdjustBundleComponents.defaultExpectation.expectationOrigins.originAdjustment = minimock.CallerInfo(1)
 
-	return mmCountStockItems
+	return mmAdjustBundleComponents
 }
 
-// ExpectLocationParam3 sets up expected param location for StockServiceRepository.CountStockItems
-func (mmCountStockItems *mStockServiceRepositoryMockCountStockItems) ExpectLocationParam3(location string) *mStockServiceRepositoryMockCountStockItems {
-	if mmCountStockItems.mock.funcCountStockItems != nil {
-		mmCountStockItems.mock.t.Fatalf("StockServiceRepositoryMock.CountStockItems mock is already set by Set")
+// ExpectComponentsParam3 sets up expected param components for StockServiceRepository.AdjustBundleComponents
+func (mmAdjustBundleComponents *mStockServiceRepositoryMockAdjustBundleComponents) ExpectComponentsParam3(components []domain.BundleComponent) *mStockServiceRepositoryMockAdjustBundleComponents {
+	if mmAdjustBundleComponents.mock.funcAdjustBundleComponents != nil {
+		mmAdjustBundleComponents.mock.t.Fatalf("StockServiceRepositoryMock.AdjustBundleComponents mock is already set by Set")
 	}
 
-	if mmCountStockItems.defaultExpectation == nil {
-		mmCountStockItems.defaultExpectation = &StockServiceRepositoryMockCountStockItemsExpectation{}
+	if mmAdjustBundleComponents.defaultExpectation == nil {
+		mmAdjustBundleComponents.defaultExpectation = &StockServiceRepositoryMockAdjustBundleComponentsExpectation{}
 	}
 
-	if mmCountStockItems.defaultExpectation.params != nil {
-		mmCountStockItems.mock.t.Fatalf("StockServiceRepositoryMock.CountStockItems mock is already set by Expect")
+	if mmAdjustBundleComponents.defaultExpectation.params != nil {
+		mmAdjustBundleComponents.mock.t.Fatalf("StockServiceRepositoryMock.AdjustBundleComponents mock is already set by Expect")
 	}
 
-	if mmCountStockItems.defaultExpectation.paramPtrs == nil {
-		mmCountStockItems.defaultExpectation.paramPtrs = &StockServiceRepositoryMockCountStockItemsParamPtrs{}
+	if mmAdjustBundleComponents.defaultExpectation.paramPtrs == nil {
+		mmAdjustBundleComponents.defaultExpectation.paramPtrs = &StockServiceRepositoryMockAdjustBundleComponentsParamPtrs{}
 	}
-	mmCountStockItems.defaultExpectation.paramPtrs.location = &location
-	mmCountStockItems.defaultExpectation.expectationOrigins.originLocation = minimock.CallerInfo(1)
+	mmAdjustBundleComponents.defaultExpectation.paramPtrs.components = &components
+	mmAdjustBundleComponents.defaultExpectation.expectationOrigins.originComponents = minimock.CallerInfo(1)
 
-	return mmCountStockItems
+	return mmAdjustBundleComponents
 }
 
-// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.CountStockItems
-func (mmCountStockItems *mStockServiceRepositoryMockCountStockItems) Inspect(f func(ctx context.Context, userID domain.UserID, location string)) *mStockServiceRepositoryMockCountStockItems {
-	if mmCountStockItems.mock.inspectFuncCountStockItems != nil {
-		mmCountStockItems.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.CountStockItems")
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.AdjustBundleComponents
+func (mmAdjustBundleComponents *mStockServiceRepositoryMockAdjustBundleComponents) Inspect(f func(ctx context.Context, adjustment domain.StockAdjustment, components []domain.BundleComponent)) *mStockServiceRepositoryMockAdjustBundleComponents {
+	if mmAdjustBundleComponents.mock.inspectFuncAdjustBundleComponents != nil {
+		mmAdjustBundleComponents.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.AdjustBundleComponents")
 	}
 
-	mmCountStockItems.mock.inspectFuncCountStockItems = f
+	mmAdjustBundleComponents.mock.inspectFuncAdjustBundleComponents = f
 
-	return mmCountStockItems
+	return mmAdjustBundleComponents
 }
 
-// Return sets up results that will be returned by StockServiceRepository.CountStockItems
-func (mmCountStockItems *mStockServiceRepositoryMockCountStockItems) Return(u1 uint16, err error) *StockServiceRepositoryMock {
-	if mmCountStockItems.mock.funcCountStockItems != nil {
-		mmCountStockItems.mock.t.Fatalf("StockServiceRepositoryMock.CountStockItems mock is already set by Set")
+// Return sets up results that will be returned by StockServiceRepository.AdjustBundleComponents
+func (mmAdjustBundleComponents *mStockServiceRepositoryMockAdjustBundleComponents) Return(sa1 []domain.StockAdjustmentResult, err error) *StockServiceRepositoryMock {
+	if mmAdjustBundleComponents.mock.funcAdjustBundleComponents != nil {
+		mmAdjustBundleComponents.mock.t.Fatalf("StockServiceRepositoryMock.AdjustBundleComponents mock is already set by Set")
 	}
 
-	if mmCountStockItems.defaultExpectation == nil {
-		mmCountStockItems.defaultExpectation = &StockServiceRepositoryMockCountStockItemsExpectation{mock: mmCountStockItems.mock}
+	if mmAdjustBundleComponents.defaultExpectation == nil {
+		mmAdjustBundleComponents.defaultExpectation = &StockServiceRepositoryMockAdjustBundleComponentsExpectation{mock: mmAdjustBundleComponents.mock}
 	}
-	mmCountStockItems.defaultExpectation.results = &StockServiceRepositoryMockCountStockItemsResults{u1, err}
-	mmCountStockItems.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCountStockItems.mock
+	mmAdjustBundleComponents.defaultExpectation.results = &StockServiceRepositoryMockAdjustBundleComponentsResults{sa1, err}
+	mmAdjustBundleComponents.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAdjustBundleComponents.mock
 }
 
-// Set uses given function f to mock the StockServiceRepository.CountStockItems method
-func (mmCountStockItems *mStockServiceRepositoryMockCountStockItems) Set(f func(ctx context.Context, userID domain.UserID, location string) (u1 uint16, err error)) *StockServiceRepositoryMock {
-	if mmCountStockItems.defaultExpectation != nil {
-		mmCountStockItems.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.CountStockItems method")
+// Set uses given function f to mock the StockServiceRepository.AdjustBundleComponents method
+func (mmAdjustBundleComponents *mStockServiceRepositoryMockAdjustBundleComponents) Set(f func(ctx context.Context, adjustment domain.StockAdjustment, components []domain.BundleComponent) (sa1 []domain.StockAdjustmentResult, err error)) *StockServiceRepositoryMock {
+	if mmAdjustBundleComponents.defaultExpectation != nil {
+		mmAdjustBundleComponents.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.AdjustBundleComponents method")
 	}
 
-	if len(mmCountStockItems.expectations) > 0 {
-		mmCountStockItems.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.CountStockItems method")
+	if len(mmAdjustBundleComponents.expectations) > 0 {
+		mmAdjustBundleComponents.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.AdjustBundleComponents method")
 	}
 
-	mmCountStockItems.mock.funcCountStockItems = f
-	mmCountStockItems.mock.funcCountStockItemsOrigin = minimock.CallerInfo(1)
-	return mmCountStockItems.mock
+	mmAdjustBundleComponents.mock.funcAdjustBundleComponents = f
+	mmAdjustBundleComponents.mock.funcAdjustBundleComponentsOrigin = minimock.CallerInfo(1)
+	return mmAdjustBundleComponents.mock
 }
 
-// When sets expectation for the StockServiceRepository.CountStockItems which will trigger the result defined by the following
+// When sets expectation for the StockServiceRepository.AdjustBundleComponents which will trigger the result defined by the following
 // Then helper
-func (mmCountStockItems *mStockServiceRepositoryMockCountStockItems) When(ctx context.Context, userID domain.UserID, location string) *StockServiceRepositoryMockCountStockItemsExpectation {
-	if mmCountStockItems.mock.funcCountStockItems != nil {
-		mmCountStockItems.mock.t.Fatalf("StockServiceRepositoryMock.CountStockItems mock is already set by Set")
+func (mmAdjustBundleComponents *mStockServiceRepositoryMockAdjustBundleComponents) When(ctx context.Context, adjustment domain.StockAdjustment, components []domain.BundleComponent) *StockServiceRepositoryMockAdjustBundleComponentsExpectation {
+	if mmAdjustBundleComponents.mock.funcAdjustBundleComponents != nil {
+		mmAdjustBundleComponents.mock.t.Fatalf("StockServiceRepositoryMock.AdjustBundleComponents mock is already set by Set")
 	}
 
-	expectation := &StockServiceRepositoryMockCountStockItemsExpectation{
-		mock:               mmCountStockItems.mock,
-		params:             &StockServiceRepositoryMockCountStockItemsParams{ctx, userID, location},
-		expectationOrigins: StockServiceRepositoryMockCountStockItemsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &StockServiceRepositoryMockAdjustBundleComponentsExpectation{
+		mock:               mmAdjustBundleComponents.mock,
+		params:             &StockServiceRepositoryMockAdjustBundleComponentsParams{ctx, adjustment, components},
+		expectationOrigins: StockServiceRepositoryMockAdjustBundleComponentsExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCountStockItems.expectations = append(mmCountStockItems.expectations, expectation)
+	mmAdjustBundleComponents.expectations = append(mmAdjustBundleComponents.expectations, expectation)
 	return expectation
 }
 
-// Then sets up StockServiceRepository.CountStockItems return parameters for the expectation previously defined by the When method
-func (e *StockServiceRepositoryMockCountStockItemsExpectation) Then(u1 uint16, err error) *StockServiceRepositoryMock {
-	e.results = &StockServiceRepositoryMockCountStockItemsResults{u1, err}
+// Then sets up StockServiceRepository.AdjustBundleComponents return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockAdjustBundleComponentsExpectation) Then(sa1 []domain.StockAdjustmentResult, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockAdjustBundleComponentsResults{sa1, err}
 	return e.mock
 }
 
-// Times sets number of times StockServiceRepository.CountStockItems should be invoked
-func (mmCountStockItems *mStockServiceRepositoryMockCountStockItems) Times(n uint64) *mStockServiceRepositoryMockCountStockItems {
+// Times sets number of times StockServiceRepository.AdjustBundleComponents should be invoked
+func (mmAdjustBundleComponents *mStockServiceRepositoryMockAdjustBundleComponents) Times(n uint64) *mStockServiceRepositoryMockAdjustBundleComponents {
 	if n == 0 {
-		mmCountStockItems.mock.t.Fatalf("Times of StockServiceRepositoryMock.CountStockItems mock can not be zero")
+		mmAdjustBundleComponents.mock.t.Fatalf("Times of StockServiceRepositoryMock.AdjustBundleComponents mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCountStockItems.expectedInvocations, n)
-	mmCountStockItems.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCountStockItems
+	mm_atomic.StoreUint64(&mmAdjustBundleComponents.expectedInvocations, n)
+	mmAdjustBundleComponents.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAdjustBundleComponents
 }
 
-func (mmCountStockItems *mStockServiceRepositoryMockCountStockItems) invocationsDone() bool {
-	if len(mmCountStockItems.expectations) == 0 && mmCountStockItems.defaultExpectation == nil && mmCountStockItems.mock.funcCountStockItems == nil {
+func (mmAdjustBundleComponents *mStockServiceRepositoryMockAdjustBundleComponents) invocationsDone() bool {
+	if len(mmAdjustBundleComponents.expectations) == 0 && mmAdjustBundleComponents.defaultExpectation == nil && mmAdjustBundleComponents.mock.funcAdjustBundleComponents == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCountStockItems.mock.afterCountStockItemsCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCountStockItems.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAdjustBundleComponents.mock.afterAdjustBundleComponentsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAdjustBundleComponents.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// CountStockItems implements mm_stocks.StockServiceRepository
-func (mmCountStockItems *StockServiceRepositoryMock) CountStockItems(ctx context.Context, userID domain.UserID, location string) (u1 uint16, err error) {
-	mm_atomic.AddUint64(&mmCountStockItems.beforeCountStockItemsCounter, 1)
-	defer mm_atomic.AddUint64(&mmCountStockItems.afterCountStockItemsCounter, 1)
+// AdjustBundleComponents implements mm_stocks.StockServiceRepository
+func (mmAdjustBundleComponents *StockServiceRepositoryMock) AdjustBundleComponents(ctx context.Context, adjustment domain.StockAdjustment, components []domain.BundleComponent) (sa1 []domain.StockAdjustmentResult, err error) {
+	mm_atomic.AddUint64(&mmAdjustBundleComponents.beforeAdjustBundleComponentsCounter, 1)
+	defer mm_atomic.AddUint64(&mmAdjustBundleComponents.afterAdjustBundleComponentsCounter, 1)
 
-	mmCountStockItems.t.Helper()
+	mmAdjustBundleComponents.t.Helper()
 
-	if mmCountStockItems.inspectFuncCountStockItems != nil {
-		mmCountStockItems.inspectFuncCountStockItems(ctx, userID, location)
+	if mmAdjustBundleComponents.inspectFuncAdjustBundleComponents != nil {
+		mmAdjustBundleComponents.inspectFuncAdjustBundleComponents(ctx, adjustment, components)
 	}
 
-	mm_params := StockServiceRepositoryMockCountStockItemsParams{ctx, userID, location}
+	mm_params := StockServiceRepositoryMockAdjustBundleComponentsParams{ctx, adjustment, components}
 
 	// Record call args
-	mmCountStockItems.CountStockItemsMock.mutex.Lock()
-	mmCountStockItems.CountStockItemsMock.callArgs = append(mmCountStockItems.CountStockItemsMock.callArgs, &mm_params)
-	mmCountStockItems.CountStockItemsMock.mutex.Unlock()
+	mmAdjustBundleComponents.AdjustBundleComponentsMock.mutex.Lock()
+	mmAdjustBundleComponents.AdjustBundleComponentsMock.callArgs = append(mmAdjustBundleComponents.AdjustBundleComponentsMock.callArgs, &mm_params)
+	mmAdjustBundleComponents.AdjustBundleComponentsMock.mutex.Unlock()
 
-	for _, e := range mmCountStockItems.CountStockItemsMock.expectations {
+	for _, e := range mmAdjustBundleComponents.AdjustBundleComponentsMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.u1, e.results.err
+			return e.results.sa1, e.results.err
 		}
 	}
 
-	if mmCountStockItems.CountStockItemsMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCountStockItems.CountStockItemsMock.defaultExpectation.Counter, 1)
-		mm_want := mmCountStockItems.CountStockItemsMock.defaultExpectation.params
-		mm_want_ptrs := mmCountStockItems.CountStockItemsMock.defaultExpectation.paramPtrs
+	if mmAdjustBundleComponents.AdjustBundleComponentsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAdjustBundleComponents.AdjustBundleComponentsMock.defaultExpectation.Counter, 1)
+		mm_want := mmAdjustBundleComponents.AdjustBundleComponentsMock.defaultExpectation.params
+		mm_want_ptrs := mmAdjustBundleComponents.AdjustBundleComponentsMock.defaultExpectation.paramPtrs
 
-		mm_got := StockServiceRepositoryMockCountStockItemsParams{ctx, userID, location}
+		mm_got := StockServiceRepositoryMockAdjustBundleComponentsParams{ctx, adjustment, components}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCountStockItems.t.Errorf("StockServiceRepositoryMock.CountStockItems got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCountStockItems.CountStockItemsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmAdjustBundleComponents.t.Errorf("StockServiceRepositoryMock.AdjustBundleComponents got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdjustBundleComponents.AdjustBundleComponentsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmCountStockItems.t.Errorf("StockServiceRepositoryMock.CountStockItems got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCountStockItems.CountStockItemsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			if mm_want_ptrs.adjustment != nil && !minimock.Equal(*mm_want_ptrs.adjustment, mm_got.adjustment) {
+				mmAdjustBundleComponents.t.Errorf("StockServiceRepositoryMock.AdjustBundleComponents got unexpected parameter adjustment, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdjustBundleComponents.AdjustBundleComponentsMock.defaultExpectation.expectationOrigins.originAdjustment, *mm_want_ptrs.adjustment, mm_got.adjustment, minimock.Diff(*mm_want_ptrs.adjustment, mm_got.adjustment))
 			}
 
-			if mm_want_ptrs.location != nil && !minimock.Equal(*mm_want_ptrs.location, mm_got.location) {
-				mmCountStockItems.t.Errorf("StockServiceRepositoryMock.CountStockItems got unexpected parameter location, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCountStockItems.CountStockItemsMock.defaultExpectation.expectationOrigins.originLocation, *mm_want_ptrs.location, mm_got.location, minimock.Diff(*mm_want_ptrs.location, mm_got.location))
+			if mm_want_ptrs.components != nil && !minimock.Equal(*mm_want_ptrs.components, mm_got.components) {
+				mmAdjustBundleComponents.t.Errorf("StockServiceRepositoryMock.AdjustBundleComponents got unexpected parameter components, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdjustBundleComponents.AdjustBundleComponentsMock.defaultExpectation.expectationOrigins.originComponents, *mm_want_ptrs.components, mm_got.components, minimock.Diff(*mm_want_ptrs.components, mm_got.components))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCountStockItems.t.Errorf("StockServiceRepositoryMock.CountStockItems got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCountStockItems.CountStockItemsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAdjustBundleComponents.t.Errorf("StockServiceRepositoryMock.AdjustBundleComponents got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAdjustBundleComponents.AdjustBundleComponentsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCountStockItems.CountStockItemsMock.defaultExpectation.results
+		mm_results := mmAdjustBundleComponents.AdjustBundleComponentsMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCountStockItems.t.Fatal("No results are set for the StockServiceRepositoryMock.CountStockItems")
+			mmAdjustBundleComponents.t.Fatal("No results are set for the StockServiceRepositoryMock.AdjustBundleComponents")
 		}
-		return (*mm_results).u1, (*mm_results).err
+		return (*mm_results).sa1, (*mm_results).err
 	}
-	if mmCountStockItems.funcCountStockItems != nil {
-		return mmCountStockItems.funcCountStockItems(ctx, userID, location)
+	if mmAdjustBundleComponents.funcAdjustBundleComponents != nil {
+		return mmAdjustBundleComponents.funcAdjustBundleComponents(ctx, adjustment, components)
 	}
-	mmCountStockItems.t.Fatalf("Unexpected call to StockServiceRepositoryMock.CountStockItems. %v %v %v", ctx, userID, location)
+	mmAdjustBundleComponents.t.Fatalf("Unexpected call to StockServiceRepositoryMock.AdjustBundleComponents. %v %v %v", ctx, adjustment, components)
 	return
 }
 
-// CountStockItemsAfterCounter returns a count of finished StockServiceRepositoryMock.CountStockItems invocations
-func (mmCountStockItems *StockServiceRepositoryMock) CountStockItemsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCountStockItems.afterCountStockItemsCounter)
+// AdjustBundleComponentsAfterCounter returns a count of finished StockServiceRepositoryMock.AdjustBundleComponents invocations
+func (mmAdjustBundleComponents *StockServiceRepositoryMock) AdjustBundleComponentsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAdjustBundleComponents.afterAdjustBundleComponentsCounter)
 }
 
-// CountStockItemsBeforeCounter returns a count of StockServiceRepositoryMock.CountStockItems invocations
-func (mmCountStockItems *StockServiceRepositoryMock) CountStockItemsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCountStockItems.beforeCountStockItemsCounter)
+// AdjustBundleComponentsBeforeCounter returns a count of StockServiceRepositoryMock.AdjustBundleComponents invocations
+func (mmAdjustBundleComponents *StockServiceRepositoryMock) AdjustBundleComponentsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAdjustBundleComponents.beforeAdjustBundleComponentsCounter)
 }
 
-// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.CountStockItems.
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.AdjustBundleComponents.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCountStockItems *mStockServiceRepositoryMockCountStockItems) Calls() []*StockServiceRepositoryMockCountStockItemsParams {
-	mmCountStockItems.mutex.RLock()
+func (mmAdjustBundleComponents *mStockServiceRepositoryMockAdjustBundleComponents) Calls() []*StockServiceRepositoryMockAdjustBundleComponentsParams {
+	mmAdjustBundleComponents.mutex.RLock()
 
-	argCopy := make([]*StockServiceRepositoryMockCountStockItemsParams, len(mmCountStockItems.callArgs))
-	copy(argCopy, mmCountStockItems.callArgs)
+	argCopy := make([]*StockServiceRepositoryMockAdjustBundleComponentsParams, len(mmAdjustBundleComponents.callArgs))
+	copy(argCopy, mmAdjustBundleComponents.callArgs)
 
-	mmCountStockItems.mutex.RUnlock()
+	mmAdjustBundleComponents.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCountStockItemsDone returns true if the count of the CountStockItems invocations corresponds
+// MinimockAdjustBundleComponentsDone returns true if the count of the AdjustBundleComponents invocations corresponds
 // the number of defined expectations
-func (m *StockServiceRepositoryMock) MinimockCountStockItemsDone() bool {
-	if m.CountStockItemsMock.optional {
+func (m *StockServiceRepositoryMock) MinimockAdjustBundleComponentsDone() bool {
+	if m.AdjustBundleComponentsMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CountStockItemsMock.expectations {
+	for _, e := range m.AdjustBundleComponentsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CountStockItemsMock.invocationsDone()
+	return m.AdjustBundleComponentsMock.invocationsDone()
 }
 
-// MinimockCountStockItemsInspect logs each unmet expectation
-func (m *StockServiceRepositoryMock) MinimockCountStockItemsInspect() {
-	for _, e := range m.CountStockItemsMock.expectations {
+// MinimockAdjustBundleComponentsInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockAdjustBundleComponentsInspect() {
+	for _, e := range m.AdjustBundleComponentsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StockServiceRepositoryMock.CountStockItems at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.AdjustBundleComponents at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCountStockItemsCounter := mm_atomic.LoadUint64(&m.afterCountStockItemsCounter)
+	afterAdjustBundleComponentsCounter := mm_atomic.LoadUint64(&m.afterAdjustBundleComponentsCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CountStockItemsMock.defaultExpectation != nil && afterCountStockItemsCounter < 1 {
-		if m.CountStockItemsMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to StockServiceRepositoryMock.CountStockItems at\n%s", m.CountStockItemsMock.defaultExpectation.returnOrigin)
+	if m.AdjustBundleComponentsMock.defaultExpectation != nil && afterAdjustBundleComponentsCounter < 1 {
+		if m.AdjustBundleComponentsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.AdjustBundleComponents at\n%s", m.AdjustBundleComponentsMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to StockServiceRepositoryMock.CountStockItems at\n%s with params: %#v", m.CountStockItemsMock.defaultExpectation.expectationOrigins.origin, *m.CountStockItemsMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.AdjustBundleComponents at\n%s with params: %#v", m.AdjustBundleComponentsMock.defaultExpectation.expectationOrigins.origin, *m.AdjustBundleComponentsMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCountStockItems != nil && afterCountStockItemsCounter < 1 {
-		m.t.Errorf("Expected call to StockServiceRepositoryMock.CountStockItems at\n%s", m.funcCountStockItemsOrigin)
+	if m.funcAdjustBundleComponents != nil && afterAdjustBundleComponentsCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.AdjustBundleComponents at\n%s", m.funcAdjustBundleComponentsOrigin)
 	}
 
-	if !m.CountStockItemsMock.invocationsDone() && afterCountStockItemsCounter > 0 {
-		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.CountStockItems at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CountStockItemsMock.expectedInvocations), m.CountStockItemsMock.expectedInvocationsOrigin, afterCountStockItemsCounter)
+	if !m.AdjustBundleComponentsMock.invocationsDone() && afterAdjustBundleComponentsCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.AdjustBundleComponents at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AdjustBundleComponentsMock.expectedInvocations), m.AdjustBundleComponentsMock.expectedInvocationsOrigin, afterAdjustBundleComponentsCounter)
 	}
 }
 
-type mStockServiceRepositoryMockDeleteStockItemFromStorage struct {
+type mStockServiceRepositoryMockAdjustStockCount struct {
 	optional           bool
 	mock               *StockServiceRepositoryMock
-	defaultExpectation *StockServiceRepositoryMockDeleteStockItemFromStorageExpectation
-	expectations       []*StockServiceRepositoryMockDeleteStockItemFromStorageExpectation
+	defaultExpectation *StockServiceRepositoryMockAdjustStockCountExpectation
+	expectations       []*StockServiceRepositoryMockAdjustStockCountExpectation
 
-	callArgs []*StockServiceRepositoryMockDeleteStockItemFromStorageParams
+	callArgs []*StockServiceRepositoryMockAdjustStockCountParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// StockServiceRepositoryMockDeleteStockItemFromStorageExpectation specifies expectation struct of the StockServiceRepository.DeleteStockItemFromStorage
-type StockServiceRepositoryMockDeleteStockItemFromStorageExpectation struct {
+// StockServiceRepositoryMockAdjustStockCountExpectation specifies expectation struct of the StockServiceRepository.AdjustStockCount
+type StockServiceRepositoryMockAdjustStockCountExpectation struct {
 	mock               *StockServiceRepositoryMock
-	params             *StockServiceRepositoryMockDeleteStockItemFromStorageParams
-	paramPtrs          *StockServiceRepositoryMockDeleteStockItemFromStorageParamPtrs
-	expectationOrigins StockServiceRepositoryMockDeleteStockItemFromStorageExpectationOrigins
-	results            *StockServiceRepositoryMockDeleteStockItemFromStorageResults
+	params             *StockServiceRepositoryMockAdjustStockCountParams
+	paramPtrs          *StockServiceRepositoryMockAdjustStockCountParamPtrs
+	expectationOrigins StockServiceRepositoryMockAdjustStockCountExpectationOrigins
+	results            *StockServiceRepositoryMockAdjustStockCountResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// StockServiceRepositoryMockDeleteStockItemFromStorageParams contains parameters of the StockServiceRepository.DeleteStockItemFromStorage
-type StockServiceRepositoryMockDeleteStockItemFromStorageParams struct {
-	ctx    context.Context
-	userID domain.UserID
-	skuID  domain.SKUID
+// StockServiceRepositoryMockAdjustStockCountParams contains parameters of the StockServiceRepository.AdjustStockCount
+type StockServiceRepositoryMockAdjustStockCountParams struct {
+	ctx        context.Context
+	adjustment domain.StockAdjustment
 }
 
-// StockServiceRepositoryMockDeleteStockItemFromStorageParamPtrs contains pointers to parameters of the StockServiceRepository.DeleteStockItemFromStorage
-type StockServiceRepositoryMockDeleteStockItemFromStorageParamPtrs struct {
-	ctx    *context.Context
-	userID *domain.UserID
-	skuID  *domain.SKUID
+// StockServiceRepositoryMockAdjustStockCountParamPtrs contains pointers to parameters of the StockServiceRepository.AdjustStockCount
+type StockServiceRepositoryMockAdjustStockCountParamPtrs struct {
+	ctx        *context.Context
+	adjustment *domain.StockAdjustment
 }
 
-// StockServiceRepositoryMockDeleteStockItemFromStorageResults contains results of the StockServiceRepository.DeleteStockItemFromStorage
-type StockServiceRepositoryMockDeleteStockItemFromStorageResults struct {
+// StockServiceRepositoryMockAdjustStockCountResults contains results of the StockServiceRepository.AdjustStockCount
+type StockServiceRepositoryMockAdjustStockCountResults struct {
+	s1  domain.StockAdjustmentResult
 	err error
 }
 
-// StockServiceRepositoryMockDeleteStockItemFromStorageOrigins contains origins of expectations of the StockServiceRepository.DeleteStockItemFromStorage
-type StockServiceRepositoryMockDeleteStockItemFromStorageExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originUserID string
-	originSkuID  string
+// StockServiceRepositoryMockAdjustStockCountOrigins contains origins of expectations of the StockServiceRepository.AdjustStockCount
+type StockServiceRepositoryMockAdjustStockCountExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originAdjustment string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -531,373 +780,9225 @@ type StockServiceRepositoryMockDeleteStockItemFromStorageExpectationOrigins stru
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteStockItemFromStorage *mStockServiceRepositoryMockDeleteStockItemFromStorage) Optional() *mStockServiceRepositoryMockDeleteStockItemFromStorage {
-	mmDeleteStockItemFromStorage.optional = true
-	return mmDeleteStockItemFromStorage
+func (mmAdjustStockCount *mStockServiceRepositoryMockAdjustStockCount) Optional() *mStockServiceRepositoryMockAdjustStockCount {
+	mmAdjustStockCount.optional = true
+	return mmAdjustStockCount
 }
 
-// Expect sets up expected params for StockServiceRepository.DeleteStockItemFromStorage
-func (mmDeleteStockItemFromStorage *mStockServiceRepositoryMockDeleteStockItemFromStorage) Expect(ctx context.Context, userID domain.UserID, skuID domain.SKUID) *mStockServiceRepositoryMockDeleteStockItemFromStorage {
-	if mmDeleteStockItemFromStorage.mock.funcDeleteStockItemFromStorage != nil {
-		mmDeleteStockItemFromStorage.mock.t.Fatalf("StockServiceRepositoryMock.DeleteStockItemFromStorage mock is already set by Set")
+// Expect sets up expected params for StockServiceRepository.AdjustStockCount
+func (mmAdjustStockCount *mStockServiceRepositoryMockAdjustStockCount) Expect(ctx context.Context, adjustment domain.StockAdjustment) *mStockServiceRepositoryMockAdjustStockCount {
+	if mmAdjustStockCount.mock.funcAdjustStockCount != nil {
+		mmAdjustStockCount.mock.t.Fatalf("StockServiceRepositoryMock.AdjustStockCount mock is already set by Set")
 	}
 
-	if mmDeleteStockItemFromStorage.defaultExpectation == nil {
-		mmDeleteStockItemFromStorage.defaultExpectation = &StockServiceRepositoryMockDeleteStockItemFromStorageExpectation{}
+	if mmAdjustStockCount.defaultExpectation == nil {
+		mmAdjustStockCount.defaultExpectation = &StockServiceRepositoryMockAdjustStockCountExpectation{}
 	}
 
-	if mmDeleteStockItemFromStorage.defaultExpectation.paramPtrs != nil {
-		mmDeleteStockItemFromStorage.mock.t.Fatalf("StockServiceRepositoryMock.DeleteStockItemFromStorage mock is already set by ExpectParams functions")
+	if mmAdjustStockCount.defaultExpectation.paramPtrs != nil {
+		mmAdjustStockCount.mock.t.Fatalf("StockServiceRepositoryMock.AdjustStockCount mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteStockItemFromStorage.defaultExpectation.params = &StockServiceRepositoryMockDeleteStockItemFromStorageParams{ctx, userID, skuID}
-	mmDeleteStockItemFromStorage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteStockItemFromStorage.expectations {
-		if minimock.Equal(e.params, mmDeleteStockItemFromStorage.defaultExpectation.params) {
-			mmDeleteStockItemFromStorage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteStockItemFromStorage.defaultExpectation.params)
+	mmAdjustStockCount.defaultExpectation.params = &StockServiceRepositoryMockAdjustStockCountParams{ctx, adjustment}
+	mmAdjustStockCount.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAdjustStockCount.expectations {
+		if minimock.Equal(e.params, mmAdjustStockCount.defaultExpectation.params) {
+			mmAdjustStockCount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAdjustStockCount.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteStockItemFromStorage
+	return mmAdjustStockCount
 }
 
-// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.DeleteStockItemFromStorage
-func (mmDeleteStockItemFromStorage *mStockServiceRepositoryMockDeleteStockItemFromStorage) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockDeleteStockItemFromStorage {
-	if mmDeleteStockItemFromStorage.mock.funcDeleteStockItemFromStorage != nil {
-		mmDeleteStockItemFromStorage.mock.t.Fatalf("StockServiceRepositoryMock.DeleteStockItemFromStorage mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.AdjustStockCount
+func (mmAdjustStockCount *mStockServiceRepositoryMockAdjustStockCount) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockAdjustStockCount {
+	if mmAdjustStockCount.mock.funcAdjustStockCount != nil {
+		mmAdjustStockCount.mock.t.Fatalf("StockServiceRepositoryMock.AdjustStockCount mock is already set by Set")
 	}
 
-	if mmDeleteStockItemFromStorage.defaultExpectation == nil {
-		mmDeleteStockItemFromStorage.defaultExpectation = &StockServiceRepositoryMockDeleteStockItemFromStorageExpectation{}
+	if mmAdjustStockCount.defaultExpectation == nil {
+		mmAdjustStockCount.defaultExpectation = &StockServiceRepositoryMockAdjustStockCountExpectation{}
 	}
 
-	if mmDeleteStockItemFromStorage.defaultExpectation.params != nil {
-		mmDeleteStockItemFromStorage.mock.t.Fatalf("StockServiceRepositoryMock.DeleteStockItemFromStorage mock is already set by Expect")
+	if mmAdjustStockCount.defaultExpectation.params != nil {
+		mmAdjustStockCount.mock.t.Fatalf("StockServiceRepositoryMock.AdjustStockCount mock is already set by Expect")
 	}
 
-	if mmDeleteStockItemFromStorage.defaultExpectation.paramPtrs == nil {
-		mmDeleteStockItemFromStorage.defaultExpectation.paramPtrs = &StockServiceRepositoryMockDeleteStockItemFromStorageParamPtrs{}
+	if mmAdjustStockCount.defaultExpectation.paramPtrs == nil {
+		mmAdjustStockCount.defaultExpectation.paramPtrs = &StockServiceRepositoryMockAdjustStockCountParamPtrs{}
 	}
-	mmDeleteStockItemFromStorage.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteStockItemFromStorage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAdjustStockCount.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAdjustStockCount.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteStockItemFromStorage
+	return mmAdjustStockCount
 }
 
-// ExpectUserIDParam2 sets up expected param userID for StockServiceRepository.DeleteStockItemFromStorage
-func (mmDeleteStockItemFromStorage *mStockServiceRepositoryMockDeleteStockItemFromStorage) ExpectUserIDParam2(userID domain.UserID) *mStockServiceRepositoryMockDeleteStockItemFromStorage {
+// ExpectAdjustmentParam2 sets up expected param adjustment for StockServiceRepository.AdjustStockCount
+func (mmAdjustStockCount *mStockServiceRepositoryMockAdjustStockCount) ExpectAdjustmentParam2(adjustment domain.StockAdjustment) *mStockServiceRepositoryMockAdjustStockCount {
+	if mmAdjustStockCount.mock.funcAdjustStockCount != nil {
+		mmAdjustStockCount.mock.t.Fatalf("StockServiceRepositoryMock.AdjustStockCount mock is already set by Set")
+	}
+
+	if mmAdjustStockCount.defaultExpectation == nil {
+		mmAdjustStockCount.defaultExpectation = &StockServiceRepositoryMockAdjustStockCountExpectation{}
+	}
+
+	if mmAdjustStockCount.defaultExpectation.params != nil {
+		mmAdjustStockCount.mock.t.Fatalf("StockServiceRepositoryMock.AdjustStockCount mock is already set by Expect")
+	}
+
+	if mmAdjustStockCount.defaultExpectation.paramPtrs == nil {
+		mmAdjustStockCount.defaultExpectation.paramPtrs = &StockServiceRepositoryMockAdjustStockCountParamPtrs{}
+	}
+	mmAdjustStockCount.defaultExpectation.paramPtrs.adjustment = &adjustment
+	mmAdjustStockCount.defaultExpectation.expectationOrigins.originAdjustment = minimock.CallerInfo(1)
+
+	return mmAdjustStockCount
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.AdjustStockCount
+func (mmAdjustStockCount *mStockServiceRepositoryMockAdjustStockCount) Inspect(f func(ctx context.Context, adjustment domain.StockAdjustment)) *mStockServiceRepositoryMockAdjustStockCount {
+	if mmAdjustStockCount.mock.inspectFuncAdjustStockCount != nil {
+		mmAdjustStockCount.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.AdjustStockCount")
+	}
+
+	mmAdjustStockCount.mock.inspectFuncAdjustStockCount = f
+
+	return mmAdjustStockCount
+}
+
+// Return sets up results that will be returned by StockServiceRepository.AdjustStockCount
+func (mmAdjustStockCount *mStockServiceRepositoryMockAdjustStockCount) Return(s1 domain.StockAdjustmentResult, err error) *StockServiceRepositoryMock {
+	if mmAdjustStockCount.mock.funcAdjustStockCount != nil {
+		mmAdjustStockCount.mock.t.Fatalf("StockServiceRepositoryMock.AdjustStockCount mock is already set by Set")
+	}
+
+	if mmAdjustStockCount.defaultExpectation == nil {
+		mmAdjustStockCount.defaultExpectation = &StockServiceRepositoryMockAdjustStockCountExpectation{mock: mmAdjustStockCount.mock}
+	}
+	mmAdjustStockCount.defaultExpectation.results = &StockServiceRepositoryMockAdjustStockCountResults{s1, err}
+	mmAdjustStockCount.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAdjustStockCount.mock
+}
+
+// Set uses given function f to mock the StockServiceRepository.AdjustStockCount method
+func (mmAdjustStockCount *mStockServiceRepositoryMockAdjustStockCount) Set(f func(ctx context.Context, adjustment domain.StockAdjustment) (s1 domain.StockAdjustmentResult, err error)) *StockServiceRepositoryMock {
+	if mmAdjustStockCount.defaultExpectation != nil {
+		mmAdjustStockCount.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.AdjustStockCount method")
+	}
+
+	if len(mmAdjustStockCount.expectations) > 0 {
+		mmAdjustStockCount.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.AdjustStockCount method")
+	}
+
+	mmAdjustStockCount.mock.funcAdjustStockCount = f
+	mmAdjustStockCount.mock.funcAdjustStockCountOrigin = minimock.CallerInfo(1)
+	return mmAdjustStockCount.mock
+}
+
+// When sets expectation for the StockServiceRepository.AdjustStockCount which will trigger the result defined by the following
+// Then helper
+func (mmAdjustStockCount *mStockServiceRepositoryMockAdjustStockCount) When(ctx context.Context, adjustment domain.StockAdjustment) *StockServiceRepositoryMockAdjustStockCountExpectation {
+	if mmAdjustStockCount.mock.funcAdjustStockCount != nil {
+		mmAdjustStockCount.mock.t.Fatalf("StockServiceRepositoryMock.AdjustStockCount mock is already set by Set")
+	}
+
+	expectation := &StockServiceRepositoryMockAdjustStockCountExpectation{
+		mock:               mmAdjustStockCount.mock,
+		params:             &StockServiceRepositoryMockAdjustStockCountParams{ctx, adjustment},
+		expectationOrigins: StockServiceRepositoryMockAdjustStockCountExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAdjustStockCount.expectations = append(mmAdjustStockCount.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceRepository.AdjustStockCount return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockAdjustStockCountExpectation) Then(s1 domain.StockAdjustmentResult, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockAdjustStockCountResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceRepository.AdjustStockCount should be invoked
+func (mmAdjustStockCount *mStockServiceRepositoryMockAdjustStockCount) Times(n uint64) *mStockServiceRepositoryMockAdjustStockCount {
+	if n == 0 {
+		mmAdjustStockCount.mock.t.Fatalf("Times of StockServiceRepositoryMock.AdjustStockCount mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAdjustStockCount.expectedInvocations, n)
+	mmAdjustStockCount.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAdjustStockCount
+}
+
+func (mmAdjustStockCount *mStockServiceRepositoryMockAdjustStockCount) invocationsDone() bool {
+	if len(mmAdjustStockCount.expectations) == 0 && mmAdjustStockCount.defaultExpectation == nil && mmAdjustStockCount.mock.funcAdjustStockCount == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAdjustStockCount.mock.afterAdjustStockCountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAdjustStockCount.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AdjustStockCount implements mm_stocks.StockServiceRepository
+func (mmAdjustStockCount *StockServiceRepositoryMock) AdjustStockCount(ctx context.Context, adjustment domain.StockAdjustment) (s1 domain.StockAdjustmentResult, err error) {
+	mm_atomic.AddUint64(&mmAdjustStockCount.beforeAdjustStockCountCounter, 1)
+	defer mm_atomic.AddUint64(&mmAdjustStockCount.afterAdjustStockCountCounter, 1)
+
+	mmAdjustStockCount.t.Helper()
+
+	if mmAdjustStockCount.inspectFuncAdjustStockCount != nil {
+		mmAdjustStockCount.inspectFuncAdjustStockCount(ctx, adjustment)
+	}
+
+	mm_params := StockServiceRepositoryMockAdjustStockCountParams{ctx, adjustment}
+
+	// Record call args
+	mmAdjustStockCount.AdjustStockCountMock.mutex.Lock()
+	mmAdjustStockCount.AdjustStockCountMock.callArgs = append(mmAdjustStockCount.AdjustStockCountMock.callArgs, &mm_params)
+	mmAdjustStockCount.AdjustStockCountMock.mutex.Unlock()
+
+	for _, e := range mmAdjustStockCount.AdjustStockCountMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmAdjustStockCount.AdjustStockCountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAdjustStockCount.AdjustStockCountMock.defaultExpectation.Counter, 1)
+		mm_want := mmAdjustStockCount.AdjustStockCountMock.defaultExpectation.params
+		mm_want_ptrs := mmAdjustStockCount.AdjustStockCountMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceRepositoryMockAdjustStockCountParams{ctx, adjustment}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAdjustStockCount.t.Errorf("StockServiceRepositoryMock.AdjustStockCount got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdjustStockCount.AdjustStockCountMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.adjustment != nil && !minimock.Equal(*mm_want_ptrs.adjustment, mm_got.adjustment) {
+				mmAdjustStockCount.t.Errorf("StockServiceRepositoryMock.AdjustStockCount got unexpected parameter adjustment, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAdjustStockCount.AdjustStockCountMock.defaultExpectation.expectationOrigins.originAdjustment, *mm_want_ptrs.adjustment, mm_got.adjustment, minimock.Diff(*mm_want_ptrs.adjustment, mm_got.adjustment))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAdjustStockCount.t.Errorf("StockServiceRepositoryMock.AdjustStockCount got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAdjustStockCount.AdjustStockCountMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAdjustStockCount.AdjustStockCountMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAdjustStockCount.t.Fatal("No results are set for the StockServiceRepositoryMock.AdjustStockCount")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmAdjustStockCount.funcAdjustStockCount != nil {
+		return mmAdjustStockCount.funcAdjustStockCount(ctx, adjustment)
+	}
+	mmAdjustStockCount.t.Fatalf("Unexpected call to StockServiceRepositoryMock.AdjustStockCount. %v %v", ctx, adjustment)
+	return
+}
+
+// AdjustStockCountAfterCounter returns a count of finished StockServiceRepositoryMock.AdjustStockCount invocations
+func (mmAdjustStockCount *StockServiceRepositoryMock) AdjustStockCountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAdjustStockCount.afterAdjustStockCountCounter)
+}
+
+// AdjustStockCountBeforeCounter returns a count of StockServiceRepositoryMock.AdjustStockCount invocations
+func (mmAdjustStockCount *StockServiceRepositoryMock) AdjustStockCountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAdjustStockCount.beforeAdjustStockCountCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.AdjustStockCount.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAdjustStockCount *mStockServiceRepositoryMockAdjustStockCount) Calls() []*StockServiceRepositoryMockAdjustStockCountParams {
+	mmAdjustStockCount.mutex.RLock()
+
+	argCopy := make([]*StockServiceRepositoryMockAdjustStockCountParams, len(mmAdjustStockCount.callArgs))
+	copy(argCopy, mmAdjustStockCount.callArgs)
+
+	mmAdjustStockCount.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAdjustStockCountDone returns true if the count of the AdjustStockCount invocations corresponds
+// the number of defined expectations
+func (m *StockServiceRepositoryMock) MinimockAdjustStockCountDone() bool {
+	if m.AdjustStockCountMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AdjustStockCountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AdjustStockCountMock.invocationsDone()
+}
+
+// MinimockAdjustStockCountInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockAdjustStockCountInspect() {
+	for _, e := range m.AdjustStockCountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.AdjustStockCount at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAdjustStockCountCounter := mm_atomic.LoadUint64(&m.afterAdjustStockCountCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AdjustStockCountMock.defaultExpectation != nil && afterAdjustStockCountCounter < 1 {
+		if m.AdjustStockCountMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.AdjustStockCount at\n%s", m.AdjustStockCountMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.AdjustStockCount at\n%s with params: %#v", m.AdjustStockCountMock.defaultExpectation.expectationOrigins.origin, *m.AdjustStockCountMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAdjustStockCount != nil && afterAdjustStockCountCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.AdjustStockCount at\n%s", m.funcAdjustStockCountOrigin)
+	}
+
+	if !m.AdjustStockCountMock.invocationsDone() && afterAdjustStockCountCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.AdjustStockCount at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AdjustStockCountMock.expectedInvocations), m.AdjustStockCountMock.expectedInvocationsOrigin, afterAdjustStockCountCounter)
+	}
+}
+
+type mStockServiceRepositoryMockApplyCycleCountVariances struct {
+	optional           bool
+	mock               *StockServiceRepositoryMock
+	defaultExpectation *StockServiceRepositoryMockApplyCycleCountVariancesExpectation
+	expectations       []*StockServiceRepositoryMockApplyCycleCountVariancesExpectation
+
+	callArgs []*StockServiceRepositoryMockApplyCycleCountVariancesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceRepositoryMockApplyCycleCountVariancesExpectation specifies expectation struct of the StockServiceRepository.ApplyCycleCountVariances
+type StockServiceRepositoryMockApplyCycleCountVariancesExpectation struct {
+	mock               *StockServiceRepositoryMock
+	params             *StockServiceRepositoryMockApplyCycleCountVariancesParams
+	paramPtrs          *StockServiceRepositoryMockApplyCycleCountVariancesParamPtrs
+	expectationOrigins StockServiceRepositoryMockApplyCycleCountVariancesExpectationOrigins
+	results            *StockServiceRepositoryMockApplyCycleCountVariancesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceRepositoryMockApplyCycleCountVariancesParams contains parameters of the StockServiceRepository.ApplyCycleCountVariances
+type StockServiceRepositoryMockApplyCycleCountVariancesParams struct {
+	ctx          context.Context
+	cycleCountID domain.CycleCountID
+	approvedBy   domain.UserID
+}
+
+// StockServiceRepositoryMockApplyCycleCountVariancesParamPtrs contains pointers to parameters of the StockServiceRepository.ApplyCycleCountVariances
+type StockServiceRepositoryMockApplyCycleCountVariancesParamPtrs struct {
+	ctx          *context.Context
+	cycleCountID *domain.CycleCountID
+	approvedBy   *domain.UserID
+}
+
+// StockServiceRepositoryMockApplyCycleCountVariancesResults contains results of the StockServiceRepository.ApplyCycleCountVariances
+type StockServiceRepositoryMockApplyCycleCountVariancesResults struct {
+	c2  domain.CycleCountApproval
+	err error
+}
+
+// StockServiceRepositoryMockApplyCycleCountVariancesOrigins contains origins of expectations of the StockServiceRepository.ApplyCycleCountVariances
+type StockServiceRepositoryMockApplyCycleCountVariancesExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originCycleCountID string
+	originApprovedBy   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmApplyCycleCountVariances *mStockServiceRepositoryMockApplyCycleCountVariances) Optional() *mStockServiceRepositoryMockApplyCycleCountVariances {
+	mmApplyCycleCountVariances.optional = true
+	return mmApplyCycleCountVariances
+}
+
+// Expect sets up expected params for StockServiceRepository.ApplyCycleCountVariances
+func (mmApplyCycleCountVariances *mStockServiceRepositoryMockApplyCycleCountVariances) Expect(ctx context.Context, cycleCountID domain.CycleCountID, approvedBy domain.UserID) *mStockServiceRepositoryMockApplyCycleCountVariances {
+	if mmApplyCycleCountVariances.mock.funcApplyCycleCountVariances != nil {
+		mmApplyCycleCountVariances.mock.t.Fatalf("StockServiceRepositoryMock.ApplyCycleCountVariances mock is already set by Set")
+	}
+
+	if mmApplyCycleCountVariances.defaultExpectation == nil {
+		mmApplyCycleCountVariances.defaultExpectation = &StockServiceRepositoryMockApplyCycleCountVariancesExpectation{}
+	}
+
+	if mmApplyCycleCountVariances.defaultExpectation.paramPtrs != nil {
+		mmApplyCycleCountVariances.mock.t.Fatalf("StockServiceRepositoryMock.ApplyCycleCountVariances mock is already set by ExpectParams functions")
+	}
+
+	mmApplyCycleCountVariances.defaultExpectation.params = &StockServiceRepositoryMockApplyCycleCountVariancesParams{ctx, cycleCountID, approvedBy}
+	mmApplyCycleCountVariances.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmApplyCycleCountVariances.expectations {
+		if minimock.Equal(e.params, mmApplyCycleCountVariances.defaultExpectation.params) {
+			mmApplyCycleCountVariances.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmApplyCycleCountVariances.defaultExpectation.params)
+		}
+	}
+
+	return mmApplyCycleCountVariances
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.ApplyCycleCountVariances
+func (mmApplyCycleCountVariances *mStockServiceRepositoryMockApplyCycleCountVariances) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockApplyCycleCountVariances {
+	if mmApplyCycleCountVariances.mock.funcApplyCycleCountVariances != nil {
+		mmApplyCycleCountVariances.mock.t.Fatalf("StockServiceRepositoryMock.ApplyCycleCountVariances mock is already set by Set")
+	}
+
+	if mmApplyCycleCountVariances.defaultExpectation == nil {
+		mmApplyCycleCountVariances.defaultExpectation = &StockServiceRepositoryMockApplyCycleCountVariancesExpectation{}
+	}
+
+	if mmApplyCycleCountVariances.defaultExpectation.params != nil {
+		mmApplyCycleCountVariances.mock.t.Fatalf("StockServiceRepositoryMock.ApplyCycleCountVariances mock is already set by Expect")
+	}
+
+	if mmApplyCycleCountVariances.defaultExpectation.paramPtrs == nil {
+		mmApplyCycleCountVariances.defaultExpectation.paramPtrs = &StockServiceRepositoryMockApplyCycleCountVariancesParamPtrs{}
+	}
+	mmApplyCycleCountVariances.defaultExpectation.paramPtrs.ctx = &ctx
+	mmApplyCycleCountVariances.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmApplyCycleCountVariances
+}
+
+// ExpectCycleCountIDParam2 sets up expected param cycleCountID for StockServiceRepository.ApplyCycleCountVariances
+func (mmApplyCycleCountVariances *mStockServiceRepositoryMockApplyCycleCountVariances) ExpectCycleCountIDParam2(cycleCountID domain.CycleCountID) *mStockServiceRepositoryMockApplyCycleCountVariances {
+	if mmApplyCycleCountVariances.mock.funcApplyCycleCountVariances != nil {
+		mmApplyCycleCountVariances.mock.t.Fatalf("StockServiceRepositoryMock.ApplyCycleCountVariances mock is already set by Set")
+	}
+
+	if mmApplyCycleCountVariances.defaultExpectation == nil {
+		mmApplyCycleCountVariances.defaultExpectation = &StockServiceRepositoryMockApplyCycleCountVariancesExpectation{}
+	}
+
+	if mmApplyCycleCountVariances.defaultExpectation.params != nil {
+		mmApplyCycleCountVariances.mock.t.Fatalf("StockServiceRepositoryMock.ApplyCycleCountVariances mock is already set by Expect")
+	}
+
+	if mmApplyCycleCountVariances.defaultExpectation.paramPtrs == nil {
+		mmApplyCycleCountVariances.defaultExpectation.paramPtrs = &StockServiceRepositoryMockApplyCycleCountVariancesParamPtrs{}
+	}
+	mmApplyCycleCountVariances.defaultExpectation.paramPtrs.cycleCountID = &cycleCountID
+	mmApplyCycleCountVariances.defaultExpectation.expectationOrigins.originCycleCountID = minimock.CallerInfo(1)
+
+	return mmApplyCycleCountVariances
+}
+
+// ExpectApprovedByParam3 sets up expected param approvedBy for StockServiceRepository.ApplyCycleCountVariances
+func (mmApplyCycleCountVariances *mStockServiceRepositoryMockApplyCycleCountVariances) ExpectApprovedByParam3(approvedBy domain.UserID) *mStockServiceRepositoryMockApplyCycleCountVariances {
+	if mmApplyCycleCountVariances.mock.funcApplyCycleCountVariances != nil {
+		mmApplyCycleCountVariances.mock.t.Fatalf("StockServiceRepositoryMock.ApplyCycleCountVariances mock is already set by Set")
+	}
+
+	if mmApplyCycleCountVariances.defaultExpectation == nil {
+		mmApplyCycleCountVariances.defaultExpectation = &StockServiceRepositoryMockApplyCycleCountVariancesExpectation{}
+	}
+
+	if mmApplyCycleCountVariances.defaultExpectation.params != nil {
+		mmApplyCycleCountVariances.mock.t.Fatalf("StockServiceRepositoryMock.ApplyCycleCountVariances mock is already set by Expect")
+	}
+
+	if mmApplyCycleCountVariances.defaultExpectation.paramPtrs == nil {
+		mmApplyCycleCountVariances.defaultExpectation.paramPtrs = &StockServiceRepositoryMockApplyCycleCountVariancesParamPtrs{}
+	}
+	mmApplyCycleCountVariances.defaultExpectation.paramPtrs.approvedBy = &approvedBy
+	mmApplyCycleCountVariances.defaultExpectation.expectationOrigins.originApprovedBy = minimock.CallerInfo(1)
+
+	return mmApplyCycleCountVariances
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.ApplyCycleCountVariances
+func (mmApplyCycleCountVariances *mStockServiceRepositoryMockApplyCycleCountVariances) Inspect(f func(ctx context.Context, cycleCountID domain.CycleCountID, approvedBy domain.UserID)) *mStockServiceRepositoryMockApplyCycleCountVariances {
+	if mmApplyCycleCountVariances.mock.inspectFuncApplyCycleCountVariances != nil {
+		mmApplyCycleCountVariances.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.ApplyCycleCountVariances")
+	}
+
+	mmApplyCycleCountVariances.mock.inspectFuncApplyCycleCountVariances = f
+
+	return mmApplyCycleCountVariances
+}
+
+// Return sets up results that will be returned by StockServiceRepository.ApplyCycleCountVariances
+func (mmApplyCycleCountVariances *mStockServiceRepositoryMockApplyCycleCountVariances) Return(c2 domain.CycleCountApproval, err error) *StockServiceRepositoryMock {
+	if mmApplyCycleCountVariances.mock.funcApplyCycleCountVariances != nil {
+		mmApplyCycleCountVariances.mock.t.Fatalf("StockServiceRepositoryMock.ApplyCycleCountVariances mock is already set by Set")
+	}
+
+	if mmApplyCycleCountVariances.defaultExpectation == nil {
+		mmApplyCycleCountVariances.defaultExpectation = &StockServiceRepositoryMockApplyCycleCountVariancesExpectation{mock: mmApplyCycleCountVariances.mock}
+	}
+	mmApplyCycleCountVariances.defaultExpectation.results = &StockServiceRepositoryMockApplyCycleCountVariancesResults{c2, err}
+	mmApplyCycleCountVariances.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmApplyCycleCountVariances.mock
+}
+
+// Set uses given function f to mock the StockServiceRepository.ApplyCycleCountVariances method
+func (mmApplyCycleCountVariances *mStockServiceRepositoryMockApplyCycleCountVariances) Set(f func(ctx context.Context, cycleCountID domain.CycleCountID, approvedBy domain.UserID) (c2 domain.CycleCountApproval, err error)) *StockServiceRepositoryMock {
+	if mmApplyCycleCountVariances.defaultExpectation != nil {
+		mmApplyCycleCountVariances.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.ApplyCycleCountVariances method")
+	}
+
+	if len(mmApplyCycleCountVariances.expectations) > 0 {
+		mmApplyCycleCountVariances.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.ApplyCycleCountVariances method")
+	}
+
+	mmApplyCycleCountVariances.mock.funcApplyCycleCountVariances = f
+	mmApplyCycleCountVariances.mock.funcApplyCycleCountVariancesOrigin = minimock.CallerInfo(1)
+	return mmApplyCycleCountVariances.mock
+}
+
+// When sets expectation for the StockServiceRepository.ApplyCycleCountVariances which will trigger the result defined by the following
+// Then helper
+func (mmApplyCycleCountVariances *mStockServiceRepositoryMockApplyCycleCountVariances) When(ctx context.Context, cycleCountID domain.CycleCountID, approvedBy domain.UserID) *StockServiceRepositoryMockApplyCycleCountVariancesExpectation {
+	if mmApplyCycleCountVariances.mock.funcApplyCycleCountVariances != nil {
+		mmApplyCycleCountVariances.mock.t.Fatalf("StockServiceRepositoryMock.ApplyCycleCountVariances mock is already set by Set")
+	}
+
+	expectation := &StockServiceRepositoryMockApplyCycleCountVariancesExpectation{
+		mock:               mmApplyCycleCountVariances.mock,
+		params:             &StockServiceRepositoryMockApplyCycleCountVariancesParams{ctx, cycleCountID, approvedBy},
+		expectationOrigins: StockServiceRepositoryMockApplyCycleCountVariancesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmApplyCycleCountVariances.expectations = append(mmApplyCycleCountVariances.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceRepository.ApplyCycleCountVariances return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockApplyCycleCountVariancesExpectation) Then(c2 domain.CycleCountApproval, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockApplyCycleCountVariancesResults{c2, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceRepository.ApplyCycleCountVariances should be invoked
+func (mmApplyCycleCountVariances *mStockServiceRepositoryMockApplyCycleCountVariances) Times(n uint64) *mStockServiceRepositoryMockApplyCycleCountVariances {
+	if n == 0 {
+		mmApplyCycleCountVariances.mock.t.Fatalf("Times of StockServiceRepositoryMock.ApplyCycleCountVariances mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmApplyCycleCountVariances.expectedInvocations, n)
+	mmApplyCycleCountVariances.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmApplyCycleCountVariances
+}
+
+func (mmApplyCycleCountVariances *mStockServiceRepositoryMockApplyCycleCountVariances) invocationsDone() bool {
+	if len(mmApplyCycleCountVariances.expectations) == 0 && mmApplyCycleCountVariances.defaultExpectation == nil && mmApplyCycleCountVariances.mock.funcApplyCycleCountVariances == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmApplyCycleCountVariances.mock.afterApplyCycleCountVariancesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmApplyCycleCountVariances.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ApplyCycleCountVariances implements mm_stocks.StockServiceRepository
+func (mmApplyCycleCountVariances *StockServiceRepositoryMock) ApplyCycleCountVariances(ctx context.Context, cycleCountID domain.CycleCountID, approvedBy domain.UserID) (c2 domain.CycleCountApproval, err error) {
+	mm_atomic.AddUint64(&mmApplyCycleCountVariances.beforeApplyCycleCountVariancesCounter, 1)
+	defer mm_atomic.AddUint64(&mmApplyCycleCountVariances.afterApplyCycleCountVariancesCounter, 1)
+
+	mmApplyCycleCountVariances.t.Helper()
+
+	if mmApplyCycleCountVariances.inspectFuncApplyCycleCountVariances != nil {
+		mmApplyCycleCountVariances.inspectFuncApplyCycleCountVariances(ctx, cycleCountID, approvedBy)
+	}
+
+	mm_params := StockServiceRepositoryMockApplyCycleCountVariancesParams{ctx, cycleCountID, approvedBy}
+
+	// Record call args
+	mmApplyCycleCountVariances.ApplyCycleCountVariancesMock.mutex.Lock()
+	mmApplyCycleCountVariances.ApplyCycleCountVariancesMock.callArgs = append(mmApplyCycleCountVariances.ApplyCycleCountVariancesMock.callArgs, &mm_params)
+	mmApplyCycleCountVariances.ApplyCycleCountVariancesMock.mutex.Unlock()
+
+	for _, e := range mmApplyCycleCountVariances.ApplyCycleCountVariancesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.c2, e.results.err
+		}
+	}
+
+	if mmApplyCycleCountVariances.ApplyCycleCountVariancesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmApplyCycleCountVariances.ApplyCycleCountVariancesMock.defaultExpectation.Counter, 1)
+		mm_want := mmApplyCycleCountVariances.ApplyCycleCountVariancesMock.defaultExpectation.params
+		mm_want_ptrs := mmApplyCycleCountVariances.ApplyCycleCountVariancesMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceRepositoryMockApplyCycleCountVariancesParams{ctx, cycleCountID, approvedBy}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmApplyCycleCountVariances.t.Errorf("StockServiceRepositoryMock.ApplyCycleCountVariances got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyCycleCountVariances.ApplyCycleCountVariancesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.cycleCountID != nil && !minimock.Equal(*mm_want_ptrs.cycleCountID, mm_got.cycleCountID) {
+				mmApplyCycleCountVariances.t.Errorf("StockServiceRepositoryMock.ApplyCycleCountVariances got unexpected parameter cycleCountID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyCycleCountVariances.ApplyCycleCountVariancesMock.defaultExpectation.expectationOrigins.originCycleCountID, *mm_want_ptrs.cycleCountID, mm_got.cycleCountID, minimock.Diff(*mm_want_ptrs.cycleCountID, mm_got.cycleCountID))
+			}
+
+			if mm_want_ptrs.approvedBy != nil && !minimock.Equal(*mm_want_ptrs.approvedBy, mm_got.approvedBy) {
+				mmApplyCycleCountVariances.t.Errorf("StockServiceRepositoryMock.ApplyCycleCountVariances got unexpected parameter approvedBy, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyCycleCountVariances.ApplyCycleCountVariancesMock.defaultExpectation.expectationOrigins.originApprovedBy, *mm_want_ptrs.approvedBy, mm_got.approvedBy, minimock.Diff(*mm_want_ptrs.approvedBy, mm_got.approvedBy))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmApplyCycleCountVariances.t.Errorf("StockServiceRepositoryMock.ApplyCycleCountVariances got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmApplyCycleCountVariances.ApplyCycleCountVariancesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmApplyCycleCountVariances.ApplyCycleCountVariancesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmApplyCycleCountVariances.t.Fatal("No results are set for the StockServiceRepositoryMock.ApplyCycleCountVariances")
+		}
+		return (*mm_results).c2, (*mm_results).err
+	}
+	if mmApplyCycleCountVariances.funcApplyCycleCountVariances != nil {
+		return mmApplyCycleCountVariances.funcApplyCycleCountVariances(ctx, cycleCountID, approvedBy)
+	}
+	mmApplyCycleCountVariances.t.Fatalf("Unexpected call to StockServiceRepositoryMock.ApplyCycleCountVariances. %v %v %v", ctx, cycleCountID, approvedBy)
+	return
+}
+
+// ApplyCycleCountVariancesAfterCounter returns a count of finished StockServiceRepositoryMock.ApplyCycleCountVariances invocations
+func (mmApplyCycleCountVariances *StockServiceRepositoryMock) ApplyCycleCountVariancesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApplyCycleCountVariances.afterApplyCycleCountVariancesCounter)
+}
+
+// ApplyCycleCountVariancesBeforeCounter returns a count of StockServiceRepositoryMock.ApplyCycleCountVariances invocations
+func (mmApplyCycleCountVariances *StockServiceRepositoryMock) ApplyCycleCountVariancesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApplyCycleCountVariances.beforeApplyCycleCountVariancesCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.ApplyCycleCountVariances.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmApplyCycleCountVariances *mStockServiceRepositoryMockApplyCycleCountVariances) Calls() []*StockServiceRepositoryMockApplyCycleCountVariancesParams {
+	mmApplyCycleCountVariances.mutex.RLock()
+
+	argCopy := make([]*StockServiceRepositoryMockApplyCycleCountVariancesParams, len(mmApplyCycleCountVariances.callArgs))
+	copy(argCopy, mmApplyCycleCountVariances.callArgs)
+
+	mmApplyCycleCountVariances.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockApplyCycleCountVariancesDone returns true if the count of the ApplyCycleCountVariances invocations corresponds
+// the number of defined expectations
+func (m *StockServiceRepositoryMock) MinimockApplyCycleCountVariancesDone() bool {
+	if m.ApplyCycleCountVariancesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ApplyCycleCountVariancesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ApplyCycleCountVariancesMock.invocationsDone()
+}
+
+// MinimockApplyCycleCountVariancesInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockApplyCycleCountVariancesInspect() {
+	for _, e := range m.ApplyCycleCountVariancesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ApplyCycleCountVariances at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterApplyCycleCountVariancesCounter := mm_atomic.LoadUint64(&m.afterApplyCycleCountVariancesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ApplyCycleCountVariancesMock.defaultExpectation != nil && afterApplyCycleCountVariancesCounter < 1 {
+		if m.ApplyCycleCountVariancesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ApplyCycleCountVariances at\n%s", m.ApplyCycleCountVariancesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ApplyCycleCountVariances at\n%s with params: %#v", m.ApplyCycleCountVariancesMock.defaultExpectation.expectationOrigins.origin, *m.ApplyCycleCountVariancesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcApplyCycleCountVariances != nil && afterApplyCycleCountVariancesCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.ApplyCycleCountVariances at\n%s", m.funcApplyCycleCountVariancesOrigin)
+	}
+
+	if !m.ApplyCycleCountVariancesMock.invocationsDone() && afterApplyCycleCountVariancesCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.ApplyCycleCountVariances at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ApplyCycleCountVariancesMock.expectedInvocations), m.ApplyCycleCountVariancesMock.expectedInvocationsOrigin, afterApplyCycleCountVariancesCounter)
+	}
+}
+
+type mStockServiceRepositoryMockCountLotsExpiringBefore struct {
+	optional           bool
+	mock               *StockServiceRepositoryMock
+	defaultExpectation *StockServiceRepositoryMockCountLotsExpiringBeforeExpectation
+	expectations       []*StockServiceRepositoryMockCountLotsExpiringBeforeExpectation
+
+	callArgs []*StockServiceRepositoryMockCountLotsExpiringBeforeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceRepositoryMockCountLotsExpiringBeforeExpectation specifies expectation struct of the StockServiceRepository.CountLotsExpiringBefore
+type StockServiceRepositoryMockCountLotsExpiringBeforeExpectation struct {
+	mock               *StockServiceRepositoryMock
+	params             *StockServiceRepositoryMockCountLotsExpiringBeforeParams
+	paramPtrs          *StockServiceRepositoryMockCountLotsExpiringBeforeParamPtrs
+	expectationOrigins StockServiceRepositoryMockCountLotsExpiringBeforeExpectationOrigins
+	results            *StockServiceRepositoryMockCountLotsExpiringBeforeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceRepositoryMockCountLotsExpiringBeforeParams contains parameters of the StockServiceRepository.CountLotsExpiringBefore
+type StockServiceRepositoryMockCountLotsExpiringBeforeParams struct {
+	ctx    context.Context
+	filter domain.ExpiringLotsFilter
+}
+
+// StockServiceRepositoryMockCountLotsExpiringBeforeParamPtrs contains pointers to parameters of the StockServiceRepository.CountLotsExpiringBefore
+type StockServiceRepositoryMockCountLotsExpiringBeforeParamPtrs struct {
+	ctx    *context.Context
+	filter *domain.ExpiringLotsFilter
+}
+
+// StockServiceRepositoryMockCountLotsExpiringBeforeResults contains results of the StockServiceRepository.CountLotsExpiringBefore
+type StockServiceRepositoryMockCountLotsExpiringBeforeResults struct {
+	u1  uint32
+	err error
+}
+
+// StockServiceRepositoryMockCountLotsExpiringBeforeOrigins contains origins of expectations of the StockServiceRepository.CountLotsExpiringBefore
+type StockServiceRepositoryMockCountLotsExpiringBeforeExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCountLotsExpiringBefore *mStockServiceRepositoryMockCountLotsExpiringBefore) Optional() *mStockServiceRepositoryMockCountLotsExpiringBefore {
+	mmCountLotsExpiringBefore.optional = true
+	return mmCountLotsExpiringBefore
+}
+
+// Expect sets up expected params for StockServiceRepository.CountLotsExpiringBefore
+func (mmCountLotsExpiringBefore *mStockServiceRepositoryMockCountLotsExpiringBefore) Expect(ctx context.Context, filter domain.ExpiringLotsFilter) *mStockServiceRepositoryMockCountLotsExpiringBefore {
+	if mmCountLotsExpiringBefore.mock.funcCountLotsExpiringBefore != nil {
+		mmCountLotsExpiringBefore.mock.t.Fatalf("StockServiceRepositoryMock.CountLotsExpiringBefore mock is already set by Set")
+	}
+
+	if mmCountLotsExpiringBefore.defaultExpectation == nil {
+		mmCountLotsExpiringBefore.defaultExpectation = &StockServiceRepositoryMockCountLotsExpiringBeforeExpectation{}
+	}
+
+	if mmCountLotsExpiringBefore.defaultExpectation.paramPtrs != nil {
+		mmCountLotsExpiringBefore.mock.t.Fatalf("StockServiceRepositoryMock.CountLotsExpiringBefore mock is already set by ExpectParams functions")
+	}
+
+	mmCountLotsExpiringBefore.defaultExpectation.params = &StockServiceRepositoryMockCountLotsExpiringBeforeParams{ctx, filter}
+	mmCountLotsExpiringBefore.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCountLotsExpiringBefore.expectations {
+		if minimock.Equal(e.params, mmCountLotsExpiringBefore.defaultExpectation.params) {
+			mmCountLotsExpiringBefore.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCountLotsExpiringBefore.defaultExpectation.params)
+		}
+	}
+
+	return mmCountLotsExpiringBefore
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.CountLotsExpiringBefore
+func (mmCountLotsExpiringBefore *mStockServiceRepositoryMockCountLotsExpiringBefore) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockCountLotsExpiringBefore {
+	if mmCountLotsExpiringBefore.mock.funcCountLotsExpiringBefore != nil {
+		mmCountLotsExpiringBefore.mock.t.Fatalf("StockServiceRepositoryMock.CountLotsExpiringBefore mock is already set by Set")
+	}
+
+	if mmCountLotsExpiringBefore.defaultExpectation == nil {
+		mmCountLotsExpiringBefore.defaultExpectation = &StockServiceRepositoryMockCountLotsExpiringBeforeExpectation{}
+	}
+
+	if mmCountLotsExpiringBefore.defaultExpectation.params != nil {
+		mmCountLotsExpiringBefore.mock.t.Fatalf("StockServiceRepositoryMock.CountLotsExpiringBefore mock is already set by Expect")
+	}
+
+	if mmCountLotsExpiringBefore.defaultExpectation.paramPtrs == nil {
+		mmCountLotsExpiringBefore.defaultExpectation.paramPtrs = &StockServiceRepositoryMockCountLotsExpiringBeforeParamPtrs{}
+	}
+	mmCountLotsExpiringBefore.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCountLotsExpiringBefore.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCountLotsExpiringBefore
+}
+
+// ExpectFilterParam2 sets up expected param filter for StockServiceRepository.CountLotsExpiringBefore
+func (mmCountLotsExpiringBefore *mStockServiceRepositoryMockCountLotsExpiringBefore) ExpectFilterParam2(filter domain.ExpiringLotsFilter) *mStockServiceRepositoryMockCountLotsExpiringBefore {
+	if mmCountLotsExpiringBefore.mock.funcCountLotsExpiringBefore != nil {
+		mmCountLotsExpiringBefore.mock.t.Fatalf("StockServiceRepositoryMock.CountLotsExpiringBefore mock is already set by Set")
+	}
+
+	if mmCountLotsExpiringBefore.defaultExpectation == nil {
+		mmCountLotsExpiringBefore.defaultExpectation = &StockServiceRepositoryMockCountLotsExpiringBeforeExpectation{}
+	}
+
+	if mmCountLotsExpiringBefore.defaultExpectation.params != nil {
+		mmCountLotsExpiringBefore.mock.t.Fatalf("StockServiceRepositoryMock.CountLotsExpiringBefore mock is already set by Expect")
+	}
+
+	if mmCountLotsExpiringBefore.defaultExpectation.paramPtrs == nil {
+		mmCountLotsExpiringBefore.defaultExpectation.paramPtrs = &StockServiceRepositoryMockCountLotsExpiringBeforeParamPtrs{}
+	}
+	mmCountLotsExpiringBefore.defaultExpectation.paramPtrs.filter = &filter
+	mmCountLotsExpiringBefore.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmCountLotsExpiringBefore
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.CountLotsExpiringBefore
+func (mmCountLotsExpiringBefore *mStockServiceRepositoryMockCountLotsExpiringBefore) Inspect(f func(ctx context.Context, filter domain.ExpiringLotsFilter)) *mStockServiceRepositoryMockCountLotsExpiringBefore {
+	if mmCountLotsExpiringBefore.mock.inspectFuncCountLotsExpiringBefore != nil {
+		mmCountLotsExpiringBefore.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.CountLotsExpiringBefore")
+	}
+
+	mmCountLotsExpiringBefore.mock.inspectFuncCountLotsExpiringBefore = f
+
+	return mmCountLotsExpiringBefore
+}
+
+// Return sets up results that will be returned by StockServiceRepository.CountLotsExpiringBefore
+func (mmCountLotsExpiringBefore *mStockServiceRepositoryMockCountLotsExpiringBefore) Return(u1 uint32, err error) *StockServiceRepositoryMock {
+	if mmCountLotsExpiringBefore.mock.funcCountLotsExpiringBefore != nil {
+		mmCountLotsExpiringBefore.mock.t.Fatalf("StockServiceRepositoryMock.CountLotsExpiringBefore mock is already set by Set")
+	}
+
+	if mmCountLotsExpiringBefore.defaultExpectation == nil {
+		mmCountLotsExpiringBefore.defaultExpectation = &StockServiceRepositoryMockCountLotsExpiringBeforeExpectation{mock: mmCountLotsExpiringBefore.mock}
+	}
+	mmCountLotsExpiringBefore.defaultExpectation.results = &StockServiceRepositoryMockCountLotsExpiringBeforeResults{u1, err}
+	mmCountLotsExpiringBefore.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCountLotsExpiringBefore.mock
+}
+
+// Set uses given function f to mock the StockServiceRepository.CountLotsExpiringBefore method
+func (mmCountLotsExpiringBefore *mStockServiceRepositoryMockCountLotsExpiringBefore) Set(f func(ctx context.Context, filter domain.ExpiringLotsFilter) (u1 uint32, err error)) *StockServiceRepositoryMock {
+	if mmCountLotsExpiringBefore.defaultExpectation != nil {
+		mmCountLotsExpiringBefore.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.CountLotsExpiringBefore method")
+	}
+
+	if len(mmCountLotsExpiringBefore.expectations) > 0 {
+		mmCountLotsExpiringBefore.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.CountLotsExpiringBefore method")
+	}
+
+	mmCountLotsExpiringBefore.mock.funcCountLotsExpiringBefore = f
+	mmCountLotsExpiringBefore.mock.funcCountLotsExpiringBeforeOrigin = minimock.CallerInfo(1)
+	return mmCountLotsExpiringBefore.mock
+}
+
+// When sets expectation for the StockServiceRepository.CountLotsExpiringBefore which will trigger the result defined by the following
+// Then helper
+func (mmCountLotsExpiringBefore *mStockServiceRepositoryMockCountLotsExpiringBefore) When(ctx context.Context, filter domain.ExpiringLotsFilter) *StockServiceRepositoryMockCountLotsExpiringBeforeExpectation {
+	if mmCountLotsExpiringBefore.mock.funcCountLotsExpiringBefore != nil {
+		mmCountLotsExpiringBefore.mock.t.Fatalf("StockServiceRepositoryMock.CountLotsExpiringBefore mock is already set by Set")
+	}
+
+	expectation := &StockServiceRepositoryMockCountLotsExpiringBeforeExpectation{
+		mock:               mmCountLotsExpiringBefore.mock,
+		params:             &StockServiceRepositoryMockCountLotsExpiringBeforeParams{ctx, filter},
+		expectationOrigins: StockServiceRepositoryMockCountLotsExpiringBeforeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCountLotsExpiringBefore.expectations = append(mmCountLotsExpiringBefore.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceRepository.CountLotsExpiringBefore return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockCountLotsExpiringBeforeExpectation) Then(u1 uint32, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockCountLotsExpiringBeforeResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceRepository.CountLotsExpiringBefore should be invoked
+func (mmCountLotsExpiringBefore *mStockServiceRepositoryMockCountLotsExpiringBefore) Times(n uint64) *mStockServiceRepositoryMockCountLotsExpiringBefore {
+	if n == 0 {
+		mmCountLotsExpiringBefore.mock.t.Fatalf("Times of StockServiceRepositoryMock.CountLotsExpiringBefore mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCountLotsExpiringBefore.expectedInvocations, n)
+	mmCountLotsExpiringBefore.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCountLotsExpiringBefore
+}
+
+func (mmCountLotsExpiringBefore *mStockServiceRepositoryMockCountLotsExpiringBefore) invocationsDone() bool {
+	if len(mmCountLotsExpiringBefore.expectations) == 0 && mmCountLotsExpiringBefore.defaultExpectation == nil && mmCountLotsExpiringBefore.mock.funcCountLotsExpiringBefore == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCountLotsExpiringBefore.mock.afterCountLotsExpiringBeforeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCountLotsExpiringBefore.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CountLotsExpiringBefore implements mm_stocks.StockServiceRepository
+func (mmCountLotsExpiringBefore *StockServiceRepositoryMock) CountLotsExpiringBefore(ctx context.Context, filter domain.ExpiringLotsFilter) (u1 uint32, err error) {
+	mm_atomic.AddUint64(&mmCountLotsExpiringBefore.beforeCountLotsExpiringBeforeCounter, 1)
+	defer mm_atomic.AddUint64(&mmCountLotsExpiringBefore.afterCountLotsExpiringBeforeCounter, 1)
+
+	mmCountLotsExpiringBefore.t.Helper()
+
+	if mmCountLotsExpiringBefore.inspectFuncCountLotsExpiringBefore != nil {
+		mmCountLotsExpiringBefore.inspectFuncCountLotsExpiringBefore(ctx, filter)
+	}
+
+	mm_params := StockServiceRepositoryMockCountLotsExpiringBeforeParams{ctx, filter}
+
+	// Record call args
+	mmCountLotsExpiringBefore.CountLotsExpiringBeforeMock.mutex.Lock()
+	mmCountLotsExpiringBefore.CountLotsExpiringBeforeMock.callArgs = append(mmCountLotsExpiringBefore.CountLotsExpiringBeforeMock.callArgs, &mm_params)
+	mmCountLotsExpiringBefore.CountLotsExpiringBeforeMock.mutex.Unlock()
+
+	for _, e := range mmCountLotsExpiringBefore.CountLotsExpiringBeforeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmCountLotsExpiringBefore.CountLotsExpiringBeforeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCountLotsExpiringBefore.CountLotsExpiringBeforeMock.defaultExpectation.Counter, 1)
+		mm_want := mmCountLotsExpiringBefore.CountLotsExpiringBeforeMock.defaultExpectation.params
+		mm_want_ptrs := mmCountLotsExpiringBefore.CountLotsExpiringBeforeMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceRepositoryMockCountLotsExpiringBeforeParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCountLotsExpiringBefore.t.Errorf("StockServiceRepositoryMock.CountLotsExpiringBefore got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountLotsExpiringBefore.CountLotsExpiringBeforeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmCountLotsExpiringBefore.t.Errorf("StockServiceRepositoryMock.CountLotsExpiringBefore got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountLotsExpiringBefore.CountLotsExpiringBeforeMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCountLotsExpiringBefore.t.Errorf("StockServiceRepositoryMock.CountLotsExpiringBefore got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCountLotsExpiringBefore.CountLotsExpiringBeforeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCountLotsExpiringBefore.CountLotsExpiringBeforeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCountLotsExpiringBefore.t.Fatal("No results are set for the StockServiceRepositoryMock.CountLotsExpiringBefore")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmCountLotsExpiringBefore.funcCountLotsExpiringBefore != nil {
+		return mmCountLotsExpiringBefore.funcCountLotsExpiringBefore(ctx, filter)
+	}
+	mmCountLotsExpiringBefore.t.Fatalf("Unexpected call to StockServiceRepositoryMock.CountLotsExpiringBefore. %v %v", ctx, filter)
+	return
+}
+
+// CountLotsExpiringBeforeAfterCounter returns a count of finished StockServiceRepositoryMock.CountLotsExpiringBefore invocations
+func (mmCountLotsExpiringBefore *StockServiceRepositoryMock) CountLotsExpiringBeforeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountLotsExpiringBefore.afterCountLotsExpiringBeforeCounter)
+}
+
+// CountLotsExpiringBeforeBeforeCounter returns a count of StockServiceRepositoryMock.CountLotsExpiringBefore invocations
+func (mmCountLotsExpiringBefore *StockServiceRepositoryMock) CountLotsExpiringBeforeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountLotsExpiringBefore.beforeCountLotsExpiringBeforeCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.CountLotsExpiringBefore.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCountLotsExpiringBefore *mStockServiceRepositoryMockCountLotsExpiringBefore) Calls() []*StockServiceRepositoryMockCountLotsExpiringBeforeParams {
+	mmCountLotsExpiringBefore.mutex.RLock()
+
+	argCopy := make([]*StockServiceRepositoryMockCountLotsExpiringBeforeParams, len(mmCountLotsExpiringBefore.callArgs))
+	copy(argCopy, mmCountLotsExpiringBefore.callArgs)
+
+	mmCountLotsExpiringBefore.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountLotsExpiringBeforeDone returns true if the count of the CountLotsExpiringBefore invocations corresponds
+// the number of defined expectations
+func (m *StockServiceRepositoryMock) MinimockCountLotsExpiringBeforeDone() bool {
+	if m.CountLotsExpiringBeforeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountLotsExpiringBeforeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountLotsExpiringBeforeMock.invocationsDone()
+}
+
+// MinimockCountLotsExpiringBeforeInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockCountLotsExpiringBeforeInspect() {
+	for _, e := range m.CountLotsExpiringBeforeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.CountLotsExpiringBefore at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCountLotsExpiringBeforeCounter := mm_atomic.LoadUint64(&m.afterCountLotsExpiringBeforeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountLotsExpiringBeforeMock.defaultExpectation != nil && afterCountLotsExpiringBeforeCounter < 1 {
+		if m.CountLotsExpiringBeforeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.CountLotsExpiringBefore at\n%s", m.CountLotsExpiringBeforeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.CountLotsExpiringBefore at\n%s with params: %#v", m.CountLotsExpiringBeforeMock.defaultExpectation.expectationOrigins.origin, *m.CountLotsExpiringBeforeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCountLotsExpiringBefore != nil && afterCountLotsExpiringBeforeCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.CountLotsExpiringBefore at\n%s", m.funcCountLotsExpiringBeforeOrigin)
+	}
+
+	if !m.CountLotsExpiringBeforeMock.invocationsDone() && afterCountLotsExpiringBeforeCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.CountLotsExpiringBefore at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CountLotsExpiringBeforeMock.expectedInvocations), m.CountLotsExpiringBeforeMock.expectedInvocationsOrigin, afterCountLotsExpiringBeforeCounter)
+	}
+}
+
+type mStockServiceRepositoryMockCountStockItems struct {
+	optional           bool
+	mock               *StockServiceRepositoryMock
+	defaultExpectation *StockServiceRepositoryMockCountStockItemsExpectation
+	expectations       []*StockServiceRepositoryMockCountStockItemsExpectation
+
+	callArgs []*StockServiceRepositoryMockCountStockItemsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceRepositoryMockCountStockItemsExpectation specifies expectation struct of the StockServiceRepository.CountStockItems
+type StockServiceRepositoryMockCountStockItemsExpectation struct {
+	mock               *StockServiceRepositoryMock
+	params             *StockServiceRepositoryMockCountStockItemsParams
+	paramPtrs          *StockServiceRepositoryMockCountStockItemsParamPtrs
+	expectationOrigins StockServiceRepositoryMockCountStockItemsExpectationOrigins
+	results            *StockServiceRepositoryMockCountStockItemsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceRepositoryMockCountStockItemsParams contains parameters of the StockServiceRepository.CountStockItems
+type StockServiceRepositoryMockCountStockItemsParams struct {
+	ctx    context.Context
+	filter domain.Filter
+}
+
+// StockServiceRepositoryMockCountStockItemsParamPtrs contains pointers to parameters of the StockServiceRepository.CountStockItems
+type StockServiceRepositoryMockCountStockItemsParamPtrs struct {
+	ctx    *context.Context
+	filter *domain.Filter
+}
+
+// StockServiceRepositoryMockCountStockItemsResults contains results of the StockServiceRepository.CountStockItems
+type StockServiceRepositoryMockCountStockItemsResults struct {
+	u1  uint16
+	err error
+}
+
+// StockServiceRepositoryMockCountStockItemsOrigins contains origins of expectations of the StockServiceRepository.CountStockItems
+type StockServiceRepositoryMockCountStockItemsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCountStockItems *mStockServiceRepositoryMockCountStockItems) Optional() *mStockServiceRepositoryMockCountStockItems {
+	mmCountStockItems.optional = true
+	return mmCountStockItems
+}
+
+// Expect sets up expected params for StockServiceRepository.CountStockItems
+func (mmCountStockItems *mStockServiceRepositoryMockCountStockItems) Expect(ctx context.Context, filter domain.Filter) *mStockServiceRepositoryMockCountStockItems {
+	if mmCountStockItems.mock.funcCountStockItems != nil {
+		mmCountStockItems.mock.t.Fatalf("StockServiceRepositoryMock.CountStockItems mock is already set by Set")
+	}
+
+	if mmCountStockItems.defaultExpectation == nil {
+		mmCountStockItems.defaultExpectation = &StockServiceRepositoryMockCountStockItemsExpectation{}
+	}
+
+	if mmCountStockItems.defaultExpectation.paramPtrs != nil {
+		mmCountStockItems.mock.t.Fatalf("StockServiceRepositoryMock.CountStockItems mock is already set by ExpectParams functions")
+	}
+
+	mmCountStockItems.defaultExpectation.params = &StockServiceRepositoryMockCountStockItemsParams{ctx, filter}
+	mmCountStockItems.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCountStockItems.expectations {
+		if minimock.Equal(e.params, mmCountStockItems.defaultExpectation.params) {
+			mmCountStockItems.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCountStockItems.defaultExpectation.params)
+		}
+	}
+
+	return mmCountStockItems
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.CountStockItems
+func (mmCountStockItems *mStockServiceRepositoryMockCountStockItems) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockCountStockItems {
+	if mmCountStockItems.mock.funcCountStockItems != nil {
+		mmCountStockItems.mock.t.Fatalf("StockServiceRepositoryMock.CountStockItems mock is already set by Set")
+	}
+
+	if mmCountStockItems.defaultExpectation == nil {
+		mmCountStockItems.defaultExpectation = &StockServiceRepositoryMockCountStockItemsExpectation{}
+	}
+
+	if mmCountStockItems.defaultExpectation.params != nil {
+		mmCountStockItems.mock.t.Fatalf("StockServiceRepositoryMock.CountStockItems mock is already set by Expect")
+	}
+
+	if mmCountStockItems.defaultExpectation.paramPtrs == nil {
+		mmCountStockItems.defaultExpectation.paramPtrs = &StockServiceRepositoryMockCountStockItemsParamPtrs{}
+	}
+	mmCountStockItems.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCountStockItems.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCountStockItems
+}
+
+// ExpectFilterParam2 sets up expected param filter for StockServiceRepository.CountStockItems
+func (mmCountStockItems *mStockServiceRepositoryMockCountStockItems) ExpectFilterParam2(filter domain.Filter) *mStockServiceRepositoryMockCountStockItems {
+	if mmCountStockItems.mock.funcCountStockItems != nil {
+		mmCountStockItems.mock.t.Fatalf("StockServiceRepositoryMock.CountStockItems mock is already set by Set")
+	}
+
+	if mmCountStockItems.defaultExpectation == nil {
+		mmCountStockItems.defaultExpectation = &StockServiceRepositoryMockCountStockItemsExpectation{}
+	}
+
+	if mmCountStockItems.defaultExpectation.params != nil {
+		mmCountStockItems.mock.t.Fatalf("StockServiceRepositoryMock.CountStockItems mock is already set by Expect")
+	}
+
+	if mmCountStockItems.defaultExpectation.paramPtrs == nil {
+		mmCountStockItems.defaultExpectation.paramPtrs = &StockServiceRepositoryMockCountStockItemsParamPtrs{}
+	}
+	mmCountStockItems.defaultExpectation.paramPtrs.filter = &filter
+	mmCountStockItems.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmCountStockItems
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.CountStockItems
+func (mmCountStockItems *mStockServiceRepositoryMockCountStockItems) Inspect(f func(ctx context.Context, filter domain.Filter)) *mStockServiceRepositoryMockCountStockItems {
+	if mmCountStockItems.mock.inspectFuncCountStockItems != nil {
+		mmCountStockItems.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.CountStockItems")
+	}
+
+	mmCountStockItems.mock.inspectFuncCountStockItems = f
+
+	return mmCountStockItems
+}
+
+// Return sets up results that will be returned by StockServiceRepository.CountStockItems
+func (mmCountStockItems *mStockServiceRepositoryMockCountStockItems) Return(u1 uint16, err error) *StockServiceRepositoryMock {
+	if mmCountStockItems.mock.funcCountStockItems != nil {
+		mmCountStockItems.mock.t.Fatalf("StockServiceRepositoryMock.CountStockItems mock is already set by Set")
+	}
+
+	if mmCountStockItems.defaultExpectation == nil {
+		mmCountStockItems.defaultExpectation = &StockServiceRepositoryMockCountStockItemsExpectation{mock: mmCountStockItems.mock}
+	}
+	mmCountStockItems.defaultExpectation.results = &StockServiceRepositoryMockCountStockItemsResults{u1, err}
+	mmCountStockItems.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCountStockItems.mock
+}
+
+// Set uses given function f to mock the StockServiceRepository.CountStockItems method
+func (mmCountStockItems *mStockServiceRepositoryMockCountStockItems) Set(f func(ctx context.Context, filter domain.Filter) (u1 uint16, err error)) *StockServiceRepositoryMock {
+	if mmCountStockItems.defaultExpectation != nil {
+		mmCountStockItems.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.CountStockItems method")
+	}
+
+	if len(mmCountStockItems.expectations) > 0 {
+		mmCountStockItems.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.CountStockItems method")
+	}
+
+	mmCountStockItems.mock.funcCountStockItems = f
+	mmCountStockItems.mock.funcCountStockItemsOrigin = minimock.CallerInfo(1)
+	return mmCountStockItems.mock
+}
+
+// When sets expectation for the StockServiceRepository.CountStockItems which will trigger the result defined by the following
+// Then helper
+func (mmCountStockItems *mStockServiceRepositoryMockCountStockItems) When(ctx context.Context, filter domain.Filter) *StockServiceRepositoryMockCountStockItemsExpectation {
+	if mmCountStockItems.mock.funcCountStockItems != nil {
+		mmCountStockItems.mock.t.Fatalf("StockServiceRepositoryMock.CountStockItems mock is already set by Set")
+	}
+
+	expectation := &StockServiceRepositoryMockCountStockItemsExpectation{
+		mock:               mmCountStockItems.mock,
+		params:             &StockServiceRepositoryMockCountStockItemsParams{ctx, filter},
+		expectationOrigins: StockServiceRepositoryMockCountStockItemsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCountStockItems.expectations = append(mmCountStockItems.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceRepository.CountStockItems return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockCountStockItemsExpectation) Then(u1 uint16, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockCountStockItemsResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceRepository.CountStockItems should be invoked
+func (mmCountStockItems *mStockServiceRepositoryMockCountStockItems) Times(n uint64) *mStockServiceRepositoryMockCountStockItems {
+	if n == 0 {
+		mmCountStockItems.mock.t.Fatalf("Times of StockServiceRepositoryMock.CountStockItems mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCountStockItems.expectedInvocations, n)
+	mmCountStockItems.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCountStockItems
+}
+
+func (mmCountStockItems *mStockServiceRepositoryMockCountStockItems) invocationsDone() bool {
+	if len(mmCountStockItems.expectations) == 0 && mmCountStockItems.defaultExpectation == nil && mmCountStockItems.mock.funcCountStockItems == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCountStockItems.mock.afterCountStockItemsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCountStockItems.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CountStockItems implements mm_stocks.StockServiceRepository
+func (mmCountStockItems *StockServiceRepositoryMock) CountStockItems(ctx context.Context, filter domain.Filter) (u1 uint16, err error) {
+	mm_atomic.AddUint64(&mmCountStockItems.beforeCountStockItemsCounter, 1)
+	defer mm_atomic.AddUint64(&mmCountStockItems.afterCountStockItemsCounter, 1)
+
+	mmCountStockItems.t.Helper()
+
+	if mmCountStockItems.inspectFuncCountStockItems != nil {
+		mmCountStockItems.inspectFuncCountStockItems(ctx, filter)
+	}
+
+	mm_params := StockServiceRepositoryMockCountStockItemsParams{ctx, filter}
+
+	// Record call args
+	mmCountStockItems.CountStockItemsMock.mutex.Lock()
+	mmCountStockItems.CountStockItemsMock.callArgs = append(mmCountStockItems.CountStockItemsMock.callArgs, &mm_params)
+	mmCountStockItems.CountStockItemsMock.mutex.Unlock()
+
+	for _, e := range mmCountStockItems.CountStockItemsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmCountStockItems.CountStockItemsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCountStockItems.CountStockItemsMock.defaultExpectation.Counter, 1)
+		mm_want := mmCountStockItems.CountStockItemsMock.defaultExpectation.params
+		mm_want_ptrs := mmCountStockItems.CountStockItemsMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceRepositoryMockCountStockItemsParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCountStockItems.t.Errorf("StockServiceRepositoryMock.CountStockItems got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountStockItems.CountStockItemsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmCountStockItems.t.Errorf("StockServiceRepositoryMock.CountStockItems got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountStockItems.CountStockItemsMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCountStockItems.t.Errorf("StockServiceRepositoryMock.CountStockItems got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCountStockItems.CountStockItemsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCountStockItems.CountStockItemsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCountStockItems.t.Fatal("No results are set for the StockServiceRepositoryMock.CountStockItems")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmCountStockItems.funcCountStockItems != nil {
+		return mmCountStockItems.funcCountStockItems(ctx, filter)
+	}
+	mmCountStockItems.t.Fatalf("Unexpected call to StockServiceRepositoryMock.CountStockItems. %v %v", ctx, filter)
+	return
+}
+
+// CountStockItemsAfterCounter returns a count of finished StockServiceRepositoryMock.CountStockItems invocations
+func (mmCountStockItems *StockServiceRepositoryMock) CountStockItemsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountStockItems.afterCountStockItemsCounter)
+}
+
+// CountStockItemsBeforeCounter returns a count of StockServiceRepositoryMock.CountStockItems invocations
+func (mmCountStockItems *StockServiceRepositoryMock) CountStockItemsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountStockItems.beforeCountStockItemsCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.CountStockItems.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCountStockItems *mStockServiceRepositoryMockCountStockItems) Calls() []*StockServiceRepositoryMockCountStockItemsParams {
+	mmCountStockItems.mutex.RLock()
+
+	argCopy := make([]*StockServiceRepositoryMockCountStockItemsParams, len(mmCountStockItems.callArgs))
+	copy(argCopy, mmCountStockItems.callArgs)
+
+	mmCountStockItems.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountStockItemsDone returns true if the count of the CountStockItems invocations corresponds
+// the number of defined expectations
+func (m *StockServiceRepositoryMock) MinimockCountStockItemsDone() bool {
+	if m.CountStockItemsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountStockItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountStockItemsMock.invocationsDone()
+}
+
+// MinimockCountStockItemsInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockCountStockItemsInspect() {
+	for _, e := range m.CountStockItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.CountStockItems at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCountStockItemsCounter := mm_atomic.LoadUint64(&m.afterCountStockItemsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountStockItemsMock.defaultExpectation != nil && afterCountStockItemsCounter < 1 {
+		if m.CountStockItemsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.CountStockItems at\n%s", m.CountStockItemsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.CountStockItems at\n%s with params: %#v", m.CountStockItemsMock.defaultExpectation.expectationOrigins.origin, *m.CountStockItemsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCountStockItems != nil && afterCountStockItemsCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.CountStockItems at\n%s", m.funcCountStockItemsOrigin)
+	}
+
+	if !m.CountStockItemsMock.invocationsDone() && afterCountStockItemsCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.CountStockItems at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CountStockItemsMock.expectedInvocations), m.CountStockItemsMock.expectedInvocationsOrigin, afterCountStockItemsCounter)
+	}
+}
+
+type mStockServiceRepositoryMockCreateCycleCount struct {
+	optional           bool
+	mock               *StockServiceRepositoryMock
+	defaultExpectation *StockServiceRepositoryMockCreateCycleCountExpectation
+	expectations       []*StockServiceRepositoryMockCreateCycleCountExpectation
+
+	callArgs []*StockServiceRepositoryMockCreateCycleCountParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceRepositoryMockCreateCycleCountExpectation specifies expectation struct of the StockServiceRepository.CreateCycleCount
+type StockServiceRepositoryMockCreateCycleCountExpectation struct {
+	mock               *StockServiceRepositoryMock
+	params             *StockServiceRepositoryMockCreateCycleCountParams
+	paramPtrs          *StockServiceRepositoryMockCreateCycleCountParamPtrs
+	expectationOrigins StockServiceRepositoryMockCreateCycleCountExpectationOrigins
+	results            *StockServiceRepositoryMockCreateCycleCountResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceRepositoryMockCreateCycleCountParams contains parameters of the StockServiceRepository.CreateCycleCount
+type StockServiceRepositoryMockCreateCycleCountParams struct {
+	ctx        context.Context
+	cycleCount domain.CycleCount
+}
+
+// StockServiceRepositoryMockCreateCycleCountParamPtrs contains pointers to parameters of the StockServiceRepository.CreateCycleCount
+type StockServiceRepositoryMockCreateCycleCountParamPtrs struct {
+	ctx        *context.Context
+	cycleCount *domain.CycleCount
+}
+
+// StockServiceRepositoryMockCreateCycleCountResults contains results of the StockServiceRepository.CreateCycleCount
+type StockServiceRepositoryMockCreateCycleCountResults struct {
+	c2  domain.CycleCount
+	err error
+}
+
+// StockServiceRepositoryMockCreateCycleCountOrigins contains origins of expectations of the StockServiceRepository.CreateCycleCount
+type StockServiceRepositoryMockCreateCycleCountExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originCycleCount string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateCycleCount *mStockServiceRepositoryMockCreateCycleCount) Optional() *mStockServiceRepositoryMockCreateCycleCount {
+	mmCreateCycleCount.optional = true
+	return mmCreateCycleCount
+}
+
+// Expect sets up expected params for StockServiceRepository.CreateCycleCount
+func (mmCreateCycleCount *mStockServiceRepositoryMockCreateCycleCount) Expect(ctx context.Context, cycleCount domain.CycleCount) *mStockServiceRepositoryMockCreateCycleCount {
+	if mmCreateCycleCount.mock.funcCreateCycleCount != nil {
+		mmCreateCycleCount.mock.t.Fatalf("StockServiceRepositoryMock.CreateCycleCount mock is already set by Set")
+	}
+
+	if mmCreateCycleCount.defaultExpectation == nil {
+		mmCreateCycleCount.defaultExpectation = &StockServiceRepositoryMockCreateCycleCountExpectation{}
+	}
+
+	if mmCreateCycleCount.defaultExpectation.paramPtrs != nil {
+		mmCreateCycleCount.mock.t.Fatalf("StockServiceRepositoryMock.CreateCycleCount mock is already set by ExpectParams functions")
+	}
+
+	mmCreateCycleCount.defaultExpectation.params = &StockServiceRepositoryMockCreateCycleCountParams{ctx, cycleCount}
+	mmCreateCycleCount.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateCycleCount.expectations {
+		if minimock.Equal(e.params, mmCreateCycleCount.defaultExpectation.params) {
+			mmCreateCycleCount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateCycleCount.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateCycleCount
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.CreateCycleCount
+func (mmCreateCycleCount *mStockServiceRepositoryMockCreateCycleCount) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockCreateCycleCount {
+	if mmCreateCycleCount.mock.funcCreateCycleCount != nil {
+		mmCreateCycleCount.mock.t.Fatalf("StockServiceRepositoryMock.CreateCycleCount mock is already set by Set")
+	}
+
+	if mmCreateCycleCount.defaultExpectation == nil {
+		mmCreateCycleCount.defaultExpectation = &StockServiceRepositoryMockCreateCycleCountExpectation{}
+	}
+
+	if mmCreateCycleCount.defaultExpectation.params != nil {
+		mmCreateCycleCount.mock.t.Fatalf("StockServiceRepositoryMock.CreateCycleCount mock is already set by Expect")
+	}
+
+	if mmCreateCycleCount.defaultExpectation.paramPtrs == nil {
+		mmCreateCycleCount.defaultExpectation.paramPtrs = &StockServiceRepositoryMockCreateCycleCountParamPtrs{}
+	}
+	mmCreateCycleCount.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateCycleCount.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateCycleCount
+}
+
+// ExpectCycleCountParam2 sets up expected param cycleCount for StockServiceRepository.CreateCycleCount
+func (mmCreateCycleCount *mStockServiceRepositoryMockCreateCycleCount) ExpectCycleCountParam2(cycleCount domain.CycleCount) *mStockServiceRepositoryMockCreateCycleCount {
+	if mmCreateCycleCount.mock.funcCreateCycleCount != nil {
+		mmCreateCycleCount.mock.t.Fatalf("StockServiceRepositoryMock.CreateCycleCount mock is already set by Set")
+	}
+
+	if mmCreateCycleCount.defaultExpectation == nil {
+		mmCreateCycleCount.defaultExpectation = &StockServiceRepositoryMockCreateCycleCountExpectation{}
+	}
+
+	if mmCreateCycleCount.defaultExpectation.params != nil {
+		mmCreateCycleCount.mock.t.Fatalf("StockServiceRepositoryMock.CreateCycleCount mock is already set by Expect")
+	}
+
+	if mmCreateCycleCount.defaultExpectation.paramPtrs == nil {
+		mmCreateCycleCount.defaultExpectation.paramPtrs = &StockServiceRepositoryMockCreateCycleCountParamPtrs{}
+	}
+	mmCreateCycleCount.defaultExpectation.paramPtrs.cycleCount = &cycleCount
+	mmCreateCycleCount.defaultExpectation.expectationOrigins.originCycleCount = minimock.CallerInfo(1)
+
+	return mmCreateCycleCount
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.CreateCycleCount
+func (mmCreateCycleCount *mStockServiceRepositoryMockCreateCycleCount) Inspect(f func(ctx context.Context, cycleCount domain.CycleCount)) *mStockServiceRepositoryMockCreateCycleCount {
+	if mmCreateCycleCount.mock.inspectFuncCreateCycleCount != nil {
+		mmCreateCycleCount.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.CreateCycleCount")
+	}
+
+	mmCreateCycleCount.mock.inspectFuncCreateCycleCount = f
+
+	return mmCreateCycleCount
+}
+
+// Return sets up results that will be returned by StockServiceRepository.CreateCycleCount
+func (mmCreateCycleCount *mStockServiceRepositoryMockCreateCycleCount) Return(c2 domain.CycleCount, err error) *StockServiceRepositoryMock {
+	if mmCreateCycleCount.mock.funcCreateCycleCount != nil {
+		mmCreateCycleCount.mock.t.Fatalf("StockServiceRepositoryMock.CreateCycleCount mock is already set by Set")
+	}
+
+	if mmCreateCycleCount.defaultExpectation == nil {
+		mmCreateCycleCount.defaultExpectation = &StockServiceRepositoryMockCreateCycleCountExpectation{mock: mmCreateCycleCount.mock}
+	}
+	mmCreateCycleCount.defaultExpectation.results = &StockServiceRepositoryMockCreateCycleCountResults{c2, err}
+	mmCreateCycleCount.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateCycleCount.mock
+}
+
+// Set uses given function f to mock the StockServiceRepository.CreateCycleCount method
+func (mmCreateCycleCount *mStockServiceRepositoryMockCreateCycleCount) Set(f func(ctx context.Context, cycleCount domain.CycleCount) (c2 domain.CycleCount, err error)) *StockServiceRepositoryMock {
+	if mmCreateCycleCount.defaultExpectation != nil {
+		mmCreateCycleCount.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.CreateCycleCount method")
+	}
+
+	if len(mmCreateCycleCount.expectations) > 0 {
+		mmCreateCycleCount.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.CreateCycleCount method")
+	}
+
+	mmCreateCycleCount.mock.funcCreateCycleCount = f
+	mmCreateCycleCount.mock.funcCreateCycleCountOrigin = minimock.CallerInfo(1)
+	return mmCreateCycleCount.mock
+}
+
+// When sets expectation for the StockServiceRepository.CreateCycleCount which will trigger the result defined by the following
+// Then helper
+func (mmCreateCycleCount *mStockServiceRepositoryMockCreateCycleCount) When(ctx context.Context, cycleCount domain.CycleCount) *StockServiceRepositoryMockCreateCycleCountExpectation {
+	if mmCreateCycleCount.mock.funcCreateCycleCount != nil {
+		mmCreateCycleCount.mock.t.Fatalf("StockServiceRepositoryMock.CreateCycleCount mock is already set by Set")
+	}
+
+	expectation := &StockServiceRepositoryMockCreateCycleCountExpectation{
+		mock:               mmCreateCycleCount.mock,
+		params:             &StockServiceRepositoryMockCreateCycleCountParams{ctx, cycleCount},
+		expectationOrigins: StockServiceRepositoryMockCreateCycleCountExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateCycleCount.expectations = append(mmCreateCycleCount.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceRepository.CreateCycleCount return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockCreateCycleCountExpectation) Then(c2 domain.CycleCount, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockCreateCycleCountResults{c2, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceRepository.CreateCycleCount should be invoked
+func (mmCreateCycleCount *mStockServiceRepositoryMockCreateCycleCount) Times(n uint64) *mStockServiceRepositoryMockCreateCycleCount {
+	if n == 0 {
+		mmCreateCycleCount.mock.t.Fatalf("Times of StockServiceRepositoryMock.CreateCycleCount mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateCycleCount.expectedInvocations, n)
+	mmCreateCycleCount.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateCycleCount
+}
+
+func (mmCreateCycleCount *mStockServiceRepositoryMockCreateCycleCount) invocationsDone() bool {
+	if len(mmCreateCycleCount.expectations) == 0 && mmCreateCycleCount.defaultExpectation == nil && mmCreateCycleCount.mock.funcCreateCycleCount == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateCycleCount.mock.afterCreateCycleCountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateCycleCount.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateCycleCount implements mm_stocks.StockServiceRepository
+func (mmCreateCycleCount *StockServiceRepositoryMock) CreateCycleCount(ctx context.Context, cycleCount domain.CycleCount) (c2 domain.CycleCount, err error) {
+	mm_atomic.AddUint64(&mmCreateCycleCount.beforeCreateCycleCountCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateCycleCount.afterCreateCycleCountCounter, 1)
+
+	mmCreateCycleCount.t.Helper()
+
+	if mmCreateCycleCount.inspectFuncCreateCycleCount != nil {
+		mmCreateCycleCount.inspectFuncCreateCycleCount(ctx, cycleCount)
+	}
+
+	mm_params := StockServiceRepositoryMockCreateCycleCountParams{ctx, cycleCount}
+
+	// Record call args
+	mmCreateCycleCount.CreateCycleCountMock.mutex.Lock()
+	mmCreateCycleCount.CreateCycleCountMock.callArgs = append(mmCreateCycleCount.CreateCycleCountMock.callArgs, &mm_params)
+	mmCreateCycleCount.CreateCycleCountMock.mutex.Unlock()
+
+	for _, e := range mmCreateCycleCount.CreateCycleCountMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.c2, e.results.err
+		}
+	}
+
+	if mmCreateCycleCount.CreateCycleCountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateCycleCount.CreateCycleCountMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateCycleCount.CreateCycleCountMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateCycleCount.CreateCycleCountMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceRepositoryMockCreateCycleCountParams{ctx, cycleCount}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateCycleCount.t.Errorf("StockServiceRepositoryMock.CreateCycleCount got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateCycleCount.CreateCycleCountMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.cycleCount != nil && !minimock.Equal(*mm_want_ptrs.cycleCount, mm_got.cycleCount) {
+				mmCreateCycleCount.t.Errorf("StockServiceRepositoryMock.CreateCycleCount got unexpected parameter cycleCount, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateCycleCount.CreateCycleCountMock.defaultExpectation.expectationOrigins.originCycleCount, *mm_want_ptrs.cycleCount, mm_got.cycleCount, minimock.Diff(*mm_want_ptrs.cycleCount, mm_got.cycleCount))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateCycleCount.t.Errorf("StockServiceRepositoryMock.CreateCycleCount got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateCycleCount.CreateCycleCountMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateCycleCount.CreateCycleCountMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateCycleCount.t.Fatal("No results are set for the StockServiceRepositoryMock.CreateCycleCount")
+		}
+		return (*mm_results).c2, (*mm_results).err
+	}
+	if mmCreateCycleCount.funcCreateCycleCount != nil {
+		return mmCreateCycleCount.funcCreateCycleCount(ctx, cycleCount)
+	}
+	mmCreateCycleCount.t.Fatalf("Unexpected call to StockServiceRepositoryMock.CreateCycleCount. %v %v", ctx, cycleCount)
+	return
+}
+
+// CreateCycleCountAfterCounter returns a count of finished StockServiceRepositoryMock.CreateCycleCount invocations
+func (mmCreateCycleCount *StockServiceRepositoryMock) CreateCycleCountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateCycleCount.afterCreateCycleCountCounter)
+}
+
+// CreateCycleCountBeforeCounter returns a count of StockServiceRepositoryMock.CreateCycleCount invocations
+func (mmCreateCycleCount *StockServiceRepositoryMock) CreateCycleCountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateCycleCount.beforeCreateCycleCountCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.CreateCycleCount.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateCycleCount *mStockServiceRepositoryMockCreateCycleCount) Calls() []*StockServiceRepositoryMockCreateCycleCountParams {
+	mmCreateCycleCount.mutex.RLock()
+
+	argCopy := make([]*StockServiceRepositoryMockCreateCycleCountParams, len(mmCreateCycleCount.callArgs))
+	copy(argCopy, mmCreateCycleCount.callArgs)
+
+	mmCreateCycleCount.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateCycleCountDone returns true if the count of the CreateCycleCount invocations corresponds
+// the number of defined expectations
+func (m *StockServiceRepositoryMock) MinimockCreateCycleCountDone() bool {
+	if m.CreateCycleCountMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateCycleCountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateCycleCountMock.invocationsDone()
+}
+
+// MinimockCreateCycleCountInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockCreateCycleCountInspect() {
+	for _, e := range m.CreateCycleCountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.CreateCycleCount at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCycleCountCounter := mm_atomic.LoadUint64(&m.afterCreateCycleCountCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateCycleCountMock.defaultExpectation != nil && afterCreateCycleCountCounter < 1 {
+		if m.CreateCycleCountMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.CreateCycleCount at\n%s", m.CreateCycleCountMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.CreateCycleCount at\n%s with params: %#v", m.CreateCycleCountMock.defaultExpectation.expectationOrigins.origin, *m.CreateCycleCountMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateCycleCount != nil && afterCreateCycleCountCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.CreateCycleCount at\n%s", m.funcCreateCycleCountOrigin)
+	}
+
+	if !m.CreateCycleCountMock.invocationsDone() && afterCreateCycleCountCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.CreateCycleCount at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateCycleCountMock.expectedInvocations), m.CreateCycleCountMock.expectedInvocationsOrigin, afterCreateCycleCountCounter)
+	}
+}
+
+type mStockServiceRepositoryMockDeleteStockItemFromStorage struct {
+	optional           bool
+	mock               *StockServiceRepositoryMock
+	defaultExpectation *StockServiceRepositoryMockDeleteStockItemFromStorageExpectation
+	expectations       []*StockServiceRepositoryMockDeleteStockItemFromStorageExpectation
+
+	callArgs []*StockServiceRepositoryMockDeleteStockItemFromStorageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceRepositoryMockDeleteStockItemFromStorageExpectation specifies expectation struct of the StockServiceRepository.DeleteStockItemFromStorage
+type StockServiceRepositoryMockDeleteStockItemFromStorageExpectation struct {
+	mock               *StockServiceRepositoryMock
+	params             *StockServiceRepositoryMockDeleteStockItemFromStorageParams
+	paramPtrs          *StockServiceRepositoryMockDeleteStockItemFromStorageParamPtrs
+	expectationOrigins StockServiceRepositoryMockDeleteStockItemFromStorageExpectationOrigins
+	results            *StockServiceRepositoryMockDeleteStockItemFromStorageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceRepositoryMockDeleteStockItemFromStorageParams contains parameters of the StockServiceRepository.DeleteStockItemFromStorage
+type StockServiceRepositoryMockDeleteStockItemFromStorageParams struct {
+	ctx       context.Context
+	userID    domain.UserID
+	skuID     domain.SKUID
+	deletedBy domain.UserID
+}
+
+// StockServiceRepositoryMockDeleteStockItemFromStorageParamPtrs contains pointers to parameters of the StockServiceRepository.DeleteStockItemFromStorage
+type StockServiceRepositoryMockDeleteStockItemFromStorageParamPtrs struct {
+	ctx       *context.Context
+	userID    *domain.UserID
+	skuID     *domain.SKUID
+	deletedBy *domain.UserID
+}
+
+// StockServiceRepositoryMockDeleteStockItemFromStorageResults contains results of the StockServiceRepository.DeleteStockItemFromStorage
+type StockServiceRepositoryMockDeleteStockItemFromStorageResults struct {
+	sa1 []domain.StockItem
+	err error
+}
+
+// StockServiceRepositoryMockDeleteStockItemFromStorageOrigins contains origins of expectations of the StockServiceRepository.DeleteStockItemFromStorage
+type StockServiceRepositoryMockDeleteStockItemFromStorageExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUserID    string
+	originSkuID     string
+	originDeletedBy string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteStockItemFromStorage *mStockServiceRepositoryMockDeleteStockItemFromStorage) Optional() *mStockServiceRepositoryMockDeleteStockItemFromStorage {
+	mmDeleteStockItemFromStorage.optional = true
+	return mmDeleteStockItemFromStorage
+}
+
+// Expect sets up expected params for StockServiceRepository.DeleteStockItemFromStorage
+func (mmDeleteStockItemFromStorage *mStockServiceRepositoryMockDeleteStockItemFromStorage) Expect(ctx context.Context, userID domain.UserID, skuID domain.SKUID, deletedBy domain.UserID) *mStockServiceRepositoryMockDeleteStockItemFromStorage {
+	if mmDeleteStockItemFromStorage.mock.funcDeleteStockItemFromStorage != nil {
+		mmDeleteStockItemFromStorage.mock.t.Fatalf("StockServiceRepositoryMock.DeleteStockItemFromStorage mock is already set by Set")
+	}
+
+	if mmDeleteStockItemFromStorage.defaultExpectation == nil {
+		mmDeleteStockItemFromStorage.defaultExpectation = &StockServiceRepositoryMockDeleteStockItemFromStorageExpectation{}
+	}
+
+	if mmDeleteStockItemFromStorage.defaultExpectation.paramPtrs != nil {
+		mmDeleteStockItemFromStorage.mock.t.Fatalf("StockServiceRepositoryMock.DeleteStockItemFromStorage mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteStockItemFromStorage.defaultExpectation.params = &StockServiceRepositoryMockDeleteStockItemFromStorageParams{ctx, userID, skuID, deletedBy}
+	mmDeleteStockItemFromStorage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteStockItemFromStorage.expectations {
+		if minimock.Equal(e.params, mmDeleteStockItemFromStorage.defaultExpectation.params) {
+			mmDeleteStockItemFromStorage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteStockItemFromStorage.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteStockItemFromStorage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.DeleteStockItemFromStorage
+func (mmDeleteStockItemFromStorage *mStockServiceRepositoryMockDeleteStockItemFromStorage) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockDeleteStockItemFromStorage {
+	if mmDeleteStockItemFromStorage.mock.funcDeleteStockItemFromStorage != nil {
+		mmDeleteStockItemFromStorage.mock.t.Fatalf("StockServiceRepositoryMock.DeleteStockItemFromStorage mock is already set by Set")
+	}
+
+	if mmDeleteStockItemFromStorage.defaultExpectation == nil {
+		mmDeleteStockItemFromStorage.defaultExpectation = &StockServiceRepositoryMockDeleteStockItemFromStorageExpectation{}
+	}
+
+	if mmDeleteStockItemFromStorage.defaultExpectation.params != nil {
+		mmDeleteStockItemFromStorage.mock.t.Fatalf("StockServiceRepositoryMock.DeleteStockItemFromStorage mock is already set by Expect")
+	}
+
+	if mmDeleteStockItemFromStorage.defaultExpectation.paramPtrs == nil {
+		mmDeleteStockItemFromStorage.defaultExpectation.paramPtrs = &StockServiceRepositoryMockDeleteStockItemFromStorageParamPtrs{}
+	}
+	mmDeleteStockItemFromStorage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteStockItemFromStorage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteStockItemFromStorage
+}
+
+// ExpectUserIDParam2 sets up expected param userID for StockServiceRepository.DeleteStockItemFromStorage
+func (mmDeleteStockItemFromStorage *mStockServiceRepositoryMockDeleteStockItemFromStorage) ExpectUserIDParam2(userID domain.UserID) *mStockServiceRepositoryMockDeleteStockItemFromStorage {
+	if mmDeleteStockItemFromStorage.mock.funcDeleteStockItemFromStorage != nil {
+		mmDeleteStockItemFromStorage.mock.t.Fatalf("StockServiceRepositoryMock.DeleteStockItemFromStorage mock is already set by Set")
+	}
+
+	if mmDeleteStockItemFromStorage.defaultExpectation == nil {
+		mmDeleteStockItemFromStorage.defaultExpectation = &StockServiceRepositoryMockDeleteStockItemFromStorageExpectation{}
+	}
+
+	if mmDeleteStockItemFromStorage.defaultExpectation.params != nil {
+		mmDeleteStockItemFromStorage.mock.t.Fatalf("StockServiceRepositoryMock.DeleteStockItemFromStorage mock is already set by Expect")
+	}
+
+	if mmDeleteStockItemFromStorage.defaultExpectation.paramPtrs == nil {
+		mmDeleteStockItemFromStorage.defaultExpectation.paramPtrs = &StockServiceRepositoryMockDeleteStockItemFromStorageParamPtrs{}
+	}
+	mmDeleteStockItemFromStorage.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteStockItemFromStorage.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteStockItemFromStorage
+}
+
+// ExpectSkuIDParam3 sets up expected param skuID for StockServiceRepository.DeleteStockItemFromStorage
+func (mmDeleteStockItemFromStorage *mStockServiceRepositoryMockDeleteStockItemFromStorage) ExpectSkuIDParam3(skuID domain.SKUID) *mStockServiceRepositoryMockDeleteStockItemFromStorage {
+	if mmDeleteStockItemFromStorage.mock.funcDeleteStockItemFromStorage != nil {
+		mmDeleteStockItemFromStorage.mock.t.Fatalf("StockServiceRepositoryMock.DeleteStockItemFromStorage mock is already set by Set")
+	}
+
+	if mmDeleteStockItemFromStorage.defaultExpectation == nil {
+		mmDeleteStockItemFromStorage.defaultExpectation = &StockServiceRepositoryMockDeleteStockItemFromStorageExpectation{}
+	}
+
+	if mmDeleteStockItemFromStorage.defaultExpectation.params != nil {
+		mmDeleteStockItemFromStorage.mock.t.Fatalf("StockServiceRepositoryMock.DeleteStockItemFromStorage mock is already set by Expect")
+	}
+
+	if mmDeleteStockItemFromStorage.defaultExpectation.paramPtrs == nil {
+		mmDeleteStockItemFromStorage.defaultExpectation.paramPtrs = &StockServiceRepositoryMockDeleteStockItemFromStorageParamPtrs{}
+	}
+	mmDeleteStockItemFromStorage.defaultExpectation.paramPtrs.skuID = &skuID
+	mmDeleteStockItemFromStorage.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmDeleteStockItemFromStorage
+}
+
+// ExpectDeletedByParam4 sets up expected param deletedBy for StockServiceRepository.DeleteStockItemFromStorage
+func (mmDeleteStockItemFromStorage *mStockServiceRepositoryMockDeleteStockItemFromStorage) ExpectDeletedByParam4(deletedBy domain.UserID) *mStockServiceRepositoryMockDeleteStockItemFromStorage {
+	if mmDeleteStockItemFromStorage.mock.funcDeleteStockItemFromStorage != nil {
+		mmDeleteStockItemFromStorage.mock.t.Fatalf("StockServiceRepositoryMock.DeleteStockItemFromStorage mock is already set by Set")
+	}
+
+	if mmDeleteStockItemFromStorage.defaultExpectation == nil {
+		mmDeleteStockItemFromStorage.defaultExpectation = &StockServiceRepositoryMockDeleteStockItemFromStorageExpectation{}
+	}
+
+	if mmDeleteStockItemFromStorage.defaultExpectation.params != nil {
+		mmDeleteStockItemFromStorage.mock.t.Fatalf("StockServiceRepositoryMock.DeleteStockItemFromStorage mock is already set by Expect")
+	}
+
+	if mmDeleteStockItemFromStorage.defaultExpectation.paramPtrs == nil {
+		mmDeleteStockItemFromStorage.defaultExpectation.paramPtrs = &StockServiceRepositoryMockDeleteStockItemFromStorageParamPtrs{}
+	}
+	mmDeleteStockItemFromStorage.defaultExpectation.paramPtrs.deletedBy = &deletedBy
+	mmDeleteStockItemFromStorage.defaultExpectation.expectationOrigins.originDeletedBy = minimock.CallerInfo(1)
+
+	return mmDeleteStockItemFromStorage
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.DeleteStockItemFromStorage
+func (mmDeleteStockItemFromStorage *mStockServiceRepositoryMockDeleteStockItemFromStorage) Inspect(f func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, deletedBy domain.UserID)) *mStockServiceRepositoryMockDeleteStockItemFromStorage {
+	if mmDeleteStockItemFromStorage.mock.inspectFuncDeleteStockItemFromStorage != nil {
+		mmDeleteStockItemFromStorage.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.DeleteStockItemFromStorage")
+	}
+
+	mmDeleteStockItemFromStorage.mock.inspectFuncDeleteStockItemFromStorage = f
+
+	return mmDeleteStockItemFromStorage
+}
+
+// Return sets up results that will be returned by StockServiceRepository.DeleteStockItemFromStorage
+func (mmDeleteStockItemFromStorage *mStockServiceRepositoryMockDeleteStockItemFromStorage) Return(sa1 []domain.StockItem, err error) *StockServiceRepositoryMock {
+	if mmDeleteStockItemFromStorage.mock.funcDeleteStockItemFromStorage != nil {
+		mmDeleteStockItemFromStorage.mock.t.Fatalf("StockServiceRepositoryMock.DeleteStockItemFromStorage mock is already set by Set")
+	}
+
+	if mmDeleteStockItemFromStorage.defaultExpectation == nil {
+		mmDeleteStockItemFromStorage.defaultExpectation = &StockServiceRepositoryMockDeleteStockItemFromStorageExpectation{mock: mmDeleteStockItemFromStorage.mock}
+	}
+	mmDeleteStockItemFromStorage.defaultExpectation.results = &StockServiceRepositoryMockDeleteStockItemFromStorageResults{sa1, err}
+	mmDeleteStockItemFromStorage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteStockItemFromStorage.mock
+}
+
+// Set uses given function f to mock the StockServiceRepository.DeleteStockItemFromStorage method
+func (mmDeleteStockItemFromStorage *mStockServiceRepositoryMockDeleteStockItemFromStorage) Set(f func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, deletedBy domain.UserID) (sa1 []domain.StockItem, err error)) *StockServiceRepositoryMock {
+	if mmDeleteStockItemFromStorage.defaultExpectation != nil {
+		mmDeleteStockItemFromStorage.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.DeleteStockItemFromStorage method")
+	}
+
+	if len(mmDeleteStockItemFromStorage.expectations) > 0 {
+		mmDeleteStockItemFromStorage.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.DeleteStockItemFromStorage method")
+	}
+
+	mmDeleteStockItemFromStorage.mock.funcDeleteStockItemFromStorage = f
+	mmDeleteStockItemFromStorage.mock.funcDeleteStockItemFromStorageOrigin = minimock.CallerInfo(1)
+	return mmDeleteStockItemFromStorage.mock
+}
+
+// When sets expectation for the StockServiceRepository.DeleteStockItemFromStorage which will trigger the result defined by the following
+// Then helper
+func (mmDeleteStockItemFromStorage *mStockServiceRepositoryMockDeleteStockItemFromStorage) When(ctx context.Context, userID domain.UserID, skuID domain.SKUID, deletedBy domain.UserID) *StockServiceRepositoryMockDeleteStockItemFromStorageExpectation {
 	if mmDeleteStockItemFromStorage.mock.funcDeleteStockItemFromStorage != nil {
 		mmDeleteStockItemFromStorage.mock.t.Fatalf("StockServiceRepositoryMock.DeleteStockItemFromStorage mock is already set by Set")
 	}
 
-	if mmDeleteStockItemFromStorage.defaultExpectation == nil {
-		mmDeleteStockItemFromStorage.defaultExpectation = &StockServiceRepositoryMockDeleteStockItemFromStorageExpectation{}
+	expectation := &StockServiceRepositoryMockDeleteStockItemFromStorageExpectation{
+		mock:               mmDeleteStockItemFromStorage.mock,
+		params:             &StockServiceRepositoryMockDeleteStockItemFromStorageParams{ctx, userID, skuID, deletedBy},
+		expectationOrigins: StockServiceRepositoryMockDeleteStockItemFromStorageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteStockItemFromStorage.expectations = append(mmDeleteStockItemFromStorage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceRepository.DeleteStockItemFromStorage return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockDeleteStockItemFromStorageExpectation) Then(sa1 []domain.StockItem, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockDeleteStockItemFromStorageResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceRepository.DeleteStockItemFromStorage should be invoked
+func (mmDeleteStockItemFromStorage *mStockServiceRepositoryMockDeleteStockItemFromStorage) Times(n uint64) *mStockServiceRepositoryMockDeleteStockItemFromStorage {
+	if n == 0 {
+		mmDeleteStockItemFromStorage.mock.t.Fatalf("Times of StockServiceRepositoryMock.DeleteStockItemFromStorage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteStockItemFromStorage.expectedInvocations, n)
+	mmDeleteStockItemFromStorage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteStockItemFromStorage
+}
+
+func (mmDeleteStockItemFromStorage *mStockServiceRepositoryMockDeleteStockItemFromStorage) invocationsDone() bool {
+	if len(mmDeleteStockItemFromStorage.expectations) == 0 && mmDeleteStockItemFromStorage.defaultExpectation == nil && mmDeleteStockItemFromStorage.mock.funcDeleteStockItemFromStorage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteStockItemFromStorage.mock.afterDeleteStockItemFromStorageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteStockItemFromStorage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteStockItemFromStorage implements mm_stocks.StockServiceRepository
+func (mmDeleteStockItemFromStorage *StockServiceRepositoryMock) DeleteStockItemFromStorage(ctx context.Context, userID domain.UserID, skuID domain.SKUID, deletedBy domain.UserID) (sa1 []domain.StockItem, err error) {
+	mm_atomic.AddUint64(&mmDeleteStockItemFromStorage.beforeDeleteStockItemFromStorageCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteStockItemFromStorage.afterDeleteStockItemFromStorageCounter, 1)
+
+	mmDeleteStockItemFromStorage.t.Helper()
+
+	if mmDeleteStockItemFromStorage.inspectFuncDeleteStockItemFromStorage != nil {
+		mmDeleteStockItemFromStorage.inspectFuncDeleteStockItemFromStorage(ctx, userID, skuID, deletedBy)
+	}
+
+	mm_params := StockServiceRepositoryMockDeleteStockItemFromStorageParams{ctx, userID, skuID, deletedBy}
+
+	// Record call args
+	mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.mutex.Lock()
+	mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.callArgs = append(mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.callArgs, &mm_params)
+	mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.mutex.Unlock()
+
+	for _, e := range mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceRepositoryMockDeleteStockItemFromStorageParams{ctx, userID, skuID, deletedBy}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteStockItemFromStorage.t.Errorf("StockServiceRepositoryMock.DeleteStockItemFromStorage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteStockItemFromStorage.t.Errorf("StockServiceRepositoryMock.DeleteStockItemFromStorage got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmDeleteStockItemFromStorage.t.Errorf("StockServiceRepositoryMock.DeleteStockItemFromStorage got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.deletedBy != nil && !minimock.Equal(*mm_want_ptrs.deletedBy, mm_got.deletedBy) {
+				mmDeleteStockItemFromStorage.t.Errorf("StockServiceRepositoryMock.DeleteStockItemFromStorage got unexpected parameter deletedBy, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.defaultExpectation.expectationOrigins.originDeletedBy, *mm_want_ptrs.deletedBy, mm_got.deletedBy, minimock.Diff(*mm_want_ptrs.deletedBy, mm_got.deletedBy))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteStockItemFromStorage.t.Errorf("StockServiceRepositoryMock.DeleteStockItemFromStorage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteStockItemFromStorage.t.Fatal("No results are set for the StockServiceRepositoryMock.DeleteStockItemFromStorage")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmDeleteStockItemFromStorage.funcDeleteStockItemFromStorage != nil {
+		return mmDeleteStockItemFromStorage.funcDeleteStockItemFromStorage(ctx, userID, skuID, deletedBy)
+	}
+	mmDeleteStockItemFromStorage.t.Fatalf("Unexpected call to StockServiceRepositoryMock.DeleteStockItemFromStorage. %v %v %v %v", ctx, userID, skuID, deletedBy)
+	return
+}
+
+// DeleteStockItemFromStorageAfterCounter returns a count of finished StockServiceRepositoryMock.DeleteStockItemFromStorage invocations
+func (mmDeleteStockItemFromStorage *StockServiceRepositoryMock) DeleteStockItemFromStorageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteStockItemFromStorage.afterDeleteStockItemFromStorageCounter)
+}
+
+// DeleteStockItemFromStorageBeforeCounter returns a count of StockServiceRepositoryMock.DeleteStockItemFromStorage invocations
+func (mmDeleteStockItemFromStorage *StockServiceRepositoryMock) DeleteStockItemFromStorageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteStockItemFromStorage.beforeDeleteStockItemFromStorageCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.DeleteStockItemFromStorage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteStockItemFromStorage *mStockServiceRepositoryMockDeleteStockItemFromStorage) Calls() []*StockServiceRepositoryMockDeleteStockItemFromStorageParams {
+	mmDeleteStockItemFromStorage.mutex.RLock()
+
+	argCopy := make([]*StockServiceRepositoryMockDeleteStockItemFromStorageParams, len(mmDeleteStockItemFromStorage.callArgs))
+	copy(argCopy, mmDeleteStockItemFromStorage.callArgs)
+
+	mmDeleteStockItemFromStorage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteStockItemFromStorageDone returns true if the count of the DeleteStockItemFromStorage invocations corresponds
+// the number of defined expectations
+func (m *StockServiceRepositoryMock) MinimockDeleteStockItemFromStorageDone() bool {
+	if m.DeleteStockItemFromStorageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteStockItemFromStorageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteStockItemFromStorageMock.invocationsDone()
+}
+
+// MinimockDeleteStockItemFromStorageInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockDeleteStockItemFromStorageInspect() {
+	for _, e := range m.DeleteStockItemFromStorageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.DeleteStockItemFromStorage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteStockItemFromStorageCounter := mm_atomic.LoadUint64(&m.afterDeleteStockItemFromStorageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteStockItemFromStorageMock.defaultExpectation != nil && afterDeleteStockItemFromStorageCounter < 1 {
+		if m.DeleteStockItemFromStorageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.DeleteStockItemFromStorage at\n%s", m.DeleteStockItemFromStorageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.DeleteStockItemFromStorage at\n%s with params: %#v", m.DeleteStockItemFromStorageMock.defaultExpectation.expectationOrigins.origin, *m.DeleteStockItemFromStorageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteStockItemFromStorage != nil && afterDeleteStockItemFromStorageCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.DeleteStockItemFromStorage at\n%s", m.funcDeleteStockItemFromStorageOrigin)
+	}
+
+	if !m.DeleteStockItemFromStorageMock.invocationsDone() && afterDeleteStockItemFromStorageCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.DeleteStockItemFromStorage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteStockItemFromStorageMock.expectedInvocations), m.DeleteStockItemFromStorageMock.expectedInvocationsOrigin, afterDeleteStockItemFromStorageCounter)
+	}
+}
+
+type mStockServiceRepositoryMockExpireDueLots struct {
+	optional           bool
+	mock               *StockServiceRepositoryMock
+	defaultExpectation *StockServiceRepositoryMockExpireDueLotsExpectation
+	expectations       []*StockServiceRepositoryMockExpireDueLotsExpectation
+
+	callArgs []*StockServiceRepositoryMockExpireDueLotsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceRepositoryMockExpireDueLotsExpectation specifies expectation struct of the StockServiceRepository.ExpireDueLots
+type StockServiceRepositoryMockExpireDueLotsExpectation struct {
+	mock               *StockServiceRepositoryMock
+	params             *StockServiceRepositoryMockExpireDueLotsParams
+	paramPtrs          *StockServiceRepositoryMockExpireDueLotsParamPtrs
+	expectationOrigins StockServiceRepositoryMockExpireDueLotsExpectationOrigins
+	results            *StockServiceRepositoryMockExpireDueLotsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceRepositoryMockExpireDueLotsParams contains parameters of the StockServiceRepository.ExpireDueLots
+type StockServiceRepositoryMockExpireDueLotsParams struct {
+	ctx   context.Context
+	limit int
+}
+
+// StockServiceRepositoryMockExpireDueLotsParamPtrs contains pointers to parameters of the StockServiceRepository.ExpireDueLots
+type StockServiceRepositoryMockExpireDueLotsParamPtrs struct {
+	ctx   *context.Context
+	limit *int
+}
+
+// StockServiceRepositoryMockExpireDueLotsResults contains results of the StockServiceRepository.ExpireDueLots
+type StockServiceRepositoryMockExpireDueLotsResults struct {
+	ea1 []domain.ExpiredLot
+	err error
+}
+
+// StockServiceRepositoryMockExpireDueLotsOrigins contains origins of expectations of the StockServiceRepository.ExpireDueLots
+type StockServiceRepositoryMockExpireDueLotsExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originLimit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExpireDueLots *mStockServiceRepositoryMockExpireDueLots) Optional() *mStockServiceRepositoryMockExpireDueLots {
+	mmExpireDueLots.optional = true
+	return mmExpireDueLots
+}
+
+// Expect sets up expected params for StockServiceRepository.ExpireDueLots
+func (mmExpireDueLots *mStockServiceRepositoryMockExpireDueLots) Expect(ctx context.Context, limit int) *mStockServiceRepositoryMockExpireDueLots {
+	if mmExpireDueLots.mock.funcExpireDueLots != nil {
+		mmExpireDueLots.mock.t.Fatalf("StockServiceRepositoryMock.ExpireDueLots mock is already set by Set")
+	}
+
+	if mmExpireDueLots.defaultExpectation == nil {
+		mmExpireDueLots.defaultExpectation = &StockServiceRepositoryMockExpireDueLotsExpectation{}
+	}
+
+	if mmExpireDueLots.defaultExpectation.paramPtrs != nil {
+		mmExpireDueLots.mock.t.Fatalf("StockServiceRepositoryMock.ExpireDueLots mock is already set by ExpectParams functions")
+	}
+
+	mmExpireDueLots.defaultExpectation.params = &StockServiceRepositoryMockExpireDueLotsParams{ctx, limit}
+	mmExpireDueLots.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExpireDueLots.expectations {
+		if minimock.Equal(e.params, mmExpireDueLots.defaultExpectation.params) {
+			mmExpireDueLots.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExpireDueLots.defaultExpectation.params)
+		}
+	}
+
+	return mmExpireDueLots
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.ExpireDueLots
+func (mmExpireDueLots *mStockServiceRepositoryMockExpireDueLots) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockExpireDueLots {
+	if mmExpireDueLots.mock.funcExpireDueLots != nil {
+		mmExpireDueLots.mock.t.Fatalf("StockServiceRepositoryMock.ExpireDueLots mock is already set by Set")
+	}
+
+	if mmExpireDueLots.defaultExpectation == nil {
+		mmExpireDueLots.defaultExpectation = &StockServiceRepositoryMockExpireDueLotsExpectation{}
+	}
+
+	if mmExpireDueLots.defaultExpectation.params != nil {
+		mmExpireDueLots.mock.t.Fatalf("StockServiceRepositoryMock.ExpireDueLots mock is already set by Expect")
+	}
+
+	if mmExpireDueLots.defaultExpectation.paramPtrs == nil {
+		mmExpireDueLots.defaultExpectation.paramPtrs = &StockServiceRepositoryMockExpireDueLotsParamPtrs{}
+	}
+	mmExpireDueLots.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExpireDueLots.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExpireDueLots
+}
+
+// ExpectLimitParam2 sets up expected param limit for StockServiceRepository.ExpireDueLots
+func (mmExpireDueLots *mStockServiceRepositoryMockExpireDueLots) ExpectLimitParam2(limit int) *mStockServiceRepositoryMockExpireDueLots {
+	if mmExpireDueLots.mock.funcExpireDueLots != nil {
+		mmExpireDueLots.mock.t.Fatalf("StockServiceRepositoryMock.ExpireDueLots mock is already set by Set")
+	}
+
+	if mmExpireDueLots.defaultExpectation == nil {
+		mmExpireDueLots.defaultExpectation = &StockServiceRepositoryMockExpireDueLotsExpectation{}
+	}
+
+	if mmExpireDueLots.defaultExpectation.params != nil {
+		mmExpireDueLots.mock.t.Fatalf("StockServiceRepositoryMock.ExpireDueLots mock is already set by Expect")
+	}
+
+	if mmExpireDueLots.defaultExpectation.paramPtrs == nil {
+		mmExpireDueLots.defaultExpectation.paramPtrs = &StockServiceRepositoryMockExpireDueLotsParamPtrs{}
+	}
+	mmExpireDueLots.defaultExpectation.paramPtrs.limit = &limit
+	mmExpireDueLots.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmExpireDueLots
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.ExpireDueLots
+func (mmExpireDueLots *mStockServiceRepositoryMockExpireDueLots) Inspect(f func(ctx context.Context, limit int)) *mStockServiceRepositoryMockExpireDueLots {
+	if mmExpireDueLots.mock.inspectFuncExpireDueLots != nil {
+		mmExpireDueLots.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.ExpireDueLots")
+	}
+
+	mmExpireDueLots.mock.inspectFuncExpireDueLots = f
+
+	return mmExpireDueLots
+}
+
+// Return sets up results that will be returned by StockServiceRepository.ExpireDueLots
+func (mmExpireDueLots *mStockServiceRepositoryMockExpireDueLots) Return(ea1 []domain.ExpiredLot, err error) *StockServiceRepositoryMock {
+	if mmExpireDueLots.mock.funcExpireDueLots != nil {
+		mmExpireDueLots.mock.t.Fatalf("StockServiceRepositoryMock.ExpireDueLots mock is already set by Set")
+	}
+
+	if mmExpireDueLots.defaultExpectation == nil {
+		mmExpireDueLots.defaultExpectation = &StockServiceRepositoryMockExpireDueLotsExpectation{mock: mmExpireDueLots.mock}
+	}
+	mmExpireDueLots.defaultExpectation.results = &StockServiceRepositoryMockExpireDueLotsResults{ea1, err}
+	mmExpireDueLots.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExpireDueLots.mock
+}
+
+// Set uses given function f to mock the StockServiceRepository.ExpireDueLots method
+func (mmExpireDueLots *mStockServiceRepositoryMockExpireDueLots) Set(f func(ctx context.Context, limit int) (ea1 []domain.ExpiredLot, err error)) *StockServiceRepositoryMock {
+	if mmExpireDueLots.defaultExpectation != nil {
+		mmExpireDueLots.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.ExpireDueLots method")
+	}
+
+	if len(mmExpireDueLots.expectations) > 0 {
+		mmExpireDueLots.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.ExpireDueLots method")
+	}
+
+	mmExpireDueLots.mock.funcExpireDueLots = f
+	mmExpireDueLots.mock.funcExpireDueLotsOrigin = minimock.CallerInfo(1)
+	return mmExpireDueLots.mock
+}
+
+// When sets expectation for the StockServiceRepository.ExpireDueLots which will trigger the result defined by the following
+// Then helper
+func (mmExpireDueLots *mStockServiceRepositoryMockExpireDueLots) When(ctx context.Context, limit int) *StockServiceRepositoryMockExpireDueLotsExpectation {
+	if mmExpireDueLots.mock.funcExpireDueLots != nil {
+		mmExpireDueLots.mock.t.Fatalf("StockServiceRepositoryMock.ExpireDueLots mock is already set by Set")
+	}
+
+	expectation := &StockServiceRepositoryMockExpireDueLotsExpectation{
+		mock:               mmExpireDueLots.mock,
+		params:             &StockServiceRepositoryMockExpireDueLotsParams{ctx, limit},
+		expectationOrigins: StockServiceRepositoryMockExpireDueLotsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExpireDueLots.expectations = append(mmExpireDueLots.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceRepository.ExpireDueLots return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockExpireDueLotsExpectation) Then(ea1 []domain.ExpiredLot, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockExpireDueLotsResults{ea1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceRepository.ExpireDueLots should be invoked
+func (mmExpireDueLots *mStockServiceRepositoryMockExpireDueLots) Times(n uint64) *mStockServiceRepositoryMockExpireDueLots {
+	if n == 0 {
+		mmExpireDueLots.mock.t.Fatalf("Times of StockServiceRepositoryMock.ExpireDueLots mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExpireDueLots.expectedInvocations, n)
+	mmExpireDueLots.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExpireDueLots
+}
+
+func (mmExpireDueLots *mStockServiceRepositoryMockExpireDueLots) invocationsDone() bool {
+	if len(mmExpireDueLots.expectations) == 0 && mmExpireDueLots.defaultExpectation == nil && mmExpireDueLots.mock.funcExpireDueLots == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExpireDueLots.mock.afterExpireDueLotsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExpireDueLots.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ExpireDueLots implements mm_stocks.StockServiceRepository
+func (mmExpireDueLots *StockServiceRepositoryMock) ExpireDueLots(ctx context.Context, limit int) (ea1 []domain.ExpiredLot, err error) {
+	mm_atomic.AddUint64(&mmExpireDueLots.beforeExpireDueLotsCounter, 1)
+	defer mm_atomic.AddUint64(&mmExpireDueLots.afterExpireDueLotsCounter, 1)
+
+	mmExpireDueLots.t.Helper()
+
+	if mmExpireDueLots.inspectFuncExpireDueLots != nil {
+		mmExpireDueLots.inspectFuncExpireDueLots(ctx, limit)
+	}
+
+	mm_params := StockServiceRepositoryMockExpireDueLotsParams{ctx, limit}
+
+	// Record call args
+	mmExpireDueLots.ExpireDueLotsMock.mutex.Lock()
+	mmExpireDueLots.ExpireDueLotsMock.callArgs = append(mmExpireDueLots.ExpireDueLotsMock.callArgs, &mm_params)
+	mmExpireDueLots.ExpireDueLotsMock.mutex.Unlock()
+
+	for _, e := range mmExpireDueLots.ExpireDueLotsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ea1, e.results.err
+		}
+	}
+
+	if mmExpireDueLots.ExpireDueLotsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExpireDueLots.ExpireDueLotsMock.defaultExpectation.Counter, 1)
+		mm_want := mmExpireDueLots.ExpireDueLotsMock.defaultExpectation.params
+		mm_want_ptrs := mmExpireDueLots.ExpireDueLotsMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceRepositoryMockExpireDueLotsParams{ctx, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExpireDueLots.t.Errorf("StockServiceRepositoryMock.ExpireDueLots got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExpireDueLots.ExpireDueLotsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmExpireDueLots.t.Errorf("StockServiceRepositoryMock.ExpireDueLots got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExpireDueLots.ExpireDueLotsMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExpireDueLots.t.Errorf("StockServiceRepositoryMock.ExpireDueLots got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExpireDueLots.ExpireDueLotsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExpireDueLots.ExpireDueLotsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExpireDueLots.t.Fatal("No results are set for the StockServiceRepositoryMock.ExpireDueLots")
+		}
+		return (*mm_results).ea1, (*mm_results).err
+	}
+	if mmExpireDueLots.funcExpireDueLots != nil {
+		return mmExpireDueLots.funcExpireDueLots(ctx, limit)
+	}
+	mmExpireDueLots.t.Fatalf("Unexpected call to StockServiceRepositoryMock.ExpireDueLots. %v %v", ctx, limit)
+	return
+}
+
+// ExpireDueLotsAfterCounter returns a count of finished StockServiceRepositoryMock.ExpireDueLots invocations
+func (mmExpireDueLots *StockServiceRepositoryMock) ExpireDueLotsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExpireDueLots.afterExpireDueLotsCounter)
+}
+
+// ExpireDueLotsBeforeCounter returns a count of StockServiceRepositoryMock.ExpireDueLots invocations
+func (mmExpireDueLots *StockServiceRepositoryMock) ExpireDueLotsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExpireDueLots.beforeExpireDueLotsCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.ExpireDueLots.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExpireDueLots *mStockServiceRepositoryMockExpireDueLots) Calls() []*StockServiceRepositoryMockExpireDueLotsParams {
+	mmExpireDueLots.mutex.RLock()
+
+	argCopy := make([]*StockServiceRepositoryMockExpireDueLotsParams, len(mmExpireDueLots.callArgs))
+	copy(argCopy, mmExpireDueLots.callArgs)
+
+	mmExpireDueLots.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExpireDueLotsDone returns true if the count of the ExpireDueLots invocations corresponds
+// the number of defined expectations
+func (m *StockServiceRepositoryMock) MinimockExpireDueLotsDone() bool {
+	if m.ExpireDueLotsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExpireDueLotsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExpireDueLotsMock.invocationsDone()
+}
+
+// MinimockExpireDueLotsInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockExpireDueLotsInspect() {
+	for _, e := range m.ExpireDueLotsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ExpireDueLots at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExpireDueLotsCounter := mm_atomic.LoadUint64(&m.afterExpireDueLotsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExpireDueLotsMock.defaultExpectation != nil && afterExpireDueLotsCounter < 1 {
+		if m.ExpireDueLotsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ExpireDueLots at\n%s", m.ExpireDueLotsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ExpireDueLots at\n%s with params: %#v", m.ExpireDueLotsMock.defaultExpectation.expectationOrigins.origin, *m.ExpireDueLotsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExpireDueLots != nil && afterExpireDueLotsCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.ExpireDueLots at\n%s", m.funcExpireDueLotsOrigin)
+	}
+
+	if !m.ExpireDueLotsMock.invocationsDone() && afterExpireDueLotsCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.ExpireDueLots at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExpireDueLotsMock.expectedInvocations), m.ExpireDueLotsMock.expectedInvocationsOrigin, afterExpireDueLotsCounter)
+	}
+}
+
+type mStockServiceRepositoryMockGetCycleCount struct {
+	optional           bool
+	mock               *StockServiceRepositoryMock
+	defaultExpectation *StockServiceRepositoryMockGetCycleCountExpectation
+	expectations       []*StockServiceRepositoryMockGetCycleCountExpectation
+
+	callArgs []*StockServiceRepositoryMockGetCycleCountParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceRepositoryMockGetCycleCountExpectation specifies expectation struct of the StockServiceRepository.GetCycleCount
+type StockServiceRepositoryMockGetCycleCountExpectation struct {
+	mock               *StockServiceRepositoryMock
+	params             *StockServiceRepositoryMockGetCycleCountParams
+	paramPtrs          *StockServiceRepositoryMockGetCycleCountParamPtrs
+	expectationOrigins StockServiceRepositoryMockGetCycleCountExpectationOrigins
+	results            *StockServiceRepositoryMockGetCycleCountResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceRepositoryMockGetCycleCountParams contains parameters of the StockServiceRepository.GetCycleCount
+type StockServiceRepositoryMockGetCycleCountParams struct {
+	ctx          context.Context
+	cycleCountID domain.CycleCountID
+}
+
+// StockServiceRepositoryMockGetCycleCountParamPtrs contains pointers to parameters of the StockServiceRepository.GetCycleCount
+type StockServiceRepositoryMockGetCycleCountParamPtrs struct {
+	ctx          *context.Context
+	cycleCountID *domain.CycleCountID
+}
+
+// StockServiceRepositoryMockGetCycleCountResults contains results of the StockServiceRepository.GetCycleCount
+type StockServiceRepositoryMockGetCycleCountResults struct {
+	c2  domain.CycleCount
+	err error
+}
+
+// StockServiceRepositoryMockGetCycleCountOrigins contains origins of expectations of the StockServiceRepository.GetCycleCount
+type StockServiceRepositoryMockGetCycleCountExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originCycleCountID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCycleCount *mStockServiceRepositoryMockGetCycleCount) Optional() *mStockServiceRepositoryMockGetCycleCount {
+	mmGetCycleCount.optional = true
+	return mmGetCycleCount
+}
+
+// Expect sets up expected params for StockServiceRepository.GetCycleCount
+func (mmGetCycleCount *mStockServiceRepositoryMockGetCycleCount) Expect(ctx context.Context, cycleCountID domain.CycleCountID) *mStockServiceRepositoryMockGetCycleCount {
+	if mmGetCycleCount.mock.funcGetCycleCount != nil {
+		mmGetCycleCount.mock.t.Fatalf("StockServiceRepositoryMock.GetCycleCount mock is already set by Set")
+	}
+
+	if mmGetCycleCount.defaultExpectation == nil {
+		mmGetCycleCount.defaultExpectation = &StockServiceRepositoryMockGetCycleCountExpectation{}
+	}
+
+	if mmGetCycleCount.defaultExpectation.paramPtrs != nil {
+		mmGetCycleCount.mock.t.Fatalf("StockServiceRepositoryMock.GetCycleCount mock is already set by ExpectParams functions")
+	}
+
+	mmGetCycleCount.defaultExpectation.params = &StockServiceRepositoryMockGetCycleCountParams{ctx, cycleCountID}
+	mmGetCycleCount.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCycleCount.expectations {
+		if minimock.Equal(e.params, mmGetCycleCount.defaultExpectation.params) {
+			mmGetCycleCount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCycleCount.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCycleCount
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.GetCycleCount
+func (mmGetCycleCount *mStockServiceRepositoryMockGetCycleCount) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockGetCycleCount {
+	if mmGetCycleCount.mock.funcGetCycleCount != nil {
+		mmGetCycleCount.mock.t.Fatalf("StockServiceRepositoryMock.GetCycleCount mock is already set by Set")
+	}
+
+	if mmGetCycleCount.defaultExpectation == nil {
+		mmGetCycleCount.defaultExpectation = &StockServiceRepositoryMockGetCycleCountExpectation{}
+	}
+
+	if mmGetCycleCount.defaultExpectation.params != nil {
+		mmGetCycleCount.mock.t.Fatalf("StockServiceRepositoryMock.GetCycleCount mock is already set by Expect")
+	}
+
+	if mmGetCycleCount.defaultExpectation.paramPtrs == nil {
+		mmGetCycleCount.defaultExpectation.paramPtrs = &StockServiceRepositoryMockGetCycleCountParamPtrs{}
+	}
+	mmGetCycleCount.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCycleCount.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCycleCount
+}
+
+// ExpectCycleCountIDParam2 sets up expected param cycleCountID for StockServiceRepository.GetCycleCount
+func (mmGetCycleCount *mStockServiceRepositoryMockGetCycleCount) ExpectCycleCountIDParam2(cycleCountID domain.CycleCountID) *mStockServiceRepositoryMockGetCycleCount {
+	if mmGetCycleCount.mock.funcGetCycleCount != nil {
+		mmGetCycleCount.mock.t.Fatalf("StockServiceRepositoryMock.GetCycleCount mock is already set by Set")
+	}
+
+	if mmGetCycleCount.defaultExpectation == nil {
+		mmGetCycleCount.defaultExpectation = &StockServiceRepositoryMockGetCycleCountExpectation{}
+	}
+
+	if mmGetCycleCount.defaultExpectation.params != nil {
+		mmGetCycleCount.mock.t.Fatalf("StockServiceRepositoryMock.GetCycleCount mock is already set by Expect")
+	}
+
+	if mmGetCycleCount.defaultExpectation.paramPtrs == nil {
+		mmGetCycleCount.defaultExpectation.paramPtrs = &StockServiceRepositoryMockGetCycleCountParamPtrs{}
+	}
+	mmGetCycleCount.defaultExpectation.paramPtrs.cycleCountID = &cycleCountID
+	mmGetCycleCount.defaultExpectation.expectationOrigins.originCycleCountID = minimock.CallerInfo(1)
+
+	return mmGetCycleCount
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.GetCycleCount
+func (mmGetCycleCount *mStockServiceRepositoryMockGetCycleCount) Inspect(f func(ctx context.Context, cycleCountID domain.CycleCountID)) *mStockServiceRepositoryMockGetCycleCount {
+	if mmGetCycleCount.mock.inspectFuncGetCycleCount != nil {
+		mmGetCycleCount.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.GetCycleCount")
+	}
+
+	mmGetCycleCount.mock.inspectFuncGetCycleCount = f
+
+	return mmGetCycleCount
+}
+
+// Return sets up results that will be returned by StockServiceRepository.GetCycleCount
+func (mmGetCycleCount *mStockServiceRepositoryMockGetCycleCount) Return(c2 domain.CycleCount, err error) *StockServiceRepositoryMock {
+	if mmGetCycleCount.mock.funcGetCycleCount != nil {
+		mmGetCycleCount.mock.t.Fatalf("StockServiceRepositoryMock.GetCycleCount mock is already set by Set")
+	}
+
+	if mmGetCycleCount.defaultExpectation == nil {
+		mmGetCycleCount.defaultExpectation = &StockServiceRepositoryMockGetCycleCountExpectation{mock: mmGetCycleCount.mock}
+	}
+	mmGetCycleCount.defaultExpectation.results = &StockServiceRepositoryMockGetCycleCountResults{c2, err}
+	mmGetCycleCount.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCycleCount.mock
+}
+
+// Set uses given function f to mock the StockServiceRepository.GetCycleCount method
+func (mmGetCycleCount *mStockServiceRepositoryMockGetCycleCount) Set(f func(ctx context.Context, cycleCountID domain.CycleCountID) (c2 domain.CycleCount, err error)) *StockServiceRepositoryMock {
+	if mmGetCycleCount.defaultExpectation != nil {
+		mmGetCycleCount.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.GetCycleCount method")
+	}
+
+	if len(mmGetCycleCount.expectations) > 0 {
+		mmGetCycleCount.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.GetCycleCount method")
+	}
+
+	mmGetCycleCount.mock.funcGetCycleCount = f
+	mmGetCycleCount.mock.funcGetCycleCountOrigin = minimock.CallerInfo(1)
+	return mmGetCycleCount.mock
+}
+
+// When sets expectation for the StockServiceRepository.GetCycleCount which will trigger the result defined by the following
+// Then helper
+func (mmGetCycleCount *mStockServiceRepositoryMockGetCycleCount) When(ctx context.Context, cycleCountID domain.CycleCountID) *StockServiceRepositoryMockGetCycleCountExpectation {
+	if mmGetCycleCount.mock.funcGetCycleCount != nil {
+		mmGetCycleCount.mock.t.Fatalf("StockServiceRepositoryMock.GetCycleCount mock is already set by Set")
+	}
+
+	expectation := &StockServiceRepositoryMockGetCycleCountExpectation{
+		mock:               mmGetCycleCount.mock,
+		params:             &StockServiceRepositoryMockGetCycleCountParams{ctx, cycleCountID},
+		expectationOrigins: StockServiceRepositoryMockGetCycleCountExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCycleCount.expectations = append(mmGetCycleCount.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceRepository.GetCycleCount return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockGetCycleCountExpectation) Then(c2 domain.CycleCount, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockGetCycleCountResults{c2, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceRepository.GetCycleCount should be invoked
+func (mmGetCycleCount *mStockServiceRepositoryMockGetCycleCount) Times(n uint64) *mStockServiceRepositoryMockGetCycleCount {
+	if n == 0 {
+		mmGetCycleCount.mock.t.Fatalf("Times of StockServiceRepositoryMock.GetCycleCount mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCycleCount.expectedInvocations, n)
+	mmGetCycleCount.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCycleCount
+}
+
+func (mmGetCycleCount *mStockServiceRepositoryMockGetCycleCount) invocationsDone() bool {
+	if len(mmGetCycleCount.expectations) == 0 && mmGetCycleCount.defaultExpectation == nil && mmGetCycleCount.mock.funcGetCycleCount == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCycleCount.mock.afterGetCycleCountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCycleCount.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCycleCount implements mm_stocks.StockServiceRepository
+func (mmGetCycleCount *StockServiceRepositoryMock) GetCycleCount(ctx context.Context, cycleCountID domain.CycleCountID) (c2 domain.CycleCount, err error) {
+	mm_atomic.AddUint64(&mmGetCycleCount.beforeGetCycleCountCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCycleCount.afterGetCycleCountCounter, 1)
+
+	mmGetCycleCount.t.Helper()
+
+	if mmGetCycleCount.inspectFuncGetCycleCount != nil {
+		mmGetCycleCount.inspectFuncGetCycleCount(ctx, cycleCountID)
+	}
+
+	mm_params := StockServiceRepositoryMockGetCycleCountParams{ctx, cycleCountID}
+
+	// Record call args
+	mmGetCycleCount.GetCycleCountMock.mutex.Lock()
+	mmGetCycleCount.GetCycleCountMock.callArgs = append(mmGetCycleCount.GetCycleCountMock.callArgs, &mm_params)
+	mmGetCycleCount.GetCycleCountMock.mutex.Unlock()
+
+	for _, e := range mmGetCycleCount.GetCycleCountMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.c2, e.results.err
+		}
+	}
+
+	if mmGetCycleCount.GetCycleCountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCycleCount.GetCycleCountMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCycleCount.GetCycleCountMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCycleCount.GetCycleCountMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceRepositoryMockGetCycleCountParams{ctx, cycleCountID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCycleCount.t.Errorf("StockServiceRepositoryMock.GetCycleCount got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCycleCount.GetCycleCountMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.cycleCountID != nil && !minimock.Equal(*mm_want_ptrs.cycleCountID, mm_got.cycleCountID) {
+				mmGetCycleCount.t.Errorf("StockServiceRepositoryMock.GetCycleCount got unexpected parameter cycleCountID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCycleCount.GetCycleCountMock.defaultExpectation.expectationOrigins.originCycleCountID, *mm_want_ptrs.cycleCountID, mm_got.cycleCountID, minimock.Diff(*mm_want_ptrs.cycleCountID, mm_got.cycleCountID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCycleCount.t.Errorf("StockServiceRepositoryMock.GetCycleCount got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCycleCount.GetCycleCountMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCycleCount.GetCycleCountMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCycleCount.t.Fatal("No results are set for the StockServiceRepositoryMock.GetCycleCount")
+		}
+		return (*mm_results).c2, (*mm_results).err
+	}
+	if mmGetCycleCount.funcGetCycleCount != nil {
+		return mmGetCycleCount.funcGetCycleCount(ctx, cycleCountID)
+	}
+	mmGetCycleCount.t.Fatalf("Unexpected call to StockServiceRepositoryMock.GetCycleCount. %v %v", ctx, cycleCountID)
+	return
+}
+
+// GetCycleCountAfterCounter returns a count of finished StockServiceRepositoryMock.GetCycleCount invocations
+func (mmGetCycleCount *StockServiceRepositoryMock) GetCycleCountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCycleCount.afterGetCycleCountCounter)
+}
+
+// GetCycleCountBeforeCounter returns a count of StockServiceRepositoryMock.GetCycleCount invocations
+func (mmGetCycleCount *StockServiceRepositoryMock) GetCycleCountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCycleCount.beforeGetCycleCountCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.GetCycleCount.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCycleCount *mStockServiceRepositoryMockGetCycleCount) Calls() []*StockServiceRepositoryMockGetCycleCountParams {
+	mmGetCycleCount.mutex.RLock()
+
+	argCopy := make([]*StockServiceRepositoryMockGetCycleCountParams, len(mmGetCycleCount.callArgs))
+	copy(argCopy, mmGetCycleCount.callArgs)
+
+	mmGetCycleCount.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCycleCountDone returns true if the count of the GetCycleCount invocations corresponds
+// the number of defined expectations
+func (m *StockServiceRepositoryMock) MinimockGetCycleCountDone() bool {
+	if m.GetCycleCountMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCycleCountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCycleCountMock.invocationsDone()
+}
+
+// MinimockGetCycleCountInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockGetCycleCountInspect() {
+	for _, e := range m.GetCycleCountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.GetCycleCount at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCycleCountCounter := mm_atomic.LoadUint64(&m.afterGetCycleCountCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCycleCountMock.defaultExpectation != nil && afterGetCycleCountCounter < 1 {
+		if m.GetCycleCountMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.GetCycleCount at\n%s", m.GetCycleCountMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.GetCycleCount at\n%s with params: %#v", m.GetCycleCountMock.defaultExpectation.expectationOrigins.origin, *m.GetCycleCountMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCycleCount != nil && afterGetCycleCountCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.GetCycleCount at\n%s", m.funcGetCycleCountOrigin)
+	}
+
+	if !m.GetCycleCountMock.invocationsDone() && afterGetCycleCountCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.GetCycleCount at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCycleCountMock.expectedInvocations), m.GetCycleCountMock.expectedInvocationsOrigin, afterGetCycleCountCounter)
+	}
+}
+
+type mStockServiceRepositoryMockGetPurchaseOrderByID struct {
+	optional           bool
+	mock               *StockServiceRepositoryMock
+	defaultExpectation *StockServiceRepositoryMockGetPurchaseOrderByIDExpectation
+	expectations       []*StockServiceRepositoryMockGetPurchaseOrderByIDExpectation
+
+	callArgs []*StockServiceRepositoryMockGetPurchaseOrderByIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceRepositoryMockGetPurchaseOrderByIDExpectation specifies expectation struct of the StockServiceRepository.GetPurchaseOrderByID
+type StockServiceRepositoryMockGetPurchaseOrderByIDExpectation struct {
+	mock               *StockServiceRepositoryMock
+	params             *StockServiceRepositoryMockGetPurchaseOrderByIDParams
+	paramPtrs          *StockServiceRepositoryMockGetPurchaseOrderByIDParamPtrs
+	expectationOrigins StockServiceRepositoryMockGetPurchaseOrderByIDExpectationOrigins
+	results            *StockServiceRepositoryMockGetPurchaseOrderByIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceRepositoryMockGetPurchaseOrderByIDParams contains parameters of the StockServiceRepository.GetPurchaseOrderByID
+type StockServiceRepositoryMockGetPurchaseOrderByIDParams struct {
+	ctx             context.Context
+	purchaseOrderID domain.PurchaseOrderID
+}
+
+// StockServiceRepositoryMockGetPurchaseOrderByIDParamPtrs contains pointers to parameters of the StockServiceRepository.GetPurchaseOrderByID
+type StockServiceRepositoryMockGetPurchaseOrderByIDParamPtrs struct {
+	ctx             *context.Context
+	purchaseOrderID *domain.PurchaseOrderID
+}
+
+// StockServiceRepositoryMockGetPurchaseOrderByIDResults contains results of the StockServiceRepository.GetPurchaseOrderByID
+type StockServiceRepositoryMockGetPurchaseOrderByIDResults struct {
+	p1  domain.PurchaseOrder
+	err error
+}
+
+// StockServiceRepositoryMockGetPurchaseOrderByIDOrigins contains origins of expectations of the StockServiceRepository.GetPurchaseOrderByID
+type StockServiceRepositoryMockGetPurchaseOrderByIDExpectationOrigins struct {
+	origin                string
+	originCtx             string
+	originPurchaseOrderID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPurchaseOrderByID *mStockServiceRepositoryMockGetPurchaseOrderByID) Optional() *mStockServiceRepositoryMockGetPurchaseOrderByID {
+	mmGetPurchaseOrderByID.optional = true
+	return mmGetPurchaseOrderByID
+}
+
+// Expect sets up expected params for StockServiceRepository.GetPurchaseOrderByID
+func (mmGetPurchaseOrderByID *mStockServiceRepositoryMockGetPurchaseOrderByID) Expect(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) *mStockServiceRepositoryMockGetPurchaseOrderByID {
+	if mmGetPurchaseOrderByID.mock.funcGetPurchaseOrderByID != nil {
+		mmGetPurchaseOrderByID.mock.t.Fatalf("StockServiceRepositoryMock.GetPurchaseOrderByID mock is already set by Set")
+	}
+
+	if mmGetPurchaseOrderByID.defaultExpectation == nil {
+		mmGetPurchaseOrderByID.defaultExpectation = &StockServiceRepositoryMockGetPurchaseOrderByIDExpectation{}
+	}
+
+	if mmGetPurchaseOrderByID.defaultExpectation.paramPtrs != nil {
+		mmGetPurchaseOrderByID.mock.t.Fatalf("StockServiceRepositoryMock.GetPurchaseOrderByID mock is already set by ExpectParams functions")
+	}
+
+	mmGetPurchaseOrderByID.defaultExpectation.params = &StockServiceRepositoryMockGetPurchaseOrderByIDParams{ctx, purchaseOrderID}
+	mmGetPurchaseOrderByID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPurchaseOrderByID.expectations {
+		if minimock.Equal(e.params, mmGetPurchaseOrderByID.defaultExpectation.params) {
+			mmGetPurchaseOrderByID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPurchaseOrderByID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPurchaseOrderByID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.GetPurchaseOrderByID
+func (mmGetPurchaseOrderByID *mStockServiceRepositoryMockGetPurchaseOrderByID) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockGetPurchaseOrderByID {
+	if mmGetPurchaseOrderByID.mock.funcGetPurchaseOrderByID != nil {
+		mmGetPurchaseOrderByID.mock.t.Fatalf("StockServiceRepositoryMock.GetPurchaseOrderByID mock is already set by Set")
+	}
+
+	if mmGetPurchaseOrderByID.defaultExpectation == nil {
+		mmGetPurchaseOrderByID.defaultExpectation = &StockServiceRepositoryMockGetPurchaseOrderByIDExpectation{}
+	}
+
+	if mmGetPurchaseOrderByID.defaultExpectation.params != nil {
+		mmGetPurchaseOrderByID.mock.t.Fatalf("StockServiceRepositoryMock.GetPurchaseOrderByID mock is already set by Expect")
+	}
+
+	if mmGetPurchaseOrderByID.defaultExpectation.paramPtrs == nil {
+		mmGetPurchaseOrderByID.defaultExpectation.paramPtrs = &StockServiceRepositoryMockGetPurchaseOrderByIDParamPtrs{}
+	}
+	mmGetPurchaseOrderByID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPurchaseOrderByID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPurchaseOrderByID
+}
+
+// ExpectPurchaseOrderIDParam2 sets up expected param purchaseOrderID for StockServiceRepository.GetPurchaseOrderByID
+func (mmGetPurchaseOrderByID *mStockServiceRepositoryMockGetPurchaseOrderByID) ExpectPurchaseOrderIDParam2(purchaseOrderID domain.PurchaseOrderID) *mStockServiceRepositoryMockGetPurchaseOrderByID {
+	if mmGetPurchaseOrderByID.mock.funcGetPurchaseOrderByID != nil {
+		mmGetPurchaseOrderByID.mock.t.Fatalf("StockServiceRepositoryMock.GetPurchaseOrderByID mock is already set by Set")
+	}
+
+	if mmGetPurchaseOrderByID.defaultExpectation == nil {
+		mmGetPurchaseOrderByID.defaultExpectation = &StockServiceRepositoryMockGetPurchaseOrderByIDExpectation{}
+	}
+
+	if mmGetPurchaseOrderByID.defaultExpectation.params != nil {
+		mmGetPurchaseOrderByID.mock.t.Fatalf("StockServiceRepositoryMock.GetPurchaseOrderByID mock is already set by Expect")
+	}
+
+	if mmGetPurchaseOrderByID.defaultExpectation.paramPtrs == nil {
+		mmGetPurchaseOrderByID.defaultExpectation.paramPtrs = &StockServiceRepositoryMockGetPurchaseOrderByIDParamPtrs{}
+	}
+	mmGetPurchaseOrderByID.defaultExpectation.paramPtrs.purchaseOrderID = &purchaseOrderID
+	mmGetPurchaseOrderByID.defaultExpectation.expectationOrigins.originPurchaseOrderID = minimock.CallerInfo(1)
+
+	return mmGetPurchaseOrderByID
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.GetPurchaseOrderByID
+func (mmGetPurchaseOrderByID *mStockServiceRepositoryMockGetPurchaseOrderByID) Inspect(f func(ctx context.Context, purchaseOrderID domain.PurchaseOrderID)) *mStockServiceRepositoryMockGetPurchaseOrderByID {
+	if mmGetPurchaseOrderByID.mock.inspectFuncGetPurchaseOrderByID != nil {
+		mmGetPurchaseOrderByID.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.GetPurchaseOrderByID")
+	}
+
+	mmGetPurchaseOrderByID.mock.inspectFuncGetPurchaseOrderByID = f
+
+	return mmGetPurchaseOrderByID
+}
+
+// Return sets up results that will be returned by StockServiceRepository.GetPurchaseOrderByID
+func (mmGetPurchaseOrderByID *mStockServiceRepositoryMockGetPurchaseOrderByID) Return(p1 domain.PurchaseOrder, err error) *StockServiceRepositoryMock {
+	if mmGetPurchaseOrderByID.mock.funcGetPurchaseOrderByID != nil {
+		mmGetPurchaseOrderByID.mock.t.Fatalf("StockServiceRepositoryMock.GetPurchaseOrderByID mock is already set by Set")
+	}
+
+	if mmGetPurchaseOrderByID.defaultExpectation == nil {
+		mmGetPurchaseOrderByID.defaultExpectation = &StockServiceRepositoryMockGetPurchaseOrderByIDExpectation{mock: mmGetPurchaseOrderByID.mock}
+	}
+	mmGetPurchaseOrderByID.defaultExpectation.results = &StockServiceRepositoryMockGetPurchaseOrderByIDResults{p1, err}
+	mmGetPurchaseOrderByID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPurchaseOrderByID.mock
+}
+
+// Set uses given function f to mock the StockServiceRepository.GetPurchaseOrderByID method
+func (mmGetPurchaseOrderByID *mStockServiceRepositoryMockGetPurchaseOrderByID) Set(f func(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) (p1 domain.PurchaseOrder, err error)) *StockServiceRepositoryMock {
+	if mmGetPurchaseOrderByID.defaultExpectation != nil {
+		mmGetPurchaseOrderByID.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.GetPurchaseOrderByID method")
+	}
+
+	if len(mmGetPurchaseOrderByID.expectations) > 0 {
+		mmGetPurchaseOrderByID.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.GetPurchaseOrderByID method")
+	}
+
+	mmGetPurchaseOrderByID.mock.funcGetPurchaseOrderByID = f
+	mmGetPurchaseOrderByID.mock.funcGetPurchaseOrderByIDOrigin = minimock.CallerInfo(1)
+	return mmGetPurchaseOrderByID.mock
+}
+
+// When sets expectation for the StockServiceRepository.GetPurchaseOrderByID which will trigger the result defined by the following
+// Then helper
+func (mmGetPurchaseOrderByID *mStockServiceRepositoryMockGetPurchaseOrderByID) When(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) *StockServiceRepositoryMockGetPurchaseOrderByIDExpectation {
+	if mmGetPurchaseOrderByID.mock.funcGetPurchaseOrderByID != nil {
+		mmGetPurchaseOrderByID.mock.t.Fatalf("StockServiceRepositoryMock.GetPurchaseOrderByID mock is already set by Set")
+	}
+
+	expectation := &StockServiceRepositoryMockGetPurchaseOrderByIDExpectation{
+		mock:               mmGetPurchaseOrderByID.mock,
+		params:             &StockServiceRepositoryMockGetPurchaseOrderByIDParams{ctx, purchaseOrderID},
+		expectationOrigins: StockServiceRepositoryMockGetPurchaseOrderByIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPurchaseOrderByID.expectations = append(mmGetPurchaseOrderByID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceRepository.GetPurchaseOrderByID return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockGetPurchaseOrderByIDExpectation) Then(p1 domain.PurchaseOrder, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockGetPurchaseOrderByIDResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceRepository.GetPurchaseOrderByID should be invoked
+func (mmGetPurchaseOrderByID *mStockServiceRepositoryMockGetPurchaseOrderByID) Times(n uint64) *mStockServiceRepositoryMockGetPurchaseOrderByID {
+	if n == 0 {
+		mmGetPurchaseOrderByID.mock.t.Fatalf("Times of StockServiceRepositoryMock.GetPurchaseOrderByID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPurchaseOrderByID.expectedInvocations, n)
+	mmGetPurchaseOrderByID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPurchaseOrderByID
+}
+
+func (mmGetPurchaseOrderByID *mStockServiceRepositoryMockGetPurchaseOrderByID) invocationsDone() bool {
+	if len(mmGetPurchaseOrderByID.expectations) == 0 && mmGetPurchaseOrderByID.defaultExpectation == nil && mmGetPurchaseOrderByID.mock.funcGetPurchaseOrderByID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPurchaseOrderByID.mock.afterGetPurchaseOrderByIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPurchaseOrderByID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPurchaseOrderByID implements mm_stocks.StockServiceRepository
+func (mmGetPurchaseOrderByID *StockServiceRepositoryMock) GetPurchaseOrderByID(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) (p1 domain.PurchaseOrder, err error) {
+	mm_atomic.AddUint64(&mmGetPurchaseOrderByID.beforeGetPurchaseOrderByIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPurchaseOrderByID.afterGetPurchaseOrderByIDCounter, 1)
+
+	mmGetPurchaseOrderByID.t.Helper()
+
+	if mmGetPurchaseOrderByID.inspectFuncGetPurchaseOrderByID != nil {
+		mmGetPurchaseOrderByID.inspectFuncGetPurchaseOrderByID(ctx, purchaseOrderID)
+	}
+
+	mm_params := StockServiceRepositoryMockGetPurchaseOrderByIDParams{ctx, purchaseOrderID}
+
+	// Record call args
+	mmGetPurchaseOrderByID.GetPurchaseOrderByIDMock.mutex.Lock()
+	mmGetPurchaseOrderByID.GetPurchaseOrderByIDMock.callArgs = append(mmGetPurchaseOrderByID.GetPurchaseOrderByIDMock.callArgs, &mm_params)
+	mmGetPurchaseOrderByID.GetPurchaseOrderByIDMock.mutex.Unlock()
+
+	for _, e := range mmGetPurchaseOrderByID.GetPurchaseOrderByIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmGetPurchaseOrderByID.GetPurchaseOrderByIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPurchaseOrderByID.GetPurchaseOrderByIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPurchaseOrderByID.GetPurchaseOrderByIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPurchaseOrderByID.GetPurchaseOrderByIDMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceRepositoryMockGetPurchaseOrderByIDParams{ctx, purchaseOrderID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPurchaseOrderByID.t.Errorf("StockServiceRepositoryMock.GetPurchaseOrderByID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPurchaseOrderByID.GetPurchaseOrderByIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.purchaseOrderID != nil && !minimock.Equal(*mm_want_ptrs.purchaseOrderID, mm_got.purchaseOrderID) {
+				mmGetPurchaseOrderByID.t.Errorf("StockServiceRepositoryMock.GetPurchaseOrderByID got unexpected parameter purchaseOrderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPurchaseOrderByID.GetPurchaseOrderByIDMock.defaultExpectation.expectationOrigins.originPurchaseOrderID, *mm_want_ptrs.purchaseOrderID, mm_got.purchaseOrderID, minimock.Diff(*mm_want_ptrs.purchaseOrderID, mm_got.purchaseOrderID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPurchaseOrderByID.t.Errorf("StockServiceRepositoryMock.GetPurchaseOrderByID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPurchaseOrderByID.GetPurchaseOrderByIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPurchaseOrderByID.GetPurchaseOrderByIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPurchaseOrderByID.t.Fatal("No results are set for the StockServiceRepositoryMock.GetPurchaseOrderByID")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmGetPurchaseOrderByID.funcGetPurchaseOrderByID != nil {
+		return mmGetPurchaseOrderByID.funcGetPurchaseOrderByID(ctx, purchaseOrderID)
+	}
+	mmGetPurchaseOrderByID.t.Fatalf("Unexpected call to StockServiceRepositoryMock.GetPurchaseOrderByID. %v %v", ctx, purchaseOrderID)
+	return
+}
+
+// GetPurchaseOrderByIDAfterCounter returns a count of finished StockServiceRepositoryMock.GetPurchaseOrderByID invocations
+func (mmGetPurchaseOrderByID *StockServiceRepositoryMock) GetPurchaseOrderByIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPurchaseOrderByID.afterGetPurchaseOrderByIDCounter)
+}
+
+// GetPurchaseOrderByIDBeforeCounter returns a count of StockServiceRepositoryMock.GetPurchaseOrderByID invocations
+func (mmGetPurchaseOrderByID *StockServiceRepositoryMock) GetPurchaseOrderByIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPurchaseOrderByID.beforeGetPurchaseOrderByIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.GetPurchaseOrderByID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPurchaseOrderByID *mStockServiceRepositoryMockGetPurchaseOrderByID) Calls() []*StockServiceRepositoryMockGetPurchaseOrderByIDParams {
+	mmGetPurchaseOrderByID.mutex.RLock()
+
+	argCopy := make([]*StockServiceRepositoryMockGetPurchaseOrderByIDParams, len(mmGetPurchaseOrderByID.callArgs))
+	copy(argCopy, mmGetPurchaseOrderByID.callArgs)
+
+	mmGetPurchaseOrderByID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPurchaseOrderByIDDone returns true if the count of the GetPurchaseOrderByID invocations corresponds
+// the number of defined expectations
+func (m *StockServiceRepositoryMock) MinimockGetPurchaseOrderByIDDone() bool {
+	if m.GetPurchaseOrderByIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPurchaseOrderByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPurchaseOrderByIDMock.invocationsDone()
+}
+
+// MinimockGetPurchaseOrderByIDInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockGetPurchaseOrderByIDInspect() {
+	for _, e := range m.GetPurchaseOrderByIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.GetPurchaseOrderByID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPurchaseOrderByIDCounter := mm_atomic.LoadUint64(&m.afterGetPurchaseOrderByIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPurchaseOrderByIDMock.defaultExpectation != nil && afterGetPurchaseOrderByIDCounter < 1 {
+		if m.GetPurchaseOrderByIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.GetPurchaseOrderByID at\n%s", m.GetPurchaseOrderByIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.GetPurchaseOrderByID at\n%s with params: %#v", m.GetPurchaseOrderByIDMock.defaultExpectation.expectationOrigins.origin, *m.GetPurchaseOrderByIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPurchaseOrderByID != nil && afterGetPurchaseOrderByIDCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.GetPurchaseOrderByID at\n%s", m.funcGetPurchaseOrderByIDOrigin)
+	}
+
+	if !m.GetPurchaseOrderByIDMock.invocationsDone() && afterGetPurchaseOrderByIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.GetPurchaseOrderByID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPurchaseOrderByIDMock.expectedInvocations), m.GetPurchaseOrderByIDMock.expectedInvocationsOrigin, afterGetPurchaseOrderByIDCounter)
+	}
+}
+
+type mStockServiceRepositoryMockGetStockItem struct {
+	optional           bool
+	mock               *StockServiceRepositoryMock
+	defaultExpectation *StockServiceRepositoryMockGetStockItemExpectation
+	expectations       []*StockServiceRepositoryMockGetStockItemExpectation
+
+	callArgs []*StockServiceRepositoryMockGetStockItemParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceRepositoryMockGetStockItemExpectation specifies expectation struct of the StockServiceRepository.GetStockItem
+type StockServiceRepositoryMockGetStockItemExpectation struct {
+	mock               *StockServiceRepositoryMock
+	params             *StockServiceRepositoryMockGetStockItemParams
+	paramPtrs          *StockServiceRepositoryMockGetStockItemParamPtrs
+	expectationOrigins StockServiceRepositoryMockGetStockItemExpectationOrigins
+	results            *StockServiceRepositoryMockGetStockItemResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceRepositoryMockGetStockItemParams contains parameters of the StockServiceRepository.GetStockItem
+type StockServiceRepositoryMockGetStockItemParams struct {
+	ctx      context.Context
+	userID   domain.UserID
+	skuID    domain.SKUID
+	location string
+}
+
+// StockServiceRepositoryMockGetStockItemParamPtrs contains pointers to parameters of the StockServiceRepository.GetStockItem
+type StockServiceRepositoryMockGetStockItemParamPtrs struct {
+	ctx      *context.Context
+	userID   *domain.UserID
+	skuID    *domain.SKUID
+	location *string
+}
+
+// StockServiceRepositoryMockGetStockItemResults contains results of the StockServiceRepository.GetStockItem
+type StockServiceRepositoryMockGetStockItemResults struct {
+	s1  domain.StockItem
+	err error
+}
+
+// StockServiceRepositoryMockGetStockItemOrigins contains origins of expectations of the StockServiceRepository.GetStockItem
+type StockServiceRepositoryMockGetStockItemExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originSkuID    string
+	originLocation string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetStockItem *mStockServiceRepositoryMockGetStockItem) Optional() *mStockServiceRepositoryMockGetStockItem {
+	mmGetStockItem.optional = true
+	return mmGetStockItem
+}
+
+// Expect sets up expected params for StockServiceRepository.GetStockItem
+func (mmGetStockItem *mStockServiceRepositoryMockGetStockItem) Expect(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) *mStockServiceRepositoryMockGetStockItem {
+	if mmGetStockItem.mock.funcGetStockItem != nil {
+		mmGetStockItem.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItem mock is already set by Set")
+	}
+
+	if mmGetStockItem.defaultExpectation == nil {
+		mmGetStockItem.defaultExpectation = &StockServiceRepositoryMockGetStockItemExpectation{}
+	}
+
+	if mmGetStockItem.defaultExpectation.paramPtrs != nil {
+		mmGetStockItem.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItem mock is already set by ExpectParams functions")
+	}
+
+	mmGetStockItem.defaultExpectation.params = &StockServiceRepositoryMockGetStockItemParams{ctx, userID, skuID, location}
+	mmGetStockItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetStockItem.expectations {
+		if minimock.Equal(e.params, mmGetStockItem.defaultExpectation.params) {
+			mmGetStockItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetStockItem.defaultExpectation.params)
+		}
+	}
+
+	return mmGetStockItem
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.GetStockItem
+func (mmGetStockItem *mStockServiceRepositoryMockGetStockItem) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockGetStockItem {
+	if mmGetStockItem.mock.funcGetStockItem != nil {
+		mmGetStockItem.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItem mock is already set by Set")
+	}
+
+	if mmGetStockItem.defaultExpectation == nil {
+		mmGetStockItem.defaultExpectation = &StockServiceRepositoryMockGetStockItemExpectation{}
+	}
+
+	if mmGetStockItem.defaultExpectation.params != nil {
+		mmGetStockItem.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItem mock is already set by Expect")
+	}
+
+	if mmGetStockItem.defaultExpectation.paramPtrs == nil {
+		mmGetStockItem.defaultExpectation.paramPtrs = &StockServiceRepositoryMockGetStockItemParamPtrs{}
+	}
+	mmGetStockItem.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetStockItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetStockItem
+}
+
+// ExpectUserIDParam2 sets up expected param userID for StockServiceRepository.GetStockItem
+func (mmGetStockItem *mStockServiceRepositoryMockGetStockItem) ExpectUserIDParam2(userID domain.UserID) *mStockServiceRepositoryMockGetStockItem {
+	if mmGetStockItem.mock.funcGetStockItem != nil {
+		mmGetStockItem.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItem mock is already set by Set")
+	}
+
+	if mmGetStockItem.defaultExpectation == nil {
+		mmGetStockItem.defaultExpectation = &StockServiceRepositoryMockGetStockItemExpectation{}
+	}
+
+	if mmGetStockItem.defaultExpectation.params != nil {
+		mmGetStockItem.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItem mock is already set by Expect")
+	}
+
+	if mmGetStockItem.defaultExpectation.paramPtrs == nil {
+		mmGetStockItem.defaultExpectation.paramPtrs = &StockServiceRepositoryMockGetStockItemParamPtrs{}
+	}
+	mmGetStockItem.defaultExpectation.paramPtrs.userID = &userID
+	mmGetStockItem.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetStockItem
+}
+
+// ExpectSkuIDParam3 sets up expected param skuID for StockServiceRepository.GetStockItem
+func (mmGetStockItem *mStockServiceRepositoryMockGetStockItem) ExpectSkuIDParam3(skuID domain.SKUID) *mStockServiceRepositoryMockGetStockItem {
+	if mmGetStockItem.mock.funcGetStockItem != nil {
+		mmGetStockItem.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItem mock is already set by Set")
+	}
+
+	if mmGetStockItem.defaultExpectation == nil {
+		mmGetStockItem.defaultExpectation = &StockServiceRepositoryMockGetStockItemExpectation{}
+	}
+
+	if mmGetStockItem.defaultExpectation.params != nil {
+		mmGetStockItem.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItem mock is already set by Expect")
+	}
+
+	if mmGetStockItem.defaultExpectation.paramPtrs == nil {
+		mmGetStockItem.defaultExpectation.paramPtrs = &StockServiceRepositoryMockGetStockItemParamPtrs{}
+	}
+	mmGetStockItem.defaultExpectation.paramPtrs.skuID = &skuID
+	mmGetStockItem.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmGetStockItem
+}
+
+// ExpectLocationParam4 sets up expected param location for StockServiceRepository.GetStockItem
+func (mmGetStockItem *mStockServiceRepositoryMockGetStockItem) ExpectLocationParam4(location string) *mStockServiceRepositoryMockGetStockItem {
+	if mmGetStockItem.mock.funcGetStockItem != nil {
+		mmGetStockItem.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItem mock is already set by Set")
+	}
+
+	if mmGetStockItem.defaultExpectation == nil {
+		mmGetStockItem.defaultExpectation = &StockServiceRepositoryMockGetStockItemExpectation{}
+	}
+
+	if mmGetStockItem.defaultExpectation.params != nil {
+		mmGetStockItem.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItem mock is already set by Expect")
+	}
+
+	if mmGetStockItem.defaultExpectation.paramPtrs == nil {
+		mmGetStockItem.defaultExpectation.paramPtrs = &StockServiceRepositoryMockGetStockItemParamPtrs{}
+	}
+	mmGetStockItem.defaultExpectation.paramPtrs.location = &location
+	mmGetStockItem.defaultExpectation.expectationOrigins.originLocation = minimock.CallerInfo(1)
+
+	return mmGetStockItem
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.GetStockItem
+func (mmGetStockItem *mStockServiceRepositoryMockGetStockItem) Inspect(f func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string)) *mStockServiceRepositoryMockGetStockItem {
+	if mmGetStockItem.mock.inspectFuncGetStockItem != nil {
+		mmGetStockItem.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.GetStockItem")
+	}
+
+	mmGetStockItem.mock.inspectFuncGetStockItem = f
+
+	return mmGetStockItem
+}
+
+// Return sets up results that will be returned by StockServiceRepository.GetStockItem
+func (mmGetStockItem *mStockServiceRepositoryMockGetStockItem) Return(s1 domain.StockItem, err error) *StockServiceRepositoryMock {
+	if mmGetStockItem.mock.funcGetStockItem != nil {
+		mmGetStockItem.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItem mock is already set by Set")
+	}
+
+	if mmGetStockItem.defaultExpectation == nil {
+		mmGetStockItem.defaultExpectation = &StockServiceRepositoryMockGetStockItemExpectation{mock: mmGetStockItem.mock}
+	}
+	mmGetStockItem.defaultExpectation.results = &StockServiceRepositoryMockGetStockItemResults{s1, err}
+	mmGetStockItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetStockItem.mock
+}
+
+// Set uses given function f to mock the StockServiceRepository.GetStockItem method
+func (mmGetStockItem *mStockServiceRepositoryMockGetStockItem) Set(f func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) (s1 domain.StockItem, err error)) *StockServiceRepositoryMock {
+	if mmGetStockItem.defaultExpectation != nil {
+		mmGetStockItem.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.GetStockItem method")
+	}
+
+	if len(mmGetStockItem.expectations) > 0 {
+		mmGetStockItem.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.GetStockItem method")
+	}
+
+	mmGetStockItem.mock.funcGetStockItem = f
+	mmGetStockItem.mock.funcGetStockItemOrigin = minimock.CallerInfo(1)
+	return mmGetStockItem.mock
+}
+
+// When sets expectation for the StockServiceRepository.GetStockItem which will trigger the result defined by the following
+// Then helper
+func (mmGetStockItem *mStockServiceRepositoryMockGetStockItem) When(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) *StockServiceRepositoryMockGetStockItemExpectation {
+	if mmGetStockItem.mock.funcGetStockItem != nil {
+		mmGetStockItem.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItem mock is already set by Set")
+	}
+
+	expectation := &StockServiceRepositoryMockGetStockItemExpectation{
+		mock:               mmGetStockItem.mock,
+		params:             &StockServiceRepositoryMockGetStockItemParams{ctx, userID, skuID, location},
+		expectationOrigins: StockServiceRepositoryMockGetStockItemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetStockItem.expectations = append(mmGetStockItem.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceRepository.GetStockItem return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockGetStockItemExpectation) Then(s1 domain.StockItem, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockGetStockItemResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceRepository.GetStockItem should be invoked
+func (mmGetStockItem *mStockServiceRepositoryMockGetStockItem) Times(n uint64) *mStockServiceRepositoryMockGetStockItem {
+	if n == 0 {
+		mmGetStockItem.mock.t.Fatalf("Times of StockServiceRepositoryMock.GetStockItem mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetStockItem.expectedInvocations, n)
+	mmGetStockItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetStockItem
+}
+
+func (mmGetStockItem *mStockServiceRepositoryMockGetStockItem) invocationsDone() bool {
+	if len(mmGetStockItem.expectations) == 0 && mmGetStockItem.defaultExpectation == nil && mmGetStockItem.mock.funcGetStockItem == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetStockItem.mock.afterGetStockItemCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetStockItem.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetStockItem implements mm_stocks.StockServiceRepository
+func (mmGetStockItem *StockServiceRepositoryMock) GetStockItem(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) (s1 domain.StockItem, err error) {
+	mm_atomic.AddUint64(&mmGetStockItem.beforeGetStockItemCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetStockItem.afterGetStockItemCounter, 1)
+
+	mmGetStockItem.t.Helper()
+
+	if mmGetStockItem.inspectFuncGetStockItem != nil {
+		mmGetStockItem.inspectFuncGetStockItem(ctx, userID, skuID, location)
+	}
+
+	mm_params := StockServiceRepositoryMockGetStockItemParams{ctx, userID, skuID, location}
+
+	// Record call args
+	mmGetStockItem.GetStockItemMock.mutex.Lock()
+	mmGetStockItem.GetStockItemMock.callArgs = append(mmGetStockItem.GetStockItemMock.callArgs, &mm_params)
+	mmGetStockItem.GetStockItemMock.mutex.Unlock()
+
+	for _, e := range mmGetStockItem.GetStockItemMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetStockItem.GetStockItemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetStockItem.GetStockItemMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetStockItem.GetStockItemMock.defaultExpectation.params
+		mm_want_ptrs := mmGetStockItem.GetStockItemMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceRepositoryMockGetStockItemParams{ctx, userID, skuID, location}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetStockItem.t.Errorf("StockServiceRepositoryMock.GetStockItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetStockItem.GetStockItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetStockItem.t.Errorf("StockServiceRepositoryMock.GetStockItem got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetStockItem.GetStockItemMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmGetStockItem.t.Errorf("StockServiceRepositoryMock.GetStockItem got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetStockItem.GetStockItemMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.location != nil && !minimock.Equal(*mm_want_ptrs.location, mm_got.location) {
+				mmGetStockItem.t.Errorf("StockServiceRepositoryMock.GetStockItem got unexpected parameter location, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetStockItem.GetStockItemMock.defaultExpectation.expectationOrigins.originLocation, *mm_want_ptrs.location, mm_got.location, minimock.Diff(*mm_want_ptrs.location, mm_got.location))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetStockItem.t.Errorf("StockServiceRepositoryMock.GetStockItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetStockItem.GetStockItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetStockItem.GetStockItemMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetStockItem.t.Fatal("No results are set for the StockServiceRepositoryMock.GetStockItem")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetStockItem.funcGetStockItem != nil {
+		return mmGetStockItem.funcGetStockItem(ctx, userID, skuID, location)
+	}
+	mmGetStockItem.t.Fatalf("Unexpected call to StockServiceRepositoryMock.GetStockItem. %v %v %v %v", ctx, userID, skuID, location)
+	return
+}
+
+// GetStockItemAfterCounter returns a count of finished StockServiceRepositoryMock.GetStockItem invocations
+func (mmGetStockItem *StockServiceRepositoryMock) GetStockItemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetStockItem.afterGetStockItemCounter)
+}
+
+// GetStockItemBeforeCounter returns a count of StockServiceRepositoryMock.GetStockItem invocations
+func (mmGetStockItem *StockServiceRepositoryMock) GetStockItemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetStockItem.beforeGetStockItemCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.GetStockItem.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetStockItem *mStockServiceRepositoryMockGetStockItem) Calls() []*StockServiceRepositoryMockGetStockItemParams {
+	mmGetStockItem.mutex.RLock()
+
+	argCopy := make([]*StockServiceRepositoryMockGetStockItemParams, len(mmGetStockItem.callArgs))
+	copy(argCopy, mmGetStockItem.callArgs)
+
+	mmGetStockItem.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetStockItemDone returns true if the count of the GetStockItem invocations corresponds
+// the number of defined expectations
+func (m *StockServiceRepositoryMock) MinimockGetStockItemDone() bool {
+	if m.GetStockItemMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetStockItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetStockItemMock.invocationsDone()
+}
+
+// MinimockGetStockItemInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockGetStockItemInspect() {
+	for _, e := range m.GetStockItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.GetStockItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetStockItemCounter := mm_atomic.LoadUint64(&m.afterGetStockItemCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetStockItemMock.defaultExpectation != nil && afterGetStockItemCounter < 1 {
+		if m.GetStockItemMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.GetStockItem at\n%s", m.GetStockItemMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.GetStockItem at\n%s with params: %#v", m.GetStockItemMock.defaultExpectation.expectationOrigins.origin, *m.GetStockItemMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetStockItem != nil && afterGetStockItemCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.GetStockItem at\n%s", m.funcGetStockItemOrigin)
+	}
+
+	if !m.GetStockItemMock.invocationsDone() && afterGetStockItemCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.GetStockItem at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetStockItemMock.expectedInvocations), m.GetStockItemMock.expectedInvocationsOrigin, afterGetStockItemCounter)
+	}
+}
+
+type mStockServiceRepositoryMockGetStockTransfer struct {
+	optional           bool
+	mock               *StockServiceRepositoryMock
+	defaultExpectation *StockServiceRepositoryMockGetStockTransferExpectation
+	expectations       []*StockServiceRepositoryMockGetStockTransferExpectation
+
+	callArgs []*StockServiceRepositoryMockGetStockTransferParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceRepositoryMockGetStockTransferExpectation specifies expectation struct of the StockServiceRepository.GetStockTransfer
+type StockServiceRepositoryMockGetStockTransferExpectation struct {
+	mock               *StockServiceRepositoryMock
+	params             *StockServiceRepositoryMockGetStockTransferParams
+	paramPtrs          *StockServiceRepositoryMockGetStockTransferParamPtrs
+	expectationOrigins StockServiceRepositoryMockGetStockTransferExpectationOrigins
+	results            *StockServiceRepositoryMockGetStockTransferResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceRepositoryMockGetStockTransferParams contains parameters of the StockServiceRepository.GetStockTransfer
+type StockServiceRepositoryMockGetStockTransferParams struct {
+	ctx        context.Context
+	userID     domain.UserID
+	transferID domain.TransferID
+}
+
+// StockServiceRepositoryMockGetStockTransferParamPtrs contains pointers to parameters of the StockServiceRepository.GetStockTransfer
+type StockServiceRepositoryMockGetStockTransferParamPtrs struct {
+	ctx        *context.Context
+	userID     *domain.UserID
+	transferID *domain.TransferID
+}
+
+// StockServiceRepositoryMockGetStockTransferResults contains results of the StockServiceRepository.GetStockTransfer
+type StockServiceRepositoryMockGetStockTransferResults struct {
+	s1  domain.StockTransfer
+	err error
+}
+
+// StockServiceRepositoryMockGetStockTransferOrigins contains origins of expectations of the StockServiceRepository.GetStockTransfer
+type StockServiceRepositoryMockGetStockTransferExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originUserID     string
+	originTransferID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetStockTransfer *mStockServiceRepositoryMockGetStockTransfer) Optional() *mStockServiceRepositoryMockGetStockTransfer {
+	mmGetStockTransfer.optional = true
+	return mmGetStockTransfer
+}
+
+// Expect sets up expected params for StockServiceRepository.GetStockTransfer
+func (mmGetStockTransfer *mStockServiceRepositoryMockGetStockTransfer) Expect(ctx context.Context, userID domain.UserID, transferID domain.TransferID) *mStockServiceRepositoryMockGetStockTransfer {
+	if mmGetStockTransfer.mock.funcGetStockTransfer != nil {
+		mmGetStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.GetStockTransfer mock is already set by Set")
+	}
+
+	if mmGetStockTransfer.defaultExpectation == nil {
+		mmGetStockTransfer.defaultExpectation = &StockServiceRepositoryMockGetStockTransferExpectation{}
+	}
+
+	if mmGetStockTransfer.defaultExpectation.paramPtrs != nil {
+		mmGetStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.GetStockTransfer mock is already set by ExpectParams functions")
+	}
+
+	mmGetStockTransfer.defaultExpectation.params = &StockServiceRepositoryMockGetStockTransferParams{ctx, userID, transferID}
+	mmGetStockTransfer.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetStockTransfer.expectations {
+		if minimock.Equal(e.params, mmGetStockTransfer.defaultExpectation.params) {
+			mmGetStockTransfer.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetStockTransfer.defaultExpectation.params)
+		}
+	}
+
+	return mmGetStockTransfer
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.GetStockTransfer
+func (mmGetStockTransfer *mStockServiceRepositoryMockGetStockTransfer) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockGetStockTransfer {
+	if mmGetStockTransfer.mock.funcGetStockTransfer != nil {
+		mmGetStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.GetStockTransfer mock is already set by Set")
+	}
+
+	if mmGetStockTransfer.defaultExpectation == nil {
+		mmGetStockTransfer.defaultExpectation = &StockServiceRepositoryMockGetStockTransferExpectation{}
+	}
+
+	if mmGetStockTransfer.defaultExpectation.params != nil {
+		mmGetStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.GetStockTransfer mock is already set by Expect")
+	}
+
+	if mmGetStockTransfer.defaultExpectation.paramPtrs == nil {
+		mmGetStockTransfer.defaultExpectation.paramPtrs = &StockServiceRepositoryMockGetStockTransferParamPtrs{}
+	}
+	mmGetStockTransfer.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetStockTransfer.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetStockTransfer
+}
+
+// ExpectUserIDParam2 sets up expected param userID for StockServiceRepository.GetStockTransfer
+func (mmGetStockTransfer *mStockServiceRepositoryMockGetStockTransfer) ExpectUserIDParam2(userID domain.UserID) *mStockServiceRepositoryMockGetStockTransfer {
+	if mmGetStockTransfer.mock.funcGetStockTransfer != nil {
+		mmGetStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.GetStockTransfer mock is already set by Set")
+	}
+
+	if mmGetStockTransfer.defaultExpectation == nil {
+		mmGetStockTransfer.defaultExpectation = &StockServiceRepositoryMockGetStockTransferExpectation{}
+	}
+
+	if mmGetStockTransfer.defaultExpectation.params != nil {
+		mmGetStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.GetStockTransfer mock is already set by Expect")
+	}
+
+	if mmGetStockTransfer.defaultExpectation.paramPtrs == nil {
+		mmGetStockTransfer.defaultExpectation.paramPtrs = &StockServiceRepositoryMockGetStockTransferParamPtrs{}
+	}
+	mmGetStockTransfer.defaultExpectation.paramPtrs.userID = &userID
+	mmGetStockTransfer.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetStockTransfer
+}
+
+// ExpectTransferIDParam3 sets up expected param transferID for StockServiceRepository.GetStockTransfer
+func (mmGetStockTransfer *mStockServiceRepositoryMockGetStockTransfer) ExpectTransferIDParam3(transferID domain.TransferID) *mStockServiceRepositoryMockGetStockTransfer {
+	if mmGetStockTransfer.mock.funcGetStockTransfer != nil {
+		mmGetStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.GetStockTransfer mock is already set by Set")
+	}
+
+	if mmGetStockTransfer.defaultExpectation == nil {
+		mmGetStockTransfer.defaultExpectation = &StockServiceRepositoryMockGetStockTransferExpectation{}
+	}
+
+	if mmGetStockTransfer.defaultExpectation.params != nil {
+		mmGetStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.GetStockTransfer mock is already set by Expect")
+	}
+
+	if mmGetStockTransfer.defaultExpectation.paramPtrs == nil {
+		mmGetStockTransfer.defaultExpectation.paramPtrs = &StockServiceRepositoryMockGetStockTransferParamPtrs{}
+	}
+	mmGetStockTransfer.defaultExpectation.paramPtrs.transferID = &transferID
+	mmGetStockTransfer.defaultExpectation.expectationOrigins.originTransferID = minimock.CallerInfo(1)
+
+	return mmGetStockTransfer
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.GetStockTransfer
+func (mmGetStockTransfer *mStockServiceRepositoryMockGetStockTransfer) Inspect(f func(ctx context.Context, userID domain.UserID, transferID domain.TransferID)) *mStockServiceRepositoryMockGetStockTransfer {
+	if mmGetStockTransfer.mock.inspectFuncGetStockTransfer != nil {
+		mmGetStockTransfer.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.GetStockTransfer")
+	}
+
+	mmGetStockTransfer.mock.inspectFuncGetStockTransfer = f
+
+	return mmGetStockTransfer
+}
+
+// Return sets up results that will be returned by StockServiceRepository.GetStockTransfer
+func (mmGetStockTransfer *mStockServiceRepositoryMockGetStockTransfer) Return(s1 domain.StockTransfer, err error) *StockServiceRepositoryMock {
+	if mmGetStockTransfer.mock.funcGetStockTransfer != nil {
+		mmGetStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.GetStockTransfer mock is already set by Set")
+	}
+
+	if mmGetStockTransfer.defaultExpectation == nil {
+		mmGetStockTransfer.defaultExpectation = &StockServiceRepositoryMockGetStockTransferExpectation{mock: mmGetStockTransfer.mock}
+	}
+	mmGetStockTransfer.defaultExpectation.results = &StockServiceRepositoryMockGetStockTransferResults{s1, err}
+	mmGetStockTransfer.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetStockTransfer.mock
+}
+
+// Set uses given function f to mock the StockServiceRepository.GetStockTransfer method
+func (mmGetStockTransfer *mStockServiceRepositoryMockGetStockTransfer) Set(f func(ctx context.Context, userID domain.UserID, transferID domain.TransferID) (s1 domain.StockTransfer, err error)) *StockServiceRepositoryMock {
+	if mmGetStockTransfer.defaultExpectation != nil {
+		mmGetStockTransfer.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.GetStockTransfer method")
+	}
+
+	if len(mmGetStockTransfer.expectations) > 0 {
+		mmGetStockTransfer.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.GetStockTransfer method")
+	}
+
+	mmGetStockTransfer.mock.funcGetStockTransfer = f
+	mmGetStockTransfer.mock.funcGetStockTransferOrigin = minimock.CallerInfo(1)
+	return mmGetStockTransfer.mock
+}
+
+// When sets expectation for the StockServiceRepository.GetStockTransfer which will trigger the result defined by the following
+// Then helper
+func (mmGetStockTransfer *mStockServiceRepositoryMockGetStockTransfer) When(ctx context.Context, userID domain.UserID, transferID domain.TransferID) *StockServiceRepositoryMockGetStockTransferExpectation {
+	if mmGetStockTransfer.mock.funcGetStockTransfer != nil {
+		mmGetStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.GetStockTransfer mock is already set by Set")
+	}
+
+	expectation := &StockServiceRepositoryMockGetStockTransferExpectation{
+		mock:               mmGetStockTransfer.mock,
+		params:             &StockServiceRepositoryMockGetStockTransferParams{ctx, userID, transferID},
+		expectationOrigins: StockServiceRepositoryMockGetStockTransferExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetStockTransfer.expectations = append(mmGetStockTransfer.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceRepository.GetStockTransfer return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockGetStockTransferExpectation) Then(s1 domain.StockTransfer, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockGetStockTransferResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceRepository.GetStockTransfer should be invoked
+func (mmGetStockTransfer *mStockServiceRepositoryMockGetStockTransfer) Times(n uint64) *mStockServiceRepositoryMockGetStockTransfer {
+	if n == 0 {
+		mmGetStockTransfer.mock.t.Fatalf("Times of StockServiceRepositoryMock.GetStockTransfer mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetStockTransfer.expectedInvocations, n)
+	mmGetStockTransfer.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetStockTransfer
+}
+
+func (mmGetStockTransfer *mStockServiceRepositoryMockGetStockTransfer) invocationsDone() bool {
+	if len(mmGetStockTransfer.expectations) == 0 && mmGetStockTransfer.defaultExpectation == nil && mmGetStockTransfer.mock.funcGetStockTransfer == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetStockTransfer.mock.afterGetStockTransferCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetStockTransfer.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetStockTransfer implements mm_stocks.StockServiceRepository
+func (mmGetStockTransfer *StockServiceRepositoryMock) GetStockTransfer(ctx context.Context, userID domain.UserID, transferID domain.TransferID) (s1 domain.StockTransfer, err error) {
+	mm_atomic.AddUint64(&mmGetStockTransfer.beforeGetStockTransferCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetStockTransfer.afterGetStockTransferCounter, 1)
+
+	mmGetStockTransfer.t.Helper()
+
+	if mmGetStockTransfer.inspectFuncGetStockTransfer != nil {
+		mmGetStockTransfer.inspectFuncGetStockTransfer(ctx, userID, transferID)
+	}
+
+	mm_params := StockServiceRepositoryMockGetStockTransferParams{ctx, userID, transferID}
+
+	// Record call args
+	mmGetStockTransfer.GetStockTransferMock.mutex.Lock()
+	mmGetStockTransfer.GetStockTransferMock.callArgs = append(mmGetStockTransfer.GetStockTransferMock.callArgs, &mm_params)
+	mmGetStockTransfer.GetStockTransferMock.mutex.Unlock()
+
+	for _, e := range mmGetStockTransfer.GetStockTransferMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetStockTransfer.GetStockTransferMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetStockTransfer.GetStockTransferMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetStockTransfer.GetStockTransferMock.defaultExpectation.params
+		mm_want_ptrs := mmGetStockTransfer.GetStockTransferMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceRepositoryMockGetStockTransferParams{ctx, userID, transferID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetStockTransfer.t.Errorf("StockServiceRepositoryMock.GetStockTransfer got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetStockTransfer.GetStockTransferMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetStockTransfer.t.Errorf("StockServiceRepositoryMock.GetStockTransfer got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetStockTransfer.GetStockTransferMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.transferID != nil && !minimock.Equal(*mm_want_ptrs.transferID, mm_got.transferID) {
+				mmGetStockTransfer.t.Errorf("StockServiceRepositoryMock.GetStockTransfer got unexpected parameter transferID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetStockTransfer.GetStockTransferMock.defaultExpectation.expectationOrigins.originTransferID, *mm_want_ptrs.transferID, mm_got.transferID, minimock.Diff(*mm_want_ptrs.transferID, mm_got.transferID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetStockTransfer.t.Errorf("StockServiceRepositoryMock.GetStockTransfer got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetStockTransfer.GetStockTransferMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetStockTransfer.GetStockTransferMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetStockTransfer.t.Fatal("No results are set for the StockServiceRepositoryMock.GetStockTransfer")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetStockTransfer.funcGetStockTransfer != nil {
+		return mmGetStockTransfer.funcGetStockTransfer(ctx, userID, transferID)
+	}
+	mmGetStockTransfer.t.Fatalf("Unexpected call to StockServiceRepositoryMock.GetStockTransfer. %v %v %v", ctx, userID, transferID)
+	return
+}
+
+// GetStockTransferAfterCounter returns a count of finished StockServiceRepositoryMock.GetStockTransfer invocations
+func (mmGetStockTransfer *StockServiceRepositoryMock) GetStockTransferAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetStockTransfer.afterGetStockTransferCounter)
+}
+
+// GetStockTransferBeforeCounter returns a count of StockServiceRepositoryMock.GetStockTransfer invocations
+func (mmGetStockTransfer *StockServiceRepositoryMock) GetStockTransferBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetStockTransfer.beforeGetStockTransferCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.GetStockTransfer.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetStockTransfer *mStockServiceRepositoryMockGetStockTransfer) Calls() []*StockServiceRepositoryMockGetStockTransferParams {
+	mmGetStockTransfer.mutex.RLock()
+
+	argCopy := make([]*StockServiceRepositoryMockGetStockTransferParams, len(mmGetStockTransfer.callArgs))
+	copy(argCopy, mmGetStockTransfer.callArgs)
+
+	mmGetStockTransfer.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetStockTransferDone returns true if the count of the GetStockTransfer invocations corresponds
+// the number of defined expectations
+func (m *StockServiceRepositoryMock) MinimockGetStockTransferDone() bool {
+	if m.GetStockTransferMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetStockTransferMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetStockTransferMock.invocationsDone()
+}
+
+// MinimockGetStockTransferInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockGetStockTransferInspect() {
+	for _, e := range m.GetStockTransferMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.GetStockTransfer at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetStockTransferCounter := mm_atomic.LoadUint64(&m.afterGetStockTransferCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetStockTransferMock.defaultExpectation != nil && afterGetStockTransferCounter < 1 {
+		if m.GetStockTransferMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.GetStockTransfer at\n%s", m.GetStockTransferMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.GetStockTransfer at\n%s with params: %#v", m.GetStockTransferMock.defaultExpectation.expectationOrigins.origin, *m.GetStockTransferMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetStockTransfer != nil && afterGetStockTransferCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.GetStockTransfer at\n%s", m.funcGetStockTransferOrigin)
+	}
+
+	if !m.GetStockTransferMock.invocationsDone() && afterGetStockTransferCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.GetStockTransfer at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetStockTransferMock.expectedInvocations), m.GetStockTransferMock.expectedInvocationsOrigin, afterGetStockTransferCounter)
+	}
+}
+
+type mStockServiceRepositoryMockListBundleOffers struct {
+	optional           bool
+	mock               *StockServiceRepositoryMock
+	defaultExpectation *StockServiceRepositoryMockListBundleOffersExpectation
+	expectations       []*StockServiceRepositoryMockListBundleOffersExpectation
+
+	callArgs []*StockServiceRepositoryMockListBundleOffersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceRepositoryMockListBundleOffersExpectation specifies expectation struct of the StockServiceRepository.ListBundleOffers
+type StockServiceRepositoryMockListBundleOffersExpectation struct {
+	mock               *StockServiceRepositoryMock
+	params             *StockServiceRepositoryMockListBundleOffersParams
+	paramPtrs          *StockServiceRepositoryMockListBundleOffersParamPtrs
+	expectationOrigins StockServiceRepositoryMockListBundleOffersExpectationOrigins
+	results            *StockServiceRepositoryMockListBundleOffersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceRepositoryMockListBundleOffersParams contains parameters of the StockServiceRepository.ListBundleOffers
+type StockServiceRepositoryMockListBundleOffersParams struct {
+	ctx    context.Context
+	filter domain.OfferFilter
+}
+
+// StockServiceRepositoryMockListBundleOffersParamPtrs contains pointers to parameters of the StockServiceRepository.ListBundleOffers
+type StockServiceRepositoryMockListBundleOffersParamPtrs struct {
+	ctx    *context.Context
+	filter *domain.OfferFilter
+}
+
+// StockServiceRepositoryMockListBundleOffersResults contains results of the StockServiceRepository.ListBundleOffers
+type StockServiceRepositoryMockListBundleOffersResults struct {
+	sa1 []domain.StockItem
+	err error
+}
+
+// StockServiceRepositoryMockListBundleOffersOrigins contains origins of expectations of the StockServiceRepository.ListBundleOffers
+type StockServiceRepositoryMockListBundleOffersExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListBundleOffers *mStockServiceRepositoryMockListBundleOffers) Optional() *mStockServiceRepositoryMockListBundleOffers {
+	mmListBundleOffers.optional = true
+	return mmListBundleOffers
+}
+
+// Expect sets up expected params for StockServiceRepository.ListBundleOffers
+func (mmListBundleOffers *mStockServiceRepositoryMockListBundleOffers) Expect(ctx context.Context, filter domain.OfferFilter) *mStockServiceRepositoryMockListBundleOffers {
+	if mmListBundleOffers.mock.funcListBundleOffers != nil {
+		mmListBundleOffers.mock.t.Fatalf("StockServiceRepositoryMock.ListBundleOffers mock is already set by Set")
+	}
+
+	if mmListBundleOffers.defaultExpectation == nil {
+		mmListBundleOffers.defaultExpectation = &StockServiceRepositoryMockListBundleOffersExpectation{}
+	}
+
+	if mmListBundleOffers.defaultExpectation.paramPtrs != nil {
+		mmListBundleOffers.mock.t.Fatalf("StockServiceRepositoryMock.ListBundleOffers mock is already set by ExpectParams functions")
+	}
+
+	mmListBundleOffers.defaultExpectation.params = &StockServiceRepositoryMockListBundleOffersParams{ctx, filter}
+	mmListBundleOffers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListBundleOffers.expectations {
+		if minimock.Equal(e.params, mmListBundleOffers.defaultExpectation.params) {
+			mmListBundleOffers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListBundleOffers.defaultExpectation.params)
+		}
+	}
+
+	return mmListBundleOffers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.ListBundleOffers
+func (mmListBundleOffers *mStockServiceRepositoryMockListBundleOffers) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockListBundleOffers {
+	if mmListBundleOffers.mock.funcListBundleOffers != nil {
+		mmListBundleOffers.mock.t.Fatalf("StockServiceRepositoryMock.ListBundleOffers mock is already set by Set")
+	}
+
+	if mmListBundleOffers.defaultExpectation == nil {
+		mmListBundleOffers.defaultExpectation = &StockServiceRepositoryMockListBundleOffersExpectation{}
+	}
+
+	if mmListBundleOffers.defaultExpectation.params != nil {
+		mmListBundleOffers.mock.t.Fatalf("StockServiceRepositoryMock.ListBundleOffers mock is already set by Expect")
+	}
+
+	if mmListBundleOffers.defaultExpectation.paramPtrs == nil {
+		mmListBundleOffers.defaultExpectation.paramPtrs = &StockServiceRepositoryMockListBundleOffersParamPtrs{}
+	}
+	mmListBundleOffers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListBundleOffers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListBundleOffers
+}
+
+// ExpectFilterParam2 sets up expected param filter for StockServiceRepository.ListBundleOffers
+func (mmListBundleOffers *mStockServiceRepositoryMockListBundleOffers) ExpectFilterParam2(filter domain.OfferFilter) *mStockServiceRepositoryMockListBundleOffers {
+	if mmListBundleOffers.mock.funcListBundleOffers != nil {
+		mmListBundleOffers.mock.t.Fatalf("StockServiceRepositoryMock.ListBundleOffers mock is already set by Set")
+	}
+
+	if mmListBundleOffers.defaultExpectation == nil {
+		mmListBundleOffers.defaultExpectation = &StockServiceRepositoryMockListBundleOffersExpectation{}
+	}
+
+	if mmListBundleOffers.defaultExpectation.params != nil {
+		mmListBundleOffers.mock.t.Fatalf("StockServiceRepositoryMock.ListBundleOffers mock is already set by Expect")
+	}
+
+	if mmListBundleOffers.defaultExpectation.paramPtrs == nil {
+		mmListBundleOffers.defaultExpectation.paramPtrs = &StockServiceRepositoryMockListBundleOffersParamPtrs{}
+	}
+	mmListBundleOffers.defaultExpectation.paramPtrs.filter = &filter
+	mmListBundleOffers.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListBundleOffers
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.ListBundleOffers
+func (mmListBundleOffers *mStockServiceRepositoryMockListBundleOffers) Inspect(f func(ctx context.Context, filter domain.OfferFilter)) *mStockServiceRepositoryMockListBundleOffers {
+	if mmListBundleOffers.mock.inspectFuncListBundleOffers != nil {
+		mmListBundleOffers.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.ListBundleOffers")
+	}
+
+	mmListBundleOffers.mock.inspectFuncListBundleOffers = f
+
+	return mmListBundleOffers
+}
+
+// Return sets up results that will be returned by StockServiceRepository.ListBundleOffers
+func (mmListBundleOffers *mStockServiceRepositoryMockListBundleOffers) Return(sa1 []domain.StockItem, err error) *StockServiceRepositoryMock {
+	if mmListBundleOffers.mock.funcListBundleOffers != nil {
+		mmListBundleOffers.mock.t.Fatalf("StockServiceRepositoryMock.ListBundleOffers mock is already set by Set")
+	}
+
+	if mmListBundleOffers.defaultExpectation == nil {
+		mmListBundleOffers.defaultExpectation = &StockServiceRepositoryMockListBundleOffersExpectation{mock: mmListBundleOffers.mock}
+	}
+	mmListBundleOffers.defaultExpectation.results = &StockServiceRepositoryMockListBundleOffersResults{sa1, err}
+	mmListBundleOffers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListBundleOffers.mock
+}
+
+// Set uses given function f to mock the StockServiceRepository.ListBundleOffers method
+func (mmListBundleOffers *mStockServiceRepositoryMockListBundleOffers) Set(f func(ctx context.Context, filter domain.OfferFilter) (sa1 []domain.StockItem, err error)) *StockServiceRepositoryMock {
+	if mmListBundleOffers.defaultExpectation != nil {
+		mmListBundleOffers.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.ListBundleOffers method")
+	}
+
+	if len(mmListBundleOffers.expectations) > 0 {
+		mmListBundleOffers.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.ListBundleOffers method")
+	}
+
+	mmListBundleOffers.mock.funcListBundleOffers = f
+	mmListBundleOffers.mock.funcListBundleOffersOrigin = minimock.CallerInfo(1)
+	return mmListBundleOffers.mock
+}
+
+// When sets expectation for the StockServiceRepository.ListBundleOffers which will trigger the result defined by the following
+// Then helper
+func (mmListBundleOffers *mStockServiceRepositoryMockListBundleOffers) When(ctx context.Context, filter domain.OfferFilter) *StockServiceRepositoryMockListBundleOffersExpectation {
+	if mmListBundleOffers.mock.funcListBundleOffers != nil {
+		mmListBundleOffers.mock.t.Fatalf("StockServiceRepositoryMock.ListBundleOffers mock is already set by Set")
+	}
+
+	expectation := &StockServiceRepositoryMockListBundleOffersExpectation{
+		mock:               mmListBundleOffers.mock,
+		params:             &StockServiceRepositoryMockListBundleOffersParams{ctx, filter},
+		expectationOrigins: StockServiceRepositoryMockListBundleOffersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListBundleOffers.expectations = append(mmListBundleOffers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceRepository.ListBundleOffers return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockListBundleOffersExpectation) Then(sa1 []domain.StockItem, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockListBundleOffersResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceRepository.ListBundleOffers should be invoked
+func (mmListBundleOffers *mStockServiceRepositoryMockListBundleOffers) Times(n uint64) *mStockServiceRepositoryMockListBundleOffers {
+	if n == 0 {
+		mmListBundleOffers.mock.t.Fatalf("Times of StockServiceRepositoryMock.ListBundleOffers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListBundleOffers.expectedInvocations, n)
+	mmListBundleOffers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListBundleOffers
+}
+
+func (mmListBundleOffers *mStockServiceRepositoryMockListBundleOffers) invocationsDone() bool {
+	if len(mmListBundleOffers.expectations) == 0 && mmListBundleOffers.defaultExpectation == nil && mmListBundleOffers.mock.funcListBundleOffers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListBundleOffers.mock.afterListBundleOffersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListBundleOffers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListBundleOffers implements mm_stocks.StockServiceRepository
+func (mmListBundleOffers *StockServiceRepositoryMock) ListBundleOffers(ctx context.Context, filter domain.OfferFilter) (sa1 []domain.StockItem, err error) {
+	mm_atomic.AddUint64(&mmListBundleOffers.beforeListBundleOffersCounter, 1)
+	defer mm_atomic.AddUint64(&mmListBundleOffers.afterListBundleOffersCounter, 1)
+
+	mmListBundleOffers.t.Helper()
+
+	if mmListBundleOffers.inspectFuncListBundleOffers != nil {
+		mmListBundleOffers.inspectFuncListBundleOffers(ctx, filter)
+	}
+
+	mm_params := StockServiceRepositoryMockListBundleOffersParams{ctx, filter}
+
+	// Record call args
+	mmListBundleOffers.ListBundleOffersMock.mutex.Lock()
+	mmListBundleOffers.ListBundleOffersMock.callArgs = append(mmListBundleOffers.ListBundleOffersMock.callArgs, &mm_params)
+	mmListBundleOffers.ListBundleOffersMock.mutex.Unlock()
+
+	for _, e := range mmListBundleOffers.ListBundleOffersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListBundleOffers.ListBundleOffersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListBundleOffers.ListBundleOffersMock.defaultExpectation.Counter, 1)
+		mm_want := mmListBundleOffers.ListBundleOffersMock.defaultExpectation.params
+		mm_want_ptrs := mmListBundleOffers.ListBundleOffersMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceRepositoryMockListBundleOffersParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListBundleOffers.t.Errorf("StockServiceRepositoryMock.ListBundleOffers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListBundleOffers.ListBundleOffersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListBundleOffers.t.Errorf("StockServiceRepositoryMock.ListBundleOffers got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListBundleOffers.ListBundleOffersMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListBundleOffers.t.Errorf("StockServiceRepositoryMock.ListBundleOffers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListBundleOffers.ListBundleOffersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListBundleOffers.ListBundleOffersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListBundleOffers.t.Fatal("No results are set for the StockServiceRepositoryMock.ListBundleOffers")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListBundleOffers.funcListBundleOffers != nil {
+		return mmListBundleOffers.funcListBundleOffers(ctx, filter)
+	}
+	mmListBundleOffers.t.Fatalf("Unexpected call to StockServiceRepositoryMock.ListBundleOffers. %v %v", ctx, filter)
+	return
+}
+
+// ListBundleOffersAfterCounter returns a count of finished StockServiceRepositoryMock.ListBundleOffers invocations
+func (mmListBundleOffers *StockServiceRepositoryMock) ListBundleOffersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListBundleOffers.afterListBundleOffersCounter)
+}
+
+// ListBundleOffersBeforeCounter returns a count of StockServiceRepositoryMock.ListBundleOffers invocations
+func (mmListBundleOffers *StockServiceRepositoryMock) ListBundleOffersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListBundleOffers.beforeListBundleOffersCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.ListBundleOffers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListBundleOffers *mStockServiceRepositoryMockListBundleOffers) Calls() []*StockServiceRepositoryMockListBundleOffersParams {
+	mmListBundleOffers.mutex.RLock()
+
+	argCopy := make([]*StockServiceRepositoryMockListBundleOffersParams, len(mmListBundleOffers.callArgs))
+	copy(argCopy, mmListBundleOffers.callArgs)
+
+	mmListBundleOffers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListBundleOffersDone returns true if the count of the ListBundleOffers invocations corresponds
+// the number of defined expectations
+func (m *StockServiceRepositoryMock) MinimockListBundleOffersDone() bool {
+	if m.ListBundleOffersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListBundleOffersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListBundleOffersMock.invocationsDone()
+}
+
+// MinimockListBundleOffersInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockListBundleOffersInspect() {
+	for _, e := range m.ListBundleOffersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ListBundleOffers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListBundleOffersCounter := mm_atomic.LoadUint64(&m.afterListBundleOffersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListBundleOffersMock.defaultExpectation != nil && afterListBundleOffersCounter < 1 {
+		if m.ListBundleOffersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ListBundleOffers at\n%s", m.ListBundleOffersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ListBundleOffers at\n%s with params: %#v", m.ListBundleOffersMock.defaultExpectation.expectationOrigins.origin, *m.ListBundleOffersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListBundleOffers != nil && afterListBundleOffersCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.ListBundleOffers at\n%s", m.funcListBundleOffersOrigin)
+	}
+
+	if !m.ListBundleOffersMock.invocationsDone() && afterListBundleOffersCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.ListBundleOffers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListBundleOffersMock.expectedInvocations), m.ListBundleOffersMock.expectedInvocationsOrigin, afterListBundleOffersCounter)
+	}
+}
+
+type mStockServiceRepositoryMockListDemandHistory struct {
+	optional           bool
+	mock               *StockServiceRepositoryMock
+	defaultExpectation *StockServiceRepositoryMockListDemandHistoryExpectation
+	expectations       []*StockServiceRepositoryMockListDemandHistoryExpectation
+
+	callArgs []*StockServiceRepositoryMockListDemandHistoryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceRepositoryMockListDemandHistoryExpectation specifies expectation struct of the StockServiceRepository.ListDemandHistory
+type StockServiceRepositoryMockListDemandHistoryExpectation struct {
+	mock               *StockServiceRepositoryMock
+	params             *StockServiceRepositoryMockListDemandHistoryParams
+	paramPtrs          *StockServiceRepositoryMockListDemandHistoryParamPtrs
+	expectationOrigins StockServiceRepositoryMockListDemandHistoryExpectationOrigins
+	results            *StockServiceRepositoryMockListDemandHistoryResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceRepositoryMockListDemandHistoryParams contains parameters of the StockServiceRepository.ListDemandHistory
+type StockServiceRepositoryMockListDemandHistoryParams struct {
+	ctx    context.Context
+	filter domain.ReorderFilter
+	since  time.Time
+}
+
+// StockServiceRepositoryMockListDemandHistoryParamPtrs contains pointers to parameters of the StockServiceRepository.ListDemandHistory
+type StockServiceRepositoryMockListDemandHistoryParamPtrs struct {
+	ctx    *context.Context
+	filter *domain.ReorderFilter
+	since  *time.Time
+}
+
+// StockServiceRepositoryMockListDemandHistoryResults contains results of the StockServiceRepository.ListDemandHistory
+type StockServiceRepositoryMockListDemandHistoryResults struct {
+	da1 []domain.DemandHistory
+	err error
+}
+
+// StockServiceRepositoryMockListDemandHistoryOrigins contains origins of expectations of the StockServiceRepository.ListDemandHistory
+type StockServiceRepositoryMockListDemandHistoryExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+	originSince  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListDemandHistory *mStockServiceRepositoryMockListDemandHistory) Optional() *mStockServiceRepositoryMockListDemandHistory {
+	mmListDemandHistory.optional = true
+	return mmListDemandHistory
+}
+
+// Expect sets up expected params for StockServiceRepository.ListDemandHistory
+func (mmListDemandHistory *mStockServiceRepositoryMockListDemandHistory) Expect(ctx context.Context, filter domain.ReorderFilter, since time.Time) *mStockServiceRepositoryMockListDemandHistory {
+	if mmListDemandHistory.mock.funcListDemandHistory != nil {
+		mmListDemandHistory.mock.t.Fatalf("StockServiceRepositoryMock.ListDemandHistory mock is already set by Set")
+	}
+
+	if mmListDemandHistory.defaultExpectation == nil {
+		mmListDemandHistory.defaultExpectation = &StockServiceRepositoryMockListDemandHistoryExpectation{}
+	}
+
+	if mmListDemandHistory.defaultExpectation.paramPtrs != nil {
+		mmListDemandHistory.mock.t.Fatalf("StockServiceRepositoryMock.ListDemandHistory mock is already set by ExpectParams functions")
+	}
+
+	mmListDemandHistory.defaultExpectation.params = &StockServiceRepositoryMockListDemandHistoryParams{ctx, filter, since}
+	mmListDemandHistory.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListDemandHistory.expectations {
+		if minimock.Equal(e.params, mmListDemandHistory.defaultExpectation.params) {
+			mmListDemandHistory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListDemandHistory.defaultExpectation.params)
+		}
+	}
+
+	return mmListDemandHistory
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.ListDemandHistory
+func (mmListDemandHistory *mStockServiceRepositoryMockListDemandHistory) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockListDemandHistory {
+	if mmListDemandHistory.mock.funcListDemandHistory != nil {
+		mmListDemandHistory.mock.t.Fatalf("StockServiceRepositoryMock.ListDemandHistory mock is already set by Set")
+	}
+
+	if mmListDemandHistory.defaultExpectation == nil {
+		mmListDemandHistory.defaultExpectation = &StockServiceRepositoryMockListDemandHistoryExpectation{}
+	}
+
+	if mmListDemandHistory.defaultExpectation.params != nil {
+		mmListDemandHistory.mock.t.Fatalf("StockServiceRepositoryMock.ListDemandHistory mock is already set by Expect")
+	}
+
+	if mmListDemandHistory.defaultExpectation.paramPtrs == nil {
+		mmListDemandHistory.defaultExpectation.paramPtrs = &StockServiceRepositoryMockListDemandHistoryParamPtrs{}
+	}
+	mmListDemandHistory.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListDemandHistory.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListDemandHistory
+}
+
+// ExpectFilterParam2 sets up expected param filter for StockServiceRepository.ListDemandHistory
+func (mmListDemandHistory *mStockServiceRepositoryMockListDemandHistory) ExpectFilterParam2(filter domain.ReorderFilter) *mStockServiceRepositoryMockListDemandHistory {
+	if mmListDemandHistory.mock.funcListDemandHistory != nil {
+		mmListDemandHistory.mock.t.Fatalf("StockServiceRepositoryMock.ListDemandHistory mock is already set by Set")
+	}
+
+	if mmListDemandHistory.defaultExpectation == nil {
+		mmListDemandHistory.defaultExpectation = &StockServiceRepositoryMockListDemandHistoryExpectation{}
+	}
+
+	if mmListDemandHistory.defaultExpectation.params != nil {
+		mmListDemandHistory.mock.t.Fatalf("StockServiceRepositoryMock.ListDemandHistory mock is already set by Expect")
+	}
+
+	if mmListDemandHistory.defaultExpectation.paramPtrs == nil {
+		mmListDemandHistory.defaultExpectation.paramPtrs = &StockServiceRepositoryMockListDemandHistoryParamPtrs{}
+	}
+	mmListDemandHistory.defaultExpectation.paramPtrs.filter = &filter
+	mmListDemandHistory.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListDemandHistory
+}
+
+// ExpectSinceParam3 sets up expected param since for StockServiceRepository.ListDemandHistory
+func (mmListDemandHistory *mStockServiceRepositoryMockListDemandHistory) ExpectSinceParam3(since time.Time) *mStockServiceRepositoryMockListDemandHistory {
+	if mmListDemandHistory.mock.funcListDemandHistory != nil {
+		mmListDemandHistory.mock.t.Fatalf("StockServiceRepositoryMock.ListDemandHistory mock is already set by Set")
+	}
+
+	if mmListDemandHistory.defaultExpectation == nil {
+		mmListDemandHistory.defaultExpectation = &StockServiceRepositoryMockListDemandHistoryExpectation{}
+	}
+
+	if mmListDemandHistory.defaultExpectation.params != nil {
+		mmListDemandHistory.mock.t.Fatalf("StockServiceRepositoryMock.ListDemandHistory mock is already set by Expect")
+	}
+
+	if mmListDemandHistory.defaultExpectation.paramPtrs == nil {
+		mmListDemandHistory.defaultExpectation.paramPtrs = &StockServiceRepositoryMockListDemandHistoryParamPtrs{}
+	}
+	mmListDemandHistory.defaultExpectation.paramPtrs.since = &since
+	mmListDemandHistory.defaultExpectation.expectationOrigins.originSince = minimock.CallerInfo(1)
+
+	return mmListDemandHistory
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.ListDemandHistory
+func (mmListDemandHistory *mStockServiceRepositoryMockListDemandHistory) Inspect(f func(ctx context.Context, filter domain.ReorderFilter, since time.Time)) *mStockServiceRepositoryMockListDemandHistory {
+	if mmListDemandHistory.mock.inspectFuncListDemandHistory != nil {
+		mmListDemandHistory.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.ListDemandHistory")
+	}
+
+	mmListDemandHistory.mock.inspectFuncListDemandHistory = f
+
+	return mmListDemandHistory
+}
+
+// Return sets up results that will be returned by StockServiceRepository.ListDemandHistory
+func (mmListDemandHistory *mStockServiceRepositoryMockListDemandHistory) Return(da1 []domain.DemandHistory, err error) *StockServiceRepositoryMock {
+	if mmListDemandHistory.mock.funcListDemandHistory != nil {
+		mmListDemandHistory.mock.t.Fatalf("StockServiceRepositoryMock.ListDemandHistory mock is already set by Set")
+	}
+
+	if mmListDemandHistory.defaultExpectation == nil {
+		mmListDemandHistory.defaultExpectation = &StockServiceRepositoryMockListDemandHistoryExpectation{mock: mmListDemandHistory.mock}
+	}
+	mmListDemandHistory.defaultExpectation.results = &StockServiceRepositoryMockListDemandHistoryResults{da1, err}
+	mmListDemandHistory.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListDemandHistory.mock
+}
+
+// Set uses given function f to mock the StockServiceRepository.ListDemandHistory method
+func (mmListDemandHistory *mStockServiceRepositoryMockListDemandHistory) Set(f func(ctx context.Context, filter domain.ReorderFilter, since time.Time) (da1 []domain.DemandHistory, err error)) *StockServiceRepositoryMock {
+	if mmListDemandHistory.defaultExpectation != nil {
+		mmListDemandHistory.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.ListDemandHistory method")
+	}
+
+	if len(mmListDemandHistory.expectations) > 0 {
+		mmListDemandHistory.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.ListDemandHistory method")
+	}
+
+	mmListDemandHistory.mock.funcListDemandHistory = f
+	mmListDemandHistory.mock.funcListDemandHistoryOrigin = minimock.CallerInfo(1)
+	return mmListDemandHistory.mock
+}
+
+// When sets expectation for the StockServiceRepository.ListDemandHistory which will trigger the result defined by the following
+// Then helper
+func (mmListDemandHistory *mStockServiceRepositoryMockListDemandHistory) When(ctx context.Context, filter domain.ReorderFilter, since time.Time) *StockServiceRepositoryMockListDemandHistoryExpectation {
+	if mmListDemandHistory.mock.funcListDemandHistory != nil {
+		mmListDemandHistory.mock.t.Fatalf("StockServiceRepositoryMock.ListDemandHistory mock is already set by Set")
+	}
+
+	expectation := &StockServiceRepositoryMockListDemandHistoryExpectation{
+		mock:               mmListDemandHistory.mock,
+		params:             &StockServiceRepositoryMockListDemandHistoryParams{ctx, filter, since},
+		expectationOrigins: StockServiceRepositoryMockListDemandHistoryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListDemandHistory.expectations = append(mmListDemandHistory.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceRepository.ListDemandHistory return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockListDemandHistoryExpectation) Then(da1 []domain.DemandHistory, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockListDemandHistoryResults{da1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceRepository.ListDemandHistory should be invoked
+func (mmListDemandHistory *mStockServiceRepositoryMockListDemandHistory) Times(n uint64) *mStockServiceRepositoryMockListDemandHistory {
+	if n == 0 {
+		mmListDemandHistory.mock.t.Fatalf("Times of StockServiceRepositoryMock.ListDemandHistory mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListDemandHistory.expectedInvocations, n)
+	mmListDemandHistory.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListDemandHistory
+}
+
+func (mmListDemandHistory *mStockServiceRepositoryMockListDemandHistory) invocationsDone() bool {
+	if len(mmListDemandHistory.expectations) == 0 && mmListDemandHistory.defaultExpectation == nil && mmListDemandHistory.mock.funcListDemandHistory == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListDemandHistory.mock.afterListDemandHistoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListDemandHistory.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListDemandHistory implements mm_stocks.StockServiceRepository
+func (mmListDemandHistory *StockServiceRepositoryMock) ListDemandHistory(ctx context.Context, filter domain.ReorderFilter, since time.Time) (da1 []domain.DemandHistory, err error) {
+	mm_atomic.AddUint64(&mmListDemandHistory.beforeListDemandHistoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmListDemandHistory.afterListDemandHistoryCounter, 1)
+
+	mmListDemandHistory.t.Helper()
+
+	if mmListDemandHistory.inspectFuncListDemandHistory != nil {
+		mmListDemandHistory.inspectFuncListDemandHistory(ctx, filter, since)
+	}
+
+	mm_params := StockServiceRepositoryMockListDemandHistoryParams{ctx, filter, since}
+
+	// Record call args
+	mmListDemandHistory.ListDemandHistoryMock.mutex.Lock()
+	mmListDemandHistory.ListDemandHistoryMock.callArgs = append(mmListDemandHistory.ListDemandHistoryMock.callArgs, &mm_params)
+	mmListDemandHistory.ListDemandHistoryMock.mutex.Unlock()
+
+	for _, e := range mmListDemandHistory.ListDemandHistoryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.da1, e.results.err
+		}
+	}
+
+	if mmListDemandHistory.ListDemandHistoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListDemandHistory.ListDemandHistoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmListDemandHistory.ListDemandHistoryMock.defaultExpectation.params
+		mm_want_ptrs := mmListDemandHistory.ListDemandHistoryMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceRepositoryMockListDemandHistoryParams{ctx, filter, since}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListDemandHistory.t.Errorf("StockServiceRepositoryMock.ListDemandHistory got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListDemandHistory.ListDemandHistoryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListDemandHistory.t.Errorf("StockServiceRepositoryMock.ListDemandHistory got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListDemandHistory.ListDemandHistoryMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+			if mm_want_ptrs.since != nil && !minimock.Equal(*mm_want_ptrs.since, mm_got.since) {
+				mmListDemandHistory.t.Errorf("StockServiceRepositoryMock.ListDemandHistory got unexpected parameter since, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListDemandHistory.ListDemandHistoryMock.defaultExpectation.expectationOrigins.originSince, *mm_want_ptrs.since, mm_got.since, minimock.Diff(*mm_want_ptrs.since, mm_got.since))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListDemandHistory.t.Errorf("StockServiceRepositoryMock.ListDemandHistory got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListDemandHistory.ListDemandHistoryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListDemandHistory.ListDemandHistoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListDemandHistory.t.Fatal("No results are set for the StockServiceRepositoryMock.ListDemandHistory")
+		}
+		return (*mm_results).da1, (*mm_results).err
+	}
+	if mmListDemandHistory.funcListDemandHistory != nil {
+		return mmListDemandHistory.funcListDemandHistory(ctx, filter, since)
+	}
+	mmListDemandHistory.t.Fatalf("Unexpected call to StockServiceRepositoryMock.ListDemandHistory. %v %v %v", ctx, filter, since)
+	return
+}
+
+// ListDemandHistoryAfterCounter returns a count of finished StockServiceRepositoryMock.ListDemandHistory invocations
+func (mmListDemandHistory *StockServiceRepositoryMock) ListDemandHistoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListDemandHistory.afterListDemandHistoryCounter)
+}
+
+// ListDemandHistoryBeforeCounter returns a count of StockServiceRepositoryMock.ListDemandHistory invocations
+func (mmListDemandHistory *StockServiceRepositoryMock) ListDemandHistoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListDemandHistory.beforeListDemandHistoryCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.ListDemandHistory.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListDemandHistory *mStockServiceRepositoryMockListDemandHistory) Calls() []*StockServiceRepositoryMockListDemandHistoryParams {
+	mmListDemandHistory.mutex.RLock()
+
+	argCopy := make([]*StockServiceRepositoryMockListDemandHistoryParams, len(mmListDemandHistory.callArgs))
+	copy(argCopy, mmListDemandHistory.callArgs)
+
+	mmListDemandHistory.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListDemandHistoryDone returns true if the count of the ListDemandHistory invocations corresponds
+// the number of defined expectations
+func (m *StockServiceRepositoryMock) MinimockListDemandHistoryDone() bool {
+	if m.ListDemandHistoryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListDemandHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListDemandHistoryMock.invocationsDone()
+}
+
+// MinimockListDemandHistoryInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockListDemandHistoryInspect() {
+	for _, e := range m.ListDemandHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ListDemandHistory at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListDemandHistoryCounter := mm_atomic.LoadUint64(&m.afterListDemandHistoryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListDemandHistoryMock.defaultExpectation != nil && afterListDemandHistoryCounter < 1 {
+		if m.ListDemandHistoryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ListDemandHistory at\n%s", m.ListDemandHistoryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ListDemandHistory at\n%s with params: %#v", m.ListDemandHistoryMock.defaultExpectation.expectationOrigins.origin, *m.ListDemandHistoryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListDemandHistory != nil && afterListDemandHistoryCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.ListDemandHistory at\n%s", m.funcListDemandHistoryOrigin)
+	}
+
+	if !m.ListDemandHistoryMock.invocationsDone() && afterListDemandHistoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.ListDemandHistory at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListDemandHistoryMock.expectedInvocations), m.ListDemandHistoryMock.expectedInvocationsOrigin, afterListDemandHistoryCounter)
+	}
+}
+
+type mStockServiceRepositoryMockListLotsExpiringBefore struct {
+	optional           bool
+	mock               *StockServiceRepositoryMock
+	defaultExpectation *StockServiceRepositoryMockListLotsExpiringBeforeExpectation
+	expectations       []*StockServiceRepositoryMockListLotsExpiringBeforeExpectation
+
+	callArgs []*StockServiceRepositoryMockListLotsExpiringBeforeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceRepositoryMockListLotsExpiringBeforeExpectation specifies expectation struct of the StockServiceRepository.ListLotsExpiringBefore
+type StockServiceRepositoryMockListLotsExpiringBeforeExpectation struct {
+	mock               *StockServiceRepositoryMock
+	params             *StockServiceRepositoryMockListLotsExpiringBeforeParams
+	paramPtrs          *StockServiceRepositoryMockListLotsExpiringBeforeParamPtrs
+	expectationOrigins StockServiceRepositoryMockListLotsExpiringBeforeExpectationOrigins
+	results            *StockServiceRepositoryMockListLotsExpiringBeforeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceRepositoryMockListLotsExpiringBeforeParams contains parameters of the StockServiceRepository.ListLotsExpiringBefore
+type StockServiceRepositoryMockListLotsExpiringBeforeParams struct {
+	ctx    context.Context
+	filter domain.ExpiringLotsFilter
+}
+
+// StockServiceRepositoryMockListLotsExpiringBeforeParamPtrs contains pointers to parameters of the StockServiceRepository.ListLotsExpiringBefore
+type StockServiceRepositoryMockListLotsExpiringBeforeParamPtrs struct {
+	ctx    *context.Context
+	filter *domain.ExpiringLotsFilter
+}
+
+// StockServiceRepositoryMockListLotsExpiringBeforeResults contains results of the StockServiceRepository.ListLotsExpiringBefore
+type StockServiceRepositoryMockListLotsExpiringBeforeResults struct {
+	sa1 []domain.StockLot
+	err error
+}
+
+// StockServiceRepositoryMockListLotsExpiringBeforeOrigins contains origins of expectations of the StockServiceRepository.ListLotsExpiringBefore
+type StockServiceRepositoryMockListLotsExpiringBeforeExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListLotsExpiringBefore *mStockServiceRepositoryMockListLotsExpiringBefore) Optional() *mStockServiceRepositoryMockListLotsExpiringBefore {
+	mmListLotsExpiringBefore.optional = true
+	return mmListLotsExpiringBefore
+}
+
+// Expect sets up expected params for StockServiceRepository.ListLotsExpiringBefore
+func (mmListLotsExpiringBefore *mStockServiceRepositoryMockListLotsExpiringBefore) Expect(ctx context.Context, filter domain.ExpiringLotsFilter) *mStockServiceRepositoryMockListLotsExpiringBefore {
+	if mmListLotsExpiringBefore.mock.funcListLotsExpiringBefore != nil {
+		mmListLotsExpiringBefore.mock.t.Fatalf("StockServiceRepositoryMock.ListLotsExpiringBefore mock is already set by Set")
+	}
+
+	if mmListLotsExpiringBefore.defaultExpectation == nil {
+		mmListLotsExpiringBefore.defaultExpectation = &StockServiceRepositoryMockListLotsExpiringBeforeExpectation{}
+	}
+
+	if mmListLotsExpiringBefore.defaultExpectation.paramPtrs != nil {
+		mmListLotsExpiringBefore.mock.t.Fatalf("StockServiceRepositoryMock.ListLotsExpiringBefore mock is already set by ExpectParams functions")
+	}
+
+	mmListLotsExpiringBefore.defaultExpectation.params = &StockServiceRepositoryMockListLotsExpiringBeforeParams{ctx, filter}
+	mmListLotsExpiringBefore.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListLotsExpiringBefore.expectations {
+		if minimock.Equal(e.params, mmListLotsExpiringBefore.defaultExpectation.params) {
+			mmListLotsExpiringBefore.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListLotsExpiringBefore.defaultExpectation.params)
+		}
+	}
+
+	return mmListLotsExpiringBefore
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.ListLotsExpiringBefore
+func (mmListLotsExpiringBefore *mStockServiceRepositoryMockListLotsExpiringBefore) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockListLotsExpiringBefore {
+	if mmListLotsExpiringBefore.mock.funcListLotsExpiringBefore != nil {
+		mmListLotsExpiringBefore.mock.t.Fatalf("StockServiceRepositoryMock.ListLotsExpiringBefore mock is already set by Set")
+	}
+
+	if mmListLotsExpiringBefore.defaultExpectation == nil {
+		mmListLotsExpiringBefore.defaultExpectation = &StockServiceRepositoryMockListLotsExpiringBeforeExpectation{}
+	}
+
+	if mmListLotsExpiringBefore.defaultExpectation.params != nil {
+		mmListLotsExpiringBefore.mock.t.Fatalf("StockServiceRepositoryMock.ListLotsExpiringBefore mock is already set by Expect")
+	}
+
+	if mmListLotsExpiringBefore.defaultExpectation.paramPtrs == nil {
+		mmListLotsExpiringBefore.defaultExpectation.paramPtrs = &StockServiceRepositoryMockListLotsExpiringBeforeParamPtrs{}
+	}
+	mmListLotsExpiringBefore.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListLotsExpiringBefore.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListLotsExpiringBefore
+}
+
+// ExpectFilterParam2 sets up expected param filter for StockServiceRepository.ListLotsExpiringBefore
+func (mmListLotsExpiringBefore *mStockServiceRepositoryMockListLotsExpiringBefore) ExpectFilterParam2(filter domain.ExpiringLotsFilter) *mStockServiceRepositoryMockListLotsExpiringBefore {
+	if mmListLotsExpiringBefore.mock.funcListLotsExpiringBefore != nil {
+		mmListLotsExpiringBefore.mock.t.Fatalf("StockServiceRepositoryMock.ListLotsExpiringBefore mock is already set by Set")
+	}
+
+	if mmListLotsExpiringBefore.defaultExpectation == nil {
+		mmListLotsExpiringBefore.defaultExpectation = &StockServiceRepositoryMockListLotsExpiringBeforeExpectation{}
+	}
+
+	if mmListLotsExpiringBefore.defaultExpectation.params != nil {
+		mmListLotsExpiringBefore.mock.t.Fatalf("StockServiceRepositoryMock.ListLotsExpiringBefore mock is already set by Expect")
+	}
+
+	if mmListLotsExpiringBefore.defaultExpectation.paramPtrs == nil {
+		mmListLotsExpiringBefore.defaultExpectation.paramPtrs = &StockServiceRepositoryMockListLotsExpiringBeforeParamPtrs{}
+	}
+	mmListLotsExpiringBefore.defaultExpectation.paramPtrs.filter = &filter
+	mmListLotsExpiringBefore.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListLotsExpiringBefore
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.ListLotsExpiringBefore
+func (mmListLotsExpiringBefore *mStockServiceRepositoryMockListLotsExpiringBefore) Inspect(f func(ctx context.Context, filter domain.ExpiringLotsFilter)) *mStockServiceRepositoryMockListLotsExpiringBefore {
+	if mmListLotsExpiringBefore.mock.inspectFuncListLotsExpiringBefore != nil {
+		mmListLotsExpiringBefore.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.ListLotsExpiringBefore")
+	}
+
+	mmListLotsExpiringBefore.mock.inspectFuncListLotsExpiringBefore = f
+
+	return mmListLotsExpiringBefore
+}
+
+// Return sets up results that will be returned by StockServiceRepository.ListLotsExpiringBefore
+func (mmListLotsExpiringBefore *mStockServiceRepositoryMockListLotsExpiringBefore) Return(sa1 []domain.StockLot, err error) *StockServiceRepositoryMock {
+	if mmListLotsExpiringBefore.mock.funcListLotsExpiringBefore != nil {
+		mmListLotsExpiringBefore.mock.t.Fatalf("StockServiceRepositoryMock.ListLotsExpiringBefore mock is already set by Set")
+	}
+
+	if mmListLotsExpiringBefore.defaultExpectation == nil {
+		mmListLotsExpiringBefore.defaultExpectation = &StockServiceRepositoryMockListLotsExpiringBeforeExpectation{mock: mmListLotsExpiringBefore.mock}
+	}
+	mmListLotsExpiringBefore.defaultExpectation.results = &StockServiceRepositoryMockListLotsExpiringBeforeResults{sa1, err}
+	mmListLotsExpiringBefore.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListLotsExpiringBefore.mock
+}
+
+// Set uses given function f to mock the StockServiceRepository.ListLotsExpiringBefore method
+func (mmListLotsExpiringBefore *mStockServiceRepositoryMockListLotsExpiringBefore) Set(f func(ctx context.Context, filter domain.ExpiringLotsFilter) (sa1 []domain.StockLot, err error)) *StockServiceRepositoryMock {
+	if mmListLotsExpiringBefore.defaultExpectation != nil {
+		mmListLotsExpiringBefore.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.ListLotsExpiringBefore method")
+	}
+
+	if len(mmListLotsExpiringBefore.expectations) > 0 {
+		mmListLotsExpiringBefore.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.ListLotsExpiringBefore method")
+	}
+
+	mmListLotsExpiringBefore.mock.funcListLotsExpiringBefore = f
+	mmListLotsExpiringBefore.mock.funcListLotsExpiringBeforeOrigin = minimock.CallerInfo(1)
+	return mmListLotsExpiringBefore.mock
+}
+
+// When sets expectation for the StockServiceRepository.ListLotsExpiringBefore which will trigger the result defined by the following
+// Then helper
+func (mmListLotsExpiringBefore *mStockServiceRepositoryMockListLotsExpiringBefore) When(ctx context.Context, filter domain.ExpiringLotsFilter) *StockServiceRepositoryMockListLotsExpiringBeforeExpectation {
+	if mmListLotsExpiringBefore.mock.funcListLotsExpiringBefore != nil {
+		mmListLotsExpiringBefore.mock.t.Fatalf("StockServiceRepositoryMock.ListLotsExpiringBefore mock is already set by Set")
+	}
+
+	expectation := &StockServiceRepositoryMockListLotsExpiringBeforeExpectation{
+		mock:               mmListLotsExpiringBefore.mock,
+		params:             &StockServiceRepositoryMockListLotsExpiringBeforeParams{ctx, filter},
+		expectationOrigins: StockServiceRepositoryMockListLotsExpiringBeforeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListLotsExpiringBefore.expectations = append(mmListLotsExpiringBefore.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceRepository.ListLotsExpiringBefore return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockListLotsExpiringBeforeExpectation) Then(sa1 []domain.StockLot, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockListLotsExpiringBeforeResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceRepository.ListLotsExpiringBefore should be invoked
+func (mmListLotsExpiringBefore *mStockServiceRepositoryMockListLotsExpiringBefore) Times(n uint64) *mStockServiceRepositoryMockListLotsExpiringBefore {
+	if n == 0 {
+		mmListLotsExpiringBefore.mock.t.Fatalf("Times of StockServiceRepositoryMock.ListLotsExpiringBefore mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListLotsExpiringBefore.expectedInvocations, n)
+	mmListLotsExpiringBefore.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListLotsExpiringBefore
+}
+
+func (mmListLotsExpiringBefore *mStockServiceRepositoryMockListLotsExpiringBefore) invocationsDone() bool {
+	if len(mmListLotsExpiringBefore.expectations) == 0 && mmListLotsExpiringBefore.defaultExpectation == nil && mmListLotsExpiringBefore.mock.funcListLotsExpiringBefore == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListLotsExpiringBefore.mock.afterListLotsExpiringBeforeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListLotsExpiringBefore.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListLotsExpiringBefore implements mm_stocks.StockServiceRepository
+func (mmListLotsExpiringBefore *StockServiceRepositoryMock) ListLotsExpiringBefore(ctx context.Context, filter domain.ExpiringLotsFilter) (sa1 []domain.StockLot, err error) {
+	mm_atomic.AddUint64(&mmListLotsExpiringBefore.beforeListLotsExpiringBeforeCounter, 1)
+	defer mm_atomic.AddUint64(&mmListLotsExpiringBefore.afterListLotsExpiringBeforeCounter, 1)
+
+	mmListLotsExpiringBefore.t.Helper()
+
+	if mmListLotsExpiringBefore.inspectFuncListLotsExpiringBefore != nil {
+		mmListLotsExpiringBefore.inspectFuncListLotsExpiringBefore(ctx, filter)
+	}
+
+	mm_params := StockServiceRepositoryMockListLotsExpiringBeforeParams{ctx, filter}
+
+	// Record call args
+	mmListLotsExpiringBefore.ListLotsExpiringBeforeMock.mutex.Lock()
+	mmListLotsExpiringBefore.ListLotsExpiringBeforeMock.callArgs = append(mmListLotsExpiringBefore.ListLotsExpiringBeforeMock.callArgs, &mm_params)
+	mmListLotsExpiringBefore.ListLotsExpiringBeforeMock.mutex.Unlock()
+
+	for _, e := range mmListLotsExpiringBefore.ListLotsExpiringBeforeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListLotsExpiringBefore.ListLotsExpiringBeforeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListLotsExpiringBefore.ListLotsExpiringBeforeMock.defaultExpectation.Counter, 1)
+		mm_want := mmListLotsExpiringBefore.ListLotsExpiringBeforeMock.defaultExpectation.params
+		mm_want_ptrs := mmListLotsExpiringBefore.ListLotsExpiringBeforeMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceRepositoryMockListLotsExpiringBeforeParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListLotsExpiringBefore.t.Errorf("StockServiceRepositoryMock.ListLotsExpiringBefore got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListLotsExpiringBefore.ListLotsExpiringBeforeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListLotsExpiringBefore.t.Errorf("StockServiceRepositoryMock.ListLotsExpiringBefore got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListLotsExpiringBefore.ListLotsExpiringBeforeMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListLotsExpiringBefore.t.Errorf("StockServiceRepositoryMock.ListLotsExpiringBefore got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListLotsExpiringBefore.ListLotsExpiringBeforeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListLotsExpiringBefore.ListLotsExpiringBeforeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListLotsExpiringBefore.t.Fatal("No results are set for the StockServiceRepositoryMock.ListLotsExpiringBefore")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListLotsExpiringBefore.funcListLotsExpiringBefore != nil {
+		return mmListLotsExpiringBefore.funcListLotsExpiringBefore(ctx, filter)
+	}
+	mmListLotsExpiringBefore.t.Fatalf("Unexpected call to StockServiceRepositoryMock.ListLotsExpiringBefore. %v %v", ctx, filter)
+	return
+}
+
+// ListLotsExpiringBeforeAfterCounter returns a count of finished StockServiceRepositoryMock.ListLotsExpiringBefore invocations
+func (mmListLotsExpiringBefore *StockServiceRepositoryMock) ListLotsExpiringBeforeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListLotsExpiringBefore.afterListLotsExpiringBeforeCounter)
+}
+
+// ListLotsExpiringBeforeBeforeCounter returns a count of StockServiceRepositoryMock.ListLotsExpiringBefore invocations
+func (mmListLotsExpiringBefore *StockServiceRepositoryMock) ListLotsExpiringBeforeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListLotsExpiringBefore.beforeListLotsExpiringBeforeCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.ListLotsExpiringBefore.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListLotsExpiringBefore *mStockServiceRepositoryMockListLotsExpiringBefore) Calls() []*StockServiceRepositoryMockListLotsExpiringBeforeParams {
+	mmListLotsExpiringBefore.mutex.RLock()
+
+	argCopy := make([]*StockServiceRepositoryMockListLotsExpiringBeforeParams, len(mmListLotsExpiringBefore.callArgs))
+	copy(argCopy, mmListLotsExpiringBefore.callArgs)
+
+	mmListLotsExpiringBefore.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListLotsExpiringBeforeDone returns true if the count of the ListLotsExpiringBefore invocations corresponds
+// the number of defined expectations
+func (m *StockServiceRepositoryMock) MinimockListLotsExpiringBeforeDone() bool {
+	if m.ListLotsExpiringBeforeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListLotsExpiringBeforeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListLotsExpiringBeforeMock.invocationsDone()
+}
+
+// MinimockListLotsExpiringBeforeInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockListLotsExpiringBeforeInspect() {
+	for _, e := range m.ListLotsExpiringBeforeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ListLotsExpiringBefore at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListLotsExpiringBeforeCounter := mm_atomic.LoadUint64(&m.afterListLotsExpiringBeforeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListLotsExpiringBeforeMock.defaultExpectation != nil && afterListLotsExpiringBeforeCounter < 1 {
+		if m.ListLotsExpiringBeforeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ListLotsExpiringBefore at\n%s", m.ListLotsExpiringBeforeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ListLotsExpiringBefore at\n%s with params: %#v", m.ListLotsExpiringBeforeMock.defaultExpectation.expectationOrigins.origin, *m.ListLotsExpiringBeforeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListLotsExpiringBefore != nil && afterListLotsExpiringBeforeCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.ListLotsExpiringBefore at\n%s", m.funcListLotsExpiringBeforeOrigin)
+	}
+
+	if !m.ListLotsExpiringBeforeMock.invocationsDone() && afterListLotsExpiringBeforeCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.ListLotsExpiringBefore at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListLotsExpiringBeforeMock.expectedInvocations), m.ListLotsExpiringBeforeMock.expectedInvocationsOrigin, afterListLotsExpiringBeforeCounter)
+	}
+}
+
+type mStockServiceRepositoryMockListStockItemOffers struct {
+	optional           bool
+	mock               *StockServiceRepositoryMock
+	defaultExpectation *StockServiceRepositoryMockListStockItemOffersExpectation
+	expectations       []*StockServiceRepositoryMockListStockItemOffersExpectation
+
+	callArgs []*StockServiceRepositoryMockListStockItemOffersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceRepositoryMockListStockItemOffersExpectation specifies expectation struct of the StockServiceRepository.ListStockItemOffers
+type StockServiceRepositoryMockListStockItemOffersExpectation struct {
+	mock               *StockServiceRepositoryMock
+	params             *StockServiceRepositoryMockListStockItemOffersParams
+	paramPtrs          *StockServiceRepositoryMockListStockItemOffersParamPtrs
+	expectationOrigins StockServiceRepositoryMockListStockItemOffersExpectationOrigins
+	results            *StockServiceRepositoryMockListStockItemOffersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceRepositoryMockListStockItemOffersParams contains parameters of the StockServiceRepository.ListStockItemOffers
+type StockServiceRepositoryMockListStockItemOffersParams struct {
+	ctx    context.Context
+	filter domain.OfferFilter
+}
+
+// StockServiceRepositoryMockListStockItemOffersParamPtrs contains pointers to parameters of the StockServiceRepository.ListStockItemOffers
+type StockServiceRepositoryMockListStockItemOffersParamPtrs struct {
+	ctx    *context.Context
+	filter *domain.OfferFilter
+}
+
+// StockServiceRepositoryMockListStockItemOffersResults contains results of the StockServiceRepository.ListStockItemOffers
+type StockServiceRepositoryMockListStockItemOffersResults struct {
+	sa1 []domain.StockItem
+	err error
+}
+
+// StockServiceRepositoryMockListStockItemOffersOrigins contains origins of expectations of the StockServiceRepository.ListStockItemOffers
+type StockServiceRepositoryMockListStockItemOffersExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListStockItemOffers *mStockServiceRepositoryMockListStockItemOffers) Optional() *mStockServiceRepositoryMockListStockItemOffers {
+	mmListStockItemOffers.optional = true
+	return mmListStockItemOffers
+}
+
+// Expect sets up expected params for StockServiceRepository.ListStockItemOffers
+func (mmListStockItemOffers *mStockServiceRepositoryMockListStockItemOffers) Expect(ctx context.Context, filter domain.OfferFilter) *mStockServiceRepositoryMockListStockItemOffers {
+	if mmListStockItemOffers.mock.funcListStockItemOffers != nil {
+		mmListStockItemOffers.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemOffers mock is already set by Set")
+	}
+
+	if mmListStockItemOffers.defaultExpectation == nil {
+		mmListStockItemOffers.defaultExpectation = &StockServiceRepositoryMockListStockItemOffersExpectation{}
+	}
+
+	if mmListStockItemOffers.defaultExpectation.paramPtrs != nil {
+		mmListStockItemOffers.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemOffers mock is already set by ExpectParams functions")
+	}
+
+	mmListStockItemOffers.defaultExpectation.params = &StockServiceRepositoryMockListStockItemOffersParams{ctx, filter}
+	mmListStockItemOffers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListStockItemOffers.expectations {
+		if minimock.Equal(e.params, mmListStockItemOffers.defaultExpectation.params) {
+			mmListStockItemOffers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListStockItemOffers.defaultExpectation.params)
+		}
+	}
+
+	return mmListStockItemOffers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.ListStockItemOffers
+func (mmListStockItemOffers *mStockServiceRepositoryMockListStockItemOffers) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockListStockItemOffers {
+	if mmListStockItemOffers.mock.funcListStockItemOffers != nil {
+		mmListStockItemOffers.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemOffers mock is already set by Set")
+	}
+
+	if mmListStockItemOffers.defaultExpectation == nil {
+		mmListStockItemOffers.defaultExpectation = &StockServiceRepositoryMockListStockItemOffersExpectation{}
+	}
+
+	if mmListStockItemOffers.defaultExpectation.params != nil {
+		mmListStockItemOffers.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemOffers mock is already set by Expect")
+	}
+
+	if mmListStockItemOffers.defaultExpectation.paramPtrs == nil {
+		mmListStockItemOffers.defaultExpectation.paramPtrs = &StockServiceRepositoryMockListStockItemOffersParamPtrs{}
+	}
+	mmListStockItemOffers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListStockItemOffers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListStockItemOffers
+}
+
+// ExpectFilterParam2 sets up expected param filter for StockServiceRepository.ListStockItemOffers
+func (mmListStockItemOffers *mStockServiceRepositoryMockListStockItemOffers) ExpectFilterParam2(filter domain.OfferFilter) *mStockServiceRepositoryMockListStockItemOffers {
+	if mmListStockItemOffers.mock.funcListStockItemOffers != nil {
+		mmListStockItemOffers.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemOffers mock is already set by Set")
+	}
+
+	if mmListStockItemOffers.defaultExpectation == nil {
+		mmListStockItemOffers.defaultExpectation = &StockServiceRepositoryMockListStockItemOffersExpectation{}
+	}
+
+	if mmListStockItemOffers.defaultExpectation.params != nil {
+		mmListStockItemOffers.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemOffers mock is already set by Expect")
+	}
+
+	if mmListStockItemOffers.defaultExpectation.paramPtrs == nil {
+		mmListStockItemOffers.defaultExpectation.paramPtrs = &StockServiceRepositoryMockListStockItemOffersParamPtrs{}
+	}
+	mmListStockItemOffers.defaultExpectation.paramPtrs.filter = &filter
+	mmListStockItemOffers.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListStockItemOffers
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.ListStockItemOffers
+func (mmListStockItemOffers *mStockServiceRepositoryMockListStockItemOffers) Inspect(f func(ctx context.Context, filter domain.OfferFilter)) *mStockServiceRepositoryMockListStockItemOffers {
+	if mmListStockItemOffers.mock.inspectFuncListStockItemOffers != nil {
+		mmListStockItemOffers.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.ListStockItemOffers")
+	}
+
+	mmListStockItemOffers.mock.inspectFuncListStockItemOffers = f
+
+	return mmListStockItemOffers
+}
+
+// Return sets up results that will be returned by StockServiceRepository.ListStockItemOffers
+func (mmListStockItemOffers *mStockServiceRepositoryMockListStockItemOffers) Return(sa1 []domain.StockItem, err error) *StockServiceRepositoryMock {
+	if mmListStockItemOffers.mock.funcListStockItemOffers != nil {
+		mmListStockItemOffers.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemOffers mock is already set by Set")
+	}
+
+	if mmListStockItemOffers.defaultExpectation == nil {
+		mmListStockItemOffers.defaultExpectation = &StockServiceRepositoryMockListStockItemOffersExpectation{mock: mmListStockItemOffers.mock}
+	}
+	mmListStockItemOffers.defaultExpectation.results = &StockServiceRepositoryMockListStockItemOffersResults{sa1, err}
+	mmListStockItemOffers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListStockItemOffers.mock
+}
+
+// Set uses given function f to mock the StockServiceRepository.ListStockItemOffers method
+func (mmListStockItemOffers *mStockServiceRepositoryMockListStockItemOffers) Set(f func(ctx context.Context, filter domain.OfferFilter) (sa1 []domain.StockItem, err error)) *StockServiceRepositoryMock {
+	if mmListStockItemOffers.defaultExpectation != nil {
+		mmListStockItemOffers.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.ListStockItemOffers method")
+	}
+
+	if len(mmListStockItemOffers.expectations) > 0 {
+		mmListStockItemOffers.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.ListStockItemOffers method")
+	}
+
+	mmListStockItemOffers.mock.funcListStockItemOffers = f
+	mmListStockItemOffers.mock.funcListStockItemOffersOrigin = minimock.CallerInfo(1)
+	return mmListStockItemOffers.mock
+}
+
+// When sets expectation for the StockServiceRepository.ListStockItemOffers which will trigger the result defined by the following
+// Then helper
+func (mmListStockItemOffers *mStockServiceRepositoryMockListStockItemOffers) When(ctx context.Context, filter domain.OfferFilter) *StockServiceRepositoryMockListStockItemOffersExpectation {
+	if mmListStockItemOffers.mock.funcListStockItemOffers != nil {
+		mmListStockItemOffers.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemOffers mock is already set by Set")
+	}
+
+	expectation := &StockServiceRepositoryMockListStockItemOffersExpectation{
+		mock:               mmListStockItemOffers.mock,
+		params:             &StockServiceRepositoryMockListStockItemOffersParams{ctx, filter},
+		expectationOrigins: StockServiceRepositoryMockListStockItemOffersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListStockItemOffers.expectations = append(mmListStockItemOffers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceRepository.ListStockItemOffers return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockListStockItemOffersExpectation) Then(sa1 []domain.StockItem, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockListStockItemOffersResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceRepository.ListStockItemOffers should be invoked
+func (mmListStockItemOffers *mStockServiceRepositoryMockListStockItemOffers) Times(n uint64) *mStockServiceRepositoryMockListStockItemOffers {
+	if n == 0 {
+		mmListStockItemOffers.mock.t.Fatalf("Times of StockServiceRepositoryMock.ListStockItemOffers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListStockItemOffers.expectedInvocations, n)
+	mmListStockItemOffers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListStockItemOffers
+}
+
+func (mmListStockItemOffers *mStockServiceRepositoryMockListStockItemOffers) invocationsDone() bool {
+	if len(mmListStockItemOffers.expectations) == 0 && mmListStockItemOffers.defaultExpectation == nil && mmListStockItemOffers.mock.funcListStockItemOffers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListStockItemOffers.mock.afterListStockItemOffersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListStockItemOffers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListStockItemOffers implements mm_stocks.StockServiceRepository
+func (mmListStockItemOffers *StockServiceRepositoryMock) ListStockItemOffers(ctx context.Context, filter domain.OfferFilter) (sa1 []domain.StockItem, err error) {
+	mm_atomic.AddUint64(&mmListStockItemOffers.beforeListStockItemOffersCounter, 1)
+	defer mm_atomic.AddUint64(&mmListStockItemOffers.afterListStockItemOffersCounter, 1)
+
+	mmListStockItemOffers.t.Helper()
+
+	if mmListStockItemOffers.inspectFuncListStockItemOffers != nil {
+		mmListStockItemOffers.inspectFuncListStockItemOffers(ctx, filter)
+	}
+
+	mm_params := StockServiceRepositoryMockListStockItemOffersParams{ctx, filter}
+
+	// Record call args
+	mmListStockItemOffers.ListStockItemOffersMock.mutex.Lock()
+	mmListStockItemOffers.ListStockItemOffersMock.callArgs = append(mmListStockItemOffers.ListStockItemOffersMock.callArgs, &mm_params)
+	mmListStockItemOffers.ListStockItemOffersMock.mutex.Unlock()
+
+	for _, e := range mmListStockItemOffers.ListStockItemOffersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListStockItemOffers.ListStockItemOffersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListStockItemOffers.ListStockItemOffersMock.defaultExpectation.Counter, 1)
+		mm_want := mmListStockItemOffers.ListStockItemOffersMock.defaultExpectation.params
+		mm_want_ptrs := mmListStockItemOffers.ListStockItemOffersMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceRepositoryMockListStockItemOffersParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListStockItemOffers.t.Errorf("StockServiceRepositoryMock.ListStockItemOffers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListStockItemOffers.ListStockItemOffersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListStockItemOffers.t.Errorf("StockServiceRepositoryMock.ListStockItemOffers got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListStockItemOffers.ListStockItemOffersMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListStockItemOffers.t.Errorf("StockServiceRepositoryMock.ListStockItemOffers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListStockItemOffers.ListStockItemOffersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListStockItemOffers.ListStockItemOffersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListStockItemOffers.t.Fatal("No results are set for the StockServiceRepositoryMock.ListStockItemOffers")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListStockItemOffers.funcListStockItemOffers != nil {
+		return mmListStockItemOffers.funcListStockItemOffers(ctx, filter)
+	}
+	mmListStockItemOffers.t.Fatalf("Unexpected call to StockServiceRepositoryMock.ListStockItemOffers. %v %v", ctx, filter)
+	return
+}
+
+// ListStockItemOffersAfterCounter returns a count of finished StockServiceRepositoryMock.ListStockItemOffers invocations
+func (mmListStockItemOffers *StockServiceRepositoryMock) ListStockItemOffersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListStockItemOffers.afterListStockItemOffersCounter)
+}
+
+// ListStockItemOffersBeforeCounter returns a count of StockServiceRepositoryMock.ListStockItemOffers invocations
+func (mmListStockItemOffers *StockServiceRepositoryMock) ListStockItemOffersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListStockItemOffers.beforeListStockItemOffersCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.ListStockItemOffers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListStockItemOffers *mStockServiceRepositoryMockListStockItemOffers) Calls() []*StockServiceRepositoryMockListStockItemOffersParams {
+	mmListStockItemOffers.mutex.RLock()
+
+	argCopy := make([]*StockServiceRepositoryMockListStockItemOffersParams, len(mmListStockItemOffers.callArgs))
+	copy(argCopy, mmListStockItemOffers.callArgs)
+
+	mmListStockItemOffers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListStockItemOffersDone returns true if the count of the ListStockItemOffers invocations corresponds
+// the number of defined expectations
+func (m *StockServiceRepositoryMock) MinimockListStockItemOffersDone() bool {
+	if m.ListStockItemOffersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListStockItemOffersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListStockItemOffersMock.invocationsDone()
+}
+
+// MinimockListStockItemOffersInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockListStockItemOffersInspect() {
+	for _, e := range m.ListStockItemOffersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ListStockItemOffers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListStockItemOffersCounter := mm_atomic.LoadUint64(&m.afterListStockItemOffersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListStockItemOffersMock.defaultExpectation != nil && afterListStockItemOffersCounter < 1 {
+		if m.ListStockItemOffersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ListStockItemOffers at\n%s", m.ListStockItemOffersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ListStockItemOffers at\n%s with params: %#v", m.ListStockItemOffersMock.defaultExpectation.expectationOrigins.origin, *m.ListStockItemOffersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListStockItemOffers != nil && afterListStockItemOffersCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.ListStockItemOffers at\n%s", m.funcListStockItemOffersOrigin)
+	}
+
+	if !m.ListStockItemOffersMock.invocationsDone() && afterListStockItemOffersCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.ListStockItemOffers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListStockItemOffersMock.expectedInvocations), m.ListStockItemOffersMock.expectedInvocationsOrigin, afterListStockItemOffersCounter)
+	}
+}
+
+type mStockServiceRepositoryMockListStockItemsByLocation struct {
+	optional           bool
+	mock               *StockServiceRepositoryMock
+	defaultExpectation *StockServiceRepositoryMockListStockItemsByLocationExpectation
+	expectations       []*StockServiceRepositoryMockListStockItemsByLocationExpectation
+
+	callArgs []*StockServiceRepositoryMockListStockItemsByLocationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceRepositoryMockListStockItemsByLocationExpectation specifies expectation struct of the StockServiceRepository.ListStockItemsByLocation
+type StockServiceRepositoryMockListStockItemsByLocationExpectation struct {
+	mock               *StockServiceRepositoryMock
+	params             *StockServiceRepositoryMockListStockItemsByLocationParams
+	paramPtrs          *StockServiceRepositoryMockListStockItemsByLocationParamPtrs
+	expectationOrigins StockServiceRepositoryMockListStockItemsByLocationExpectationOrigins
+	results            *StockServiceRepositoryMockListStockItemsByLocationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceRepositoryMockListStockItemsByLocationParams contains parameters of the StockServiceRepository.ListStockItemsByLocation
+type StockServiceRepositoryMockListStockItemsByLocationParams struct {
+	ctx    context.Context
+	filter domain.Filter
+}
+
+// StockServiceRepositoryMockListStockItemsByLocationParamPtrs contains pointers to parameters of the StockServiceRepository.ListStockItemsByLocation
+type StockServiceRepositoryMockListStockItemsByLocationParamPtrs struct {
+	ctx    *context.Context
+	filter *domain.Filter
+}
+
+// StockServiceRepositoryMockListStockItemsByLocationResults contains results of the StockServiceRepository.ListStockItemsByLocation
+type StockServiceRepositoryMockListStockItemsByLocationResults struct {
+	sa1 []domain.StockItem
+	err error
+}
+
+// StockServiceRepositoryMockListStockItemsByLocationOrigins contains origins of expectations of the StockServiceRepository.ListStockItemsByLocation
+type StockServiceRepositoryMockListStockItemsByLocationExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListStockItemsByLocation *mStockServiceRepositoryMockListStockItemsByLocation) Optional() *mStockServiceRepositoryMockListStockItemsByLocation {
+	mmListStockItemsByLocation.optional = true
+	return mmListStockItemsByLocation
+}
+
+// Expect sets up expected params for StockServiceRepository.ListStockItemsByLocation
+func (mmListStockItemsByLocation *mStockServiceRepositoryMockListStockItemsByLocation) Expect(ctx context.Context, filter domain.Filter) *mStockServiceRepositoryMockListStockItemsByLocation {
+	if mmListStockItemsByLocation.mock.funcListStockItemsByLocation != nil {
+		mmListStockItemsByLocation.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemsByLocation mock is already set by Set")
+	}
+
+	if mmListStockItemsByLocation.defaultExpectation == nil {
+		mmListStockItemsByLocation.defaultExpectation = &StockServiceRepositoryMockListStockItemsByLocationExpectation{}
+	}
+
+	if mmListStockItemsByLocation.defaultExpectation.paramPtrs != nil {
+		mmListStockItemsByLocation.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemsByLocation mock is already set by ExpectParams functions")
+	}
+
+	mmListStockItemsByLocation.defaultExpectation.params = &StockServiceRepositoryMockListStockItemsByLocationParams{ctx, filter}
+	mmListStockItemsByLocation.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListStockItemsByLocation.expectations {
+		if minimock.Equal(e.params, mmListStockItemsByLocation.defaultExpectation.params) {
+			mmListStockItemsByLocation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListStockItemsByLocation.defaultExpectation.params)
+		}
+	}
+
+	return mmListStockItemsByLocation
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.ListStockItemsByLocation
+func (mmListStockItemsByLocation *mStockServiceRepositoryMockListStockItemsByLocation) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockListStockItemsByLocation {
+	if mmListStockItemsByLocation.mock.funcListStockItemsByLocation != nil {
+		mmListStockItemsByLocation.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemsByLocation mock is already set by Set")
+	}
+
+	if mmListStockItemsByLocation.defaultExpectation == nil {
+		mmListStockItemsByLocation.defaultExpectation = &StockServiceRepositoryMockListStockItemsByLocationExpectation{}
+	}
+
+	if mmListStockItemsByLocation.defaultExpectation.params != nil {
+		mmListStockItemsByLocation.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemsByLocation mock is already set by Expect")
+	}
+
+	if mmListStockItemsByLocation.defaultExpectation.paramPtrs == nil {
+		mmListStockItemsByLocation.defaultExpectation.paramPtrs = &StockServiceRepositoryMockListStockItemsByLocationParamPtrs{}
+	}
+	mmListStockItemsByLocation.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListStockItemsByLocation.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListStockItemsByLocation
+}
+
+// ExpectFilterParam2 sets up expected param filter for StockServiceRepository.ListStockItemsByLocation
+func (mmListStockItemsByLocation *mStockServiceRepositoryMockListStockItemsByLocation) ExpectFilterParam2(filter domain.Filter) *mStockServiceRepositoryMockListStockItemsByLocation {
+	if mmListStockItemsByLocation.mock.funcListStockItemsByLocation != nil {
+		mmListStockItemsByLocation.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemsByLocation mock is already set by Set")
+	}
+
+	if mmListStockItemsByLocation.defaultExpectation == nil {
+		mmListStockItemsByLocation.defaultExpectation = &StockServiceRepositoryMockListStockItemsByLocationExpectation{}
+	}
+
+	if mmListStockItemsByLocation.defaultExpectation.params != nil {
+		mmListStockItemsByLocation.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemsByLocation mock is already set by Expect")
+	}
+
+	if mmListStockItemsByLocation.defaultExpectation.paramPtrs == nil {
+		mmListStockItemsByLocation.defaultExpectation.paramPtrs = &StockServiceRepositoryMockListStockItemsByLocationParamPtrs{}
+	}
+	mmListStockItemsByLocation.defaultExpectation.paramPtrs.filter = &filter
+	mmListStockItemsByLocation.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListStockItemsByLocation
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.ListStockItemsByLocation
+func (mmListStockItemsByLocation *mStockServiceRepositoryMockListStockItemsByLocation) Inspect(f func(ctx context.Context, filter domain.Filter)) *mStockServiceRepositoryMockListStockItemsByLocation {
+	if mmListStockItemsByLocation.mock.inspectFuncListStockItemsByLocation != nil {
+		mmListStockItemsByLocation.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.ListStockItemsByLocation")
+	}
+
+	mmListStockItemsByLocation.mock.inspectFuncListStockItemsByLocation = f
+
+	return mmListStockItemsByLocation
+}
+
+// Return sets up results that will be returned by StockServiceRepository.ListStockItemsByLocation
+func (mmListStockItemsByLocation *mStockServiceRepositoryMockListStockItemsByLocation) Return(sa1 []domain.StockItem, err error) *StockServiceRepositoryMock {
+	if mmListStockItemsByLocation.mock.funcListStockItemsByLocation != nil {
+		mmListStockItemsByLocation.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemsByLocation mock is already set by Set")
+	}
+
+	if mmListStockItemsByLocation.defaultExpectation == nil {
+		mmListStockItemsByLocation.defaultExpectation = &StockServiceRepositoryMockListStockItemsByLocationExpectation{mock: mmListStockItemsByLocation.mock}
+	}
+	mmListStockItemsByLocation.defaultExpectation.results = &StockServiceRepositoryMockListStockItemsByLocationResults{sa1, err}
+	mmListStockItemsByLocation.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListStockItemsByLocation.mock
+}
+
+// Set uses given function f to mock the StockServiceRepository.ListStockItemsByLocation method
+func (mmListStockItemsByLocation *mStockServiceRepositoryMockListStockItemsByLocation) Set(f func(ctx context.Context, filter domain.Filter) (sa1 []domain.StockItem, err error)) *StockServiceRepositoryMock {
+	if mmListStockItemsByLocation.defaultExpectation != nil {
+		mmListStockItemsByLocation.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.ListStockItemsByLocation method")
+	}
+
+	if len(mmListStockItemsByLocation.expectations) > 0 {
+		mmListStockItemsByLocation.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.ListStockItemsByLocation method")
+	}
+
+	mmListStockItemsByLocation.mock.funcListStockItemsByLocation = f
+	mmListStockItemsByLocation.mock.funcListStockItemsByLocationOrigin = minimock.CallerInfo(1)
+	return mmListStockItemsByLocation.mock
+}
+
+// When sets expectation for the StockServiceRepository.ListStockItemsByLocation which will trigger the result defined by the following
+// Then helper
+func (mmListStockItemsByLocation *mStockServiceRepositoryMockListStockItemsByLocation) When(ctx context.Context, filter domain.Filter) *StockServiceRepositoryMockListStockItemsByLocationExpectation {
+	if mmListStockItemsByLocation.mock.funcListStockItemsByLocation != nil {
+		mmListStockItemsByLocation.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemsByLocation mock is already set by Set")
+	}
+
+	expectation := &StockServiceRepositoryMockListStockItemsByLocationExpectation{
+		mock:               mmListStockItemsByLocation.mock,
+		params:             &StockServiceRepositoryMockListStockItemsByLocationParams{ctx, filter},
+		expectationOrigins: StockServiceRepositoryMockListStockItemsByLocationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListStockItemsByLocation.expectations = append(mmListStockItemsByLocation.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceRepository.ListStockItemsByLocation return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockListStockItemsByLocationExpectation) Then(sa1 []domain.StockItem, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockListStockItemsByLocationResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceRepository.ListStockItemsByLocation should be invoked
+func (mmListStockItemsByLocation *mStockServiceRepositoryMockListStockItemsByLocation) Times(n uint64) *mStockServiceRepositoryMockListStockItemsByLocation {
+	if n == 0 {
+		mmListStockItemsByLocation.mock.t.Fatalf("Times of StockServiceRepositoryMock.ListStockItemsByLocation mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListStockItemsByLocation.expectedInvocations, n)
+	mmListStockItemsByLocation.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListStockItemsByLocation
+}
+
+func (mmListStockItemsByLocation *mStockServiceRepositoryMockListStockItemsByLocation) invocationsDone() bool {
+	if len(mmListStockItemsByLocation.expectations) == 0 && mmListStockItemsByLocation.defaultExpectation == nil && mmListStockItemsByLocation.mock.funcListStockItemsByLocation == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListStockItemsByLocation.mock.afterListStockItemsByLocationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListStockItemsByLocation.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListStockItemsByLocation implements mm_stocks.StockServiceRepository
+func (mmListStockItemsByLocation *StockServiceRepositoryMock) ListStockItemsByLocation(ctx context.Context, filter domain.Filter) (sa1 []domain.StockItem, err error) {
+	mm_atomic.AddUint64(&mmListStockItemsByLocation.beforeListStockItemsByLocationCounter, 1)
+	defer mm_atomic.AddUint64(&mmListStockItemsByLocation.afterListStockItemsByLocationCounter, 1)
+
+	mmListStockItemsByLocation.t.Helper()
+
+	if mmListStockItemsByLocation.inspectFuncListStockItemsByLocation != nil {
+		mmListStockItemsByLocation.inspectFuncListStockItemsByLocation(ctx, filter)
+	}
+
+	mm_params := StockServiceRepositoryMockListStockItemsByLocationParams{ctx, filter}
+
+	// Record call args
+	mmListStockItemsByLocation.ListStockItemsByLocationMock.mutex.Lock()
+	mmListStockItemsByLocation.ListStockItemsByLocationMock.callArgs = append(mmListStockItemsByLocation.ListStockItemsByLocationMock.callArgs, &mm_params)
+	mmListStockItemsByLocation.ListStockItemsByLocationMock.mutex.Unlock()
+
+	for _, e := range mmListStockItemsByLocation.ListStockItemsByLocationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListStockItemsByLocation.ListStockItemsByLocationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListStockItemsByLocation.ListStockItemsByLocationMock.defaultExpectation.Counter, 1)
+		mm_want := mmListStockItemsByLocation.ListStockItemsByLocationMock.defaultExpectation.params
+		mm_want_ptrs := mmListStockItemsByLocation.ListStockItemsByLocationMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceRepositoryMockListStockItemsByLocationParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListStockItemsByLocation.t.Errorf("StockServiceRepositoryMock.ListStockItemsByLocation got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListStockItemsByLocation.ListStockItemsByLocationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListStockItemsByLocation.t.Errorf("StockServiceRepositoryMock.ListStockItemsByLocation got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListStockItemsByLocation.ListStockItemsByLocationMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListStockItemsByLocation.t.Errorf("StockServiceRepositoryMock.ListStockItemsByLocation got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListStockItemsByLocation.ListStockItemsByLocationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListStockItemsByLocation.ListStockItemsByLocationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListStockItemsByLocation.t.Fatal("No results are set for the StockServiceRepositoryMock.ListStockItemsByLocation")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListStockItemsByLocation.funcListStockItemsByLocation != nil {
+		return mmListStockItemsByLocation.funcListStockItemsByLocation(ctx, filter)
+	}
+	mmListStockItemsByLocation.t.Fatalf("Unexpected call to StockServiceRepositoryMock.ListStockItemsByLocation. %v %v", ctx, filter)
+	return
+}
+
+// ListStockItemsByLocationAfterCounter returns a count of finished StockServiceRepositoryMock.ListStockItemsByLocation invocations
+func (mmListStockItemsByLocation *StockServiceRepositoryMock) ListStockItemsByLocationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListStockItemsByLocation.afterListStockItemsByLocationCounter)
+}
+
+// ListStockItemsByLocationBeforeCounter returns a count of StockServiceRepositoryMock.ListStockItemsByLocation invocations
+func (mmListStockItemsByLocation *StockServiceRepositoryMock) ListStockItemsByLocationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListStockItemsByLocation.beforeListStockItemsByLocationCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.ListStockItemsByLocation.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListStockItemsByLocation *mStockServiceRepositoryMockListStockItemsByLocation) Calls() []*StockServiceRepositoryMockListStockItemsByLocationParams {
+	mmListStockItemsByLocation.mutex.RLock()
+
+	argCopy := make([]*StockServiceRepositoryMockListStockItemsByLocationParams, len(mmListStockItemsByLocation.callArgs))
+	copy(argCopy, mmListStockItemsByLocation.callArgs)
+
+	mmListStockItemsByLocation.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListStockItemsByLocationDone returns true if the count of the ListStockItemsByLocation invocations corresponds
+// the number of defined expectations
+func (m *StockServiceRepositoryMock) MinimockListStockItemsByLocationDone() bool {
+	if m.ListStockItemsByLocationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListStockItemsByLocationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListStockItemsByLocationMock.invocationsDone()
+}
+
+// MinimockListStockItemsByLocationInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockListStockItemsByLocationInspect() {
+	for _, e := range m.ListStockItemsByLocationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ListStockItemsByLocation at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListStockItemsByLocationCounter := mm_atomic.LoadUint64(&m.afterListStockItemsByLocationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListStockItemsByLocationMock.defaultExpectation != nil && afterListStockItemsByLocationCounter < 1 {
+		if m.ListStockItemsByLocationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ListStockItemsByLocation at\n%s", m.ListStockItemsByLocationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ListStockItemsByLocation at\n%s with params: %#v", m.ListStockItemsByLocationMock.defaultExpectation.expectationOrigins.origin, *m.ListStockItemsByLocationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListStockItemsByLocation != nil && afterListStockItemsByLocationCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.ListStockItemsByLocation at\n%s", m.funcListStockItemsByLocationOrigin)
+	}
+
+	if !m.ListStockItemsByLocationMock.invocationsDone() && afterListStockItemsByLocationCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.ListStockItemsByLocation at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListStockItemsByLocationMock.expectedInvocations), m.ListStockItemsByLocationMock.expectedInvocationsOrigin, afterListStockItemsByLocationCounter)
+	}
+}
+
+type mStockServiceRepositoryMockListStockItemsBySkus struct {
+	optional           bool
+	mock               *StockServiceRepositoryMock
+	defaultExpectation *StockServiceRepositoryMockListStockItemsBySkusExpectation
+	expectations       []*StockServiceRepositoryMockListStockItemsBySkusExpectation
+
+	callArgs []*StockServiceRepositoryMockListStockItemsBySkusParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceRepositoryMockListStockItemsBySkusExpectation specifies expectation struct of the StockServiceRepository.ListStockItemsBySkus
+type StockServiceRepositoryMockListStockItemsBySkusExpectation struct {
+	mock               *StockServiceRepositoryMock
+	params             *StockServiceRepositoryMockListStockItemsBySkusParams
+	paramPtrs          *StockServiceRepositoryMockListStockItemsBySkusParamPtrs
+	expectationOrigins StockServiceRepositoryMockListStockItemsBySkusExpectationOrigins
+	results            *StockServiceRepositoryMockListStockItemsBySkusResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceRepositoryMockListStockItemsBySkusParams contains parameters of the StockServiceRepository.ListStockItemsBySkus
+type StockServiceRepositoryMockListStockItemsBySkusParams struct {
+	ctx    context.Context
+	skuIDs []domain.SKUID
+}
+
+// StockServiceRepositoryMockListStockItemsBySkusParamPtrs contains pointers to parameters of the StockServiceRepository.ListStockItemsBySkus
+type StockServiceRepositoryMockListStockItemsBySkusParamPtrs struct {
+	ctx    *context.Context
+	skuIDs *[]domain.SKUID
+}
+
+// StockServiceRepositoryMockListStockItemsBySkusResults contains results of the StockServiceRepository.ListStockItemsBySkus
+type StockServiceRepositoryMockListStockItemsBySkusResults struct {
+	sa1 []domain.StockItem
+	err error
+}
+
+// StockServiceRepositoryMockListStockItemsBySkusOrigins contains origins of expectations of the StockServiceRepository.ListStockItemsBySkus
+type StockServiceRepositoryMockListStockItemsBySkusExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originSkuIDs string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListStockItemsBySkus *mStockServiceRepositoryMockListStockItemsBySkus) Optional() *mStockServiceRepositoryMockListStockItemsBySkus {
+	mmListStockItemsBySkus.optional = true
+	return mmListStockItemsBySkus
+}
+
+// Expect sets up expected params for StockServiceRepository.ListStockItemsBySkus
+func (mmListStockItemsBySkus *mStockServiceRepositoryMockListStockItemsBySkus) Expect(ctx context.Context, skuIDs []domain.SKUID) *mStockServiceRepositoryMockListStockItemsBySkus {
+	if mmListStockItemsBySkus.mock.funcListStockItemsBySkus != nil {
+		mmListStockItemsBySkus.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemsBySkus mock is already set by Set")
+	}
+
+	if mmListStockItemsBySkus.defaultExpectation == nil {
+		mmListStockItemsBySkus.defaultExpectation = &StockServiceRepositoryMockListStockItemsBySkusExpectation{}
+	}
+
+	if mmListStockItemsBySkus.defaultExpectation.paramPtrs != nil {
+		mmListStockItemsBySkus.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemsBySkus mock is already set by ExpectParams functions")
+	}
+
+	mmListStockItemsBySkus.defaultExpectation.params = &StockServiceRepositoryMockListStockItemsBySkusParams{ctx, skuIDs}
+	mmListStockItemsBySkus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListStockItemsBySkus.expectations {
+		if minimock.Equal(e.params, mmListStockItemsBySkus.defaultExpectation.params) {
+			mmListStockItemsBySkus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListStockItemsBySkus.defaultExpectation.params)
+		}
+	}
+
+	return mmListStockItemsBySkus
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.ListStockItemsBySkus
+func (mmListStockItemsBySkus *mStockServiceRepositoryMockListStockItemsBySkus) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockListStockItemsBySkus {
+	if mmListStockItemsBySkus.mock.funcListStockItemsBySkus != nil {
+		mmListStockItemsBySkus.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemsBySkus mock is already set by Set")
+	}
+
+	if mmListStockItemsBySkus.defaultExpectation == nil {
+		mmListStockItemsBySkus.defaultExpectation = &StockServiceRepositoryMockListStockItemsBySkusExpectation{}
+	}
+
+	if mmListStockItemsBySkus.defaultExpectation.params != nil {
+		mmListStockItemsBySkus.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemsBySkus mock is already set by Expect")
+	}
+
+	if mmListStockItemsBySkus.defaultExpectation.paramPtrs == nil {
+		mmListStockItemsBySkus.defaultExpectation.paramPtrs = &StockServiceRepositoryMockListStockItemsBySkusParamPtrs{}
+	}
+	mmListStockItemsBySkus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListStockItemsBySkus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListStockItemsBySkus
+}
+
+// ExpectSkuIDsParam2 sets up expected param skuIDs for StockServiceRepository.ListStockItemsBySkus
+func (mmListStockItemsBySkus *mStockServiceRepositoryMockListStockItemsBySkus) ExpectSkuIDsParam2(skuIDs []domain.SKUID) *mStockServiceRepositoryMockListStockItemsBySkus {
+	if mmListStockItemsBySkus.mock.funcListStockItemsBySkus != nil {
+		mmListStockItemsBySkus.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemsBySkus mock is already set by Set")
+	}
+
+	if mmListStockItemsBySkus.defaultExpectation == nil {
+		mmListStockItemsBySkus.defaultExpectation = &StockServiceRepositoryMockListStockItemsBySkusExpectation{}
+	}
+
+	if mmListStockItemsBySkus.defaultExpectation.params != nil {
+		mmListStockItemsBySkus.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemsBySkus mock is already set by Expect")
+	}
+
+	if mmListStockItemsBySkus.defaultExpectation.paramPtrs == nil {
+		mmListStockItemsBySkus.defaultExpectation.paramPtrs = &StockServiceRepositoryMockListStockItemsBySkusParamPtrs{}
+	}
+	mmListStockItemsBySkus.defaultExpectation.paramPtrs.skuIDs = &skuIDs
+	mmListStockItemsBySkus.defaultExpectation.expectationOrigins.originSkuIDs = minimock.CallerInfo(1)
+
+	return mmListStockItemsBySkus
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.ListStockItemsBySkus
+func (mmListStockItemsBySkus *mStockServiceRepositoryMockListStockItemsBySkus) Inspect(f func(ctx context.Context, skuIDs []domain.SKUID)) *mStockServiceRepositoryMockListStockItemsBySkus {
+	if mmListStockItemsBySkus.mock.inspectFuncListStockItemsBySkus != nil {
+		mmListStockItemsBySkus.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.ListStockItemsBySkus")
+	}
+
+	mmListStockItemsBySkus.mock.inspectFuncListStockItemsBySkus = f
+
+	return mmListStockItemsBySkus
+}
+
+// Return sets up results that will be returned by StockServiceRepository.ListStockItemsBySkus
+func (mmListStockItemsBySkus *mStockServiceRepositoryMockListStockItemsBySkus) Return(sa1 []domain.StockItem, err error) *StockServiceRepositoryMock {
+	if mmListStockItemsBySkus.mock.funcListStockItemsBySkus != nil {
+		mmListStockItemsBySkus.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemsBySkus mock is already set by Set")
+	}
+
+	if mmListStockItemsBySkus.defaultExpectation == nil {
+		mmListStockItemsBySkus.defaultExpectation = &StockServiceRepositoryMockListStockItemsBySkusExpectation{mock: mmListStockItemsBySkus.mock}
+	}
+	mmListStockItemsBySkus.defaultExpectation.results = &StockServiceRepositoryMockListStockItemsBySkusResults{sa1, err}
+	mmListStockItemsBySkus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListStockItemsBySkus.mock
+}
+
+// Set uses given function f to mock the StockServiceRepository.ListStockItemsBySkus method
+func (mmListStockItemsBySkus *mStockServiceRepositoryMockListStockItemsBySkus) Set(f func(ctx context.Context, skuIDs []domain.SKUID) (sa1 []domain.StockItem, err error)) *StockServiceRepositoryMock {
+	if mmListStockItemsBySkus.defaultExpectation != nil {
+		mmListStockItemsBySkus.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.ListStockItemsBySkus method")
+	}
+
+	if len(mmListStockItemsBySkus.expectations) > 0 {
+		mmListStockItemsBySkus.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.ListStockItemsBySkus method")
+	}
+
+	mmListStockItemsBySkus.mock.funcListStockItemsBySkus = f
+	mmListStockItemsBySkus.mock.funcListStockItemsBySkusOrigin = minimock.CallerInfo(1)
+	return mmListStockItemsBySkus.mock
+}
+
+// When sets expectation for the StockServiceRepository.ListStockItemsBySkus which will trigger the result defined by the following
+// Then helper
+func (mmListStockItemsBySkus *mStockServiceRepositoryMockListStockItemsBySkus) When(ctx context.Context, skuIDs []domain.SKUID) *StockServiceRepositoryMockListStockItemsBySkusExpectation {
+	if mmListStockItemsBySkus.mock.funcListStockItemsBySkus != nil {
+		mmListStockItemsBySkus.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemsBySkus mock is already set by Set")
+	}
+
+	expectation := &StockServiceRepositoryMockListStockItemsBySkusExpectation{
+		mock:               mmListStockItemsBySkus.mock,
+		params:             &StockServiceRepositoryMockListStockItemsBySkusParams{ctx, skuIDs},
+		expectationOrigins: StockServiceRepositoryMockListStockItemsBySkusExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListStockItemsBySkus.expectations = append(mmListStockItemsBySkus.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceRepository.ListStockItemsBySkus return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockListStockItemsBySkusExpectation) Then(sa1 []domain.StockItem, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockListStockItemsBySkusResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceRepository.ListStockItemsBySkus should be invoked
+func (mmListStockItemsBySkus *mStockServiceRepositoryMockListStockItemsBySkus) Times(n uint64) *mStockServiceRepositoryMockListStockItemsBySkus {
+	if n == 0 {
+		mmListStockItemsBySkus.mock.t.Fatalf("Times of StockServiceRepositoryMock.ListStockItemsBySkus mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListStockItemsBySkus.expectedInvocations, n)
+	mmListStockItemsBySkus.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListStockItemsBySkus
+}
+
+func (mmListStockItemsBySkus *mStockServiceRepositoryMockListStockItemsBySkus) invocationsDone() bool {
+	if len(mmListStockItemsBySkus.expectations) == 0 && mmListStockItemsBySkus.defaultExpectation == nil && mmListStockItemsBySkus.mock.funcListStockItemsBySkus == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListStockItemsBySkus.mock.afterListStockItemsBySkusCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListStockItemsBySkus.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListStockItemsBySkus implements mm_stocks.StockServiceRepository
+func (mmListStockItemsBySkus *StockServiceRepositoryMock) ListStockItemsBySkus(ctx context.Context, skuIDs []domain.SKUID) (sa1 []domain.StockItem, err error) {
+	mm_atomic.AddUint64(&mmListStockItemsBySkus.beforeListStockItemsBySkusCounter, 1)
+	defer mm_atomic.AddUint64(&mmListStockItemsBySkus.afterListStockItemsBySkusCounter, 1)
+
+	mmListStockItemsBySkus.t.Helper()
+
+	if mmListStockItemsBySkus.inspectFuncListStockItemsBySkus != nil {
+		mmListStockItemsBySkus.inspectFuncListStockItemsBySkus(ctx, skuIDs)
+	}
+
+	mm_params := StockServiceRepositoryMockListStockItemsBySkusParams{ctx, skuIDs}
+
+	// Record call args
+	mmListStockItemsBySkus.ListStockItemsBySkusMock.mutex.Lock()
+	mmListStockItemsBySkus.ListStockItemsBySkusMock.callArgs = append(mmListStockItemsBySkus.ListStockItemsBySkusMock.callArgs, &mm_params)
+	mmListStockItemsBySkus.ListStockItemsBySkusMock.mutex.Unlock()
+
+	for _, e := range mmListStockItemsBySkus.ListStockItemsBySkusMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListStockItemsBySkus.ListStockItemsBySkusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListStockItemsBySkus.ListStockItemsBySkusMock.defaultExpectation.Counter, 1)
+		mm_want := mmListStockItemsBySkus.ListStockItemsBySkusMock.defaultExpectation.params
+		mm_want_ptrs := mmListStockItemsBySkus.ListStockItemsBySkusMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceRepositoryMockListStockItemsBySkusParams{ctx, skuIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListStockItemsBySkus.t.Errorf("StockServiceRepositoryMock.ListStockItemsBySkus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListStockItemsBySkus.ListStockItemsBySkusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.skuIDs != nil && !minimock.Equal(*mm_want_ptrs.skuIDs, mm_got.skuIDs) {
+				mmListStockItemsBySkus.t.Errorf("StockServiceRepositoryMock.ListStockItemsBySkus got unexpected parameter skuIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListStockItemsBySkus.ListStockItemsBySkusMock.defaultExpectation.expectationOrigins.originSkuIDs, *mm_want_ptrs.skuIDs, mm_got.skuIDs, minimock.Diff(*mm_want_ptrs.skuIDs, mm_got.skuIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListStockItemsBySkus.t.Errorf("StockServiceRepositoryMock.ListStockItemsBySkus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListStockItemsBySkus.ListStockItemsBySkusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListStockItemsBySkus.ListStockItemsBySkusMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListStockItemsBySkus.t.Fatal("No results are set for the StockServiceRepositoryMock.ListStockItemsBySkus")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListStockItemsBySkus.funcListStockItemsBySkus != nil {
+		return mmListStockItemsBySkus.funcListStockItemsBySkus(ctx, skuIDs)
+	}
+	mmListStockItemsBySkus.t.Fatalf("Unexpected call to StockServiceRepositoryMock.ListStockItemsBySkus. %v %v", ctx, skuIDs)
+	return
+}
+
+// ListStockItemsBySkusAfterCounter returns a count of finished StockServiceRepositoryMock.ListStockItemsBySkus invocations
+func (mmListStockItemsBySkus *StockServiceRepositoryMock) ListStockItemsBySkusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListStockItemsBySkus.afterListStockItemsBySkusCounter)
+}
+
+// ListStockItemsBySkusBeforeCounter returns a count of StockServiceRepositoryMock.ListStockItemsBySkus invocations
+func (mmListStockItemsBySkus *StockServiceRepositoryMock) ListStockItemsBySkusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListStockItemsBySkus.beforeListStockItemsBySkusCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.ListStockItemsBySkus.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListStockItemsBySkus *mStockServiceRepositoryMockListStockItemsBySkus) Calls() []*StockServiceRepositoryMockListStockItemsBySkusParams {
+	mmListStockItemsBySkus.mutex.RLock()
+
+	argCopy := make([]*StockServiceRepositoryMockListStockItemsBySkusParams, len(mmListStockItemsBySkus.callArgs))
+	copy(argCopy, mmListStockItemsBySkus.callArgs)
+
+	mmListStockItemsBySkus.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListStockItemsBySkusDone returns true if the count of the ListStockItemsBySkus invocations corresponds
+// the number of defined expectations
+func (m *StockServiceRepositoryMock) MinimockListStockItemsBySkusDone() bool {
+	if m.ListStockItemsBySkusMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListStockItemsBySkusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListStockItemsBySkusMock.invocationsDone()
+}
+
+// MinimockListStockItemsBySkusInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockListStockItemsBySkusInspect() {
+	for _, e := range m.ListStockItemsBySkusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ListStockItemsBySkus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListStockItemsBySkusCounter := mm_atomic.LoadUint64(&m.afterListStockItemsBySkusCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListStockItemsBySkusMock.defaultExpectation != nil && afterListStockItemsBySkusCounter < 1 {
+		if m.ListStockItemsBySkusMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ListStockItemsBySkus at\n%s", m.ListStockItemsBySkusMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ListStockItemsBySkus at\n%s with params: %#v", m.ListStockItemsBySkusMock.defaultExpectation.expectationOrigins.origin, *m.ListStockItemsBySkusMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListStockItemsBySkus != nil && afterListStockItemsBySkusCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.ListStockItemsBySkus at\n%s", m.funcListStockItemsBySkusOrigin)
+	}
+
+	if !m.ListStockItemsBySkusMock.invocationsDone() && afterListStockItemsBySkusCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.ListStockItemsBySkus at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListStockItemsBySkusMock.expectedInvocations), m.ListStockItemsBySkusMock.expectedInvocationsOrigin, afterListStockItemsBySkusCounter)
+	}
+}
+
+type mStockServiceRepositoryMockListSuppliersOfMerchant struct {
+	optional           bool
+	mock               *StockServiceRepositoryMock
+	defaultExpectation *StockServiceRepositoryMockListSuppliersOfMerchantExpectation
+	expectations       []*StockServiceRepositoryMockListSuppliersOfMerchantExpectation
+
+	callArgs []*StockServiceRepositoryMockListSuppliersOfMerchantParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceRepositoryMockListSuppliersOfMerchantExpectation specifies expectation struct of the StockServiceRepository.ListSuppliersOfMerchant
+type StockServiceRepositoryMockListSuppliersOfMerchantExpectation struct {
+	mock               *StockServiceRepositoryMock
+	params             *StockServiceRepositoryMockListSuppliersOfMerchantParams
+	paramPtrs          *StockServiceRepositoryMockListSuppliersOfMerchantParamPtrs
+	expectationOrigins StockServiceRepositoryMockListSuppliersOfMerchantExpectationOrigins
+	results            *StockServiceRepositoryMockListSuppliersOfMerchantResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceRepositoryMockListSuppliersOfMerchantParams contains parameters of the StockServiceRepository.ListSuppliersOfMerchant
+type StockServiceRepositoryMockListSuppliersOfMerchantParams struct {
+	ctx    context.Context
+	userID domain.UserID
+}
+
+// StockServiceRepositoryMockListSuppliersOfMerchantParamPtrs contains pointers to parameters of the StockServiceRepository.ListSuppliersOfMerchant
+type StockServiceRepositoryMockListSuppliersOfMerchantParamPtrs struct {
+	ctx    *context.Context
+	userID *domain.UserID
+}
+
+// StockServiceRepositoryMockListSuppliersOfMerchantResults contains results of the StockServiceRepository.ListSuppliersOfMerchant
+type StockServiceRepositoryMockListSuppliersOfMerchantResults struct {
+	sa1 []domain.Supplier
+	err error
+}
+
+// StockServiceRepositoryMockListSuppliersOfMerchantOrigins contains origins of expectations of the StockServiceRepository.ListSuppliersOfMerchant
+type StockServiceRepositoryMockListSuppliersOfMerchantExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListSuppliersOfMerchant *mStockServiceRepositoryMockListSuppliersOfMerchant) Optional() *mStockServiceRepositoryMockListSuppliersOfMerchant {
+	mmListSuppliersOfMerchant.optional = true
+	return mmListSuppliersOfMerchant
+}
+
+// Expect sets up expected params for StockServiceRepository.ListSuppliersOfMerchant
+func (mmListSuppliersOfMerchant *mStockServiceRepositoryMockListSuppliersOfMerchant) Expect(ctx context.Context, userID domain.UserID) *mStockServiceRepositoryMockListSuppliersOfMerchant {
+	if mmListSuppliersOfMerchant.mock.funcListSuppliersOfMerchant != nil {
+		mmListSuppliersOfMerchant.mock.t.Fatalf("StockServiceRepositoryMock.ListSuppliersOfMerchant mock is already set by Set")
+	}
+
+	if mmListSuppliersOfMerchant.defaultExpectation == nil {
+		mmListSuppliersOfMerchant.defaultExpectation = &StockServiceRepositoryMockListSuppliersOfMerchantExpectation{}
+	}
+
+	if mmListSuppliersOfMerchant.defaultExpectation.paramPtrs != nil {
+		mmListSuppliersOfMerchant.mock.t.Fatalf("StockServiceRepositoryMock.ListSuppliersOfMerchant mock is already set by ExpectParams functions")
+	}
+
+	mmListSuppliersOfMerchant.defaultExpectation.params = &StockServiceRepositoryMockListSuppliersOfMerchantParams{ctx, userID}
+	mmListSuppliersOfMerchant.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListSuppliersOfMerchant.expectations {
+		if minimock.Equal(e.params, mmListSuppliersOfMerchant.defaultExpectation.params) {
+			mmListSuppliersOfMerchant.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListSuppliersOfMerchant.defaultExpectation.params)
+		}
+	}
+
+	return mmListSuppliersOfMerchant
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.ListSuppliersOfMerchant
+func (mmListSuppliersOfMerchant *mStockServiceRepositoryMockListSuppliersOfMerchant) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockListSuppliersOfMerchant {
+	if mmListSuppliersOfMerchant.mock.funcListSuppliersOfMerchant != nil {
+		mmListSuppliersOfMerchant.mock.t.Fatalf("StockServiceRepositoryMock.ListSuppliersOfMerchant mock is already set by Set")
+	}
+
+	if mmListSuppliersOfMerchant.defaultExpectation == nil {
+		mmListSuppliersOfMerchant.defaultExpectation = &StockServiceRepositoryMockListSuppliersOfMerchantExpectation{}
+	}
+
+	if mmListSuppliersOfMerchant.defaultExpectation.params != nil {
+		mmListSuppliersOfMerchant.mock.t.Fatalf("StockServiceRepositoryMock.ListSuppliersOfMerchant mock is already set by Expect")
+	}
+
+	if mmListSuppliersOfMerchant.defaultExpectation.paramPtrs == nil {
+		mmListSuppliersOfMerchant.defaultExpectation.paramPtrs = &StockServiceRepositoryMockListSuppliersOfMerchantParamPtrs{}
+	}
+	mmListSuppliersOfMerchant.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListSuppliersOfMerchant.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListSuppliersOfMerchant
+}
+
+// ExpectUserIDParam2 sets up expected param userID for StockServiceRepository.ListSuppliersOfMerchant
+func (mmListSuppliersOfMerchant *mStockServiceRepositoryMockListSuppliersOfMerchant) ExpectUserIDParam2(userID domain.UserID) *mStockServiceRepositoryMockListSuppliersOfMerchant {
+	if mmListSuppliersOfMerchant.mock.funcListSuppliersOfMerchant != nil {
+		mmListSuppliersOfMerchant.mock.t.Fatalf("StockServiceRepositoryMock.ListSuppliersOfMerchant mock is already set by Set")
+	}
+
+	if mmListSuppliersOfMerchant.defaultExpectation == nil {
+		mmListSuppliersOfMerchant.defaultExpectation = &StockServiceRepositoryMockListSuppliersOfMerchantExpectation{}
+	}
+
+	if mmListSuppliersOfMerchant.defaultExpectation.params != nil {
+		mmListSuppliersOfMerchant.mock.t.Fatalf("StockServiceRepositoryMock.ListSuppliersOfMerchant mock is already set by Expect")
+	}
+
+	if mmListSuppliersOfMerchant.defaultExpectation.paramPtrs == nil {
+		mmListSuppliersOfMerchant.defaultExpectation.paramPtrs = &StockServiceRepositoryMockListSuppliersOfMerchantParamPtrs{}
+	}
+	mmListSuppliersOfMerchant.defaultExpectation.paramPtrs.userID = &userID
+	mmListSuppliersOfMerchant.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmListSuppliersOfMerchant
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.ListSuppliersOfMerchant
+func (mmListSuppliersOfMerchant *mStockServiceRepositoryMockListSuppliersOfMerchant) Inspect(f func(ctx context.Context, userID domain.UserID)) *mStockServiceRepositoryMockListSuppliersOfMerchant {
+	if mmListSuppliersOfMerchant.mock.inspectFuncListSuppliersOfMerchant != nil {
+		mmListSuppliersOfMerchant.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.ListSuppliersOfMerchant")
+	}
+
+	mmListSuppliersOfMerchant.mock.inspectFuncListSuppliersOfMerchant = f
+
+	return mmListSuppliersOfMerchant
+}
+
+// Return sets up results that will be returned by StockServiceRepository.ListSuppliersOfMerchant
+func (mmListSuppliersOfMerchant *mStockServiceRepositoryMockListSuppliersOfMerchant) Return(sa1 []domain.Supplier, err error) *StockServiceRepositoryMock {
+	if mmListSuppliersOfMerchant.mock.funcListSuppliersOfMerchant != nil {
+		mmListSuppliersOfMerchant.mock.t.Fatalf("StockServiceRepositoryMock.ListSuppliersOfMerchant mock is already set by Set")
+	}
+
+	if mmListSuppliersOfMerchant.defaultExpectation == nil {
+		mmListSuppliersOfMerchant.defaultExpectation = &StockServiceRepositoryMockListSuppliersOfMerchantExpectation{mock: mmListSuppliersOfMerchant.mock}
+	}
+	mmListSuppliersOfMerchant.defaultExpectation.results = &StockServiceRepositoryMockListSuppliersOfMerchantResults{sa1, err}
+	mmListSuppliersOfMerchant.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListSuppliersOfMerchant.mock
+}
+
+// Set uses given function f to mock the StockServiceRepository.ListSuppliersOfMerchant method
+func (mmListSuppliersOfMerchant *mStockServiceRepositoryMockListSuppliersOfMerchant) Set(f func(ctx context.Context, userID domain.UserID) (sa1 []domain.Supplier, err error)) *StockServiceRepositoryMock {
+	if mmListSuppliersOfMerchant.defaultExpectation != nil {
+		mmListSuppliersOfMerchant.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.ListSuppliersOfMerchant method")
+	}
+
+	if len(mmListSuppliersOfMerchant.expectations) > 0 {
+		mmListSuppliersOfMerchant.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.ListSuppliersOfMerchant method")
+	}
+
+	mmListSuppliersOfMerchant.mock.funcListSuppliersOfMerchant = f
+	mmListSuppliersOfMerchant.mock.funcListSuppliersOfMerchantOrigin = minimock.CallerInfo(1)
+	return mmListSuppliersOfMerchant.mock
+}
+
+// When sets expectation for the StockServiceRepository.ListSuppliersOfMerchant which will trigger the result defined by the following
+// Then helper
+func (mmListSuppliersOfMerchant *mStockServiceRepositoryMockListSuppliersOfMerchant) When(ctx context.Context, userID domain.UserID) *StockServiceRepositoryMockListSuppliersOfMerchantExpectation {
+	if mmListSuppliersOfMerchant.mock.funcListSuppliersOfMerchant != nil {
+		mmListSuppliersOfMerchant.mock.t.Fatalf("StockServiceRepositoryMock.ListSuppliersOfMerchant mock is already set by Set")
+	}
+
+	expectation := &StockServiceRepositoryMockListSuppliersOfMerchantExpectation{
+		mock:               mmListSuppliersOfMerchant.mock,
+		params:             &StockServiceRepositoryMockListSuppliersOfMerchantParams{ctx, userID},
+		expectationOrigins: StockServiceRepositoryMockListSuppliersOfMerchantExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListSuppliersOfMerchant.expectations = append(mmListSuppliersOfMerchant.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceRepository.ListSuppliersOfMerchant return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockListSuppliersOfMerchantExpectation) Then(sa1 []domain.Supplier, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockListSuppliersOfMerchantResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceRepository.ListSuppliersOfMerchant should be invoked
+func (mmListSuppliersOfMerchant *mStockServiceRepositoryMockListSuppliersOfMerchant) Times(n uint64) *mStockServiceRepositoryMockListSuppliersOfMerchant {
+	if n == 0 {
+		mmListSuppliersOfMerchant.mock.t.Fatalf("Times of StockServiceRepositoryMock.ListSuppliersOfMerchant mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListSuppliersOfMerchant.expectedInvocations, n)
+	mmListSuppliersOfMerchant.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListSuppliersOfMerchant
+}
+
+func (mmListSuppliersOfMerchant *mStockServiceRepositoryMockListSuppliersOfMerchant) invocationsDone() bool {
+	if len(mmListSuppliersOfMerchant.expectations) == 0 && mmListSuppliersOfMerchant.defaultExpectation == nil && mmListSuppliersOfMerchant.mock.funcListSuppliersOfMerchant == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListSuppliersOfMerchant.mock.afterListSuppliersOfMerchantCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListSuppliersOfMerchant.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListSuppliersOfMerchant implements mm_stocks.StockServiceRepository
+func (mmListSuppliersOfMerchant *StockServiceRepositoryMock) ListSuppliersOfMerchant(ctx context.Context, userID domain.UserID) (sa1 []domain.Supplier, err error) {
+	mm_atomic.AddUint64(&mmListSuppliersOfMerchant.beforeListSuppliersOfMerchantCounter, 1)
+	defer mm_atomic.AddUint64(&mmListSuppliersOfMerchant.afterListSuppliersOfMerchantCounter, 1)
+
+	mmListSuppliersOfMerchant.t.Helper()
+
+	if mmListSuppliersOfMerchant.inspectFuncListSuppliersOfMerchant != nil {
+		mmListSuppliersOfMerchant.inspectFuncListSuppliersOfMerchant(ctx, userID)
+	}
+
+	mm_params := StockServiceRepositoryMockListSuppliersOfMerchantParams{ctx, userID}
+
+	// Record call args
+	mmListSuppliersOfMerchant.ListSuppliersOfMerchantMock.mutex.Lock()
+	mmListSuppliersOfMerchant.ListSuppliersOfMerchantMock.callArgs = append(mmListSuppliersOfMerchant.ListSuppliersOfMerchantMock.callArgs, &mm_params)
+	mmListSuppliersOfMerchant.ListSuppliersOfMerchantMock.mutex.Unlock()
+
+	for _, e := range mmListSuppliersOfMerchant.ListSuppliersOfMerchantMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmListSuppliersOfMerchant.ListSuppliersOfMerchantMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListSuppliersOfMerchant.ListSuppliersOfMerchantMock.defaultExpectation.Counter, 1)
+		mm_want := mmListSuppliersOfMerchant.ListSuppliersOfMerchantMock.defaultExpectation.params
+		mm_want_ptrs := mmListSuppliersOfMerchant.ListSuppliersOfMerchantMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceRepositoryMockListSuppliersOfMerchantParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListSuppliersOfMerchant.t.Errorf("StockServiceRepositoryMock.ListSuppliersOfMerchant got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSuppliersOfMerchant.ListSuppliersOfMerchantMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmListSuppliersOfMerchant.t.Errorf("StockServiceRepositoryMock.ListSuppliersOfMerchant got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListSuppliersOfMerchant.ListSuppliersOfMerchantMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListSuppliersOfMerchant.t.Errorf("StockServiceRepositoryMock.ListSuppliersOfMerchant got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListSuppliersOfMerchant.ListSuppliersOfMerchantMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListSuppliersOfMerchant.ListSuppliersOfMerchantMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListSuppliersOfMerchant.t.Fatal("No results are set for the StockServiceRepositoryMock.ListSuppliersOfMerchant")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmListSuppliersOfMerchant.funcListSuppliersOfMerchant != nil {
+		return mmListSuppliersOfMerchant.funcListSuppliersOfMerchant(ctx, userID)
+	}
+	mmListSuppliersOfMerchant.t.Fatalf("Unexpected call to StockServiceRepositoryMock.ListSuppliersOfMerchant. %v %v", ctx, userID)
+	return
+}
+
+// ListSuppliersOfMerchantAfterCounter returns a count of finished StockServiceRepositoryMock.ListSuppliersOfMerchant invocations
+func (mmListSuppliersOfMerchant *StockServiceRepositoryMock) ListSuppliersOfMerchantAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSuppliersOfMerchant.afterListSuppliersOfMerchantCounter)
+}
+
+// ListSuppliersOfMerchantBeforeCounter returns a count of StockServiceRepositoryMock.ListSuppliersOfMerchant invocations
+func (mmListSuppliersOfMerchant *StockServiceRepositoryMock) ListSuppliersOfMerchantBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListSuppliersOfMerchant.beforeListSuppliersOfMerchantCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.ListSuppliersOfMerchant.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListSuppliersOfMerchant *mStockServiceRepositoryMockListSuppliersOfMerchant) Calls() []*StockServiceRepositoryMockListSuppliersOfMerchantParams {
+	mmListSuppliersOfMerchant.mutex.RLock()
+
+	argCopy := make([]*StockServiceRepositoryMockListSuppliersOfMerchantParams, len(mmListSuppliersOfMerchant.callArgs))
+	copy(argCopy, mmListSuppliersOfMerchant.callArgs)
+
+	mmListSuppliersOfMerchant.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListSuppliersOfMerchantDone returns true if the count of the ListSuppliersOfMerchant invocations corresponds
+// the number of defined expectations
+func (m *StockServiceRepositoryMock) MinimockListSuppliersOfMerchantDone() bool {
+	if m.ListSuppliersOfMerchantMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListSuppliersOfMerchantMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListSuppliersOfMerchantMock.invocationsDone()
+}
+
+// MinimockListSuppliersOfMerchantInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockListSuppliersOfMerchantInspect() {
+	for _, e := range m.ListSuppliersOfMerchantMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ListSuppliersOfMerchant at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListSuppliersOfMerchantCounter := mm_atomic.LoadUint64(&m.afterListSuppliersOfMerchantCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListSuppliersOfMerchantMock.defaultExpectation != nil && afterListSuppliersOfMerchantCounter < 1 {
+		if m.ListSuppliersOfMerchantMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ListSuppliersOfMerchant at\n%s", m.ListSuppliersOfMerchantMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ListSuppliersOfMerchant at\n%s with params: %#v", m.ListSuppliersOfMerchantMock.defaultExpectation.expectationOrigins.origin, *m.ListSuppliersOfMerchantMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListSuppliersOfMerchant != nil && afterListSuppliersOfMerchantCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.ListSuppliersOfMerchant at\n%s", m.funcListSuppliersOfMerchantOrigin)
+	}
+
+	if !m.ListSuppliersOfMerchantMock.invocationsDone() && afterListSuppliersOfMerchantCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.ListSuppliersOfMerchant at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListSuppliersOfMerchantMock.expectedInvocations), m.ListSuppliersOfMerchantMock.expectedInvocationsOrigin, afterListSuppliersOfMerchantCounter)
+	}
+}
+
+type mStockServiceRepositoryMockMarkPurchaseOrderOrdered struct {
+	optional           bool
+	mock               *StockServiceRepositoryMock
+	defaultExpectation *StockServiceRepositoryMockMarkPurchaseOrderOrderedExpectation
+	expectations       []*StockServiceRepositoryMockMarkPurchaseOrderOrderedExpectation
+
+	callArgs []*StockServiceRepositoryMockMarkPurchaseOrderOrderedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceRepositoryMockMarkPurchaseOrderOrderedExpectation specifies expectation struct of the StockServiceRepository.MarkPurchaseOrderOrdered
+type StockServiceRepositoryMockMarkPurchaseOrderOrderedExpectation struct {
+	mock               *StockServiceRepositoryMock
+	params             *StockServiceRepositoryMockMarkPurchaseOrderOrderedParams
+	paramPtrs          *StockServiceRepositoryMockMarkPurchaseOrderOrderedParamPtrs
+	expectationOrigins StockServiceRepositoryMockMarkPurchaseOrderOrderedExpectationOrigins
+	results            *StockServiceRepositoryMockMarkPurchaseOrderOrderedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceRepositoryMockMarkPurchaseOrderOrderedParams contains parameters of the StockServiceRepository.MarkPurchaseOrderOrdered
+type StockServiceRepositoryMockMarkPurchaseOrderOrderedParams struct {
+	ctx             context.Context
+	purchaseOrderID domain.PurchaseOrderID
+}
+
+// StockServiceRepositoryMockMarkPurchaseOrderOrderedParamPtrs contains pointers to parameters of the StockServiceRepository.MarkPurchaseOrderOrdered
+type StockServiceRepositoryMockMarkPurchaseOrderOrderedParamPtrs struct {
+	ctx             *context.Context
+	purchaseOrderID *domain.PurchaseOrderID
+}
+
+// StockServiceRepositoryMockMarkPurchaseOrderOrderedResults contains results of the StockServiceRepository.MarkPurchaseOrderOrdered
+type StockServiceRepositoryMockMarkPurchaseOrderOrderedResults struct {
+	p1  domain.PurchaseOrder
+	err error
+}
+
+// StockServiceRepositoryMockMarkPurchaseOrderOrderedOrigins contains origins of expectations of the StockServiceRepository.MarkPurchaseOrderOrdered
+type StockServiceRepositoryMockMarkPurchaseOrderOrderedExpectationOrigins struct {
+	origin                string
+	originCtx             string
+	originPurchaseOrderID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkPurchaseOrderOrdered *mStockServiceRepositoryMockMarkPurchaseOrderOrdered) Optional() *mStockServiceRepositoryMockMarkPurchaseOrderOrdered {
+	mmMarkPurchaseOrderOrdered.optional = true
+	return mmMarkPurchaseOrderOrdered
+}
+
+// Expect sets up expected params for StockServiceRepository.MarkPurchaseOrderOrdered
+func (mmMarkPurchaseOrderOrdered *mStockServiceRepositoryMockMarkPurchaseOrderOrdered) Expect(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) *mStockServiceRepositoryMockMarkPurchaseOrderOrdered {
+	if mmMarkPurchaseOrderOrdered.mock.funcMarkPurchaseOrderOrdered != nil {
+		mmMarkPurchaseOrderOrdered.mock.t.Fatalf("StockServiceRepositoryMock.MarkPurchaseOrderOrdered mock is already set by Set")
+	}
+
+	if mmMarkPurchaseOrderOrdered.defaultExpectation == nil {
+		mmMarkPurchaseOrderOrdered.defaultExpectation = &StockServiceRepositoryMockMarkPurchaseOrderOrderedExpectation{}
+	}
+
+	if mmMarkPurchaseOrderOrdered.defaultExpectation.paramPtrs != nil {
+		mmMarkPurchaseOrderOrdered.mock.t.Fatalf("StockServiceRepositoryMock.MarkPurchaseOrderOrdered mock is already set by ExpectParams functions")
+	}
+
+	mmMarkPurchaseOrderOrdered.defaultExpectation.params = &StockServiceRepositoryMockMarkPurchaseOrderOrderedParams{ctx, purchaseOrderID}
+	mmMarkPurchaseOrderOrdered.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkPurchaseOrderOrdered.expectations {
+		if minimock.Equal(e.params, mmMarkPurchaseOrderOrdered.defaultExpectation.params) {
+			mmMarkPurchaseOrderOrdered.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkPurchaseOrderOrdered.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkPurchaseOrderOrdered
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.MarkPurchaseOrderOrdered
+func (mmMarkPurchaseOrderOrdered *mStockServiceRepositoryMockMarkPurchaseOrderOrdered) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockMarkPurchaseOrderOrdered {
+	if mmMarkPurchaseOrderOrdered.mock.funcMarkPurchaseOrderOrdered != nil {
+		mmMarkPurchaseOrderOrdered.mock.t.Fatalf("StockServiceRepositoryMock.MarkPurchaseOrderOrdered mock is already set by Set")
+	}
+
+	if mmMarkPurchaseOrderOrdered.defaultExpectation == nil {
+		mmMarkPurchaseOrderOrdered.defaultExpectation = &StockServiceRepositoryMockMarkPurchaseOrderOrderedExpectation{}
+	}
+
+	if mmMarkPurchaseOrderOrdered.defaultExpectation.params != nil {
+		mmMarkPurchaseOrderOrdered.mock.t.Fatalf("StockServiceRepositoryMock.MarkPurchaseOrderOrdered mock is already set by Expect")
+	}
+
+	if mmMarkPurchaseOrderOrdered.defaultExpectation.paramPtrs == nil {
+		mmMarkPurchaseOrderOrdered.defaultExpectation.paramPtrs = &StockServiceRepositoryMockMarkPurchaseOrderOrderedParamPtrs{}
+	}
+	mmMarkPurchaseOrderOrdered.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkPurchaseOrderOrdered.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkPurchaseOrderOrdered
+}
+
+// ExpectPurchaseOrderIDParam2 sets up expected param purchaseOrderID for StockServiceRepository.MarkPurchaseOrderOrdered
+func (mmMarkPurchaseOrderOrdered *mStockServiceRepositoryMockMarkPurchaseOrderOrdered) ExpectPurchaseOrderIDParam2(purchaseOrderID domain.PurchaseOrderID) *mStockServiceRepositoryMockMarkPurchaseOrderOrdered {
+	if mmMarkPurchaseOrderOrdered.mock.funcMarkPurchaseOrderOrdered != nil {
+		mmMarkPurchaseOrderOrdered.mock.t.Fatalf("StockServiceRepositoryMock.MarkPurchaseOrderOrdered mock is already set by Set")
+	}
+
+	if mmMarkPurchaseOrderOrdered.defaultExpectation == nil {
+		mmMarkPurchaseOrderOrdered.defaultExpectation = &StockServiceRepositoryMockMarkPurchaseOrderOrderedExpectation{}
+	}
+
+	if mmMarkPurchaseOrderOrdered.defaultExpectation.params != nil {
+		mmMarkPurchaseOrderOrdered.mock.t.Fatalf("StockServiceRepositoryMock.MarkPurchaseOrderOrdered mock is already set by Expect")
+	}
+
+	if mmMarkPurchaseOrderOrdered.defaultExpectation.paramPtrs == nil {
+		mmMarkPurchaseOrderOrdered.defaultExpectation.paramPtrs = &StockServiceRepositoryMockMarkPurchaseOrderOrderedParamPtrs{}
+	}
+	mmMarkPurchaseOrderOrdered.defaultExpectation.paramPtrs.purchaseOrderID = &purchaseOrderID
+	mmMarkPurchaseOrderOrdered.defaultExpectation.expectationOrigins.originPurchaseOrderID = minimock.CallerInfo(1)
+
+	return mmMarkPurchaseOrderOrdered
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.MarkPurchaseOrderOrdered
+func (mmMarkPurchaseOrderOrdered *mStockServiceRepositoryMockMarkPurchaseOrderOrdered) Inspect(f func(ctx context.Context, purchaseOrderID domain.PurchaseOrderID)) *mStockServiceRepositoryMockMarkPurchaseOrderOrdered {
+	if mmMarkPurchaseOrderOrdered.mock.inspectFuncMarkPurchaseOrderOrdered != nil {
+		mmMarkPurchaseOrderOrdered.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.MarkPurchaseOrderOrdered")
+	}
+
+	mmMarkPurchaseOrderOrdered.mock.inspectFuncMarkPurchaseOrderOrdered = f
+
+	return mmMarkPurchaseOrderOrdered
+}
+
+// Return sets up results that will be returned by StockServiceRepository.MarkPurchaseOrderOrdered
+func (mmMarkPurchaseOrderOrdered *mStockServiceRepositoryMockMarkPurchaseOrderOrdered) Return(p1 domain.PurchaseOrder, err error) *StockServiceRepositoryMock {
+	if mmMarkPurchaseOrderOrdered.mock.funcMarkPurchaseOrderOrdered != nil {
+		mmMarkPurchaseOrderOrdered.mock.t.Fatalf("StockServiceRepositoryMock.MarkPurchaseOrderOrdered mock is already set by Set")
+	}
+
+	if mmMarkPurchaseOrderOrdered.defaultExpectation == nil {
+		mmMarkPurchaseOrderOrdered.defaultExpectation = &StockServiceRepositoryMockMarkPurchaseOrderOrderedExpectation{mock: mmMarkPurchaseOrderOrdered.mock}
+	}
+	mmMarkPurchaseOrderOrdered.defaultExpectation.results = &StockServiceRepositoryMockMarkPurchaseOrderOrderedResults{p1, err}
+	mmMarkPurchaseOrderOrdered.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkPurchaseOrderOrdered.mock
+}
+
+// Set uses given function f to mock the StockServiceRepository.MarkPurchaseOrderOrdered method
+func (mmMarkPurchaseOrderOrdered *mStockServiceRepositoryMockMarkPurchaseOrderOrdered) Set(f func(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) (p1 domain.PurchaseOrder, err error)) *StockServiceRepositoryMock {
+	if mmMarkPurchaseOrderOrdered.defaultExpectation != nil {
+		mmMarkPurchaseOrderOrdered.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.MarkPurchaseOrderOrdered method")
+	}
+
+	if len(mmMarkPurchaseOrderOrdered.expectations) > 0 {
+		mmMarkPurchaseOrderOrdered.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.MarkPurchaseOrderOrdered method")
+	}
+
+	mmMarkPurchaseOrderOrdered.mock.funcMarkPurchaseOrderOrdered = f
+	mmMarkPurchaseOrderOrdered.mock.funcMarkPurchaseOrderOrderedOrigin = minimock.CallerInfo(1)
+	return mmMarkPurchaseOrderOrdered.mock
+}
+
+// When sets expectation for the StockServiceRepository.MarkPurchaseOrderOrdered which will trigger the result defined by the following
+// Then helper
+func (mmMarkPurchaseOrderOrdered *mStockServiceRepositoryMockMarkPurchaseOrderOrdered) When(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) *StockServiceRepositoryMockMarkPurchaseOrderOrderedExpectation {
+	if mmMarkPurchaseOrderOrdered.mock.funcMarkPurchaseOrderOrdered != nil {
+		mmMarkPurchaseOrderOrdered.mock.t.Fatalf("StockServiceRepositoryMock.MarkPurchaseOrderOrdered mock is already set by Set")
+	}
+
+	expectation := &StockServiceRepositoryMockMarkPurchaseOrderOrderedExpectation{
+		mock:               mmMarkPurchaseOrderOrdered.mock,
+		params:             &StockServiceRepositoryMockMarkPurchaseOrderOrderedParams{ctx, purchaseOrderID},
+		expectationOrigins: StockServiceRepositoryMockMarkPurchaseOrderOrderedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkPurchaseOrderOrdered.expectations = append(mmMarkPurchaseOrderOrdered.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceRepository.MarkPurchaseOrderOrdered return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockMarkPurchaseOrderOrderedExpectation) Then(p1 domain.PurchaseOrder, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockMarkPurchaseOrderOrderedResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceRepository.MarkPurchaseOrderOrdered should be invoked
+func (mmMarkPurchaseOrderOrdered *mStockServiceRepositoryMockMarkPurchaseOrderOrdered) Times(n uint64) *mStockServiceRepositoryMockMarkPurchaseOrderOrdered {
+	if n == 0 {
+		mmMarkPurchaseOrderOrdered.mock.t.Fatalf("Times of StockServiceRepositoryMock.MarkPurchaseOrderOrdered mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkPurchaseOrderOrdered.expectedInvocations, n)
+	mmMarkPurchaseOrderOrdered.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkPurchaseOrderOrdered
+}
+
+func (mmMarkPurchaseOrderOrdered *mStockServiceRepositoryMockMarkPurchaseOrderOrdered) invocationsDone() bool {
+	if len(mmMarkPurchaseOrderOrdered.expectations) == 0 && mmMarkPurchaseOrderOrdered.defaultExpectation == nil && mmMarkPurchaseOrderOrdered.mock.funcMarkPurchaseOrderOrdered == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkPurchaseOrderOrdered.mock.afterMarkPurchaseOrderOrderedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkPurchaseOrderOrdered.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkPurchaseOrderOrdered implements mm_stocks.StockServiceRepository
+func (mmMarkPurchaseOrderOrdered *StockServiceRepositoryMock) MarkPurchaseOrderOrdered(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) (p1 domain.PurchaseOrder, err error) {
+	mm_atomic.AddUint64(&mmMarkPurchaseOrderOrdered.beforeMarkPurchaseOrderOrderedCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkPurchaseOrderOrdered.afterMarkPurchaseOrderOrderedCounter, 1)
+
+	mmMarkPurchaseOrderOrdered.t.Helper()
+
+	if mmMarkPurchaseOrderOrdered.inspectFuncMarkPurchaseOrderOrdered != nil {
+		mmMarkPurchaseOrderOrdered.inspectFuncMarkPurchaseOrderOrdered(ctx, purchaseOrderID)
+	}
+
+	mm_params := StockServiceRepositoryMockMarkPurchaseOrderOrderedParams{ctx, purchaseOrderID}
+
+	// Record call args
+	mmMarkPurchaseOrderOrdered.MarkPurchaseOrderOrderedMock.mutex.Lock()
+	mmMarkPurchaseOrderOrdered.MarkPurchaseOrderOrderedMock.callArgs = append(mmMarkPurchaseOrderOrdered.MarkPurchaseOrderOrderedMock.callArgs, &mm_params)
+	mmMarkPurchaseOrderOrdered.MarkPurchaseOrderOrderedMock.mutex.Unlock()
+
+	for _, e := range mmMarkPurchaseOrderOrdered.MarkPurchaseOrderOrderedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmMarkPurchaseOrderOrdered.MarkPurchaseOrderOrderedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkPurchaseOrderOrdered.MarkPurchaseOrderOrderedMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkPurchaseOrderOrdered.MarkPurchaseOrderOrderedMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkPurchaseOrderOrdered.MarkPurchaseOrderOrderedMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceRepositoryMockMarkPurchaseOrderOrderedParams{ctx, purchaseOrderID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkPurchaseOrderOrdered.t.Errorf("StockServiceRepositoryMock.MarkPurchaseOrderOrdered got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkPurchaseOrderOrdered.MarkPurchaseOrderOrderedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.purchaseOrderID != nil && !minimock.Equal(*mm_want_ptrs.purchaseOrderID, mm_got.purchaseOrderID) {
+				mmMarkPurchaseOrderOrdered.t.Errorf("StockServiceRepositoryMock.MarkPurchaseOrderOrdered got unexpected parameter purchaseOrderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkPurchaseOrderOrdered.MarkPurchaseOrderOrderedMock.defaultExpectation.expectationOrigins.originPurchaseOrderID, *mm_want_ptrs.purchaseOrderID, mm_got.purchaseOrderID, minimock.Diff(*mm_want_ptrs.purchaseOrderID, mm_got.purchaseOrderID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkPurchaseOrderOrdered.t.Errorf("StockServiceRepositoryMock.MarkPurchaseOrderOrdered got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkPurchaseOrderOrdered.MarkPurchaseOrderOrderedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkPurchaseOrderOrdered.MarkPurchaseOrderOrderedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkPurchaseOrderOrdered.t.Fatal("No results are set for the StockServiceRepositoryMock.MarkPurchaseOrderOrdered")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmMarkPurchaseOrderOrdered.funcMarkPurchaseOrderOrdered != nil {
+		return mmMarkPurchaseOrderOrdered.funcMarkPurchaseOrderOrdered(ctx, purchaseOrderID)
+	}
+	mmMarkPurchaseOrderOrdered.t.Fatalf("Unexpected call to StockServiceRepositoryMock.MarkPurchaseOrderOrdered. %v %v", ctx, purchaseOrderID)
+	return
+}
+
+// MarkPurchaseOrderOrderedAfterCounter returns a count of finished StockServiceRepositoryMock.MarkPurchaseOrderOrdered invocations
+func (mmMarkPurchaseOrderOrdered *StockServiceRepositoryMock) MarkPurchaseOrderOrderedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkPurchaseOrderOrdered.afterMarkPurchaseOrderOrderedCounter)
+}
+
+// MarkPurchaseOrderOrderedBeforeCounter returns a count of StockServiceRepositoryMock.MarkPurchaseOrderOrdered invocations
+func (mmMarkPurchaseOrderOrdered *StockServiceRepositoryMock) MarkPurchaseOrderOrderedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkPurchaseOrderOrdered.beforeMarkPurchaseOrderOrderedCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.MarkPurchaseOrderOrdered.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkPurchaseOrderOrdered *mStockServiceRepositoryMockMarkPurchaseOrderOrdered) Calls() []*StockServiceRepositoryMockMarkPurchaseOrderOrderedParams {
+	mmMarkPurchaseOrderOrdered.mutex.RLock()
+
+	argCopy := make([]*StockServiceRepositoryMockMarkPurchaseOrderOrderedParams, len(mmMarkPurchaseOrderOrdered.callArgs))
+	copy(argCopy, mmMarkPurchaseOrderOrdered.callArgs)
+
+	mmMarkPurchaseOrderOrdered.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkPurchaseOrderOrderedDone returns true if the count of the MarkPurchaseOrderOrdered invocations corresponds
+// the number of defined expectations
+func (m *StockServiceRepositoryMock) MinimockMarkPurchaseOrderOrderedDone() bool {
+	if m.MarkPurchaseOrderOrderedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkPurchaseOrderOrderedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkPurchaseOrderOrderedMock.invocationsDone()
+}
+
+// MinimockMarkPurchaseOrderOrderedInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockMarkPurchaseOrderOrderedInspect() {
+	for _, e := range m.MarkPurchaseOrderOrderedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.MarkPurchaseOrderOrdered at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkPurchaseOrderOrderedCounter := mm_atomic.LoadUint64(&m.afterMarkPurchaseOrderOrderedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkPurchaseOrderOrderedMock.defaultExpectation != nil && afterMarkPurchaseOrderOrderedCounter < 1 {
+		if m.MarkPurchaseOrderOrderedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.MarkPurchaseOrderOrdered at\n%s", m.MarkPurchaseOrderOrderedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.MarkPurchaseOrderOrdered at\n%s with params: %#v", m.MarkPurchaseOrderOrderedMock.defaultExpectation.expectationOrigins.origin, *m.MarkPurchaseOrderOrderedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkPurchaseOrderOrdered != nil && afterMarkPurchaseOrderOrderedCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.MarkPurchaseOrderOrdered at\n%s", m.funcMarkPurchaseOrderOrderedOrigin)
+	}
+
+	if !m.MarkPurchaseOrderOrderedMock.invocationsDone() && afterMarkPurchaseOrderOrderedCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.MarkPurchaseOrderOrdered at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkPurchaseOrderOrderedMock.expectedInvocations), m.MarkPurchaseOrderOrderedMock.expectedInvocationsOrigin, afterMarkPurchaseOrderOrderedCounter)
+	}
+}
+
+type mStockServiceRepositoryMockPurgeStockItemsDeletedBefore struct {
+	optional           bool
+	mock               *StockServiceRepositoryMock
+	defaultExpectation *StockServiceRepositoryMockPurgeStockItemsDeletedBeforeExpectation
+	expectations       []*StockServiceRepositoryMockPurgeStockItemsDeletedBeforeExpectation
+
+	callArgs []*StockServiceRepositoryMockPurgeStockItemsDeletedBeforeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceRepositoryMockPurgeStockItemsDeletedBeforeExpectation specifies expectation struct of the StockServiceRepository.PurgeStockItemsDeletedBefore
+type StockServiceRepositoryMockPurgeStockItemsDeletedBeforeExpectation struct {
+	mock               *StockServiceRepositoryMock
+	params             *StockServiceRepositoryMockPurgeStockItemsDeletedBeforeParams
+	paramPtrs          *StockServiceRepositoryMockPurgeStockItemsDeletedBeforeParamPtrs
+	expectationOrigins StockServiceRepositoryMockPurgeStockItemsDeletedBeforeExpectationOrigins
+	results            *StockServiceRepositoryMockPurgeStockItemsDeletedBeforeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceRepositoryMockPurgeStockItemsDeletedBeforeParams contains parameters of the StockServiceRepository.PurgeStockItemsDeletedBefore
+type StockServiceRepositoryMockPurgeStockItemsDeletedBeforeParams struct {
+	ctx           context.Context
+	deletedBefore time.Time
+}
+
+// StockServiceRepositoryMockPurgeStockItemsDeletedBeforeParamPtrs contains pointers to parameters of the StockServiceRepository.PurgeStockItemsDeletedBefore
+type StockServiceRepositoryMockPurgeStockItemsDeletedBeforeParamPtrs struct {
+	ctx           *context.Context
+	deletedBefore *time.Time
+}
+
+// StockServiceRepositoryMockPurgeStockItemsDeletedBeforeResults contains results of the StockServiceRepository.PurgeStockItemsDeletedBefore
+type StockServiceRepositoryMockPurgeStockItemsDeletedBeforeResults struct {
+	i1  int64
+	err error
+}
+
+// StockServiceRepositoryMockPurgeStockItemsDeletedBeforeOrigins contains origins of expectations of the StockServiceRepository.PurgeStockItemsDeletedBefore
+type StockServiceRepositoryMockPurgeStockItemsDeletedBeforeExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originDeletedBefore string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeStockItemsDeletedBefore *mStockServiceRepositoryMockPurgeStockItemsDeletedBefore) Optional() *mStockServiceRepositoryMockPurgeStockItemsDeletedBefore {
+	mmPurgeStockItemsDeletedBefore.optional = true
+	return mmPurgeStockItemsDeletedBefore
+}
+
+// Expect sets up expected params for StockServiceRepository.PurgeStockItemsDeletedBefore
+func (mmPurgeStockItemsDeletedBefore *mStockServiceRepositoryMockPurgeStockItemsDeletedBefore) Expect(ctx context.Context, deletedBefore time.Time) *mStockServiceRepositoryMockPurgeStockItemsDeletedBefore {
+	if mmPurgeStockItemsDeletedBefore.mock.funcPurgeStockItemsDeletedBefore != nil {
+		mmPurgeStockItemsDeletedBefore.mock.t.Fatalf("StockServiceRepositoryMock.PurgeStockItemsDeletedBefore mock is already set by Set")
+	}
+
+	if mmPurgeStockItemsDeletedBefore.defaultExpectation == nil {
+		mmPurgeStockItemsDeletedBefore.defaultExpectation = &StockServiceRepositoryMockPurgeStockItemsDeletedBeforeExpectation{}
+	}
+
+	if mmPurgeStockItemsDeletedBefore.defaultExpectation.paramPtrs != nil {
+		mmPurgeStockItemsDeletedBefore.mock.t.Fatalf("StockServiceRepositoryMock.PurgeStockItemsDeletedBefore mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeStockItemsDeletedBefore.defaultExpectation.params = &StockServiceRepositoryMockPurgeStockItemsDeletedBeforeParams{ctx, deletedBefore}
+	mmPurgeStockItemsDeletedBefore.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeStockItemsDeletedBefore.expectations {
+		if minimock.Equal(e.params, mmPurgeStockItemsDeletedBefore.defaultExpectation.params) {
+			mmPurgeStockItemsDeletedBefore.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeStockItemsDeletedBefore.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeStockItemsDeletedBefore
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.PurgeStockItemsDeletedBefore
+func (mmPurgeStockItemsDeletedBefore *mStockServiceRepositoryMockPurgeStockItemsDeletedBefore) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockPurgeStockItemsDeletedBefore {
+	if mmPurgeStockItemsDeletedBefore.mock.funcPurgeStockItemsDeletedBefore != nil {
+		mmPurgeStockItemsDeletedBefore.mock.t.Fatalf("StockServiceRepositoryMock.PurgeStockItemsDeletedBefore mock is already set by Set")
+	}
+
+	if mmPurgeStockItemsDeletedBefore.defaultExpectation == nil {
+		mmPurgeStockItemsDeletedBefore.defaultExpectation = &StockServiceRepositoryMockPurgeStockItemsDeletedBeforeExpectation{}
+	}
+
+	if mmPurgeStockItemsDeletedBefore.defaultExpectation.params != nil {
+		mmPurgeStockItemsDeletedBefore.mock.t.Fatalf("StockServiceRepositoryMock.PurgeStockItemsDeletedBefore mock is already set by Expect")
+	}
+
+	if mmPurgeStockItemsDeletedBefore.defaultExpectation.paramPtrs == nil {
+		mmPurgeStockItemsDeletedBefore.defaultExpectation.paramPtrs = &StockServiceRepositoryMockPurgeStockItemsDeletedBeforeParamPtrs{}
+	}
+	mmPurgeStockItemsDeletedBefore.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeStockItemsDeletedBefore.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeStockItemsDeletedBefore
+}
+
+// ExpectDeletedBeforeParam2 sets up expected param deletedBefore for StockServiceRepository.PurgeStockItemsDeletedBefore
+func (mmPurgeStockItemsDeletedBefore *mStockServiceRepositoryMockPurgeStockItemsDeletedBefore) ExpectDeletedBeforeParam2(deletedBefore time.Time) *mStockServiceRepositoryMockPurgeStockItemsDeletedBefore {
+	if mmPurgeStockItemsDeletedBefore.mock.funcPurgeStockItemsDeletedBefore != nil {
+		mmPurgeStockItemsDeletedBefore.mock.t.Fatalf("StockServiceRepositoryMock.PurgeStockItemsDeletedBefore mock is already set by Set")
+	}
+
+	if mmPurgeStockItemsDeletedBefore.defaultExpectation == nil {
+		mmPurgeStockItemsDeletedBefore.defaultExpectation = &StockServiceRepositoryMockPurgeStockItemsDeletedBeforeExpectation{}
+	}
+
+	if mmPurgeStockItemsDeletedBefore.defaultExpectation.params != nil {
+		mmPurgeStockItemsDeletedBefore.mock.t.Fatalf("StockServiceRepositoryMock.PurgeStockItemsDeletedBefore mock is already set by Expect")
+	}
+
+	if mmPurgeStockItemsDeletedBefore.defaultExpectation.paramPtrs == nil {
+		mmPurgeStockItemsDeletedBefore.defaultExpectation.paramPtrs = &StockServiceRepositoryMockPurgeStockItemsDeletedBeforeParamPtrs{}
+	}
+	mmPurgeStockItemsDeletedBefore.defaultExpectation.paramPtrs.deletedBefore = &deletedBefore
+	mmPurgeStockItemsDeletedBefore.defaultExpectation.expectationOrigins.originDeletedBefore = minimock.CallerInfo(1)
+
+	return mmPurgeStockItemsDeletedBefore
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.PurgeStockItemsDeletedBefore
+func (mmPurgeStockItemsDeletedBefore *mStockServiceRepositoryMockPurgeStockItemsDeletedBefore) Inspect(f func(ctx context.Context, deletedBefore time.Time)) *mStockServiceRepositoryMockPurgeStockItemsDeletedBefore {
+	if mmPurgeStockItemsDeletedBefore.mock.inspectFuncPurgeStockItemsDeletedBefore != nil {
+		mmPurgeStockItemsDeletedBefore.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.PurgeStockItemsDeletedBefore")
+	}
+
+	mmPurgeStockItemsDeletedBefore.mock.inspectFuncPurgeStockItemsDeletedBefore = f
+
+	return mmPurgeStockItemsDeletedBefore
+}
+
+// Return sets up results that will be returned by StockServiceRepository.PurgeStockItemsDeletedBefore
+func (mmPurgeStockItemsDeletedBefore *mStockServiceRepositoryMockPurgeStockItemsDeletedBefore) Return(i1 int64, err error) *StockServiceRepositoryMock {
+	if mmPurgeStockItemsDeletedBefore.mock.funcPurgeStockItemsDeletedBefore != nil {
+		mmPurgeStockItemsDeletedBefore.mock.t.Fatalf("StockServiceRepositoryMock.PurgeStockItemsDeletedBefore mock is already set by Set")
+	}
+
+	if mmPurgeStockItemsDeletedBefore.defaultExpectation == nil {
+		mmPurgeStockItemsDeletedBefore.defaultExpectation = &StockServiceRepositoryMockPurgeStockItemsDeletedBeforeExpectation{mock: mmPurgeStockItemsDeletedBefore.mock}
+	}
+	mmPurgeStockItemsDeletedBefore.defaultExpectation.results = &StockServiceRepositoryMockPurgeStockItemsDeletedBeforeResults{i1, err}
+	mmPurgeStockItemsDeletedBefore.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeStockItemsDeletedBefore.mock
+}
+
+// Set uses given function f to mock the StockServiceRepository.PurgeStockItemsDeletedBefore method
+func (mmPurgeStockItemsDeletedBefore *mStockServiceRepositoryMockPurgeStockItemsDeletedBefore) Set(f func(ctx context.Context, deletedBefore time.Time) (i1 int64, err error)) *StockServiceRepositoryMock {
+	if mmPurgeStockItemsDeletedBefore.defaultExpectation != nil {
+		mmPurgeStockItemsDeletedBefore.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.PurgeStockItemsDeletedBefore method")
+	}
+
+	if len(mmPurgeStockItemsDeletedBefore.expectations) > 0 {
+		mmPurgeStockItemsDeletedBefore.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.PurgeStockItemsDeletedBefore method")
+	}
+
+	mmPurgeStockItemsDeletedBefore.mock.funcPurgeStockItemsDeletedBefore = f
+	mmPurgeStockItemsDeletedBefore.mock.funcPurgeStockItemsDeletedBeforeOrigin = minimock.CallerInfo(1)
+	return mmPurgeStockItemsDeletedBefore.mock
+}
+
+// When sets expectation for the StockServiceRepository.PurgeStockItemsDeletedBefore which will trigger the result defined by the following
+// Then helper
+func (mmPurgeStockItemsDeletedBefore *mStockServiceRepositoryMockPurgeStockItemsDeletedBefore) When(ctx context.Context, deletedBefore time.Time) *StockServiceRepositoryMockPurgeStockItemsDeletedBeforeExpectation {
+	if mmPurgeStockItemsDeletedBefore.mock.funcPurgeStockItemsDeletedBefore != nil {
+		mmPurgeStockItemsDeletedBefore.mock.t.Fatalf("StockServiceRepositoryMock.PurgeStockItemsDeletedBefore mock is already set by Set")
+	}
+
+	expectation := &StockServiceRepositoryMockPurgeStockItemsDeletedBeforeExpectation{
+		mock:               mmPurgeStockItemsDeletedBefore.mock,
+		params:             &StockServiceRepositoryMockPurgeStockItemsDeletedBeforeParams{ctx, deletedBefore},
+		expectationOrigins: StockServiceRepositoryMockPurgeStockItemsDeletedBeforeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeStockItemsDeletedBefore.expectations = append(mmPurgeStockItemsDeletedBefore.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceRepository.PurgeStockItemsDeletedBefore return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockPurgeStockItemsDeletedBeforeExpectation) Then(i1 int64, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockPurgeStockItemsDeletedBeforeResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceRepository.PurgeStockItemsDeletedBefore should be invoked
+func (mmPurgeStockItemsDeletedBefore *mStockServiceRepositoryMockPurgeStockItemsDeletedBefore) Times(n uint64) *mStockServiceRepositoryMockPurgeStockItemsDeletedBefore {
+	if n == 0 {
+		mmPurgeStockItemsDeletedBefore.mock.t.Fatalf("Times of StockServiceRepositoryMock.PurgeStockItemsDeletedBefore mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeStockItemsDeletedBefore.expectedInvocations, n)
+	mmPurgeStockItemsDeletedBefore.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeStockItemsDeletedBefore
+}
+
+func (mmPurgeStockItemsDeletedBefore *mStockServiceRepositoryMockPurgeStockItemsDeletedBefore) invocationsDone() bool {
+	if len(mmPurgeStockItemsDeletedBefore.expectations) == 0 && mmPurgeStockItemsDeletedBefore.defaultExpectation == nil && mmPurgeStockItemsDeletedBefore.mock.funcPurgeStockItemsDeletedBefore == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeStockItemsDeletedBefore.mock.afterPurgeStockItemsDeletedBeforeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeStockItemsDeletedBefore.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeStockItemsDeletedBefore implements mm_stocks.StockServiceRepository
+func (mmPurgeStockItemsDeletedBefore *StockServiceRepositoryMock) PurgeStockItemsDeletedBefore(ctx context.Context, deletedBefore time.Time) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmPurgeStockItemsDeletedBefore.beforePurgeStockItemsDeletedBeforeCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeStockItemsDeletedBefore.afterPurgeStockItemsDeletedBeforeCounter, 1)
+
+	mmPurgeStockItemsDeletedBefore.t.Helper()
+
+	if mmPurgeStockItemsDeletedBefore.inspectFuncPurgeStockItemsDeletedBefore != nil {
+		mmPurgeStockItemsDeletedBefore.inspectFuncPurgeStockItemsDeletedBefore(ctx, deletedBefore)
+	}
+
+	mm_params := StockServiceRepositoryMockPurgeStockItemsDeletedBeforeParams{ctx, deletedBefore}
+
+	// Record call args
+	mmPurgeStockItemsDeletedBefore.PurgeStockItemsDeletedBeforeMock.mutex.Lock()
+	mmPurgeStockItemsDeletedBefore.PurgeStockItemsDeletedBeforeMock.callArgs = append(mmPurgeStockItemsDeletedBefore.PurgeStockItemsDeletedBeforeMock.callArgs, &mm_params)
+	mmPurgeStockItemsDeletedBefore.PurgeStockItemsDeletedBeforeMock.mutex.Unlock()
+
+	for _, e := range mmPurgeStockItemsDeletedBefore.PurgeStockItemsDeletedBeforeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmPurgeStockItemsDeletedBefore.PurgeStockItemsDeletedBeforeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeStockItemsDeletedBefore.PurgeStockItemsDeletedBeforeMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeStockItemsDeletedBefore.PurgeStockItemsDeletedBeforeMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeStockItemsDeletedBefore.PurgeStockItemsDeletedBeforeMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceRepositoryMockPurgeStockItemsDeletedBeforeParams{ctx, deletedBefore}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeStockItemsDeletedBefore.t.Errorf("StockServiceRepositoryMock.PurgeStockItemsDeletedBefore got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeStockItemsDeletedBefore.PurgeStockItemsDeletedBeforeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.deletedBefore != nil && !minimock.Equal(*mm_want_ptrs.deletedBefore, mm_got.deletedBefore) {
+				mmPurgeStockItemsDeletedBefore.t.Errorf("StockServiceRepositoryMock.PurgeStockItemsDeletedBefore got unexpected parameter deletedBefore, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeStockItemsDeletedBefore.PurgeStockItemsDeletedBeforeMock.defaultExpectation.expectationOrigins.originDeletedBefore, *mm_want_ptrs.deletedBefore, mm_got.deletedBefore, minimock.Diff(*mm_want_ptrs.deletedBefore, mm_got.deletedBefore))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeStockItemsDeletedBefore.t.Errorf("StockServiceRepositoryMock.PurgeStockItemsDeletedBefore got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeStockItemsDeletedBefore.PurgeStockItemsDeletedBeforeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeStockItemsDeletedBefore.PurgeStockItemsDeletedBeforeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeStockItemsDeletedBefore.t.Fatal("No results are set for the StockServiceRepositoryMock.PurgeStockItemsDeletedBefore")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmPurgeStockItemsDeletedBefore.funcPurgeStockItemsDeletedBefore != nil {
+		return mmPurgeStockItemsDeletedBefore.funcPurgeStockItemsDeletedBefore(ctx, deletedBefore)
+	}
+	mmPurgeStockItemsDeletedBefore.t.Fatalf("Unexpected call to StockServiceRepositoryMock.PurgeStockItemsDeletedBefore. %v %v", ctx, deletedBefore)
+	return
+}
+
+// PurgeStockItemsDeletedBeforeAfterCounter returns a count of finished StockServiceRepositoryMock.PurgeStockItemsDeletedBefore invocations
+func (mmPurgeStockItemsDeletedBefore *StockServiceRepositoryMock) PurgeStockItemsDeletedBeforeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeStockItemsDeletedBefore.afterPurgeStockItemsDeletedBeforeCounter)
+}
+
+// PurgeStockItemsDeletedBeforeBeforeCounter returns a count of StockServiceRepositoryMock.PurgeStockItemsDeletedBefore invocations
+func (mmPurgeStockItemsDeletedBefore *StockServiceRepositoryMock) PurgeStockItemsDeletedBeforeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeStockItemsDeletedBefore.beforePurgeStockItemsDeletedBeforeCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.PurgeStockItemsDeletedBefore.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeStockItemsDeletedBefore *mStockServiceRepositoryMockPurgeStockItemsDeletedBefore) Calls() []*StockServiceRepositoryMockPurgeStockItemsDeletedBeforeParams {
+	mmPurgeStockItemsDeletedBefore.mutex.RLock()
+
+	argCopy := make([]*StockServiceRepositoryMockPurgeStockItemsDeletedBeforeParams, len(mmPurgeStockItemsDeletedBefore.callArgs))
+	copy(argCopy, mmPurgeStockItemsDeletedBefore.callArgs)
+
+	mmPurgeStockItemsDeletedBefore.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeStockItemsDeletedBeforeDone returns true if the count of the PurgeStockItemsDeletedBefore invocations corresponds
+// the number of defined expectations
+func (m *StockServiceRepositoryMock) MinimockPurgeStockItemsDeletedBeforeDone() bool {
+	if m.PurgeStockItemsDeletedBeforeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeStockItemsDeletedBeforeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeStockItemsDeletedBeforeMock.invocationsDone()
+}
+
+// MinimockPurgeStockItemsDeletedBeforeInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockPurgeStockItemsDeletedBeforeInspect() {
+	for _, e := range m.PurgeStockItemsDeletedBeforeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.PurgeStockItemsDeletedBefore at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeStockItemsDeletedBeforeCounter := mm_atomic.LoadUint64(&m.afterPurgeStockItemsDeletedBeforeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeStockItemsDeletedBeforeMock.defaultExpectation != nil && afterPurgeStockItemsDeletedBeforeCounter < 1 {
+		if m.PurgeStockItemsDeletedBeforeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.PurgeStockItemsDeletedBefore at\n%s", m.PurgeStockItemsDeletedBeforeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.PurgeStockItemsDeletedBefore at\n%s with params: %#v", m.PurgeStockItemsDeletedBeforeMock.defaultExpectation.expectationOrigins.origin, *m.PurgeStockItemsDeletedBeforeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeStockItemsDeletedBefore != nil && afterPurgeStockItemsDeletedBeforeCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.PurgeStockItemsDeletedBefore at\n%s", m.funcPurgeStockItemsDeletedBeforeOrigin)
+	}
+
+	if !m.PurgeStockItemsDeletedBeforeMock.invocationsDone() && afterPurgeStockItemsDeletedBeforeCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.PurgeStockItemsDeletedBefore at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeStockItemsDeletedBeforeMock.expectedInvocations), m.PurgeStockItemsDeletedBeforeMock.expectedInvocationsOrigin, afterPurgeStockItemsDeletedBeforeCounter)
+	}
+}
+
+type mStockServiceRepositoryMockReceivePurchaseOrderLines struct {
+	optional           bool
+	mock               *StockServiceRepositoryMock
+	defaultExpectation *StockServiceRepositoryMockReceivePurchaseOrderLinesExpectation
+	expectations       []*StockServiceRepositoryMockReceivePurchaseOrderLinesExpectation
+
+	callArgs []*StockServiceRepositoryMockReceivePurchaseOrderLinesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceRepositoryMockReceivePurchaseOrderLinesExpectation specifies expectation struct of the StockServiceRepository.ReceivePurchaseOrderLines
+type StockServiceRepositoryMockReceivePurchaseOrderLinesExpectation struct {
+	mock               *StockServiceRepositoryMock
+	params             *StockServiceRepositoryMockReceivePurchaseOrderLinesParams
+	paramPtrs          *StockServiceRepositoryMockReceivePurchaseOrderLinesParamPtrs
+	expectationOrigins StockServiceRepositoryMockReceivePurchaseOrderLinesExpectationOrigins
+	results            *StockServiceRepositoryMockReceivePurchaseOrderLinesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceRepositoryMockReceivePurchaseOrderLinesParams contains parameters of the StockServiceRepository.ReceivePurchaseOrderLines
+type StockServiceRepositoryMockReceivePurchaseOrderLinesParams struct {
+	ctx     context.Context
+	receipt domain.PurchaseOrderReceipt
+}
+
+// StockServiceRepositoryMockReceivePurchaseOrderLinesParamPtrs contains pointers to parameters of the StockServiceRepository.ReceivePurchaseOrderLines
+type StockServiceRepositoryMockReceivePurchaseOrderLinesParamPtrs struct {
+	ctx     *context.Context
+	receipt *domain.PurchaseOrderReceipt
+}
+
+// StockServiceRepositoryMockReceivePurchaseOrderLinesResults contains results of the StockServiceRepository.ReceivePurchaseOrderLines
+type StockServiceRepositoryMockReceivePurchaseOrderLinesResults struct {
+	p1  domain.PurchaseOrderReceiptResult
+	err error
+}
+
+// StockServiceRepositoryMockReceivePurchaseOrderLinesOrigins contains origins of expectations of the StockServiceRepository.ReceivePurchaseOrderLines
+type StockServiceRepositoryMockReceivePurchaseOrderLinesExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originReceipt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReceivePurchaseOrderLines *mStockServiceRepositoryMockReceivePurchaseOrderLines) Optional() *mStockServiceRepositoryMockReceivePurchaseOrderLines {
+	mmReceivePurchaseOrderLines.optional = true
+	return mmReceivePurchaseOrderLines
+}
+
+// Expect sets up expected params for StockServiceRepository.ReceivePurchaseOrderLines
+func (mmReceivePurchaseOrderLines *mStockServiceRepositoryMockReceivePurchaseOrderLines) Expect(ctx context.Context, receipt domain.PurchaseOrderReceipt) *mStockServiceRepositoryMockReceivePurchaseOrderLines {
+	if mmReceivePurchaseOrderLines.mock.funcReceivePurchaseOrderLines != nil {
+		mmReceivePurchaseOrderLines.mock.t.Fatalf("StockServiceRepositoryMock.ReceivePurchaseOrderLines mock is already set by Set")
+	}
+
+	if mmReceivePurchaseOrderLines.defaultExpectation == nil {
+		mmReceivePurchaseOrderLines.defaultExpectation = &StockServiceRepositoryMockReceivePurchaseOrderLinesExpectation{}
+	}
+
+	if mmReceivePurchaseOrderLines.defaultExpectation.paramPtrs != nil {
+		mmReceivePurchaseOrderLines.mock.t.Fatalf("StockServiceRepositoryMock.ReceivePurchaseOrderLines mock is already set by ExpectParams functions")
+	}
+
+	mmReceivePurchaseOrderLines.defaultExpectation.params = &StockServiceRepositoryMockReceivePurchaseOrderLinesParams{ctx, receipt}
+	mmReceivePurchaseOrderLines.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReceivePurchaseOrderLines.expectations {
+		if minimock.Equal(e.params, mmReceivePurchaseOrderLines.defaultExpectation.params) {
+			mmReceivePurchaseOrderLines.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReceivePurchaseOrderLines.defaultExpectation.params)
+		}
+	}
+
+	return mmReceivePurchaseOrderLines
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.ReceivePurchaseOrderLines
+func (mmReceivePurchaseOrderLines *mStockServiceRepositoryMockReceivePurchaseOrderLines) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockReceivePurchaseOrderLines {
+	if mmReceivePurchaseOrderLines.mock.funcReceivePurchaseOrderLines != nil {
+		mmReceivePurchaseOrderLines.mock.t.Fatalf("StockServiceRepositoryMock.ReceivePurchaseOrderLines mock is already set by Set")
+	}
+
+	if mmReceivePurchaseOrderLines.defaultExpectation == nil {
+		mmReceivePurchaseOrderLines.defaultExpectation = &StockServiceRepositoryMockReceivePurchaseOrderLinesExpectation{}
+	}
+
+	if mmReceivePurchaseOrderLines.defaultExpectation.params != nil {
+		mmReceivePurchaseOrderLines.mock.t.Fatalf("StockServiceRepositoryMock.ReceivePurchaseOrderLines mock is already set by Expect")
+	}
+
+	if mmReceivePurchaseOrderLines.defaultExpectation.paramPtrs == nil {
+		mmReceivePurchaseOrderLines.defaultExpectation.paramPtrs = &StockServiceRepositoryMockReceivePurchaseOrderLinesParamPtrs{}
+	}
+	mmReceivePurchaseOrderLines.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReceivePurchaseOrderLines.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReceivePurchaseOrderLines
+}
+
+// ExpectReceiptParam2 sets up expected param receipt for StockServiceRepository.ReceivePurchaseOrderLines
+func (mmReceivePurchaseOrderLines *mStockServiceRepositoryMockReceivePurchaseOrderLines) ExpectReceiptParam2(receipt domain.PurchaseOrderReceipt) *mStockServiceRepositoryMockReceivePurchaseOrderLines {
+	if mmReceivePurchaseOrderLines.mock.funcReceivePurchaseOrderLines != nil {
+		mmReceivePurchaseOrderLines.mock.t.Fatalf("StockServiceRepositoryMock.ReceivePurchaseOrderLines mock is already set by Set")
+	}
+
+	if mmReceivePurchaseOrderLines.defaultExpectation == nil {
+		mmReceivePurchaseOrderLines.defaultExpectation = &StockServiceRepositoryMockReceivePurchaseOrderLinesExpectation{}
+	}
+
+	if mmReceivePurchaseOrderLines.defaultExpectation.params != nil {
+		mmReceivePurchaseOrderLines.mock.t.Fatalf("StockServiceRepositoryMock.ReceivePurchaseOrderLines mock is already set by Expect")
+	}
+
+	if mmReceivePurchaseOrderLines.defaultExpectation.paramPtrs == nil {
+		mmReceivePurchaseOrderLines.defaultExpectation.paramPtrs = &StockServiceRepositoryMockReceivePurchaseOrderLinesParamPtrs{}
+	}
+	mmReceivePurchaseOrderLines.defaultExpectation.paramPtrs.receipt = &receipt
+	mmReceivePurchaseOrderLines.defaultExpectation.expectationOrigins.originReceipt = minimock.CallerInfo(1)
+
+	return mmReceivePurchaseOrderLines
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.ReceivePurchaseOrderLines
+func (mmReceivePurchaseOrderLines *mStockServiceRepositoryMockReceivePurchaseOrderLines) Inspect(f func(ctx context.Context, receipt domain.PurchaseOrderReceipt)) *mStockServiceRepositoryMockReceivePurchaseOrderLines {
+	if mmReceivePurchaseOrderLines.mock.inspectFuncReceivePurchaseOrderLines != nil {
+		mmReceivePurchaseOrderLines.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.ReceivePurchaseOrderLines")
+	}
+
+	mmReceivePurchaseOrderLines.mock.inspectFuncReceivePurchaseOrderLines = f
+
+	return mmReceivePurchaseOrderLines
+}
+
+// Return sets up results that will be returned by StockServiceRepository.ReceivePurchaseOrderLines
+func (mmReceivePurchaseOrderLines *mStockServiceRepositoryMockReceivePurchaseOrderLines) Return(p1 domain.PurchaseOrderReceiptResult, err error) *StockServiceRepositoryMock {
+	if mmReceivePurchaseOrderLines.mock.funcReceivePurchaseOrderLines != nil {
+		mmReceivePurchaseOrderLines.mock.t.Fatalf("StockServiceRepositoryMock.ReceivePurchaseOrderLines mock is already set by Set")
+	}
+
+	if mmReceivePurchaseOrderLines.defaultExpectation == nil {
+		mmReceivePurchaseOrderLines.defaultExpectation = &StockServiceRepositoryMockReceivePurchaseOrderLinesExpectation{mock: mmReceivePurchaseOrderLines.mock}
+	}
+	mmReceivePurchaseOrderLines.defaultExpectation.results = &StockServiceRepositoryMockReceivePurchaseOrderLinesResults{p1, err}
+	mmReceivePurchaseOrderLines.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReceivePurchaseOrderLines.mock
+}
+
+// Set uses given function f to mock the StockServiceRepository.ReceivePurchaseOrderLines method
+func (mmReceivePurchaseOrderLines *mStockServiceRepositoryMockReceivePurchaseOrderLines) Set(f func(ctx context.Context, receipt domain.PurchaseOrderReceipt) (p1 domain.PurchaseOrderReceiptResult, err error)) *StockServiceRepositoryMock {
+	if mmReceivePurchaseOrderLines.defaultExpectation != nil {
+		mmReceivePurchaseOrderLines.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.ReceivePurchaseOrderLines method")
+	}
+
+	if len(mmReceivePurchaseOrderLines.expectations) > 0 {
+		mmReceivePurchaseOrderLines.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.ReceivePurchaseOrderLines method")
+	}
+
+	mmReceivePurchaseOrderLines.mock.funcReceivePurchaseOrderLines = f
+	mmReceivePurchaseOrderLines.mock.funcReceivePurchaseOrderLinesOrigin = minimock.CallerInfo(1)
+	return mmReceivePurchaseOrderLines.mock
+}
+
+// When sets expectation for the StockServiceRepository.ReceivePurchaseOrderLines which will trigger the result defined by the following
+// Then helper
+func (mmReceivePurchaseOrderLines *mStockServiceRepositoryMockReceivePurchaseOrderLines) When(ctx context.Context, receipt domain.PurchaseOrderReceipt) *StockServiceRepositoryMockReceivePurchaseOrderLinesExpectation {
+	if mmReceivePurchaseOrderLines.mock.funcReceivePurchaseOrderLines != nil {
+		mmReceivePurchaseOrderLines.mock.t.Fatalf("StockServiceRepositoryMock.ReceivePurchaseOrderLines mock is already set by Set")
+	}
+
+	expectation := &StockServiceRepositoryMockReceivePurchaseOrderLinesExpectation{
+		mock:               mmReceivePurchaseOrderLines.mock,
+		params:             &StockServiceRepositoryMockReceivePurchaseOrderLinesParams{ctx, receipt},
+		expectationOrigins: StockServiceRepositoryMockReceivePurchaseOrderLinesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReceivePurchaseOrderLines.expectations = append(mmReceivePurchaseOrderLines.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceRepository.ReceivePurchaseOrderLines return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockReceivePurchaseOrderLinesExpectation) Then(p1 domain.PurchaseOrderReceiptResult, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockReceivePurchaseOrderLinesResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceRepository.ReceivePurchaseOrderLines should be invoked
+func (mmReceivePurchaseOrderLines *mStockServiceRepositoryMockReceivePurchaseOrderLines) Times(n uint64) *mStockServiceRepositoryMockReceivePurchaseOrderLines {
+	if n == 0 {
+		mmReceivePurchaseOrderLines.mock.t.Fatalf("Times of StockServiceRepositoryMock.ReceivePurchaseOrderLines mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReceivePurchaseOrderLines.expectedInvocations, n)
+	mmReceivePurchaseOrderLines.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReceivePurchaseOrderLines
+}
+
+func (mmReceivePurchaseOrderLines *mStockServiceRepositoryMockReceivePurchaseOrderLines) invocationsDone() bool {
+	if len(mmReceivePurchaseOrderLines.expectations) == 0 && mmReceivePurchaseOrderLines.defaultExpectation == nil && mmReceivePurchaseOrderLines.mock.funcReceivePurchaseOrderLines == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReceivePurchaseOrderLines.mock.afterReceivePurchaseOrderLinesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReceivePurchaseOrderLines.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReceivePurchaseOrderLines implements mm_stocks.StockServiceRepository
+func (mmReceivePurchaseOrderLines *StockServiceRepositoryMock) ReceivePurchaseOrderLines(ctx context.Context, receipt domain.PurchaseOrderReceipt) (p1 domain.PurchaseOrderReceiptResult, err error) {
+	mm_atomic.AddUint64(&mmReceivePurchaseOrderLines.beforeReceivePurchaseOrderLinesCounter, 1)
+	defer mm_atomic.AddUint64(&mmReceivePurchaseOrderLines.afterReceivePurchaseOrderLinesCounter, 1)
+
+	mmReceivePurchaseOrderLines.t.Helper()
+
+	if mmReceivePurchaseOrderLines.inspectFuncReceivePurchaseOrderLines != nil {
+		mmReceivePurchaseOrderLines.inspectFuncReceivePurchaseOrderLines(ctx, receipt)
+	}
+
+	mm_params := StockServiceRepositoryMockReceivePurchaseOrderLinesParams{ctx, receipt}
+
+	// Record call args
+	mmReceivePurchaseOrderLines.ReceivePurchaseOrderLinesMock.mutex.Lock()
+	mmReceivePurchaseOrderLines.ReceivePurchaseOrderLinesMock.callArgs = append(mmReceivePurchaseOrderLines.ReceivePurchaseOrderLinesMock.callArgs, &mm_params)
+	mmReceivePurchaseOrderLines.ReceivePurchaseOrderLinesMock.mutex.Unlock()
+
+	for _, e := range mmReceivePurchaseOrderLines.ReceivePurchaseOrderLinesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmReceivePurchaseOrderLines.ReceivePurchaseOrderLinesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReceivePurchaseOrderLines.ReceivePurchaseOrderLinesMock.defaultExpectation.Counter, 1)
+		mm_want := mmReceivePurchaseOrderLines.ReceivePurchaseOrderLinesMock.defaultExpectation.params
+		mm_want_ptrs := mmReceivePurchaseOrderLines.ReceivePurchaseOrderLinesMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceRepositoryMockReceivePurchaseOrderLinesParams{ctx, receipt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReceivePurchaseOrderLines.t.Errorf("StockServiceRepositoryMock.ReceivePurchaseOrderLines got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReceivePurchaseOrderLines.ReceivePurchaseOrderLinesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.receipt != nil && !minimock.Equal(*mm_want_ptrs.receipt, mm_got.receipt) {
+				mmReceivePurchaseOrderLines.t.Errorf("StockServiceRepositoryMock.ReceivePurchaseOrderLines got unexpected parameter receipt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReceivePurchaseOrderLines.ReceivePurchaseOrderLinesMock.defaultExpectation.expectationOrigins.originReceipt, *mm_want_ptrs.receipt, mm_got.receipt, minimock.Diff(*mm_want_ptrs.receipt, mm_got.receipt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReceivePurchaseOrderLines.t.Errorf("StockServiceRepositoryMock.ReceivePurchaseOrderLines got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReceivePurchaseOrderLines.ReceivePurchaseOrderLinesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReceivePurchaseOrderLines.ReceivePurchaseOrderLinesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReceivePurchaseOrderLines.t.Fatal("No results are set for the StockServiceRepositoryMock.ReceivePurchaseOrderLines")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmReceivePurchaseOrderLines.funcReceivePurchaseOrderLines != nil {
+		return mmReceivePurchaseOrderLines.funcReceivePurchaseOrderLines(ctx, receipt)
+	}
+	mmReceivePurchaseOrderLines.t.Fatalf("Unexpected call to StockServiceRepositoryMock.ReceivePurchaseOrderLines. %v %v", ctx, receipt)
+	return
+}
+
+// ReceivePurchaseOrderLinesAfterCounter returns a count of finished StockServiceRepositoryMock.ReceivePurchaseOrderLines invocations
+func (mmReceivePurchaseOrderLines *StockServiceRepositoryMock) ReceivePurchaseOrderLinesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReceivePurchaseOrderLines.afterReceivePurchaseOrderLinesCounter)
+}
+
+// ReceivePurchaseOrderLinesBeforeCounter returns a count of StockServiceRepositoryMock.ReceivePurchaseOrderLines invocations
+func (mmReceivePurchaseOrderLines *StockServiceRepositoryMock) ReceivePurchaseOrderLinesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReceivePurchaseOrderLines.beforeReceivePurchaseOrderLinesCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.ReceivePurchaseOrderLines.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReceivePurchaseOrderLines *mStockServiceRepositoryMockReceivePurchaseOrderLines) Calls() []*StockServiceRepositoryMockReceivePurchaseOrderLinesParams {
+	mmReceivePurchaseOrderLines.mutex.RLock()
+
+	argCopy := make([]*StockServiceRepositoryMockReceivePurchaseOrderLinesParams, len(mmReceivePurchaseOrderLines.callArgs))
+	copy(argCopy, mmReceivePurchaseOrderLines.callArgs)
+
+	mmReceivePurchaseOrderLines.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReceivePurchaseOrderLinesDone returns true if the count of the ReceivePurchaseOrderLines invocations corresponds
+// the number of defined expectations
+func (m *StockServiceRepositoryMock) MinimockReceivePurchaseOrderLinesDone() bool {
+	if m.ReceivePurchaseOrderLinesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReceivePurchaseOrderLinesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReceivePurchaseOrderLinesMock.invocationsDone()
+}
+
+// MinimockReceivePurchaseOrderLinesInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockReceivePurchaseOrderLinesInspect() {
+	for _, e := range m.ReceivePurchaseOrderLinesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ReceivePurchaseOrderLines at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReceivePurchaseOrderLinesCounter := mm_atomic.LoadUint64(&m.afterReceivePurchaseOrderLinesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReceivePurchaseOrderLinesMock.defaultExpectation != nil && afterReceivePurchaseOrderLinesCounter < 1 {
+		if m.ReceivePurchaseOrderLinesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ReceivePurchaseOrderLines at\n%s", m.ReceivePurchaseOrderLinesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ReceivePurchaseOrderLines at\n%s with params: %#v", m.ReceivePurchaseOrderLinesMock.defaultExpectation.expectationOrigins.origin, *m.ReceivePurchaseOrderLinesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReceivePurchaseOrderLines != nil && afterReceivePurchaseOrderLinesCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.ReceivePurchaseOrderLines at\n%s", m.funcReceivePurchaseOrderLinesOrigin)
+	}
+
+	if !m.ReceivePurchaseOrderLinesMock.invocationsDone() && afterReceivePurchaseOrderLinesCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.ReceivePurchaseOrderLines at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReceivePurchaseOrderLinesMock.expectedInvocations), m.ReceivePurchaseOrderLinesMock.expectedInvocationsOrigin, afterReceivePurchaseOrderLinesCounter)
+	}
+}
+
+type mStockServiceRepositoryMockReceiveStockTransfer struct {
+	optional           bool
+	mock               *StockServiceRepositoryMock
+	defaultExpectation *StockServiceRepositoryMockReceiveStockTransferExpectation
+	expectations       []*StockServiceRepositoryMockReceiveStockTransferExpectation
+
+	callArgs []*StockServiceRepositoryMockReceiveStockTransferParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceRepositoryMockReceiveStockTransferExpectation specifies expectation struct of the StockServiceRepository.ReceiveStockTransfer
+type StockServiceRepositoryMockReceiveStockTransferExpectation struct {
+	mock               *StockServiceRepositoryMock
+	params             *StockServiceRepositoryMockReceiveStockTransferParams
+	paramPtrs          *StockServiceRepositoryMockReceiveStockTransferParamPtrs
+	expectationOrigins StockServiceRepositoryMockReceiveStockTransferExpectationOrigins
+	results            *StockServiceRepositoryMockReceiveStockTransferResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceRepositoryMockReceiveStockTransferParams contains parameters of the StockServiceRepository.ReceiveStockTransfer
+type StockServiceRepositoryMockReceiveStockTransferParams struct {
+	ctx        context.Context
+	userID     domain.UserID
+	transferID domain.TransferID
+}
+
+// StockServiceRepositoryMockReceiveStockTransferParamPtrs contains pointers to parameters of the StockServiceRepository.ReceiveStockTransfer
+type StockServiceRepositoryMockReceiveStockTransferParamPtrs struct {
+	ctx        *context.Context
+	userID     *domain.UserID
+	transferID *domain.TransferID
+}
+
+// StockServiceRepositoryMockReceiveStockTransferResults contains results of the StockServiceRepository.ReceiveStockTransfer
+type StockServiceRepositoryMockReceiveStockTransferResults struct {
+	s1  domain.StockTransferResult
+	err error
+}
+
+// StockServiceRepositoryMockReceiveStockTransferOrigins contains origins of expectations of the StockServiceRepository.ReceiveStockTransfer
+type StockServiceRepositoryMockReceiveStockTransferExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originUserID     string
+	originTransferID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReceiveStockTransfer *mStockServiceRepositoryMockReceiveStockTransfer) Optional() *mStockServiceRepositoryMockReceiveStockTransfer {
+	mmReceiveStockTransfer.optional = true
+	return mmReceiveStockTransfer
+}
+
+// Expect sets up expected params for StockServiceRepository.ReceiveStockTransfer
+func (mmReceiveStockTransfer *mStockServiceRepositoryMockReceiveStockTransfer) Expect(ctx context.Context, userID domain.UserID, transferID domain.TransferID) *mStockServiceRepositoryMockReceiveStockTransfer {
+	if mmReceiveStockTransfer.mock.funcReceiveStockTransfer != nil {
+		mmReceiveStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.ReceiveStockTransfer mock is already set by Set")
+	}
+
+	if mmReceiveStockTransfer.defaultExpectation == nil {
+		mmReceiveStockTransfer.defaultExpectation = &StockServiceRepositoryMockReceiveStockTransferExpectation{}
+	}
+
+	if mmReceiveStockTransfer.defaultExpectation.paramPtrs != nil {
+		mmReceiveStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.ReceiveStockTransfer mock is already set by ExpectParams functions")
+	}
+
+	mmReceiveStockTransfer.defaultExpectation.params = &StockServiceRepositoryMockReceiveStockTransferParams{ctx, userID, transferID}
+	mmReceiveStockTransfer.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReceiveStockTransfer.expectations {
+		if minimock.Equal(e.params, mmReceiveStockTransfer.defaultExpectation.params) {
+			mmReceiveStockTransfer.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReceiveStockTransfer.defaultExpectation.params)
+		}
+	}
+
+	return mmReceiveStockTransfer
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.ReceiveStockTransfer
+func (mmReceiveStockTransfer *mStockServiceRepositoryMockReceiveStockTransfer) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockReceiveStockTransfer {
+	if mmReceiveStockTransfer.mock.funcReceiveStockTransfer != nil {
+		mmReceiveStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.ReceiveStockTransfer mock is already set by Set")
+	}
+
+	if mmReceiveStockTransfer.defaultExpectation == nil {
+		mmReceiveStockTransfer.defaultExpectation = &StockServiceRepositoryMockReceiveStockTransferExpectation{}
+	}
+
+	if mmReceiveStockTransfer.defaultExpectation.params != nil {
+		mmReceiveStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.ReceiveStockTransfer mock is already set by Expect")
+	}
+
+	if mmReceiveStockTransfer.defaultExpectation.paramPtrs == nil {
+		mmReceiveStockTransfer.defaultExpectation.paramPtrs = &StockServiceRepositoryMockReceiveStockTransferParamPtrs{}
+	}
+	mmReceiveStockTransfer.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReceiveStockTransfer.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReceiveStockTransfer
+}
+
+// ExpectUserIDParam2 sets up expected param userID for StockServiceRepository.ReceiveStockTransfer
+func (mmReceiveStockTransfer *mStockServiceRepositoryMockReceiveStockTransfer) ExpectUserIDParam2(userID domain.UserID) *mStockServiceRepositoryMockReceiveStockTransfer {
+	if mmReceiveStockTransfer.mock.funcReceiveStockTransfer != nil {
+		mmReceiveStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.ReceiveStockTransfer mock is already set by Set")
+	}
+
+	if mmReceiveStockTransfer.defaultExpectation == nil {
+		mmReceiveStockTransfer.defaultExpectation = &StockServiceRepositoryMockReceiveStockTransferExpectation{}
+	}
+
+	if mmReceiveStockTransfer.defaultExpectation.params != nil {
+		mmReceiveStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.ReceiveStockTransfer mock is already set by Expect")
+	}
+
+	if mmReceiveStockTransfer.defaultExpectation.paramPtrs == nil {
+		mmReceiveStockTransfer.defaultExpectation.paramPtrs = &StockServiceRepositoryMockReceiveStockTransferParamPtrs{}
+	}
+	mmReceiveStockTransfer.defaultExpectation.paramPtrs.userID = &userID
+	mmReceiveStockTransfer.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmReceiveStockTransfer
+}
+
+// ExpectTransferIDParam3 sets up expected param transferID for StockServiceRepository.ReceiveStockTransfer
+func (mmReceiveStockTransfer *mStockServiceRepositoryMockReceiveStockTransfer) ExpectTransferIDParam3(transferID domain.TransferID) *mStockServiceRepositoryMockReceiveStockTransfer {
+	if mmReceiveStockTransfer.mock.funcReceiveStockTransfer != nil {
+		mmReceiveStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.ReceiveStockTransfer mock is already set by Set")
+	}
+
+	if mmReceiveStockTransfer.defaultExpectation == nil {
+		mmReceiveStockTransfer.defaultExpectation = &StockServiceRepositoryMockReceiveStockTransferExpectation{}
+	}
+
+	if mmReceiveStockTransfer.defaultExpectation.params != nil {
+		mmReceiveStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.ReceiveStockTransfer mock is already set by Expect")
+	}
+
+	if mmReceiveStockTransfer.defaultExpectation.paramPtrs == nil {
+		mmReceiveStockTransfer.defaultExpectation.paramPtrs = &StockServiceRepositoryMockReceiveStockTransferParamPtrs{}
+	}
+	mmReceiveStockTransfer.defaultExpectation.paramPtrs.transferID = &transferID
+	mmReceiveStockTransfer.defaultExpectation.expectationOrigins.originTransferID = minimock.CallerInfo(1)
+
+	return mmReceiveStockTransfer
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.ReceiveStockTransfer
+func (mmReceiveStockTransfer *mStockServiceRepositoryMockReceiveStockTransfer) Inspect(f func(ctx context.Context, userID domain.UserID, transferID domain.TransferID)) *mStockServiceRepositoryMockReceiveStockTransfer {
+	if mmReceiveStockTransfer.mock.inspectFuncReceiveStockTransfer != nil {
+		mmReceiveStockTransfer.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.ReceiveStockTransfer")
+	}
+
+	mmReceiveStockTransfer.mock.inspectFuncReceiveStockTransfer = f
+
+	return mmReceiveStockTransfer
+}
+
+// Return sets up results that will be returned by StockServiceRepository.ReceiveStockTransfer
+func (mmReceiveStockTransfer *mStockServiceRepositoryMockReceiveStockTransfer) Return(s1 domain.StockTransferResult, err error) *StockServiceRepositoryMock {
+	if mmReceiveStockTransfer.mock.funcReceiveStockTransfer != nil {
+		mmReceiveStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.ReceiveStockTransfer mock is already set by Set")
+	}
+
+	if mmReceiveStockTransfer.defaultExpectation == nil {
+		mmReceiveStockTransfer.defaultExpectation = &StockServiceRepositoryMockReceiveStockTransferExpectation{mock: mmReceiveStockTransfer.mock}
+	}
+	mmReceiveStockTransfer.defaultExpectation.results = &StockServiceRepositoryMockReceiveStockTransferResults{s1, err}
+	mmReceiveStockTransfer.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReceiveStockTransfer.mock
+}
+
+// Set uses given function f to mock the StockServiceRepository.ReceiveStockTransfer method
+func (mmReceiveStockTransfer *mStockServiceRepositoryMockReceiveStockTransfer) Set(f func(ctx context.Context, userID domain.UserID, transferID domain.TransferID) (s1 domain.StockTransferResult, err error)) *StockServiceRepositoryMock {
+	if mmReceiveStockTransfer.defaultExpectation != nil {
+		mmReceiveStockTransfer.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.ReceiveStockTransfer method")
+	}
+
+	if len(mmReceiveStockTransfer.expectations) > 0 {
+		mmReceiveStockTransfer.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.ReceiveStockTransfer method")
+	}
+
+	mmReceiveStockTransfer.mock.funcReceiveStockTransfer = f
+	mmReceiveStockTransfer.mock.funcReceiveStockTransferOrigin = minimock.CallerInfo(1)
+	return mmReceiveStockTransfer.mock
+}
+
+// When sets expectation for the StockServiceRepository.ReceiveStockTransfer which will trigger the result defined by the following
+// Then helper
+func (mmReceiveStockTransfer *mStockServiceRepositoryMockReceiveStockTransfer) When(ctx context.Context, userID domain.UserID, transferID domain.TransferID) *StockServiceRepositoryMockReceiveStockTransferExpectation {
+	if mmReceiveStockTransfer.mock.funcReceiveStockTransfer != nil {
+		mmReceiveStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.ReceiveStockTransfer mock is already set by Set")
+	}
+
+	expectation := &StockServiceRepositoryMockReceiveStockTransferExpectation{
+		mock:               mmReceiveStockTransfer.mock,
+		params:             &StockServiceRepositoryMockReceiveStockTransferParams{ctx, userID, transferID},
+		expectationOrigins: StockServiceRepositoryMockReceiveStockTransferExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReceiveStockTransfer.expectations = append(mmReceiveStockTransfer.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceRepository.ReceiveStockTransfer return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockReceiveStockTransferExpectation) Then(s1 domain.StockTransferResult, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockReceiveStockTransferResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceRepository.ReceiveStockTransfer should be invoked
+func (mmReceiveStockTransfer *mStockServiceRepositoryMockReceiveStockTransfer) Times(n uint64) *mStockServiceRepositoryMockReceiveStockTransfer {
+	if n == 0 {
+		mmReceiveStockTransfer.mock.t.Fatalf("Times of StockServiceRepositoryMock.ReceiveStockTransfer mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReceiveStockTransfer.expectedInvocations, n)
+	mmReceiveStockTransfer.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReceiveStockTransfer
+}
+
+func (mmReceiveStockTransfer *mStockServiceRepositoryMockReceiveStockTransfer) invocationsDone() bool {
+	if len(mmReceiveStockTransfer.expectations) == 0 && mmReceiveStockTransfer.defaultExpectation == nil && mmReceiveStockTransfer.mock.funcReceiveStockTransfer == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReceiveStockTransfer.mock.afterReceiveStockTransferCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReceiveStockTransfer.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReceiveStockTransfer implements mm_stocks.StockServiceRepository
+func (mmReceiveStockTransfer *StockServiceRepositoryMock) ReceiveStockTransfer(ctx context.Context, userID domain.UserID, transferID domain.TransferID) (s1 domain.StockTransferResult, err error) {
+	mm_atomic.AddUint64(&mmReceiveStockTransfer.beforeReceiveStockTransferCounter, 1)
+	defer mm_atomic.AddUint64(&mmReceiveStockTransfer.afterReceiveStockTransferCounter, 1)
+
+	mmReceiveStockTransfer.t.Helper()
+
+	if mmReceiveStockTransfer.inspectFuncReceiveStockTransfer != nil {
+		mmReceiveStockTransfer.inspectFuncReceiveStockTransfer(ctx, userID, transferID)
+	}
+
+	mm_params := StockServiceRepositoryMockReceiveStockTransferParams{ctx, userID, transferID}
+
+	// Record call args
+	mmReceiveStockTransfer.ReceiveStockTransferMock.mutex.Lock()
+	mmReceiveStockTransfer.ReceiveStockTransferMock.callArgs = append(mmReceiveStockTransfer.ReceiveStockTransferMock.callArgs, &mm_params)
+	mmReceiveStockTransfer.ReceiveStockTransferMock.mutex.Unlock()
+
+	for _, e := range mmReceiveStockTransfer.ReceiveStockTransferMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmReceiveStockTransfer.ReceiveStockTransferMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReceiveStockTransfer.ReceiveStockTransferMock.defaultExpectation.Counter, 1)
+		mm_want := mmReceiveStockTransfer.ReceiveStockTransferMock.defaultExpectation.params
+		mm_want_ptrs := mmReceiveStockTransfer.ReceiveStockTransferMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceRepositoryMockReceiveStockTransferParams{ctx, userID, transferID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReceiveStockTransfer.t.Errorf("StockServiceRepositoryMock.ReceiveStockTransfer got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReceiveStockTransfer.ReceiveStockTransferMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmReceiveStockTransfer.t.Errorf("StockServiceRepositoryMock.ReceiveStockTransfer got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReceiveStockTransfer.ReceiveStockTransferMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.transferID != nil && !minimock.Equal(*mm_want_ptrs.transferID, mm_got.transferID) {
+				mmReceiveStockTransfer.t.Errorf("StockServiceRepositoryMock.ReceiveStockTransfer got unexpected parameter transferID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReceiveStockTransfer.ReceiveStockTransferMock.defaultExpectation.expectationOrigins.originTransferID, *mm_want_ptrs.transferID, mm_got.transferID, minimock.Diff(*mm_want_ptrs.transferID, mm_got.transferID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReceiveStockTransfer.t.Errorf("StockServiceRepositoryMock.ReceiveStockTransfer got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReceiveStockTransfer.ReceiveStockTransferMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReceiveStockTransfer.ReceiveStockTransferMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReceiveStockTransfer.t.Fatal("No results are set for the StockServiceRepositoryMock.ReceiveStockTransfer")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmReceiveStockTransfer.funcReceiveStockTransfer != nil {
+		return mmReceiveStockTransfer.funcReceiveStockTransfer(ctx, userID, transferID)
+	}
+	mmReceiveStockTransfer.t.Fatalf("Unexpected call to StockServiceRepositoryMock.ReceiveStockTransfer. %v %v %v", ctx, userID, transferID)
+	return
+}
+
+// ReceiveStockTransferAfterCounter returns a count of finished StockServiceRepositoryMock.ReceiveStockTransfer invocations
+func (mmReceiveStockTransfer *StockServiceRepositoryMock) ReceiveStockTransferAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReceiveStockTransfer.afterReceiveStockTransferCounter)
+}
+
+// ReceiveStockTransferBeforeCounter returns a count of StockServiceRepositoryMock.ReceiveStockTransfer invocations
+func (mmReceiveStockTransfer *StockServiceRepositoryMock) ReceiveStockTransferBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReceiveStockTransfer.beforeReceiveStockTransferCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.ReceiveStockTransfer.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReceiveStockTransfer *mStockServiceRepositoryMockReceiveStockTransfer) Calls() []*StockServiceRepositoryMockReceiveStockTransferParams {
+	mmReceiveStockTransfer.mutex.RLock()
+
+	argCopy := make([]*StockServiceRepositoryMockReceiveStockTransferParams, len(mmReceiveStockTransfer.callArgs))
+	copy(argCopy, mmReceiveStockTransfer.callArgs)
+
+	mmReceiveStockTransfer.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReceiveStockTransferDone returns true if the count of the ReceiveStockTransfer invocations corresponds
+// the number of defined expectations
+func (m *StockServiceRepositoryMock) MinimockReceiveStockTransferDone() bool {
+	if m.ReceiveStockTransferMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReceiveStockTransferMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReceiveStockTransferMock.invocationsDone()
+}
+
+// MinimockReceiveStockTransferInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockReceiveStockTransferInspect() {
+	for _, e := range m.ReceiveStockTransferMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ReceiveStockTransfer at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReceiveStockTransferCounter := mm_atomic.LoadUint64(&m.afterReceiveStockTransferCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReceiveStockTransferMock.defaultExpectation != nil && afterReceiveStockTransferCounter < 1 {
+		if m.ReceiveStockTransferMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ReceiveStockTransfer at\n%s", m.ReceiveStockTransferMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ReceiveStockTransfer at\n%s with params: %#v", m.ReceiveStockTransferMock.defaultExpectation.expectationOrigins.origin, *m.ReceiveStockTransferMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReceiveStockTransfer != nil && afterReceiveStockTransferCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.ReceiveStockTransfer at\n%s", m.funcReceiveStockTransferOrigin)
+	}
+
+	if !m.ReceiveStockTransferMock.invocationsDone() && afterReceiveStockTransferCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.ReceiveStockTransfer at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReceiveStockTransferMock.expectedInvocations), m.ReceiveStockTransferMock.expectedInvocationsOrigin, afterReceiveStockTransferCounter)
+	}
+}
+
+type mStockServiceRepositoryMockRestoreDeletedStockItem struct {
+	optional           bool
+	mock               *StockServiceRepositoryMock
+	defaultExpectation *StockServiceRepositoryMockRestoreDeletedStockItemExpectation
+	expectations       []*StockServiceRepositoryMockRestoreDeletedStockItemExpectation
+
+	callArgs []*StockServiceRepositoryMockRestoreDeletedStockItemParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceRepositoryMockRestoreDeletedStockItemExpectation specifies expectation struct of the StockServiceRepository.RestoreDeletedStockItem
+type StockServiceRepositoryMockRestoreDeletedStockItemExpectation struct {
+	mock               *StockServiceRepositoryMock
+	params             *StockServiceRepositoryMockRestoreDeletedStockItemParams
+	paramPtrs          *StockServiceRepositoryMockRestoreDeletedStockItemParamPtrs
+	expectationOrigins StockServiceRepositoryMockRestoreDeletedStockItemExpectationOrigins
+	results            *StockServiceRepositoryMockRestoreDeletedStockItemResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceRepositoryMockRestoreDeletedStockItemParams contains parameters of the StockServiceRepository.RestoreDeletedStockItem
+type StockServiceRepositoryMockRestoreDeletedStockItemParams struct {
+	ctx      context.Context
+	userID   domain.UserID
+	skuID    domain.SKUID
+	location string
+}
+
+// StockServiceRepositoryMockRestoreDeletedStockItemParamPtrs contains pointers to parameters of the StockServiceRepository.RestoreDeletedStockItem
+type StockServiceRepositoryMockRestoreDeletedStockItemParamPtrs struct {
+	ctx      *context.Context
+	userID   *domain.UserID
+	skuID    *domain.SKUID
+	location *string
+}
+
+// StockServiceRepositoryMockRestoreDeletedStockItemResults contains results of the StockServiceRepository.RestoreDeletedStockItem
+type StockServiceRepositoryMockRestoreDeletedStockItemResults struct {
+	s1  domain.StockItem
+	err error
+}
+
+// StockServiceRepositoryMockRestoreDeletedStockItemOrigins contains origins of expectations of the StockServiceRepository.RestoreDeletedStockItem
+type StockServiceRepositoryMockRestoreDeletedStockItemExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUserID   string
+	originSkuID    string
+	originLocation string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRestoreDeletedStockItem *mStockServiceRepositoryMockRestoreDeletedStockItem) Optional() *mStockServiceRepositoryMockRestoreDeletedStockItem {
+	mmRestoreDeletedStockItem.optional = true
+	return mmRestoreDeletedStockItem
+}
+
+// Expect sets up expected params for StockServiceRepository.RestoreDeletedStockItem
+func (mmRestoreDeletedStockItem *mStockServiceRepositoryMockRestoreDeletedStockItem) Expect(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) *mStockServiceRepositoryMockRestoreDeletedStockItem {
+	if mmRestoreDeletedStockItem.mock.funcRestoreDeletedStockItem != nil {
+		mmRestoreDeletedStockItem.mock.t.Fatalf("StockServiceRepositoryMock.RestoreDeletedStockItem mock is already set by Set")
+	}
+
+	if mmRestoreDeletedStockItem.defaultExpectation == nil {
+		mmRestoreDeletedStockItem.defaultExpectation = &StockServiceRepositoryMockRestoreDeletedStockItemExpectation{}
+	}
+
+	if mmRestoreDeletedStockItem.defaultExpectation.paramPtrs != nil {
+		mmRestoreDeletedStockItem.mock.t.Fatalf("StockServiceRepositoryMock.RestoreDeletedStockItem mock is already set by ExpectParams functions")
+	}
+
+	mmRestoreDeletedStockItem.defaultExpectation.params = &StockServiceRepositoryMockRestoreDeletedStockItemParams{ctx, userID, skuID, location}
+	mmRestoreDeletedStockItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRestoreDeletedStockItem.expectations {
+		if minimock.Equal(e.params, mmRestoreDeletedStockItem.defaultExpectation.params) {
+			mmRestoreDeletedStockItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRestoreDeletedStockItem.defaultExpectation.params)
+		}
+	}
+
+	return mmRestoreDeletedStockItem
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.RestoreDeletedStockItem
+func (mmRestoreDeletedStockItem *mStockServiceRepositoryMockRestoreDeletedStockItem) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockRestoreDeletedStockItem {
+	if mmRestoreDeletedStockItem.mock.funcRestoreDeletedStockItem != nil {
+		mmRestoreDeletedStockItem.mock.t.Fatalf("StockServiceRepositoryMock.RestoreDeletedStockItem mock is already set by Set")
+	}
+
+	if mmRestoreDeletedStockItem.defaultExpectation == nil {
+		mmRestoreDeletedStockItem.defaultExpectation = &StockServiceRepositoryMockRestoreDeletedStockItemExpectation{}
+	}
+
+	if mmRestoreDeletedStockItem.defaultExpectation.params != nil {
+		mmRestoreDeletedStockItem.mock.t.Fatalf("StockServiceRepositoryMock.RestoreDeletedStockItem mock is already set by Expect")
+	}
+
+	if mmRestoreDeletedStockItem.defaultExpectation.paramPtrs == nil {
+		mmRestoreDeletedStockItem.defaultExpectation.paramPtrs = &StockServiceRepositoryMockRestoreDeletedStockItemParamPtrs{}
+	}
+	mmRestoreDeletedStockItem.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRestoreDeletedStockItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRestoreDeletedStockItem
+}
+
+// ExpectUserIDParam2 sets up expected param userID for StockServiceRepository.RestoreDeletedStockItem
+func (mmRestoreDeletedStockItem *mStockServiceRepositoryMockRestoreDeletedStockItem) ExpectUserIDParam2(userID domain.UserID) *mStockServiceRepositoryMockRestoreDeletedStockItem {
+	if mmRestoreDeletedStockItem.mock.funcRestoreDeletedStockItem != nil {
+		mmRestoreDeletedStockItem.mock.t.Fatalf("StockServiceRepositoryMock.RestoreDeletedStockItem mock is already set by Set")
+	}
+
+	if mmRestoreDeletedStockItem.defaultExpectation == nil {
+		mmRestoreDeletedStockItem.defaultExpectation = &StockServiceRepositoryMockRestoreDeletedStockItemExpectation{}
+	}
+
+	if mmRestoreDeletedStockItem.defaultExpectation.params != nil {
+		mmRestoreDeletedStockItem.mock.t.Fatalf("StockServiceRepositoryMock.RestoreDeletedStockItem mock is already set by Expect")
+	}
+
+	if mmRestoreDeletedStockItem.defaultExpectation.paramPtrs == nil {
+		mmRestoreDeletedStockItem.defaultExpectation.paramPtrs = &StockServiceRepositoryMockRestoreDeletedStockItemParamPtrs{}
+	}
+	mmRestoreDeletedStockItem.defaultExpectation.paramPtrs.userID = &userID
+	mmRestoreDeletedStockItem.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRestoreDeletedStockItem
+}
+
+// ExpectSkuIDParam3 sets up expected param skuID for StockServiceRepository.RestoreDeletedStockItem
+func (mmRestoreDeletedStockItem *mStockServiceRepositoryMockRestoreDeletedStockItem) ExpectSkuIDParam3(skuID domain.SKUID) *mStockServiceRepositoryMockRestoreDeletedStockItem {
+	if mmRestoreDeletedStockItem.mock.funcRestoreDeletedStockItem != nil {
+		mmRestoreDeletedStockItem.mock.t.Fatalf("StockServiceRepositoryMock.RestoreDeletedStockItem mock is already set by Set")
+	}
+
+	if mmRestoreDeletedStockItem.defaultExpectation == nil {
+		mmRestoreDeletedStockItem.defaultExpectation = &StockServiceRepositoryMockRestoreDeletedStockItemExpectation{}
+	}
+
+	if mmRestoreDeletedStockItem.defaultExpectation.params != nil {
+		mmRestoreDeletedStockItem.mock.t.Fatalf("StockServiceRepositoryMock.RestoreDeletedStockItem mock is already set by Expect")
+	}
+
+	if mmRestoreDeletedStockItem.defaultExpectation.paramPtrs == nil {
+		mmRestoreDeletedStockItem.defaultExpectation.paramPtrs = &StockServiceRepositoryMockRestoreDeletedStockItemParamPtrs{}
+	}
+	mmRestoreDeletedStockItem.defaultExpectation.paramPtrs.skuID = &skuID
+	mmRestoreDeletedStockItem.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmRestoreDeletedStockItem
+}
+
+// ExpectLocationParam4 sets up expected param location for StockServiceRepository.RestoreDeletedStockItem
+func (mmRestoreDeletedStockItem *mStockServiceRepositoryMockRestoreDeletedStockItem) ExpectLocationParam4(location string) *mStockServiceRepositoryMockRestoreDeletedStockItem {
+	if mmRestoreDeletedStockItem.mock.funcRestoreDeletedStockItem != nil {
+		mmRestoreDeletedStockItem.mock.t.Fatalf("StockServiceRepositoryMock.RestoreDeletedStockItem mock is already set by Set")
+	}
+
+	if mmRestoreDeletedStockItem.defaultExpectation == nil {
+		mmRestoreDeletedStockItem.defaultExpectation = &StockServiceRepositoryMockRestoreDeletedStockItemExpectation{}
+	}
+
+	if mmRestoreDeletedStockItem.defaultExpectation.params != nil {
+		mmRestoreDeletedStockItem.mock.t.Fatalf("StockServiceRepositoryMock.RestoreDeletedStockItem mock is already set by Expect")
+	}
+
+	if mmRestoreDeletedStockItem.defaultExpectation.paramPtrs == nil {
+		mmRestoreDeletedStockItem.defaultExpectation.paramPtrs = &StockServiceRepositoryMockRestoreDeletedStockItemParamPtrs{}
+	}
+	mmRestoreDeletedStockItem.defaultExpectation.paramPtrs.location = &location
+	mmRestoreDeletedStockItem.defaultExpectation.expectationOrigins.originLocation = minimock.CallerInfo(1)
+
+	return mmRestoreDeletedStockItem
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.RestoreDeletedStockItem
+func (mmRestoreDeletedStockItem *mStockServiceRepositoryMockRestoreDeletedStockItem) Inspect(f func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string)) *mStockServiceRepositoryMockRestoreDeletedStockItem {
+	if mmRestoreDeletedStockItem.mock.inspectFuncRestoreDeletedStockItem != nil {
+		mmRestoreDeletedStockItem.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.RestoreDeletedStockItem")
+	}
+
+	mmRestoreDeletedStockItem.mock.inspectFuncRestoreDeletedStockItem = f
+
+	return mmRestoreDeletedStockItem
+}
+
+// Return sets up results that will be returned by StockServiceRepository.RestoreDeletedStockItem
+func (mmRestoreDeletedStockItem *mStockServiceRepositoryMockRestoreDeletedStockItem) Return(s1 domain.StockItem, err error) *StockServiceRepositoryMock {
+	if mmRestoreDeletedStockItem.mock.funcRestoreDeletedStockItem != nil {
+		mmRestoreDeletedStockItem.mock.t.Fatalf("StockServiceRepositoryMock.RestoreDeletedStockItem mock is already set by Set")
+	}
+
+	if mmRestoreDeletedStockItem.defaultExpectation == nil {
+		mmRestoreDeletedStockItem.defaultExpectation = &StockServiceRepositoryMockRestoreDeletedStockItemExpectation{mock: mmRestoreDeletedStockItem.mock}
+	}
+	mmRestoreDeletedStockItem.defaultExpectation.results = &StockServiceRepositoryMockRestoreDeletedStockItemResults{s1, err}
+	mmRestoreDeletedStockItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRestoreDeletedStockItem.mock
+}
+
+// Set uses given function f to mock the StockServiceRepository.RestoreDeletedStockItem method
+func (mmRestoreDeletedStockItem *mStockServiceRepositoryMockRestoreDeletedStockItem) Set(f func(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) (s1 domain.StockItem, err error)) *StockServiceRepositoryMock {
+	if mmRestoreDeletedStockItem.defaultExpectation != nil {
+		mmRestoreDeletedStockItem.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.RestoreDeletedStockItem method")
+	}
+
+	if len(mmRestoreDeletedStockItem.expectations) > 0 {
+		mmRestoreDeletedStockItem.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.RestoreDeletedStockItem method")
+	}
+
+	mmRestoreDeletedStockItem.mock.funcRestoreDeletedStockItem = f
+	mmRestoreDeletedStockItem.mock.funcRestoreDeletedStockItemOrigin = minimock.CallerInfo(1)
+	return mmRestoreDeletedStockItem.mock
+}
+
+// When sets expectation for the StockServiceRepository.RestoreDeletedStockItem which will trigger the result defined by the following
+// Then helper
+func (mmRestoreDeletedStockItem *mStockServiceRepositoryMockRestoreDeletedStockItem) When(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) *StockServiceRepositoryMockRestoreDeletedStockItemExpectation {
+	if mmRestoreDeletedStockItem.mock.funcRestoreDeletedStockItem != nil {
+		mmRestoreDeletedStockItem.mock.t.Fatalf("StockServiceRepositoryMock.RestoreDeletedStockItem mock is already set by Set")
+	}
+
+	expectation := &StockServiceRepositoryMockRestoreDeletedStockItemExpectation{
+		mock:               mmRestoreDeletedStockItem.mock,
+		params:             &StockServiceRepositoryMockRestoreDeletedStockItemParams{ctx, userID, skuID, location},
+		expectationOrigins: StockServiceRepositoryMockRestoreDeletedStockItemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRestoreDeletedStockItem.expectations = append(mmRestoreDeletedStockItem.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceRepository.RestoreDeletedStockItem return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockRestoreDeletedStockItemExpectation) Then(s1 domain.StockItem, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockRestoreDeletedStockItemResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceRepository.RestoreDeletedStockItem should be invoked
+func (mmRestoreDeletedStockItem *mStockServiceRepositoryMockRestoreDeletedStockItem) Times(n uint64) *mStockServiceRepositoryMockRestoreDeletedStockItem {
+	if n == 0 {
+		mmRestoreDeletedStockItem.mock.t.Fatalf("Times of StockServiceRepositoryMock.RestoreDeletedStockItem mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRestoreDeletedStockItem.expectedInvocations, n)
+	mmRestoreDeletedStockItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRestoreDeletedStockItem
+}
+
+func (mmRestoreDeletedStockItem *mStockServiceRepositoryMockRestoreDeletedStockItem) invocationsDone() bool {
+	if len(mmRestoreDeletedStockItem.expectations) == 0 && mmRestoreDeletedStockItem.defaultExpectation == nil && mmRestoreDeletedStockItem.mock.funcRestoreDeletedStockItem == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRestoreDeletedStockItem.mock.afterRestoreDeletedStockItemCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRestoreDeletedStockItem.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RestoreDeletedStockItem implements mm_stocks.StockServiceRepository
+func (mmRestoreDeletedStockItem *StockServiceRepositoryMock) RestoreDeletedStockItem(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) (s1 domain.StockItem, err error) {
+	mm_atomic.AddUint64(&mmRestoreDeletedStockItem.beforeRestoreDeletedStockItemCounter, 1)
+	defer mm_atomic.AddUint64(&mmRestoreDeletedStockItem.afterRestoreDeletedStockItemCounter, 1)
+
+	mmRestoreDeletedStockItem.t.Helper()
+
+	if mmRestoreDeletedStockItem.inspectFuncRestoreDeletedStockItem != nil {
+		mmRestoreDeletedStockItem.inspectFuncRestoreDeletedStockItem(ctx, userID, skuID, location)
+	}
+
+	mm_params := StockServiceRepositoryMockRestoreDeletedStockItemParams{ctx, userID, skuID, location}
+
+	// Record call args
+	mmRestoreDeletedStockItem.RestoreDeletedStockItemMock.mutex.Lock()
+	mmRestoreDeletedStockItem.RestoreDeletedStockItemMock.callArgs = append(mmRestoreDeletedStockItem.RestoreDeletedStockItemMock.callArgs, &mm_params)
+	mmRestoreDeletedStockItem.RestoreDeletedStockItemMock.mutex.Unlock()
+
+	for _, e := range mmRestoreDeletedStockItem.RestoreDeletedStockItemMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmRestoreDeletedStockItem.RestoreDeletedStockItemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRestoreDeletedStockItem.RestoreDeletedStockItemMock.defaultExpectation.Counter, 1)
+		mm_want := mmRestoreDeletedStockItem.RestoreDeletedStockItemMock.defaultExpectation.params
+		mm_want_ptrs := mmRestoreDeletedStockItem.RestoreDeletedStockItemMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceRepositoryMockRestoreDeletedStockItemParams{ctx, userID, skuID, location}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRestoreDeletedStockItem.t.Errorf("StockServiceRepositoryMock.RestoreDeletedStockItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreDeletedStockItem.RestoreDeletedStockItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRestoreDeletedStockItem.t.Errorf("StockServiceRepositoryMock.RestoreDeletedStockItem got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreDeletedStockItem.RestoreDeletedStockItemMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmRestoreDeletedStockItem.t.Errorf("StockServiceRepositoryMock.RestoreDeletedStockItem got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreDeletedStockItem.RestoreDeletedStockItemMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.location != nil && !minimock.Equal(*mm_want_ptrs.location, mm_got.location) {
+				mmRestoreDeletedStockItem.t.Errorf("StockServiceRepositoryMock.RestoreDeletedStockItem got unexpected parameter location, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreDeletedStockItem.RestoreDeletedStockItemMock.defaultExpectation.expectationOrigins.originLocation, *mm_want_ptrs.location, mm_got.location, minimock.Diff(*mm_want_ptrs.location, mm_got.location))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRestoreDeletedStockItem.t.Errorf("StockServiceRepositoryMock.RestoreDeletedStockItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRestoreDeletedStockItem.RestoreDeletedStockItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRestoreDeletedStockItem.RestoreDeletedStockItemMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRestoreDeletedStockItem.t.Fatal("No results are set for the StockServiceRepositoryMock.RestoreDeletedStockItem")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmRestoreDeletedStockItem.funcRestoreDeletedStockItem != nil {
+		return mmRestoreDeletedStockItem.funcRestoreDeletedStockItem(ctx, userID, skuID, location)
+	}
+	mmRestoreDeletedStockItem.t.Fatalf("Unexpected call to StockServiceRepositoryMock.RestoreDeletedStockItem. %v %v %v %v", ctx, userID, skuID, location)
+	return
+}
+
+// RestoreDeletedStockItemAfterCounter returns a count of finished StockServiceRepositoryMock.RestoreDeletedStockItem invocations
+func (mmRestoreDeletedStockItem *StockServiceRepositoryMock) RestoreDeletedStockItemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestoreDeletedStockItem.afterRestoreDeletedStockItemCounter)
+}
+
+// RestoreDeletedStockItemBeforeCounter returns a count of StockServiceRepositoryMock.RestoreDeletedStockItem invocations
+func (mmRestoreDeletedStockItem *StockServiceRepositoryMock) RestoreDeletedStockItemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestoreDeletedStockItem.beforeRestoreDeletedStockItemCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.RestoreDeletedStockItem.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRestoreDeletedStockItem *mStockServiceRepositoryMockRestoreDeletedStockItem) Calls() []*StockServiceRepositoryMockRestoreDeletedStockItemParams {
+	mmRestoreDeletedStockItem.mutex.RLock()
+
+	argCopy := make([]*StockServiceRepositoryMockRestoreDeletedStockItemParams, len(mmRestoreDeletedStockItem.callArgs))
+	copy(argCopy, mmRestoreDeletedStockItem.callArgs)
+
+	mmRestoreDeletedStockItem.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRestoreDeletedStockItemDone returns true if the count of the RestoreDeletedStockItem invocations corresponds
+// the number of defined expectations
+func (m *StockServiceRepositoryMock) MinimockRestoreDeletedStockItemDone() bool {
+	if m.RestoreDeletedStockItemMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RestoreDeletedStockItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RestoreDeletedStockItemMock.invocationsDone()
+}
+
+// MinimockRestoreDeletedStockItemInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockRestoreDeletedStockItemInspect() {
+	for _, e := range m.RestoreDeletedStockItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.RestoreDeletedStockItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRestoreDeletedStockItemCounter := mm_atomic.LoadUint64(&m.afterRestoreDeletedStockItemCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RestoreDeletedStockItemMock.defaultExpectation != nil && afterRestoreDeletedStockItemCounter < 1 {
+		if m.RestoreDeletedStockItemMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.RestoreDeletedStockItem at\n%s", m.RestoreDeletedStockItemMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.RestoreDeletedStockItem at\n%s with params: %#v", m.RestoreDeletedStockItemMock.defaultExpectation.expectationOrigins.origin, *m.RestoreDeletedStockItemMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRestoreDeletedStockItem != nil && afterRestoreDeletedStockItemCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.RestoreDeletedStockItem at\n%s", m.funcRestoreDeletedStockItemOrigin)
+	}
+
+	if !m.RestoreDeletedStockItemMock.invocationsDone() && afterRestoreDeletedStockItemCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.RestoreDeletedStockItem at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RestoreDeletedStockItemMock.expectedInvocations), m.RestoreDeletedStockItemMock.expectedInvocationsOrigin, afterRestoreDeletedStockItemCounter)
+	}
+}
+
+type mStockServiceRepositoryMockSaveCartDemand struct {
+	optional           bool
+	mock               *StockServiceRepositoryMock
+	defaultExpectation *StockServiceRepositoryMockSaveCartDemandExpectation
+	expectations       []*StockServiceRepositoryMockSaveCartDemandExpectation
+
+	callArgs []*StockServiceRepositoryMockSaveCartDemandParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceRepositoryMockSaveCartDemandExpectation specifies expectation struct of the StockServiceRepository.SaveCartDemand
+type StockServiceRepositoryMockSaveCartDemandExpectation struct {
+	mock               *StockServiceRepositoryMock
+	params             *StockServiceRepositoryMockSaveCartDemandParams
+	paramPtrs          *StockServiceRepositoryMockSaveCartDemandParamPtrs
+	expectationOrigins StockServiceRepositoryMockSaveCartDemandExpectationOrigins
+	results            *StockServiceRepositoryMockSaveCartDemandResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceRepositoryMockSaveCartDemandParams contains parameters of the StockServiceRepository.SaveCartDemand
+type StockServiceRepositoryMockSaveCartDemandParams struct {
+	ctx    context.Context
+	demand domain.CartDemand
+}
+
+// StockServiceRepositoryMockSaveCartDemandParamPtrs contains pointers to parameters of the StockServiceRepository.SaveCartDemand
+type StockServiceRepositoryMockSaveCartDemandParamPtrs struct {
+	ctx    *context.Context
+	demand *domain.CartDemand
+}
+
+// StockServiceRepositoryMockSaveCartDemandResults contains results of the StockServiceRepository.SaveCartDemand
+type StockServiceRepositoryMockSaveCartDemandResults struct {
+	err error
+}
+
+// StockServiceRepositoryMockSaveCartDemandOrigins contains origins of expectations of the StockServiceRepository.SaveCartDemand
+type StockServiceRepositoryMockSaveCartDemandExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originDemand string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSaveCartDemand *mStockServiceRepositoryMockSaveCartDemand) Optional() *mStockServiceRepositoryMockSaveCartDemand {
+	mmSaveCartDemand.optional = true
+	return mmSaveCartDemand
+}
+
+// Expect sets up expected params for StockServiceRepository.SaveCartDemand
+func (mmSaveCartDemand *mStockServiceRepositoryMockSaveCartDemand) Expect(ctx context.Context, demand domain.CartDemand) *mStockServiceRepositoryMockSaveCartDemand {
+	if mmSaveCartDemand.mock.funcSaveCartDemand != nil {
+		mmSaveCartDemand.mock.t.Fatalf("StockServiceRepositoryMock.SaveCartDemand mock is already set by Set")
+	}
+
+	if mmSaveCartDemand.defaultExpectation == nil {
+		mmSaveCartDemand.defaultExpectation = &StockServiceRepositoryMockSaveCartDemandExpectation{}
+	}
+
+	if mmSaveCartDemand.defaultExpectation.paramPtrs != nil {
+		mmSaveCartDemand.mock.t.Fatalf("StockServiceRepositoryMock.SaveCartDemand mock is already set by ExpectParams functions")
+	}
+
+	mmSaveCartDemand.defaultExpectation.params = &StockServiceRepositoryMockSaveCartDemandParams{ctx, demand}
+	mmSaveCartDemand.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveCartDemand.expectations {
+		if minimock.Equal(e.params, mmSaveCartDemand.defaultExpectation.params) {
+			mmSaveCartDemand.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveCartDemand.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveCartDemand
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.SaveCartDemand
+func (mmSaveCartDemand *mStockServiceRepositoryMockSaveCartDemand) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockSaveCartDemand {
+	if mmSaveCartDemand.mock.funcSaveCartDemand != nil {
+		mmSaveCartDemand.mock.t.Fatalf("StockServiceRepositoryMock.SaveCartDemand mock is already set by Set")
+	}
+
+	if mmSaveCartDemand.defaultExpectation == nil {
+		mmSaveCartDemand.defaultExpectation = &StockServiceRepositoryMockSaveCartDemandExpectation{}
+	}
+
+	if mmSaveCartDemand.defaultExpectation.params != nil {
+		mmSaveCartDemand.mock.t.Fatalf("StockServiceRepositoryMock.SaveCartDemand mock is already set by Expect")
+	}
+
+	if mmSaveCartDemand.defaultExpectation.paramPtrs == nil {
+		mmSaveCartDemand.defaultExpectation.paramPtrs = &StockServiceRepositoryMockSaveCartDemandParamPtrs{}
+	}
+	mmSaveCartDemand.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveCartDemand.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSaveCartDemand
+}
+
+// ExpectDemandParam2 sets up expected param demand for StockServiceRepository.SaveCartDemand
+func (mmSaveCartDemand *mStockServiceRepositoryMockSaveCartDemand) ExpectDemandParam2(demand domain.CartDemand) *mStockServiceRepositoryMockSaveCartDemand {
+	if mmSaveCartDemand.mock.funcSaveCartDemand != nil {
+		mmSaveCartDemand.mock.t.Fatalf("StockServiceRepositoryMock.SaveCartDemand mock is already set by Set")
+	}
+
+	if mmSaveCartDemand.defaultExpectation == nil {
+		mmSaveCartDemand.defaultExpectation = &StockServiceRepositoryMockSaveCartDemandExpectation{}
+	}
+
+	if mmSaveCartDemand.defaultExpectation.params != nil {
+		mmSaveCartDemand.mock.t.Fatalf("StockServiceRepositoryMock.SaveCartDemand mock is already set by Expect")
+	}
+
+	if mmSaveCartDemand.defaultExpectation.paramPtrs == nil {
+		mmSaveCartDemand.defaultExpectation.paramPtrs = &StockServiceRepositoryMockSaveCartDemandParamPtrs{}
+	}
+	mmSaveCartDemand.defaultExpectation.paramPtrs.demand = &demand
+	mmSaveCartDemand.defaultExpectation.expectationOrigins.originDemand = minimock.CallerInfo(1)
+
+	return mmSaveCartDemand
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.SaveCartDemand
+func (mmSaveCartDemand *mStockServiceRepositoryMockSaveCartDemand) Inspect(f func(ctx context.Context, demand domain.CartDemand)) *mStockServiceRepositoryMockSaveCartDemand {
+	if mmSaveCartDemand.mock.inspectFuncSaveCartDemand != nil {
+		mmSaveCartDemand.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.SaveCartDemand")
+	}
+
+	mmSaveCartDemand.mock.inspectFuncSaveCartDemand = f
+
+	return mmSaveCartDemand
+}
+
+// Return sets up results that will be returned by StockServiceRepository.SaveCartDemand
+func (mmSaveCartDemand *mStockServiceRepositoryMockSaveCartDemand) Return(err error) *StockServiceRepositoryMock {
+	if mmSaveCartDemand.mock.funcSaveCartDemand != nil {
+		mmSaveCartDemand.mock.t.Fatalf("StockServiceRepositoryMock.SaveCartDemand mock is already set by Set")
+	}
+
+	if mmSaveCartDemand.defaultExpectation == nil {
+		mmSaveCartDemand.defaultExpectation = &StockServiceRepositoryMockSaveCartDemandExpectation{mock: mmSaveCartDemand.mock}
+	}
+	mmSaveCartDemand.defaultExpectation.results = &StockServiceRepositoryMockSaveCartDemandResults{err}
+	mmSaveCartDemand.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveCartDemand.mock
+}
+
+// Set uses given function f to mock the StockServiceRepository.SaveCartDemand method
+func (mmSaveCartDemand *mStockServiceRepositoryMockSaveCartDemand) Set(f func(ctx context.Context, demand domain.CartDemand) (err error)) *StockServiceRepositoryMock {
+	if mmSaveCartDemand.defaultExpectation != nil {
+		mmSaveCartDemand.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.SaveCartDemand method")
+	}
+
+	if len(mmSaveCartDemand.expectations) > 0 {
+		mmSaveCartDemand.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.SaveCartDemand method")
+	}
+
+	mmSaveCartDemand.mock.funcSaveCartDemand = f
+	mmSaveCartDemand.mock.funcSaveCartDemandOrigin = minimock.CallerInfo(1)
+	return mmSaveCartDemand.mock
+}
+
+// When sets expectation for the StockServiceRepository.SaveCartDemand which will trigger the result defined by the following
+// Then helper
+func (mmSaveCartDemand *mStockServiceRepositoryMockSaveCartDemand) When(ctx context.Context, demand domain.CartDemand) *StockServiceRepositoryMockSaveCartDemandExpectation {
+	if mmSaveCartDemand.mock.funcSaveCartDemand != nil {
+		mmSaveCartDemand.mock.t.Fatalf("StockServiceRepositoryMock.SaveCartDemand mock is already set by Set")
+	}
+
+	expectation := &StockServiceRepositoryMockSaveCartDemandExpectation{
+		mock:               mmSaveCartDemand.mock,
+		params:             &StockServiceRepositoryMockSaveCartDemandParams{ctx, demand},
+		expectationOrigins: StockServiceRepositoryMockSaveCartDemandExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSaveCartDemand.expectations = append(mmSaveCartDemand.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceRepository.SaveCartDemand return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockSaveCartDemandExpectation) Then(err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockSaveCartDemandResults{err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceRepository.SaveCartDemand should be invoked
+func (mmSaveCartDemand *mStockServiceRepositoryMockSaveCartDemand) Times(n uint64) *mStockServiceRepositoryMockSaveCartDemand {
+	if n == 0 {
+		mmSaveCartDemand.mock.t.Fatalf("Times of StockServiceRepositoryMock.SaveCartDemand mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSaveCartDemand.expectedInvocations, n)
+	mmSaveCartDemand.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveCartDemand
+}
+
+func (mmSaveCartDemand *mStockServiceRepositoryMockSaveCartDemand) invocationsDone() bool {
+	if len(mmSaveCartDemand.expectations) == 0 && mmSaveCartDemand.defaultExpectation == nil && mmSaveCartDemand.mock.funcSaveCartDemand == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveCartDemand.mock.afterSaveCartDemandCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveCartDemand.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SaveCartDemand implements mm_stocks.StockServiceRepository
+func (mmSaveCartDemand *StockServiceRepositoryMock) SaveCartDemand(ctx context.Context, demand domain.CartDemand) (err error) {
+	mm_atomic.AddUint64(&mmSaveCartDemand.beforeSaveCartDemandCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveCartDemand.afterSaveCartDemandCounter, 1)
+
+	mmSaveCartDemand.t.Helper()
+
+	if mmSaveCartDemand.inspectFuncSaveCartDemand != nil {
+		mmSaveCartDemand.inspectFuncSaveCartDemand(ctx, demand)
+	}
+
+	mm_params := StockServiceRepositoryMockSaveCartDemandParams{ctx, demand}
+
+	// Record call args
+	mmSaveCartDemand.SaveCartDemandMock.mutex.Lock()
+	mmSaveCartDemand.SaveCartDemandMock.callArgs = append(mmSaveCartDemand.SaveCartDemandMock.callArgs, &mm_params)
+	mmSaveCartDemand.SaveCartDemandMock.mutex.Unlock()
+
+	for _, e := range mmSaveCartDemand.SaveCartDemandMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveCartDemand.SaveCartDemandMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveCartDemand.SaveCartDemandMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveCartDemand.SaveCartDemandMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveCartDemand.SaveCartDemandMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceRepositoryMockSaveCartDemandParams{ctx, demand}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSaveCartDemand.t.Errorf("StockServiceRepositoryMock.SaveCartDemand got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveCartDemand.SaveCartDemandMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.demand != nil && !minimock.Equal(*mm_want_ptrs.demand, mm_got.demand) {
+				mmSaveCartDemand.t.Errorf("StockServiceRepositoryMock.SaveCartDemand got unexpected parameter demand, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveCartDemand.SaveCartDemandMock.defaultExpectation.expectationOrigins.originDemand, *mm_want_ptrs.demand, mm_got.demand, minimock.Diff(*mm_want_ptrs.demand, mm_got.demand))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveCartDemand.t.Errorf("StockServiceRepositoryMock.SaveCartDemand got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveCartDemand.SaveCartDemandMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveCartDemand.SaveCartDemandMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveCartDemand.t.Fatal("No results are set for the StockServiceRepositoryMock.SaveCartDemand")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveCartDemand.funcSaveCartDemand != nil {
+		return mmSaveCartDemand.funcSaveCartDemand(ctx, demand)
+	}
+	mmSaveCartDemand.t.Fatalf("Unexpected call to StockServiceRepositoryMock.SaveCartDemand. %v %v", ctx, demand)
+	return
+}
+
+// SaveCartDemandAfterCounter returns a count of finished StockServiceRepositoryMock.SaveCartDemand invocations
+func (mmSaveCartDemand *StockServiceRepositoryMock) SaveCartDemandAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveCartDemand.afterSaveCartDemandCounter)
+}
+
+// SaveCartDemandBeforeCounter returns a count of StockServiceRepositoryMock.SaveCartDemand invocations
+func (mmSaveCartDemand *StockServiceRepositoryMock) SaveCartDemandBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveCartDemand.beforeSaveCartDemandCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.SaveCartDemand.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveCartDemand *mStockServiceRepositoryMockSaveCartDemand) Calls() []*StockServiceRepositoryMockSaveCartDemandParams {
+	mmSaveCartDemand.mutex.RLock()
+
+	argCopy := make([]*StockServiceRepositoryMockSaveCartDemandParams, len(mmSaveCartDemand.callArgs))
+	copy(argCopy, mmSaveCartDemand.callArgs)
+
+	mmSaveCartDemand.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveCartDemandDone returns true if the count of the SaveCartDemand invocations corresponds
+// the number of defined expectations
+func (m *StockServiceRepositoryMock) MinimockSaveCartDemandDone() bool {
+	if m.SaveCartDemandMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveCartDemandMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveCartDemandMock.invocationsDone()
+}
+
+// MinimockSaveCartDemandInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockSaveCartDemandInspect() {
+	for _, e := range m.SaveCartDemandMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.SaveCartDemand at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveCartDemandCounter := mm_atomic.LoadUint64(&m.afterSaveCartDemandCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveCartDemandMock.defaultExpectation != nil && afterSaveCartDemandCounter < 1 {
+		if m.SaveCartDemandMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.SaveCartDemand at\n%s", m.SaveCartDemandMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.SaveCartDemand at\n%s with params: %#v", m.SaveCartDemandMock.defaultExpectation.expectationOrigins.origin, *m.SaveCartDemandMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveCartDemand != nil && afterSaveCartDemandCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.SaveCartDemand at\n%s", m.funcSaveCartDemandOrigin)
+	}
+
+	if !m.SaveCartDemandMock.invocationsDone() && afterSaveCartDemandCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.SaveCartDemand at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveCartDemandMock.expectedInvocations), m.SaveCartDemandMock.expectedInvocationsOrigin, afterSaveCartDemandCounter)
+	}
+}
+
+type mStockServiceRepositoryMockSaveCycleCounts struct {
+	optional           bool
+	mock               *StockServiceRepositoryMock
+	defaultExpectation *StockServiceRepositoryMockSaveCycleCountsExpectation
+	expectations       []*StockServiceRepositoryMockSaveCycleCountsExpectation
+
+	callArgs []*StockServiceRepositoryMockSaveCycleCountsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceRepositoryMockSaveCycleCountsExpectation specifies expectation struct of the StockServiceRepository.SaveCycleCounts
+type StockServiceRepositoryMockSaveCycleCountsExpectation struct {
+	mock               *StockServiceRepositoryMock
+	params             *StockServiceRepositoryMockSaveCycleCountsParams
+	paramPtrs          *StockServiceRepositoryMockSaveCycleCountsParamPtrs
+	expectationOrigins StockServiceRepositoryMockSaveCycleCountsExpectationOrigins
+	results            *StockServiceRepositoryMockSaveCycleCountsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceRepositoryMockSaveCycleCountsParams contains parameters of the StockServiceRepository.SaveCycleCounts
+type StockServiceRepositoryMockSaveCycleCountsParams struct {
+	ctx        context.Context
+	submission domain.CycleCountSubmission
+}
+
+// StockServiceRepositoryMockSaveCycleCountsParamPtrs contains pointers to parameters of the StockServiceRepository.SaveCycleCounts
+type StockServiceRepositoryMockSaveCycleCountsParamPtrs struct {
+	ctx        *context.Context
+	submission *domain.CycleCountSubmission
+}
+
+// StockServiceRepositoryMockSaveCycleCountsResults contains results of the StockServiceRepository.SaveCycleCounts
+type StockServiceRepositoryMockSaveCycleCountsResults struct {
+	err error
+}
+
+// StockServiceRepositoryMockSaveCycleCountsOrigins contains origins of expectations of the StockServiceRepository.SaveCycleCounts
+type StockServiceRepositoryMockSaveCycleCountsExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originSubmission string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSaveCycleCounts *mStockServiceRepositoryMockSaveCycleCounts) Optional() *mStockServiceRepositoryMockSaveCycleCounts {
+	mmSaveCycleCounts.optional = true
+	return mmSaveCycleCounts
+}
+
+// Expect sets up expected params for StockServiceRepository.SaveCycleCounts
+func (mmSaveCycleCounts *mStockServiceRepositoryMockSaveCycleCounts) Expect(ctx context.Context, submission domain.CycleCountSubmission) *mStockServiceRepositoryMockSaveCycleCounts {
+	if mmSaveCycleCounts.mock.funcSaveCycleCounts != nil {
+		mmSaveCycleCounts.mock.t.Fatalf("StockServiceRepositoryMock.SaveCycleCounts mock is already set by Set")
+	}
+
+	if mmSaveCycleCounts.defaultExpectation == nil {
+		mmSaveCycleCounts.defaultExpectation = &StockServiceRepositoryMockSaveCycleCountsExpectation{}
 	}
 
-	if mmDeleteStockItemFromStorage.defaultExpectation.params != nil {
-		mmDeleteStockItemFromStorage.mock.t.Fatalf("StockServiceRepositoryMock.DeleteStockItemFromStorage mock is already set by Expect")
+	if mmSaveCycleCounts.defaultExpectation.paramPtrs != nil {
+		mmSaveCycleCounts.mock.t.Fatalf("StockServiceRepositoryMock.SaveCycleCounts mock is already set by ExpectParams functions")
 	}
 
-	if mmDeleteStockItemFromStorage.defaultExpectation.paramPtrs == nil {
-		mmDeleteStockItemFromStorage.defaultExpectation.paramPtrs = &StockServiceRepositoryMockDeleteStockItemFromStorageParamPtrs{}
+	mmSaveCycleCounts.defaultExpectation.params = &StockServiceRepositoryMockSaveCycleCountsParams{ctx, submission}
+	mmSaveCycleCounts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveCycleCounts.expectations {
+		if minimock.Equal(e.params, mmSaveCycleCounts.defaultExpectation.params) {
+			mmSaveCycleCounts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveCycleCounts.defaultExpectation.params)
+		}
 	}
-	mmDeleteStockItemFromStorage.defaultExpectation.paramPtrs.userID = &userID
-	mmDeleteStockItemFromStorage.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmDeleteStockItemFromStorage
+	return mmSaveCycleCounts
 }
 
-// ExpectSkuIDParam3 sets up expected param skuID for StockServiceRepository.DeleteStockItemFromStorage
-func (mmDeleteStockItemFromStorage *mStockServiceRepositoryMockDeleteStockItemFromStorage) ExpectSkuIDParam3(skuID domain.SKUID) *mStockServiceRepositoryMockDeleteStockItemFromStorage {
-	if mmDeleteStockItemFromStorage.mock.funcDeleteStockItemFromStorage != nil {
-		mmDeleteStockItemFromStorage.mock.t.Fatalf("StockServiceRepositoryMock.DeleteStockItemFromStorage mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.SaveCycleCounts
+func (mmSaveCycleCounts *mStockServiceRepositoryMockSaveCycleCounts) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockSaveCycleCounts {
+	if mmSaveCycleCounts.mock.funcSaveCycleCounts != nil {
+		mmSaveCycleCounts.mock.t.Fatalf("StockServiceRepositoryMock.SaveCycleCounts mock is already set by Set")
 	}
 
-	if mmDeleteStockItemFromStorage.defaultExpectation == nil {
-		mmDeleteStockItemFromStorage.defaultExpectation = &StockServiceRepositoryMockDeleteStockItemFromStorageExpectation{}
+	if mmSaveCycleCounts.defaultExpectation == nil {
+		mmSaveCycleCounts.defaultExpectation = &StockServiceRepositoryMockSaveCycleCountsExpectation{}
 	}
 
-	if mmDeleteStockItemFromStorage.defaultExpectation.params != nil {
-		mmDeleteStockItemFromStorage.mock.t.Fatalf("StockServiceRepositoryMock.DeleteStockItemFromStorage mock is already set by Expect")
+	if mmSaveCycleCounts.defaultExpectation.params != nil {
+		mmSaveCycleCounts.mock.t.Fatalf("StockServiceRepositoryMock.SaveCycleCounts mock is already set by Expect")
 	}
 
-	if mmDeleteStockItemFromStorage.defaultExpectation.paramPtrs == nil {
-		mmDeleteStockItemFromStorage.defaultExpectation.paramPtrs = &StockServiceRepositoryMockDeleteStockItemFromStorageParamPtrs{}
+	if mmSaveCycleCounts.defaultExpectation.paramPtrs == nil {
+		mmSaveCycleCounts.defaultExpectation.paramPtrs = &StockServiceRepositoryMockSaveCycleCountsParamPtrs{}
 	}
-	mmDeleteStockItemFromStorage.defaultExpectation.paramPtrs.skuID = &skuID
-	mmDeleteStockItemFromStorage.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+	mmSaveCycleCounts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveCycleCounts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteStockItemFromStorage
+	return mmSaveCycleCounts
 }
 
-// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.DeleteStockItemFromStorage
-func (mmDeleteStockItemFromStorage *mStockServiceRepositoryMockDeleteStockItemFromStorage) Inspect(f func(ctx context.Context, userID domain.UserID, skuID domain.SKUID)) *mStockServiceRepositoryMockDeleteStockItemFromStorage {
-	if mmDeleteStockItemFromStorage.mock.inspectFuncDeleteStockItemFromStorage != nil {
-		mmDeleteStockItemFromStorage.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.DeleteStockItemFromStorage")
+// ExpectSubmissionParam2 sets up expected param submission for StockServiceRepository.SaveCycleCounts
+func (mmSaveCycleCounts *mStockServiceRepositoryMockSaveCycleCounts) ExpectSubmissionParam2(submission domain.CycleCountSubmission) *mStockServiceRepositoryMockSaveCycleCounts {
+	if mmSaveCycleCounts.mock.funcSaveCycleCounts != nil {
+		mmSaveCycleCounts.mock.t.Fatalf("StockServiceRepositoryMock.SaveCycleCounts mock is already set by Set")
 	}
 
-	mmDeleteStockItemFromStorage.mock.inspectFuncDeleteStockItemFromStorage = f
+	if mmSaveCycleCounts.defaultExpectation == nil {
+		mmSaveCycleCounts.defaultExpectation = &StockServiceRepositoryMockSaveCycleCountsExpectation{}
+	}
 
-	return mmDeleteStockItemFromStorage
+	if mmSaveCycleCounts.defaultExpectation.params != nil {
+		mmSaveCycleCounts.mock.t.Fatalf("StockServiceRepositoryMock.SaveCycleCounts mock is already set by Expect")
+	}
+
+	if mmSaveCycleCounts.defaultExpectation.paramPtrs == nil {
+		mmSaveCycleCounts.defaultExpectation.paramPtrs = &StockServiceRepositoryMockSaveCycleCountsParamPtrs{}
+	}
+	mmSaveCycleCounts.defaultExpectation.paramPtrs.submission = &submission
+	mmSaveCycleCounts.defaultExpectation.expectationOrigins.originSubmission = minimock.CallerInfo(1)
+
+	return mmSaveCycleCounts
 }
 
-// Return sets up results that will be returned by StockServiceRepository.DeleteStockItemFromStorage
-func (mmDeleteStockItemFromStorage *mStockServiceRepositoryMockDeleteStockItemFromStorage) Return(err error) *StockServiceRepositoryMock {
-	if mmDeleteStockItemFromStorage.mock.funcDeleteStockItemFromStorage != nil {
-		mmDeleteStockItemFromStorage.mock.t.Fatalf("StockServiceRepositoryMock.DeleteStockItemFromStorage mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.SaveCycleCounts
+func (mmSaveCycleCounts *mStockServiceRepositoryMockSaveCycleCounts) Inspect(f func(ctx context.Context, submission domain.CycleCountSubmission)) *mStockServiceRepositoryMockSaveCycleCounts {
+	if mmSaveCycleCounts.mock.inspectFuncSaveCycleCounts != nil {
+		mmSaveCycleCounts.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.SaveCycleCounts")
 	}
 
-	if mmDeleteStockItemFromStorage.defaultExpectation == nil {
-		mmDeleteStockItemFromStorage.defaultExpectation = &StockServiceRepositoryMockDeleteStockItemFromStorageExpectation{mock: mmDeleteStockItemFromStorage.mock}
+	mmSaveCycleCounts.mock.inspectFuncSaveCycleCounts = f
+
+	return mmSaveCycleCounts
+}
+
+// Return sets up results that will be returned by StockServiceRepository.SaveCycleCounts
+func (mmSaveCycleCounts *mStockServiceRepositoryMockSaveCycleCounts) Return(err error) *StockServiceRepositoryMock {
+	if mmSaveCycleCounts.mock.funcSaveCycleCounts != nil {
+		mmSaveCycleCounts.mock.t.Fatalf("StockServiceRepositoryMock.SaveCycleCounts mock is already set by Set")
 	}
-	mmDeleteStockItemFromStorage.defaultExpectation.results = &StockServiceRepositoryMockDeleteStockItemFromStorageResults{err}
-	mmDeleteStockItemFromStorage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteStockItemFromStorage.mock
+
+	if mmSaveCycleCounts.defaultExpectation == nil {
+		mmSaveCycleCounts.defaultExpectation = &StockServiceRepositoryMockSaveCycleCountsExpectation{mock: mmSaveCycleCounts.mock}
+	}
+	mmSaveCycleCounts.defaultExpectation.results = &StockServiceRepositoryMockSaveCycleCountsResults{err}
+	mmSaveCycleCounts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveCycleCounts.mock
 }
 
-// Set uses given function f to mock the StockServiceRepository.DeleteStockItemFromStorage method
-func (mmDeleteStockItemFromStorage *mStockServiceRepositoryMockDeleteStockItemFromStorage) Set(f func(ctx context.Context, userID domain.UserID, skuID domain.SKUID) (err error)) *StockServiceRepositoryMock {
-	if mmDeleteStockItemFromStorage.defaultExpectation != nil {
-		mmDeleteStockItemFromStorage.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.DeleteStockItemFromStorage method")
+// Set uses given function f to mock the StockServiceRepository.SaveCycleCounts method
+func (mmSaveCycleCounts *mStockServiceRepositoryMockSaveCycleCounts) Set(f func(ctx context.Context, submission domain.CycleCountSubmission) (err error)) *StockServiceRepositoryMock {
+	if mmSaveCycleCounts.defaultExpectation != nil {
+		mmSaveCycleCounts.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.SaveCycleCounts method")
 	}
 
-	if len(mmDeleteStockItemFromStorage.expectations) > 0 {
-		mmDeleteStockItemFromStorage.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.DeleteStockItemFromStorage method")
+	if len(mmSaveCycleCounts.expectations) > 0 {
+		mmSaveCycleCounts.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.SaveCycleCounts method")
 	}
 
-	mmDeleteStockItemFromStorage.mock.funcDeleteStockItemFromStorage = f
-	mmDeleteStockItemFromStorage.mock.funcDeleteStockItemFromStorageOrigin = minimock.CallerInfo(1)
-	return mmDeleteStockItemFromStorage.mock
+	mmSaveCycleCounts.mock.funcSaveCycleCounts = f
+	mmSaveCycleCounts.mock.funcSaveCycleCountsOrigin = minimock.CallerInfo(1)
+	return mmSaveCycleCounts.mock
 }
 
-// When sets expectation for the StockServiceRepository.DeleteStockItemFromStorage which will trigger the result defined by the following
+// When sets expectation for the StockServiceRepository.SaveCycleCounts which will trigger the result defined by the following
 // Then helper
-func (mmDeleteStockItemFromStorage *mStockServiceRepositoryMockDeleteStockItemFromStorage) When(ctx context.Context, userID domain.UserID, skuID domain.SKUID) *StockServiceRepositoryMockDeleteStockItemFromStorageExpectation {
-	if mmDeleteStockItemFromStorage.mock.funcDeleteStockItemFromStorage != nil {
-		mmDeleteStockItemFromStorage.mock.t.Fatalf("StockServiceRepositoryMock.DeleteStockItemFromStorage mock is already set by Set")
+func (mmSaveCycleCounts *mStockServiceRepositoryMockSaveCycleCounts) When(ctx context.Context, submission domain.CycleCountSubmission) *StockServiceRepositoryMockSaveCycleCountsExpectation {
+	if mmSaveCycleCounts.mock.funcSaveCycleCounts != nil {
+		mmSaveCycleCounts.mock.t.Fatalf("StockServiceRepositoryMock.SaveCycleCounts mock is already set by Set")
 	}
 
-	expectation := &StockServiceRepositoryMockDeleteStockItemFromStorageExpectation{
-		mock:               mmDeleteStockItemFromStorage.mock,
-		params:             &StockServiceRepositoryMockDeleteStockItemFromStorageParams{ctx, userID, skuID},
-		expectationOrigins: StockServiceRepositoryMockDeleteStockItemFromStorageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &StockServiceRepositoryMockSaveCycleCountsExpectation{
+		mock:               mmSaveCycleCounts.mock,
+		params:             &StockServiceRepositoryMockSaveCycleCountsParams{ctx, submission},
+		expectationOrigins: StockServiceRepositoryMockSaveCycleCountsExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteStockItemFromStorage.expectations = append(mmDeleteStockItemFromStorage.expectations, expectation)
+	mmSaveCycleCounts.expectations = append(mmSaveCycleCounts.expectations, expectation)
 	return expectation
 }
 
-// Then sets up StockServiceRepository.DeleteStockItemFromStorage return parameters for the expectation previously defined by the When method
-func (e *StockServiceRepositoryMockDeleteStockItemFromStorageExpectation) Then(err error) *StockServiceRepositoryMock {
-	e.results = &StockServiceRepositoryMockDeleteStockItemFromStorageResults{err}
+// Then sets up StockServiceRepository.SaveCycleCounts return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockSaveCycleCountsExpectation) Then(err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockSaveCycleCountsResults{err}
+	return e.mock
+}
+
+// Times sets number of times StockServiceRepository.SaveCycleCounts should be invoked
+func (mmSaveCycleCounts *mStockServiceRepositoryMockSaveCycleCounts) Times(n uint64) *mStockServiceRepositoryMockSaveCycleCounts {
+	if n == 0 {
+		mmSaveCycleCounts.mock.t.Fatalf("Times of StockServiceRepositoryMock.SaveCycleCounts mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSaveCycleCounts.expectedInvocations, n)
+	mmSaveCycleCounts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveCycleCounts
+}
+
+func (mmSaveCycleCounts *mStockServiceRepositoryMockSaveCycleCounts) invocationsDone() bool {
+	if len(mmSaveCycleCounts.expectations) == 0 && mmSaveCycleCounts.defaultExpectation == nil && mmSaveCycleCounts.mock.funcSaveCycleCounts == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveCycleCounts.mock.afterSaveCycleCountsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveCycleCounts.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SaveCycleCounts implements mm_stocks.StockServiceRepository
+func (mmSaveCycleCounts *StockServiceRepositoryMock) SaveCycleCounts(ctx context.Context, submission domain.CycleCountSubmission) (err error) {
+	mm_atomic.AddUint64(&mmSaveCycleCounts.beforeSaveCycleCountsCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveCycleCounts.afterSaveCycleCountsCounter, 1)
+
+	mmSaveCycleCounts.t.Helper()
+
+	if mmSaveCycleCounts.inspectFuncSaveCycleCounts != nil {
+		mmSaveCycleCounts.inspectFuncSaveCycleCounts(ctx, submission)
+	}
+
+	mm_params := StockServiceRepositoryMockSaveCycleCountsParams{ctx, submission}
+
+	// Record call args
+	mmSaveCycleCounts.SaveCycleCountsMock.mutex.Lock()
+	mmSaveCycleCounts.SaveCycleCountsMock.callArgs = append(mmSaveCycleCounts.SaveCycleCountsMock.callArgs, &mm_params)
+	mmSaveCycleCounts.SaveCycleCountsMock.mutex.Unlock()
+
+	for _, e := range mmSaveCycleCounts.SaveCycleCountsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveCycleCounts.SaveCycleCountsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveCycleCounts.SaveCycleCountsMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveCycleCounts.SaveCycleCountsMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveCycleCounts.SaveCycleCountsMock.defaultExpectation.paramPtrs
+
+		mm_got := StockServiceRepositoryMockSaveCycleCountsParams{ctx, submission}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSaveCycleCounts.t.Errorf("StockServiceRepositoryMock.SaveCycleCounts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveCycleCounts.SaveCycleCountsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.submission != nil && !minimock.Equal(*mm_want_ptrs.submission, mm_got.submission) {
+				mmSaveCycleCounts.t.Errorf("StockServiceRepositoryMock.SaveCycleCounts got unexpected parameter submission, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveCycleCounts.SaveCycleCountsMock.defaultExpectation.expectationOrigins.originSubmission, *mm_want_ptrs.submission, mm_got.submission, minimock.Diff(*mm_want_ptrs.submission, mm_got.submission))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveCycleCounts.t.Errorf("StockServiceRepositoryMock.SaveCycleCounts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveCycleCounts.SaveCycleCountsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveCycleCounts.SaveCycleCountsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveCycleCounts.t.Fatal("No results are set for the StockServiceRepositoryMock.SaveCycleCounts")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveCycleCounts.funcSaveCycleCounts != nil {
+		return mmSaveCycleCounts.funcSaveCycleCounts(ctx, submission)
+	}
+	mmSaveCycleCounts.t.Fatalf("Unexpected call to StockServiceRepositoryMock.SaveCycleCounts. %v %v", ctx, submission)
+	return
+}
+
+// SaveCycleCountsAfterCounter returns a count of finished StockServiceRepositoryMock.SaveCycleCounts invocations
+func (mmSaveCycleCounts *StockServiceRepositoryMock) SaveCycleCountsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveCycleCounts.afterSaveCycleCountsCounter)
+}
+
+// SaveCycleCountsBeforeCounter returns a count of StockServiceRepositoryMock.SaveCycleCounts invocations
+func (mmSaveCycleCounts *StockServiceRepositoryMock) SaveCycleCountsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveCycleCounts.beforeSaveCycleCountsCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.SaveCycleCounts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveCycleCounts *mStockServiceRepositoryMockSaveCycleCounts) Calls() []*StockServiceRepositoryMockSaveCycleCountsParams {
+	mmSaveCycleCounts.mutex.RLock()
+
+	argCopy := make([]*StockServiceRepositoryMockSaveCycleCountsParams, len(mmSaveCycleCounts.callArgs))
+	copy(argCopy, mmSaveCycleCounts.callArgs)
+
+	mmSaveCycleCounts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveCycleCountsDone returns true if the count of the SaveCycleCounts invocations corresponds
+// the number of defined expectations
+func (m *StockServiceRepositoryMock) MinimockSaveCycleCountsDone() bool {
+	if m.SaveCycleCountsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveCycleCountsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveCycleCountsMock.invocationsDone()
+}
+
+// MinimockSaveCycleCountsInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockSaveCycleCountsInspect() {
+	for _, e := range m.SaveCycleCountsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.SaveCycleCounts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveCycleCountsCounter := mm_atomic.LoadUint64(&m.afterSaveCycleCountsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveCycleCountsMock.defaultExpectation != nil && afterSaveCycleCountsCounter < 1 {
+		if m.SaveCycleCountsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.SaveCycleCounts at\n%s", m.SaveCycleCountsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.SaveCycleCounts at\n%s with params: %#v", m.SaveCycleCountsMock.defaultExpectation.expectationOrigins.origin, *m.SaveCycleCountsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveCycleCounts != nil && afterSaveCycleCountsCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.SaveCycleCounts at\n%s", m.funcSaveCycleCountsOrigin)
+	}
+
+	if !m.SaveCycleCountsMock.invocationsDone() && afterSaveCycleCountsCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.SaveCycleCounts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveCycleCountsMock.expectedInvocations), m.SaveCycleCountsMock.expectedInvocationsOrigin, afterSaveCycleCountsCounter)
+	}
+}
+
+type mStockServiceRepositoryMockSavePurchaseOrder struct {
+	optional           bool
+	mock               *StockServiceRepositoryMock
+	defaultExpectation *StockServiceRepositoryMockSavePurchaseOrderExpectation
+	expectations       []*StockServiceRepositoryMockSavePurchaseOrderExpectation
+
+	callArgs []*StockServiceRepositoryMockSavePurchaseOrderParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockServiceRepositoryMockSavePurchaseOrderExpectation specifies expectation struct of the StockServiceRepository.SavePurchaseOrder
+type StockServiceRepositoryMockSavePurchaseOrderExpectation struct {
+	mock               *StockServiceRepositoryMock
+	params             *StockServiceRepositoryMockSavePurchaseOrderParams
+	paramPtrs          *StockServiceRepositoryMockSavePurchaseOrderParamPtrs
+	expectationOrigins StockServiceRepositoryMockSavePurchaseOrderExpectationOrigins
+	results            *StockServiceRepositoryMockSavePurchaseOrderResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockServiceRepositoryMockSavePurchaseOrderParams contains parameters of the StockServiceRepository.SavePurchaseOrder
+type StockServiceRepositoryMockSavePurchaseOrderParams struct {
+	ctx           context.Context
+	purchaseOrder domain.PurchaseOrder
+}
+
+// StockServiceRepositoryMockSavePurchaseOrderParamPtrs contains pointers to parameters of the StockServiceRepository.SavePurchaseOrder
+type StockServiceRepositoryMockSavePurchaseOrderParamPtrs struct {
+	ctx           *context.Context
+	purchaseOrder *domain.PurchaseOrder
+}
+
+// StockServiceRepositoryMockSavePurchaseOrderResults contains results of the StockServiceRepository.SavePurchaseOrder
+type StockServiceRepositoryMockSavePurchaseOrderResults struct {
+	p1  domain.PurchaseOrder
+	err error
+}
+
+// StockServiceRepositoryMockSavePurchaseOrderOrigins contains origins of expectations of the StockServiceRepository.SavePurchaseOrder
+type StockServiceRepositoryMockSavePurchaseOrderExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originPurchaseOrder string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSavePurchaseOrder *mStockServiceRepositoryMockSavePurchaseOrder) Optional() *mStockServiceRepositoryMockSavePurchaseOrder {
+	mmSavePurchaseOrder.optional = true
+	return mmSavePurchaseOrder
+}
+
+// Expect sets up expected params for StockServiceRepository.SavePurchaseOrder
+func (mmSavePurchaseOrder *mStockServiceRepositoryMockSavePurchaseOrder) Expect(ctx context.Context, purchaseOrder domain.PurchaseOrder) *mStockServiceRepositoryMockSavePurchaseOrder {
+	if mmSavePurchaseOrder.mock.funcSavePurchaseOrder != nil {
+		mmSavePurchaseOrder.mock.t.Fatalf("StockServiceRepositoryMock.SavePurchaseOrder mock is already set by Set")
+	}
+
+	if mmSavePurchaseOrder.defaultExpectation == nil {
+		mmSavePurchaseOrder.defaultExpectation = &StockServiceRepositoryMockSavePurchaseOrderExpectation{}
+	}
+
+	if mmSavePurchaseOrder.defaultExpectation.paramPtrs != nil {
+		mmSavePurchaseOrder.mock.t.Fatalf("StockServiceRepositoryMock.SavePurchaseOrder mock is already set by ExpectParams functions")
+	}
+
+	mmSavePurchaseOrder.defaultExpectation.params = &StockServiceRepositoryMockSavePurchaseOrderParams{ctx, purchaseOrder}
+	mmSavePurchaseOrder.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSavePurchaseOrder.expectations {
+		if minimock.Equal(e.params, mmSavePurchaseOrder.defaultExpectation.params) {
+			mmSavePurchaseOrder.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSavePurchaseOrder.defaultExpectation.params)
+		}
+	}
+
+	return mmSavePurchaseOrder
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.SavePurchaseOrder
+func (mmSavePurchaseOrder *mStockServiceRepositoryMockSavePurchaseOrder) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockSavePurchaseOrder {
+	if mmSavePurchaseOrder.mock.funcSavePurchaseOrder != nil {
+		mmSavePurchaseOrder.mock.t.Fatalf("StockServiceRepositoryMock.SavePurchaseOrder mock is already set by Set")
+	}
+
+	if mmSavePurchaseOrder.defaultExpectation == nil {
+		mmSavePurchaseOrder.defaultExpectation = &StockServiceRepositoryMockSavePurchaseOrderExpectation{}
+	}
+
+	if mmSavePurchaseOrder.defaultExpectation.params != nil {
+		mmSavePurchaseOrder.mock.t.Fatalf("StockServiceRepositoryMock.SavePurchaseOrder mock is already set by Expect")
+	}
+
+	if mmSavePurchaseOrder.defaultExpectation.paramPtrs == nil {
+		mmSavePurchaseOrder.defaultExpectation.paramPtrs = &StockServiceRepositoryMockSavePurchaseOrderParamPtrs{}
+	}
+	mmSavePurchaseOrder.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSavePurchaseOrder.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSavePurchaseOrder
+}
+
+// ExpectPurchaseOrderParam2 sets up expected param purchaseOrder for StockServiceRepository.SavePurchaseOrder
+func (mmSavePurchaseOrder *mStockServiceRepositoryMockSavePurchaseOrder) ExpectPurchaseOrderParam2(purchaseOrder domain.PurchaseOrder) *mStockServiceRepositoryMockSavePurchaseOrder {
+	if mmSavePurchaseOrder.mock.funcSavePurchaseOrder != nil {
+		mmSavePurchaseOrder.mock.t.Fatalf("StockServiceRepositoryMock.SavePurchaseOrder mock is already set by Set")
+	}
+
+	if mmSavePurchaseOrder.defaultExpectation == nil {
+		mmSavePurchaseOrder.defaultExpectation = &StockServiceRepositoryMockSavePurchaseOrderExpectation{}
+	}
+
+	if mmSavePurchaseOrder.defaultExpectation.params != nil {
+		mmSavePurchaseOrder.mock.t.Fatalf("StockServiceRepositoryMock.SavePurchaseOrder mock is already set by Expect")
+	}
+
+	if mmSavePurchaseOrder.defaultExpectation.paramPtrs == nil {
+		mmSavePurchaseOrder.defaultExpectation.paramPtrs = &StockServiceRepositoryMockSavePurchaseOrderParamPtrs{}
+	}
+	mmSavePurchaseOrder.defaultExpectation.paramPtrs.purchaseOrder = &purchaseOrder
+	mmSavePurchaseOrder.defaultExpectation.expectationOrigins.originPurchaseOrder = minimock.CallerInfo(1)
+
+	return mmSavePurchaseOrder
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.SavePurchaseOrder
+func (mmSavePurchaseOrder *mStockServiceRepositoryMockSavePurchaseOrder) Inspect(f func(ctx context.Context, purchaseOrder domain.PurchaseOrder)) *mStockServiceRepositoryMockSavePurchaseOrder {
+	if mmSavePurchaseOrder.mock.inspectFuncSavePurchaseOrder != nil {
+		mmSavePurchaseOrder.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.SavePurchaseOrder")
+	}
+
+	mmSavePurchaseOrder.mock.inspectFuncSavePurchaseOrder = f
+
+	return mmSavePurchaseOrder
+}
+
+// Return sets up results that will be returned by StockServiceRepository.SavePurchaseOrder
+func (mmSavePurchaseOrder *mStockServiceRepositoryMockSavePurchaseOrder) Return(p1 domain.PurchaseOrder, err error) *StockServiceRepositoryMock {
+	if mmSavePurchaseOrder.mock.funcSavePurchaseOrder != nil {
+		mmSavePurchaseOrder.mock.t.Fatalf("StockServiceRepositoryMock.SavePurchaseOrder mock is already set by Set")
+	}
+
+	if mmSavePurchaseOrder.defaultExpectation == nil {
+		mmSavePurchaseOrder.defaultExpectation = &StockServiceRepositoryMockSavePurchaseOrderExpectation{mock: mmSavePurchaseOrder.mock}
+	}
+	mmSavePurchaseOrder.defaultExpectation.results = &StockServiceRepositoryMockSavePurchaseOrderResults{p1, err}
+	mmSavePurchaseOrder.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSavePurchaseOrder.mock
+}
+
+// Set uses given function f to mock the StockServiceRepository.SavePurchaseOrder method
+func (mmSavePurchaseOrder *mStockServiceRepositoryMockSavePurchaseOrder) Set(f func(ctx context.Context, purchaseOrder domain.PurchaseOrder) (p1 domain.PurchaseOrder, err error)) *StockServiceRepositoryMock {
+	if mmSavePurchaseOrder.defaultExpectation != nil {
+		mmSavePurchaseOrder.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.SavePurchaseOrder method")
+	}
+
+	if len(mmSavePurchaseOrder.expectations) > 0 {
+		mmSavePurchaseOrder.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.SavePurchaseOrder method")
+	}
+
+	mmSavePurchaseOrder.mock.funcSavePurchaseOrder = f
+	mmSavePurchaseOrder.mock.funcSavePurchaseOrderOrigin = minimock.CallerInfo(1)
+	return mmSavePurchaseOrder.mock
+}
+
+// When sets expectation for the StockServiceRepository.SavePurchaseOrder which will trigger the result defined by the following
+// Then helper
+func (mmSavePurchaseOrder *mStockServiceRepositoryMockSavePurchaseOrder) When(ctx context.Context, purchaseOrder domain.PurchaseOrder) *StockServiceRepositoryMockSavePurchaseOrderExpectation {
+	if mmSavePurchaseOrder.mock.funcSavePurchaseOrder != nil {
+		mmSavePurchaseOrder.mock.t.Fatalf("StockServiceRepositoryMock.SavePurchaseOrder mock is already set by Set")
+	}
+
+	expectation := &StockServiceRepositoryMockSavePurchaseOrderExpectation{
+		mock:               mmSavePurchaseOrder.mock,
+		params:             &StockServiceRepositoryMockSavePurchaseOrderParams{ctx, purchaseOrder},
+		expectationOrigins: StockServiceRepositoryMockSavePurchaseOrderExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSavePurchaseOrder.expectations = append(mmSavePurchaseOrder.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockServiceRepository.SavePurchaseOrder return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockSavePurchaseOrderExpectation) Then(p1 domain.PurchaseOrder, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockSavePurchaseOrderResults{p1, err}
 	return e.mock
 }
 
-// Times sets number of times StockServiceRepository.DeleteStockItemFromStorage should be invoked
-func (mmDeleteStockItemFromStorage *mStockServiceRepositoryMockDeleteStockItemFromStorage) Times(n uint64) *mStockServiceRepositoryMockDeleteStockItemFromStorage {
+// Times sets number of times StockServiceRepository.SavePurchaseOrder should be invoked
+func (mmSavePurchaseOrder *mStockServiceRepositoryMockSavePurchaseOrder) Times(n uint64) *mStockServiceRepositoryMockSavePurchaseOrder {
 	if n == 0 {
-		mmDeleteStockItemFromStorage.mock.t.Fatalf("Times of StockServiceRepositoryMock.DeleteStockItemFromStorage mock can not be zero")
+		mmSavePurchaseOrder.mock.t.Fatalf("Times of StockServiceRepositoryMock.SavePurchaseOrder mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteStockItemFromStorage.expectedInvocations, n)
-	mmDeleteStockItemFromStorage.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteStockItemFromStorage
+	mm_atomic.StoreUint64(&mmSavePurchaseOrder.expectedInvocations, n)
+	mmSavePurchaseOrder.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSavePurchaseOrder
 }
 
-func (mmDeleteStockItemFromStorage *mStockServiceRepositoryMockDeleteStockItemFromStorage) invocationsDone() bool {
-	if len(mmDeleteStockItemFromStorage.expectations) == 0 && mmDeleteStockItemFromStorage.defaultExpectation == nil && mmDeleteStockItemFromStorage.mock.funcDeleteStockItemFromStorage == nil {
+func (mmSavePurchaseOrder *mStockServiceRepositoryMockSavePurchaseOrder) invocationsDone() bool {
+	if len(mmSavePurchaseOrder.expectations) == 0 && mmSavePurchaseOrder.defaultExpectation == nil && mmSavePurchaseOrder.mock.funcSavePurchaseOrder == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteStockItemFromStorage.mock.afterDeleteStockItemFromStorageCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteStockItemFromStorage.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSavePurchaseOrder.mock.afterSavePurchaseOrderCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSavePurchaseOrder.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteStockItemFromStorage implements mm_stocks.StockServiceRepository
-func (mmDeleteStockItemFromStorage *StockServiceRepositoryMock) DeleteStockItemFromStorage(ctx context.Context, userID domain.UserID, skuID domain.SKUID) (err error) {
-	mm_atomic.AddUint64(&mmDeleteStockItemFromStorage.beforeDeleteStockItemFromStorageCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteStockItemFromStorage.afterDeleteStockItemFromStorageCounter, 1)
+// SavePurchaseOrder implements mm_stocks.StockServiceRepository
+func (mmSavePurchaseOrder *StockServiceRepositoryMock) SavePurchaseOrder(ctx context.Context, purchaseOrder domain.PurchaseOrder) (p1 domain.PurchaseOrder, err error) {
+	mm_atomic.AddUint64(&mmSavePurchaseOrder.beforeSavePurchaseOrderCounter, 1)
+	defer mm_atomic.AddUint64(&mmSavePurchaseOrder.afterSavePurchaseOrderCounter, 1)
 
-	mmDeleteStockItemFromStorage.t.Helper()
+	mmSavePurchaseOrder.t.Helper()
 
-	if mmDeleteStockItemFromStorage.inspectFuncDeleteStockItemFromStorage != nil {
-		mmDeleteStockItemFromStorage.inspectFuncDeleteStockItemFromStorage(ctx, userID, skuID)
+	if mmSavePurchaseOrder.inspectFuncSavePurchaseOrder != nil {
+		mmSavePurchaseOrder.inspectFuncSavePurchaseOrder(ctx, purchaseOrder)
 	}
 
-	mm_params := StockServiceRepositoryMockDeleteStockItemFromStorageParams{ctx, userID, skuID}
+	mm_params := StockServiceRepositoryMockSavePurchaseOrderParams{ctx, purchaseOrder}
 
 	// Record call args
-	mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.mutex.Lock()
-	mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.callArgs = append(mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.callArgs, &mm_params)
-	mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.mutex.Unlock()
+	mmSavePurchaseOrder.SavePurchaseOrderMock.mutex.Lock()
+	mmSavePurchaseOrder.SavePurchaseOrderMock.callArgs = append(mmSavePurchaseOrder.SavePurchaseOrderMock.callArgs, &mm_params)
+	mmSavePurchaseOrder.SavePurchaseOrderMock.mutex.Unlock()
 
-	for _, e := range mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.expectations {
+	for _, e := range mmSavePurchaseOrder.SavePurchaseOrderMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.p1, e.results.err
 		}
 	}
 
-	if mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.defaultExpectation.paramPtrs
+	if mmSavePurchaseOrder.SavePurchaseOrderMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSavePurchaseOrder.SavePurchaseOrderMock.defaultExpectation.Counter, 1)
+		mm_want := mmSavePurchaseOrder.SavePurchaseOrderMock.defaultExpectation.params
+		mm_want_ptrs := mmSavePurchaseOrder.SavePurchaseOrderMock.defaultExpectation.paramPtrs
 
-		mm_got := StockServiceRepositoryMockDeleteStockItemFromStorageParams{ctx, userID, skuID}
+		mm_got := StockServiceRepositoryMockSavePurchaseOrderParams{ctx, purchaseOrder}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteStockItemFromStorage.t.Errorf("StockServiceRepositoryMock.DeleteStockItemFromStorage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmDeleteStockItemFromStorage.t.Errorf("StockServiceRepositoryMock.DeleteStockItemFromStorage got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+				mmSavePurchaseOrder.t.Errorf("StockServiceRepositoryMock.SavePurchaseOrder got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSavePurchaseOrder.SavePurchaseOrderMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
-				mmDeleteStockItemFromStorage.t.Errorf("StockServiceRepositoryMock.DeleteStockItemFromStorage got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			if mm_want_ptrs.purchaseOrder != nil && !minimock.Equal(*mm_want_ptrs.purchaseOrder, mm_got.purchaseOrder) {
+				mmSavePurchaseOrder.t.Errorf("StockServiceRepositoryMock.SavePurchaseOrder got unexpected parameter purchaseOrder, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSavePurchaseOrder.SavePurchaseOrderMock.defaultExpectation.expectationOrigins.originPurchaseOrder, *mm_want_ptrs.purchaseOrder, mm_got.purchaseOrder, minimock.Diff(*mm_want_ptrs.purchaseOrder, mm_got.purchaseOrder))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteStockItemFromStorage.t.Errorf("StockServiceRepositoryMock.DeleteStockItemFromStorage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSavePurchaseOrder.t.Errorf("StockServiceRepositoryMock.SavePurchaseOrder got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSavePurchaseOrder.SavePurchaseOrderMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteStockItemFromStorage.DeleteStockItemFromStorageMock.defaultExpectation.results
+		mm_results := mmSavePurchaseOrder.SavePurchaseOrderMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteStockItemFromStorage.t.Fatal("No results are set for the StockServiceRepositoryMock.DeleteStockItemFromStorage")
+			mmSavePurchaseOrder.t.Fatal("No results are set for the StockServiceRepositoryMock.SavePurchaseOrder")
 		}
-		return (*mm_results).err
+		return (*mm_results).p1, (*mm_results).err
 	}
-	if mmDeleteStockItemFromStorage.funcDeleteStockItemFromStorage != nil {
-		return mmDeleteStockItemFromStorage.funcDeleteStockItemFromStorage(ctx, userID, skuID)
+	if mmSavePurchaseOrder.funcSavePurchaseOrder != nil {
+		return mmSavePurchaseOrder.funcSavePurchaseOrder(ctx, purchaseOrder)
 	}
-	mmDeleteStockItemFromStorage.t.Fatalf("Unexpected call to StockServiceRepositoryMock.DeleteStockItemFromStorage. %v %v %v", ctx, userID, skuID)
+	mmSavePurchaseOrder.t.Fatalf("Unexpected call to StockServiceRepositoryMock.SavePurchaseOrder. %v %v", ctx, purchaseOrder)
 	return
 }
 
-// DeleteStockItemFromStorageAfterCounter returns a count of finished StockServiceRepositoryMock.DeleteStockItemFromStorage invocations
-func (mmDeleteStockItemFromStorage *StockServiceRepositoryMock) DeleteStockItemFromStorageAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteStockItemFromStorage.afterDeleteStockItemFromStorageCounter)
+// SavePurchaseOrderAfterCounter returns a count of finished StockServiceRepositoryMock.SavePurchaseOrder invocations
+func (mmSavePurchaseOrder *StockServiceRepositoryMock) SavePurchaseOrderAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSavePurchaseOrder.afterSavePurchaseOrderCounter)
 }
 
-// DeleteStockItemFromStorageBeforeCounter returns a count of StockServiceRepositoryMock.DeleteStockItemFromStorage invocations
-func (mmDeleteStockItemFromStorage *StockServiceRepositoryMock) DeleteStockItemFromStorageBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteStockItemFromStorage.beforeDeleteStockItemFromStorageCounter)
+// SavePurchaseOrderBeforeCounter returns a count of StockServiceRepositoryMock.SavePurchaseOrder invocations
+func (mmSavePurchaseOrder *StockServiceRepositoryMock) SavePurchaseOrderBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSavePurchaseOrder.beforeSavePurchaseOrderCounter)
 }
 
-// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.DeleteStockItemFromStorage.
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.SavePurchaseOrder.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteStockItemFromStorage *mStockServiceRepositoryMockDeleteStockItemFromStorage) Calls() []*StockServiceRepositoryMockDeleteStockItemFromStorageParams {
-	mmDeleteStockItemFromStorage.mutex.RLock()
+func (mmSavePurchaseOrder *mStockServiceRepositoryMockSavePurchaseOrder) Calls() []*StockServiceRepositoryMockSavePurchaseOrderParams {
+	mmSavePurchaseOrder.mutex.RLock()
 
-	argCopy := make([]*StockServiceRepositoryMockDeleteStockItemFromStorageParams, len(mmDeleteStockItemFromStorage.callArgs))
-	copy(argCopy, mmDeleteStockItemFromStorage.callArgs)
+	argCopy := make([]*StockServiceRepositoryMockSavePurchaseOrderParams, len(mmSavePurchaseOrder.callArgs))
+	copy(argCopy, mmSavePurchaseOrder.callArgs)
 
-	mmDeleteStockItemFromStorage.mutex.RUnlock()
+	mmSavePurchaseOrder.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteStockItemFromStorageDone returns true if the count of the DeleteStockItemFromStorage invocations corresponds
+// MinimockSavePurchaseOrderDone returns true if the count of the SavePurchaseOrder invocations corresponds
 // the number of defined expectations
-func (m *StockServiceRepositoryMock) MinimockDeleteStockItemFromStorageDone() bool {
-	if m.DeleteStockItemFromStorageMock.optional {
+func (m *StockServiceRepositoryMock) MinimockSavePurchaseOrderDone() bool {
+	if m.SavePurchaseOrderMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteStockItemFromStorageMock.expectations {
+	for _, e := range m.SavePurchaseOrderMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteStockItemFromStorageMock.invocationsDone()
+	return m.SavePurchaseOrderMock.invocationsDone()
 }
 
-// MinimockDeleteStockItemFromStorageInspect logs each unmet expectation
-func (m *StockServiceRepositoryMock) MinimockDeleteStockItemFromStorageInspect() {
-	for _, e := range m.DeleteStockItemFromStorageMock.expectations {
+// MinimockSavePurchaseOrderInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockSavePurchaseOrderInspect() {
+	for _, e := range m.SavePurchaseOrderMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StockServiceRepositoryMock.DeleteStockItemFromStorage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.SavePurchaseOrder at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteStockItemFromStorageCounter := mm_atomic.LoadUint64(&m.afterDeleteStockItemFromStorageCounter)
+	afterSavePurchaseOrderCounter := mm_atomic.LoadUint64(&m.afterSavePurchaseOrderCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteStockItemFromStorageMock.defaultExpectation != nil && afterDeleteStockItemFromStorageCounter < 1 {
-		if m.DeleteStockItemFromStorageMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to StockServiceRepositoryMock.DeleteStockItemFromStorage at\n%s", m.DeleteStockItemFromStorageMock.defaultExpectation.returnOrigin)
+	if m.SavePurchaseOrderMock.defaultExpectation != nil && afterSavePurchaseOrderCounter < 1 {
+		if m.SavePurchaseOrderMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.SavePurchaseOrder at\n%s", m.SavePurchaseOrderMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to StockServiceRepositoryMock.DeleteStockItemFromStorage at\n%s with params: %#v", m.DeleteStockItemFromStorageMock.defaultExpectation.expectationOrigins.origin, *m.DeleteStockItemFromStorageMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.SavePurchaseOrder at\n%s with params: %#v", m.SavePurchaseOrderMock.defaultExpectation.expectationOrigins.origin, *m.SavePurchaseOrderMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteStockItemFromStorage != nil && afterDeleteStockItemFromStorageCounter < 1 {
-		m.t.Errorf("Expected call to StockServiceRepositoryMock.DeleteStockItemFromStorage at\n%s", m.funcDeleteStockItemFromStorageOrigin)
+	if m.funcSavePurchaseOrder != nil && afterSavePurchaseOrderCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.SavePurchaseOrder at\n%s", m.funcSavePurchaseOrderOrigin)
 	}
 
-	if !m.DeleteStockItemFromStorageMock.invocationsDone() && afterDeleteStockItemFromStorageCounter > 0 {
-		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.DeleteStockItemFromStorage at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteStockItemFromStorageMock.expectedInvocations), m.DeleteStockItemFromStorageMock.expectedInvocationsOrigin, afterDeleteStockItemFromStorageCounter)
+	if !m.SavePurchaseOrderMock.invocationsDone() && afterSavePurchaseOrderCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.SavePurchaseOrder at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SavePurchaseOrderMock.expectedInvocations), m.SavePurchaseOrderMock.expectedInvocationsOrigin, afterSavePurchaseOrderCounter)
 	}
 }
 
-type mStockServiceRepositoryMockGetStockItem struct {
+type mStockServiceRepositoryMockSaveSupplier struct {
 	optional           bool
 	mock               *StockServiceRepositoryMock
-	defaultExpectation *StockServiceRepositoryMockGetStockItemExpectation
-	expectations       []*StockServiceRepositoryMockGetStockItemExpectation
+	defaultExpectation *StockServiceRepositoryMockSaveSupplierExpectation
+	expectations       []*StockServiceRepositoryMockSaveSupplierExpectation
 
-	callArgs []*StockServiceRepositoryMockGetStockItemParams
+	callArgs []*StockServiceRepositoryMockSaveSupplierParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// StockServiceRepositoryMockGetStockItemExpectation specifies expectation struct of the StockServiceRepository.GetStockItem
-type StockServiceRepositoryMockGetStockItemExpectation struct {
+// StockServiceRepositoryMockSaveSupplierExpectation specifies expectation struct of the StockServiceRepository.SaveSupplier
+type StockServiceRepositoryMockSaveSupplierExpectation struct {
 	mock               *StockServiceRepositoryMock
-	params             *StockServiceRepositoryMockGetStockItemParams
-	paramPtrs          *StockServiceRepositoryMockGetStockItemParamPtrs
-	expectationOrigins StockServiceRepositoryMockGetStockItemExpectationOrigins
-	results            *StockServiceRepositoryMockGetStockItemResults
+	params             *StockServiceRepositoryMockSaveSupplierParams
+	paramPtrs          *StockServiceRepositoryMockSaveSupplierParamPtrs
+	expectationOrigins StockServiceRepositoryMockSaveSupplierExpectationOrigins
+	results            *StockServiceRepositoryMockSaveSupplierResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// StockServiceRepositoryMockGetStockItemParams contains parameters of the StockServiceRepository.GetStockItem
-type StockServiceRepositoryMockGetStockItemParams struct {
-	ctx    context.Context
-	userID domain.UserID
-	skuID  domain.SKUID
+// StockServiceRepositoryMockSaveSupplierParams contains parameters of the StockServiceRepository.SaveSupplier
+type StockServiceRepositoryMockSaveSupplierParams struct {
+	ctx      context.Context
+	supplier domain.Supplier
 }
 
-// StockServiceRepositoryMockGetStockItemParamPtrs contains pointers to parameters of the StockServiceRepository.GetStockItem
-type StockServiceRepositoryMockGetStockItemParamPtrs struct {
-	ctx    *context.Context
-	userID *domain.UserID
-	skuID  *domain.SKUID
+// StockServiceRepositoryMockSaveSupplierParamPtrs contains pointers to parameters of the StockServiceRepository.SaveSupplier
+type StockServiceRepositoryMockSaveSupplierParamPtrs struct {
+	ctx      *context.Context
+	supplier *domain.Supplier
 }
 
-// StockServiceRepositoryMockGetStockItemResults contains results of the StockServiceRepository.GetStockItem
-type StockServiceRepositoryMockGetStockItemResults struct {
-	s1  domain.StockItem
+// StockServiceRepositoryMockSaveSupplierResults contains results of the StockServiceRepository.SaveSupplier
+type StockServiceRepositoryMockSaveSupplierResults struct {
+	s1  domain.Supplier
 	err error
 }
 
-// StockServiceRepositoryMockGetStockItemOrigins contains origins of expectations of the StockServiceRepository.GetStockItem
-type StockServiceRepositoryMockGetStockItemExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originUserID string
-	originSkuID  string
+// StockServiceRepositoryMockSaveSupplierOrigins contains origins of expectations of the StockServiceRepository.SaveSupplier
+type StockServiceRepositoryMockSaveSupplierExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originSupplier string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -905,370 +10006,345 @@ type StockServiceRepositoryMockGetStockItemExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetStockItem *mStockServiceRepositoryMockGetStockItem) Optional() *mStockServiceRepositoryMockGetStockItem {
-	mmGetStockItem.optional = true
-	return mmGetStockItem
+func (mmSaveSupplier *mStockServiceRepositoryMockSaveSupplier) Optional() *mStockServiceRepositoryMockSaveSupplier {
+	mmSaveSupplier.optional = true
+	return mmSaveSupplier
 }
 
-// Expect sets up expected params for StockServiceRepository.GetStockItem
-func (mmGetStockItem *mStockServiceRepositoryMockGetStockItem) Expect(ctx context.Context, userID domain.UserID, skuID domain.SKUID) *mStockServiceRepositoryMockGetStockItem {
-	if mmGetStockItem.mock.funcGetStockItem != nil {
-		mmGetStockItem.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItem mock is already set by Set")
+// Expect sets up expected params for StockServiceRepository.SaveSupplier
+func (mmSaveSupplier *mStockServiceRepositoryMockSaveSupplier) Expect(ctx context.Context, supplier domain.Supplier) *mStockServiceRepositoryMockSaveSupplier {
+	if mmSaveSupplier.mock.funcSaveSupplier != nil {
+		mmSaveSupplier.mock.t.Fatalf("StockServiceRepositoryMock.SaveSupplier mock is already set by Set")
 	}
 
-	if mmGetStockItem.defaultExpectation == nil {
-		mmGetStockItem.defaultExpectation = &StockServiceRepositoryMockGetStockItemExpectation{}
+	if mmSaveSupplier.defaultExpectation == nil {
+		mmSaveSupplier.defaultExpectation = &StockServiceRepositoryMockSaveSupplierExpectation{}
 	}
 
-	if mmGetStockItem.defaultExpectation.paramPtrs != nil {
-		mmGetStockItem.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItem mock is already set by ExpectParams functions")
+	if mmSaveSupplier.defaultExpectation.paramPtrs != nil {
+		mmSaveSupplier.mock.t.Fatalf("StockServiceRepositoryMock.SaveSupplier mock is already set by ExpectParams functions")
 	}
 
-	mmGetStockItem.defaultExpectation.params = &StockServiceRepositoryMockGetStockItemParams{ctx, userID, skuID}
-	mmGetStockItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetStockItem.expectations {
-		if minimock.Equal(e.params, mmGetStockItem.defaultExpectation.params) {
-			mmGetStockItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetStockItem.defaultExpectation.params)
+	mmSaveSupplier.defaultExpectation.params = &StockServiceRepositoryMockSaveSupplierParams{ctx, supplier}
+	mmSaveSupplier.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveSupplier.expectations {
+		if minimock.Equal(e.params, mmSaveSupplier.defaultExpectation.params) {
+			mmSaveSupplier.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveSupplier.defaultExpectation.params)
 		}
 	}
 
-	return mmGetStockItem
-}
-
-// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.GetStockItem
-func (mmGetStockItem *mStockServiceRepositoryMockGetStockItem) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockGetStockItem {
-	if mmGetStockItem.mock.funcGetStockItem != nil {
-		mmGetStockItem.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItem mock is already set by Set")
-	}
-
-	if mmGetStockItem.defaultExpectation == nil {
-		mmGetStockItem.defaultExpectation = &StockServiceRepositoryMockGetStockItemExpectation{}
-	}
-
-	if mmGetStockItem.defaultExpectation.params != nil {
-		mmGetStockItem.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItem mock is already set by Expect")
-	}
-
-	if mmGetStockItem.defaultExpectation.paramPtrs == nil {
-		mmGetStockItem.defaultExpectation.paramPtrs = &StockServiceRepositoryMockGetStockItemParamPtrs{}
-	}
-	mmGetStockItem.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetStockItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
-
-	return mmGetStockItem
+	return mmSaveSupplier
 }
 
-// ExpectUserIDParam2 sets up expected param userID for StockServiceRepository.GetStockItem
-func (mmGetStockItem *mStockServiceRepositoryMockGetStockItem) ExpectUserIDParam2(userID domain.UserID) *mStockServiceRepositoryMockGetStockItem {
-	if mmGetStockItem.mock.funcGetStockItem != nil {
-		mmGetStockItem.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItem mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.SaveSupplier
+func (mmSaveSupplier *mStockServiceRepositoryMockSaveSupplier) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockSaveSupplier {
+	if mmSaveSupplier.mock.funcSaveSupplier != nil {
+		mmSaveSupplier.mock.t.Fatalf("StockServiceRepositoryMock.SaveSupplier mock is already set by Set")
 	}
 
-	if mmGetStockItem.defaultExpectation == nil {
-		mmGetStockItem.defaultExpectation = &StockServiceRepositoryMockGetStockItemExpectation{}
+	if mmSaveSupplier.defaultExpectation == nil {
+		mmSaveSupplier.defaultExpectation = &StockServiceRepositoryMockSaveSupplierExpectation{}
 	}
 
-	if mmGetStockItem.defaultExpectation.params != nil {
-		mmGetStockItem.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItem mock is already set by Expect")
+	if mmSaveSupplier.defaultExpectation.params != nil {
+		mmSaveSupplier.mock.t.Fatalf("StockServiceRepositoryMock.SaveSupplier mock is already set by Expect")
 	}
 
-	if mmGetStockItem.defaultExpectation.paramPtrs == nil {
-		mmGetStockItem.defaultExpectation.paramPtrs = &StockServiceRepositoryMockGetStockItemParamPtrs{}
+	if mmSaveSupplier.defaultExpectation.paramPtrs == nil {
+		mmSaveSupplier.defaultExpectation.paramPtrs = &StockServiceRepositoryMockSaveSupplierParamPtrs{}
 	}
-	mmGetStockItem.defaultExpectation.paramPtrs.userID = &userID
-	mmGetStockItem.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmSaveSupplier.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveSupplier.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetStockItem
+	return mmSaveSupplier
 }
 
-// ExpectSkuIDParam3 sets up expected param skuID for StockServiceRepository.GetStockItem
-func (mmGetStockItem *mStockServiceRepositoryMockGetStockItem) ExpectSkuIDParam3(skuID domain.SKUID) *mStockServiceRepositoryMockGetStockItem {
-	if mmGetStockItem.mock.funcGetStockItem != nil {
-		mmGetStockItem.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItem mock is already set by Set")
+// ExpectSupplierParam2 sets up expected param supplier for StockServiceRepository.SaveSupplier
+func (mmSaveSupplier *mStockServiceRepositoryMockSaveSupplier) ExpectSupplierParam2(supplier domain.Supplier) *mStockServiceRepositoryMockSaveSupplier {
+	if mmSaveSupplier.mock.funcSaveSupplier != nil {
+		mmSaveSupplier.mock.t.Fatalf("StockServiceRepositoryMock.SaveSupplier mock is already set by Set")
 	}
 
-	if mmGetStockItem.defaultExpectation == nil {
-		mmGetStockItem.defaultExpectation = &StockServiceRepositoryMockGetStockItemExpectation{}
+	if mmSaveSupplier.defaultExpectation == nil {
+		mmSaveSupplier.defaultExpectation = &StockServiceRepositoryMockSaveSupplierExpectation{}
 	}
 
-	if mmGetStockItem.defaultExpectation.params != nil {
-		mmGetStockItem.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItem mock is already set by Expect")
+	if mmSaveSupplier.defaultExpectation.params != nil {
+		mmSaveSupplier.mock.t.Fatalf("StockServiceRepositoryMock.SaveSupplier mock is already set by Expect")
 	}
 
-	if mmGetStockItem.defaultExpectation.paramPtrs == nil {
-		mmGetStockItem.defaultExpectation.paramPtrs = &StockServiceRepositoryMockGetStockItemParamPtrs{}
+	if mmSaveSupplier.defaultExpectation.paramPtrs == nil {
+		mmSaveSupplier.defaultExpectation.paramPtrs = &StockServiceRepositoryMockSaveSupplierParamPtrs{}
 	}
-	mmGetStockItem.defaultExpectation.paramPtrs.skuID = &skuID
-	mmGetStockItem.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+	mmSaveSupplier.defaultExpectation.paramPtrs.supplier = &supplier
+	mmSaveSupplier.defaultExpectation.expectationOrigins.originSupplier = minimock.CallerInfo(1)
 
-	return mmGetStockItem
+	return mmSaveSupplier
 }
 
-// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.GetStockItem
-func (mmGetStockItem *mStockServiceRepositoryMockGetStockItem) Inspect(f func(ctx context.Context, userID domain.UserID, skuID domain.SKUID)) *mStockServiceRepositoryMockGetStockItem {
-	if mmGetStockItem.mock.inspectFuncGetStockItem != nil {
-		mmGetStockItem.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.GetStockItem")
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.SaveSupplier
+func (mmSaveSupplier *mStockServiceRepositoryMockSaveSupplier) Inspect(f func(ctx context.Context, supplier domain.Supplier)) *mStockServiceRepositoryMockSaveSupplier {
+	if mmSaveSupplier.mock.inspectFuncSaveSupplier != nil {
+		mmSaveSupplier.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.SaveSupplier")
 	}
 
-	mmGetStockItem.mock.inspectFuncGetStockItem = f
+	mmSaveSupplier.mock.inspectFuncSaveSupplier = f
 
-	return mmGetStockItem
+	return mmSaveSupplier
 }
 
-// Return sets up results that will be returned by StockServiceRepository.GetStockItem
-func (mmGetStockItem *mStockServiceRepositoryMockGetStockItem) Return(s1 domain.StockItem, err error) *StockServiceRepositoryMock {
-	if mmGetStockItem.mock.funcGetStockItem != nil {
-		mmGetStockItem.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItem mock is already set by Set")
+// Return sets up results that will be returned by StockServiceRepository.SaveSupplier
+func (mmSaveSupplier *mStockServiceRepositoryMockSaveSupplier) Return(s1 domain.Supplier, err error) *StockServiceRepositoryMock {
+	if mmSaveSupplier.mock.funcSaveSupplier != nil {
+		mmSaveSupplier.mock.t.Fatalf("StockServiceRepositoryMock.SaveSupplier mock is already set by Set")
 	}
 
-	if mmGetStockItem.defaultExpectation == nil {
-		mmGetStockItem.defaultExpectation = &StockServiceRepositoryMockGetStockItemExpectation{mock: mmGetStockItem.mock}
+	if mmSaveSupplier.defaultExpectation == nil {
+		mmSaveSupplier.defaultExpectation = &StockServiceRepositoryMockSaveSupplierExpectation{mock: mmSaveSupplier.mock}
 	}
-	mmGetStockItem.defaultExpectation.results = &StockServiceRepositoryMockGetStockItemResults{s1, err}
-	mmGetStockItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetStockItem.mock
+	mmSaveSupplier.defaultExpectation.results = &StockServiceRepositoryMockSaveSupplierResults{s1, err}
+	mmSaveSupplier.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveSupplier.mock
 }
 
-// Set uses given function f to mock the StockServiceRepository.GetStockItem method
-func (mmGetStockItem *mStockServiceRepositoryMockGetStockItem) Set(f func(ctx context.Context, userID domain.UserID, skuID domain.SKUID) (s1 domain.StockItem, err error)) *StockServiceRepositoryMock {
-	if mmGetStockItem.defaultExpectation != nil {
-		mmGetStockItem.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.GetStockItem method")
+// Set uses given function f to mock the StockServiceRepository.SaveSupplier method
+func (mmSaveSupplier *mStockServiceRepositoryMockSaveSupplier) Set(f func(ctx context.Context, supplier domain.Supplier) (s1 domain.Supplier, err error)) *StockServiceRepositoryMock {
+	if mmSaveSupplier.defaultExpectation != nil {
+		mmSaveSupplier.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.SaveSupplier method")
 	}
 
-	if len(mmGetStockItem.expectations) > 0 {
-		mmGetStockItem.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.GetStockItem method")
+	if len(mmSaveSupplier.expectations) > 0 {
+		mmSaveSupplier.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.SaveSupplier method")
 	}
 
-	mmGetStockItem.mock.funcGetStockItem = f
-	mmGetStockItem.mock.funcGetStockItemOrigin = minimock.CallerInfo(1)
-	return mmGetStockItem.mock
+	mmSaveSupplier.mock.funcSaveSupplier = f
+	mmSaveSupplier.mock.funcSaveSupplierOrigin = minimock.CallerInfo(1)
+	return mmSaveSupplier.mock
 }
 
-// When sets expectation for the StockServiceRepository.GetStockItem which will trigger the result defined by the following
+// When sets expectation for the StockServiceRepository.SaveSupplier which will trigger the result defined by the following
 // Then helper
-func (mmGetStockItem *mStockServiceRepositoryMockGetStockItem) When(ctx context.Context, userID domain.UserID, skuID domain.SKUID) *StockServiceRepositoryMockGetStockItemExpectation {
-	if mmGetStockItem.mock.funcGetStockItem != nil {
-		mmGetStockItem.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItem mock is already set by Set")
+func (mmSaveSupplier *mStockServiceRepositoryMockSaveSupplier) When(ctx context.Context, supplier domain.Supplier) *StockServiceRepositoryMockSaveSupplierExpectation {
+	if mmSaveSupplier.mock.funcSaveSupplier != nil {
+		mmSaveSupplier.mock.t.Fatalf("StockServiceRepositoryMock.SaveSupplier mock is already set by Set")
 	}
 
-	expectation := &StockServiceRepositoryMockGetStockItemExpectation{
-		mock:               mmGetStockItem.mock,
-		params:             &StockServiceRepositoryMockGetStockItemParams{ctx, userID, skuID},
-		expectationOrigins: StockServiceRepositoryMockGetStockItemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &StockServiceRepositoryMockSaveSupplierExpectation{
+		mock:               mmSaveSupplier.mock,
+		params:             &StockServiceRepositoryMockSaveSupplierParams{ctx, supplier},
+		expectationOrigins: StockServiceRepositoryMockSaveSupplierExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetStockItem.expectations = append(mmGetStockItem.expectations, expectation)
+	mmSaveSupplier.expectations = append(mmSaveSupplier.expectations, expectation)
 	return expectation
 }
 
-// Then sets up StockServiceRepository.GetStockItem return parameters for the expectation previously defined by the When method
-func (e *StockServiceRepositoryMockGetStockItemExpectation) Then(s1 domain.StockItem, err error) *StockServiceRepositoryMock {
-	e.results = &StockServiceRepositoryMockGetStockItemResults{s1, err}
+// Then sets up StockServiceRepository.SaveSupplier return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockSaveSupplierExpectation) Then(s1 domain.Supplier, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockSaveSupplierResults{s1, err}
 	return e.mock
 }
 
-// Times sets number of times StockServiceRepository.GetStockItem should be invoked
-func (mmGetStockItem *mStockServiceRepositoryMockGetStockItem) Times(n uint64) *mStockServiceRepositoryMockGetStockItem {
+// Times sets number of times StockServiceRepository.SaveSupplier should be invoked
+func (mmSaveSupplier *mStockServiceRepositoryMockSaveSupplier) Times(n uint64) *mStockServiceRepositoryMockSaveSupplier {
 	if n == 0 {
-		mmGetStockItem.mock.t.Fatalf("Times of StockServiceRepositoryMock.GetStockItem mock can not be zero")
+		mmSaveSupplier.mock.t.Fatalf("Times of StockServiceRepositoryMock.SaveSupplier mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetStockItem.expectedInvocations, n)
-	mmGetStockItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetStockItem
+	mm_atomic.StoreUint64(&mmSaveSupplier.expectedInvocations, n)
+	mmSaveSupplier.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveSupplier
 }
 
-func (mmGetStockItem *mStockServiceRepositoryMockGetStockItem) invocationsDone() bool {
-	if len(mmGetStockItem.expectations) == 0 && mmGetStockItem.defaultExpectation == nil && mmGetStockItem.mock.funcGetStockItem == nil {
+func (mmSaveSupplier *mStockServiceRepositoryMockSaveSupplier) invocationsDone() bool {
+	if len(mmSaveSupplier.expectations) == 0 && mmSaveSupplier.defaultExpectation == nil && mmSaveSupplier.mock.funcSaveSupplier == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetStockItem.mock.afterGetStockItemCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetStockItem.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveSupplier.mock.afterSaveSupplierCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveSupplier.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetStockItem implements mm_stocks.StockServiceRepository
-func (mmGetStockItem *StockServiceRepositoryMock) GetStockItem(ctx context.Context, userID domain.UserID, skuID domain.SKUID) (s1 domain.StockItem, err error) {
-	mm_atomic.AddUint64(&mmGetStockItem.beforeGetStockItemCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetStockItem.afterGetStockItemCounter, 1)
+// SaveSupplier implements mm_stocks.StockServiceRepository
+func (mmSaveSupplier *StockServiceRepositoryMock) SaveSupplier(ctx context.Context, supplier domain.Supplier) (s1 domain.Supplier, err error) {
+	mm_atomic.AddUint64(&mmSaveSupplier.beforeSaveSupplierCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveSupplier.afterSaveSupplierCounter, 1)
 
-	mmGetStockItem.t.Helper()
+	mmSaveSupplier.t.Helper()
 
-	if mmGetStockItem.inspectFuncGetStockItem != nil {
-		mmGetStockItem.inspectFuncGetStockItem(ctx, userID, skuID)
+	if mmSaveSupplier.inspectFuncSaveSupplier != nil {
+		mmSaveSupplier.inspectFuncSaveSupplier(ctx, supplier)
 	}
 
-	mm_params := StockServiceRepositoryMockGetStockItemParams{ctx, userID, skuID}
+	mm_params := StockServiceRepositoryMockSaveSupplierParams{ctx, supplier}
 
 	// Record call args
-	mmGetStockItem.GetStockItemMock.mutex.Lock()
-	mmGetStockItem.GetStockItemMock.callArgs = append(mmGetStockItem.GetStockItemMock.callArgs, &mm_params)
-	mmGetStockItem.GetStockItemMock.mutex.Unlock()
+	mmSaveSupplier.SaveSupplierMock.mutex.Lock()
+	mmSaveSupplier.SaveSupplierMock.callArgs = append(mmSaveSupplier.SaveSupplierMock.callArgs, &mm_params)
+	mmSaveSupplier.SaveSupplierMock.mutex.Unlock()
 
-	for _, e := range mmGetStockItem.GetStockItemMock.expectations {
+	for _, e := range mmSaveSupplier.SaveSupplierMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.s1, e.results.err
 		}
 	}
 
-	if mmGetStockItem.GetStockItemMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetStockItem.GetStockItemMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetStockItem.GetStockItemMock.defaultExpectation.params
-		mm_want_ptrs := mmGetStockItem.GetStockItemMock.defaultExpectation.paramPtrs
+	if mmSaveSupplier.SaveSupplierMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveSupplier.SaveSupplierMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveSupplier.SaveSupplierMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveSupplier.SaveSupplierMock.defaultExpectation.paramPtrs
 
-		mm_got := StockServiceRepositoryMockGetStockItemParams{ctx, userID, skuID}
+		mm_got := StockServiceRepositoryMockSaveSupplierParams{ctx, supplier}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetStockItem.t.Errorf("StockServiceRepositoryMock.GetStockItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetStockItem.GetStockItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
-				mmGetStockItem.t.Errorf("StockServiceRepositoryMock.GetStockItem got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetStockItem.GetStockItemMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+				mmSaveSupplier.t.Errorf("StockServiceRepositoryMock.SaveSupplier got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveSupplier.SaveSupplierMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
-				mmGetStockItem.t.Errorf("StockServiceRepositoryMock.GetStockItem got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetStockItem.GetStockItemMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			if mm_want_ptrs.supplier != nil && !minimock.Equal(*mm_want_ptrs.supplier, mm_got.supplier) {
+				mmSaveSupplier.t.Errorf("StockServiceRepositoryMock.SaveSupplier got unexpected parameter supplier, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveSupplier.SaveSupplierMock.defaultExpectation.expectationOrigins.originSupplier, *mm_want_ptrs.supplier, mm_got.supplier, minimock.Diff(*mm_want_ptrs.supplier, mm_got.supplier))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetStockItem.t.Errorf("StockServiceRepositoryMock.GetStockItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetStockItem.GetStockItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSaveSupplier.t.Errorf("StockServiceRepositoryMock.SaveSupplier got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveSupplier.SaveSupplierMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetStockItem.GetStockItemMock.defaultExpectation.results
+		mm_results := mmSaveSupplier.SaveSupplierMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetStockItem.t.Fatal("No results are set for the StockServiceRepositoryMock.GetStockItem")
+			mmSaveSupplier.t.Fatal("No results are set for the StockServiceRepositoryMock.SaveSupplier")
 		}
 		return (*mm_results).s1, (*mm_results).err
 	}
-	if mmGetStockItem.funcGetStockItem != nil {
-		return mmGetStockItem.funcGetStockItem(ctx, userID, skuID)
+	if mmSaveSupplier.funcSaveSupplier != nil {
+		return mmSaveSupplier.funcSaveSupplier(ctx, supplier)
 	}
-	mmGetStockItem.t.Fatalf("Unexpected call to StockServiceRepositoryMock.GetStockItem. %v %v %v", ctx, userID, skuID)
+	mmSaveSupplier.t.Fatalf("Unexpected call to StockServiceRepositoryMock.SaveSupplier. %v %v", ctx, supplier)
 	return
 }
 
-// GetStockItemAfterCounter returns a count of finished StockServiceRepositoryMock.GetStockItem invocations
-func (mmGetStockItem *StockServiceRepositoryMock) GetStockItemAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetStockItem.afterGetStockItemCounter)
+// SaveSupplierAfterCounter returns a count of finished StockServiceRepositoryMock.SaveSupplier invocations
+func (mmSaveSupplier *StockServiceRepositoryMock) SaveSupplierAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveSupplier.afterSaveSupplierCounter)
 }
 
-// GetStockItemBeforeCounter returns a count of StockServiceRepositoryMock.GetStockItem invocations
-func (mmGetStockItem *StockServiceRepositoryMock) GetStockItemBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetStockItem.beforeGetStockItemCounter)
+// SaveSupplierBeforeCounter returns a count of StockServiceRepositoryMock.SaveSupplier invocations
+func (mmSaveSupplier *StockServiceRepositoryMock) SaveSupplierBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveSupplier.beforeSaveSupplierCounter)
 }
 
-// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.GetStockItem.
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.SaveSupplier.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetStockItem *mStockServiceRepositoryMockGetStockItem) Calls() []*StockServiceRepositoryMockGetStockItemParams {
-	mmGetStockItem.mutex.RLock()
+func (mmSaveSupplier *mStockServiceRepositoryMockSaveSupplier) Calls() []*StockServiceRepositoryMockSaveSupplierParams {
+	mmSaveSupplier.mutex.RLock()
 
-	argCopy := make([]*StockServiceRepositoryMockGetStockItemParams, len(mmGetStockItem.callArgs))
-	copy(argCopy, mmGetStockItem.callArgs)
+	argCopy := make([]*StockServiceRepositoryMockSaveSupplierParams, len(mmSaveSupplier.callArgs))
+	copy(argCopy, mmSaveSupplier.callArgs)
 
-	mmGetStockItem.mutex.RUnlock()
+	mmSaveSupplier.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetStockItemDone returns true if the count of the GetStockItem invocations corresponds
+// MinimockSaveSupplierDone returns true if the count of the SaveSupplier invocations corresponds
 // the number of defined expectations
-func (m *StockServiceRepositoryMock) MinimockGetStockItemDone() bool {
-	if m.GetStockItemMock.optional {
+func (m *StockServiceRepositoryMock) MinimockSaveSupplierDone() bool {
+	if m.SaveSupplierMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetStockItemMock.expectations {
+	for _, e := range m.SaveSupplierMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetStockItemMock.invocationsDone()
+	return m.SaveSupplierMock.invocationsDone()
 }
 
-// MinimockGetStockItemInspect logs each unmet expectation
-func (m *StockServiceRepositoryMock) MinimockGetStockItemInspect() {
-	for _, e := range m.GetStockItemMock.expectations {
+// MinimockSaveSupplierInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockSaveSupplierInspect() {
+	for _, e := range m.SaveSupplierMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StockServiceRepositoryMock.GetStockItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.SaveSupplier at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetStockItemCounter := mm_atomic.LoadUint64(&m.afterGetStockItemCounter)
+	afterSaveSupplierCounter := mm_atomic.LoadUint64(&m.afterSaveSupplierCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetStockItemMock.defaultExpectation != nil && afterGetStockItemCounter < 1 {
-		if m.GetStockItemMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to StockServiceRepositoryMock.GetStockItem at\n%s", m.GetStockItemMock.defaultExpectation.returnOrigin)
+	if m.SaveSupplierMock.defaultExpectation != nil && afterSaveSupplierCounter < 1 {
+		if m.SaveSupplierMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.SaveSupplier at\n%s", m.SaveSupplierMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to StockServiceRepositoryMock.GetStockItem at\n%s with params: %#v", m.GetStockItemMock.defaultExpectation.expectationOrigins.origin, *m.GetStockItemMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.SaveSupplier at\n%s with params: %#v", m.SaveSupplierMock.defaultExpectation.expectationOrigins.origin, *m.SaveSupplierMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetStockItem != nil && afterGetStockItemCounter < 1 {
-		m.t.Errorf("Expected call to StockServiceRepositoryMock.GetStockItem at\n%s", m.funcGetStockItemOrigin)
+	if m.funcSaveSupplier != nil && afterSaveSupplierCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.SaveSupplier at\n%s", m.funcSaveSupplierOrigin)
 	}
 
-	if !m.GetStockItemMock.invocationsDone() && afterGetStockItemCounter > 0 {
-		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.GetStockItem at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetStockItemMock.expectedInvocations), m.GetStockItemMock.expectedInvocationsOrigin, afterGetStockItemCounter)
+	if !m.SaveSupplierMock.invocationsDone() && afterSaveSupplierCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.SaveSupplier at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveSupplierMock.expectedInvocations), m.SaveSupplierMock.expectedInvocationsOrigin, afterSaveSupplierCounter)
 	}
 }
 
-type mStockServiceRepositoryMockGetStockItemBySku struct {
+type mStockServiceRepositoryMockShipStockTransfer struct {
 	optional           bool
 	mock               *StockServiceRepositoryMock
-	defaultExpectation *StockServiceRepositoryMockGetStockItemBySkuExpectation
-	expectations       []*StockServiceRepositoryMockGetStockItemBySkuExpectation
+	defaultExpectation *StockServiceRepositoryMockShipStockTransferExpectation
+	expectations       []*StockServiceRepositoryMockShipStockTransferExpectation
 
-	callArgs []*StockServiceRepositoryMockGetStockItemBySkuParams
+	callArgs []*StockServiceRepositoryMockShipStockTransferParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// StockServiceRepositoryMockGetStockItemBySkuExpectation specifies expectation struct of the StockServiceRepository.GetStockItemBySku
-type StockServiceRepositoryMockGetStockItemBySkuExpectation struct {
+// StockServiceRepositoryMockShipStockTransferExpectation specifies expectation struct of the StockServiceRepository.ShipStockTransfer
+type StockServiceRepositoryMockShipStockTransferExpectation struct {
 	mock               *StockServiceRepositoryMock
-	params             *StockServiceRepositoryMockGetStockItemBySkuParams
-	paramPtrs          *StockServiceRepositoryMockGetStockItemBySkuParamPtrs
-	expectationOrigins StockServiceRepositoryMockGetStockItemBySkuExpectationOrigins
-	results            *StockServiceRepositoryMockGetStockItemBySkuResults
+	params             *StockServiceRepositoryMockShipStockTransferParams
+	paramPtrs          *StockServiceRepositoryMockShipStockTransferParamPtrs
+	expectationOrigins StockServiceRepositoryMockShipStockTransferExpectationOrigins
+	results            *StockServiceRepositoryMockShipStockTransferResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// StockServiceRepositoryMockGetStockItemBySkuParams contains parameters of the StockServiceRepository.GetStockItemBySku
-type StockServiceRepositoryMockGetStockItemBySkuParams struct {
-	ctx   context.Context
-	skuID domain.SKUID
+// StockServiceRepositoryMockShipStockTransferParams contains parameters of the StockServiceRepository.ShipStockTransfer
+type StockServiceRepositoryMockShipStockTransferParams struct {
+	ctx                context.Context
+	transfer           domain.StockTransfer
+	receiveImmediately bool
 }
 
-// StockServiceRepositoryMockGetStockItemBySkuParamPtrs contains pointers to parameters of the StockServiceRepository.GetStockItemBySku
-type StockServiceRepositoryMockGetStockItemBySkuParamPtrs struct {
-	ctx   *context.Context
-	skuID *domain.SKUID
+// StockServiceRepositoryMockShipStockTransferParamPtrs contains pointers to parameters of the StockServiceRepository.ShipStockTransfer
+type StockServiceRepositoryMockShipStockTransferParamPtrs struct {
+	ctx                *context.Context
+	transfer           *domain.StockTransfer
+	receiveImmediately *bool
 }
 
-// StockServiceRepositoryMockGetStockItemBySkuResults contains results of the StockServiceRepository.GetStockItemBySku
-type StockServiceRepositoryMockGetStockItemBySkuResults struct {
-	s1  domain.StockItem
+// StockServiceRepositoryMockShipStockTransferResults contains results of the StockServiceRepository.ShipStockTransfer
+type StockServiceRepositoryMockShipStockTransferResults struct {
+	s1  domain.StockTransferResult
 	err error
 }
 
-// StockServiceRepositoryMockGetStockItemBySkuOrigins contains origins of expectations of the StockServiceRepository.GetStockItemBySku
-type StockServiceRepositoryMockGetStockItemBySkuExpectationOrigins struct {
-	origin      string
-	originCtx   string
-	originSkuID string
+// StockServiceRepositoryMockShipStockTransferOrigins contains origins of expectations of the StockServiceRepository.ShipStockTransfer
+type StockServiceRepositoryMockShipStockTransferExpectationOrigins struct {
+	origin                   string
+	originCtx                string
+	originTransfer           string
+	originReceiveImmediately string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1276,342 +10352,369 @@ type StockServiceRepositoryMockGetStockItemBySkuExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetStockItemBySku *mStockServiceRepositoryMockGetStockItemBySku) Optional() *mStockServiceRepositoryMockGetStockItemBySku {
-	mmGetStockItemBySku.optional = true
-	return mmGetStockItemBySku
+func (mmShipStockTransfer *mStockServiceRepositoryMockShipStockTransfer) Optional() *mStockServiceRepositoryMockShipStockTransfer {
+	mmShipStockTransfer.optional = true
+	return mmShipStockTransfer
 }
 
-// Expect sets up expected params for StockServiceRepository.GetStockItemBySku
-func (mmGetStockItemBySku *mStockServiceRepositoryMockGetStockItemBySku) Expect(ctx context.Context, skuID domain.SKUID) *mStockServiceRepositoryMockGetStockItemBySku {
-	if mmGetStockItemBySku.mock.funcGetStockItemBySku != nil {
-		mmGetStockItemBySku.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItemBySku mock is already set by Set")
+// Expect sets up expected params for StockServiceRepository.ShipStockTransfer
+func (mmShipStockTransfer *mStockServiceRepositoryMockShipStockTransfer) Expect(ctx context.Context, transfer domain.StockTransfer, receiveImmediately bool) *mStockServiceRepositoryMockShipStockTransfer {
+	if mmShipStockTransfer.mock.funcShipStockTransfer != nil {
+		mmShipStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.ShipStockTransfer mock is already set by Set")
 	}
 
-	if mmGetStockItemBySku.defaultExpectation == nil {
-		mmGetStockItemBySku.defaultExpectation = &StockServiceRepositoryMockGetStockItemBySkuExpectation{}
+	if mmShipStockTransfer.defaultExpectation == nil {
+		mmShipStockTransfer.defaultExpectation = &StockServiceRepositoryMockShipStockTransferExpectation{}
 	}
 
-	if mmGetStockItemBySku.defaultExpectation.paramPtrs != nil {
-		mmGetStockItemBySku.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItemBySku mock is already set by ExpectParams functions")
+	if mmShipStockTransfer.defaultExpectation.paramPtrs != nil {
+		mmShipStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.ShipStockTransfer mock is already set by ExpectParams functions")
 	}
 
-	mmGetStockItemBySku.defaultExpectation.params = &StockServiceRepositoryMockGetStockItemBySkuParams{ctx, skuID}
-	mmGetStockItemBySku.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetStockItemBySku.expectations {
-		if minimock.Equal(e.params, mmGetStockItemBySku.defaultExpectation.params) {
-			mmGetStockItemBySku.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetStockItemBySku.defaultExpectation.params)
+	mmShipStockTransfer.defaultExpectation.params = &StockServiceRepositoryMockShipStockTransferParams{ctx, transfer, receiveImmediately}
+	mmShipStockTransfer.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmShipStockTransfer.expectations {
+		if minimock.Equal(e.params, mmShipStockTransfer.defaultExpectation.params) {
+			mmShipStockTransfer.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmShipStockTransfer.defaultExpectation.params)
 		}
 	}
 
-	return mmGetStockItemBySku
+	return mmShipStockTransfer
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.ShipStockTransfer
+func (mmShipStockTransfer *mStockServiceRepositoryMockShipStockTransfer) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockShipStockTransfer {
+	if mmShipStockTransfer.mock.funcShipStockTransfer != nil {
+		mmShipStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.ShipStockTransfer mock is already set by Set")
+	}
+
+	if mmShipStockTransfer.defaultExpectation == nil {
+		mmShipStockTransfer.defaultExpectation = &StockServiceRepositoryMockShipStockTransferExpectation{}
+	}
+
+	if mmShipStockTransfer.defaultExpectation.params != nil {
+		mmShipStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.ShipStockTransfer mock is already set by Expect")
+	}
+
+	if mmShipStockTransfer.defaultExpectation.paramPtrs == nil {
+		mmShipStockTransfer.defaultExpectation.paramPtrs = &StockServiceRepositoryMockShipStockTransferParamPtrs{}
+	}
+	mmShipStockTransfer.defaultExpectation.paramPtrs.ctx = &ctx
+	mmShipStockTransfer.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmShipStockTransfer
 }
 
-// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.GetStockItemBySku
-func (mmGetStockItemBySku *mStockServiceRepositoryMockGetStockItemBySku) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockGetStockItemBySku {
-	if mmGetStockItemBySku.mock.funcGetStockItemBySku != nil {
-		mmGetStockItemBySku.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItemBySku mock is already set by Set")
+// ExpectTransferParam2 sets up expected param transfer for StockServiceRepository.ShipStockTransfer
+func (mmShipStockTransfer *mStockServiceRepositoryMockShipStockTransfer) ExpectTransferParam2(transfer domain.StockTransfer) *mStockServiceRepositoryMockShipStockTransfer {
+	if mmShipStockTransfer.mock.funcShipStockTransfer != nil {
+		mmShipStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.ShipStockTransfer mock is already set by Set")
 	}
 
-	if mmGetStockItemBySku.defaultExpectation == nil {
-		mmGetStockItemBySku.defaultExpectation = &StockServiceRepositoryMockGetStockItemBySkuExpectation{}
+	if mmShipStockTransfer.defaultExpectation == nil {
+		mmShipStockTransfer.defaultExpectation = &StockServiceRepositoryMockShipStockTransferExpectation{}
 	}
 
-	if mmGetStockItemBySku.defaultExpectation.params != nil {
-		mmGetStockItemBySku.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItemBySku mock is already set by Expect")
+	if mmShipStockTransfer.defaultExpectation.params != nil {
+		mmShipStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.ShipStockTransfer mock is already set by Expect")
 	}
 
-	if mmGetStockItemBySku.defaultExpectation.paramPtrs == nil {
-		mmGetStockItemBySku.defaultExpectation.paramPtrs = &StockServiceRepositoryMockGetStockItemBySkuParamPtrs{}
+	if mmShipStockTransfer.defaultExpectation.paramPtrs == nil {
+		mmShipStockTransfer.defaultExpectation.paramPtrs = &StockServiceRepositoryMockShipStockTransferParamPtrs{}
 	}
-	mmGetStockItemBySku.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetStockItemBySku.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmShipStockTransfer.defaultExpectation.paramPtrs.transfer = &transfer
+	mmShipStockTransfer.defaultExpectation.expectationOrigins.originTransfer = minimock.CallerInfo(1)
 
-	return mmGetStockItemBySku
+	return mmShipStockTransfer
 }
 
-// ExpectSkuIDParam2 sets up expected param skuID for StockServiceRepository.GetStockItemBySku
-func (mmGetStockItemBySku *mStockServiceRepositoryMockGetStockItemBySku) ExpectSkuIDParam2(skuID domain.SKUID) *mStockServiceRepositoryMockGetStockItemBySku {
-	if mmGetStockItemBySku.mock.funcGetStockItemBySku != nil {
-		mmGetStockItemBySku.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItemBySku mock is already set by Set")
+// ExpectReceiveImmediatelyParam3 sets up expected param receiveImmediately for StockServiceRepository.ShipStockTransfer
+func (mmShipStockTransfer *mStockServiceRepositoryMockShipStockTransfer) ExpectReceiveImmediatelyParam3(receiveImmediately bool) *mStockServiceRepositoryMockShipStockTransfer {
+	if mmShipStockTransfer.mock.funcShipStockTransfer != nil {
+		mmShipStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.ShipStockTransfer mock is already set by Set")
 	}
 
-	if mmGetStockItemBySku.defaultExpectation == nil {
-		mmGetStockItemBySku.defaultExpectation = &StockServiceRepositoryMockGetStockItemBySkuExpectation{}
+	if mmShipStockTransfer.defaultExpectation == nil {
+		mmShipStockTransfer.defaultExpectation = &StockServiceRepositoryMockShipStockTransferExpectation{}
 	}
 
-	if mmGetStockItemBySku.defaultExpectation.params != nil {
-		mmGetStockItemBySku.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItemBySku mock is already set by Expect")
+	if mmShipStockTransfer.defaultExpectation.params != nil {
+		mmShipStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.ShipStockTransfer mock is already set by Expect")
 	}
 
-	if mmGetStockItemBySku.defaultExpectation.paramPtrs == nil {
-		mmGetStockItemBySku.defaultExpectation.paramPtrs = &StockServiceRepositoryMockGetStockItemBySkuParamPtrs{}
+	if mmShipStockTransfer.defaultExpectation.paramPtrs == nil {
+		mmShipStockTransfer.defaultExpectation.paramPtrs = &StockServiceRepositoryMockShipStockTransferParamPtrs{}
 	}
-	mmGetStockItemBySku.defaultExpectation.paramPtrs.skuID = &skuID
-	mmGetStockItemBySku.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+	mmShipStockTransfer.defaultExpectation.paramPtrs.receiveImmediately = &receiveImmediately
+	mmShipStockTransfer.defaultExpectation.expectationOrigins.originReceiveImmediately = minimock.CallerInfo(1)
 
-	return mmGetStockItemBySku
+	return mmShipStockTransfer
 }
 
-// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.GetStockItemBySku
-func (mmGetStockItemBySku *mStockServiceRepositoryMockGetStockItemBySku) Inspect(f func(ctx context.Context, skuID domain.SKUID)) *mStockServiceRepositoryMockGetStockItemBySku {
-	if mmGetStockItemBySku.mock.inspectFuncGetStockItemBySku != nil {
-		mmGetStockItemBySku.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.GetStockItemBySku")
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.ShipStockTransfer
+func (mmShipStockTransfer *mStockServiceRepositoryMockShipStockTransfer) Inspect(f func(ctx context.Context, transfer domain.StockTransfer, receiveImmediately bool)) *mStockServiceRepositoryMockShipStockTransfer {
+	if mmShipStockTransfer.mock.inspectFuncShipStockTransfer != nil {
+		mmShipStockTransfer.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.ShipStockTransfer")
 	}
 
-	mmGetStockItemBySku.mock.inspectFuncGetStockItemBySku = f
+	mmShipStockTransfer.mock.inspectFuncShipStockTransfer = f
 
-	return mmGetStockItemBySku
+	return mmShipStockTransfer
 }
 
-// Return sets up results that will be returned by StockServiceRepository.GetStockItemBySku
-func (mmGetStockItemBySku *mStockServiceRepositoryMockGetStockItemBySku) Return(s1 domain.StockItem, err error) *StockServiceRepositoryMock {
-	if mmGetStockItemBySku.mock.funcGetStockItemBySku != nil {
-		mmGetStockItemBySku.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItemBySku mock is already set by Set")
+// Return sets up results that will be returned by StockServiceRepository.ShipStockTransfer
+func (mmShipStockTransfer *mStockServiceRepositoryMockShipStockTransfer) Return(s1 domain.StockTransferResult, err error) *StockServiceRepositoryMock {
+	if mmShipStockTransfer.mock.funcShipStockTransfer != nil {
+		mmShipStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.ShipStockTransfer mock is already set by Set")
 	}
 
-	if mmGetStockItemBySku.defaultExpectation == nil {
-		mmGetStockItemBySku.defaultExpectation = &StockServiceRepositoryMockGetStockItemBySkuExpectation{mock: mmGetStockItemBySku.mock}
+	if mmShipStockTransfer.defaultExpectation == nil {
+		mmShipStockTransfer.defaultExpectation = &StockServiceRepositoryMockShipStockTransferExpectation{mock: mmShipStockTransfer.mock}
 	}
-	mmGetStockItemBySku.defaultExpectation.results = &StockServiceRepositoryMockGetStockItemBySkuResults{s1, err}
-	mmGetStockItemBySku.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetStockItemBySku.mock
+	mmShipStockTransfer.defaultExpectation.results = &StockServiceRepositoryMockShipStockTransferResults{s1, err}
+	mmShipStockTransfer.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmShipStockTransfer.mock
 }
 
-// Set uses given function f to mock the StockServiceRepository.GetStockItemBySku method
-func (mmGetStockItemBySku *mStockServiceRepositoryMockGetStockItemBySku) Set(f func(ctx context.Context, skuID domain.SKUID) (s1 domain.StockItem, err error)) *StockServiceRepositoryMock {
-	if mmGetStockItemBySku.defaultExpectation != nil {
-		mmGetStockItemBySku.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.GetStockItemBySku method")
+// Set uses given function f to mock the StockServiceRepository.ShipStockTransfer method
+func (mmShipStockTransfer *mStockServiceRepositoryMockShipStockTransfer) Set(f func(ctx context.Context, transfer domain.StockTransfer, receiveImmediately bool) (s1 domain.StockTransferResult, err error)) *StockServiceRepositoryMock {
+	if mmShipStockTransfer.defaultExpectation != nil {
+		mmShipStockTransfer.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.ShipStockTransfer method")
 	}
 
-	if len(mmGetStockItemBySku.expectations) > 0 {
-		mmGetStockItemBySku.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.GetStockItemBySku method")
+	if len(mmShipStockTransfer.expectations) > 0 {
+		mmShipStockTransfer.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.ShipStockTransfer method")
 	}
 
-	mmGetStockItemBySku.mock.funcGetStockItemBySku = f
-	mmGetStockItemBySku.mock.funcGetStockItemBySkuOrigin = minimock.CallerInfo(1)
-	return mmGetStockItemBySku.mock
+	mmShipStockTransfer.mock.funcShipStockTransfer = f
+	mmShipStockTransfer.mock.funcShipStockTransferOrigin = minimock.CallerInfo(1)
+	return mmShipStockTransfer.mock
 }
 
-// When sets expectation for the StockServiceRepository.GetStockItemBySku which will trigger the result defined by the following
+// When sets expectation for the StockServiceRepository.ShipStockTransfer which will trigger the result defined by the following
 // Then helper
-func (mmGetStockItemBySku *mStockServiceRepositoryMockGetStockItemBySku) When(ctx context.Context, skuID domain.SKUID) *StockServiceRepositoryMockGetStockItemBySkuExpectation {
-	if mmGetStockItemBySku.mock.funcGetStockItemBySku != nil {
-		mmGetStockItemBySku.mock.t.Fatalf("StockServiceRepositoryMock.GetStockItemBySku mock is already set by Set")
+func (mmShipStockTransfer *mStockServiceRepositoryMockShipStockTransfer) When(ctx context.Context, transfer domain.StockTransfer, receiveImmediately bool) *StockServiceRepositoryMockShipStockTransferExpectation {
+	if mmShipStockTransfer.mock.funcShipStockTransfer != nil {
+		mmShipStockTransfer.mock.t.Fatalf("StockServiceRepositoryMock.ShipStockTransfer mock is already set by Set")
 	}
 
-	expectation := &StockServiceRepositoryMockGetStockItemBySkuExpectation{
-		mock:               mmGetStockItemBySku.mock,
-		params:             &StockServiceRepositoryMockGetStockItemBySkuParams{ctx, skuID},
-		expectationOrigins: StockServiceRepositoryMockGetStockItemBySkuExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &StockServiceRepositoryMockShipStockTransferExpectation{
+		mock:               mmShipStockTransfer.mock,
+		params:             &StockServiceRepositoryMockShipStockTransferParams{ctx, transfer, receiveImmediately},
+		expectationOrigins: StockServiceRepositoryMockShipStockTransferExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetStockItemBySku.expectations = append(mmGetStockItemBySku.expectations, expectation)
+	mmShipStockTransfer.expectations = append(mmShipStockTransfer.expectations, expectation)
 	return expectation
 }
 
-// Then sets up StockServiceRepository.GetStockItemBySku return parameters for the expectation previously defined by the When method
-func (e *StockServiceRepositoryMockGetStockItemBySkuExpectation) Then(s1 domain.StockItem, err error) *StockServiceRepositoryMock {
-	e.results = &StockServiceRepositoryMockGetStockItemBySkuResults{s1, err}
+// Then sets up StockServiceRepository.ShipStockTransfer return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockShipStockTransferExpectation) Then(s1 domain.StockTransferResult, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockShipStockTransferResults{s1, err}
 	return e.mock
 }
 
-// Times sets number of times StockServiceRepository.GetStockItemBySku should be invoked
-func (mmGetStockItemBySku *mStockServiceRepositoryMockGetStockItemBySku) Times(n uint64) *mStockServiceRepositoryMockGetStockItemBySku {
+// Times sets number of times StockServiceRepository.ShipStockTransfer should be invoked
+func (mmShipStockTransfer *mStockServiceRepositoryMockShipStockTransfer) Times(n uint64) *mStockServiceRepositoryMockShipStockTransfer {
 	if n == 0 {
-		mmGetStockItemBySku.mock.t.Fatalf("Times of StockServiceRepositoryMock.GetStockItemBySku mock can not be zero")
+		mmShipStockTransfer.mock.t.Fatalf("Times of StockServiceRepositoryMock.ShipStockTransfer mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetStockItemBySku.expectedInvocations, n)
-	mmGetStockItemBySku.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetStockItemBySku
+	mm_atomic.StoreUint64(&mmShipStockTransfer.expectedInvocations, n)
+	mmShipStockTransfer.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmShipStockTransfer
 }
 
-func (mmGetStockItemBySku *mStockServiceRepositoryMockGetStockItemBySku) invocationsDone() bool {
-	if len(mmGetStockItemBySku.expectations) == 0 && mmGetStockItemBySku.defaultExpectation == nil && mmGetStockItemBySku.mock.funcGetStockItemBySku == nil {
+func (mmShipStockTransfer *mStockServiceRepositoryMockShipStockTransfer) invocationsDone() bool {
+	if len(mmShipStockTransfer.expectations) == 0 && mmShipStockTransfer.defaultExpectation == nil && mmShipStockTransfer.mock.funcShipStockTransfer == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetStockItemBySku.mock.afterGetStockItemBySkuCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetStockItemBySku.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmShipStockTransfer.mock.afterShipStockTransferCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmShipStockTransfer.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetStockItemBySku implements mm_stocks.StockServiceRepository
-func (mmGetStockItemBySku *StockServiceRepositoryMock) GetStockItemBySku(ctx context.Context, skuID domain.SKUID) (s1 domain.StockItem, err error) {
-	mm_atomic.AddUint64(&mmGetStockItemBySku.beforeGetStockItemBySkuCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetStockItemBySku.afterGetStockItemBySkuCounter, 1)
+// ShipStockTransfer implements mm_stocks.StockServiceRepository
+func (mmShipStockTransfer *StockServiceRepositoryMock) ShipStockTransfer(ctx context.Context, transfer domain.StockTransfer, receiveImmediately bool) (s1 domain.StockTransferResult, err error) {
+	mm_atomic.AddUint64(&mmShipStockTransfer.beforeShipStockTransferCounter, 1)
+	defer mm_atomic.AddUint64(&mmShipStockTransfer.afterShipStockTransferCounter, 1)
 
-	mmGetStockItemBySku.t.Helper()
+	mmShipStockTransfer.t.Helper()
 
-	if mmGetStockItemBySku.inspectFuncGetStockItemBySku != nil {
-		mmGetStockItemBySku.inspectFuncGetStockItemBySku(ctx, skuID)
+	if mmShipStockTransfer.inspectFuncShipStockTransfer != nil {
+		mmShipStockTransfer.inspectFuncShipStockTransfer(ctx, transfer, receiveImmediately)
 	}
 
-	mm_params := StockServiceRepositoryMockGetStockItemBySkuParams{ctx, skuID}
+	mm_params := StockServiceRepositoryMockShipStockTransferParams{ctx, transfer, receiveImmediately}
 
 	// Record call args
-	mmGetStockItemBySku.GetStockItemBySkuMock.mutex.Lock()
-	mmGetStockItemBySku.GetStockItemBySkuMock.callArgs = append(mmGetStockItemBySku.GetStockItemBySkuMock.callArgs, &mm_params)
-	mmGetStockItemBySku.GetStockItemBySkuMock.mutex.Unlock()
+	mmShipStockTransfer.ShipStockTransferMock.mutex.Lock()
+	mmShipStockTransfer.ShipStockTransferMock.callArgs = append(mmShipStockTransfer.ShipStockTransferMock.callArgs, &mm_params)
+	mmShipStockTransfer.ShipStockTransferMock.mutex.Unlock()
 
-	for _, e := range mmGetStockItemBySku.GetStockItemBySkuMock.expectations {
+	for _, e := range mmShipStockTransfer.ShipStockTransferMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.s1, e.results.err
 		}
 	}
 
-	if mmGetStockItemBySku.GetStockItemBySkuMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetStockItemBySku.GetStockItemBySkuMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetStockItemBySku.GetStockItemBySkuMock.defaultExpectation.params
-		mm_want_ptrs := mmGetStockItemBySku.GetStockItemBySkuMock.defaultExpectation.paramPtrs
+	if mmShipStockTransfer.ShipStockTransferMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmShipStockTransfer.ShipStockTransferMock.defaultExpectation.Counter, 1)
+		mm_want := mmShipStockTransfer.ShipStockTransferMock.defaultExpectation.params
+		mm_want_ptrs := mmShipStockTransfer.ShipStockTransferMock.defaultExpectation.paramPtrs
 
-		mm_got := StockServiceRepositoryMockGetStockItemBySkuParams{ctx, skuID}
+		mm_got := StockServiceRepositoryMockShipStockTransferParams{ctx, transfer, receiveImmediately}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetStockItemBySku.t.Errorf("StockServiceRepositoryMock.GetStockItemBySku got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetStockItemBySku.GetStockItemBySkuMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmShipStockTransfer.t.Errorf("StockServiceRepositoryMock.ShipStockTransfer got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmShipStockTransfer.ShipStockTransferMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
-				mmGetStockItemBySku.t.Errorf("StockServiceRepositoryMock.GetStockItemBySku got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetStockItemBySku.GetStockItemBySkuMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			if mm_want_ptrs.transfer != nil && !minimock.Equal(*mm_want_ptrs.transfer, mm_got.transfer) {
+				mmShipStockTransfer.t.Errorf("StockServiceRepositoryMock.ShipStockTransfer got unexpected parameter transfer, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmShipStockTransfer.ShipStockTransferMock.defaultExpectation.expectationOrigins.originTransfer, *mm_want_ptrs.transfer, mm_got.transfer, minimock.Diff(*mm_want_ptrs.transfer, mm_got.transfer))
+			}
+
+			if mm_want_ptrs.receiveImmediately != nil && !minimock.Equal(*mm_want_ptrs.receiveImmediately, mm_got.receiveImmediately) {
+				mmShipStockTransfer.t.Errorf("StockServiceRepositoryMock.ShipStockTransfer got unexpected parameter receiveImmediately, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmShipStockTransfer.ShipStockTransferMock.defaultExpectation.expectationOrigins.originReceiveImmediately, *mm_want_ptrs.receiveImmediately, mm_got.receiveImmediately, minimock.Diff(*mm_want_ptrs.receiveImmediately, mm_got.receiveImmediately))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetStockItemBySku.t.Errorf("StockServiceRepositoryMock.GetStockItemBySku got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetStockItemBySku.GetStockItemBySkuMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmShipStockTransfer.t.Errorf("StockServiceRepositoryMock.ShipStockTransfer got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmShipStockTransfer.ShipStockTransferMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetStockItemBySku.GetStockItemBySkuMock.defaultExpectation.results
+		mm_results := mmShipStockTransfer.ShipStockTransferMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetStockItemBySku.t.Fatal("No results are set for the StockServiceRepositoryMock.GetStockItemBySku")
+			mmShipStockTransfer.t.Fatal("No results are set for the StockServiceRepositoryMock.ShipStockTransfer")
 		}
 		return (*mm_results).s1, (*mm_results).err
 	}
-	if mmGetStockItemBySku.funcGetStockItemBySku != nil {
-		return mmGetStockItemBySku.funcGetStockItemBySku(ctx, skuID)
+	if mmShipStockTransfer.funcShipStockTransfer != nil {
+		return mmShipStockTransfer.funcShipStockTransfer(ctx, transfer, receiveImmediately)
 	}
-	mmGetStockItemBySku.t.Fatalf("Unexpected call to StockServiceRepositoryMock.GetStockItemBySku. %v %v", ctx, skuID)
+	mmShipStockTransfer.t.Fatalf("Unexpected call to StockServiceRepositoryMock.ShipStockTransfer. %v %v %v", ctx, transfer, receiveImmediately)
 	return
 }
 
-// GetStockItemBySkuAfterCounter returns a count of finished StockServiceRepositoryMock.GetStockItemBySku invocations
-func (mmGetStockItemBySku *StockServiceRepositoryMock) GetStockItemBySkuAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetStockItemBySku.afterGetStockItemBySkuCounter)
+// ShipStockTransferAfterCounter returns a count of finished StockServiceRepositoryMock.ShipStockTransfer invocations
+func (mmShipStockTransfer *StockServiceRepositoryMock) ShipStockTransferAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmShipStockTransfer.afterShipStockTransferCounter)
 }
 
-// GetStockItemBySkuBeforeCounter returns a count of StockServiceRepositoryMock.GetStockItemBySku invocations
-func (mmGetStockItemBySku *StockServiceRepositoryMock) GetStockItemBySkuBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetStockItemBySku.beforeGetStockItemBySkuCounter)
+// ShipStockTransferBeforeCounter returns a count of StockServiceRepositoryMock.ShipStockTransfer invocations
+func (mmShipStockTransfer *StockServiceRepositoryMock) ShipStockTransferBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmShipStockTransfer.beforeShipStockTransferCounter)
 }
 
-// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.GetStockItemBySku.
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.ShipStockTransfer.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetStockItemBySku *mStockServiceRepositoryMockGetStockItemBySku) Calls() []*StockServiceRepositoryMockGetStockItemBySkuParams {
-	mmGetStockItemBySku.mutex.RLock()
+func (mmShipStockTransfer *mStockServiceRepositoryMockShipStockTransfer) Calls() []*StockServiceRepositoryMockShipStockTransferParams {
+	mmShipStockTransfer.mutex.RLock()
 
-	argCopy := make([]*StockServiceRepositoryMockGetStockItemBySkuParams, len(mmGetStockItemBySku.callArgs))
-	copy(argCopy, mmGetStockItemBySku.callArgs)
+	argCopy := make([]*StockServiceRepositoryMockShipStockTransferParams, len(mmShipStockTransfer.callArgs))
+	copy(argCopy, mmShipStockTransfer.callArgs)
 
-	mmGetStockItemBySku.mutex.RUnlock()
+	mmShipStockTransfer.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetStockItemBySkuDone returns true if the count of the GetStockItemBySku invocations corresponds
+// MinimockShipStockTransferDone returns true if the count of the ShipStockTransfer invocations corresponds
 // the number of defined expectations
-func (m *StockServiceRepositoryMock) MinimockGetStockItemBySkuDone() bool {
-	if m.GetStockItemBySkuMock.optional {
+func (m *StockServiceRepositoryMock) MinimockShipStockTransferDone() bool {
+	if m.ShipStockTransferMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetStockItemBySkuMock.expectations {
+	for _, e := range m.ShipStockTransferMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetStockItemBySkuMock.invocationsDone()
+	return m.ShipStockTransferMock.invocationsDone()
 }
 
-// MinimockGetStockItemBySkuInspect logs each unmet expectation
-func (m *StockServiceRepositoryMock) MinimockGetStockItemBySkuInspect() {
-	for _, e := range m.GetStockItemBySkuMock.expectations {
+// MinimockShipStockTransferInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockShipStockTransferInspect() {
+	for _, e := range m.ShipStockTransferMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StockServiceRepositoryMock.GetStockItemBySku at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ShipStockTransfer at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetStockItemBySkuCounter := mm_atomic.LoadUint64(&m.afterGetStockItemBySkuCounter)
+	afterShipStockTransferCounter := mm_atomic.LoadUint64(&m.afterShipStockTransferCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetStockItemBySkuMock.defaultExpectation != nil && afterGetStockItemBySkuCounter < 1 {
-		if m.GetStockItemBySkuMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to StockServiceRepositoryMock.GetStockItemBySku at\n%s", m.GetStockItemBySkuMock.defaultExpectation.returnOrigin)
+	if m.ShipStockTransferMock.defaultExpectation != nil && afterShipStockTransferCounter < 1 {
+		if m.ShipStockTransferMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ShipStockTransfer at\n%s", m.ShipStockTransferMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to StockServiceRepositoryMock.GetStockItemBySku at\n%s with params: %#v", m.GetStockItemBySkuMock.defaultExpectation.expectationOrigins.origin, *m.GetStockItemBySkuMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.ShipStockTransfer at\n%s with params: %#v", m.ShipStockTransferMock.defaultExpectation.expectationOrigins.origin, *m.ShipStockTransferMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetStockItemBySku != nil && afterGetStockItemBySkuCounter < 1 {
-		m.t.Errorf("Expected call to StockServiceRepositoryMock.GetStockItemBySku at\n%s", m.funcGetStockItemBySkuOrigin)
+	if m.funcShipStockTransfer != nil && afterShipStockTransferCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.ShipStockTransfer at\n%s", m.funcShipStockTransferOrigin)
 	}
 
-	if !m.GetStockItemBySkuMock.invocationsDone() && afterGetStockItemBySkuCounter > 0 {
-		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.GetStockItemBySku at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetStockItemBySkuMock.expectedInvocations), m.GetStockItemBySkuMock.expectedInvocationsOrigin, afterGetStockItemBySkuCounter)
+	if !m.ShipStockTransferMock.invocationsDone() && afterShipStockTransferCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.ShipStockTransfer at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ShipStockTransferMock.expectedInvocations), m.ShipStockTransferMock.expectedInvocationsOrigin, afterShipStockTransferCounter)
 	}
 }
 
-type mStockServiceRepositoryMockListStockItemsByLocation struct {
+type mStockServiceRepositoryMockUpdateBackorderSettings struct {
 	optional           bool
 	mock               *StockServiceRepositoryMock
-	defaultExpectation *StockServiceRepositoryMockListStockItemsByLocationExpectation
-	expectations       []*StockServiceRepositoryMockListStockItemsByLocationExpectation
+	defaultExpectation *StockServiceRepositoryMockUpdateBackorderSettingsExpectation
+	expectations       []*StockServiceRepositoryMockUpdateBackorderSettingsExpectation
 
-	callArgs []*StockServiceRepositoryMockListStockItemsByLocationParams
+	callArgs []*StockServiceRepositoryMockUpdateBackorderSettingsParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// StockServiceRepositoryMockListStockItemsByLocationExpectation specifies expectation struct of the StockServiceRepository.ListStockItemsByLocation
-type StockServiceRepositoryMockListStockItemsByLocationExpectation struct {
+// StockServiceRepositoryMockUpdateBackorderSettingsExpectation specifies expectation struct of the StockServiceRepository.UpdateBackorderSettings
+type StockServiceRepositoryMockUpdateBackorderSettingsExpectation struct {
 	mock               *StockServiceRepositoryMock
-	params             *StockServiceRepositoryMockListStockItemsByLocationParams
-	paramPtrs          *StockServiceRepositoryMockListStockItemsByLocationParamPtrs
-	expectationOrigins StockServiceRepositoryMockListStockItemsByLocationExpectationOrigins
-	results            *StockServiceRepositoryMockListStockItemsByLocationResults
+	params             *StockServiceRepositoryMockUpdateBackorderSettingsParams
+	paramPtrs          *StockServiceRepositoryMockUpdateBackorderSettingsParamPtrs
+	expectationOrigins StockServiceRepositoryMockUpdateBackorderSettingsExpectationOrigins
+	results            *StockServiceRepositoryMockUpdateBackorderSettingsResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// StockServiceRepositoryMockListStockItemsByLocationParams contains parameters of the StockServiceRepository.ListStockItemsByLocation
-type StockServiceRepositoryMockListStockItemsByLocationParams struct {
-	ctx    context.Context
-	filter domain.Filter
+// StockServiceRepositoryMockUpdateBackorderSettingsParams contains parameters of the StockServiceRepository.UpdateBackorderSettings
+type StockServiceRepositoryMockUpdateBackorderSettingsParams struct {
+	ctx      context.Context
+	settings domain.BackorderSettings
 }
 
-// StockServiceRepositoryMockListStockItemsByLocationParamPtrs contains pointers to parameters of the StockServiceRepository.ListStockItemsByLocation
-type StockServiceRepositoryMockListStockItemsByLocationParamPtrs struct {
-	ctx    *context.Context
-	filter *domain.Filter
+// StockServiceRepositoryMockUpdateBackorderSettingsParamPtrs contains pointers to parameters of the StockServiceRepository.UpdateBackorderSettings
+type StockServiceRepositoryMockUpdateBackorderSettingsParamPtrs struct {
+	ctx      *context.Context
+	settings *domain.BackorderSettings
 }
 
-// StockServiceRepositoryMockListStockItemsByLocationResults contains results of the StockServiceRepository.ListStockItemsByLocation
-type StockServiceRepositoryMockListStockItemsByLocationResults struct {
-	sa1 []domain.StockItem
+// StockServiceRepositoryMockUpdateBackorderSettingsResults contains results of the StockServiceRepository.UpdateBackorderSettings
+type StockServiceRepositoryMockUpdateBackorderSettingsResults struct {
 	err error
 }
 
-// StockServiceRepositoryMockListStockItemsByLocationOrigins contains origins of expectations of the StockServiceRepository.ListStockItemsByLocation
-type StockServiceRepositoryMockListStockItemsByLocationExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originFilter string
+// StockServiceRepositoryMockUpdateBackorderSettingsOrigins contains origins of expectations of the StockServiceRepository.UpdateBackorderSettings
+type StockServiceRepositoryMockUpdateBackorderSettingsExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originSettings string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1619,341 +10722,342 @@ type StockServiceRepositoryMockListStockItemsByLocationExpectationOrigins struct
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmListStockItemsByLocation *mStockServiceRepositoryMockListStockItemsByLocation) Optional() *mStockServiceRepositoryMockListStockItemsByLocation {
-	mmListStockItemsByLocation.optional = true
-	return mmListStockItemsByLocation
+func (mmUpdateBackorderSettings *mStockServiceRepositoryMockUpdateBackorderSettings) Optional() *mStockServiceRepositoryMockUpdateBackorderSettings {
+	mmUpdateBackorderSettings.optional = true
+	return mmUpdateBackorderSettings
 }
 
-// Expect sets up expected params for StockServiceRepository.ListStockItemsByLocation
-func (mmListStockItemsByLocation *mStockServiceRepositoryMockListStockItemsByLocation) Expect(ctx context.Context, filter domain.Filter) *mStockServiceRepositoryMockListStockItemsByLocation {
-	if mmListStockItemsByLocation.mock.funcListStockItemsByLocation != nil {
-		mmListStockItemsByLocation.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemsByLocation mock is already set by Set")
+// Expect sets up expected params for StockServiceRepository.UpdateBackorderSettings
+func (mmUpdateBackorderSettings *mStockServiceRepositoryMockUpdateBackorderSettings) Expect(ctx context.Context, settings domain.BackorderSettings) *mStockServiceRepositoryMockUpdateBackorderSettings {
+	if mmUpdateBackorderSettings.mock.funcUpdateBackorderSettings != nil {
+		mmUpdateBackorderSettings.mock.t.Fatalf("StockServiceRepositoryMock.UpdateBackorderSettings mock is already set by Set")
 	}
 
-	if mmListStockItemsByLocation.defaultExpectation == nil {
-		mmListStockItemsByLocation.defaultExpectation = &StockServiceRepositoryMockListStockItemsByLocationExpectation{}
+	if mmUpdateBackorderSettings.defaultExpectation == nil {
+		mmUpdateBackorderSettings.defaultExpectation = &StockServiceRepositoryMockUpdateBackorderSettingsExpectation{}
 	}
 
-	if mmListStockItemsByLocation.defaultExpectation.paramPtrs != nil {
-		mmListStockItemsByLocation.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemsByLocation mock is already set by ExpectParams functions")
+	if mmUpdateBackorderSettings.defaultExpectation.paramPtrs != nil {
+		mmUpdateBackorderSettings.mock.t.Fatalf("StockServiceRepositoryMock.UpdateBackorderSettings mock is already set by ExpectParams functions")
 	}
 
-	mmListStockItemsByLocation.defaultExpectation.params = &StockServiceRepositoryMockListStockItemsByLocationParams{ctx, filter}
-	mmListStockItemsByLocation.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmListStockItemsByLocation.expectations {
-		if minimock.Equal(e.params, mmListStockItemsByLocation.defaultExpectation.params) {
-			mmListStockItemsByLocation.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListStockItemsByLocation.defaultExpectation.params)
+	mmUpdateBackorderSettings.defaultExpectation.params = &StockServiceRepositoryMockUpdateBackorderSettingsParams{ctx, settings}
+	mmUpdateBackorderSettings.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateBackorderSettings.expectations {
+		if minimock.Equal(e.params, mmUpdateBackorderSettings.defaultExpectation.params) {
+			mmUpdateBackorderSettings.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateBackorderSettings.defaultExpectation.params)
 		}
 	}
 
-	return mmListStockItemsByLocation
+	return mmUpdateBackorderSettings
 }
 
-// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.ListStockItemsByLocation
-func (mmListStockItemsByLocation *mStockServiceRepositoryMockListStockItemsByLocation) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockListStockItemsByLocation {
-	if mmListStockItemsByLocation.mock.funcListStockItemsByLocation != nil {
-		mmListStockItemsByLocation.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemsByLocation mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.UpdateBackorderSettings
+func (mmUpdateBackorderSettings *mStockServiceRepositoryMockUpdateBackorderSettings) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockUpdateBackorderSettings {
+	if mmUpdateBackorderSettings.mock.funcUpdateBackorderSettings != nil {
+		mmUpdateBackorderSettings.mock.t.Fatalf("StockServiceRepositoryMock.UpdateBackorderSettings mock is already set by Set")
 	}
 
-	if mmListStockItemsByLocation.defaultExpectation == nil {
-		mmListStockItemsByLocation.defaultExpectation = &StockServiceRepositoryMockListStockItemsByLocationExpectation{}
+	if mmUpdateBackorderSettings.defaultExpectation == nil {
+		mmUpdateBackorderSettings.defaultExpectation = &StockServiceRepositoryMockUpdateBackorderSettingsExpectation{}
 	}
 
-	if mmListStockItemsByLocation.defaultExpectation.params != nil {
-		mmListStockItemsByLocation.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemsByLocation mock is already set by Expect")
+	if mmUpdateBackorderSettings.defaultExpectation.params != nil {
+		mmUpdateBackorderSettings.mock.t.Fatalf("StockServiceRepositoryMock.UpdateBackorderSettings mock is already set by Expect")
 	}
 
-	if mmListStockItemsByLocation.defaultExpectation.paramPtrs == nil {
-		mmListStockItemsByLocation.defaultExpectation.paramPtrs = &StockServiceRepositoryMockListStockItemsByLocationParamPtrs{}
+	if mmUpdateBackorderSettings.defaultExpectation.paramPtrs == nil {
+		mmUpdateBackorderSettings.defaultExpectation.paramPtrs = &StockServiceRepositoryMockUpdateBackorderSettingsParamPtrs{}
 	}
-	mmListStockItemsByLocation.defaultExpectation.paramPtrs.ctx = &ctx
-	mmListStockItemsByLocation.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmUpdateBackorderSettings.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateBackorderSettings.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmListStockItemsByLocation
+	return mmUpdateBackorderSettings
 }
 
-// ExpectFilterParam2 sets up expected param filter for StockServiceRepository.ListStockItemsByLocation
-func (mmListStockItemsByLocation *mStockServiceRepositoryMockListStockItemsByLocation) ExpectFilterParam2(filter domain.Filter) *mStockServiceRepositoryMockListStockItemsByLocation {
-	if mmListStockItemsByLocation.mock.funcListStockItemsByLocation != nil {
-		mmListStockItemsByLocation.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemsByLocation mock is already set by Set")
+// ExpectSettingsParam2 sets up expected param settings for StockServiceRepository.UpdateBackorderSettings
+func (mmUpdateBackorderSettings *mStockServiceRepositoryMockUpdateBackorderSettings) ExpectSettingsParam2(settings domain.BackorderSettings) *mStockServiceRepositoryMockUpdateBackorderSettings {
+	if mmUpdateBackorderSettings.mock.funcUpdateBackorderSettings != nil {
+		mmUpdateBackorderSettings.mock.t.Fatalf("StockServiceRepositoryMock.UpdateBackorderSettings mock is already set by Set")
 	}
 
-	if mmListStockItemsByLocation.defaultExpectation == nil {
-		mmListStockItemsByLocation.defaultExpectation = &StockServiceRepositoryMockListStockItemsByLocationExpectation{}
+	if mmUpdateBackorderSettings.defaultExpectation == nil {
+		mmUpdateBackorderSettings.defaultExpectation = &StockServiceRepositoryMockUpdateBackorderSettingsExpectation{}
 	}
 
-	if mmListStockItemsByLocation.defaultExpectation.params != nil {
-		mmListStockItemsByLocation.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemsByLocation mock is already set by Expect")
+	if mmUpdateBackorderSettings.defaultExpectation.params != nil {
+		mmUpdateBackorderSettings.mock.t.Fatalf("StockServiceRepositoryMock.UpdateBackorderSettings mock is already set by Expect")
 	}
 
-	if mmListStockItemsByLocation.defaultExpectation.paramPtrs == nil {
-		mmListStockItemsByLocation.defaultExpectation.paramPtrs = &StockServiceRepositoryMockListStockItemsByLocationParamPtrs{}
+	if mmUpdateBackorderSettings.defaultExpectation.paramPtrs == nil {
+		mmUpdateBackorderSettings.defaultExpectation.paramPtrs = &StockServiceRepositoryMockUpdateBackorderSettingsParamPtrs{}
 	}
-	mmListStockItemsByLocation.defaultExpectation.paramPtrs.filter = &filter
-	mmListStockItemsByLocation.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+	mmUpdateBackorderSettings.defaultExpectation.paramPtrs.settings = &settings
+	mmUpdateBackorderSettings.defaultExpectation.expectationOrigins.originSettings = minimock.CallerInfo(1)
 
-	return mmListStockItemsByLocation
+	return mmUpdateBackorderSettings
 }
 
-// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.ListStockItemsByLocation
-func (mmListStockItemsByLocation *mStockServiceRepositoryMockListStockItemsByLocation) Inspect(f func(ctx context.Context, filter domain.Filter)) *mStockServiceRepositoryMockListStockItemsByLocation {
-	if mmListStockItemsByLocation.mock.inspectFuncListStockItemsByLocation != nil {
-		mmListStockItemsByLocation.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.ListStockItemsByLocation")
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.UpdateBackorderSettings
+func (mmUpdateBackorderSettings *mStockServiceRepositoryMockUpdateBackorderSettings) Inspect(f func(ctx context.Context, settings domain.BackorderSettings)) *mStockServiceRepositoryMockUpdateBackorderSettings {
+	if mmUpdateBackorderSettings.mock.inspectFuncUpdateBackorderSettings != nil {
+		mmUpdateBackorderSettings.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.UpdateBackorderSettings")
 	}
 
-	mmListStockItemsByLocation.mock.inspectFuncListStockItemsByLocation = f
+	mmUpdateBackorderSettings.mock.inspectFuncUpdateBackorderSettings = f
 
-	return mmListStockItemsByLocation
+	return mmUpdateBackorderSettings
 }
 
-// Return sets up results that will be returned by StockServiceRepository.ListStockItemsByLocation
-func (mmListStockItemsByLocation *mStockServiceRepositoryMockListStockItemsByLocation) Return(sa1 []domain.StockItem, err error) *StockServiceRepositoryMock {
-	if mmListStockItemsByLocation.mock.funcListStockItemsByLocation != nil {
-		mmListStockItemsByLocation.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemsByLocation mock is already set by Set")
+// Return sets up results that will be returned by StockServiceRepository.UpdateBackorderSettings
+func (mmUpdateBackorderSettings *mStockServiceRepositoryMockUpdateBackorderSettings) Return(err error) *StockServiceRepositoryMock {
+	if mmUpdateBackorderSettings.mock.funcUpdateBackorderSettings != nil {
+		mmUpdateBackorderSettings.mock.t.Fatalf("StockServiceRepositoryMock.UpdateBackorderSettings mock is already set by Set")
 	}
 
-	if mmListStockItemsByLocation.defaultExpectation == nil {
-		mmListStockItemsByLocation.defaultExpectation = &StockServiceRepositoryMockListStockItemsByLocationExpectation{mock: mmListStockItemsByLocation.mock}
+	if mmUpdateBackorderSettings.defaultExpectation == nil {
+		mmUpdateBackorderSettings.defaultExpectation = &StockServiceRepositoryMockUpdateBackorderSettingsExpectation{mock: mmUpdateBackorderSettings.mock}
 	}
-	mmListStockItemsByLocation.defaultExpectation.results = &StockServiceRepositoryMockListStockItemsByLocationResults{sa1, err}
-	mmListStockItemsByLocation.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmListStockItemsByLocation.mock
+	mmUpdateBackorderSettings.defaultExpectation.results = &StockServiceRepositoryMockUpdateBackorderSettingsResults{err}
+	mmUpdateBackorderSettings.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateBackorderSettings.mock
 }
 
-// Set uses given function f to mock the StockServiceRepository.ListStockItemsByLocation method
-func (mmListStockItemsByLocation *mStockServiceRepositoryMockListStockItemsByLocation) Set(f func(ctx context.Context, filter domain.Filter) (sa1 []domain.StockItem, err error)) *StockServiceRepositoryMock {
-	if mmListStockItemsByLocation.defaultExpectation != nil {
-		mmListStockItemsByLocation.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.ListStockItemsByLocation method")
+// Set uses given function f to mock the StockServiceRepository.UpdateBackorderSettings method
+func (mmUpdateBackorderSettings *mStockServiceRepositoryMockUpdateBackorderSettings) Set(f func(ctx context.Context, settings domain.BackorderSettings) (err error)) *StockServiceRepositoryMock {
+	if mmUpdateBackorderSettings.defaultExpectation != nil {
+		mmUpdateBackorderSettings.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.UpdateBackorderSettings method")
 	}
 
-	if len(mmListStockItemsByLocation.expectations) > 0 {
-		mmListStockItemsByLocation.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.ListStockItemsByLocation method")
+	if len(mmUpdateBackorderSettings.expectations) > 0 {
+		mmUpdateBackorderSettings.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.UpdateBackorderSettings method")
 	}
 
-	mmListStockItemsByLocation.mock.funcListStockItemsByLocation = f
-	mmListStockItemsByLocation.mock.funcListStockItemsByLocationOrigin = minimock.CallerInfo(1)
-	return mmListStockItemsByLocation.mock
+	mmUpdateBackorderSettings.mock.funcUpdateBackorderSettings = f
+	mmUpdateBackorderSettings.mock.funcUpdateBackorderSettingsOrigin = minimock.CallerInfo(1)
+	return mmUpdateBackorderSettings.mock
 }
 
-// When sets expectation for the StockServiceRepository.ListStockItemsByLocation which will trigger the result defined by the following
+// When sets expectation for the StockServiceRepository.UpdateBackorderSettings which will trigger the result defined by the following
 // Then helper
-func (mmListStockItemsByLocation *mStockServiceRepositoryMockListStockItemsByLocation) When(ctx context.Context, filter domain.Filter) *StockServiceRepositoryMockListStockItemsByLocationExpectation {
-	if mmListStockItemsByLocation.mock.funcListStockItemsByLocation != nil {
-		mmListStockItemsByLocation.mock.t.Fatalf("StockServiceRepositoryMock.ListStockItemsByLocation mock is already set by Set")
+func (mmUpdateBackorderSettings *mStockServiceRepositoryMockUpdateBackorderSettings) When(ctx context.Context, settings domain.BackorderSettings) *StockServiceRepositoryMockUpdateBackorderSettingsExpectation {
+	if mmUpdateBackorderSettings.mock.funcUpdateBackorderSettings != nil {
+		mmUpdateBackorderSettings.mock.t.Fatalf("StockServiceRepositoryMock.UpdateBackorderSettings mock is already set by Set")
 	}
 
-	expectation := &StockServiceRepositoryMockListStockItemsByLocationExpectation{
-		mock:               mmListStockItemsByLocation.mock,
-		params:             &StockServiceRepositoryMockListStockItemsByLocationParams{ctx, filter},
-		expectationOrigins: StockServiceRepositoryMockListStockItemsByLocationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &StockServiceRepositoryMockUpdateBackorderSettingsExpectation{
+		mock:               mmUpdateBackorderSettings.mock,
+		params:             &StockServiceRepositoryMockUpdateBackorderSettingsParams{ctx, settings},
+		expectationOrigins: StockServiceRepositoryMockUpdateBackorderSettingsExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmListStockItemsByLocation.expectations = append(mmListStockItemsByLocation.expectations, expectation)
+	mmUpdateBackorderSettings.expectations = append(mmUpdateBackorderSettings.expectations, expectation)
 	return expectation
 }
 
-// Then sets up StockServiceRepository.ListStockItemsByLocation return parameters for the expectation previously defined by the When method
-func (e *StockServiceRepositoryMockListStockItemsByLocationExpectation) Then(sa1 []domain.StockItem, err error) *StockServiceRepositoryMock {
-	e.results = &StockServiceRepositoryMockListStockItemsByLocationResults{sa1, err}
+// Then sets up StockServiceRepository.UpdateBackorderSettings return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockUpdateBackorderSettingsExpectation) Then(err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockUpdateBackorderSettingsResults{err}
 	return e.mock
 }
 
-// Times sets number of times StockServiceRepository.ListStockItemsByLocation should be invoked
-func (mmListStockItemsByLocation *mStockServiceRepositoryMockListStockItemsByLocation) Times(n uint64) *mStockServiceRepositoryMockListStockItemsByLocation {
+// Times sets number of times StockServiceRepository.UpdateBackorderSettings should be invoked
+func (mmUpdateBackorderSettings *mStockServiceRepositoryMockUpdateBackorderSettings) Times(n uint64) *mStockServiceRepositoryMockUpdateBackorderSettings {
 	if n == 0 {
-		mmListStockItemsByLocation.mock.t.Fatalf("Times of StockServiceRepositoryMock.ListStockItemsByLocation mock can not be zero")
+		mmUpdateBackorderSettings.mock.t.Fatalf("Times of StockServiceRepositoryMock.UpdateBackorderSettings mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmListStockItemsByLocation.expectedInvocations, n)
-	mmListStockItemsByLocation.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmListStockItemsByLocation
+	mm_atomic.StoreUint64(&mmUpdateBackorderSettings.expectedInvocations, n)
+	mmUpdateBackorderSettings.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateBackorderSettings
 }
 
-func (mmListStockItemsByLocation *mStockServiceRepositoryMockListStockItemsByLocation) invocationsDone() bool {
-	if len(mmListStockItemsByLocation.expectations) == 0 && mmListStockItemsByLocation.defaultExpectation == nil && mmListStockItemsByLocation.mock.funcListStockItemsByLocation == nil {
+func (mmUpdateBackorderSettings *mStockServiceRepositoryMockUpdateBackorderSettings) invocationsDone() bool {
+	if len(mmUpdateBackorderSettings.expectations) == 0 && mmUpdateBackorderSettings.defaultExpectation == nil && mmUpdateBackorderSettings.mock.funcUpdateBackorderSettings == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmListStockItemsByLocation.mock.afterListStockItemsByLocationCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmListStockItemsByLocation.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateBackorderSettings.mock.afterUpdateBackorderSettingsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateBackorderSettings.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// ListStockItemsByLocation implements mm_stocks.StockServiceRepository
-func (mmListStockItemsByLocation *StockServiceRepositoryMock) ListStockItemsByLocation(ctx context.Context, filter domain.Filter) (sa1 []domain.StockItem, err error) {
-	mm_atomic.AddUint64(&mmListStockItemsByLocation.beforeListStockItemsByLocationCounter, 1)
-	defer mm_atomic.AddUint64(&mmListStockItemsByLocation.afterListStockItemsByLocationCounter, 1)
+// UpdateBackorderSettings implements mm_stocks.StockServiceRepository
+func (mmUpdateBackorderSettings *StockServiceRepositoryMock) UpdateBackorderSettings(ctx context.Context, settings domain.BackorderSettings) (err error) {
+	mm_atomic.AddUint64(&mmUpdateBackorderSettings.beforeUpdateBackorderSettingsCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateBackorderSettings.afterUpdateBackorderSettingsCounter, 1)
 
-	mmListStockItemsByLocation.t.Helper()
+	mmUpdateBackorderSettings.t.Helper()
 
-	if mmListStockItemsByLocation.inspectFuncListStockItemsByLocation != nil {
-		mmListStockItemsByLocation.inspectFuncListStockItemsByLocation(ctx, filter)
+	if mmUpdateBackorderSettings.inspectFuncUpdateBackorderSettings != nil {
+		mmUpdateBackorderSettings.inspectFuncUpdateBackorderSettings(ctx, settings)
 	}
 
-	mm_params := StockServiceRepositoryMockListStockItemsByLocationParams{ctx, filter}
+	mm_params := StockServiceRepositoryMockUpdateBackorderSettingsParams{ctx, settings}
 
 	// Record call args
-	mmListStockItemsByLocation.ListStockItemsByLocationMock.mutex.Lock()
-	mmListStockItemsByLocation.ListStockItemsByLocationMock.callArgs = append(mmListStockItemsByLocation.ListStockItemsByLocationMock.callArgs, &mm_params)
-	mmListStockItemsByLocation.ListStockItemsByLocationMock.mutex.Unlock()
+	mmUpdateBackorderSettings.UpdateBackorderSettingsMock.mutex.Lock()
+	mmUpdateBackorderSettings.UpdateBackorderSettingsMock.callArgs = append(mmUpdateBackorderSettings.UpdateBackorderSettingsMock.callArgs, &mm_params)
+	mmUpdateBackorderSettings.UpdateBackorderSettingsMock.mutex.Unlock()
 
-	for _, e := range mmListStockItemsByLocation.ListStockItemsByLocationMock.expectations {
+	for _, e := range mmUpdateBackorderSettings.UpdateBackorderSettingsMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.sa1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmListStockItemsByLocation.ListStockItemsByLocationMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmListStockItemsByLocation.ListStockItemsByLocationMock.defaultExpectation.Counter, 1)
-		mm_want := mmListStockItemsByLocation.ListStockItemsByLocationMock.defaultExpectation.params
-		mm_want_ptrs := mmListStockItemsByLocation.ListStockItemsByLocationMock.defaultExpectation.paramPtrs
+	if mmUpdateBackorderSettings.UpdateBackorderSettingsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateBackorderSettings.UpdateBackorderSettingsMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateBackorderSettings.UpdateBackorderSettingsMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateBackorderSettings.UpdateBackorderSettingsMock.defaultExpectation.paramPtrs
 
-		mm_got := StockServiceRepositoryMockListStockItemsByLocationParams{ctx, filter}
+		mm_got := StockServiceRepositoryMockUpdateBackorderSettingsParams{ctx, settings}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmListStockItemsByLocation.t.Errorf("StockServiceRepositoryMock.ListStockItemsByLocation got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmListStockItemsByLocation.ListStockItemsByLocationMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpdateBackorderSettings.t.Errorf("StockServiceRepositoryMock.UpdateBackorderSettings got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateBackorderSettings.UpdateBackorderSettingsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
-				mmListStockItemsByLocation.t.Errorf("StockServiceRepositoryMock.ListStockItemsByLocation got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmListStockItemsByLocation.ListStockItemsByLocationMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			if mm_want_ptrs.settings != nil && !minimock.Equal(*mm_want_ptrs.settings, mm_got.settings) {
+				mmUpdateBackorderSettings.t.Errorf("StockServiceRepositoryMock.UpdateBackorderSettings got unexpected parameter settings, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateBackorderSettings.UpdateBackorderSettingsMock.defaultExpectation.expectationOrigins.originSettings, *mm_want_ptrs.settings, mm_got.settings, minimock.Diff(*mm_want_ptrs.settings, mm_got.settings))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmListStockItemsByLocation.t.Errorf("StockServiceRepositoryMock.ListStockItemsByLocation got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmListStockItemsByLocation.ListStockItemsByLocationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateBackorderSettings.t.Errorf("StockServiceRepositoryMock.UpdateBackorderSettings got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateBackorderSettings.UpdateBackorderSettingsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmListStockItemsByLocation.ListStockItemsByLocationMock.defaultExpectation.results
+		mm_results := mmUpdateBackorderSettings.UpdateBackorderSettingsMock.defaultExpectation.results
 		if mm_results == nil {
-			mmListStockItemsByLocation.t.Fatal("No results are set for the StockServiceRepositoryMock.ListStockItemsByLocation")
+			mmUpdateBackorderSettings.t.Fatal("No results are set for the StockServiceRepositoryMock.UpdateBackorderSettings")
 		}
-		return (*mm_results).sa1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmListStockItemsByLocation.funcListStockItemsByLocation != nil {
-		return mmListStockItemsByLocation.funcListStockItemsByLocation(ctx, filter)
+	if mmUpdateBackorderSettings.funcUpdateBackorderSettings != nil {
+		return mmUpdateBackorderSettings.funcUpdateBackorderSettings(ctx, settings)
 	}
-	mmListStockItemsByLocation.t.Fatalf("Unexpected call to StockServiceRepositoryMock.ListStockItemsByLocation. %v %v", ctx, filter)
+	mmUpdateBackorderSettings.t.Fatalf("Unexpected call to StockServiceRepositoryMock.UpdateBackorderSettings. %v %v", ctx, settings)
 	return
 }
 
-// ListStockItemsByLocationAfterCounter returns a count of finished StockServiceRepositoryMock.ListStockItemsByLocation invocations
-func (mmListStockItemsByLocation *StockServiceRepositoryMock) ListStockItemsByLocationAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmListStockItemsByLocation.afterListStockItemsByLocationCounter)
+// UpdateBackorderSettingsAfterCounter returns a count of finished StockServiceRepositoryMock.UpdateBackorderSettings invocations
+func (mmUpdateBackorderSettings *StockServiceRepositoryMock) UpdateBackorderSettingsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateBackorderSettings.afterUpdateBackorderSettingsCounter)
 }
 
-// ListStockItemsByLocationBeforeCounter returns a count of StockServiceRepositoryMock.ListStockItemsByLocation invocations
-func (mmListStockItemsByLocation *StockServiceRepositoryMock) ListStockItemsByLocationBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmListStockItemsByLocation.beforeListStockItemsByLocationCounter)
+// UpdateBackorderSettingsBeforeCounter returns a count of StockServiceRepositoryMock.UpdateBackorderSettings invocations
+func (mmUpdateBackorderSettings *StockServiceRepositoryMock) UpdateBackorderSettingsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateBackorderSettings.beforeUpdateBackorderSettingsCounter)
 }
 
-// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.ListStockItemsByLocation.
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.UpdateBackorderSettings.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmListStockItemsByLocation *mStockServiceRepositoryMockListStockItemsByLocation) Calls() []*StockServiceRepositoryMockListStockItemsByLocationParams {
-	mmListStockItemsByLocation.mutex.RLock()
+func (mmUpdateBackorderSettings *mStockServiceRepositoryMockUpdateBackorderSettings) Calls() []*StockServiceRepositoryMockUpdateBackorderSettingsParams {
+	mmUpdateBackorderSettings.mutex.RLock()
 
-	argCopy := make([]*StockServiceRepositoryMockListStockItemsByLocationParams, len(mmListStockItemsByLocation.callArgs))
-	copy(argCopy, mmListStockItemsByLocation.callArgs)
+	argCopy := make([]*StockServiceRepositoryMockUpdateBackorderSettingsParams, len(mmUpdateBackorderSettings.callArgs))
+	copy(argCopy, mmUpdateBackorderSettings.callArgs)
 
-	mmListStockItemsByLocation.mutex.RUnlock()
+	mmUpdateBackorderSettings.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockListStockItemsByLocationDone returns true if the count of the ListStockItemsByLocation invocations corresponds
+// MinimockUpdateBackorderSettingsDone returns true if the count of the UpdateBackorderSettings invocations corresponds
 // the number of defined expectations
-func (m *StockServiceRepositoryMock) MinimockListStockItemsByLocationDone() bool {
-	if m.ListStockItemsByLocationMock.optional {
+func (m *StockServiceRepositoryMock) MinimockUpdateBackorderSettingsDone() bool {
+	if m.UpdateBackorderSettingsMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ListStockItemsByLocationMock.expectations {
+	for _, e := range m.UpdateBackorderSettingsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ListStockItemsByLocationMock.invocationsDone()
+	return m.UpdateBackorderSettingsMock.invocationsDone()
 }
 
-// MinimockListStockItemsByLocationInspect logs each unmet expectation
-func (m *StockServiceRepositoryMock) MinimockListStockItemsByLocationInspect() {
-	for _, e := range m.ListStockItemsByLocationMock.expectations {
+// MinimockUpdateBackorderSettingsInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockUpdateBackorderSettingsInspect() {
+	for _, e := range m.UpdateBackorderSettingsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StockServiceRepositoryMock.ListStockItemsByLocation at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.UpdateBackorderSettings at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterListStockItemsByLocationCounter := mm_atomic.LoadUint64(&m.afterListStockItemsByLocationCounter)
+	afterUpdateBackorderSettingsCounter := mm_atomic.LoadUint64(&m.afterUpdateBackorderSettingsCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ListStockItemsByLocationMock.defaultExpectation != nil && afterListStockItemsByLocationCounter < 1 {
-		if m.ListStockItemsByLocationMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to StockServiceRepositoryMock.ListStockItemsByLocation at\n%s", m.ListStockItemsByLocationMock.defaultExpectation.returnOrigin)
+	if m.UpdateBackorderSettingsMock.defaultExpectation != nil && afterUpdateBackorderSettingsCounter < 1 {
+		if m.UpdateBackorderSettingsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.UpdateBackorderSettings at\n%s", m.UpdateBackorderSettingsMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to StockServiceRepositoryMock.ListStockItemsByLocation at\n%s with params: %#v", m.ListStockItemsByLocationMock.defaultExpectation.expectationOrigins.origin, *m.ListStockItemsByLocationMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.UpdateBackorderSettings at\n%s with params: %#v", m.UpdateBackorderSettingsMock.defaultExpectation.expectationOrigins.origin, *m.UpdateBackorderSettingsMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcListStockItemsByLocation != nil && afterListStockItemsByLocationCounter < 1 {
-		m.t.Errorf("Expected call to StockServiceRepositoryMock.ListStockItemsByLocation at\n%s", m.funcListStockItemsByLocationOrigin)
+	if m.funcUpdateBackorderSettings != nil && afterUpdateBackorderSettingsCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.UpdateBackorderSettings at\n%s", m.funcUpdateBackorderSettingsOrigin)
 	}
 
-	if !m.ListStockItemsByLocationMock.invocationsDone() && afterListStockItemsByLocationCounter > 0 {
-		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.ListStockItemsByLocation at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.ListStockItemsByLocationMock.expectedInvocations), m.ListStockItemsByLocationMock.expectedInvocationsOrigin, afterListStockItemsByLocationCounter)
+	if !m.UpdateBackorderSettingsMock.invocationsDone() && afterUpdateBackorderSettingsCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.UpdateBackorderSettings at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateBackorderSettingsMock.expectedInvocations), m.UpdateBackorderSettingsMock.expectedInvocationsOrigin, afterUpdateBackorderSettingsCounter)
 	}
 }
 
-type mStockServiceRepositoryMockSaveStockItem struct {
+type mStockServiceRepositoryMockUpdateStockItemFields struct {
 	optional           bool
 	mock               *StockServiceRepositoryMock
-	defaultExpectation *StockServiceRepositoryMockSaveStockItemExpectation
-	expectations       []*StockServiceRepositoryMockSaveStockItemExpectation
+	defaultExpectation *StockServiceRepositoryMockUpdateStockItemFieldsExpectation
+	expectations       []*StockServiceRepositoryMockUpdateStockItemFieldsExpectation
 
-	callArgs []*StockServiceRepositoryMockSaveStockItemParams
+	callArgs []*StockServiceRepositoryMockUpdateStockItemFieldsParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// StockServiceRepositoryMockSaveStockItemExpectation specifies expectation struct of the StockServiceRepository.SaveStockItem
-type StockServiceRepositoryMockSaveStockItemExpectation struct {
+// StockServiceRepositoryMockUpdateStockItemFieldsExpectation specifies expectation struct of the StockServiceRepository.UpdateStockItemFields
+type StockServiceRepositoryMockUpdateStockItemFieldsExpectation struct {
 	mock               *StockServiceRepositoryMock
-	params             *StockServiceRepositoryMockSaveStockItemParams
-	paramPtrs          *StockServiceRepositoryMockSaveStockItemParamPtrs
-	expectationOrigins StockServiceRepositoryMockSaveStockItemExpectationOrigins
-	results            *StockServiceRepositoryMockSaveStockItemResults
+	params             *StockServiceRepositoryMockUpdateStockItemFieldsParams
+	paramPtrs          *StockServiceRepositoryMockUpdateStockItemFieldsParamPtrs
+	expectationOrigins StockServiceRepositoryMockUpdateStockItemFieldsExpectationOrigins
+	results            *StockServiceRepositoryMockUpdateStockItemFieldsResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// StockServiceRepositoryMockSaveStockItemParams contains parameters of the StockServiceRepository.SaveStockItem
-type StockServiceRepositoryMockSaveStockItemParams struct {
-	ctx       context.Context
-	stockItem domain.StockItem
+// StockServiceRepositoryMockUpdateStockItemFieldsParams contains parameters of the StockServiceRepository.UpdateStockItemFields
+type StockServiceRepositoryMockUpdateStockItemFieldsParams struct {
+	ctx    context.Context
+	update domain.StockItemUpdate
 }
 
-// StockServiceRepositoryMockSaveStockItemParamPtrs contains pointers to parameters of the StockServiceRepository.SaveStockItem
-type StockServiceRepositoryMockSaveStockItemParamPtrs struct {
-	ctx       *context.Context
-	stockItem *domain.StockItem
+// StockServiceRepositoryMockUpdateStockItemFieldsParamPtrs contains pointers to parameters of the StockServiceRepository.UpdateStockItemFields
+type StockServiceRepositoryMockUpdateStockItemFieldsParamPtrs struct {
+	ctx    *context.Context
+	update *domain.StockItemUpdate
 }
 
-// StockServiceRepositoryMockSaveStockItemResults contains results of the StockServiceRepository.SaveStockItem
-type StockServiceRepositoryMockSaveStockItemResults struct {
+// StockServiceRepositoryMockUpdateStockItemFieldsResults contains results of the StockServiceRepository.UpdateStockItemFields
+type StockServiceRepositoryMockUpdateStockItemFieldsResults struct {
+	s1  domain.StockItem
 	err error
 }
 
-// StockServiceRepositoryMockSaveStockItemOrigins contains origins of expectations of the StockServiceRepository.SaveStockItem
-type StockServiceRepositoryMockSaveStockItemExpectationOrigins struct {
-	origin          string
-	originCtx       string
-	originStockItem string
+// StockServiceRepositoryMockUpdateStockItemFieldsOrigins contains origins of expectations of the StockServiceRepository.UpdateStockItemFields
+type StockServiceRepositoryMockUpdateStockItemFieldsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUpdate string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1961,338 +11065,340 @@ type StockServiceRepositoryMockSaveStockItemExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmSaveStockItem *mStockServiceRepositoryMockSaveStockItem) Optional() *mStockServiceRepositoryMockSaveStockItem {
-	mmSaveStockItem.optional = true
-	return mmSaveStockItem
+func (mmUpdateStockItemFields *mStockServiceRepositoryMockUpdateStockItemFields) Optional() *mStockServiceRepositoryMockUpdateStockItemFields {
+	mmUpdateStockItemFields.optional = true
+	return mmUpdateStockItemFields
 }
 
-// Expect sets up expected params for StockServiceRepository.SaveStockItem
-func (mmSaveStockItem *mStockServiceRepositoryMockSaveStockItem) Expect(ctx context.Context, stockItem domain.StockItem) *mStockServiceRepositoryMockSaveStockItem {
-	if mmSaveStockItem.mock.funcSaveStockItem != nil {
-		mmSaveStockItem.mock.t.Fatalf("StockServiceRepositoryMock.SaveStockItem mock is already set by Set")
+// Expect sets up expected params for StockServiceRepository.UpdateStockItemFields
+func (mmUpdateStockItemFields *mStockServiceRepositoryMockUpdateStockItemFields) Expect(ctx context.Context, update domain.StockItemUpdate) *mStockServiceRepositoryMockUpdateStockItemFields {
+	if mmUpdateStockItemFields.mock.funcUpdateStockItemFields != nil {
+		mmUpdateStockItemFields.mock.t.Fatalf("StockServiceRepositoryMock.UpdateStockItemFields mock is already set by Set")
 	}
 
-	if mmSaveStockItem.defaultExpectation == nil {
-		mmSaveStockItem.defaultExpectation = &StockServiceRepositoryMockSaveStockItemExpectation{}
+	if mmUpdateStockItemFields.defaultExpectation == nil {
+		mmUpdateStockItemFields.defaultExpectation = &StockServiceRepositoryMockUpdateStockItemFieldsExpectation{}
 	}
 
-	if mmSaveStockItem.defaultExpectation.paramPtrs != nil {
-		mmSaveStockItem.mock.t.Fatalf("StockServiceRepositoryMock.SaveStockItem mock is already set by ExpectParams functions")
+	if mmUpdateStockItemFields.defaultExpectation.paramPtrs != nil {
+		mmUpdateStockItemFields.mock.t.Fatalf("StockServiceRepositoryMock.UpdateStockItemFields mock is already set by ExpectParams functions")
 	}
 
-	mmSaveStockItem.defaultExpectation.params = &StockServiceRepositoryMockSaveStockItemParams{ctx, stockItem}
-	mmSaveStockItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmSaveStockItem.expectations {
-		if minimock.Equal(e.params, mmSaveStockItem.defaultExpectation.params) {
-			mmSaveStockItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveStockItem.defaultExpectation.params)
+	mmUpdateStockItemFields.defaultExpectation.params = &StockServiceRepositoryMockUpdateStockItemFieldsParams{ctx, update}
+	mmUpdateStockItemFields.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateStockItemFields.expectations {
+		if minimock.Equal(e.params, mmUpdateStockItemFields.defaultExpectation.params) {
+			mmUpdateStockItemFields.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateStockItemFields.defaultExpectation.params)
 		}
 	}
 
-	return mmSaveStockItem
+	return mmUpdateStockItemFields
 }
 
-// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.SaveStockItem
-func (mmSaveStockItem *mStockServiceRepositoryMockSaveStockItem) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockSaveStockItem {
-	if mmSaveStockItem.mock.funcSaveStockItem != nil {
-		mmSaveStockItem.mock.t.Fatalf("StockServiceRepositoryMock.SaveStockItem mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.UpdateStockItemFields
+func (mmUpdateStockItemFields *mStockServiceRepositoryMockUpdateStockItemFields) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockUpdateStockItemFields {
+	if mmUpdateStockItemFields.mock.funcUpdateStockItemFields != nil {
+		mmUpdateStockItemFields.mock.t.Fatalf("StockServiceRepositoryMock.UpdateStockItemFields mock is already set by Set")
 	}
 
-	if mmSaveStockItem.defaultExpectation == nil {
-		mmSaveStockItem.defaultExpectation = &StockServiceRepositoryMockSaveStockItemExpectation{}
+	if mmUpdateStockItemFields.defaultExpectation == nil {
+		mmUpdateStockItemFields.defaultExpectation = &StockServiceRepositoryMockUpdateStockItemFieldsExpectation{}
 	}
 
-	if mmSaveStockItem.defaultExpectation.params != nil {
-		mmSaveStockItem.mock.t.Fatalf("StockServiceRepositoryMock.SaveStockItem mock is already set by Expect")
+	if mmUpdateStockItemFields.defaultExpectation.params != nil {
+		mmUpdateStockItemFields.mock.t.Fatalf("StockServiceRepositoryMock.UpdateStockItemFields mock is already set by Expect")
 	}
 
-	if mmSaveStockItem.defaultExpectation.paramPtrs == nil {
-		mmSaveStockItem.defaultExpectation.paramPtrs = &StockServiceRepositoryMockSaveStockItemParamPtrs{}
+	if mmUpdateStockItemFields.defaultExpectation.paramPtrs == nil {
+		mmUpdateStockItemFields.defaultExpectation.paramPtrs = &StockServiceRepositoryMockUpdateStockItemFieldsParamPtrs{}
 	}
-	mmSaveStockItem.defaultExpectation.paramPtrs.ctx = &ctx
-	mmSaveStockItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmUpdateStockItemFields.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateStockItemFields.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmSaveStockItem
+	return mmUpdateStockItemFields
 }
 
-// ExpectStockItemParam2 sets up expected param stockItem for StockServiceRepository.SaveStockItem
-func (mmSaveStockItem *mStockServiceRepositoryMockSaveStockItem) ExpectStockItemParam2(stockItem domain.StockItem) *mStockServiceRepositoryMockSaveStockItem {
-	if mmSaveStockItem.mock.funcSaveStockItem != nil {
-		mmSaveStockItem.mock.t.Fatalf("StockServiceRepositoryMock.SaveStockItem mock is already set by Set")
+// ExpectUpdateParam2 sets up expected param update for StockServiceRepository.UpdateStockItemFields
+func (mmUpdateStockItemFields *mStockServiceRepositoryMockUpdateStockItemFields) ExpectUpdateParam2(update domain.StockItemUpdate) *mStockServiceRepositoryMockUpdateStockItemFields {
+	if mmUpdateStockItemFields.mock.funcUpdateStockItemFields != nil {
+		mmUpdateStockItemFields.mock.t.Fatalf("StockServiceRepositoryMock.UpdateStockItemFields mock is already set by Set")
 	}
 
-	if mmSaveStockItem.defaultExpectation == nil {
-		mmSaveStockItem.defaultExpectation = &StockServiceRepositoryMockSaveStockItemExpectation{}
+	if mmUpdateStockItemFields.defaultExpectation == nil {
+		mmUpdateStockItemFields.defaultExpectation = &StockServiceRepositoryMockUpdateStockItemFieldsExpectation{}
 	}
 
-	if mmSaveStockItem.defaultExpectation.params != nil {
-		mmSaveStockItem.mock.t.Fatalf("StockServiceRepositoryMock.SaveStockItem mock is already set by Expect")
+	if mmUpdateStockItemFields.defaultExpectation.params != nil {
+		mmUpdateStockItemFields.mock.t.Fatalf("StockServiceRepositoryMock.UpdateStockItemFields mock is already set by Expect")
 	}
 
-	if mmSaveStockItem.defaultExpectation.paramPtrs == nil {
-		mmSaveStockItem.defaultExpectation.paramPtrs = &StockServiceRepositoryMockSaveStockItemParamPtrs{}
+	if mmUpdateStockItemFields.defaultExpectation.paramPtrs == nil {
+		mmUpdateStockItemFields.defaultExpectation.paramPtrs = &StockServiceRepositoryMockUpdateStockItemFieldsParamPtrs{}
 	}
-	mmSaveStockItem.defaultExpectation.paramPtrs.stockItem = &stockItem
-	mmSaveStockItem.defaultExpectation.expectationOrigins.originStockItem = minimock.CallerInfo(1)
+	mmUpdateStockItemFields.defaultExpectation.paramPtrs.update = &update
+	mmUpdateStockItemFields.defaultExpectation.expectationOrigins.originUpdate = minimock.CallerInfo(1)
 
-	return mmSaveStockItem
+	return mmUpdateStockItemFields
 }
 
-// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.SaveStockItem
-func (mmSaveStockItem *mStockServiceRepositoryMockSaveStockItem) Inspect(f func(ctx context.Context, stockItem domain.StockItem)) *mStockServiceRepositoryMockSaveStockItem {
-	if mmSaveStockItem.mock.inspectFuncSaveStockItem != nil {
-		mmSaveStockItem.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.SaveStockItem")
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.UpdateStockItemFields
+func (mmUpdateStockItemFields *mStockServiceRepositoryMockUpdateStockItemFields) Inspect(f func(ctx context.Context, update domain.StockItemUpdate)) *mStockServiceRepositoryMockUpdateStockItemFields {
+	if mmUpdateStockItemFields.mock.inspectFuncUpdateStockItemFields != nil {
+		mmUpdateStockItemFields.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.UpdateStockItemFields")
 	}
 
-	mmSaveStockItem.mock.inspectFuncSaveStockItem = f
+	mmUpdateStockItemFields.mock.inspectFuncUpdateStockItemFields = f
 
-	return mmSaveStockItem
+	return mmUpdateStockItemFields
 }
 
-// Return sets up results that will be returned by StockServiceRepository.SaveStockItem
-func (mmSaveStockItem *mStockServiceRepositoryMockSaveStockItem) Return(err error) *StockServiceRepositoryMock {
-	if mmSaveStockItem.mock.funcSaveStockItem != nil {
-		mmSaveStockItem.mock.t.Fatalf("StockServiceRepositoryMock.SaveStockItem mock is already set by Set")
+// Return sets up results that will be returned by StockServiceRepository.UpdateStockItemFields
+func (mmUpdateStockItemFields *mStockServiceRepositoryMockUpdateStockItemFields) Return(s1 domain.StockItem, err error) *StockServiceRepositoryMock {
+	if mmUpdateStockItemFields.mock.funcUpdateStockItemFields != nil {
+		mmUpdateStockItemFields.mock.t.Fatalf("StockServiceRepositoryMock.UpdateStockItemFields mock is already set by Set")
 	}
 
-	if mmSaveStockItem.defaultExpectation == nil {
-		mmSaveStockItem.defaultExpectation = &StockServiceRepositoryMockSaveStockItemExpectation{mock: mmSaveStockItem.mock}
+	if mmUpdateStockItemFields.defaultExpectation == nil {
+		mmUpdateStockItemFields.defaultExpectation = &StockServiceRepositoryMockUpdateStockItemFieldsExpectation{mock: mmUpdateStockItemFields.mock}
 	}
-	mmSaveStockItem.defaultExpectation.results = &StockServiceRepositoryMockSaveStockItemResults{err}
-	mmSaveStockItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmSaveStockItem.mock
+	mmUpdateStockItemFields.defaultExpectation.results = &StockServiceRepositoryMockUpdateStockItemFieldsResults{s1, err}
+	mmUpdateStockItemFields.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateStockItemFields.mock
 }
 
-// Set uses given function f to mock the StockServiceRepository.SaveStockItem method
-func (mmSaveStockItem *mStockServiceRepositoryMockSaveStockItem) Set(f func(ctx context.Context, stockItem domain.StockItem) (err error)) *StockServiceRepositoryMock {
-	if mmSaveStockItem.defaultExpectation != nil {
-		mmSaveStockItem.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.SaveStockItem method")
+// Set uses given function f to mock the StockServiceRepository.UpdateStockItemFields method
+func (mmUpdateStockItemFields *mStockServiceRepositoryMockUpdateStockItemFields) Set(f func(ctx context.Context, update domain.StockItemUpdate) (s1 domain.StockItem, err error)) *StockServiceRepositoryMock {
+	if mmUpdateStockItemFields.defaultExpectation != nil {
+		mmUpdateStockItemFields.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.UpdateStockItemFields method")
 	}
 
-	if len(mmSaveStockItem.expectations) > 0 {
-		mmSaveStockItem.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.SaveStockItem method")
+	if len(mmUpdateStockItemFields.expectations) > 0 {
+		mmUpdateStockItemFields.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.UpdateStockItemFields method")
 	}
 
-	mmSaveStockItem.mock.funcSaveStockItem = f
-	mmSaveStockItem.mock.funcSaveStockItemOrigin = minimock.CallerInfo(1)
-	return mmSaveStockItem.mock
+	mmUpdateStockItemFields.mock.funcUpdateStockItemFields = f
+	mmUpdateStockItemFields.mock.funcUpdateStockItemFieldsOrigin = minimock.CallerInfo(1)
+	return mmUpdateStockItemFields.mock
 }
 
-// When sets expectation for the StockServiceRepository.SaveStockItem which will trigger the result defined by the following
+// When sets expectation for the StockServiceRepository.UpdateStockItemFields which will trigger the result defined by the following
 // Then helper
-func (mmSaveStockItem *mStockServiceRepositoryMockSaveStockItem) When(ctx context.Context, stockItem domain.StockItem) *StockServiceRepositoryMockSaveStockItemExpectation {
-	if mmSaveStockItem.mock.funcSaveStockItem != nil {
-		mmSaveStockItem.mock.t.Fatalf("StockServiceRepositoryMock.SaveStockItem mock is already set by Set")
+func (mmUpdateStockItemFields *mStockServiceRepositoryMockUpdateStockItemFields) When(ctx context.Context, update domain.StockItemUpdate) *StockServiceRepositoryMockUpdateStockItemFieldsExpectation {
+	if mmUpdateStockItemFields.mock.funcUpdateStockItemFields != nil {
+		mmUpdateStockItemFields.mock.t.Fatalf("StockServiceRepositoryMock.UpdateStockItemFields mock is already set by Set")
 	}
 
-	expectation := &StockServiceRepositoryMockSaveStockItemExpectation{
-		mock:               mmSaveStockItem.mock,
-		params:             &StockServiceRepositoryMockSaveStockItemParams{ctx, stockItem},
-		expectationOrigins: StockServiceRepositoryMockSaveStockItemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &StockServiceRepositoryMockUpdateStockItemFieldsExpectation{
+		mock:               mmUpdateStockItemFields.mock,
+		params:             &StockServiceRepositoryMockUpdateStockItemFieldsParams{ctx, update},
+		expectationOrigins: StockServiceRepositoryMockUpdateStockItemFieldsExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmSaveStockItem.expectations = append(mmSaveStockItem.expectations, expectation)
+	mmUpdateStockItemFields.expectations = append(mmUpdateStockItemFields.expectations, expectation)
 	return expectation
 }
 
-// Then sets up StockServiceRepository.SaveStockItem return parameters for the expectation previously defined by the When method
-func (e *StockServiceRepositoryMockSaveStockItemExpectation) Then(err error) *StockServiceRepositoryMock {
-	e.results = &StockServiceRepositoryMockSaveStockItemResults{err}
+// Then sets up StockServiceRepository.UpdateStockItemFields return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockUpdateStockItemFieldsExpectation) Then(s1 domain.StockItem, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockUpdateStockItemFieldsResults{s1, err}
 	return e.mock
 }
 
-// Times sets number of times StockServiceRepository.SaveStockItem should be invoked
-func (mmSaveStockItem *mStockServiceRepositoryMockSaveStockItem) Times(n uint64) *mStockServiceRepositoryMockSaveStockItem {
+// Times sets number of times StockServiceRepository.UpdateStockItemFields should be invoked
+func (mmUpdateStockItemFields *mStockServiceRepositoryMockUpdateStockItemFields) Times(n uint64) *mStockServiceRepositoryMockUpdateStockItemFields {
 	if n == 0 {
-		mmSaveStockItem.mock.t.Fatalf("Times of StockServiceRepositoryMock.SaveStockItem mock can not be zero")
+		mmUpdateStockItemFields.mock.t.Fatalf("Times of StockServiceRepositoryMock.UpdateStockItemFields mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmSaveStockItem.expectedInvocations, n)
-	mmSaveStockItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmSaveStockItem
+	mm_atomic.StoreUint64(&mmUpdateStockItemFields.expectedInvocations, n)
+	mmUpdateStockItemFields.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateStockItemFields
 }
 
-func (mmSaveStockItem *mStockServiceRepositoryMockSaveStockItem) invocationsDone() bool {
-	if len(mmSaveStockItem.expectations) == 0 && mmSaveStockItem.defaultExpectation == nil && mmSaveStockItem.mock.funcSaveStockItem == nil {
+func (mmUpdateStockItemFields *mStockServiceRepositoryMockUpdateStockItemFields) invocationsDone() bool {
+	if len(mmUpdateStockItemFields.expectations) == 0 && mmUpdateStockItemFields.defaultExpectation == nil && mmUpdateStockItemFields.mock.funcUpdateStockItemFields == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmSaveStockItem.mock.afterSaveStockItemCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmSaveStockItem.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateStockItemFields.mock.afterUpdateStockItemFieldsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateStockItemFields.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// SaveStockItem implements mm_stocks.StockServiceRepository
-func (mmSaveStockItem *StockServiceRepositoryMock) SaveStockItem(ctx context.Context, stockItem domain.StockItem) (err error) {
-	mm_atomic.AddUint64(&mmSaveStockItem.beforeSaveStockItemCounter, 1)
-	defer mm_atomic.AddUint64(&mmSaveStockItem.afterSaveStockItemCounter, 1)
+// UpdateStockItemFields implements mm_stocks.StockServiceRepository
+func (mmUpdateStockItemFields *StockServiceRepositoryMock) UpdateStockItemFields(ctx context.Context, update domain.StockItemUpdate) (s1 domain.StockItem, err error) {
+	mm_atomic.AddUint64(&mmUpdateStockItemFields.beforeUpdateStockItemFieldsCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateStockItemFields.afterUpdateStockItemFieldsCounter, 1)
 
-	mmSaveStockItem.t.Helper()
+	mmUpdateStockItemFields.t.Helper()
 
-	if mmSaveStockItem.inspectFuncSaveStockItem != nil {
-		mmSaveStockItem.inspectFuncSaveStockItem(ctx, stockItem)
+	if mmUpdateStockItemFields.inspectFuncUpdateStockItemFields != nil {
+		mmUpdateStockItemFields.inspectFuncUpdateStockItemFields(ctx, update)
 	}
 
-	mm_params := StockServiceRepositoryMockSaveStockItemParams{ctx, stockItem}
+	mm_params := StockServiceRepositoryMockUpdateStockItemFieldsParams{ctx, update}
 
 	// Record call args
-	mmSaveStockItem.SaveStockItemMock.mutex.Lock()
-	mmSaveStockItem.SaveStockItemMock.callArgs = append(mmSaveStockItem.SaveStockItemMock.callArgs, &mm_params)
-	mmSaveStockItem.SaveStockItemMock.mutex.Unlock()
+	mmUpdateStockItemFields.UpdateStockItemFieldsMock.mutex.Lock()
+	mmUpdateStockItemFields.UpdateStockItemFieldsMock.callArgs = append(mmUpdateStockItemFields.UpdateStockItemFieldsMock.callArgs, &mm_params)
+	mmUpdateStockItemFields.UpdateStockItemFieldsMock.mutex.Unlock()
 
-	for _, e := range mmSaveStockItem.SaveStockItemMock.expectations {
+	for _, e := range mmUpdateStockItemFields.UpdateStockItemFieldsMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.s1, e.results.err
 		}
 	}
 
-	if mmSaveStockItem.SaveStockItemMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmSaveStockItem.SaveStockItemMock.defaultExpectation.Counter, 1)
-		mm_want := mmSaveStockItem.SaveStockItemMock.defaultExpectation.params
-		mm_want_ptrs := mmSaveStockItem.SaveStockItemMock.defaultExpectation.paramPtrs
+	if mmUpdateStockItemFields.UpdateStockItemFieldsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateStockItemFields.UpdateStockItemFieldsMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateStockItemFields.UpdateStockItemFieldsMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateStockItemFields.UpdateStockItemFieldsMock.defaultExpectation.paramPtrs
 
-		mm_got := StockServiceRepositoryMockSaveStockItemParams{ctx, stockItem}
+		mm_got := StockServiceRepositoryMockUpdateStockItemFieldsParams{ctx, update}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmSaveStockItem.t.Errorf("StockServiceRepositoryMock.SaveStockItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSaveStockItem.SaveStockItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpdateStockItemFields.t.Errorf("StockServiceRepositoryMock.UpdateStockItemFields got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateStockItemFields.UpdateStockItemFieldsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.stockItem != nil && !minimock.Equal(*mm_want_ptrs.stockItem, mm_got.stockItem) {
-				mmSaveStockItem.t.Errorf("StockServiceRepositoryMock.SaveStockItem got unexpected parameter stockItem, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSaveStockItem.SaveStockItemMock.defaultExpectation.expectationOrigins.originStockItem, *mm_want_ptrs.stockItem, mm_got.stockItem, minimock.Diff(*mm_want_ptrs.stockItem, mm_got.stockItem))
+			if mm_want_ptrs.update != nil && !minimock.Equal(*mm_want_ptrs.update, mm_got.update) {
+				mmUpdateStockItemFields.t.Errorf("StockServiceRepositoryMock.UpdateStockItemFields got unexpected parameter update, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateStockItemFields.UpdateStockItemFieldsMock.defaultExpectation.expectationOrigins.originUpdate, *mm_want_ptrs.update, mm_got.update, minimock.Diff(*mm_want_ptrs.update, mm_got.update))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmSaveStockItem.t.Errorf("StockServiceRepositoryMock.SaveStockItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmSaveStockItem.SaveStockItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateStockItemFields.t.Errorf("StockServiceRepositoryMock.UpdateStockItemFields got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateStockItemFields.UpdateStockItemFieldsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmSaveStockItem.SaveStockItemMock.defaultExpectation.results
+		mm_results := mmUpdateStockItemFields.UpdateStockItemFieldsMock.defaultExpectation.results
 		if mm_results == nil {
-			mmSaveStockItem.t.Fatal("No results are set for the StockServiceRepositoryMock.SaveStockItem")
+			mmUpdateStockItemFields.t.Fatal("No results are set for the StockServiceRepositoryMock.UpdateStockItemFields")
 		}
-		return (*mm_results).err
+		return (*mm_results).s1, (*mm_results).err
 	}
-	if mmSaveStockItem.funcSaveStockItem != nil {
-		return mmSaveStockItem.funcSaveStockItem(ctx, stockItem)
+	if mmUpdateStockItemFields.funcUpdateStockItemFields != nil {
+		return mmUpdateStockItemFields.funcUpdateStockItemFields(ctx, update)
 	}
-	mmSaveStockItem.t.Fatalf("Unexpected call to StockServiceRepositoryMock.SaveStockItem. %v %v", ctx, stockItem)
+	mmUpdateStockItemFields.t.Fatalf("Unexpected call to StockServiceRepositoryMock.UpdateStockItemFields. %v %v", ctx, update)
 	return
 }
 
-// SaveStockItemAfterCounter returns a count of finished StockServiceRepositoryMock.SaveStockItem invocations
-func (mmSaveStockItem *StockServiceRepositoryMock) SaveStockItemAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSaveStockItem.afterSaveStockItemCounter)
+// UpdateStockItemFieldsAfterCounter returns a count of finished StockServiceRepositoryMock.UpdateStockItemFields invocations
+func (mmUpdateStockItemFields *StockServiceRepositoryMock) UpdateStockItemFieldsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateStockItemFields.afterUpdateStockItemFieldsCounter)
 }
 
-// SaveStockItemBeforeCounter returns a count of StockServiceRepositoryMock.SaveStockItem invocations
-func (mmSaveStockItem *StockServiceRepositoryMock) SaveStockItemBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSaveStockItem.beforeSaveStockItemCounter)
+// UpdateStockItemFieldsBeforeCounter returns a count of StockServiceRepositoryMock.UpdateStockItemFields invocations
+func (mmUpdateStockItemFields *StockServiceRepositoryMock) UpdateStockItemFieldsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateStockItemFields.beforeUpdateStockItemFieldsCounter)
 }
 
-// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.SaveStockItem.
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.UpdateStockItemFields.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmSaveStockItem *mStockServiceRepositoryMockSaveStockItem) Calls() []*StockServiceRepositoryMockSaveStockItemParams {
-	mmSaveStockItem.mutex.RLock()
+func (mmUpdateStockItemFields *mStockServiceRepositoryMockUpdateStockItemFields) Calls() []*StockServiceRepositoryMockUpdateStockItemFieldsParams {
+	mmUpdateStockItemFields.mutex.RLock()
 
-	argCopy := make([]*StockServiceRepositoryMockSaveStockItemParams, len(mmSaveStockItem.callArgs))
-	copy(argCopy, mmSaveStockItem.callArgs)
+	argCopy := make([]*StockServiceRepositoryMockUpdateStockItemFieldsParams, len(mmUpdateStockItemFields.callArgs))
+	copy(argCopy, mmUpdateStockItemFields.callArgs)
 
-	mmSaveStockItem.mutex.RUnlock()
+	mmUpdateStockItemFields.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockSaveStockItemDone returns true if the count of the SaveStockItem invocations corresponds
+// MinimockUpdateStockItemFieldsDone returns true if the count of the UpdateStockItemFields invocations corresponds
 // the number of defined expectations
-func (m *StockServiceRepositoryMock) MinimockSaveStockItemDone() bool {
-	if m.SaveStockItemMock.optional {
+func (m *StockServiceRepositoryMock) MinimockUpdateStockItemFieldsDone() bool {
+	if m.UpdateStockItemFieldsMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.SaveStockItemMock.expectations {
+	for _, e := range m.UpdateStockItemFieldsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.SaveStockItemMock.invocationsDone()
+	return m.UpdateStockItemFieldsMock.invocationsDone()
 }
 
-// MinimockSaveStockItemInspect logs each unmet expectation
-func (m *StockServiceRepositoryMock) MinimockSaveStockItemInspect() {
-	for _, e := range m.SaveStockItemMock.expectations {
+// MinimockUpdateStockItemFieldsInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockUpdateStockItemFieldsInspect() {
+	for _, e := range m.UpdateStockItemFieldsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StockServiceRepositoryMock.SaveStockItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.UpdateStockItemFields at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterSaveStockItemCounter := mm_atomic.LoadUint64(&m.afterSaveStockItemCounter)
+	afterUpdateStockItemFieldsCounter := mm_atomic.LoadUint64(&m.afterUpdateStockItemFieldsCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SaveStockItemMock.defaultExpectation != nil && afterSaveStockItemCounter < 1 {
-		if m.SaveStockItemMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to StockServiceRepositoryMock.SaveStockItem at\n%s", m.SaveStockItemMock.defaultExpectation.returnOrigin)
+	if m.UpdateStockItemFieldsMock.defaultExpectation != nil && afterUpdateStockItemFieldsCounter < 1 {
+		if m.UpdateStockItemFieldsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.UpdateStockItemFields at\n%s", m.UpdateStockItemFieldsMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to StockServiceRepositoryMock.SaveStockItem at\n%s with params: %#v", m.SaveStockItemMock.defaultExpectation.expectationOrigins.origin, *m.SaveStockItemMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.UpdateStockItemFields at\n%s with params: %#v", m.UpdateStockItemFieldsMock.defaultExpectation.expectationOrigins.origin, *m.UpdateStockItemFieldsMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSaveStockItem != nil && afterSaveStockItemCounter < 1 {
-		m.t.Errorf("Expected call to StockServiceRepositoryMock.SaveStockItem at\n%s", m.funcSaveStockItemOrigin)
+	if m.funcUpdateStockItemFields != nil && afterUpdateStockItemFieldsCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.UpdateStockItemFields at\n%s", m.funcUpdateStockItemFieldsOrigin)
 	}
 
-	if !m.SaveStockItemMock.invocationsDone() && afterSaveStockItemCounter > 0 {
-		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.SaveStockItem at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.SaveStockItemMock.expectedInvocations), m.SaveStockItemMock.expectedInvocationsOrigin, afterSaveStockItemCounter)
+	if !m.UpdateStockItemFieldsMock.invocationsDone() && afterUpdateStockItemFieldsCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.UpdateStockItemFields at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateStockItemFieldsMock.expectedInvocations), m.UpdateStockItemFieldsMock.expectedInvocationsOrigin, afterUpdateStockItemFieldsCounter)
 	}
 }
 
-type mStockServiceRepositoryMockUpdateStockItem struct {
+type mStockServiceRepositoryMockUpsertStockItem struct {
 	optional           bool
 	mock               *StockServiceRepositoryMock
-	defaultExpectation *StockServiceRepositoryMockUpdateStockItemExpectation
-	expectations       []*StockServiceRepositoryMockUpdateStockItemExpectation
+	defaultExpectation *StockServiceRepositoryMockUpsertStockItemExpectation
+	expectations       []*StockServiceRepositoryMockUpsertStockItemExpectation
 
-	callArgs []*StockServiceRepositoryMockUpdateStockItemParams
+	callArgs []*StockServiceRepositoryMockUpsertStockItemParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// StockServiceRepositoryMockUpdateStockItemExpectation specifies expectation struct of the StockServiceRepository.UpdateStockItem
-type StockServiceRepositoryMockUpdateStockItemExpectation struct {
+// StockServiceRepositoryMockUpsertStockItemExpectation specifies expectation struct of the StockServiceRepository.UpsertStockItem
+type StockServiceRepositoryMockUpsertStockItemExpectation struct {
 	mock               *StockServiceRepositoryMock
-	params             *StockServiceRepositoryMockUpdateStockItemParams
-	paramPtrs          *StockServiceRepositoryMockUpdateStockItemParamPtrs
-	expectationOrigins StockServiceRepositoryMockUpdateStockItemExpectationOrigins
-	results            *StockServiceRepositoryMockUpdateStockItemResults
+	params             *StockServiceRepositoryMockUpsertStockItemParams
+	paramPtrs          *StockServiceRepositoryMockUpsertStockItemParamPtrs
+	expectationOrigins StockServiceRepositoryMockUpsertStockItemExpectationOrigins
+	results            *StockServiceRepositoryMockUpsertStockItemResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// StockServiceRepositoryMockUpdateStockItemParams contains parameters of the StockServiceRepository.UpdateStockItem
-type StockServiceRepositoryMockUpdateStockItemParams struct {
+// StockServiceRepositoryMockUpsertStockItemParams contains parameters of the StockServiceRepository.UpsertStockItem
+type StockServiceRepositoryMockUpsertStockItemParams struct {
 	ctx       context.Context
 	stockItem domain.StockItem
 }
 
-// StockServiceRepositoryMockUpdateStockItemParamPtrs contains pointers to parameters of the StockServiceRepository.UpdateStockItem
-type StockServiceRepositoryMockUpdateStockItemParamPtrs struct {
+// StockServiceRepositoryMockUpsertStockItemParamPtrs contains pointers to parameters of the StockServiceRepository.UpsertStockItem
+type StockServiceRepositoryMockUpsertStockItemParamPtrs struct {
 	ctx       *context.Context
 	stockItem *domain.StockItem
 }
 
-// StockServiceRepositoryMockUpdateStockItemResults contains results of the StockServiceRepository.UpdateStockItem
-type StockServiceRepositoryMockUpdateStockItemResults struct {
+// StockServiceRepositoryMockUpsertStockItemResults contains results of the StockServiceRepository.UpsertStockItem
+type StockServiceRepositoryMockUpsertStockItemResults struct {
+	s1  domain.StockItem
+	b1  bool
 	err error
 }
 
-// StockServiceRepositoryMockUpdateStockItemOrigins contains origins of expectations of the StockServiceRepository.UpdateStockItem
-type StockServiceRepositoryMockUpdateStockItemExpectationOrigins struct {
+// StockServiceRepositoryMockUpsertStockItemOrigins contains origins of expectations of the StockServiceRepository.UpsertStockItem
+type StockServiceRepositoryMockUpsertStockItemExpectationOrigins struct {
 	origin          string
 	originCtx       string
 	originStockItem string
@@ -2303,292 +11409,292 @@ type StockServiceRepositoryMockUpdateStockItemExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmUpdateStockItem *mStockServiceRepositoryMockUpdateStockItem) Optional() *mStockServiceRepositoryMockUpdateStockItem {
-	mmUpdateStockItem.optional = true
-	return mmUpdateStockItem
+func (mmUpsertStockItem *mStockServiceRepositoryMockUpsertStockItem) Optional() *mStockServiceRepositoryMockUpsertStockItem {
+	mmUpsertStockItem.optional = true
+	return mmUpsertStockItem
 }
 
-// Expect sets up expected params for StockServiceRepository.UpdateStockItem
-func (mmUpdateStockItem *mStockServiceRepositoryMockUpdateStockItem) Expect(ctx context.Context, stockItem domain.StockItem) *mStockServiceRepositoryMockUpdateStockItem {
-	if mmUpdateStockItem.mock.funcUpdateStockItem != nil {
-		mmUpdateStockItem.mock.t.Fatalf("StockServiceRepositoryMock.UpdateStockItem mock is already set by Set")
+// Expect sets up expected params for StockServiceRepository.UpsertStockItem
+func (mmUpsertStockItem *mStockServiceRepositoryMockUpsertStockItem) Expect(ctx context.Context, stockItem domain.StockItem) *mStockServiceRepositoryMockUpsertStockItem {
+	if mmUpsertStockItem.mock.funcUpsertStockItem != nil {
+		mmUpsertStockItem.mock.t.Fatalf("StockServiceRepositoryMock.UpsertStockItem mock is already set by Set")
 	}
 
-	if mmUpdateStockItem.defaultExpectation == nil {
-		mmUpdateStockItem.defaultExpectation = &StockServiceRepositoryMockUpdateStockItemExpectation{}
+	if mmUpsertStockItem.defaultExpectation == nil {
+		mmUpsertStockItem.defaultExpectation = &StockServiceRepositoryMockUpsertStockItemExpectation{}
 	}
 
-	if mmUpdateStockItem.defaultExpectation.paramPtrs != nil {
-		mmUpdateStockItem.mock.t.Fatalf("StockServiceRepositoryMock.UpdateStockItem mock is already set by ExpectParams functions")
+	if mmUpsertStockItem.defaultExpectation.paramPtrs != nil {
+		mmUpsertStockItem.mock.t.Fatalf("StockServiceRepositoryMock.UpsertStockItem mock is already set by ExpectParams functions")
 	}
 
-	mmUpdateStockItem.defaultExpectation.params = &StockServiceRepositoryMockUpdateStockItemParams{ctx, stockItem}
-	mmUpdateStockItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmUpdateStockItem.expectations {
-		if minimock.Equal(e.params, mmUpdateStockItem.defaultExpectation.params) {
-			mmUpdateStockItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateStockItem.defaultExpectation.params)
+	mmUpsertStockItem.defaultExpectation.params = &StockServiceRepositoryMockUpsertStockItemParams{ctx, stockItem}
+	mmUpsertStockItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpsertStockItem.expectations {
+		if minimock.Equal(e.params, mmUpsertStockItem.defaultExpectation.params) {
+			mmUpsertStockItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpsertStockItem.defaultExpectation.params)
 		}
 	}
 
-	return mmUpdateStockItem
+	return mmUpsertStockItem
 }
 
-// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.UpdateStockItem
-func (mmUpdateStockItem *mStockServiceRepositoryMockUpdateStockItem) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockUpdateStockItem {
-	if mmUpdateStockItem.mock.funcUpdateStockItem != nil {
-		mmUpdateStockItem.mock.t.Fatalf("StockServiceRepositoryMock.UpdateStockItem mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for StockServiceRepository.UpsertStockItem
+func (mmUpsertStockItem *mStockServiceRepositoryMockUpsertStockItem) ExpectCtxParam1(ctx context.Context) *mStockServiceRepositoryMockUpsertStockItem {
+	if mmUpsertStockItem.mock.funcUpsertStockItem != nil {
+		mmUpsertStockItem.mock.t.Fatalf("StockServiceRepositoryMock.UpsertStockItem mock is already set by Set")
 	}
 
-	if mmUpdateStockItem.defaultExpectation == nil {
-		mmUpdateStockItem.defaultExpectation = &StockServiceRepositoryMockUpdateStockItemExpectation{}
+	if mmUpsertStockItem.defaultExpectation == nil {
+		mmUpsertStockItem.defaultExpectation = &StockServiceRepositoryMockUpsertStockItemExpectation{}
 	}
 
-	if mmUpdateStockItem.defaultExpectation.params != nil {
-		mmUpdateStockItem.mock.t.Fatalf("StockServiceRepositoryMock.UpdateStockItem mock is already set by Expect")
+	if mmUpsertStockItem.defaultExpectation.params != nil {
+		mmUpsertStockItem.mock.t.Fatalf("StockServiceRepositoryMock.UpsertStockItem mock is already set by Expect")
 	}
 
-	if mmUpdateStockItem.defaultExpectation.paramPtrs == nil {
-		mmUpdateStockItem.defaultExpectation.paramPtrs = &StockServiceRepositoryMockUpdateStockItemParamPtrs{}
+	if mmUpsertStockItem.defaultExpectation.paramPtrs == nil {
+		mmUpsertStockItem.defaultExpectation.paramPtrs = &StockServiceRepositoryMockUpsertStockItemParamPtrs{}
 	}
-	mmUpdateStockItem.defaultExpectation.paramPtrs.ctx = &ctx
-	mmUpdateStockItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmUpsertStockItem.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpsertStockItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmUpdateStockItem
+	return mmUpsertStockItem
 }
 
-// ExpectStockItemParam2 sets up expected param stockItem for StockServiceRepository.UpdateStockItem
-func (mmUpdateStockItem *mStockServiceRepositoryMockUpdateStockItem) ExpectStockItemParam2(stockItem domain.StockItem) *mStockServiceRepositoryMockUpdateStockItem {
-	if mmUpdateStockItem.mock.funcUpdateStockItem != nil {
-		mmUpdateStockItem.mock.t.Fatalf("StockServiceRepositoryMock.UpdateStockItem mock is already set by Set")
+// ExpectStockItemParam2 sets up expected param stockItem for StockServiceRepository.UpsertStockItem
+func (mmUpsertStockItem *mStockServiceRepositoryMockUpsertStockItem) ExpectStockItemParam2(stockItem domain.StockItem) *mStockServiceRepositoryMockUpsertStockItem {
+	if mmUpsertStockItem.mock.funcUpsertStockItem != nil {
+		mmUpsertStockItem.mock.t.Fatalf("StockServiceRepositoryMock.UpsertStockItem mock is already set by Set")
 	}
 
-	if mmUpdateStockItem.defaultExpectation == nil {
-		mmUpdateStockItem.defaultExpectation = &StockServiceRepositoryMockUpdateStockItemExpectation{}
+	if mmUpsertStockItem.defaultExpectation == nil {
+		mmUpsertStockItem.defaultExpectation = &StockServiceRepositoryMockUpsertStockItemExpectation{}
 	}
 
-	if mmUpdateStockItem.defaultExpectation.params != nil {
-		mmUpdateStockItem.mock.t.Fatalf("StockServiceRepositoryMock.UpdateStockItem mock is already set by Expect")
+	if mmUpsertStockItem.defaultExpectation.params != nil {
+		mmUpsertStockItem.mock.t.Fatalf("StockServiceRepositoryMock.UpsertStockItem mock is already set by Expect")
 	}
 
-	if mmUpdateStockItem.defaultExpectation.paramPtrs == nil {
-		mmUpdateStockItem.defaultExpectation.paramPtrs = &StockServiceRepositoryMockUpdateStockItemParamPtrs{}
+	if mmUpsertStockItem.defaultExpectation.paramPtrs == nil {
+		mmUpsertStockItem.defaultExpectation.paramPtrs = &StockServiceRepositoryMockUpsertStockItemParamPtrs{}
 	}
-	mmUpdateStockItem.defaultExpectation.paramPtrs.stockItem = &stockItem
-	mmUpdateStockItem.defaultExpectation.expectationOrigins.originStockItem = minimock.CallerInfo(1)
+	mmUpsertStockItem.defaultExpectation.paramPtrs.stockItem = &stockItem
+	mmUpsertStockItem.defaultExpectation.expectationOrigins.originStockItem = minimock.CallerInfo(1)
 
-	return mmUpdateStockItem
+	return mmUpsertStockItem
 }
 
-// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.UpdateStockItem
-func (mmUpdateStockItem *mStockServiceRepositoryMockUpdateStockItem) Inspect(f func(ctx context.Context, stockItem domain.StockItem)) *mStockServiceRepositoryMockUpdateStockItem {
-	if mmUpdateStockItem.mock.inspectFuncUpdateStockItem != nil {
-		mmUpdateStockItem.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.UpdateStockItem")
+// Inspect accepts an inspector function that has same arguments as the StockServiceRepository.UpsertStockItem
+func (mmUpsertStockItem *mStockServiceRepositoryMockUpsertStockItem) Inspect(f func(ctx context.Context, stockItem domain.StockItem)) *mStockServiceRepositoryMockUpsertStockItem {
+	if mmUpsertStockItem.mock.inspectFuncUpsertStockItem != nil {
+		mmUpsertStockItem.mock.t.Fatalf("Inspect function is already set for StockServiceRepositoryMock.UpsertStockItem")
 	}
 
-	mmUpdateStockItem.mock.inspectFuncUpdateStockItem = f
+	mmUpsertStockItem.mock.inspectFuncUpsertStockItem = f
 
-	return mmUpdateStockItem
+	return mmUpsertStockItem
 }
 
-// Return sets up results that will be returned by StockServiceRepository.UpdateStockItem
-func (mmUpdateStockItem *mStockServiceRepositoryMockUpdateStockItem) Return(err error) *StockServiceRepositoryMock {
-	if mmUpdateStockItem.mock.funcUpdateStockItem != nil {
-		mmUpdateStockItem.mock.t.Fatalf("StockServiceRepositoryMock.UpdateStockItem mock is already set by Set")
+// Return sets up results that will be returned by StockServiceRepository.UpsertStockItem
+func (mmUpsertStockItem *mStockServiceRepositoryMockUpsertStockItem) Return(s1 domain.StockItem, b1 bool, err error) *StockServiceRepositoryMock {
+	if mmUpsertStockItem.mock.funcUpsertStockItem != nil {
+		mmUpsertStockItem.mock.t.Fatalf("StockServiceRepositoryMock.UpsertStockItem mock is already set by Set")
 	}
 
-	if mmUpdateStockItem.defaultExpectation == nil {
-		mmUpdateStockItem.defaultExpectation = &StockServiceRepositoryMockUpdateStockItemExpectation{mock: mmUpdateStockItem.mock}
+	if mmUpsertStockItem.defaultExpectation == nil {
+		mmUpsertStockItem.defaultExpectation = &StockServiceRepositoryMockUpsertStockItemExpectation{mock: mmUpsertStockItem.mock}
 	}
-	mmUpdateStockItem.defaultExpectation.results = &StockServiceRepositoryMockUpdateStockItemResults{err}
-	mmUpdateStockItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmUpdateStockItem.mock
+	mmUpsertStockItem.defaultExpectation.results = &StockServiceRepositoryMockUpsertStockItemResults{s1, b1, err}
+	mmUpsertStockItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpsertStockItem.mock
 }
 
-// Set uses given function f to mock the StockServiceRepository.UpdateStockItem method
-func (mmUpdateStockItem *mStockServiceRepositoryMockUpdateStockItem) Set(f func(ctx context.Context, stockItem domain.StockItem) (err error)) *StockServiceRepositoryMock {
-	if mmUpdateStockItem.defaultExpectation != nil {
-		mmUpdateStockItem.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.UpdateStockItem method")
+// Set uses given function f to mock the StockServiceRepository.UpsertStockItem method
+func (mmUpsertStockItem *mStockServiceRepositoryMockUpsertStockItem) Set(f func(ctx context.Context, stockItem domain.StockItem) (s1 domain.StockItem, b1 bool, err error)) *StockServiceRepositoryMock {
+	if mmUpsertStockItem.defaultExpectation != nil {
+		mmUpsertStockItem.mock.t.Fatalf("Default expectation is already set for the StockServiceRepository.UpsertStockItem method")
 	}
 
-	if len(mmUpdateStockItem.expectations) > 0 {
-		mmUpdateStockItem.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.UpdateStockItem method")
+	if len(mmUpsertStockItem.expectations) > 0 {
+		mmUpsertStockItem.mock.t.Fatalf("Some expectations are already set for the StockServiceRepository.UpsertStockItem method")
 	}
 
-	mmUpdateStockItem.mock.funcUpdateStockItem = f
-	mmUpdateStockItem.mock.funcUpdateStockItemOrigin = minimock.CallerInfo(1)
-	return mmUpdateStockItem.mock
+	mmUpsertStockItem.mock.funcUpsertStockItem = f
+	mmUpsertStockItem.mock.funcUpsertStockItemOrigin = minimock.CallerInfo(1)
+	return mmUpsertStockItem.mock
 }
 
-// When sets expectation for the StockServiceRepository.UpdateStockItem which will trigger the result defined by the following
+// When sets expectation for the StockServiceRepository.UpsertStockItem which will trigger the result defined by the following
 // Then helper
-func (mmUpdateStockItem *mStockServiceRepositoryMockUpdateStockItem) When(ctx context.Context, stockItem domain.StockItem) *StockServiceRepositoryMockUpdateStockItemExpectation {
-	if mmUpdateStockItem.mock.funcUpdateStockItem != nil {
-		mmUpdateStockItem.mock.t.Fatalf("StockServiceRepositoryMock.UpdateStockItem mock is already set by Set")
+func (mmUpsertStockItem *mStockServiceRepositoryMockUpsertStockItem) When(ctx context.Context, stockItem domain.StockItem) *StockServiceRepositoryMockUpsertStockItemExpectation {
+	if mmUpsertStockItem.mock.funcUpsertStockItem != nil {
+		mmUpsertStockItem.mock.t.Fatalf("StockServiceRepositoryMock.UpsertStockItem mock is already set by Set")
 	}
 
-	expectation := &StockServiceRepositoryMockUpdateStockItemExpectation{
-		mock:               mmUpdateStockItem.mock,
-		params:             &StockServiceRepositoryMockUpdateStockItemParams{ctx, stockItem},
-		expectationOrigins: StockServiceRepositoryMockUpdateStockItemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &StockServiceRepositoryMockUpsertStockItemExpectation{
+		mock:               mmUpsertStockItem.mock,
+		params:             &StockServiceRepositoryMockUpsertStockItemParams{ctx, stockItem},
+		expectationOrigins: StockServiceRepositoryMockUpsertStockItemExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmUpdateStockItem.expectations = append(mmUpdateStockItem.expectations, expectation)
+	mmUpsertStockItem.expectations = append(mmUpsertStockItem.expectations, expectation)
 	return expectation
 }
 
-// Then sets up StockServiceRepository.UpdateStockItem return parameters for the expectation previously defined by the When method
-func (e *StockServiceRepositoryMockUpdateStockItemExpectation) Then(err error) *StockServiceRepositoryMock {
-	e.results = &StockServiceRepositoryMockUpdateStockItemResults{err}
+// Then sets up StockServiceRepository.UpsertStockItem return parameters for the expectation previously defined by the When method
+func (e *StockServiceRepositoryMockUpsertStockItemExpectation) Then(s1 domain.StockItem, b1 bool, err error) *StockServiceRepositoryMock {
+	e.results = &StockServiceRepositoryMockUpsertStockItemResults{s1, b1, err}
 	return e.mock
 }
 
-// Times sets number of times StockServiceRepository.UpdateStockItem should be invoked
-func (mmUpdateStockItem *mStockServiceRepositoryMockUpdateStockItem) Times(n uint64) *mStockServiceRepositoryMockUpdateStockItem {
+// Times sets number of times StockServiceRepository.UpsertStockItem should be invoked
+func (mmUpsertStockItem *mStockServiceRepositoryMockUpsertStockItem) Times(n uint64) *mStockServiceRepositoryMockUpsertStockItem {
 	if n == 0 {
-		mmUpdateStockItem.mock.t.Fatalf("Times of StockServiceRepositoryMock.UpdateStockItem mock can not be zero")
+		mmUpsertStockItem.mock.t.Fatalf("Times of StockServiceRepositoryMock.UpsertStockItem mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmUpdateStockItem.expectedInvocations, n)
-	mmUpdateStockItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmUpdateStockItem
+	mm_atomic.StoreUint64(&mmUpsertStockItem.expectedInvocations, n)
+	mmUpsertStockItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpsertStockItem
 }
 
-func (mmUpdateStockItem *mStockServiceRepositoryMockUpdateStockItem) invocationsDone() bool {
-	if len(mmUpdateStockItem.expectations) == 0 && mmUpdateStockItem.defaultExpectation == nil && mmUpdateStockItem.mock.funcUpdateStockItem == nil {
+func (mmUpsertStockItem *mStockServiceRepositoryMockUpsertStockItem) invocationsDone() bool {
+	if len(mmUpsertStockItem.expectations) == 0 && mmUpsertStockItem.defaultExpectation == nil && mmUpsertStockItem.mock.funcUpsertStockItem == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmUpdateStockItem.mock.afterUpdateStockItemCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateStockItem.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpsertStockItem.mock.afterUpsertStockItemCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpsertStockItem.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// UpdateStockItem implements mm_stocks.StockServiceRepository
-func (mmUpdateStockItem *StockServiceRepositoryMock) UpdateStockItem(ctx context.Context, stockItem domain.StockItem) (err error) {
-	mm_atomic.AddUint64(&mmUpdateStockItem.beforeUpdateStockItemCounter, 1)
-	defer mm_atomic.AddUint64(&mmUpdateStockItem.afterUpdateStockItemCounter, 1)
+// UpsertStockItem implements mm_stocks.StockServiceRepository
+func (mmUpsertStockItem *StockServiceRepositoryMock) UpsertStockItem(ctx context.Context, stockItem domain.StockItem) (s1 domain.StockItem, b1 bool, err error) {
+	mm_atomic.AddUint64(&mmUpsertStockItem.beforeUpsertStockItemCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpsertStockItem.afterUpsertStockItemCounter, 1)
 
-	mmUpdateStockItem.t.Helper()
+	mmUpsertStockItem.t.Helper()
 
-	if mmUpdateStockItem.inspectFuncUpdateStockItem != nil {
-		mmUpdateStockItem.inspectFuncUpdateStockItem(ctx, stockItem)
+	if mmUpsertStockItem.inspectFuncUpsertStockItem != nil {
+		mmUpsertStockItem.inspectFuncUpsertStockItem(ctx, stockItem)
 	}
 
-	mm_params := StockServiceRepositoryMockUpdateStockItemParams{ctx, stockItem}
+	mm_params := StockServiceRepositoryMockUpsertStockItemParams{ctx, stockItem}
 
 	// Record call args
-	mmUpdateStockItem.UpdateStockItemMock.mutex.Lock()
-	mmUpdateStockItem.UpdateStockItemMock.callArgs = append(mmUpdateStockItem.UpdateStockItemMock.callArgs, &mm_params)
-	mmUpdateStockItem.UpdateStockItemMock.mutex.Unlock()
+	mmUpsertStockItem.UpsertStockItemMock.mutex.Lock()
+	mmUpsertStockItem.UpsertStockItemMock.callArgs = append(mmUpsertStockItem.UpsertStockItemMock.callArgs, &mm_params)
+	mmUpsertStockItem.UpsertStockItemMock.mutex.Unlock()
 
-	for _, e := range mmUpdateStockItem.UpdateStockItemMock.expectations {
+	for _, e := range mmUpsertStockItem.UpsertStockItemMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.s1, e.results.b1, e.results.err
 		}
 	}
 
-	if mmUpdateStockItem.UpdateStockItemMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmUpdateStockItem.UpdateStockItemMock.defaultExpectation.Counter, 1)
-		mm_want := mmUpdateStockItem.UpdateStockItemMock.defaultExpectation.params
-		mm_want_ptrs := mmUpdateStockItem.UpdateStockItemMock.defaultExpectation.paramPtrs
+	if mmUpsertStockItem.UpsertStockItemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpsertStockItem.UpsertStockItemMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpsertStockItem.UpsertStockItemMock.defaultExpectation.params
+		mm_want_ptrs := mmUpsertStockItem.UpsertStockItemMock.defaultExpectation.paramPtrs
 
-		mm_got := StockServiceRepositoryMockUpdateStockItemParams{ctx, stockItem}
+		mm_got := StockServiceRepositoryMockUpsertStockItemParams{ctx, stockItem}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmUpdateStockItem.t.Errorf("StockServiceRepositoryMock.UpdateStockItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmUpdateStockItem.UpdateStockItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpsertStockItem.t.Errorf("StockServiceRepositoryMock.UpsertStockItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpsertStockItem.UpsertStockItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.stockItem != nil && !minimock.Equal(*mm_want_ptrs.stockItem, mm_got.stockItem) {
-				mmUpdateStockItem.t.Errorf("StockServiceRepositoryMock.UpdateStockItem got unexpected parameter stockItem, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmUpdateStockItem.UpdateStockItemMock.defaultExpectation.expectationOrigins.originStockItem, *mm_want_ptrs.stockItem, mm_got.stockItem, minimock.Diff(*mm_want_ptrs.stockItem, mm_got.stockItem))
+				mmUpsertStockItem.t.Errorf("StockServiceRepositoryMock.UpsertStockItem got unexpected parameter stockItem, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpsertStockItem.UpsertStockItemMock.defaultExpectation.expectationOrigins.originStockItem, *mm_want_ptrs.stockItem, mm_got.stockItem, minimock.Diff(*mm_want_ptrs.stockItem, mm_got.stockItem))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmUpdateStockItem.t.Errorf("StockServiceRepositoryMock.UpdateStockItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmUpdateStockItem.UpdateStockItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpsertStockItem.t.Errorf("StockServiceRepositoryMock.UpsertStockItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpsertStockItem.UpsertStockItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmUpdateStockItem.UpdateStockItemMock.defaultExpectation.results
+		mm_results := mmUpsertStockItem.UpsertStockItemMock.defaultExpectation.results
 		if mm_results == nil {
-			mmUpdateStockItem.t.Fatal("No results are set for the StockServiceRepositoryMock.UpdateStockItem")
+			mmUpsertStockItem.t.Fatal("No results are set for the StockServiceRepositoryMock.UpsertStockItem")
 		}
-		return (*mm_results).err
+		return (*mm_results).s1, (*mm_results).b1, (*mm_results).err
 	}
-	if mmUpdateStockItem.funcUpdateStockItem != nil {
-		return mmUpdateStockItem.funcUpdateStockItem(ctx, stockItem)
+	if mmUpsertStockItem.funcUpsertStockItem != nil {
+		return mmUpsertStockItem.funcUpsertStockItem(ctx, stockItem)
 	}
-	mmUpdateStockItem.t.Fatalf("Unexpected call to StockServiceRepositoryMock.UpdateStockItem. %v %v", ctx, stockItem)
+	mmUpsertStockItem.t.Fatalf("Unexpected call to StockServiceRepositoryMock.UpsertStockItem. %v %v", ctx, stockItem)
 	return
 }
 
-// UpdateStockItemAfterCounter returns a count of finished StockServiceRepositoryMock.UpdateStockItem invocations
-func (mmUpdateStockItem *StockServiceRepositoryMock) UpdateStockItemAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdateStockItem.afterUpdateStockItemCounter)
+// UpsertStockItemAfterCounter returns a count of finished StockServiceRepositoryMock.UpsertStockItem invocations
+func (mmUpsertStockItem *StockServiceRepositoryMock) UpsertStockItemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpsertStockItem.afterUpsertStockItemCounter)
 }
 
-// UpdateStockItemBeforeCounter returns a count of StockServiceRepositoryMock.UpdateStockItem invocations
-func (mmUpdateStockItem *StockServiceRepositoryMock) UpdateStockItemBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmUpdateStockItem.beforeUpdateStockItemCounter)
+// UpsertStockItemBeforeCounter returns a count of StockServiceRepositoryMock.UpsertStockItem invocations
+func (mmUpsertStockItem *StockServiceRepositoryMock) UpsertStockItemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpsertStockItem.beforeUpsertStockItemCounter)
 }
 
-// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.UpdateStockItem.
+// Calls returns a list of arguments used in each call to StockServiceRepositoryMock.UpsertStockItem.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmUpdateStockItem *mStockServiceRepositoryMockUpdateStockItem) Calls() []*StockServiceRepositoryMockUpdateStockItemParams {
-	mmUpdateStockItem.mutex.RLock()
+func (mmUpsertStockItem *mStockServiceRepositoryMockUpsertStockItem) Calls() []*StockServiceRepositoryMockUpsertStockItemParams {
+	mmUpsertStockItem.mutex.RLock()
 
-	argCopy := make([]*StockServiceRepositoryMockUpdateStockItemParams, len(mmUpdateStockItem.callArgs))
-	copy(argCopy, mmUpdateStockItem.callArgs)
+	argCopy := make([]*StockServiceRepositoryMockUpsertStockItemParams, len(mmUpsertStockItem.callArgs))
+	copy(argCopy, mmUpsertStockItem.callArgs)
 
-	mmUpdateStockItem.mutex.RUnlock()
+	mmUpsertStockItem.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockUpdateStockItemDone returns true if the count of the UpdateStockItem invocations corresponds
+// MinimockUpsertStockItemDone returns true if the count of the UpsertStockItem invocations corresponds
 // the number of defined expectations
-func (m *StockServiceRepositoryMock) MinimockUpdateStockItemDone() bool {
-	if m.UpdateStockItemMock.optional {
+func (m *StockServiceRepositoryMock) MinimockUpsertStockItemDone() bool {
+	if m.UpsertStockItemMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.UpdateStockItemMock.expectations {
+	for _, e := range m.UpsertStockItemMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.UpdateStockItemMock.invocationsDone()
+	return m.UpsertStockItemMock.invocationsDone()
 }
 
-// MinimockUpdateStockItemInspect logs each unmet expectation
-func (m *StockServiceRepositoryMock) MinimockUpdateStockItemInspect() {
-	for _, e := range m.UpdateStockItemMock.expectations {
+// MinimockUpsertStockItemInspect logs each unmet expectation
+func (m *StockServiceRepositoryMock) MinimockUpsertStockItemInspect() {
+	for _, e := range m.UpsertStockItemMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to StockServiceRepositoryMock.UpdateStockItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.UpsertStockItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterUpdateStockItemCounter := mm_atomic.LoadUint64(&m.afterUpdateStockItemCounter)
+	afterUpsertStockItemCounter := mm_atomic.LoadUint64(&m.afterUpsertStockItemCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.UpdateStockItemMock.defaultExpectation != nil && afterUpdateStockItemCounter < 1 {
-		if m.UpdateStockItemMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to StockServiceRepositoryMock.UpdateStockItem at\n%s", m.UpdateStockItemMock.defaultExpectation.returnOrigin)
+	if m.UpsertStockItemMock.defaultExpectation != nil && afterUpsertStockItemCounter < 1 {
+		if m.UpsertStockItemMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.UpsertStockItem at\n%s", m.UpsertStockItemMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to StockServiceRepositoryMock.UpdateStockItem at\n%s with params: %#v", m.UpdateStockItemMock.defaultExpectation.expectationOrigins.origin, *m.UpdateStockItemMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to StockServiceRepositoryMock.UpsertStockItem at\n%s with params: %#v", m.UpsertStockItemMock.defaultExpectation.expectationOrigins.origin, *m.UpsertStockItemMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcUpdateStockItem != nil && afterUpdateStockItemCounter < 1 {
-		m.t.Errorf("Expected call to StockServiceRepositoryMock.UpdateStockItem at\n%s", m.funcUpdateStockItemOrigin)
+	if m.funcUpsertStockItem != nil && afterUpsertStockItemCounter < 1 {
+		m.t.Errorf("Expected call to StockServiceRepositoryMock.UpsertStockItem at\n%s", m.funcUpsertStockItemOrigin)
 	}
 
-	if !m.UpdateStockItemMock.invocationsDone() && afterUpdateStockItemCounter > 0 {
-		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.UpdateStockItem at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.UpdateStockItemMock.expectedInvocations), m.UpdateStockItemMock.expectedInvocationsOrigin, afterUpdateStockItemCounter)
+	if !m.UpsertStockItemMock.invocationsDone() && afterUpsertStockItemCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockServiceRepositoryMock.UpsertStockItem at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpsertStockItemMock.expectedInvocations), m.UpsertStockItemMock.expectedInvocationsOrigin, afterUpsertStockItemCounter)
 	}
 }
 
@@ -2596,19 +11702,69 @@ func (m *StockServiceRepositoryMock) MinimockUpdateStockItemInspect() {
 func (m *StockServiceRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockAdjustBundleComponentsInspect()
+
+			m.MinimockAdjustStockCountInspect()
+
+			m.MinimockApplyCycleCountVariancesInspect()
+
+			m.MinimockCountLotsExpiringBeforeInspect()
+
 			m.MinimockCountStockItemsInspect()
 
+			m.MinimockCreateCycleCountInspect()
+
 			m.MinimockDeleteStockItemFromStorageInspect()
 
+			m.MinimockExpireDueLotsInspect()
+
+			m.MinimockGetCycleCountInspect()
+
+			m.MinimockGetPurchaseOrderByIDInspect()
+
 			m.MinimockGetStockItemInspect()
 
-			m.MinimockGetStockItemBySkuInspect()
+			m.MinimockGetStockTransferInspect()
+
+			m.MinimockListBundleOffersInspect()
+
+			m.MinimockListDemandHistoryInspect()
+
+			m.MinimockListLotsExpiringBeforeInspect()
+
+			m.MinimockListStockItemOffersInspect()
 
 			m.MinimockListStockItemsByLocationInspect()
 
-			m.MinimockSaveStockItemInspect()
+			m.MinimockListStockItemsBySkusInspect()
+
+			m.MinimockListSuppliersOfMerchantInspect()
+
+			m.MinimockMarkPurchaseOrderOrderedInspect()
+
+			m.MinimockPurgeStockItemsDeletedBeforeInspect()
+
+			m.MinimockReceivePurchaseOrderLinesInspect()
+
+			m.MinimockReceiveStockTransferInspect()
+
+			m.MinimockRestoreDeletedStockItemInspect()
+
+			m.MinimockSaveCartDemandInspect()
+
+			m.MinimockSaveCycleCountsInspect()
+
+			m.MinimockSavePurchaseOrderInspect()
+
+			m.MinimockSaveSupplierInspect()
+
+			m.MinimockShipStockTransferInspect()
+
+			m.MinimockUpdateBackorderSettingsInspect()
+
+			m.MinimockUpdateStockItemFieldsInspect()
 
-			m.MinimockUpdateStockItemInspect()
+			m.MinimockUpsertStockItemInspect()
 		}
 	})
 }
@@ -2632,11 +11788,36 @@ func (m *StockServiceRepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *StockServiceRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAdjustBundleComponentsDone() &&
+		m.MinimockAdjustStockCountDone() &&
+		m.MinimockApplyCycleCountVariancesDone() &&
+		m.MinimockCountLotsExpiringBeforeDone() &&
 		m.MinimockCountStockItemsDone() &&
+		m.MinimockCreateCycleCountDone() &&
 		m.MinimockDeleteStockItemFromStorageDone() &&
+		m.MinimockExpireDueLotsDone() &&
+		m.MinimockGetCycleCountDone() &&
+		m.MinimockGetPurchaseOrderByIDDone() &&
 		m.MinimockGetStockItemDone() &&
-		m.MinimockGetStockItemBySkuDone() &&
+		m.MinimockGetStockTransferDone() &&
+		m.MinimockListBundleOffersDone() &&
+		m.MinimockListDemandHistoryDone() &&
+		m.MinimockListLotsExpiringBeforeDone() &&
+		m.MinimockListStockItemOffersDone() &&
 		m.MinimockListStockItemsByLocationDone() &&
-		m.MinimockSaveStockItemDone() &&
-		m.MinimockUpdateStockItemDone()
+		m.MinimockListStockItemsBySkusDone() &&
+		m.MinimockListSuppliersOfMerchantDone() &&
+		m.MinimockMarkPurchaseOrderOrderedDone() &&
+		m.MinimockPurgeStockItemsDeletedBeforeDone() &&
+		m.MinimockReceivePurchaseOrderLinesDone() &&
+		m.MinimockReceiveStockTransferDone() &&
+		m.MinimockRestoreDeletedStockItemDone() &&
+		m.MinimockSaveCartDemandDone() &&
+		m.MinimockSaveCycleCountsDone() &&
+		m.MinimockSavePurchaseOrderDone() &&
+		m.MinimockSaveSupplierDone() &&
+		m.MinimockShipStockTransferDone() &&
+		m.MinimockUpdateBackorderSettingsDone() &&
+		m.MinimockUpdateStockItemFieldsDone() &&
+		m.MinimockUpsertStockItemDone()
 }
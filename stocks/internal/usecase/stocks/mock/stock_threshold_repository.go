@@ -0,0 +1,1932 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mock
+
+import (
+	"context"
+	"stocks/internal/domain"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// StockThresholdRepositoryMock implements mm_stocks.StockThresholdRepository
+type StockThresholdRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCountLowStockItems          func(ctx context.Context, location string) (u1 uint32, err error)
+	funcCountLowStockItemsOrigin    string
+	inspectFuncCountLowStockItems   func(ctx context.Context, location string)
+	afterCountLowStockItemsCounter  uint64
+	beforeCountLowStockItemsCounter uint64
+	CountLowStockItemsMock          mStockThresholdRepositoryMockCountLowStockItems
+
+	funcGetStockThreshold          func(ctx context.Context, skuID domain.SKUID, location string) (s1 domain.StockThreshold, err error)
+	funcGetStockThresholdOrigin    string
+	inspectFuncGetStockThreshold   func(ctx context.Context, skuID domain.SKUID, location string)
+	afterGetStockThresholdCounter  uint64
+	beforeGetStockThresholdCounter uint64
+	GetStockThresholdMock          mStockThresholdRepositoryMockGetStockThreshold
+
+	funcListLowStockItems          func(ctx context.Context, filter domain.LowStockFilter) (la1 []domain.LowStockItem, err error)
+	funcListLowStockItemsOrigin    string
+	inspectFuncListLowStockItems   func(ctx context.Context, filter domain.LowStockFilter)
+	afterListLowStockItemsCounter  uint64
+	beforeListLowStockItemsCounter uint64
+	ListLowStockItemsMock          mStockThresholdRepositoryMockListLowStockItems
+
+	funcSaveStockThreshold          func(ctx context.Context, threshold domain.StockThreshold) (err error)
+	funcSaveStockThresholdOrigin    string
+	inspectFuncSaveStockThreshold   func(ctx context.Context, threshold domain.StockThreshold)
+	afterSaveStockThresholdCounter  uint64
+	beforeSaveStockThresholdCounter uint64
+	SaveStockThresholdMock          mStockThresholdRepositoryMockSaveStockThreshold
+
+	funcUpdateStockLevel          func(ctx context.Context, stockItem domain.StockItem, from domain.StockLevel, to domain.StockLevel) (b1 bool, err error)
+	funcUpdateStockLevelOrigin    string
+	inspectFuncUpdateStockLevel   func(ctx context.Context, stockItem domain.StockItem, from domain.StockLevel, to domain.StockLevel)
+	afterUpdateStockLevelCounter  uint64
+	beforeUpdateStockLevelCounter uint64
+	UpdateStockLevelMock          mStockThresholdRepositoryMockUpdateStockLevel
+}
+
+// NewStockThresholdRepositoryMock returns a mock for mm_stocks.StockThresholdRepository
+func NewStockThresholdRepositoryMock(t minimock.Tester) *StockThresholdRepositoryMock {
+	m := &StockThresholdRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CountLowStockItemsMock = mStockThresholdRepositoryMockCountLowStockItems{mock: m}
+	m.CountLowStockItemsMock.callArgs = []*StockThresholdRepositoryMockCountLowStockItemsParams{}
+
+	m.GetStockThresholdMock = mStockThresholdRepositoryMockGetStockThreshold{mock: m}
+	m.GetStockThresholdMock.callArgs = []*StockThresholdRepositoryMockGetStockThresholdParams{}
+
+	m.ListLowStockItemsMock = mStockThresholdRepositoryMockListLowStockItems{mock: m}
+	m.ListLowStockItemsMock.callArgs = []*StockThresholdRepositoryMockListLowStockItemsParams{}
+
+	m.SaveStockThresholdMock = mStockThresholdRepositoryMockSaveStockThreshold{mock: m}
+	m.SaveStockThresholdMock.callArgs = []*StockThresholdRepositoryMockSaveStockThresholdParams{}
+
+	m.UpdateStockLevelMock = mStockThresholdRepositoryMockUpdateStockLevel{mock: m}
+	m.UpdateStockLevelMock.callArgs = []*StockThresholdRepositoryMockUpdateStockLevelParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mStockThresholdRepositoryMockCountLowStockItems struct {
+	optional           bool
+	mock               *StockThresholdRepositoryMock
+	defaultExpectation *StockThresholdRepositoryMockCountLowStockItemsExpectation
+	expectations       []*StockThresholdRepositoryMockCountLowStockItemsExpectation
+
+	callArgs []*StockThresholdRepositoryMockCountLowStockItemsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockThresholdRepositoryMockCountLowStockItemsExpectation specifies expectation struct of the StockThresholdRepository.CountLowStockItems
+type StockThresholdRepositoryMockCountLowStockItemsExpectation struct {
+	mock               *StockThresholdRepositoryMock
+	params             *StockThresholdRepositoryMockCountLowStockItemsParams
+	paramPtrs          *StockThresholdRepositoryMockCountLowStockItemsParamPtrs
+	expectationOrigins StockThresholdRepositoryMockCountLowStockItemsExpectationOrigins
+	results            *StockThresholdRepositoryMockCountLowStockItemsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockThresholdRepositoryMockCountLowStockItemsParams contains parameters of the StockThresholdRepository.CountLowStockItems
+type StockThresholdRepositoryMockCountLowStockItemsParams struct {
+	ctx      context.Context
+	location string
+}
+
+// StockThresholdRepositoryMockCountLowStockItemsParamPtrs contains pointers to parameters of the StockThresholdRepository.CountLowStockItems
+type StockThresholdRepositoryMockCountLowStockItemsParamPtrs struct {
+	ctx      *context.Context
+	location *string
+}
+
+// StockThresholdRepositoryMockCountLowStockItemsResults contains results of the StockThresholdRepository.CountLowStockItems
+type StockThresholdRepositoryMockCountLowStockItemsResults struct {
+	u1  uint32
+	err error
+}
+
+// StockThresholdRepositoryMockCountLowStockItemsOrigins contains origins of expectations of the StockThresholdRepository.CountLowStockItems
+type StockThresholdRepositoryMockCountLowStockItemsExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originLocation string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCountLowStockItems *mStockThresholdRepositoryMockCountLowStockItems) Optional() *mStockThresholdRepositoryMockCountLowStockItems {
+	mmCountLowStockItems.optional = true
+	return mmCountLowStockItems
+}
+
+// Expect sets up expected params for StockThresholdRepository.CountLowStockItems
+func (mmCountLowStockItems *mStockThresholdRepositoryMockCountLowStockItems) Expect(ctx context.Context, location string) *mStockThresholdRepositoryMockCountLowStockItems {
+	if mmCountLowStockItems.mock.funcCountLowStockItems != nil {
+		mmCountLowStockItems.mock.t.Fatalf("StockThresholdRepositoryMock.CountLowStockItems mock is already set by Set")
+	}
+
+	if mmCountLowStockItems.defaultExpectation == nil {
+		mmCountLowStockItems.defaultExpectation = &StockThresholdRepositoryMockCountLowStockItemsExpectation{}
+	}
+
+	if mmCountLowStockItems.defaultExpectation.paramPtrs != nil {
+		mmCountLowStockItems.mock.t.Fatalf("StockThresholdRepositoryMock.CountLowStockItems mock is already set by ExpectParams functions")
+	}
+
+	mmCountLowStockItems.defaultExpectation.params = &StockThresholdRepositoryMockCountLowStockItemsParams{ctx, location}
+	mmCountLowStockItems.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCountLowStockItems.expectations {
+		if minimock.Equal(e.params, mmCountLowStockItems.defaultExpectation.params) {
+			mmCountLowStockItems.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCountLowStockItems.defaultExpectation.params)
+		}
+	}
+
+	return mmCountLowStockItems
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockThresholdRepository.CountLowStockItems
+func (mmCountLowStockItems *mStockThresholdRepositoryMockCountLowStockItems) ExpectCtxParam1(ctx context.Context) *mStockThresholdRepositoryMockCountLowStockItems {
+	if mmCountLowStockItems.mock.funcCountLowStockItems != nil {
+		mmCountLowStockItems.mock.t.Fatalf("StockThresholdRepositoryMock.CountLowStockItems mock is already set by Set")
+	}
+
+	if mmCountLowStockItems.defaultExpectation == nil {
+		mmCountLowStockItems.defaultExpectation = &StockThresholdRepositoryMockCountLowStockItemsExpectation{}
+	}
+
+	if mmCountLowStockItems.defaultExpectation.params != nil {
+		mmCountLowStockItems.mock.t.Fatalf("StockThresholdRepositoryMock.CountLowStockItems mock is already set by Expect")
+	}
+
+	if mmCountLowStockItems.defaultExpectation.paramPtrs == nil {
+		mmCountLowStockItems.defaultExpectation.paramPtrs = &StockThresholdRepositoryMockCountLowStockItemsParamPtrs{}
+	}
+	mmCountLowStockItems.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCountLowStockItems.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCountLowStockItems
+}
+
+// ExpectLocationParam2 sets up expected param location for StockThresholdRepository.CountLowStockItems
+func (mmCountLowStockItems *mStockThresholdRepositoryMockCountLowStockItems) ExpectLocationParam2(location string) *mStockThresholdRepositoryMockCountLowStockItems {
+	if mmCountLowStockItems.mock.funcCountLowStockItems != nil {
+		mmCountLowStockItems.mock.t.Fatalf("StockThresholdRepositoryMock.CountLowStockItems mock is already set by Set")
+	}
+
+	if mmCountLowStockItems.defaultExpectation == nil {
+		mmCountLowStockItems.defaultExpectation = &StockThresholdRepositoryMockCountLowStockItemsExpectation{}
+	}
+
+	if mmCountLowStockItems.defaultExpectation.params != nil {
+		mmCountLowStockItems.mock.t.Fatalf("StockThresholdRepositoryMock.CountLowStockItems mock is already set by Expect")
+	}
+
+	if mmCountLowStockItems.defaultExpectation.paramPtrs == nil {
+		mmCountLowStockItems.defaultExpectation.paramPtrs = &StockThresholdRepositoryMockCountLowStockItemsParamPtrs{}
+	}
+	mmCountLowStockItems.defaultExpectation.paramPtrs.location = &location
+	mmCountLowStockItems.defaultExpectation.expectationOrigins.originLocation = minimock.CallerInfo(1)
+
+	return mmCountLowStockItems
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockThresholdRepository.CountLowStockItems
+func (mmCountLowStockItems *mStockThresholdRepositoryMockCountLowStockItems) Inspect(f func(ctx context.Context, location string)) *mStockThresholdRepositoryMockCountLowStockItems {
+	if mmCountLowStockItems.mock.inspectFuncCountLowStockItems != nil {
+		mmCountLowStockItems.mock.t.Fatalf("Inspect function is already set for StockThresholdRepositoryMock.CountLowStockItems")
+	}
+
+	mmCountLowStockItems.mock.inspectFuncCountLowStockItems = f
+
+	return mmCountLowStockItems
+}
+
+// Return sets up results that will be returned by StockThresholdRepository.CountLowStockItems
+func (mmCountLowStockItems *mStockThresholdRepositoryMockCountLowStockItems) Return(u1 uint32, err error) *StockThresholdRepositoryMock {
+	if mmCountLowStockItems.mock.funcCountLowStockItems != nil {
+		mmCountLowStockItems.mock.t.Fatalf("StockThresholdRepositoryMock.CountLowStockItems mock is already set by Set")
+	}
+
+	if mmCountLowStockItems.defaultExpectation == nil {
+		mmCountLowStockItems.defaultExpectation = &StockThresholdRepositoryMockCountLowStockItemsExpectation{mock: mmCountLowStockItems.mock}
+	}
+	mmCountLowStockItems.defaultExpectation.results = &StockThresholdRepositoryMockCountLowStockItemsResults{u1, err}
+	mmCountLowStockItems.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCountLowStockItems.mock
+}
+
+// Set uses given function f to mock the StockThresholdRepository.CountLowStockItems method
+func (mmCountLowStockItems *mStockThresholdRepositoryMockCountLowStockItems) Set(f func(ctx context.Context, location string) (u1 uint32, err error)) *StockThresholdRepositoryMock {
+	if mmCountLowStockItems.defaultExpectation != nil {
+		mmCountLowStockItems.mock.t.Fatalf("Default expectation is already set for the StockThresholdRepository.CountLowStockItems method")
+	}
+
+	if len(mmCountLowStockItems.expectations) > 0 {
+		mmCountLowStockItems.mock.t.Fatalf("Some expectations are already set for the StockThresholdRepository.CountLowStockItems method")
+	}
+
+	mmCountLowStockItems.mock.funcCountLowStockItems = f
+	mmCountLowStockItems.mock.funcCountLowStockItemsOrigin = minimock.CallerInfo(1)
+	return mmCountLowStockItems.mock
+}
+
+// When sets expectation for the StockThresholdRepository.CountLowStockItems which will trigger the result defined by the following
+// Then helper
+func (mmCountLowStockItems *mStockThresholdRepositoryMockCountLowStockItems) When(ctx context.Context, location string) *StockThresholdRepositoryMockCountLowStockItemsExpectation {
+	if mmCountLowStockItems.mock.funcCountLowStockItems != nil {
+		mmCountLowStockItems.mock.t.Fatalf("StockThresholdRepositoryMock.CountLowStockItems mock is already set by Set")
+	}
+
+	expectation := &StockThresholdRepositoryMockCountLowStockItemsExpectation{
+		mock:               mmCountLowStockItems.mock,
+		params:             &StockThresholdRepositoryMockCountLowStockItemsParams{ctx, location},
+		expectationOrigins: StockThresholdRepositoryMockCountLowStockItemsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCountLowStockItems.expectations = append(mmCountLowStockItems.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockThresholdRepository.CountLowStockItems return parameters for the expectation previously defined by the When method
+func (e *StockThresholdRepositoryMockCountLowStockItemsExpectation) Then(u1 uint32, err error) *StockThresholdRepositoryMock {
+	e.results = &StockThresholdRepositoryMockCountLowStockItemsResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times StockThresholdRepository.CountLowStockItems should be invoked
+func (mmCountLowStockItems *mStockThresholdRepositoryMockCountLowStockItems) Times(n uint64) *mStockThresholdRepositoryMockCountLowStockItems {
+	if n == 0 {
+		mmCountLowStockItems.mock.t.Fatalf("Times of StockThresholdRepositoryMock.CountLowStockItems mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCountLowStockItems.expectedInvocations, n)
+	mmCountLowStockItems.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCountLowStockItems
+}
+
+func (mmCountLowStockItems *mStockThresholdRepositoryMockCountLowStockItems) invocationsDone() bool {
+	if len(mmCountLowStockItems.expectations) == 0 && mmCountLowStockItems.defaultExpectation == nil && mmCountLowStockItems.mock.funcCountLowStockItems == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCountLowStockItems.mock.afterCountLowStockItemsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCountLowStockItems.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CountLowStockItems implements mm_stocks.StockThresholdRepository
+func (mmCountLowStockItems *StockThresholdRepositoryMock) CountLowStockItems(ctx context.Context, location string) (u1 uint32, err error) {
+	mm_atomic.AddUint64(&mmCountLowStockItems.beforeCountLowStockItemsCounter, 1)
+	defer mm_atomic.AddUint64(&mmCountLowStockItems.afterCountLowStockItemsCounter, 1)
+
+	mmCountLowStockItems.t.Helper()
+
+	if mmCountLowStockItems.inspectFuncCountLowStockItems != nil {
+		mmCountLowStockItems.inspectFuncCountLowStockItems(ctx, location)
+	}
+
+	mm_params := StockThresholdRepositoryMockCountLowStockItemsParams{ctx, location}
+
+	// Record call args
+	mmCountLowStockItems.CountLowStockItemsMock.mutex.Lock()
+	mmCountLowStockItems.CountLowStockItemsMock.callArgs = append(mmCountLowStockItems.CountLowStockItemsMock.callArgs, &mm_params)
+	mmCountLowStockItems.CountLowStockItemsMock.mutex.Unlock()
+
+	for _, e := range mmCountLowStockItems.CountLowStockItemsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmCountLowStockItems.CountLowStockItemsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCountLowStockItems.CountLowStockItemsMock.defaultExpectation.Counter, 1)
+		mm_want := mmCountLowStockItems.CountLowStockItemsMock.defaultExpectation.params
+		mm_want_ptrs := mmCountLowStockItems.CountLowStockItemsMock.defaultExpectation.paramPtrs
+
+		mm_got := StockThresholdRepositoryMockCountLowStockItemsParams{ctx, location}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCountLowStockItems.t.Errorf("StockThresholdRepositoryMock.CountLowStockItems got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountLowStockItems.CountLowStockItemsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.location != nil && !minimock.Equal(*mm_want_ptrs.location, mm_got.location) {
+				mmCountLowStockItems.t.Errorf("StockThresholdRepositoryMock.CountLowStockItems got unexpected parameter location, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountLowStockItems.CountLowStockItemsMock.defaultExpectation.expectationOrigins.originLocation, *mm_want_ptrs.location, mm_got.location, minimock.Diff(*mm_want_ptrs.location, mm_got.location))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCountLowStockItems.t.Errorf("StockThresholdRepositoryMock.CountLowStockItems got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCountLowStockItems.CountLowStockItemsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCountLowStockItems.CountLowStockItemsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCountLowStockItems.t.Fatal("No results are set for the StockThresholdRepositoryMock.CountLowStockItems")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmCountLowStockItems.funcCountLowStockItems != nil {
+		return mmCountLowStockItems.funcCountLowStockItems(ctx, location)
+	}
+	mmCountLowStockItems.t.Fatalf("Unexpected call to StockThresholdRepositoryMock.CountLowStockItems. %v %v", ctx, location)
+	return
+}
+
+// CountLowStockItemsAfterCounter returns a count of finished StockThresholdRepositoryMock.CountLowStockItems invocations
+func (mmCountLowStockItems *StockThresholdRepositoryMock) CountLowStockItemsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountLowStockItems.afterCountLowStockItemsCounter)
+}
+
+// CountLowStockItemsBeforeCounter returns a count of StockThresholdRepositoryMock.CountLowStockItems invocations
+func (mmCountLowStockItems *StockThresholdRepositoryMock) CountLowStockItemsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountLowStockItems.beforeCountLowStockItemsCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockThresholdRepositoryMock.CountLowStockItems.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCountLowStockItems *mStockThresholdRepositoryMockCountLowStockItems) Calls() []*StockThresholdRepositoryMockCountLowStockItemsParams {
+	mmCountLowStockItems.mutex.RLock()
+
+	argCopy := make([]*StockThresholdRepositoryMockCountLowStockItemsParams, len(mmCountLowStockItems.callArgs))
+	copy(argCopy, mmCountLowStockItems.callArgs)
+
+	mmCountLowStockItems.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountLowStockItemsDone returns true if the count of the CountLowStockItems invocations corresponds
+// the number of defined expectations
+func (m *StockThresholdRepositoryMock) MinimockCountLowStockItemsDone() bool {
+	if m.CountLowStockItemsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountLowStockItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountLowStockItemsMock.invocationsDone()
+}
+
+// MinimockCountLowStockItemsInspect logs each unmet expectation
+func (m *StockThresholdRepositoryMock) MinimockCountLowStockItemsInspect() {
+	for _, e := range m.CountLowStockItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockThresholdRepositoryMock.CountLowStockItems at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCountLowStockItemsCounter := mm_atomic.LoadUint64(&m.afterCountLowStockItemsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountLowStockItemsMock.defaultExpectation != nil && afterCountLowStockItemsCounter < 1 {
+		if m.CountLowStockItemsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockThresholdRepositoryMock.CountLowStockItems at\n%s", m.CountLowStockItemsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockThresholdRepositoryMock.CountLowStockItems at\n%s with params: %#v", m.CountLowStockItemsMock.defaultExpectation.expectationOrigins.origin, *m.CountLowStockItemsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCountLowStockItems != nil && afterCountLowStockItemsCounter < 1 {
+		m.t.Errorf("Expected call to StockThresholdRepositoryMock.CountLowStockItems at\n%s", m.funcCountLowStockItemsOrigin)
+	}
+
+	if !m.CountLowStockItemsMock.invocationsDone() && afterCountLowStockItemsCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockThresholdRepositoryMock.CountLowStockItems at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CountLowStockItemsMock.expectedInvocations), m.CountLowStockItemsMock.expectedInvocationsOrigin, afterCountLowStockItemsCounter)
+	}
+}
+
+type mStockThresholdRepositoryMockGetStockThreshold struct {
+	optional           bool
+	mock               *StockThresholdRepositoryMock
+	defaultExpectation *StockThresholdRepositoryMockGetStockThresholdExpectation
+	expectations       []*StockThresholdRepositoryMockGetStockThresholdExpectation
+
+	callArgs []*StockThresholdRepositoryMockGetStockThresholdParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockThresholdRepositoryMockGetStockThresholdExpectation specifies expectation struct of the StockThresholdRepository.GetStockThreshold
+type StockThresholdRepositoryMockGetStockThresholdExpectation struct {
+	mock               *StockThresholdRepositoryMock
+	params             *StockThresholdRepositoryMockGetStockThresholdParams
+	paramPtrs          *StockThresholdRepositoryMockGetStockThresholdParamPtrs
+	expectationOrigins StockThresholdRepositoryMockGetStockThresholdExpectationOrigins
+	results            *StockThresholdRepositoryMockGetStockThresholdResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockThresholdRepositoryMockGetStockThresholdParams contains parameters of the StockThresholdRepository.GetStockThreshold
+type StockThresholdRepositoryMockGetStockThresholdParams struct {
+	ctx      context.Context
+	skuID    domain.SKUID
+	location string
+}
+
+// StockThresholdRepositoryMockGetStockThresholdParamPtrs contains pointers to parameters of the StockThresholdRepository.GetStockThreshold
+type StockThresholdRepositoryMockGetStockThresholdParamPtrs struct {
+	ctx      *context.Context
+	skuID    *domain.SKUID
+	location *string
+}
+
+// StockThresholdRepositoryMockGetStockThresholdResults contains results of the StockThresholdRepository.GetStockThreshold
+type StockThresholdRepositoryMockGetStockThresholdResults struct {
+	s1  domain.StockThreshold
+	err error
+}
+
+// StockThresholdRepositoryMockGetStockThresholdOrigins contains origins of expectations of the StockThresholdRepository.GetStockThreshold
+type StockThresholdRepositoryMockGetStockThresholdExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originSkuID    string
+	originLocation string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetStockThreshold *mStockThresholdRepositoryMockGetStockThreshold) Optional() *mStockThresholdRepositoryMockGetStockThreshold {
+	mmGetStockThreshold.optional = true
+	return mmGetStockThreshold
+}
+
+// Expect sets up expected params for StockThresholdRepository.GetStockThreshold
+func (mmGetStockThreshold *mStockThresholdRepositoryMockGetStockThreshold) Expect(ctx context.Context, skuID domain.SKUID, location string) *mStockThresholdRepositoryMockGetStockThreshold {
+	if mmGetStockThreshold.mock.funcGetStockThreshold != nil {
+		mmGetStockThreshold.mock.t.Fatalf("StockThresholdRepositoryMock.GetStockThreshold mock is already set by Set")
+	}
+
+	if mmGetStockThreshold.defaultExpectation == nil {
+		mmGetStockThreshold.defaultExpectation = &StockThresholdRepositoryMockGetStockThresholdExpectation{}
+	}
+
+	if mmGetStockThreshold.defaultExpectation.paramPtrs != nil {
+		mmGetStockThreshold.mock.t.Fatalf("StockThresholdRepositoryMock.GetStockThreshold mock is already set by ExpectParams functions")
+	}
+
+	mmGetStockThreshold.defaultExpectation.params = &StockThresholdRepositoryMockGetStockThresholdParams{ctx, skuID, location}
+	mmGetStockThreshold.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetStockThreshold.expectations {
+		if minimock.Equal(e.params, mmGetStockThreshold.defaultExpectation.params) {
+			mmGetStockThreshold.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetStockThreshold.defaultExpectation.params)
+		}
+	}
+
+	return mmGetStockThreshold
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockThresholdRepository.GetStockThreshold
+func (mmGetStockThreshold *mStockThresholdRepositoryMockGetStockThreshold) ExpectCtxParam1(ctx context.Context) *mStockThresholdRepositoryMockGetStockThreshold {
+	if mmGetStockThreshold.mock.funcGetStockThreshold != nil {
+		mmGetStockThreshold.mock.t.Fatalf("StockThresholdRepositoryMock.GetStockThreshold mock is already set by Set")
+	}
+
+	if mmGetStockThreshold.defaultExpectation == nil {
+		mmGetStockThreshold.defaultExpectation = &StockThresholdRepositoryMockGetStockThresholdExpectation{}
+	}
+
+	if mmGetStockThreshold.defaultExpectation.params != nil {
+		mmGetStockThreshold.mock.t.Fatalf("StockThresholdRepositoryMock.GetStockThreshold mock is already set by Expect")
+	}
+
+	if mmGetStockThreshold.defaultExpectation.paramPtrs == nil {
+		mmGetStockThreshold.defaultExpectation.paramPtrs = &StockThresholdRepositoryMockGetStockThresholdParamPtrs{}
+	}
+	mmGetStockThreshold.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetStockThreshold.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetStockThreshold
+}
+
+// ExpectSkuIDParam2 sets up expected param skuID for StockThresholdRepository.GetStockThreshold
+func (mmGetStockThreshold *mStockThresholdRepositoryMockGetStockThreshold) ExpectSkuIDParam2(skuID domain.SKUID) *mStockThresholdRepositoryMockGetStockThreshold {
+	if mmGetStockThreshold.mock.funcGetStockThreshold != nil {
+		mmGetStockThreshold.mock.t.Fatalf("StockThresholdRepositoryMock.GetStockThreshold mock is already set by Set")
+	}
+
+	if mmGetStockThreshold.defaultExpectation == nil {
+		mmGetStockThreshold.defaultExpectation = &StockThresholdRepositoryMockGetStockThresholdExpectation{}
+	}
+
+	if mmGetStockThreshold.defaultExpectation.params != nil {
+		mmGetStockThreshold.mock.t.Fatalf("StockThresholdRepositoryMock.GetStockThreshold mock is already set by Expect")
+	}
+
+	if mmGetStockThreshold.defaultExpectation.paramPtrs == nil {
+		mmGetStockThreshold.defaultExpectation.paramPtrs = &StockThresholdRepositoryMockGetStockThresholdParamPtrs{}
+	}
+	mmGetStockThreshold.defaultExpectation.paramPtrs.skuID = &skuID
+	mmGetStockThreshold.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmGetStockThreshold
+}
+
+// ExpectLocationParam3 sets up expected param location for StockThresholdRepository.GetStockThreshold
+func (mmGetStockThreshold *mStockThresholdRepositoryMockGetStockThreshold) ExpectLocationParam3(location string) *mStockThresholdRepositoryMockGetStockThreshold {
+	if mmGetStockThreshold.mock.funcGetStockThreshold != nil {
+		mmGetStockThreshold.mock.t.Fatalf("StockThresholdRepositoryMock.GetStockThreshold mock is already set by Set")
+	}
+
+	if mmGetStockThreshold.defaultExpectation == nil {
+		mmGetStockThreshold.defaultExpectation = &StockThresholdRepositoryMockGetStockThresholdExpectation{}
+	}
+
+	if mmGetStockThreshold.defaultExpectation.params != nil {
+		mmGetStockThreshold.mock.t.Fatalf("StockThresholdRepositoryMock.GetStockThreshold mock is already set by Expect")
+	}
+
+	if mmGetStockThreshold.defaultExpectation.paramPtrs == nil {
+		mmGetStockThreshold.defaultExpectation.paramPtrs = &StockThresholdRepositoryMockGetStockThresholdParamPtrs{}
+	}
+	mmGetStockThreshold.defaultExpectation.paramPtrs.location = &location
+	mmGetStockThreshold.defaultExpectation.expectationOrigins.originLocation = minimock.CallerInfo(1)
+
+	return mmGetStockThreshold
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockThresholdRepository.GetStockThreshold
+func (mmGetStockThreshold *mStockThresholdRepositoryMockGetStockThreshold) Inspect(f func(ctx context.Context, skuID domain.SKUID, location string)) *mStockThresholdRepositoryMockGetStockThreshold {
+	if mmGetStockThreshold.mock.inspectFuncGetStockThreshold != nil {
+		mmGetStockThreshold.mock.t.Fatalf("Inspect function is already set for StockThresholdRepositoryMock.GetStockThreshold")
+	}
+
+	mmGetStockThreshold.mock.inspectFuncGetStockThreshold = f
+
+	return mmGetStockThreshold
+}
+
+// Return sets up results that will be returned by StockThresholdRepository.GetStockThreshold
+func (mmGetStockThreshold *mStockThresholdRepositoryMockGetStockThreshold) Return(s1 domain.StockThreshold, err error) *StockThresholdRepositoryMock {
+	if mmGetStockThreshold.mock.funcGetStockThreshold != nil {
+		mmGetStockThreshold.mock.t.Fatalf("StockThresholdRepositoryMock.GetStockThreshold mock is already set by Set")
+	}
+
+	if mmGetStockThreshold.defaultExpectation == nil {
+		mmGetStockThreshold.defaultExpectation = &StockThresholdRepositoryMockGetStockThresholdExpectation{mock: mmGetStockThreshold.mock}
+	}
+	mmGetStockThreshold.defaultExpectation.results = &StockThresholdRepositoryMockGetStockThresholdResults{s1, err}
+	mmGetStockThreshold.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetStockThreshold.mock
+}
+
+// Set uses given function f to mock the StockThresholdRepository.GetStockThreshold method
+func (mmGetStockThreshold *mStockThresholdRepositoryMockGetStockThreshold) Set(f func(ctx context.Context, skuID domain.SKUID, location string) (s1 domain.StockThreshold, err error)) *StockThresholdRepositoryMock {
+	if mmGetStockThreshold.defaultExpectation != nil {
+		mmGetStockThreshold.mock.t.Fatalf("Default expectation is already set for the StockThresholdRepository.GetStockThreshold method")
+	}
+
+	if len(mmGetStockThreshold.expectations) > 0 {
+		mmGetStockThreshold.mock.t.Fatalf("Some expectations are already set for the StockThresholdRepository.GetStockThreshold method")
+	}
+
+	mmGetStockThreshold.mock.funcGetStockThreshold = f
+	mmGetStockThreshold.mock.funcGetStockThresholdOrigin = minimock.CallerInfo(1)
+	return mmGetStockThreshold.mock
+}
+
+// When sets expectation for the StockThresholdRepository.GetStockThreshold which will trigger the result defined by the following
+// Then helper
+func (mmGetStockThreshold *mStockThresholdRepositoryMockGetStockThreshold) When(ctx context.Context, skuID domain.SKUID, location string) *StockThresholdRepositoryMockGetStockThresholdExpectation {
+	if mmGetStockThreshold.mock.funcGetStockThreshold != nil {
+		mmGetStockThreshold.mock.t.Fatalf("StockThresholdRepositoryMock.GetStockThreshold mock is already set by Set")
+	}
+
+	expectation := &StockThresholdRepositoryMockGetStockThresholdExpectation{
+		mock:               mmGetStockThreshold.mock,
+		params:             &StockThresholdRepositoryMockGetStockThresholdParams{ctx, skuID, location},
+		expectationOrigins: StockThresholdRepositoryMockGetStockThresholdExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetStockThreshold.expectations = append(mmGetStockThreshold.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockThresholdRepository.GetStockThreshold return parameters for the expectation previously defined by the When method
+func (e *StockThresholdRepositoryMockGetStockThresholdExpectation) Then(s1 domain.StockThreshold, err error) *StockThresholdRepositoryMock {
+	e.results = &StockThresholdRepositoryMockGetStockThresholdResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times StockThresholdRepository.GetStockThreshold should be invoked
+func (mmGetStockThreshold *mStockThresholdRepositoryMockGetStockThreshold) Times(n uint64) *mStockThresholdRepositoryMockGetStockThreshold {
+	if n == 0 {
+		mmGetStockThreshold.mock.t.Fatalf("Times of StockThresholdRepositoryMock.GetStockThreshold mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetStockThreshold.expectedInvocations, n)
+	mmGetStockThreshold.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetStockThreshold
+}
+
+func (mmGetStockThreshold *mStockThresholdRepositoryMockGetStockThreshold) invocationsDone() bool {
+	if len(mmGetStockThreshold.expectations) == 0 && mmGetStockThreshold.defaultExpectation == nil && mmGetStockThreshold.mock.funcGetStockThreshold == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetStockThreshold.mock.afterGetStockThresholdCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetStockThreshold.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetStockThreshold implements mm_stocks.StockThresholdRepository
+func (mmGetStockThreshold *StockThresholdRepositoryMock) GetStockThreshold(ctx context.Context, skuID domain.SKUID, location string) (s1 domain.StockThreshold, err error) {
+	mm_atomic.AddUint64(&mmGetStockThreshold.beforeGetStockThresholdCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetStockThreshold.afterGetStockThresholdCounter, 1)
+
+	mmGetStockThreshold.t.Helper()
+
+	if mmGetStockThreshold.inspectFuncGetStockThreshold != nil {
+		mmGetStockThreshold.inspectFuncGetStockThreshold(ctx, skuID, location)
+	}
+
+	mm_params := StockThresholdRepositoryMockGetStockThresholdParams{ctx, skuID, location}
+
+	// Record call args
+	mmGetStockThreshold.GetStockThresholdMock.mutex.Lock()
+	mmGetStockThreshold.GetStockThresholdMock.callArgs = append(mmGetStockThreshold.GetStockThresholdMock.callArgs, &mm_params)
+	mmGetStockThreshold.GetStockThresholdMock.mutex.Unlock()
+
+	for _, e := range mmGetStockThreshold.GetStockThresholdMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetStockThreshold.GetStockThresholdMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetStockThreshold.GetStockThresholdMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetStockThreshold.GetStockThresholdMock.defaultExpectation.params
+		mm_want_ptrs := mmGetStockThreshold.GetStockThresholdMock.defaultExpectation.paramPtrs
+
+		mm_got := StockThresholdRepositoryMockGetStockThresholdParams{ctx, skuID, location}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetStockThreshold.t.Errorf("StockThresholdRepositoryMock.GetStockThreshold got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetStockThreshold.GetStockThresholdMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmGetStockThreshold.t.Errorf("StockThresholdRepositoryMock.GetStockThreshold got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetStockThreshold.GetStockThresholdMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.location != nil && !minimock.Equal(*mm_want_ptrs.location, mm_got.location) {
+				mmGetStockThreshold.t.Errorf("StockThresholdRepositoryMock.GetStockThreshold got unexpected parameter location, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetStockThreshold.GetStockThresholdMock.defaultExpectation.expectationOrigins.originLocation, *mm_want_ptrs.location, mm_got.location, minimock.Diff(*mm_want_ptrs.location, mm_got.location))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetStockThreshold.t.Errorf("StockThresholdRepositoryMock.GetStockThreshold got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetStockThreshold.GetStockThresholdMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetStockThreshold.GetStockThresholdMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetStockThreshold.t.Fatal("No results are set for the StockThresholdRepositoryMock.GetStockThreshold")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetStockThreshold.funcGetStockThreshold != nil {
+		return mmGetStockThreshold.funcGetStockThreshold(ctx, skuID, location)
+	}
+	mmGetStockThreshold.t.Fatalf("Unexpected call to StockThresholdRepositoryMock.GetStockThreshold. %v %v %v", ctx, skuID, location)
+	return
+}
+
+// GetStockThresholdAfterCounter returns a count of finished StockThresholdRepositoryMock.GetStockThreshold invocations
+func (mmGetStockThreshold *StockThresholdRepositoryMock) GetStockThresholdAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetStockThreshold.afterGetStockThresholdCounter)
+}
+
+// GetStockThresholdBeforeCounter returns a count of StockThresholdRepositoryMock.GetStockThreshold invocations
+func (mmGetStockThreshold *StockThresholdRepositoryMock) GetStockThresholdBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetStockThreshold.beforeGetStockThresholdCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockThresholdRepositoryMock.GetStockThreshold.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetStockThreshold *mStockThresholdRepositoryMockGetStockThreshold) Calls() []*StockThresholdRepositoryMockGetStockThresholdParams {
+	mmGetStockThreshold.mutex.RLock()
+
+	argCopy := make([]*StockThresholdRepositoryMockGetStockThresholdParams, len(mmGetStockThreshold.callArgs))
+	copy(argCopy, mmGetStockThreshold.callArgs)
+
+	mmGetStockThreshold.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetStockThresholdDone returns true if the count of the GetStockThreshold invocations corresponds
+// the number of defined expectations
+func (m *StockThresholdRepositoryMock) MinimockGetStockThresholdDone() bool {
+	if m.GetStockThresholdMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetStockThresholdMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetStockThresholdMock.invocationsDone()
+}
+
+// MinimockGetStockThresholdInspect logs each unmet expectation
+func (m *StockThresholdRepositoryMock) MinimockGetStockThresholdInspect() {
+	for _, e := range m.GetStockThresholdMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockThresholdRepositoryMock.GetStockThreshold at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetStockThresholdCounter := mm_atomic.LoadUint64(&m.afterGetStockThresholdCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetStockThresholdMock.defaultExpectation != nil && afterGetStockThresholdCounter < 1 {
+		if m.GetStockThresholdMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockThresholdRepositoryMock.GetStockThreshold at\n%s", m.GetStockThresholdMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockThresholdRepositoryMock.GetStockThreshold at\n%s with params: %#v", m.GetStockThresholdMock.defaultExpectation.expectationOrigins.origin, *m.GetStockThresholdMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetStockThreshold != nil && afterGetStockThresholdCounter < 1 {
+		m.t.Errorf("Expected call to StockThresholdRepositoryMock.GetStockThreshold at\n%s", m.funcGetStockThresholdOrigin)
+	}
+
+	if !m.GetStockThresholdMock.invocationsDone() && afterGetStockThresholdCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockThresholdRepositoryMock.GetStockThreshold at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetStockThresholdMock.expectedInvocations), m.GetStockThresholdMock.expectedInvocationsOrigin, afterGetStockThresholdCounter)
+	}
+}
+
+type mStockThresholdRepositoryMockListLowStockItems struct {
+	optional           bool
+	mock               *StockThresholdRepositoryMock
+	defaultExpectation *StockThresholdRepositoryMockListLowStockItemsExpectation
+	expectations       []*StockThresholdRepositoryMockListLowStockItemsExpectation
+
+	callArgs []*StockThresholdRepositoryMockListLowStockItemsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockThresholdRepositoryMockListLowStockItemsExpectation specifies expectation struct of the StockThresholdRepository.ListLowStockItems
+type StockThresholdRepositoryMockListLowStockItemsExpectation struct {
+	mock               *StockThresholdRepositoryMock
+	params             *StockThresholdRepositoryMockListLowStockItemsParams
+	paramPtrs          *StockThresholdRepositoryMockListLowStockItemsParamPtrs
+	expectationOrigins StockThresholdRepositoryMockListLowStockItemsExpectationOrigins
+	results            *StockThresholdRepositoryMockListLowStockItemsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockThresholdRepositoryMockListLowStockItemsParams contains parameters of the StockThresholdRepository.ListLowStockItems
+type StockThresholdRepositoryMockListLowStockItemsParams struct {
+	ctx    context.Context
+	filter domain.LowStockFilter
+}
+
+// StockThresholdRepositoryMockListLowStockItemsParamPtrs contains pointers to parameters of the StockThresholdRepository.ListLowStockItems
+type StockThresholdRepositoryMockListLowStockItemsParamPtrs struct {
+	ctx    *context.Context
+	filter *domain.LowStockFilter
+}
+
+// StockThresholdRepositoryMockListLowStockItemsResults contains results of the StockThresholdRepository.ListLowStockItems
+type StockThresholdRepositoryMockListLowStockItemsResults struct {
+	la1 []domain.LowStockItem
+	err error
+}
+
+// StockThresholdRepositoryMockListLowStockItemsOrigins contains origins of expectations of the StockThresholdRepository.ListLowStockItems
+type StockThresholdRepositoryMockListLowStockItemsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListLowStockItems *mStockThresholdRepositoryMockListLowStockItems) Optional() *mStockThresholdRepositoryMockListLowStockItems {
+	mmListLowStockItems.optional = true
+	return mmListLowStockItems
+}
+
+// Expect sets up expected params for StockThresholdRepository.ListLowStockItems
+func (mmListLowStockItems *mStockThresholdRepositoryMockListLowStockItems) Expect(ctx context.Context, filter domain.LowStockFilter) *mStockThresholdRepositoryMockListLowStockItems {
+	if mmListLowStockItems.mock.funcListLowStockItems != nil {
+		mmListLowStockItems.mock.t.Fatalf("StockThresholdRepositoryMock.ListLowStockItems mock is already set by Set")
+	}
+
+	if mmListLowStockItems.defaultExpectation == nil {
+		mmListLowStockItems.defaultExpectation = &StockThresholdRepositoryMockListLowStockItemsExpectation{}
+	}
+
+	if mmListLowStockItems.defaultExpectation.paramPtrs != nil {
+		mmListLowStockItems.mock.t.Fatalf("StockThresholdRepositoryMock.ListLowStockItems mock is already set by ExpectParams functions")
+	}
+
+	mmListLowStockItems.defaultExpectation.params = &StockThresholdRepositoryMockListLowStockItemsParams{ctx, filter}
+	mmListLowStockItems.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListLowStockItems.expectations {
+		if minimock.Equal(e.params, mmListLowStockItems.defaultExpectation.params) {
+			mmListLowStockItems.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListLowStockItems.defaultExpectation.params)
+		}
+	}
+
+	return mmListLowStockItems
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockThresholdRepository.ListLowStockItems
+func (mmListLowStockItems *mStockThresholdRepositoryMockListLowStockItems) ExpectCtxParam1(ctx context.Context) *mStockThresholdRepositoryMockListLowStockItems {
+	if mmListLowStockItems.mock.funcListLowStockItems != nil {
+		mmListLowStockItems.mock.t.Fatalf("StockThresholdRepositoryMock.ListLowStockItems mock is already set by Set")
+	}
+
+	if mmListLowStockItems.defaultExpectation == nil {
+		mmListLowStockItems.defaultExpectation = &StockThresholdRepositoryMockListLowStockItemsExpectation{}
+	}
+
+	if mmListLowStockItems.defaultExpectation.params != nil {
+		mmListLowStockItems.mock.t.Fatalf("StockThresholdRepositoryMock.ListLowStockItems mock is already set by Expect")
+	}
+
+	if mmListLowStockItems.defaultExpectation.paramPtrs == nil {
+		mmListLowStockItems.defaultExpectation.paramPtrs = &StockThresholdRepositoryMockListLowStockItemsParamPtrs{}
+	}
+	mmListLowStockItems.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListLowStockItems.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListLowStockItems
+}
+
+// ExpectFilterParam2 sets up expected param filter for StockThresholdRepository.ListLowStockItems
+func (mmListLowStockItems *mStockThresholdRepositoryMockListLowStockItems) ExpectFilterParam2(filter domain.LowStockFilter) *mStockThresholdRepositoryMockListLowStockItems {
+	if mmListLowStockItems.mock.funcListLowStockItems != nil {
+		mmListLowStockItems.mock.t.Fatalf("StockThresholdRepositoryMock.ListLowStockItems mock is already set by Set")
+	}
+
+	if mmListLowStockItems.defaultExpectation == nil {
+		mmListLowStockItems.defaultExpectation = &StockThresholdRepositoryMockListLowStockItemsExpectation{}
+	}
+
+	if mmListLowStockItems.defaultExpectation.params != nil {
+		mmListLowStockItems.mock.t.Fatalf("StockThresholdRepositoryMock.ListLowStockItems mock is already set by Expect")
+	}
+
+	if mmListLowStockItems.defaultExpectation.paramPtrs == nil {
+		mmListLowStockItems.defaultExpectation.paramPtrs = &StockThresholdRepositoryMockListLowStockItemsParamPtrs{}
+	}
+	mmListLowStockItems.defaultExpectation.paramPtrs.filter = &filter
+	mmListLowStockItems.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmListLowStockItems
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockThresholdRepository.ListLowStockItems
+func (mmListLowStockItems *mStockThresholdRepositoryMockListLowStockItems) Inspect(f func(ctx context.Context, filter domain.LowStockFilter)) *mStockThresholdRepositoryMockListLowStockItems {
+	if mmListLowStockItems.mock.inspectFuncListLowStockItems != nil {
+		mmListLowStockItems.mock.t.Fatalf("Inspect function is already set for StockThresholdRepositoryMock.ListLowStockItems")
+	}
+
+	mmListLowStockItems.mock.inspectFuncListLowStockItems = f
+
+	return mmListLowStockItems
+}
+
+// Return sets up results that will be returned by StockThresholdRepository.ListLowStockItems
+func (mmListLowStockItems *mStockThresholdRepositoryMockListLowStockItems) Return(la1 []domain.LowStockItem, err error) *StockThresholdRepositoryMock {
+	if mmListLowStockItems.mock.funcListLowStockItems != nil {
+		mmListLowStockItems.mock.t.Fatalf("StockThresholdRepositoryMock.ListLowStockItems mock is already set by Set")
+	}
+
+	if mmListLowStockItems.defaultExpectation == nil {
+		mmListLowStockItems.defaultExpectation = &StockThresholdRepositoryMockListLowStockItemsExpectation{mock: mmListLowStockItems.mock}
+	}
+	mmListLowStockItems.defaultExpectation.results = &StockThresholdRepositoryMockListLowStockItemsResults{la1, err}
+	mmListLowStockItems.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListLowStockItems.mock
+}
+
+// Set uses given function f to mock the StockThresholdRepository.ListLowStockItems method
+func (mmListLowStockItems *mStockThresholdRepositoryMockListLowStockItems) Set(f func(ctx context.Context, filter domain.LowStockFilter) (la1 []domain.LowStockItem, err error)) *StockThresholdRepositoryMock {
+	if mmListLowStockItems.defaultExpectation != nil {
+		mmListLowStockItems.mock.t.Fatalf("Default expectation is already set for the StockThresholdRepository.ListLowStockItems method")
+	}
+
+	if len(mmListLowStockItems.expectations) > 0 {
+		mmListLowStockItems.mock.t.Fatalf("Some expectations are already set for the StockThresholdRepository.ListLowStockItems method")
+	}
+
+	mmListLowStockItems.mock.funcListLowStockItems = f
+	mmListLowStockItems.mock.funcListLowStockItemsOrigin = minimock.CallerInfo(1)
+	return mmListLowStockItems.mock
+}
+
+// When sets expectation for the StockThresholdRepository.ListLowStockItems which will trigger the result defined by the following
+// Then helper
+func (mmListLowStockItems *mStockThresholdRepositoryMockListLowStockItems) When(ctx context.Context, filter domain.LowStockFilter) *StockThresholdRepositoryMockListLowStockItemsExpectation {
+	if mmListLowStockItems.mock.funcListLowStockItems != nil {
+		mmListLowStockItems.mock.t.Fatalf("StockThresholdRepositoryMock.ListLowStockItems mock is already set by Set")
+	}
+
+	expectation := &StockThresholdRepositoryMockListLowStockItemsExpectation{
+		mock:               mmListLowStockItems.mock,
+		params:             &StockThresholdRepositoryMockListLowStockItemsParams{ctx, filter},
+		expectationOrigins: StockThresholdRepositoryMockListLowStockItemsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListLowStockItems.expectations = append(mmListLowStockItems.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockThresholdRepository.ListLowStockItems return parameters for the expectation previously defined by the When method
+func (e *StockThresholdRepositoryMockListLowStockItemsExpectation) Then(la1 []domain.LowStockItem, err error) *StockThresholdRepositoryMock {
+	e.results = &StockThresholdRepositoryMockListLowStockItemsResults{la1, err}
+	return e.mock
+}
+
+// Times sets number of times StockThresholdRepository.ListLowStockItems should be invoked
+func (mmListLowStockItems *mStockThresholdRepositoryMockListLowStockItems) Times(n uint64) *mStockThresholdRepositoryMockListLowStockItems {
+	if n == 0 {
+		mmListLowStockItems.mock.t.Fatalf("Times of StockThresholdRepositoryMock.ListLowStockItems mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListLowStockItems.expectedInvocations, n)
+	mmListLowStockItems.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListLowStockItems
+}
+
+func (mmListLowStockItems *mStockThresholdRepositoryMockListLowStockItems) invocationsDone() bool {
+	if len(mmListLowStockItems.expectations) == 0 && mmListLowStockItems.defaultExpectation == nil && mmListLowStockItems.mock.funcListLowStockItems == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListLowStockItems.mock.afterListLowStockItemsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListLowStockItems.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListLowStockItems implements mm_stocks.StockThresholdRepository
+func (mmListLowStockItems *StockThresholdRepositoryMock) ListLowStockItems(ctx context.Context, filter domain.LowStockFilter) (la1 []domain.LowStockItem, err error) {
+	mm_atomic.AddUint64(&mmListLowStockItems.beforeListLowStockItemsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListLowStockItems.afterListLowStockItemsCounter, 1)
+
+	mmListLowStockItems.t.Helper()
+
+	if mmListLowStockItems.inspectFuncListLowStockItems != nil {
+		mmListLowStockItems.inspectFuncListLowStockItems(ctx, filter)
+	}
+
+	mm_params := StockThresholdRepositoryMockListLowStockItemsParams{ctx, filter}
+
+	// Record call args
+	mmListLowStockItems.ListLowStockItemsMock.mutex.Lock()
+	mmListLowStockItems.ListLowStockItemsMock.callArgs = append(mmListLowStockItems.ListLowStockItemsMock.callArgs, &mm_params)
+	mmListLowStockItems.ListLowStockItemsMock.mutex.Unlock()
+
+	for _, e := range mmListLowStockItems.ListLowStockItemsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.la1, e.results.err
+		}
+	}
+
+	if mmListLowStockItems.ListLowStockItemsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListLowStockItems.ListLowStockItemsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListLowStockItems.ListLowStockItemsMock.defaultExpectation.params
+		mm_want_ptrs := mmListLowStockItems.ListLowStockItemsMock.defaultExpectation.paramPtrs
+
+		mm_got := StockThresholdRepositoryMockListLowStockItemsParams{ctx, filter}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListLowStockItems.t.Errorf("StockThresholdRepositoryMock.ListLowStockItems got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListLowStockItems.ListLowStockItemsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmListLowStockItems.t.Errorf("StockThresholdRepositoryMock.ListLowStockItems got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListLowStockItems.ListLowStockItemsMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListLowStockItems.t.Errorf("StockThresholdRepositoryMock.ListLowStockItems got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListLowStockItems.ListLowStockItemsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListLowStockItems.ListLowStockItemsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListLowStockItems.t.Fatal("No results are set for the StockThresholdRepositoryMock.ListLowStockItems")
+		}
+		return (*mm_results).la1, (*mm_results).err
+	}
+	if mmListLowStockItems.funcListLowStockItems != nil {
+		return mmListLowStockItems.funcListLowStockItems(ctx, filter)
+	}
+	mmListLowStockItems.t.Fatalf("Unexpected call to StockThresholdRepositoryMock.ListLowStockItems. %v %v", ctx, filter)
+	return
+}
+
+// ListLowStockItemsAfterCounter returns a count of finished StockThresholdRepositoryMock.ListLowStockItems invocations
+func (mmListLowStockItems *StockThresholdRepositoryMock) ListLowStockItemsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListLowStockItems.afterListLowStockItemsCounter)
+}
+
+// ListLowStockItemsBeforeCounter returns a count of StockThresholdRepositoryMock.ListLowStockItems invocations
+func (mmListLowStockItems *StockThresholdRepositoryMock) ListLowStockItemsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListLowStockItems.beforeListLowStockItemsCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockThresholdRepositoryMock.ListLowStockItems.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListLowStockItems *mStockThresholdRepositoryMockListLowStockItems) Calls() []*StockThresholdRepositoryMockListLowStockItemsParams {
+	mmListLowStockItems.mutex.RLock()
+
+	argCopy := make([]*StockThresholdRepositoryMockListLowStockItemsParams, len(mmListLowStockItems.callArgs))
+	copy(argCopy, mmListLowStockItems.callArgs)
+
+	mmListLowStockItems.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListLowStockItemsDone returns true if the count of the ListLowStockItems invocations corresponds
+// the number of defined expectations
+func (m *StockThresholdRepositoryMock) MinimockListLowStockItemsDone() bool {
+	if m.ListLowStockItemsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListLowStockItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListLowStockItemsMock.invocationsDone()
+}
+
+// MinimockListLowStockItemsInspect logs each unmet expectation
+func (m *StockThresholdRepositoryMock) MinimockListLowStockItemsInspect() {
+	for _, e := range m.ListLowStockItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockThresholdRepositoryMock.ListLowStockItems at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListLowStockItemsCounter := mm_atomic.LoadUint64(&m.afterListLowStockItemsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListLowStockItemsMock.defaultExpectation != nil && afterListLowStockItemsCounter < 1 {
+		if m.ListLowStockItemsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockThresholdRepositoryMock.ListLowStockItems at\n%s", m.ListLowStockItemsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockThresholdRepositoryMock.ListLowStockItems at\n%s with params: %#v", m.ListLowStockItemsMock.defaultExpectation.expectationOrigins.origin, *m.ListLowStockItemsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListLowStockItems != nil && afterListLowStockItemsCounter < 1 {
+		m.t.Errorf("Expected call to StockThresholdRepositoryMock.ListLowStockItems at\n%s", m.funcListLowStockItemsOrigin)
+	}
+
+	if !m.ListLowStockItemsMock.invocationsDone() && afterListLowStockItemsCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockThresholdRepositoryMock.ListLowStockItems at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListLowStockItemsMock.expectedInvocations), m.ListLowStockItemsMock.expectedInvocationsOrigin, afterListLowStockItemsCounter)
+	}
+}
+
+type mStockThresholdRepositoryMockSaveStockThreshold struct {
+	optional           bool
+	mock               *StockThresholdRepositoryMock
+	defaultExpectation *StockThresholdRepositoryMockSaveStockThresholdExpectation
+	expectations       []*StockThresholdRepositoryMockSaveStockThresholdExpectation
+
+	callArgs []*StockThresholdRepositoryMockSaveStockThresholdParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockThresholdRepositoryMockSaveStockThresholdExpectation specifies expectation struct of the StockThresholdRepository.SaveStockThreshold
+type StockThresholdRepositoryMockSaveStockThresholdExpectation struct {
+	mock               *StockThresholdRepositoryMock
+	params             *StockThresholdRepositoryMockSaveStockThresholdParams
+	paramPtrs          *StockThresholdRepositoryMockSaveStockThresholdParamPtrs
+	expectationOrigins StockThresholdRepositoryMockSaveStockThresholdExpectationOrigins
+	results            *StockThresholdRepositoryMockSaveStockThresholdResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockThresholdRepositoryMockSaveStockThresholdParams contains parameters of the StockThresholdRepository.SaveStockThreshold
+type StockThresholdRepositoryMockSaveStockThresholdParams struct {
+	ctx       context.Context
+	threshold domain.StockThreshold
+}
+
+// StockThresholdRepositoryMockSaveStockThresholdParamPtrs contains pointers to parameters of the StockThresholdRepository.SaveStockThreshold
+type StockThresholdRepositoryMockSaveStockThresholdParamPtrs struct {
+	ctx       *context.Context
+	threshold *domain.StockThreshold
+}
+
+// StockThresholdRepositoryMockSaveStockThresholdResults contains results of the StockThresholdRepository.SaveStockThreshold
+type StockThresholdRepositoryMockSaveStockThresholdResults struct {
+	err error
+}
+
+// StockThresholdRepositoryMockSaveStockThresholdOrigins contains origins of expectations of the StockThresholdRepository.SaveStockThreshold
+type StockThresholdRepositoryMockSaveStockThresholdExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originThreshold string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSaveStockThreshold *mStockThresholdRepositoryMockSaveStockThreshold) Optional() *mStockThresholdRepositoryMockSaveStockThreshold {
+	mmSaveStockThreshold.optional = true
+	return mmSaveStockThreshold
+}
+
+// Expect sets up expected params for StockThresholdRepository.SaveStockThreshold
+func (mmSaveStockThreshold *mStockThresholdRepositoryMockSaveStockThreshold) Expect(ctx context.Context, threshold domain.StockThreshold) *mStockThresholdRepositoryMockSaveStockThreshold {
+	if mmSaveStockThreshold.mock.funcSaveStockThreshold != nil {
+		mmSaveStockThreshold.mock.t.Fatalf("StockThresholdRepositoryMock.SaveStockThreshold mock is already set by Set")
+	}
+
+	if mmSaveStockThreshold.defaultExpectation == nil {
+		mmSaveStockThreshold.defaultExpectation = &StockThresholdRepositoryMockSaveStockThresholdExpectation{}
+	}
+
+	if mmSaveStockThreshold.defaultExpectation.paramPtrs != nil {
+		mmSaveStockThreshold.mock.t.Fatalf("StockThresholdRepositoryMock.SaveStockThreshold mock is already set by ExpectParams functions")
+	}
+
+	mmSaveStockThreshold.defaultExpectation.params = &StockThresholdRepositoryMockSaveStockThresholdParams{ctx, threshold}
+	mmSaveStockThreshold.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveStockThreshold.expectations {
+		if minimock.Equal(e.params, mmSaveStockThreshold.defaultExpectation.params) {
+			mmSaveStockThreshold.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveStockThreshold.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveStockThreshold
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockThresholdRepository.SaveStockThreshold
+func (mmSaveStockThreshold *mStockThresholdRepositoryMockSaveStockThreshold) ExpectCtxParam1(ctx context.Context) *mStockThresholdRepositoryMockSaveStockThreshold {
+	if mmSaveStockThreshold.mock.funcSaveStockThreshold != nil {
+		mmSaveStockThreshold.mock.t.Fatalf("StockThresholdRepositoryMock.SaveStockThreshold mock is already set by Set")
+	}
+
+	if mmSaveStockThreshold.defaultExpectation == nil {
+		mmSaveStockThreshold.defaultExpectation = &StockThresholdRepositoryMockSaveStockThresholdExpectation{}
+	}
+
+	if mmSaveStockThreshold.defaultExpectation.params != nil {
+		mmSaveStockThreshold.mock.t.Fatalf("StockThresholdRepositoryMock.SaveStockThreshold mock is already set by Expect")
+	}
+
+	if mmSaveStockThreshold.defaultExpectation.paramPtrs == nil {
+		mmSaveStockThreshold.defaultExpectation.paramPtrs = &StockThresholdRepositoryMockSaveStockThresholdParamPtrs{}
+	}
+	mmSaveStockThreshold.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveStockThreshold.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSaveStockThreshold
+}
+
+// ExpectThresholdParam2 sets up expected param threshold for StockThresholdRepository.SaveStockThreshold
+func (mmSaveStockThreshold *mStockThresholdRepositoryMockSaveStockThreshold) ExpectThresholdParam2(threshold domain.StockThreshold) *mStockThresholdRepositoryMockSaveStockThreshold {
+	if mmSaveStockThreshold.mock.funcSaveStockThreshold != nil {
+		mmSaveStockThreshold.mock.t.Fatalf("StockThresholdRepositoryMock.SaveStockThreshold mock is already set by Set")
+	}
+
+	if mmSaveStockThreshold.defaultExpectation == nil {
+		mmSaveStockThreshold.defaultExpectation = &StockThresholdRepositoryMockSaveStockThresholdExpectation{}
+	}
+
+	if mmSaveStockThreshold.defaultExpectation.params != nil {
+		mmSaveStockThreshold.mock.t.Fatalf("StockThresholdRepositoryMock.SaveStockThreshold mock is already set by Expect")
+	}
+
+	if mmSaveStockThreshold.defaultExpectation.paramPtrs == nil {
+		mmSaveStockThreshold.defaultExpectation.paramPtrs = &StockThresholdRepositoryMockSaveStockThresholdParamPtrs{}
+	}
+	mmSaveStockThreshold.defaultExpectation.paramPtrs.threshold = &threshold
+	mmSaveStockThreshold.defaultExpectation.expectationOrigins.originThreshold = minimock.CallerInfo(1)
+
+	return mmSaveStockThreshold
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockThresholdRepository.SaveStockThreshold
+func (mmSaveStockThreshold *mStockThresholdRepositoryMockSaveStockThreshold) Inspect(f func(ctx context.Context, threshold domain.StockThreshold)) *mStockThresholdRepositoryMockSaveStockThreshold {
+	if mmSaveStockThreshold.mock.inspectFuncSaveStockThreshold != nil {
+		mmSaveStockThreshold.mock.t.Fatalf("Inspect function is already set for StockThresholdRepositoryMock.SaveStockThreshold")
+	}
+
+	mmSaveStockThreshold.mock.inspectFuncSaveStockThreshold = f
+
+	return mmSaveStockThreshold
+}
+
+// Return sets up results that will be returned by StockThresholdRepository.SaveStockThreshold
+func (mmSaveStockThreshold *mStockThresholdRepositoryMockSaveStockThreshold) Return(err error) *StockThresholdRepositoryMock {
+	if mmSaveStockThreshold.mock.funcSaveStockThreshold != nil {
+		mmSaveStockThreshold.mock.t.Fatalf("StockThresholdRepositoryMock.SaveStockThreshold mock is already set by Set")
+	}
+
+	if mmSaveStockThreshold.defaultExpectation == nil {
+		mmSaveStockThreshold.defaultExpectation = &StockThresholdRepositoryMockSaveStockThresholdExpectation{mock: mmSaveStockThreshold.mock}
+	}
+	mmSaveStockThreshold.defaultExpectation.results = &StockThresholdRepositoryMockSaveStockThresholdResults{err}
+	mmSaveStockThreshold.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveStockThreshold.mock
+}
+
+// Set uses given function f to mock the StockThresholdRepository.SaveStockThreshold method
+func (mmSaveStockThreshold *mStockThresholdRepositoryMockSaveStockThreshold) Set(f func(ctx context.Context, threshold domain.StockThreshold) (err error)) *StockThresholdRepositoryMock {
+	if mmSaveStockThreshold.defaultExpectation != nil {
+		mmSaveStockThreshold.mock.t.Fatalf("Default expectation is already set for the StockThresholdRepository.SaveStockThreshold method")
+	}
+
+	if len(mmSaveStockThreshold.expectations) > 0 {
+		mmSaveStockThreshold.mock.t.Fatalf("Some expectations are already set for the StockThresholdRepository.SaveStockThreshold method")
+	}
+
+	mmSaveStockThreshold.mock.funcSaveStockThreshold = f
+	mmSaveStockThreshold.mock.funcSaveStockThresholdOrigin = minimock.CallerInfo(1)
+	return mmSaveStockThreshold.mock
+}
+
+// When sets expectation for the StockThresholdRepository.SaveStockThreshold which will trigger the result defined by the following
+// Then helper
+func (mmSaveStockThreshold *mStockThresholdRepositoryMockSaveStockThreshold) When(ctx context.Context, threshold domain.StockThreshold) *StockThresholdRepositoryMockSaveStockThresholdExpectation {
+	if mmSaveStockThreshold.mock.funcSaveStockThreshold != nil {
+		mmSaveStockThreshold.mock.t.Fatalf("StockThresholdRepositoryMock.SaveStockThreshold mock is already set by Set")
+	}
+
+	expectation := &StockThresholdRepositoryMockSaveStockThresholdExpectation{
+		mock:               mmSaveStockThreshold.mock,
+		params:             &StockThresholdRepositoryMockSaveStockThresholdParams{ctx, threshold},
+		expectationOrigins: StockThresholdRepositoryMockSaveStockThresholdExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSaveStockThreshold.expectations = append(mmSaveStockThreshold.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockThresholdRepository.SaveStockThreshold return parameters for the expectation previously defined by the When method
+func (e *StockThresholdRepositoryMockSaveStockThresholdExpectation) Then(err error) *StockThresholdRepositoryMock {
+	e.results = &StockThresholdRepositoryMockSaveStockThresholdResults{err}
+	return e.mock
+}
+
+// Times sets number of times StockThresholdRepository.SaveStockThreshold should be invoked
+func (mmSaveStockThreshold *mStockThresholdRepositoryMockSaveStockThreshold) Times(n uint64) *mStockThresholdRepositoryMockSaveStockThreshold {
+	if n == 0 {
+		mmSaveStockThreshold.mock.t.Fatalf("Times of StockThresholdRepositoryMock.SaveStockThreshold mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSaveStockThreshold.expectedInvocations, n)
+	mmSaveStockThreshold.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveStockThreshold
+}
+
+func (mmSaveStockThreshold *mStockThresholdRepositoryMockSaveStockThreshold) invocationsDone() bool {
+	if len(mmSaveStockThreshold.expectations) == 0 && mmSaveStockThreshold.defaultExpectation == nil && mmSaveStockThreshold.mock.funcSaveStockThreshold == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveStockThreshold.mock.afterSaveStockThresholdCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveStockThreshold.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SaveStockThreshold implements mm_stocks.StockThresholdRepository
+func (mmSaveStockThreshold *StockThresholdRepositoryMock) SaveStockThreshold(ctx context.Context, threshold domain.StockThreshold) (err error) {
+	mm_atomic.AddUint64(&mmSaveStockThreshold.beforeSaveStockThresholdCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveStockThreshold.afterSaveStockThresholdCounter, 1)
+
+	mmSaveStockThreshold.t.Helper()
+
+	if mmSaveStockThreshold.inspectFuncSaveStockThreshold != nil {
+		mmSaveStockThreshold.inspectFuncSaveStockThreshold(ctx, threshold)
+	}
+
+	mm_params := StockThresholdRepositoryMockSaveStockThresholdParams{ctx, threshold}
+
+	// Record call args
+	mmSaveStockThreshold.SaveStockThresholdMock.mutex.Lock()
+	mmSaveStockThreshold.SaveStockThresholdMock.callArgs = append(mmSaveStockThreshold.SaveStockThresholdMock.callArgs, &mm_params)
+	mmSaveStockThreshold.SaveStockThresholdMock.mutex.Unlock()
+
+	for _, e := range mmSaveStockThreshold.SaveStockThresholdMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSaveStockThreshold.SaveStockThresholdMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveStockThreshold.SaveStockThresholdMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveStockThreshold.SaveStockThresholdMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveStockThreshold.SaveStockThresholdMock.defaultExpectation.paramPtrs
+
+		mm_got := StockThresholdRepositoryMockSaveStockThresholdParams{ctx, threshold}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSaveStockThreshold.t.Errorf("StockThresholdRepositoryMock.SaveStockThreshold got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveStockThreshold.SaveStockThresholdMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.threshold != nil && !minimock.Equal(*mm_want_ptrs.threshold, mm_got.threshold) {
+				mmSaveStockThreshold.t.Errorf("StockThresholdRepositoryMock.SaveStockThreshold got unexpected parameter threshold, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveStockThreshold.SaveStockThresholdMock.defaultExpectation.expectationOrigins.originThreshold, *mm_want_ptrs.threshold, mm_got.threshold, minimock.Diff(*mm_want_ptrs.threshold, mm_got.threshold))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveStockThreshold.t.Errorf("StockThresholdRepositoryMock.SaveStockThreshold got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveStockThreshold.SaveStockThresholdMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveStockThreshold.SaveStockThresholdMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveStockThreshold.t.Fatal("No results are set for the StockThresholdRepositoryMock.SaveStockThreshold")
+		}
+		return (*mm_results).err
+	}
+	if mmSaveStockThreshold.funcSaveStockThreshold != nil {
+		return mmSaveStockThreshold.funcSaveStockThreshold(ctx, threshold)
+	}
+	mmSaveStockThreshold.t.Fatalf("Unexpected call to StockThresholdRepositoryMock.SaveStockThreshold. %v %v", ctx, threshold)
+	return
+}
+
+// SaveStockThresholdAfterCounter returns a count of finished StockThresholdRepositoryMock.SaveStockThreshold invocations
+func (mmSaveStockThreshold *StockThresholdRepositoryMock) SaveStockThresholdAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveStockThreshold.afterSaveStockThresholdCounter)
+}
+
+// SaveStockThresholdBeforeCounter returns a count of StockThresholdRepositoryMock.SaveStockThreshold invocations
+func (mmSaveStockThreshold *StockThresholdRepositoryMock) SaveStockThresholdBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveStockThreshold.beforeSaveStockThresholdCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockThresholdRepositoryMock.SaveStockThreshold.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveStockThreshold *mStockThresholdRepositoryMockSaveStockThreshold) Calls() []*StockThresholdRepositoryMockSaveStockThresholdParams {
+	mmSaveStockThreshold.mutex.RLock()
+
+	argCopy := make([]*StockThresholdRepositoryMockSaveStockThresholdParams, len(mmSaveStockThreshold.callArgs))
+	copy(argCopy, mmSaveStockThreshold.callArgs)
+
+	mmSaveStockThreshold.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveStockThresholdDone returns true if the count of the SaveStockThreshold invocations corresponds
+// the number of defined expectations
+func (m *StockThresholdRepositoryMock) MinimockSaveStockThresholdDone() bool {
+	if m.SaveStockThresholdMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveStockThresholdMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveStockThresholdMock.invocationsDone()
+}
+
+// MinimockSaveStockThresholdInspect logs each unmet expectation
+func (m *StockThresholdRepositoryMock) MinimockSaveStockThresholdInspect() {
+	for _, e := range m.SaveStockThresholdMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockThresholdRepositoryMock.SaveStockThreshold at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveStockThresholdCounter := mm_atomic.LoadUint64(&m.afterSaveStockThresholdCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveStockThresholdMock.defaultExpectation != nil && afterSaveStockThresholdCounter < 1 {
+		if m.SaveStockThresholdMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockThresholdRepositoryMock.SaveStockThreshold at\n%s", m.SaveStockThresholdMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockThresholdRepositoryMock.SaveStockThreshold at\n%s with params: %#v", m.SaveStockThresholdMock.defaultExpectation.expectationOrigins.origin, *m.SaveStockThresholdMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveStockThreshold != nil && afterSaveStockThresholdCounter < 1 {
+		m.t.Errorf("Expected call to StockThresholdRepositoryMock.SaveStockThreshold at\n%s", m.funcSaveStockThresholdOrigin)
+	}
+
+	if !m.SaveStockThresholdMock.invocationsDone() && afterSaveStockThresholdCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockThresholdRepositoryMock.SaveStockThreshold at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveStockThresholdMock.expectedInvocations), m.SaveStockThresholdMock.expectedInvocationsOrigin, afterSaveStockThresholdCounter)
+	}
+}
+
+type mStockThresholdRepositoryMockUpdateStockLevel struct {
+	optional           bool
+	mock               *StockThresholdRepositoryMock
+	defaultExpectation *StockThresholdRepositoryMockUpdateStockLevelExpectation
+	expectations       []*StockThresholdRepositoryMockUpdateStockLevelExpectation
+
+	callArgs []*StockThresholdRepositoryMockUpdateStockLevelParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// StockThresholdRepositoryMockUpdateStockLevelExpectation specifies expectation struct of the StockThresholdRepository.UpdateStockLevel
+type StockThresholdRepositoryMockUpdateStockLevelExpectation struct {
+	mock               *StockThresholdRepositoryMock
+	params             *StockThresholdRepositoryMockUpdateStockLevelParams
+	paramPtrs          *StockThresholdRepositoryMockUpdateStockLevelParamPtrs
+	expectationOrigins StockThresholdRepositoryMockUpdateStockLevelExpectationOrigins
+	results            *StockThresholdRepositoryMockUpdateStockLevelResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// StockThresholdRepositoryMockUpdateStockLevelParams contains parameters of the StockThresholdRepository.UpdateStockLevel
+type StockThresholdRepositoryMockUpdateStockLevelParams struct {
+	ctx       context.Context
+	stockItem domain.StockItem
+	from      domain.StockLevel
+	to        domain.StockLevel
+}
+
+// StockThresholdRepositoryMockUpdateStockLevelParamPtrs contains pointers to parameters of the StockThresholdRepository.UpdateStockLevel
+type StockThresholdRepositoryMockUpdateStockLevelParamPtrs struct {
+	ctx       *context.Context
+	stockItem *domain.StockItem
+	from      *domain.StockLevel
+	to        *domain.StockLevel
+}
+
+// StockThresholdRepositoryMockUpdateStockLevelResults contains results of the StockThresholdRepository.UpdateStockLevel
+type StockThresholdRepositoryMockUpdateStockLevelResults struct {
+	b1  bool
+	err error
+}
+
+// StockThresholdRepositoryMockUpdateStockLevelOrigins contains origins of expectations of the StockThresholdRepository.UpdateStockLevel
+type StockThresholdRepositoryMockUpdateStockLevelExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originStockItem string
+	originFrom      string
+	originTo        string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateStockLevel *mStockThresholdRepositoryMockUpdateStockLevel) Optional() *mStockThresholdRepositoryMockUpdateStockLevel {
+	mmUpdateStockLevel.optional = true
+	return mmUpdateStockLevel
+}
+
+// Expect sets up expected params for StockThresholdRepository.UpdateStockLevel
+func (mmUpdateStockLevel *mStockThresholdRepositoryMockUpdateStockLevel) Expect(ctx context.Context, stockItem domain.StockItem, from domain.StockLevel, to domain.StockLevel) *mStockThresholdRepositoryMockUpdateStockLevel {
+	if mmUpdateStockLevel.mock.funcUpdateStockLevel != nil {
+		mmUpdateStockLevel.mock.t.Fatalf("StockThresholdRepositoryMock.UpdateStockLevel mock is already set by Set")
+	}
+
+	if mmUpdateStockLevel.defaultExpectation == nil {
+		mmUpdateStockLevel.defaultExpectation = &StockThresholdRepositoryMockUpdateStockLevelExpectation{}
+	}
+
+	if mmUpdateStockLevel.defaultExpectation.paramPtrs != nil {
+		mmUpdateStockLevel.mock.t.Fatalf("StockThresholdRepositoryMock.UpdateStockLevel mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateStockLevel.defaultExpectation.params = &StockThresholdRepositoryMockUpdateStockLevelParams{ctx, stockItem, from, to}
+	mmUpdateStockLevel.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateStockLevel.expectations {
+		if minimock.Equal(e.params, mmUpdateStockLevel.defaultExpectation.params) {
+			mmUpdateStockLevel.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateStockLevel.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateStockLevel
+}
+
+// ExpectCtxParam1 sets up expected param ctx for StockThresholdRepository.UpdateStockLevel
+func (mmUpdateStockLevel *mStockThresholdRepositoryMockUpdateStockLevel) ExpectCtxParam1(ctx context.Context) *mStockThresholdRepositoryMockUpdateStockLevel {
+	if mmUpdateStockLevel.mock.funcUpdateStockLevel != nil {
+		mmUpdateStockLevel.mock.t.Fatalf("StockThresholdRepositoryMock.UpdateStockLevel mock is already set by Set")
+	}
+
+	if mmUpdateStockLevel.defaultExpectation == nil {
+		mmUpdateStockLevel.defaultExpectation = &StockThresholdRepositoryMockUpdateStockLevelExpectation{}
+	}
+
+	if mmUpdateStockLevel.defaultExpectation.params != nil {
+		mmUpdateStockLevel.mock.t.Fatalf("StockThresholdRepositoryMock.UpdateStockLevel mock is already set by Expect")
+	}
+
+	if mmUpdateStockLevel.defaultExpectation.paramPtrs == nil {
+		mmUpdateStockLevel.defaultExpectation.paramPtrs = &StockThresholdRepositoryMockUpdateStockLevelParamPtrs{}
+	}
+	mmUpdateStockLevel.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateStockLevel.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateStockLevel
+}
+
+// ExpectStockItemParam2 sets up expected param stockItem for StockThresholdRepository.UpdateStockLevel
+func (mmUpdateStockLevel *mStockThresholdRepositoryMockUpdateStockLevel) ExpectStockItemParam2(stockItem domain.StockItem) *mStockThresholdRepositoryMockUpdateStockLevel {
+	if mmUpdateStockLevel.mock.funcUpdateStockLevel != nil {
+		mmUpdateStockLevel.mock.t.Fatalf("StockThresholdRepositoryMock.UpdateStockLevel mock is already set by Set")
+	}
+
+	if mmUpdateStockLevel.defaultExpectation == nil {
+		mmUpdateStockLevel.defaultExpectation = &StockThresholdRepositoryMockUpdateStockLevelExpectation{}
+	}
+
+	if mmUpdateStockLevel.defaultExpectation.params != nil {
+		mmUpdateStockLevel.mock.t.Fatalf("StockThresholdRepositoryMock.UpdateStockLevel mock is already set by Expect")
+	}
+
+	if mmUpdateStockLevel.defaultExpectation.paramPtrs == nil {
+		mmUpdateStockLevel.defaultExpectation.paramPtrs = &StockThresholdRepositoryMockUpdateStockLevelParamPtrs{}
+	}
+	mmUpdateStockLevel.defaultExpectation.paramPtrs.stockItem = &stockItem
+	mmUpdateStockLevel.defaultExpectation.expectationOrigins.originStockItem = minimock.CallerInfo(1)
+
+	return mmUpdateStockLevel
+}
+
+// ExpectFromParam3 sets up expected param from for StockThresholdRepository.UpdateStockLevel
+func (mmUpdateStockLevel *mStockThresholdRepositoryMockUpdateStockLevel) ExpectFromParam3(from domain.StockLevel) *mStockThresholdRepositoryMockUpdateStockLevel {
+	if mmUpdateStockLevel.mock.funcUpdateStockLevel != nil {
+		mmUpdateStockLevel.mock.t.Fatalf("StockThresholdRepositoryMock.UpdateStockLevel mock is already set by Set")
+	}
+
+	if mmUpdateStockLevel.defaultExpectation == nil {
+		mmUpdateStockLevel.defaultExpectation = &StockThresholdRepositoryMockUpdateStockLevelExpectation{}
+	}
+
+	if mmUpdateStockLevel.defaultExpectation.params != nil {
+		mmUpdateStockLevel.mock.t.Fatalf("StockThresholdRepositoryMock.UpdateStockLevel mock is already set by Expect")
+	}
+
+	if mmUpdateStockLevel.defaultExpectation.paramPtrs == nil {
+		mmUpdateStockLevel.defaultExpectation.paramPtrs = &StockThresholdRepositoryMockUpdateStockLevelParamPtrs{}
+	}
+	mmUpdateStockLevel.defaultExpectation.paramPtrs.from = &from
+	mmUpdateStockLevel.defaultExpectation.expectationOrigins.originFrom = minimock.CallerInfo(1)
+
+	return mmUpdateStockLevel
+}
+
+// ExpectToParam4 sets up expected param to for StockThresholdRepository.UpdateStockLevel
+func (mmUpdateStockLevel *mStockThresholdRepositoryMockUpdateStockLevel) ExpectToParam4(to domain.StockLevel) *mStockThresholdRepositoryMockUpdateStockLevel {
+	if mmUpdateStockLevel.mock.funcUpdateStockLevel != nil {
+		mmUpdateStockLevel.mock.t.Fatalf("StockThresholdRepositoryMock.UpdateStockLevel mock is already set by Set")
+	}
+
+	if mmUpdateStockLevel.defaultExpectation == nil {
+		mmUpdateStockLevel.defaultExpectation = &StockThresholdRepositoryMockUpdateStockLevelExpectation{}
+	}
+
+	if mmUpdateStockLevel.defaultExpectation.params != nil {
+		mmUpdateStockLevel.mock.t.Fatalf("StockThresholdRepositoryMock.UpdateStockLevel mock is already set by Expect")
+	}
+
+	if mmUpdateStockLevel.defaultExpectation.paramPtrs == nil {
+		mmUpdateStockLevel.defaultExpectation.paramPtrs = &StockThresholdRepositoryMockUpdateStockLevelParamPtrs{}
+	}
+	mmUpdateStockLevel.defaultExpectation.paramPtrs.to = &to
+	mmUpdateStockLevel.defaultExpectation.expectationOrigins.originTo = minimock.CallerInfo(1)
+
+	return mmUpdateStockLevel
+}
+
+// Inspect accepts an inspector function that has same arguments as the StockThresholdRepository.UpdateStockLevel
+func (mmUpdateStockLevel *mStockThresholdRepositoryMockUpdateStockLevel) Inspect(f func(ctx context.Context, stockItem domain.StockItem, from domain.StockLevel, to domain.StockLevel)) *mStockThresholdRepositoryMockUpdateStockLevel {
+	if mmUpdateStockLevel.mock.inspectFuncUpdateStockLevel != nil {
+		mmUpdateStockLevel.mock.t.Fatalf("Inspect function is already set for StockThresholdRepositoryMock.UpdateStockLevel")
+	}
+
+	mmUpdateStockLevel.mock.inspectFuncUpdateStockLevel = f
+
+	return mmUpdateStockLevel
+}
+
+// Return sets up results that will be returned by StockThresholdRepository.UpdateStockLevel
+func (mmUpdateStockLevel *mStockThresholdRepositoryMockUpdateStockLevel) Return(b1 bool, err error) *StockThresholdRepositoryMock {
+	if mmUpdateStockLevel.mock.funcUpdateStockLevel != nil {
+		mmUpdateStockLevel.mock.t.Fatalf("StockThresholdRepositoryMock.UpdateStockLevel mock is already set by Set")
+	}
+
+	if mmUpdateStockLevel.defaultExpectation == nil {
+		mmUpdateStockLevel.defaultExpectation = &StockThresholdRepositoryMockUpdateStockLevelExpectation{mock: mmUpdateStockLevel.mock}
+	}
+	mmUpdateStockLevel.defaultExpectation.results = &StockThresholdRepositoryMockUpdateStockLevelResults{b1, err}
+	mmUpdateStockLevel.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateStockLevel.mock
+}
+
+// Set uses given function f to mock the StockThresholdRepository.UpdateStockLevel method
+func (mmUpdateStockLevel *mStockThresholdRepositoryMockUpdateStockLevel) Set(f func(ctx context.Context, stockItem domain.StockItem, from domain.StockLevel, to domain.StockLevel) (b1 bool, err error)) *StockThresholdRepositoryMock {
+	if mmUpdateStockLevel.defaultExpectation != nil {
+		mmUpdateStockLevel.mock.t.Fatalf("Default expectation is already set for the StockThresholdRepository.UpdateStockLevel method")
+	}
+
+	if len(mmUpdateStockLevel.expectations) > 0 {
+		mmUpdateStockLevel.mock.t.Fatalf("Some expectations are already set for the StockThresholdRepository.UpdateStockLevel method")
+	}
+
+	mmUpdateStockLevel.mock.funcUpdateStockLevel = f
+	mmUpdateStockLevel.mock.funcUpdateStockLevelOrigin = minimock.CallerInfo(1)
+	return mmUpdateStockLevel.mock
+}
+
+// When sets expectation for the StockThresholdRepository.UpdateStockLevel which will trigger the result defined by the following
+// Then helper
+func (mmUpdateStockLevel *mStockThresholdRepositoryMockUpdateStockLevel) When(ctx context.Context, stockItem domain.StockItem, from domain.StockLevel, to domain.StockLevel) *StockThresholdRepositoryMockUpdateStockLevelExpectation {
+	if mmUpdateStockLevel.mock.funcUpdateStockLevel != nil {
+		mmUpdateStockLevel.mock.t.Fatalf("StockThresholdRepositoryMock.UpdateStockLevel mock is already set by Set")
+	}
+
+	expectation := &StockThresholdRepositoryMockUpdateStockLevelExpectation{
+		mock:               mmUpdateStockLevel.mock,
+		params:             &StockThresholdRepositoryMockUpdateStockLevelParams{ctx, stockItem, from, to},
+		expectationOrigins: StockThresholdRepositoryMockUpdateStockLevelExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateStockLevel.expectations = append(mmUpdateStockLevel.expectations, expectation)
+	return expectation
+}
+
+// Then sets up StockThresholdRepository.UpdateStockLevel return parameters for the expectation previously defined by the When method
+func (e *StockThresholdRepositoryMockUpdateStockLevelExpectation) Then(b1 bool, err error) *StockThresholdRepositoryMock {
+	e.results = &StockThresholdRepositoryMockUpdateStockLevelResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times StockThresholdRepository.UpdateStockLevel should be invoked
+func (mmUpdateStockLevel *mStockThresholdRepositoryMockUpdateStockLevel) Times(n uint64) *mStockThresholdRepositoryMockUpdateStockLevel {
+	if n == 0 {
+		mmUpdateStockLevel.mock.t.Fatalf("Times of StockThresholdRepositoryMock.UpdateStockLevel mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateStockLevel.expectedInvocations, n)
+	mmUpdateStockLevel.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateStockLevel
+}
+
+func (mmUpdateStockLevel *mStockThresholdRepositoryMockUpdateStockLevel) invocationsDone() bool {
+	if len(mmUpdateStockLevel.expectations) == 0 && mmUpdateStockLevel.defaultExpectation == nil && mmUpdateStockLevel.mock.funcUpdateStockLevel == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateStockLevel.mock.afterUpdateStockLevelCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateStockLevel.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateStockLevel implements mm_stocks.StockThresholdRepository
+func (mmUpdateStockLevel *StockThresholdRepositoryMock) UpdateStockLevel(ctx context.Context, stockItem domain.StockItem, from domain.StockLevel, to domain.StockLevel) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmUpdateStockLevel.beforeUpdateStockLevelCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateStockLevel.afterUpdateStockLevelCounter, 1)
+
+	mmUpdateStockLevel.t.Helper()
+
+	if mmUpdateStockLevel.inspectFuncUpdateStockLevel != nil {
+		mmUpdateStockLevel.inspectFuncUpdateStockLevel(ctx, stockItem, from, to)
+	}
+
+	mm_params := StockThresholdRepositoryMockUpdateStockLevelParams{ctx, stockItem, from, to}
+
+	// Record call args
+	mmUpdateStockLevel.UpdateStockLevelMock.mutex.Lock()
+	mmUpdateStockLevel.UpdateStockLevelMock.callArgs = append(mmUpdateStockLevel.UpdateStockLevelMock.callArgs, &mm_params)
+	mmUpdateStockLevel.UpdateStockLevelMock.mutex.Unlock()
+
+	for _, e := range mmUpdateStockLevel.UpdateStockLevelMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmUpdateStockLevel.UpdateStockLevelMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateStockLevel.UpdateStockLevelMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateStockLevel.UpdateStockLevelMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateStockLevel.UpdateStockLevelMock.defaultExpectation.paramPtrs
+
+		mm_got := StockThresholdRepositoryMockUpdateStockLevelParams{ctx, stockItem, from, to}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateStockLevel.t.Errorf("StockThresholdRepositoryMock.UpdateStockLevel got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateStockLevel.UpdateStockLevelMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.stockItem != nil && !minimock.Equal(*mm_want_ptrs.stockItem, mm_got.stockItem) {
+				mmUpdateStockLevel.t.Errorf("StockThresholdRepositoryMock.UpdateStockLevel got unexpected parameter stockItem, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateStockLevel.UpdateStockLevelMock.defaultExpectation.expectationOrigins.originStockItem, *mm_want_ptrs.stockItem, mm_got.stockItem, minimock.Diff(*mm_want_ptrs.stockItem, mm_got.stockItem))
+			}
+
+			if mm_want_ptrs.from != nil && !minimock.Equal(*mm_want_ptrs.from, mm_got.from) {
+				mmUpdateStockLevel.t.Errorf("StockThresholdRepositoryMock.UpdateStockLevel got unexpected parameter from, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateStockLevel.UpdateStockLevelMock.defaultExpectation.expectationOrigins.originFrom, *mm_want_ptrs.from, mm_got.from, minimock.Diff(*mm_want_ptrs.from, mm_got.from))
+			}
+
+			if mm_want_ptrs.to != nil && !minimock.Equal(*mm_want_ptrs.to, mm_got.to) {
+				mmUpdateStockLevel.t.Errorf("StockThresholdRepositoryMock.UpdateStockLevel got unexpected parameter to, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateStockLevel.UpdateStockLevelMock.defaultExpectation.expectationOrigins.originTo, *mm_want_ptrs.to, mm_got.to, minimock.Diff(*mm_want_ptrs.to, mm_got.to))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdateStockLevel.t.Errorf("StockThresholdRepositoryMock.UpdateStockLevel got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateStockLevel.UpdateStockLevelMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdateStockLevel.UpdateStockLevelMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdateStockLevel.t.Fatal("No results are set for the StockThresholdRepositoryMock.UpdateStockLevel")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmUpdateStockLevel.funcUpdateStockLevel != nil {
+		return mmUpdateStockLevel.funcUpdateStockLevel(ctx, stockItem, from, to)
+	}
+	mmUpdateStockLevel.t.Fatalf("Unexpected call to StockThresholdRepositoryMock.UpdateStockLevel. %v %v %v %v", ctx, stockItem, from, to)
+	return
+}
+
+// UpdateStockLevelAfterCounter returns a count of finished StockThresholdRepositoryMock.UpdateStockLevel invocations
+func (mmUpdateStockLevel *StockThresholdRepositoryMock) UpdateStockLevelAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateStockLevel.afterUpdateStockLevelCounter)
+}
+
+// UpdateStockLevelBeforeCounter returns a count of StockThresholdRepositoryMock.UpdateStockLevel invocations
+func (mmUpdateStockLevel *StockThresholdRepositoryMock) UpdateStockLevelBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateStockLevel.beforeUpdateStockLevelCounter)
+}
+
+// Calls returns a list of arguments used in each call to StockThresholdRepositoryMock.UpdateStockLevel.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdateStockLevel *mStockThresholdRepositoryMockUpdateStockLevel) Calls() []*StockThresholdRepositoryMockUpdateStockLevelParams {
+	mmUpdateStockLevel.mutex.RLock()
+
+	argCopy := make([]*StockThresholdRepositoryMockUpdateStockLevelParams, len(mmUpdateStockLevel.callArgs))
+	copy(argCopy, mmUpdateStockLevel.callArgs)
+
+	mmUpdateStockLevel.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdateStockLevelDone returns true if the count of the UpdateStockLevel invocations corresponds
+// the number of defined expectations
+func (m *StockThresholdRepositoryMock) MinimockUpdateStockLevelDone() bool {
+	if m.UpdateStockLevelMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdateStockLevelMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdateStockLevelMock.invocationsDone()
+}
+
+// MinimockUpdateStockLevelInspect logs each unmet expectation
+func (m *StockThresholdRepositoryMock) MinimockUpdateStockLevelInspect() {
+	for _, e := range m.UpdateStockLevelMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to StockThresholdRepositoryMock.UpdateStockLevel at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdateStockLevelCounter := mm_atomic.LoadUint64(&m.afterUpdateStockLevelCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdateStockLevelMock.defaultExpectation != nil && afterUpdateStockLevelCounter < 1 {
+		if m.UpdateStockLevelMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to StockThresholdRepositoryMock.UpdateStockLevel at\n%s", m.UpdateStockLevelMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to StockThresholdRepositoryMock.UpdateStockLevel at\n%s with params: %#v", m.UpdateStockLevelMock.defaultExpectation.expectationOrigins.origin, *m.UpdateStockLevelMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdateStockLevel != nil && afterUpdateStockLevelCounter < 1 {
+		m.t.Errorf("Expected call to StockThresholdRepositoryMock.UpdateStockLevel at\n%s", m.funcUpdateStockLevelOrigin)
+	}
+
+	if !m.UpdateStockLevelMock.invocationsDone() && afterUpdateStockLevelCounter > 0 {
+		m.t.Errorf("Expected %d calls to StockThresholdRepositoryMock.UpdateStockLevel at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateStockLevelMock.expectedInvocations), m.UpdateStockLevelMock.expectedInvocationsOrigin, afterUpdateStockLevelCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *StockThresholdRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCountLowStockItemsInspect()
+
+			m.MinimockGetStockThresholdInspect()
+
+			m.MinimockListLowStockItemsInspect()
+
+			m.MinimockSaveStockThresholdInspect()
+
+			m.MinimockUpdateStockLevelInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *StockThresholdRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *StockThresholdRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCountLowStockItemsDone() &&
+		m.MinimockGetStockThresholdDone() &&
+		m.MinimockListLowStockItemsDone() &&
+		m.MinimockSaveStockThresholdDone() &&
+		m.MinimockUpdateStockLevelDone()
+}
@@ -0,0 +1,128 @@
+package stocks
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"stocks/internal/domain"
+	"stocks/internal/kafka"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// priceChangesBatchSize limits how many due price changes are applied in one transaction.
+const priceChangesBatchSize = 100
+
+func (s *stockServiceUseCase) SchedulePriceChange(
+	ctx context.Context,
+	priceChange domain.ScheduledPriceChange,
+) (domain.ScheduledPriceChange, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.SchedulePriceChange")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user_id", fmt.Sprintf("%d", priceChange.UserID)),
+		attribute.String("sku_id", fmt.Sprintf("%d", priceChange.SkuID)),
+		attribute.String("location", priceChange.Location),
+		attribute.Int64("new_price", priceChange.NewPrice.Amount),
+		attribute.String("currency", string(priceChange.NewPrice.Currency)),
+		attribute.String("effective_at", priceChange.EffectiveAt.Format(time.RFC3339)),
+	)
+
+	if priceChange.EffectiveAt.Before(time.Now()) {
+		span.SetAttributes(attribute.String("error.message", domain.ErrPriceChangeInPast.Error()))
+		return domain.ScheduledPriceChange{}, domain.ErrPriceChangeInPast
+	}
+
+	// check stock item exist or not.
+	stockItem, err := s.GetStockItem(ctx, priceChange.UserID, priceChange.SkuID, priceChange.Location)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.ScheduledPriceChange{}, err
+	}
+
+	// price change without currency keeps currency of the offer.
+	if priceChange.NewPrice.Currency == "" {
+		priceChange.NewPrice.Currency = stockItem.Price.Currency
+	}
+
+	scheduledPriceChange, err := s.SaveScheduledPriceChange(ctx, priceChange)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.ScheduledPriceChange{}, err
+	}
+
+	return scheduledPriceChange, nil
+}
+
+// ApplyScheduledPriceChanges applies all due price changes in batches and emits price_changed for each of them.
+func (s *stockServiceUseCase) ApplyScheduledPriceChanges(ctx context.Context) error {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.ApplyScheduledPriceChanges")
+	defer span.End()
+
+	var appliedCount int
+
+	for {
+		appliedPriceChanges, err := s.ApplyDuePriceChanges(ctx, priceChangesBatchSize)
+		if err != nil {
+			span.SetAttributes(attribute.String("error.message", err.Error()))
+			return err
+		}
+
+		for _, priceChange := range appliedPriceChanges {
+			s.KafkaProducer.ProducePriceChanged(ctx, kafka.PriceChangedPayload{
+				SKU:               fmt.Sprintf("%d", priceChange.SkuID),
+				UserID:            int64(priceChange.UserID),
+				Location:          priceChange.Location,
+				OldPrice:          priceChange.OldPrice.Amount,
+				OldCurrency:       string(priceChange.OldPrice.Currency),
+				NewPrice:          priceChange.NewPrice.Amount,
+				Currency:          string(priceChange.NewPrice.Currency),
+				ScheduledChangeID: int64(priceChange.ID),
+			})
+		}
+
+		appliedCount += len(appliedPriceChanges)
+
+		// failed changes are not returned, so such batch ends the loop early, rest is applied on next run.
+		if len(appliedPriceChanges) < priceChangesBatchSize {
+			break
+		}
+	}
+
+	span.SetAttributes(attribute.Int("applied_count", appliedCount))
+
+	return nil
+}
+
+func (s *stockServiceUseCase) GetPriceHistory(ctx context.Context, filter domain.PriceHistoryFilter) (domain.PriceHistory, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.GetPriceHistory")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("sku_id", fmt.Sprintf("%d", filter.SkuID)),
+		attribute.String("user_id", fmt.Sprintf("%d", filter.UserID)),
+		attribute.String("location", filter.Location),
+		attribute.Int64("page_size", filter.PageSize),
+		attribute.Int64("current_page", filter.CurrentPage),
+	)
+
+	priceHistoryEntries, err := s.ListPriceHistory(ctx, filter)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.PriceHistory{}, err
+	}
+
+	pendingPriceChanges, err := s.ListPendingPriceChanges(ctx, filter)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.PriceHistory{}, err
+	}
+
+	return domain.PriceHistory{
+		Entries:   priceHistoryEntries,
+		Scheduled: pendingPriceChanges,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package stocks
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"stocks/internal/domain"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// SetPriceTiers replaces price tiers of customer group of stock item and returns tiers of every group it has.
+func (s *stockServiceUseCase) SetPriceTiers(ctx context.Context, priceList domain.PriceList) ([]domain.PriceTier, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.SetPriceTiers")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user_id", fmt.Sprintf("%d", priceList.UserID)),
+		attribute.String("sku_id", fmt.Sprintf("%d", priceList.SkuID)),
+		attribute.String("location", priceList.Location),
+		attribute.String("customer_group", priceList.CustomerGroup),
+		attribute.Int("tiers", len(priceList.Tiers)),
+	)
+
+	for i := range priceList.Tiers {
+		priceList.Tiers[i].CustomerGroup = priceList.CustomerGroup
+	}
+
+	if err := s.ReplacePriceTiers(ctx, priceList); err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return nil, err
+	}
+
+	priceTiers, err := s.ListPriceTiersOfStockItem(ctx, priceList.UserID, priceList.SkuID, priceList.Location)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return nil, err
+	}
+
+	return priceTiers, nil
+}
+
+func (s *stockServiceUseCase) GetPriceTiers(
+	ctx context.Context,
+	userID domain.UserID,
+	skuID domain.SKUID,
+	location string,
+) ([]domain.PriceTier, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.GetPriceTiers")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user_id", fmt.Sprintf("%d", userID)),
+		attribute.String("sku_id", fmt.Sprintf("%d", skuID)),
+		attribute.String("location", location),
+	)
+
+	priceTiers, err := s.ListPriceTiersOfStockItem(ctx, userID, skuID, location)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return nil, err
+	}
+
+	return priceTiers, nil
+}
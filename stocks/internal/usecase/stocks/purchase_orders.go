@@ -0,0 +1,164 @@
+package stocks
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"stocks/internal/domain"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func (s *stockServiceUseCase) CreateSupplier(ctx context.Context, supplier domain.Supplier) (domain.Supplier, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.CreateSupplier")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user_id", fmt.Sprintf("%d", supplier.UserID)),
+		attribute.String("name", supplier.Name),
+	)
+
+	createdSupplier, err := s.SaveSupplier(ctx, supplier)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.Supplier{}, err
+	}
+
+	return createdSupplier, nil
+}
+
+func (s *stockServiceUseCase) ListSuppliers(ctx context.Context, userID domain.UserID) ([]domain.Supplier, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.ListSuppliers")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("user_id", fmt.Sprintf("%d", userID)))
+
+	suppliers, err := s.ListSuppliersOfMerchant(ctx, userID)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return nil, err
+	}
+
+	return suppliers, nil
+}
+
+// CreatePurchaseOrder creates draft purchase order, its skus must exist and hold stock of their own, not bundles.
+func (s *stockServiceUseCase) CreatePurchaseOrder(ctx context.Context, purchaseOrder domain.PurchaseOrder) (domain.PurchaseOrder, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.CreatePurchaseOrder")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user_id", fmt.Sprintf("%d", purchaseOrder.UserID)),
+		attribute.Int64("supplier_id", int64(purchaseOrder.SupplierID)),
+		attribute.String("location", purchaseOrder.Location),
+		attribute.Int("lines", len(purchaseOrder.Lines)),
+	)
+
+	if err := purchaseOrder.Validate(); err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.PurchaseOrder{}, err
+	}
+
+	for _, line := range purchaseOrder.Lines {
+		sku, err := s.GetSKUByID(ctx, line.Sku.ID)
+		if err != nil {
+			span.SetAttributes(attribute.String("error.message", err.Error()))
+			return domain.PurchaseOrder{}, err
+		}
+
+		if sku.IsBundle {
+			span.SetAttributes(attribute.String("error.message", domain.ErrSKUIsBundle.Error()))
+			return domain.PurchaseOrder{}, domain.ErrSKUIsBundle
+		}
+	}
+
+	createdPurchaseOrder, err := s.SavePurchaseOrder(ctx, purchaseOrder)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.PurchaseOrder{}, err
+	}
+
+	return createdPurchaseOrder, nil
+}
+
+func (s *stockServiceUseCase) GetPurchaseOrder(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) (domain.PurchaseOrder, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.GetPurchaseOrder")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int64("purchase_order_id", int64(purchaseOrderID)))
+
+	purchaseOrder, err := s.GetPurchaseOrderByID(ctx, purchaseOrderID)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.PurchaseOrder{}, err
+	}
+
+	return purchaseOrder, nil
+}
+
+// PlacePurchaseOrder sends draft purchase order to supplier, from now on its lines are incoming stock.
+func (s *stockServiceUseCase) PlacePurchaseOrder(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) (domain.PurchaseOrder, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.PlacePurchaseOrder")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int64("purchase_order_id", int64(purchaseOrderID)))
+
+	purchaseOrder, err := s.GetPurchaseOrderByID(ctx, purchaseOrderID)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.PurchaseOrder{}, err
+	}
+
+	if purchaseOrder.Status != domain.PurchaseOrderStatusDraft {
+		err = fmt.Errorf("%w: purchase order is %s", domain.ErrPurchaseOrderStatus, purchaseOrder.Status)
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.PurchaseOrder{}, err
+	}
+
+	purchaseOrder, err = s.MarkPurchaseOrderOrdered(ctx, purchaseOrderID)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.PurchaseOrder{}, err
+	}
+
+	return purchaseOrder, nil
+}
+
+// ReceivePurchaseOrder adds arrived units of purchase order to its location like AddStockItem does,
+// so the same events are emitted and stock levels are checked.
+func (s *stockServiceUseCase) ReceivePurchaseOrder(ctx context.Context, receipt domain.PurchaseOrderReceipt) (domain.PurchaseOrder, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.ReceivePurchaseOrder")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int64("purchase_order_id", int64(receipt.PurchaseOrderID)),
+		attribute.Int("lines", len(receipt.Lines)),
+		attribute.String("received_by", fmt.Sprintf("%d", receipt.ReceivedBy)),
+	)
+
+	purchaseOrder, err := s.GetPurchaseOrderByID(ctx, receipt.PurchaseOrderID)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.PurchaseOrder{}, err
+	}
+
+	if err := purchaseOrder.ValidateReceipt(receipt); err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.PurchaseOrder{}, err
+	}
+
+	result, err := s.ReceivePurchaseOrderLines(ctx, receipt)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.PurchaseOrder{}, err
+	}
+
+	for _, received := range result.StockItems {
+		s.notifyStockItemAdded(ctx, received.StockItem, received.Created)
+	}
+
+	span.SetAttributes(attribute.String("status", string(result.PurchaseOrder.Status)))
+
+	return result.PurchaseOrder, nil
+}
@@ -0,0 +1,92 @@
+package stocks
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"stocks/internal/domain"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// RecordCartDemand records units added to a cart, they are demand reorder suggestions count beside sales.
+func (s *stockServiceUseCase) RecordCartDemand(ctx context.Context, demand domain.CartDemand) error {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.RecordCartDemand")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("sku_id", fmt.Sprintf("%d", demand.SkuID)),
+		attribute.Int64("quantity", demand.Quantity),
+		attribute.Int64("partition", int64(demand.Partition)),
+		attribute.Int64("offset", demand.Offset),
+	)
+
+	if demand.Quantity <= 0 {
+		return nil
+	}
+
+	if err := s.SaveCartDemand(ctx, demand); err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+// GetReorderSuggestions proposes quantities to order for stock items of merchant from their sales velocity,
+// the most urgent ones, with fewest days of cover, come first.
+func (s *stockServiceUseCase) GetReorderSuggestions(ctx context.Context, filter domain.ReorderFilter) ([]domain.ReorderSuggestion, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.GetReorderSuggestions")
+	defer span.End()
+
+	window := filter.ReorderParams.WithDefaults(s.ReorderDefaults).WindowDays
+
+	span.SetAttributes(
+		attribute.String("user_id", fmt.Sprintf("%d", filter.UserID)),
+		attribute.String("location", filter.Location),
+		attribute.String("sku_id", fmt.Sprintf("%d", filter.SkuID)),
+		attribute.Int("window_days", int(window)),
+	)
+
+	since := time.Now().AddDate(0, 0, -int(window))
+
+	demandHistory, err := s.ListDemandHistory(ctx, filter, since)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return nil, err
+	}
+
+	suggestions := make([]domain.ReorderSuggestion, 0, len(demandHistory))
+
+	for _, history := range demandHistory {
+		suggestion := history.SuggestReorder(history.ParamsOf(filter.ReorderParams, s.ReorderDefaults))
+		if suggestion.SuggestedQuantity > 0 {
+			suggestions = append(suggestions, suggestion)
+		}
+	}
+
+	// stock items which do not sell have no days of cover, they go last.
+	slices.SortStableFunc(suggestions, func(a, b domain.ReorderSuggestion) int {
+		switch {
+		case a.DaysOfCover < 0 && b.DaysOfCover < 0:
+			return 0
+		case a.DaysOfCover < 0:
+			return 1
+		case b.DaysOfCover < 0:
+			return -1
+		case a.DaysOfCover < b.DaysOfCover:
+			return -1
+		case a.DaysOfCover > b.DaysOfCover:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	span.SetAttributes(attribute.Int("suggestions", len(suggestions)))
+
+	return suggestions, nil
+}
@@ -0,0 +1,54 @@
+package stocks
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"stocks/internal/domain"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// GetInventoryValuation sends inventory value aggregated by dimensions of filter row by row.
+func (s *stockServiceUseCase) GetInventoryValuation(
+	ctx context.Context,
+	filter domain.InventoryValuationFilter,
+	send func(row domain.InventoryValuationRow) error,
+) error {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.GetInventoryValuation")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("group_by", fmt.Sprintf("%v", filter.GroupBy)),
+		attribute.String("user_id", fmt.Sprintf("%d", filter.UserID)),
+		attribute.String("location", filter.Location),
+		attribute.String("type", filter.Type),
+	)
+
+	if !filter.AsOf.IsZero() {
+		span.SetAttributes(attribute.String("as_of", filter.AsOf.Format(time.RFC3339)))
+
+		if filter.AsOf.After(time.Now()) {
+			span.SetAttributes(attribute.String("error.message", domain.ErrValuationAsOfInFuture.Error()))
+			return domain.ErrValuationAsOfInFuture
+		}
+	}
+
+	if len(filter.GroupBy) == 0 {
+		filter.GroupBy = []domain.ValuationDimension{
+			domain.ValuationDimensionLocation,
+			domain.ValuationDimensionType,
+			domain.ValuationDimensionOwner,
+		}
+	}
+
+	err := s.StreamInventoryValuation(ctx, filter, send)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return err
+	}
+
+	return nil
+}
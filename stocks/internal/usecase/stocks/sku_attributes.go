@@ -0,0 +1,173 @@
+package stocks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"stocks/internal/domain"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// SetAttributeSchema creates or replaces attribute schema of sku type, existing sku attributes
+// are validated against new schema when they are updated next time.
+func (s *stockServiceUseCase) SetAttributeSchema(ctx context.Context, schema domain.AttributeSchema) error {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.SetAttributeSchema")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("type", schema.SkuType),
+		attribute.Int("attributes", len(schema.Attributes)),
+	)
+
+	if err := schema.Validate(); err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return err
+	}
+
+	err := s.SaveAttributeSchema(ctx, schema)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+// CreateVariantGroup creates variant group of sku type, its axes must be attributes of type schema.
+func (s *stockServiceUseCase) CreateVariantGroup(ctx context.Context, group domain.VariantGroup) (domain.VariantGroup, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.CreateVariantGroup")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("name", group.Name),
+		attribute.String("type", group.SkuType),
+		attribute.String("axes", strings.Join(group.Axes, ",")),
+	)
+
+	schema, err := s.attributeSchema(ctx, group.SkuType)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.VariantGroup{}, err
+	}
+
+	for _, axis := range group.Axes {
+		defined := slices.ContainsFunc(schema.Attributes, func(definition domain.AttributeDefinition) bool {
+			return definition.Name == axis
+		})
+		if !defined {
+			err := fmt.Errorf("%w: variant axis %q is not defined for type %q", domain.ErrInvalidAttributes, axis, group.SkuType)
+			span.SetAttributes(attribute.String("error.message", err.Error()))
+
+			return domain.VariantGroup{}, err
+		}
+	}
+
+	createdGroup, err := s.SKURepository.CreateVariantGroup(ctx, group)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.VariantGroup{}, err
+	}
+
+	return createdGroup, nil
+}
+
+// GetVariantGroup returns variant group with its variants.
+func (s *stockServiceUseCase) GetVariantGroup(ctx context.Context, groupID domain.VariantGroupID) (domain.VariantGroup, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.GetVariantGroup")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("variant_group_id", fmt.Sprintf("%d", groupID)))
+
+	group, err := s.SKURepository.GetVariantGroup(ctx, groupID)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.VariantGroup{}, err
+	}
+
+	return group, nil
+}
+
+// UpdateSKUAttributes validates attributes against schema of sku type and sets them with variant group of sku.
+func (s *stockServiceUseCase) UpdateSKUAttributes(ctx context.Context, update domain.SKUAttributesUpdate) (domain.SKU, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.UpdateSKUAttributes")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("sku_id", fmt.Sprintf("%d", update.SkuID)),
+		attribute.String("variant_group_id", fmt.Sprintf("%d", update.VariantGroupID)),
+	)
+
+	sku, err := s.GetSKUByID(ctx, update.SkuID)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.SKU{}, err
+	}
+
+	schema, err := s.attributeSchema(ctx, sku.Type)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.SKU{}, err
+	}
+
+	if err := schema.ValidateAttributes(update.Attributes); err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.SKU{}, err
+	}
+
+	var axesValues domain.SKUAttributes
+
+	if update.VariantGroupID != 0 {
+		group, err := s.SKURepository.GetVariantGroup(ctx, update.VariantGroupID)
+		if err != nil {
+			span.SetAttributes(attribute.String("error.message", err.Error()))
+			return domain.SKU{}, err
+		}
+
+		if group.SkuType != sku.Type {
+			err := fmt.Errorf("%w: variant group is for type %q, sku is %q", domain.ErrInvalidAttributes, group.SkuType, sku.Type)
+			span.SetAttributes(attribute.String("error.message", err.Error()))
+
+			return domain.SKU{}, err
+		}
+
+		axesValues, err = group.AxesValues(update.Attributes)
+		if err != nil {
+			span.SetAttributes(attribute.String("error.message", err.Error()))
+			return domain.SKU{}, err
+		}
+	}
+
+	err = s.SKURepository.UpdateSKUAttributes(ctx, update, axesValues)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.SKU{}, err
+	}
+
+	sku.VariantGroupID = update.VariantGroupID
+	sku.Attributes = update.Attributes
+
+	if update.Unit != "" {
+		sku.Unit = update.Unit
+	}
+
+	return sku, nil
+}
+
+// attributeSchema returns attribute schema of sku type, type without schema allows no attributes.
+func (s *stockServiceUseCase) attributeSchema(ctx context.Context, skuType string) (domain.AttributeSchema, error) {
+	schema, err := s.GetAttributeSchema(ctx, skuType)
+	if err != nil {
+		if errors.Is(err, domain.ErrAttributeSchemaNotFound) {
+			return domain.AttributeSchema{SkuType: skuType}, nil
+		}
+
+		return domain.AttributeSchema{}, err
+	}
+
+	return schema, nil
+}
@@ -6,9 +6,12 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"slices"
+	"stocks/internal/changebus"
 	"stocks/internal/domain"
 	"stocks/internal/kafka"
 	"stocks/internal/usecase"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -20,24 +23,98 @@ type (
 	// SKURepository provides repository methods of SKU service.
 	SKURepository interface {
 		GetSKUByID(ctx context.Context, skuID domain.SKUID) (domain.SKU, error)
+		SearchSKUsByQuery(ctx context.Context, filter domain.SKUSearchFilter) ([]domain.SKUSearchResult, error)
+		CountSKUsByType(ctx context.Context, filter domain.SKUSearchFilter) ([]domain.TypeFacet, error)
+		SaveBundle(ctx context.Context, bundle domain.Bundle) error
+		GetBundleComponents(ctx context.Context, skuID domain.SKUID) ([]domain.BundleComponent, error)
+		SaveAttributeSchema(ctx context.Context, schema domain.AttributeSchema) error
+		GetAttributeSchema(ctx context.Context, skuType string) (domain.AttributeSchema, error)
+		CreateVariantGroup(ctx context.Context, group domain.VariantGroup) (domain.VariantGroup, error)
+		GetVariantGroup(ctx context.Context, groupID domain.VariantGroupID) (domain.VariantGroup, error)
+		UpdateSKUAttributes(ctx context.Context, update domain.SKUAttributesUpdate, axesValues domain.SKUAttributes) error
 	}
 
 	// StockServiceRepository provides repository methods of stock service.
 	StockServiceRepository interface {
-		SaveStockItem(ctx context.Context, stockItem domain.StockItem) error
-		GetStockItem(ctx context.Context, userID domain.UserID, skuID domain.SKUID) (domain.StockItem, error)
-		UpdateStockItem(ctx context.Context, stockItem domain.StockItem) error
-		DeleteStockItemFromStorage(ctx context.Context, userID domain.UserID, skuID domain.SKUID) error
-		GetStockItemBySku(ct context.Context, skuID domain.SKUID) (domain.StockItem, error)
+		UpsertStockItem(ctx context.Context, stockItem domain.StockItem) (domain.StockItem, bool, error)
+		GetStockItem(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) (domain.StockItem, error)
+		UpdateStockItemFields(ctx context.Context, update domain.StockItemUpdate) (domain.StockItem, error)
+		DeleteStockItemFromStorage(ctx context.Context, userID domain.UserID, skuID domain.SKUID, deletedBy domain.UserID) ([]domain.StockItem, error)
+		RestoreDeletedStockItem(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) (domain.StockItem, error)
+		PurgeStockItemsDeletedBefore(ctx context.Context, deletedBefore time.Time) (int64, error)
+		ListStockItemOffers(ctx context.Context, filter domain.OfferFilter) ([]domain.StockItem, error)
+		ListBundleOffers(ctx context.Context, filter domain.OfferFilter) ([]domain.StockItem, error)
 		ListStockItemsByLocation(ctx context.Context, filter domain.Filter) ([]domain.StockItem, error)
-		CountStockItems(ctx context.Context, userID domain.UserID, location string) (uint16, error)
+		ListStockItemsBySkus(ctx context.Context, skuIDs []domain.SKUID) ([]domain.StockItem, error)
+		CountStockItems(ctx context.Context, filter domain.Filter) (uint16, error)
+		AdjustStockCount(ctx context.Context, adjustment domain.StockAdjustment) (domain.StockAdjustmentResult, error)
+		AdjustBundleComponents(
+			ctx context.Context,
+			adjustment domain.StockAdjustment,
+			components []domain.BundleComponent,
+		) ([]domain.StockAdjustmentResult, error)
+		UpdateBackorderSettings(ctx context.Context, settings domain.BackorderSettings) error
+		ShipStockTransfer(ctx context.Context, transfer domain.StockTransfer, receiveImmediately bool) (domain.StockTransferResult, error)
+		ReceiveStockTransfer(ctx context.Context, userID domain.UserID, transferID domain.TransferID) (domain.StockTransferResult, error)
+		GetStockTransfer(ctx context.Context, userID domain.UserID, transferID domain.TransferID) (domain.StockTransfer, error)
+		ExpireDueLots(ctx context.Context, limit int) ([]domain.ExpiredLot, error)
+		CountLotsExpiringBefore(ctx context.Context, filter domain.ExpiringLotsFilter) (uint32, error)
+		ListLotsExpiringBefore(ctx context.Context, filter domain.ExpiringLotsFilter) ([]domain.StockLot, error)
+		CreateCycleCount(ctx context.Context, cycleCount domain.CycleCount) (domain.CycleCount, error)
+		GetCycleCount(ctx context.Context, cycleCountID domain.CycleCountID) (domain.CycleCount, error)
+		SaveCycleCounts(ctx context.Context, submission domain.CycleCountSubmission) error
+		ApplyCycleCountVariances(ctx context.Context, cycleCountID domain.CycleCountID, approvedBy domain.UserID) (domain.CycleCountApproval, error)
+		SaveSupplier(ctx context.Context, supplier domain.Supplier) (domain.Supplier, error)
+		ListSuppliersOfMerchant(ctx context.Context, userID domain.UserID) ([]domain.Supplier, error)
+		SavePurchaseOrder(ctx context.Context, purchaseOrder domain.PurchaseOrder) (domain.PurchaseOrder, error)
+		GetPurchaseOrderByID(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) (domain.PurchaseOrder, error)
+		MarkPurchaseOrderOrdered(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) (domain.PurchaseOrder, error)
+		ReceivePurchaseOrderLines(ctx context.Context, receipt domain.PurchaseOrderReceipt) (domain.PurchaseOrderReceiptResult, error)
+		SaveCartDemand(ctx context.Context, demand domain.CartDemand) error
+		ListDemandHistory(ctx context.Context, filter domain.ReorderFilter, since time.Time) ([]domain.DemandHistory, error)
+	}
+
+	// StockThresholdRepository provides repository methods of reorder thresholds and stock levels.
+	StockThresholdRepository interface {
+		SaveStockThreshold(ctx context.Context, threshold domain.StockThreshold) error
+		GetStockThreshold(ctx context.Context, skuID domain.SKUID, location string) (domain.StockThreshold, error)
+		UpdateStockLevel(ctx context.Context, stockItem domain.StockItem, from, to domain.StockLevel) (bool, error)
+		CountLowStockItems(ctx context.Context, location string) (uint32, error)
+		ListLowStockItems(ctx context.Context, filter domain.LowStockFilter) ([]domain.LowStockItem, error)
+	}
+
+	// PriceRepository provides repository methods of price history and scheduled price changes.
+	PriceRepository interface {
+		SaveScheduledPriceChange(ctx context.Context, priceChange domain.ScheduledPriceChange) (domain.ScheduledPriceChange, error)
+		ApplyDuePriceChanges(ctx context.Context, limit int) ([]domain.ScheduledPriceChange, error)
+		ListPriceHistory(ctx context.Context, filter domain.PriceHistoryFilter) ([]domain.PriceHistoryEntry, error)
+		ListPendingPriceChanges(ctx context.Context, filter domain.PriceHistoryFilter) ([]domain.ScheduledPriceChange, error)
+		ReplacePriceTiers(ctx context.Context, priceList domain.PriceList) error
+		ListPriceTiersOfStockItem(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) ([]domain.PriceTier, error)
+	}
+
+	// ReportRepository provides repository methods of stock reports.
+	ReportRepository interface {
+		StreamInventoryValuation(
+			ctx context.Context,
+			filter domain.InventoryValuationFilter,
+			fn func(row domain.InventoryValuationRow) error,
+		) error
 	}
 )
 
 type stockServiceUseCase struct {
 	SKURepository
 	StockServiceRepository
+	StockThresholdRepository
+	PriceRepository
+	ReportRepository
 	KafkaProducer kafka.StocksEventProducer
+	Changes       changebus.Subscriber
+	// DefaultOfferRule chooses the best offer of sku when request does not name a rule.
+	DefaultOfferRule domain.OfferRule
+	// ReorderDefaults are reorder parameters used when request does not set them.
+	ReorderDefaults domain.ReorderParams
 }
 
 var _ usecase.StockServiceUseCase = (*stockServiceUseCase)(nil)
@@ -45,12 +122,24 @@ var _ usecase.StockServiceUseCase = (*stockServiceUseCase)(nil)
 func NewStockServiceUseCase(
 	skuRepo SKURepository,
 	stockRepo StockServiceRepository,
+	thresholdRepo StockThresholdRepository,
+	priceRepo PriceRepository,
+	reportRepo ReportRepository,
 	kafkaProducer kafka.StocksEventProducer,
+	changes changebus.Subscriber,
+	defaultOfferRule domain.OfferRule,
+	reorderDefaults domain.ReorderParams,
 ) *stockServiceUseCase {
 	return &stockServiceUseCase{
-		SKURepository:          skuRepo,
-		StockServiceRepository: stockRepo,
-		KafkaProducer:          kafkaProducer,
+		SKURepository:            skuRepo,
+		StockServiceRepository:   stockRepo,
+		StockThresholdRepository: thresholdRepo,
+		PriceRepository:          priceRepo,
+		ReportRepository:         reportRepo,
+		KafkaProducer:            kafkaProducer,
+		Changes:                  changes,
+		DefaultOfferRule:         defaultOfferRule,
+		ReorderDefaults:          reorderDefaults,
 	}
 }
 
@@ -61,8 +150,9 @@ func (s *stockServiceUseCase) AddStockItem(ctx context.Context, stockItem domain
 	span.SetAttributes(
 		attribute.String("user_id", fmt.Sprintf("%d", stockItem.UserID)),
 		attribute.String("sku_id", fmt.Sprintf("%d", stockItem.Sku.ID)),
-		attribute.Int64("count", int64(stockItem.Count)),
-		attribute.Int64("price", int64(stockItem.Price)),
+		attribute.Int64("count", stockItem.Count),
+		attribute.Int64("price", stockItem.Price.Amount),
+		attribute.String("currency", string(stockItem.Price.Currency)),
 		attribute.String("location", stockItem.Location),
 	)
 
@@ -73,79 +163,232 @@ func (s *stockServiceUseCase) AddStockItem(ctx context.Context, stockItem domain
 		return err
 	}
 
-	stockItem.Sku = sku
+	if sku.IsBundle {
+		span.SetAttributes(attribute.String("error.message", domain.ErrSKUIsBundle.Error()))
+		return domain.ErrSKUIsBundle
+	}
 
-	existingStockItem, err := s.GetStockItem(ctx, stockItem.UserID, stockItem.Sku.ID)
-	if err != nil {
-		if errors.Is(err, domain.ErrStockItemNotFound) {
-			err = s.SaveStockItem(ctx, stockItem)
-			if err != nil {
-				span.SetAttributes(attribute.String("error.message", err.Error()))
-				return err
-			}
+	stockItem.Sku = sku
 
-			s.KafkaProducer.ProduceSKUCreated(ctx, kafka.SKUCreatedAndStockChangedPayload{
-				SKU:   fmt.Sprintf("%d", stockItem.Sku.ID),
-				Count: stockItem.Count,
-				Price: stockItem.Price,
-			})
+	if stockItem.Lot != nil {
+		if err := stockItem.Lot.Validate(); err != nil {
+			span.SetAttributes(attribute.String("error.message", err.Error()))
+			return err
 		}
 
-		span.SetAttributes(attribute.String("error.message", err.Error()))
+		span.SetAttributes(attribute.String("lot_number", stockItem.Lot.LotNumber))
+	}
 
+	// upsert is atomic, so concurrent adds of the same stock item do not race.
+	upsertedStockItem, created, err := s.UpsertStockItem(ctx, stockItem)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
 		return err
 	}
 
-	stockItem.Count += existingStockItem.Count
+	s.notifyStockItemAdded(ctx, upsertedStockItem, created)
 
-	err = s.UpdateStockItem(ctx, stockItem)
+	return nil
+}
+
+// notifyStockItemAdded emits sku_created for new stock item or stock_changed for existing one and checks its level.
+func (s *stockServiceUseCase) notifyStockItemAdded(ctx context.Context, stockItem domain.StockItem, created bool) {
+	payload := kafka.SKUCreatedAndStockChangedPayload{
+		SKU:      fmt.Sprintf("%d", stockItem.Sku.ID),
+		Count:    stockItem.Count,
+		Price:    stockItem.Price.Amount,
+		Currency: string(stockItem.Price.Currency),
+	}
+
+	if created {
+		s.KafkaProducer.ProduceSKUCreated(ctx, payload)
+	} else {
+		s.KafkaProducer.ProduceStockChanged(ctx, payload)
+	}
+
+	s.checkStockLevel(ctx, stockItem, stockItem.Level)
+}
+
+func (s *stockServiceUseCase) UpdateStockItem(ctx context.Context, update domain.StockItemUpdate) (domain.StockItem, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.UpdateStockItem")
+	defer span.End()
+
+	updateMask := make([]string, 0, len(update.UpdateMask))
+	for _, field := range update.UpdateMask {
+		updateMask = append(updateMask, string(field))
+	}
+
+	span.SetAttributes(
+		attribute.String("user_id", fmt.Sprintf("%d", update.UserID)),
+		attribute.String("sku_id", fmt.Sprintf("%d", update.SkuID)),
+		attribute.String("location", update.Location),
+		attribute.StringSlice("update_mask", updateMask),
+	)
+
+	stockItem, err := s.UpdateStockItemFields(ctx, update)
 	if err != nil {
 		span.SetAttributes(attribute.String("error.message", err.Error()))
-		return err
+		return domain.StockItem{}, err
 	}
 
 	s.KafkaProducer.ProduceStockChanged(ctx, kafka.SKUCreatedAndStockChangedPayload{
-		SKU:   fmt.Sprintf("%d", stockItem.Sku.ID),
-		Count: stockItem.Count,
-		Price: stockItem.Price,
+		SKU:      fmt.Sprintf("%d", stockItem.Sku.ID),
+		Count:    stockItem.Count,
+		Price:    stockItem.Price.Amount,
+		Currency: string(stockItem.Price.Currency),
 	})
 
-	return nil
+	s.checkStockLevel(ctx, stockItem, stockItem.Level)
+
+	return stockItem, nil
 }
 
-func (s *stockServiceUseCase) DeleteStockItem(ctx context.Context, userID domain.UserID, skuID domain.SKUID) error {
+func (s *stockServiceUseCase) DeleteStockItem(ctx context.Context, userID domain.UserID, skuID domain.SKUID, deletedBy domain.UserID) error {
 	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.DeleteStockItem")
 	defer span.End()
 
 	span.SetAttributes(
 		attribute.String("user_id", fmt.Sprintf("%d", userID)),
 		attribute.String("sku_id", fmt.Sprintf("%d", skuID)),
+		attribute.String("deleted_by", fmt.Sprintf("%d", deletedBy)),
 	)
 
-	err := s.DeleteStockItemFromStorage(ctx, userID, skuID)
+	deletedStockItems, err := s.DeleteStockItemFromStorage(ctx, userID, skuID, deletedBy)
 	if err != nil {
 		span.SetAttributes(attribute.String("error.message", err.Error()))
 		return err
 	}
 
+	for _, deletedStockItem := range deletedStockItems {
+		s.KafkaProducer.ProduceStockDeleted(ctx, kafka.StockDeletedPayload{
+			SKU:       fmt.Sprintf("%d", deletedStockItem.Sku.ID),
+			UserID:    int64(deletedStockItem.UserID),
+			Location:  deletedStockItem.Location,
+			Count:     deletedStockItem.Count,
+			DeletedBy: int64(deletedBy),
+		})
+	}
+
 	return nil
 }
 
-func (s *stockServiceUseCase) GetStockItemBySKU(ctx context.Context, skuID domain.SKUID) (domain.StockItem, error) {
-	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.GetStockItemBySKU")
+func (s *stockServiceUseCase) RestoreStockItem(
+	ctx context.Context,
+	userID domain.UserID,
+	skuID domain.SKUID,
+	location string,
+) (domain.StockItem, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.RestoreStockItem")
 	defer span.End()
 
 	span.SetAttributes(
+		attribute.String("user_id", fmt.Sprintf("%d", userID)),
 		attribute.String("sku_id", fmt.Sprintf("%d", skuID)),
+		attribute.String("location", location),
 	)
 
-	stockItem, err := s.GetStockItemBySku(ctx, skuID)
+	restoredStockItem, err := s.RestoreDeletedStockItem(ctx, userID, skuID, location)
 	if err != nil {
 		span.SetAttributes(attribute.String("error.message", err.Error()))
 		return domain.StockItem{}, err
 	}
 
-	return stockItem, nil
+	s.KafkaProducer.ProduceStockChanged(ctx, kafka.SKUCreatedAndStockChangedPayload{
+		SKU:      fmt.Sprintf("%d", restoredStockItem.Sku.ID),
+		Count:    restoredStockItem.Count,
+		Price:    restoredStockItem.Price.Amount,
+		Currency: string(restoredStockItem.Price.Currency),
+	})
+
+	return restoredStockItem, nil
+}
+
+// PurgeDeletedStockItems removes stock items which stay deleted longer than retention.
+func (s *stockServiceUseCase) PurgeDeletedStockItems(ctx context.Context, retention time.Duration) error {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.PurgeDeletedStockItems")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("retention", retention.String()))
+
+	purgedCount, err := s.PurgeStockItemsDeletedBefore(ctx, time.Now().Add(-retention))
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return err
+	}
+
+	span.SetAttributes(attribute.Int64("purged_count", purgedCount))
+
+	return nil
+}
+
+// GetStockItemBySKU returns the best offer of sku by rule of filter or default one, with every offer when requested.
+func (s *stockServiceUseCase) GetStockItemBySKU(ctx context.Context, filter domain.OfferFilter) (domain.StockOffers, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.GetStockItemBySKU")
+	defer span.End()
+
+	if filter.Rule == "" {
+		filter.Rule = s.DefaultOfferRule
+	}
+
+	span.SetAttributes(
+		attribute.String("sku_id", fmt.Sprintf("%d", filter.SkuID)),
+		attribute.String("seller_id", fmt.Sprintf("%d", filter.SellerID)),
+		attribute.String("offer_rule", string(filter.Rule)),
+		attribute.Bool("all_offers", filter.AllOffers),
+		attribute.Int64("quantity", filter.Quantity),
+		attribute.String("customer_group", filter.CustomerGroup),
+	)
+
+	components, err := s.GetBundleComponents(ctx, filter.SkuID)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.StockOffers{}, err
+	}
+
+	listOffers := s.ListStockItemOffers
+	if len(components) > 0 {
+		listOffers = s.ListBundleOffers
+	}
+
+	offers, err := listOffers(ctx, filter)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.StockOffers{}, err
+	}
+
+	stockOffers := domain.StockOffers{Best: offers[0]}
+	if filter.AllOffers {
+		stockOffers.Offers = offers
+	}
+
+	return stockOffers, nil
+}
+
+// GetStockItemsBySKUs looks offers up for every filter like GetStockItemBySKU, skus without offers are left out.
+func (s *stockServiceUseCase) GetStockItemsBySKUs(ctx context.Context, filters []domain.OfferFilter) ([]domain.StockOffers, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.GetStockItemsBySKUs")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("lookups", len(filters)))
+
+	stockOffers := make([]domain.StockOffers, 0, len(filters))
+
+	for _, filter := range filters {
+		offers, err := s.GetStockItemBySKU(ctx, filter)
+		if err != nil {
+			if errors.Is(err, domain.ErrStockItemNotFound) {
+				continue
+			}
+
+			span.SetAttributes(attribute.String("error.message", err.Error()))
+
+			return nil, err
+		}
+
+		stockOffers = append(stockOffers, offers)
+	}
+
+	return stockOffers, nil
 }
 
 func (s *stockServiceUseCase) ListStockItems(ctx context.Context, filter domain.Filter) (domain.PaginatedResponse[domain.StockItem], error) {
@@ -161,13 +404,13 @@ func (s *stockServiceUseCase) ListStockItems(ctx context.Context, filter domain.
 
 	var paginatedResponse domain.PaginatedResponse[domain.StockItem]
 	// count stock items.
-	countStockItems, err := s.CountStockItems(ctx, filter.UserID, filter.Location)
+	countStockItems, err := s.CountStockItems(ctx, filter)
 	if err != nil {
 		span.SetAttributes(attribute.String("error.message", err.Error()))
 		return domain.PaginatedResponse[domain.StockItem]{}, err
 	}
 
-	paginatedResponse.TotalCount = countStockItems
+	paginatedResponse.TotalCount = uint32(countStockItems)
 
 	listOfStockItems, err := s.ListStockItemsByLocation(ctx, filter)
 	if err != nil {
@@ -180,3 +423,338 @@ func (s *stockServiceUseCase) ListStockItems(ctx context.Context, filter domain.
 
 	return paginatedResponse, nil
 }
+
+func (s *stockServiceUseCase) SearchSKUs(ctx context.Context, filter domain.SKUSearchFilter) (domain.SKUSearchResponse, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.SearchSKUs")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("query", filter.Query),
+		attribute.StringSlice("types", filter.Types),
+		attribute.Int64("page_size", filter.PageSize),
+		attribute.Int64("current_page", filter.CurrentPage),
+	)
+
+	var searchResponse domain.SKUSearchResponse
+	// facets are counted over matches of every type, so clients can see other types while filtering.
+	typeFacets, err := s.CountSKUsByType(ctx, filter)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.SKUSearchResponse{}, err
+	}
+
+	var totalCount uint32
+
+	for _, typeFacet := range typeFacets {
+		if len(filter.Types) == 0 || slices.Contains(filter.Types, typeFacet.Type) {
+			totalCount += typeFacet.Count
+		}
+	}
+
+	searchResults, err := s.SearchSKUsByQuery(ctx, filter)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.SKUSearchResponse{}, err
+	}
+
+	searchResponse.Items = searchResults
+	searchResponse.Facets = typeFacets
+	searchResponse.TotalCount = totalCount
+	searchResponse.PageNumber = int64(math.Ceil(float64(totalCount) / float64(filter.PageSize)))
+
+	return searchResponse, nil
+}
+
+func (s *stockServiceUseCase) SetStockThreshold(ctx context.Context, threshold domain.StockThreshold) error {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.SetStockThreshold")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("sku_id", fmt.Sprintf("%d", threshold.SkuID)),
+		attribute.String("location", threshold.Location),
+		attribute.Int64("reorder_threshold", int64(threshold.ReorderThreshold)),
+		attribute.Int64("hysteresis", int64(threshold.Hysteresis)),
+	)
+
+	// check sku exist or not.
+	_, err := s.GetSKUByID(ctx, threshold.SkuID)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return err
+	}
+
+	err = s.SaveStockThreshold(ctx, threshold)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+func (s *stockServiceUseCase) ListLowStock(ctx context.Context, filter domain.LowStockFilter) (domain.PaginatedResponse[domain.LowStockItem], error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.ListLowStock")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("location", filter.Location),
+		attribute.Int64("page_size", filter.PageSize),
+		attribute.Int64("current_page", filter.CurrentPage),
+	)
+
+	var paginatedResponse domain.PaginatedResponse[domain.LowStockItem]
+
+	countLowStockItems, err := s.CountLowStockItems(ctx, filter.Location)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.PaginatedResponse[domain.LowStockItem]{}, err
+	}
+
+	paginatedResponse.TotalCount = countLowStockItems
+
+	lowStockItems, err := s.ListLowStockItems(ctx, filter)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.PaginatedResponse[domain.LowStockItem]{}, err
+	}
+
+	paginatedResponse.Items = lowStockItems
+	paginatedResponse.PageNumber = int64(math.Ceil(float64(countLowStockItems) / float64(filter.PageSize)))
+
+	return paginatedResponse, nil
+}
+
+// checkStockLevel re-evaluates stock level of changed stock item and emits stock_low or stock_depleted
+// when it crosses reorder threshold. Stock change is already stored, so alerting failures are only traced.
+func (s *stockServiceUseCase) checkStockLevel(ctx context.Context, stockItem domain.StockItem, current domain.StockLevel) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.checkStockLevel")
+	defer span.End()
+
+	threshold, err := s.GetStockThreshold(ctx, stockItem.Sku.ID, stockItem.Location)
+	if err != nil {
+		if !errors.Is(err, domain.ErrStockThresholdNotFound) {
+			span.SetAttributes(attribute.String("error.message", err.Error()))
+		}
+
+		return
+	}
+
+	level := threshold.Evaluate(current, stockItem.Count)
+	if level == current {
+		return
+	}
+
+	changed, err := s.UpdateStockLevel(ctx, stockItem, current, level)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return
+	}
+
+	// level was moved by concurrent update, it is responsible for alerting.
+	if !changed {
+		return
+	}
+
+	span.SetAttributes(attribute.String("stock_level", string(level)))
+
+	payload := kafka.StockLevelPayload{
+		SKU:              fmt.Sprintf("%d", stockItem.Sku.ID),
+		UserID:           int64(stockItem.UserID),
+		Location:         stockItem.Location,
+		Count:            stockItem.Count,
+		ReorderThreshold: threshold.ReorderThreshold,
+	}
+
+	switch level {
+	case domain.StockLevelLow:
+		// recovering from depleted is not an alert.
+		if current != domain.StockLevelDepleted {
+			s.KafkaProducer.ProduceStockLow(ctx, payload)
+		}
+	case domain.StockLevelDepleted:
+		s.KafkaProducer.ProduceStockDepleted(ctx, payload)
+	case domain.StockLevelOK:
+	}
+}
+
+func (s *stockServiceUseCase) AdjustStock(ctx context.Context, adjustment domain.StockAdjustment) (domain.StockAdjustmentResult, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.AdjustStock")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user_id", fmt.Sprintf("%d", adjustment.UserID)),
+		attribute.String("sku_id", fmt.Sprintf("%d", adjustment.SkuID)),
+		attribute.String("location", adjustment.Location),
+		attribute.Int64("delta", adjustment.Delta),
+		attribute.String("reason", string(adjustment.Reason)),
+	)
+
+	components, err := s.GetBundleComponents(ctx, adjustment.SkuID)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.StockAdjustmentResult{}, err
+	}
+
+	// bundle has no stock of its own, its components are adjusted instead.
+	if len(components) > 0 {
+		adjustmentResult, err := s.adjustBundle(ctx, adjustment, components)
+		if err != nil {
+			span.SetAttributes(attribute.String("error.message", err.Error()))
+			return domain.StockAdjustmentResult{}, err
+		}
+
+		return adjustmentResult, nil
+	}
+
+	adjustmentResult, err := s.AdjustStockCount(ctx, adjustment)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.StockAdjustmentResult{}, err
+	}
+
+	s.KafkaProducer.ProduceStockChanged(ctx, kafka.SKUCreatedAndStockChangedPayload{
+		SKU:      fmt.Sprintf("%d", adjustment.SkuID),
+		Count:    adjustmentResult.Count,
+		Price:    adjustmentResult.Price.Amount,
+		Currency: string(adjustmentResult.Price.Currency),
+		Delta:    adjustment.Delta,
+		Reason:   string(adjustment.Reason),
+	})
+
+	s.checkStockLevel(ctx, adjustmentResult.StockItem, adjustmentResult.Level)
+
+	return adjustmentResult, nil
+}
+
+func (s *stockServiceUseCase) SetBackorderSettings(ctx context.Context, settings domain.BackorderSettings) error {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.SetBackorderSettings")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user_id", fmt.Sprintf("%d", settings.UserID)),
+		attribute.String("sku_id", fmt.Sprintf("%d", settings.SkuID)),
+		attribute.String("location", settings.Location),
+		attribute.String("mode", string(settings.Mode)),
+		attribute.Int64("max_quantity", settings.MaxQuantity),
+	)
+
+	if err := settings.Validate(); err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return err
+	}
+
+	err := s.UpdateBackorderSettings(ctx, settings)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+func (s *stockServiceUseCase) TransferStock(
+	ctx context.Context,
+	transfer domain.StockTransfer,
+	receiveImmediately bool,
+) (domain.StockTransfer, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.TransferStock")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user_id", fmt.Sprintf("%d", transfer.UserID)),
+		attribute.String("sku_id", fmt.Sprintf("%d", transfer.SkuID)),
+		attribute.String("from_location", transfer.FromLocation),
+		attribute.String("to_location", transfer.ToLocation),
+		attribute.Int64("quantity", transfer.Quantity),
+		attribute.Bool("receive_immediately", receiveImmediately),
+	)
+
+	transferResult, err := s.ShipStockTransfer(ctx, transfer, receiveImmediately)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.StockTransfer{}, err
+	}
+
+	s.KafkaProducer.ProduceStockTransferShipped(ctx, stockTransferPayload(transferResult.Transfer))
+
+	if transferResult.Transfer.Status == domain.TransferStatusReceived {
+		s.KafkaProducer.ProduceStockTransferReceived(ctx, stockTransferPayload(transferResult.Transfer))
+	}
+
+	s.notifyStockItemsChanged(ctx, transferResult.ChangedItems)
+
+	return transferResult.Transfer, nil
+}
+
+func (s *stockServiceUseCase) ReceiveTransfer(
+	ctx context.Context,
+	userID domain.UserID,
+	transferID domain.TransferID,
+) (domain.StockTransfer, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.ReceiveTransfer")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user_id", fmt.Sprintf("%d", userID)),
+		attribute.Int64("transfer_id", int64(transferID)),
+	)
+
+	transferResult, err := s.ReceiveStockTransfer(ctx, userID, transferID)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.StockTransfer{}, err
+	}
+
+	s.KafkaProducer.ProduceStockTransferReceived(ctx, stockTransferPayload(transferResult.Transfer))
+	s.notifyStockItemsChanged(ctx, transferResult.ChangedItems)
+
+	return transferResult.Transfer, nil
+}
+
+func (s *stockServiceUseCase) GetTransfer(
+	ctx context.Context,
+	userID domain.UserID,
+	transferID domain.TransferID,
+) (domain.StockTransfer, error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.GetTransfer")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("user_id", fmt.Sprintf("%d", userID)),
+		attribute.Int64("transfer_id", int64(transferID)),
+	)
+
+	transfer, err := s.GetStockTransfer(ctx, userID, transferID)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.StockTransfer{}, err
+	}
+
+	return transfer, nil
+}
+
+// notifyStockItemsChanged emits stock_changed and re-evaluates stock levels of changed stock items.
+func (s *stockServiceUseCase) notifyStockItemsChanged(ctx context.Context, stockItems []domain.StockItem) {
+	for _, stockItem := range stockItems {
+		s.KafkaProducer.ProduceStockChanged(ctx, kafka.SKUCreatedAndStockChangedPayload{
+			SKU:      fmt.Sprintf("%d", stockItem.Sku.ID),
+			Count:    stockItem.Count,
+			Price:    stockItem.Price.Amount,
+			Currency: string(stockItem.Price.Currency),
+		})
+
+		s.checkStockLevel(ctx, stockItem, stockItem.Level)
+	}
+}
+
+func stockTransferPayload(transfer domain.StockTransfer) kafka.StockTransferPayload {
+	return kafka.StockTransferPayload{
+		TransferID:   int64(transfer.ID),
+		SKU:          fmt.Sprintf("%d", transfer.SkuID),
+		UserID:       int64(transfer.UserID),
+		FromLocation: transfer.FromLocation,
+		ToLocation:   transfer.ToLocation,
+		Quantity:     transfer.Quantity,
+		Status:       string(transfer.Status),
+	}
+}
@@ -3,6 +3,8 @@ package stocks
 import (
 	"context"
 	"errors"
+	"math"
+	"reflect"
 	"stocks/internal/domain"
 	"stocks/internal/usecase/stocks/mock"
 	"testing"
@@ -35,7 +37,7 @@ func TestStockServiceUseCase_AddStockItem(t *testing.T) {
 					Type: "apparel",
 				},
 				Count:    10,
-				Price:    12,
+				Price:    domain.Money{Currency: "RUB", Amount: 12},
 				Location: "Ashgabat",
 			},
 			skuRepoMock: func(sm *mock.SKURepositoryMock) {
@@ -44,18 +46,21 @@ func TestStockServiceUseCase_AddStockItem(t *testing.T) {
 					Return(domain.SKU{ID: 1001, Name: "t-shirt", Type: "apparel"}, nil)
 			},
 			stockRepoMock: func(ssrm *mock.StockServiceRepositoryMock) {
-				ssrm.GetStockItemMock.
-					Expect(ctx, domain.UserID(1), domain.SKUID(1001)).
-					Return(domain.StockItem{}, domain.ErrStockItemNotFound)
-				ssrm.SaveStockItemMock.
+				ssrm.UpsertStockItemMock.
 					Expect(ctx, domain.StockItem{
 						UserID:   1,
 						Sku:      domain.SKU{ID: 1001, Name: "t-shirt", Type: "apparel"},
 						Count:    10,
-						Price:    12,
+						Price:    domain.Money{Currency: "RUB", Amount: 12},
 						Location: "Ashgabat",
 					}).
-					Return(nil)
+					Return(domain.StockItem{
+						UserID:   1,
+						Sku:      domain.SKU{ID: 1001, Name: "t-shirt", Type: "apparel"},
+						Count:    10,
+						Price:    domain.Money{Currency: "RUB", Amount: 12},
+						Location: "Ashgabat",
+					}, true, nil)
 			},
 			wantCount: 10,
 			wantErr:   false,
@@ -70,7 +75,7 @@ func TestStockServiceUseCase_AddStockItem(t *testing.T) {
 					Type: "accessory",
 				},
 				Count:    5,
-				Price:    20,
+				Price:    domain.Money{Currency: "RUB", Amount: 20},
 				Location: "Ashgabat",
 			},
 			skuRepoMock: func(sm *mock.SKURepositoryMock) {
@@ -79,23 +84,21 @@ func TestStockServiceUseCase_AddStockItem(t *testing.T) {
 					Return(domain.SKU{ID: 2020, Name: "cup", Type: "accessory"}, nil)
 			},
 			stockRepoMock: func(ssrm *mock.StockServiceRepositoryMock) {
-				ssrm.GetStockItemMock.
-					Expect(ctx, domain.UserID(1), domain.SKUID(2020)).
-					Return(domain.StockItem{
+				ssrm.UpsertStockItemMock.
+					Expect(ctx, domain.StockItem{
 						UserID:   1,
 						Sku:      domain.SKU{ID: 2020, Name: "cup", Type: "accessory"},
-						Count:    10,
-						Price:    12,
+						Count:    5,
+						Price:    domain.Money{Currency: "RUB", Amount: 20},
 						Location: "Ashgabat",
-					}, nil)
-				ssrm.UpdateStockItemMock.
-					Expect(ctx, domain.StockItem{
+					}).
+					Return(domain.StockItem{
 						UserID:   1,
 						Sku:      domain.SKU{ID: 2020, Name: "cup", Type: "accessory"},
 						Count:    15,
-						Price:    20,
+						Price:    domain.Money{Currency: "RUB", Amount: 20},
 						Location: "Ashgabat",
-					}).Return(nil)
+					}, false, nil)
 			},
 			wantCount: 15,
 			wantErr:   false,
@@ -110,7 +113,7 @@ func TestStockServiceUseCase_AddStockItem(t *testing.T) {
 					Type: "apparel",
 				},
 				Count:    8,
-				Price:    30,
+				Price:    domain.Money{Currency: "RUB", Amount: 30},
 				Location: "Ashgabat",
 			},
 			skuRepoMock: func(sm *mock.SKURepositoryMock) {
@@ -122,32 +125,6 @@ func TestStockServiceUseCase_AddStockItem(t *testing.T) {
 			wantErr:       true,
 			expectedErr:   domain.ErrSKUNotFound,
 		},
-		{
-			name: "get stock item error except not found",
-			stockItem: domain.StockItem{
-				UserID: 1,
-				Sku: domain.SKU{
-					ID:   1003,
-					Name: "t-shirt",
-					Type: "apparel",
-				},
-				Count:    7,
-				Price:    25,
-				Location: "Ashgabat",
-			},
-			skuRepoMock: func(sm *mock.SKURepositoryMock) {
-				sm.GetSKUByIDMock.
-					Expect(ctx, domain.SKUID(1003)).
-					Return(domain.SKU{ID: 1003, Name: "t-shirt", Type: "apparel"}, nil)
-			},
-			stockRepoMock: func(ssrm *mock.StockServiceRepositoryMock) {
-				ssrm.GetStockItemMock.
-					Expect(ctx, domain.UserID(1), domain.SKUID(1003)).
-					Return(domain.StockItem{}, errors.New("database error"))
-			},
-			wantErr:     true,
-			expectedErr: errors.New("database error"),
-		},
 		{
 			name: "save stock item error",
 			stockItem: domain.StockItem{
@@ -158,7 +135,7 @@ func TestStockServiceUseCase_AddStockItem(t *testing.T) {
 					Type: "apparel",
 				},
 				Count:    7,
-				Price:    25,
+				Price:    domain.Money{Currency: "RUB", Amount: 25},
 				Location: "Ashgabat",
 			},
 			skuRepoMock: func(sm *mock.SKURepositoryMock) {
@@ -167,18 +144,15 @@ func TestStockServiceUseCase_AddStockItem(t *testing.T) {
 					Return(domain.SKU{ID: 1002, Name: "t-shirt", Type: "apparel"}, nil)
 			},
 			stockRepoMock: func(ssrm *mock.StockServiceRepositoryMock) {
-				ssrm.GetStockItemMock.
-					Expect(ctx, domain.UserID(1), domain.SKUID(1002)).
-					Return(domain.StockItem{}, domain.ErrStockItemNotFound)
-				ssrm.SaveStockItemMock.
+				ssrm.UpsertStockItemMock.
 					Expect(ctx, domain.StockItem{
 						UserID:   1,
 						Sku:      domain.SKU{ID: 1002, Name: "t-shirt", Type: "apparel"},
 						Count:    7,
-						Price:    25,
+						Price:    domain.Money{Currency: "RUB", Amount: 25},
 						Location: "Ashgabat",
 					}).
-					Return(errors.New("save stock item failed"))
+					Return(domain.StockItem{}, false, errors.New("save stock item failed"))
 			},
 			wantErr:     true,
 			expectedErr: errors.New("save stock item failed"),
@@ -204,3 +178,106 @@ func TestStockServiceUseCase_AddStockItem(t *testing.T) {
 	// 	})
 	// }
 }
+
+func TestStockServiceUseCase_SearchSKUs(t *testing.T) {
+	t.Parallel()
+
+	facets := []domain.TypeFacet{
+		{Type: "apparel", Count: 25},
+		{Type: "accessory", Count: 10},
+		{Type: "food", Count: 5},
+	}
+	results := []domain.SKUSearchResult{{Sku: domain.SKU{ID: 1001, Name: "t-shirt", Type: "apparel"}, Rank: 0.5}}
+
+	tests := []struct {
+		name           string
+		filter         domain.SKUSearchFilter
+		facets         []domain.TypeFacet
+		wantTotalCount uint32
+		wantPageNumber int64
+	}{
+		{
+			name:           "every type is counted",
+			filter:         domain.SKUSearchFilter{Query: "shirt", PageSize: 10, CurrentPage: 1},
+			facets:         facets,
+			wantTotalCount: 40,
+			wantPageNumber: 4,
+		},
+		{
+			name:           "only filtered types are counted",
+			filter:         domain.SKUSearchFilter{Query: "shirt", Types: []string{"apparel", "food"}, PageSize: 10, CurrentPage: 1},
+			facets:         facets,
+			wantTotalCount: 30,
+			wantPageNumber: 3,
+		},
+		{
+			name:           "last page is partial",
+			filter:         domain.SKUSearchFilter{Query: "shirt", Types: []string{"accessory"}, PageSize: 3, CurrentPage: 1},
+			facets:         facets,
+			wantTotalCount: 10,
+			wantPageNumber: 4,
+		},
+		{
+			name:   "no matches",
+			filter: domain.SKUSearchFilter{Query: "shirt", PageSize: 10, CurrentPage: 1},
+		},
+		{
+			name:           "count above uint16 range",
+			filter:         domain.SKUSearchFilter{Query: "shirt", PageSize: 100, CurrentPage: 1},
+			facets:         []domain.TypeFacet{{Type: "apparel", Count: math.MaxUint16}, {Type: "food", Count: 100}},
+			wantTotalCount: math.MaxUint16 + 100,
+			wantPageNumber: 657,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := minimock.NewController(t)
+			skuRepo := mock.NewSKURepositoryMock(ctrl)
+			skuRepo.CountSKUsByTypeMock.Expect(minimock.AnyContext, tt.filter).Return(tt.facets, nil)
+			skuRepo.SearchSKUsByQueryMock.Expect(minimock.AnyContext, tt.filter).Return(results, nil)
+
+			useCase := &stockServiceUseCase{SKURepository: skuRepo}
+
+			got, err := useCase.SearchSKUs(context.Background(), tt.filter)
+			if err != nil {
+				t.Fatalf("SearchSKUs() error = %v", err)
+			}
+
+			if got.TotalCount != tt.wantTotalCount {
+				t.Errorf("SearchSKUs() TotalCount = %d, want %d", got.TotalCount, tt.wantTotalCount)
+			}
+
+			if got.PageNumber != tt.wantPageNumber {
+				t.Errorf("SearchSKUs() PageNumber = %d, want %d", got.PageNumber, tt.wantPageNumber)
+			}
+
+			if !reflect.DeepEqual(got.Facets, tt.facets) {
+				t.Errorf("SearchSKUs() Facets = %v, want %v", got.Facets, tt.facets)
+			}
+
+			if !reflect.DeepEqual(got.Items, results) {
+				t.Errorf("SearchSKUs() Items = %v, want %v", got.Items, results)
+			}
+		})
+	}
+}
+
+func TestStockServiceUseCase_SearchSKUs_CountError(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+	filter := domain.SKUSearchFilter{Query: "shirt", PageSize: 10, CurrentPage: 1}
+	countErr := errors.New("count skus failed")
+
+	skuRepo := mock.NewSKURepositoryMock(ctrl)
+	skuRepo.CountSKUsByTypeMock.Expect(minimock.AnyContext, filter).Return(nil, countErr)
+
+	useCase := &stockServiceUseCase{SKURepository: skuRepo}
+
+	if _, err := useCase.SearchSKUs(context.Background(), filter); !errors.Is(err, countErr) {
+		t.Errorf("SearchSKUs() error = %v, want %v", err, countErr)
+	}
+}
@@ -0,0 +1,94 @@
+package stocks
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"stocks/internal/domain"
+	"stocks/internal/kafka"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// expiredLotsBatchSize limits how many expired lots are swept in one transaction.
+const expiredLotsBatchSize = 100
+
+func (s *stockServiceUseCase) ListExpiringLots(
+	ctx context.Context,
+	filter domain.ExpiringLotsFilter,
+) (domain.PaginatedResponse[domain.StockLot], error) {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.ListExpiringLots")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("expires_before", filter.ExpiresBefore.Format(time.RFC3339)),
+		attribute.String("user_id", fmt.Sprintf("%d", filter.UserID)),
+		attribute.String("location", filter.Location),
+		attribute.Int64("page_size", filter.PageSize),
+		attribute.Int64("current_page", filter.CurrentPage),
+	)
+
+	var paginatedResponse domain.PaginatedResponse[domain.StockLot]
+
+	countLots, err := s.CountLotsExpiringBefore(ctx, filter)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.PaginatedResponse[domain.StockLot]{}, err
+	}
+
+	paginatedResponse.TotalCount = countLots
+
+	lots, err := s.ListLotsExpiringBefore(ctx, filter)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return domain.PaginatedResponse[domain.StockLot]{}, err
+	}
+
+	paginatedResponse.Items = lots
+	paginatedResponse.PageNumber = int64(math.Ceil(float64(countLots) / float64(filter.PageSize)))
+
+	return paginatedResponse, nil
+}
+
+// ExpireStockLots moves units of all expired lots out of available stock in batches
+// and emits stock_changed with reason expired for each of them.
+func (s *stockServiceUseCase) ExpireStockLots(ctx context.Context) error {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.ExpireStockLots")
+	defer span.End()
+
+	var expiredCount int
+
+	for {
+		expiredLots, err := s.ExpireDueLots(ctx, expiredLotsBatchSize)
+		if err != nil {
+			span.SetAttributes(attribute.String("error.message", err.Error()))
+			return err
+		}
+
+		for _, expiredLot := range expiredLots {
+			s.KafkaProducer.ProduceStockChanged(ctx, kafka.SKUCreatedAndStockChangedPayload{
+				SKU:      fmt.Sprintf("%d", expiredLot.Lot.Sku.ID),
+				Count:    expiredLot.Count,
+				Price:    expiredLot.Price.Amount,
+				Currency: string(expiredLot.Price.Currency),
+				Delta:    -expiredLot.Lot.Quantity,
+				Reason:   string(domain.AdjustmentReasonExpired),
+			})
+
+			s.checkStockLevel(ctx, expiredLot.StockItem, expiredLot.Level)
+		}
+
+		expiredCount += len(expiredLots)
+
+		if len(expiredLots) < expiredLotsBatchSize {
+			break
+		}
+	}
+
+	span.SetAttributes(attribute.Int("expired_count", expiredCount))
+
+	return nil
+}
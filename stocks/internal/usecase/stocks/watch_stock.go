@@ -0,0 +1,76 @@
+package stocks
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"stocks/internal/domain"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// WatchStock sends current state of stock items of skus and then their every change until ctx is done.
+// Changes are coalesced while send is blocked, so slow watcher receives the latest state only.
+func (s *stockServiceUseCase) WatchStock(
+	ctx context.Context,
+	skuIDs []domain.SKUID,
+	send func(change domain.StockChange) error,
+) error {
+	ctx, span := otel.Tracer(os.Getenv("SERVICE_NAME")).Start(ctx, "StockServiceUseCase.WatchStock")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("sku_ids", fmt.Sprintf("%v", skuIDs)))
+
+	// subscribe before snapshot is read, so changes committed in between are not lost.
+	subscription := s.Changes.Subscribe(skuIDs)
+	defer s.Changes.Unsubscribe(subscription)
+
+	stockItems, err := s.ListStockItemsBySkus(ctx, skuIDs)
+	if err != nil {
+		span.SetAttributes(attribute.String("error.message", err.Error()))
+		return err
+	}
+
+	// changes committed before snapshot was read may still be pending, they are older than snapshot.
+	snapshots := make(map[watchedStockItem]domain.StockChange, len(stockItems))
+
+	for _, stockItem := range stockItems {
+		change := domain.NewStockChange(stockItem)
+		change.Snapshot = true
+		snapshots[watchedStockItem{userID: change.UserID, skuID: change.SkuID, location: change.Location}] = change
+
+		if err := send(change); err != nil {
+			span.SetAttributes(attribute.String("error.message", err.Error()))
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-subscription.Done():
+			return nil
+		case <-subscription.Notify():
+			for _, change := range subscription.Drain() {
+				snapshot, ok := snapshots[watchedStockItem{userID: change.UserID, skuID: change.SkuID, location: change.Location}]
+				if ok && !change.Supersedes(snapshot) {
+					continue
+				}
+
+				if err := send(change); err != nil {
+					span.SetAttributes(attribute.String("error.message", err.Error()))
+					return err
+				}
+			}
+		}
+	}
+}
+
+// watchedStockItem identifies stock item watcher received snapshot of.
+type watchedStockItem struct {
+	userID   domain.UserID
+	skuID    domain.SKUID
+	location string
+}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"stocks/internal/domain"
+	"time"
 )
 
 //go:generate mkdir -p mock
@@ -11,8 +12,51 @@ type (
 	// StockServiceUseCase represent stock service usecase methods.
 	StockServiceUseCase interface {
 		AddStockItem(ctx context.Context, stockItem domain.StockItem) error
-		DeleteStockItem(ctx context.Context, userID domain.UserID, skuID domain.SKUID) error
-		GetStockItemBySKU(ctx context.Context, skuID domain.SKUID) (domain.StockItem, error)
+		UpdateStockItem(ctx context.Context, update domain.StockItemUpdate) (domain.StockItem, error)
+		DeleteStockItem(ctx context.Context, userID domain.UserID, skuID domain.SKUID, deletedBy domain.UserID) error
+		RestoreStockItem(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) (domain.StockItem, error)
+		PurgeDeletedStockItems(ctx context.Context, retention time.Duration) error
+		GetStockItemBySKU(ctx context.Context, filter domain.OfferFilter) (domain.StockOffers, error)
+		GetStockItemsBySKUs(ctx context.Context, filters []domain.OfferFilter) ([]domain.StockOffers, error)
+		WatchStock(ctx context.Context, skuIDs []domain.SKUID, send func(change domain.StockChange) error) error
 		ListStockItems(ctx context.Context, filter domain.Filter) (domain.PaginatedResponse[domain.StockItem], error)
+		SearchSKUs(ctx context.Context, filter domain.SKUSearchFilter) (domain.SKUSearchResponse, error)
+		SetStockThreshold(ctx context.Context, threshold domain.StockThreshold) error
+		ListLowStock(ctx context.Context, filter domain.LowStockFilter) (domain.PaginatedResponse[domain.LowStockItem], error)
+		AdjustStock(ctx context.Context, adjustment domain.StockAdjustment) (domain.StockAdjustmentResult, error)
+		SetBackorderSettings(ctx context.Context, settings domain.BackorderSettings) error
+		TransferStock(ctx context.Context, transfer domain.StockTransfer, receiveImmediately bool) (domain.StockTransfer, error)
+		ReceiveTransfer(ctx context.Context, userID domain.UserID, transferID domain.TransferID) (domain.StockTransfer, error)
+		GetTransfer(ctx context.Context, userID domain.UserID, transferID domain.TransferID) (domain.StockTransfer, error)
+		SchedulePriceChange(ctx context.Context, priceChange domain.ScheduledPriceChange) (domain.ScheduledPriceChange, error)
+		SetPriceTiers(ctx context.Context, priceList domain.PriceList) ([]domain.PriceTier, error)
+		GetPriceTiers(ctx context.Context, userID domain.UserID, skuID domain.SKUID, location string) ([]domain.PriceTier, error)
+		ApplyScheduledPriceChanges(ctx context.Context) error
+		GetPriceHistory(ctx context.Context, filter domain.PriceHistoryFilter) (domain.PriceHistory, error)
+		ListExpiringLots(ctx context.Context, filter domain.ExpiringLotsFilter) (domain.PaginatedResponse[domain.StockLot], error)
+		ExpireStockLots(ctx context.Context) error
+		OpenCycleCount(ctx context.Context, cycleCount domain.CycleCount) (domain.CycleCount, error)
+		SubmitCycleCounts(ctx context.Context, submission domain.CycleCountSubmission) (domain.CycleCount, error)
+		ReviewCycleCount(ctx context.Context, cycleCountID domain.CycleCountID) (domain.CycleCount, error)
+		ApproveCycleCount(ctx context.Context, cycleCountID domain.CycleCountID, approvedBy domain.UserID) (domain.CycleCount, error)
+		CreateSupplier(ctx context.Context, supplier domain.Supplier) (domain.Supplier, error)
+		ListSuppliers(ctx context.Context, userID domain.UserID) ([]domain.Supplier, error)
+		CreatePurchaseOrder(ctx context.Context, purchaseOrder domain.PurchaseOrder) (domain.PurchaseOrder, error)
+		GetPurchaseOrder(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) (domain.PurchaseOrder, error)
+		PlacePurchaseOrder(ctx context.Context, purchaseOrderID domain.PurchaseOrderID) (domain.PurchaseOrder, error)
+		ReceivePurchaseOrder(ctx context.Context, receipt domain.PurchaseOrderReceipt) (domain.PurchaseOrder, error)
+		RecordCartDemand(ctx context.Context, demand domain.CartDemand) error
+		GetReorderSuggestions(ctx context.Context, filter domain.ReorderFilter) ([]domain.ReorderSuggestion, error)
+		SetBundle(ctx context.Context, bundle domain.Bundle) error
+		GetBundle(ctx context.Context, skuID domain.SKUID) (domain.Bundle, error)
+		SetAttributeSchema(ctx context.Context, schema domain.AttributeSchema) error
+		CreateVariantGroup(ctx context.Context, group domain.VariantGroup) (domain.VariantGroup, error)
+		GetVariantGroup(ctx context.Context, groupID domain.VariantGroupID) (domain.VariantGroup, error)
+		UpdateSKUAttributes(ctx context.Context, update domain.SKUAttributesUpdate) (domain.SKU, error)
+		GetInventoryValuation(
+			ctx context.Context,
+			filter domain.InventoryValuationFilter,
+			send func(row domain.InventoryValuationRow) error,
+		) error
 	}
 )
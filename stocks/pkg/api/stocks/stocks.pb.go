@@ -10,6 +10,9 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,6 +25,225 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type OfferRule int32
+
+const (
+	// default rule of service is used.
+	OfferRule_OFFER_RULE_UNSPECIFIED  OfferRule = 0
+	OfferRule_OFFER_RULE_LOWEST_PRICE OfferRule = 1
+	OfferRule_OFFER_RULE_MOST_STOCK   OfferRule = 2
+)
+
+// Enum value maps for OfferRule.
+var (
+	OfferRule_name = map[int32]string{
+		0: "OFFER_RULE_UNSPECIFIED",
+		1: "OFFER_RULE_LOWEST_PRICE",
+		2: "OFFER_RULE_MOST_STOCK",
+	}
+	OfferRule_value = map[string]int32{
+		"OFFER_RULE_UNSPECIFIED":  0,
+		"OFFER_RULE_LOWEST_PRICE": 1,
+		"OFFER_RULE_MOST_STOCK":   2,
+	}
+)
+
+func (x OfferRule) Enum() *OfferRule {
+	p := new(OfferRule)
+	*p = x
+	return p
+}
+
+func (x OfferRule) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OfferRule) Descriptor() protoreflect.EnumDescriptor {
+	return file_stocks_proto_enumTypes[0].Descriptor()
+}
+
+func (OfferRule) Type() protoreflect.EnumType {
+	return &file_stocks_proto_enumTypes[0]
+}
+
+func (x OfferRule) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OfferRule.Descriptor instead.
+func (OfferRule) EnumDescriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{0}
+}
+
+type AttributeType int32
+
+const (
+	AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED AttributeType = 0
+	AttributeType_ATTRIBUTE_TYPE_STRING      AttributeType = 1
+	AttributeType_ATTRIBUTE_TYPE_NUMBER      AttributeType = 2
+	AttributeType_ATTRIBUTE_TYPE_BOOLEAN     AttributeType = 3
+	// string restricted to allowed values.
+	AttributeType_ATTRIBUTE_TYPE_ENUM AttributeType = 4
+)
+
+// Enum value maps for AttributeType.
+var (
+	AttributeType_name = map[int32]string{
+		0: "ATTRIBUTE_TYPE_UNSPECIFIED",
+		1: "ATTRIBUTE_TYPE_STRING",
+		2: "ATTRIBUTE_TYPE_NUMBER",
+		3: "ATTRIBUTE_TYPE_BOOLEAN",
+		4: "ATTRIBUTE_TYPE_ENUM",
+	}
+	AttributeType_value = map[string]int32{
+		"ATTRIBUTE_TYPE_UNSPECIFIED": 0,
+		"ATTRIBUTE_TYPE_STRING":      1,
+		"ATTRIBUTE_TYPE_NUMBER":      2,
+		"ATTRIBUTE_TYPE_BOOLEAN":     3,
+		"ATTRIBUTE_TYPE_ENUM":        4,
+	}
+)
+
+func (x AttributeType) Enum() *AttributeType {
+	p := new(AttributeType)
+	*p = x
+	return p
+}
+
+func (x AttributeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttributeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_stocks_proto_enumTypes[1].Descriptor()
+}
+
+func (AttributeType) Type() protoreflect.EnumType {
+	return &file_stocks_proto_enumTypes[1]
+}
+
+func (x AttributeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttributeType.Descriptor instead.
+func (AttributeType) EnumDescriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{1}
+}
+
+type AdjustmentReason int32
+
+const (
+	AdjustmentReason_ADJUSTMENT_REASON_UNSPECIFIED AdjustmentReason = 0
+	AdjustmentReason_ADJUSTMENT_REASON_RECEIVED    AdjustmentReason = 1
+	AdjustmentReason_ADJUSTMENT_REASON_SOLD        AdjustmentReason = 2
+	AdjustmentReason_ADJUSTMENT_REASON_RETURNED    AdjustmentReason = 3
+	AdjustmentReason_ADJUSTMENT_REASON_DAMAGED     AdjustmentReason = 4
+	AdjustmentReason_ADJUSTMENT_REASON_LOST        AdjustmentReason = 5
+	AdjustmentReason_ADJUSTMENT_REASON_CORRECTION  AdjustmentReason = 6
+)
+
+// Enum value maps for AdjustmentReason.
+var (
+	AdjustmentReason_name = map[int32]string{
+		0: "ADJUSTMENT_REASON_UNSPECIFIED",
+		1: "ADJUSTMENT_REASON_RECEIVED",
+		2: "ADJUSTMENT_REASON_SOLD",
+		3: "ADJUSTMENT_REASON_RETURNED",
+		4: "ADJUSTMENT_REASON_DAMAGED",
+		5: "ADJUSTMENT_REASON_LOST",
+		6: "ADJUSTMENT_REASON_CORRECTION",
+	}
+	AdjustmentReason_value = map[string]int32{
+		"ADJUSTMENT_REASON_UNSPECIFIED": 0,
+		"ADJUSTMENT_REASON_RECEIVED":    1,
+		"ADJUSTMENT_REASON_SOLD":        2,
+		"ADJUSTMENT_REASON_RETURNED":    3,
+		"ADJUSTMENT_REASON_DAMAGED":     4,
+		"ADJUSTMENT_REASON_LOST":        5,
+		"ADJUSTMENT_REASON_CORRECTION":  6,
+	}
+)
+
+func (x AdjustmentReason) Enum() *AdjustmentReason {
+	p := new(AdjustmentReason)
+	*p = x
+	return p
+}
+
+func (x AdjustmentReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AdjustmentReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_stocks_proto_enumTypes[2].Descriptor()
+}
+
+func (AdjustmentReason) Type() protoreflect.EnumType {
+	return &file_stocks_proto_enumTypes[2]
+}
+
+func (x AdjustmentReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AdjustmentReason.Descriptor instead.
+func (AdjustmentReason) EnumDescriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{2}
+}
+
+type ValuationDimension int32
+
+const (
+	ValuationDimension_VALUATION_DIMENSION_UNSPECIFIED ValuationDimension = 0
+	ValuationDimension_VALUATION_DIMENSION_LOCATION    ValuationDimension = 1
+	ValuationDimension_VALUATION_DIMENSION_TYPE        ValuationDimension = 2
+	ValuationDimension_VALUATION_DIMENSION_OWNER       ValuationDimension = 3
+)
+
+// Enum value maps for ValuationDimension.
+var (
+	ValuationDimension_name = map[int32]string{
+		0: "VALUATION_DIMENSION_UNSPECIFIED",
+		1: "VALUATION_DIMENSION_LOCATION",
+		2: "VALUATION_DIMENSION_TYPE",
+		3: "VALUATION_DIMENSION_OWNER",
+	}
+	ValuationDimension_value = map[string]int32{
+		"VALUATION_DIMENSION_UNSPECIFIED": 0,
+		"VALUATION_DIMENSION_LOCATION":    1,
+		"VALUATION_DIMENSION_TYPE":        2,
+		"VALUATION_DIMENSION_OWNER":       3,
+	}
+)
+
+func (x ValuationDimension) Enum() *ValuationDimension {
+	p := new(ValuationDimension)
+	*p = x
+	return p
+}
+
+func (x ValuationDimension) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ValuationDimension) Descriptor() protoreflect.EnumDescriptor {
+	return file_stocks_proto_enumTypes[3].Descriptor()
+}
+
+func (ValuationDimension) Type() protoreflect.EnumType {
+	return &file_stocks_proto_enumTypes[3]
+}
+
+func (x ValuationDimension) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ValuationDimension.Descriptor instead.
+func (ValuationDimension) EnumDescriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{3}
+}
+
 type GeneralResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -74,31 +296,30 @@ func (x *GeneralResponse) GetMessage() string {
 	return ""
 }
 
-type CreateStockItemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	SkuId         uint32                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
-	Count         uint32                 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
-	Price         uint32                 `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
-	Location      string                 `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+// Money is amount in minor units of currency, e.g. 1999 RUB is 19.99 rubles.
+type Money struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ISO 4217 currency code.
+	Currency      string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount        int64  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateStockItemRequest) Reset() {
-	*x = CreateStockItemRequest{}
+func (x *Money) Reset() {
+	*x = Money{}
 	mi := &file_stocks_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateStockItemRequest) String() string {
+func (x *Money) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateStockItemRequest) ProtoMessage() {}
+func (*Money) ProtoMessage() {}
 
-func (x *CreateStockItemRequest) ProtoReflect() protoreflect.Message {
+func (x *Money) ProtoReflect() protoreflect.Message {
 	mi := &file_stocks_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -110,68 +331,58 @@ func (x *CreateStockItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateStockItemRequest.ProtoReflect.Descriptor instead.
-func (*CreateStockItemRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
 	return file_stocks_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *CreateStockItemRequest) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *CreateStockItemRequest) GetSkuId() uint32 {
-	if x != nil {
-		return x.SkuId
-	}
-	return 0
-}
-
-func (x *CreateStockItemRequest) GetCount() uint32 {
+func (x *Money) GetCurrency() string {
 	if x != nil {
-		return x.Count
+		return x.Currency
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateStockItemRequest) GetPrice() uint32 {
+func (x *Money) GetAmount() int64 {
 	if x != nil {
-		return x.Price
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *CreateStockItemRequest) GetLocation() string {
-	if x != nil {
-		return x.Location
-	}
-	return ""
-}
-
-type DeleteStockItemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	SkuId         uint32                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+type CreateStockItemRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuId  uint32                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	// count in minor units of sku unit of measure, grams for kg.
+	Count    int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Location string `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	// price of one unit of measure, e.g. of kg for weighed goods.
+	Price *Money `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	// lot received units belong to, empty for stock without lot tracking.
+	LotNumber string `protobuf:"bytes,7,opt,name=lot_number,json=lotNumber,proto3" json:"lot_number,omitempty"`
+	// expiry date of lot, absent for lots which do not expire.
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// received date of lot, absent means now.
+	ReceivedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteStockItemRequest) Reset() {
-	*x = DeleteStockItemRequest{}
+func (x *CreateStockItemRequest) Reset() {
+	*x = CreateStockItemRequest{}
 	mi := &file_stocks_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteStockItemRequest) String() string {
+func (x *CreateStockItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteStockItemRequest) ProtoMessage() {}
+func (*CreateStockItemRequest) ProtoMessage() {}
 
-func (x *DeleteStockItemRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateStockItemRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_stocks_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -183,46 +394,90 @@ func (x *DeleteStockItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteStockItemRequest.ProtoReflect.Descriptor instead.
-func (*DeleteStockItemRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateStockItemRequest.ProtoReflect.Descriptor instead.
+func (*CreateStockItemRequest) Descriptor() ([]byte, []int) {
 	return file_stocks_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *DeleteStockItemRequest) GetUserId() int64 {
+func (x *CreateStockItemRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *DeleteStockItemRequest) GetSkuId() uint32 {
+func (x *CreateStockItemRequest) GetSkuId() uint32 {
 	if x != nil {
 		return x.SkuId
 	}
 	return 0
 }
 
-type GetStockItemRequest struct {
+func (x *CreateStockItemRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CreateStockItemRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CreateStockItemRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *CreateStockItemRequest) GetLotNumber() string {
+	if x != nil {
+		return x.LotNumber
+	}
+	return ""
+}
+
+func (x *CreateStockItemRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateStockItemRequest) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+type RestoreStockItemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SkuId         uint32                 `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuId         uint32                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Location      string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStockItemRequest) Reset() {
-	*x = GetStockItemRequest{}
+func (x *RestoreStockItemRequest) Reset() {
+	*x = RestoreStockItemRequest{}
 	mi := &file_stocks_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStockItemRequest) String() string {
+func (x *RestoreStockItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStockItemRequest) ProtoMessage() {}
+func (*RestoreStockItemRequest) ProtoMessage() {}
 
-func (x *GetStockItemRequest) ProtoReflect() protoreflect.Message {
+func (x *RestoreStockItemRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_stocks_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -234,42 +489,57 @@ func (x *GetStockItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStockItemRequest.ProtoReflect.Descriptor instead.
-func (*GetStockItemRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreStockItemRequest.ProtoReflect.Descriptor instead.
+func (*RestoreStockItemRequest) Descriptor() ([]byte, []int) {
 	return file_stocks_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetStockItemRequest) GetSkuId() uint32 {
+func (x *RestoreStockItemRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RestoreStockItemRequest) GetSkuId() uint32 {
 	if x != nil {
 		return x.SkuId
 	}
 	return 0
 }
 
-type FilterRequest struct {
+func (x *RestoreStockItemRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+type StockItemUpdate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Location      string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
-	PageSize      int64                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	CurrentPage   int64                  `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	SkuId         uint32                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Count         int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Location      string                 `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	Price         *Money                 `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FilterRequest) Reset() {
-	*x = FilterRequest{}
+func (x *StockItemUpdate) Reset() {
+	*x = StockItemUpdate{}
 	mi := &file_stocks_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FilterRequest) String() string {
+func (x *StockItemUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilterRequest) ProtoMessage() {}
+func (*StockItemUpdate) ProtoMessage() {}
 
-func (x *FilterRequest) ProtoReflect() protoreflect.Message {
+func (x *StockItemUpdate) ProtoReflect() protoreflect.Message {
 	mi := &file_stocks_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -281,65 +551,71 @@ func (x *FilterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilterRequest.ProtoReflect.Descriptor instead.
-func (*FilterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StockItemUpdate.ProtoReflect.Descriptor instead.
+func (*StockItemUpdate) Descriptor() ([]byte, []int) {
 	return file_stocks_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *FilterRequest) GetUserId() int64 {
+func (x *StockItemUpdate) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *FilterRequest) GetLocation() string {
+func (x *StockItemUpdate) GetSkuId() uint32 {
 	if x != nil {
-		return x.Location
+		return x.SkuId
 	}
-	return ""
+	return 0
 }
 
-func (x *FilterRequest) GetPageSize() int64 {
+func (x *StockItemUpdate) GetCount() int64 {
 	if x != nil {
-		return x.PageSize
+		return x.Count
 	}
 	return 0
 }
 
-func (x *FilterRequest) GetCurrentPage() int64 {
+func (x *StockItemUpdate) GetLocation() string {
 	if x != nil {
-		return x.CurrentPage
+		return x.Location
 	}
-	return 0
+	return ""
 }
 
-type StockItemResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SkuId         uint32                 `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	Count         uint32                 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
-	Price         uint32                 `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
-	Location      string                 `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *StockItemUpdate) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
 }
 
-func (x *StockItemResponse) Reset() {
-	*x = StockItemResponse{}
+type UpdateStockItemRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Item  *StockItemUpdate       `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	// paths of item to write: count, price, location. gateway fills it from PATCH body when omitted.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// location of stock item to update, item.location is the new one.
+	CurrentLocation string `protobuf:"bytes,3,opt,name=current_location,json=currentLocation,proto3" json:"current_location,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateStockItemRequest) Reset() {
+	*x = UpdateStockItemRequest{}
 	mi := &file_stocks_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StockItemResponse) String() string {
+func (x *UpdateStockItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StockItemResponse) ProtoMessage() {}
+func (*UpdateStockItemRequest) ProtoMessage() {}
 
-func (x *StockItemResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateStockItemRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_stocks_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -351,77 +627,5151 @@ func (x *StockItemResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StockItemResponse.ProtoReflect.Descriptor instead.
-func (*StockItemResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateStockItemRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStockItemRequest) Descriptor() ([]byte, []int) {
 	return file_stocks_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *StockItemResponse) GetSkuId() uint32 {
+func (x *UpdateStockItemRequest) GetItem() *StockItemUpdate {
 	if x != nil {
-		return x.SkuId
+		return x.Item
 	}
-	return 0
+	return nil
 }
 
-func (x *StockItemResponse) GetName() string {
+func (x *UpdateStockItemRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
 	if x != nil {
-		return x.Name
+		return x.UpdateMask
 	}
-	return ""
+	return nil
+}
+
+func (x *UpdateStockItemRequest) GetCurrentLocation() string {
+	if x != nil {
+		return x.CurrentLocation
+	}
+	return ""
+}
+
+type DeleteStockItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuId         uint32                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteStockItemRequest) Reset() {
+	*x = DeleteStockItemRequest{}
+	mi := &file_stocks_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteStockItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStockItemRequest) ProtoMessage() {}
+
+func (x *DeleteStockItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStockItemRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStockItemRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteStockItemRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteStockItemRequest) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+type GetStockItemRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	SkuId uint32                 `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	// rule the best offer is chosen by among sellers of sku.
+	OfferRule OfferRule `protobuf:"varint,2,opt,name=offer_rule,json=offerRule,proto3,enum=stocks.OfferRule" json:"offer_rule,omitempty"`
+	// limits offers to one seller, zero means every seller.
+	SellerId int64 `protobuf:"varint,3,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	// fills offers with every offer ordered by rule, best first.
+	AllOffers bool `protobuf:"varint,4,opt,name=all_offers,json=allOffers,proto3" json:"all_offers,omitempty"`
+	// quantity ordered in minor units of sku unit of measure, offers are priced by tiers it reaches. zero is one unit.
+	Quantity int64 `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// customer group whose price tiers apply besides tiers for every customer.
+	CustomerGroup string `protobuf:"bytes,6,opt,name=customer_group,json=customerGroup,proto3" json:"customer_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockItemRequest) Reset() {
+	*x = GetStockItemRequest{}
+	mi := &file_stocks_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockItemRequest) ProtoMessage() {}
+
+func (x *GetStockItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockItemRequest.ProtoReflect.Descriptor instead.
+func (*GetStockItemRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetStockItemRequest) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *GetStockItemRequest) GetOfferRule() OfferRule {
+	if x != nil {
+		return x.OfferRule
+	}
+	return OfferRule_OFFER_RULE_UNSPECIFIED
+}
+
+func (x *GetStockItemRequest) GetSellerId() int64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+func (x *GetStockItemRequest) GetAllOffers() bool {
+	if x != nil {
+		return x.AllOffers
+	}
+	return false
+}
+
+func (x *GetStockItemRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *GetStockItemRequest) GetCustomerGroup() string {
+	if x != nil {
+		return x.CustomerGroup
+	}
+	return ""
+}
+
+type GetStockItemsBySKUsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// skus without offers are left out of response.
+	Items         []*GetStockItemRequest `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockItemsBySKUsRequest) Reset() {
+	*x = GetStockItemsBySKUsRequest{}
+	mi := &file_stocks_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockItemsBySKUsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockItemsBySKUsRequest) ProtoMessage() {}
+
+func (x *GetStockItemsBySKUsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockItemsBySKUsRequest.ProtoReflect.Descriptor instead.
+func (*GetStockItemsBySKUsRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetStockItemsBySKUsRequest) GetItems() []*GetStockItemRequest {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetStockItemsBySKUsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*StockItemResponse   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockItemsBySKUsResponse) Reset() {
+	*x = GetStockItemsBySKUsResponse{}
+	mi := &file_stocks_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockItemsBySKUsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockItemsBySKUsResponse) ProtoMessage() {}
+
+func (x *GetStockItemsBySKUsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockItemsBySKUsResponse.ProtoReflect.Descriptor instead.
+func (*GetStockItemsBySKUsResponse) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetStockItemsBySKUsResponse) GetItems() []*StockItemResponse {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type WatchStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SkuIds        []uint32               `protobuf:"varint,1,rep,packed,name=sku_ids,json=skuIds,proto3" json:"sku_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchStockRequest) Reset() {
+	*x = WatchStockRequest{}
+	mi := &file_stocks_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchStockRequest) ProtoMessage() {}
+
+func (x *WatchStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchStockRequest.ProtoReflect.Descriptor instead.
+func (*WatchStockRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WatchStockRequest) GetSkuIds() []uint32 {
+	if x != nil {
+		return x.SkuIds
+	}
+	return nil
+}
+
+type StockChangeEvent struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuId    uint32                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Location string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	Count    int64                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// stock item was deleted or moved away from location.
+	Deleted bool `protobuf:"varint,6,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// current state sent right after subscribing.
+	Snapshot      bool                   `protobuf:"varint,7,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	Price         *Money                 `protobuf:"bytes,9,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockChangeEvent) Reset() {
+	*x = StockChangeEvent{}
+	mi := &file_stocks_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockChangeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockChangeEvent) ProtoMessage() {}
+
+func (x *StockChangeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockChangeEvent.ProtoReflect.Descriptor instead.
+func (*StockChangeEvent) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StockChangeEvent) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *StockChangeEvent) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *StockChangeEvent) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *StockChangeEvent) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StockChangeEvent) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *StockChangeEvent) GetSnapshot() bool {
+	if x != nil {
+		return x.Snapshot
+	}
+	return false
+}
+
+func (x *StockChangeEvent) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *StockChangeEvent) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type FilterRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	UserId      int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Location    string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	PageSize    int64                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	CurrentPage int64                  `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	// attribute values stock item skus must have, compared as text.
+	Attributes    map[string]string `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FilterRequest) Reset() {
+	*x = FilterRequest{}
+	mi := &file_stocks_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilterRequest) ProtoMessage() {}
+
+func (x *FilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilterRequest.ProtoReflect.Descriptor instead.
+func (*FilterRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FilterRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *FilterRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *FilterRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *FilterRequest) GetCurrentPage() int64 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *FilterRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type StockItemResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	SkuId uint32                 `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type  string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// count in minor units of unit, negative when stock is backordered.
+	Count    int64  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Location string `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	// seller owning the stock item.
+	SellerId int64 `protobuf:"varint,7,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	// every offer of sku, set by GetStockItemBySKU when all offers are requested.
+	Offers []*StockItemResponse `protobuf:"bytes,8,rep,name=offers,proto3" json:"offers,omitempty"`
+	// sku is a bundle, count is the number of complete bundles components of the seller make up.
+	Bundle bool `protobuf:"varint,9,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	// variant group of sku, zero when sku is not a variant.
+	VariantGroupId int64            `protobuf:"varint,10,opt,name=variant_group_id,json=variantGroupId,proto3" json:"variant_group_id,omitempty"`
+	Attributes     *structpb.Struct `protobuf:"bytes,11,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// unit of measure of sku: each, kg or pack.
+	Unit string `protobuf:"bytes,12,opt,name=unit,proto3" json:"unit,omitempty"`
+	// count formatted as decimal quantity of unit, e.g. "1.250" for 1250 grams.
+	Quantity string `protobuf:"bytes,13,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// price of one unit of measure in currency of the offer.
+	Price *Money `protobuf:"bytes,14,opt,name=price,proto3" json:"price,omitempty"`
+	// set by GetStockItemBySKU.
+	Backorder *BackorderPolicy `protobuf:"bytes,15,opt,name=backorder,proto3" json:"backorder,omitempty"`
+	// how much can be ordered including backorders, set by GetStockItemBySKU.
+	AvailableToOrder int64 `protobuf:"varint,16,opt,name=available_to_order,json=availableToOrder,proto3" json:"available_to_order,omitempty"`
+	// quantity ordered from suppliers and not received yet, set by GetStockItemBySKU.
+	IncomingQuantity int64 `protobuf:"varint,17,opt,name=incoming_quantity,json=incomingQuantity,proto3" json:"incoming_quantity,omitempty"`
+	// the earliest expected delivery of incoming quantity.
+	IncomingExpectedAt *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=incoming_expected_at,json=incomingExpectedAt,proto3" json:"incoming_expected_at,omitempty"`
+	// price before price tiers, set by GetStockItemBySKU. price is the one requested quantity gets.
+	ListPrice *Money `protobuf:"bytes,19,opt,name=list_price,json=listPrice,proto3" json:"list_price,omitempty"`
+	// price tier price comes from, unset when list price applies.
+	PriceTier     *PriceTier `protobuf:"bytes,20,opt,name=price_tier,json=priceTier,proto3" json:"price_tier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockItemResponse) Reset() {
+	*x = StockItemResponse{}
+	mi := &file_stocks_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockItemResponse) ProtoMessage() {}
+
+func (x *StockItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockItemResponse.ProtoReflect.Descriptor instead.
+func (*StockItemResponse) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StockItemResponse) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *StockItemResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
 func (x *StockItemResponse) GetType() string {
 	if x != nil {
-		return x.Type
+		return x.Type
+	}
+	return ""
+}
+
+func (x *StockItemResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StockItemResponse) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *StockItemResponse) GetSellerId() int64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+func (x *StockItemResponse) GetOffers() []*StockItemResponse {
+	if x != nil {
+		return x.Offers
+	}
+	return nil
+}
+
+func (x *StockItemResponse) GetBundle() bool {
+	if x != nil {
+		return x.Bundle
+	}
+	return false
+}
+
+func (x *StockItemResponse) GetVariantGroupId() int64 {
+	if x != nil {
+		return x.VariantGroupId
+	}
+	return 0
+}
+
+func (x *StockItemResponse) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *StockItemResponse) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *StockItemResponse) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+func (x *StockItemResponse) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *StockItemResponse) GetBackorder() *BackorderPolicy {
+	if x != nil {
+		return x.Backorder
+	}
+	return nil
+}
+
+func (x *StockItemResponse) GetAvailableToOrder() int64 {
+	if x != nil {
+		return x.AvailableToOrder
+	}
+	return 0
+}
+
+func (x *StockItemResponse) GetIncomingQuantity() int64 {
+	if x != nil {
+		return x.IncomingQuantity
+	}
+	return 0
+}
+
+func (x *StockItemResponse) GetIncomingExpectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IncomingExpectedAt
+	}
+	return nil
+}
+
+func (x *StockItemResponse) GetListPrice() *Money {
+	if x != nil {
+		return x.ListPrice
+	}
+	return nil
+}
+
+func (x *StockItemResponse) GetPriceTier() *PriceTier {
+	if x != nil {
+		return x.PriceTier
+	}
+	return nil
+}
+
+type ListStockItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*StockItemResponse   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    uint32                 `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	PageNumber    int64                  `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockItemsResponse) Reset() {
+	*x = ListStockItemsResponse{}
+	mi := &file_stocks_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockItemsResponse) ProtoMessage() {}
+
+func (x *ListStockItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListStockItemsResponse) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListStockItemsResponse) GetItems() []*StockItemResponse {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListStockItemsResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListStockItemsResponse) GetPageNumber() int64 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+type SearchSKUsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Query       string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Types       []string               `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+	PageSize    int64                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	CurrentPage int64                  `protobuf:"varint,4,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	// attribute values matched skus must have, compared as text.
+	Attributes    map[string]string `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSKUsRequest) Reset() {
+	*x = SearchSKUsRequest{}
+	mi := &file_stocks_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSKUsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSKUsRequest) ProtoMessage() {}
+
+func (x *SearchSKUsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSKUsRequest.ProtoReflect.Descriptor instead.
+func (*SearchSKUsRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SearchSKUsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchSKUsRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *SearchSKUsRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchSKUsRequest) GetCurrentPage() int64 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *SearchSKUsRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type SKUSearchResult struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SkuId          uint32                 `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type           string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Rank           float64                `protobuf:"fixed64,4,opt,name=rank,proto3" json:"rank,omitempty"`
+	Highlight      string                 `protobuf:"bytes,5,opt,name=highlight,proto3" json:"highlight,omitempty"`
+	AvailableCount int64                  `protobuf:"varint,6,opt,name=available_count,json=availableCount,proto3" json:"available_count,omitempty"`
+	VariantGroupId int64                  `protobuf:"varint,7,opt,name=variant_group_id,json=variantGroupId,proto3" json:"variant_group_id,omitempty"`
+	Attributes     *structpb.Struct       `protobuf:"bytes,8,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SKUSearchResult) Reset() {
+	*x = SKUSearchResult{}
+	mi := &file_stocks_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SKUSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SKUSearchResult) ProtoMessage() {}
+
+func (x *SKUSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SKUSearchResult.ProtoReflect.Descriptor instead.
+func (*SKUSearchResult) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SKUSearchResult) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *SKUSearchResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SKUSearchResult) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SKUSearchResult) GetRank() float64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *SKUSearchResult) GetHighlight() string {
+	if x != nil {
+		return x.Highlight
+	}
+	return ""
+}
+
+func (x *SKUSearchResult) GetAvailableCount() int64 {
+	if x != nil {
+		return x.AvailableCount
+	}
+	return 0
+}
+
+func (x *SKUSearchResult) GetVariantGroupId() int64 {
+	if x != nil {
+		return x.VariantGroupId
+	}
+	return 0
+}
+
+func (x *SKUSearchResult) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type TypeFacet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Count         uint32                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TypeFacet) Reset() {
+	*x = TypeFacet{}
+	mi := &file_stocks_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TypeFacet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypeFacet) ProtoMessage() {}
+
+func (x *TypeFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypeFacet.ProtoReflect.Descriptor instead.
+func (*TypeFacet) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TypeFacet) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TypeFacet) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SearchSKUsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*SKUSearchResult     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Facets        []*TypeFacet           `protobuf:"bytes,2,rep,name=facets,proto3" json:"facets,omitempty"`
+	TotalCount    uint32                 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	PageNumber    int64                  `protobuf:"varint,4,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchSKUsResponse) Reset() {
+	*x = SearchSKUsResponse{}
+	mi := &file_stocks_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchSKUsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSKUsResponse) ProtoMessage() {}
+
+func (x *SearchSKUsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSKUsResponse.ProtoReflect.Descriptor instead.
+func (*SearchSKUsResponse) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SearchSKUsResponse) GetItems() []*SKUSearchResult {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SearchSKUsResponse) GetFacets() []*TypeFacet {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+func (x *SearchSKUsResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *SearchSKUsResponse) GetPageNumber() int64 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+type SetStockThresholdRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	SkuId uint32                 `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	// empty location sets threshold for all locations of the sku.
+	Location         string `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	ReorderThreshold uint32 `protobuf:"varint,3,opt,name=reorder_threshold,json=reorderThreshold,proto3" json:"reorder_threshold,omitempty"`
+	Hysteresis       uint32 `protobuf:"varint,4,opt,name=hysteresis,proto3" json:"hysteresis,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetStockThresholdRequest) Reset() {
+	*x = SetStockThresholdRequest{}
+	mi := &file_stocks_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetStockThresholdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStockThresholdRequest) ProtoMessage() {}
+
+func (x *SetStockThresholdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStockThresholdRequest.ProtoReflect.Descriptor instead.
+func (*SetStockThresholdRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetStockThresholdRequest) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *SetStockThresholdRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *SetStockThresholdRequest) GetReorderThreshold() uint32 {
+	if x != nil {
+		return x.ReorderThreshold
+	}
+	return 0
+}
+
+func (x *SetStockThresholdRequest) GetHysteresis() uint32 {
+	if x != nil {
+		return x.Hysteresis
+	}
+	return 0
+}
+
+type BundleComponent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	SkuId uint32                 `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	// quantity of component in one bundle.
+	Quantity      uint32 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BundleComponent) Reset() {
+	*x = BundleComponent{}
+	mi := &file_stocks_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BundleComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleComponent) ProtoMessage() {}
+
+func (x *BundleComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleComponent.ProtoReflect.Descriptor instead.
+func (*BundleComponent) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BundleComponent) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *BundleComponent) GetQuantity() uint32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type SetBundleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BundleSkuId   uint32                 `protobuf:"varint,1,opt,name=bundle_sku_id,json=bundleSkuId,proto3" json:"bundle_sku_id,omitempty"`
+	Components    []*BundleComponent     `protobuf:"bytes,2,rep,name=components,proto3" json:"components,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBundleRequest) Reset() {
+	*x = SetBundleRequest{}
+	mi := &file_stocks_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBundleRequest) ProtoMessage() {}
+
+func (x *SetBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBundleRequest.ProtoReflect.Descriptor instead.
+func (*SetBundleRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetBundleRequest) GetBundleSkuId() uint32 {
+	if x != nil {
+		return x.BundleSkuId
+	}
+	return 0
+}
+
+func (x *SetBundleRequest) GetComponents() []*BundleComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+type GetBundleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BundleSkuId   uint32                 `protobuf:"varint,1,opt,name=bundle_sku_id,json=bundleSkuId,proto3" json:"bundle_sku_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBundleRequest) Reset() {
+	*x = GetBundleRequest{}
+	mi := &file_stocks_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBundleRequest) ProtoMessage() {}
+
+func (x *GetBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBundleRequest.ProtoReflect.Descriptor instead.
+func (*GetBundleRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetBundleRequest) GetBundleSkuId() uint32 {
+	if x != nil {
+		return x.BundleSkuId
+	}
+	return 0
+}
+
+type BundleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BundleSkuId   uint32                 `protobuf:"varint,1,opt,name=bundle_sku_id,json=bundleSkuId,proto3" json:"bundle_sku_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Components    []*BundleComponent     `protobuf:"bytes,4,rep,name=components,proto3" json:"components,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BundleResponse) Reset() {
+	*x = BundleResponse{}
+	mi := &file_stocks_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleResponse) ProtoMessage() {}
+
+func (x *BundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleResponse.ProtoReflect.Descriptor instead.
+func (*BundleResponse) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BundleResponse) GetBundleSkuId() uint32 {
+	if x != nil {
+		return x.BundleSkuId
+	}
+	return 0
+}
+
+func (x *BundleResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BundleResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BundleResponse) GetComponents() []*BundleComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+type AttributeDefinition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          AttributeType          `protobuf:"varint,2,opt,name=type,proto3,enum=stocks.AttributeType" json:"type,omitempty"`
+	Required      bool                   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	AllowedValues []string               `protobuf:"bytes,4,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeDefinition) Reset() {
+	*x = AttributeDefinition{}
+	mi := &file_stocks_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeDefinition) ProtoMessage() {}
+
+func (x *AttributeDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeDefinition.ProtoReflect.Descriptor instead.
+func (*AttributeDefinition) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AttributeDefinition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AttributeDefinition) GetType() AttributeType {
+	if x != nil {
+		return x.Type
+	}
+	return AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED
+}
+
+func (x *AttributeDefinition) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *AttributeDefinition) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+type SetAttributeSchemaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sku type schema applies to.
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Attributes    []*AttributeDefinition `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAttributeSchemaRequest) Reset() {
+	*x = SetAttributeSchemaRequest{}
+	mi := &file_stocks_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAttributeSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAttributeSchemaRequest) ProtoMessage() {}
+
+func (x *SetAttributeSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAttributeSchemaRequest.ProtoReflect.Descriptor instead.
+func (*SetAttributeSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetAttributeSchemaRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SetAttributeSchemaRequest) GetAttributes() []*AttributeDefinition {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type CreateVariantGroupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type  string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// attributes variants of group differ by, like size and color.
+	Axes          []string `protobuf:"bytes,3,rep,name=axes,proto3" json:"axes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVariantGroupRequest) Reset() {
+	*x = CreateVariantGroupRequest{}
+	mi := &file_stocks_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVariantGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVariantGroupRequest) ProtoMessage() {}
+
+func (x *CreateVariantGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVariantGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateVariantGroupRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateVariantGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateVariantGroupRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateVariantGroupRequest) GetAxes() []string {
+	if x != nil {
+		return x.Axes
+	}
+	return nil
+}
+
+type GetVariantGroupRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	VariantGroupId int64                  `protobuf:"varint,1,opt,name=variant_group_id,json=variantGroupId,proto3" json:"variant_group_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetVariantGroupRequest) Reset() {
+	*x = GetVariantGroupRequest{}
+	mi := &file_stocks_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVariantGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVariantGroupRequest) ProtoMessage() {}
+
+func (x *GetVariantGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVariantGroupRequest.ProtoReflect.Descriptor instead.
+func (*GetVariantGroupRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetVariantGroupRequest) GetVariantGroupId() int64 {
+	if x != nil {
+		return x.VariantGroupId
+	}
+	return 0
+}
+
+type SKUResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SkuId          uint32                 `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type           string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	VariantGroupId int64                  `protobuf:"varint,4,opt,name=variant_group_id,json=variantGroupId,proto3" json:"variant_group_id,omitempty"`
+	Attributes     *structpb.Struct       `protobuf:"bytes,5,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	Unit           string                 `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SKUResponse) Reset() {
+	*x = SKUResponse{}
+	mi := &file_stocks_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SKUResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SKUResponse) ProtoMessage() {}
+
+func (x *SKUResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SKUResponse.ProtoReflect.Descriptor instead.
+func (*SKUResponse) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SKUResponse) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *SKUResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SKUResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SKUResponse) GetVariantGroupId() int64 {
+	if x != nil {
+		return x.VariantGroupId
+	}
+	return 0
+}
+
+func (x *SKUResponse) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *SKUResponse) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type VariantGroupResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	VariantGroupId int64                  `protobuf:"varint,1,opt,name=variant_group_id,json=variantGroupId,proto3" json:"variant_group_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type           string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Axes           []string               `protobuf:"bytes,4,rep,name=axes,proto3" json:"axes,omitempty"`
+	Variants       []*SKUResponse         `protobuf:"bytes,5,rep,name=variants,proto3" json:"variants,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VariantGroupResponse) Reset() {
+	*x = VariantGroupResponse{}
+	mi := &file_stocks_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariantGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariantGroupResponse) ProtoMessage() {}
+
+func (x *VariantGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariantGroupResponse.ProtoReflect.Descriptor instead.
+func (*VariantGroupResponse) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *VariantGroupResponse) GetVariantGroupId() int64 {
+	if x != nil {
+		return x.VariantGroupId
+	}
+	return 0
+}
+
+func (x *VariantGroupResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VariantGroupResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *VariantGroupResponse) GetAxes() []string {
+	if x != nil {
+		return x.Axes
+	}
+	return nil
+}
+
+func (x *VariantGroupResponse) GetVariants() []*SKUResponse {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+type UpdateSKUAttributesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	SkuId uint32                 `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	// zero detaches sku from its variant group.
+	VariantGroupId int64            `protobuf:"varint,2,opt,name=variant_group_id,json=variantGroupId,proto3" json:"variant_group_id,omitempty"`
+	Attributes     *structpb.Struct `protobuf:"bytes,3,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	// unit of measure: each, kg or pack. empty keeps current one, it can not change while sku has stock items.
+	Unit          string `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSKUAttributesRequest) Reset() {
+	*x = UpdateSKUAttributesRequest{}
+	mi := &file_stocks_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSKUAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSKUAttributesRequest) ProtoMessage() {}
+
+func (x *UpdateSKUAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSKUAttributesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSKUAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateSKUAttributesRequest) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *UpdateSKUAttributesRequest) GetVariantGroupId() int64 {
+	if x != nil {
+		return x.VariantGroupId
+	}
+	return 0
+}
+
+func (x *UpdateSKUAttributesRequest) GetAttributes() *structpb.Struct {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *UpdateSKUAttributesRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type ListLowStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Location      string                 `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	PageSize      int64                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	CurrentPage   int64                  `protobuf:"varint,3,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLowStockRequest) Reset() {
+	*x = ListLowStockRequest{}
+	mi := &file_stocks_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLowStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLowStockRequest) ProtoMessage() {}
+
+func (x *ListLowStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLowStockRequest.ProtoReflect.Descriptor instead.
+func (*ListLowStockRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListLowStockRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ListLowStockRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListLowStockRequest) GetCurrentPage() int64 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+type LowStockItemResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Item             *StockItemResponse     `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	UserId           int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StockLevel       string                 `protobuf:"bytes,3,opt,name=stock_level,json=stockLevel,proto3" json:"stock_level,omitempty"`
+	ReorderThreshold uint32                 `protobuf:"varint,4,opt,name=reorder_threshold,json=reorderThreshold,proto3" json:"reorder_threshold,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LowStockItemResponse) Reset() {
+	*x = LowStockItemResponse{}
+	mi := &file_stocks_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LowStockItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LowStockItemResponse) ProtoMessage() {}
+
+func (x *LowStockItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LowStockItemResponse.ProtoReflect.Descriptor instead.
+func (*LowStockItemResponse) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *LowStockItemResponse) GetItem() *StockItemResponse {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *LowStockItemResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LowStockItemResponse) GetStockLevel() string {
+	if x != nil {
+		return x.StockLevel
+	}
+	return ""
+}
+
+func (x *LowStockItemResponse) GetReorderThreshold() uint32 {
+	if x != nil {
+		return x.ReorderThreshold
+	}
+	return 0
+}
+
+type ListLowStockResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Items         []*LowStockItemResponse `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalCount    uint32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	PageNumber    int64                   `protobuf:"varint,3,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLowStockResponse) Reset() {
+	*x = ListLowStockResponse{}
+	mi := &file_stocks_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLowStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLowStockResponse) ProtoMessage() {}
+
+func (x *ListLowStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLowStockResponse.ProtoReflect.Descriptor instead.
+func (*ListLowStockResponse) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListLowStockResponse) GetItems() []*LowStockItemResponse {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListLowStockResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListLowStockResponse) GetPageNumber() int64 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+type AdjustStockRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuId    uint32                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Location string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	// signed change of quantity, negative values decrease stock.
+	Delta         int64            `protobuf:"varint,4,opt,name=delta,proto3" json:"delta,omitempty"`
+	Reason        AdjustmentReason `protobuf:"varint,5,opt,name=reason,proto3,enum=stocks.AdjustmentReason" json:"reason,omitempty"`
+	Note          string           `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustStockRequest) Reset() {
+	*x = AdjustStockRequest{}
+	mi := &file_stocks_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockRequest) ProtoMessage() {}
+
+func (x *AdjustStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustStockRequest.ProtoReflect.Descriptor instead.
+func (*AdjustStockRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AdjustStockRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdjustStockRequest) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *AdjustStockRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *AdjustStockRequest) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *AdjustStockRequest) GetReason() AdjustmentReason {
+	if x != nil {
+		return x.Reason
+	}
+	return AdjustmentReason_ADJUSTMENT_REASON_UNSPECIFIED
+}
+
+func (x *AdjustStockRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type AdjustStockResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SkuId    uint32                 `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Location string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	// quantity after adjustment, negative when stock is backordered.
+	Quantity int64 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// lots units were taken from, first expired first out. empty for increases, bundles and stock without lots.
+	Lots          []*LotAllocation `protobuf:"bytes,4,rep,name=lots,proto3" json:"lots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustStockResponse) Reset() {
+	*x = AdjustStockResponse{}
+	mi := &file_stocks_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockResponse) ProtoMessage() {}
+
+func (x *AdjustStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustStockResponse.ProtoReflect.Descriptor instead.
+func (*AdjustStockResponse) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AdjustStockResponse) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *AdjustStockResponse) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *AdjustStockResponse) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *AdjustStockResponse) GetLots() []*LotAllocation {
+	if x != nil {
+		return x.Lots
+	}
+	return nil
+}
+
+type LotAllocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LotId         int64                  `protobuf:"varint,1,opt,name=lot_id,json=lotId,proto3" json:"lot_id,omitempty"`
+	LotNumber     string                 `protobuf:"bytes,2,opt,name=lot_number,json=lotNumber,proto3" json:"lot_number,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Quantity      int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LotAllocation) Reset() {
+	*x = LotAllocation{}
+	mi := &file_stocks_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LotAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LotAllocation) ProtoMessage() {}
+
+func (x *LotAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LotAllocation.ProtoReflect.Descriptor instead.
+func (*LotAllocation) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *LotAllocation) GetLotId() int64 {
+	if x != nil {
+		return x.LotId
+	}
+	return 0
+}
+
+func (x *LotAllocation) GetLotNumber() string {
+	if x != nil {
+		return x.LotNumber
+	}
+	return ""
+}
+
+func (x *LotAllocation) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *LotAllocation) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type ListExpiringLotsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// lots expiring within that many days from now, expired lots not swept yet are included.
+	WithinDays uint32 `protobuf:"varint,1,opt,name=within_days,json=withinDays,proto3" json:"within_days,omitempty"`
+	// optional filters, zero values match all sellers and locations.
+	UserId        int64  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Location      string `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	PageSize      int64  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	CurrentPage   int64  `protobuf:"varint,5,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpiringLotsRequest) Reset() {
+	*x = ListExpiringLotsRequest{}
+	mi := &file_stocks_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpiringLotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpiringLotsRequest) ProtoMessage() {}
+
+func (x *ListExpiringLotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpiringLotsRequest.ProtoReflect.Descriptor instead.
+func (*ListExpiringLotsRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListExpiringLotsRequest) GetWithinDays() uint32 {
+	if x != nil {
+		return x.WithinDays
+	}
+	return 0
+}
+
+func (x *ListExpiringLotsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListExpiringLotsRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ListExpiringLotsRequest) GetPageSize() int64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListExpiringLotsRequest) GetCurrentPage() int64 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+type StockLotResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LotId      int64                  `protobuf:"varint,1,opt,name=lot_id,json=lotId,proto3" json:"lot_id,omitempty"`
+	UserId     int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuId      uint32                 `protobuf:"varint,3,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Name       string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Location   string                 `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	LotNumber  string                 `protobuf:"bytes,6,opt,name=lot_number,json=lotNumber,proto3" json:"lot_number,omitempty"`
+	ReceivedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	ExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// quantity left in lot in minor units of sku unit of measure.
+	Quantity      int64 `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockLotResponse) Reset() {
+	*x = StockLotResponse{}
+	mi := &file_stocks_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockLotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockLotResponse) ProtoMessage() {}
+
+func (x *StockLotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockLotResponse.ProtoReflect.Descriptor instead.
+func (*StockLotResponse) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *StockLotResponse) GetLotId() int64 {
+	if x != nil {
+		return x.LotId
+	}
+	return 0
+}
+
+func (x *StockLotResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *StockLotResponse) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *StockLotResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StockLotResponse) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *StockLotResponse) GetLotNumber() string {
+	if x != nil {
+		return x.LotNumber
+	}
+	return ""
+}
+
+func (x *StockLotResponse) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+func (x *StockLotResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *StockLotResponse) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type ListExpiringLotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lots          []*StockLotResponse    `protobuf:"bytes,1,rep,name=lots,proto3" json:"lots,omitempty"`
+	TotalCount    uint32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	PageNumber    int64                  `protobuf:"varint,3,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpiringLotsResponse) Reset() {
+	*x = ListExpiringLotsResponse{}
+	mi := &file_stocks_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpiringLotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpiringLotsResponse) ProtoMessage() {}
+
+func (x *ListExpiringLotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpiringLotsResponse.ProtoReflect.Descriptor instead.
+func (*ListExpiringLotsResponse) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListExpiringLotsResponse) GetLots() []*StockLotResponse {
+	if x != nil {
+		return x.Lots
+	}
+	return nil
+}
+
+func (x *ListExpiringLotsResponse) GetTotalCount() uint32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListExpiringLotsResponse) GetPageNumber() int64 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+// BackorderPolicy tells whether stock item may be sold beyond its count.
+type BackorderPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// none, backorder (sold beyond stock, shipped when restocked) or preorder (not released yet).
+	Mode string `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	// the most count may go below zero, zero is unlimited.
+	MaxQuantity int64 `protobuf:"varint,2,opt,name=max_quantity,json=maxQuantity,proto3" json:"max_quantity,omitempty"`
+	// expected time stock arrives, required for preorder.
+	RestockAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=restock_at,json=restockAt,proto3" json:"restock_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackorderPolicy) Reset() {
+	*x = BackorderPolicy{}
+	mi := &file_stocks_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackorderPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackorderPolicy) ProtoMessage() {}
+
+func (x *BackorderPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackorderPolicy.ProtoReflect.Descriptor instead.
+func (*BackorderPolicy) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BackorderPolicy) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *BackorderPolicy) GetMaxQuantity() int64 {
+	if x != nil {
+		return x.MaxQuantity
+	}
+	return 0
+}
+
+func (x *BackorderPolicy) GetRestockAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RestockAt
+	}
+	return nil
+}
+
+type BackorderSettingsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuId    uint32                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Location string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	// deprecated: same as backorder mode without limit, used when policy is not set.
+	BackordersEnabled bool             `protobuf:"varint,4,opt,name=backorders_enabled,json=backordersEnabled,proto3" json:"backorders_enabled,omitempty"`
+	Policy            *BackorderPolicy `protobuf:"bytes,5,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BackorderSettingsRequest) Reset() {
+	*x = BackorderSettingsRequest{}
+	mi := &file_stocks_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackorderSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackorderSettingsRequest) ProtoMessage() {}
+
+func (x *BackorderSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackorderSettingsRequest.ProtoReflect.Descriptor instead.
+func (*BackorderSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *BackorderSettingsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BackorderSettingsRequest) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *BackorderSettingsRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *BackorderSettingsRequest) GetBackordersEnabled() bool {
+	if x != nil {
+		return x.BackordersEnabled
+	}
+	return false
+}
+
+func (x *BackorderSettingsRequest) GetPolicy() *BackorderPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type TransferStockRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	UserId       int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuId        uint32                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	FromLocation string                 `protobuf:"bytes,3,opt,name=from_location,json=fromLocation,proto3" json:"from_location,omitempty"`
+	ToLocation   string                 `protobuf:"bytes,4,opt,name=to_location,json=toLocation,proto3" json:"to_location,omitempty"`
+	Quantity     int64                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// puts units to destination right away instead of leaving transfer in transit.
+	ReceiveImmediately bool `protobuf:"varint,6,opt,name=receive_immediately,json=receiveImmediately,proto3" json:"receive_immediately,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TransferStockRequest) Reset() {
+	*x = TransferStockRequest{}
+	mi := &file_stocks_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferStockRequest) ProtoMessage() {}
+
+func (x *TransferStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferStockRequest.ProtoReflect.Descriptor instead.
+func (*TransferStockRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *TransferStockRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TransferStockRequest) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *TransferStockRequest) GetFromLocation() string {
+	if x != nil {
+		return x.FromLocation
+	}
+	return ""
+}
+
+func (x *TransferStockRequest) GetToLocation() string {
+	if x != nil {
+		return x.ToLocation
+	}
+	return ""
+}
+
+func (x *TransferStockRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *TransferStockRequest) GetReceiveImmediately() bool {
+	if x != nil {
+		return x.ReceiveImmediately
+	}
+	return false
+}
+
+type ReceiveStockTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TransferId    int64                  `protobuf:"varint,2,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveStockTransferRequest) Reset() {
+	*x = ReceiveStockTransferRequest{}
+	mi := &file_stocks_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveStockTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveStockTransferRequest) ProtoMessage() {}
+
+func (x *ReceiveStockTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveStockTransferRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveStockTransferRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ReceiveStockTransferRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReceiveStockTransferRequest) GetTransferId() int64 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+type StockTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferId    int64                  `protobuf:"varint,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	SkuId         uint32                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	FromLocation  string                 `protobuf:"bytes,3,opt,name=from_location,json=fromLocation,proto3" json:"from_location,omitempty"`
+	ToLocation    string                 `protobuf:"bytes,4,opt,name=to_location,json=toLocation,proto3" json:"to_location,omitempty"`
+	Quantity      int64                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	ShippedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=shipped_at,json=shippedAt,proto3" json:"shipped_at,omitempty"`
+	ReceivedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockTransferResponse) Reset() {
+	*x = StockTransferResponse{}
+	mi := &file_stocks_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockTransferResponse) ProtoMessage() {}
+
+func (x *StockTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockTransferResponse.ProtoReflect.Descriptor instead.
+func (*StockTransferResponse) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *StockTransferResponse) GetTransferId() int64 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+func (x *StockTransferResponse) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *StockTransferResponse) GetFromLocation() string {
+	if x != nil {
+		return x.FromLocation
+	}
+	return ""
+}
+
+func (x *StockTransferResponse) GetToLocation() string {
+	if x != nil {
+		return x.ToLocation
+	}
+	return ""
+}
+
+func (x *StockTransferResponse) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StockTransferResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StockTransferResponse) GetShippedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ShippedAt
+	}
+	return nil
+}
+
+func (x *StockTransferResponse) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+type OpenCycleCountRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Location string                 `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	// merchant whose stock is counted, zero counts stock of every merchant in location.
+	UserId        int64  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Note          string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpenCycleCountRequest) Reset() {
+	*x = OpenCycleCountRequest{}
+	mi := &file_stocks_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenCycleCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenCycleCountRequest) ProtoMessage() {}
+
+func (x *OpenCycleCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenCycleCountRequest.ProtoReflect.Descriptor instead.
+func (*OpenCycleCountRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *OpenCycleCountRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *OpenCycleCountRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *OpenCycleCountRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type CountedQuantity struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// merchant owning counted stock, may be omitted when count session has one.
+	UserId int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuId  uint32 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	// quantity found on shelf in minor units of sku unit of measure.
+	CountedQuantity int64 `protobuf:"varint,3,opt,name=counted_quantity,json=countedQuantity,proto3" json:"counted_quantity,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CountedQuantity) Reset() {
+	*x = CountedQuantity{}
+	mi := &file_stocks_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountedQuantity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountedQuantity) ProtoMessage() {}
+
+func (x *CountedQuantity) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountedQuantity.ProtoReflect.Descriptor instead.
+func (*CountedQuantity) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CountedQuantity) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CountedQuantity) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *CountedQuantity) GetCountedQuantity() int64 {
+	if x != nil {
+		return x.CountedQuantity
+	}
+	return 0
+}
+
+type SubmitCycleCountsRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	CycleCountId int64                  `protobuf:"varint,1,opt,name=cycle_count_id,json=cycleCountId,proto3" json:"cycle_count_id,omitempty"`
+	// counting sku again replaces its previous count.
+	Counts        []*CountedQuantity `protobuf:"bytes,2,rep,name=counts,proto3" json:"counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitCycleCountsRequest) Reset() {
+	*x = SubmitCycleCountsRequest{}
+	mi := &file_stocks_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitCycleCountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitCycleCountsRequest) ProtoMessage() {}
+
+func (x *SubmitCycleCountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitCycleCountsRequest.ProtoReflect.Descriptor instead.
+func (*SubmitCycleCountsRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SubmitCycleCountsRequest) GetCycleCountId() int64 {
+	if x != nil {
+		return x.CycleCountId
+	}
+	return 0
+}
+
+func (x *SubmitCycleCountsRequest) GetCounts() []*CountedQuantity {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+type GetCycleCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CycleCountId  int64                  `protobuf:"varint,1,opt,name=cycle_count_id,json=cycleCountId,proto3" json:"cycle_count_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCycleCountRequest) Reset() {
+	*x = GetCycleCountRequest{}
+	mi := &file_stocks_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCycleCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCycleCountRequest) ProtoMessage() {}
+
+func (x *GetCycleCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCycleCountRequest.ProtoReflect.Descriptor instead.
+func (*GetCycleCountRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetCycleCountRequest) GetCycleCountId() int64 {
+	if x != nil {
+		return x.CycleCountId
+	}
+	return 0
+}
+
+type ApproveCycleCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CycleCountId  int64                  `protobuf:"varint,1,opt,name=cycle_count_id,json=cycleCountId,proto3" json:"cycle_count_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveCycleCountRequest) Reset() {
+	*x = ApproveCycleCountRequest{}
+	mi := &file_stocks_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveCycleCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveCycleCountRequest) ProtoMessage() {}
+
+func (x *ApproveCycleCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveCycleCountRequest.ProtoReflect.Descriptor instead.
+func (*ApproveCycleCountRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ApproveCycleCountRequest) GetCycleCountId() int64 {
+	if x != nil {
+		return x.CycleCountId
+	}
+	return 0
+}
+
+type CycleCountLine struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuId  uint32                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Name   string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// quantity system had when sku was counted.
+	SystemQuantity  int64 `protobuf:"varint,4,opt,name=system_quantity,json=systemQuantity,proto3" json:"system_quantity,omitempty"`
+	CountedQuantity int64 `protobuf:"varint,5,opt,name=counted_quantity,json=countedQuantity,proto3" json:"counted_quantity,omitempty"`
+	// counted minus system quantity, posted as adjustment on approval.
+	Variance  int64                  `protobuf:"varint,6,opt,name=variance,proto3" json:"variance,omitempty"`
+	CountedBy int64                  `protobuf:"varint,7,opt,name=counted_by,json=countedBy,proto3" json:"counted_by,omitempty"`
+	CountedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=counted_at,json=countedAt,proto3" json:"counted_at,omitempty"`
+	// quantity of stock item after approval, set once count session is approved.
+	QuantityAfter int64 `protobuf:"varint,9,opt,name=quantity_after,json=quantityAfter,proto3" json:"quantity_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CycleCountLine) Reset() {
+	*x = CycleCountLine{}
+	mi := &file_stocks_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CycleCountLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CycleCountLine) ProtoMessage() {}
+
+func (x *CycleCountLine) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CycleCountLine.ProtoReflect.Descriptor instead.
+func (*CycleCountLine) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CycleCountLine) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CycleCountLine) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *CycleCountLine) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CycleCountLine) GetSystemQuantity() int64 {
+	if x != nil {
+		return x.SystemQuantity
+	}
+	return 0
+}
+
+func (x *CycleCountLine) GetCountedQuantity() int64 {
+	if x != nil {
+		return x.CountedQuantity
+	}
+	return 0
+}
+
+func (x *CycleCountLine) GetVariance() int64 {
+	if x != nil {
+		return x.Variance
+	}
+	return 0
+}
+
+func (x *CycleCountLine) GetCountedBy() int64 {
+	if x != nil {
+		return x.CountedBy
+	}
+	return 0
+}
+
+func (x *CycleCountLine) GetCountedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CountedAt
+	}
+	return nil
+}
+
+func (x *CycleCountLine) GetQuantityAfter() int64 {
+	if x != nil {
+		return x.QuantityAfter
+	}
+	return 0
+}
+
+// CycleCountEvent is audit trail entry of count session.
+type CycleCountEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// opened, counted or approved.
+	Action  string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	ActorId int64  `protobuf:"varint,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	// sku and quantities of counted and approved lines, empty for session events.
+	UserId          int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuId           uint32                 `protobuf:"varint,4,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	SystemQuantity  int64                  `protobuf:"varint,5,opt,name=system_quantity,json=systemQuantity,proto3" json:"system_quantity,omitempty"`
+	CountedQuantity int64                  `protobuf:"varint,6,opt,name=counted_quantity,json=countedQuantity,proto3" json:"counted_quantity,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CycleCountEvent) Reset() {
+	*x = CycleCountEvent{}
+	mi := &file_stocks_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CycleCountEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CycleCountEvent) ProtoMessage() {}
+
+func (x *CycleCountEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CycleCountEvent.ProtoReflect.Descriptor instead.
+func (*CycleCountEvent) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CycleCountEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *CycleCountEvent) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *CycleCountEvent) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CycleCountEvent) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *CycleCountEvent) GetSystemQuantity() int64 {
+	if x != nil {
+		return x.SystemQuantity
+	}
+	return 0
+}
+
+func (x *CycleCountEvent) GetCountedQuantity() int64 {
+	if x != nil {
+		return x.CountedQuantity
+	}
+	return 0
+}
+
+func (x *CycleCountEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CycleCountResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	CycleCountId int64                  `protobuf:"varint,1,opt,name=cycle_count_id,json=cycleCountId,proto3" json:"cycle_count_id,omitempty"`
+	Location     string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	UserId       int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// open or approved.
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	OpenedBy      int64                  `protobuf:"varint,6,opt,name=opened_by,json=openedBy,proto3" json:"opened_by,omitempty"`
+	OpenedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=opened_at,json=openedAt,proto3" json:"opened_at,omitempty"`
+	ApprovedBy    int64                  `protobuf:"varint,8,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	ApprovedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
+	Lines         []*CycleCountLine      `protobuf:"bytes,10,rep,name=lines,proto3" json:"lines,omitempty"`
+	Events        []*CycleCountEvent     `protobuf:"bytes,11,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CycleCountResponse) Reset() {
+	*x = CycleCountResponse{}
+	mi := &file_stocks_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CycleCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CycleCountResponse) ProtoMessage() {}
+
+func (x *CycleCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CycleCountResponse.ProtoReflect.Descriptor instead.
+func (*CycleCountResponse) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CycleCountResponse) GetCycleCountId() int64 {
+	if x != nil {
+		return x.CycleCountId
+	}
+	return 0
+}
+
+func (x *CycleCountResponse) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CycleCountResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CycleCountResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CycleCountResponse) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *CycleCountResponse) GetOpenedBy() int64 {
+	if x != nil {
+		return x.OpenedBy
+	}
+	return 0
+}
+
+func (x *CycleCountResponse) GetOpenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpenedAt
+	}
+	return nil
+}
+
+func (x *CycleCountResponse) GetApprovedBy() int64 {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return 0
+}
+
+func (x *CycleCountResponse) GetApprovedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ApprovedAt
+	}
+	return nil
+}
+
+func (x *CycleCountResponse) GetLines() []*CycleCountLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *CycleCountResponse) GetEvents() []*CycleCountEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type CreateSupplierRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// merchant supplier belongs to.
+	UserId int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email  string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Phone  string `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	// days from ordering to delivery, expected date of orders without one.
+	LeadTimeDays  int32 `protobuf:"varint,5,opt,name=lead_time_days,json=leadTimeDays,proto3" json:"lead_time_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSupplierRequest) Reset() {
+	*x = CreateSupplierRequest{}
+	mi := &file_stocks_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSupplierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSupplierRequest) ProtoMessage() {}
+
+func (x *CreateSupplierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSupplierRequest.ProtoReflect.Descriptor instead.
+func (*CreateSupplierRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CreateSupplierRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateSupplierRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSupplierRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateSupplierRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *CreateSupplierRequest) GetLeadTimeDays() int32 {
+	if x != nil {
+		return x.LeadTimeDays
+	}
+	return 0
+}
+
+type SupplierResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SupplierId    int64                  `protobuf:"varint,1,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	LeadTimeDays  int32                  `protobuf:"varint,6,opt,name=lead_time_days,json=leadTimeDays,proto3" json:"lead_time_days,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SupplierResponse) Reset() {
+	*x = SupplierResponse{}
+	mi := &file_stocks_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupplierResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupplierResponse) ProtoMessage() {}
+
+func (x *SupplierResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupplierResponse.ProtoReflect.Descriptor instead.
+func (*SupplierResponse) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SupplierResponse) GetSupplierId() int64 {
+	if x != nil {
+		return x.SupplierId
+	}
+	return 0
+}
+
+func (x *SupplierResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SupplierResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SupplierResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SupplierResponse) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *SupplierResponse) GetLeadTimeDays() int32 {
+	if x != nil {
+		return x.LeadTimeDays
+	}
+	return 0
+}
+
+func (x *SupplierResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListSuppliersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuppliersRequest) Reset() {
+	*x = ListSuppliersRequest{}
+	mi := &file_stocks_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSuppliersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuppliersRequest) ProtoMessage() {}
+
+func (x *ListSuppliersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuppliersRequest.ProtoReflect.Descriptor instead.
+func (*ListSuppliersRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListSuppliersRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListSuppliersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suppliers     []*SupplierResponse    `protobuf:"bytes,1,rep,name=suppliers,proto3" json:"suppliers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuppliersResponse) Reset() {
+	*x = ListSuppliersResponse{}
+	mi := &file_stocks_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSuppliersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuppliersResponse) ProtoMessage() {}
+
+func (x *ListSuppliersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuppliersResponse.ProtoReflect.Descriptor instead.
+func (*ListSuppliersResponse) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListSuppliersResponse) GetSuppliers() []*SupplierResponse {
+	if x != nil {
+		return x.Suppliers
+	}
+	return nil
+}
+
+type PurchaseOrderLineRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	SkuId uint32                 `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	// quantity in minor units of sku unit of measure.
+	Quantity int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// price stock item is offered at once units are received.
+	Price         *Money `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchaseOrderLineRequest) Reset() {
+	*x = PurchaseOrderLineRequest{}
+	mi := &file_stocks_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseOrderLineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseOrderLineRequest) ProtoMessage() {}
+
+func (x *PurchaseOrderLineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseOrderLineRequest.ProtoReflect.Descriptor instead.
+func (*PurchaseOrderLineRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PurchaseOrderLineRequest) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLineRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLineRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type CreatePurchaseOrderRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserId     int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SupplierId int64                  `protobuf:"varint,2,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	// location units are delivered to.
+	Location string `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	Note     string `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	// expected delivery, absent means lead time of supplier after ordering.
+	ExpectedAt    *timestamppb.Timestamp      `protobuf:"bytes,5,opt,name=expected_at,json=expectedAt,proto3" json:"expected_at,omitempty"`
+	Lines         []*PurchaseOrderLineRequest `protobuf:"bytes,6,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePurchaseOrderRequest) Reset() {
+	*x = CreatePurchaseOrderRequest{}
+	mi := &file_stocks_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePurchaseOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePurchaseOrderRequest) ProtoMessage() {}
+
+func (x *CreatePurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreatePurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CreatePurchaseOrderRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreatePurchaseOrderRequest) GetSupplierId() int64 {
+	if x != nil {
+		return x.SupplierId
+	}
+	return 0
+}
+
+func (x *CreatePurchaseOrderRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CreatePurchaseOrderRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *CreatePurchaseOrderRequest) GetExpectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedAt
+	}
+	return nil
+}
+
+func (x *CreatePurchaseOrderRequest) GetLines() []*PurchaseOrderLineRequest {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type PurchaseOrderRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PurchaseOrderId int64                  `protobuf:"varint,1,opt,name=purchase_order_id,json=purchaseOrderId,proto3" json:"purchase_order_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PurchaseOrderRequest) Reset() {
+	*x = PurchaseOrderRequest{}
+	mi := &file_stocks_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseOrderRequest) ProtoMessage() {}
+
+func (x *PurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*PurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *PurchaseOrderRequest) GetPurchaseOrderId() int64 {
+	if x != nil {
+		return x.PurchaseOrderId
+	}
+	return 0
+}
+
+type ReceivedLineRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SkuId    uint32                 `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Quantity int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// lot received units belong to, empty for stock without lot tracking.
+	LotNumber string `protobuf:"bytes,3,opt,name=lot_number,json=lotNumber,proto3" json:"lot_number,omitempty"`
+	// expiry date of lot, absent for lots which do not expire.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceivedLineRequest) Reset() {
+	*x = ReceivedLineRequest{}
+	mi := &file_stocks_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceivedLineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceivedLineRequest) ProtoMessage() {}
+
+func (x *ReceivedLineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceivedLineRequest.ProtoReflect.Descriptor instead.
+func (*ReceivedLineRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ReceivedLineRequest) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *ReceivedLineRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReceivedLineRequest) GetLotNumber() string {
+	if x != nil {
+		return x.LotNumber
+	}
+	return ""
+}
+
+func (x *ReceivedLineRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type ReceivePurchaseOrderRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PurchaseOrderId int64                  `protobuf:"varint,1,opt,name=purchase_order_id,json=purchaseOrderId,proto3" json:"purchase_order_id,omitempty"`
+	Lines           []*ReceivedLineRequest `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReceivePurchaseOrderRequest) Reset() {
+	*x = ReceivePurchaseOrderRequest{}
+	mi := &file_stocks_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceivePurchaseOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceivePurchaseOrderRequest) ProtoMessage() {}
+
+func (x *ReceivePurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceivePurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*ReceivePurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ReceivePurchaseOrderRequest) GetPurchaseOrderId() int64 {
+	if x != nil {
+		return x.PurchaseOrderId
+	}
+	return 0
+}
+
+func (x *ReceivePurchaseOrderRequest) GetLines() []*ReceivedLineRequest {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type PurchaseOrderLine struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	SkuId               uint32                 `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Quantity            int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price               *Money                 `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	ReceivedQuantity    int64                  `protobuf:"varint,5,opt,name=received_quantity,json=receivedQuantity,proto3" json:"received_quantity,omitempty"`
+	OutstandingQuantity int64                  `protobuf:"varint,6,opt,name=outstanding_quantity,json=outstandingQuantity,proto3" json:"outstanding_quantity,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PurchaseOrderLine) Reset() {
+	*x = PurchaseOrderLine{}
+	mi := &file_stocks_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseOrderLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseOrderLine) ProtoMessage() {}
+
+func (x *PurchaseOrderLine) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseOrderLine.ProtoReflect.Descriptor instead.
+func (*PurchaseOrderLine) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PurchaseOrderLine) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLine) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PurchaseOrderLine) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLine) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *PurchaseOrderLine) GetReceivedQuantity() int64 {
+	if x != nil {
+		return x.ReceivedQuantity
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLine) GetOutstandingQuantity() int64 {
+	if x != nil {
+		return x.OutstandingQuantity
+	}
+	return 0
+}
+
+type PurchaseOrderResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PurchaseOrderId int64                  `protobuf:"varint,1,opt,name=purchase_order_id,json=purchaseOrderId,proto3" json:"purchase_order_id,omitempty"`
+	UserId          int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SupplierId      int64                  `protobuf:"varint,3,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	Location        string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	// draft, ordered, partially_received or received.
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Note          string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedBy     int64                  `protobuf:"varint,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	OrderedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=ordered_at,json=orderedAt,proto3" json:"ordered_at,omitempty"`
+	ExpectedAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=expected_at,json=expectedAt,proto3" json:"expected_at,omitempty"`
+	ReceivedAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	Lines         []*PurchaseOrderLine   `protobuf:"bytes,12,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchaseOrderResponse) Reset() {
+	*x = PurchaseOrderResponse{}
+	mi := &file_stocks_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseOrderResponse) ProtoMessage() {}
+
+func (x *PurchaseOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseOrderResponse.ProtoReflect.Descriptor instead.
+func (*PurchaseOrderResponse) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *PurchaseOrderResponse) GetPurchaseOrderId() int64 {
+	if x != nil {
+		return x.PurchaseOrderId
+	}
+	return 0
+}
+
+func (x *PurchaseOrderResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PurchaseOrderResponse) GetSupplierId() int64 {
+	if x != nil {
+		return x.SupplierId
+	}
+	return 0
+}
+
+func (x *PurchaseOrderResponse) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *PurchaseOrderResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PurchaseOrderResponse) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *PurchaseOrderResponse) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *PurchaseOrderResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PurchaseOrderResponse) GetOrderedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OrderedAt
+	}
+	return nil
+}
+
+func (x *PurchaseOrderResponse) GetExpectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedAt
+	}
+	return nil
+}
+
+func (x *PurchaseOrderResponse) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+func (x *PurchaseOrderResponse) GetLines() []*PurchaseOrderLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type GetReorderSuggestionsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// location and sku narrow suggestions down, empty values suggest for every stock item of merchant.
+	Location string `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	SkuId    uint32 `protobuf:"varint,3,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	// parameters below fall back to configured defaults when zero.
+	// days sales velocity is measured over.
+	WindowDays int32 `protobuf:"varint,4,opt,name=window_days,json=windowDays,proto3" json:"window_days,omitempty"`
+	// overrides lead time of supplier stock item was last ordered from.
+	LeadTimeDays int32 `protobuf:"varint,5,opt,name=lead_time_days,json=leadTimeDays,proto3" json:"lead_time_days,omitempty"`
+	// days of sales kept on top of lead time demand.
+	SafetyStockDays int32 `protobuf:"varint,6,opt,name=safety_stock_days,json=safetyStockDays,proto3" json:"safety_stock_days,omitempty"`
+	// days of sales suggested order lasts after it arrives.
+	CoverDays     int32 `protobuf:"varint,7,opt,name=cover_days,json=coverDays,proto3" json:"cover_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReorderSuggestionsRequest) Reset() {
+	*x = GetReorderSuggestionsRequest{}
+	mi := &file_stocks_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReorderSuggestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReorderSuggestionsRequest) ProtoMessage() {}
+
+func (x *GetReorderSuggestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReorderSuggestionsRequest.ProtoReflect.Descriptor instead.
+func (*GetReorderSuggestionsRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetReorderSuggestionsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetReorderSuggestionsRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *GetReorderSuggestionsRequest) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *GetReorderSuggestionsRequest) GetWindowDays() int32 {
+	if x != nil {
+		return x.WindowDays
+	}
+	return 0
+}
+
+func (x *GetReorderSuggestionsRequest) GetLeadTimeDays() int32 {
+	if x != nil {
+		return x.LeadTimeDays
+	}
+	return 0
+}
+
+func (x *GetReorderSuggestionsRequest) GetSafetyStockDays() int32 {
+	if x != nil {
+		return x.SafetyStockDays
+	}
+	return 0
+}
+
+func (x *GetReorderSuggestionsRequest) GetCoverDays() int32 {
+	if x != nil {
+		return x.CoverDays
+	}
+	return 0
+}
+
+type ReorderSuggestion struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SkuId            uint32                 `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Location         string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	SellerId         int64                  `protobuf:"varint,4,opt,name=seller_id,json=sellerId,proto3" json:"seller_id,omitempty"`
+	Unit             string                 `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
+	Count            int64                  `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	IncomingQuantity int64                  `protobuf:"varint,7,opt,name=incoming_quantity,json=incomingQuantity,proto3" json:"incoming_quantity,omitempty"`
+	// units sold net of returns over window.
+	SoldQuantity int64 `protobuf:"varint,8,opt,name=sold_quantity,json=soldQuantity,proto3" json:"sold_quantity,omitempty"`
+	// units of sku added to carts over window by every customer.
+	CartAddedQuantity int64 `protobuf:"varint,9,opt,name=cart_added_quantity,json=cartAddedQuantity,proto3" json:"cart_added_quantity,omitempty"`
+	// units sold per day.
+	SalesVelocity float64 `protobuf:"fixed64,10,opt,name=sales_velocity,json=salesVelocity,proto3" json:"sales_velocity,omitempty"`
+	// days count lasts at sales velocity, -1 when stock item does not sell.
+	DaysOfCover       float64 `protobuf:"fixed64,11,opt,name=days_of_cover,json=daysOfCover,proto3" json:"days_of_cover,omitempty"`
+	LeadTimeDays      int32   `protobuf:"varint,12,opt,name=lead_time_days,json=leadTimeDays,proto3" json:"lead_time_days,omitempty"`
+	SafetyStock       int64   `protobuf:"varint,13,opt,name=safety_stock,json=safetyStock,proto3" json:"safety_stock,omitempty"`
+	ReorderPoint      int64   `protobuf:"varint,14,opt,name=reorder_point,json=reorderPoint,proto3" json:"reorder_point,omitempty"`
+	SuggestedQuantity int64   `protobuf:"varint,15,opt,name=suggested_quantity,json=suggestedQuantity,proto3" json:"suggested_quantity,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReorderSuggestion) Reset() {
+	*x = ReorderSuggestion{}
+	mi := &file_stocks_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderSuggestion) ProtoMessage() {}
+
+func (x *ReorderSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderSuggestion.ProtoReflect.Descriptor instead.
+func (*ReorderSuggestion) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ReorderSuggestion) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *ReorderSuggestion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReorderSuggestion) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ReorderSuggestion) GetSellerId() int64 {
+	if x != nil {
+		return x.SellerId
+	}
+	return 0
+}
+
+func (x *ReorderSuggestion) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *ReorderSuggestion) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ReorderSuggestion) GetIncomingQuantity() int64 {
+	if x != nil {
+		return x.IncomingQuantity
+	}
+	return 0
+}
+
+func (x *ReorderSuggestion) GetSoldQuantity() int64 {
+	if x != nil {
+		return x.SoldQuantity
+	}
+	return 0
+}
+
+func (x *ReorderSuggestion) GetCartAddedQuantity() int64 {
+	if x != nil {
+		return x.CartAddedQuantity
+	}
+	return 0
+}
+
+func (x *ReorderSuggestion) GetSalesVelocity() float64 {
+	if x != nil {
+		return x.SalesVelocity
+	}
+	return 0
+}
+
+func (x *ReorderSuggestion) GetDaysOfCover() float64 {
+	if x != nil {
+		return x.DaysOfCover
+	}
+	return 0
+}
+
+func (x *ReorderSuggestion) GetLeadTimeDays() int32 {
+	if x != nil {
+		return x.LeadTimeDays
+	}
+	return 0
+}
+
+func (x *ReorderSuggestion) GetSafetyStock() int64 {
+	if x != nil {
+		return x.SafetyStock
+	}
+	return 0
+}
+
+func (x *ReorderSuggestion) GetReorderPoint() int64 {
+	if x != nil {
+		return x.ReorderPoint
+	}
+	return 0
+}
+
+func (x *ReorderSuggestion) GetSuggestedQuantity() int64 {
+	if x != nil {
+		return x.SuggestedQuantity
+	}
+	return 0
+}
+
+type GetReorderSuggestionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*ReorderSuggestion   `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReorderSuggestionsResponse) Reset() {
+	*x = GetReorderSuggestionsResponse{}
+	mi := &file_stocks_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReorderSuggestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReorderSuggestionsResponse) ProtoMessage() {}
+
+func (x *GetReorderSuggestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReorderSuggestionsResponse.ProtoReflect.Descriptor instead.
+func (*GetReorderSuggestionsResponse) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetReorderSuggestionsResponse) GetSuggestions() []*ReorderSuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+type SchedulePriceChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuId         uint32                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Location      string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	EffectiveAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+	NewPrice      *Money                 `protobuf:"bytes,6,opt,name=new_price,json=newPrice,proto3" json:"new_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePriceChangeRequest) Reset() {
+	*x = SchedulePriceChangeRequest{}
+	mi := &file_stocks_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePriceChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePriceChangeRequest) ProtoMessage() {}
+
+func (x *SchedulePriceChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePriceChangeRequest.ProtoReflect.Descriptor instead.
+func (*SchedulePriceChangeRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SchedulePriceChangeRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SchedulePriceChangeRequest) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *SchedulePriceChangeRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *SchedulePriceChangeRequest) GetEffectiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return nil
+}
+
+func (x *SchedulePriceChangeRequest) GetNewPrice() *Money {
+	if x != nil {
+		return x.NewPrice
+	}
+	return nil
+}
+
+type ScheduledPriceChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuId         uint32                 `protobuf:"varint,3,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Location      string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	EffectiveAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	NewPrice      *Money                 `protobuf:"bytes,8,opt,name=new_price,json=newPrice,proto3" json:"new_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledPriceChangeResponse) Reset() {
+	*x = ScheduledPriceChangeResponse{}
+	mi := &file_stocks_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledPriceChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledPriceChangeResponse) ProtoMessage() {}
+
+func (x *ScheduledPriceChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledPriceChangeResponse.ProtoReflect.Descriptor instead.
+func (*ScheduledPriceChangeResponse) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ScheduledPriceChangeResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScheduledPriceChangeResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ScheduledPriceChangeResponse) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *ScheduledPriceChangeResponse) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ScheduledPriceChangeResponse) GetEffectiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return nil
+}
+
+func (x *ScheduledPriceChangeResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ScheduledPriceChangeResponse) GetNewPrice() *Money {
+	if x != nil {
+		return x.NewPrice
+	}
+	return nil
+}
+
+type PriceTier struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// empty group is every customer.
+	CustomerGroup string `protobuf:"bytes,1,opt,name=customer_group,json=customerGroup,proto3" json:"customer_group,omitempty"`
+	// minimum quantity in minor units of sku unit of measure.
+	MinQuantity int64 `protobuf:"varint,2,opt,name=min_quantity,json=minQuantity,proto3" json:"min_quantity,omitempty"`
+	// price of one unit of measure in currency of the offer.
+	Price         *Money `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceTier) Reset() {
+	*x = PriceTier{}
+	mi := &file_stocks_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceTier) ProtoMessage() {}
+
+func (x *PriceTier) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceTier.ProtoReflect.Descriptor instead.
+func (*PriceTier) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *PriceTier) GetCustomerGroup() string {
+	if x != nil {
+		return x.CustomerGroup
+	}
+	return ""
+}
+
+func (x *PriceTier) GetMinQuantity() int64 {
+	if x != nil {
+		return x.MinQuantity
+	}
+	return 0
+}
+
+func (x *PriceTier) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type PriceTierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinQuantity   int64                  `protobuf:"varint,1,opt,name=min_quantity,json=minQuantity,proto3" json:"min_quantity,omitempty"`
+	Price         *Money                 `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceTierRequest) Reset() {
+	*x = PriceTierRequest{}
+	mi := &file_stocks_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceTierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceTierRequest) ProtoMessage() {}
+
+func (x *PriceTierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceTierRequest.ProtoReflect.Descriptor instead.
+func (*PriceTierRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *PriceTierRequest) GetMinQuantity() int64 {
+	if x != nil {
+		return x.MinQuantity
+	}
+	return 0
+}
+
+func (x *PriceTierRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type SetPriceTiersRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuId    uint32                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Location string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	// group tiers are set for, empty is every customer.
+	CustomerGroup string `protobuf:"bytes,4,opt,name=customer_group,json=customerGroup,proto3" json:"customer_group,omitempty"`
+	// replace tiers of the group, empty list removes them.
+	Tiers         []*PriceTierRequest `protobuf:"bytes,5,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPriceTiersRequest) Reset() {
+	*x = SetPriceTiersRequest{}
+	mi := &file_stocks_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPriceTiersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPriceTiersRequest) ProtoMessage() {}
+
+func (x *SetPriceTiersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPriceTiersRequest.ProtoReflect.Descriptor instead.
+func (*SetPriceTiersRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SetPriceTiersRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetPriceTiersRequest) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *SetPriceTiersRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *SetPriceTiersRequest) GetCustomerGroup() string {
+	if x != nil {
+		return x.CustomerGroup
+	}
+	return ""
+}
+
+func (x *SetPriceTiersRequest) GetTiers() []*PriceTierRequest {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+type GetPriceTiersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SkuId         uint32                 `protobuf:"varint,2,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	Location      string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceTiersRequest) Reset() {
+	*x = GetPriceTiersRequest{}
+	mi := &file_stocks_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceTiersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceTiersRequest) ProtoMessage() {}
+
+func (x *GetPriceTiersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceTiersRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceTiersRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetPriceTiersRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetPriceTiersRequest) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *GetPriceTiersRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+type PriceTiersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tiers of every customer group, ordered by group and minimum quantity.
+	Tiers         []*PriceTier `protobuf:"bytes,1,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceTiersResponse) Reset() {
+	*x = PriceTiersResponse{}
+	mi := &file_stocks_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceTiersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceTiersResponse) ProtoMessage() {}
+
+func (x *PriceTiersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceTiersResponse.ProtoReflect.Descriptor instead.
+func (*PriceTiersResponse) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *PriceTiersResponse) GetTiers() []*PriceTier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+type GetPriceHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	SkuId uint32                 `protobuf:"varint,1,opt,name=sku_id,json=skuId,proto3" json:"sku_id,omitempty"`
+	// optional filters, zero values match all sellers and locations.
+	UserId        int64  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Location      string `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	PageSize      int64  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	CurrentPage   int64  `protobuf:"varint,5,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryRequest) Reset() {
+	*x = GetPriceHistoryRequest{}
+	mi := &file_stocks_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryRequest) ProtoMessage() {}
+
+func (x *GetPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetPriceHistoryRequest) GetSkuId() uint32 {
+	if x != nil {
+		return x.SkuId
+	}
+	return 0
+}
+
+func (x *GetPriceHistoryRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetPriceHistoryRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
 	}
 	return ""
 }
 
-func (x *StockItemResponse) GetCount() uint32 {
+func (x *GetPriceHistoryRequest) GetPageSize() int64 {
 	if x != nil {
-		return x.Count
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *StockItemResponse) GetPrice() uint32 {
+func (x *GetPriceHistoryRequest) GetCurrentPage() int64 {
 	if x != nil {
-		return x.Price
+		return x.CurrentPage
 	}
 	return 0
 }
 
-func (x *StockItemResponse) GetLocation() string {
+type PriceHistoryEntry struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Location  string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	ChangedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	// absent for the price stock item was created with.
+	OldPrice      *Money `protobuf:"bytes,6,opt,name=old_price,json=oldPrice,proto3" json:"old_price,omitempty"`
+	NewPrice      *Money `protobuf:"bytes,7,opt,name=new_price,json=newPrice,proto3" json:"new_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceHistoryEntry) Reset() {
+	*x = PriceHistoryEntry{}
+	mi := &file_stocks_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceHistoryEntry) ProtoMessage() {}
+
+func (x *PriceHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceHistoryEntry.ProtoReflect.Descriptor instead.
+func (*PriceHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *PriceHistoryEntry) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PriceHistoryEntry) GetLocation() string {
 	if x != nil {
 		return x.Location
 	}
 	return ""
 }
 
-type ListStockItemsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*StockItemResponse   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalCount    uint32                 `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
-	PageNumber    int64                  `protobuf:"varint,3,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+func (x *PriceHistoryEntry) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *PriceHistoryEntry) GetOldPrice() *Money {
+	if x != nil {
+		return x.OldPrice
+	}
+	return nil
+}
+
+func (x *PriceHistoryEntry) GetNewPrice() *Money {
+	if x != nil {
+		return x.NewPrice
+	}
+	return nil
+}
+
+type PriceHistoryResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Entries       []*PriceHistoryEntry            `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Scheduled     []*ScheduledPriceChangeResponse `protobuf:"bytes,2,rep,name=scheduled,proto3" json:"scheduled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListStockItemsResponse) Reset() {
-	*x = ListStockItemsResponse{}
-	mi := &file_stocks_proto_msgTypes[6]
+func (x *PriceHistoryResponse) Reset() {
+	*x = PriceHistoryResponse{}
+	mi := &file_stocks_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListStockItemsResponse) String() string {
+func (x *PriceHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListStockItemsResponse) ProtoMessage() {}
+func (*PriceHistoryResponse) ProtoMessage() {}
 
-func (x *ListStockItemsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_stocks_proto_msgTypes[6]
+func (x *PriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -432,63 +5782,292 @@ func (x *ListStockItemsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListStockItemsResponse.ProtoReflect.Descriptor instead.
-func (*ListStockItemsResponse) Descriptor() ([]byte, []int) {
-	return file_stocks_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use PriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*PriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *ListStockItemsResponse) GetItems() []*StockItemResponse {
+func (x *PriceHistoryResponse) GetEntries() []*PriceHistoryEntry {
 	if x != nil {
-		return x.Items
+		return x.Entries
 	}
 	return nil
 }
 
-func (x *ListStockItemsResponse) GetTotalCount() uint32 {
+func (x *PriceHistoryResponse) GetScheduled() []*ScheduledPriceChangeResponse {
 	if x != nil {
-		return x.TotalCount
+		return x.Scheduled
+	}
+	return nil
+}
+
+type InventoryValuationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// dimensions rows are aggregated by, empty means every dimension.
+	GroupBy []ValuationDimension `protobuf:"varint,1,rep,packed,name=group_by,json=groupBy,proto3,enum=stocks.ValuationDimension" json:"group_by,omitempty"`
+	// optional filters, zero values match everything.
+	UserId   int64  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Location string `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	Type     string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	// stock is rebuilt from movement ledger at that time, absent means current stock.
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryValuationRequest) Reset() {
+	*x = InventoryValuationRequest{}
+	mi := &file_stocks_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryValuationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryValuationRequest) ProtoMessage() {}
+
+func (x *InventoryValuationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryValuationRequest.ProtoReflect.Descriptor instead.
+func (*InventoryValuationRequest) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *InventoryValuationRequest) GetGroupBy() []ValuationDimension {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+func (x *InventoryValuationRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *ListStockItemsResponse) GetPageNumber() int64 {
+func (x *InventoryValuationRequest) GetLocation() string {
 	if x != nil {
-		return x.PageNumber
+		return x.Location
+	}
+	return ""
+}
+
+func (x *InventoryValuationRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *InventoryValuationRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+type InventoryValuationRow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// dimensions which are not grouped by are left empty.
+	UserId   int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Location string `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	Type     string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	SkuCount int64  `protobuf:"varint,4,opt,name=sku_count,json=skuCount,proto3" json:"sku_count,omitempty"`
+	Quantity int64  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// sum of quantity multiplied by price, rows are split by currency.
+	Value         *Money `protobuf:"bytes,7,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryValuationRow) Reset() {
+	*x = InventoryValuationRow{}
+	mi := &file_stocks_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryValuationRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryValuationRow) ProtoMessage() {}
+
+func (x *InventoryValuationRow) ProtoReflect() protoreflect.Message {
+	mi := &file_stocks_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryValuationRow.ProtoReflect.Descriptor instead.
+func (*InventoryValuationRow) Descriptor() ([]byte, []int) {
+	return file_stocks_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *InventoryValuationRow) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *InventoryValuationRow) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *InventoryValuationRow) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *InventoryValuationRow) GetSkuCount() int64 {
+	if x != nil {
+		return x.SkuCount
+	}
+	return 0
+}
+
+func (x *InventoryValuationRow) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
 	}
 	return 0
 }
 
+func (x *InventoryValuationRow) GetValue() *Money {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
 var File_stocks_proto protoreflect.FileDescriptor
 
 const file_stocks_proto_rawDesc = "" +
 	"\n" +
-	"\fstocks.proto\x12\x06stocks\x1a\x1cgoogle/api/annotations.proto\"E\n" +
+	"\fstocks.proto\x12\x06stocks\x1a\x1cgoogle/api/annotations.proto\x1a google/protobuf/field_mask.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"E\n" +
 	"\x0fGeneralResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x90\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\";\n" +
+	"\x05Money\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\"\xbc\x02\n" +
 	"\x16CreateStockItemRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x15\n" +
 	"\x06sku_id\x18\x02 \x01(\rR\x05skuId\x12\x14\n" +
-	"\x05count\x18\x03 \x01(\rR\x05count\x12\x14\n" +
-	"\x05price\x18\x04 \x01(\rR\x05price\x12\x1a\n" +
-	"\blocation\x18\x05 \x01(\tR\blocation\"H\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\x12\x1a\n" +
+	"\blocation\x18\x05 \x01(\tR\blocation\x12#\n" +
+	"\x05price\x18\x06 \x01(\v2\r.stocks.MoneyR\x05price\x12\x1d\n" +
+	"\n" +
+	"lot_number\x18\a \x01(\tR\tlotNumber\x129\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12;\n" +
+	"\vreceived_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"receivedAtJ\x04\b\x04\x10\x05\"e\n" +
+	"\x17RestoreStockItemRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\rR\x05skuId\x12\x1a\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\"\x9e\x01\n" +
+	"\x0fStockItemUpdate\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\rR\x05skuId\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\x12\x1a\n" +
+	"\blocation\x18\x05 \x01(\tR\blocation\x12#\n" +
+	"\x05price\x18\x06 \x01(\v2\r.stocks.MoneyR\x05priceJ\x04\b\x04\x10\x05\"\xad\x01\n" +
+	"\x16UpdateStockItemRequest\x12+\n" +
+	"\x04item\x18\x01 \x01(\v2\x17.stocks.StockItemUpdateR\x04item\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12)\n" +
+	"\x10current_location\x18\x03 \x01(\tR\x0fcurrentLocation\"H\n" +
 	"\x16DeleteStockItemRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x15\n" +
-	"\x06sku_id\x18\x02 \x01(\rR\x05skuId\",\n" +
+	"\x06sku_id\x18\x02 \x01(\rR\x05skuId\"\xdd\x01\n" +
 	"\x13GetStockItemRequest\x12\x15\n" +
-	"\x06sku_id\x18\x01 \x01(\rR\x05skuId\"\x84\x01\n" +
+	"\x06sku_id\x18\x01 \x01(\rR\x05skuId\x120\n" +
+	"\n" +
+	"offer_rule\x18\x02 \x01(\x0e2\x11.stocks.OfferRuleR\tofferRule\x12\x1b\n" +
+	"\tseller_id\x18\x03 \x01(\x03R\bsellerId\x12\x1d\n" +
+	"\n" +
+	"all_offers\x18\x04 \x01(\bR\tallOffers\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x03R\bquantity\x12%\n" +
+	"\x0ecustomer_group\x18\x06 \x01(\tR\rcustomerGroup\"O\n" +
+	"\x1aGetStockItemsBySKUsRequest\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.stocks.GetStockItemRequestR\x05items\"N\n" +
+	"\x1bGetStockItemsBySKUsResponse\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.stocks.StockItemResponseR\x05items\",\n" +
+	"\x11WatchStockRequest\x12\x17\n" +
+	"\asku_ids\x18\x01 \x03(\rR\x06skuIds\"\x90\x02\n" +
+	"\x10StockChangeEvent\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\rR\x05skuId\x12\x1a\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x03R\x05count\x12\x18\n" +
+	"\adeleted\x18\x06 \x01(\bR\adeleted\x12\x1a\n" +
+	"\bsnapshot\x18\a \x01(\bR\bsnapshot\x129\n" +
+	"\n" +
+	"changed_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\x12#\n" +
+	"\x05price\x18\t \x01(\v2\r.stocks.MoneyR\x05priceJ\x04\b\x05\x10\x06\"\x8a\x02\n" +
 	"\rFilterRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
 	"\blocation\x18\x02 \x01(\tR\blocation\x12\x1b\n" +
 	"\tpage_size\x18\x03 \x01(\x03R\bpageSize\x12!\n" +
-	"\fcurrent_page\x18\x04 \x01(\x03R\vcurrentPage\"\x9a\x01\n" +
+	"\fcurrent_page\x18\x04 \x01(\x03R\vcurrentPage\x12E\n" +
+	"\n" +
+	"attributes\x18\x05 \x03(\v2%.stocks.FilterRequest.AttributesEntryR\n" +
+	"attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xea\x05\n" +
 	"\x11StockItemResponse\x12\x15\n" +
 	"\x06sku_id\x18\x01 \x01(\rR\x05skuId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
 	"\x04type\x18\x03 \x01(\tR\x04type\x12\x14\n" +
-	"\x05count\x18\x04 \x01(\rR\x05count\x12\x14\n" +
-	"\x05price\x18\x05 \x01(\rR\x05price\x12\x1a\n" +
-	"\blocation\x18\x06 \x01(\tR\blocation\"\x89\x01\n" +
+	"\x05count\x18\x04 \x01(\x03R\x05count\x12\x1a\n" +
+	"\blocation\x18\x06 \x01(\tR\blocation\x12\x1b\n" +
+	"\tseller_id\x18\a \x01(\x03R\bsellerId\x121\n" +
+	"\x06offers\x18\b \x03(\v2\x19.stocks.StockItemResponseR\x06offers\x12\x16\n" +
+	"\x06bundle\x18\t \x01(\bR\x06bundle\x12(\n" +
+	"\x10variant_group_id\x18\n" +
+	" \x01(\x03R\x0evariantGroupId\x127\n" +
+	"\n" +
+	"attributes\x18\v \x01(\v2\x17.google.protobuf.StructR\n" +
+	"attributes\x12\x12\n" +
+	"\x04unit\x18\f \x01(\tR\x04unit\x12\x1a\n" +
+	"\bquantity\x18\r \x01(\tR\bquantity\x12#\n" +
+	"\x05price\x18\x0e \x01(\v2\r.stocks.MoneyR\x05price\x125\n" +
+	"\tbackorder\x18\x0f \x01(\v2\x17.stocks.BackorderPolicyR\tbackorder\x12,\n" +
+	"\x12available_to_order\x18\x10 \x01(\x03R\x10availableToOrder\x12+\n" +
+	"\x11incoming_quantity\x18\x11 \x01(\x03R\x10incomingQuantity\x12L\n" +
+	"\x14incoming_expected_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\x12incomingExpectedAt\x12,\n" +
+	"\n" +
+	"list_price\x18\x13 \x01(\v2\r.stocks.MoneyR\tlistPrice\x120\n" +
+	"\n" +
+	"price_tier\x18\x14 \x01(\v2\x11.stocks.PriceTierR\tpriceTierJ\x04\b\x05\x10\x06\"\x89\x01\n" +
 	"\x16ListStockItemsResponse\x12/\n" +
 	"\x05items\x18\x01 \x03(\v2\x19.stocks.StockItemResponseR\x05items\x12\x1e\n" +
 	"\n" +
@@ -496,12 +6075,476 @@ const file_stocks_proto_rawDesc = "" +
 	"totalCount\x12\x1e\n" +
 	"\n" +
 	"pageNumber\x18\x03 \x01(\x03R\n" +
-	"pageNumber2\xc0\x03\n" +
+	"pageNumber\"\x89\x02\n" +
+	"\x11SearchSKUsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05types\x18\x02 \x03(\tR\x05types\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x03R\bpageSize\x12!\n" +
+	"\fcurrent_page\x18\x04 \x01(\x03R\vcurrentPage\x12I\n" +
+	"\n" +
+	"attributes\x18\x05 \x03(\v2).stocks.SearchSKUsRequest.AttributesEntryR\n" +
+	"attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8e\x02\n" +
+	"\x0fSKUSearchResult\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\rR\x05skuId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x12\n" +
+	"\x04rank\x18\x04 \x01(\x01R\x04rank\x12\x1c\n" +
+	"\thighlight\x18\x05 \x01(\tR\thighlight\x12'\n" +
+	"\x0favailable_count\x18\x06 \x01(\x03R\x0eavailableCount\x12(\n" +
+	"\x10variant_group_id\x18\a \x01(\x03R\x0evariantGroupId\x127\n" +
+	"\n" +
+	"attributes\x18\b \x01(\v2\x17.google.protobuf.StructR\n" +
+	"attributes\"5\n" +
+	"\tTypeFacet\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\rR\x05count\"\xb0\x01\n" +
+	"\x12SearchSKUsResponse\x12-\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.stocks.SKUSearchResultR\x05items\x12)\n" +
+	"\x06facets\x18\x02 \x03(\v2\x11.stocks.TypeFacetR\x06facets\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\rR\n" +
+	"totalCount\x12\x1f\n" +
+	"\vpage_number\x18\x04 \x01(\x03R\n" +
+	"pageNumber\"\x9a\x01\n" +
+	"\x18SetStockThresholdRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\rR\x05skuId\x12\x1a\n" +
+	"\blocation\x18\x02 \x01(\tR\blocation\x12+\n" +
+	"\x11reorder_threshold\x18\x03 \x01(\rR\x10reorderThreshold\x12\x1e\n" +
+	"\n" +
+	"hysteresis\x18\x04 \x01(\rR\n" +
+	"hysteresis\"D\n" +
+	"\x0fBundleComponent\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\rR\x05skuId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\rR\bquantity\"o\n" +
+	"\x10SetBundleRequest\x12\"\n" +
+	"\rbundle_sku_id\x18\x01 \x01(\rR\vbundleSkuId\x127\n" +
+	"\n" +
+	"components\x18\x02 \x03(\v2\x17.stocks.BundleComponentR\n" +
+	"components\"6\n" +
+	"\x10GetBundleRequest\x12\"\n" +
+	"\rbundle_sku_id\x18\x01 \x01(\rR\vbundleSkuId\"\x95\x01\n" +
+	"\x0eBundleResponse\x12\"\n" +
+	"\rbundle_sku_id\x18\x01 \x01(\rR\vbundleSkuId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x127\n" +
+	"\n" +
+	"components\x18\x04 \x03(\v2\x17.stocks.BundleComponentR\n" +
+	"components\"\x97\x01\n" +
+	"\x13AttributeDefinition\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12)\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x15.stocks.AttributeTypeR\x04type\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\bR\brequired\x12%\n" +
+	"\x0eallowed_values\x18\x04 \x03(\tR\rallowedValues\"l\n" +
+	"\x19SetAttributeSchemaRequest\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12;\n" +
+	"\n" +
+	"attributes\x18\x02 \x03(\v2\x1b.stocks.AttributeDefinitionR\n" +
+	"attributes\"W\n" +
+	"\x19CreateVariantGroupRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x12\n" +
+	"\x04axes\x18\x03 \x03(\tR\x04axes\"B\n" +
+	"\x16GetVariantGroupRequest\x12(\n" +
+	"\x10variant_group_id\x18\x01 \x01(\x03R\x0evariantGroupId\"\xc3\x01\n" +
+	"\vSKUResponse\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\rR\x05skuId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12(\n" +
+	"\x10variant_group_id\x18\x04 \x01(\x03R\x0evariantGroupId\x127\n" +
+	"\n" +
+	"attributes\x18\x05 \x01(\v2\x17.google.protobuf.StructR\n" +
+	"attributes\x12\x12\n" +
+	"\x04unit\x18\x06 \x01(\tR\x04unit\"\xad\x01\n" +
+	"\x14VariantGroupResponse\x12(\n" +
+	"\x10variant_group_id\x18\x01 \x01(\x03R\x0evariantGroupId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x12\n" +
+	"\x04axes\x18\x04 \x03(\tR\x04axes\x12/\n" +
+	"\bvariants\x18\x05 \x03(\v2\x13.stocks.SKUResponseR\bvariants\"\xaa\x01\n" +
+	"\x1aUpdateSKUAttributesRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\rR\x05skuId\x12(\n" +
+	"\x10variant_group_id\x18\x02 \x01(\x03R\x0evariantGroupId\x127\n" +
+	"\n" +
+	"attributes\x18\x03 \x01(\v2\x17.google.protobuf.StructR\n" +
+	"attributes\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\"q\n" +
+	"\x13ListLowStockRequest\x12\x1a\n" +
+	"\blocation\x18\x01 \x01(\tR\blocation\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x03R\bpageSize\x12!\n" +
+	"\fcurrent_page\x18\x03 \x01(\x03R\vcurrentPage\"\xac\x01\n" +
+	"\x14LowStockItemResponse\x12-\n" +
+	"\x04item\x18\x01 \x01(\v2\x19.stocks.StockItemResponseR\x04item\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vstock_level\x18\x03 \x01(\tR\n" +
+	"stockLevel\x12+\n" +
+	"\x11reorder_threshold\x18\x04 \x01(\rR\x10reorderThreshold\"\x8c\x01\n" +
+	"\x14ListLowStockResponse\x122\n" +
+	"\x05items\x18\x01 \x03(\v2\x1c.stocks.LowStockItemResponseR\x05items\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\rR\n" +
+	"totalCount\x12\x1f\n" +
+	"\vpage_number\x18\x03 \x01(\x03R\n" +
+	"pageNumber\"\xbc\x01\n" +
+	"\x12AdjustStockRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\rR\x05skuId\x12\x1a\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\x12\x14\n" +
+	"\x05delta\x18\x04 \x01(\x03R\x05delta\x120\n" +
+	"\x06reason\x18\x05 \x01(\x0e2\x18.stocks.AdjustmentReasonR\x06reason\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\"\x8f\x01\n" +
+	"\x13AdjustStockResponse\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\rR\x05skuId\x12\x1a\n" +
+	"\blocation\x18\x02 \x01(\tR\blocation\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\x12)\n" +
+	"\x04lots\x18\x04 \x03(\v2\x15.stocks.LotAllocationR\x04lots\"\x9c\x01\n" +
+	"\rLotAllocation\x12\x15\n" +
+	"\x06lot_id\x18\x01 \x01(\x03R\x05lotId\x12\x1d\n" +
+	"\n" +
+	"lot_number\x18\x02 \x01(\tR\tlotNumber\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\"\xaf\x01\n" +
+	"\x17ListExpiringLotsRequest\x12\x1f\n" +
+	"\vwithin_days\x18\x01 \x01(\rR\n" +
+	"withinDays\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x03R\bpageSize\x12!\n" +
+	"\fcurrent_page\x18\x05 \x01(\x03R\vcurrentPage\"\xbc\x02\n" +
+	"\x10StockLotResponse\x12\x15\n" +
+	"\x06lot_id\x18\x01 \x01(\x03R\x05lotId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x15\n" +
+	"\x06sku_id\x18\x03 \x01(\rR\x05skuId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1a\n" +
+	"\blocation\x18\x05 \x01(\tR\blocation\x12\x1d\n" +
+	"\n" +
+	"lot_number\x18\x06 \x01(\tR\tlotNumber\x12;\n" +
+	"\vreceived_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"receivedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1a\n" +
+	"\bquantity\x18\t \x01(\x03R\bquantity\"\x8a\x01\n" +
+	"\x18ListExpiringLotsResponse\x12,\n" +
+	"\x04lots\x18\x01 \x03(\v2\x18.stocks.StockLotResponseR\x04lots\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\rR\n" +
+	"totalCount\x12\x1f\n" +
+	"\vpage_number\x18\x03 \x01(\x03R\n" +
+	"pageNumber\"\x83\x01\n" +
+	"\x0fBackorderPolicy\x12\x12\n" +
+	"\x04mode\x18\x01 \x01(\tR\x04mode\x12!\n" +
+	"\fmax_quantity\x18\x02 \x01(\x03R\vmaxQuantity\x129\n" +
+	"\n" +
+	"restock_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\trestockAt\"\xc6\x01\n" +
+	"\x18BackorderSettingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\rR\x05skuId\x12\x1a\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\x12-\n" +
+	"\x12backorders_enabled\x18\x04 \x01(\bR\x11backordersEnabled\x12/\n" +
+	"\x06policy\x18\x05 \x01(\v2\x17.stocks.BackorderPolicyR\x06policy\"\xd9\x01\n" +
+	"\x14TransferStockRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\rR\x05skuId\x12#\n" +
+	"\rfrom_location\x18\x03 \x01(\tR\ffromLocation\x12\x1f\n" +
+	"\vto_location\x18\x04 \x01(\tR\n" +
+	"toLocation\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x03R\bquantity\x12/\n" +
+	"\x13receive_immediately\x18\x06 \x01(\bR\x12receiveImmediately\"W\n" +
+	"\x1bReceiveStockTransferRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vtransfer_id\x18\x02 \x01(\x03R\n" +
+	"transferId\"\xc1\x02\n" +
+	"\x15StockTransferResponse\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\x03R\n" +
+	"transferId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\rR\x05skuId\x12#\n" +
+	"\rfrom_location\x18\x03 \x01(\tR\ffromLocation\x12\x1f\n" +
+	"\vto_location\x18\x04 \x01(\tR\n" +
+	"toLocation\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x03R\bquantity\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"shipped_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tshippedAt\x12;\n" +
+	"\vreceived_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"receivedAt\"`\n" +
+	"\x15OpenCycleCountRequest\x12\x1a\n" +
+	"\blocation\x18\x01 \x01(\tR\blocation\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"l\n" +
+	"\x0fCountedQuantity\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\rR\x05skuId\x12)\n" +
+	"\x10counted_quantity\x18\x03 \x01(\x03R\x0fcountedQuantity\"q\n" +
+	"\x18SubmitCycleCountsRequest\x12$\n" +
+	"\x0ecycle_count_id\x18\x01 \x01(\x03R\fcycleCountId\x12/\n" +
+	"\x06counts\x18\x02 \x03(\v2\x17.stocks.CountedQuantityR\x06counts\"<\n" +
+	"\x14GetCycleCountRequest\x12$\n" +
+	"\x0ecycle_count_id\x18\x01 \x01(\x03R\fcycleCountId\"@\n" +
+	"\x18ApproveCycleCountRequest\x12$\n" +
+	"\x0ecycle_count_id\x18\x01 \x01(\x03R\fcycleCountId\"\xc5\x02\n" +
+	"\x0eCycleCountLine\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\rR\x05skuId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12'\n" +
+	"\x0fsystem_quantity\x18\x04 \x01(\x03R\x0esystemQuantity\x12)\n" +
+	"\x10counted_quantity\x18\x05 \x01(\x03R\x0fcountedQuantity\x12\x1a\n" +
+	"\bvariance\x18\x06 \x01(\x03R\bvariance\x12\x1d\n" +
+	"\n" +
+	"counted_by\x18\a \x01(\x03R\tcountedBy\x129\n" +
+	"\n" +
+	"counted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcountedAt\x12%\n" +
+	"\x0equantity_after\x18\t \x01(\x03R\rquantityAfter\"\x83\x02\n" +
+	"\x0fCycleCountEvent\x12\x16\n" +
+	"\x06action\x18\x01 \x01(\tR\x06action\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\x03R\aactorId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x15\n" +
+	"\x06sku_id\x18\x04 \x01(\rR\x05skuId\x12'\n" +
+	"\x0fsystem_quantity\x18\x05 \x01(\x03R\x0esystemQuantity\x12)\n" +
+	"\x10counted_quantity\x18\x06 \x01(\x03R\x0fcountedQuantity\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xae\x03\n" +
+	"\x12CycleCountResponse\x12$\n" +
+	"\x0ecycle_count_id\x18\x01 \x01(\x03R\fcycleCountId\x12\x1a\n" +
+	"\blocation\x18\x02 \x01(\tR\blocation\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\x12\x1b\n" +
+	"\topened_by\x18\x06 \x01(\x03R\bopenedBy\x127\n" +
+	"\topened_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bopenedAt\x12\x1f\n" +
+	"\vapproved_by\x18\b \x01(\x03R\n" +
+	"approvedBy\x12;\n" +
+	"\vapproved_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"approvedAt\x12,\n" +
+	"\x05lines\x18\n" +
+	" \x03(\v2\x16.stocks.CycleCountLineR\x05lines\x12/\n" +
+	"\x06events\x18\v \x03(\v2\x17.stocks.CycleCountEventR\x06events\"\x96\x01\n" +
+	"\x15CreateSupplierRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\x12$\n" +
+	"\x0elead_time_days\x18\x05 \x01(\x05R\fleadTimeDays\"\xed\x01\n" +
+	"\x10SupplierResponse\x12\x1f\n" +
+	"\vsupplier_id\x18\x01 \x01(\x03R\n" +
+	"supplierId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x05 \x01(\tR\x05phone\x12$\n" +
+	"\x0elead_time_days\x18\x06 \x01(\x05R\fleadTimeDays\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"/\n" +
+	"\x14ListSuppliersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"O\n" +
+	"\x15ListSuppliersResponse\x126\n" +
+	"\tsuppliers\x18\x01 \x03(\v2\x18.stocks.SupplierResponseR\tsuppliers\"r\n" +
+	"\x18PurchaseOrderLineRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\rR\x05skuId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12#\n" +
+	"\x05price\x18\x03 \x01(\v2\r.stocks.MoneyR\x05price\"\xfb\x01\n" +
+	"\x1aCreatePurchaseOrderRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vsupplier_id\x18\x02 \x01(\x03R\n" +
+	"supplierId\x12\x1a\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12;\n" +
+	"\vexpected_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expectedAt\x126\n" +
+	"\x05lines\x18\x06 \x03(\v2 .stocks.PurchaseOrderLineRequestR\x05lines\"B\n" +
+	"\x14PurchaseOrderRequest\x12*\n" +
+	"\x11purchase_order_id\x18\x01 \x01(\x03R\x0fpurchaseOrderId\"\xa2\x01\n" +
+	"\x13ReceivedLineRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\rR\x05skuId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12\x1d\n" +
+	"\n" +
+	"lot_number\x18\x03 \x01(\tR\tlotNumber\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"|\n" +
+	"\x1bReceivePurchaseOrderRequest\x12*\n" +
+	"\x11purchase_order_id\x18\x01 \x01(\x03R\x0fpurchaseOrderId\x121\n" +
+	"\x05lines\x18\x02 \x03(\v2\x1b.stocks.ReceivedLineRequestR\x05lines\"\xdf\x01\n" +
+	"\x11PurchaseOrderLine\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\rR\x05skuId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\x12#\n" +
+	"\x05price\x18\x04 \x01(\v2\r.stocks.MoneyR\x05price\x12+\n" +
+	"\x11received_quantity\x18\x05 \x01(\x03R\x10receivedQuantity\x121\n" +
+	"\x14outstanding_quantity\x18\x06 \x01(\x03R\x13outstandingQuantity\"\x85\x04\n" +
+	"\x15PurchaseOrderResponse\x12*\n" +
+	"\x11purchase_order_id\x18\x01 \x01(\x03R\x0fpurchaseOrderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vsupplier_id\x18\x03 \x01(\x03R\n" +
+	"supplierId\x12\x1a\n" +
+	"\blocation\x18\x04 \x01(\tR\blocation\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\x03R\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"ordered_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\torderedAt\x12;\n" +
+	"\vexpected_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expectedAt\x12;\n" +
+	"\vreceived_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"receivedAt\x12/\n" +
+	"\x05lines\x18\f \x03(\v2\x19.stocks.PurchaseOrderLineR\x05lines\"\xfc\x01\n" +
+	"\x1cGetReorderSuggestionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\blocation\x18\x02 \x01(\tR\blocation\x12\x15\n" +
+	"\x06sku_id\x18\x03 \x01(\rR\x05skuId\x12\x1f\n" +
+	"\vwindow_days\x18\x04 \x01(\x05R\n" +
+	"windowDays\x12$\n" +
+	"\x0elead_time_days\x18\x05 \x01(\x05R\fleadTimeDays\x12*\n" +
+	"\x11safety_stock_days\x18\x06 \x01(\x05R\x0fsafetyStockDays\x12\x1d\n" +
+	"\n" +
+	"cover_days\x18\a \x01(\x05R\tcoverDays\"\x8b\x04\n" +
+	"\x11ReorderSuggestion\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\rR\x05skuId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\x12\x1b\n" +
+	"\tseller_id\x18\x04 \x01(\x03R\bsellerId\x12\x12\n" +
+	"\x04unit\x18\x05 \x01(\tR\x04unit\x12\x14\n" +
+	"\x05count\x18\x06 \x01(\x03R\x05count\x12+\n" +
+	"\x11incoming_quantity\x18\a \x01(\x03R\x10incomingQuantity\x12#\n" +
+	"\rsold_quantity\x18\b \x01(\x03R\fsoldQuantity\x12.\n" +
+	"\x13cart_added_quantity\x18\t \x01(\x03R\x11cartAddedQuantity\x12%\n" +
+	"\x0esales_velocity\x18\n" +
+	" \x01(\x01R\rsalesVelocity\x12\"\n" +
+	"\rdays_of_cover\x18\v \x01(\x01R\vdaysOfCover\x12$\n" +
+	"\x0elead_time_days\x18\f \x01(\x05R\fleadTimeDays\x12!\n" +
+	"\fsafety_stock\x18\r \x01(\x03R\vsafetyStock\x12#\n" +
+	"\rreorder_point\x18\x0e \x01(\x03R\freorderPoint\x12-\n" +
+	"\x12suggested_quantity\x18\x0f \x01(\x03R\x11suggestedQuantity\"\\\n" +
+	"\x1dGetReorderSuggestionsResponse\x12;\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x19.stocks.ReorderSuggestionR\vsuggestions\"\xd9\x01\n" +
+	"\x1aSchedulePriceChangeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\rR\x05skuId\x12\x1a\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\x12=\n" +
+	"\feffective_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveAt\x12*\n" +
+	"\tnew_price\x18\x06 \x01(\v2\r.stocks.MoneyR\bnewPriceJ\x04\b\x04\x10\x05\"\x83\x02\n" +
+	"\x1cScheduledPriceChangeResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x15\n" +
+	"\x06sku_id\x18\x03 \x01(\rR\x05skuId\x12\x1a\n" +
+	"\blocation\x18\x04 \x01(\tR\blocation\x12=\n" +
+	"\feffective_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveAt\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12*\n" +
+	"\tnew_price\x18\b \x01(\v2\r.stocks.MoneyR\bnewPriceJ\x04\b\x05\x10\x06\"z\n" +
+	"\tPriceTier\x12%\n" +
+	"\x0ecustomer_group\x18\x01 \x01(\tR\rcustomerGroup\x12!\n" +
+	"\fmin_quantity\x18\x02 \x01(\x03R\vminQuantity\x12#\n" +
+	"\x05price\x18\x03 \x01(\v2\r.stocks.MoneyR\x05price\"Z\n" +
+	"\x10PriceTierRequest\x12!\n" +
+	"\fmin_quantity\x18\x01 \x01(\x03R\vminQuantity\x12#\n" +
+	"\x05price\x18\x02 \x01(\v2\r.stocks.MoneyR\x05price\"\xb9\x01\n" +
+	"\x14SetPriceTiersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\rR\x05skuId\x12\x1a\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\x12%\n" +
+	"\x0ecustomer_group\x18\x04 \x01(\tR\rcustomerGroup\x12.\n" +
+	"\x05tiers\x18\x05 \x03(\v2\x18.stocks.PriceTierRequestR\x05tiers\"b\n" +
+	"\x14GetPriceTiersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x15\n" +
+	"\x06sku_id\x18\x02 \x01(\rR\x05skuId\x12\x1a\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\"=\n" +
+	"\x12PriceTiersResponse\x12'\n" +
+	"\x05tiers\x18\x01 \x03(\v2\x11.stocks.PriceTierR\x05tiers\"\xa4\x01\n" +
+	"\x16GetPriceHistoryRequest\x12\x15\n" +
+	"\x06sku_id\x18\x01 \x01(\rR\x05skuId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x03R\bpageSize\x12!\n" +
+	"\fcurrent_page\x18\x05 \x01(\x03R\vcurrentPage\"\xe7\x01\n" +
+	"\x11PriceHistoryEntry\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\blocation\x18\x02 \x01(\tR\blocation\x129\n" +
+	"\n" +
+	"changed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\x12*\n" +
+	"\told_price\x18\x06 \x01(\v2\r.stocks.MoneyR\boldPrice\x12*\n" +
+	"\tnew_price\x18\a \x01(\v2\r.stocks.MoneyR\bnewPriceJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05\"\x8f\x01\n" +
+	"\x14PriceHistoryResponse\x123\n" +
+	"\aentries\x18\x01 \x03(\v2\x19.stocks.PriceHistoryEntryR\aentries\x12B\n" +
+	"\tscheduled\x18\x02 \x03(\v2$.stocks.ScheduledPriceChangeResponseR\tscheduled\"\xcc\x01\n" +
+	"\x19InventoryValuationRequest\x125\n" +
+	"\bgroup_by\x18\x01 \x03(\x0e2\x1a.stocks.ValuationDimensionR\agroupBy\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12/\n" +
+	"\x05as_of\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\"\xc4\x01\n" +
+	"\x15InventoryValuationRow\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\blocation\x18\x02 \x01(\tR\blocation\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1b\n" +
+	"\tsku_count\x18\x04 \x01(\x03R\bskuCount\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x03R\bquantity\x12#\n" +
+	"\x05value\x18\a \x01(\v2\r.stocks.MoneyR\x05valueJ\x04\b\x06\x10\a*_\n" +
+	"\tOfferRule\x12\x1a\n" +
+	"\x16OFFER_RULE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17OFFER_RULE_LOWEST_PRICE\x10\x01\x12\x19\n" +
+	"\x15OFFER_RULE_MOST_STOCK\x10\x02*\x9a\x01\n" +
+	"\rAttributeType\x12\x1e\n" +
+	"\x1aATTRIBUTE_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15ATTRIBUTE_TYPE_STRING\x10\x01\x12\x19\n" +
+	"\x15ATTRIBUTE_TYPE_NUMBER\x10\x02\x12\x1a\n" +
+	"\x16ATTRIBUTE_TYPE_BOOLEAN\x10\x03\x12\x17\n" +
+	"\x13ATTRIBUTE_TYPE_ENUM\x10\x04*\xee\x01\n" +
+	"\x10AdjustmentReason\x12!\n" +
+	"\x1dADJUSTMENT_REASON_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aADJUSTMENT_REASON_RECEIVED\x10\x01\x12\x1a\n" +
+	"\x16ADJUSTMENT_REASON_SOLD\x10\x02\x12\x1e\n" +
+	"\x1aADJUSTMENT_REASON_RETURNED\x10\x03\x12\x1d\n" +
+	"\x19ADJUSTMENT_REASON_DAMAGED\x10\x04\x12\x1a\n" +
+	"\x16ADJUSTMENT_REASON_LOST\x10\x05\x12 \n" +
+	"\x1cADJUSTMENT_REASON_CORRECTION\x10\x06*\x98\x01\n" +
+	"\x12ValuationDimension\x12#\n" +
+	"\x1fVALUATION_DIMENSION_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cVALUATION_DIMENSION_LOCATION\x10\x01\x12\x1c\n" +
+	"\x18VALUATION_DIMENSION_TYPE\x10\x02\x12\x1d\n" +
+	"\x19VALUATION_DIMENSION_OWNER\x10\x032\xe6\"\n" +
 	"\rStocksService\x12d\n" +
 	"\fAddStockItem\x12\x1e.stocks.CreateStockItemRequest\x1a\x17.stocks.GeneralResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/stocks/item/add\x12j\n" +
-	"\x0fDeleteStockItem\x12\x1e.stocks.DeleteStockItemRequest\x1a\x17.stocks.GeneralResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/stocks/item/delete\x12h\n" +
-	"\x11GetStockItemBySKU\x12\x1b.stocks.GetStockItemRequest\x1a\x19.stocks.StockItemResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/stocks/item/get\x12s\n" +
-	"\x18ListStockItemsByLocation\x12\x15.stocks.FilterRequest\x1a\x1e.stocks.ListStockItemsResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/stocks/list/locationB\x1eZ\x1cstocks/pkg/api/stocks;stocksb\x06proto3"
+	"\x0fDeleteStockItem\x12\x1e.stocks.DeleteStockItemRequest\x1a\x17.stocks.GeneralResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/stocks/item/delete\x12o\n" +
+	"\x10RestoreStockItem\x12\x1f.stocks.RestoreStockItemRequest\x1a\x19.stocks.StockItemResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/stocks/item/restore\x12\x85\x01\n" +
+	"\x0fUpdateStockItem\x12\x1e.stocks.UpdateStockItemRequest\x1a\x19.stocks.StockItemResponse\"7\x82\xd3\xe4\x93\x021:\x04item2)/stocks/item/{item.user_id}/{item.sku_id}\x12h\n" +
+	"\x11GetStockItemBySKU\x12\x1b.stocks.GetStockItemRequest\x1a\x19.stocks.StockItemResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/stocks/item/get\x12\x81\x01\n" +
+	"\x13GetStockItemsBySKUs\x12\".stocks.GetStockItemsBySKUsRequest\x1a#.stocks.GetStockItemsBySKUsResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/stocks/item/batch-get\x12]\n" +
+	"\n" +
+	"WatchStock\x12\x19.stocks.WatchStockRequest\x1a\x18.stocks.StockChangeEvent\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/stocks/watch0\x01\x12s\n" +
+	"\x18ListStockItemsByLocation\x12\x15.stocks.FilterRequest\x1a\x1e.stocks.ListStockItemsResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/stocks/list/location\x12b\n" +
+	"\n" +
+	"SearchSKUs\x12\x19.stocks.SearchSKUsRequest\x1a\x1a.stocks.SearchSKUsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/stocks/sku/search\x12p\n" +
+	"\x11SetStockThreshold\x12 .stocks.SetStockThresholdRequest\x1a\x17.stocks.GeneralResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/stocks/threshold/set\x12f\n" +
+	"\fListLowStock\x12\x1b.stocks.ListLowStockRequest\x1a\x1c.stocks.ListLowStockResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/stocks/list/low\x12f\n" +
+	"\vAdjustStock\x12\x1a.stocks.AdjustStockRequest\x1a\x1b.stocks.AdjustStockResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/stocks/item/adjust\x12w\n" +
+	"\x10ListExpiringLots\x12\x1f.stocks.ListExpiringLotsRequest\x1a .stocks.ListExpiringLotsResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/stocks/lots/expiring\x12x\n" +
+	"\x17UpdateBackorderSettings\x12 .stocks.BackorderSettingsRequest\x1a\x17.stocks.GeneralResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/stocks/item/backorders\x12i\n" +
+	"\rTransferStock\x12\x1c.stocks.TransferStockRequest\x1a\x1d.stocks.StockTransferResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/stocks/transfer\x12\x7f\n" +
+	"\x14ReceiveStockTransfer\x12#.stocks.ReceiveStockTransferRequest\x1a\x1d.stocks.StockTransferResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/stocks/transfer/receive\x12k\n" +
+	"\x0eOpenCycleCount\x12\x1d.stocks.OpenCycleCountRequest\x1a\x1a.stocks.CycleCountResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/stocks/counts/open\x12s\n" +
+	"\x11SubmitCycleCounts\x12 .stocks.SubmitCycleCountsRequest\x1a\x1a.stocks.CycleCountResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/stocks/counts/submit\x12w\n" +
+	"\x16GetCycleCountVariances\x12\x1c.stocks.GetCycleCountRequest\x1a\x1a.stocks.CycleCountResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/stocks/counts/variances\x12t\n" +
+	"\x11ApproveCycleCount\x12 .stocks.ApproveCycleCountRequest\x1a\x1a.stocks.CycleCountResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/stocks/counts/approve\x12n\n" +
+	"\x0eCreateSupplier\x12\x1d.stocks.CreateSupplierRequest\x1a\x18.stocks.SupplierResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/stocks/suppliers/create\x12o\n" +
+	"\rListSuppliers\x12\x1c.stocks.ListSuppliersRequest\x1a\x1d.stocks.ListSuppliersResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/stocks/suppliers/list\x12\x83\x01\n" +
+	"\x13CreatePurchaseOrder\x12\".stocks.CreatePurchaseOrderRequest\x1a\x1d.stocks.PurchaseOrderResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/stocks/purchase-orders/create\x12{\n" +
+	"\x12PlacePurchaseOrder\x12\x1c.stocks.PurchaseOrderRequest\x1a\x1d.stocks.PurchaseOrderResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/stocks/purchase-orders/place\x12\x86\x01\n" +
+	"\x14ReceivePurchaseOrder\x12#.stocks.ReceivePurchaseOrderRequest\x1a\x1d.stocks.PurchaseOrderResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/stocks/purchase-orders/receive\x12w\n" +
+	"\x10GetPurchaseOrder\x12\x1c.stocks.PurchaseOrderRequest\x1a\x1d.stocks.PurchaseOrderResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/stocks/purchase-orders/get\x12\x8c\x01\n" +
+	"\x15GetReorderSuggestions\x12$.stocks.GetReorderSuggestionsRequest\x1a%.stocks.GetReorderSuggestionsResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/stocks/reorder/suggestions\x12\x82\x01\n" +
+	"\x13SchedulePriceChange\x12\".stocks.SchedulePriceChangeRequest\x1a$.stocks.ScheduledPriceChangeResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/stocks/price/schedule\x12q\n" +
+	"\x0fGetPriceHistory\x12\x1e.stocks.GetPriceHistoryRequest\x1a\x1c.stocks.PriceHistoryResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/stocks/price/history\x12m\n" +
+	"\rSetPriceTiers\x12\x1c.stocks.SetPriceTiersRequest\x1a\x1a.stocks.PriceTiersResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/stocks/price/tiers/set\x12m\n" +
+	"\rGetPriceTiers\x12\x1c.stocks.GetPriceTiersRequest\x1a\x1a.stocks.PriceTiersResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/stocks/price/tiers/get\x12]\n" +
+	"\tSetBundle\x12\x18.stocks.SetBundleRequest\x1a\x17.stocks.GeneralResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/stocks/bundle/set\x12\\\n" +
+	"\tGetBundle\x12\x18.stocks.GetBundleRequest\x1a\x16.stocks.BundleResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/stocks/bundle/get\x12s\n" +
+	"\x12SetAttributeSchema\x12!.stocks.SetAttributeSchemaRequest\x1a\x17.stocks.GeneralResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/stocks/sku/schema/set\x12\x82\x01\n" +
+	"\x12CreateVariantGroup\x12!.stocks.CreateVariantGroupRequest\x1a\x1c.stocks.VariantGroupResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /stocks/sku/variant-group/create\x12y\n" +
+	"\x0fGetVariantGroup\x12\x1e.stocks.GetVariantGroupRequest\x1a\x1c.stocks.VariantGroupResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/stocks/sku/variant-group/get\x12q\n" +
+	"\x13UpdateSKUAttributes\x12\".stocks.UpdateSKUAttributesRequest\x1a\x13.stocks.SKUResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/stocks/sku/attributes\x12\x81\x01\n" +
+	"\x15GetInventoryValuation\x12!.stocks.InventoryValuationRequest\x1a\x1d.stocks.InventoryValuationRow\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/stocks/reports/valuation0\x01B\x1eZ\x1cstocks/pkg/api/stocks;stocksb\x06proto3"
 
 var (
 	file_stocks_proto_rawDescOnce sync.Once
@@ -515,31 +6558,260 @@ func file_stocks_proto_rawDescGZIP() []byte {
 	return file_stocks_proto_rawDescData
 }
 
-var file_stocks_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_stocks_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_stocks_proto_msgTypes = make([]protoimpl.MessageInfo, 81)
 var file_stocks_proto_goTypes = []any{
-	(*GeneralResponse)(nil),        // 0: stocks.GeneralResponse
-	(*CreateStockItemRequest)(nil), // 1: stocks.CreateStockItemRequest
-	(*DeleteStockItemRequest)(nil), // 2: stocks.DeleteStockItemRequest
-	(*GetStockItemRequest)(nil),    // 3: stocks.GetStockItemRequest
-	(*FilterRequest)(nil),          // 4: stocks.FilterRequest
-	(*StockItemResponse)(nil),      // 5: stocks.StockItemResponse
-	(*ListStockItemsResponse)(nil), // 6: stocks.ListStockItemsResponse
+	(OfferRule)(0),                        // 0: stocks.OfferRule
+	(AttributeType)(0),                    // 1: stocks.AttributeType
+	(AdjustmentReason)(0),                 // 2: stocks.AdjustmentReason
+	(ValuationDimension)(0),               // 3: stocks.ValuationDimension
+	(*GeneralResponse)(nil),               // 4: stocks.GeneralResponse
+	(*Money)(nil),                         // 5: stocks.Money
+	(*CreateStockItemRequest)(nil),        // 6: stocks.CreateStockItemRequest
+	(*RestoreStockItemRequest)(nil),       // 7: stocks.RestoreStockItemRequest
+	(*StockItemUpdate)(nil),               // 8: stocks.StockItemUpdate
+	(*UpdateStockItemRequest)(nil),        // 9: stocks.UpdateStockItemRequest
+	(*DeleteStockItemRequest)(nil),        // 10: stocks.DeleteStockItemRequest
+	(*GetStockItemRequest)(nil),           // 11: stocks.GetStockItemRequest
+	(*GetStockItemsBySKUsRequest)(nil),    // 12: stocks.GetStockItemsBySKUsRequest
+	(*GetStockItemsBySKUsResponse)(nil),   // 13: stocks.GetStockItemsBySKUsResponse
+	(*WatchStockRequest)(nil),             // 14: stocks.WatchStockRequest
+	(*StockChangeEvent)(nil),              // 15: stocks.StockChangeEvent
+	(*FilterRequest)(nil),                 // 16: stocks.FilterRequest
+	(*StockItemResponse)(nil),             // 17: stocks.StockItemResponse
+	(*ListStockItemsResponse)(nil),        // 18: stocks.ListStockItemsResponse
+	(*SearchSKUsRequest)(nil),             // 19: stocks.SearchSKUsRequest
+	(*SKUSearchResult)(nil),               // 20: stocks.SKUSearchResult
+	(*TypeFacet)(nil),                     // 21: stocks.TypeFacet
+	(*SearchSKUsResponse)(nil),            // 22: stocks.SearchSKUsResponse
+	(*SetStockThresholdRequest)(nil),      // 23: stocks.SetStockThresholdRequest
+	(*BundleComponent)(nil),               // 24: stocks.BundleComponent
+	(*SetBundleRequest)(nil),              // 25: stocks.SetBundleRequest
+	(*GetBundleRequest)(nil),              // 26: stocks.GetBundleRequest
+	(*BundleResponse)(nil),                // 27: stocks.BundleResponse
+	(*AttributeDefinition)(nil),           // 28: stocks.AttributeDefinition
+	(*SetAttributeSchemaRequest)(nil),     // 29: stocks.SetAttributeSchemaRequest
+	(*CreateVariantGroupRequest)(nil),     // 30: stocks.CreateVariantGroupRequest
+	(*GetVariantGroupRequest)(nil),        // 31: stocks.GetVariantGroupRequest
+	(*SKUResponse)(nil),                   // 32: stocks.SKUResponse
+	(*VariantGroupResponse)(nil),          // 33: stocks.VariantGroupResponse
+	(*UpdateSKUAttributesRequest)(nil),    // 34: stocks.UpdateSKUAttributesRequest
+	(*ListLowStockRequest)(nil),           // 35: stocks.ListLowStockRequest
+	(*LowStockItemResponse)(nil),          // 36: stocks.LowStockItemResponse
+	(*ListLowStockResponse)(nil),          // 37: stocks.ListLowStockResponse
+	(*AdjustStockRequest)(nil),            // 38: stocks.AdjustStockRequest
+	(*AdjustStockResponse)(nil),           // 39: stocks.AdjustStockResponse
+	(*LotAllocation)(nil),                 // 40: stocks.LotAllocation
+	(*ListExpiringLotsRequest)(nil),       // 41: stocks.ListExpiringLotsRequest
+	(*StockLotResponse)(nil),              // 42: stocks.StockLotResponse
+	(*ListExpiringLotsResponse)(nil),      // 43: stocks.ListExpiringLotsResponse
+	(*BackorderPolicy)(nil),               // 44: stocks.BackorderPolicy
+	(*BackorderSettingsRequest)(nil),      // 45: stocks.BackorderSettingsRequest
+	(*TransferStockRequest)(nil),          // 46: stocks.TransferStockRequest
+	(*ReceiveStockTransferRequest)(nil),   // 47: stocks.ReceiveStockTransferRequest
+	(*StockTransferResponse)(nil),         // 48: stocks.StockTransferResponse
+	(*OpenCycleCountRequest)(nil),         // 49: stocks.OpenCycleCountRequest
+	(*CountedQuantity)(nil),               // 50: stocks.CountedQuantity
+	(*SubmitCycleCountsRequest)(nil),      // 51: stocks.SubmitCycleCountsRequest
+	(*GetCycleCountRequest)(nil),          // 52: stocks.GetCycleCountRequest
+	(*ApproveCycleCountRequest)(nil),      // 53: stocks.ApproveCycleCountRequest
+	(*CycleCountLine)(nil),                // 54: stocks.CycleCountLine
+	(*CycleCountEvent)(nil),               // 55: stocks.CycleCountEvent
+	(*CycleCountResponse)(nil),            // 56: stocks.CycleCountResponse
+	(*CreateSupplierRequest)(nil),         // 57: stocks.CreateSupplierRequest
+	(*SupplierResponse)(nil),              // 58: stocks.SupplierResponse
+	(*ListSuppliersRequest)(nil),          // 59: stocks.ListSuppliersRequest
+	(*ListSuppliersResponse)(nil),         // 60: stocks.ListSuppliersResponse
+	(*PurchaseOrderLineRequest)(nil),      // 61: stocks.PurchaseOrderLineRequest
+	(*CreatePurchaseOrderRequest)(nil),    // 62: stocks.CreatePurchaseOrderRequest
+	(*PurchaseOrderRequest)(nil),          // 63: stocks.PurchaseOrderRequest
+	(*ReceivedLineRequest)(nil),           // 64: stocks.ReceivedLineRequest
+	(*ReceivePurchaseOrderRequest)(nil),   // 65: stocks.ReceivePurchaseOrderRequest
+	(*PurchaseOrderLine)(nil),             // 66: stocks.PurchaseOrderLine
+	(*PurchaseOrderResponse)(nil),         // 67: stocks.PurchaseOrderResponse
+	(*GetReorderSuggestionsRequest)(nil),  // 68: stocks.GetReorderSuggestionsRequest
+	(*ReorderSuggestion)(nil),             // 69: stocks.ReorderSuggestion
+	(*GetReorderSuggestionsResponse)(nil), // 70: stocks.GetReorderSuggestionsResponse
+	(*SchedulePriceChangeRequest)(nil),    // 71: stocks.SchedulePriceChangeRequest
+	(*ScheduledPriceChangeResponse)(nil),  // 72: stocks.ScheduledPriceChangeResponse
+	(*PriceTier)(nil),                     // 73: stocks.PriceTier
+	(*PriceTierRequest)(nil),              // 74: stocks.PriceTierRequest
+	(*SetPriceTiersRequest)(nil),          // 75: stocks.SetPriceTiersRequest
+	(*GetPriceTiersRequest)(nil),          // 76: stocks.GetPriceTiersRequest
+	(*PriceTiersResponse)(nil),            // 77: stocks.PriceTiersResponse
+	(*GetPriceHistoryRequest)(nil),        // 78: stocks.GetPriceHistoryRequest
+	(*PriceHistoryEntry)(nil),             // 79: stocks.PriceHistoryEntry
+	(*PriceHistoryResponse)(nil),          // 80: stocks.PriceHistoryResponse
+	(*InventoryValuationRequest)(nil),     // 81: stocks.InventoryValuationRequest
+	(*InventoryValuationRow)(nil),         // 82: stocks.InventoryValuationRow
+	nil,                                   // 83: stocks.FilterRequest.AttributesEntry
+	nil,                                   // 84: stocks.SearchSKUsRequest.AttributesEntry
+	(*timestamppb.Timestamp)(nil),         // 85: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),         // 86: google.protobuf.FieldMask
+	(*structpb.Struct)(nil),               // 87: google.protobuf.Struct
 }
 var file_stocks_proto_depIdxs = []int32{
-	5, // 0: stocks.ListStockItemsResponse.items:type_name -> stocks.StockItemResponse
-	1, // 1: stocks.StocksService.AddStockItem:input_type -> stocks.CreateStockItemRequest
-	2, // 2: stocks.StocksService.DeleteStockItem:input_type -> stocks.DeleteStockItemRequest
-	3, // 3: stocks.StocksService.GetStockItemBySKU:input_type -> stocks.GetStockItemRequest
-	4, // 4: stocks.StocksService.ListStockItemsByLocation:input_type -> stocks.FilterRequest
-	0, // 5: stocks.StocksService.AddStockItem:output_type -> stocks.GeneralResponse
-	0, // 6: stocks.StocksService.DeleteStockItem:output_type -> stocks.GeneralResponse
-	5, // 7: stocks.StocksService.GetStockItemBySKU:output_type -> stocks.StockItemResponse
-	6, // 8: stocks.StocksService.ListStockItemsByLocation:output_type -> stocks.ListStockItemsResponse
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	5,   // 0: stocks.CreateStockItemRequest.price:type_name -> stocks.Money
+	85,  // 1: stocks.CreateStockItemRequest.expires_at:type_name -> google.protobuf.Timestamp
+	85,  // 2: stocks.CreateStockItemRequest.received_at:type_name -> google.protobuf.Timestamp
+	5,   // 3: stocks.StockItemUpdate.price:type_name -> stocks.Money
+	8,   // 4: stocks.UpdateStockItemRequest.item:type_name -> stocks.StockItemUpdate
+	86,  // 5: stocks.UpdateStockItemRequest.update_mask:type_name -> google.protobuf.FieldMask
+	0,   // 6: stocks.GetStockItemRequest.offer_rule:type_name -> stocks.OfferRule
+	11,  // 7: stocks.GetStockItemsBySKUsRequest.items:type_name -> stocks.GetStockItemRequest
+	17,  // 8: stocks.GetStockItemsBySKUsResponse.items:type_name -> stocks.StockItemResponse
+	85,  // 9: stocks.StockChangeEvent.changed_at:type_name -> google.protobuf.Timestamp
+	5,   // 10: stocks.StockChangeEvent.price:type_name -> stocks.Money
+	83,  // 11: stocks.FilterRequest.attributes:type_name -> stocks.FilterRequest.AttributesEntry
+	17,  // 12: stocks.StockItemResponse.offers:type_name -> stocks.StockItemResponse
+	87,  // 13: stocks.StockItemResponse.attributes:type_name -> google.protobuf.Struct
+	5,   // 14: stocks.StockItemResponse.price:type_name -> stocks.Money
+	44,  // 15: stocks.StockItemResponse.backorder:type_name -> stocks.BackorderPolicy
+	85,  // 16: stocks.StockItemResponse.incoming_expected_at:type_name -> google.protobuf.Timestamp
+	5,   // 17: stocks.StockItemResponse.list_price:type_name -> stocks.Money
+	73,  // 18: stocks.StockItemResponse.price_tier:type_name -> stocks.PriceTier
+	17,  // 19: stocks.ListStockItemsResponse.items:type_name -> stocks.StockItemResponse
+	84,  // 20: stocks.SearchSKUsRequest.attributes:type_name -> stocks.SearchSKUsRequest.AttributesEntry
+	87,  // 21: stocks.SKUSearchResult.attributes:type_name -> google.protobuf.Struct
+	20,  // 22: stocks.SearchSKUsResponse.items:type_name -> stocks.SKUSearchResult
+	21,  // 23: stocks.SearchSKUsResponse.facets:type_name -> stocks.TypeFacet
+	24,  // 24: stocks.SetBundleRequest.components:type_name -> stocks.BundleComponent
+	24,  // 25: stocks.BundleResponse.components:type_name -> stocks.BundleComponent
+	1,   // 26: stocks.AttributeDefinition.type:type_name -> stocks.AttributeType
+	28,  // 27: stocks.SetAttributeSchemaRequest.attributes:type_name -> stocks.AttributeDefinition
+	87,  // 28: stocks.SKUResponse.attributes:type_name -> google.protobuf.Struct
+	32,  // 29: stocks.VariantGroupResponse.variants:type_name -> stocks.SKUResponse
+	87,  // 30: stocks.UpdateSKUAttributesRequest.attributes:type_name -> google.protobuf.Struct
+	17,  // 31: stocks.LowStockItemResponse.item:type_name -> stocks.StockItemResponse
+	36,  // 32: stocks.ListLowStockResponse.items:type_name -> stocks.LowStockItemResponse
+	2,   // 33: stocks.AdjustStockRequest.reason:type_name -> stocks.AdjustmentReason
+	40,  // 34: stocks.AdjustStockResponse.lots:type_name -> stocks.LotAllocation
+	85,  // 35: stocks.LotAllocation.expires_at:type_name -> google.protobuf.Timestamp
+	85,  // 36: stocks.StockLotResponse.received_at:type_name -> google.protobuf.Timestamp
+	85,  // 37: stocks.StockLotResponse.expires_at:type_name -> google.protobuf.Timestamp
+	42,  // 38: stocks.ListExpiringLotsResponse.lots:type_name -> stocks.StockLotResponse
+	85,  // 39: stocks.BackorderPolicy.restock_at:type_name -> google.protobuf.Timestamp
+	44,  // 40: stocks.BackorderSettingsRequest.policy:type_name -> stocks.BackorderPolicy
+	85,  // 41: stocks.StockTransferResponse.shipped_at:type_name -> google.protobuf.Timestamp
+	85,  // 42: stocks.StockTransferResponse.received_at:type_name -> google.protobuf.Timestamp
+	50,  // 43: stocks.SubmitCycleCountsRequest.counts:type_name -> stocks.CountedQuantity
+	85,  // 44: stocks.CycleCountLine.counted_at:type_name -> google.protobuf.Timestamp
+	85,  // 45: stocks.CycleCountEvent.created_at:type_name -> google.protobuf.Timestamp
+	85,  // 46: stocks.CycleCountResponse.opened_at:type_name -> google.protobuf.Timestamp
+	85,  // 47: stocks.CycleCountResponse.approved_at:type_name -> google.protobuf.Timestamp
+	54,  // 48: stocks.CycleCountResponse.lines:type_name -> stocks.CycleCountLine
+	55,  // 49: stocks.CycleCountResponse.events:type_name -> stocks.CycleCountEvent
+	85,  // 50: stocks.SupplierResponse.created_at:type_name -> google.protobuf.Timestamp
+	58,  // 51: stocks.ListSuppliersResponse.suppliers:type_name -> stocks.SupplierResponse
+	5,   // 52: stocks.PurchaseOrderLineRequest.price:type_name -> stocks.Money
+	85,  // 53: stocks.CreatePurchaseOrderRequest.expected_at:type_name -> google.protobuf.Timestamp
+	61,  // 54: stocks.CreatePurchaseOrderRequest.lines:type_name -> stocks.PurchaseOrderLineRequest
+	85,  // 55: stocks.ReceivedLineRequest.expires_at:type_name -> google.protobuf.Timestamp
+	64,  // 56: stocks.ReceivePurchaseOrderRequest.lines:type_name -> stocks.ReceivedLineRequest
+	5,   // 57: stocks.PurchaseOrderLine.price:type_name -> stocks.Money
+	85,  // 58: stocks.PurchaseOrderResponse.created_at:type_name -> google.protobuf.Timestamp
+	85,  // 59: stocks.PurchaseOrderResponse.ordered_at:type_name -> google.protobuf.Timestamp
+	85,  // 60: stocks.PurchaseOrderResponse.expected_at:type_name -> google.protobuf.Timestamp
+	85,  // 61: stocks.PurchaseOrderResponse.received_at:type_name -> google.protobuf.Timestamp
+	66,  // 62: stocks.PurchaseOrderResponse.lines:type_name -> stocks.PurchaseOrderLine
+	69,  // 63: stocks.GetReorderSuggestionsResponse.suggestions:type_name -> stocks.ReorderSuggestion
+	85,  // 64: stocks.SchedulePriceChangeRequest.effective_at:type_name -> google.protobuf.Timestamp
+	5,   // 65: stocks.SchedulePriceChangeRequest.new_price:type_name -> stocks.Money
+	85,  // 66: stocks.ScheduledPriceChangeResponse.effective_at:type_name -> google.protobuf.Timestamp
+	5,   // 67: stocks.ScheduledPriceChangeResponse.new_price:type_name -> stocks.Money
+	5,   // 68: stocks.PriceTier.price:type_name -> stocks.Money
+	5,   // 69: stocks.PriceTierRequest.price:type_name -> stocks.Money
+	74,  // 70: stocks.SetPriceTiersRequest.tiers:type_name -> stocks.PriceTierRequest
+	73,  // 71: stocks.PriceTiersResponse.tiers:type_name -> stocks.PriceTier
+	85,  // 72: stocks.PriceHistoryEntry.changed_at:type_name -> google.protobuf.Timestamp
+	5,   // 73: stocks.PriceHistoryEntry.old_price:type_name -> stocks.Money
+	5,   // 74: stocks.PriceHistoryEntry.new_price:type_name -> stocks.Money
+	79,  // 75: stocks.PriceHistoryResponse.entries:type_name -> stocks.PriceHistoryEntry
+	72,  // 76: stocks.PriceHistoryResponse.scheduled:type_name -> stocks.ScheduledPriceChangeResponse
+	3,   // 77: stocks.InventoryValuationRequest.group_by:type_name -> stocks.ValuationDimension
+	85,  // 78: stocks.InventoryValuationRequest.as_of:type_name -> google.protobuf.Timestamp
+	5,   // 79: stocks.InventoryValuationRow.value:type_name -> stocks.Money
+	6,   // 80: stocks.StocksService.AddStockItem:input_type -> stocks.CreateStockItemRequest
+	10,  // 81: stocks.StocksService.DeleteStockItem:input_type -> stocks.DeleteStockItemRequest
+	7,   // 82: stocks.StocksService.RestoreStockItem:input_type -> stocks.RestoreStockItemRequest
+	9,   // 83: stocks.StocksService.UpdateStockItem:input_type -> stocks.UpdateStockItemRequest
+	11,  // 84: stocks.StocksService.GetStockItemBySKU:input_type -> stocks.GetStockItemRequest
+	12,  // 85: stocks.StocksService.GetStockItemsBySKUs:input_type -> stocks.GetStockItemsBySKUsRequest
+	14,  // 86: stocks.StocksService.WatchStock:input_type -> stocks.WatchStockRequest
+	16,  // 87: stocks.StocksService.ListStockItemsByLocation:input_type -> stocks.FilterRequest
+	19,  // 88: stocks.StocksService.SearchSKUs:input_type -> stocks.SearchSKUsRequest
+	23,  // 89: stocks.StocksService.SetStockThreshold:input_type -> stocks.SetStockThresholdRequest
+	35,  // 90: stocks.StocksService.ListLowStock:input_type -> stocks.ListLowStockRequest
+	38,  // 91: stocks.StocksService.AdjustStock:input_type -> stocks.AdjustStockRequest
+	41,  // 92: stocks.StocksService.ListExpiringLots:input_type -> stocks.ListExpiringLotsRequest
+	45,  // 93: stocks.StocksService.UpdateBackorderSettings:input_type -> stocks.BackorderSettingsRequest
+	46,  // 94: stocks.StocksService.TransferStock:input_type -> stocks.TransferStockRequest
+	47,  // 95: stocks.StocksService.ReceiveStockTransfer:input_type -> stocks.ReceiveStockTransferRequest
+	49,  // 96: stocks.StocksService.OpenCycleCount:input_type -> stocks.OpenCycleCountRequest
+	51,  // 97: stocks.StocksService.SubmitCycleCounts:input_type -> stocks.SubmitCycleCountsRequest
+	52,  // 98: stocks.StocksService.GetCycleCountVariances:input_type -> stocks.GetCycleCountRequest
+	53,  // 99: stocks.StocksService.ApproveCycleCount:input_type -> stocks.ApproveCycleCountRequest
+	57,  // 100: stocks.StocksService.CreateSupplier:input_type -> stocks.CreateSupplierRequest
+	59,  // 101: stocks.StocksService.ListSuppliers:input_type -> stocks.ListSuppliersRequest
+	62,  // 102: stocks.StocksService.CreatePurchaseOrder:input_type -> stocks.CreatePurchaseOrderRequest
+	63,  // 103: stocks.StocksService.PlacePurchaseOrder:input_type -> stocks.PurchaseOrderRequest
+	65,  // 104: stocks.StocksService.ReceivePurchaseOrder:input_type -> stocks.ReceivePurchaseOrderRequest
+	63,  // 105: stocks.StocksService.GetPurchaseOrder:input_type -> stocks.PurchaseOrderRequest
+	68,  // 106: stocks.StocksService.GetReorderSuggestions:input_type -> stocks.GetReorderSuggestionsRequest
+	71,  // 107: stocks.StocksService.SchedulePriceChange:input_type -> stocks.SchedulePriceChangeRequest
+	78,  // 108: stocks.StocksService.GetPriceHistory:input_type -> stocks.GetPriceHistoryRequest
+	75,  // 109: stocks.StocksService.SetPriceTiers:input_type -> stocks.SetPriceTiersRequest
+	76,  // 110: stocks.StocksService.GetPriceTiers:input_type -> stocks.GetPriceTiersRequest
+	25,  // 111: stocks.StocksService.SetBundle:input_type -> stocks.SetBundleRequest
+	26,  // 112: stocks.StocksService.GetBundle:input_type -> stocks.GetBundleRequest
+	29,  // 113: stocks.StocksService.SetAttributeSchema:input_type -> stocks.SetAttributeSchemaRequest
+	30,  // 114: stocks.StocksService.CreateVariantGroup:input_type -> stocks.CreateVariantGroupRequest
+	31,  // 115: stocks.StocksService.GetVariantGroup:input_type -> stocks.GetVariantGroupRequest
+	34,  // 116: stocks.StocksService.UpdateSKUAttributes:input_type -> stocks.UpdateSKUAttributesRequest
+	81,  // 117: stocks.StocksService.GetInventoryValuation:input_type -> stocks.InventoryValuationRequest
+	4,   // 118: stocks.StocksService.AddStockItem:output_type -> stocks.GeneralResponse
+	4,   // 119: stocks.StocksService.DeleteStockItem:output_type -> stocks.GeneralResponse
+	17,  // 120: stocks.StocksService.RestoreStockItem:output_type -> stocks.StockItemResponse
+	17,  // 121: stocks.StocksService.UpdateStockItem:output_type -> stocks.StockItemResponse
+	17,  // 122: stocks.StocksService.GetStockItemBySKU:output_type -> stocks.StockItemResponse
+	13,  // 123: stocks.StocksService.GetStockItemsBySKUs:output_type -> stocks.GetStockItemsBySKUsResponse
+	15,  // 124: stocks.StocksService.WatchStock:output_type -> stocks.StockChangeEvent
+	18,  // 125: stocks.StocksService.ListStockItemsByLocation:output_type -> stocks.ListStockItemsResponse
+	22,  // 126: stocks.StocksService.SearchSKUs:output_type -> stocks.SearchSKUsResponse
+	4,   // 127: stocks.StocksService.SetStockThreshold:output_type -> stocks.GeneralResponse
+	37,  // 128: stocks.StocksService.ListLowStock:output_type -> stocks.ListLowStockResponse
+	39,  // 129: stocks.StocksService.AdjustStock:output_type -> stocks.AdjustStockResponse
+	43,  // 130: stocks.StocksService.ListExpiringLots:output_type -> stocks.ListExpiringLotsResponse
+	4,   // 131: stocks.StocksService.UpdateBackorderSettings:output_type -> stocks.GeneralResponse
+	48,  // 132: stocks.StocksService.TransferStock:output_type -> stocks.StockTransferResponse
+	48,  // 133: stocks.StocksService.ReceiveStockTransfer:output_type -> stocks.StockTransferResponse
+	56,  // 134: stocks.StocksService.OpenCycleCount:output_type -> stocks.CycleCountResponse
+	56,  // 135: stocks.StocksService.SubmitCycleCounts:output_type -> stocks.CycleCountResponse
+	56,  // 136: stocks.StocksService.GetCycleCountVariances:output_type -> stocks.CycleCountResponse
+	56,  // 137: stocks.StocksService.ApproveCycleCount:output_type -> stocks.CycleCountResponse
+	58,  // 138: stocks.StocksService.CreateSupplier:output_type -> stocks.SupplierResponse
+	60,  // 139: stocks.StocksService.ListSuppliers:output_type -> stocks.ListSuppliersResponse
+	67,  // 140: stocks.StocksService.CreatePurchaseOrder:output_type -> stocks.PurchaseOrderResponse
+	67,  // 141: stocks.StocksService.PlacePurchaseOrder:output_type -> stocks.PurchaseOrderResponse
+	67,  // 142: stocks.StocksService.ReceivePurchaseOrder:output_type -> stocks.PurchaseOrderResponse
+	67,  // 143: stocks.StocksService.GetPurchaseOrder:output_type -> stocks.PurchaseOrderResponse
+	70,  // 144: stocks.StocksService.GetReorderSuggestions:output_type -> stocks.GetReorderSuggestionsResponse
+	72,  // 145: stocks.StocksService.SchedulePriceChange:output_type -> stocks.ScheduledPriceChangeResponse
+	80,  // 146: stocks.StocksService.GetPriceHistory:output_type -> stocks.PriceHistoryResponse
+	77,  // 147: stocks.StocksService.SetPriceTiers:output_type -> stocks.PriceTiersResponse
+	77,  // 148: stocks.StocksService.GetPriceTiers:output_type -> stocks.PriceTiersResponse
+	4,   // 149: stocks.StocksService.SetBundle:output_type -> stocks.GeneralResponse
+	27,  // 150: stocks.StocksService.GetBundle:output_type -> stocks.BundleResponse
+	4,   // 151: stocks.StocksService.SetAttributeSchema:output_type -> stocks.GeneralResponse
+	33,  // 152: stocks.StocksService.CreateVariantGroup:output_type -> stocks.VariantGroupResponse
+	33,  // 153: stocks.StocksService.GetVariantGroup:output_type -> stocks.VariantGroupResponse
+	32,  // 154: stocks.StocksService.UpdateSKUAttributes:output_type -> stocks.SKUResponse
+	82,  // 155: stocks.StocksService.GetInventoryValuation:output_type -> stocks.InventoryValuationRow
+	118, // [118:156] is the sub-list for method output_type
+	80,  // [80:118] is the sub-list for method input_type
+	80,  // [80:80] is the sub-list for extension type_name
+	80,  // [80:80] is the sub-list for extension extendee
+	0,   // [0:80] is the sub-list for field type_name
 }
 
 func init() { file_stocks_proto_init() }
@@ -552,13 +6824,14 @@ func file_stocks_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_stocks_proto_rawDesc), len(file_stocks_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      4,
+			NumMessages:   81,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_stocks_proto_goTypes,
 		DependencyIndexes: file_stocks_proto_depIdxs,
+		EnumInfos:         file_stocks_proto_enumTypes,
 		MessageInfos:      file_stocks_proto_msgTypes,
 	}.Build()
 	File_stocks_proto = out.File
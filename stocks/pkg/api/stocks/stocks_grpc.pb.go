@@ -21,8 +21,42 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	StocksService_AddStockItem_FullMethodName             = "/stocks.StocksService/AddStockItem"
 	StocksService_DeleteStockItem_FullMethodName          = "/stocks.StocksService/DeleteStockItem"
+	StocksService_RestoreStockItem_FullMethodName         = "/stocks.StocksService/RestoreStockItem"
+	StocksService_UpdateStockItem_FullMethodName          = "/stocks.StocksService/UpdateStockItem"
 	StocksService_GetStockItemBySKU_FullMethodName        = "/stocks.StocksService/GetStockItemBySKU"
+	StocksService_GetStockItemsBySKUs_FullMethodName      = "/stocks.StocksService/GetStockItemsBySKUs"
+	StocksService_WatchStock_FullMethodName               = "/stocks.StocksService/WatchStock"
 	StocksService_ListStockItemsByLocation_FullMethodName = "/stocks.StocksService/ListStockItemsByLocation"
+	StocksService_SearchSKUs_FullMethodName               = "/stocks.StocksService/SearchSKUs"
+	StocksService_SetStockThreshold_FullMethodName        = "/stocks.StocksService/SetStockThreshold"
+	StocksService_ListLowStock_FullMethodName             = "/stocks.StocksService/ListLowStock"
+	StocksService_AdjustStock_FullMethodName              = "/stocks.StocksService/AdjustStock"
+	StocksService_ListExpiringLots_FullMethodName         = "/stocks.StocksService/ListExpiringLots"
+	StocksService_UpdateBackorderSettings_FullMethodName  = "/stocks.StocksService/UpdateBackorderSettings"
+	StocksService_TransferStock_FullMethodName            = "/stocks.StocksService/TransferStock"
+	StocksService_ReceiveStockTransfer_FullMethodName     = "/stocks.StocksService/ReceiveStockTransfer"
+	StocksService_OpenCycleCount_FullMethodName           = "/stocks.StocksService/OpenCycleCount"
+	StocksService_SubmitCycleCounts_FullMethodName        = "/stocks.StocksService/SubmitCycleCounts"
+	StocksService_GetCycleCountVariances_FullMethodName   = "/stocks.StocksService/GetCycleCountVariances"
+	StocksService_ApproveCycleCount_FullMethodName        = "/stocks.StocksService/ApproveCycleCount"
+	StocksService_CreateSupplier_FullMethodName           = "/stocks.StocksService/CreateSupplier"
+	StocksService_ListSuppliers_FullMethodName            = "/stocks.StocksService/ListSuppliers"
+	StocksService_CreatePurchaseOrder_FullMethodName      = "/stocks.StocksService/CreatePurchaseOrder"
+	StocksService_PlacePurchaseOrder_FullMethodName       = "/stocks.StocksService/PlacePurchaseOrder"
+	StocksService_ReceivePurchaseOrder_FullMethodName     = "/stocks.StocksService/ReceivePurchaseOrder"
+	StocksService_GetPurchaseOrder_FullMethodName         = "/stocks.StocksService/GetPurchaseOrder"
+	StocksService_GetReorderSuggestions_FullMethodName    = "/stocks.StocksService/GetReorderSuggestions"
+	StocksService_SchedulePriceChange_FullMethodName      = "/stocks.StocksService/SchedulePriceChange"
+	StocksService_GetPriceHistory_FullMethodName          = "/stocks.StocksService/GetPriceHistory"
+	StocksService_SetPriceTiers_FullMethodName            = "/stocks.StocksService/SetPriceTiers"
+	StocksService_GetPriceTiers_FullMethodName            = "/stocks.StocksService/GetPriceTiers"
+	StocksService_SetBundle_FullMethodName                = "/stocks.StocksService/SetBundle"
+	StocksService_GetBundle_FullMethodName                = "/stocks.StocksService/GetBundle"
+	StocksService_SetAttributeSchema_FullMethodName       = "/stocks.StocksService/SetAttributeSchema"
+	StocksService_CreateVariantGroup_FullMethodName       = "/stocks.StocksService/CreateVariantGroup"
+	StocksService_GetVariantGroup_FullMethodName          = "/stocks.StocksService/GetVariantGroup"
+	StocksService_UpdateSKUAttributes_FullMethodName      = "/stocks.StocksService/UpdateSKUAttributes"
+	StocksService_GetInventoryValuation_FullMethodName    = "/stocks.StocksService/GetInventoryValuation"
 )
 
 // StocksServiceClient is the client API for StocksService service.
@@ -31,8 +65,42 @@ const (
 type StocksServiceClient interface {
 	AddStockItem(ctx context.Context, in *CreateStockItemRequest, opts ...grpc.CallOption) (*GeneralResponse, error)
 	DeleteStockItem(ctx context.Context, in *DeleteStockItemRequest, opts ...grpc.CallOption) (*GeneralResponse, error)
+	RestoreStockItem(ctx context.Context, in *RestoreStockItemRequest, opts ...grpc.CallOption) (*StockItemResponse, error)
+	UpdateStockItem(ctx context.Context, in *UpdateStockItemRequest, opts ...grpc.CallOption) (*StockItemResponse, error)
 	GetStockItemBySKU(ctx context.Context, in *GetStockItemRequest, opts ...grpc.CallOption) (*StockItemResponse, error)
+	GetStockItemsBySKUs(ctx context.Context, in *GetStockItemsBySKUsRequest, opts ...grpc.CallOption) (*GetStockItemsBySKUsResponse, error)
+	WatchStock(ctx context.Context, in *WatchStockRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StockChangeEvent], error)
 	ListStockItemsByLocation(ctx context.Context, in *FilterRequest, opts ...grpc.CallOption) (*ListStockItemsResponse, error)
+	SearchSKUs(ctx context.Context, in *SearchSKUsRequest, opts ...grpc.CallOption) (*SearchSKUsResponse, error)
+	SetStockThreshold(ctx context.Context, in *SetStockThresholdRequest, opts ...grpc.CallOption) (*GeneralResponse, error)
+	ListLowStock(ctx context.Context, in *ListLowStockRequest, opts ...grpc.CallOption) (*ListLowStockResponse, error)
+	AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*AdjustStockResponse, error)
+	ListExpiringLots(ctx context.Context, in *ListExpiringLotsRequest, opts ...grpc.CallOption) (*ListExpiringLotsResponse, error)
+	UpdateBackorderSettings(ctx context.Context, in *BackorderSettingsRequest, opts ...grpc.CallOption) (*GeneralResponse, error)
+	TransferStock(ctx context.Context, in *TransferStockRequest, opts ...grpc.CallOption) (*StockTransferResponse, error)
+	ReceiveStockTransfer(ctx context.Context, in *ReceiveStockTransferRequest, opts ...grpc.CallOption) (*StockTransferResponse, error)
+	OpenCycleCount(ctx context.Context, in *OpenCycleCountRequest, opts ...grpc.CallOption) (*CycleCountResponse, error)
+	SubmitCycleCounts(ctx context.Context, in *SubmitCycleCountsRequest, opts ...grpc.CallOption) (*CycleCountResponse, error)
+	GetCycleCountVariances(ctx context.Context, in *GetCycleCountRequest, opts ...grpc.CallOption) (*CycleCountResponse, error)
+	ApproveCycleCount(ctx context.Context, in *ApproveCycleCountRequest, opts ...grpc.CallOption) (*CycleCountResponse, error)
+	CreateSupplier(ctx context.Context, in *CreateSupplierRequest, opts ...grpc.CallOption) (*SupplierResponse, error)
+	ListSuppliers(ctx context.Context, in *ListSuppliersRequest, opts ...grpc.CallOption) (*ListSuppliersResponse, error)
+	CreatePurchaseOrder(ctx context.Context, in *CreatePurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrderResponse, error)
+	PlacePurchaseOrder(ctx context.Context, in *PurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrderResponse, error)
+	ReceivePurchaseOrder(ctx context.Context, in *ReceivePurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrderResponse, error)
+	GetPurchaseOrder(ctx context.Context, in *PurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrderResponse, error)
+	GetReorderSuggestions(ctx context.Context, in *GetReorderSuggestionsRequest, opts ...grpc.CallOption) (*GetReorderSuggestionsResponse, error)
+	SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, opts ...grpc.CallOption) (*ScheduledPriceChangeResponse, error)
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*PriceHistoryResponse, error)
+	SetPriceTiers(ctx context.Context, in *SetPriceTiersRequest, opts ...grpc.CallOption) (*PriceTiersResponse, error)
+	GetPriceTiers(ctx context.Context, in *GetPriceTiersRequest, opts ...grpc.CallOption) (*PriceTiersResponse, error)
+	SetBundle(ctx context.Context, in *SetBundleRequest, opts ...grpc.CallOption) (*GeneralResponse, error)
+	GetBundle(ctx context.Context, in *GetBundleRequest, opts ...grpc.CallOption) (*BundleResponse, error)
+	SetAttributeSchema(ctx context.Context, in *SetAttributeSchemaRequest, opts ...grpc.CallOption) (*GeneralResponse, error)
+	CreateVariantGroup(ctx context.Context, in *CreateVariantGroupRequest, opts ...grpc.CallOption) (*VariantGroupResponse, error)
+	GetVariantGroup(ctx context.Context, in *GetVariantGroupRequest, opts ...grpc.CallOption) (*VariantGroupResponse, error)
+	UpdateSKUAttributes(ctx context.Context, in *UpdateSKUAttributesRequest, opts ...grpc.CallOption) (*SKUResponse, error)
+	GetInventoryValuation(ctx context.Context, in *InventoryValuationRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[InventoryValuationRow], error)
 }
 
 type stocksServiceClient struct {
@@ -63,6 +131,26 @@ func (c *stocksServiceClient) DeleteStockItem(ctx context.Context, in *DeleteSto
 	return out, nil
 }
 
+func (c *stocksServiceClient) RestoreStockItem(ctx context.Context, in *RestoreStockItemRequest, opts ...grpc.CallOption) (*StockItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockItemResponse)
+	err := c.cc.Invoke(ctx, StocksService_RestoreStockItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) UpdateStockItem(ctx context.Context, in *UpdateStockItemRequest, opts ...grpc.CallOption) (*StockItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockItemResponse)
+	err := c.cc.Invoke(ctx, StocksService_UpdateStockItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *stocksServiceClient) GetStockItemBySKU(ctx context.Context, in *GetStockItemRequest, opts ...grpc.CallOption) (*StockItemResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StockItemResponse)
@@ -70,142 +158,1214 @@ func (c *stocksServiceClient) GetStockItemBySKU(ctx context.Context, in *GetStoc
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *stocksServiceClient) GetStockItemsBySKUs(ctx context.Context, in *GetStockItemsBySKUsRequest, opts ...grpc.CallOption) (*GetStockItemsBySKUsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStockItemsBySKUsResponse)
+	err := c.cc.Invoke(ctx, StocksService_GetStockItemsBySKUs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) WatchStock(ctx context.Context, in *WatchStockRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StockChangeEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StocksService_ServiceDesc.Streams[0], StocksService_WatchStock_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchStockRequest, StockChangeEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StocksService_WatchStockClient = grpc.ServerStreamingClient[StockChangeEvent]
+
+func (c *stocksServiceClient) ListStockItemsByLocation(ctx context.Context, in *FilterRequest, opts ...grpc.CallOption) (*ListStockItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStockItemsResponse)
+	err := c.cc.Invoke(ctx, StocksService_ListStockItemsByLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) SearchSKUs(ctx context.Context, in *SearchSKUsRequest, opts ...grpc.CallOption) (*SearchSKUsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchSKUsResponse)
+	err := c.cc.Invoke(ctx, StocksService_SearchSKUs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) SetStockThreshold(ctx context.Context, in *SetStockThresholdRequest, opts ...grpc.CallOption) (*GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneralResponse)
+	err := c.cc.Invoke(ctx, StocksService_SetStockThreshold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) ListLowStock(ctx context.Context, in *ListLowStockRequest, opts ...grpc.CallOption) (*ListLowStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLowStockResponse)
+	err := c.cc.Invoke(ctx, StocksService_ListLowStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*AdjustStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdjustStockResponse)
+	err := c.cc.Invoke(ctx, StocksService_AdjustStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) ListExpiringLots(ctx context.Context, in *ListExpiringLotsRequest, opts ...grpc.CallOption) (*ListExpiringLotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExpiringLotsResponse)
+	err := c.cc.Invoke(ctx, StocksService_ListExpiringLots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) UpdateBackorderSettings(ctx context.Context, in *BackorderSettingsRequest, opts ...grpc.CallOption) (*GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneralResponse)
+	err := c.cc.Invoke(ctx, StocksService_UpdateBackorderSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) TransferStock(ctx context.Context, in *TransferStockRequest, opts ...grpc.CallOption) (*StockTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockTransferResponse)
+	err := c.cc.Invoke(ctx, StocksService_TransferStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) ReceiveStockTransfer(ctx context.Context, in *ReceiveStockTransferRequest, opts ...grpc.CallOption) (*StockTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockTransferResponse)
+	err := c.cc.Invoke(ctx, StocksService_ReceiveStockTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) OpenCycleCount(ctx context.Context, in *OpenCycleCountRequest, opts ...grpc.CallOption) (*CycleCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CycleCountResponse)
+	err := c.cc.Invoke(ctx, StocksService_OpenCycleCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) SubmitCycleCounts(ctx context.Context, in *SubmitCycleCountsRequest, opts ...grpc.CallOption) (*CycleCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CycleCountResponse)
+	err := c.cc.Invoke(ctx, StocksService_SubmitCycleCounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) GetCycleCountVariances(ctx context.Context, in *GetCycleCountRequest, opts ...grpc.CallOption) (*CycleCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CycleCountResponse)
+	err := c.cc.Invoke(ctx, StocksService_GetCycleCountVariances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) ApproveCycleCount(ctx context.Context, in *ApproveCycleCountRequest, opts ...grpc.CallOption) (*CycleCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CycleCountResponse)
+	err := c.cc.Invoke(ctx, StocksService_ApproveCycleCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) CreateSupplier(ctx context.Context, in *CreateSupplierRequest, opts ...grpc.CallOption) (*SupplierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SupplierResponse)
+	err := c.cc.Invoke(ctx, StocksService_CreateSupplier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) ListSuppliers(ctx context.Context, in *ListSuppliersRequest, opts ...grpc.CallOption) (*ListSuppliersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSuppliersResponse)
+	err := c.cc.Invoke(ctx, StocksService_ListSuppliers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) CreatePurchaseOrder(ctx context.Context, in *CreatePurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, StocksService_CreatePurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) PlacePurchaseOrder(ctx context.Context, in *PurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, StocksService_PlacePurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) ReceivePurchaseOrder(ctx context.Context, in *ReceivePurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, StocksService_ReceivePurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) GetPurchaseOrder(ctx context.Context, in *PurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, StocksService_GetPurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) GetReorderSuggestions(ctx context.Context, in *GetReorderSuggestionsRequest, opts ...grpc.CallOption) (*GetReorderSuggestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReorderSuggestionsResponse)
+	err := c.cc.Invoke(ctx, StocksService_GetReorderSuggestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, opts ...grpc.CallOption) (*ScheduledPriceChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduledPriceChangeResponse)
+	err := c.cc.Invoke(ctx, StocksService_SchedulePriceChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*PriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceHistoryResponse)
+	err := c.cc.Invoke(ctx, StocksService_GetPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) SetPriceTiers(ctx context.Context, in *SetPriceTiersRequest, opts ...grpc.CallOption) (*PriceTiersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceTiersResponse)
+	err := c.cc.Invoke(ctx, StocksService_SetPriceTiers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) GetPriceTiers(ctx context.Context, in *GetPriceTiersRequest, opts ...grpc.CallOption) (*PriceTiersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceTiersResponse)
+	err := c.cc.Invoke(ctx, StocksService_GetPriceTiers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) SetBundle(ctx context.Context, in *SetBundleRequest, opts ...grpc.CallOption) (*GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneralResponse)
+	err := c.cc.Invoke(ctx, StocksService_SetBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) GetBundle(ctx context.Context, in *GetBundleRequest, opts ...grpc.CallOption) (*BundleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BundleResponse)
+	err := c.cc.Invoke(ctx, StocksService_GetBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) SetAttributeSchema(ctx context.Context, in *SetAttributeSchemaRequest, opts ...grpc.CallOption) (*GeneralResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GeneralResponse)
+	err := c.cc.Invoke(ctx, StocksService_SetAttributeSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) CreateVariantGroup(ctx context.Context, in *CreateVariantGroupRequest, opts ...grpc.CallOption) (*VariantGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VariantGroupResponse)
+	err := c.cc.Invoke(ctx, StocksService_CreateVariantGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) GetVariantGroup(ctx context.Context, in *GetVariantGroupRequest, opts ...grpc.CallOption) (*VariantGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VariantGroupResponse)
+	err := c.cc.Invoke(ctx, StocksService_GetVariantGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) UpdateSKUAttributes(ctx context.Context, in *UpdateSKUAttributesRequest, opts ...grpc.CallOption) (*SKUResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SKUResponse)
+	err := c.cc.Invoke(ctx, StocksService_UpdateSKUAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stocksServiceClient) GetInventoryValuation(ctx context.Context, in *InventoryValuationRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[InventoryValuationRow], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &StocksService_ServiceDesc.Streams[1], StocksService_GetInventoryValuation_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[InventoryValuationRequest, InventoryValuationRow]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StocksService_GetInventoryValuationClient = grpc.ServerStreamingClient[InventoryValuationRow]
+
+// StocksServiceServer is the server API for StocksService service.
+// All implementations must embed UnimplementedStocksServiceServer
+// for forward compatibility.
+type StocksServiceServer interface {
+	AddStockItem(context.Context, *CreateStockItemRequest) (*GeneralResponse, error)
+	DeleteStockItem(context.Context, *DeleteStockItemRequest) (*GeneralResponse, error)
+	RestoreStockItem(context.Context, *RestoreStockItemRequest) (*StockItemResponse, error)
+	UpdateStockItem(context.Context, *UpdateStockItemRequest) (*StockItemResponse, error)
+	GetStockItemBySKU(context.Context, *GetStockItemRequest) (*StockItemResponse, error)
+	GetStockItemsBySKUs(context.Context, *GetStockItemsBySKUsRequest) (*GetStockItemsBySKUsResponse, error)
+	WatchStock(*WatchStockRequest, grpc.ServerStreamingServer[StockChangeEvent]) error
+	ListStockItemsByLocation(context.Context, *FilterRequest) (*ListStockItemsResponse, error)
+	SearchSKUs(context.Context, *SearchSKUsRequest) (*SearchSKUsResponse, error)
+	SetStockThreshold(context.Context, *SetStockThresholdRequest) (*GeneralResponse, error)
+	ListLowStock(context.Context, *ListLowStockRequest) (*ListLowStockResponse, error)
+	AdjustStock(context.Context, *AdjustStockRequest) (*AdjustStockResponse, error)
+	ListExpiringLots(context.Context, *ListExpiringLotsRequest) (*ListExpiringLotsResponse, error)
+	UpdateBackorderSettings(context.Context, *BackorderSettingsRequest) (*GeneralResponse, error)
+	TransferStock(context.Context, *TransferStockRequest) (*StockTransferResponse, error)
+	ReceiveStockTransfer(context.Context, *ReceiveStockTransferRequest) (*StockTransferResponse, error)
+	OpenCycleCount(context.Context, *OpenCycleCountRequest) (*CycleCountResponse, error)
+	SubmitCycleCounts(context.Context, *SubmitCycleCountsRequest) (*CycleCountResponse, error)
+	GetCycleCountVariances(context.Context, *GetCycleCountRequest) (*CycleCountResponse, error)
+	ApproveCycleCount(context.Context, *ApproveCycleCountRequest) (*CycleCountResponse, error)
+	CreateSupplier(context.Context, *CreateSupplierRequest) (*SupplierResponse, error)
+	ListSuppliers(context.Context, *ListSuppliersRequest) (*ListSuppliersResponse, error)
+	CreatePurchaseOrder(context.Context, *CreatePurchaseOrderRequest) (*PurchaseOrderResponse, error)
+	PlacePurchaseOrder(context.Context, *PurchaseOrderRequest) (*PurchaseOrderResponse, error)
+	ReceivePurchaseOrder(context.Context, *ReceivePurchaseOrderRequest) (*PurchaseOrderResponse, error)
+	GetPurchaseOrder(context.Context, *PurchaseOrderRequest) (*PurchaseOrderResponse, error)
+	GetReorderSuggestions(context.Context, *GetReorderSuggestionsRequest) (*GetReorderSuggestionsResponse, error)
+	SchedulePriceChange(context.Context, *SchedulePriceChangeRequest) (*ScheduledPriceChangeResponse, error)
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*PriceHistoryResponse, error)
+	SetPriceTiers(context.Context, *SetPriceTiersRequest) (*PriceTiersResponse, error)
+	GetPriceTiers(context.Context, *GetPriceTiersRequest) (*PriceTiersResponse, error)
+	SetBundle(context.Context, *SetBundleRequest) (*GeneralResponse, error)
+	GetBundle(context.Context, *GetBundleRequest) (*BundleResponse, error)
+	SetAttributeSchema(context.Context, *SetAttributeSchemaRequest) (*GeneralResponse, error)
+	CreateVariantGroup(context.Context, *CreateVariantGroupRequest) (*VariantGroupResponse, error)
+	GetVariantGroup(context.Context, *GetVariantGroupRequest) (*VariantGroupResponse, error)
+	UpdateSKUAttributes(context.Context, *UpdateSKUAttributesRequest) (*SKUResponse, error)
+	GetInventoryValuation(*InventoryValuationRequest, grpc.ServerStreamingServer[InventoryValuationRow]) error
+	mustEmbedUnimplementedStocksServiceServer()
+}
+
+// UnimplementedStocksServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStocksServiceServer struct{}
+
+func (UnimplementedStocksServiceServer) AddStockItem(context.Context, *CreateStockItemRequest) (*GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddStockItem not implemented")
+}
+func (UnimplementedStocksServiceServer) DeleteStockItem(context.Context, *DeleteStockItemRequest) (*GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStockItem not implemented")
+}
+func (UnimplementedStocksServiceServer) RestoreStockItem(context.Context, *RestoreStockItemRequest) (*StockItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreStockItem not implemented")
+}
+func (UnimplementedStocksServiceServer) UpdateStockItem(context.Context, *UpdateStockItemRequest) (*StockItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStockItem not implemented")
+}
+func (UnimplementedStocksServiceServer) GetStockItemBySKU(context.Context, *GetStockItemRequest) (*StockItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStockItemBySKU not implemented")
+}
+func (UnimplementedStocksServiceServer) GetStockItemsBySKUs(context.Context, *GetStockItemsBySKUsRequest) (*GetStockItemsBySKUsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStockItemsBySKUs not implemented")
+}
+func (UnimplementedStocksServiceServer) WatchStock(*WatchStockRequest, grpc.ServerStreamingServer[StockChangeEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStock not implemented")
+}
+func (UnimplementedStocksServiceServer) ListStockItemsByLocation(context.Context, *FilterRequest) (*ListStockItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStockItemsByLocation not implemented")
+}
+func (UnimplementedStocksServiceServer) SearchSKUs(context.Context, *SearchSKUsRequest) (*SearchSKUsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchSKUs not implemented")
+}
+func (UnimplementedStocksServiceServer) SetStockThreshold(context.Context, *SetStockThresholdRequest) (*GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStockThreshold not implemented")
+}
+func (UnimplementedStocksServiceServer) ListLowStock(context.Context, *ListLowStockRequest) (*ListLowStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLowStock not implemented")
+}
+func (UnimplementedStocksServiceServer) AdjustStock(context.Context, *AdjustStockRequest) (*AdjustStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustStock not implemented")
+}
+func (UnimplementedStocksServiceServer) ListExpiringLots(context.Context, *ListExpiringLotsRequest) (*ListExpiringLotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExpiringLots not implemented")
+}
+func (UnimplementedStocksServiceServer) UpdateBackorderSettings(context.Context, *BackorderSettingsRequest) (*GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBackorderSettings not implemented")
+}
+func (UnimplementedStocksServiceServer) TransferStock(context.Context, *TransferStockRequest) (*StockTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferStock not implemented")
+}
+func (UnimplementedStocksServiceServer) ReceiveStockTransfer(context.Context, *ReceiveStockTransferRequest) (*StockTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveStockTransfer not implemented")
+}
+func (UnimplementedStocksServiceServer) OpenCycleCount(context.Context, *OpenCycleCountRequest) (*CycleCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenCycleCount not implemented")
+}
+func (UnimplementedStocksServiceServer) SubmitCycleCounts(context.Context, *SubmitCycleCountsRequest) (*CycleCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitCycleCounts not implemented")
+}
+func (UnimplementedStocksServiceServer) GetCycleCountVariances(context.Context, *GetCycleCountRequest) (*CycleCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCycleCountVariances not implemented")
+}
+func (UnimplementedStocksServiceServer) ApproveCycleCount(context.Context, *ApproveCycleCountRequest) (*CycleCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveCycleCount not implemented")
+}
+func (UnimplementedStocksServiceServer) CreateSupplier(context.Context, *CreateSupplierRequest) (*SupplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSupplier not implemented")
+}
+func (UnimplementedStocksServiceServer) ListSuppliers(context.Context, *ListSuppliersRequest) (*ListSuppliersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSuppliers not implemented")
+}
+func (UnimplementedStocksServiceServer) CreatePurchaseOrder(context.Context, *CreatePurchaseOrderRequest) (*PurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePurchaseOrder not implemented")
+}
+func (UnimplementedStocksServiceServer) PlacePurchaseOrder(context.Context, *PurchaseOrderRequest) (*PurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlacePurchaseOrder not implemented")
+}
+func (UnimplementedStocksServiceServer) ReceivePurchaseOrder(context.Context, *ReceivePurchaseOrderRequest) (*PurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceivePurchaseOrder not implemented")
+}
+func (UnimplementedStocksServiceServer) GetPurchaseOrder(context.Context, *PurchaseOrderRequest) (*PurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPurchaseOrder not implemented")
+}
+func (UnimplementedStocksServiceServer) GetReorderSuggestions(context.Context, *GetReorderSuggestionsRequest) (*GetReorderSuggestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReorderSuggestions not implemented")
+}
+func (UnimplementedStocksServiceServer) SchedulePriceChange(context.Context, *SchedulePriceChangeRequest) (*ScheduledPriceChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulePriceChange not implemented")
+}
+func (UnimplementedStocksServiceServer) GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*PriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (UnimplementedStocksServiceServer) SetPriceTiers(context.Context, *SetPriceTiersRequest) (*PriceTiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPriceTiers not implemented")
+}
+func (UnimplementedStocksServiceServer) GetPriceTiers(context.Context, *GetPriceTiersRequest) (*PriceTiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceTiers not implemented")
+}
+func (UnimplementedStocksServiceServer) SetBundle(context.Context, *SetBundleRequest) (*GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBundle not implemented")
+}
+func (UnimplementedStocksServiceServer) GetBundle(context.Context, *GetBundleRequest) (*BundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBundle not implemented")
+}
+func (UnimplementedStocksServiceServer) SetAttributeSchema(context.Context, *SetAttributeSchemaRequest) (*GeneralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAttributeSchema not implemented")
+}
+func (UnimplementedStocksServiceServer) CreateVariantGroup(context.Context, *CreateVariantGroupRequest) (*VariantGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVariantGroup not implemented")
+}
+func (UnimplementedStocksServiceServer) GetVariantGroup(context.Context, *GetVariantGroupRequest) (*VariantGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVariantGroup not implemented")
+}
+func (UnimplementedStocksServiceServer) UpdateSKUAttributes(context.Context, *UpdateSKUAttributesRequest) (*SKUResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSKUAttributes not implemented")
+}
+func (UnimplementedStocksServiceServer) GetInventoryValuation(*InventoryValuationRequest, grpc.ServerStreamingServer[InventoryValuationRow]) error {
+	return status.Errorf(codes.Unimplemented, "method GetInventoryValuation not implemented")
+}
+func (UnimplementedStocksServiceServer) mustEmbedUnimplementedStocksServiceServer() {}
+func (UnimplementedStocksServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeStocksServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StocksServiceServer will
+// result in compilation errors.
+type UnsafeStocksServiceServer interface {
+	mustEmbedUnimplementedStocksServiceServer()
+}
+
+func RegisterStocksServiceServer(s grpc.ServiceRegistrar, srv StocksServiceServer) {
+	// If the following call pancis, it indicates UnimplementedStocksServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StocksService_ServiceDesc, srv)
+}
+
+func _StocksService_AddStockItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStockItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).AddStockItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_AddStockItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).AddStockItem(ctx, req.(*CreateStockItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocksService_DeleteStockItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStockItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).DeleteStockItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_DeleteStockItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).DeleteStockItem(ctx, req.(*DeleteStockItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocksService_RestoreStockItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreStockItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).RestoreStockItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_RestoreStockItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).RestoreStockItem(ctx, req.(*RestoreStockItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocksService_UpdateStockItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStockItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).UpdateStockItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_UpdateStockItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).UpdateStockItem(ctx, req.(*UpdateStockItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocksService_GetStockItemBySKU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStockItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).GetStockItemBySKU(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_GetStockItemBySKU_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).GetStockItemBySKU(ctx, req.(*GetStockItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocksService_GetStockItemsBySKUs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStockItemsBySKUsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).GetStockItemsBySKUs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_GetStockItemsBySKUs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).GetStockItemsBySKUs(ctx, req.(*GetStockItemsBySKUsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocksService_WatchStock_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchStockRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StocksServiceServer).WatchStock(m, &grpc.GenericServerStream[WatchStockRequest, StockChangeEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StocksService_WatchStockServer = grpc.ServerStreamingServer[StockChangeEvent]
+
+func _StocksService_ListStockItemsByLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).ListStockItemsByLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_ListStockItemsByLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).ListStockItemsByLocation(ctx, req.(*FilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocksService_SearchSKUs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchSKUsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).SearchSKUs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_SearchSKUs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).SearchSKUs(ctx, req.(*SearchSKUsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocksService_SetStockThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStockThresholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).SetStockThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_SetStockThreshold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).SetStockThreshold(ctx, req.(*SetStockThresholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocksService_ListLowStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLowStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).ListLowStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_ListLowStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).ListLowStock(ctx, req.(*ListLowStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocksService_AdjustStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).AdjustStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_AdjustStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).AdjustStock(ctx, req.(*AdjustStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocksService_ListExpiringLots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpiringLotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).ListExpiringLots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_ListExpiringLots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).ListExpiringLots(ctx, req.(*ListExpiringLotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocksService_UpdateBackorderSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackorderSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).UpdateBackorderSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_UpdateBackorderSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).UpdateBackorderSettings(ctx, req.(*BackorderSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocksService_TransferStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).TransferStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_TransferStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).TransferStock(ctx, req.(*TransferStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocksService_ReceiveStockTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiveStockTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).ReceiveStockTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_ReceiveStockTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).ReceiveStockTransfer(ctx, req.(*ReceiveStockTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocksService_OpenCycleCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenCycleCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).OpenCycleCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_OpenCycleCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).OpenCycleCount(ctx, req.(*OpenCycleCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocksService_SubmitCycleCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitCycleCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).SubmitCycleCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_SubmitCycleCounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).SubmitCycleCounts(ctx, req.(*SubmitCycleCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocksService_GetCycleCountVariances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCycleCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).GetCycleCountVariances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_GetCycleCountVariances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).GetCycleCountVariances(ctx, req.(*GetCycleCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocksService_ApproveCycleCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveCycleCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).ApproveCycleCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_ApproveCycleCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).ApproveCycleCount(ctx, req.(*ApproveCycleCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocksService_CreateSupplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSupplierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).CreateSupplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_CreateSupplier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).CreateSupplier(ctx, req.(*CreateSupplierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocksService_ListSuppliers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSuppliersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).ListSuppliers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_ListSuppliers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).ListSuppliers(ctx, req.(*ListSuppliersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocksService_CreatePurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePurchaseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).CreatePurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_CreatePurchaseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).CreatePurchaseOrder(ctx, req.(*CreatePurchaseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StocksService_PlacePurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).PlacePurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_PlacePurchaseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).PlacePurchaseOrder(ctx, req.(*PurchaseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *stocksServiceClient) ListStockItemsByLocation(ctx context.Context, in *FilterRequest, opts ...grpc.CallOption) (*ListStockItemsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListStockItemsResponse)
-	err := c.cc.Invoke(ctx, StocksService_ListStockItemsByLocation_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _StocksService_ReceivePurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceivePurchaseOrderRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(StocksServiceServer).ReceivePurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_ReceivePurchaseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).ReceivePurchaseOrder(ctx, req.(*ReceivePurchaseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// StocksServiceServer is the server API for StocksService service.
-// All implementations must embed UnimplementedStocksServiceServer
-// for forward compatibility.
-type StocksServiceServer interface {
-	AddStockItem(context.Context, *CreateStockItemRequest) (*GeneralResponse, error)
-	DeleteStockItem(context.Context, *DeleteStockItemRequest) (*GeneralResponse, error)
-	GetStockItemBySKU(context.Context, *GetStockItemRequest) (*StockItemResponse, error)
-	ListStockItemsByLocation(context.Context, *FilterRequest) (*ListStockItemsResponse, error)
-	mustEmbedUnimplementedStocksServiceServer()
+func _StocksService_GetPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).GetPurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_GetPurchaseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).GetPurchaseOrder(ctx, req.(*PurchaseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedStocksServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedStocksServiceServer struct{}
+func _StocksService_GetReorderSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReorderSuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).GetReorderSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_GetReorderSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).GetReorderSuggestions(ctx, req.(*GetReorderSuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedStocksServiceServer) AddStockItem(context.Context, *CreateStockItemRequest) (*GeneralResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddStockItem not implemented")
+func _StocksService_SchedulePriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePriceChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).SchedulePriceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_SchedulePriceChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).SchedulePriceChange(ctx, req.(*SchedulePriceChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedStocksServiceServer) DeleteStockItem(context.Context, *DeleteStockItemRequest) (*GeneralResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteStockItem not implemented")
+
+func _StocksService_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_GetPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedStocksServiceServer) GetStockItemBySKU(context.Context, *GetStockItemRequest) (*StockItemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetStockItemBySKU not implemented")
+
+func _StocksService_SetPriceTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPriceTiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).SetPriceTiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_SetPriceTiers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).SetPriceTiers(ctx, req.(*SetPriceTiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedStocksServiceServer) ListStockItemsByLocation(context.Context, *FilterRequest) (*ListStockItemsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListStockItemsByLocation not implemented")
+
+func _StocksService_GetPriceTiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceTiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).GetPriceTiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_GetPriceTiers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).GetPriceTiers(ctx, req.(*GetPriceTiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedStocksServiceServer) mustEmbedUnimplementedStocksServiceServer() {}
-func (UnimplementedStocksServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafeStocksServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to StocksServiceServer will
-// result in compilation errors.
-type UnsafeStocksServiceServer interface {
-	mustEmbedUnimplementedStocksServiceServer()
+func _StocksService_SetBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StocksServiceServer).SetBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_SetBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).SetBundle(ctx, req.(*SetBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterStocksServiceServer(s grpc.ServiceRegistrar, srv StocksServiceServer) {
-	// If the following call pancis, it indicates UnimplementedStocksServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _StocksService_GetBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&StocksService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(StocksServiceServer).GetBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StocksService_GetBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StocksServiceServer).GetBundle(ctx, req.(*GetBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _StocksService_AddStockItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateStockItemRequest)
+func _StocksService_SetAttributeSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAttributeSchemaRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StocksServiceServer).AddStockItem(ctx, in)
+		return srv.(StocksServiceServer).SetAttributeSchema(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StocksService_AddStockItem_FullMethodName,
+		FullMethod: StocksService_SetAttributeSchema_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StocksServiceServer).AddStockItem(ctx, req.(*CreateStockItemRequest))
+		return srv.(StocksServiceServer).SetAttributeSchema(ctx, req.(*SetAttributeSchemaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StocksService_DeleteStockItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteStockItemRequest)
+func _StocksService_CreateVariantGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVariantGroupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StocksServiceServer).DeleteStockItem(ctx, in)
+		return srv.(StocksServiceServer).CreateVariantGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StocksService_DeleteStockItem_FullMethodName,
+		FullMethod: StocksService_CreateVariantGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StocksServiceServer).DeleteStockItem(ctx, req.(*DeleteStockItemRequest))
+		return srv.(StocksServiceServer).CreateVariantGroup(ctx, req.(*CreateVariantGroupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StocksService_GetStockItemBySKU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStockItemRequest)
+func _StocksService_GetVariantGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVariantGroupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StocksServiceServer).GetStockItemBySKU(ctx, in)
+		return srv.(StocksServiceServer).GetVariantGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StocksService_GetStockItemBySKU_FullMethodName,
+		FullMethod: StocksService_GetVariantGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StocksServiceServer).GetStockItemBySKU(ctx, req.(*GetStockItemRequest))
+		return srv.(StocksServiceServer).GetVariantGroup(ctx, req.(*GetVariantGroupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _StocksService_ListStockItemsByLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FilterRequest)
+func _StocksService_UpdateSKUAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSKUAttributesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(StocksServiceServer).ListStockItemsByLocation(ctx, in)
+		return srv.(StocksServiceServer).UpdateSKUAttributes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: StocksService_ListStockItemsByLocation_FullMethodName,
+		FullMethod: StocksService_UpdateSKUAttributes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(StocksServiceServer).ListStockItemsByLocation(ctx, req.(*FilterRequest))
+		return srv.(StocksServiceServer).UpdateSKUAttributes(ctx, req.(*UpdateSKUAttributesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StocksService_GetInventoryValuation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InventoryValuationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StocksServiceServer).GetInventoryValuation(m, &grpc.GenericServerStream[InventoryValuationRequest, InventoryValuationRow]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type StocksService_GetInventoryValuationServer = grpc.ServerStreamingServer[InventoryValuationRow]
+
 // StocksService_ServiceDesc is the grpc.ServiceDesc for StocksService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -221,15 +1381,154 @@ var StocksService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteStockItem",
 			Handler:    _StocksService_DeleteStockItem_Handler,
 		},
+		{
+			MethodName: "RestoreStockItem",
+			Handler:    _StocksService_RestoreStockItem_Handler,
+		},
+		{
+			MethodName: "UpdateStockItem",
+			Handler:    _StocksService_UpdateStockItem_Handler,
+		},
 		{
 			MethodName: "GetStockItemBySKU",
 			Handler:    _StocksService_GetStockItemBySKU_Handler,
 		},
+		{
+			MethodName: "GetStockItemsBySKUs",
+			Handler:    _StocksService_GetStockItemsBySKUs_Handler,
+		},
 		{
 			MethodName: "ListStockItemsByLocation",
 			Handler:    _StocksService_ListStockItemsByLocation_Handler,
 		},
+		{
+			MethodName: "SearchSKUs",
+			Handler:    _StocksService_SearchSKUs_Handler,
+		},
+		{
+			MethodName: "SetStockThreshold",
+			Handler:    _StocksService_SetStockThreshold_Handler,
+		},
+		{
+			MethodName: "ListLowStock",
+			Handler:    _StocksService_ListLowStock_Handler,
+		},
+		{
+			MethodName: "AdjustStock",
+			Handler:    _StocksService_AdjustStock_Handler,
+		},
+		{
+			MethodName: "ListExpiringLots",
+			Handler:    _StocksService_ListExpiringLots_Handler,
+		},
+		{
+			MethodName: "UpdateBackorderSettings",
+			Handler:    _StocksService_UpdateBackorderSettings_Handler,
+		},
+		{
+			MethodName: "TransferStock",
+			Handler:    _StocksService_TransferStock_Handler,
+		},
+		{
+			MethodName: "ReceiveStockTransfer",
+			Handler:    _StocksService_ReceiveStockTransfer_Handler,
+		},
+		{
+			MethodName: "OpenCycleCount",
+			Handler:    _StocksService_OpenCycleCount_Handler,
+		},
+		{
+			MethodName: "SubmitCycleCounts",
+			Handler:    _StocksService_SubmitCycleCounts_Handler,
+		},
+		{
+			MethodName: "GetCycleCountVariances",
+			Handler:    _StocksService_GetCycleCountVariances_Handler,
+		},
+		{
+			MethodName: "ApproveCycleCount",
+			Handler:    _StocksService_ApproveCycleCount_Handler,
+		},
+		{
+			MethodName: "CreateSupplier",
+			Handler:    _StocksService_CreateSupplier_Handler,
+		},
+		{
+			MethodName: "ListSuppliers",
+			Handler:    _StocksService_ListSuppliers_Handler,
+		},
+		{
+			MethodName: "CreatePurchaseOrder",
+			Handler:    _StocksService_CreatePurchaseOrder_Handler,
+		},
+		{
+			MethodName: "PlacePurchaseOrder",
+			Handler:    _StocksService_PlacePurchaseOrder_Handler,
+		},
+		{
+			MethodName: "ReceivePurchaseOrder",
+			Handler:    _StocksService_ReceivePurchaseOrder_Handler,
+		},
+		{
+			MethodName: "GetPurchaseOrder",
+			Handler:    _StocksService_GetPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "GetReorderSuggestions",
+			Handler:    _StocksService_GetReorderSuggestions_Handler,
+		},
+		{
+			MethodName: "SchedulePriceChange",
+			Handler:    _StocksService_SchedulePriceChange_Handler,
+		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _StocksService_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "SetPriceTiers",
+			Handler:    _StocksService_SetPriceTiers_Handler,
+		},
+		{
+			MethodName: "GetPriceTiers",
+			Handler:    _StocksService_GetPriceTiers_Handler,
+		},
+		{
+			MethodName: "SetBundle",
+			Handler:    _StocksService_SetBundle_Handler,
+		},
+		{
+			MethodName: "GetBundle",
+			Handler:    _StocksService_GetBundle_Handler,
+		},
+		{
+			MethodName: "SetAttributeSchema",
+			Handler:    _StocksService_SetAttributeSchema_Handler,
+		},
+		{
+			MethodName: "CreateVariantGroup",
+			Handler:    _StocksService_CreateVariantGroup_Handler,
+		},
+		{
+			MethodName: "GetVariantGroup",
+			Handler:    _StocksService_GetVariantGroup_Handler,
+		},
+		{
+			MethodName: "UpdateSKUAttributes",
+			Handler:    _StocksService_UpdateSKUAttributes_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchStock",
+			Handler:       _StocksService_WatchStock_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetInventoryValuation",
+			Handler:       _StocksService_GetInventoryValuation_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "stocks.proto",
 }